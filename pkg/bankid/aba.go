@@ -0,0 +1,60 @@
+package bankid
+
+import "fmt"
+
+// abaWeights are applied to the nine digits of a routing number; the
+// weighted sum of a valid number is a multiple of ten
+var abaWeights = [9]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+
+// ValidateABA checks a US ABA routing transit number: nine digits, a
+// Federal Reserve routing symbol prefix in use, and the check digit
+func ValidateABA(routing string) error {
+	if len(routing) != 9 {
+		return fmt.Errorf("%w: routing number must be 9 digits", ErrInvalidLength)
+	}
+	if !isDigits(routing) {
+		return fmt.Errorf("%w: routing number must be numeric", ErrInvalidFormat)
+	}
+	if !validABAPrefix(routing[:2]) {
+		return fmt.Errorf("%w: routing number prefix %s is not assigned", ErrInvalidFormat, routing[:2])
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(routing[i]-'0') * abaWeights[i]
+	}
+	if sum%10 != 0 {
+		return fmt.Errorf("%w: routing number %s", ErrInvalidChecksum, routing)
+	}
+	return nil
+}
+
+// ABACheckDigit computes the ninth digit for the first eight digits of a
+// routing number
+func ABACheckDigit(prefix string) (byte, error) {
+	if len(prefix) != 8 || !isDigits(prefix) {
+		return 0, fmt.Errorf("%w: routing number prefix must be 8 digits", ErrInvalidFormat)
+	}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(prefix[i]-'0') * abaWeights[i]
+	}
+	return byte('0' + (10-sum%10)%10), nil
+}
+
+// validABAPrefix reports whether the first two digits are a routing symbol
+// in use: 00 for the government, 01-12 for Federal Reserve districts, 21-32
+// for thrifts, 61-72 for electronic transactions and 80 for traveller's cheques
+func validABAPrefix(p string) bool {
+	n := int(p[0]-'0')*10 + int(p[1]-'0')
+	return n <= 12 || (n >= 21 && n <= 32) || (n >= 61 && n <= 72) || n == 80
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,259 @@
+package bankid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	tests := []struct {
+		name    string
+		iban    string
+		wantErr error
+	}{
+		{"valid GB", "GB82WEST12345698765432", nil},
+		{"valid DE", "DE89370400440532013000", nil},
+		{"valid FR with letters in BBAN", "FR1420041010050500013M02606", nil},
+		{"valid NL", "NL91ABNA0417164300", nil},
+		{"wrong check digits", "GB83WEST12345698765432", ErrInvalidChecksum},
+		{"transposed digits", "GB82WEST12345698765423", ErrInvalidChecksum},
+		{"too long", "GB82WEST123456987654321", ErrInvalidLength},
+		{"digits where letters belong", "GB82123412345698765432", ErrInvalidFormat},
+		{"non-numeric check digits", "GBXXWEST12345698765432", ErrInvalidFormat},
+		{"unsupported country", "XX82WEST12345698765432", ErrUnsupportedCountry},
+		{"too short", "GB82", ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIBAN(tt.iban)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateIBAN(t *testing.T) {
+	iban, err := GenerateIBAN("GB", "WEST12345698765432")
+	require.NoError(t, err)
+	assert.Equal(t, "GB82WEST12345698765432", iban)
+
+	iban, err = GenerateIBAN("DE", "370400440532013000")
+	require.NoError(t, err)
+	assert.Equal(t, "DE89370400440532013000", iban)
+
+	_, err = GenerateIBAN("DE", "37040044053201300")
+	assert.ErrorIs(t, err, ErrInvalidLength)
+
+	// Every supported structure round-trips through generation and validation
+	for country := range ibanStructures {
+		length, ok := BBANLength(country)
+		require.True(t, ok, country)
+		segments, err := parseStructure(ibanStructures[country])
+		require.NoError(t, err, country)
+
+		var bban strings.Builder
+		for _, s := range segments {
+			fill := "7"
+			if s.class == 'a' {
+				fill = "K"
+			}
+			bban.WriteString(strings.Repeat(fill, s.length))
+		}
+		require.Equal(t, length, bban.Len(), country)
+
+		iban, err := GenerateIBAN(country, bban.String())
+		require.NoError(t, err, country)
+		assert.NoError(t, ValidateIBAN(iban), country)
+	}
+}
+
+func TestNormalizeAndFormatIBAN(t *testing.T) {
+	assert.Equal(t, "GB82WEST12345698765432", NormalizeIBAN(" gb82 west 1234 5698 7654 32 "))
+	assert.Equal(t, "GB82 WEST 1234 5698 7654 32", FormatIBAN("GB82WEST12345698765432"))
+	assert.Equal(t, "NL91 ABNA 0417 1643 00", FormatIBAN("nl91abna0417164300"))
+}
+
+func TestValidateABA(t *testing.T) {
+	tests := []struct {
+		name    string
+		routing string
+		wantErr error
+	}{
+		{"federal reserve bank", "011000015", nil},
+		{"commercial bank", "021000021", nil},
+		{"thrift", "211274450", nil},
+		{"bad check digit", "021000022", ErrInvalidChecksum},
+		{"unassigned prefix", "131000021", ErrInvalidFormat},
+		{"letters", "02100002A", ErrInvalidFormat},
+		{"too short", "02100002", ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateABA(tt.routing)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestABACheckDigit(t *testing.T) {
+	digit, err := ABACheckDigit("02100002")
+	require.NoError(t, err)
+	assert.Equal(t, byte('1'), digit)
+
+	_, err = ABACheckDigit("0210000")
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
+
+func TestModulusTable_ValidateUKAccount(t *testing.T) {
+	table := DefaultModulusTable()
+
+	tests := []struct {
+		name     string
+		sortCode string
+		account  string
+		wantErr  error
+	}{
+		{"MOD10 pass", "08-99-99", "66374958", nil},
+		{"MOD10 fail", "089999", "66374959", ErrInvalidChecksum},
+		{"MOD11 pass", "107999", "88837491", nil},
+		{"MOD11 fail", "107999", "88837492", ErrInvalidChecksum},
+		{"DBLAL pass", "202959", "63748472", nil},
+		{"DBLAL fail", "202959", "63748473", ErrInvalidChecksum},
+		{"exception 1 adds 27", "118765", "64371383", nil},
+		{"exception 1 fail", "118765", "64371389", ErrInvalidChecksum},
+		{"exception 4 remainder matches gh", "134020", "63849206", nil},
+		{"exception 4 remainder differs from gh", "134020", "63849203", ErrInvalidChecksum},
+		{"exception 7 zeroises when g is 9", "772798", "99345694", nil},
+		{"exception 7 ignored when g is not 9", "772798", "99345684", ErrInvalidChecksum},
+		{"sort code not in table", "400000", "12345678", nil},
+		{"short account is padded", "400000", "345678", nil},
+		{"bad sort code", "40-00", "12345678", ErrInvalidFormat},
+		{"bad account", "400000", "12AB5678", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := table.ValidateUKAccount(tt.sortCode, tt.account)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadModulusTable(t *testing.T) {
+	table, err := LoadModulusTable(strings.NewReader(`
+100000 109999 MOD10 0 0 0 0 0 0 7 1 3 7 1 3 7 1
+100000 100099 MOD11 0 0 0 0 0 0 8 7 6 5 4 3 2 1 2
+`))
+	require.NoError(t, err)
+	assert.Len(t, table.Rules("100050"), 2)
+	assert.Len(t, table.Rules("100500"), 1)
+	assert.Empty(t, table.Rules("110000"))
+
+	// Rules with unimplemented exceptions do not reject accounts
+	assert.NoError(t, table.ValidateUKAccount("100050", "66374958"))
+
+	_, err = LoadModulusTable(strings.NewReader("100000 109999 MOD12 0 0 0 0 0 0 7 1 3 7 1 3 7 1"))
+	assert.Error(t, err)
+	_, err = LoadModulusTable(strings.NewReader("100000 109999 MOD10 0 0 0"))
+	assert.Error(t, err)
+	_, err = LoadModulusTable(strings.NewReader("109999 100000 MOD10 0 0 0 0 0 0 7 1 3 7 1 3 7 1"))
+	assert.Error(t, err)
+}
+
+func TestInternalAccountNumber(t *testing.T) {
+	number, err := InternalAccountNumber(1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "0000000018", number)
+
+	number, err = InternalAccountNumber(7992739871, 11)
+	require.NoError(t, err)
+	assert.Equal(t, "79927398713", number)
+	assert.NoError(t, ValidateInternalAccountNumber(number, 11))
+
+	assert.ErrorIs(t, ValidateInternalAccountNumber("79927398714", 11), ErrInvalidChecksum)
+	assert.ErrorIs(t, ValidateInternalAccountNumber("79927398713", 10), ErrInvalidLength)
+	assert.ErrorIs(t, ValidateInternalAccountNumber("79927398X13", 11), ErrInvalidFormat)
+
+	_, err = InternalAccountNumber(1000000000, 10)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+}
+
+func TestScheme(t *testing.T) {
+	t.Run("internal numbers only", func(t *testing.T) {
+		scheme, err := NewScheme("", "")
+		require.NoError(t, err)
+		number, iban, err := scheme.Assign(42)
+		require.NoError(t, err)
+		assert.Equal(t, "0000000422", number)
+		assert.Empty(t, iban)
+	})
+
+	t.Run("nil scheme", func(t *testing.T) {
+		var scheme *Scheme
+		number, iban, err := scheme.Assign(42)
+		require.NoError(t, err)
+		assert.Len(t, number, DefaultAccountNumberDigits)
+		assert.Empty(t, iban)
+	})
+
+	t.Run("GB sort code and account", func(t *testing.T) {
+		scheme, err := NewScheme("gb", "CORE400000")
+		require.NoError(t, err)
+		assert.Equal(t, 8, scheme.Digits())
+
+		number, iban, err := scheme.Assign(1234)
+		require.NoError(t, err)
+		assert.Equal(t, "00012344", number)
+		assert.Equal(t, "CORE40000000012344", iban[4:])
+		assert.NoError(t, ValidateIBAN(iban))
+		assert.NoError(t, ValidateInternalAccountNumber(number, 8))
+	})
+
+	t.Run("DE bank code", func(t *testing.T) {
+		scheme, err := NewScheme("DE", "37040044")
+		require.NoError(t, err)
+		_, iban, err := scheme.Assign(7)
+		require.NoError(t, err)
+		assert.NoError(t, ValidateIBAN(iban))
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		_, err := NewScheme("XX", "1234")
+		assert.ErrorIs(t, err, ErrUnsupportedCountry)
+		_, err = NewScheme("", "1234")
+		assert.Error(t, err)
+		_, err = NewScheme("GB", "1234")
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+		_, err = NewScheme("NL", "ABNA041716430")
+		assert.ErrorIs(t, err, ErrInvalidLength)
+	})
+
+	t.Run("national check digits", func(t *testing.T) {
+		for _, tt := range []struct{ country, bankCode string }{
+			{"BE", "539"},
+			{"FR", "2004101005"},
+			{"ES", "21000418"},
+			{"IT", "X0542811101"},
+			{"NO", "8601"},
+		} {
+			_, err := NewScheme(tt.country, tt.bankCode)
+			assert.ErrorIs(t, err, ErrUnsupportedCountry, tt.country)
+		}
+	})
+}
@@ -0,0 +1,243 @@
+// Package bankid generates and validates bank account identifiers: IBANs,
+// US ABA routing numbers, UK sort codes with account numbers, and the
+// internal account numbers issued by the bank.
+package bankid
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Validation errors. Functions wrap these with details, so compare with errors.Is.
+var (
+	ErrInvalidFormat      = errors.New("invalid format")
+	ErrInvalidLength      = errors.New("invalid length")
+	ErrInvalidChecksum    = errors.New("invalid check digits")
+	ErrUnsupportedCountry = errors.New("unsupported country")
+)
+
+// ibanStructures holds the BBAN structure of each supported country in the
+// notation of the SWIFT IBAN registry: each segment is a length, '!' for a
+// fixed length, and a character class - n for digits, a for upper-case
+// letters and c for upper-case alphanumerics.
+var ibanStructures = map[string]string{
+	"AD": "4!n4!n12!c",
+	"AE": "3!n16!n",
+	"AT": "5!n11!n",
+	"BE": "3!n7!n2!n",
+	"BG": "4!a4!n2!n8!c",
+	"BR": "8!n5!n10!n1!a1!c",
+	"CH": "5!n12!c",
+	"CY": "3!n5!n16!c",
+	"CZ": "4!n6!n10!n",
+	"DE": "8!n10!n",
+	"DK": "4!n9!n1!n",
+	"EE": "2!n2!n11!n1!n",
+	"ES": "4!n4!n1!n1!n10!n",
+	"FI": "3!n11!n",
+	"FR": "5!n5!n11!c2!n",
+	"GB": "4!a6!n8!n",
+	"GI": "4!a15!c",
+	"GR": "3!n4!n16!c",
+	"HR": "7!n10!n",
+	"HU": "3!n4!n1!n15!n1!n",
+	"IE": "4!a6!n8!n",
+	"IL": "3!n3!n13!n",
+	"IS": "4!n2!n6!n10!n",
+	"IT": "1!a5!n5!n12!c",
+	"LI": "5!n12!c",
+	"LT": "5!n11!n",
+	"LU": "3!n13!c",
+	"LV": "4!a13!c",
+	"MC": "5!n5!n11!c2!n",
+	"MT": "4!a5!n18!c",
+	"MU": "4!a2!n2!n12!n3!n3!a",
+	"NL": "4!a10!n",
+	"NO": "4!n6!n1!n",
+	"PL": "8!n16!n",
+	"PT": "4!n4!n11!n2!n",
+	"QA": "4!a21!c",
+	"RO": "4!a16!c",
+	"SA": "2!n18!c",
+	"SE": "3!n16!n1!n",
+	"SI": "5!n8!n2!n",
+	"SK": "4!n6!n10!n",
+	"SM": "1!a5!n5!n12!c",
+	"TR": "5!n1!n16!c",
+	"UA": "6!n19!c",
+}
+
+// bbanSegment is one fixed-length run of a BBAN structure
+type bbanSegment struct {
+	length int
+	class  byte
+}
+
+// parseStructure splits a registry structure such as "4!a6!n8!n" into segments
+func parseStructure(structure string) ([]bbanSegment, error) {
+	var segments []bbanSegment
+	for rest := structure; rest != ""; {
+		bang := strings.IndexByte(rest, '!')
+		if bang <= 0 || bang+1 >= len(rest) {
+			return nil, fmt.Errorf("malformed BBAN structure %q", structure)
+		}
+		length, err := strconv.Atoi(rest[:bang])
+		if err != nil || length <= 0 {
+			return nil, fmt.Errorf("malformed BBAN structure %q", structure)
+		}
+		class := rest[bang+1]
+		if class != 'n' && class != 'a' && class != 'c' {
+			return nil, fmt.Errorf("malformed BBAN structure %q", structure)
+		}
+		segments = append(segments, bbanSegment{length: length, class: class})
+		rest = rest[bang+2:]
+	}
+	return segments, nil
+}
+
+// BBANLength returns the BBAN length for the country, or false if the
+// country is not supported
+func BBANLength(country string) (int, bool) {
+	structure, ok := ibanStructures[country]
+	if !ok {
+		return 0, false
+	}
+	segments, err := parseStructure(structure)
+	if err != nil {
+		return 0, false
+	}
+	total := 0
+	for _, s := range segments {
+		total += s.length
+	}
+	return total, true
+}
+
+// ValidateBBAN checks that a BBAN matches the country's structure
+func ValidateBBAN(country, bban string) error {
+	structure, ok := ibanStructures[country]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnsupportedCountry, country)
+	}
+	segments, err := parseStructure(structure)
+	if err != nil {
+		return err
+	}
+
+	want := 0
+	for _, s := range segments {
+		want += s.length
+	}
+	if len(bban) != want {
+		return fmt.Errorf("%w: %s BBAN must be %d characters, got %d", ErrInvalidLength, country, want, len(bban))
+	}
+
+	pos := 0
+	for _, s := range segments {
+		for _, c := range []byte(bban[pos : pos+s.length]) {
+			if !matchesClass(c, s.class) {
+				return fmt.Errorf("%w: %s BBAN character %d must be %s", ErrInvalidFormat, country, pos+1, className(s.class))
+			}
+			pos++
+		}
+	}
+	return nil
+}
+
+func matchesClass(c, class byte) bool {
+	isDigit := c >= '0' && c <= '9'
+	isUpper := c >= 'A' && c <= 'Z'
+	switch class {
+	case 'n':
+		return isDigit
+	case 'a':
+		return isUpper
+	default:
+		return isDigit || isUpper
+	}
+}
+
+func className(class byte) string {
+	switch class {
+	case 'n':
+		return "a digit"
+	case 'a':
+		return "a letter"
+	default:
+		return "a letter or digit"
+	}
+}
+
+// NormalizeIBAN removes spaces and upper-cases an IBAN as entered by a person
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
+
+// FormatIBAN returns the IBAN in print format, in groups of four characters
+func FormatIBAN(iban string) string {
+	iban = NormalizeIBAN(iban)
+	var b strings.Builder
+	for i := 0; i < len(iban); i += 4 {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(iban[i:min(i+4, len(iban))])
+	}
+	return b.String()
+}
+
+// ValidateIBAN checks an IBAN in electronic format: a supported country
+// code, the country's length and BBAN structure, and the mod-97 check digits
+func ValidateIBAN(iban string) error {
+	if len(iban) < 5 {
+		return fmt.Errorf("%w: IBAN is too short", ErrInvalidLength)
+	}
+	country := iban[:2]
+	if _, ok := ibanStructures[country]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnsupportedCountry, country)
+	}
+	if iban[2] < '0' || iban[2] > '9' || iban[3] < '0' || iban[3] > '9' {
+		return fmt.Errorf("%w: IBAN check digits must be numeric", ErrInvalidFormat)
+	}
+	if err := ValidateBBAN(country, iban[4:]); err != nil {
+		return err
+	}
+	if mod97(iban[4:]+iban[:4]) != 1 {
+		return fmt.Errorf("%w: IBAN %s", ErrInvalidChecksum, iban)
+	}
+	return nil
+}
+
+// IBANCheckDigits computes the two check digits for a BBAN in a country
+func IBANCheckDigits(country, bban string) (string, error) {
+	if err := ValidateBBAN(country, bban); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%02d", 98-mod97(bban+country+"00")), nil
+}
+
+// GenerateIBAN builds the IBAN for a BBAN in a country
+func GenerateIBAN(country, bban string) (string, error) {
+	check, err := IBANCheckDigits(country, bban)
+	if err != nil {
+		return "", err
+	}
+	return country + check + bban, nil
+}
+
+// mod97 computes the ISO 7064 MOD 97-10 remainder of s, with letters
+// expanded to two digits (A=10 ... Z=35). s must be upper-case alphanumeric.
+func mod97(s string) int {
+	remainder := 0
+	for _, c := range []byte(s) {
+		if c >= 'A' && c <= 'Z' {
+			v := int(c-'A') + 10
+			remainder = (remainder*100 + v) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	return remainder
+}
@@ -0,0 +1,144 @@
+package bankid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultAccountNumberDigits is the length of internal account numbers
+// when no IBAN scheme dictates otherwise
+const DefaultAccountNumberDigits = 10
+
+// InternalAccountNumber turns a sequence number into an internal account
+// number of the given length: the sequence zero-padded to digits-1 followed
+// by a Luhn check digit
+func InternalAccountNumber(seq int64, digits int) (string, error) {
+	if digits < 2 || digits > 19 {
+		return "", fmt.Errorf("%w: account numbers must be 2 to 19 digits", ErrInvalidLength)
+	}
+	if seq < 0 {
+		return "", fmt.Errorf("%w: sequence must not be negative", ErrInvalidFormat)
+	}
+	body := strconv.FormatInt(seq, 10)
+	if len(body) > digits-1 {
+		return "", fmt.Errorf("%w: sequence %d does not fit in %d digits", ErrInvalidLength, seq, digits)
+	}
+	body = strings.Repeat("0", digits-1-len(body)) + body
+	return body + string(luhnCheckDigit(body)), nil
+}
+
+// ValidateInternalAccountNumber checks an internal account number's length
+// and Luhn check digit
+func ValidateInternalAccountNumber(number string, digits int) error {
+	if len(number) != digits {
+		return fmt.Errorf("%w: account number must be %d digits", ErrInvalidLength, digits)
+	}
+	if !isDigits(number) {
+		return fmt.Errorf("%w: account number must be numeric", ErrInvalidFormat)
+	}
+	if luhnCheckDigit(number[:len(number)-1]) != number[len(number)-1] {
+		return fmt.Errorf("%w: account number %s", ErrInvalidChecksum, number)
+	}
+	return nil
+}
+
+// luhnCheckDigit computes the Luhn (mod 10) check digit for a digit string
+func luhnCheckDigit(body string) byte {
+	sum := 0
+	double := true
+	for i := len(body) - 1; i >= 0; i-- {
+		d := int(body[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// nationalCheckDigits lists the supported countries whose BBAN carries
+// national check digits over the bank code and account number, such as the
+// mod-97 pair ending a Belgian BBAN or the RIB key of a French one. A Luhn
+// internal number appended to the bank code would not carry them, so these
+// countries cannot have a scheme.
+var nationalCheckDigits = map[string]bool{
+	"BE": true, "CZ": true, "EE": true, "ES": true, "FI": true, "FR": true,
+	"HR": true, "HU": true, "IS": true, "IT": true, "MC": true, "NO": true,
+	"PL": true, "PT": true, "SI": true, "SK": true, "SM": true,
+}
+
+// Scheme assigns account numbers for the bank. Every account gets an
+// internal number; when the bank has an IBAN country and bank code, the
+// internal number fills the rest of the BBAN and the account also gets an
+// IBAN. The zero Scheme issues internal numbers only.
+type Scheme struct {
+	Country  string
+	BankCode string // Leading part of the BBAN identifying the bank and branch
+	digits   int
+}
+
+// NewScheme creates a numbering scheme. With an empty country the scheme
+// issues internal numbers only; otherwise the country's BBAN must not carry
+// national check digits and the bank code must leave room in it for a
+// numeric account number.
+func NewScheme(country, bankCode string) (*Scheme, error) {
+	s := &Scheme{Country: strings.ToUpper(country), BankCode: strings.ToUpper(bankCode)}
+	if s.Country == "" {
+		if s.BankCode != "" {
+			return nil, fmt.Errorf("bank code %q requires an IBAN country", bankCode)
+		}
+		return s, nil
+	}
+
+	length, ok := BBANLength(s.Country)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedCountry, country)
+	}
+	if nationalCheckDigits[s.Country] {
+		return nil, fmt.Errorf("%w: %s BBANs carry national check digits the scheme does not compute", ErrUnsupportedCountry, s.Country)
+	}
+	s.digits = length - len(s.BankCode)
+	if s.digits < 2 {
+		return nil, fmt.Errorf("%w: bank code %q leaves no room for an account number in a %s BBAN", ErrInvalidLength, bankCode, s.Country)
+	}
+
+	// The account number part must accept digits wherever it falls
+	sample, err := InternalAccountNumber(0, s.digits)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateBBAN(s.Country, s.BankCode+sample); err != nil {
+		return nil, fmt.Errorf("bank code %q does not fit the %s BBAN: %w", bankCode, s.Country, err)
+	}
+	return s, nil
+}
+
+// Digits returns the length of the internal account numbers the scheme issues
+func (s *Scheme) Digits() int {
+	if s == nil || s.digits == 0 {
+		return DefaultAccountNumberDigits
+	}
+	return s.digits
+}
+
+// Assign returns the internal account number, and the IBAN if the scheme
+// has one, for a sequence number
+func (s *Scheme) Assign(seq int64) (number, iban string, err error) {
+	number, err = InternalAccountNumber(seq, s.Digits())
+	if err != nil {
+		return "", "", err
+	}
+	if s == nil || s.Country == "" {
+		return number, "", nil
+	}
+	iban, err = GenerateIBAN(s.Country, s.BankCode+number)
+	if err != nil {
+		return "", "", err
+	}
+	return number, iban, nil
+}
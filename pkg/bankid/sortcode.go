@@ -0,0 +1,204 @@
+package bankid
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ModulusMethod is the checking algorithm of a UK modulus rule
+type ModulusMethod string
+
+const (
+	ModulusMethod10    ModulusMethod = "MOD10"
+	ModulusMethod11    ModulusMethod = "MOD11"
+	ModulusMethodDBLAL ModulusMethod = "DBLAL"
+)
+
+// modulusWeightsCount is the number of digits in a sort code and account number
+const modulusWeightsCount = 14
+
+// ModulusRule is one line of the Vocalink modulus weight table: the sort
+// code range it covers, the method, the weights applied to the fourteen
+// digits of sort code and account number, and an optional exception code
+type ModulusRule struct {
+	Start     string
+	End       string
+	Method    ModulusMethod
+	Weights   [modulusWeightsCount]int
+	Exception int
+}
+
+// ModulusTable is a parsed modulus weight table
+type ModulusTable struct {
+	rules []ModulusRule
+}
+
+//go:embed modulus.txt
+var defaultModulusData []byte
+
+// DefaultModulusTable returns the table bundled with the package. It holds
+// a small sample of ranges; production deployments load the current
+// Vocalink valacdos.txt with LoadModulusTable.
+func DefaultModulusTable() *ModulusTable {
+	table, err := LoadModulusTable(bytes.NewReader(defaultModulusData))
+	if err != nil {
+		panic(fmt.Sprintf("bankid: embedded modulus table: %v", err))
+	}
+	return table
+}
+
+// LoadModulusTable parses a modulus weight table in the Vocalink
+// valacdos.txt layout: start and end sort code, method, fourteen weights
+// and an optional exception code, separated by white space
+func LoadModulusTable(r io.Reader) (*ModulusTable, error) {
+	table := &ModulusTable{}
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3+modulusWeightsCount && len(fields) != 4+modulusWeightsCount {
+			return nil, fmt.Errorf("line %d: expected %d or %d fields, got %d", lineNo, 3+modulusWeightsCount, 4+modulusWeightsCount, len(fields))
+		}
+
+		rule := ModulusRule{Start: fields[0], End: fields[1], Method: ModulusMethod(fields[2])}
+		if len(rule.Start) != 6 || !isDigits(rule.Start) || len(rule.End) != 6 || !isDigits(rule.End) || rule.End < rule.Start {
+			return nil, fmt.Errorf("line %d: invalid sort code range %s-%s", lineNo, rule.Start, rule.End)
+		}
+		switch rule.Method {
+		case ModulusMethod10, ModulusMethod11, ModulusMethodDBLAL:
+		default:
+			return nil, fmt.Errorf("line %d: unknown method %q", lineNo, rule.Method)
+		}
+		for i := range rule.Weights {
+			w, err := strconv.Atoi(fields[3+i])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid weight %q", lineNo, fields[3+i])
+			}
+			rule.Weights[i] = w
+		}
+		if len(fields) == 4+modulusWeightsCount {
+			exception, err := strconv.Atoi(fields[3+modulusWeightsCount])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid exception %q", lineNo, fields[3+modulusWeightsCount])
+			}
+			rule.Exception = exception
+		}
+		table.rules = append(table.rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read modulus table: %w", err)
+	}
+
+	sort.SliceStable(table.rules, func(i, j int) bool { return table.rules[i].Start < table.rules[j].Start })
+	return table, nil
+}
+
+// Rules returns the rules that apply to a sort code, in table order
+func (t *ModulusTable) Rules(sortCode string) []ModulusRule {
+	var rules []ModulusRule
+	for _, rule := range t.rules {
+		if rule.Start > sortCode {
+			break
+		}
+		if sortCode <= rule.End {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// NormalizeSortCode strips the dashes and spaces people write in sort codes
+func NormalizeSortCode(sortCode string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(sortCode)
+}
+
+// NormalizeUKAccountNumber left-pads six and seven digit account numbers
+// to the standard eight digits
+func NormalizeUKAccountNumber(account string) string {
+	account = strings.ReplaceAll(account, " ", "")
+	if (len(account) == 6 || len(account) == 7) && isDigits(account) {
+		return strings.Repeat("0", 8-len(account)) + account
+	}
+	return account
+}
+
+// ValidateUKAccount checks a sort code and account number against the
+// table. Sort codes the table does not cover cannot be checked and are
+// presumed valid, as the Vocalink specification directs; when two rules
+// cover a sort code both must pass.
+//
+// Exceptions 1, 4 and 7 are implemented. Rules carrying any other
+// exception are skipped rather than risk rejecting a valid account.
+func (t *ModulusTable) ValidateUKAccount(sortCode, account string) error {
+	sortCode = NormalizeSortCode(sortCode)
+	account = NormalizeUKAccountNumber(account)
+	if len(sortCode) != 6 || !isDigits(sortCode) {
+		return fmt.Errorf("%w: sort code must be 6 digits", ErrInvalidFormat)
+	}
+	if len(account) != 8 || !isDigits(account) {
+		return fmt.Errorf("%w: account number must be 6 to 8 digits", ErrInvalidFormat)
+	}
+
+	digits := sortCode + account
+	for _, rule := range t.Rules(sortCode) {
+		if !rule.supported() {
+			continue
+		}
+		if !rule.check(digits) {
+			return fmt.Errorf("%w: account %s does not pass the %s check for sort code %s", ErrInvalidChecksum, account, rule.Method, sortCode)
+		}
+	}
+	return nil
+}
+
+func (r ModulusRule) supported() bool {
+	return r.Exception == 0 || r.Exception == 1 || r.Exception == 4 || r.Exception == 7
+}
+
+// check runs the rule over the fourteen digits u-z (sort code) and a-h
+// (account number)
+func (r ModulusRule) check(digits string) bool {
+	weights := r.Weights
+	// Exception 7: when g is 9, weights u to b are zeroised
+	if r.Exception == 7 && digits[12] == '9' {
+		for i := 0; i < 8; i++ {
+			weights[i] = 0
+		}
+	}
+
+	total := 0
+	for i := 0; i < modulusWeightsCount; i++ {
+		product := int(digits[i]-'0') * weights[i]
+		if r.Method == ModulusMethodDBLAL {
+			total += product/10 + product%10
+		} else {
+			total += product
+		}
+	}
+
+	switch r.Method {
+	case ModulusMethod10:
+		return total%10 == 0
+	case ModulusMethod11:
+		// Exception 4: the remainder must equal the check digits gh
+		if r.Exception == 4 {
+			gh := int(digits[12]-'0')*10 + int(digits[13]-'0')
+			return total%11 == gh
+		}
+		return total%11 == 0
+	default:
+		// Exception 1: 27 is added to the total before the check
+		if r.Exception == 1 {
+			total += 27
+		}
+		return total%10 == 0
+	}
+}
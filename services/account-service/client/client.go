@@ -0,0 +1,203 @@
+// Package client is the Go client other services use to look up accounts
+// and move funds between them in account-service over gRPC.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotFound is returned when account-service has no such account
+var ErrNotFound = errors.New("account not found")
+
+// ErrRejected is returned when account-service refuses a transfer, for
+// example for insufficient funds or a frozen account. The error it wraps
+// carries account-service's reason.
+var ErrRejected = errors.New("transfer rejected")
+
+// LimitBreachError is returned when a transfer would exceed one of the
+// customer's transaction limits. It wraps ErrRejected.
+type LimitBreachError struct {
+	LimitID   uuid.UUID
+	Kind      string
+	Limit     int64
+	Remaining int64
+	Message   string
+}
+
+func (e *LimitBreachError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrRejected, e.Message)
+}
+
+func (e *LimitBreachError) Unwrap() error {
+	return ErrRejected
+}
+
+// Account is the subset of an account record other services rely on
+type Account struct {
+	ID            uuid.UUID
+	AccountNumber string
+	IBAN          string
+	CustomerID    uuid.UUID
+	AccountType   string
+	Currency      string
+	Status        string
+}
+
+// Holding is an account a customer has a role on
+type Holding struct {
+	Account *Account
+	Role    string // PrimaryHolder, JointHolder, AuthorizedSignatory, PowerOfAttorney or Beneficiary
+}
+
+// TransferRequest moves an amount, in minor units, between two accounts
+type TransferRequest struct {
+	FromAccountID uuid.UUID
+	ToAccountID   uuid.UUID
+	Amount        int64
+	Currency      string
+	Reference     string
+	Description   string
+	Channel       string // Branch, Online, Mobile, API or Batch; API when empty
+	// CounterpartyCountry is the ISO 3166 alpha-2 country of the external
+	// party behind a settlement transfer, for transaction monitoring
+	CounterpartyCountry string
+	// IdempotencyKey, when set, makes the transfer safe to repeat: a repeat
+	// returns as the first did without moving the funds again
+	IdempotencyKey string
+}
+
+// Client looks up accounts and transfers funds in account-service
+type Client struct {
+	conn   *grpc.ClientConn
+	client accountpb.AccountServiceClient
+}
+
+// New creates a Client for the account-service gRPC endpoint at addr. The
+// connection is established lazily on the first call.
+func New(addr string) (*Client, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account-service client: %w", err)
+	}
+	return &Client{
+		conn:   conn,
+		client: accountpb.NewAccountServiceClient(conn),
+	}, nil
+}
+
+// Close closes the underlying connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// GetAccount retrieves an account by ID
+func (c *Client) GetAccount(ctx context.Context, id uuid.UUID) (*Account, error) {
+	return c.getAccount(ctx, &accountpb.GetAccountRequest{Id: id.String()})
+}
+
+// GetAccountByNumber retrieves an account by its account number
+func (c *Client) GetAccountByNumber(ctx context.Context, accountNumber string) (*Account, error) {
+	return c.getAccount(ctx, &accountpb.GetAccountRequest{AccountNumber: accountNumber})
+}
+
+func (c *Client) getAccount(ctx context.Context, req *accountpb.GetAccountRequest) (*Account, error) {
+	resp, err := c.client.GetAccount(ctx, req)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return accountFromProto(resp.GetAccount())
+}
+
+// ListCustomerHoldings lists the accounts a customer currently has a role
+// on, once for each role
+func (c *Client) ListCustomerHoldings(ctx context.Context, customerID uuid.UUID) ([]Holding, error) {
+	resp, err := c.client.ListAccountsByCustomer(ctx, &accountpb.ListAccountsByCustomerRequest{
+		CustomerId: customerID.String(),
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customer accounts: %w", err)
+	}
+
+	holdings := make([]Holding, 0, len(resp.GetAccounts()))
+	for _, ca := range resp.GetAccounts() {
+		account, err := accountFromProto(ca.GetAccount())
+		if err != nil {
+			return nil, err
+		}
+		holdings = append(holdings, Holding{Account: account, Role: ca.GetParty().GetRole()})
+	}
+	return holdings, nil
+}
+
+// Transfer moves funds between two accounts. Any transfer fee configured in
+// account-service is charged to the source account.
+func (c *Client) Transfer(ctx context.Context, req TransferRequest) error {
+	_, err := c.client.Transfer(ctx, &accountpb.TransferRequest{
+		FromAccountId:       req.FromAccountID.String(),
+		ToAccountId:         req.ToAccountID.String(),
+		Amount:              req.Amount,
+		Currency:            req.Currency,
+		Reference:           req.Reference,
+		Description:         req.Description,
+		Channel:             req.Channel,
+		CounterpartyCountry: req.CounterpartyCountry,
+		IdempotencyKey:      req.IdempotencyKey,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return ErrNotFound
+	case codes.FailedPrecondition:
+		st := status.Convert(err)
+		for _, detail := range st.Details() {
+			if breach, ok := detail.(*accountpb.LimitBreach); ok {
+				limitID, _ := uuid.Parse(breach.GetLimitId())
+				return &LimitBreachError{
+					LimitID:   limitID,
+					Kind:      breach.GetKind(),
+					Limit:     breach.GetLimit(),
+					Remaining: breach.GetRemaining(),
+					Message:   st.Message(),
+				}
+			}
+		}
+		return fmt.Errorf("%w: %s", ErrRejected, st.Message())
+	}
+	return fmt.Errorf("failed to transfer: %w", err)
+}
+
+func accountFromProto(account *accountpb.Account) (*Account, error) {
+	accountID, err := uuid.Parse(account.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid account id %q: %w", account.GetId(), err)
+	}
+	customerID, err := uuid.Parse(account.GetCustomerId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid customer id %q: %w", account.GetCustomerId(), err)
+	}
+
+	return &Account{
+		ID:            accountID,
+		AccountNumber: account.GetAccountNumber(),
+		IBAN:          account.GetIban(),
+		CustomerID:    customerID,
+		AccountType:   account.GetAccountType(),
+		Currency:      account.GetCurrency(),
+		Status:        account.GetStatus(),
+	}, nil
+}
@@ -0,0 +1,128 @@
+// Command amlbacktest runs a set of AML monitoring rules over historical
+// transactions and reports the alerts they would have raised, without
+// writing anything. Events come from the account database or from a CSV
+// export.
+//
+//	amlbacktest -rules rules.json -from 2024-01-01 -to 2024-04-01 -alerts
+//	amlbacktest -rules rules.json -events events.csv
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/core-banking/pkg/config"
+	"github.com/core-banking/pkg/database"
+	"github.com/core-banking/pkg/logger"
+
+	"github.com/core-banking/services/account-service/internal/aml"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/core-banking/services/account-service/internal/service"
+)
+
+const dateLayout = "2006-01-02"
+
+func main() {
+	rulesPath := flag.String("rules", os.Getenv("AML_RULES_FILE"), "AML rules file (defaults to AML_RULES_FILE)")
+	eventsPath := flag.String("events", "", "CSV export of transaction events; read from the database when empty")
+	fromFlag := flag.String("from", "", "first day tested, YYYY-MM-DD (defaults to 90 days ago)")
+	toFlag := flag.String("to", "", "day after the last day tested, YYYY-MM-DD (defaults to today)")
+	alerts := flag.Bool("alerts", false, "list every alert, not just the totals per rule")
+	flag.Parse()
+
+	if err := run(*rulesPath, *eventsPath, *fromFlag, *toFlag, *alerts); err != nil {
+		fmt.Fprintf(os.Stderr, "amlbacktest: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(rulesPath, eventsPath, fromFlag, toFlag string, detailed bool) error {
+	if rulesPath == "" {
+		return fmt.Errorf("no rules file: set -rules or AML_RULES_FILE")
+	}
+	rules, err := aml.LoadConfig(rulesPath)
+	if err != nil {
+		return err
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from, err := parseDate(fromFlag, today.AddDate(0, 0, -90))
+	if err != nil {
+		return fmt.Errorf("invalid -from: %w", err)
+	}
+	to, err := parseDate(toFlag, today.AddDate(0, 0, 1))
+	if err != nil {
+		return fmt.Errorf("invalid -to: %w", err)
+	}
+	if !from.Before(to) {
+		return fmt.Errorf("-from must be before -to")
+	}
+
+	var events []aml.Event
+	if eventsPath != "" {
+		events, err = readEvents(eventsPath)
+		if fromFlag == "" {
+			from = time.Time{} // Test the whole export unless asked otherwise
+		}
+	} else {
+		// Load the lookback before the period too, so the first days are
+		// tested against the history leading up to them
+		events, err = loadEvents(from.Add(-rules.Lookback()), to)
+	}
+	if err != nil {
+		return err
+	}
+
+	var tested []aml.Event
+	for _, e := range events {
+		if e.OccurredAt.Before(to) {
+			tested = append(tested, e)
+		}
+	}
+
+	result := aml.Backtest(rules, tested, from)
+	return result.WriteReport(os.Stdout, rules, detailed)
+}
+
+func parseDate(value string, fallback time.Time) (time.Time, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return time.Parse(dateLayout, value)
+}
+
+func readEvents(path string) ([]aml.Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open events: %w", err)
+	}
+	defer f.Close()
+
+	events, err := aml.ReadEventsCSV(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return events, nil
+}
+
+func loadEvents(from, to time.Time) ([]aml.Event, error) {
+	ctx := context.Background()
+	cfg, err := config.Load[config.Config](ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	logger.Init(cfg.ServiceName, "error", os.Stderr)
+	log := logger.New(cfg.ServiceName)
+
+	db, err := database.NewDatabase(ctx, cfg.DatabaseConfig(), &log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	return service.LoadAMLEvents(ctx, repository.NewAccountRepository(db.DB), from, to)
+}
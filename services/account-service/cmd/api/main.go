@@ -6,15 +6,28 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
+	"github.com/core-banking/pkg/bankid"
 	"github.com/core-banking/pkg/config"
+	"github.com/core-banking/pkg/database"
 	"github.com/core-banking/pkg/logger"
 	"github.com/core-banking/pkg/middleware"
+
+	"github.com/core-banking/services/account-service/internal/aml"
+	"github.com/core-banking/services/account-service/internal/fx"
+	accountgrpc "github.com/core-banking/services/account-service/internal/grpc"
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/reconciliation"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/core-banking/services/account-service/internal/service"
+	customerclient "github.com/core-banking/services/customer-service/client"
 )
 
 func main() {
@@ -32,7 +45,156 @@ func main() {
 	log.Info().
 		Str("environment", cfg.Environment).
 		Int("port", cfg.ServerPort).
-		Msg("Starting account service (placeholder)")
+		Msg("Starting account service")
+
+	// Initialize database
+	db, err := database.NewDatabase(ctx, cfg.DatabaseConfig(), &log)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize database")
+	}
+	defer db.Close()
+
+	// Verify database health
+	if err := db.HealthCheck(ctx); err != nil {
+		log.Fatal().Err(err).Msg("Database health check failed")
+	}
+	log.Info().Msg("Database health check passed")
+
+	// Initialize repository
+	repo := repository.NewAccountRepository(db.DB)
+
+	// Connect to customer-service, which owns the customers that are parties to accounts
+	customerServiceAddr := os.Getenv("CUSTOMER_SERVICE_ADDR")
+	if customerServiceAddr == "" {
+		customerServiceAddr = "localhost:50051"
+	}
+	customers, err := customerclient.New(customerServiceAddr)
+	if err != nil {
+		log.Fatal().Err(err).Str("addr", customerServiceAddr).Msg("Failed to create customer-service client")
+	}
+	defer customers.Close()
+
+	// New accounts get IBANs only when the bank's IBAN country and bank code are configured
+	numbering, err := bankid.NewScheme(os.Getenv("BANK_IBAN_COUNTRY"), os.Getenv("BANK_CODE"))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Invalid account numbering configuration")
+	}
+	if numbering.Country == "" {
+		log.Warn().Msg("BANK_IBAN_COUNTRY not set, new accounts will not be assigned IBANs")
+	}
+
+	// Nostro reconciliation matches with the rules in the configuration file,
+	// or with the built-in rules when none is given
+	reconciliationRules := reconciliation.DefaultRules()
+	if path := os.Getenv("RECONCILIATION_CONFIG_FILE"); path != "" {
+		reconciliationRules, err = reconciliation.LoadRules(path)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("Failed to load reconciliation rules")
+		}
+	}
+
+	// Conversions are priced with the spreads in the FX configuration file,
+	// or with the built-in defaults when none is given
+	fxConfig := fx.DefaultConfig()
+	if path := os.Getenv("FX_CONFIG_FILE"); path != "" {
+		fxConfig, err = fx.LoadConfig(path)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("Failed to load FX configuration")
+		}
+	}
+	if path := os.Getenv("FX_RATES_FILE"); path != "" {
+		created, err := service.LoadFXRates(ctx, repo, path, cfg.ServiceName)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("Failed to load FX rate table")
+		}
+		log.Info().Str("path", path).Int("created", created).Msg("Loaded FX rate table")
+	}
+
+	// Payment schemes settle through the bank's own accounts, which are not
+	// held to the limits of the customer that owns them
+	var settlementAccounts []uuid.UUID
+	if value := os.Getenv("SETTLEMENT_ACCOUNT_IDS"); value != "" {
+		for _, field := range strings.Split(value, ",") {
+			id, err := uuid.Parse(strings.TrimSpace(field))
+			if err != nil {
+				log.Fatal().Err(err).Str("id", field).Msg("Invalid SETTLEMENT_ACCOUNT_IDS")
+			}
+			settlementAccounts = append(settlementAccounts, id)
+		}
+	}
+
+	// Start gRPC server
+	grpcPort := 50052 // Default gRPC port
+	grpcConfig := accountgrpc.Config{
+		Port:        grpcPort,
+		MaxRecvSize: 4, // 4MB
+		MaxSendSize: 4, // 4MB
+		Timeout:     30 * time.Second,
+		Numbering:   numbering,
+
+		ReconciliationRules: reconciliationRules,
+		FX:                  fxConfig,
+		SettlementAccounts:  settlementAccounts,
+	}
+
+	grpcServer := accountgrpc.NewServer(repo, customers, grpcConfig)
+
+	// Start gRPC server in goroutine
+	go func() {
+		log.Info().Int("port", grpcPort).Msg("Starting gRPC server")
+		if err := grpcServer.Start(); err != nil {
+			log.Fatal().Err(err).Msg("gRPC server failed to start")
+		}
+	}()
+
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+
+	holdExpirer := service.NewHoldExpirer(repo, time.Minute, 500, log)
+	go holdExpirer.Run(jobsCtx)
+
+	// Credit interest accrues only for the products in the configuration file;
+	// overdraft interest always accrues at each account's own debit rate
+	interestConfig := &interest.Config{}
+	if path := os.Getenv("INTEREST_CONFIG_FILE"); path != "" {
+		interestConfig, err = interest.LoadConfig(path)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("Failed to load interest configuration")
+		}
+	} else {
+		log.Warn().Msg("INTEREST_CONFIG_FILE not set, credit interest accrual disabled")
+	}
+	interestJob := service.NewInterestJob(repo, interestConfig, 15*time.Minute, log)
+	go interestJob.Run(jobsCtx)
+
+	feeJob := service.NewFeeJob(repo, 15*time.Minute, log)
+	go feeJob.Run(jobsCtx)
+
+	statementJob := service.NewStatementJob(repo, customers, 15*time.Minute, log)
+	go statementJob.Run(jobsCtx)
+
+	// Roles held by customers merged in customer-service pass to the
+	// customers they were merged into
+	customerEventJob := service.NewCustomerEventJob(repo, customers, time.Minute, 100, log)
+	go customerEventJob.Run(jobsCtx)
+
+	reconciliationJob := service.NewReconciliationJob(repo, reconciliationRules, 15*time.Minute, log)
+	go reconciliationJob.Run(jobsCtx)
+
+	// Transaction monitoring runs only with a rules file; events posted
+	// meanwhile wait and are monitored once it is configured
+	if path := os.Getenv("AML_RULES_FILE"); path != "" {
+		amlRules, err := aml.LoadConfig(path)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("Failed to load AML rules")
+		}
+		log.Info().Str("path", path).Str("version", amlRules.Version).Int("rules", len(amlRules.Rules)).Msg("Loaded AML rules")
+		amlMonitor := service.NewAMLMonitor(repo, amlRules, 30*time.Second, 500, log)
+		go amlMonitor.Run(jobsCtx)
+	} else {
+		log.Warn().Msg("AML_RULES_FILE not set, transaction monitoring disabled")
+	}
 
 	// Create router
 	router := createRouter(log)
@@ -67,11 +229,17 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Stop background jobs
+	stopJobs()
+
 	// Shutdown server
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.Error().Err(err).Msg("Server forced to shutdown")
 	}
 
+	// Shutdown gRPC server
+	grpcServer.Stop()
+
 	log.Info().Msg("Account service exited properly")
 }
 
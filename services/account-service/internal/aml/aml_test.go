@@ -0,0 +1,324 @@
+package aml
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	start    = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	customer = uuid.MustParse("00000000-0000-0000-0000-00000000c001")
+)
+
+func eventID(n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", n))
+}
+
+// ev is event n of the test customer, hours after the start
+func ev(n int, dir Direction, amount int64, hours float64) Event {
+	return Event{
+		ID:         eventID(n),
+		Sequence:   int64(n),
+		CustomerID: customer,
+		Direction:  dir,
+		Amount:     amount,
+		Currency:   "USD",
+		OccurredAt: start.Add(time.Duration(hours * float64(time.Hour))),
+	}
+}
+
+func ids(ns ...int) []uuid.UUID {
+	out := make([]uuid.UUID, len(ns))
+	for i, n := range ns {
+		out[i] = eventID(n)
+	}
+	return out
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := LoadConfig("../../config/aml_rules.json")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg.Version != "2024.1" || len(cfg.Rules) != 4 {
+		t.Errorf("LoadConfig() = version %q with %d rules", cfg.Version, len(cfg.Rules))
+	}
+	// The volume spike rule looks back over its window and 90 days of baseline
+	if got, want := cfg.Lookback(), 24*time.Hour+90*24*time.Hour; got != want {
+		t.Errorf("Lookback() = %v, want %v", got, want)
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{"no version", `{"rules": [{"id": "s", "type": "Structuring", "currency": "USD", "window_hours": 24, "threshold": 1000, "margin": 100, "min_count": 2}]}`},
+		{"no rules", `{"version": "1", "rules": []}`},
+		{"unknown type", `{"version": "1", "rules": [{"id": "x", "type": "Smurfing", "currency": "USD", "window_hours": 24}]}`},
+		{"bad id", `{"version": "1", "rules": [{"id": "Has Space", "type": "HighRiskJurisdiction", "currency": "USD", "countries": ["KP"]}]}`},
+		{"margin above threshold", `{"version": "1", "rules": [{"id": "s", "type": "Structuring", "currency": "USD", "window_hours": 24, "threshold": 1000, "margin": 1000, "min_count": 2}]}`},
+		{"single structured transaction", `{"version": "1", "rules": [{"id": "s", "type": "Structuring", "currency": "USD", "window_hours": 24, "threshold": 1000, "margin": 100, "min_count": 1}]}`},
+		{"no window", `{"version": "1", "rules": [{"id": "r", "type": "RapidMovement", "currency": "USD", "min_amount": 100, "outflow_percent": 90}]}`},
+		{"outflow over 100%", `{"version": "1", "rules": [{"id": "r", "type": "RapidMovement", "currency": "USD", "window_hours": 24, "min_amount": 100, "outflow_percent": 120}]}`},
+		{"lowercase country", `{"version": "1", "rules": [{"id": "h", "type": "HighRiskJurisdiction", "currency": "USD", "countries": ["kp"]}]}`},
+		{"baseline shorter than window", `{"version": "1", "rules": [{"id": "v", "type": "VolumeSpike", "currency": "USD", "window_hours": 72, "min_amount": 100, "baseline_days": 2, "spike_percent": 300}]}`},
+		{"spike not above baseline", `{"version": "1", "rules": [{"id": "v", "type": "VolumeSpike", "currency": "USD", "window_hours": 24, "min_amount": 100, "baseline_days": 30, "spike_percent": 100}]}`},
+		{"duplicate id", `{"version": "1", "rules": [{"id": "h", "type": "HighRiskJurisdiction", "currency": "USD", "countries": ["KP"]}, {"id": "h", "type": "HighRiskJurisdiction", "currency": "EUR", "countries": ["KP"]}]}`},
+		{"not json", `rules:`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseConfig([]byte(tt.doc)); err == nil {
+				t.Error("ParseConfig() expected error, got none")
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	structuring := Rule{ID: "structuring", Type: RuleStructuring, Currency: "USD", Window: 72 * time.Hour, Threshold: 1000000, Margin: 100000, MinCount: 2}
+	rapid := Rule{ID: "rapid", Type: RuleRapidMovement, Currency: "USD", Window: 48 * time.Hour, MinAmount: 500000, OutflowPercent: 90}
+	highRisk := Rule{ID: "high-risk", Type: RuleHighRiskJurisdiction, Currency: "USD", Window: 168 * time.Hour, MinAmount: 100000, Countries: []string{"KP", "IR"}}
+	spike := Rule{ID: "spike", Type: RuleVolumeSpike, Currency: "USD", Window: 24 * time.Hour, Baseline: 10 * 24 * time.Hour, MinAmount: 1000000, SpikePercent: 500}
+
+	withCountry := func(e Event, country string) Event {
+		e.CounterpartyCountry = country
+		return e
+	}
+
+	tests := []struct {
+		name     string
+		rule     Rule
+		history  []Event
+		event    Event
+		lastHits map[string]time.Time
+		want     []uuid.UUID // Evidence; nil when the rule must not fire
+	}{
+		{
+			name:    "structuring",
+			rule:    structuring,
+			history: []Event{ev(1, DirectionIn, 950000, 0), ev(2, DirectionIn, 20000, 1), ev(3, DirectionOut, 990000, 2)},
+			event:   ev(4, DirectionIn, 920000, 30),
+			want:    ids(1, 4),
+		},
+		{
+			name:    "structuring under the threshold in total",
+			rule:    Rule{ID: "structuring", Type: RuleStructuring, Currency: "USD", Window: 72 * time.Hour, Threshold: 1000000, Margin: 600000, MinCount: 2},
+			history: []Event{ev(1, DirectionIn, 450000, 0)},
+			event:   ev(2, DirectionIn, 450000, 1),
+		},
+		{
+			name:    "structuring outside the window",
+			rule:    structuring,
+			history: []Event{ev(1, DirectionIn, 950000, 0)},
+			event:   ev(2, DirectionIn, 950000, 73),
+		},
+		{
+			name:    "structuring at the window edge",
+			rule:    structuring,
+			history: []Event{ev(1, DirectionIn, 950000, 0)},
+			event:   ev(2, DirectionIn, 950000, 72),
+			want:    ids(1, 2),
+		},
+		{
+			name:    "structuring at the threshold",
+			rule:    structuring,
+			history: []Event{ev(1, DirectionIn, 950000, 0)},
+			event:   ev(2, DirectionIn, 1000000, 1),
+		},
+		{
+			name:    "rapid movement",
+			rule:    rapid,
+			history: []Event{ev(1, DirectionOut, 300000, 0), ev(2, DirectionIn, 800000, 1), ev(3, DirectionOut, 400000, 5)},
+			event:   ev(4, DirectionOut, 330000, 20),
+			want:    ids(2, 3, 4),
+		},
+		{
+			name:    "rapid movement below the outflow share",
+			rule:    rapid,
+			history: []Event{ev(1, DirectionIn, 800000, 1)},
+			event:   ev(2, DirectionOut, 700000, 20),
+		},
+		{
+			name:    "rapid movement on an inflow",
+			rule:    rapid,
+			history: []Event{ev(1, DirectionOut, 800000, 1)},
+			event:   ev(2, DirectionIn, 800000, 2),
+		},
+		{
+			name:  "high-risk jurisdiction",
+			rule:  highRisk,
+			event: withCountry(ev(1, DirectionOut, 150000, 0), "IR"),
+			want:  ids(1),
+		},
+		{
+			name:    "high-risk jurisdiction adding up",
+			rule:    highRisk,
+			history: []Event{withCountry(ev(1, DirectionIn, 60000, 0), "KP"), withCountry(ev(2, DirectionIn, 500000, 1), "GB")},
+			event:   withCountry(ev(3, DirectionOut, 60000, 100), "IR"),
+			want:    ids(1, 3),
+		},
+		{
+			name:    "high-risk jurisdiction with another country",
+			rule:    highRisk,
+			history: []Event{withCountry(ev(1, DirectionIn, 500000, 0), "KP")},
+			event:   withCountry(ev(2, DirectionOut, 500000, 1), "DE"),
+		},
+		{
+			name: "volume spike",
+			rule: spike,
+			// 1,000,000 over the ten days before the window is 100,000 a day
+			history: []Event{ev(1, DirectionIn, 600000, 0), ev(2, DirectionOut, 400000, 100), ev(3, DirectionIn, 700000, 250)},
+			event:   ev(4, DirectionOut, 800000, 260),
+			want:    ids(3, 4),
+		},
+		{
+			name:    "volume spike below the multiple",
+			rule:    spike,
+			history: []Event{ev(1, DirectionIn, 4000000, 0), ev(2, DirectionIn, 700000, 250)},
+			event:   ev(3, DirectionOut, 800000, 260),
+		},
+		{
+			name:  "volume spike without a baseline",
+			rule:  spike,
+			event: ev(1, DirectionIn, 5000000, 0),
+		},
+		{
+			name:     "suppressed within the window of the last alert",
+			rule:     structuring,
+			history:  []Event{ev(1, DirectionIn, 950000, 0)},
+			event:    ev(2, DirectionIn, 950000, 1),
+			lastHits: map[string]time.Time{"structuring": start.Add(-70 * time.Hour)},
+		},
+		{
+			name:     "raised again once the window has passed",
+			rule:     structuring,
+			history:  []Event{ev(1, DirectionIn, 950000, 0)},
+			event:    ev(2, DirectionIn, 950000, 1),
+			lastHits: map[string]time.Time{"structuring": start.Add(-71 * time.Hour)},
+			want:     ids(1, 2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Version: "test", Rules: []Rule{tt.rule}}
+			if err := cfg.Validate(); err != nil {
+				t.Fatalf("invalid rule: %v", err)
+			}
+			hits := cfg.Evaluate(tt.event, History{Events: tt.history, LastHits: tt.lastHits})
+			if tt.want == nil {
+				if len(hits) != 0 {
+					t.Errorf("Evaluate() = %+v, want no hits", hits)
+				}
+				return
+			}
+			if len(hits) != 1 {
+				t.Fatalf("Evaluate() = %+v, want one hit", hits)
+			}
+			if hits[0].RuleID != tt.rule.ID || hits[0].RuleType != tt.rule.Type || hits[0].Summary == "" {
+				t.Errorf("Evaluate() hit = %+v", hits[0])
+			}
+			if !reflect.DeepEqual(hits[0].Evidence, tt.want) {
+				t.Errorf("Evaluate() evidence = %v, want %v", hits[0].Evidence, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_OtherCurrency(t *testing.T) {
+	cfg := &Config{Version: "test", Rules: []Rule{
+		{ID: "high-risk", Type: RuleHighRiskJurisdiction, Currency: "EUR", Countries: []string{"KP"}},
+	}}
+	event := ev(1, DirectionOut, 100000, 0)
+	event.CounterpartyCountry = "KP"
+	if hits := cfg.Evaluate(event, History{}); len(hits) != 0 {
+		t.Errorf("Evaluate() = %+v, want no hits for a USD event", hits)
+	}
+}
+
+func TestBacktest(t *testing.T) {
+	cfg, err := LoadConfig("../../config/aml_rules.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open("testdata/events.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	events, err := ReadEventsCSV(f)
+	if err != nil {
+		t.Fatalf("ReadEventsCSV() error = %v", err)
+	}
+
+	result := Backtest(cfg, events, time.Time{})
+	if result.Events != len(events) || result.Customers != 3 {
+		t.Errorf("Backtest() tested %d events of %d customers", result.Events, result.Customers)
+	}
+	want := map[string]int{
+		"structuring-usd":            1, // The third deposit within the window is suppressed
+		"rapid-movement-usd":         1,
+		"high-risk-jurisdiction-usd": 1,
+	}
+	if got := result.RuleCounts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("RuleCounts() = %v, want %v", got, want)
+	}
+
+	// Testing from the day after the first two deposits only uses them as
+	// history, so the alert moves to the deposit that completes the pattern
+	// within the tested period
+	from := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	later := Backtest(cfg, events, from)
+	if later.RuleCounts()["structuring-usd"] != 1 {
+		t.Errorf("Backtest() from %s = %v", from.Format("2006-01-02"), later.RuleCounts())
+	}
+	for _, hit := range later.Hits {
+		if hit.At.Before(from) {
+			t.Errorf("Backtest() reported %s at %v before %v", hit.RuleID, hit.At, from)
+		}
+	}
+
+	var report bytes.Buffer
+	if err := result.WriteReport(&report, cfg, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"rules version 2024.1", "structuring-usd", "volume-spike-usd", "Alerts:"} {
+		if !strings.Contains(report.String(), want) {
+			t.Errorf("report does not contain %q:\n%s", want, report.String())
+		}
+	}
+}
+
+func TestReadEventsCSV_Invalid(t *testing.T) {
+	header := "id,sequence,customer_id,account_id,direction,amount,currency,counterparty_country,occurred_at\n"
+	row := "00000000-0000-0000-0000-000000000001,1,00000000-0000-0000-0000-00000000c001,00000000-0000-0000-0000-00000000a001,%s,%s,USD,,2024-03-01T09:00:00Z\n"
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"missing column", "id,sequence\n"},
+		{"bad direction", header + fmt.Sprintf(row, "Sideways", "100")},
+		{"negative amount", header + fmt.Sprintf(row, "In", "-100")},
+		{"decimal amount", header + fmt.Sprintf(row, "In", "1.00")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadEventsCSV(strings.NewReader(tt.data)); err == nil {
+				t.Error("ReadEventsCSV() expected error, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package aml
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BacktestHit is an alert the rules would have raised
+type BacktestHit struct {
+	Hit
+	CustomerID uuid.UUID
+	EventID    uuid.UUID
+	At         time.Time
+}
+
+// BacktestResult is the outcome of running rules over historical events
+type BacktestResult struct {
+	Version   string
+	Events    int
+	Customers int
+	Hits      []BacktestHit
+}
+
+// Backtest replays events through the rules in posting order, keeping a
+// sliding window of each customer's history, and returns the alerts that
+// would have been raised. Events before from only fill the windows, so that
+// rules see the history leading up to the period tested; a zero from tests
+// every event. Nothing is written anywhere.
+func Backtest(cfg *Config, events []Event, from time.Time) *BacktestResult {
+	ordered := make([]Event, len(events))
+	copy(ordered, events)
+	sortEvents(ordered)
+
+	lookback := cfg.Lookback()
+	histories := make(map[uuid.UUID]*History)
+	customers := make(map[uuid.UUID]bool)
+	result := &BacktestResult{Version: cfg.Version}
+
+	for _, event := range ordered {
+		history, ok := histories[event.CustomerID]
+		if !ok {
+			history = &History{LastHits: make(map[string]time.Time)}
+			histories[event.CustomerID] = history
+		}
+
+		// Slide the window: drop what no rule can look back to any more
+		start := event.OccurredAt.Add(-lookback)
+		drop := 0
+		for drop < len(history.Events) && history.Events[drop].OccurredAt.Before(start) {
+			drop++
+		}
+		history.Events = history.Events[drop:]
+
+		if event.OccurredAt.Before(from) {
+			history.Events = append(history.Events, event)
+			continue
+		}
+		result.Events++
+		customers[event.CustomerID] = true
+
+		for _, hit := range cfg.Evaluate(event, *history) {
+			history.LastHits[hit.RuleID] = event.OccurredAt
+			result.Hits = append(result.Hits, BacktestHit{
+				Hit:        hit,
+				CustomerID: event.CustomerID,
+				EventID:    event.ID,
+				At:         event.OccurredAt,
+			})
+		}
+		history.Events = append(history.Events, event)
+	}
+
+	result.Customers = len(customers)
+	return result
+}
+
+// RuleCounts returns how many alerts each rule raised, keyed by rule ID
+func (r *BacktestResult) RuleCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, hit := range r.Hits {
+		counts[hit.RuleID]++
+	}
+	return counts
+}
+
+// WriteReport writes a plain text summary of the result, followed by every
+// alert when detailed is set
+func (r *BacktestResult) WriteReport(w io.Writer, cfg *Config, detailed bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "AML backtest of rules version %s\n", r.Version)
+	fmt.Fprintf(tw, "Events:\t%d\nCustomers:\t%d\nAlerts:\t%d\n\n", r.Events, r.Customers, len(r.Hits))
+
+	counts := r.RuleCounts()
+	fmt.Fprintln(tw, "RULE\tTYPE\tALERTS\tCUSTOMERS")
+	for _, rule := range cfg.Rules {
+		customers := make(map[uuid.UUID]bool)
+		for _, hit := range r.Hits {
+			if hit.RuleID == rule.ID {
+				customers[hit.CustomerID] = true
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\n", rule.ID, rule.Type, counts[rule.ID], len(customers))
+	}
+
+	if detailed && len(r.Hits) > 0 {
+		fmt.Fprintln(tw, "\nTIME\tRULE\tCUSTOMER\tEVIDENCE\tSUMMARY")
+		for _, hit := range r.Hits {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\n",
+				hit.At.UTC().Format(time.RFC3339), hit.RuleID, hit.CustomerID, len(hit.Evidence), hit.Summary)
+		}
+	}
+
+	return tw.Flush()
+}
+
+// csvHeader is the column layout of an event export
+var csvHeader = []string{
+	"id", "sequence", "customer_id", "account_id", "direction", "amount",
+	"currency", "counterparty_country", "occurred_at",
+}
+
+// ReadEventsCSV reads events exported as CSV with a header row naming the
+// columns in csvHeader, in any order. Times are RFC 3339.
+func ReadEventsCSV(r io.Reader) ([]Event, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range csvHeader {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("event file has no %s column", name)
+		}
+	}
+
+	var events []Event
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		event, err := parseEventRecord(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func parseEventRecord(record []string, columns map[string]int) (Event, error) {
+	field := func(name string) string {
+		return strings.TrimSpace(record[columns[name]])
+	}
+
+	var event Event
+	var err error
+	if event.ID, err = uuid.Parse(field("id")); err != nil {
+		return Event{}, fmt.Errorf("invalid id: %w", err)
+	}
+	if event.Sequence, err = strconv.ParseInt(field("sequence"), 10, 64); err != nil {
+		return Event{}, fmt.Errorf("invalid sequence: %w", err)
+	}
+	if event.CustomerID, err = uuid.Parse(field("customer_id")); err != nil {
+		return Event{}, fmt.Errorf("invalid customer_id: %w", err)
+	}
+	if event.AccountID, err = uuid.Parse(field("account_id")); err != nil {
+		return Event{}, fmt.Errorf("invalid account_id: %w", err)
+	}
+	event.Direction = Direction(field("direction"))
+	if event.Direction != DirectionIn && event.Direction != DirectionOut {
+		return Event{}, fmt.Errorf("direction must be In or Out, got %q", event.Direction)
+	}
+	if event.Amount, err = strconv.ParseInt(field("amount"), 10, 64); err != nil || event.Amount <= 0 {
+		return Event{}, fmt.Errorf("amount must be a positive number of minor units, got %q", field("amount"))
+	}
+	event.Currency = field("currency")
+	if !currencyRegex.MatchString(event.Currency) {
+		return Event{}, fmt.Errorf("invalid currency %q", event.Currency)
+	}
+	event.CounterpartyCountry = field("counterparty_country")
+	if event.CounterpartyCountry != "" && !countryRegex.MatchString(event.CounterpartyCountry) {
+		return Event{}, fmt.Errorf("invalid counterparty_country %q", event.CounterpartyCountry)
+	}
+	if event.OccurredAt, err = time.Parse(time.RFC3339, field("occurred_at")); err != nil {
+		return Event{}, fmt.Errorf("invalid occurred_at: %w", err)
+	}
+	return event, nil
+}
@@ -0,0 +1,257 @@
+package aml
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Direction is whether funds entered or left the customer's account
+type Direction string
+
+const (
+	DirectionIn  Direction = "In"
+	DirectionOut Direction = "Out"
+)
+
+// Event is one posted transaction from the point of view of one customer
+type Event struct {
+	ID                  uuid.UUID
+	Sequence            int64 // Posting order; breaks ties between events at the same time
+	CustomerID          uuid.UUID
+	AccountID           uuid.UUID
+	Direction           Direction
+	Amount              int64 // Minor units, positive
+	Currency            string
+	CounterpartyCountry string // ISO 3166 alpha-2; empty when unknown
+	OccurredAt          time.Time
+}
+
+// before reports whether e was posted before other
+func (e *Event) before(other *Event) bool {
+	if !e.OccurredAt.Equal(other.OccurredAt) {
+		return e.OccurredAt.Before(other.OccurredAt)
+	}
+	return e.Sequence < other.Sequence
+}
+
+// History is what the engine knows about a customer when an event arrives:
+// the customer's earlier events within the lookback and when each rule last
+// raised an alert for them
+type History struct {
+	Events   []Event
+	LastHits map[string]time.Time
+}
+
+// Hit is a rule that fired on an event
+type Hit struct {
+	RuleID   string
+	RuleType RuleType
+	Summary  string
+	// Evidence are the events the rule saw, the triggering event included,
+	// oldest first
+	Evidence []uuid.UUID
+}
+
+// Evaluate checks every rule against an event and the customer's history
+// and returns the rules that fired. A rule that already raised an alert for
+// the customer within its window of the event stays quiet, so one episode
+// raises one alert rather than one per transaction.
+func (c *Config) Evaluate(event Event, history History) []Hit {
+	var hits []Hit
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.Currency != event.Currency {
+			continue
+		}
+		if last, ok := history.LastHits[rule.ID]; ok && event.OccurredAt.Sub(last) < max(rule.Window, time.Nanosecond) {
+			continue
+		}
+
+		var hit *Hit
+		switch rule.Type {
+		case RuleStructuring:
+			hit = rule.structuring(event, history.Events)
+		case RuleRapidMovement:
+			hit = rule.rapidMovement(event, history.Events)
+		case RuleHighRiskJurisdiction:
+			hit = rule.highRiskJurisdiction(event, history.Events)
+		case RuleVolumeSpike:
+			hit = rule.volumeSpike(event, history.Events)
+		}
+		if hit != nil {
+			hit.RuleID = rule.ID
+			hit.RuleType = rule.Type
+			hits = append(hits, *hit)
+		}
+	}
+	return hits
+}
+
+// window returns the events of the rule's currency in the window ending at
+// the event, the event included, oldest first
+func (r *Rule) window(event Event, events []Event, from time.Time) []Event {
+	selected := []Event{event}
+	for _, e := range events {
+		if e.ID == event.ID || e.Currency != r.Currency || !e.before(&event) || e.OccurredAt.Before(from) {
+			continue
+		}
+		selected = append(selected, e)
+	}
+	sortEvents(selected)
+	return selected
+}
+
+// windowStart is the earliest time an event counts towards the rule's window.
+// The window is closed at both ends.
+func (r *Rule) windowStart(event Event) time.Time {
+	return event.OccurredAt.Add(-r.Window)
+}
+
+func (r *Rule) structuring(event Event, events []Event) *Hit {
+	justUnder := func(e Event) bool {
+		return e.Amount < r.Threshold && e.Amount >= r.Threshold-r.Margin
+	}
+	if !justUnder(event) {
+		return nil
+	}
+
+	var evidence []Event
+	var total int64
+	for _, e := range r.window(event, events, r.windowStart(event)) {
+		if e.Direction == event.Direction && justUnder(e) {
+			evidence = append(evidence, e)
+			total += e.Amount
+		}
+	}
+	if len(evidence) < r.MinCount || total < r.Threshold {
+		return nil
+	}
+
+	return &Hit{
+		Summary: fmt.Sprintf("%d %s transactions just under %d totalling %d within %s",
+			len(evidence), directionNoun(event.Direction), r.Threshold, total, formatWindow(r.Window)),
+		Evidence: eventIDs(evidence),
+	}
+}
+
+func (r *Rule) rapidMovement(event Event, events []Event) *Hit {
+	if event.Direction != DirectionOut {
+		return nil
+	}
+
+	var evidence []Event
+	var inflow, outflow int64
+	for _, e := range r.window(event, events, r.windowStart(event)) {
+		switch {
+		case e.Direction == DirectionIn:
+			inflow += e.Amount
+		case inflow > 0:
+			// Only what leaves after funds arrived counts as moving them on
+			outflow += e.Amount
+		default:
+			continue
+		}
+		evidence = append(evidence, e)
+	}
+	if inflow < r.MinAmount || outflow*100 < inflow*r.OutflowPercent {
+		return nil
+	}
+
+	return &Hit{
+		Summary: fmt.Sprintf("%d received and %d (%d%%) sent on within %s",
+			inflow, outflow, outflow*100/inflow, formatWindow(r.Window)),
+		Evidence: eventIDs(evidence),
+	}
+}
+
+func (r *Rule) highRiskJurisdiction(event Event, events []Event) *Hit {
+	if !slices.Contains(r.Countries, event.CounterpartyCountry) {
+		return nil
+	}
+
+	var evidence []Event
+	var total int64
+	for _, e := range r.window(event, events, r.windowStart(event)) {
+		if slices.Contains(r.Countries, e.CounterpartyCountry) {
+			evidence = append(evidence, e)
+			total += e.Amount
+		}
+	}
+	if total < r.MinAmount {
+		return nil
+	}
+
+	summary := fmt.Sprintf("%d with counterparty in %s", event.Amount, event.CounterpartyCountry)
+	if len(evidence) > 1 {
+		summary = fmt.Sprintf("%d transactions totalling %d with high-risk jurisdictions within %s",
+			len(evidence), total, formatWindow(r.Window))
+	}
+	return &Hit{Summary: summary, Evidence: eventIDs(evidence)}
+}
+
+// volumeSpike compares the volume in the window with the average volume per
+// window over the baseline period before it. Customers with no activity in
+// the baseline period have no baseline and are not checked.
+func (r *Rule) volumeSpike(event Event, events []Event) *Hit {
+	windowStart := r.windowStart(event)
+	baselineStart := windowStart.Add(-r.Baseline)
+
+	var evidence []Event
+	var volume, baseline int64
+	for _, e := range r.window(event, events, baselineStart) {
+		if e.OccurredAt.Before(windowStart) {
+			baseline += e.Amount
+			continue
+		}
+		evidence = append(evidence, e)
+		volume += e.Amount
+	}
+	if baseline == 0 || volume < r.MinAmount {
+		return nil
+	}
+
+	// volume / (baseline * window / period) >= spike / 100, kept in integers
+	periods := int64(r.Baseline / r.Window)
+	if volume*periods*100 < baseline*r.SpikePercent {
+		return nil
+	}
+
+	return &Hit{
+		Summary: fmt.Sprintf("volume %d within %s against an average of %d over the previous %s",
+			volume, formatWindow(r.Window), baseline/periods, formatWindow(r.Baseline)),
+		Evidence: eventIDs(evidence),
+	}
+}
+
+func sortEvents(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].before(&events[j])
+	})
+}
+
+func eventIDs(events []Event) []uuid.UUID {
+	ids := make([]uuid.UUID, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+	return ids
+}
+
+func directionNoun(d Direction) string {
+	if d == DirectionIn {
+		return "incoming"
+	}
+	return "outgoing"
+}
+
+func formatWindow(d time.Duration) string {
+	hours := int(d.Hours())
+	if hours%24 == 0 {
+		return fmt.Sprintf("%d days", hours/24)
+	}
+	return fmt.Sprintf("%d hours", hours)
+}
@@ -0,0 +1,242 @@
+package aml
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+)
+
+// RuleType is a pattern of activity the engine looks for
+type RuleType string
+
+const (
+	// RuleStructuring flags several transactions each just under a reporting
+	// threshold that together reach it within the window
+	RuleStructuring RuleType = "Structuring"
+	// RuleRapidMovement flags funds that leave a customer's accounts soon
+	// after they arrived
+	RuleRapidMovement RuleType = "RapidMovement"
+	// RuleHighRiskJurisdiction flags transactions with counterparties in
+	// listed countries
+	RuleHighRiskJurisdiction RuleType = "HighRiskJurisdiction"
+	// RuleVolumeSpike flags volume within the window far above the
+	// customer's average over the baseline period before it
+	RuleVolumeSpike RuleType = "VolumeSpike"
+)
+
+// IsValid checks if the rule type is supported
+func (t RuleType) IsValid() bool {
+	switch t {
+	case RuleStructuring, RuleRapidMovement, RuleHighRiskJurisdiction, RuleVolumeSpike:
+		return true
+	}
+	return false
+}
+
+var (
+	ruleIDRegex   = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+	currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryRegex  = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// maxWindow bounds rule windows and baselines; the monitor loads a
+// customer's history over the longest of them for every event
+const maxWindow = 366 * 24 * time.Hour
+
+// Rule is one monitoring scenario. Amounts are in minor units of the rule's
+// currency and the rule only sees transactions in that currency.
+type Rule struct {
+	ID          string
+	Type        RuleType
+	Description string
+	Currency    string
+	// Window is how far back from each transaction the rule looks
+	Window time.Duration
+	// Threshold is the reporting threshold structuring stays under
+	Threshold int64
+	// Margin is how far under the threshold an amount counts as just under
+	Margin int64
+	// MinCount is how many transactions just under the threshold it takes
+	MinCount int
+	// MinAmount is the least inflow for rapid movement, the least total
+	// with high-risk countries, or the least volume for a spike
+	MinAmount int64
+	// OutflowPercent is the share of the inflow that must leave again
+	OutflowPercent int64
+	// Countries are ISO 3166 alpha-2 codes of high-risk jurisdictions
+	Countries []string
+	// Baseline is the period before the window a spike is measured against
+	Baseline time.Duration
+	// SpikePercent is how large window volume must be against the average
+	// baseline volume per window, e.g. 500 for five times
+	SpikePercent int64
+}
+
+// Validate checks the rule is complete and consistent for its type
+func (r *Rule) Validate() error {
+	if !ruleIDRegex.MatchString(r.ID) {
+		return fmt.Errorf("rule id %q must be lowercase letters, digits, '-' or '_'", r.ID)
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("rule %s: invalid type: %s", r.ID, r.Type)
+	}
+	if !currencyRegex.MatchString(r.Currency) {
+		return fmt.Errorf("rule %s: currency must be a 3-letter ISO 4217 code", r.ID)
+	}
+	if r.Window < 0 || r.Window > maxWindow {
+		return fmt.Errorf("rule %s: window must be between 0 and %d hours", r.ID, int(maxWindow.Hours()))
+	}
+	if r.Type != RuleHighRiskJurisdiction && r.Window == 0 {
+		return fmt.Errorf("rule %s: window is required", r.ID)
+	}
+
+	switch r.Type {
+	case RuleStructuring:
+		if r.Threshold <= 0 {
+			return fmt.Errorf("rule %s: threshold must be positive", r.ID)
+		}
+		if r.Margin <= 0 || r.Margin >= r.Threshold {
+			return fmt.Errorf("rule %s: margin must be positive and below the threshold", r.ID)
+		}
+		if r.MinCount < 2 {
+			return fmt.Errorf("rule %s: min_count must be at least 2", r.ID)
+		}
+	case RuleRapidMovement:
+		if r.MinAmount <= 0 {
+			return fmt.Errorf("rule %s: min_amount must be positive", r.ID)
+		}
+		if r.OutflowPercent <= 0 || r.OutflowPercent > 100 {
+			return fmt.Errorf("rule %s: outflow_percent must be between 1 and 100", r.ID)
+		}
+	case RuleHighRiskJurisdiction:
+		if len(r.Countries) == 0 {
+			return fmt.Errorf("rule %s: countries are required", r.ID)
+		}
+		for _, country := range r.Countries {
+			if !countryRegex.MatchString(country) {
+				return fmt.Errorf("rule %s: invalid country %q", r.ID, country)
+			}
+		}
+		if r.MinAmount < 0 {
+			return fmt.Errorf("rule %s: min_amount must not be negative", r.ID)
+		}
+	case RuleVolumeSpike:
+		if r.MinAmount <= 0 {
+			return fmt.Errorf("rule %s: min_amount must be positive", r.ID)
+		}
+		if r.Baseline < r.Window || r.Window+r.Baseline > maxWindow {
+			return fmt.Errorf("rule %s: baseline must cover at least the window and end within %d hours", r.ID, int(maxWindow.Hours()))
+		}
+		if r.SpikePercent <= 100 {
+			return fmt.Errorf("rule %s: spike_percent must be above 100", r.ID)
+		}
+	}
+	return nil
+}
+
+// Lookback is how far back from a transaction the rule needs history
+func (r *Rule) Lookback() time.Duration {
+	return r.Window + r.Baseline
+}
+
+// Config is a versioned set of rules. Every alert records the version of the
+// rules that raised it.
+type Config struct {
+	Version string
+	Rules   []Rule
+}
+
+// Validate checks every rule and that rule IDs are unique
+func (c *Config) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("aml rules have no version")
+	}
+	if len(c.Version) > 64 {
+		return fmt.Errorf("aml rules version must not exceed 64 characters")
+	}
+	if len(c.Rules) == 0 {
+		return fmt.Errorf("no aml rules")
+	}
+	ids := make(map[string]bool, len(c.Rules))
+	for i := range c.Rules {
+		if err := c.Rules[i].Validate(); err != nil {
+			return err
+		}
+		if ids[c.Rules[i].ID] {
+			return fmt.Errorf("duplicate rule id %s", c.Rules[i].ID)
+		}
+		ids[c.Rules[i].ID] = true
+	}
+	return nil
+}
+
+// Lookback is how far back from a transaction any rule needs history
+func (c *Config) Lookback() time.Duration {
+	var lookback time.Duration
+	for i := range c.Rules {
+		lookback = max(lookback, c.Rules[i].Lookback())
+	}
+	return lookback
+}
+
+// ruleFile is the on-disk JSON representation of Rule
+type ruleFile struct {
+	ID             string   `json:"id"`
+	Type           RuleType `json:"type"`
+	Description    string   `json:"description"`
+	Currency       string   `json:"currency"`
+	WindowHours    int      `json:"window_hours"`
+	Threshold      int64    `json:"threshold"`
+	Margin         int64    `json:"margin"`
+	MinCount       int      `json:"min_count"`
+	MinAmount      int64    `json:"min_amount"`
+	OutflowPercent int64    `json:"outflow_percent"`
+	Countries      []string `json:"countries"`
+	BaselineDays   int      `json:"baseline_days"`
+	SpikePercent   int64    `json:"spike_percent"`
+}
+
+// LoadConfig reads and validates an AML rules file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aml rules: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates an AML rules document
+func ParseConfig(data []byte) (*Config, error) {
+	var file struct {
+		Version string     `json:"version"`
+		Rules   []ruleFile `json:"rules"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse aml rules: %w", err)
+	}
+
+	cfg := &Config{Version: file.Version}
+	for _, r := range file.Rules {
+		cfg.Rules = append(cfg.Rules, Rule{
+			ID:             r.ID,
+			Type:           r.Type,
+			Description:    r.Description,
+			Currency:       r.Currency,
+			Window:         time.Duration(r.WindowHours) * time.Hour,
+			Threshold:      r.Threshold,
+			Margin:         r.Margin,
+			MinCount:       r.MinCount,
+			MinAmount:      r.MinAmount,
+			OutflowPercent: r.OutflowPercent,
+			Countries:      r.Countries,
+			Baseline:       time.Duration(r.BaselineDays) * 24 * time.Hour,
+			SpikePercent:   r.SpikePercent,
+		})
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
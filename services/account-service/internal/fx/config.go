@@ -0,0 +1,91 @@
+package fx
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Defaults used when no configuration file is given
+const (
+	DefaultQuoteTTL           = 30 * time.Second
+	DefaultSpread      Spread = 50
+	maxQuoteTTLSeconds        = 3600
+)
+
+// Config holds the pricing of conversions
+type Config struct {
+	// QuoteTTL is how long a customer has to execute a quote
+	QuoteTTL time.Duration
+	// DefaultSpread applies to accounts whose segment has no spread of its own
+	DefaultSpread Spread
+	// SegmentSpreads are keyed by customer segment
+	SegmentSpreads map[string]Spread
+}
+
+// DefaultConfig returns the pricing used when no configuration file is given
+func DefaultConfig() *Config {
+	return &Config{
+		QuoteTTL:      DefaultQuoteTTL,
+		DefaultSpread: DefaultSpread,
+	}
+}
+
+// Spread returns the spread charged to a customer segment
+func (c *Config) Spread(segment string) Spread {
+	if spread, ok := c.SegmentSpreads[segment]; ok && segment != "" {
+		return spread
+	}
+	return c.DefaultSpread
+}
+
+// configFile is the on-disk JSON representation of Config
+type configFile struct {
+	QuoteTTLSeconds   *int              `json:"quote_ttl_seconds"`
+	DefaultSpreadBps  *int              `json:"default_spread_bps"`
+	SegmentSpreadsBps map[string]Spread `json:"segment_spreads_bps"`
+}
+
+// LoadConfig reads and validates an FX configuration file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fx config: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates an FX configuration document. Settings
+// left out keep their defaults.
+func ParseConfig(data []byte) (*Config, error) {
+	var file configFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse fx config: %w", err)
+	}
+
+	cfg := DefaultConfig()
+	if file.QuoteTTLSeconds != nil {
+		if *file.QuoteTTLSeconds <= 0 || *file.QuoteTTLSeconds > maxQuoteTTLSeconds {
+			return nil, fmt.Errorf("quote_ttl_seconds must be between 1 and %d", maxQuoteTTLSeconds)
+		}
+		cfg.QuoteTTL = time.Duration(*file.QuoteTTLSeconds) * time.Second
+	}
+	if file.DefaultSpreadBps != nil {
+		cfg.DefaultSpread = Spread(*file.DefaultSpreadBps)
+		if !cfg.DefaultSpread.IsValid() {
+			return nil, fmt.Errorf("default_spread_bps must be between 0 and %d", MaxSpread-1)
+		}
+	}
+	for segment, spread := range file.SegmentSpreadsBps {
+		if segment == "" {
+			return nil, fmt.Errorf("segment_spreads_bps has an empty segment")
+		}
+		if !spread.IsValid() {
+			return nil, fmt.Errorf("segment %s spread must be between 0 and %d bps", segment, MaxSpread-1)
+		}
+	}
+	cfg.SegmentSpreads = file.SegmentSpreadsBps
+
+	return cfg, nil
+}
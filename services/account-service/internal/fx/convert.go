@@ -0,0 +1,111 @@
+package fx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/core-banking/services/account-service/internal/statement"
+)
+
+// exactDecimals is the precision an unrounded amount is recorded with
+const exactDecimals = 12
+
+// ErrConversion is returned when an amount cannot be converted: it rounds to
+// nothing or overflows
+var ErrConversion = errors.New("amount cannot be converted")
+
+// CustomerRate applies the spread to a mid rate. When the rate table quotes
+// the pair the other way round the mid rate is inverted first. The result is
+// rounded down to a whole Rate unit, so the customer never gets more than
+// the spread allows.
+func CustomerRate(mid Rate, inverted bool, spread Spread) (Rate, error) {
+	if mid <= 0 || !spread.IsValid() {
+		return 0, fmt.Errorf("%w: mid %s with spread %d", ErrConversion, mid, spread)
+	}
+	r := mid.Rat()
+	if inverted {
+		r.Inv(r)
+	}
+	r.Mul(r, big.NewRat(int64(MaxSpread-spread), int64(MaxSpread)))
+	r.Mul(r, big.NewRat(RateScale, 1))
+
+	units := floor(r)
+	if units.Sign() <= 0 || !units.IsInt64() {
+		return 0, fmt.Errorf("%w: rate %s out of range", ErrConversion, r.FloatString(rateDecimals))
+	}
+	return Rate(units.Int64()), nil
+}
+
+// Conversion is an amount converted at a customer rate. One side is the
+// amount asked for; the other is computed from it and rounded to a minor
+// unit, and Exact is that side before rounding.
+type Conversion struct {
+	SourceAmount int64
+	TargetAmount int64
+	Exact        *big.Rat
+}
+
+// ExactString formats the unrounded amount, in minor units, to twelve decimal places
+func (c Conversion) ExactString() string {
+	return c.Exact.FloatString(exactDecimals)
+}
+
+// ConvertSource converts an amount of the source currency, in its minor
+// units, into the target currency. The target amount is rounded down.
+func ConvertSource(source int64, from, to string, rate Rate) (Conversion, error) {
+	exact := new(big.Rat).Mul(big.NewRat(source, 1), minorRate(from, to, rate))
+	target := floor(exact)
+	if source <= 0 || target.Sign() <= 0 || !target.IsInt64() {
+		return Conversion{}, fmt.Errorf("%w: %d %s at %s", ErrConversion, source, from, rate)
+	}
+	return Conversion{SourceAmount: source, TargetAmount: target.Int64(), Exact: exact}, nil
+}
+
+// ConvertTarget works out the amount of the source currency needed to
+// deliver an amount of the target currency, both in minor units. The source
+// amount is rounded up.
+func ConvertTarget(target int64, from, to string, rate Rate) (Conversion, error) {
+	if target <= 0 || rate <= 0 {
+		return Conversion{}, fmt.Errorf("%w: %d %s at %s", ErrConversion, target, to, rate)
+	}
+	exact := new(big.Rat).Quo(big.NewRat(target, 1), minorRate(from, to, rate))
+	source := ceil(exact)
+	if !source.IsInt64() {
+		return Conversion{}, fmt.Errorf("%w: %d %s at %s", ErrConversion, target, to, rate)
+	}
+	return Conversion{SourceAmount: source.Int64(), TargetAmount: target, Exact: exact}, nil
+}
+
+// minorRate turns a rate between major units into one between minor units
+func minorRate(from, to string, rate Rate) *big.Rat {
+	r := rate.Rat()
+	shift := statement.CurrencyExponent(to) - statement.CurrencyExponent(from)
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil)
+	if shift >= 0 {
+		return r.Mul(r, new(big.Rat).SetInt(pow))
+	}
+	return r.Quo(r, new(big.Rat).SetInt(pow))
+}
+
+// floor and ceil rely on big.Int division being Euclidean; a Rat's
+// denominator is always positive
+
+func floor(r *big.Rat) *big.Int {
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+func ceil(r *big.Rat) *big.Int {
+	q, m := new(big.Int).DivMod(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
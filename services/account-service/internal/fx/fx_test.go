@@ -0,0 +1,193 @@
+package fx
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Rate
+		wantErr bool
+	}{
+		{"1.0850", 10850000000, false},
+		{"151.2345", 1512345000000, false},
+		{"0.0000000001", 1, false},
+		{" 2 ", 20000000000, false},
+		{"0.00000000001", 0, true},
+		{"0", 0, true},
+		{"-1.2", 0, true},
+		{"1/3", 0, true},
+		{"1e3", 0, true},
+		{"abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseRate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseRate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if s := Rate(10850000000).String(); s != "1.0850000000" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestPair(t *testing.T) {
+	if Pair("USD", "EUR") != "EUR/USD" || Pair("EUR", "USD") != "EUR/USD" {
+		t.Errorf("Pair() = %s, %s; want EUR/USD both ways", Pair("USD", "EUR"), Pair("EUR", "USD"))
+	}
+}
+
+func TestCustomerRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		mid      Rate
+		inverted bool
+		spread   Spread
+		want     Rate
+	}{
+		{"no spread", 10850000000, false, 0, 10850000000},
+		{"50 bps", 10850000000, false, 50, 10795750000},
+		// 1/1.085 = 0.92165898617..., less 0.5%, rounded down
+		{"inverted", 10850000000, true, 50, 9170506912},
+		{"inverted without spread", 1512345000000, true, 0, 66122478}, // 0.0066122478
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CustomerRate(tt.mid, tt.inverted, tt.spread)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("CustomerRate() = %d (%s), want %d", got, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := CustomerRate(10850000000, false, MaxSpread); !errors.Is(err, ErrConversion) {
+		t.Errorf("CustomerRate() with a 100%% spread error = %v", err)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	rate := Rate(10795750000) // 1.079575
+
+	// 100.00 EUR -> 107.9575 USD, rounded down to 107.95
+	c, err := ConvertSource(10000, "EUR", "USD", rate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.TargetAmount != 10795 || c.ExactString() != "10795.750000000000" {
+		t.Errorf("ConvertSource() = %d (%s), want 10795", c.TargetAmount, c.ExactString())
+	}
+
+	// Delivering 107.96 USD takes 100.0016... EUR, rounded up to 100.01
+	c, err = ConvertTarget(10796, "EUR", "USD", rate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.SourceAmount != 10001 || c.Exact.Cmp(big.NewRat(10796*RateScale/100, 10795750000/100)) != 0 {
+		t.Errorf("ConvertTarget() = %d (%s), want 10001", c.SourceAmount, c.ExactString())
+	}
+
+	// Minor units differ: 1000 JPY at 0.0066122 USD is 6.6122 USD, 661 cents
+	c, err = ConvertSource(1000, "JPY", "USD", 66122000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.TargetAmount != 661 {
+		t.Errorf("ConvertSource(JPY) = %d, want 661", c.TargetAmount)
+	}
+	// and 6.61 USD at 151.2345 is 999.66 JPY, rounded down to 999
+	c, err = ConvertSource(661, "USD", "JPY", 1512345000000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.TargetAmount != 999 {
+		t.Errorf("ConvertSource(USD) = %d, want 999", c.TargetAmount)
+	}
+	// Three-decimal currencies keep their precision
+	c, err = ConvertTarget(1000, "USD", "KWD", 3070000000) // 1.000 KWD at 0.307
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.SourceAmount != 326 {
+		t.Errorf("ConvertTarget(KWD) = %d, want 326", c.SourceAmount)
+	}
+
+	if _, err := ConvertSource(1, "USD", "JPY", 50000000); !errors.Is(err, ErrConversion) {
+		t.Errorf("ConvertSource() of a sub-yen amount error = %v", err)
+	}
+}
+
+func TestParseTable(t *testing.T) {
+	want := []Entry{
+		{Base: "EUR", Quote: "USD", EffectiveFrom: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Mid: 10850000000},
+		{Base: "USD", Quote: "JPY", EffectiveFrom: time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC), Mid: 1512345000000},
+	}
+
+	csv := "\ufeffmid_rate,base,quote,effective_from\n1.085,eur,USD,2024-04-01\n151.2345,USD,JPY,2024-04-01T14:00:00+02:00\n"
+	json := `{"rates": [
+		{"base": "EUR", "quote": "USD", "effective_from": "2024-04-01", "mid_rate": "1.0850"},
+		{"base": "USD", "quote": "JPY", "effective_from": "2024-04-01T12:00:00Z", "mid_rate": "151.2345"}
+	]}`
+	for name, data := range map[string]string{"csv": csv, "json": json} {
+		got, err := ParseTable("", []byte(data))
+		if err != nil {
+			t.Fatalf("%s: ParseTable() error = %v", name, err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("%s: got %d entries, want %d", name, len(got), len(want))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("%s: entry %d = %+v, want %+v", name, i, got[i], want[i])
+			}
+		}
+	}
+
+	for name, data := range map[string]string{
+		"missing column": "base,quote,mid_rate\nEUR,USD,1.08\n",
+		"same currency":  "base,quote,effective_from,mid_rate\nEUR,EUR,2024-04-01,1\n",
+		"bad currency":   "base,quote,effective_from,mid_rate\nEURO,USD,2024-04-01,1\n",
+		"bad date":       "base,quote,effective_from,mid_rate\nEUR,USD,01/04/2024,1\n",
+		"twice":          "base,quote,effective_from,mid_rate\nEUR,USD,2024-04-01,1\nEUR,USD,2024-04-01,2\n",
+		"no rates":       `{"rates": []}`,
+		"unknown field":  `{"rates": [{"pair": "EUR/USD"}]}`,
+	} {
+		if _, err := ParseTable("", []byte(data)); !errors.Is(err, ErrInvalidTable) {
+			t.Errorf("%s: ParseTable() error = %v, want ErrInvalidTable", name, err)
+		}
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`{"quote_ttl_seconds": 10, "segment_spreads_bps": {"Private": 20, "Staff": 0}}`))
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+	if cfg.QuoteTTL != 10*time.Second || cfg.Spread("Private") != 20 || cfg.Spread("Staff") != 0 || cfg.Spread("") != DefaultSpread {
+		t.Errorf("ParseConfig() = %+v", cfg)
+	}
+
+	for _, doc := range []string{
+		`{"quote_ttl_seconds": 0}`,
+		`{"default_spread_bps": 10000}`,
+		`{"segment_spreads_bps": {"Private": -1}}`,
+		`{"segment_spreads_bps": {"": 5}}`,
+	} {
+		if _, err := ParseConfig([]byte(doc)); err == nil {
+			t.Errorf("ParseConfig(%s) error = nil", doc)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package fx converts amounts between currencies. Mid rates come from
+// effective-dated rate tables; the rate a customer gets is the mid rate less
+// a spread that depends on their segment. Every rounding is to a whole minor
+// unit of the currency concerned and always in the bank's favour, so that a
+// conversion can be recomputed exactly from the rate recorded with it.
+package fx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Rate is an exchange rate, the number of quote currency major units per
+// base currency major unit, in units of 10^-10 so that 1.0850 is 10850000000
+type Rate int64
+
+// RateScale is the number of Rate units in a rate of 1
+const RateScale = 10000000000
+
+// rateDecimals is the number of decimal places a Rate holds
+const rateDecimals = 10
+
+// ErrInvalidRate is wrapped by every rate parsing error
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// ParseRate parses a positive decimal rate such as "1.0850" into a Rate
+func ParseRate(s string) (Rate, error) {
+	s = strings.TrimSpace(s)
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || strings.ContainsAny(s, "/eE") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+	if r.Sign() <= 0 {
+		return 0, fmt.Errorf("%w: %q must be positive", ErrInvalidRate, s)
+	}
+	r.Mul(r, big.NewRat(RateScale, 1))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("%w: %q has more than %d decimal places", ErrInvalidRate, s, rateDecimals)
+	}
+	if !r.Num().IsInt64() {
+		return 0, fmt.Errorf("%w: %q is too large", ErrInvalidRate, s)
+	}
+	return Rate(r.Num().Int64()), nil
+}
+
+// Rat returns the rate as an exact fraction
+func (r Rate) Rat() *big.Rat {
+	return big.NewRat(int64(r), RateScale)
+}
+
+// String formats the rate with all ten decimal places, the form accepted by ParseRate
+func (r Rate) String() string {
+	return r.Rat().FloatString(rateDecimals)
+}
+
+// Spread is the margin taken from the mid rate, in basis points
+type Spread int
+
+// MaxSpread is a spread of 100%
+const MaxSpread Spread = 10000
+
+// IsValid checks the spread takes less than the whole amount
+func (s Spread) IsValid() bool {
+	return s >= 0 && s < MaxSpread
+}
+
+// Pair names the currency pair two currencies belong to, whichever way
+// round they are given: the codes in alphabetical order, as in "EUR/USD"
+func Pair(a, b string) string {
+	if b < a {
+		a, b = b, a
+	}
+	return a + "/" + b
+}
+
+// IsCurrency checks a code has the shape of an ISO 4217 currency code
+func IsCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,215 @@
+package fx
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Format is a rate table file format
+type Format string
+
+const (
+	FormatCSV  Format = "CSV"
+	FormatJSON Format = "JSON"
+)
+
+// ErrInvalidTable is wrapped by every rate table parsing error
+var ErrInvalidTable = errors.New("invalid rate table")
+
+// Entry is one mid rate of a rate table, effective from a point in time
+// until the next entry for the same pair
+type Entry struct {
+	Base          string
+	Quote         string
+	EffectiveFrom time.Time
+	Mid           Rate
+}
+
+// Validate checks the entry names two different currencies and a rate
+func (e *Entry) Validate() error {
+	if !IsCurrency(e.Base) || !IsCurrency(e.Quote) {
+		return fmt.Errorf("invalid currency pair %s/%s", e.Base, e.Quote)
+	}
+	if e.Base == e.Quote {
+		return fmt.Errorf("currency pair %s/%s converts a currency into itself", e.Base, e.Quote)
+	}
+	if e.Mid <= 0 {
+		return fmt.Errorf("%s/%s mid rate must be positive", e.Base, e.Quote)
+	}
+	if e.EffectiveFrom.IsZero() {
+		return fmt.Errorf("%s/%s effective_from is required", e.Base, e.Quote)
+	}
+	return nil
+}
+
+// DetectFormat guesses the format of a rate table: a JSON document starts
+// with a brace or bracket, anything else is CSV
+func DetectFormat(data []byte) Format {
+	trimmed := bytes.TrimLeft(data, " \t\r\n\ufeff")
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return FormatJSON
+	}
+	return FormatCSV
+}
+
+// LoadTable reads a rate table file, in the format its extension names
+func LoadTable(path string) ([]Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate table: %w", err)
+	}
+	var format Format
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		format = FormatCSV
+	case ".json":
+		format = FormatJSON
+	}
+	return ParseTable(format, data)
+}
+
+// ParseTable parses and validates a rate table. An empty format is detected
+// from the content. A table may not give two rates for the same pair and
+// time.
+//
+// CSV tables have a header row naming the columns base, quote,
+// effective_from and mid_rate in any order. JSON tables are a document
+// {"rates": [{"base": ..., "quote": ..., "effective_from": ..., "mid_rate": ...}]}.
+// Effective times are RFC 3339 timestamps, or dates meaning midnight UTC;
+// mid rates are decimal strings.
+func ParseTable(format Format, data []byte) ([]Entry, error) {
+	if format == "" {
+		format = DetectFormat(data)
+	}
+
+	var rows []tableRow
+	var err error
+	switch format {
+	case FormatCSV:
+		rows, err = parseCSV(data)
+	case FormatJSON:
+		rows, err = parseJSON(data)
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalidTable, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no rates", ErrInvalidTable)
+	}
+
+	entries := make([]Entry, 0, len(rows))
+	seen := make(map[string]bool, len(rows))
+	for i, row := range rows {
+		entry, err := row.entry()
+		if err != nil {
+			return nil, fmt.Errorf("%w: rate %d: %v", ErrInvalidTable, i+1, err)
+		}
+		key := entry.Base + entry.Quote + entry.EffectiveFrom.Format(time.RFC3339Nano)
+		if seen[key] {
+			return nil, fmt.Errorf("%w: rate %d: %s/%s is given twice from %s", ErrInvalidTable, i+1,
+				entry.Base, entry.Quote, entry.EffectiveFrom.Format(time.RFC3339))
+		}
+		seen[key] = true
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// tableRow is a rate as written in a file, before parsing
+type tableRow struct {
+	Base          string `json:"base"`
+	Quote         string `json:"quote"`
+	EffectiveFrom string `json:"effective_from"`
+	MidRate       string `json:"mid_rate"`
+}
+
+func (r tableRow) entry() (Entry, error) {
+	effective, err := ParseEffectiveFrom(r.EffectiveFrom)
+	if err != nil {
+		return Entry{}, err
+	}
+	mid, err := ParseRate(r.MidRate)
+	if err != nil {
+		return Entry{}, err
+	}
+	entry := Entry{
+		Base:          strings.ToUpper(strings.TrimSpace(r.Base)),
+		Quote:         strings.ToUpper(strings.TrimSpace(r.Quote)),
+		EffectiveFrom: effective,
+		Mid:           mid,
+	}
+	return entry, entry.Validate()
+}
+
+// ParseEffectiveFrom parses an RFC 3339 timestamp, or a date meaning
+// midnight UTC, into a UTC time
+func ParseEffectiveFrom(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid effective_from %q", s)
+	}
+	return t, nil
+}
+
+func parseCSV(data []byte) ([]tableRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing header: %v", ErrInvalidTable, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"base", "quote", "effective_from", "mid_rate"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing column %s", ErrInvalidTable, name)
+		}
+	}
+
+	var rows []tableRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTable, err)
+		}
+		rows = append(rows, tableRow{
+			Base:          record[columns["base"]],
+			Quote:         record[columns["quote"]],
+			EffectiveFrom: record[columns["effective_from"]],
+			MidRate:       record[columns["mid_rate"]],
+		})
+	}
+	return rows, nil
+}
+
+func parseJSON(data []byte) ([]tableRow, error) {
+	var doc struct {
+		Rates []tableRow `json:"rates"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTable, err)
+	}
+	return doc.Rates, nil
+}
@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/core-banking/pkg/bankid"
+	"github.com/core-banking/services/account-service/internal/fx"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/reconciliation"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/core-banking/services/account-service/internal/service"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server represents the gRPC server for account service
+type Server struct {
+	accountService *service.AccountService
+	grpcServer     *grpc.Server
+	listener       net.Listener
+}
+
+// Config holds the server configuration
+type Config struct {
+	Port        int
+	MaxRecvSize int
+	MaxSendSize int
+	Timeout     time.Duration
+	Numbering   *bankid.Scheme // Account numbering; nil issues internal numbers only
+
+	ReconciliationRules reconciliation.Rules // Automatic matching rules; nil uses the defaults
+	FX                  *fx.Config           // Quote expiry and spreads; nil uses the defaults
+	SettlementAccounts  []uuid.UUID          // Accounts exempt from customer limits as the bank's own
+}
+
+// NewServer creates a new gRPC server
+func NewServer(repo repository.AccountRepository, customers service.CustomerDirectory, cfg Config) *Server {
+	// Create account service
+	accountService := service.NewAccountService(repo, customers, cfg.Numbering)
+	if cfg.ReconciliationRules != nil {
+		accountService.SetReconciliationRules(cfg.ReconciliationRules)
+	}
+	if cfg.FX != nil {
+		accountService.SetFXConfig(cfg.FX)
+	}
+	if len(cfg.SettlementAccounts) > 0 {
+		accountService.SetSettlementAccounts(cfg.SettlementAccounts)
+	}
+
+	// Create gRPC server with options
+	grpcOpts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cfg.MaxRecvSize * 1024 * 1024),
+		grpc.MaxSendMsgSize(cfg.MaxSendSize * 1024 * 1024),
+		grpc.ChainUnaryInterceptor(
+			loggingUnaryInterceptor,
+			recoveryUnaryInterceptor,
+			timeoutUnaryInterceptor(cfg.Timeout),
+			metadataUnaryInterceptor,
+		),
+	}
+
+	grpcServer := grpc.NewServer(grpcOpts...)
+
+	// Register account service
+	accountpb.RegisterAccountServiceServer(grpcServer, accountService)
+
+	// Create listener
+	addr := fmt.Sprintf(":%d", cfg.Port)
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", addr, err)
+	}
+
+	return &Server{
+		accountService: accountService,
+		grpcServer:     grpcServer,
+		listener:       listener,
+	}
+}
+
+// Start starts the gRPC server
+func (s *Server) Start() error {
+	log.Printf("Starting gRPC server on %s", s.listener.Addr().String())
+	return s.grpcServer.Serve(s.listener)
+}
+
+// Stop gracefully stops the gRPC server
+func (s *Server) Stop() {
+	log.Println("Stopping gRPC server...")
+	s.grpcServer.GracefulStop()
+}
+
+// Interceptor functions
+
+func loggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	log.Printf("gRPC unary request: %s", info.FullMethod)
+	resp, err := handler(ctx, req)
+	duration := time.Since(start)
+	code := codes.OK
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			code = st.Code()
+		}
+	}
+	log.Printf("gRPC unary response: %s, code=%s, duration=%v", info.FullMethod, code, duration)
+	return resp, err
+}
+
+func recoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Panic in unary handler: %v", r)
+			err = status.Errorf(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func timeoutUnaryInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+type contextKey string
+
+func metadataUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if requestID := md.Get("x-request-id"); len(requestID) > 0 {
+			ctx = context.WithValue(ctx, contextKey("request_id"), requestID[0])
+		}
+		if userID := md.Get("x-user-id"); len(userID) > 0 {
+			ctx = context.WithValue(ctx, contextKey("user_id"), userID[0])
+		}
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,176 @@
+package interest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+)
+
+// Frequency is how often accrued interest is capitalized onto the ledger
+type Frequency string
+
+const (
+	FrequencyMonthly   Frequency = "Monthly"
+	FrequencyQuarterly Frequency = "Quarterly"
+)
+
+// IsValid checks if the capitalization frequency is supported
+func (f Frequency) IsValid() bool {
+	return f == FrequencyMonthly || f == FrequencyQuarterly
+}
+
+// IsPeriodEnd reports whether date is the last day of a capitalization period
+func (f Frequency) IsPeriodEnd(date time.Time) bool {
+	date = Date(date)
+	if date.AddDate(0, 0, 1).Day() != 1 {
+		return false
+	}
+	if f == FrequencyQuarterly {
+		return date.Month()%3 == 0
+	}
+	return true
+}
+
+// PeriodStart returns the first day of the capitalization period ending on periodEnd
+func (f Frequency) PeriodStart(periodEnd time.Time) time.Time {
+	periodEnd = Date(periodEnd)
+	months := 1
+	if f == FrequencyQuarterly {
+		months = 3
+	}
+	first := time.Date(periodEnd.Year(), periodEnd.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return first.AddDate(0, 1-months, 0)
+}
+
+// Overdraft interest on account types without a product uses these terms
+const (
+	DefaultOverdraftDayCount       = DayCountAct365
+	DefaultOverdraftCapitalization = FrequencyMonthly
+)
+
+// Product holds the interest terms for one account type
+type Product struct {
+	AccountType    models.AccountType
+	DayCount       DayCount
+	Capitalization Frequency
+	Rates          RateSchedule
+}
+
+// Validate checks the product terms are complete and consistent
+func (p *Product) Validate() error {
+	if !p.AccountType.IsValid() {
+		return fmt.Errorf("invalid account type: %s", p.AccountType)
+	}
+	if !p.DayCount.IsValid() {
+		return fmt.Errorf("invalid day count convention: %s", p.DayCount)
+	}
+	if !p.Capitalization.IsValid() {
+		return fmt.Errorf("invalid capitalization frequency: %s", p.Capitalization)
+	}
+	if len(p.Rates) == 0 {
+		return fmt.Errorf("product %s has no rate tables", p.AccountType)
+	}
+	for i := range p.Rates {
+		if err := p.Rates[i].Validate(); err != nil {
+			return fmt.Errorf("rate table effective %s: %w", p.Rates[i].EffectiveFrom.Format(DateLayout), err)
+		}
+	}
+	return nil
+}
+
+// Config is the set of interest-bearing products, keyed by account type.
+// The zero Config has no products.
+type Config struct {
+	products map[models.AccountType]*Product
+}
+
+// Product returns the interest terms for an account type, if it earns interest
+func (c *Config) Product(accountType models.AccountType) (*Product, bool) {
+	p, ok := c.products[accountType]
+	return p, ok
+}
+
+// Products returns every configured product ordered by account type
+func (c *Config) Products() []*Product {
+	products := make([]*Product, 0, len(c.products))
+	for _, p := range c.products {
+		products = append(products, p)
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i].AccountType < products[j].AccountType })
+	return products
+}
+
+// configFile is the on-disk JSON representation of Config. Rates are given as
+// percentage strings so that they are never rounded through a float.
+type configFile struct {
+	Products []struct {
+		AccountType    models.AccountType `json:"account_type"`
+		DayCount       DayCount           `json:"day_count"`
+		Capitalization Frequency          `json:"capitalization"`
+		Rates          []struct {
+			EffectiveFrom string     `json:"effective_from"`
+			Method        TierMethod `json:"method"`
+			Tiers         []struct {
+				MinBalance int64  `json:"min_balance"`
+				Rate       string `json:"rate"`
+			} `json:"tiers"`
+		} `json:"rates"`
+	} `json:"products"`
+}
+
+// LoadConfig reads and validates an interest configuration file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read interest config: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates an interest configuration document
+func ParseConfig(data []byte) (*Config, error) {
+	var file configFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse interest config: %w", err)
+	}
+
+	cfg := &Config{products: make(map[models.AccountType]*Product)}
+	for _, fp := range file.Products {
+		product := &Product{
+			AccountType:    fp.AccountType,
+			DayCount:       fp.DayCount,
+			Capitalization: fp.Capitalization,
+		}
+
+		for _, ft := range fp.Rates {
+			effective, err := time.Parse(DateLayout, ft.EffectiveFrom)
+			if err != nil {
+				return nil, fmt.Errorf("product %s: invalid effective_from %q", fp.AccountType, ft.EffectiveFrom)
+			}
+			table := RateTable{EffectiveFrom: effective, Method: ft.Method}
+			for _, tier := range ft.Tiers {
+				rate, err := ParseRate(tier.Rate)
+				if err != nil {
+					return nil, fmt.Errorf("product %s: %w", fp.AccountType, err)
+				}
+				table.Tiers = append(table.Tiers, Tier{MinBalance: tier.MinBalance, Rate: rate})
+			}
+			product.Rates = append(product.Rates, table)
+		}
+		product.Rates.Sort()
+
+		if err := product.Validate(); err != nil {
+			return nil, err
+		}
+		if _, exists := cfg.products[product.AccountType]; exists {
+			return nil, fmt.Errorf("duplicate product for account type %s", product.AccountType)
+		}
+		cfg.products[product.AccountType] = product
+	}
+
+	return cfg, nil
+}
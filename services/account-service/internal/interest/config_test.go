@@ -0,0 +1,66 @@
+package interest
+
+import (
+	"testing"
+
+	"github.com/core-banking/services/account-service/internal/models"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`{"products": [{
+		"account_type": "Savings", "day_count": "30/360", "capitalization": "Quarterly",
+		"rates": [
+			{"effective_from": "2024-06-01", "method": "Banded", "tiers": [{"min_balance": 0, "rate": "1.5"}]},
+			{"effective_from": "2024-01-01", "method": "Tiered", "tiers": [{"min_balance": 0, "rate": "1.25"}]}
+		]
+	}]}`))
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+
+	product, ok := cfg.Product(models.AccountTypeSavings)
+	if !ok {
+		t.Fatal("expected Savings product")
+	}
+	if _, ok := cfg.Product(models.AccountTypeChecking); ok {
+		t.Error("did not expect Checking product")
+	}
+	if product.Rates[0].EffectiveFrom != date("2024-01-01") || product.Rates[0].Tiers[0].Rate != 12500 {
+		t.Errorf("rates not sorted by effective date: %+v", product.Rates)
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"bad day count", `{"products": [{"account_type": "Savings", "day_count": "ACT/ACT", "capitalization": "Monthly",
+			"rates": [{"effective_from": "2024-01-01", "method": "Tiered", "tiers": [{"min_balance": 0, "rate": "1"}]}]}]}`},
+		{"no rates", `{"products": [{"account_type": "Savings", "day_count": "ACT/365", "capitalization": "Monthly", "rates": []}]}`},
+		{"first tier above zero", `{"products": [{"account_type": "Savings", "day_count": "ACT/365", "capitalization": "Monthly",
+			"rates": [{"effective_from": "2024-01-01", "method": "Tiered", "tiers": [{"min_balance": 100, "rate": "1"}]}]}]}`},
+		{"bad effective date", `{"products": [{"account_type": "Savings", "day_count": "ACT/365", "capitalization": "Monthly",
+			"rates": [{"effective_from": "01/01/2024", "method": "Tiered", "tiers": [{"min_balance": 0, "rate": "1"}]}]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseConfig([]byte(tt.json)); err == nil {
+				t.Error("ParseConfig() expected error")
+			}
+		})
+	}
+}
+
+func TestFrequency_Periods(t *testing.T) {
+	if !FrequencyMonthly.IsPeriodEnd(date("2024-02-29")) || FrequencyMonthly.IsPeriodEnd(date("2024-02-28")) {
+		t.Error("monthly period should end on the last day of February")
+	}
+	if FrequencyQuarterly.IsPeriodEnd(date("2024-05-31")) || !FrequencyQuarterly.IsPeriodEnd(date("2024-06-30")) {
+		t.Error("quarterly period should end on the last day of the quarter")
+	}
+	if got := FrequencyQuarterly.PeriodStart(date("2024-06-30")); got != date("2024-04-01") {
+		t.Errorf("PeriodStart() = %s, want 2024-04-01", got.Format(DateLayout))
+	}
+}
@@ -0,0 +1,77 @@
+package interest
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// DayCount identifies a day-count convention used to turn a period of days
+// into a fraction of a year
+type DayCount string
+
+const (
+	DayCountAct365 DayCount = "ACT/365"
+	DayCountAct360 DayCount = "ACT/360"
+	DayCount30360  DayCount = "30/360"
+)
+
+// IsValid checks if the day-count convention is supported
+func (d DayCount) IsValid() bool {
+	switch d {
+	case DayCountAct365, DayCountAct360, DayCount30360:
+		return true
+	}
+	return false
+}
+
+// YearFraction returns the fraction of a year between start (inclusive) and
+// end (exclusive) under the convention. Both dates are truncated to days.
+func (d DayCount) YearFraction(start, end time.Time) (*big.Rat, error) {
+	start, end = Date(start), Date(end)
+	if end.Before(start) {
+		return nil, fmt.Errorf("end %s is before start %s", end.Format(DateLayout), start.Format(DateLayout))
+	}
+
+	switch d {
+	case DayCountAct365:
+		return big.NewRat(actualDays(start, end), 365), nil
+	case DayCountAct360:
+		return big.NewRat(actualDays(start, end), 360), nil
+	case DayCount30360:
+		return big.NewRat(days30360(start, end), 360), nil
+	default:
+		return nil, fmt.Errorf("unsupported day count convention: %s", d)
+	}
+}
+
+func actualDays(start, end time.Time) int64 {
+	return int64(end.Sub(start).Hours() / 24)
+}
+
+// days30360 implements the 30/360 bond basis day count. Accruing one calendar
+// day at a time under this convention sums to exactly 30 days per month: the
+// last day of February carries the missing days and the 30th of a 31-day
+// month carries none.
+func days30360(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+
+	return int64(360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1))
+}
+
+// DateLayout is the layout used for business dates in configuration and reports
+const DateLayout = "2006-01-02"
+
+// Date truncates a timestamp to midnight UTC of its calendar day
+func Date(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
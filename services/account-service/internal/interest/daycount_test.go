@@ -0,0 +1,76 @@
+package interest
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	d, err := time.Parse(DateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestDayCount_YearFraction(t *testing.T) {
+	tests := []struct {
+		name     string
+		dayCount DayCount
+		start    string
+		end      string
+		want     *big.Rat
+	}{
+		{"ACT/365 one day", DayCountAct365, "2024-03-01", "2024-03-02", big.NewRat(1, 365)},
+		{"ACT/365 leap year", DayCountAct365, "2024-01-01", "2025-01-01", big.NewRat(366, 365)},
+		{"ACT/360 thirty one days", DayCountAct360, "2024-01-01", "2024-02-01", big.NewRat(31, 360)},
+		{"30/360 full month", DayCount30360, "2024-01-01", "2024-02-01", big.NewRat(30, 360)},
+		{"30/360 thirtieth of a long month carries nothing", DayCount30360, "2024-01-30", "2024-01-31", big.NewRat(0, 360)},
+		{"30/360 thirty first", DayCount30360, "2024-01-31", "2024-02-01", big.NewRat(1, 360)},
+		{"30/360 end of leap February", DayCount30360, "2024-02-29", "2024-03-01", big.NewRat(2, 360)},
+		{"30/360 end of February", DayCount30360, "2023-02-28", "2023-03-01", big.NewRat(3, 360)},
+		{"30/360 full year", DayCount30360, "2024-01-01", "2025-01-01", big.NewRat(1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dayCount.YearFraction(date(tt.start), date(tt.end))
+			if err != nil {
+				t.Fatalf("YearFraction() error = %v", err)
+			}
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("YearFraction() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayCount30360_DailySumsToMonth(t *testing.T) {
+	for month := time.January; month <= time.December; month++ {
+		start := time.Date(2024, month, 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 1, 0)
+
+		total := new(big.Rat)
+		for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+			f, err := DayCount30360.YearFraction(d, d.AddDate(0, 0, 1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			total.Add(total, f)
+		}
+
+		if total.Cmp(big.NewRat(30, 360)) != 0 {
+			t.Errorf("%s daily fractions sum to %s, want 1/12", month, total)
+		}
+	}
+}
+
+func TestDayCount_YearFractionErrors(t *testing.T) {
+	if _, err := DayCountAct365.YearFraction(date("2024-02-01"), date("2024-01-01")); err == nil {
+		t.Error("expected error when end is before start")
+	}
+	if _, err := DayCount("ACT/ACT").YearFraction(date("2024-01-01"), date("2024-01-02")); err == nil {
+		t.Error("expected error for unsupported convention")
+	}
+}
@@ -0,0 +1,81 @@
+package interest
+
+import (
+	"math/big"
+	"time"
+)
+
+// MicrosPerUnit is the number of accrual micro-units in one minor currency
+// unit. Daily accruals are kept at this precision so that fractions of a cent
+// carry forward to capitalization instead of being lost each day.
+const MicrosPerUnit = 1000000
+
+// Accrue returns the interest earned by holding balance for the single day
+// starting at date, in micro-units of the minor currency unit, together with
+// the effective annual rate that produced it. Non-positive balances earn
+// nothing.
+func (p *Product) Accrue(date time.Time, balance int64) (int64, Rate, error) {
+	if balance <= 0 {
+		return 0, 0, nil
+	}
+
+	table, err := p.Rates.TableOn(date)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	date = Date(date)
+	fraction, err := p.DayCount.YearFraction(date, date.AddDate(0, 0, 1))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	annual := table.AnnualInterest(balance)
+
+	daily := new(big.Rat).Mul(annual, fraction)
+	daily.Mul(daily, big.NewRat(MicrosPerUnit, 1))
+
+	effective := new(big.Rat).Quo(annual, big.NewRat(balance, 1))
+	effective.Mul(effective, big.NewRat(RateScale, 1))
+
+	return floor(daily), Rate(floor(effective)), nil
+}
+
+// AccrueDebit returns the overdraft interest owed for holding balance for the
+// single day starting at date, in micro-units of the minor currency unit, at
+// the given annual debit rate. Non-negative balances owe nothing.
+func AccrueDebit(dayCount DayCount, rate Rate, date time.Time, balance int64) (int64, error) {
+	if balance >= 0 || rate <= 0 {
+		return 0, nil
+	}
+
+	date = Date(date)
+	fraction, err := dayCount.YearFraction(date, date.AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	daily := new(big.Rat).Mul(big.NewRat(-balance, 1), rate.Rat())
+	daily.Mul(daily, fraction)
+	daily.Mul(daily, big.NewRat(MicrosPerUnit, 1))
+
+	return floor(daily), nil
+}
+
+// CapitalizationAmount returns the whole minor units to post given the total
+// ever accrued (in micro-units) and the total already capitalized. Working
+// from running totals means sub-unit remainders carry into the next period and
+// any back-dated recalculation of an already capitalized period is settled by
+// the next capitalization. The result is never negative.
+func CapitalizationAmount(totalAccruedMicros, totalCapitalized int64) int64 {
+	amount := totalAccruedMicros/MicrosPerUnit - totalCapitalized
+	if amount < 0 {
+		return 0
+	}
+	return amount
+}
+
+// floor truncates a non-negative rational to an integer
+func floor(r *big.Rat) int64 {
+	return new(big.Int).Quo(r.Num(), r.Denom()).Int64()
+}
@@ -0,0 +1,156 @@
+package interest
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Rate is an annual interest rate expressed in millionths, so 1% is 10000
+// and 4.125% is 41250
+type Rate int64
+
+// RateScale is the number of Rate units in a rate of 1 (100%)
+const RateScale = 1000000
+
+// ParseRate parses a percentage such as "4.125" or "4.125%" into a Rate
+func ParseRate(s string) (Rate, error) {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "%")
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, fmt.Errorf("invalid rate: %q", s)
+	}
+	// percent -> millionths of one
+	r.Mul(r, big.NewRat(RateScale, 100))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("rate %q has more than 4 decimal places", s)
+	}
+	return Rate(r.Num().Int64()), nil
+}
+
+// Rat returns the rate as an exact fraction of one
+func (r Rate) Rat() *big.Rat {
+	return big.NewRat(int64(r), RateScale)
+}
+
+// Percent formats the rate as a plain percentage such as "4.1250", the form
+// accepted by ParseRate
+func (r Rate) Percent() string {
+	return r.Rat().Mul(r.Rat(), big.NewRat(100, 1)).FloatString(4)
+}
+
+// String formats the rate as a percentage
+func (r Rate) String() string {
+	return r.Percent() + "%"
+}
+
+// TierMethod determines how tiers apply to a balance
+type TierMethod string
+
+const (
+	// TierMethodTiered applies the rate of the highest tier reached to the whole balance
+	TierMethodTiered TierMethod = "Tiered"
+	// TierMethodBanded applies each tier's rate only to the slice of the balance within that band
+	TierMethodBanded TierMethod = "Banded"
+)
+
+// IsValid checks if the tier method is valid
+func (m TierMethod) IsValid() bool {
+	return m == TierMethodTiered || m == TierMethodBanded
+}
+
+// Tier is a balance threshold, in minor units, from which a rate applies
+type Tier struct {
+	MinBalance int64
+	Rate       Rate
+}
+
+// RateTable is a set of tiers effective from a given date
+type RateTable struct {
+	EffectiveFrom time.Time
+	Method        TierMethod
+	Tiers         []Tier
+}
+
+// Validate checks the table is well formed
+func (t *RateTable) Validate() error {
+	if !t.Method.IsValid() {
+		return fmt.Errorf("invalid tier method: %s", t.Method)
+	}
+	if len(t.Tiers) == 0 {
+		return errors.New("rate table must have at least one tier")
+	}
+	if t.Tiers[0].MinBalance != 0 {
+		return errors.New("first tier must start at a balance of zero")
+	}
+	for i := 1; i < len(t.Tiers); i++ {
+		if t.Tiers[i].MinBalance <= t.Tiers[i-1].MinBalance {
+			return errors.New("tiers must be sorted by strictly increasing min_balance")
+		}
+	}
+	return nil
+}
+
+// AnnualInterest returns the interest a balance would earn over one full year
+// under this table, in minor units as an exact fraction. Negative balances
+// earn nothing.
+func (t *RateTable) AnnualInterest(balance int64) *big.Rat {
+	total := new(big.Rat)
+	if balance <= 0 {
+		return total
+	}
+
+	switch t.Method {
+	case TierMethodTiered:
+		rate := t.Tiers[0].Rate
+		for _, tier := range t.Tiers {
+			if balance >= tier.MinBalance {
+				rate = tier.Rate
+			}
+		}
+		return total.Mul(big.NewRat(balance, 1), rate.Rat())
+
+	case TierMethodBanded:
+		for i, tier := range t.Tiers {
+			if balance <= tier.MinBalance {
+				break
+			}
+			upper := balance
+			if i+1 < len(t.Tiers) && t.Tiers[i+1].MinBalance < balance {
+				upper = t.Tiers[i+1].MinBalance
+			}
+			slice := new(big.Rat).Mul(big.NewRat(upper-tier.MinBalance, 1), tier.Rate.Rat())
+			total.Add(total, slice)
+		}
+	}
+
+	return total
+}
+
+// RateSchedule is an effective-dated history of rate tables
+type RateSchedule []RateTable
+
+// TableOn returns the table in effect on the given date
+func (s RateSchedule) TableOn(date time.Time) (*RateTable, error) {
+	date = Date(date)
+	var found *RateTable
+	for i := range s {
+		if !Date(s[i].EffectiveFrom).After(date) {
+			if found == nil || s[i].EffectiveFrom.After(found.EffectiveFrom) {
+				found = &s[i]
+			}
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no rate table effective on %s", date.Format(DateLayout))
+	}
+	return found, nil
+}
+
+// Sort orders the schedule by effective date
+func (s RateSchedule) Sort() {
+	sort.Slice(s, func(i, j int) bool { return s[i].EffectiveFrom.Before(s[j].EffectiveFrom) })
+}
@@ -0,0 +1,115 @@
+package interest
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Rate
+		wantErr bool
+	}{
+		{"2", 20000, false},
+		{"4.125", 41250, false},
+		{"4.125%", 41250, false},
+		{"0.0001", 1, false},
+		{"0.00001", 0, true},
+		{"abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseRate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseRate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateTable_AnnualInterest(t *testing.T) {
+	tiers := []Tier{
+		{MinBalance: 0, Rate: 10000},       // 1%
+		{MinBalance: 100000, Rate: 20000},  // 2%
+		{MinBalance: 1000000, Rate: 30000}, // 3%
+	}
+	tiered := &RateTable{Method: TierMethodTiered, Tiers: tiers}
+	banded := &RateTable{Method: TierMethodBanded, Tiers: tiers}
+
+	tests := []struct {
+		name    string
+		table   *RateTable
+		balance int64
+		want    *big.Rat
+	}{
+		{"tiered below first threshold", tiered, 50000, big.NewRat(500, 1)},
+		{"tiered at threshold", tiered, 100000, big.NewRat(2000, 1)},
+		{"tiered top tier", tiered, 2000000, big.NewRat(60000, 1)},
+		{"banded within first band", banded, 50000, big.NewRat(500, 1)},
+		{"banded across bands", banded, 2000000, big.NewRat(1000+18000+30000, 1)},
+		{"negative balance", banded, -500, new(big.Rat)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.table.AnnualInterest(tt.balance)
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("AnnualInterest(%d) = %s, want %s", tt.balance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateSchedule_TableOn(t *testing.T) {
+	schedule := RateSchedule{
+		{EffectiveFrom: date("2024-03-01"), Method: TierMethodTiered, Tiers: []Tier{{Rate: 20000}}},
+		{EffectiveFrom: date("2024-01-01"), Method: TierMethodTiered, Tiers: []Tier{{Rate: 10000}}},
+	}
+
+	if _, err := schedule.TableOn(date("2023-12-31")); err == nil {
+		t.Error("expected error before first effective date")
+	}
+
+	table, err := schedule.TableOn(date("2024-02-29"))
+	if err != nil || table.Tiers[0].Rate != 10000 {
+		t.Errorf("TableOn(2024-02-29) = %v, %v; want 1%% table", table, err)
+	}
+
+	table, err = schedule.TableOn(date("2024-03-01"))
+	if err != nil || table.Tiers[0].Rate != 20000 {
+		t.Errorf("TableOn(2024-03-01) = %v, %v; want 2%% table", table, err)
+	}
+}
+
+func TestCapitalizationAmount(t *testing.T) {
+	if got := CapitalizationAmount(1698630120, 0); got != 1698 {
+		t.Errorf("CapitalizationAmount() = %d, want 1698", got)
+	}
+	// The 0.63 left over from the first period carries into the second
+	if got := CapitalizationAmount(3290630120, 1698); got != 1592 {
+		t.Errorf("CapitalizationAmount() = %d, want 1592", got)
+	}
+	if got := CapitalizationAmount(1000000, 5); got != 0 {
+		t.Errorf("CapitalizationAmount() = %d, want 0", got)
+	}
+}
+
+func TestAccrueDebit(t *testing.T) {
+	// 1000.00 overdrawn at 18.25% ACT/365 owes exactly 0.50 a day
+	got, err := AccrueDebit(DayCountAct365, 182500, date("2024-01-10"), -100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 50*MicrosPerUnit {
+		t.Errorf("AccrueDebit() = %d, want %d", got, 50*MicrosPerUnit)
+	}
+
+	if got, _ := AccrueDebit(DayCountAct365, 182500, date("2024-01-10"), 100000); got != 0 {
+		t.Errorf("AccrueDebit() on credit balance = %d, want 0", got)
+	}
+}
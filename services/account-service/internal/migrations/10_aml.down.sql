@@ -0,0 +1,8 @@
+-- Drop tables
+DROP TABLE IF EXISTS aml_alert_evidence;
+DROP TABLE IF EXISTS aml_alerts;
+DROP TABLE IF EXISTS transaction_events;
+
+-- Drop types
+DROP TYPE IF EXISTS aml_alert_status;
+DROP TYPE IF EXISTS transaction_event_direction;
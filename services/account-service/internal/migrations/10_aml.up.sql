@@ -0,0 +1,53 @@
+-- AML transaction monitoring. Every posted customer transaction adds one
+-- event per side, written in the same database transaction as its postings,
+-- and the monitor works through them in sequence order. Alerts keep the rule
+-- and rules version that raised them and the events they were based on.
+CREATE TYPE transaction_event_direction AS ENUM ('In', 'Out');
+CREATE TYPE aml_alert_status AS ENUM ('Open', 'Escalated', 'Dismissed');
+
+CREATE TABLE transaction_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sequence BIGSERIAL NOT NULL UNIQUE,
+    customer_id UUID NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    direction transaction_event_direction NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Minor units
+    currency CHAR(3) NOT NULL,
+    counterparty_account_id UUID REFERENCES accounts(id),
+    counterparty_country CHAR(2), -- ISO 3166 alpha-2; NULL when unknown
+    channel transaction_channel NOT NULL,
+    transaction_type limit_transaction_type NOT NULL,
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    monitored_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_transaction_events_customer ON transaction_events(customer_id, occurred_at);
+CREATE INDEX idx_transaction_events_unmonitored ON transaction_events(sequence)
+    WHERE monitored_at IS NULL;
+
+CREATE TABLE aml_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    rule_id VARCHAR(64) NOT NULL,
+    rule_type VARCHAR(64) NOT NULL,
+    rules_version VARCHAR(64) NOT NULL,
+    customer_id UUID NOT NULL,
+    trigger_event_id UUID NOT NULL REFERENCES transaction_events(id),
+    summary TEXT NOT NULL,
+    status aml_alert_status NOT NULL DEFAULT 'Open',
+    raised_at TIMESTAMP WITH TIME ZONE NOT NULL, -- Time of the triggering transaction
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE,
+    closed_by VARCHAR(255) NOT NULL DEFAULT '',
+    resolution TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_aml_alerts_customer_rule ON aml_alerts(customer_id, rule_id, raised_at);
+CREATE INDEX idx_aml_alerts_status ON aml_alerts(status, created_at);
+
+CREATE TABLE aml_alert_evidence (
+    alert_id UUID NOT NULL REFERENCES aml_alerts(id),
+    event_id UUID NOT NULL REFERENCES transaction_events(id),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (alert_id, event_id)
+);
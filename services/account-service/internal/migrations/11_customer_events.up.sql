@@ -0,0 +1,10 @@
+-- Customer event feed position. Events from customer-service's feed, such
+-- as customers being merged, are applied in sequence order; the last one
+-- applied is recorded in the same database transaction as its effects.
+CREATE TABLE customer_event_offset (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    sequence BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO customer_event_offset (id) VALUES (TRUE);
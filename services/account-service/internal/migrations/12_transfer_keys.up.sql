@@ -0,0 +1,15 @@
+-- Transfer idempotency keys. A transfer made with a key records its
+-- postings here in the same database transaction, so a caller that
+-- repeats the request after failing to learn the outcome, such as a
+-- payment run rolled back after posting, gets the first transfer back
+-- instead of moving the funds twice.
+CREATE TABLE transfer_keys (
+    idempotency_key VARCHAR(255) PRIMARY KEY,
+    from_account_id UUID NOT NULL REFERENCES accounts(id),
+    to_account_id UUID NOT NULL REFERENCES accounts(id),
+    amount BIGINT NOT NULL, -- Minor units
+    debit_posting_id UUID NOT NULL REFERENCES postings(id),
+    credit_posting_id UUID NOT NULL REFERENCES postings(id),
+    fee_id UUID REFERENCES fees(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,18 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_holds_updated_at ON holds;
+DROP TRIGGER IF EXISTS update_accounts_updated_at ON accounts;
+
+-- Drop functions
+DROP FUNCTION IF EXISTS update_updated_at_column();
+
+-- Drop tables
+DROP TABLE IF EXISTS holds;
+DROP TABLE IF EXISTS postings;
+DROP TABLE IF EXISTS accounts;
+
+-- Drop types
+DROP TYPE IF EXISTS hold_status;
+DROP TYPE IF EXISTS hold_type;
+DROP TYPE IF EXISTS posting_type;
+DROP TYPE IF EXISTS account_type;
+DROP TYPE IF EXISTS account_status;
@@ -0,0 +1,84 @@
+-- Create accounts table
+CREATE TYPE account_status AS ENUM ('Active', 'Frozen', 'Closed');
+CREATE TYPE account_type AS ENUM ('Checking', 'Savings');
+
+CREATE TABLE accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_number VARCHAR(50) NOT NULL UNIQUE,
+    customer_id UUID NOT NULL,
+    account_type account_type NOT NULL,
+    currency CHAR(3) NOT NULL,
+    ledger_balance BIGINT NOT NULL DEFAULT 0, -- Minor units
+    status account_status NOT NULL DEFAULT 'Active',
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+-- Create postings table (append-only ledger)
+CREATE TYPE posting_type AS ENUM ('Credit', 'Debit', 'HoldCapture');
+
+CREATE TABLE postings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    posting_type posting_type NOT NULL,
+    amount BIGINT NOT NULL, -- Signed minor units, debits are negative
+    currency CHAR(3) NOT NULL,
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    hold_id UUID,
+    value_date DATE NOT NULL,
+    booked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create holds table
+CREATE TYPE hold_type AS ENUM ('CardAuthorization', 'LegalGarnishment', 'Payment', 'Manual');
+CREATE TYPE hold_status AS ENUM ('Active', 'Released', 'Captured', 'Expired');
+
+CREATE TABLE holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    hold_type hold_type NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    remaining_amount BIGINT NOT NULL CHECK (remaining_amount >= 0),
+    currency CHAR(3) NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    status hold_status NOT NULL DEFAULT 'Active',
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by UUID NOT NULL
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_accounts_customer_id ON accounts(customer_id);
+CREATE INDEX idx_accounts_status ON accounts(status);
+
+CREATE INDEX idx_postings_account_id_booked_at ON postings(account_id, booked_at);
+CREATE INDEX idx_postings_hold_id ON postings(hold_id) WHERE hold_id IS NOT NULL;
+
+CREATE INDEX idx_holds_account_id_active ON holds(account_id) WHERE status = 'Active';
+CREATE INDEX idx_holds_expires_at_active ON holds(expires_at) WHERE status = 'Active' AND expires_at IS NOT NULL;
+CREATE INDEX idx_holds_reference ON holds(reference);
+
+-- Create trigger for updated_at
+CREATE OR REPLACE FUNCTION update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_accounts_updated_at
+    BEFORE UPDATE ON accounts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_holds_updated_at
+    BEFORE UPDATE ON holds
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_accounts_account_type;
+DROP INDEX IF EXISTS idx_postings_account_id_value_date;
+
+-- Drop tables
+DROP TABLE IF EXISTS interest_capitalizations;
+DROP TABLE IF EXISTS interest_accruals;
+
+-- PostgreSQL cannot drop a single enum value; 'Interest' remains on posting_type
@@ -0,0 +1,30 @@
+-- Interest capitalization postings
+ALTER TYPE posting_type ADD VALUE IF NOT EXISTS 'Interest';
+
+-- Daily accruals, one row per account and day. Recalculated in place when a
+-- back-dated posting changes a past balance.
+CREATE TABLE interest_accruals (
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    accrual_date DATE NOT NULL,
+    balance BIGINT NOT NULL, -- Minor units
+    rate BIGINT NOT NULL, -- Effective annual rate in millionths
+    amount_micros BIGINT NOT NULL, -- Minor units * 1,000,000
+    calculated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, accrual_date)
+);
+
+-- Capitalizations, at most one per account and period
+CREATE TABLE interest_capitalizations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    amount BIGINT NOT NULL, -- Minor units
+    posting_id UUID REFERENCES postings(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (account_id, period_end)
+);
+
+-- Value-dated balance lookups and back-dated posting detection
+CREATE INDEX idx_postings_account_id_value_date ON postings(account_id, value_date);
+CREATE INDEX idx_accounts_account_type ON accounts(account_type) WHERE status <> 'Closed';
@@ -0,0 +1,23 @@
+-- Drop tables
+DROP TABLE IF EXISTS fees;
+DROP TABLE IF EXISTS fee_rules;
+
+-- Drop types
+DROP TYPE IF EXISTS fee_status;
+DROP TYPE IF EXISTS fee_type;
+
+-- Drop columns
+ALTER TABLE interest_capitalizations
+    DROP COLUMN IF EXISTS debit_posting_id,
+    DROP COLUMN IF EXISTS debit_amount;
+
+ALTER TABLE interest_accruals
+    DROP COLUMN IF EXISTS debit_micros,
+    DROP COLUMN IF EXISTS debit_rate;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS segment,
+    DROP COLUMN IF EXISTS overdraft_rate,
+    DROP COLUMN IF EXISTS overdraft_limit;
+
+-- PostgreSQL cannot drop a single enum value; 'Fee' and 'FeeReversal' remain on posting_type
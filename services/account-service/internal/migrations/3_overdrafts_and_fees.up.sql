@@ -0,0 +1,63 @@
+-- Arranged overdrafts and fee waiver segments
+ALTER TABLE accounts
+    ADD COLUMN overdraft_limit BIGINT NOT NULL DEFAULT 0 CHECK (overdraft_limit >= 0), -- Minor units
+    ADD COLUMN overdraft_rate BIGINT NOT NULL DEFAULT 0 CHECK (overdraft_rate >= 0), -- Annual rate in millionths
+    ADD COLUMN segment VARCHAR(50) NOT NULL DEFAULT '';
+
+-- Fee postings and their reversals
+ALTER TYPE posting_type ADD VALUE IF NOT EXISTS 'Fee';
+ALTER TYPE posting_type ADD VALUE IF NOT EXISTS 'FeeReversal';
+
+-- Overdraft (debit) interest alongside credit interest
+ALTER TABLE interest_accruals
+    ADD COLUMN debit_rate BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN debit_micros BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE interest_capitalizations
+    ADD COLUMN debit_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN debit_posting_id UUID REFERENCES postings(id);
+
+-- Fee schedule. Rows are append-only; the latest rule effective at the time
+-- of charging applies, so product teams change fees by inserting new rules.
+CREATE TYPE fee_type AS ENUM ('MonthlyMaintenance', 'UnarrangedOverdraft', 'InsufficientFunds', 'Transfer');
+CREATE TYPE fee_status AS ENUM ('Charged', 'Waived', 'Reversed');
+
+CREATE TABLE fee_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_type account_type NOT NULL,
+    fee_type fee_type NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount >= 0), -- Minor units
+    waiver_min_balance BIGINT,
+    waiver_segments TEXT[] NOT NULL DEFAULT '{}',
+    effective_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+-- Fees charged or waived
+CREATE TABLE fees (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    fee_rule_id UUID NOT NULL REFERENCES fee_rules(id),
+    fee_type fee_type NOT NULL,
+    amount BIGINT NOT NULL, -- Minor units
+    currency CHAR(3) NOT NULL,
+    status fee_status NOT NULL,
+    reference VARCHAR(255) NOT NULL,
+    waiver_reason VARCHAR(255) NOT NULL DEFAULT '',
+    posting_id UUID REFERENCES postings(id),
+    reversal_posting_id UUID REFERENCES postings(id),
+    reversal_reason VARCHAR(255) NOT NULL DEFAULT '',
+    reversed_by VARCHAR(255) NOT NULL DEFAULT '',
+    charged_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    reversed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_fee_rules_lookup ON fee_rules(account_type, fee_type, currency, effective_from DESC);
+CREATE INDEX idx_fees_account_id_charged_at ON fees(account_id, charged_at);
+
+-- Periodic fees are charged at most once per account and period reference
+CREATE UNIQUE INDEX idx_fees_periodic ON fees(account_id, fee_type, reference)
+    WHERE fee_type IN ('MonthlyMaintenance', 'UnarrangedOverdraft');
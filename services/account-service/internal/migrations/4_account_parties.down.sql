@@ -0,0 +1,6 @@
+-- Drop tables
+DROP TABLE IF EXISTS account_parties;
+
+-- Drop types
+DROP TYPE IF EXISTS signing_rule;
+DROP TYPE IF EXISTS party_role;
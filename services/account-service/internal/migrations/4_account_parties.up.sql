@@ -0,0 +1,32 @@
+-- Parties to an account and the role each plays. accounts.customer_id is
+-- kept as the current primary holder.
+CREATE TYPE party_role AS ENUM ('PrimaryHolder', 'JointHolder', 'AuthorizedSignatory', 'PowerOfAttorney', 'Beneficiary');
+CREATE TYPE signing_rule AS ENUM ('AnyOne', 'All', 'TwoOf', 'None');
+
+CREATE TABLE account_parties (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    customer_id UUID NOT NULL,
+    role party_role NOT NULL,
+    signing_rule signing_rule NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE, -- Last day the role applies, inclusive
+    end_reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(100) NOT NULL DEFAULT '',
+    CHECK (end_date IS NULL OR end_date >= start_date),
+    CHECK ((role = 'Beneficiary') = (signing_rule = 'None'))
+);
+
+CREATE INDEX idx_account_parties_account_id ON account_parties(account_id);
+CREATE INDEX idx_account_parties_customer_id ON account_parties(customer_id);
+
+-- An account has exactly one primary holder at a time
+CREATE UNIQUE INDEX idx_account_parties_primary ON account_parties(account_id)
+    WHERE role = 'PrimaryHolder' AND end_date IS NULL;
+
+-- Every existing account gets its owner as primary holder
+INSERT INTO account_parties (account_id, customer_id, role, signing_rule, start_date, created_by)
+SELECT id, customer_id, 'PrimaryHolder', 'AnyOne', opened_at::date, 'migration'
+FROM accounts;
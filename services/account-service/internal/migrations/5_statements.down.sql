@@ -0,0 +1,9 @@
+-- Drop tables
+DROP TABLE IF EXISTS statement_documents;
+DROP TABLE IF EXISTS statements;
+
+-- Drop functions
+DROP FUNCTION IF EXISTS reject_statement_change();
+
+-- Drop types
+DROP TYPE IF EXISTS statement_format;
@@ -0,0 +1,43 @@
+-- Account statements. Each statement is stored once per format together with
+-- the SHA-256 hash of its content. Rows are never changed: regenerating a
+-- period inserts a new version alongside the earlier ones.
+CREATE TYPE statement_format AS ENUM ('JSON', 'CSV', 'CAMT053', 'PDF');
+
+CREATE TABLE statements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL, -- Inclusive
+    version INTEGER NOT NULL CHECK (version > 0),
+    opening_balance BIGINT NOT NULL, -- Minor units
+    closing_balance BIGINT NOT NULL, -- Minor units
+    generated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    generated_by VARCHAR(255) NOT NULL DEFAULT '',
+    CHECK (period_end >= period_start),
+    UNIQUE (account_id, period_start, period_end, version)
+);
+
+CREATE TABLE statement_documents (
+    statement_id UUID NOT NULL REFERENCES statements(id),
+    format statement_format NOT NULL,
+    content BYTEA NOT NULL,
+    content_hash CHAR(64) NOT NULL, -- Hex-encoded SHA-256 of content
+    PRIMARY KEY (statement_id, format)
+);
+
+CREATE INDEX idx_statements_account_id_period ON statements(account_id, period_end DESC);
+
+-- Reject any attempt to change or remove an issued statement
+CREATE OR REPLACE FUNCTION reject_statement_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'statements are immutable: % on % is not allowed', TG_OP, TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER statements_immutable
+    BEFORE UPDATE OR DELETE ON statements
+    FOR EACH ROW EXECUTE FUNCTION reject_statement_change();
+
+CREATE TRIGGER statement_documents_immutable
+    BEFORE UPDATE OR DELETE ON statement_documents
+    FOR EACH ROW EXECUTE FUNCTION reject_statement_change();
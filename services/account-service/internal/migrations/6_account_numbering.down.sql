@@ -0,0 +1,5 @@
+-- Drop columns
+ALTER TABLE accounts DROP COLUMN IF EXISTS iban;
+
+-- Drop sequences
+DROP SEQUENCE IF EXISTS account_number_seq;
@@ -0,0 +1,5 @@
+-- Account numbers are issued from a sequence, with a check digit, and
+-- accounts of banks with an IBAN scheme also carry their IBAN
+CREATE SEQUENCE IF NOT EXISTS account_number_seq START WITH 1;
+
+ALTER TABLE accounts ADD COLUMN iban VARCHAR(34) UNIQUE;
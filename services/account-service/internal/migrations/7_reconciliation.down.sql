@@ -0,0 +1,11 @@
+-- Drop tables
+DROP TABLE IF EXISTS reconciliation_match_postings;
+DROP TABLE IF EXISTS bank_statement_lines;
+DROP TABLE IF EXISTS reconciliation_matches;
+DROP TABLE IF EXISTS reconciliation_runs;
+DROP TABLE IF EXISTS bank_statements;
+
+-- Drop types
+DROP TYPE IF EXISTS match_kind;
+DROP TYPE IF EXISTS reconciliation_status;
+DROP TYPE IF EXISTS bank_statement_format;
@@ -0,0 +1,84 @@
+-- Reconciliation of nostro and settlement accounts against the statements
+-- their correspondent banks send. Each imported statement line is matched
+-- to the postings that account for it; lines and postings left over are
+-- the breaks worked by operations.
+CREATE TYPE bank_statement_format AS ENUM ('MT940', 'CAMT053');
+CREATE TYPE reconciliation_status AS ENUM ('Unmatched', 'Matched', 'WrittenOff');
+CREATE TYPE match_kind AS ENUM ('Auto', 'Manual', 'WriteOff');
+
+CREATE TABLE bank_statements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    format bank_statement_format NOT NULL,
+    external_id VARCHAR(70) NOT NULL, -- Statement ID and sequence number given by the bank
+    external_account VARCHAR(70) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    opening_date DATE NOT NULL,
+    opening_balance BIGINT NOT NULL, -- Minor units
+    closing_date DATE NOT NULL,
+    closing_balance BIGINT NOT NULL, -- Minor units
+    line_count INTEGER NOT NULL,
+    content_hash CHAR(64) NOT NULL, -- Hex-encoded SHA-256 of the imported file
+    imported_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    imported_by VARCHAR(255) NOT NULL DEFAULT '',
+    CHECK (closing_date >= opening_date),
+    UNIQUE (account_id, external_id)
+);
+
+CREATE TABLE reconciliation_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    as_of DATE NOT NULL,
+    rerun BOOLEAN NOT NULL DEFAULT FALSE,
+    matches_created INTEGER NOT NULL,
+    unmatched_lines INTEGER NOT NULL,
+    unmatched_postings INTEGER NOT NULL,
+    statement_balance BIGINT NOT NULL, -- Minor units
+    ledger_balance BIGINT NOT NULL, -- Minor units
+    report BYTEA NOT NULL, -- JSON
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    run_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE reconciliation_matches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    kind match_kind NOT NULL,
+    rule VARCHAR(100) NOT NULL DEFAULT '', -- Auto matches only
+    run_id UUID REFERENCES reconciliation_runs(id) DEFERRABLE INITIALLY DEFERRED, -- The run is stored after its matches
+    adjustment_posting_id UUID REFERENCES postings(id), -- Write-offs only
+    note VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    CHECK ((kind = 'WriteOff') = (adjustment_posting_id IS NOT NULL))
+);
+
+CREATE TABLE bank_statement_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    statement_id UUID NOT NULL REFERENCES bank_statements(id),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    line_number INTEGER NOT NULL,
+    value_date DATE NOT NULL,
+    booking_date DATE NOT NULL,
+    amount BIGINT NOT NULL, -- Signed minor units, money leaving the account is negative
+    reference VARCHAR(70) NOT NULL DEFAULT '',
+    bank_reference VARCHAR(70) NOT NULL DEFAULT '',
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    status reconciliation_status NOT NULL DEFAULT 'Unmatched',
+    match_id UUID REFERENCES reconciliation_matches(id),
+    CHECK ((status = 'Unmatched') = (match_id IS NULL)),
+    UNIQUE (statement_id, line_number)
+);
+
+-- A posting accounts for at most one match
+CREATE TABLE reconciliation_match_postings (
+    match_id UUID NOT NULL REFERENCES reconciliation_matches(id),
+    posting_id UUID NOT NULL UNIQUE REFERENCES postings(id),
+    PRIMARY KEY (match_id, posting_id)
+);
+
+CREATE INDEX idx_bank_statements_account_id_closing ON bank_statements(account_id, closing_date);
+CREATE INDEX idx_bank_statement_lines_account_id_status ON bank_statement_lines(account_id, status, value_date);
+CREATE INDEX idx_bank_statement_lines_match_id ON bank_statement_lines(match_id);
+CREATE INDEX idx_reconciliation_matches_account_id_kind ON reconciliation_matches(account_id, kind);
+CREATE INDEX idx_reconciliation_runs_account_id ON reconciliation_runs(account_id, run_at DESC);
@@ -0,0 +1,8 @@
+-- Drop tables
+DROP TABLE IF EXISTS fx_quotes;
+DROP TABLE IF EXISTS fx_position_accounts;
+DROP TABLE IF EXISTS fx_rates;
+
+-- Drop types
+DROP TYPE IF EXISTS fx_fixed_side;
+DROP TYPE IF EXISTS fx_quote_status;
@@ -0,0 +1,61 @@
+-- Foreign exchange. Rate table rows are append-only like the fee schedule:
+-- the latest rate effective at the time of quoting applies. Quotes record
+-- the rate, spread and unrounded amount they were priced with so that every
+-- conversion can be recomputed.
+CREATE TYPE fx_quote_status AS ENUM ('Open', 'Executed', 'Expired');
+CREATE TYPE fx_fixed_side AS ENUM ('Source', 'Target');
+
+CREATE TABLE fx_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    base_currency CHAR(3) NOT NULL,
+    quote_currency CHAR(3) NOT NULL,
+    mid_rate BIGINT NOT NULL CHECK (mid_rate > 0), -- Quote units per base unit in 10^-10
+    effective_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    source VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    CHECK (base_currency <> quote_currency)
+);
+
+-- The bank's position in each currency of a pair
+CREATE TABLE fx_position_accounts (
+    currency_pair CHAR(7) NOT NULL, -- Codes in alphabetical order, e.g. 'EUR/USD'
+    currency CHAR(3) NOT NULL,
+    account_id UUID NOT NULL UNIQUE REFERENCES accounts(id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_by VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (currency_pair, currency)
+);
+
+CREATE TABLE fx_quotes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_account_id UUID NOT NULL REFERENCES accounts(id),
+    to_account_id UUID NOT NULL REFERENCES accounts(id),
+    source_currency CHAR(3) NOT NULL,
+    target_currency CHAR(3) NOT NULL,
+    source_amount BIGINT NOT NULL CHECK (source_amount > 0), -- Minor units
+    target_amount BIGINT NOT NULL CHECK (target_amount > 0), -- Minor units
+    fixed_side fx_fixed_side NOT NULL,
+    rate_id UUID NOT NULL REFERENCES fx_rates(id),
+    mid_rate BIGINT NOT NULL, -- As in the rate table
+    inverted BOOLEAN NOT NULL,
+    segment VARCHAR(50) NOT NULL DEFAULT '',
+    spread_bps INTEGER NOT NULL CHECK (spread_bps >= 0 AND spread_bps < 10000),
+    customer_rate BIGINT NOT NULL CHECK (customer_rate > 0), -- Target units per source unit in 10^-10
+    exact_amount VARCHAR(40) NOT NULL, -- The computed side before rounding, minor units
+    status fx_quote_status NOT NULL DEFAULT 'Open',
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    executed_at TIMESTAMP WITH TIME ZONE,
+    executed_by VARCHAR(255) NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    debit_posting_id UUID REFERENCES postings(id),
+    credit_posting_id UUID REFERENCES postings(id),
+    CHECK (source_currency <> target_currency),
+    CHECK ((status = 'Executed') = (debit_posting_id IS NOT NULL AND credit_posting_id IS NOT NULL))
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_fx_rates_lookup ON fx_rates(base_currency, quote_currency, effective_from DESC);
+CREATE INDEX idx_fx_quotes_from_account_id ON fx_quotes(from_account_id, created_at);
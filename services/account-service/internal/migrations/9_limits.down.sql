@@ -0,0 +1,11 @@
+-- Drop tables
+DROP TABLE IF EXISTS limit_usages;
+DROP TABLE IF EXISTS limit_increases;
+DROP TABLE IF EXISTS customer_risk_tiers;
+DROP TABLE IF EXISTS limit_rules;
+
+-- Drop types
+DROP TYPE IF EXISTS risk_tier;
+DROP TYPE IF EXISTS limit_transaction_type;
+DROP TYPE IF EXISTS transaction_channel;
+DROP TYPE IF EXISTS limit_kind;
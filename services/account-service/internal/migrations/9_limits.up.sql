@@ -0,0 +1,63 @@
+-- Transaction limits and velocity controls. Limit rules are append-only like
+-- the fee schedule: for each scope the latest rule effective at the time of
+-- the transaction applies. A NULL channel, transaction type or risk tier
+-- applies to all of them.
+CREATE TYPE limit_kind AS ENUM ('PerTransaction', 'DailyAmount', 'MonthlyAmount', 'DailyCount', 'MonthlyCount');
+CREATE TYPE transaction_channel AS ENUM ('Branch', 'Online', 'Mobile', 'API', 'Batch');
+CREATE TYPE limit_transaction_type AS ENUM ('Transfer', 'FXConversion');
+CREATE TYPE risk_tier AS ENUM ('Low', 'Medium', 'High');
+
+CREATE TABLE limit_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind limit_kind NOT NULL,
+    channel transaction_channel,
+    transaction_type limit_transaction_type,
+    risk_tier risk_tier,
+    currency CHAR(3) NOT NULL,
+    value BIGINT NOT NULL CHECK (value >= 0), -- Minor units, or a number of transactions
+    effective_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+-- Customers without a row are in the Medium tier
+CREATE TABLE customer_risk_tiers (
+    customer_id UUID PRIMARY KEY,
+    risk_tier risk_tier NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+-- Temporary increases of one rule for one customer
+CREATE TABLE limit_increases (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL,
+    limit_rule_id UUID NOT NULL REFERENCES limit_rules(id),
+    value BIGINT NOT NULL CHECK (value >= 0),
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason VARCHAR(500) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    revoked_by VARCHAR(255) NOT NULL DEFAULT '',
+    CHECK (expires_at > starts_at)
+);
+
+-- Transactions counted against limits, written in the same database
+-- transaction as their postings
+CREATE TABLE limit_usages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    channel transaction_channel NOT NULL,
+    transaction_type limit_transaction_type NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Minor units
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_limit_rules_currency ON limit_rules(currency, effective_from);
+CREATE INDEX idx_limit_increases_customer_id ON limit_increases(customer_id, expires_at);
+CREATE INDEX idx_limit_usages_customer_id ON limit_usages(customer_id, currency, occurred_at);
@@ -0,0 +1,211 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountStatus represents the status of an account
+type AccountStatus string
+
+const (
+	AccountStatusActive AccountStatus = "Active"
+	AccountStatusFrozen AccountStatus = "Frozen"
+	AccountStatusClosed AccountStatus = "Closed"
+)
+
+// IsValid checks if the status is valid
+func (s AccountStatus) IsValid() bool {
+	switch s {
+	case AccountStatusActive, AccountStatusFrozen, AccountStatusClosed:
+		return true
+	}
+	return false
+}
+
+// AccountType represents the product type of an account
+type AccountType string
+
+const (
+	AccountTypeChecking AccountType = "Checking"
+	AccountTypeSavings  AccountType = "Savings"
+)
+
+// AccountTypes lists every supported account type
+var AccountTypes = []AccountType{AccountTypeChecking, AccountTypeSavings}
+
+// IsValid checks if the account type is valid
+func (t AccountType) IsValid() bool {
+	switch t {
+	case AccountTypeChecking, AccountTypeSavings:
+		return true
+	}
+	return false
+}
+
+// PostingType represents the kind of ledger posting
+type PostingType string
+
+const (
+	PostingTypeCredit      PostingType = "Credit"
+	PostingTypeDebit       PostingType = "Debit"
+	PostingTypeHoldCapture PostingType = "HoldCapture"
+	PostingTypeInterest    PostingType = "Interest"
+	PostingTypeFee         PostingType = "Fee"
+	PostingTypeFeeReversal PostingType = "FeeReversal"
+)
+
+// IsValid checks if the posting type is valid
+func (t PostingType) IsValid() bool {
+	switch t {
+	case PostingTypeCredit, PostingTypeDebit, PostingTypeHoldCapture, PostingTypeInterest,
+		PostingTypeFee, PostingTypeFeeReversal:
+		return true
+	}
+	return false
+}
+
+// Account represents a deposit account in the core banking system.
+// All monetary amounts are held in minor units of the account currency.
+type Account struct {
+	ID             uuid.UUID     `json:"id" db:"id"`
+	AccountNumber  string        `json:"account_number" db:"account_number"`
+	IBAN           string        `json:"iban,omitempty" db:"iban"` // Empty when the bank issues no IBANs
+	CustomerID     uuid.UUID     `json:"customer_id" db:"customer_id"`
+	AccountType    AccountType   `json:"account_type" db:"account_type"`
+	Currency       string        `json:"currency" db:"currency"`
+	LedgerBalance  int64         `json:"ledger_balance" db:"ledger_balance"`
+	OverdraftLimit int64         `json:"overdraft_limit" db:"overdraft_limit"` // Arranged overdraft, minor units
+	OverdraftRate  int64         `json:"overdraft_rate" db:"overdraft_rate"`   // Annual debit interest rate in millionths
+	Segment        string        `json:"segment,omitempty" db:"segment"`       // Customer segment used by fee waivers
+	Status         AccountStatus `json:"status" db:"status"`
+	OpenedAt       time.Time     `json:"opened_at" db:"opened_at"`
+	ClosedAt       *time.Time    `json:"closed_at,omitempty" db:"closed_at"`
+	CreatedAt      time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at" db:"updated_at"`
+	Version        int           `json:"version" db:"version"` // Optimistic locking
+}
+
+// Posting represents a single immutable entry on an account's ledger.
+// Amount is signed: credits are positive and debits are negative.
+type Posting struct {
+	ID          uuid.UUID   `json:"id" db:"id"`
+	AccountID   uuid.UUID   `json:"account_id" db:"account_id"`
+	PostingType PostingType `json:"posting_type" db:"posting_type"`
+	Amount      int64       `json:"amount" db:"amount"`
+	Currency    string      `json:"currency" db:"currency"`
+	Reference   string      `json:"reference" db:"reference"`
+	Description string      `json:"description" db:"description"`
+	HoldID      *uuid.UUID  `json:"hold_id,omitempty" db:"hold_id"`
+	ValueDate   time.Time   `json:"value_date" db:"value_date"`
+	BookedAt    time.Time   `json:"booked_at" db:"booked_at"`
+}
+
+// TransferKey records the transfer made under an idempotency key, so that
+// the request repeated with the key returns it rather than moving the
+// funds again
+type TransferKey struct {
+	Key             string     `json:"key" db:"idempotency_key"`
+	FromAccountID   uuid.UUID  `json:"from_account_id" db:"from_account_id"`
+	ToAccountID     uuid.UUID  `json:"to_account_id" db:"to_account_id"`
+	Amount          int64      `json:"amount" db:"amount"` // Minor units
+	DebitPostingID  uuid.UUID  `json:"debit_posting_id" db:"debit_posting_id"`
+	CreditPostingID uuid.UUID  `json:"credit_posting_id" db:"credit_posting_id"`
+	FeeID           *uuid.UUID `json:"fee_id,omitempty" db:"fee_id"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Balance is a point-in-time view of an account's funds
+type Balance struct {
+	AccountID      uuid.UUID `json:"account_id"`
+	Currency       string    `json:"currency"`
+	LedgerBalance  int64     `json:"ledger_balance"`
+	HeldAmount     int64     `json:"held_amount"`
+	OverdraftLimit int64     `json:"overdraft_limit"`
+	Available      int64     `json:"available_balance"`
+	AsOf           time.Time `json:"as_of"`
+}
+
+// NewBalance derives the available balance from the ledger balance, the
+// total amount currently earmarked by active holds and the arranged
+// overdraft limit.
+func NewBalance(account *Account, heldAmount int64, asOf time.Time) *Balance {
+	return &Balance{
+		AccountID:      account.ID,
+		Currency:       account.Currency,
+		LedgerBalance:  account.LedgerBalance,
+		HeldAmount:     heldAmount,
+		OverdraftLimit: account.OverdraftLimit,
+		Available:      account.LedgerBalance - heldAmount + account.OverdraftLimit,
+		AsOf:           asOf,
+	}
+}
+
+// Value implements driver.Valuer for AccountStatus
+func (s AccountStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for AccountStatus
+func (s *AccountStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = AccountStatusActive
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan AccountStatus")
+	}
+	*s = AccountStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid AccountStatus value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for AccountType
+func (t AccountType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for AccountType
+func (t *AccountType) Scan(value interface{}) error {
+	if value == nil {
+		*t = AccountTypeChecking
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan AccountType")
+	}
+	*t = AccountType(str)
+	if !t.IsValid() {
+		return errors.New("invalid AccountType value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for PostingType
+func (t PostingType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for PostingType
+func (t *PostingType) Scan(value interface{}) error {
+	if value == nil {
+		*t = PostingTypeCredit
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan PostingType")
+	}
+	*t = PostingType(str)
+	if !t.IsValid() {
+		return errors.New("invalid PostingType value")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventDirection is whether funds entered or left the customer's account
+type EventDirection string
+
+const (
+	EventDirectionIn  EventDirection = "In"
+	EventDirectionOut EventDirection = "Out"
+)
+
+// IsValid checks if the event direction is valid
+func (d EventDirection) IsValid() bool {
+	return d == EventDirectionIn || d == EventDirectionOut
+}
+
+// AMLAlertStatus is where an alert is in its investigation
+type AMLAlertStatus string
+
+const (
+	AMLAlertStatusOpen      AMLAlertStatus = "Open"
+	AMLAlertStatusEscalated AMLAlertStatus = "Escalated" // Reported as suspicious
+	AMLAlertStatusDismissed AMLAlertStatus = "Dismissed" // Found to be legitimate
+)
+
+// IsValid checks if the alert status is valid
+func (s AMLAlertStatus) IsValid() bool {
+	switch s {
+	case AMLAlertStatusOpen, AMLAlertStatusEscalated, AMLAlertStatusDismissed:
+		return true
+	}
+	return false
+}
+
+// ErrAlertClosed is returned when closing an alert that is no longer open
+var ErrAlertClosed = errors.New("alert is already closed")
+
+// TransactionEvent is one posted transaction from the point of view of one
+// customer. A transfer between two accounts adds an Out event for the payer
+// and an In event for the payee.
+type TransactionEvent struct {
+	ID                    uuid.UUID       `json:"id" db:"id"`
+	Sequence              int64           `json:"sequence" db:"sequence"`
+	CustomerID            uuid.UUID       `json:"customer_id" db:"customer_id"`
+	AccountID             uuid.UUID       `json:"account_id" db:"account_id"`
+	Direction             EventDirection  `json:"direction" db:"direction"`
+	Amount                int64           `json:"amount" db:"amount"` // Minor units, positive
+	Currency              string          `json:"currency" db:"currency"`
+	CounterpartyAccountID *uuid.UUID      `json:"counterparty_account_id,omitempty" db:"counterparty_account_id"`
+	CounterpartyCountry   string          `json:"counterparty_country,omitempty" db:"counterparty_country"`
+	Channel               Channel         `json:"channel" db:"channel"`
+	TransactionType       TransactionType `json:"transaction_type" db:"transaction_type"`
+	Reference             string          `json:"reference" db:"reference"`
+	OccurredAt            time.Time       `json:"occurred_at" db:"occurred_at"`
+	MonitoredAt           *time.Time      `json:"monitored_at,omitempty" db:"monitored_at"`
+}
+
+// AMLAlert is raised when a monitoring rule fires on a customer's activity
+type AMLAlert struct {
+	ID             uuid.UUID      `json:"id" db:"id"`
+	RuleID         string         `json:"rule_id" db:"rule_id"`
+	RuleType       string         `json:"rule_type" db:"rule_type"`
+	RulesVersion   string         `json:"rules_version" db:"rules_version"`
+	CustomerID     uuid.UUID      `json:"customer_id" db:"customer_id"`
+	TriggerEventID uuid.UUID      `json:"trigger_event_id" db:"trigger_event_id"`
+	Summary        string         `json:"summary" db:"summary"`
+	Status         AMLAlertStatus `json:"status" db:"status"`
+	RaisedAt       time.Time      `json:"raised_at" db:"raised_at"`
+	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
+	ClosedAt       *time.Time     `json:"closed_at,omitempty" db:"closed_at"`
+	ClosedBy       string         `json:"closed_by,omitempty" db:"closed_by"`
+	Resolution     string         `json:"resolution,omitempty" db:"resolution"`
+	Evidence       []uuid.UUID    `json:"evidence" db:"-"` // Event IDs, oldest first
+}
+
+// Close records the outcome of the investigation of an open alert
+func (a *AMLAlert) Close(status AMLAlertStatus, closedBy, resolution string, now time.Time) error {
+	if a.Status != AMLAlertStatusOpen {
+		return ErrAlertClosed
+	}
+	if status != AMLAlertStatusEscalated && status != AMLAlertStatusDismissed {
+		return errors.New("alert can only be closed as Escalated or Dismissed")
+	}
+	a.Status = status
+	a.ClosedAt = &now
+	a.ClosedBy = closedBy
+	a.Resolution = resolution
+	return nil
+}
+
+// AMLAlertFilter selects alerts to list. Zero fields match any alert.
+type AMLAlertFilter struct {
+	CustomerID *uuid.UUID
+	Status     AMLAlertStatus
+	RuleID     string
+	Limit      int
+}
+
+// Value implements driver.Valuer for EventDirection
+func (d EventDirection) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+// Scan implements sql.Scanner for EventDirection
+func (d *EventDirection) Scan(value interface{}) error {
+	if value == nil {
+		*d = EventDirectionOut
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan EventDirection")
+	}
+	*d = EventDirection(str)
+	if !d.IsValid() {
+		return errors.New("invalid EventDirection value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for AMLAlertStatus
+func (s AMLAlertStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for AMLAlertStatus
+func (s *AMLAlertStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = AMLAlertStatusOpen
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan AMLAlertStatus")
+	}
+	*s = AMLAlertStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid AMLAlertStatus value")
+	}
+	return nil
+}
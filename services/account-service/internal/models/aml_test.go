@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAMLAlert_Close(t *testing.T) {
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	alert := &AMLAlert{Status: AMLAlertStatusOpen}
+	assert.Error(t, alert.Close(AMLAlertStatusOpen, "analyst", "Reviewed", now))
+	assert.Equal(t, AMLAlertStatusOpen, alert.Status)
+
+	require.NoError(t, alert.Close(AMLAlertStatusDismissed, "analyst", "Payroll pattern", now))
+	assert.Equal(t, AMLAlertStatusDismissed, alert.Status)
+	assert.Equal(t, &now, alert.ClosedAt)
+	assert.Equal(t, "analyst", alert.ClosedBy)
+	assert.Equal(t, "Payroll pattern", alert.Resolution)
+
+	// A closed alert keeps its outcome
+	assert.ErrorIs(t, alert.Close(AMLAlertStatusEscalated, "supervisor", "Reported", now), ErrAlertClosed)
+	assert.Equal(t, AMLAlertStatusDismissed, alert.Status)
+}
+
+func TestAMLEnums_Scan(t *testing.T) {
+	var direction EventDirection
+	require.NoError(t, direction.Scan("In"))
+	assert.Equal(t, EventDirectionIn, direction)
+	require.NoError(t, direction.Scan(nil))
+	assert.Equal(t, EventDirectionOut, direction)
+	assert.Error(t, direction.Scan("Sideways"))
+
+	var status AMLAlertStatus
+	require.NoError(t, status.Scan("Escalated"))
+	assert.Equal(t, AMLAlertStatusEscalated, status)
+	require.NoError(t, status.Scan(nil))
+	assert.Equal(t, AMLAlertStatusOpen, status)
+	assert.Error(t, status.Scan("Closed"))
+}
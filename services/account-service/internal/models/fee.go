@@ -0,0 +1,159 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeeType represents the event a fee is charged for
+type FeeType string
+
+const (
+	FeeTypeMonthlyMaintenance  FeeType = "MonthlyMaintenance"
+	FeeTypeUnarrangedOverdraft FeeType = "UnarrangedOverdraft"
+	FeeTypeInsufficientFunds   FeeType = "InsufficientFunds"
+	FeeTypeTransfer            FeeType = "Transfer"
+)
+
+// IsValid checks if the fee type is valid
+func (t FeeType) IsValid() bool {
+	switch t {
+	case FeeTypeMonthlyMaintenance, FeeTypeUnarrangedOverdraft, FeeTypeInsufficientFunds, FeeTypeTransfer:
+		return true
+	}
+	return false
+}
+
+// FeeStatus represents the lifecycle status of a fee
+type FeeStatus string
+
+const (
+	FeeStatusCharged  FeeStatus = "Charged"
+	FeeStatusWaived   FeeStatus = "Waived"
+	FeeStatusReversed FeeStatus = "Reversed"
+)
+
+// IsValid checks if the fee status is valid
+func (s FeeStatus) IsValid() bool {
+	switch s {
+	case FeeStatusCharged, FeeStatusWaived, FeeStatusReversed:
+		return true
+	}
+	return false
+}
+
+// ErrFeeNotReversible is returned when reversing a fee that is not charged
+var ErrFeeNotReversible = errors.New("only charged fees can be reversed")
+
+// FeeRule is one effective-dated entry of the fee schedule. Rules are never
+// updated; a change is made by adding a rule with a later effective date, and
+// an amount of zero switches the fee off.
+type FeeRule struct {
+	ID               uuid.UUID   `json:"id" db:"id"`
+	AccountType      AccountType `json:"account_type" db:"account_type"`
+	FeeType          FeeType     `json:"fee_type" db:"fee_type"`
+	Currency         string      `json:"currency" db:"currency"`
+	Amount           int64       `json:"amount" db:"amount"` // Minor units
+	WaiverMinBalance *int64      `json:"waiver_min_balance,omitempty" db:"waiver_min_balance"`
+	WaiverSegments   []string    `json:"waiver_segments,omitempty" db:"waiver_segments"`
+	EffectiveFrom    time.Time   `json:"effective_from" db:"effective_from"`
+	CreatedAt        time.Time   `json:"created_at" db:"created_at"`
+	CreatedBy        string      `json:"created_by" db:"created_by"`
+}
+
+// WaiverFor reports whether the fee is waived for the account and, if so,
+// why. The minimum balance waiver is checked against the given ledger
+// balance, that of the day the fee is charged for.
+func (r *FeeRule) WaiverFor(account *Account, balance int64) (bool, string) {
+	if account.Segment != "" {
+		for _, segment := range r.WaiverSegments {
+			if segment == account.Segment {
+				return true, fmt.Sprintf("customer segment %s", segment)
+			}
+		}
+	}
+	if r.WaiverMinBalance != nil && balance >= *r.WaiverMinBalance {
+		return true, fmt.Sprintf("balance at or above %d", *r.WaiverMinBalance)
+	}
+	return false, ""
+}
+
+// Fee is a fee charged to, or waived for, an account
+type Fee struct {
+	ID                uuid.UUID  `json:"id" db:"id"`
+	AccountID         uuid.UUID  `json:"account_id" db:"account_id"`
+	FeeRuleID         uuid.UUID  `json:"fee_rule_id" db:"fee_rule_id"`
+	FeeType           FeeType    `json:"fee_type" db:"fee_type"`
+	Amount            int64      `json:"amount" db:"amount"` // Minor units, positive
+	Currency          string     `json:"currency" db:"currency"`
+	Status            FeeStatus  `json:"status" db:"status"`
+	Reference         string     `json:"reference" db:"reference"`
+	WaiverReason      string     `json:"waiver_reason,omitempty" db:"waiver_reason"`
+	PostingID         *uuid.UUID `json:"posting_id,omitempty" db:"posting_id"`
+	ReversalPostingID *uuid.UUID `json:"reversal_posting_id,omitempty" db:"reversal_posting_id"`
+	ReversalReason    string     `json:"reversal_reason,omitempty" db:"reversal_reason"`
+	ReversedBy        string     `json:"reversed_by,omitempty" db:"reversed_by"`
+	ChargedAt         time.Time  `json:"charged_at" db:"charged_at"`
+	ReversedAt        *time.Time `json:"reversed_at,omitempty" db:"reversed_at"`
+}
+
+// Reverse marks a charged fee as reversed by the given posting
+func (f *Fee) Reverse(postingID uuid.UUID, reason, reversedBy string, at time.Time) error {
+	if f.Status != FeeStatusCharged {
+		return ErrFeeNotReversible
+	}
+	f.Status = FeeStatusReversed
+	f.ReversalPostingID = &postingID
+	f.ReversalReason = reason
+	f.ReversedBy = reversedBy
+	f.ReversedAt = &at
+	return nil
+}
+
+// Value implements driver.Valuer for FeeType
+func (t FeeType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for FeeType
+func (t *FeeType) Scan(value interface{}) error {
+	if value == nil {
+		*t = FeeTypeMonthlyMaintenance
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan FeeType")
+	}
+	*t = FeeType(str)
+	if !t.IsValid() {
+		return errors.New("invalid FeeType value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for FeeStatus
+func (s FeeStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for FeeStatus
+func (s *FeeStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = FeeStatusCharged
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan FeeStatus")
+	}
+	*s = FeeStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid FeeStatus value")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeRule_WaiverFor(t *testing.T) {
+	minBalance := int64(100000)
+	rule := &FeeRule{
+		FeeType:          FeeTypeMonthlyMaintenance,
+		Amount:           500,
+		WaiverMinBalance: &minBalance,
+		WaiverSegments:   []string{"Student", "Staff"},
+	}
+
+	tests := []struct {
+		name    string
+		account *Account
+		balance int64
+		waived  bool
+	}{
+		{"below minimum balance", &Account{}, 99999, false},
+		{"at minimum balance", &Account{}, 100000, true},
+		{"current balance above but balance charged for below", &Account{LedgerBalance: 200000}, 99999, false},
+		{"waived segment", &Account{Segment: "Student"}, 0, true},
+		{"other segment", &Account{Segment: "Retail"}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waived, reason := rule.WaiverFor(tt.account, tt.balance)
+			assert.Equal(t, tt.waived, waived)
+			assert.Equal(t, tt.waived, reason != "")
+		})
+	}
+
+	noWaiver := &FeeRule{FeeType: FeeTypeTransfer, Amount: 25}
+	waived, _ := noWaiver.WaiverFor(&Account{}, 1<<40)
+	assert.False(t, waived)
+}
+
+func TestFee_Reverse(t *testing.T) {
+	fee := &Fee{Status: FeeStatusCharged, Amount: 500}
+	postingID := uuid.New()
+	now := time.Now()
+
+	require.NoError(t, fee.Reverse(postingID, "goodwill", "agent-1", now))
+	assert.Equal(t, FeeStatusReversed, fee.Status)
+	assert.Equal(t, postingID, *fee.ReversalPostingID)
+	assert.Equal(t, "goodwill", fee.ReversalReason)
+
+	assert.ErrorIs(t, fee.Reverse(uuid.New(), "again", "agent-1", now), ErrFeeNotReversible)
+
+	waived := &Fee{Status: FeeStatusWaived}
+	assert.ErrorIs(t, waived.Reverse(uuid.New(), "x", "agent-1", now), ErrFeeNotReversible)
+}
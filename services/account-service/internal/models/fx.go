@@ -0,0 +1,150 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FXRate is an entry of the exchange rate table. Rows are append-only: the
+// latest rate effective at the time of quoting applies.
+type FXRate struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	BaseCurrency  string    `json:"base_currency" db:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency" db:"quote_currency"`
+	MidRate       int64     `json:"mid_rate" db:"mid_rate"` // Quote units per base unit in 10^-10
+	EffectiveFrom time.Time `json:"effective_from" db:"effective_from"`
+	Source        string    `json:"source" db:"source"` // The file or RPC the rate was loaded from
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	CreatedBy     string    `json:"created_by" db:"created_by"`
+}
+
+// FXQuoteStatus represents where an FX quote stands
+type FXQuoteStatus string
+
+const (
+	FXQuoteStatusOpen     FXQuoteStatus = "Open"
+	FXQuoteStatusExecuted FXQuoteStatus = "Executed"
+	FXQuoteStatusExpired  FXQuoteStatus = "Expired"
+)
+
+// IsValid checks if the FX quote status is valid
+func (s FXQuoteStatus) IsValid() bool {
+	switch s {
+	case FXQuoteStatusOpen, FXQuoteStatusExecuted, FXQuoteStatusExpired:
+		return true
+	}
+	return false
+}
+
+// FXFixedSide is the side of a conversion whose amount the customer chose
+type FXFixedSide string
+
+const (
+	// FXFixedSideSource converts a given amount; the target amount is rounded down
+	FXFixedSideSource FXFixedSide = "Source"
+	// FXFixedSideTarget delivers a given amount; the source amount is rounded up
+	FXFixedSideTarget FXFixedSide = "Target"
+)
+
+// IsValid checks if the FX fixed side is valid
+func (s FXFixedSide) IsValid() bool {
+	return s == FXFixedSideSource || s == FXFixedSideTarget
+}
+
+// FXQuote is a priced conversion between two accounts that can be executed
+// until it expires. It records everything needed to recompute its amounts:
+// the rate table entry, whether it was inverted, the spread and the
+// resulting customer rate, and the computed amount before rounding.
+type FXQuote struct {
+	ID              uuid.UUID     `json:"id" db:"id"`
+	FromAccountID   uuid.UUID     `json:"from_account_id" db:"from_account_id"`
+	ToAccountID     uuid.UUID     `json:"to_account_id" db:"to_account_id"`
+	SourceCurrency  string        `json:"source_currency" db:"source_currency"`
+	TargetCurrency  string        `json:"target_currency" db:"target_currency"`
+	SourceAmount    int64         `json:"source_amount" db:"source_amount"` // Minor units
+	TargetAmount    int64         `json:"target_amount" db:"target_amount"` // Minor units
+	FixedSide       FXFixedSide   `json:"fixed_side" db:"fixed_side"`
+	RateID          uuid.UUID     `json:"rate_id" db:"rate_id"`
+	MidRate         int64         `json:"mid_rate" db:"mid_rate"` // As in the rate table, 10^-10
+	Inverted        bool          `json:"inverted" db:"inverted"` // The table quotes the pair the other way round
+	Segment         string        `json:"segment,omitempty" db:"segment"`
+	SpreadBps       int           `json:"spread_bps" db:"spread_bps"`
+	CustomerRate    int64         `json:"customer_rate" db:"customer_rate"` // Target units per source unit, 10^-10
+	ExactAmount     string        `json:"exact_amount" db:"exact_amount"`   // The computed side before rounding, minor units
+	Status          FXQuoteStatus `json:"status" db:"status"`
+	ExpiresAt       time.Time     `json:"expires_at" db:"expires_at"`
+	CreatedAt       time.Time     `json:"created_at" db:"created_at"`
+	CreatedBy       string        `json:"created_by" db:"created_by"`
+	ExecutedAt      *time.Time    `json:"executed_at,omitempty" db:"executed_at"`
+	ExecutedBy      string        `json:"executed_by,omitempty" db:"executed_by"`
+	Reference       string        `json:"reference,omitempty" db:"reference"`
+	DebitPostingID  *uuid.UUID    `json:"debit_posting_id,omitempty" db:"debit_posting_id"`
+	CreditPostingID *uuid.UUID    `json:"credit_posting_id,omitempty" db:"credit_posting_id"`
+}
+
+// StatusAt returns the quote's status at the given time, treating an open
+// quote past its expiry as expired
+func (q *FXQuote) StatusAt(now time.Time) FXQuoteStatus {
+	if q.Status == FXQuoteStatusOpen && !now.Before(q.ExpiresAt) {
+		return FXQuoteStatusExpired
+	}
+	return q.Status
+}
+
+// FXPositionAccount is the account that holds the bank's position in one
+// currency of a currency pair. Every conversion between the pair's
+// currencies books through the pair's two position accounts.
+type FXPositionAccount struct {
+	CurrencyPair string    `json:"currency_pair" db:"currency_pair"` // e.g. "EUR/USD", codes in alphabetical order
+	Currency     string    `json:"currency" db:"currency"`
+	AccountID    uuid.UUID `json:"account_id" db:"account_id"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	UpdatedBy    string    `json:"updated_by" db:"updated_by"`
+}
+
+// Value implements driver.Valuer for FXQuoteStatus
+func (s FXQuoteStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for FXQuoteStatus
+func (s *FXQuoteStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = FXQuoteStatusOpen
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan FXQuoteStatus")
+	}
+	*s = FXQuoteStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid FXQuoteStatus value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for FXFixedSide
+func (s FXFixedSide) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for FXFixedSide
+func (s *FXFixedSide) Scan(value interface{}) error {
+	if value == nil {
+		*s = FXFixedSideSource
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan FXFixedSide")
+	}
+	*s = FXFixedSide(str)
+	if !s.IsValid() {
+		return errors.New("invalid FXFixedSide value")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFXEnums_Scan(t *testing.T) {
+	var status FXQuoteStatus
+	require.NoError(t, status.Scan("Executed"))
+	assert.Equal(t, FXQuoteStatusExecuted, status)
+	require.NoError(t, status.Scan(nil))
+	assert.Equal(t, FXQuoteStatusOpen, status)
+	assert.Error(t, status.Scan("Cancelled"))
+	assert.Error(t, status.Scan(1))
+
+	var side FXFixedSide
+	require.NoError(t, side.Scan("Target"))
+	assert.Equal(t, FXFixedSideTarget, side)
+	require.NoError(t, side.Scan(nil))
+	assert.Equal(t, FXFixedSideSource, side)
+	assert.Error(t, side.Scan("Both"))
+}
+
+func TestFXQuote_StatusAt(t *testing.T) {
+	expiry := time.Date(2024, 4, 1, 12, 0, 30, 0, time.UTC)
+	quote := &FXQuote{Status: FXQuoteStatusOpen, ExpiresAt: expiry}
+
+	assert.Equal(t, FXQuoteStatusOpen, quote.StatusAt(expiry.Add(-time.Second)))
+	assert.Equal(t, FXQuoteStatusExpired, quote.StatusAt(expiry))
+
+	quote.Status = FXQuoteStatusExecuted
+	assert.Equal(t, FXQuoteStatusExecuted, quote.StatusAt(expiry.Add(time.Hour)))
+}
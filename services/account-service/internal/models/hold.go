@@ -0,0 +1,168 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HoldType represents the reason category of a hold
+type HoldType string
+
+const (
+	HoldTypeCardAuthorization HoldType = "CardAuthorization"
+	HoldTypeLegalGarnishment  HoldType = "LegalGarnishment"
+	HoldTypePayment           HoldType = "Payment"
+	HoldTypeManual            HoldType = "Manual"
+)
+
+// IsValid checks if the hold type is valid
+func (t HoldType) IsValid() bool {
+	switch t {
+	case HoldTypeCardAuthorization, HoldTypeLegalGarnishment, HoldTypePayment, HoldTypeManual:
+		return true
+	}
+	return false
+}
+
+// IsLegal reports whether the hold is imposed by a legal order. Legal holds
+// can be placed on frozen accounts and are never released by the expirer
+// while the account is frozen.
+func (t HoldType) IsLegal() bool {
+	return t == HoldTypeLegalGarnishment
+}
+
+// HoldStatus represents the lifecycle status of a hold
+type HoldStatus string
+
+const (
+	HoldStatusActive   HoldStatus = "Active"
+	HoldStatusReleased HoldStatus = "Released"
+	HoldStatusCaptured HoldStatus = "Captured"
+	HoldStatusExpired  HoldStatus = "Expired"
+)
+
+// IsValid checks if the hold status is valid
+func (s HoldStatus) IsValid() bool {
+	switch s {
+	case HoldStatusActive, HoldStatusReleased, HoldStatusCaptured, HoldStatusExpired:
+		return true
+	}
+	return false
+}
+
+// Hold earmarks funds on an account so they cannot be spent elsewhere.
+// Amount is the originally held amount; RemainingAmount shrinks as the hold
+// is partially released or captured.
+type Hold struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	AccountID       uuid.UUID  `json:"account_id" db:"account_id"`
+	HoldType        HoldType   `json:"hold_type" db:"hold_type"`
+	Amount          int64      `json:"amount" db:"amount"`
+	RemainingAmount int64      `json:"remaining_amount" db:"remaining_amount"`
+	Currency        string     `json:"currency" db:"currency"`
+	Reason          string     `json:"reason" db:"reason"`
+	Reference       string     `json:"reference" db:"reference"`
+	Status          HoldStatus `json:"status" db:"status"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+	CreatedBy       uuid.UUID  `json:"created_by" db:"created_by"`
+}
+
+// ErrHoldNotActive is returned when an operation requires an active hold
+var ErrHoldNotActive = errors.New("hold is not active")
+
+// ErrHoldAmountExceeded is returned when releasing or capturing more than remains on a hold
+var ErrHoldAmountExceeded = errors.New("amount exceeds remaining held amount")
+
+// IsExpired reports whether the hold has passed its expiry at the given time
+func (h *Hold) IsExpired(now time.Time) bool {
+	return h.ExpiresAt != nil && !now.Before(*h.ExpiresAt)
+}
+
+// Release releases part of the remaining held amount. Releasing the full
+// remaining amount moves the hold to Released.
+func (h *Hold) Release(amount int64) error {
+	if h.Status != HoldStatusActive {
+		return ErrHoldNotActive
+	}
+	if amount <= 0 || amount > h.RemainingAmount {
+		return ErrHoldAmountExceeded
+	}
+	h.RemainingAmount -= amount
+	if h.RemainingAmount == 0 {
+		h.Status = HoldStatusReleased
+	}
+	return nil
+}
+
+// Capture converts part of the held amount into a posting. Any amount left
+// over after a capture is released, so the hold always ends up Captured.
+func (h *Hold) Capture(amount int64) error {
+	if h.Status != HoldStatusActive {
+		return ErrHoldNotActive
+	}
+	if amount <= 0 || amount > h.RemainingAmount {
+		return ErrHoldAmountExceeded
+	}
+	h.RemainingAmount = 0
+	h.Status = HoldStatusCaptured
+	return nil
+}
+
+// Expire marks the hold as expired and frees the remaining amount
+func (h *Hold) Expire() error {
+	if h.Status != HoldStatusActive {
+		return ErrHoldNotActive
+	}
+	h.RemainingAmount = 0
+	h.Status = HoldStatusExpired
+	return nil
+}
+
+// Value implements driver.Valuer for HoldType
+func (t HoldType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for HoldType
+func (t *HoldType) Scan(value interface{}) error {
+	if value == nil {
+		*t = HoldTypeManual
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan HoldType")
+	}
+	*t = HoldType(str)
+	if !t.IsValid() {
+		return errors.New("invalid HoldType value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for HoldStatus
+func (s HoldStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for HoldStatus
+func (s *HoldStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = HoldStatusActive
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan HoldStatus")
+	}
+	*s = HoldStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid HoldStatus value")
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldType_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		holdType HoldType
+		valid    bool
+	}{
+		{"CardAuthorization is valid", HoldTypeCardAuthorization, true},
+		{"LegalGarnishment is valid", HoldTypeLegalGarnishment, true},
+		{"Payment is valid", HoldTypePayment, true},
+		{"Manual is valid", HoldTypeManual, true},
+		{"Empty is invalid", HoldType(""), false},
+		{"Unknown is invalid", HoldType("Unknown"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, tt.holdType.IsValid())
+		})
+	}
+}
+
+func TestHoldStatus_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    HoldStatus
+		wantErr bool
+	}{
+		{"valid Active", "Active", HoldStatusActive, false},
+		{"valid Captured", "Captured", HoldStatusCaptured, false},
+		{"nil input", nil, HoldStatusActive, false},
+		{"invalid string", "Invalid", HoldStatus(""), true},
+		{"wrong type", 123, HoldStatus(""), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got HoldStatus
+			err := got.Scan(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func newActiveHold(amount int64) *Hold {
+	return &Hold{
+		HoldType:        HoldTypeCardAuthorization,
+		Amount:          amount,
+		RemainingAmount: amount,
+		Currency:        "USD",
+		Status:          HoldStatusActive,
+	}
+}
+
+func TestHold_Release(t *testing.T) {
+	t.Run("partial release keeps hold active", func(t *testing.T) {
+		hold := newActiveHold(10000)
+		require.NoError(t, hold.Release(2500))
+		assert.Equal(t, int64(7500), hold.RemainingAmount)
+		assert.Equal(t, HoldStatusActive, hold.Status)
+	})
+
+	t.Run("full release moves hold to Released", func(t *testing.T) {
+		hold := newActiveHold(10000)
+		require.NoError(t, hold.Release(10000))
+		assert.Equal(t, int64(0), hold.RemainingAmount)
+		assert.Equal(t, HoldStatusReleased, hold.Status)
+	})
+
+	t.Run("release more than remaining fails", func(t *testing.T) {
+		hold := newActiveHold(10000)
+		assert.ErrorIs(t, hold.Release(10001), ErrHoldAmountExceeded)
+	})
+
+	t.Run("release of inactive hold fails", func(t *testing.T) {
+		hold := newActiveHold(10000)
+		hold.Status = HoldStatusCaptured
+		assert.ErrorIs(t, hold.Release(1), ErrHoldNotActive)
+	})
+}
+
+func TestHold_Capture(t *testing.T) {
+	t.Run("partial capture releases the remainder", func(t *testing.T) {
+		hold := newActiveHold(10000)
+		require.NoError(t, hold.Capture(8000))
+		assert.Equal(t, int64(0), hold.RemainingAmount)
+		assert.Equal(t, HoldStatusCaptured, hold.Status)
+	})
+
+	t.Run("capture more than remaining fails", func(t *testing.T) {
+		hold := newActiveHold(10000)
+		require.NoError(t, hold.Release(5000))
+		assert.ErrorIs(t, hold.Capture(6000), ErrHoldAmountExceeded)
+	})
+}
+
+func TestHold_IsExpired(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	hold := newActiveHold(100)
+	assert.False(t, hold.IsExpired(now), "hold without expiry never expires")
+
+	hold.ExpiresAt = &past
+	assert.True(t, hold.IsExpired(now))
+
+	hold.ExpiresAt = &future
+	assert.False(t, hold.IsExpired(now))
+}
+
+func TestNewBalance(t *testing.T) {
+	account := &Account{Currency: "EUR", LedgerBalance: 50000}
+	balance := NewBalance(account, 12000, time.Now())
+
+	assert.Equal(t, int64(50000), balance.LedgerBalance)
+	assert.Equal(t, int64(12000), balance.HeldAmount)
+	assert.Equal(t, int64(38000), balance.Available)
+	assert.Equal(t, "EUR", balance.Currency)
+}
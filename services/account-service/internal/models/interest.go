@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InterestAccrual is the interest earned by, and the overdraft interest owed
+// on, an account for a single day. Amounts are in micro-units of the minor
+// currency unit so that sub-cent fractions survive until capitalization.
+type InterestAccrual struct {
+	AccountID    uuid.UUID `json:"account_id" db:"account_id"`
+	AccrualDate  time.Time `json:"accrual_date" db:"accrual_date"`
+	Balance      int64     `json:"balance" db:"balance"`             // Value-dated end-of-day balance, minor units
+	Rate         int64     `json:"rate" db:"rate"`                   // Effective annual rate in millionths
+	AmountMicros int64     `json:"amount_micros" db:"amount_micros"` // Minor units * 1,000,000
+	DebitRate    int64     `json:"debit_rate" db:"debit_rate"`       // Overdraft rate in millionths
+	DebitMicros  int64     `json:"debit_micros" db:"debit_micros"`   // Overdraft interest owed, minor units * 1,000,000
+	CalculatedAt time.Time `json:"calculated_at" db:"calculated_at"`
+}
+
+// InterestCapitalization records accrued interest moved onto the ledger for a period
+type InterestCapitalization struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	AccountID      uuid.UUID  `json:"account_id" db:"account_id"`
+	PeriodStart    time.Time  `json:"period_start" db:"period_start"`
+	PeriodEnd      time.Time  `json:"period_end" db:"period_end"`
+	Amount         int64      `json:"amount" db:"amount"` // Minor units
+	PostingID      *uuid.UUID `json:"posting_id,omitempty" db:"posting_id"`
+	DebitAmount    int64      `json:"debit_amount" db:"debit_amount"` // Overdraft interest, minor units
+	DebitPostingID *uuid.UUID `json:"debit_posting_id,omitempty" db:"debit_posting_id"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+}
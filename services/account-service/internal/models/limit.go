@@ -0,0 +1,285 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LimitKind is what a transaction limit bounds
+type LimitKind string
+
+const (
+	LimitKindPerTransaction LimitKind = "PerTransaction"
+	LimitKindDailyAmount    LimitKind = "DailyAmount"
+	LimitKindMonthlyAmount  LimitKind = "MonthlyAmount"
+	LimitKindDailyCount     LimitKind = "DailyCount"
+	LimitKindMonthlyCount   LimitKind = "MonthlyCount"
+)
+
+// IsValid checks if the limit kind is valid
+func (k LimitKind) IsValid() bool {
+	switch k {
+	case LimitKindPerTransaction, LimitKindDailyAmount, LimitKindMonthlyAmount,
+		LimitKindDailyCount, LimitKindMonthlyCount:
+		return true
+	}
+	return false
+}
+
+// IsCount reports whether the limit bounds a number of transactions rather
+// than an amount
+func (k LimitKind) IsCount() bool {
+	return k == LimitKindDailyCount || k == LimitKindMonthlyCount
+}
+
+// Window returns the period containing now that usage is counted over, in
+// UTC calendar days or months. A per-transaction limit has no window and
+// returns zero times.
+func (k LimitKind) Window(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	switch k {
+	case LimitKindDailyAmount, LimitKindDailyCount:
+		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1)
+	case LimitKindMonthlyAmount, LimitKindMonthlyCount:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+	return time.Time{}, time.Time{}
+}
+
+// Channel is the channel a transaction was initiated through
+type Channel string
+
+const (
+	ChannelBranch Channel = "Branch"
+	ChannelOnline Channel = "Online"
+	ChannelMobile Channel = "Mobile"
+	ChannelAPI    Channel = "API"
+	ChannelBatch  Channel = "Batch"
+)
+
+// IsValid checks if the channel is valid
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelBranch, ChannelOnline, ChannelMobile, ChannelAPI, ChannelBatch:
+		return true
+	}
+	return false
+}
+
+// TransactionType is the kind of transaction a limit applies to
+type TransactionType string
+
+const (
+	TransactionTypeTransfer     TransactionType = "Transfer"
+	TransactionTypeFXConversion TransactionType = "FXConversion"
+)
+
+// IsValid checks if the transaction type is valid
+func (t TransactionType) IsValid() bool {
+	return t == TransactionTypeTransfer || t == TransactionTypeFXConversion
+}
+
+// RiskTier is the customer risk tier limits are set for
+type RiskTier string
+
+const (
+	RiskTierLow    RiskTier = "Low"
+	RiskTierMedium RiskTier = "Medium"
+	RiskTierHigh   RiskTier = "High"
+)
+
+// DefaultRiskTier applies to customers with no recorded risk tier
+const DefaultRiskTier = RiskTierMedium
+
+// IsValid checks if the risk tier is valid
+func (t RiskTier) IsValid() bool {
+	switch t {
+	case RiskTierLow, RiskTierMedium, RiskTierHigh:
+		return true
+	}
+	return false
+}
+
+// LimitRule is one effective-dated transaction limit for a currency. A rule
+// with an empty channel, transaction type or risk tier applies to all of
+// them. Like fee rules, limit rules are never updated: a change is made by
+// adding a rule for the same scope with a later effective date.
+type LimitRule struct {
+	ID              uuid.UUID       `json:"id" db:"id"`
+	Kind            LimitKind       `json:"kind" db:"kind"`
+	Channel         Channel         `json:"channel,omitempty" db:"channel"`
+	TransactionType TransactionType `json:"transaction_type,omitempty" db:"transaction_type"`
+	RiskTier        RiskTier        `json:"risk_tier,omitempty" db:"risk_tier"`
+	Currency        string          `json:"currency" db:"currency"`
+	Value           int64           `json:"value" db:"value"` // Minor units, or a number of transactions for count limits
+	EffectiveFrom   time.Time       `json:"effective_from" db:"effective_from"`
+	CreatedAt       time.Time       `json:"created_at" db:"created_at"`
+	CreatedBy       string          `json:"created_by" db:"created_by"`
+}
+
+// Applies reports whether the rule bounds transactions of a customer in the
+// risk tier through the channel. An empty channel or transaction type
+// matches rules for any.
+func (r *LimitRule) Applies(tier RiskTier, channel Channel, txType TransactionType) bool {
+	return (r.RiskTier == "" || r.RiskTier == tier) &&
+		(r.Channel == "" || channel == "" || r.Channel == channel) &&
+		(r.TransactionType == "" || txType == "" || r.TransactionType == txType)
+}
+
+// CustomerRiskTier records the risk tier limits apply to a customer under
+type CustomerRiskTier struct {
+	CustomerID uuid.UUID `json:"customer_id" db:"customer_id"`
+	RiskTier   RiskTier  `json:"risk_tier" db:"risk_tier"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	UpdatedBy  string    `json:"updated_by" db:"updated_by"`
+}
+
+// LimitIncrease temporarily raises one limit rule for one customer. It
+// replaces the rule's value between StartsAt and ExpiresAt unless revoked.
+type LimitIncrease struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	CustomerID  uuid.UUID  `json:"customer_id" db:"customer_id"`
+	LimitRuleID uuid.UUID  `json:"limit_rule_id" db:"limit_rule_id"`
+	Value       int64      `json:"value" db:"value"`
+	StartsAt    time.Time  `json:"starts_at" db:"starts_at"`
+	ExpiresAt   time.Time  `json:"expires_at" db:"expires_at"`
+	Reason      string     `json:"reason" db:"reason"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	CreatedBy   string     `json:"created_by" db:"created_by"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	RevokedBy   string     `json:"revoked_by,omitempty" db:"revoked_by"`
+}
+
+// ActiveAt reports whether the increase applies at the given time
+func (i *LimitIncrease) ActiveAt(now time.Time) bool {
+	return i.RevokedAt == nil && !now.Before(i.StartsAt) && now.Before(i.ExpiresAt)
+}
+
+// LimitUsage is a transaction counted against a customer's limits
+type LimitUsage struct {
+	ID              uuid.UUID       `json:"id" db:"id"`
+	CustomerID      uuid.UUID       `json:"customer_id" db:"customer_id"`
+	AccountID       uuid.UUID       `json:"account_id" db:"account_id"`
+	Channel         Channel         `json:"channel" db:"channel"`
+	TransactionType TransactionType `json:"transaction_type" db:"transaction_type"`
+	Currency        string          `json:"currency" db:"currency"`
+	Amount          int64           `json:"amount" db:"amount"` // Minor units, positive
+	Reference       string          `json:"reference" db:"reference"`
+	OccurredAt      time.Time       `json:"occurred_at" db:"occurred_at"`
+}
+
+// LimitUsageFilter selects the usage a limit rule counts. An empty channel
+// or transaction type counts usage through any.
+type LimitUsageFilter struct {
+	CustomerID      uuid.UUID
+	Channel         Channel
+	TransactionType TransactionType
+	Currency        string
+	From            time.Time
+	To              time.Time
+}
+
+// Value implements driver.Valuer for LimitKind
+func (k LimitKind) Value() (driver.Value, error) {
+	return string(k), nil
+}
+
+// Scan implements sql.Scanner for LimitKind
+func (k *LimitKind) Scan(value interface{}) error {
+	if value == nil {
+		*k = LimitKindPerTransaction
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan LimitKind")
+	}
+	*k = LimitKind(str)
+	if !k.IsValid() {
+		return errors.New("invalid LimitKind value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for Channel. An empty channel is stored as
+// NULL, meaning any channel.
+func (c Channel) Value() (driver.Value, error) {
+	if c == "" {
+		return nil, nil
+	}
+	return string(c), nil
+}
+
+// Scan implements sql.Scanner for Channel
+func (c *Channel) Scan(value interface{}) error {
+	if value == nil {
+		*c = ""
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan Channel")
+	}
+	*c = Channel(str)
+	if !c.IsValid() {
+		return errors.New("invalid Channel value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for TransactionType. An empty type is
+// stored as NULL, meaning any transaction type.
+func (t TransactionType) Value() (driver.Value, error) {
+	if t == "" {
+		return nil, nil
+	}
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for TransactionType
+func (t *TransactionType) Scan(value interface{}) error {
+	if value == nil {
+		*t = ""
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan TransactionType")
+	}
+	*t = TransactionType(str)
+	if !t.IsValid() {
+		return errors.New("invalid TransactionType value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for RiskTier. An empty tier is stored as
+// NULL, meaning any tier.
+func (t RiskTier) Value() (driver.Value, error) {
+	if t == "" {
+		return nil, nil
+	}
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for RiskTier
+func (t *RiskTier) Scan(value interface{}) error {
+	if value == nil {
+		*t = ""
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan RiskTier")
+	}
+	*t = RiskTier(str)
+	if !t.IsValid() {
+		return errors.New("invalid RiskTier value")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitKind_Window(t *testing.T) {
+	now := time.Date(2024, 2, 29, 23, 30, 0, 0, time.FixedZone("EST", -5*3600))
+
+	start, end := LimitKindDailyAmount.Window(now)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = LimitKindMonthlyCount.Window(now)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = LimitKindPerTransaction.Window(now)
+	assert.True(t, start.IsZero())
+	assert.True(t, end.IsZero())
+}
+
+func TestLimitRule_Applies(t *testing.T) {
+	anyScope := &LimitRule{Kind: LimitKindDailyAmount}
+	assert.True(t, anyScope.Applies(RiskTierLow, ChannelMobile, TransactionTypeTransfer))
+
+	mobile := &LimitRule{Kind: LimitKindDailyCount, Channel: ChannelMobile, RiskTier: RiskTierHigh}
+	assert.True(t, mobile.Applies(RiskTierHigh, ChannelMobile, TransactionTypeTransfer))
+	assert.False(t, mobile.Applies(RiskTierHigh, ChannelOnline, TransactionTypeTransfer))
+	assert.False(t, mobile.Applies(RiskTierMedium, ChannelMobile, TransactionTypeTransfer))
+	// An unspecified channel lists the rules for every channel
+	assert.True(t, mobile.Applies(RiskTierHigh, "", ""))
+}
+
+func TestLimitIncrease_ActiveAt(t *testing.T) {
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	increase := &LimitIncrease{StartsAt: start, ExpiresAt: start.AddDate(0, 0, 7)}
+
+	assert.False(t, increase.ActiveAt(start.Add(-time.Second)))
+	assert.True(t, increase.ActiveAt(start))
+	assert.False(t, increase.ActiveAt(increase.ExpiresAt))
+
+	revoked := start.Add(time.Hour)
+	increase.RevokedAt = &revoked
+	assert.False(t, increase.ActiveAt(start.Add(2*time.Hour)))
+}
+
+func TestLimitEnums_Scan(t *testing.T) {
+	var kind LimitKind
+	require.NoError(t, kind.Scan("MonthlyAmount"))
+	assert.Equal(t, LimitKindMonthlyAmount, kind)
+	assert.Error(t, kind.Scan("Weekly"))
+
+	var channel Channel
+	require.NoError(t, channel.Scan("Mobile"))
+	assert.Equal(t, ChannelMobile, channel)
+	require.NoError(t, channel.Scan(nil))
+	assert.Equal(t, Channel(""), channel)
+	assert.Error(t, channel.Scan(1))
+
+	value, err := Channel("").Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	var tier RiskTier
+	require.NoError(t, tier.Scan(nil))
+	assert.Equal(t, RiskTier(""), tier)
+	assert.Error(t, tier.Scan("Extreme"))
+}
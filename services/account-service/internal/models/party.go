@@ -0,0 +1,218 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PartyRole represents the capacity in which a customer is party to an account
+type PartyRole string
+
+const (
+	PartyRolePrimaryHolder       PartyRole = "PrimaryHolder"
+	PartyRoleJointHolder         PartyRole = "JointHolder"
+	PartyRoleAuthorizedSignatory PartyRole = "AuthorizedSignatory"
+	PartyRolePowerOfAttorney     PartyRole = "PowerOfAttorney"
+	PartyRoleBeneficiary         PartyRole = "Beneficiary"
+)
+
+// IsValid checks if the party role is valid
+func (r PartyRole) IsValid() bool {
+	switch r {
+	case PartyRolePrimaryHolder, PartyRoleJointHolder, PartyRoleAuthorizedSignatory,
+		PartyRolePowerOfAttorney, PartyRoleBeneficiary:
+		return true
+	}
+	return false
+}
+
+// CanSign reports whether the role carries signing authority. Beneficiaries
+// are entitled to the funds but cannot operate the account.
+func (r PartyRole) CanSign() bool {
+	return r.IsValid() && r != PartyRoleBeneficiary
+}
+
+// SigningRule describes who must sign alongside a party for an instruction
+// to be authorized
+type SigningRule string
+
+const (
+	// SigningRuleAnyOne lets the party authorize alone
+	SigningRuleAnyOne SigningRule = "AnyOne"
+	// SigningRuleAll requires every party on the account under the All rule to sign together
+	SigningRuleAll SigningRule = "All"
+	// SigningRuleTwoOf requires the party and any one other signing party,
+	// whatever that party's own rule
+	SigningRuleTwoOf SigningRule = "TwoOf"
+	// SigningRuleNone is for parties without signing authority
+	SigningRuleNone SigningRule = "None"
+)
+
+// IsValid checks if the signing rule is valid
+func (s SigningRule) IsValid() bool {
+	switch s {
+	case SigningRuleAnyOne, SigningRuleAll, SigningRuleTwoOf, SigningRuleNone:
+		return true
+	}
+	return false
+}
+
+// AllowedFor reports whether the signing rule can be given to a party in the role
+func (s SigningRule) AllowedFor(role PartyRole) bool {
+	if !s.IsValid() || !role.IsValid() {
+		return false
+	}
+	return (s == SigningRuleNone) == !role.CanSign()
+}
+
+// ErrPartyEnded is returned when changing a party whose role has already ended
+var ErrPartyEnded = errors.New("party role has already ended")
+
+// ErrInvalidEndDate is returned when a party's end date precedes its start date
+var ErrInvalidEndDate = errors.New("end date must not be before start date")
+
+// AccountParty links a customer to an account in a role for a period of
+// time. StartDate and EndDate are calendar dates in UTC and both inclusive;
+// a nil EndDate means the role is open-ended.
+type AccountParty struct {
+	ID          uuid.UUID   `json:"id" db:"id"`
+	AccountID   uuid.UUID   `json:"account_id" db:"account_id"`
+	CustomerID  uuid.UUID   `json:"customer_id" db:"customer_id"`
+	Role        PartyRole   `json:"role" db:"role"`
+	SigningRule SigningRule `json:"signing_rule" db:"signing_rule"`
+	StartDate   time.Time   `json:"start_date" db:"start_date"`
+	EndDate     *time.Time  `json:"end_date,omitempty" db:"end_date"`
+	EndReason   string      `json:"end_reason,omitempty" db:"end_reason"`
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+	CreatedBy   string      `json:"created_by" db:"created_by"`
+}
+
+// partyDate truncates a time to its UTC calendar date
+func partyDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// IsActiveOn reports whether the role applies on the date of the given time
+func (p *AccountParty) IsActiveOn(at time.Time) bool {
+	day := partyDate(at)
+	if day.Before(partyDate(p.StartDate)) {
+		return false
+	}
+	return p.EndDate == nil || !day.After(partyDate(*p.EndDate))
+}
+
+// Overlaps reports whether the two parties' periods share at least one day
+func (p *AccountParty) Overlaps(other *AccountParty) bool {
+	if p.EndDate != nil && partyDate(*p.EndDate).Before(partyDate(other.StartDate)) {
+		return false
+	}
+	if other.EndDate != nil && partyDate(*other.EndDate).Before(partyDate(p.StartDate)) {
+		return false
+	}
+	return true
+}
+
+// End sets the last day on which the role applies. A role that ended before
+// today cannot be changed.
+func (p *AccountParty) End(endDate time.Time, reason string, now time.Time) error {
+	if p.EndDate != nil && partyDate(*p.EndDate).Before(partyDate(now)) {
+		return ErrPartyEnded
+	}
+	endDate = partyDate(endDate)
+	if endDate.Before(partyDate(p.StartDate)) {
+		return ErrInvalidEndDate
+	}
+	p.EndDate = &endDate
+	p.EndReason = reason
+	return nil
+}
+
+// SignersAuthorized reports whether the given customers together hold
+// enough signing authority over an account with the given parties at the
+// given time. Authority is met when any signer may sign alone, when a
+// signer who may sign with one other has signed with any other signing
+// party, or when every party under the All rule has signed.
+func SignersAuthorized(parties []*AccountParty, signers []uuid.UUID, at time.Time) bool {
+	signed := make(map[uuid.UUID]bool, len(signers))
+	for _, id := range signers {
+		signed[id] = true
+	}
+
+	// A customer may hold several roles; each counts once
+	signing := make(map[uuid.UUID]bool)
+	twoOf, allSigned, allRequired := false, true, false
+	for _, p := range parties {
+		if !p.IsActiveOn(at) || !p.Role.CanSign() || p.SigningRule == SigningRuleNone {
+			continue
+		}
+		if signed[p.CustomerID] {
+			signing[p.CustomerID] = true
+		}
+		switch p.SigningRule {
+		case SigningRuleAnyOne:
+			if signed[p.CustomerID] {
+				return true
+			}
+		case SigningRuleTwoOf:
+			if signed[p.CustomerID] {
+				twoOf = true
+			}
+		case SigningRuleAll:
+			allRequired = true
+			if !signed[p.CustomerID] {
+				allSigned = false
+			}
+		}
+	}
+
+	return (twoOf && len(signing) >= 2) || (allRequired && allSigned)
+}
+
+// Value implements driver.Valuer for PartyRole
+func (r PartyRole) Value() (driver.Value, error) {
+	return string(r), nil
+}
+
+// Scan implements sql.Scanner for PartyRole
+func (r *PartyRole) Scan(value interface{}) error {
+	if value == nil {
+		*r = PartyRolePrimaryHolder
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan PartyRole")
+	}
+	*r = PartyRole(str)
+	if !r.IsValid() {
+		return errors.New("invalid PartyRole value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for SigningRule
+func (s SigningRule) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for SigningRule
+func (s *SigningRule) Scan(value interface{}) error {
+	if value == nil {
+		*s = SigningRuleAnyOne
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan SigningRule")
+	}
+	*s = SigningRule(str)
+	if !s.IsValid() {
+		return errors.New("invalid SigningRule value")
+	}
+	return nil
+}
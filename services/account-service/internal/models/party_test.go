@@ -0,0 +1,133 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func day(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestSigningRule_AllowedFor(t *testing.T) {
+	assert.True(t, SigningRuleAnyOne.AllowedFor(PartyRolePrimaryHolder))
+	assert.True(t, SigningRuleTwoOf.AllowedFor(PartyRoleAuthorizedSignatory))
+	assert.True(t, SigningRuleNone.AllowedFor(PartyRoleBeneficiary))
+	assert.False(t, SigningRuleAll.AllowedFor(PartyRoleBeneficiary))
+	assert.False(t, SigningRuleNone.AllowedFor(PartyRoleJointHolder))
+	assert.False(t, SigningRule("Some").AllowedFor(PartyRoleJointHolder))
+}
+
+func TestAccountParty_IsActiveOn(t *testing.T) {
+	end := day("2024-03-31")
+	party := &AccountParty{StartDate: day("2024-01-01"), EndDate: &end}
+
+	assert.False(t, party.IsActiveOn(day("2023-12-31").Add(23*time.Hour)))
+	assert.True(t, party.IsActiveOn(day("2024-01-01")))
+	assert.True(t, party.IsActiveOn(day("2024-03-31").Add(23*time.Hour)))
+	assert.False(t, party.IsActiveOn(day("2024-04-01")))
+
+	open := &AccountParty{StartDate: day("2024-01-01")}
+	assert.True(t, open.IsActiveOn(day("2099-01-01")))
+	assert.True(t, open.Overlaps(party))
+	assert.False(t, party.Overlaps(&AccountParty{StartDate: day("2024-04-01")}))
+}
+
+func TestAccountParty_End(t *testing.T) {
+	now := day("2024-06-15")
+	party := &AccountParty{StartDate: day("2024-06-01")}
+
+	assert.ErrorIs(t, party.End(day("2024-05-31"), "too early", now), ErrInvalidEndDate)
+
+	require.NoError(t, party.End(day("2024-06-30"), "relationship ended", now))
+	assert.Equal(t, day("2024-06-30"), *party.EndDate)
+
+	// A future end date can still be brought forward
+	require.NoError(t, party.End(day("2024-06-20"), "brought forward", now))
+
+	assert.ErrorIs(t, party.End(day("2024-07-01"), "again", day("2024-06-21")), ErrPartyEnded)
+}
+
+func TestSignersAuthorized(t *testing.T) {
+	now := day("2024-06-15")
+	alice, bob, carol, dave, erin := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	ended := day("2024-06-01")
+
+	party := func(customer uuid.UUID, role PartyRole, rule SigningRule) *AccountParty {
+		return &AccountParty{CustomerID: customer, Role: role, SigningRule: rule, StartDate: day("2024-01-01")}
+	}
+
+	tests := []struct {
+		name    string
+		parties []*AccountParty
+		signers []uuid.UUID
+		want    bool
+	}{
+		{"any one signs alone", []*AccountParty{party(alice, PartyRolePrimaryHolder, SigningRuleAnyOne)}, []uuid.UUID{alice}, true},
+		{"non-party signer", []*AccountParty{party(alice, PartyRolePrimaryHolder, SigningRuleAnyOne)}, []uuid.UUID{bob}, false},
+		{"two of needs a second signer", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleTwoOf),
+			party(bob, PartyRoleJointHolder, SigningRuleTwoOf),
+			party(carol, PartyRoleAuthorizedSignatory, SigningRuleTwoOf),
+		}, []uuid.UUID{carol}, false},
+		{"two of satisfied", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleTwoOf),
+			party(bob, PartyRoleJointHolder, SigningRuleTwoOf),
+			party(carol, PartyRoleAuthorizedSignatory, SigningRuleTwoOf),
+		}, []uuid.UUID{alice, carol}, true},
+		{"two of with an any one co-signer", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleTwoOf),
+			party(bob, PartyRolePowerOfAttorney, SigningRuleAnyOne),
+		}, []uuid.UUID{alice, bob}, true},
+		{"two of with an all co-signer", []*AccountParty{
+			party(alice, PartyRoleAuthorizedSignatory, SigningRuleTwoOf),
+			party(bob, PartyRolePrimaryHolder, SigningRuleAll),
+			party(carol, PartyRoleJointHolder, SigningRuleAll),
+		}, []uuid.UUID{alice, bob}, true},
+		{"all co-signer alone", []*AccountParty{
+			party(alice, PartyRoleAuthorizedSignatory, SigningRuleTwoOf),
+			party(bob, PartyRolePrimaryHolder, SigningRuleAll),
+			party(carol, PartyRoleJointHolder, SigningRuleAll),
+		}, []uuid.UUID{bob}, false},
+		{"two of with a co-signer without signing authority", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleTwoOf),
+			party(bob, PartyRoleJointHolder, SigningRuleNone),
+			party(dave, PartyRoleBeneficiary, SigningRuleNone),
+		}, []uuid.UUID{alice, bob, dave}, false},
+		{"two of with the same customer in two roles", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleTwoOf),
+			party(alice, PartyRoleAuthorizedSignatory, SigningRuleTwoOf),
+			party(bob, PartyRoleJointHolder, SigningRuleTwoOf),
+		}, []uuid.UUID{alice}, false},
+		{"all requires everyone", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleAll),
+			party(bob, PartyRoleJointHolder, SigningRuleAll),
+		}, []uuid.UUID{alice}, false},
+		{"all satisfied", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleAll),
+			party(bob, PartyRoleJointHolder, SigningRuleAll),
+		}, []uuid.UUID{bob, alice}, true},
+		{"beneficiary cannot sign", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleAll),
+			party(dave, PartyRoleBeneficiary, SigningRuleNone),
+		}, []uuid.UUID{dave}, false},
+		{"ended party cannot sign", []*AccountParty{
+			party(alice, PartyRolePrimaryHolder, SigningRuleAll),
+			{CustomerID: erin, Role: PartyRolePowerOfAttorney, SigningRule: SigningRuleAnyOne, StartDate: day("2024-01-01"), EndDate: &ended},
+		}, []uuid.UUID{erin}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SignersAuthorized(tt.parties, tt.signers, now))
+		})
+	}
+}
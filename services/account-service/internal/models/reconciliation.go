@@ -0,0 +1,206 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BankStatementFormat is the format a correspondent bank statement arrived in
+type BankStatementFormat string
+
+const (
+	BankStatementFormatMT940   BankStatementFormat = "MT940"
+	BankStatementFormatCAMT053 BankStatementFormat = "CAMT053"
+)
+
+// IsValid checks if the bank statement format is valid
+func (f BankStatementFormat) IsValid() bool {
+	return f == BankStatementFormatMT940 || f == BankStatementFormatCAMT053
+}
+
+// ReconciliationStatus is where a statement line stands in reconciliation
+type ReconciliationStatus string
+
+const (
+	ReconciliationStatusUnmatched  ReconciliationStatus = "Unmatched"
+	ReconciliationStatusMatched    ReconciliationStatus = "Matched"
+	ReconciliationStatusWrittenOff ReconciliationStatus = "WrittenOff"
+)
+
+// IsValid checks if the reconciliation status is valid
+func (s ReconciliationStatus) IsValid() bool {
+	switch s {
+	case ReconciliationStatusUnmatched, ReconciliationStatusMatched, ReconciliationStatusWrittenOff:
+		return true
+	}
+	return false
+}
+
+// MatchKind records how statement lines and postings came to be matched
+type MatchKind string
+
+const (
+	// MatchKindAuto is made by a reconciliation rule and undone by a rerun
+	MatchKindAuto MatchKind = "Auto"
+	// MatchKindManual is made by an operator and survives reruns
+	MatchKindManual MatchKind = "Manual"
+	// MatchKindWriteOff clears a break against an adjustment posting
+	MatchKindWriteOff MatchKind = "WriteOff"
+)
+
+// IsValid checks if the match kind is valid
+func (k MatchKind) IsValid() bool {
+	switch k {
+	case MatchKindAuto, MatchKindManual, MatchKindWriteOff:
+		return true
+	}
+	return false
+}
+
+// ErrAlreadyReconciled is returned when matching a line or posting that is
+// already part of a match
+var ErrAlreadyReconciled = errors.New("already reconciled")
+
+// BankStatement is a statement imported from a correspondent bank for a
+// nostro or settlement account. Dates are calendar dates in UTC.
+type BankStatement struct {
+	ID              uuid.UUID           `json:"id" db:"id"`
+	AccountID       uuid.UUID           `json:"account_id" db:"account_id"`
+	Format          BankStatementFormat `json:"format" db:"format"`
+	ExternalID      string              `json:"external_id" db:"external_id"` // The bank's statement ID and sequence number
+	ExternalAccount string              `json:"external_account" db:"external_account"`
+	Currency        string              `json:"currency" db:"currency"`
+	OpeningDate     time.Time           `json:"opening_date" db:"opening_date"`
+	OpeningBalance  int64               `json:"opening_balance" db:"opening_balance"`
+	ClosingDate     time.Time           `json:"closing_date" db:"closing_date"`
+	ClosingBalance  int64               `json:"closing_balance" db:"closing_balance"`
+	LineCount       int                 `json:"line_count" db:"line_count"`
+	ContentHash     string              `json:"content_hash" db:"content_hash"`
+	ImportedAt      time.Time           `json:"imported_at" db:"imported_at"`
+	ImportedBy      string              `json:"imported_by" db:"imported_by"`
+}
+
+// BankStatementLine is one entry of an imported statement. Amount is signed
+// from our side: money arriving on the account is positive.
+type BankStatementLine struct {
+	ID            uuid.UUID            `json:"id" db:"id"`
+	StatementID   uuid.UUID            `json:"statement_id" db:"statement_id"`
+	AccountID     uuid.UUID            `json:"account_id" db:"account_id"`
+	LineNumber    int                  `json:"line_number" db:"line_number"`
+	ValueDate     time.Time            `json:"value_date" db:"value_date"`
+	BookingDate   time.Time            `json:"booking_date" db:"booking_date"`
+	Amount        int64                `json:"amount" db:"amount"`
+	Reference     string               `json:"reference" db:"reference"`
+	BankReference string               `json:"bank_reference" db:"bank_reference"`
+	Description   string               `json:"description" db:"description"`
+	Status        ReconciliationStatus `json:"status" db:"status"`
+	MatchID       *uuid.UUID           `json:"match_id,omitempty" db:"match_id"`
+}
+
+// ReconciliationMatch ties statement lines to the postings that account for
+// them. A write-off clears a break with an adjustment posting, which joins
+// the match's postings so that it is not a break in turn.
+type ReconciliationMatch struct {
+	ID                  uuid.UUID   `json:"id" db:"id"`
+	AccountID           uuid.UUID   `json:"account_id" db:"account_id"`
+	Kind                MatchKind   `json:"kind" db:"kind"`
+	Rule                string      `json:"rule,omitempty" db:"rule"`
+	RunID               *uuid.UUID  `json:"run_id,omitempty" db:"run_id"`
+	LineIDs             []uuid.UUID `json:"line_ids" db:"-"`
+	PostingIDs          []uuid.UUID `json:"posting_ids" db:"-"`
+	AdjustmentPostingID *uuid.UUID  `json:"adjustment_posting_id,omitempty" db:"adjustment_posting_id"`
+	Note                string      `json:"note,omitempty" db:"note"`
+	CreatedAt           time.Time   `json:"created_at" db:"created_at"`
+	CreatedBy           string      `json:"created_by" db:"created_by"`
+}
+
+// ReconciliationRun records one reconciliation of an account up to a date
+// together with its report
+type ReconciliationRun struct {
+	ID                uuid.UUID `json:"id" db:"id"`
+	AccountID         uuid.UUID `json:"account_id" db:"account_id"`
+	AsOf              time.Time `json:"as_of" db:"as_of"`
+	Rerun             bool      `json:"rerun" db:"rerun"`
+	MatchesCreated    int       `json:"matches_created" db:"matches_created"`
+	UnmatchedLines    int       `json:"unmatched_lines" db:"unmatched_lines"`
+	UnmatchedPostings int       `json:"unmatched_postings" db:"unmatched_postings"`
+	StatementBalance  int64     `json:"statement_balance" db:"statement_balance"`
+	LedgerBalance     int64     `json:"ledger_balance" db:"ledger_balance"`
+	Report            []byte    `json:"-" db:"report"`
+	RunAt             time.Time `json:"run_at" db:"run_at"`
+	RunBy             string    `json:"run_by" db:"run_by"`
+}
+
+// Difference is the statement balance less the ledger balance
+func (r *ReconciliationRun) Difference() int64 {
+	return r.StatementBalance - r.LedgerBalance
+}
+
+// Value implements driver.Valuer for BankStatementFormat
+func (f BankStatementFormat) Value() (driver.Value, error) {
+	return string(f), nil
+}
+
+// Scan implements sql.Scanner for BankStatementFormat
+func (f *BankStatementFormat) Scan(value interface{}) error {
+	if value == nil {
+		*f = BankStatementFormatMT940
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan BankStatementFormat")
+	}
+	*f = BankStatementFormat(str)
+	if !f.IsValid() {
+		return errors.New("invalid BankStatementFormat value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for ReconciliationStatus
+func (s ReconciliationStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for ReconciliationStatus
+func (s *ReconciliationStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = ReconciliationStatusUnmatched
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ReconciliationStatus")
+	}
+	*s = ReconciliationStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid ReconciliationStatus value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for MatchKind
+func (k MatchKind) Value() (driver.Value, error) {
+	return string(k), nil
+}
+
+// Scan implements sql.Scanner for MatchKind
+func (k *MatchKind) Scan(value interface{}) error {
+	if value == nil {
+		*k = MatchKindAuto
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan MatchKind")
+	}
+	*k = MatchKind(str)
+	if !k.IsValid() {
+		return errors.New("invalid MatchKind value")
+	}
+	return nil
+}
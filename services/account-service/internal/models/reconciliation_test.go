@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciliationEnums_IsValid(t *testing.T) {
+	assert.True(t, BankStatementFormatCAMT053.IsValid())
+	assert.False(t, BankStatementFormat("BAI2").IsValid())
+	assert.True(t, ReconciliationStatusWrittenOff.IsValid())
+	assert.False(t, ReconciliationStatus("Pending").IsValid())
+	assert.True(t, MatchKindManual.IsValid())
+	assert.False(t, MatchKind("Fuzzy").IsValid())
+}
+
+func TestReconciliationEnums_Scan(t *testing.T) {
+	var status ReconciliationStatus
+	require.NoError(t, status.Scan("Matched"))
+	assert.Equal(t, ReconciliationStatusMatched, status)
+	require.NoError(t, status.Scan(nil))
+	assert.Equal(t, ReconciliationStatusUnmatched, status)
+	assert.Error(t, status.Scan("Open"))
+	assert.Error(t, status.Scan(1))
+
+	var kind MatchKind
+	require.NoError(t, kind.Scan("WriteOff"))
+	assert.Equal(t, MatchKindWriteOff, kind)
+	assert.Error(t, kind.Scan("Rule"))
+
+	var format BankStatementFormat
+	require.NoError(t, format.Scan("MT940"))
+	assert.Equal(t, BankStatementFormatMT940, format)
+	assert.Error(t, format.Scan([]byte("MT940")))
+}
+
+func TestReconciliationRun_Difference(t *testing.T) {
+	run := &ReconciliationRun{StatementBalance: 8250, LedgerBalance: 7900}
+	assert.Equal(t, int64(350), run.Difference())
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatementFormat represents a rendering of an account statement
+type StatementFormat string
+
+const (
+	StatementFormatJSON    StatementFormat = "JSON"
+	StatementFormatCSV     StatementFormat = "CSV"
+	StatementFormatCAMT053 StatementFormat = "CAMT053"
+	StatementFormatPDF     StatementFormat = "PDF"
+)
+
+// StatementFormats lists every format a statement is rendered in
+var StatementFormats = []StatementFormat{StatementFormatJSON, StatementFormatCSV, StatementFormatCAMT053, StatementFormatPDF}
+
+// IsValid checks if the statement format is valid
+func (f StatementFormat) IsValid() bool {
+	switch f {
+	case StatementFormatJSON, StatementFormatCSV, StatementFormatCAMT053, StatementFormatPDF:
+		return true
+	}
+	return false
+}
+
+// ContentType returns the MIME type of the format
+func (f StatementFormat) ContentType() string {
+	switch f {
+	case StatementFormatJSON:
+		return "application/json"
+	case StatementFormatCSV:
+		return "text/csv"
+	case StatementFormatCAMT053:
+		return "application/xml"
+	case StatementFormatPDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+// ErrStatementTampered is returned when a stored statement document no longer
+// matches the content hash recorded when it was generated
+var ErrStatementTampered = errors.New("statement content does not match its hash")
+
+// Statement records an account statement issued for a period. Statements are
+// immutable: regenerating a period creates a new version alongside the old.
+type Statement struct {
+	ID             uuid.UUID `json:"id" db:"id"`
+	AccountID      uuid.UUID `json:"account_id" db:"account_id"`
+	PeriodStart    time.Time `json:"period_start" db:"period_start"`
+	PeriodEnd      time.Time `json:"period_end" db:"period_end"`
+	Version        int       `json:"version" db:"version"`
+	OpeningBalance int64     `json:"opening_balance" db:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance" db:"closing_balance"`
+	GeneratedAt    time.Time `json:"generated_at" db:"generated_at"`
+	GeneratedBy    string    `json:"generated_by" db:"generated_by"`
+}
+
+// StatementDocument is one stored rendering of a statement
+type StatementDocument struct {
+	StatementID uuid.UUID       `json:"statement_id" db:"statement_id"`
+	Format      StatementFormat `json:"format" db:"format"`
+	Content     []byte          `json:"-" db:"content"`
+	ContentHash string          `json:"content_hash" db:"content_hash"`
+}
+
+// NewStatementDocument wraps rendered content together with its hash
+func NewStatementDocument(statementID uuid.UUID, format StatementFormat, content []byte) *StatementDocument {
+	return &StatementDocument{
+		StatementID: statementID,
+		Format:      format,
+		Content:     content,
+		ContentHash: HashContent(content),
+	}
+}
+
+// Verify checks the content still matches the hash recorded for it
+func (d *StatementDocument) Verify() error {
+	if HashContent(d.Content) != d.ContentHash {
+		return ErrStatementTampered
+	}
+	return nil
+}
+
+// HashContent returns the hex-encoded SHA-256 hash of the content
+func HashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Value implements driver.Valuer for StatementFormat
+func (f StatementFormat) Value() (driver.Value, error) {
+	return string(f), nil
+}
+
+// Scan implements sql.Scanner for StatementFormat
+func (f *StatementFormat) Scan(value interface{}) error {
+	if value == nil {
+		*f = StatementFormatJSON
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan StatementFormat")
+	}
+	*f = StatementFormat(str)
+	if !f.IsValid() {
+		return errors.New("invalid StatementFormat value")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementFormat_IsValid(t *testing.T) {
+	for _, f := range StatementFormats {
+		assert.True(t, f.IsValid(), string(f))
+	}
+	assert.False(t, StatementFormat("XLSX").IsValid())
+	assert.Equal(t, "application/pdf", StatementFormatPDF.ContentType())
+	assert.Equal(t, "application/xml", StatementFormatCAMT053.ContentType())
+}
+
+func TestStatementDocument_Verify(t *testing.T) {
+	doc := NewStatementDocument(uuid.New(), StatementFormatCSV, []byte("record,date\n"))
+	assert.Equal(t, HashContent([]byte("record,date\n")), doc.ContentHash)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", HashContent([]byte("abc")))
+	require.NoError(t, doc.Verify())
+
+	doc.Content = []byte("record,date\nTAMPERED\n")
+	assert.ErrorIs(t, doc.Verify(), ErrStatementTampered)
+}
+
+func TestStatementFormat_Scan(t *testing.T) {
+	var f StatementFormat
+	require.NoError(t, f.Scan("CAMT053"))
+	assert.Equal(t, StatementFormatCAMT053, f)
+
+	require.NoError(t, f.Scan(nil))
+	assert.Equal(t, StatementFormatJSON, f)
+
+	assert.Error(t, f.Scan("DOCX"))
+	assert.Error(t, f.Scan(42))
+}
@@ -0,0 +1,9097 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: account.proto
+
+package accountpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Account represents a deposit account
+type Account struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountNumber  string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	CustomerId     string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AccountType    string                 `protobuf:"bytes,4,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	LedgerBalance  int64                  `protobuf:"varint,6,opt,name=ledger_balance,json=ledgerBalance,proto3" json:"ledger_balance,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	OpenedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version        int32                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	OverdraftLimit int64                  `protobuf:"varint,13,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	OverdraftRate  string                 `protobuf:"bytes,14,opt,name=overdraft_rate,json=overdraftRate,proto3" json:"overdraft_rate,omitempty"` // Annual debit interest rate as a percentage, e.g. "18.9"
+	Segment        string                 `protobuf:"bytes,15,opt,name=segment,proto3" json:"segment,omitempty"`
+	Iban           string                 `protobuf:"bytes,16,opt,name=iban,proto3" json:"iban,omitempty"` // Empty when the bank issues no IBANs
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *Account) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Account) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetLedgerBalance() int64 {
+	if x != nil {
+		return x.LedgerBalance
+	}
+	return 0
+}
+
+func (x *Account) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Account) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *Account) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Account) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Account) GetOverdraftLimit() int64 {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return 0
+}
+
+func (x *Account) GetOverdraftRate() string {
+	if x != nil {
+		return x.OverdraftRate
+	}
+	return ""
+}
+
+func (x *Account) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *Account) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+// Balance is a point-in-time view of an account's funds
+type Balance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	LedgerBalance    int64                  `protobuf:"varint,3,opt,name=ledger_balance,json=ledgerBalance,proto3" json:"ledger_balance,omitempty"`
+	HeldAmount       int64                  `protobuf:"varint,4,opt,name=held_amount,json=heldAmount,proto3" json:"held_amount,omitempty"`
+	AvailableBalance int64                  `protobuf:"varint,5,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"` // ledger - held + overdraft limit
+	AsOf             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	OverdraftLimit   int64                  `protobuf:"varint,7,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Balance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Balance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Balance) GetLedgerBalance() int64 {
+	if x != nil {
+		return x.LedgerBalance
+	}
+	return 0
+}
+
+func (x *Balance) GetHeldAmount() int64 {
+	if x != nil {
+		return x.HeldAmount
+	}
+	return 0
+}
+
+func (x *Balance) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+func (x *Balance) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *Balance) GetOverdraftLimit() int64 {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return 0
+}
+
+// Hold represents earmarked funds on an account
+type Hold struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	HoldType        string                 `protobuf:"bytes,3,opt,name=hold_type,json=holdType,proto3" json:"hold_type,omitempty"`
+	Amount          int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	RemainingAmount int64                  `protobuf:"varint,5,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Reason          string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference       string                 `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
+	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Hold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hold) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Hold) GetHoldType() string {
+	if x != nil {
+		return x.HoldType
+	}
+	return ""
+}
+
+func (x *Hold) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Hold) GetRemainingAmount() int64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
+}
+
+func (x *Hold) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Hold) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Hold) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Hold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Hold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// Posting represents a ledger entry
+type Posting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PostingType   string                 `protobuf:"bytes,3,opt,name=posting_type,json=postingType,proto3" json:"posting_type,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	HoldId        string                 `protobuf:"bytes,8,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	ValueDate     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=value_date,json=valueDate,proto3" json:"value_date,omitempty"`
+	BookedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=booked_at,json=bookedAt,proto3" json:"booked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Posting) Reset() {
+	*x = Posting{}
+	mi := &file_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Posting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Posting) ProtoMessage() {}
+
+func (x *Posting) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Posting.ProtoReflect.Descriptor instead.
+func (*Posting) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Posting) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Posting) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Posting) GetPostingType() string {
+	if x != nil {
+		return x.PostingType
+	}
+	return ""
+}
+
+func (x *Posting) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Posting) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Posting) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Posting) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Posting) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *Posting) GetValueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValueDate
+	}
+	return nil
+}
+
+func (x *Posting) GetBookedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BookedAt
+	}
+	return nil
+}
+
+// FeeRule is an effective-dated entry of the fee schedule
+type FeeRule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountType      string                 `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	FeeType          string                 `protobuf:"bytes,3,opt,name=fee_type,json=feeType,proto3" json:"fee_type,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount           int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	WaiverMinBalance *int64                 `protobuf:"varint,6,opt,name=waiver_min_balance,json=waiverMinBalance,proto3,oneof" json:"waiver_min_balance,omitempty"`
+	WaiverSegments   []string               `protobuf:"bytes,7,rep,name=waiver_segments,json=waiverSegments,proto3" json:"waiver_segments,omitempty"`
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FeeRule) Reset() {
+	*x = FeeRule{}
+	mi := &file_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRule) ProtoMessage() {}
+
+func (x *FeeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRule.ProtoReflect.Descriptor instead.
+func (*FeeRule) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FeeRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeeRule) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *FeeRule) GetFeeType() string {
+	if x != nil {
+		return x.FeeType
+	}
+	return ""
+}
+
+func (x *FeeRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FeeRule) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FeeRule) GetWaiverMinBalance() int64 {
+	if x != nil && x.WaiverMinBalance != nil {
+		return *x.WaiverMinBalance
+	}
+	return 0
+}
+
+func (x *FeeRule) GetWaiverSegments() []string {
+	if x != nil {
+		return x.WaiverSegments
+	}
+	return nil
+}
+
+func (x *FeeRule) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *FeeRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FeeRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// Fee is a fee charged to, or waived for, an account
+type Fee struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId         string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	FeeRuleId         string                 `protobuf:"bytes,3,opt,name=fee_rule_id,json=feeRuleId,proto3" json:"fee_rule_id,omitempty"`
+	FeeType           string                 `protobuf:"bytes,4,opt,name=fee_type,json=feeType,proto3" json:"fee_type,omitempty"`
+	Amount            int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency          string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Reference         string                 `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
+	WaiverReason      string                 `protobuf:"bytes,9,opt,name=waiver_reason,json=waiverReason,proto3" json:"waiver_reason,omitempty"`
+	PostingId         string                 `protobuf:"bytes,10,opt,name=posting_id,json=postingId,proto3" json:"posting_id,omitempty"`
+	ReversalPostingId string                 `protobuf:"bytes,11,opt,name=reversal_posting_id,json=reversalPostingId,proto3" json:"reversal_posting_id,omitempty"`
+	ReversalReason    string                 `protobuf:"bytes,12,opt,name=reversal_reason,json=reversalReason,proto3" json:"reversal_reason,omitempty"`
+	ReversedBy        string                 `protobuf:"bytes,13,opt,name=reversed_by,json=reversedBy,proto3" json:"reversed_by,omitempty"`
+	ChargedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=charged_at,json=chargedAt,proto3" json:"charged_at,omitempty"`
+	ReversedAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=reversed_at,json=reversedAt,proto3" json:"reversed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Fee) Reset() {
+	*x = Fee{}
+	mi := &file_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fee) ProtoMessage() {}
+
+func (x *Fee) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fee.ProtoReflect.Descriptor instead.
+func (*Fee) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Fee) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Fee) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Fee) GetFeeRuleId() string {
+	if x != nil {
+		return x.FeeRuleId
+	}
+	return ""
+}
+
+func (x *Fee) GetFeeType() string {
+	if x != nil {
+		return x.FeeType
+	}
+	return ""
+}
+
+func (x *Fee) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Fee) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Fee) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Fee) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Fee) GetWaiverReason() string {
+	if x != nil {
+		return x.WaiverReason
+	}
+	return ""
+}
+
+func (x *Fee) GetPostingId() string {
+	if x != nil {
+		return x.PostingId
+	}
+	return ""
+}
+
+func (x *Fee) GetReversalPostingId() string {
+	if x != nil {
+		return x.ReversalPostingId
+	}
+	return ""
+}
+
+func (x *Fee) GetReversalReason() string {
+	if x != nil {
+		return x.ReversalReason
+	}
+	return ""
+}
+
+func (x *Fee) GetReversedBy() string {
+	if x != nil {
+		return x.ReversedBy
+	}
+	return ""
+}
+
+func (x *Fee) GetChargedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChargedAt
+	}
+	return nil
+}
+
+func (x *Fee) GetReversedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReversedAt
+	}
+	return nil
+}
+
+// AccountParty is a customer's role on an account. Start and end dates are
+// calendar dates in UTC and both inclusive.
+type AccountParty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	SigningRule   string                 `protobuf:"bytes,5,opt,name=signing_rule,json=signingRule,proto3" json:"signing_rule,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	EndReason     string                 `protobuf:"bytes,8,opt,name=end_reason,json=endReason,proto3" json:"end_reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountParty) Reset() {
+	*x = AccountParty{}
+	mi := &file_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountParty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountParty) ProtoMessage() {}
+
+func (x *AccountParty) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountParty.ProtoReflect.Descriptor instead.
+func (*AccountParty) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AccountParty) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountParty) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountParty) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AccountParty) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AccountParty) GetSigningRule() string {
+	if x != nil {
+		return x.SigningRule
+	}
+	return ""
+}
+
+func (x *AccountParty) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AccountParty) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AccountParty) GetEndReason() string {
+	if x != nil {
+		return x.EndReason
+	}
+	return ""
+}
+
+func (x *AccountParty) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountParty) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AccountParty) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// CustomerAccount is an account together with a customer's role on it
+type CustomerAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Party         *AccountParty          `protobuf:"bytes,2,opt,name=party,proto3" json:"party,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerAccount) Reset() {
+	*x = CustomerAccount{}
+	mi := &file_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerAccount) ProtoMessage() {}
+
+func (x *CustomerAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerAccount.ProtoReflect.Descriptor instead.
+func (*CustomerAccount) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CustomerAccount) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CustomerAccount) GetParty() *AccountParty {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+// Statement describes an issued account statement. Period start and end are
+// calendar dates in UTC and both inclusive.
+type Statement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PeriodStart    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Version        int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	OpeningBalance int64                  `protobuf:"varint,6,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	ClosingBalance int64                  `protobuf:"varint,7,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	GeneratedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	GeneratedBy    string                 `protobuf:"bytes,9,opt,name=generated_by,json=generatedBy,proto3" json:"generated_by,omitempty"`
+	Documents      []*StatementDocument   `protobuf:"bytes,10,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Statement) Reset() {
+	*x = Statement{}
+	mi := &file_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Statement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statement) ProtoMessage() {}
+
+func (x *Statement) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statement.ProtoReflect.Descriptor instead.
+func (*Statement) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Statement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Statement) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Statement) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Statement) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Statement) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Statement) GetOpeningBalance() int64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *Statement) GetClosingBalance() int64 {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return 0
+}
+
+func (x *Statement) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *Statement) GetGeneratedBy() string {
+	if x != nil {
+		return x.GeneratedBy
+	}
+	return ""
+}
+
+func (x *Statement) GetDocuments() []*StatementDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+// StatementDocument identifies one stored rendering of a statement
+type StatementDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // JSON, CSV, CAMT053 or PDF
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ContentHash   string                 `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"` // Hex-encoded SHA-256 of the content
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatementDocument) Reset() {
+	*x = StatementDocument{}
+	mi := &file_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementDocument) ProtoMessage() {}
+
+func (x *StatementDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementDocument.ProtoReflect.Descriptor instead.
+func (*StatementDocument) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StatementDocument) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *StatementDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *StatementDocument) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+// BankStatement is a statement imported from a correspondent bank
+type BankStatement struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Format          string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // MT940 or CAMT053
+	ExternalId      string                 `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	ExternalAccount string                 `protobuf:"bytes,5,opt,name=external_account,json=externalAccount,proto3" json:"external_account,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpeningDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
+	OpeningBalance  int64                  `protobuf:"varint,8,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	ClosingDate     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
+	ClosingBalance  int64                  `protobuf:"varint,10,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	LineCount       int32                  `protobuf:"varint,11,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	ContentHash     string                 `protobuf:"bytes,12,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"` // Hex-encoded SHA-256 of the imported file
+	ImportedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=imported_at,json=importedAt,proto3" json:"imported_at,omitempty"`
+	ImportedBy      string                 `protobuf:"bytes,14,opt,name=imported_by,json=importedBy,proto3" json:"imported_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BankStatement) Reset() {
+	*x = BankStatement{}
+	mi := &file_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankStatement) ProtoMessage() {}
+
+func (x *BankStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankStatement.ProtoReflect.Descriptor instead.
+func (*BankStatement) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BankStatement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BankStatement) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *BankStatement) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *BankStatement) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *BankStatement) GetExternalAccount() string {
+	if x != nil {
+		return x.ExternalAccount
+	}
+	return ""
+}
+
+func (x *BankStatement) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BankStatement) GetOpeningDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpeningDate
+	}
+	return nil
+}
+
+func (x *BankStatement) GetOpeningBalance() int64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *BankStatement) GetClosingDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosingDate
+	}
+	return nil
+}
+
+func (x *BankStatement) GetClosingBalance() int64 {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return 0
+}
+
+func (x *BankStatement) GetLineCount() int32 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+func (x *BankStatement) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *BankStatement) GetImportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ImportedAt
+	}
+	return nil
+}
+
+func (x *BankStatement) GetImportedBy() string {
+	if x != nil {
+		return x.ImportedBy
+	}
+	return ""
+}
+
+// BankStatementLine is one entry of an imported statement
+type BankStatementLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StatementId   string                 `protobuf:"bytes,2,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	LineNumber    int32                  `protobuf:"varint,4,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	ValueDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=value_date,json=valueDate,proto3" json:"value_date,omitempty"`
+	BookingDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=booking_date,json=bookingDate,proto3" json:"booking_date,omitempty"`
+	Amount        int64                  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"` // Money arriving on the account is positive
+	Reference     string                 `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
+	BankReference string                 `protobuf:"bytes,9,opt,name=bank_reference,json=bankReference,proto3" json:"bank_reference,omitempty"`
+	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // Unmatched, Matched or WrittenOff
+	MatchId       string                 `protobuf:"bytes,12,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BankStatementLine) Reset() {
+	*x = BankStatementLine{}
+	mi := &file_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankStatementLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankStatementLine) ProtoMessage() {}
+
+func (x *BankStatementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankStatementLine.ProtoReflect.Descriptor instead.
+func (*BankStatementLine) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BankStatementLine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BankStatementLine) GetStatementId() string {
+	if x != nil {
+		return x.StatementId
+	}
+	return ""
+}
+
+func (x *BankStatementLine) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *BankStatementLine) GetLineNumber() int32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *BankStatementLine) GetValueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValueDate
+	}
+	return nil
+}
+
+func (x *BankStatementLine) GetBookingDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BookingDate
+	}
+	return nil
+}
+
+func (x *BankStatementLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BankStatementLine) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *BankStatementLine) GetBankReference() string {
+	if x != nil {
+		return x.BankReference
+	}
+	return ""
+}
+
+func (x *BankStatementLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BankStatementLine) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BankStatementLine) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+// ReconciliationMatch ties statement lines to the postings that account for them
+type ReconciliationMatch struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId           string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Kind                string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // Auto, Manual or WriteOff
+	Rule                string                 `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	RunId               string                 `protobuf:"bytes,5,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	LineIds             []string               `protobuf:"bytes,6,rep,name=line_ids,json=lineIds,proto3" json:"line_ids,omitempty"`
+	PostingIds          []string               `protobuf:"bytes,7,rep,name=posting_ids,json=postingIds,proto3" json:"posting_ids,omitempty"`
+	AdjustmentPostingId string                 `protobuf:"bytes,8,opt,name=adjustment_posting_id,json=adjustmentPostingId,proto3" json:"adjustment_posting_id,omitempty"`
+	Note                string                 `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy           string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReconciliationMatch) Reset() {
+	*x = ReconciliationMatch{}
+	mi := &file_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationMatch) ProtoMessage() {}
+
+func (x *ReconciliationMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationMatch.ProtoReflect.Descriptor instead.
+func (*ReconciliationMatch) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReconciliationMatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReconciliationMatch) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ReconciliationMatch) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciliationMatch) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ReconciliationMatch) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ReconciliationMatch) GetLineIds() []string {
+	if x != nil {
+		return x.LineIds
+	}
+	return nil
+}
+
+func (x *ReconciliationMatch) GetPostingIds() []string {
+	if x != nil {
+		return x.PostingIds
+	}
+	return nil
+}
+
+func (x *ReconciliationMatch) GetAdjustmentPostingId() string {
+	if x != nil {
+		return x.AdjustmentPostingId
+	}
+	return ""
+}
+
+func (x *ReconciliationMatch) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReconciliationMatch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationMatch) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// ReconciliationRun summarizes one reconciliation of an account
+type ReconciliationRun struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId         string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AsOf              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Rerun             bool                   `protobuf:"varint,4,opt,name=rerun,proto3" json:"rerun,omitempty"`
+	MatchesCreated    int32                  `protobuf:"varint,5,opt,name=matches_created,json=matchesCreated,proto3" json:"matches_created,omitempty"`
+	UnmatchedLines    int32                  `protobuf:"varint,6,opt,name=unmatched_lines,json=unmatchedLines,proto3" json:"unmatched_lines,omitempty"`
+	UnmatchedPostings int32                  `protobuf:"varint,7,opt,name=unmatched_postings,json=unmatchedPostings,proto3" json:"unmatched_postings,omitempty"`
+	StatementBalance  int64                  `protobuf:"varint,8,opt,name=statement_balance,json=statementBalance,proto3" json:"statement_balance,omitempty"`
+	LedgerBalance     int64                  `protobuf:"varint,9,opt,name=ledger_balance,json=ledgerBalance,proto3" json:"ledger_balance,omitempty"`
+	Difference        int64                  `protobuf:"varint,10,opt,name=difference,proto3" json:"difference,omitempty"` // statement - ledger
+	RunAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	RunBy             string                 `protobuf:"bytes,12,opt,name=run_by,json=runBy,proto3" json:"run_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReconciliationRun) Reset() {
+	*x = ReconciliationRun{}
+	mi := &file_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRun) ProtoMessage() {}
+
+func (x *ReconciliationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRun.ProtoReflect.Descriptor instead.
+func (*ReconciliationRun) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReconciliationRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetRerun() bool {
+	if x != nil {
+		return x.Rerun
+	}
+	return false
+}
+
+func (x *ReconciliationRun) GetMatchesCreated() int32 {
+	if x != nil {
+		return x.MatchesCreated
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetUnmatchedLines() int32 {
+	if x != nil {
+		return x.UnmatchedLines
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetUnmatchedPostings() int32 {
+	if x != nil {
+		return x.UnmatchedPostings
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetStatementBalance() int64 {
+	if x != nil {
+		return x.StatementBalance
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetLedgerBalance() int64 {
+	if x != nil {
+		return x.LedgerBalance
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetDifference() int64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetRunBy() string {
+	if x != nil {
+		return x.RunBy
+	}
+	return ""
+}
+
+// FXRate is an entry of the exchange rate table
+type FXRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	MidRate       string                 `protobuf:"bytes,4,opt,name=mid_rate,json=midRate,proto3" json:"mid_rate,omitempty"` // Quote units per base unit, up to 10 decimal places, e.g. "1.0850"
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FXRate) Reset() {
+	*x = FXRate{}
+	mi := &file_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FXRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FXRate) ProtoMessage() {}
+
+func (x *FXRate) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FXRate.ProtoReflect.Descriptor instead.
+func (*FXRate) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FXRate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FXRate) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *FXRate) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *FXRate) GetMidRate() string {
+	if x != nil {
+		return x.MidRate
+	}
+	return ""
+}
+
+func (x *FXRate) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *FXRate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FXRate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FXRate) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// FXPositionAccount is the account holding the bank's position in one
+// currency of a currency pair
+type FXPositionAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyPair  string                 `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"` // Codes in alphabetical order, e.g. "EUR/USD"
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	AccountId     string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FXPositionAccount) Reset() {
+	*x = FXPositionAccount{}
+	mi := &file_account_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FXPositionAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FXPositionAccount) ProtoMessage() {}
+
+func (x *FXPositionAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FXPositionAccount.ProtoReflect.Descriptor instead.
+func (*FXPositionAccount) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FXPositionAccount) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *FXPositionAccount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FXPositionAccount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *FXPositionAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *FXPositionAccount) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// FXQuote is a priced conversion between two accounts. The rates and the
+// unrounded amount it records are enough to recompute its amounts.
+type FXQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAccountId   string                 `protobuf:"bytes,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId     string                 `protobuf:"bytes,3,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	SourceCurrency  string                 `protobuf:"bytes,4,opt,name=source_currency,json=sourceCurrency,proto3" json:"source_currency,omitempty"`
+	TargetCurrency  string                 `protobuf:"bytes,5,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	SourceAmount    int64                  `protobuf:"varint,6,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	TargetAmount    int64                  `protobuf:"varint,7,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	FixedSide       string                 `protobuf:"bytes,8,opt,name=fixed_side,json=fixedSide,proto3" json:"fixed_side,omitempty"` // Source or Target: the amount the customer chose
+	RateId          string                 `protobuf:"bytes,9,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	MidRate         string                 `protobuf:"bytes,10,opt,name=mid_rate,json=midRate,proto3" json:"mid_rate,omitempty"` // As in the rate table
+	Inverted        bool                   `protobuf:"varint,11,opt,name=inverted,proto3" json:"inverted,omitempty"`             // The rate table quotes the pair the other way round
+	Segment         string                 `protobuf:"bytes,12,opt,name=segment,proto3" json:"segment,omitempty"`
+	SpreadBps       int32                  `protobuf:"varint,13,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+	CustomerRate    string                 `protobuf:"bytes,14,opt,name=customer_rate,json=customerRate,proto3" json:"customer_rate,omitempty"` // Target units per source unit after the spread
+	ExactAmount     string                 `protobuf:"bytes,15,opt,name=exact_amount,json=exactAmount,proto3" json:"exact_amount,omitempty"`    // The computed side before rounding, in minor units
+	Status          string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`                                 // Open, Executed or Expired
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,19,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ExecutedAt      *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	ExecutedBy      string                 `protobuf:"bytes,21,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
+	Reference       string                 `protobuf:"bytes,22,opt,name=reference,proto3" json:"reference,omitempty"`
+	DebitPostingId  string                 `protobuf:"bytes,23,opt,name=debit_posting_id,json=debitPostingId,proto3" json:"debit_posting_id,omitempty"`
+	CreditPostingId string                 `protobuf:"bytes,24,opt,name=credit_posting_id,json=creditPostingId,proto3" json:"credit_posting_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FXQuote) Reset() {
+	*x = FXQuote{}
+	mi := &file_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FXQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FXQuote) ProtoMessage() {}
+
+func (x *FXQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FXQuote.ProtoReflect.Descriptor instead.
+func (*FXQuote) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FXQuote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FXQuote) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *FXQuote) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *FXQuote) GetSourceCurrency() string {
+	if x != nil {
+		return x.SourceCurrency
+	}
+	return ""
+}
+
+func (x *FXQuote) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *FXQuote) GetSourceAmount() int64 {
+	if x != nil {
+		return x.SourceAmount
+	}
+	return 0
+}
+
+func (x *FXQuote) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *FXQuote) GetFixedSide() string {
+	if x != nil {
+		return x.FixedSide
+	}
+	return ""
+}
+
+func (x *FXQuote) GetRateId() string {
+	if x != nil {
+		return x.RateId
+	}
+	return ""
+}
+
+func (x *FXQuote) GetMidRate() string {
+	if x != nil {
+		return x.MidRate
+	}
+	return ""
+}
+
+func (x *FXQuote) GetInverted() bool {
+	if x != nil {
+		return x.Inverted
+	}
+	return false
+}
+
+func (x *FXQuote) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *FXQuote) GetSpreadBps() int32 {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return 0
+}
+
+func (x *FXQuote) GetCustomerRate() string {
+	if x != nil {
+		return x.CustomerRate
+	}
+	return ""
+}
+
+func (x *FXQuote) GetExactAmount() string {
+	if x != nil {
+		return x.ExactAmount
+	}
+	return ""
+}
+
+func (x *FXQuote) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FXQuote) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *FXQuote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FXQuote) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *FXQuote) GetExecutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+func (x *FXQuote) GetExecutedBy() string {
+	if x != nil {
+		return x.ExecutedBy
+	}
+	return ""
+}
+
+func (x *FXQuote) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *FXQuote) GetDebitPostingId() string {
+	if x != nil {
+		return x.DebitPostingId
+	}
+	return ""
+}
+
+func (x *FXQuote) GetCreditPostingId() string {
+	if x != nil {
+		return x.CreditPostingId
+	}
+	return ""
+}
+
+// LimitRule is one effective-dated transaction limit. An empty channel,
+// transaction type or risk tier applies to all of them.
+type LimitRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // PerTransaction, DailyAmount, MonthlyAmount, DailyCount or MonthlyCount
+	Channel         string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	TransactionType string                 `protobuf:"bytes,4,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"` // Transfer or FXConversion
+	RiskTier        string                 `protobuf:"bytes,5,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`                      // Low, Medium or High
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Value           int64                  `protobuf:"varint,7,opt,name=value,proto3" json:"value,omitempty"` // Minor units, or a number of transactions for count limits
+	EffectiveFrom   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LimitRule) Reset() {
+	*x = LimitRule{}
+	mi := &file_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitRule) ProtoMessage() {}
+
+func (x *LimitRule) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitRule.ProtoReflect.Descriptor instead.
+func (*LimitRule) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LimitRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LimitRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LimitRule) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *LimitRule) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *LimitRule) GetRiskTier() string {
+	if x != nil {
+		return x.RiskTier
+	}
+	return ""
+}
+
+func (x *LimitRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LimitRule) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *LimitRule) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *LimitRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LimitRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// LimitIncrease temporarily raises one limit rule for one customer
+type LimitIncrease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	LimitRuleId   string                 `protobuf:"bytes,3,opt,name=limit_rule_id,json=limitRuleId,proto3" json:"limit_rule_id,omitempty"`
+	Value         int64                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"` // Replaces the rule's value while in force
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	RevokedBy     string                 `protobuf:"bytes,11,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitIncrease) Reset() {
+	*x = LimitIncrease{}
+	mi := &file_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitIncrease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitIncrease) ProtoMessage() {}
+
+func (x *LimitIncrease) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitIncrease.ProtoReflect.Descriptor instead.
+func (*LimitIncrease) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LimitIncrease) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LimitIncrease) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *LimitIncrease) GetLimitRuleId() string {
+	if x != nil {
+		return x.LimitRuleId
+	}
+	return ""
+}
+
+func (x *LimitIncrease) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *LimitIncrease) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *LimitIncrease) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LimitIncrease) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LimitIncrease) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LimitIncrease) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *LimitIncrease) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *LimitIncrease) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+// RemainingLimit is how much of a limit a customer has left in its current period
+type RemainingLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *LimitRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // The rule's value, or the increase's while one is in force
+	Used          int64                  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`   // Zero for per-transaction limits
+	Remaining     int64                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	ResetsAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"` // Unset for per-transaction limits
+	Increase      *LimitIncrease         `protobuf:"bytes,6,opt,name=increase,proto3" json:"increase,omitempty"`                 // The temporary increase in force, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemainingLimit) Reset() {
+	*x = RemainingLimit{}
+	mi := &file_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemainingLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemainingLimit) ProtoMessage() {}
+
+func (x *RemainingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemainingLimit.ProtoReflect.Descriptor instead.
+func (*RemainingLimit) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemainingLimit) GetRule() *LimitRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *RemainingLimit) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RemainingLimit) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *RemainingLimit) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RemainingLimit) GetResetsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return nil
+}
+
+func (x *RemainingLimit) GetIncrease() *LimitIncrease {
+	if x != nil {
+		return x.Increase
+	}
+	return nil
+}
+
+// LimitBreach is attached to the FailedPrecondition status of a transaction
+// rejected by a limit
+type LimitBreach struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LimitId       string                 `protobuf:"bytes,1,opt,name=limit_id,json=limitId,proto3" json:"limit_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used          int64                  `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining     int64                  `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"` // Headroom left before the transaction
+	Requested     int64                  `protobuf:"varint,7,opt,name=requested,proto3" json:"requested,omitempty"` // The amount, or 1 for count limits
+	ResetsAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`
+	IncreaseId    string                 `protobuf:"bytes,9,opt,name=increase_id,json=increaseId,proto3" json:"increase_id,omitempty"` // The temporary increase in force, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitBreach) Reset() {
+	*x = LimitBreach{}
+	mi := &file_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitBreach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitBreach) ProtoMessage() {}
+
+func (x *LimitBreach) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitBreach.ProtoReflect.Descriptor instead.
+func (*LimitBreach) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LimitBreach) GetLimitId() string {
+	if x != nil {
+		return x.LimitId
+	}
+	return ""
+}
+
+func (x *LimitBreach) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LimitBreach) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LimitBreach) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LimitBreach) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *LimitBreach) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *LimitBreach) GetRequested() int64 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *LimitBreach) GetResetsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return nil
+}
+
+func (x *LimitBreach) GetIncreaseId() string {
+	if x != nil {
+		return x.IncreaseId
+	}
+	return ""
+}
+
+// TransactionEvent is one posted transaction from the point of view of one
+// customer, as seen by transaction monitoring
+type TransactionEvent struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	CustomerId            string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AccountId             string                 `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Direction             string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"` // In or Out
+	Amount                int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency              string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	CounterpartyAccountId string                 `protobuf:"bytes,8,opt,name=counterparty_account_id,json=counterpartyAccountId,proto3" json:"counterparty_account_id,omitempty"`
+	CounterpartyCountry   string                 `protobuf:"bytes,9,opt,name=counterparty_country,json=counterpartyCountry,proto3" json:"counterparty_country,omitempty"`
+	Channel               string                 `protobuf:"bytes,10,opt,name=channel,proto3" json:"channel,omitempty"`
+	TransactionType       string                 `protobuf:"bytes,11,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Reference             string                 `protobuf:"bytes,12,opt,name=reference,proto3" json:"reference,omitempty"`
+	OccurredAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TransactionEvent) Reset() {
+	*x = TransactionEvent{}
+	mi := &file_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionEvent) ProtoMessage() {}
+
+func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
+func (*TransactionEvent) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransactionEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TransactionEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionEvent) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetCounterpartyAccountId() string {
+	if x != nil {
+		return x.CounterpartyAccountId
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetCounterpartyCountry() string {
+	if x != nil {
+		return x.CounterpartyCountry
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// AMLAlert is raised when a monitoring rule fires on a customer's activity
+type AMLAlert struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId           string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleType         string                 `protobuf:"bytes,3,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	RulesVersion     string                 `protobuf:"bytes,4,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	TriggerEventId   string                 `protobuf:"bytes,6,opt,name=trigger_event_id,json=triggerEventId,proto3" json:"trigger_event_id,omitempty"`
+	Summary          string                 `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // Open, Escalated or Dismissed
+	RaisedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=raised_at,json=raisedAt,proto3" json:"raised_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	ClosedBy         string                 `protobuf:"bytes,12,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	Resolution       string                 `protobuf:"bytes,13,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	EvidenceEventIds []string               `protobuf:"bytes,14,rep,name=evidence_event_ids,json=evidenceEventIds,proto3" json:"evidence_event_ids,omitempty"` // Oldest first
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AMLAlert) Reset() {
+	*x = AMLAlert{}
+	mi := &file_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AMLAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AMLAlert) ProtoMessage() {}
+
+func (x *AMLAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AMLAlert.ProtoReflect.Descriptor instead.
+func (*AMLAlert) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AMLAlert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetRulesVersion() string {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetTriggerEventId() string {
+	if x != nil {
+		return x.TriggerEventId
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetRaisedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RaisedAt
+	}
+	return nil
+}
+
+func (x *AMLAlert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AMLAlert) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *AMLAlert) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *AMLAlert) GetEvidenceEventIds() []string {
+	if x != nil {
+		return x.EvidenceEventIds
+	}
+	return nil
+}
+
+// OpenAccountRequest is the request for opening an account. The customer
+// becomes the account's primary holder.
+type OpenAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AccountType   string                 `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Segment       string                 `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`                            // Customer segment used by fee waivers, e.g. "Student"
+	SigningRule   string                 `protobuf:"bytes,5,opt,name=signing_rule,json=signingRule,proto3" json:"signing_rule,omitempty"` // Primary holder's signing rule, defaults to AnyOne
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenAccountRequest) Reset() {
+	*x = OpenAccountRequest{}
+	mi := &file_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAccountRequest) ProtoMessage() {}
+
+func (x *OpenAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAccountRequest.ProtoReflect.Descriptor instead.
+func (*OpenAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OpenAccountRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *OpenAccountRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *OpenAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OpenAccountRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *OpenAccountRequest) GetSigningRule() string {
+	if x != nil {
+		return x.SigningRule
+	}
+	return ""
+}
+
+// OpenAccountResponse is the response for opening an account
+type OpenAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenAccountResponse) Reset() {
+	*x = OpenAccountResponse{}
+	mi := &file_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAccountResponse) ProtoMessage() {}
+
+func (x *OpenAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAccountResponse.ProtoReflect.Descriptor instead.
+func (*OpenAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OpenAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// GetAccountRequest is the request for getting an account by ID, or by
+// account number when no ID is given
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAccountRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+// GetAccountResponse is the response for getting an account
+type GetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_account_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// FreezeAccountRequest is the request for freezing an account
+type FreezeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeAccountRequest) Reset() {
+	*x = FreezeAccountRequest{}
+	mi := &file_account_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeAccountRequest) ProtoMessage() {}
+
+func (x *FreezeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeAccountRequest.ProtoReflect.Descriptor instead.
+func (*FreezeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FreezeAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FreezeAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// FreezeAccountResponse is the response for freezing an account
+type FreezeAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeAccountResponse) Reset() {
+	*x = FreezeAccountResponse{}
+	mi := &file_account_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeAccountResponse) ProtoMessage() {}
+
+func (x *FreezeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeAccountResponse.ProtoReflect.Descriptor instead.
+func (*FreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FreezeAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// UnfreezeAccountRequest is the request for unfreezing an account
+type UnfreezeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeAccountRequest) Reset() {
+	*x = UnfreezeAccountRequest{}
+	mi := &file_account_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeAccountRequest) ProtoMessage() {}
+
+func (x *UnfreezeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnfreezeAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnfreezeAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// UnfreezeAccountResponse is the response for unfreezing an account
+type UnfreezeAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeAccountResponse) Reset() {
+	*x = UnfreezeAccountResponse{}
+	mi := &file_account_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeAccountResponse) ProtoMessage() {}
+
+func (x *UnfreezeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnfreezeAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// GetBalanceRequest is the request for getting an account balance
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_account_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetBalanceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// GetBalanceResponse is the response for getting an account balance
+type GetBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       *Balance               `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_account_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetBalanceResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// PlaceHoldRequest is the request for placing a hold
+type PlaceHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	HoldType      string                 `protobuf:"bytes,2,opt,name=hold_type,json=holdType,proto3" json:"hold_type,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"` // e.g. card authorization code or court order number
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceHoldRequest) Reset() {
+	*x = PlaceHoldRequest{}
+	mi := &file_account_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldRequest) ProtoMessage() {}
+
+func (x *PlaceHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceHoldRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlaceHoldRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetHoldType() string {
+	if x != nil {
+		return x.HoldType
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PlaceHoldRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PlaceHoldRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// PlaceHoldResponse is the response for placing a hold
+type PlaceHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	Balance       *Balance               `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceHoldResponse) Reset() {
+	*x = PlaceHoldResponse{}
+	mi := &file_account_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldResponse) ProtoMessage() {}
+
+func (x *PlaceHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldResponse.ProtoReflect.Descriptor instead.
+func (*PlaceHoldResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PlaceHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *PlaceHoldResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// ReleaseHoldRequest is the request for releasing a hold.
+// An amount of zero releases the full remaining amount.
+type ReleaseHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	mi := &file_account_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReleaseHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReleaseHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ReleaseHoldResponse is the response for releasing a hold
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	Balance       *Balance               `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	mi := &file_account_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReleaseHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *ReleaseHoldResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// CaptureHoldRequest is the request for capturing a hold.
+// An amount of zero captures the full remaining amount.
+type CaptureHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldRequest) Reset() {
+	*x = CaptureHoldRequest{}
+	mi := &file_account_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest) ProtoMessage() {}
+
+func (x *CaptureHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CaptureHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CaptureHoldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CaptureHoldResponse is the response for capturing a hold
+type CaptureHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	Posting       *Posting               `protobuf:"bytes,2,opt,name=posting,proto3" json:"posting,omitempty"`
+	Balance       *Balance               `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldResponse) Reset() {
+	*x = CaptureHoldResponse{}
+	mi := &file_account_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldResponse) ProtoMessage() {}
+
+func (x *CaptureHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldResponse.ProtoReflect.Descriptor instead.
+func (*CaptureHoldResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CaptureHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *CaptureHoldResponse) GetPosting() *Posting {
+	if x != nil {
+		return x.Posting
+	}
+	return nil
+}
+
+func (x *CaptureHoldResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// ListHoldsRequest is the request for listing holds
+type ListHoldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldsRequest) Reset() {
+	*x = ListHoldsRequest{}
+	mi := &file_account_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldsRequest) ProtoMessage() {}
+
+func (x *ListHoldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldsRequest.ProtoReflect.Descriptor instead.
+func (*ListHoldsRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListHoldsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListHoldsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+// ListHoldsResponse is the response for listing holds
+type ListHoldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holds         []*Hold                `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldsResponse) Reset() {
+	*x = ListHoldsResponse{}
+	mi := &file_account_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldsResponse) ProtoMessage() {}
+
+func (x *ListHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldsResponse.ProtoReflect.Descriptor instead.
+func (*ListHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListHoldsResponse) GetHolds() []*Hold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+// SetOverdraftLimitRequest is the request for setting an arranged overdraft.
+// A limit of zero removes the arranged overdraft.
+type SetOverdraftLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	DebitRate     string                 `protobuf:"bytes,3,opt,name=debit_rate,json=debitRate,proto3" json:"debit_rate,omitempty"` // Annual percentage, e.g. "18.9"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOverdraftLimitRequest) Reset() {
+	*x = SetOverdraftLimitRequest{}
+	mi := &file_account_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOverdraftLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOverdraftLimitRequest) ProtoMessage() {}
+
+func (x *SetOverdraftLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOverdraftLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetOverdraftLimitRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetOverdraftLimitRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SetOverdraftLimitRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SetOverdraftLimitRequest) GetDebitRate() string {
+	if x != nil {
+		return x.DebitRate
+	}
+	return ""
+}
+
+// SetOverdraftLimitResponse is the response for setting an arranged overdraft
+type SetOverdraftLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Balance       *Balance               `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOverdraftLimitResponse) Reset() {
+	*x = SetOverdraftLimitResponse{}
+	mi := &file_account_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOverdraftLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOverdraftLimitResponse) ProtoMessage() {}
+
+func (x *SetOverdraftLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOverdraftLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetOverdraftLimitResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetOverdraftLimitResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *SetOverdraftLimitResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// TransferRequest is the request for transferring funds between accounts
+type TransferRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId       string                 `protobuf:"bytes,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId         string                 `protobuf:"bytes,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount              int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency            string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Reference           string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description         string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Channel             string                 `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`                                                    // Branch, Online, Mobile, API or Batch; defaults to API
+	CounterpartyCountry string                 `protobuf:"bytes,8,opt,name=counterparty_country,json=counterpartyCountry,proto3" json:"counterparty_country,omitempty"` // ISO 3166 alpha-2 of an external counterparty
+	// A transfer repeated with the key of one already made returns that
+	// transfer instead of moving the funds again
+	IdempotencyKey string `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_account_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TransferRequest) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetCounterpartyCountry() string {
+	if x != nil {
+		return x.CounterpartyCountry
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// TransferResponse is the response for transferring funds between accounts
+type TransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debit         *Posting               `protobuf:"bytes,1,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit        *Posting               `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit,omitempty"`
+	Fee           *Fee                   `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`         // Transfer fee, if one applies
+	Balance       *Balance               `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"` // Balance of the source account after the transfer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	mi := &file_account_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TransferResponse) GetDebit() *Posting {
+	if x != nil {
+		return x.Debit
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetCredit() *Posting {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// SetFeeRuleRequest is the request for adding a fee rule
+type SetFeeRuleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountType      string                 `protobuf:"bytes,1,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	FeeType          string                 `protobuf:"bytes,2,opt,name=fee_type,json=feeType,proto3" json:"fee_type,omitempty"`
+	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount           int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"` // Zero switches the fee off
+	WaiverMinBalance *int64                 `protobuf:"varint,5,opt,name=waiver_min_balance,json=waiverMinBalance,proto3,oneof" json:"waiver_min_balance,omitempty"`
+	WaiverSegments   []string               `protobuf:"bytes,6,rep,name=waiver_segments,json=waiverSegments,proto3" json:"waiver_segments,omitempty"`
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // Defaults to now
+	CreatedBy        string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetFeeRuleRequest) Reset() {
+	*x = SetFeeRuleRequest{}
+	mi := &file_account_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeRuleRequest) ProtoMessage() {}
+
+func (x *SetFeeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetFeeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetFeeRuleRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetFeeType() string {
+	if x != nil {
+		return x.FeeType
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SetFeeRuleRequest) GetWaiverMinBalance() int64 {
+	if x != nil && x.WaiverMinBalance != nil {
+		return *x.WaiverMinBalance
+	}
+	return 0
+}
+
+func (x *SetFeeRuleRequest) GetWaiverSegments() []string {
+	if x != nil {
+		return x.WaiverSegments
+	}
+	return nil
+}
+
+func (x *SetFeeRuleRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *SetFeeRuleRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// SetFeeRuleResponse is the response for adding a fee rule
+type SetFeeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FeeRule               `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeeRuleResponse) Reset() {
+	*x = SetFeeRuleResponse{}
+	mi := &file_account_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeRuleResponse) ProtoMessage() {}
+
+func (x *SetFeeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetFeeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetFeeRuleResponse) GetRule() *FeeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ListFeeRulesRequest is the request for listing fee rules
+type ListFeeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountType   string                 `protobuf:"bytes,1,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"` // Empty lists every account type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeRulesRequest) Reset() {
+	*x = ListFeeRulesRequest{}
+	mi := &file_account_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRulesRequest) ProtoMessage() {}
+
+func (x *ListFeeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListFeeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListFeeRulesRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+// ListFeeRulesResponse is the response for listing fee rules
+type ListFeeRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*FeeRule             `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeRulesResponse) Reset() {
+	*x = ListFeeRulesResponse{}
+	mi := &file_account_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRulesResponse) ProtoMessage() {}
+
+func (x *ListFeeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListFeeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListFeeRulesResponse) GetRules() []*FeeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// ListFeesRequest is the request for listing the fees on an account
+type ListFeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeesRequest) Reset() {
+	*x = ListFeesRequest{}
+	mi := &file_account_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeesRequest) ProtoMessage() {}
+
+func (x *ListFeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeesRequest.ProtoReflect.Descriptor instead.
+func (*ListFeesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListFeesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// ListFeesResponse is the response for listing the fees on an account
+type ListFeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fees          []*Fee                 `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeesResponse) Reset() {
+	*x = ListFeesResponse{}
+	mi := &file_account_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeesResponse) ProtoMessage() {}
+
+func (x *ListFeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeesResponse.ProtoReflect.Descriptor instead.
+func (*ListFeesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListFeesResponse) GetFees() []*Fee {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+// ReverseFeeRequest is the request for reversing a fee
+type ReverseFeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeId         string                 `protobuf:"bytes,1,opt,name=fee_id,json=feeId,proto3" json:"fee_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReversedBy    string                 `protobuf:"bytes,3,opt,name=reversed_by,json=reversedBy,proto3" json:"reversed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseFeeRequest) Reset() {
+	*x = ReverseFeeRequest{}
+	mi := &file_account_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseFeeRequest) ProtoMessage() {}
+
+func (x *ReverseFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseFeeRequest.ProtoReflect.Descriptor instead.
+func (*ReverseFeeRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReverseFeeRequest) GetFeeId() string {
+	if x != nil {
+		return x.FeeId
+	}
+	return ""
+}
+
+func (x *ReverseFeeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReverseFeeRequest) GetReversedBy() string {
+	if x != nil {
+		return x.ReversedBy
+	}
+	return ""
+}
+
+// ReverseFeeResponse is the response for reversing a fee
+type ReverseFeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fee           *Fee                   `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	Reversal      *Posting               `protobuf:"bytes,2,opt,name=reversal,proto3" json:"reversal,omitempty"`
+	Balance       *Balance               `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseFeeResponse) Reset() {
+	*x = ReverseFeeResponse{}
+	mi := &file_account_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseFeeResponse) ProtoMessage() {}
+
+func (x *ReverseFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseFeeResponse.ProtoReflect.Descriptor instead.
+func (*ReverseFeeResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReverseFeeResponse) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *ReverseFeeResponse) GetReversal() *Posting {
+	if x != nil {
+		return x.Reversal
+	}
+	return nil
+}
+
+func (x *ReverseFeeResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// AddAccountPartyRequest is the request for adding a party to an account
+type AddAccountPartyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	SigningRule   string                 `protobuf:"bytes,4,opt,name=signing_rule,json=signingRule,proto3" json:"signing_rule,omitempty"` // Defaults to AnyOne, or None for beneficiaries
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`       // Defaults to today
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAccountPartyRequest) Reset() {
+	*x = AddAccountPartyRequest{}
+	mi := &file_account_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAccountPartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccountPartyRequest) ProtoMessage() {}
+
+func (x *AddAccountPartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccountPartyRequest.ProtoReflect.Descriptor instead.
+func (*AddAccountPartyRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddAccountPartyRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AddAccountPartyRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddAccountPartyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddAccountPartyRequest) GetSigningRule() string {
+	if x != nil {
+		return x.SigningRule
+	}
+	return ""
+}
+
+func (x *AddAccountPartyRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AddAccountPartyRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AddAccountPartyRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// AddAccountPartyResponse is the response for adding a party to an account
+type AddAccountPartyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Party         *AccountParty          `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAccountPartyResponse) Reset() {
+	*x = AddAccountPartyResponse{}
+	mi := &file_account_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAccountPartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccountPartyResponse) ProtoMessage() {}
+
+func (x *AddAccountPartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccountPartyResponse.ProtoReflect.Descriptor instead.
+func (*AddAccountPartyResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddAccountPartyResponse) GetParty() *AccountParty {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+// EndAccountPartyRequest is the request for ending a party's role
+type EndAccountPartyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       string                 `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"` // Defaults to today
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndAccountPartyRequest) Reset() {
+	*x = EndAccountPartyRequest{}
+	mi := &file_account_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndAccountPartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndAccountPartyRequest) ProtoMessage() {}
+
+func (x *EndAccountPartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndAccountPartyRequest.ProtoReflect.Descriptor instead.
+func (*EndAccountPartyRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EndAccountPartyRequest) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+func (x *EndAccountPartyRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *EndAccountPartyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EndAccountPartyResponse is the response for ending a party's role
+type EndAccountPartyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Party         *AccountParty          `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndAccountPartyResponse) Reset() {
+	*x = EndAccountPartyResponse{}
+	mi := &file_account_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndAccountPartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndAccountPartyResponse) ProtoMessage() {}
+
+func (x *EndAccountPartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndAccountPartyResponse.ProtoReflect.Descriptor instead.
+func (*EndAccountPartyResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EndAccountPartyResponse) GetParty() *AccountParty {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+// ListAccountPartiesRequest is the request for listing the parties to an account
+type ListAccountPartiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountPartiesRequest) Reset() {
+	*x = ListAccountPartiesRequest{}
+	mi := &file_account_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountPartiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountPartiesRequest) ProtoMessage() {}
+
+func (x *ListAccountPartiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountPartiesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountPartiesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListAccountPartiesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListAccountPartiesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+// ListAccountPartiesResponse is the response for listing the parties to an account
+type ListAccountPartiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parties       []*AccountParty        `protobuf:"bytes,1,rep,name=parties,proto3" json:"parties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountPartiesResponse) Reset() {
+	*x = ListAccountPartiesResponse{}
+	mi := &file_account_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountPartiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountPartiesResponse) ProtoMessage() {}
+
+func (x *ListAccountPartiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountPartiesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountPartiesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListAccountPartiesResponse) GetParties() []*AccountParty {
+	if x != nil {
+		return x.Parties
+	}
+	return nil
+}
+
+// ListAccountsByCustomerRequest is the request for listing a customer's accounts
+type ListAccountsByCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsByCustomerRequest) Reset() {
+	*x = ListAccountsByCustomerRequest{}
+	mi := &file_account_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsByCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsByCustomerRequest) ProtoMessage() {}
+
+func (x *ListAccountsByCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsByCustomerRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsByCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAccountsByCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListAccountsByCustomerRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+// ListAccountsByCustomerResponse lists one entry per role the customer holds,
+// so an account appears once for each of the customer's roles on it
+type ListAccountsByCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*CustomerAccount     `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsByCustomerResponse) Reset() {
+	*x = ListAccountsByCustomerResponse{}
+	mi := &file_account_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsByCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsByCustomerResponse) ProtoMessage() {}
+
+func (x *ListAccountsByCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsByCustomerResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsByCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListAccountsByCustomerResponse) GetAccounts() []*CustomerAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// CheckSigningAuthorityRequest is the request for checking signing authority
+type CheckSigningAuthorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CustomerIds   []string               `protobuf:"bytes,2,rep,name=customer_ids,json=customerIds,proto3" json:"customer_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSigningAuthorityRequest) Reset() {
+	*x = CheckSigningAuthorityRequest{}
+	mi := &file_account_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSigningAuthorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSigningAuthorityRequest) ProtoMessage() {}
+
+func (x *CheckSigningAuthorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSigningAuthorityRequest.ProtoReflect.Descriptor instead.
+func (*CheckSigningAuthorityRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CheckSigningAuthorityRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CheckSigningAuthorityRequest) GetCustomerIds() []string {
+	if x != nil {
+		return x.CustomerIds
+	}
+	return nil
+}
+
+// CheckSigningAuthorityResponse is the response for checking signing authority
+type CheckSigningAuthorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authorized    bool                   `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSigningAuthorityResponse) Reset() {
+	*x = CheckSigningAuthorityResponse{}
+	mi := &file_account_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSigningAuthorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSigningAuthorityResponse) ProtoMessage() {}
+
+func (x *CheckSigningAuthorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSigningAuthorityResponse.ProtoReflect.Descriptor instead.
+func (*CheckSigningAuthorityResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CheckSigningAuthorityResponse) GetAuthorized() bool {
+	if x != nil {
+		return x.Authorized
+	}
+	return false
+}
+
+// GenerateStatementRequest is the request for generating a statement. Every
+// call issues a new version, leaving earlier versions in place.
+type GenerateStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	GeneratedBy   string                 `protobuf:"bytes,4,opt,name=generated_by,json=generatedBy,proto3" json:"generated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateStatementRequest) Reset() {
+	*x = GenerateStatementRequest{}
+	mi := &file_account_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStatementRequest) ProtoMessage() {}
+
+func (x *GenerateStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStatementRequest.ProtoReflect.Descriptor instead.
+func (*GenerateStatementRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GenerateStatementRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GenerateStatementRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GenerateStatementRequest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *GenerateStatementRequest) GetGeneratedBy() string {
+	if x != nil {
+		return x.GeneratedBy
+	}
+	return ""
+}
+
+// GenerateStatementResponse is the response for generating a statement
+type GenerateStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statement     *Statement             `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateStatementResponse) Reset() {
+	*x = GenerateStatementResponse{}
+	mi := &file_account_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStatementResponse) ProtoMessage() {}
+
+func (x *GenerateStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStatementResponse.ProtoReflect.Descriptor instead.
+func (*GenerateStatementResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GenerateStatementResponse) GetStatement() *Statement {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+// GetStatementRequest is the request for retrieving a statement rendering
+type GetStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatementId   string                 `protobuf:"bytes,1,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // Defaults to PDF
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementRequest) Reset() {
+	*x = GetStatementRequest{}
+	mi := &file_account_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementRequest) ProtoMessage() {}
+
+func (x *GetStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetStatementRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetStatementRequest) GetStatementId() string {
+	if x != nil {
+		return x.StatementId
+	}
+	return ""
+}
+
+func (x *GetStatementRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// GetStatementResponse is the response for retrieving a statement rendering
+type GetStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statement     *Statement             `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHash   string                 `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementResponse) Reset() {
+	*x = GetStatementResponse{}
+	mi := &file_account_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementResponse) ProtoMessage() {}
+
+func (x *GetStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetStatementResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetStatementResponse) GetStatement() *Statement {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *GetStatementResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetStatementResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+// ListStatementsRequest is the request for listing an account's statements
+type ListStatementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatementsRequest) Reset() {
+	*x = ListStatementsRequest{}
+	mi := &file_account_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatementsRequest) ProtoMessage() {}
+
+func (x *ListStatementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStatementsRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListStatementsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// ListStatementsResponse is the response for listing an account's statements
+type ListStatementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statements    []*Statement           `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatementsResponse) Reset() {
+	*x = ListStatementsResponse{}
+	mi := &file_account_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatementsResponse) ProtoMessage() {}
+
+func (x *ListStatementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStatementsResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListStatementsResponse) GetStatements() []*Statement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// ImportBankStatementRequest is the request for importing bank statements.
+// The file may hold several statements; all are imported or none.
+type ImportBankStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // MT940 or CAMT053; detected from the content when empty
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ImportedBy    string                 `protobuf:"bytes,4,opt,name=imported_by,json=importedBy,proto3" json:"imported_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBankStatementRequest) Reset() {
+	*x = ImportBankStatementRequest{}
+	mi := &file_account_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBankStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBankStatementRequest) ProtoMessage() {}
+
+func (x *ImportBankStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBankStatementRequest.ProtoReflect.Descriptor instead.
+func (*ImportBankStatementRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ImportBankStatementRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ImportBankStatementRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportBankStatementRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportBankStatementRequest) GetImportedBy() string {
+	if x != nil {
+		return x.ImportedBy
+	}
+	return ""
+}
+
+// ImportBankStatementResponse is the response for importing bank statements
+type ImportBankStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statements    []*BankStatement       `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBankStatementResponse) Reset() {
+	*x = ImportBankStatementResponse{}
+	mi := &file_account_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBankStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBankStatementResponse) ProtoMessage() {}
+
+func (x *ImportBankStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBankStatementResponse.ProtoReflect.Descriptor instead.
+func (*ImportBankStatementResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ImportBankStatementResponse) GetStatements() []*BankStatement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// RunReconciliationRequest is the request for reconciling an account
+type RunReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // Defaults to today
+	Rerun         bool                   `protobuf:"varint,3,opt,name=rerun,proto3" json:"rerun,omitempty"`          // Undo earlier automatic matches up to as_of and match again
+	RunBy         string                 `protobuf:"bytes,4,opt,name=run_by,json=runBy,proto3" json:"run_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReconciliationRequest) Reset() {
+	*x = RunReconciliationRequest{}
+	mi := &file_account_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReconciliationRequest) ProtoMessage() {}
+
+func (x *RunReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*RunReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RunReconciliationRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RunReconciliationRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *RunReconciliationRequest) GetRerun() bool {
+	if x != nil {
+		return x.Rerun
+	}
+	return false
+}
+
+func (x *RunReconciliationRequest) GetRunBy() string {
+	if x != nil {
+		return x.RunBy
+	}
+	return ""
+}
+
+// RunReconciliationResponse is the response for reconciling an account
+type RunReconciliationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *ReconciliationRun     `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Matches       []*ReconciliationMatch `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"` // Made by this run
+	Report        []byte                 `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`   // JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReconciliationResponse) Reset() {
+	*x = RunReconciliationResponse{}
+	mi := &file_account_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReconciliationResponse) ProtoMessage() {}
+
+func (x *RunReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*RunReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RunReconciliationResponse) GetRun() *ReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *RunReconciliationResponse) GetMatches() []*ReconciliationMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *RunReconciliationResponse) GetReport() []byte {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// GetReconciliationReportRequest is the request for retrieving a reconciliation report
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_account_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetReconciliationReportRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// GetReconciliationReportResponse is the response for retrieving a reconciliation report
+type GetReconciliationReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *ReconciliationRun     `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Report        []byte                 `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"` // JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportResponse) Reset() {
+	*x = GetReconciliationReportResponse{}
+	mi := &file_account_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportResponse) ProtoMessage() {}
+
+func (x *GetReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetReconciliationReportResponse) GetRun() *ReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *GetReconciliationReportResponse) GetReport() []byte {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// ListReconciliationBreaksRequest is the request for listing unmatched items
+type ListReconciliationBreaksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // Defaults to today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationBreaksRequest) Reset() {
+	*x = ListReconciliationBreaksRequest{}
+	mi := &file_account_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationBreaksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationBreaksRequest) ProtoMessage() {}
+
+func (x *ListReconciliationBreaksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationBreaksRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationBreaksRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListReconciliationBreaksRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListReconciliationBreaksRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// ListReconciliationBreaksResponse is the response for listing unmatched items
+type ListReconciliationBreaksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*BankStatementLine   `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Postings      []*Posting             `protobuf:"bytes,2,rep,name=postings,proto3" json:"postings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationBreaksResponse) Reset() {
+	*x = ListReconciliationBreaksResponse{}
+	mi := &file_account_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationBreaksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationBreaksResponse) ProtoMessage() {}
+
+func (x *ListReconciliationBreaksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationBreaksResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationBreaksResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListReconciliationBreaksResponse) GetLines() []*BankStatementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ListReconciliationBreaksResponse) GetPostings() []*Posting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+// ManualMatchRequest is the request for matching items by hand. The lines
+// and postings must all be unmatched and their amounts must sum to the same
+// total.
+type ManualMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	LineIds       []string               `protobuf:"bytes,2,rep,name=line_ids,json=lineIds,proto3" json:"line_ids,omitempty"`
+	PostingIds    []string               `protobuf:"bytes,3,rep,name=posting_ids,json=postingIds,proto3" json:"posting_ids,omitempty"`
+	MatchedBy     string                 `protobuf:"bytes,4,opt,name=matched_by,json=matchedBy,proto3" json:"matched_by,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManualMatchRequest) Reset() {
+	*x = ManualMatchRequest{}
+	mi := &file_account_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManualMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManualMatchRequest) ProtoMessage() {}
+
+func (x *ManualMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualMatchRequest.ProtoReflect.Descriptor instead.
+func (*ManualMatchRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ManualMatchRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ManualMatchRequest) GetLineIds() []string {
+	if x != nil {
+		return x.LineIds
+	}
+	return nil
+}
+
+func (x *ManualMatchRequest) GetPostingIds() []string {
+	if x != nil {
+		return x.PostingIds
+	}
+	return nil
+}
+
+func (x *ManualMatchRequest) GetMatchedBy() string {
+	if x != nil {
+		return x.MatchedBy
+	}
+	return ""
+}
+
+func (x *ManualMatchRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// ManualMatchResponse is the response for matching items by hand
+type ManualMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *ReconciliationMatch   `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManualMatchResponse) Reset() {
+	*x = ManualMatchResponse{}
+	mi := &file_account_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManualMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManualMatchResponse) ProtoMessage() {}
+
+func (x *ManualMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualMatchResponse.ProtoReflect.Descriptor instead.
+func (*ManualMatchResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ManualMatchResponse) GetMatch() *ReconciliationMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// WriteOffBreakRequest is the request for writing off one unmatched item.
+// Exactly one of line_id and posting_id is given. The adjustment moves the
+// amount between the reconciled account and the offset account.
+type WriteOffBreakRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	LineId          string                 `protobuf:"bytes,2,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	PostingId       string                 `protobuf:"bytes,3,opt,name=posting_id,json=postingId,proto3" json:"posting_id,omitempty"`
+	OffsetAccountId string                 `protobuf:"bytes,4,opt,name=offset_account_id,json=offsetAccountId,proto3" json:"offset_account_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	WrittenOffBy    string                 `protobuf:"bytes,6,opt,name=written_off_by,json=writtenOffBy,proto3" json:"written_off_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WriteOffBreakRequest) Reset() {
+	*x = WriteOffBreakRequest{}
+	mi := &file_account_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteOffBreakRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffBreakRequest) ProtoMessage() {}
+
+func (x *WriteOffBreakRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffBreakRequest.ProtoReflect.Descriptor instead.
+func (*WriteOffBreakRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *WriteOffBreakRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *WriteOffBreakRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *WriteOffBreakRequest) GetPostingId() string {
+	if x != nil {
+		return x.PostingId
+	}
+	return ""
+}
+
+func (x *WriteOffBreakRequest) GetOffsetAccountId() string {
+	if x != nil {
+		return x.OffsetAccountId
+	}
+	return ""
+}
+
+func (x *WriteOffBreakRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WriteOffBreakRequest) GetWrittenOffBy() string {
+	if x != nil {
+		return x.WrittenOffBy
+	}
+	return ""
+}
+
+// WriteOffBreakResponse is the response for writing off an unmatched item
+type WriteOffBreakResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *ReconciliationMatch   `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	Adjustment    *Posting               `protobuf:"bytes,2,opt,name=adjustment,proto3" json:"adjustment,omitempty"` // On the reconciled account
+	Offset        *Posting               `protobuf:"bytes,3,opt,name=offset,proto3" json:"offset,omitempty"`         // On the offset account
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteOffBreakResponse) Reset() {
+	*x = WriteOffBreakResponse{}
+	mi := &file_account_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteOffBreakResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffBreakResponse) ProtoMessage() {}
+
+func (x *WriteOffBreakResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffBreakResponse.ProtoReflect.Descriptor instead.
+func (*WriteOffBreakResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WriteOffBreakResponse) GetMatch() *ReconciliationMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *WriteOffBreakResponse) GetAdjustment() *Posting {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+func (x *WriteOffBreakResponse) GetOffset() *Posting {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+// SetFXRatesRequest is the request for adding exchange rates. The rates are
+// given either as a list or as a CSV or JSON rate table file.
+type SetFXRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*FXRate              `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	Table         []byte                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // CSV or JSON; detected from the table when empty
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFXRatesRequest) Reset() {
+	*x = SetFXRatesRequest{}
+	mi := &file_account_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFXRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFXRatesRequest) ProtoMessage() {}
+
+func (x *SetFXRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFXRatesRequest.ProtoReflect.Descriptor instead.
+func (*SetFXRatesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetFXRatesRequest) GetRates() []*FXRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *SetFXRatesRequest) GetTable() []byte {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *SetFXRatesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *SetFXRatesRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// SetFXRatesResponse is the response for adding exchange rates
+type SetFXRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"` // Identical to rates already in the table
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFXRatesResponse) Reset() {
+	*x = SetFXRatesResponse{}
+	mi := &file_account_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFXRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFXRatesResponse) ProtoMessage() {}
+
+func (x *SetFXRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFXRatesResponse.ProtoReflect.Descriptor instead.
+func (*SetFXRatesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetFXRatesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *SetFXRatesResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// ListFXRatesRequest is the request for listing the rate history of a pair
+type ListFXRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFXRatesRequest) Reset() {
+	*x = ListFXRatesRequest{}
+	mi := &file_account_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFXRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFXRatesRequest) ProtoMessage() {}
+
+func (x *ListFXRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFXRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListFXRatesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListFXRatesRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ListFXRatesRequest) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+// ListFXRatesResponse is the response for listing the rate history of a pair
+type ListFXRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*FXRate              `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"` // Latest effective first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFXRatesResponse) Reset() {
+	*x = ListFXRatesResponse{}
+	mi := &file_account_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFXRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFXRatesResponse) ProtoMessage() {}
+
+func (x *ListFXRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFXRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListFXRatesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListFXRatesResponse) GetRates() []*FXRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+// SetFXPositionAccountRequest is the request for assigning an FX position
+// account. The account's currency must be one of the pair's.
+type SetFXPositionAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyPair  string                 `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFXPositionAccountRequest) Reset() {
+	*x = SetFXPositionAccountRequest{}
+	mi := &file_account_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFXPositionAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFXPositionAccountRequest) ProtoMessage() {}
+
+func (x *SetFXPositionAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFXPositionAccountRequest.ProtoReflect.Descriptor instead.
+func (*SetFXPositionAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetFXPositionAccountRequest) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *SetFXPositionAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SetFXPositionAccountRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// SetFXPositionAccountResponse is the response for assigning an FX position account
+type SetFXPositionAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      *FXPositionAccount     `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFXPositionAccountResponse) Reset() {
+	*x = SetFXPositionAccountResponse{}
+	mi := &file_account_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFXPositionAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFXPositionAccountResponse) ProtoMessage() {}
+
+func (x *SetFXPositionAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFXPositionAccountResponse.ProtoReflect.Descriptor instead.
+func (*SetFXPositionAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetFXPositionAccountResponse) GetPosition() *FXPositionAccount {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+// CreateFXQuoteRequest is the request for pricing a conversion. Exactly one
+// of source_amount and target_amount is given.
+type CreateFXQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId string                 `protobuf:"bytes,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId   string                 `protobuf:"bytes,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	SourceAmount  int64                  `protobuf:"varint,3,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"` // Minor units of the source account's currency to convert
+	TargetAmount  int64                  `protobuf:"varint,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"` // Minor units of the target account's currency to deliver
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFXQuoteRequest) Reset() {
+	*x = CreateFXQuoteRequest{}
+	mi := &file_account_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFXQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFXQuoteRequest) ProtoMessage() {}
+
+func (x *CreateFXQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFXQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateFXQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateFXQuoteRequest) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *CreateFXQuoteRequest) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *CreateFXQuoteRequest) GetSourceAmount() int64 {
+	if x != nil {
+		return x.SourceAmount
+	}
+	return 0
+}
+
+func (x *CreateFXQuoteRequest) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *CreateFXQuoteRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+// CreateFXQuoteResponse is the response for pricing a conversion
+type CreateFXQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *FXQuote               `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFXQuoteResponse) Reset() {
+	*x = CreateFXQuoteResponse{}
+	mi := &file_account_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFXQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFXQuoteResponse) ProtoMessage() {}
+
+func (x *CreateFXQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFXQuoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateFXQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateFXQuoteResponse) GetQuote() *FXQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// GetFXQuoteRequest is the request for retrieving an FX quote
+type GetFXQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFXQuoteRequest) Reset() {
+	*x = GetFXQuoteRequest{}
+	mi := &file_account_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFXQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFXQuoteRequest) ProtoMessage() {}
+
+func (x *GetFXQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFXQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetFXQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetFXQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+// GetFXQuoteResponse is the response for retrieving an FX quote
+type GetFXQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *FXQuote               `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFXQuoteResponse) Reset() {
+	*x = GetFXQuoteResponse{}
+	mi := &file_account_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFXQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFXQuoteResponse) ProtoMessage() {}
+
+func (x *GetFXQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFXQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetFXQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetFXQuoteResponse) GetQuote() *FXQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// ExecuteFXQuoteRequest is the request for booking a quoted conversion
+type ExecuteFXQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"` // Defaults to FX-<quote_id>
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ExecutedBy    string                 `protobuf:"bytes,4,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
+	Channel       string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"` // Branch, Online, Mobile, API or Batch; defaults to API
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteFXQuoteRequest) Reset() {
+	*x = ExecuteFXQuoteRequest{}
+	mi := &file_account_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteFXQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteFXQuoteRequest) ProtoMessage() {}
+
+func (x *ExecuteFXQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteFXQuoteRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteFXQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExecuteFXQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *ExecuteFXQuoteRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ExecuteFXQuoteRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExecuteFXQuoteRequest) GetExecutedBy() string {
+	if x != nil {
+		return x.ExecutedBy
+	}
+	return ""
+}
+
+func (x *ExecuteFXQuoteRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+// ExecuteFXQuoteResponse is the response for booking a quoted conversion
+type ExecuteFXQuoteResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Quote            *FXQuote               `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Debit            *Posting               `protobuf:"bytes,2,opt,name=debit,proto3" json:"debit,omitempty"`                                               // On the source account
+	Credit           *Posting               `protobuf:"bytes,3,opt,name=credit,proto3" json:"credit,omitempty"`                                             // On the target account
+	PositionPostings []*Posting             `protobuf:"bytes,4,rep,name=position_postings,json=positionPostings,proto3" json:"position_postings,omitempty"` // On the pair's two position accounts
+	Balance          *Balance               `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`                                           // Balance of the source account after the conversion
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExecuteFXQuoteResponse) Reset() {
+	*x = ExecuteFXQuoteResponse{}
+	mi := &file_account_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteFXQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteFXQuoteResponse) ProtoMessage() {}
+
+func (x *ExecuteFXQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteFXQuoteResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteFXQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExecuteFXQuoteResponse) GetQuote() *FXQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *ExecuteFXQuoteResponse) GetDebit() *Posting {
+	if x != nil {
+		return x.Debit
+	}
+	return nil
+}
+
+func (x *ExecuteFXQuoteResponse) GetCredit() *Posting {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+func (x *ExecuteFXQuoteResponse) GetPositionPostings() []*Posting {
+	if x != nil {
+		return x.PositionPostings
+	}
+	return nil
+}
+
+func (x *ExecuteFXQuoteResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// SetLimitRuleRequest is the request for adding a transaction limit
+type SetLimitRuleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Channel         string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	TransactionType string                 `protobuf:"bytes,3,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	RiskTier        string                 `protobuf:"bytes,4,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
+	Currency        string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Value           int64                  `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`
+	EffectiveFrom   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // Defaults to now
+	CreatedBy       string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetLimitRuleRequest) Reset() {
+	*x = SetLimitRuleRequest{}
+	mi := &file_account_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLimitRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLimitRuleRequest) ProtoMessage() {}
+
+func (x *SetLimitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLimitRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetLimitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SetLimitRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetLimitRuleRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SetLimitRuleRequest) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *SetLimitRuleRequest) GetRiskTier() string {
+	if x != nil {
+		return x.RiskTier
+	}
+	return ""
+}
+
+func (x *SetLimitRuleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetLimitRuleRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SetLimitRuleRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *SetLimitRuleRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// SetLimitRuleResponse is the response for adding a transaction limit
+type SetLimitRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *LimitRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLimitRuleResponse) Reset() {
+	*x = SetLimitRuleResponse{}
+	mi := &file_account_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLimitRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLimitRuleResponse) ProtoMessage() {}
+
+func (x *SetLimitRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLimitRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetLimitRuleResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetLimitRuleResponse) GetRule() *LimitRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ListLimitRulesRequest is the request for listing the transaction limit history
+type ListLimitRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"` // Optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLimitRulesRequest) Reset() {
+	*x = ListLimitRulesRequest{}
+	mi := &file_account_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLimitRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitRulesRequest) ProtoMessage() {}
+
+func (x *ListLimitRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListLimitRulesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListLimitRulesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// ListLimitRulesResponse is the response for listing the transaction limit history
+type ListLimitRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*LimitRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLimitRulesResponse) Reset() {
+	*x = ListLimitRulesResponse{}
+	mi := &file_account_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLimitRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitRulesResponse) ProtoMessage() {}
+
+func (x *ListLimitRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListLimitRulesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListLimitRulesResponse) GetRules() []*LimitRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// SetCustomerRiskTierRequest is the request for setting a customer's risk tier
+type SetCustomerRiskTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RiskTier      string                 `protobuf:"bytes,2,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCustomerRiskTierRequest) Reset() {
+	*x = SetCustomerRiskTierRequest{}
+	mi := &file_account_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCustomerRiskTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCustomerRiskTierRequest) ProtoMessage() {}
+
+func (x *SetCustomerRiskTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCustomerRiskTierRequest.ProtoReflect.Descriptor instead.
+func (*SetCustomerRiskTierRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetCustomerRiskTierRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *SetCustomerRiskTierRequest) GetRiskTier() string {
+	if x != nil {
+		return x.RiskTier
+	}
+	return ""
+}
+
+func (x *SetCustomerRiskTierRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// SetCustomerRiskTierResponse is the response for setting a customer's risk tier
+type SetCustomerRiskTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RiskTier      string                 `protobuf:"bytes,2,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCustomerRiskTierResponse) Reset() {
+	*x = SetCustomerRiskTierResponse{}
+	mi := &file_account_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCustomerRiskTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCustomerRiskTierResponse) ProtoMessage() {}
+
+func (x *SetCustomerRiskTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCustomerRiskTierResponse.ProtoReflect.Descriptor instead.
+func (*SetCustomerRiskTierResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetCustomerRiskTierResponse) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *SetCustomerRiskTierResponse) GetRiskTier() string {
+	if x != nil {
+		return x.RiskTier
+	}
+	return ""
+}
+
+func (x *SetCustomerRiskTierResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GrantLimitIncreaseRequest is the request for temporarily raising a limit
+type GrantLimitIncreaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	LimitRuleId   string                 `protobuf:"bytes,2,opt,name=limit_rule_id,json=limitRuleId,proto3" json:"limit_rule_id,omitempty"`
+	Value         int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`                      // Must exceed the rule's value
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // Defaults to now
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,7,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantLimitIncreaseRequest) Reset() {
+	*x = GrantLimitIncreaseRequest{}
+	mi := &file_account_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantLimitIncreaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantLimitIncreaseRequest) ProtoMessage() {}
+
+func (x *GrantLimitIncreaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantLimitIncreaseRequest.ProtoReflect.Descriptor instead.
+func (*GrantLimitIncreaseRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GrantLimitIncreaseRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GrantLimitIncreaseRequest) GetLimitRuleId() string {
+	if x != nil {
+		return x.LimitRuleId
+	}
+	return ""
+}
+
+func (x *GrantLimitIncreaseRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GrantLimitIncreaseRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *GrantLimitIncreaseRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GrantLimitIncreaseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GrantLimitIncreaseRequest) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+// GrantLimitIncreaseResponse is the response for temporarily raising a limit
+type GrantLimitIncreaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Increase      *LimitIncrease         `protobuf:"bytes,1,opt,name=increase,proto3" json:"increase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantLimitIncreaseResponse) Reset() {
+	*x = GrantLimitIncreaseResponse{}
+	mi := &file_account_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantLimitIncreaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantLimitIncreaseResponse) ProtoMessage() {}
+
+func (x *GrantLimitIncreaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantLimitIncreaseResponse.ProtoReflect.Descriptor instead.
+func (*GrantLimitIncreaseResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GrantLimitIncreaseResponse) GetIncrease() *LimitIncrease {
+	if x != nil {
+		return x.Increase
+	}
+	return nil
+}
+
+// RevokeLimitIncreaseRequest is the request for ending a temporary limit increase
+type RevokeLimitIncreaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncreaseId    string                 `protobuf:"bytes,1,opt,name=increase_id,json=increaseId,proto3" json:"increase_id,omitempty"`
+	RevokedBy     string                 `protobuf:"bytes,2,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLimitIncreaseRequest) Reset() {
+	*x = RevokeLimitIncreaseRequest{}
+	mi := &file_account_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLimitIncreaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLimitIncreaseRequest) ProtoMessage() {}
+
+func (x *RevokeLimitIncreaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLimitIncreaseRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLimitIncreaseRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RevokeLimitIncreaseRequest) GetIncreaseId() string {
+	if x != nil {
+		return x.IncreaseId
+	}
+	return ""
+}
+
+func (x *RevokeLimitIncreaseRequest) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+// RevokeLimitIncreaseResponse is the response for ending a temporary limit increase
+type RevokeLimitIncreaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Increase      *LimitIncrease         `protobuf:"bytes,1,opt,name=increase,proto3" json:"increase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLimitIncreaseResponse) Reset() {
+	*x = RevokeLimitIncreaseResponse{}
+	mi := &file_account_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLimitIncreaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLimitIncreaseResponse) ProtoMessage() {}
+
+func (x *RevokeLimitIncreaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLimitIncreaseResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLimitIncreaseResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RevokeLimitIncreaseResponse) GetIncrease() *LimitIncrease {
+	if x != nil {
+		return x.Increase
+	}
+	return nil
+}
+
+// GetRemainingLimitsRequest is the request for an account's remaining limits.
+// Without a channel or transaction type, limits for all of them are listed.
+type GetRemainingLimitsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Channel         string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	TransactionType string                 `protobuf:"bytes,3,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRemainingLimitsRequest) Reset() {
+	*x = GetRemainingLimitsRequest{}
+	mi := &file_account_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemainingLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemainingLimitsRequest) ProtoMessage() {}
+
+func (x *GetRemainingLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemainingLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetRemainingLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetRemainingLimitsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetRemainingLimitsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetRemainingLimitsRequest) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+// GetRemainingLimitsResponse is the response for an account's remaining limits
+type GetRemainingLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RiskTier      string                 `protobuf:"bytes,2,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Limits        []*RemainingLimit      `protobuf:"bytes,4,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRemainingLimitsResponse) Reset() {
+	*x = GetRemainingLimitsResponse{}
+	mi := &file_account_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemainingLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemainingLimitsResponse) ProtoMessage() {}
+
+func (x *GetRemainingLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemainingLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetRemainingLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetRemainingLimitsResponse) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetRemainingLimitsResponse) GetRiskTier() string {
+	if x != nil {
+		return x.RiskTier
+	}
+	return ""
+}
+
+func (x *GetRemainingLimitsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetRemainingLimitsResponse) GetLimits() []*RemainingLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// ListAMLAlertsRequest is the request for listing monitoring alerts. Empty
+// filters match every alert.
+type ListAMLAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAMLAlertsRequest) Reset() {
+	*x = ListAMLAlertsRequest{}
+	mi := &file_account_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAMLAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAMLAlertsRequest) ProtoMessage() {}
+
+func (x *ListAMLAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAMLAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAMLAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListAMLAlertsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListAMLAlertsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAMLAlertsRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ListAMLAlertsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListAMLAlertsResponse is the response for listing monitoring alerts
+type ListAMLAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*AMLAlert            `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAMLAlertsResponse) Reset() {
+	*x = ListAMLAlertsResponse{}
+	mi := &file_account_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAMLAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAMLAlertsResponse) ProtoMessage() {}
+
+func (x *ListAMLAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAMLAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAMLAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListAMLAlertsResponse) GetAlerts() []*AMLAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+// GetAMLAlertRequest is the request for retrieving a monitoring alert
+type GetAMLAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAMLAlertRequest) Reset() {
+	*x = GetAMLAlertRequest{}
+	mi := &file_account_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAMLAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAMLAlertRequest) ProtoMessage() {}
+
+func (x *GetAMLAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAMLAlertRequest.ProtoReflect.Descriptor instead.
+func (*GetAMLAlertRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetAMLAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetAMLAlertResponse is the response for retrieving a monitoring alert
+type GetAMLAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *AMLAlert              `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	Evidence      []*TransactionEvent    `protobuf:"bytes,2,rep,name=evidence,proto3" json:"evidence,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAMLAlertResponse) Reset() {
+	*x = GetAMLAlertResponse{}
+	mi := &file_account_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAMLAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAMLAlertResponse) ProtoMessage() {}
+
+func (x *GetAMLAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAMLAlertResponse.ProtoReflect.Descriptor instead.
+func (*GetAMLAlertResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetAMLAlertResponse) GetAlert() *AMLAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *GetAMLAlertResponse) GetEvidence() []*TransactionEvent {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// CloseAMLAlertRequest is the request for closing a monitoring alert
+type CloseAMLAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // Escalated or Dismissed
+	ClosedBy      string                 `protobuf:"bytes,3,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	Resolution    string                 `protobuf:"bytes,4,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAMLAlertRequest) Reset() {
+	*x = CloseAMLAlertRequest{}
+	mi := &file_account_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAMLAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAMLAlertRequest) ProtoMessage() {}
+
+func (x *CloseAMLAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAMLAlertRequest.ProtoReflect.Descriptor instead.
+func (*CloseAMLAlertRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CloseAMLAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloseAMLAlertRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CloseAMLAlertRequest) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *CloseAMLAlertRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+// CloseAMLAlertResponse is the response for closing a monitoring alert
+type CloseAMLAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *AMLAlert              `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAMLAlertResponse) Reset() {
+	*x = CloseAMLAlertResponse{}
+	mi := &file_account_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAMLAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAMLAlertResponse) ProtoMessage() {}
+
+func (x *CloseAMLAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAMLAlertResponse.ProtoReflect.Descriptor instead.
+func (*CloseAMLAlertResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CloseAMLAlertResponse) GetAlert() *AMLAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+var File_account_proto protoreflect.FileDescriptor
+
+const file_account_proto_rawDesc = "" +
+	"\n" +
+	"\raccount.proto\x12\n" +
+	"account.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x04\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12!\n" +
+	"\faccount_type\x18\x04 \x01(\tR\vaccountType\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12%\n" +
+	"\x0eledger_balance\x18\x06 \x01(\x03R\rledgerBalance\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x127\n" +
+	"\topened_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x127\n" +
+	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\x12'\n" +
+	"\x0foverdraft_limit\x18\r \x01(\x03R\x0eoverdraftLimit\x12%\n" +
+	"\x0eoverdraft_rate\x18\x0e \x01(\tR\roverdraftRate\x12\x18\n" +
+	"\asegment\x18\x0f \x01(\tR\asegment\x12\x12\n" +
+	"\x04iban\x18\x10 \x01(\tR\x04iban\"\x93\x02\n" +
+	"\aBalance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12%\n" +
+	"\x0eledger_balance\x18\x03 \x01(\x03R\rledgerBalance\x12\x1f\n" +
+	"\vheld_amount\x18\x04 \x01(\x03R\n" +
+	"heldAmount\x12+\n" +
+	"\x11available_balance\x18\x05 \x01(\x03R\x10availableBalance\x12/\n" +
+	"\x05as_of\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12'\n" +
+	"\x0foverdraft_limit\x18\a \x01(\x03R\x0eoverdraftLimit\"\xcf\x03\n" +
+	"\x04Hold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1b\n" +
+	"\thold_type\x18\x03 \x01(\tR\bholdType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12)\n" +
+	"\x10remaining_amount\x18\x05 \x01(\x03R\x0fremainingAmount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1c\n" +
+	"\treference\x18\b \x01(\tR\treference\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\"\xdc\x02\n" +
+	"\aPosting\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12!\n" +
+	"\fposting_type\x18\x03 \x01(\tR\vpostingType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x17\n" +
+	"\ahold_id\x18\b \x01(\tR\x06holdId\x129\n" +
+	"\n" +
+	"value_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tvalueDate\x127\n" +
+	"\tbooked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bbookedAt\"\x9b\x03\n" +
+	"\aFeeRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\faccount_type\x18\x02 \x01(\tR\vaccountType\x12\x19\n" +
+	"\bfee_type\x18\x03 \x01(\tR\afeeType\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x121\n" +
+	"\x12waiver_min_balance\x18\x06 \x01(\x03H\x00R\x10waiverMinBalance\x88\x01\x01\x12'\n" +
+	"\x0fwaiver_segments\x18\a \x03(\tR\x0ewaiverSegments\x12A\n" +
+	"\x0eeffective_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedByB\x15\n" +
+	"\x13_waiver_min_balance\"\x8f\x04\n" +
+	"\x03Fee\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1e\n" +
+	"\vfee_rule_id\x18\x03 \x01(\tR\tfeeRuleId\x12\x19\n" +
+	"\bfee_type\x18\x04 \x01(\tR\afeeType\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1c\n" +
+	"\treference\x18\b \x01(\tR\treference\x12#\n" +
+	"\rwaiver_reason\x18\t \x01(\tR\fwaiverReason\x12\x1d\n" +
+	"\n" +
+	"posting_id\x18\n" +
+	" \x01(\tR\tpostingId\x12.\n" +
+	"\x13reversal_posting_id\x18\v \x01(\tR\x11reversalPostingId\x12'\n" +
+	"\x0freversal_reason\x18\f \x01(\tR\x0ereversalReason\x12\x1f\n" +
+	"\vreversed_by\x18\r \x01(\tR\n" +
+	"reversedBy\x129\n" +
+	"\n" +
+	"charged_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tchargedAt\x12;\n" +
+	"\vreversed_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reversedAt\"\xbb\x03\n" +
+	"\fAccountParty\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12!\n" +
+	"\fsigning_rule\x18\x05 \x01(\tR\vsigningRule\x129\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1d\n" +
+	"\n" +
+	"end_reason\x18\b \x01(\tR\tendReason\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\"p\n" +
+	"\x0fCustomerAccount\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\x12.\n" +
+	"\x05party\x18\x02 \x01(\v2\x18.account.v1.AccountPartyR\x05party\"\xbf\x03\n" +
+	"\tStatement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12=\n" +
+	"\fperiod_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12'\n" +
+	"\x0fopening_balance\x18\x06 \x01(\x03R\x0eopeningBalance\x12'\n" +
+	"\x0fclosing_balance\x18\a \x01(\x03R\x0eclosingBalance\x12=\n" +
+	"\fgenerated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12!\n" +
+	"\fgenerated_by\x18\t \x01(\tR\vgeneratedBy\x12;\n" +
+	"\tdocuments\x18\n" +
+	" \x03(\v2\x1d.account.v1.StatementDocumentR\tdocuments\"q\n" +
+	"\x11StatementDocument\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12!\n" +
+	"\fcontent_hash\x18\x03 \x01(\tR\vcontentHash\"\xae\x04\n" +
+	"\rBankStatement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1f\n" +
+	"\vexternal_id\x18\x04 \x01(\tR\n" +
+	"externalId\x12)\n" +
+	"\x10external_account\x18\x05 \x01(\tR\x0fexternalAccount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12=\n" +
+	"\fopening_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vopeningDate\x12'\n" +
+	"\x0fopening_balance\x18\b \x01(\x03R\x0eopeningBalance\x12=\n" +
+	"\fclosing_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vclosingDate\x12'\n" +
+	"\x0fclosing_balance\x18\n" +
+	" \x01(\x03R\x0eclosingBalance\x12\x1d\n" +
+	"\n" +
+	"line_count\x18\v \x01(\x05R\tlineCount\x12!\n" +
+	"\fcontent_hash\x18\f \x01(\tR\vcontentHash\x12;\n" +
+	"\vimported_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"importedAt\x12\x1f\n" +
+	"\vimported_by\x18\x0e \x01(\tR\n" +
+	"importedBy\"\xb2\x03\n" +
+	"\x11BankStatementLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fstatement_id\x18\x02 \x01(\tR\vstatementId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vline_number\x18\x04 \x01(\x05R\n" +
+	"lineNumber\x129\n" +
+	"\n" +
+	"value_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tvalueDate\x12=\n" +
+	"\fbooking_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vbookingDate\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x1c\n" +
+	"\treference\x18\b \x01(\tR\treference\x12%\n" +
+	"\x0ebank_reference\x18\t \x01(\tR\rbankReference\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x19\n" +
+	"\bmatch_id\x18\f \x01(\tR\amatchId\"\xe1\x02\n" +
+	"\x13ReconciliationMatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04rule\x18\x04 \x01(\tR\x04rule\x12\x15\n" +
+	"\x06run_id\x18\x05 \x01(\tR\x05runId\x12\x19\n" +
+	"\bline_ids\x18\x06 \x03(\tR\alineIds\x12\x1f\n" +
+	"\vposting_ids\x18\a \x03(\tR\n" +
+	"postingIds\x122\n" +
+	"\x15adjustment_posting_id\x18\b \x01(\tR\x13adjustmentPostingId\x12\x12\n" +
+	"\x04note\x18\t \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\"\xc8\x03\n" +
+	"\x11ReconciliationRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12/\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x14\n" +
+	"\x05rerun\x18\x04 \x01(\bR\x05rerun\x12'\n" +
+	"\x0fmatches_created\x18\x05 \x01(\x05R\x0ematchesCreated\x12'\n" +
+	"\x0funmatched_lines\x18\x06 \x01(\x05R\x0eunmatchedLines\x12-\n" +
+	"\x12unmatched_postings\x18\a \x01(\x05R\x11unmatchedPostings\x12+\n" +
+	"\x11statement_balance\x18\b \x01(\x03R\x10statementBalance\x12%\n" +
+	"\x0eledger_balance\x18\t \x01(\x03R\rledgerBalance\x12\x1e\n" +
+	"\n" +
+	"difference\x18\n" +
+	" \x01(\x03R\n" +
+	"difference\x121\n" +
+	"\x06run_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x05runAt\x12\x15\n" +
+	"\x06run_by\x18\f \x01(\tR\x05runBy\"\xb4\x02\n" +
+	"\x06FXRate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rbase_currency\x18\x02 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x03 \x01(\tR\rquoteCurrency\x12\x19\n" +
+	"\bmid_rate\x18\x04 \x01(\tR\amidRate\x12A\n" +
+	"\x0eeffective_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\"\xcd\x01\n" +
+	"\x11FXPositionAccount\x12#\n" +
+	"\rcurrency_pair\x18\x01 \x01(\tR\fcurrencyPair\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\"\xf0\x06\n" +
+	"\aFXQuote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0ffrom_account_id\x18\x02 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x03 \x01(\tR\vtoAccountId\x12'\n" +
+	"\x0fsource_currency\x18\x04 \x01(\tR\x0esourceCurrency\x12'\n" +
+	"\x0ftarget_currency\x18\x05 \x01(\tR\x0etargetCurrency\x12#\n" +
+	"\rsource_amount\x18\x06 \x01(\x03R\fsourceAmount\x12#\n" +
+	"\rtarget_amount\x18\a \x01(\x03R\ftargetAmount\x12\x1d\n" +
+	"\n" +
+	"fixed_side\x18\b \x01(\tR\tfixedSide\x12\x17\n" +
+	"\arate_id\x18\t \x01(\tR\x06rateId\x12\x19\n" +
+	"\bmid_rate\x18\n" +
+	" \x01(\tR\amidRate\x12\x1a\n" +
+	"\binverted\x18\v \x01(\bR\binverted\x12\x18\n" +
+	"\asegment\x18\f \x01(\tR\asegment\x12\x1d\n" +
+	"\n" +
+	"spread_bps\x18\r \x01(\x05R\tspreadBps\x12#\n" +
+	"\rcustomer_rate\x18\x0e \x01(\tR\fcustomerRate\x12!\n" +
+	"\fexact_amount\x18\x0f \x01(\tR\vexactAmount\x12\x16\n" +
+	"\x06status\x18\x10 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x13 \x01(\tR\tcreatedBy\x12;\n" +
+	"\vexecuted_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"executedAt\x12\x1f\n" +
+	"\vexecuted_by\x18\x15 \x01(\tR\n" +
+	"executedBy\x12\x1c\n" +
+	"\treference\x18\x16 \x01(\tR\treference\x12(\n" +
+	"\x10debit_posting_id\x18\x17 \x01(\tR\x0edebitPostingId\x12*\n" +
+	"\x11credit_posting_id\x18\x18 \x01(\tR\x0fcreditPostingId\"\xe0\x02\n" +
+	"\tLimitRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12)\n" +
+	"\x10transaction_type\x18\x04 \x01(\tR\x0ftransactionType\x12\x1b\n" +
+	"\trisk_tier\x18\x05 \x01(\tR\briskTier\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05value\x18\a \x01(\x03R\x05value\x12A\n" +
+	"\x0eeffective_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\"\xba\x03\n" +
+	"\rLimitIncrease\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\"\n" +
+	"\rlimit_rule_id\x18\x03 \x01(\tR\vlimitRuleId\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x03R\x05value\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"revoked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_by\x18\v \x01(\tR\trevokedBy\"\xf3\x01\n" +
+	"\x0eRemainingLimit\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.account.v1.LimitRuleR\x04rule\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04used\x18\x03 \x01(\x03R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x03R\tremaining\x127\n" +
+	"\tresets_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bresetsAt\x125\n" +
+	"\bincrease\x18\x06 \x01(\v2\x19.account.v1.LimitIncreaseR\bincrease\"\x98\x02\n" +
+	"\vLimitBreach\x12\x19\n" +
+	"\blimit_id\x18\x01 \x01(\tR\alimitId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04used\x18\x05 \x01(\x03R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x03R\tremaining\x12\x1c\n" +
+	"\trequested\x18\a \x01(\x03R\trequested\x127\n" +
+	"\tresets_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bresetsAt\x12\x1f\n" +
+	"\vincrease_id\x18\t \x01(\tR\n" +
+	"increaseId\"\xdb\x03\n" +
+	"\x10TransactionEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\tR\taccountId\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x126\n" +
+	"\x17counterparty_account_id\x18\b \x01(\tR\x15counterpartyAccountId\x121\n" +
+	"\x14counterparty_country\x18\t \x01(\tR\x13counterpartyCountry\x12\x18\n" +
+	"\achannel\x18\n" +
+	" \x01(\tR\achannel\x12)\n" +
+	"\x10transaction_type\x18\v \x01(\tR\x0ftransactionType\x12\x1c\n" +
+	"\treference\x18\f \x01(\tR\treference\x12;\n" +
+	"\voccurred_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x8a\x04\n" +
+	"\bAMLAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_type\x18\x03 \x01(\tR\bruleType\x12#\n" +
+	"\rrules_version\x18\x04 \x01(\tR\frulesVersion\x12\x1f\n" +
+	"\vcustomer_id\x18\x05 \x01(\tR\n" +
+	"customerId\x12(\n" +
+	"\x10trigger_event_id\x18\x06 \x01(\tR\x0etriggerEventId\x12\x18\n" +
+	"\asummary\x18\a \x01(\tR\asummary\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x127\n" +
+	"\traised_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\braisedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tclosed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12\x1b\n" +
+	"\tclosed_by\x18\f \x01(\tR\bclosedBy\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\r \x01(\tR\n" +
+	"resolution\x12,\n" +
+	"\x12evidence_event_ids\x18\x0e \x03(\tR\x10evidenceEventIds\"\xb1\x01\n" +
+	"\x12OpenAccountRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12!\n" +
+	"\faccount_type\x18\x02 \x01(\tR\vaccountType\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x18\n" +
+	"\asegment\x18\x04 \x01(\tR\asegment\x12!\n" +
+	"\fsigning_rule\x18\x05 \x01(\tR\vsigningRule\"D\n" +
+	"\x13OpenAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"J\n" +
+	"\x11GetAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\"C\n" +
+	"\x12GetAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\">\n" +
+	"\x14FreezeAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"F\n" +
+	"\x15FreezeAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"@\n" +
+	"\x16UnfreezeAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"H\n" +
+	"\x17UnfreezeAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"2\n" +
+	"\x11GetBalanceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"C\n" +
+	"\x12GetBalanceResponse\x12-\n" +
+	"\abalance\x18\x01 \x01(\v2\x13.account.v1.BalanceR\abalance\"\x92\x02\n" +
+	"\x10PlaceHoldRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1b\n" +
+	"\thold_type\x18\x02 \x01(\tR\bholdType\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\"h\n" +
+	"\x11PlaceHoldResponse\x12$\n" +
+	"\x04hold\x18\x01 \x01(\v2\x10.account.v1.HoldR\x04hold\x12-\n" +
+	"\abalance\x18\x02 \x01(\v2\x13.account.v1.BalanceR\abalance\"]\n" +
+	"\x12ReleaseHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"j\n" +
+	"\x13ReleaseHoldResponse\x12$\n" +
+	"\x04hold\x18\x01 \x01(\v2\x10.account.v1.HoldR\x04hold\x12-\n" +
+	"\abalance\x18\x02 \x01(\v2\x13.account.v1.BalanceR\abalance\"g\n" +
+	"\x12CaptureHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x99\x01\n" +
+	"\x13CaptureHoldResponse\x12$\n" +
+	"\x04hold\x18\x01 \x01(\v2\x10.account.v1.HoldR\x04hold\x12-\n" +
+	"\aposting\x18\x02 \x01(\v2\x13.account.v1.PostingR\aposting\x12-\n" +
+	"\abalance\x18\x03 \x01(\v2\x13.account.v1.BalanceR\abalance\"R\n" +
+	"\x10ListHoldsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\";\n" +
+	"\x11ListHoldsResponse\x12&\n" +
+	"\x05holds\x18\x01 \x03(\v2\x10.account.v1.HoldR\x05holds\"n\n" +
+	"\x18SetOverdraftLimitRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x1d\n" +
+	"\n" +
+	"debit_rate\x18\x03 \x01(\tR\tdebitRate\"y\n" +
+	"\x19SetOverdraftLimitResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\x12-\n" +
+	"\abalance\x18\x02 \x01(\v2\x13.account.v1.BalanceR\abalance\"\xc7\x02\n" +
+	"\x0fTransferRequest\x12&\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x02 \x01(\tR\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x18\n" +
+	"\achannel\x18\a \x01(\tR\achannel\x121\n" +
+	"\x14counterparty_country\x18\b \x01(\tR\x13counterpartyCountry\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\"\xbc\x01\n" +
+	"\x10TransferResponse\x12)\n" +
+	"\x05debit\x18\x01 \x01(\v2\x13.account.v1.PostingR\x05debit\x12+\n" +
+	"\x06credit\x18\x02 \x01(\v2\x13.account.v1.PostingR\x06credit\x12!\n" +
+	"\x03fee\x18\x03 \x01(\v2\x0f.account.v1.FeeR\x03fee\x12-\n" +
+	"\abalance\x18\x04 \x01(\v2\x13.account.v1.BalanceR\abalance\"\xda\x02\n" +
+	"\x11SetFeeRuleRequest\x12!\n" +
+	"\faccount_type\x18\x01 \x01(\tR\vaccountType\x12\x19\n" +
+	"\bfee_type\x18\x02 \x01(\tR\afeeType\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x121\n" +
+	"\x12waiver_min_balance\x18\x05 \x01(\x03H\x00R\x10waiverMinBalance\x88\x01\x01\x12'\n" +
+	"\x0fwaiver_segments\x18\x06 \x03(\tR\x0ewaiverSegments\x12A\n" +
+	"\x0eeffective_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedByB\x15\n" +
+	"\x13_waiver_min_balance\"=\n" +
+	"\x12SetFeeRuleResponse\x12'\n" +
+	"\x04rule\x18\x01 \x01(\v2\x13.account.v1.FeeRuleR\x04rule\"8\n" +
+	"\x13ListFeeRulesRequest\x12!\n" +
+	"\faccount_type\x18\x01 \x01(\tR\vaccountType\"A\n" +
+	"\x14ListFeeRulesResponse\x12)\n" +
+	"\x05rules\x18\x01 \x03(\v2\x13.account.v1.FeeRuleR\x05rules\"0\n" +
+	"\x0fListFeesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"7\n" +
+	"\x10ListFeesResponse\x12#\n" +
+	"\x04fees\x18\x01 \x03(\v2\x0f.account.v1.FeeR\x04fees\"c\n" +
+	"\x11ReverseFeeRequest\x12\x15\n" +
+	"\x06fee_id\x18\x01 \x01(\tR\x05feeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vreversed_by\x18\x03 \x01(\tR\n" +
+	"reversedBy\"\x97\x01\n" +
+	"\x12ReverseFeeResponse\x12!\n" +
+	"\x03fee\x18\x01 \x01(\v2\x0f.account.v1.FeeR\x03fee\x12/\n" +
+	"\breversal\x18\x02 \x01(\v2\x13.account.v1.PostingR\breversal\x12-\n" +
+	"\abalance\x18\x03 \x01(\v2\x13.account.v1.BalanceR\abalance\"\xa0\x02\n" +
+	"\x16AddAccountPartyRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12!\n" +
+	"\fsigning_rule\x18\x04 \x01(\tR\vsigningRule\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\"I\n" +
+	"\x17AddAccountPartyResponse\x12.\n" +
+	"\x05party\x18\x01 \x01(\v2\x18.account.v1.AccountPartyR\x05party\"\x82\x01\n" +
+	"\x16EndAccountPartyRequest\x12\x19\n" +
+	"\bparty_id\x18\x01 \x01(\tR\apartyId\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"I\n" +
+	"\x17EndAccountPartyResponse\x12.\n" +
+	"\x05party\x18\x01 \x01(\v2\x18.account.v1.AccountPartyR\x05party\"[\n" +
+	"\x19ListAccountPartiesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"P\n" +
+	"\x1aListAccountPartiesResponse\x122\n" +
+	"\aparties\x18\x01 \x03(\v2\x18.account.v1.AccountPartyR\aparties\"a\n" +
+	"\x1dListAccountsByCustomerRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"Y\n" +
+	"\x1eListAccountsByCustomerResponse\x127\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1b.account.v1.CustomerAccountR\baccounts\"`\n" +
+	"\x1cCheckSigningAuthorityRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\fcustomer_ids\x18\x02 \x03(\tR\vcustomerIds\"?\n" +
+	"\x1dCheckSigningAuthorityResponse\x12\x1e\n" +
+	"\n" +
+	"authorized\x18\x01 \x01(\bR\n" +
+	"authorized\"\xd6\x01\n" +
+	"\x18GenerateStatementRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12!\n" +
+	"\fgenerated_by\x18\x04 \x01(\tR\vgeneratedBy\"P\n" +
+	"\x19GenerateStatementResponse\x123\n" +
+	"\tstatement\x18\x01 \x01(\v2\x15.account.v1.StatementR\tstatement\"P\n" +
+	"\x13GetStatementRequest\x12!\n" +
+	"\fstatement_id\x18\x01 \x01(\tR\vstatementId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\xc3\x01\n" +
+	"\x14GetStatementResponse\x123\n" +
+	"\tstatement\x18\x01 \x01(\v2\x15.account.v1.StatementR\tstatement\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_hash\x18\x05 \x01(\tR\vcontentHash\"6\n" +
+	"\x15ListStatementsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"O\n" +
+	"\x16ListStatementsResponse\x125\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v2\x15.account.v1.StatementR\n" +
+	"statements\"\x8e\x01\n" +
+	"\x1aImportBankStatementRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x1f\n" +
+	"\vimported_by\x18\x04 \x01(\tR\n" +
+	"importedBy\"X\n" +
+	"\x1bImportBankStatementResponse\x129\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v2\x19.account.v1.BankStatementR\n" +
+	"statements\"\x97\x01\n" +
+	"\x18RunReconciliationRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x14\n" +
+	"\x05rerun\x18\x03 \x01(\bR\x05rerun\x12\x15\n" +
+	"\x06run_by\x18\x04 \x01(\tR\x05runBy\"\x9f\x01\n" +
+	"\x19RunReconciliationResponse\x12/\n" +
+	"\x03run\x18\x01 \x01(\v2\x1d.account.v1.ReconciliationRunR\x03run\x129\n" +
+	"\amatches\x18\x02 \x03(\v2\x1f.account.v1.ReconciliationMatchR\amatches\x12\x16\n" +
+	"\x06report\x18\x03 \x01(\fR\x06report\"7\n" +
+	"\x1eGetReconciliationReportRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"j\n" +
+	"\x1fGetReconciliationReportResponse\x12/\n" +
+	"\x03run\x18\x01 \x01(\v2\x1d.account.v1.ReconciliationRunR\x03run\x12\x16\n" +
+	"\x06report\x18\x02 \x01(\fR\x06report\"q\n" +
+	"\x1fListReconciliationBreaksRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\x88\x01\n" +
+	" ListReconciliationBreaksResponse\x123\n" +
+	"\x05lines\x18\x01 \x03(\v2\x1d.account.v1.BankStatementLineR\x05lines\x12/\n" +
+	"\bpostings\x18\x02 \x03(\v2\x13.account.v1.PostingR\bpostings\"\xa2\x01\n" +
+	"\x12ManualMatchRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\bline_ids\x18\x02 \x03(\tR\alineIds\x12\x1f\n" +
+	"\vposting_ids\x18\x03 \x03(\tR\n" +
+	"postingIds\x12\x1d\n" +
+	"\n" +
+	"matched_by\x18\x04 \x01(\tR\tmatchedBy\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"L\n" +
+	"\x13ManualMatchResponse\x125\n" +
+	"\x05match\x18\x01 \x01(\v2\x1f.account.v1.ReconciliationMatchR\x05match\"\xd7\x01\n" +
+	"\x14WriteOffBreakRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\aline_id\x18\x02 \x01(\tR\x06lineId\x12\x1d\n" +
+	"\n" +
+	"posting_id\x18\x03 \x01(\tR\tpostingId\x12*\n" +
+	"\x11offset_account_id\x18\x04 \x01(\tR\x0foffsetAccountId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12$\n" +
+	"\x0ewritten_off_by\x18\x06 \x01(\tR\fwrittenOffBy\"\xb0\x01\n" +
+	"\x15WriteOffBreakResponse\x125\n" +
+	"\x05match\x18\x01 \x01(\v2\x1f.account.v1.ReconciliationMatchR\x05match\x123\n" +
+	"\n" +
+	"adjustment\x18\x02 \x01(\v2\x13.account.v1.PostingR\n" +
+	"adjustment\x12+\n" +
+	"\x06offset\x18\x03 \x01(\v2\x13.account.v1.PostingR\x06offset\"\x8a\x01\n" +
+	"\x11SetFXRatesRequest\x12(\n" +
+	"\x05rates\x18\x01 \x03(\v2\x12.account.v1.FXRateR\x05rates\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\fR\x05table\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\"H\n" +
+	"\x12SetFXRatesResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\"`\n" +
+	"\x12ListFXRatesRequest\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\"?\n" +
+	"\x13ListFXRatesResponse\x12(\n" +
+	"\x05rates\x18\x01 \x03(\v2\x12.account.v1.FXRateR\x05rates\"\x80\x01\n" +
+	"\x1bSetFXPositionAccountRequest\x12#\n" +
+	"\rcurrency_pair\x18\x01 \x01(\tR\fcurrencyPair\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"Y\n" +
+	"\x1cSetFXPositionAccountResponse\x129\n" +
+	"\bposition\x18\x01 \x01(\v2\x1d.account.v1.FXPositionAccountR\bposition\"\xcf\x01\n" +
+	"\x14CreateFXQuoteRequest\x12&\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x02 \x01(\tR\vtoAccountId\x12#\n" +
+	"\rsource_amount\x18\x03 \x01(\x03R\fsourceAmount\x12#\n" +
+	"\rtarget_amount\x18\x04 \x01(\x03R\ftargetAmount\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\"B\n" +
+	"\x15CreateFXQuoteResponse\x12)\n" +
+	"\x05quote\x18\x01 \x01(\v2\x13.account.v1.FXQuoteR\x05quote\".\n" +
+	"\x11GetFXQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\"?\n" +
+	"\x12GetFXQuoteResponse\x12)\n" +
+	"\x05quote\x18\x01 \x01(\v2\x13.account.v1.FXQuoteR\x05quote\"\xad\x01\n" +
+	"\x15ExecuteFXQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vexecuted_by\x18\x04 \x01(\tR\n" +
+	"executedBy\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\"\x8c\x02\n" +
+	"\x16ExecuteFXQuoteResponse\x12)\n" +
+	"\x05quote\x18\x01 \x01(\v2\x13.account.v1.FXQuoteR\x05quote\x12)\n" +
+	"\x05debit\x18\x02 \x01(\v2\x13.account.v1.PostingR\x05debit\x12+\n" +
+	"\x06credit\x18\x03 \x01(\v2\x13.account.v1.PostingR\x06credit\x12@\n" +
+	"\x11position_postings\x18\x04 \x03(\v2\x13.account.v1.PostingR\x10positionPostings\x12-\n" +
+	"\abalance\x18\x05 \x01(\v2\x13.account.v1.BalanceR\abalance\"\x9f\x02\n" +
+	"\x13SetLimitRuleRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12)\n" +
+	"\x10transaction_type\x18\x03 \x01(\tR\x0ftransactionType\x12\x1b\n" +
+	"\trisk_tier\x18\x04 \x01(\tR\briskTier\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x03R\x05value\x12A\n" +
+	"\x0eeffective_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\"A\n" +
+	"\x14SetLimitRuleResponse\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.account.v1.LimitRuleR\x04rule\"3\n" +
+	"\x15ListLimitRulesRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"E\n" +
+	"\x16ListLimitRulesResponse\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.account.v1.LimitRuleR\x05rules\"y\n" +
+	"\x1aSetCustomerRiskTierRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\trisk_tier\x18\x02 \x01(\tR\briskTier\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"\x96\x01\n" +
+	"\x1bSetCustomerRiskTierResponse\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\trisk_tier\x18\x02 \x01(\tR\briskTier\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa1\x02\n" +
+	"\x19GrantLimitIncreaseRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\"\n" +
+	"\rlimit_rule_id\x18\x02 \x01(\tR\vlimitRuleId\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x03R\x05value\x127\n" +
+	"\tstarts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\a \x01(\tR\tgrantedBy\"S\n" +
+	"\x1aGrantLimitIncreaseResponse\x125\n" +
+	"\bincrease\x18\x01 \x01(\v2\x19.account.v1.LimitIncreaseR\bincrease\"\\\n" +
+	"\x1aRevokeLimitIncreaseRequest\x12\x1f\n" +
+	"\vincrease_id\x18\x01 \x01(\tR\n" +
+	"increaseId\x12\x1d\n" +
+	"\n" +
+	"revoked_by\x18\x02 \x01(\tR\trevokedBy\"T\n" +
+	"\x1bRevokeLimitIncreaseResponse\x125\n" +
+	"\bincrease\x18\x01 \x01(\v2\x19.account.v1.LimitIncreaseR\bincrease\"\x7f\n" +
+	"\x19GetRemainingLimitsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12)\n" +
+	"\x10transaction_type\x18\x03 \x01(\tR\x0ftransactionType\"\xaa\x01\n" +
+	"\x1aGetRemainingLimitsResponse\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\trisk_tier\x18\x02 \x01(\tR\briskTier\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x122\n" +
+	"\x06limits\x18\x04 \x03(\v2\x1a.account.v1.RemainingLimitR\x06limits\"~\n" +
+	"\x14ListAMLAlertsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x17\n" +
+	"\arule_id\x18\x03 \x01(\tR\x06ruleId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"E\n" +
+	"\x15ListAMLAlertsResponse\x12,\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x14.account.v1.AMLAlertR\x06alerts\"$\n" +
+	"\x12GetAMLAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"{\n" +
+	"\x13GetAMLAlertResponse\x12*\n" +
+	"\x05alert\x18\x01 \x01(\v2\x14.account.v1.AMLAlertR\x05alert\x128\n" +
+	"\bevidence\x18\x02 \x03(\v2\x1c.account.v1.TransactionEventR\bevidence\"{\n" +
+	"\x14CloseAMLAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tclosed_by\x18\x03 \x01(\tR\bclosedBy\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x04 \x01(\tR\n" +
+	"resolution\"C\n" +
+	"\x15CloseAMLAlertResponse\x12*\n" +
+	"\x05alert\x18\x01 \x01(\v2\x14.account.v1.AMLAlertR\x05alert2\xe8\x1e\n" +
+	"\x0eAccountService\x12N\n" +
+	"\vOpenAccount\x12\x1e.account.v1.OpenAccountRequest\x1a\x1f.account.v1.OpenAccountResponse\x12K\n" +
+	"\n" +
+	"GetAccount\x12\x1d.account.v1.GetAccountRequest\x1a\x1e.account.v1.GetAccountResponse\x12T\n" +
+	"\rFreezeAccount\x12 .account.v1.FreezeAccountRequest\x1a!.account.v1.FreezeAccountResponse\x12Z\n" +
+	"\x0fUnfreezeAccount\x12\".account.v1.UnfreezeAccountRequest\x1a#.account.v1.UnfreezeAccountResponse\x12K\n" +
+	"\n" +
+	"GetBalance\x12\x1d.account.v1.GetBalanceRequest\x1a\x1e.account.v1.GetBalanceResponse\x12H\n" +
+	"\tPlaceHold\x12\x1c.account.v1.PlaceHoldRequest\x1a\x1d.account.v1.PlaceHoldResponse\x12N\n" +
+	"\vReleaseHold\x12\x1e.account.v1.ReleaseHoldRequest\x1a\x1f.account.v1.ReleaseHoldResponse\x12N\n" +
+	"\vCaptureHold\x12\x1e.account.v1.CaptureHoldRequest\x1a\x1f.account.v1.CaptureHoldResponse\x12H\n" +
+	"\tListHolds\x12\x1c.account.v1.ListHoldsRequest\x1a\x1d.account.v1.ListHoldsResponse\x12`\n" +
+	"\x11SetOverdraftLimit\x12$.account.v1.SetOverdraftLimitRequest\x1a%.account.v1.SetOverdraftLimitResponse\x12E\n" +
+	"\bTransfer\x12\x1b.account.v1.TransferRequest\x1a\x1c.account.v1.TransferResponse\x12K\n" +
+	"\n" +
+	"SetFeeRule\x12\x1d.account.v1.SetFeeRuleRequest\x1a\x1e.account.v1.SetFeeRuleResponse\x12Q\n" +
+	"\fListFeeRules\x12\x1f.account.v1.ListFeeRulesRequest\x1a .account.v1.ListFeeRulesResponse\x12E\n" +
+	"\bListFees\x12\x1b.account.v1.ListFeesRequest\x1a\x1c.account.v1.ListFeesResponse\x12K\n" +
+	"\n" +
+	"ReverseFee\x12\x1d.account.v1.ReverseFeeRequest\x1a\x1e.account.v1.ReverseFeeResponse\x12Z\n" +
+	"\x0fAddAccountParty\x12\".account.v1.AddAccountPartyRequest\x1a#.account.v1.AddAccountPartyResponse\x12Z\n" +
+	"\x0fEndAccountParty\x12\".account.v1.EndAccountPartyRequest\x1a#.account.v1.EndAccountPartyResponse\x12c\n" +
+	"\x12ListAccountParties\x12%.account.v1.ListAccountPartiesRequest\x1a&.account.v1.ListAccountPartiesResponse\x12o\n" +
+	"\x16ListAccountsByCustomer\x12).account.v1.ListAccountsByCustomerRequest\x1a*.account.v1.ListAccountsByCustomerResponse\x12l\n" +
+	"\x15CheckSigningAuthority\x12(.account.v1.CheckSigningAuthorityRequest\x1a).account.v1.CheckSigningAuthorityResponse\x12`\n" +
+	"\x11GenerateStatement\x12$.account.v1.GenerateStatementRequest\x1a%.account.v1.GenerateStatementResponse\x12Q\n" +
+	"\fGetStatement\x12\x1f.account.v1.GetStatementRequest\x1a .account.v1.GetStatementResponse\x12W\n" +
+	"\x0eListStatements\x12!.account.v1.ListStatementsRequest\x1a\".account.v1.ListStatementsResponse\x12f\n" +
+	"\x13ImportBankStatement\x12&.account.v1.ImportBankStatementRequest\x1a'.account.v1.ImportBankStatementResponse\x12`\n" +
+	"\x11RunReconciliation\x12$.account.v1.RunReconciliationRequest\x1a%.account.v1.RunReconciliationResponse\x12r\n" +
+	"\x17GetReconciliationReport\x12*.account.v1.GetReconciliationReportRequest\x1a+.account.v1.GetReconciliationReportResponse\x12u\n" +
+	"\x18ListReconciliationBreaks\x12+.account.v1.ListReconciliationBreaksRequest\x1a,.account.v1.ListReconciliationBreaksResponse\x12N\n" +
+	"\vManualMatch\x12\x1e.account.v1.ManualMatchRequest\x1a\x1f.account.v1.ManualMatchResponse\x12T\n" +
+	"\rWriteOffBreak\x12 .account.v1.WriteOffBreakRequest\x1a!.account.v1.WriteOffBreakResponse\x12K\n" +
+	"\n" +
+	"SetFXRates\x12\x1d.account.v1.SetFXRatesRequest\x1a\x1e.account.v1.SetFXRatesResponse\x12N\n" +
+	"\vListFXRates\x12\x1e.account.v1.ListFXRatesRequest\x1a\x1f.account.v1.ListFXRatesResponse\x12i\n" +
+	"\x14SetFXPositionAccount\x12'.account.v1.SetFXPositionAccountRequest\x1a(.account.v1.SetFXPositionAccountResponse\x12T\n" +
+	"\rCreateFXQuote\x12 .account.v1.CreateFXQuoteRequest\x1a!.account.v1.CreateFXQuoteResponse\x12K\n" +
+	"\n" +
+	"GetFXQuote\x12\x1d.account.v1.GetFXQuoteRequest\x1a\x1e.account.v1.GetFXQuoteResponse\x12W\n" +
+	"\x0eExecuteFXQuote\x12!.account.v1.ExecuteFXQuoteRequest\x1a\".account.v1.ExecuteFXQuoteResponse\x12Q\n" +
+	"\fSetLimitRule\x12\x1f.account.v1.SetLimitRuleRequest\x1a .account.v1.SetLimitRuleResponse\x12W\n" +
+	"\x0eListLimitRules\x12!.account.v1.ListLimitRulesRequest\x1a\".account.v1.ListLimitRulesResponse\x12f\n" +
+	"\x13SetCustomerRiskTier\x12&.account.v1.SetCustomerRiskTierRequest\x1a'.account.v1.SetCustomerRiskTierResponse\x12c\n" +
+	"\x12GrantLimitIncrease\x12%.account.v1.GrantLimitIncreaseRequest\x1a&.account.v1.GrantLimitIncreaseResponse\x12f\n" +
+	"\x13RevokeLimitIncrease\x12&.account.v1.RevokeLimitIncreaseRequest\x1a'.account.v1.RevokeLimitIncreaseResponse\x12c\n" +
+	"\x12GetRemainingLimits\x12%.account.v1.GetRemainingLimitsRequest\x1a&.account.v1.GetRemainingLimitsResponse\x12T\n" +
+	"\rListAMLAlerts\x12 .account.v1.ListAMLAlertsRequest\x1a!.account.v1.ListAMLAlertsResponse\x12N\n" +
+	"\vGetAMLAlert\x12\x1e.account.v1.GetAMLAlertRequest\x1a\x1f.account.v1.GetAMLAlertResponse\x12T\n" +
+	"\rCloseAMLAlert\x12 .account.v1.CloseAMLAlertRequest\x1a!.account.v1.CloseAMLAlertResponseBKZIgithub.com/core-banking/services/account-service/internal/proto/accountpbb\x06proto3"
+
+var (
+	file_account_proto_rawDescOnce sync.Once
+	file_account_proto_rawDescData []byte
+)
+
+func file_account_proto_rawDescGZIP() []byte {
+	file_account_proto_rawDescOnce.Do(func() {
+		file_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_account_proto_rawDesc), len(file_account_proto_rawDesc)))
+	})
+	return file_account_proto_rawDescData
+}
+
+var file_account_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
+var file_account_proto_goTypes = []any{
+	(*Account)(nil),                          // 0: account.v1.Account
+	(*Balance)(nil),                          // 1: account.v1.Balance
+	(*Hold)(nil),                             // 2: account.v1.Hold
+	(*Posting)(nil),                          // 3: account.v1.Posting
+	(*FeeRule)(nil),                          // 4: account.v1.FeeRule
+	(*Fee)(nil),                              // 5: account.v1.Fee
+	(*AccountParty)(nil),                     // 6: account.v1.AccountParty
+	(*CustomerAccount)(nil),                  // 7: account.v1.CustomerAccount
+	(*Statement)(nil),                        // 8: account.v1.Statement
+	(*StatementDocument)(nil),                // 9: account.v1.StatementDocument
+	(*BankStatement)(nil),                    // 10: account.v1.BankStatement
+	(*BankStatementLine)(nil),                // 11: account.v1.BankStatementLine
+	(*ReconciliationMatch)(nil),              // 12: account.v1.ReconciliationMatch
+	(*ReconciliationRun)(nil),                // 13: account.v1.ReconciliationRun
+	(*FXRate)(nil),                           // 14: account.v1.FXRate
+	(*FXPositionAccount)(nil),                // 15: account.v1.FXPositionAccount
+	(*FXQuote)(nil),                          // 16: account.v1.FXQuote
+	(*LimitRule)(nil),                        // 17: account.v1.LimitRule
+	(*LimitIncrease)(nil),                    // 18: account.v1.LimitIncrease
+	(*RemainingLimit)(nil),                   // 19: account.v1.RemainingLimit
+	(*LimitBreach)(nil),                      // 20: account.v1.LimitBreach
+	(*TransactionEvent)(nil),                 // 21: account.v1.TransactionEvent
+	(*AMLAlert)(nil),                         // 22: account.v1.AMLAlert
+	(*OpenAccountRequest)(nil),               // 23: account.v1.OpenAccountRequest
+	(*OpenAccountResponse)(nil),              // 24: account.v1.OpenAccountResponse
+	(*GetAccountRequest)(nil),                // 25: account.v1.GetAccountRequest
+	(*GetAccountResponse)(nil),               // 26: account.v1.GetAccountResponse
+	(*FreezeAccountRequest)(nil),             // 27: account.v1.FreezeAccountRequest
+	(*FreezeAccountResponse)(nil),            // 28: account.v1.FreezeAccountResponse
+	(*UnfreezeAccountRequest)(nil),           // 29: account.v1.UnfreezeAccountRequest
+	(*UnfreezeAccountResponse)(nil),          // 30: account.v1.UnfreezeAccountResponse
+	(*GetBalanceRequest)(nil),                // 31: account.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),               // 32: account.v1.GetBalanceResponse
+	(*PlaceHoldRequest)(nil),                 // 33: account.v1.PlaceHoldRequest
+	(*PlaceHoldResponse)(nil),                // 34: account.v1.PlaceHoldResponse
+	(*ReleaseHoldRequest)(nil),               // 35: account.v1.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil),              // 36: account.v1.ReleaseHoldResponse
+	(*CaptureHoldRequest)(nil),               // 37: account.v1.CaptureHoldRequest
+	(*CaptureHoldResponse)(nil),              // 38: account.v1.CaptureHoldResponse
+	(*ListHoldsRequest)(nil),                 // 39: account.v1.ListHoldsRequest
+	(*ListHoldsResponse)(nil),                // 40: account.v1.ListHoldsResponse
+	(*SetOverdraftLimitRequest)(nil),         // 41: account.v1.SetOverdraftLimitRequest
+	(*SetOverdraftLimitResponse)(nil),        // 42: account.v1.SetOverdraftLimitResponse
+	(*TransferRequest)(nil),                  // 43: account.v1.TransferRequest
+	(*TransferResponse)(nil),                 // 44: account.v1.TransferResponse
+	(*SetFeeRuleRequest)(nil),                // 45: account.v1.SetFeeRuleRequest
+	(*SetFeeRuleResponse)(nil),               // 46: account.v1.SetFeeRuleResponse
+	(*ListFeeRulesRequest)(nil),              // 47: account.v1.ListFeeRulesRequest
+	(*ListFeeRulesResponse)(nil),             // 48: account.v1.ListFeeRulesResponse
+	(*ListFeesRequest)(nil),                  // 49: account.v1.ListFeesRequest
+	(*ListFeesResponse)(nil),                 // 50: account.v1.ListFeesResponse
+	(*ReverseFeeRequest)(nil),                // 51: account.v1.ReverseFeeRequest
+	(*ReverseFeeResponse)(nil),               // 52: account.v1.ReverseFeeResponse
+	(*AddAccountPartyRequest)(nil),           // 53: account.v1.AddAccountPartyRequest
+	(*AddAccountPartyResponse)(nil),          // 54: account.v1.AddAccountPartyResponse
+	(*EndAccountPartyRequest)(nil),           // 55: account.v1.EndAccountPartyRequest
+	(*EndAccountPartyResponse)(nil),          // 56: account.v1.EndAccountPartyResponse
+	(*ListAccountPartiesRequest)(nil),        // 57: account.v1.ListAccountPartiesRequest
+	(*ListAccountPartiesResponse)(nil),       // 58: account.v1.ListAccountPartiesResponse
+	(*ListAccountsByCustomerRequest)(nil),    // 59: account.v1.ListAccountsByCustomerRequest
+	(*ListAccountsByCustomerResponse)(nil),   // 60: account.v1.ListAccountsByCustomerResponse
+	(*CheckSigningAuthorityRequest)(nil),     // 61: account.v1.CheckSigningAuthorityRequest
+	(*CheckSigningAuthorityResponse)(nil),    // 62: account.v1.CheckSigningAuthorityResponse
+	(*GenerateStatementRequest)(nil),         // 63: account.v1.GenerateStatementRequest
+	(*GenerateStatementResponse)(nil),        // 64: account.v1.GenerateStatementResponse
+	(*GetStatementRequest)(nil),              // 65: account.v1.GetStatementRequest
+	(*GetStatementResponse)(nil),             // 66: account.v1.GetStatementResponse
+	(*ListStatementsRequest)(nil),            // 67: account.v1.ListStatementsRequest
+	(*ListStatementsResponse)(nil),           // 68: account.v1.ListStatementsResponse
+	(*ImportBankStatementRequest)(nil),       // 69: account.v1.ImportBankStatementRequest
+	(*ImportBankStatementResponse)(nil),      // 70: account.v1.ImportBankStatementResponse
+	(*RunReconciliationRequest)(nil),         // 71: account.v1.RunReconciliationRequest
+	(*RunReconciliationResponse)(nil),        // 72: account.v1.RunReconciliationResponse
+	(*GetReconciliationReportRequest)(nil),   // 73: account.v1.GetReconciliationReportRequest
+	(*GetReconciliationReportResponse)(nil),  // 74: account.v1.GetReconciliationReportResponse
+	(*ListReconciliationBreaksRequest)(nil),  // 75: account.v1.ListReconciliationBreaksRequest
+	(*ListReconciliationBreaksResponse)(nil), // 76: account.v1.ListReconciliationBreaksResponse
+	(*ManualMatchRequest)(nil),               // 77: account.v1.ManualMatchRequest
+	(*ManualMatchResponse)(nil),              // 78: account.v1.ManualMatchResponse
+	(*WriteOffBreakRequest)(nil),             // 79: account.v1.WriteOffBreakRequest
+	(*WriteOffBreakResponse)(nil),            // 80: account.v1.WriteOffBreakResponse
+	(*SetFXRatesRequest)(nil),                // 81: account.v1.SetFXRatesRequest
+	(*SetFXRatesResponse)(nil),               // 82: account.v1.SetFXRatesResponse
+	(*ListFXRatesRequest)(nil),               // 83: account.v1.ListFXRatesRequest
+	(*ListFXRatesResponse)(nil),              // 84: account.v1.ListFXRatesResponse
+	(*SetFXPositionAccountRequest)(nil),      // 85: account.v1.SetFXPositionAccountRequest
+	(*SetFXPositionAccountResponse)(nil),     // 86: account.v1.SetFXPositionAccountResponse
+	(*CreateFXQuoteRequest)(nil),             // 87: account.v1.CreateFXQuoteRequest
+	(*CreateFXQuoteResponse)(nil),            // 88: account.v1.CreateFXQuoteResponse
+	(*GetFXQuoteRequest)(nil),                // 89: account.v1.GetFXQuoteRequest
+	(*GetFXQuoteResponse)(nil),               // 90: account.v1.GetFXQuoteResponse
+	(*ExecuteFXQuoteRequest)(nil),            // 91: account.v1.ExecuteFXQuoteRequest
+	(*ExecuteFXQuoteResponse)(nil),           // 92: account.v1.ExecuteFXQuoteResponse
+	(*SetLimitRuleRequest)(nil),              // 93: account.v1.SetLimitRuleRequest
+	(*SetLimitRuleResponse)(nil),             // 94: account.v1.SetLimitRuleResponse
+	(*ListLimitRulesRequest)(nil),            // 95: account.v1.ListLimitRulesRequest
+	(*ListLimitRulesResponse)(nil),           // 96: account.v1.ListLimitRulesResponse
+	(*SetCustomerRiskTierRequest)(nil),       // 97: account.v1.SetCustomerRiskTierRequest
+	(*SetCustomerRiskTierResponse)(nil),      // 98: account.v1.SetCustomerRiskTierResponse
+	(*GrantLimitIncreaseRequest)(nil),        // 99: account.v1.GrantLimitIncreaseRequest
+	(*GrantLimitIncreaseResponse)(nil),       // 100: account.v1.GrantLimitIncreaseResponse
+	(*RevokeLimitIncreaseRequest)(nil),       // 101: account.v1.RevokeLimitIncreaseRequest
+	(*RevokeLimitIncreaseResponse)(nil),      // 102: account.v1.RevokeLimitIncreaseResponse
+	(*GetRemainingLimitsRequest)(nil),        // 103: account.v1.GetRemainingLimitsRequest
+	(*GetRemainingLimitsResponse)(nil),       // 104: account.v1.GetRemainingLimitsResponse
+	(*ListAMLAlertsRequest)(nil),             // 105: account.v1.ListAMLAlertsRequest
+	(*ListAMLAlertsResponse)(nil),            // 106: account.v1.ListAMLAlertsResponse
+	(*GetAMLAlertRequest)(nil),               // 107: account.v1.GetAMLAlertRequest
+	(*GetAMLAlertResponse)(nil),              // 108: account.v1.GetAMLAlertResponse
+	(*CloseAMLAlertRequest)(nil),             // 109: account.v1.CloseAMLAlertRequest
+	(*CloseAMLAlertResponse)(nil),            // 110: account.v1.CloseAMLAlertResponse
+	(*timestamppb.Timestamp)(nil),            // 111: google.protobuf.Timestamp
+}
+var file_account_proto_depIdxs = []int32{
+	111, // 0: account.v1.Account.opened_at:type_name -> google.protobuf.Timestamp
+	111, // 1: account.v1.Account.closed_at:type_name -> google.protobuf.Timestamp
+	111, // 2: account.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	111, // 3: account.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
+	111, // 4: account.v1.Balance.as_of:type_name -> google.protobuf.Timestamp
+	111, // 5: account.v1.Hold.expires_at:type_name -> google.protobuf.Timestamp
+	111, // 6: account.v1.Hold.created_at:type_name -> google.protobuf.Timestamp
+	111, // 7: account.v1.Hold.updated_at:type_name -> google.protobuf.Timestamp
+	111, // 8: account.v1.Posting.value_date:type_name -> google.protobuf.Timestamp
+	111, // 9: account.v1.Posting.booked_at:type_name -> google.protobuf.Timestamp
+	111, // 10: account.v1.FeeRule.effective_from:type_name -> google.protobuf.Timestamp
+	111, // 11: account.v1.FeeRule.created_at:type_name -> google.protobuf.Timestamp
+	111, // 12: account.v1.Fee.charged_at:type_name -> google.protobuf.Timestamp
+	111, // 13: account.v1.Fee.reversed_at:type_name -> google.protobuf.Timestamp
+	111, // 14: account.v1.AccountParty.start_date:type_name -> google.protobuf.Timestamp
+	111, // 15: account.v1.AccountParty.end_date:type_name -> google.protobuf.Timestamp
+	111, // 16: account.v1.AccountParty.created_at:type_name -> google.protobuf.Timestamp
+	111, // 17: account.v1.AccountParty.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 18: account.v1.CustomerAccount.account:type_name -> account.v1.Account
+	6,   // 19: account.v1.CustomerAccount.party:type_name -> account.v1.AccountParty
+	111, // 20: account.v1.Statement.period_start:type_name -> google.protobuf.Timestamp
+	111, // 21: account.v1.Statement.period_end:type_name -> google.protobuf.Timestamp
+	111, // 22: account.v1.Statement.generated_at:type_name -> google.protobuf.Timestamp
+	9,   // 23: account.v1.Statement.documents:type_name -> account.v1.StatementDocument
+	111, // 24: account.v1.BankStatement.opening_date:type_name -> google.protobuf.Timestamp
+	111, // 25: account.v1.BankStatement.closing_date:type_name -> google.protobuf.Timestamp
+	111, // 26: account.v1.BankStatement.imported_at:type_name -> google.protobuf.Timestamp
+	111, // 27: account.v1.BankStatementLine.value_date:type_name -> google.protobuf.Timestamp
+	111, // 28: account.v1.BankStatementLine.booking_date:type_name -> google.protobuf.Timestamp
+	111, // 29: account.v1.ReconciliationMatch.created_at:type_name -> google.protobuf.Timestamp
+	111, // 30: account.v1.ReconciliationRun.as_of:type_name -> google.protobuf.Timestamp
+	111, // 31: account.v1.ReconciliationRun.run_at:type_name -> google.protobuf.Timestamp
+	111, // 32: account.v1.FXRate.effective_from:type_name -> google.protobuf.Timestamp
+	111, // 33: account.v1.FXRate.created_at:type_name -> google.protobuf.Timestamp
+	111, // 34: account.v1.FXPositionAccount.updated_at:type_name -> google.protobuf.Timestamp
+	111, // 35: account.v1.FXQuote.expires_at:type_name -> google.protobuf.Timestamp
+	111, // 36: account.v1.FXQuote.created_at:type_name -> google.protobuf.Timestamp
+	111, // 37: account.v1.FXQuote.executed_at:type_name -> google.protobuf.Timestamp
+	111, // 38: account.v1.LimitRule.effective_from:type_name -> google.protobuf.Timestamp
+	111, // 39: account.v1.LimitRule.created_at:type_name -> google.protobuf.Timestamp
+	111, // 40: account.v1.LimitIncrease.starts_at:type_name -> google.protobuf.Timestamp
+	111, // 41: account.v1.LimitIncrease.expires_at:type_name -> google.protobuf.Timestamp
+	111, // 42: account.v1.LimitIncrease.created_at:type_name -> google.protobuf.Timestamp
+	111, // 43: account.v1.LimitIncrease.revoked_at:type_name -> google.protobuf.Timestamp
+	17,  // 44: account.v1.RemainingLimit.rule:type_name -> account.v1.LimitRule
+	111, // 45: account.v1.RemainingLimit.resets_at:type_name -> google.protobuf.Timestamp
+	18,  // 46: account.v1.RemainingLimit.increase:type_name -> account.v1.LimitIncrease
+	111, // 47: account.v1.LimitBreach.resets_at:type_name -> google.protobuf.Timestamp
+	111, // 48: account.v1.TransactionEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	111, // 49: account.v1.AMLAlert.raised_at:type_name -> google.protobuf.Timestamp
+	111, // 50: account.v1.AMLAlert.created_at:type_name -> google.protobuf.Timestamp
+	111, // 51: account.v1.AMLAlert.closed_at:type_name -> google.protobuf.Timestamp
+	0,   // 52: account.v1.OpenAccountResponse.account:type_name -> account.v1.Account
+	0,   // 53: account.v1.GetAccountResponse.account:type_name -> account.v1.Account
+	0,   // 54: account.v1.FreezeAccountResponse.account:type_name -> account.v1.Account
+	0,   // 55: account.v1.UnfreezeAccountResponse.account:type_name -> account.v1.Account
+	1,   // 56: account.v1.GetBalanceResponse.balance:type_name -> account.v1.Balance
+	111, // 57: account.v1.PlaceHoldRequest.expires_at:type_name -> google.protobuf.Timestamp
+	2,   // 58: account.v1.PlaceHoldResponse.hold:type_name -> account.v1.Hold
+	1,   // 59: account.v1.PlaceHoldResponse.balance:type_name -> account.v1.Balance
+	2,   // 60: account.v1.ReleaseHoldResponse.hold:type_name -> account.v1.Hold
+	1,   // 61: account.v1.ReleaseHoldResponse.balance:type_name -> account.v1.Balance
+	2,   // 62: account.v1.CaptureHoldResponse.hold:type_name -> account.v1.Hold
+	3,   // 63: account.v1.CaptureHoldResponse.posting:type_name -> account.v1.Posting
+	1,   // 64: account.v1.CaptureHoldResponse.balance:type_name -> account.v1.Balance
+	2,   // 65: account.v1.ListHoldsResponse.holds:type_name -> account.v1.Hold
+	0,   // 66: account.v1.SetOverdraftLimitResponse.account:type_name -> account.v1.Account
+	1,   // 67: account.v1.SetOverdraftLimitResponse.balance:type_name -> account.v1.Balance
+	3,   // 68: account.v1.TransferResponse.debit:type_name -> account.v1.Posting
+	3,   // 69: account.v1.TransferResponse.credit:type_name -> account.v1.Posting
+	5,   // 70: account.v1.TransferResponse.fee:type_name -> account.v1.Fee
+	1,   // 71: account.v1.TransferResponse.balance:type_name -> account.v1.Balance
+	111, // 72: account.v1.SetFeeRuleRequest.effective_from:type_name -> google.protobuf.Timestamp
+	4,   // 73: account.v1.SetFeeRuleResponse.rule:type_name -> account.v1.FeeRule
+	4,   // 74: account.v1.ListFeeRulesResponse.rules:type_name -> account.v1.FeeRule
+	5,   // 75: account.v1.ListFeesResponse.fees:type_name -> account.v1.Fee
+	5,   // 76: account.v1.ReverseFeeResponse.fee:type_name -> account.v1.Fee
+	3,   // 77: account.v1.ReverseFeeResponse.reversal:type_name -> account.v1.Posting
+	1,   // 78: account.v1.ReverseFeeResponse.balance:type_name -> account.v1.Balance
+	111, // 79: account.v1.AddAccountPartyRequest.start_date:type_name -> google.protobuf.Timestamp
+	111, // 80: account.v1.AddAccountPartyRequest.end_date:type_name -> google.protobuf.Timestamp
+	6,   // 81: account.v1.AddAccountPartyResponse.party:type_name -> account.v1.AccountParty
+	111, // 82: account.v1.EndAccountPartyRequest.end_date:type_name -> google.protobuf.Timestamp
+	6,   // 83: account.v1.EndAccountPartyResponse.party:type_name -> account.v1.AccountParty
+	6,   // 84: account.v1.ListAccountPartiesResponse.parties:type_name -> account.v1.AccountParty
+	7,   // 85: account.v1.ListAccountsByCustomerResponse.accounts:type_name -> account.v1.CustomerAccount
+	111, // 86: account.v1.GenerateStatementRequest.period_start:type_name -> google.protobuf.Timestamp
+	111, // 87: account.v1.GenerateStatementRequest.period_end:type_name -> google.protobuf.Timestamp
+	8,   // 88: account.v1.GenerateStatementResponse.statement:type_name -> account.v1.Statement
+	8,   // 89: account.v1.GetStatementResponse.statement:type_name -> account.v1.Statement
+	8,   // 90: account.v1.ListStatementsResponse.statements:type_name -> account.v1.Statement
+	10,  // 91: account.v1.ImportBankStatementResponse.statements:type_name -> account.v1.BankStatement
+	111, // 92: account.v1.RunReconciliationRequest.as_of:type_name -> google.protobuf.Timestamp
+	13,  // 93: account.v1.RunReconciliationResponse.run:type_name -> account.v1.ReconciliationRun
+	12,  // 94: account.v1.RunReconciliationResponse.matches:type_name -> account.v1.ReconciliationMatch
+	13,  // 95: account.v1.GetReconciliationReportResponse.run:type_name -> account.v1.ReconciliationRun
+	111, // 96: account.v1.ListReconciliationBreaksRequest.as_of:type_name -> google.protobuf.Timestamp
+	11,  // 97: account.v1.ListReconciliationBreaksResponse.lines:type_name -> account.v1.BankStatementLine
+	3,   // 98: account.v1.ListReconciliationBreaksResponse.postings:type_name -> account.v1.Posting
+	12,  // 99: account.v1.ManualMatchResponse.match:type_name -> account.v1.ReconciliationMatch
+	12,  // 100: account.v1.WriteOffBreakResponse.match:type_name -> account.v1.ReconciliationMatch
+	3,   // 101: account.v1.WriteOffBreakResponse.adjustment:type_name -> account.v1.Posting
+	3,   // 102: account.v1.WriteOffBreakResponse.offset:type_name -> account.v1.Posting
+	14,  // 103: account.v1.SetFXRatesRequest.rates:type_name -> account.v1.FXRate
+	14,  // 104: account.v1.ListFXRatesResponse.rates:type_name -> account.v1.FXRate
+	15,  // 105: account.v1.SetFXPositionAccountResponse.position:type_name -> account.v1.FXPositionAccount
+	16,  // 106: account.v1.CreateFXQuoteResponse.quote:type_name -> account.v1.FXQuote
+	16,  // 107: account.v1.GetFXQuoteResponse.quote:type_name -> account.v1.FXQuote
+	16,  // 108: account.v1.ExecuteFXQuoteResponse.quote:type_name -> account.v1.FXQuote
+	3,   // 109: account.v1.ExecuteFXQuoteResponse.debit:type_name -> account.v1.Posting
+	3,   // 110: account.v1.ExecuteFXQuoteResponse.credit:type_name -> account.v1.Posting
+	3,   // 111: account.v1.ExecuteFXQuoteResponse.position_postings:type_name -> account.v1.Posting
+	1,   // 112: account.v1.ExecuteFXQuoteResponse.balance:type_name -> account.v1.Balance
+	111, // 113: account.v1.SetLimitRuleRequest.effective_from:type_name -> google.protobuf.Timestamp
+	17,  // 114: account.v1.SetLimitRuleResponse.rule:type_name -> account.v1.LimitRule
+	17,  // 115: account.v1.ListLimitRulesResponse.rules:type_name -> account.v1.LimitRule
+	111, // 116: account.v1.SetCustomerRiskTierResponse.updated_at:type_name -> google.protobuf.Timestamp
+	111, // 117: account.v1.GrantLimitIncreaseRequest.starts_at:type_name -> google.protobuf.Timestamp
+	111, // 118: account.v1.GrantLimitIncreaseRequest.expires_at:type_name -> google.protobuf.Timestamp
+	18,  // 119: account.v1.GrantLimitIncreaseResponse.increase:type_name -> account.v1.LimitIncrease
+	18,  // 120: account.v1.RevokeLimitIncreaseResponse.increase:type_name -> account.v1.LimitIncrease
+	19,  // 121: account.v1.GetRemainingLimitsResponse.limits:type_name -> account.v1.RemainingLimit
+	22,  // 122: account.v1.ListAMLAlertsResponse.alerts:type_name -> account.v1.AMLAlert
+	22,  // 123: account.v1.GetAMLAlertResponse.alert:type_name -> account.v1.AMLAlert
+	21,  // 124: account.v1.GetAMLAlertResponse.evidence:type_name -> account.v1.TransactionEvent
+	22,  // 125: account.v1.CloseAMLAlertResponse.alert:type_name -> account.v1.AMLAlert
+	23,  // 126: account.v1.AccountService.OpenAccount:input_type -> account.v1.OpenAccountRequest
+	25,  // 127: account.v1.AccountService.GetAccount:input_type -> account.v1.GetAccountRequest
+	27,  // 128: account.v1.AccountService.FreezeAccount:input_type -> account.v1.FreezeAccountRequest
+	29,  // 129: account.v1.AccountService.UnfreezeAccount:input_type -> account.v1.UnfreezeAccountRequest
+	31,  // 130: account.v1.AccountService.GetBalance:input_type -> account.v1.GetBalanceRequest
+	33,  // 131: account.v1.AccountService.PlaceHold:input_type -> account.v1.PlaceHoldRequest
+	35,  // 132: account.v1.AccountService.ReleaseHold:input_type -> account.v1.ReleaseHoldRequest
+	37,  // 133: account.v1.AccountService.CaptureHold:input_type -> account.v1.CaptureHoldRequest
+	39,  // 134: account.v1.AccountService.ListHolds:input_type -> account.v1.ListHoldsRequest
+	41,  // 135: account.v1.AccountService.SetOverdraftLimit:input_type -> account.v1.SetOverdraftLimitRequest
+	43,  // 136: account.v1.AccountService.Transfer:input_type -> account.v1.TransferRequest
+	45,  // 137: account.v1.AccountService.SetFeeRule:input_type -> account.v1.SetFeeRuleRequest
+	47,  // 138: account.v1.AccountService.ListFeeRules:input_type -> account.v1.ListFeeRulesRequest
+	49,  // 139: account.v1.AccountService.ListFees:input_type -> account.v1.ListFeesRequest
+	51,  // 140: account.v1.AccountService.ReverseFee:input_type -> account.v1.ReverseFeeRequest
+	53,  // 141: account.v1.AccountService.AddAccountParty:input_type -> account.v1.AddAccountPartyRequest
+	55,  // 142: account.v1.AccountService.EndAccountParty:input_type -> account.v1.EndAccountPartyRequest
+	57,  // 143: account.v1.AccountService.ListAccountParties:input_type -> account.v1.ListAccountPartiesRequest
+	59,  // 144: account.v1.AccountService.ListAccountsByCustomer:input_type -> account.v1.ListAccountsByCustomerRequest
+	61,  // 145: account.v1.AccountService.CheckSigningAuthority:input_type -> account.v1.CheckSigningAuthorityRequest
+	63,  // 146: account.v1.AccountService.GenerateStatement:input_type -> account.v1.GenerateStatementRequest
+	65,  // 147: account.v1.AccountService.GetStatement:input_type -> account.v1.GetStatementRequest
+	67,  // 148: account.v1.AccountService.ListStatements:input_type -> account.v1.ListStatementsRequest
+	69,  // 149: account.v1.AccountService.ImportBankStatement:input_type -> account.v1.ImportBankStatementRequest
+	71,  // 150: account.v1.AccountService.RunReconciliation:input_type -> account.v1.RunReconciliationRequest
+	73,  // 151: account.v1.AccountService.GetReconciliationReport:input_type -> account.v1.GetReconciliationReportRequest
+	75,  // 152: account.v1.AccountService.ListReconciliationBreaks:input_type -> account.v1.ListReconciliationBreaksRequest
+	77,  // 153: account.v1.AccountService.ManualMatch:input_type -> account.v1.ManualMatchRequest
+	79,  // 154: account.v1.AccountService.WriteOffBreak:input_type -> account.v1.WriteOffBreakRequest
+	81,  // 155: account.v1.AccountService.SetFXRates:input_type -> account.v1.SetFXRatesRequest
+	83,  // 156: account.v1.AccountService.ListFXRates:input_type -> account.v1.ListFXRatesRequest
+	85,  // 157: account.v1.AccountService.SetFXPositionAccount:input_type -> account.v1.SetFXPositionAccountRequest
+	87,  // 158: account.v1.AccountService.CreateFXQuote:input_type -> account.v1.CreateFXQuoteRequest
+	89,  // 159: account.v1.AccountService.GetFXQuote:input_type -> account.v1.GetFXQuoteRequest
+	91,  // 160: account.v1.AccountService.ExecuteFXQuote:input_type -> account.v1.ExecuteFXQuoteRequest
+	93,  // 161: account.v1.AccountService.SetLimitRule:input_type -> account.v1.SetLimitRuleRequest
+	95,  // 162: account.v1.AccountService.ListLimitRules:input_type -> account.v1.ListLimitRulesRequest
+	97,  // 163: account.v1.AccountService.SetCustomerRiskTier:input_type -> account.v1.SetCustomerRiskTierRequest
+	99,  // 164: account.v1.AccountService.GrantLimitIncrease:input_type -> account.v1.GrantLimitIncreaseRequest
+	101, // 165: account.v1.AccountService.RevokeLimitIncrease:input_type -> account.v1.RevokeLimitIncreaseRequest
+	103, // 166: account.v1.AccountService.GetRemainingLimits:input_type -> account.v1.GetRemainingLimitsRequest
+	105, // 167: account.v1.AccountService.ListAMLAlerts:input_type -> account.v1.ListAMLAlertsRequest
+	107, // 168: account.v1.AccountService.GetAMLAlert:input_type -> account.v1.GetAMLAlertRequest
+	109, // 169: account.v1.AccountService.CloseAMLAlert:input_type -> account.v1.CloseAMLAlertRequest
+	24,  // 170: account.v1.AccountService.OpenAccount:output_type -> account.v1.OpenAccountResponse
+	26,  // 171: account.v1.AccountService.GetAccount:output_type -> account.v1.GetAccountResponse
+	28,  // 172: account.v1.AccountService.FreezeAccount:output_type -> account.v1.FreezeAccountResponse
+	30,  // 173: account.v1.AccountService.UnfreezeAccount:output_type -> account.v1.UnfreezeAccountResponse
+	32,  // 174: account.v1.AccountService.GetBalance:output_type -> account.v1.GetBalanceResponse
+	34,  // 175: account.v1.AccountService.PlaceHold:output_type -> account.v1.PlaceHoldResponse
+	36,  // 176: account.v1.AccountService.ReleaseHold:output_type -> account.v1.ReleaseHoldResponse
+	38,  // 177: account.v1.AccountService.CaptureHold:output_type -> account.v1.CaptureHoldResponse
+	40,  // 178: account.v1.AccountService.ListHolds:output_type -> account.v1.ListHoldsResponse
+	42,  // 179: account.v1.AccountService.SetOverdraftLimit:output_type -> account.v1.SetOverdraftLimitResponse
+	44,  // 180: account.v1.AccountService.Transfer:output_type -> account.v1.TransferResponse
+	46,  // 181: account.v1.AccountService.SetFeeRule:output_type -> account.v1.SetFeeRuleResponse
+	48,  // 182: account.v1.AccountService.ListFeeRules:output_type -> account.v1.ListFeeRulesResponse
+	50,  // 183: account.v1.AccountService.ListFees:output_type -> account.v1.ListFeesResponse
+	52,  // 184: account.v1.AccountService.ReverseFee:output_type -> account.v1.ReverseFeeResponse
+	54,  // 185: account.v1.AccountService.AddAccountParty:output_type -> account.v1.AddAccountPartyResponse
+	56,  // 186: account.v1.AccountService.EndAccountParty:output_type -> account.v1.EndAccountPartyResponse
+	58,  // 187: account.v1.AccountService.ListAccountParties:output_type -> account.v1.ListAccountPartiesResponse
+	60,  // 188: account.v1.AccountService.ListAccountsByCustomer:output_type -> account.v1.ListAccountsByCustomerResponse
+	62,  // 189: account.v1.AccountService.CheckSigningAuthority:output_type -> account.v1.CheckSigningAuthorityResponse
+	64,  // 190: account.v1.AccountService.GenerateStatement:output_type -> account.v1.GenerateStatementResponse
+	66,  // 191: account.v1.AccountService.GetStatement:output_type -> account.v1.GetStatementResponse
+	68,  // 192: account.v1.AccountService.ListStatements:output_type -> account.v1.ListStatementsResponse
+	70,  // 193: account.v1.AccountService.ImportBankStatement:output_type -> account.v1.ImportBankStatementResponse
+	72,  // 194: account.v1.AccountService.RunReconciliation:output_type -> account.v1.RunReconciliationResponse
+	74,  // 195: account.v1.AccountService.GetReconciliationReport:output_type -> account.v1.GetReconciliationReportResponse
+	76,  // 196: account.v1.AccountService.ListReconciliationBreaks:output_type -> account.v1.ListReconciliationBreaksResponse
+	78,  // 197: account.v1.AccountService.ManualMatch:output_type -> account.v1.ManualMatchResponse
+	80,  // 198: account.v1.AccountService.WriteOffBreak:output_type -> account.v1.WriteOffBreakResponse
+	82,  // 199: account.v1.AccountService.SetFXRates:output_type -> account.v1.SetFXRatesResponse
+	84,  // 200: account.v1.AccountService.ListFXRates:output_type -> account.v1.ListFXRatesResponse
+	86,  // 201: account.v1.AccountService.SetFXPositionAccount:output_type -> account.v1.SetFXPositionAccountResponse
+	88,  // 202: account.v1.AccountService.CreateFXQuote:output_type -> account.v1.CreateFXQuoteResponse
+	90,  // 203: account.v1.AccountService.GetFXQuote:output_type -> account.v1.GetFXQuoteResponse
+	92,  // 204: account.v1.AccountService.ExecuteFXQuote:output_type -> account.v1.ExecuteFXQuoteResponse
+	94,  // 205: account.v1.AccountService.SetLimitRule:output_type -> account.v1.SetLimitRuleResponse
+	96,  // 206: account.v1.AccountService.ListLimitRules:output_type -> account.v1.ListLimitRulesResponse
+	98,  // 207: account.v1.AccountService.SetCustomerRiskTier:output_type -> account.v1.SetCustomerRiskTierResponse
+	100, // 208: account.v1.AccountService.GrantLimitIncrease:output_type -> account.v1.GrantLimitIncreaseResponse
+	102, // 209: account.v1.AccountService.RevokeLimitIncrease:output_type -> account.v1.RevokeLimitIncreaseResponse
+	104, // 210: account.v1.AccountService.GetRemainingLimits:output_type -> account.v1.GetRemainingLimitsResponse
+	106, // 211: account.v1.AccountService.ListAMLAlerts:output_type -> account.v1.ListAMLAlertsResponse
+	108, // 212: account.v1.AccountService.GetAMLAlert:output_type -> account.v1.GetAMLAlertResponse
+	110, // 213: account.v1.AccountService.CloseAMLAlert:output_type -> account.v1.CloseAMLAlertResponse
+	170, // [170:214] is the sub-list for method output_type
+	126, // [126:170] is the sub-list for method input_type
+	126, // [126:126] is the sub-list for extension type_name
+	126, // [126:126] is the sub-list for extension extendee
+	0,   // [0:126] is the sub-list for field type_name
+}
+
+func init() { file_account_proto_init() }
+func file_account_proto_init() {
+	if File_account_proto != nil {
+		return
+	}
+	file_account_proto_msgTypes[4].OneofWrappers = []any{}
+	file_account_proto_msgTypes[45].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_proto_rawDesc), len(file_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   111,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_account_proto_goTypes,
+		DependencyIndexes: file_account_proto_depIdxs,
+		MessageInfos:      file_account_proto_msgTypes,
+	}.Build()
+	File_account_proto = out.File
+	file_account_proto_goTypes = nil
+	file_account_proto_depIdxs = nil
+}
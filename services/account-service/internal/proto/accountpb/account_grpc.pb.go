@@ -0,0 +1,1851 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: account.proto
+
+package accountpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AccountService_OpenAccount_FullMethodName              = "/account.v1.AccountService/OpenAccount"
+	AccountService_GetAccount_FullMethodName               = "/account.v1.AccountService/GetAccount"
+	AccountService_FreezeAccount_FullMethodName            = "/account.v1.AccountService/FreezeAccount"
+	AccountService_UnfreezeAccount_FullMethodName          = "/account.v1.AccountService/UnfreezeAccount"
+	AccountService_GetBalance_FullMethodName               = "/account.v1.AccountService/GetBalance"
+	AccountService_PlaceHold_FullMethodName                = "/account.v1.AccountService/PlaceHold"
+	AccountService_ReleaseHold_FullMethodName              = "/account.v1.AccountService/ReleaseHold"
+	AccountService_CaptureHold_FullMethodName              = "/account.v1.AccountService/CaptureHold"
+	AccountService_ListHolds_FullMethodName                = "/account.v1.AccountService/ListHolds"
+	AccountService_SetOverdraftLimit_FullMethodName        = "/account.v1.AccountService/SetOverdraftLimit"
+	AccountService_Transfer_FullMethodName                 = "/account.v1.AccountService/Transfer"
+	AccountService_SetFeeRule_FullMethodName               = "/account.v1.AccountService/SetFeeRule"
+	AccountService_ListFeeRules_FullMethodName             = "/account.v1.AccountService/ListFeeRules"
+	AccountService_ListFees_FullMethodName                 = "/account.v1.AccountService/ListFees"
+	AccountService_ReverseFee_FullMethodName               = "/account.v1.AccountService/ReverseFee"
+	AccountService_AddAccountParty_FullMethodName          = "/account.v1.AccountService/AddAccountParty"
+	AccountService_EndAccountParty_FullMethodName          = "/account.v1.AccountService/EndAccountParty"
+	AccountService_ListAccountParties_FullMethodName       = "/account.v1.AccountService/ListAccountParties"
+	AccountService_ListAccountsByCustomer_FullMethodName   = "/account.v1.AccountService/ListAccountsByCustomer"
+	AccountService_CheckSigningAuthority_FullMethodName    = "/account.v1.AccountService/CheckSigningAuthority"
+	AccountService_GenerateStatement_FullMethodName        = "/account.v1.AccountService/GenerateStatement"
+	AccountService_GetStatement_FullMethodName             = "/account.v1.AccountService/GetStatement"
+	AccountService_ListStatements_FullMethodName           = "/account.v1.AccountService/ListStatements"
+	AccountService_ImportBankStatement_FullMethodName      = "/account.v1.AccountService/ImportBankStatement"
+	AccountService_RunReconciliation_FullMethodName        = "/account.v1.AccountService/RunReconciliation"
+	AccountService_GetReconciliationReport_FullMethodName  = "/account.v1.AccountService/GetReconciliationReport"
+	AccountService_ListReconciliationBreaks_FullMethodName = "/account.v1.AccountService/ListReconciliationBreaks"
+	AccountService_ManualMatch_FullMethodName              = "/account.v1.AccountService/ManualMatch"
+	AccountService_WriteOffBreak_FullMethodName            = "/account.v1.AccountService/WriteOffBreak"
+	AccountService_SetFXRates_FullMethodName               = "/account.v1.AccountService/SetFXRates"
+	AccountService_ListFXRates_FullMethodName              = "/account.v1.AccountService/ListFXRates"
+	AccountService_SetFXPositionAccount_FullMethodName     = "/account.v1.AccountService/SetFXPositionAccount"
+	AccountService_CreateFXQuote_FullMethodName            = "/account.v1.AccountService/CreateFXQuote"
+	AccountService_GetFXQuote_FullMethodName               = "/account.v1.AccountService/GetFXQuote"
+	AccountService_ExecuteFXQuote_FullMethodName           = "/account.v1.AccountService/ExecuteFXQuote"
+	AccountService_SetLimitRule_FullMethodName             = "/account.v1.AccountService/SetLimitRule"
+	AccountService_ListLimitRules_FullMethodName           = "/account.v1.AccountService/ListLimitRules"
+	AccountService_SetCustomerRiskTier_FullMethodName      = "/account.v1.AccountService/SetCustomerRiskTier"
+	AccountService_GrantLimitIncrease_FullMethodName       = "/account.v1.AccountService/GrantLimitIncrease"
+	AccountService_RevokeLimitIncrease_FullMethodName      = "/account.v1.AccountService/RevokeLimitIncrease"
+	AccountService_GetRemainingLimits_FullMethodName       = "/account.v1.AccountService/GetRemainingLimits"
+	AccountService_ListAMLAlerts_FullMethodName            = "/account.v1.AccountService/ListAMLAlerts"
+	AccountService_GetAMLAlert_FullMethodName              = "/account.v1.AccountService/GetAMLAlert"
+	AccountService_CloseAMLAlert_FullMethodName            = "/account.v1.AccountService/CloseAMLAlert"
+)
+
+// AccountServiceClient is the client API for AccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AccountService provides account, balance and hold operations.
+// All monetary amounts are expressed in minor units of the account currency.
+type AccountServiceClient interface {
+	// OpenAccount opens a new account for a customer
+	OpenAccount(ctx context.Context, in *OpenAccountRequest, opts ...grpc.CallOption) (*OpenAccountResponse, error)
+	// GetAccount retrieves an account by ID
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	// FreezeAccount freezes an account, blocking debits and non-legal holds
+	FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error)
+	// UnfreezeAccount returns a frozen account to Active
+	UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error)
+	// GetBalance returns the ledger, held and available balance of an account
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	// PlaceHold earmarks funds on an account
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error)
+	// ReleaseHold releases all or part of a hold
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	// CaptureHold converts a hold into a ledger posting
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	// ListHolds lists the holds on an account
+	ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error)
+	// SetOverdraftLimit sets the arranged overdraft limit and debit interest rate
+	SetOverdraftLimit(ctx context.Context, in *SetOverdraftLimitRequest, opts ...grpc.CallOption) (*SetOverdraftLimitResponse, error)
+	// Transfer moves funds between two accounts in the same currency
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	// SetFeeRule adds an effective-dated entry to the fee schedule
+	SetFeeRule(ctx context.Context, in *SetFeeRuleRequest, opts ...grpc.CallOption) (*SetFeeRuleResponse, error)
+	// ListFeeRules lists the fee schedule history
+	ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error)
+	// ListFees lists the fees charged to or waived for an account
+	ListFees(ctx context.Context, in *ListFeesRequest, opts ...grpc.CallOption) (*ListFeesResponse, error)
+	// ReverseFee refunds a charged fee
+	ReverseFee(ctx context.Context, in *ReverseFeeRequest, opts ...grpc.CallOption) (*ReverseFeeResponse, error)
+	// AddAccountParty adds a customer to an account in a role
+	AddAccountParty(ctx context.Context, in *AddAccountPartyRequest, opts ...grpc.CallOption) (*AddAccountPartyResponse, error)
+	// EndAccountParty ends a customer's role on an account
+	EndAccountParty(ctx context.Context, in *EndAccountPartyRequest, opts ...grpc.CallOption) (*EndAccountPartyResponse, error)
+	// ListAccountParties lists the parties to an account
+	ListAccountParties(ctx context.Context, in *ListAccountPartiesRequest, opts ...grpc.CallOption) (*ListAccountPartiesResponse, error)
+	// ListAccountsByCustomer lists every account on which a customer holds a role
+	ListAccountsByCustomer(ctx context.Context, in *ListAccountsByCustomerRequest, opts ...grpc.CallOption) (*ListAccountsByCustomerResponse, error)
+	// CheckSigningAuthority checks whether a set of signers may operate an account
+	CheckSigningAuthority(ctx context.Context, in *CheckSigningAuthorityRequest, opts ...grpc.CallOption) (*CheckSigningAuthorityResponse, error)
+	// GenerateStatement issues a new version of an account's statement for a period
+	GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error)
+	// GetStatement returns one stored rendering of a statement
+	GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error)
+	// ListStatements lists every statement issued for an account
+	ListStatements(ctx context.Context, in *ListStatementsRequest, opts ...grpc.CallOption) (*ListStatementsResponse, error)
+	// ImportBankStatement stores the MT940 or camt.053 statements a
+	// correspondent bank sent for a nostro or settlement account
+	ImportBankStatement(ctx context.Context, in *ImportBankStatementRequest, opts ...grpc.CallOption) (*ImportBankStatementResponse, error)
+	// RunReconciliation matches an account's statement lines to its postings and reports the breaks
+	RunReconciliation(ctx context.Context, in *RunReconciliationRequest, opts ...grpc.CallOption) (*RunReconciliationResponse, error)
+	// GetReconciliationReport returns a past reconciliation run with its report
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error)
+	// ListReconciliationBreaks lists the statement lines and postings still unmatched
+	ListReconciliationBreaks(ctx context.Context, in *ListReconciliationBreaksRequest, opts ...grpc.CallOption) (*ListReconciliationBreaksResponse, error)
+	// ManualMatch matches statement lines to postings that sum to the same amount
+	ManualMatch(ctx context.Context, in *ManualMatchRequest, opts ...grpc.CallOption) (*ManualMatchResponse, error)
+	// WriteOffBreak clears an unmatched statement line or posting with an adjustment
+	WriteOffBreak(ctx context.Context, in *WriteOffBreakRequest, opts ...grpc.CallOption) (*WriteOffBreakResponse, error)
+	// SetFXRates adds effective-dated mid rates to the exchange rate table
+	SetFXRates(ctx context.Context, in *SetFXRatesRequest, opts ...grpc.CallOption) (*SetFXRatesResponse, error)
+	// ListFXRates lists the rate history of a currency pair
+	ListFXRates(ctx context.Context, in *ListFXRatesRequest, opts ...grpc.CallOption) (*ListFXRatesResponse, error)
+	// SetFXPositionAccount assigns the account holding the bank's position in one currency of a pair
+	SetFXPositionAccount(ctx context.Context, in *SetFXPositionAccountRequest, opts ...grpc.CallOption) (*SetFXPositionAccountResponse, error)
+	// CreateFXQuote prices a conversion between two accounts in different currencies
+	CreateFXQuote(ctx context.Context, in *CreateFXQuoteRequest, opts ...grpc.CallOption) (*CreateFXQuoteResponse, error)
+	// GetFXQuote retrieves an FX quote
+	GetFXQuote(ctx context.Context, in *GetFXQuoteRequest, opts ...grpc.CallOption) (*GetFXQuoteResponse, error)
+	// ExecuteFXQuote books a quoted conversion before the quote expires
+	ExecuteFXQuote(ctx context.Context, in *ExecuteFXQuoteRequest, opts ...grpc.CallOption) (*ExecuteFXQuoteResponse, error)
+	// SetLimitRule adds an effective-dated entry to the transaction limits
+	SetLimitRule(ctx context.Context, in *SetLimitRuleRequest, opts ...grpc.CallOption) (*SetLimitRuleResponse, error)
+	// ListLimitRules lists the transaction limit history
+	ListLimitRules(ctx context.Context, in *ListLimitRulesRequest, opts ...grpc.CallOption) (*ListLimitRulesResponse, error)
+	// SetCustomerRiskTier sets the risk tier a customer's limits are chosen by
+	SetCustomerRiskTier(ctx context.Context, in *SetCustomerRiskTierRequest, opts ...grpc.CallOption) (*SetCustomerRiskTierResponse, error)
+	// GrantLimitIncrease temporarily raises a limit for one customer
+	GrantLimitIncrease(ctx context.Context, in *GrantLimitIncreaseRequest, opts ...grpc.CallOption) (*GrantLimitIncreaseResponse, error)
+	// RevokeLimitIncrease ends a temporary limit increase before it expires
+	RevokeLimitIncrease(ctx context.Context, in *RevokeLimitIncreaseRequest, opts ...grpc.CallOption) (*RevokeLimitIncreaseResponse, error)
+	// GetRemainingLimits reports how much of each limit an account's customer has left
+	GetRemainingLimits(ctx context.Context, in *GetRemainingLimitsRequest, opts ...grpc.CallOption) (*GetRemainingLimitsResponse, error)
+	// ListAMLAlerts lists transaction monitoring alerts, newest first
+	ListAMLAlerts(ctx context.Context, in *ListAMLAlertsRequest, opts ...grpc.CallOption) (*ListAMLAlertsResponse, error)
+	// GetAMLAlert retrieves an alert with the transactions it was raised on
+	GetAMLAlert(ctx context.Context, in *GetAMLAlertRequest, opts ...grpc.CallOption) (*GetAMLAlertResponse, error)
+	// CloseAMLAlert records the outcome of investigating an alert
+	CloseAMLAlert(ctx context.Context, in *CloseAMLAlertRequest, opts ...grpc.CallOption) (*CloseAMLAlertResponse, error)
+}
+
+type accountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountServiceClient(cc grpc.ClientConnInterface) AccountServiceClient {
+	return &accountServiceClient{cc}
+}
+
+func (c *accountServiceClient) OpenAccount(ctx context.Context, in *OpenAccountRequest, opts ...grpc.CallOption) (*OpenAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_OpenAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_FreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_UnfreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceHoldResponse)
+	err := c.cc.Invoke(ctx, AccountService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, AccountService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, AccountService_CaptureHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHoldsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetOverdraftLimit(ctx context.Context, in *SetOverdraftLimitRequest, opts ...grpc.CallOption) (*SetOverdraftLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOverdraftLimitResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetOverdraftLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, AccountService_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetFeeRule(ctx context.Context, in *SetFeeRuleRequest, opts ...grpc.CallOption) (*SetFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFeeRuleResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeeRulesResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListFeeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListFees(ctx context.Context, in *ListFeesRequest, opts ...grpc.CallOption) (*ListFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeesResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ReverseFee(ctx context.Context, in *ReverseFeeRequest, opts ...grpc.CallOption) (*ReverseFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseFeeResponse)
+	err := c.cc.Invoke(ctx, AccountService_ReverseFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AddAccountParty(ctx context.Context, in *AddAccountPartyRequest, opts ...grpc.CallOption) (*AddAccountPartyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAccountPartyResponse)
+	err := c.cc.Invoke(ctx, AccountService_AddAccountParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) EndAccountParty(ctx context.Context, in *EndAccountPartyRequest, opts ...grpc.CallOption) (*EndAccountPartyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndAccountPartyResponse)
+	err := c.cc.Invoke(ctx, AccountService_EndAccountParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAccountParties(ctx context.Context, in *ListAccountPartiesRequest, opts ...grpc.CallOption) (*ListAccountPartiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountPartiesResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListAccountParties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAccountsByCustomer(ctx context.Context, in *ListAccountsByCustomerRequest, opts ...grpc.CallOption) (*ListAccountsByCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsByCustomerResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListAccountsByCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CheckSigningAuthority(ctx context.Context, in *CheckSigningAuthorityRequest, opts ...grpc.CallOption) (*CheckSigningAuthorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckSigningAuthorityResponse)
+	err := c.cc.Invoke(ctx, AccountService_CheckSigningAuthority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateStatementResponse)
+	err := c.cc.Invoke(ctx, AccountService_GenerateStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatementResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListStatements(ctx context.Context, in *ListStatementsRequest, opts ...grpc.CallOption) (*ListStatementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStatementsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ImportBankStatement(ctx context.Context, in *ImportBankStatementRequest, opts ...grpc.CallOption) (*ImportBankStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBankStatementResponse)
+	err := c.cc.Invoke(ctx, AccountService_ImportBankStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RunReconciliation(ctx context.Context, in *RunReconciliationRequest, opts ...grpc.CallOption) (*RunReconciliationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunReconciliationResponse)
+	err := c.cc.Invoke(ctx, AccountService_RunReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetReconciliationReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListReconciliationBreaks(ctx context.Context, in *ListReconciliationBreaksRequest, opts ...grpc.CallOption) (*ListReconciliationBreaksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationBreaksResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListReconciliationBreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ManualMatch(ctx context.Context, in *ManualMatchRequest, opts ...grpc.CallOption) (*ManualMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManualMatchResponse)
+	err := c.cc.Invoke(ctx, AccountService_ManualMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) WriteOffBreak(ctx context.Context, in *WriteOffBreakRequest, opts ...grpc.CallOption) (*WriteOffBreakResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteOffBreakResponse)
+	err := c.cc.Invoke(ctx, AccountService_WriteOffBreak_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetFXRates(ctx context.Context, in *SetFXRatesRequest, opts ...grpc.CallOption) (*SetFXRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFXRatesResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetFXRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListFXRates(ctx context.Context, in *ListFXRatesRequest, opts ...grpc.CallOption) (*ListFXRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFXRatesResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListFXRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetFXPositionAccount(ctx context.Context, in *SetFXPositionAccountRequest, opts ...grpc.CallOption) (*SetFXPositionAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFXPositionAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetFXPositionAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CreateFXQuote(ctx context.Context, in *CreateFXQuoteRequest, opts ...grpc.CallOption) (*CreateFXQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFXQuoteResponse)
+	err := c.cc.Invoke(ctx, AccountService_CreateFXQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetFXQuote(ctx context.Context, in *GetFXQuoteRequest, opts ...grpc.CallOption) (*GetFXQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFXQuoteResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetFXQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ExecuteFXQuote(ctx context.Context, in *ExecuteFXQuoteRequest, opts ...grpc.CallOption) (*ExecuteFXQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteFXQuoteResponse)
+	err := c.cc.Invoke(ctx, AccountService_ExecuteFXQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetLimitRule(ctx context.Context, in *SetLimitRuleRequest, opts ...grpc.CallOption) (*SetLimitRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLimitRuleResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetLimitRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListLimitRules(ctx context.Context, in *ListLimitRulesRequest, opts ...grpc.CallOption) (*ListLimitRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLimitRulesResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListLimitRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetCustomerRiskTier(ctx context.Context, in *SetCustomerRiskTierRequest, opts ...grpc.CallOption) (*SetCustomerRiskTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCustomerRiskTierResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetCustomerRiskTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GrantLimitIncrease(ctx context.Context, in *GrantLimitIncreaseRequest, opts ...grpc.CallOption) (*GrantLimitIncreaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantLimitIncreaseResponse)
+	err := c.cc.Invoke(ctx, AccountService_GrantLimitIncrease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RevokeLimitIncrease(ctx context.Context, in *RevokeLimitIncreaseRequest, opts ...grpc.CallOption) (*RevokeLimitIncreaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeLimitIncreaseResponse)
+	err := c.cc.Invoke(ctx, AccountService_RevokeLimitIncrease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetRemainingLimits(ctx context.Context, in *GetRemainingLimitsRequest, opts ...grpc.CallOption) (*GetRemainingLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRemainingLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetRemainingLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAMLAlerts(ctx context.Context, in *ListAMLAlertsRequest, opts ...grpc.CallOption) (*ListAMLAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAMLAlertsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListAMLAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAMLAlert(ctx context.Context, in *GetAMLAlertRequest, opts ...grpc.CallOption) (*GetAMLAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAMLAlertResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAMLAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CloseAMLAlert(ctx context.Context, in *CloseAMLAlertRequest, opts ...grpc.CallOption) (*CloseAMLAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseAMLAlertResponse)
+	err := c.cc.Invoke(ctx, AccountService_CloseAMLAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountServiceServer is the server API for AccountService service.
+// All implementations must embed UnimplementedAccountServiceServer
+// for forward compatibility.
+//
+// AccountService provides account, balance and hold operations.
+// All monetary amounts are expressed in minor units of the account currency.
+type AccountServiceServer interface {
+	// OpenAccount opens a new account for a customer
+	OpenAccount(context.Context, *OpenAccountRequest) (*OpenAccountResponse, error)
+	// GetAccount retrieves an account by ID
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	// FreezeAccount freezes an account, blocking debits and non-legal holds
+	FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error)
+	// UnfreezeAccount returns a frozen account to Active
+	UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error)
+	// GetBalance returns the ledger, held and available balance of an account
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// PlaceHold earmarks funds on an account
+	PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error)
+	// ReleaseHold releases all or part of a hold
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	// CaptureHold converts a hold into a ledger posting
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	// ListHolds lists the holds on an account
+	ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error)
+	// SetOverdraftLimit sets the arranged overdraft limit and debit interest rate
+	SetOverdraftLimit(context.Context, *SetOverdraftLimitRequest) (*SetOverdraftLimitResponse, error)
+	// Transfer moves funds between two accounts in the same currency
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	// SetFeeRule adds an effective-dated entry to the fee schedule
+	SetFeeRule(context.Context, *SetFeeRuleRequest) (*SetFeeRuleResponse, error)
+	// ListFeeRules lists the fee schedule history
+	ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error)
+	// ListFees lists the fees charged to or waived for an account
+	ListFees(context.Context, *ListFeesRequest) (*ListFeesResponse, error)
+	// ReverseFee refunds a charged fee
+	ReverseFee(context.Context, *ReverseFeeRequest) (*ReverseFeeResponse, error)
+	// AddAccountParty adds a customer to an account in a role
+	AddAccountParty(context.Context, *AddAccountPartyRequest) (*AddAccountPartyResponse, error)
+	// EndAccountParty ends a customer's role on an account
+	EndAccountParty(context.Context, *EndAccountPartyRequest) (*EndAccountPartyResponse, error)
+	// ListAccountParties lists the parties to an account
+	ListAccountParties(context.Context, *ListAccountPartiesRequest) (*ListAccountPartiesResponse, error)
+	// ListAccountsByCustomer lists every account on which a customer holds a role
+	ListAccountsByCustomer(context.Context, *ListAccountsByCustomerRequest) (*ListAccountsByCustomerResponse, error)
+	// CheckSigningAuthority checks whether a set of signers may operate an account
+	CheckSigningAuthority(context.Context, *CheckSigningAuthorityRequest) (*CheckSigningAuthorityResponse, error)
+	// GenerateStatement issues a new version of an account's statement for a period
+	GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error)
+	// GetStatement returns one stored rendering of a statement
+	GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error)
+	// ListStatements lists every statement issued for an account
+	ListStatements(context.Context, *ListStatementsRequest) (*ListStatementsResponse, error)
+	// ImportBankStatement stores the MT940 or camt.053 statements a
+	// correspondent bank sent for a nostro or settlement account
+	ImportBankStatement(context.Context, *ImportBankStatementRequest) (*ImportBankStatementResponse, error)
+	// RunReconciliation matches an account's statement lines to its postings and reports the breaks
+	RunReconciliation(context.Context, *RunReconciliationRequest) (*RunReconciliationResponse, error)
+	// GetReconciliationReport returns a past reconciliation run with its report
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error)
+	// ListReconciliationBreaks lists the statement lines and postings still unmatched
+	ListReconciliationBreaks(context.Context, *ListReconciliationBreaksRequest) (*ListReconciliationBreaksResponse, error)
+	// ManualMatch matches statement lines to postings that sum to the same amount
+	ManualMatch(context.Context, *ManualMatchRequest) (*ManualMatchResponse, error)
+	// WriteOffBreak clears an unmatched statement line or posting with an adjustment
+	WriteOffBreak(context.Context, *WriteOffBreakRequest) (*WriteOffBreakResponse, error)
+	// SetFXRates adds effective-dated mid rates to the exchange rate table
+	SetFXRates(context.Context, *SetFXRatesRequest) (*SetFXRatesResponse, error)
+	// ListFXRates lists the rate history of a currency pair
+	ListFXRates(context.Context, *ListFXRatesRequest) (*ListFXRatesResponse, error)
+	// SetFXPositionAccount assigns the account holding the bank's position in one currency of a pair
+	SetFXPositionAccount(context.Context, *SetFXPositionAccountRequest) (*SetFXPositionAccountResponse, error)
+	// CreateFXQuote prices a conversion between two accounts in different currencies
+	CreateFXQuote(context.Context, *CreateFXQuoteRequest) (*CreateFXQuoteResponse, error)
+	// GetFXQuote retrieves an FX quote
+	GetFXQuote(context.Context, *GetFXQuoteRequest) (*GetFXQuoteResponse, error)
+	// ExecuteFXQuote books a quoted conversion before the quote expires
+	ExecuteFXQuote(context.Context, *ExecuteFXQuoteRequest) (*ExecuteFXQuoteResponse, error)
+	// SetLimitRule adds an effective-dated entry to the transaction limits
+	SetLimitRule(context.Context, *SetLimitRuleRequest) (*SetLimitRuleResponse, error)
+	// ListLimitRules lists the transaction limit history
+	ListLimitRules(context.Context, *ListLimitRulesRequest) (*ListLimitRulesResponse, error)
+	// SetCustomerRiskTier sets the risk tier a customer's limits are chosen by
+	SetCustomerRiskTier(context.Context, *SetCustomerRiskTierRequest) (*SetCustomerRiskTierResponse, error)
+	// GrantLimitIncrease temporarily raises a limit for one customer
+	GrantLimitIncrease(context.Context, *GrantLimitIncreaseRequest) (*GrantLimitIncreaseResponse, error)
+	// RevokeLimitIncrease ends a temporary limit increase before it expires
+	RevokeLimitIncrease(context.Context, *RevokeLimitIncreaseRequest) (*RevokeLimitIncreaseResponse, error)
+	// GetRemainingLimits reports how much of each limit an account's customer has left
+	GetRemainingLimits(context.Context, *GetRemainingLimitsRequest) (*GetRemainingLimitsResponse, error)
+	// ListAMLAlerts lists transaction monitoring alerts, newest first
+	ListAMLAlerts(context.Context, *ListAMLAlertsRequest) (*ListAMLAlertsResponse, error)
+	// GetAMLAlert retrieves an alert with the transactions it was raised on
+	GetAMLAlert(context.Context, *GetAMLAlertRequest) (*GetAMLAlertResponse, error)
+	// CloseAMLAlert records the outcome of investigating an alert
+	CloseAMLAlert(context.Context, *CloseAMLAlertRequest) (*CloseAMLAlertResponse, error)
+	mustEmbedUnimplementedAccountServiceServer()
+}
+
+// UnimplementedAccountServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountServiceServer struct{}
+
+func (UnimplementedAccountServiceServer) OpenAccount(context.Context, *OpenAccountRequest) (*OpenAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedAccountServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedAccountServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedAccountServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedAccountServiceServer) ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHolds not implemented")
+}
+func (UnimplementedAccountServiceServer) SetOverdraftLimit(context.Context, *SetOverdraftLimitRequest) (*SetOverdraftLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOverdraftLimit not implemented")
+}
+func (UnimplementedAccountServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedAccountServiceServer) SetFeeRule(context.Context, *SetFeeRuleRequest) (*SetFeeRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFeeRule not implemented")
+}
+func (UnimplementedAccountServiceServer) ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFeeRules not implemented")
+}
+func (UnimplementedAccountServiceServer) ListFees(context.Context, *ListFeesRequest) (*ListFeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFees not implemented")
+}
+func (UnimplementedAccountServiceServer) ReverseFee(context.Context, *ReverseFeeRequest) (*ReverseFeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReverseFee not implemented")
+}
+func (UnimplementedAccountServiceServer) AddAccountParty(context.Context, *AddAccountPartyRequest) (*AddAccountPartyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAccountParty not implemented")
+}
+func (UnimplementedAccountServiceServer) EndAccountParty(context.Context, *EndAccountPartyRequest) (*EndAccountPartyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndAccountParty not implemented")
+}
+func (UnimplementedAccountServiceServer) ListAccountParties(context.Context, *ListAccountPartiesRequest) (*ListAccountPartiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccountParties not implemented")
+}
+func (UnimplementedAccountServiceServer) ListAccountsByCustomer(context.Context, *ListAccountsByCustomerRequest) (*ListAccountsByCustomerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccountsByCustomer not implemented")
+}
+func (UnimplementedAccountServiceServer) CheckSigningAuthority(context.Context, *CheckSigningAuthorityRequest) (*CheckSigningAuthorityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckSigningAuthority not implemented")
+}
+func (UnimplementedAccountServiceServer) GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedAccountServiceServer) GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedAccountServiceServer) ListStatements(context.Context, *ListStatementsRequest) (*ListStatementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStatements not implemented")
+}
+func (UnimplementedAccountServiceServer) ImportBankStatement(context.Context, *ImportBankStatementRequest) (*ImportBankStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportBankStatement not implemented")
+}
+func (UnimplementedAccountServiceServer) RunReconciliation(context.Context, *RunReconciliationRequest) (*RunReconciliationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunReconciliation not implemented")
+}
+func (UnimplementedAccountServiceServer) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedAccountServiceServer) ListReconciliationBreaks(context.Context, *ListReconciliationBreaksRequest) (*ListReconciliationBreaksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReconciliationBreaks not implemented")
+}
+func (UnimplementedAccountServiceServer) ManualMatch(context.Context, *ManualMatchRequest) (*ManualMatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ManualMatch not implemented")
+}
+func (UnimplementedAccountServiceServer) WriteOffBreak(context.Context, *WriteOffBreakRequest) (*WriteOffBreakResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WriteOffBreak not implemented")
+}
+func (UnimplementedAccountServiceServer) SetFXRates(context.Context, *SetFXRatesRequest) (*SetFXRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFXRates not implemented")
+}
+func (UnimplementedAccountServiceServer) ListFXRates(context.Context, *ListFXRatesRequest) (*ListFXRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFXRates not implemented")
+}
+func (UnimplementedAccountServiceServer) SetFXPositionAccount(context.Context, *SetFXPositionAccountRequest) (*SetFXPositionAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFXPositionAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) CreateFXQuote(context.Context, *CreateFXQuoteRequest) (*CreateFXQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFXQuote not implemented")
+}
+func (UnimplementedAccountServiceServer) GetFXQuote(context.Context, *GetFXQuoteRequest) (*GetFXQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFXQuote not implemented")
+}
+func (UnimplementedAccountServiceServer) ExecuteFXQuote(context.Context, *ExecuteFXQuoteRequest) (*ExecuteFXQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExecuteFXQuote not implemented")
+}
+func (UnimplementedAccountServiceServer) SetLimitRule(context.Context, *SetLimitRuleRequest) (*SetLimitRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLimitRule not implemented")
+}
+func (UnimplementedAccountServiceServer) ListLimitRules(context.Context, *ListLimitRulesRequest) (*ListLimitRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLimitRules not implemented")
+}
+func (UnimplementedAccountServiceServer) SetCustomerRiskTier(context.Context, *SetCustomerRiskTierRequest) (*SetCustomerRiskTierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCustomerRiskTier not implemented")
+}
+func (UnimplementedAccountServiceServer) GrantLimitIncrease(context.Context, *GrantLimitIncreaseRequest) (*GrantLimitIncreaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantLimitIncrease not implemented")
+}
+func (UnimplementedAccountServiceServer) RevokeLimitIncrease(context.Context, *RevokeLimitIncreaseRequest) (*RevokeLimitIncreaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeLimitIncrease not implemented")
+}
+func (UnimplementedAccountServiceServer) GetRemainingLimits(context.Context, *GetRemainingLimitsRequest) (*GetRemainingLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRemainingLimits not implemented")
+}
+func (UnimplementedAccountServiceServer) ListAMLAlerts(context.Context, *ListAMLAlertsRequest) (*ListAMLAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAMLAlerts not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAMLAlert(context.Context, *GetAMLAlertRequest) (*GetAMLAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAMLAlert not implemented")
+}
+func (UnimplementedAccountServiceServer) CloseAMLAlert(context.Context, *CloseAMLAlertRequest) (*CloseAMLAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseAMLAlert not implemented")
+}
+func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
+func (UnimplementedAccountServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountServiceServer will
+// result in compilation errors.
+type UnsafeAccountServiceServer interface {
+	mustEmbedUnimplementedAccountServiceServer()
+}
+
+func RegisterAccountServiceServer(s grpc.ServiceRegistrar, srv AccountServiceServer) {
+	// If the following call panics, it indicates UnimplementedAccountServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountService_ServiceDesc, srv)
+}
+
+func _AccountService_OpenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).OpenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_OpenAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).OpenAccount(ctx, req.(*OpenAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).FreezeAccount(ctx, req.(*FreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_UnfreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UnfreezeAccount(ctx, req.(*UnfreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CaptureHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListHolds(ctx, req.(*ListHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetOverdraftLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOverdraftLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetOverdraftLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetOverdraftLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetOverdraftLimit(ctx, req.(*SetOverdraftLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetFeeRule(ctx, req.(*SetFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListFeeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListFeeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListFeeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListFeeRules(ctx, req.(*ListFeeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListFees(ctx, req.(*ListFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ReverseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ReverseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ReverseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ReverseFee(ctx, req.(*ReverseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_AddAccountParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAccountPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).AddAccountParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_AddAccountParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).AddAccountParty(ctx, req.(*AddAccountPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_EndAccountParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndAccountPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EndAccountParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_EndAccountParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EndAccountParty(ctx, req.(*EndAccountPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAccountParties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountPartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAccountParties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListAccountParties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAccountParties(ctx, req.(*ListAccountPartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAccountsByCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsByCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAccountsByCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListAccountsByCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAccountsByCustomer(ctx, req.(*ListAccountsByCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CheckSigningAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSigningAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CheckSigningAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CheckSigningAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CheckSigningAuthority(ctx, req.(*CheckSigningAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GenerateStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GenerateStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GenerateStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GenerateStatement(ctx, req.(*GenerateStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetStatement(ctx, req.(*GetStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListStatements(ctx, req.(*ListStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ImportBankStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBankStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ImportBankStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ImportBankStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ImportBankStatement(ctx, req.(*ImportBankStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RunReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RunReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RunReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RunReconciliation(ctx, req.(*RunReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetReconciliationReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListReconciliationBreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationBreaksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListReconciliationBreaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListReconciliationBreaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListReconciliationBreaks(ctx, req.(*ListReconciliationBreaksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ManualMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ManualMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ManualMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ManualMatch(ctx, req.(*ManualMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_WriteOffBreak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffBreakRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).WriteOffBreak(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_WriteOffBreak_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).WriteOffBreak(ctx, req.(*WriteOffBreakRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetFXRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFXRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetFXRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetFXRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetFXRates(ctx, req.(*SetFXRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListFXRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFXRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListFXRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListFXRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListFXRates(ctx, req.(*ListFXRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetFXPositionAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFXPositionAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetFXPositionAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetFXPositionAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetFXPositionAccount(ctx, req.(*SetFXPositionAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CreateFXQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFXQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CreateFXQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CreateFXQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CreateFXQuote(ctx, req.(*CreateFXQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetFXQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFXQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetFXQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetFXQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetFXQuote(ctx, req.(*GetFXQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ExecuteFXQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteFXQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ExecuteFXQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ExecuteFXQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ExecuteFXQuote(ctx, req.(*ExecuteFXQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetLimitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLimitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetLimitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetLimitRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetLimitRule(ctx, req.(*SetLimitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListLimitRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLimitRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListLimitRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListLimitRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListLimitRules(ctx, req.(*ListLimitRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetCustomerRiskTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCustomerRiskTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetCustomerRiskTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetCustomerRiskTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetCustomerRiskTier(ctx, req.(*SetCustomerRiskTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GrantLimitIncrease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantLimitIncreaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GrantLimitIncrease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GrantLimitIncrease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GrantLimitIncrease(ctx, req.(*GrantLimitIncreaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RevokeLimitIncrease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLimitIncreaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RevokeLimitIncrease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RevokeLimitIncrease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RevokeLimitIncrease(ctx, req.(*RevokeLimitIncreaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetRemainingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRemainingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetRemainingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetRemainingLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetRemainingLimits(ctx, req.(*GetRemainingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAMLAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAMLAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAMLAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListAMLAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAMLAlerts(ctx, req.(*ListAMLAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAMLAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAMLAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAMLAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAMLAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAMLAlert(ctx, req.(*GetAMLAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CloseAMLAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAMLAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CloseAMLAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CloseAMLAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CloseAMLAlert(ctx, req.(*CloseAMLAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "account.v1.AccountService",
+	HandlerType: (*AccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenAccount",
+			Handler:    _AccountService_OpenAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _AccountService_GetAccount_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _AccountService_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _AccountService_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _AccountService_GetBalance_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _AccountService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _AccountService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _AccountService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "ListHolds",
+			Handler:    _AccountService_ListHolds_Handler,
+		},
+		{
+			MethodName: "SetOverdraftLimit",
+			Handler:    _AccountService_SetOverdraftLimit_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _AccountService_Transfer_Handler,
+		},
+		{
+			MethodName: "SetFeeRule",
+			Handler:    _AccountService_SetFeeRule_Handler,
+		},
+		{
+			MethodName: "ListFeeRules",
+			Handler:    _AccountService_ListFeeRules_Handler,
+		},
+		{
+			MethodName: "ListFees",
+			Handler:    _AccountService_ListFees_Handler,
+		},
+		{
+			MethodName: "ReverseFee",
+			Handler:    _AccountService_ReverseFee_Handler,
+		},
+		{
+			MethodName: "AddAccountParty",
+			Handler:    _AccountService_AddAccountParty_Handler,
+		},
+		{
+			MethodName: "EndAccountParty",
+			Handler:    _AccountService_EndAccountParty_Handler,
+		},
+		{
+			MethodName: "ListAccountParties",
+			Handler:    _AccountService_ListAccountParties_Handler,
+		},
+		{
+			MethodName: "ListAccountsByCustomer",
+			Handler:    _AccountService_ListAccountsByCustomer_Handler,
+		},
+		{
+			MethodName: "CheckSigningAuthority",
+			Handler:    _AccountService_CheckSigningAuthority_Handler,
+		},
+		{
+			MethodName: "GenerateStatement",
+			Handler:    _AccountService_GenerateStatement_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _AccountService_GetStatement_Handler,
+		},
+		{
+			MethodName: "ListStatements",
+			Handler:    _AccountService_ListStatements_Handler,
+		},
+		{
+			MethodName: "ImportBankStatement",
+			Handler:    _AccountService_ImportBankStatement_Handler,
+		},
+		{
+			MethodName: "RunReconciliation",
+			Handler:    _AccountService_RunReconciliation_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _AccountService_GetReconciliationReport_Handler,
+		},
+		{
+			MethodName: "ListReconciliationBreaks",
+			Handler:    _AccountService_ListReconciliationBreaks_Handler,
+		},
+		{
+			MethodName: "ManualMatch",
+			Handler:    _AccountService_ManualMatch_Handler,
+		},
+		{
+			MethodName: "WriteOffBreak",
+			Handler:    _AccountService_WriteOffBreak_Handler,
+		},
+		{
+			MethodName: "SetFXRates",
+			Handler:    _AccountService_SetFXRates_Handler,
+		},
+		{
+			MethodName: "ListFXRates",
+			Handler:    _AccountService_ListFXRates_Handler,
+		},
+		{
+			MethodName: "SetFXPositionAccount",
+			Handler:    _AccountService_SetFXPositionAccount_Handler,
+		},
+		{
+			MethodName: "CreateFXQuote",
+			Handler:    _AccountService_CreateFXQuote_Handler,
+		},
+		{
+			MethodName: "GetFXQuote",
+			Handler:    _AccountService_GetFXQuote_Handler,
+		},
+		{
+			MethodName: "ExecuteFXQuote",
+			Handler:    _AccountService_ExecuteFXQuote_Handler,
+		},
+		{
+			MethodName: "SetLimitRule",
+			Handler:    _AccountService_SetLimitRule_Handler,
+		},
+		{
+			MethodName: "ListLimitRules",
+			Handler:    _AccountService_ListLimitRules_Handler,
+		},
+		{
+			MethodName: "SetCustomerRiskTier",
+			Handler:    _AccountService_SetCustomerRiskTier_Handler,
+		},
+		{
+			MethodName: "GrantLimitIncrease",
+			Handler:    _AccountService_GrantLimitIncrease_Handler,
+		},
+		{
+			MethodName: "RevokeLimitIncrease",
+			Handler:    _AccountService_RevokeLimitIncrease_Handler,
+		},
+		{
+			MethodName: "GetRemainingLimits",
+			Handler:    _AccountService_GetRemainingLimits_Handler,
+		},
+		{
+			MethodName: "ListAMLAlerts",
+			Handler:    _AccountService_ListAMLAlerts_Handler,
+		},
+		{
+			MethodName: "GetAMLAlert",
+			Handler:    _AccountService_GetAMLAlert_Handler,
+		},
+		{
+			MethodName: "CloseAMLAlert",
+			Handler:    _AccountService_CloseAMLAlert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "account.proto",
+}
@@ -0,0 +1,264 @@
+package reconciliation
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// camt053NamespacePrefix is shared by every version of BankToCustomerStatement
+const camt053NamespacePrefix = "urn:iso:std:iso:20022:tech:xsd:camt.053."
+
+// camt.053 balance types, credit/debit indicator and entry status read
+const (
+	camtOpeningBooked     = "OPBD"
+	camtPreviouslyClosed  = "PRCD"
+	camtClosingBooked     = "CLBD"
+	camtDebit             = "DBIT"
+	camtEntryStatusBooked = "BOOK"
+	camtNotProvided       = "NOTPROVIDED"
+)
+
+type camtDocument struct {
+	XMLName xml.Name   `xml:"Document"`
+	Stmts   []camtStmt `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStmt struct {
+	ID           string `xml:"Id"`
+	ElctrncSeqNb string `xml:"ElctrncSeqNb"`
+	LglSeqNb     string `xml:"LglSeqNb"`
+	Acct         struct {
+		IBAN string `xml:"Id>IBAN"`
+		Othr string `xml:"Id>Othr>Id"`
+		Ccy  string `xml:"Ccy"`
+	} `xml:"Acct"`
+	Bal  []camtBal  `xml:"Bal"`
+	Ntry []camtNtry `xml:"Ntry"`
+}
+
+type camtBal struct {
+	Tp        string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Dt        camtDate   `xml:"Dt"`
+}
+
+type camtAmount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+// camtDate is a choice of ISO date or date-time
+type camtDate struct {
+	Dt   string `xml:"Dt"`
+	DtTm string `xml:"DtTm"`
+}
+
+type camtNtry struct {
+	NtryRef      string     `xml:"NtryRef"`
+	Amt          camtAmount `xml:"Amt"`
+	CdtDbtInd    string     `xml:"CdtDbtInd"`
+	RvslInd      bool       `xml:"RvslInd"`
+	Sts          camtStatus `xml:"Sts"`
+	BookgDt      camtDate   `xml:"BookgDt"`
+	ValDt        camtDate   `xml:"ValDt"`
+	AcctSvcrRef  string     `xml:"AcctSvcrRef"`
+	TxDtls       []camtTx   `xml:"NtryDtls>TxDtls"`
+	AddtlNtryInf string     `xml:"AddtlNtryInf"`
+}
+
+// camtStatus is a plain code up to camt.053.001.08 and a Cd element after
+type camtStatus struct {
+	Code string `xml:",chardata"`
+	Cd   string `xml:"Cd"`
+}
+
+type camtTx struct {
+	EndToEndID string   `xml:"Refs>EndToEndId"`
+	Ustrd      []string `xml:"RmtInf>Ustrd"`
+}
+
+// ParseCAMT053 reads the statements in an ISO 20022 camt.053 message of any
+// version. Only booked entries are kept; a reversal flips the direction of
+// its entry.
+func ParseCAMT053(data []byte) ([]*Statement, error) {
+	var doc camtDocument
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+	}
+	if ns := doc.XMLName.Space; ns != "" && !strings.HasPrefix(ns, camt053NamespacePrefix) {
+		return nil, fmt.Errorf("%w: unexpected namespace %q", ErrInvalidStatement, ns)
+	}
+
+	statements := make([]*Statement, 0, len(doc.Stmts))
+	for i, stmt := range doc.Stmts {
+		s, err := parseCAMTStatement(stmt)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i+1, err)
+		}
+		statements = append(statements, s)
+	}
+	return statements, nil
+}
+
+func parseCAMTStatement(stmt camtStmt) (*Statement, error) {
+	s := &Statement{
+		Format:         FormatCAMT053,
+		StatementID:    strings.TrimSpace(stmt.ID),
+		SequenceNumber: strings.TrimSpace(stmt.ElctrncSeqNb),
+		Account:        strings.TrimSpace(stmt.Acct.IBAN),
+		Currency:       strings.TrimSpace(stmt.Acct.Ccy),
+	}
+	if s.SequenceNumber == "" {
+		s.SequenceNumber = strings.TrimSpace(stmt.LglSeqNb)
+	}
+	if s.Account == "" {
+		s.Account = strings.TrimSpace(stmt.Acct.Othr)
+	}
+	if s.StatementID == "" {
+		return nil, fmt.Errorf("%w: missing Stmt/Id", ErrInvalidStatement)
+	}
+	if s.Account == "" {
+		return nil, fmt.Errorf("%w: missing account identification", ErrInvalidStatement)
+	}
+
+	var opening, closing bool
+	for _, bal := range stmt.Bal {
+		switch bal.Tp {
+		case camtOpeningBooked, camtPreviouslyClosed:
+			if opening && bal.Tp == camtPreviouslyClosed {
+				continue // OPBD wins when both are given
+			}
+			amount, currency, err := camtSignedAmount(bal.Amt, bal.CdtDbtInd == camtDebit, s.Currency)
+			if err != nil {
+				return nil, err
+			}
+			date, err := bal.Dt.parse()
+			if err != nil {
+				return nil, err
+			}
+			s.Currency, s.OpeningBalance, s.OpeningDate = currency, amount, date
+			opening = true
+		case camtClosingBooked:
+			amount, currency, err := camtSignedAmount(bal.Amt, bal.CdtDbtInd == camtDebit, s.Currency)
+			if err != nil {
+				return nil, err
+			}
+			date, err := bal.Dt.parse()
+			if err != nil {
+				return nil, err
+			}
+			s.Currency, s.ClosingBalance, s.ClosingDate = currency, amount, date
+			closing = true
+		}
+	}
+	if !opening {
+		return nil, fmt.Errorf("%w: missing OPBD or PRCD balance", ErrInvalidStatement)
+	}
+	if !closing {
+		return nil, fmt.Errorf("%w: missing CLBD balance", ErrInvalidStatement)
+	}
+
+	for _, ntry := range stmt.Ntry {
+		status := strings.TrimSpace(ntry.Sts.Cd)
+		if status == "" {
+			status = strings.TrimSpace(ntry.Sts.Code)
+		}
+		if status != camtEntryStatusBooked {
+			continue
+		}
+		line, err := parseCAMTEntry(ntry, s.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("entry %q: %w", ntry.NtryRef, err)
+		}
+		s.Lines = append(s.Lines, line)
+	}
+	return s, nil
+}
+
+func parseCAMTEntry(ntry camtNtry, currency string) (Line, error) {
+	// A reversed credit leaves the account, a reversed debit comes back
+	negative := (ntry.CdtDbtInd == camtDebit) != ntry.RvslInd
+	amount, _, err := camtSignedAmount(ntry.Amt, negative, currency)
+	if err != nil {
+		return Line{}, err
+	}
+	booking, err := ntry.BookgDt.parse()
+	if err != nil {
+		return Line{}, err
+	}
+	value := booking
+	if ntry.ValDt != (camtDate{}) {
+		if value, err = ntry.ValDt.parse(); err != nil {
+			return Line{}, err
+		}
+	}
+
+	line := Line{
+		ValueDate:     value,
+		BookingDate:   booking,
+		Amount:        amount,
+		BankReference: strings.TrimSpace(ntry.AcctSvcrRef),
+		Description:   strings.TrimSpace(ntry.AddtlNtryInf),
+	}
+	if line.BankReference == "" {
+		line.BankReference = strings.TrimSpace(ntry.NtryRef)
+	}
+	if len(ntry.TxDtls) > 0 {
+		tx := ntry.TxDtls[0]
+		if ref := strings.TrimSpace(tx.EndToEndID); ref != camtNotProvided {
+			line.Reference = ref
+		}
+		if line.Description == "" {
+			line.Description = strings.TrimSpace(strings.Join(tx.Ustrd, " "))
+		}
+	}
+	return line, nil
+}
+
+// camtSignedAmount parses an amount in the statement currency
+func camtSignedAmount(amt camtAmount, negative bool, currency string) (int64, string, error) {
+	ccy := strings.TrimSpace(amt.Ccy)
+	if currency != "" && ccy != "" && ccy != currency {
+		return 0, "", fmt.Errorf("%w: amount in %s on a %s statement", ErrInvalidStatement, ccy, currency)
+	}
+	if ccy == "" {
+		ccy = currency
+	}
+	if ccy == "" {
+		return 0, "", fmt.Errorf("%w: amount without currency", ErrInvalidStatement)
+	}
+	amount, err := parseAmount(amt.Value, ccy)
+	if err != nil {
+		return 0, "", err
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, ccy, nil
+}
+
+// parse returns the calendar day of the date, in the time zone it was
+// written in
+func (d camtDate) parse() (time.Time, error) {
+	if v := strings.TrimSpace(d.Dt); v != "" {
+		t, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidStatement, v)
+		}
+		return t, nil
+	}
+	v := strings.TrimSpace(d.DtTm)
+	if v == "" {
+		return time.Time{}, fmt.Errorf("%w: missing date", ErrInvalidStatement)
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid date-time %q", ErrInvalidStatement, v)
+}
@@ -0,0 +1,242 @@
+package reconciliation
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxGroupCandidates bounds how many items a ManyToOne rule considers for
+// each group; the nearest by value date are kept
+const maxGroupCandidates = 20
+
+// Item is a statement line or a posting taking part in matching
+type Item struct {
+	ID         uuid.UUID
+	ValueDate  time.Time
+	Amount     int64
+	References []string // Empty references are ignored
+}
+
+// Match pairs statement lines with the postings they account for. Either
+// side holds a single item unless a ManyToOne rule made it.
+type Match struct {
+	Rule     string
+	Lines    []uuid.UUID
+	Postings []uuid.UUID
+}
+
+// AutoMatch applies the rules in order to the unmatched lines and postings.
+// The result depends only on the items and rules, not on the order the
+// items are given in, so a run can be repeated with the same outcome.
+func AutoMatch(rules Rules, lines, postings []Item) []Match {
+	m := &matcher{
+		lines:    sortedItems(lines),
+		postings: sortedItems(postings),
+	}
+	m.lineUsed = make([]bool, len(m.lines))
+	m.postingUsed = make([]bool, len(m.postings))
+
+	for _, rule := range rules {
+		switch rule.Type {
+		case RuleExactReference, RuleAmountDate:
+			m.matchPairs(rule)
+		case RuleManyToOne:
+			m.matchGroups(rule, m.lines, m.lineUsed, m.postings, m.postingUsed, false)
+			m.matchGroups(rule, m.postings, m.postingUsed, m.lines, m.lineUsed, true)
+		}
+	}
+	return m.matches
+}
+
+type matcher struct {
+	lines, postings       []Item
+	lineUsed, postingUsed []bool
+	matches               []Match
+}
+
+// matchPairs makes one-to-one matches, closest value dates first
+func (m *matcher) matchPairs(rule Rule) {
+	type pair struct{ line, posting, days int }
+	var pairs []pair
+	for i, line := range m.lines {
+		if m.lineUsed[i] {
+			continue
+		}
+		for j, posting := range m.postings {
+			if m.postingUsed[j] || posting.Amount != line.Amount {
+				continue
+			}
+			if rule.Type == RuleExactReference && !sharesReference(line, posting) {
+				continue
+			}
+			days := daysApart(line.ValueDate, posting.ValueDate)
+			if !withinWindow(rule, days) {
+				continue
+			}
+			pairs = append(pairs, pair{line: i, posting: j, days: days})
+		}
+	}
+
+	sort.SliceStable(pairs, func(a, b int) bool {
+		if pairs[a].days != pairs[b].days {
+			return pairs[a].days < pairs[b].days
+		}
+		if pairs[a].line != pairs[b].line {
+			return pairs[a].line < pairs[b].line
+		}
+		return pairs[a].posting < pairs[b].posting
+	})
+	for _, p := range pairs {
+		if m.lineUsed[p.line] || m.postingUsed[p.posting] {
+			continue
+		}
+		m.lineUsed[p.line], m.postingUsed[p.posting] = true, true
+		m.matches = append(m.matches, Match{
+			Rule:     rule.Name,
+			Lines:    []uuid.UUID{m.lines[p.line].ID},
+			Postings: []uuid.UUID{m.postings[p.posting].ID},
+		})
+	}
+}
+
+// matchGroups matches each unmatched item on the one side with the smallest
+// group of unmatched items on the other side that sums to it. reversed is
+// set when the one side holds postings.
+func (m *matcher) matchGroups(rule Rule, ones []Item, oneUsed []bool, many []Item, manyUsed []bool, reversed bool) {
+	for i, one := range ones {
+		if oneUsed[i] || one.Amount == 0 {
+			continue
+		}
+
+		type candidate struct{ index, days int }
+		var candidates []candidate
+		for j, item := range many {
+			if manyUsed[j] || item.Amount == 0 || (item.Amount > 0) != (one.Amount > 0) || abs(item.Amount) > abs(one.Amount) {
+				continue
+			}
+			days := daysApart(one.ValueDate, item.ValueDate)
+			if days <= rule.DateWindowDays {
+				candidates = append(candidates, candidate{index: j, days: days})
+			}
+		}
+		if len(candidates) < 2 {
+			continue
+		}
+		sort.SliceStable(candidates, func(a, b int) bool { return candidates[a].days < candidates[b].days })
+		if len(candidates) > maxGroupCandidates {
+			candidates = candidates[:maxGroupCandidates]
+		}
+		sort.Slice(candidates, func(a, b int) bool { return candidates[a].index < candidates[b].index })
+
+		amounts := make([]int64, len(candidates))
+		for k, c := range candidates {
+			amounts[k] = abs(many[c.index].Amount)
+		}
+		group := findGroup(amounts, abs(one.Amount), rule.MaxGroupSize)
+		if group == nil {
+			continue
+		}
+
+		oneUsed[i] = true
+		ids := make([]uuid.UUID, len(group))
+		for k, g := range group {
+			manyUsed[candidates[g].index] = true
+			ids[k] = many[candidates[g].index].ID
+		}
+		match := Match{Rule: rule.Name, Lines: []uuid.UUID{one.ID}, Postings: ids}
+		if reversed {
+			match.Lines, match.Postings = ids, []uuid.UUID{one.ID}
+		}
+		m.matches = append(m.matches, match)
+	}
+}
+
+// findGroup returns the indexes of the smallest set of at least two amounts
+// summing to target, the first in index order among sets of that size, or
+// nil if there is none. Amounts are positive.
+func findGroup(amounts []int64, target int64, maxSize int) []int {
+	for size := 2; size <= maxSize && size <= len(amounts); size++ {
+		group := make([]int, 0, size)
+		var search func(start int, remaining int64) bool
+		search = func(start int, remaining int64) bool {
+			if len(group) == size {
+				return remaining == 0
+			}
+			for k := start; k <= len(amounts)-(size-len(group)); k++ {
+				if amounts[k] > remaining {
+					continue
+				}
+				group = append(group, k)
+				if search(k+1, remaining-amounts[k]) {
+					return true
+				}
+				group = group[:len(group)-1]
+			}
+			return false
+		}
+		if search(0, target) {
+			return group
+		}
+	}
+	return nil
+}
+
+// sortedItems orders items by value date, amount and ID
+func sortedItems(items []Item) []Item {
+	sorted := append([]Item(nil), items...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if !a.ValueDate.Equal(b.ValueDate) {
+			return a.ValueDate.Before(b.ValueDate)
+		}
+		if a.Amount != b.Amount {
+			return a.Amount < b.Amount
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+	return sorted
+}
+
+func sharesReference(a, b Item) bool {
+	for _, ra := range a.References {
+		if ra == "" {
+			continue
+		}
+		for _, rb := range b.References {
+			if ra == rb {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withinWindow applies the rule's date window; an exact reference match
+// without a window ignores dates
+func withinWindow(rule Rule, days int) bool {
+	if rule.Type == RuleExactReference && rule.DateWindowDays == 0 {
+		return true
+	}
+	return days <= rule.DateWindowDays
+}
+
+// daysApart returns the number of calendar days between two dates
+func daysApart(a, b time.Time) int {
+	a = time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	b = time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(a.Sub(b).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+func abs(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+	return amount
+}
@@ -0,0 +1,120 @@
+package reconciliation
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func itemID(side byte, n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%c%011d", side, n))
+}
+
+func TestAutoMatch(t *testing.T) {
+	line := func(n int, day string, amount int64, refs ...string) Item {
+		return Item{ID: itemID('a', n), ValueDate: mustDate(t, day), Amount: amount, References: refs}
+	}
+	posting := func(n int, day string, amount int64, refs ...string) Item {
+		return Item{ID: itemID('b', n), ValueDate: mustDate(t, day), Amount: amount, References: refs}
+	}
+
+	lines := []Item{
+		line(1, "2024-04-01", 10000, "PAY-1", "BANK-1"), // Reference match despite the date
+		line(2, "2024-04-02", -5000, "", "BANK-2"),      // Amount and date: the closer posting wins
+		line(3, "2024-04-03", 30000),                    // Batch of three postings
+		line(4, "2024-04-04", -700),                     // One posting settled by two lines
+		line(5, "2024-04-04", -300),
+		line(6, "2024-04-05", 999), // Break
+	}
+	postings := []Item{
+		posting(1, "2024-03-25", 10000, "PAY-1"),
+		posting(2, "2024-04-04", -5000),
+		posting(3, "2024-04-03", -5000),
+		posting(4, "2024-04-03", 10000),
+		posting(5, "2024-04-03", 15000),
+		posting(6, "2024-04-02", 5000),
+		posting(7, "2024-04-04", -1000),
+		posting(8, "2024-04-10", 999), // Outside every window
+	}
+
+	got := AutoMatch(DefaultRules(), lines, postings)
+	want := []Match{
+		{Rule: "reference", Lines: []uuid.UUID{itemID('a', 1)}, Postings: []uuid.UUID{itemID('b', 1)}},
+		{Rule: "amount-date", Lines: []uuid.UUID{itemID('a', 2)}, Postings: []uuid.UUID{itemID('b', 3)}},
+		{Rule: "batch", Lines: []uuid.UUID{itemID('a', 3)}, Postings: []uuid.UUID{itemID('b', 6), itemID('b', 4), itemID('b', 5)}},
+		{Rule: "batch", Lines: []uuid.UUID{itemID('a', 4), itemID('a', 5)}, Postings: []uuid.UUID{itemID('b', 7)}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AutoMatch() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	// The outcome does not depend on the order the items arrive in
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		r.Shuffle(len(lines), func(a, b int) { lines[a], lines[b] = lines[b], lines[a] })
+		r.Shuffle(len(postings), func(a, b int) { postings[a], postings[b] = postings[b], postings[a] })
+		if again := AutoMatch(DefaultRules(), lines, postings); !reflect.DeepEqual(again, want) {
+			t.Fatalf("shuffled AutoMatch() = %+v", again)
+		}
+	}
+
+	// Without the reference rule the first line is too far from its posting
+	amountOnly := Rules{{Name: "amount", Type: RuleAmountDate, DateWindowDays: 1}}
+	for _, m := range AutoMatch(amountOnly, lines, postings) {
+		if m.Lines[0] == itemID('a', 1) {
+			t.Errorf("amount-only rules matched line 1 to %v", m.Postings)
+		}
+	}
+}
+
+func TestFindGroup(t *testing.T) {
+	tests := []struct {
+		amounts []int64
+		target  int64
+		maxSize int
+		want    []int
+	}{
+		{[]int64{5, 3, 2, 8}, 10, 3, []int{2, 3}}, // A pair before the triple 5+3+2
+		{[]int64{1, 2, 3, 4}, 10, 3, nil},         // Needs four
+		{[]int64{1, 2, 3, 4}, 10, 4, []int{0, 1, 2, 3}},
+		{[]int64{10}, 10, 5, nil}, // A single item is not a group
+	}
+	for _, tt := range tests {
+		if got := findGroup(tt.amounts, tt.target, tt.maxSize); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("findGroup(%v, %d, %d) = %v, want %v", tt.amounts, tt.target, tt.maxSize, got, tt.want)
+		}
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(`{"rules": [
+		{"name": "ref", "type": "ExactReference", "date_window_days": 5},
+		{"name": "sweep", "type": "ManyToOne", "max_group_size": 3}
+	]}`))
+	if err != nil {
+		t.Fatalf("ParseRules() error = %v", err)
+	}
+	want := Rules{
+		{Name: "ref", Type: RuleExactReference, DateWindowDays: 5},
+		{Name: "sweep", Type: RuleManyToOne, MaxGroupSize: 3},
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("ParseRules() = %+v, want %+v", rules, want)
+	}
+
+	for name, doc := range map[string]string{
+		"empty":          `{"rules": []}`,
+		"unknown type":   `{"rules": [{"name": "a", "type": "Fuzzy"}]}`,
+		"duplicate name": `{"rules": [{"name": "a", "type": "AmountDate"}, {"name": "a", "type": "ExactReference"}]}`,
+		"group too big":  `{"rules": [{"name": "a", "type": "ManyToOne", "max_group_size": 11}]}`,
+		"group on pair":  `{"rules": [{"name": "a", "type": "AmountDate", "max_group_size": 2}]}`,
+		"negative days":  `{"rules": [{"name": "a", "type": "AmountDate", "date_window_days": -1}]}`,
+	} {
+		if _, err := ParseRules([]byte(doc)); err == nil {
+			t.Errorf("%s: ParseRules() error = nil", name)
+		}
+	}
+}
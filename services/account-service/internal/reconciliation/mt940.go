@@ -0,0 +1,245 @@
+package reconciliation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// mt940DateLayout is the YYMMDD date of MT940 balances and entries
+const mt940DateLayout = "060102"
+
+// mt940Tag matches the start of a field, e.g. ":61:" or ":60F:"
+var mt940Tag = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):`)
+
+// mt940Balance is the layout of fields 60a, 62a and 64: D/C mark, date,
+// currency and amount
+var mt940Balance = regexp.MustCompile(`^([CD])([0-9]{6})([A-Z]{3})([0-9]+,[0-9]*)$`)
+
+// mt940Line is the layout of field 61: value date, optional entry date,
+// D/C mark (R for a reversal), optional funds code, amount, transaction
+// type, customer reference and optional bank reference
+var mt940Line = regexp.MustCompile(`^([0-9]{6})([0-9]{4})?(R?[CD])([A-Z])?([0-9]+,[0-9]*)([NFS][A-Z0-9]{3})([^/]{0,16}?)(?://(.{0,16}))?$`)
+
+type mt940Field struct {
+	tag   string
+	value string
+}
+
+// ParseMT940 reads the statements in an MT940 file. Each message may be
+// wrapped in SWIFT FIN blocks or given as bare block 4 text; a file may hold
+// several messages.
+func ParseMT940(data []byte) ([]*Statement, error) {
+	text := strings.ReplaceAll(strings.TrimPrefix(string(data), "\ufeff"), "\r\n", "\n")
+
+	var statements []*Statement
+	for i, message := range splitMT940(text) {
+		fields, err := mt940Fields(message)
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i+1, err)
+		}
+		parsed, err := parseMT940Message(fields)
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i+1, err)
+		}
+		statements = append(statements, parsed)
+	}
+	return statements, nil
+}
+
+// splitMT940 returns the block 4 text of each message. Bare text is split
+// at each :20: field, which starts every statement.
+func splitMT940(text string) []string {
+	var messages []string
+	if strings.Contains(text, "{4:") {
+		for {
+			start := strings.Index(text, "{4:")
+			if start < 0 {
+				break
+			}
+			text = text[start+3:]
+			end := strings.Index(text, "\n-}")
+			if end < 0 {
+				end = strings.Index(text, "-}")
+			}
+			if end < 0 {
+				messages = append(messages, text)
+				break
+			}
+			messages = append(messages, text[:end])
+			text = text[end:]
+		}
+		return messages
+	}
+
+	var current []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, ":20:") && len(current) > 0 {
+			messages = append(messages, strings.Join(current, "\n"))
+			current = nil
+		}
+		if strings.TrimSpace(line) == "-" {
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 && strings.TrimSpace(strings.Join(current, "")) != "" {
+		messages = append(messages, strings.Join(current, "\n"))
+	}
+	return messages
+}
+
+// mt940Fields splits message text into fields; lines not starting with a
+// tag continue the previous field
+func mt940Fields(message string) ([]mt940Field, error) {
+	var fields []mt940Field
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimRight(line, " \r")
+		if line == "" {
+			continue
+		}
+		if m := mt940Tag.FindStringSubmatch(line); m != nil {
+			fields = append(fields, mt940Field{tag: m[1], value: line[len(m[0]):]})
+			continue
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("%w: text before the first field: %q", ErrInvalidStatement, line)
+		}
+		fields[len(fields)-1].value += "\n" + line
+	}
+	return fields, nil
+}
+
+func parseMT940Message(fields []mt940Field) (*Statement, error) {
+	s := &Statement{Format: FormatMT940}
+	var opening, closing bool
+	var last *Line
+	for _, f := range fields {
+		switch f.tag {
+		case "20":
+			s.StatementID = strings.TrimSpace(f.value)
+		case "25":
+			s.Account = strings.TrimSpace(f.value)
+		case "28C":
+			s.SequenceNumber = strings.TrimSpace(f.value)
+		case "60F", "60M":
+			amount, date, currency, err := parseMT940Balance(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.tag, err)
+			}
+			s.OpeningBalance, s.OpeningDate, s.Currency = amount, date, currency
+			opening = true
+		case "61":
+			if !opening {
+				return nil, fmt.Errorf("%w: field 61 before the opening balance", ErrInvalidStatement)
+			}
+			line, err := parseMT940Line(f.value, s.Currency)
+			if err != nil {
+				return nil, fmt.Errorf("field 61 %q: %w", f.value, err)
+			}
+			s.Lines = append(s.Lines, line)
+			last = &s.Lines[len(s.Lines)-1]
+		case "86":
+			// Information to the account owner belongs to the entry before it
+			if last != nil {
+				last.Description = strings.Join(strings.Fields(f.value), " ")
+			}
+		case "62F", "62M":
+			amount, date, currency, err := parseMT940Balance(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.tag, err)
+			}
+			if currency != s.Currency {
+				return nil, fmt.Errorf("%w: closing balance in %s, opening in %s", ErrInvalidStatement, currency, s.Currency)
+			}
+			s.ClosingBalance, s.ClosingDate = amount, date
+			closing = true
+			last = nil
+		}
+	}
+
+	switch {
+	case s.StatementID == "":
+		return nil, fmt.Errorf("%w: missing field 20", ErrInvalidStatement)
+	case s.Account == "":
+		return nil, fmt.Errorf("%w: missing field 25", ErrInvalidStatement)
+	case !opening:
+		return nil, fmt.Errorf("%w: missing opening balance", ErrInvalidStatement)
+	case !closing:
+		return nil, fmt.Errorf("%w: missing closing balance", ErrInvalidStatement)
+	}
+	return s, nil
+}
+
+func parseMT940Balance(value string) (int64, time.Time, string, error) {
+	m := mt940Balance.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return 0, time.Time{}, "", fmt.Errorf("%w: invalid balance %q", ErrInvalidStatement, value)
+	}
+	date, err := time.Parse(mt940DateLayout, m[2])
+	if err != nil {
+		return 0, time.Time{}, "", fmt.Errorf("%w: invalid date %q", ErrInvalidStatement, m[2])
+	}
+	amount, err := parseAmount(m[4], m[3])
+	if err != nil {
+		return 0, time.Time{}, "", err
+	}
+	if m[1] == "D" {
+		amount = -amount
+	}
+	return amount, date, m[3], nil
+}
+
+func parseMT940Line(value, currency string) (Line, error) {
+	first, supplementary, _ := strings.Cut(value, "\n")
+	m := mt940Line.FindStringSubmatch(first)
+	if m == nil {
+		return Line{}, fmt.Errorf("%w: invalid statement line", ErrInvalidStatement)
+	}
+
+	valueDate, err := time.Parse(mt940DateLayout, m[1])
+	if err != nil {
+		return Line{}, fmt.Errorf("%w: invalid value date %q", ErrInvalidStatement, m[1])
+	}
+	bookingDate := valueDate
+	if m[2] != "" {
+		// The entry date has no year: take the one that puts it nearest
+		// the value date
+		bookingDate, err = time.Parse("20060102", valueDate.Format("2006")+m[2])
+		if err != nil {
+			return Line{}, fmt.Errorf("%w: invalid entry date %q", ErrInvalidStatement, m[2])
+		}
+		switch {
+		case bookingDate.Sub(valueDate) > 183*24*time.Hour:
+			bookingDate = bookingDate.AddDate(-1, 0, 0)
+		case valueDate.Sub(bookingDate) > 183*24*time.Hour:
+			bookingDate = bookingDate.AddDate(1, 0, 0)
+		}
+	}
+
+	amount, err := parseAmount(m[5], currency)
+	if err != nil {
+		return Line{}, err
+	}
+	// A reversal of a credit is a debit and a reversal of a debit a credit
+	if m[3] == "D" || m[3] == "RC" {
+		amount = -amount
+	}
+
+	reference := strings.TrimSpace(m[7])
+	if reference == "NONREF" {
+		reference = ""
+	}
+	line := Line{
+		ValueDate:     valueDate,
+		BookingDate:   bookingDate,
+		Amount:        amount,
+		Reference:     reference,
+		BankReference: strings.TrimSpace(m[8]),
+	}
+	if supplementary = strings.TrimSpace(supplementary); supplementary != "" {
+		line.Description = supplementary
+	}
+	return line, nil
+}
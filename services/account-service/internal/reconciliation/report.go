@@ -0,0 +1,113 @@
+package reconciliation
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Report summarizes a reconciliation run. Amounts are signed minor units.
+type Report struct {
+	RunID     uuid.UUID `json:"run_id"`
+	AccountID uuid.UUID `json:"account_id"`
+	AsOf      string    `json:"as_of"`
+	RunAt     time.Time `json:"run_at"`
+	RunBy     string    `json:"run_by"`
+	Rerun     bool      `json:"rerun"`
+	Currency  string    `json:"currency"`
+
+	// The latest statement closing on or before AsOf against the ledger
+	// balance on its closing date
+	StatementID      string `json:"statement_id"`
+	StatementDate    string `json:"statement_date"`
+	StatementBalance int64  `json:"statement_balance"`
+	LedgerBalance    int64  `json:"ledger_balance"`
+	Difference       int64  `json:"difference"`
+	// UnexplainedDifference is what the open breaks do not account for
+	UnexplainedDifference int64 `json:"unexplained_difference"`
+
+	Rules                 []RuleResult    `json:"rules"`
+	PreviouslyMatched     int             `json:"previously_matched"` // Lines matched before this run and kept
+	UnmatchedLines        []ReportLine    `json:"unmatched_lines"`
+	UnmatchedPostings     []ReportPosting `json:"unmatched_postings"`
+	UnmatchedLineTotal    int64           `json:"unmatched_line_total"`
+	UnmatchedPostingTotal int64           `json:"unmatched_posting_total"`
+}
+
+// RuleResult counts what one rule matched in the run
+type RuleResult struct {
+	Name     string   `json:"name"`
+	Type     RuleType `json:"type"`
+	Matches  int      `json:"matches"`
+	Lines    int      `json:"lines"`
+	Postings int      `json:"postings"`
+}
+
+// ReportLine is a statement line left unmatched
+type ReportLine struct {
+	ID            uuid.UUID `json:"id"`
+	StatementID   string    `json:"statement_id"`
+	ValueDate     string    `json:"value_date"`
+	Amount        int64     `json:"amount"`
+	Reference     string    `json:"reference,omitempty"`
+	BankReference string    `json:"bank_reference,omitempty"`
+	Description   string    `json:"description,omitempty"`
+}
+
+// ReportPosting is a ledger posting left unmatched
+type ReportPosting struct {
+	ID          uuid.UUID `json:"id"`
+	ValueDate   string    `json:"value_date"`
+	Amount      int64     `json:"amount"`
+	Reference   string    `json:"reference,omitempty"`
+	Description string    `json:"description,omitempty"`
+}
+
+// NewRuleResults counts the matches made by each rule, listing every rule
+// in order even when it matched nothing
+func NewRuleResults(rules Rules, matches []Match) []RuleResult {
+	results := make([]RuleResult, len(rules))
+	index := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		results[i] = RuleResult{Name: rule.Name, Type: rule.Type}
+		index[rule.Name] = i
+	}
+	for _, m := range matches {
+		if i, ok := index[m.Rule]; ok {
+			results[i].Matches++
+			results[i].Lines += len(m.Lines)
+			results[i].Postings += len(m.Postings)
+		}
+	}
+	return results
+}
+
+// Totals fills in the unmatched totals and the unexplained difference
+func (r *Report) Totals() {
+	r.UnmatchedLineTotal, r.UnmatchedPostingTotal = 0, 0
+	for _, l := range r.UnmatchedLines {
+		r.UnmatchedLineTotal += l.Amount
+	}
+	for _, p := range r.UnmatchedPostings {
+		r.UnmatchedPostingTotal += p.Amount
+	}
+	r.Difference = r.StatementBalance - r.LedgerBalance
+	r.UnexplainedDifference = r.Difference - (r.UnmatchedLineTotal - r.UnmatchedPostingTotal)
+}
+
+// Render encodes the report as indented JSON
+func (r *Report) Render() ([]byte, error) {
+	if r.UnmatchedLines == nil {
+		r.UnmatchedLines = []ReportLine{}
+	}
+	if r.UnmatchedPostings == nil {
+		r.UnmatchedPostings = []ReportPosting{}
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode reconciliation report: %w", err)
+	}
+	return append(data, '\n'), nil
+}
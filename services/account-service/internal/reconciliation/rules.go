@@ -0,0 +1,113 @@
+package reconciliation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// RuleType is a way of pairing statement lines with postings
+type RuleType string
+
+const (
+	// RuleExactReference pairs a line with a posting of the same amount
+	// whose reference the bank quotes
+	RuleExactReference RuleType = "ExactReference"
+	// RuleAmountDate pairs a line with a posting of the same amount whose
+	// value date is within the window
+	RuleAmountDate RuleType = "AmountDate"
+	// RuleManyToOne pairs one line with several postings, or one posting
+	// with several lines, that sum to it within the window
+	RuleManyToOne RuleType = "ManyToOne"
+)
+
+// IsValid checks if the rule type is supported
+func (t RuleType) IsValid() bool {
+	return t == RuleExactReference || t == RuleAmountDate || t == RuleManyToOne
+}
+
+// MaxGroupSizeLimit bounds how many items a ManyToOne rule may combine; the
+// subset search grows exponentially with it
+const MaxGroupSizeLimit = 10
+
+// Rule is one step of automatic matching
+type Rule struct {
+	Name           string   `json:"name"`
+	Type           RuleType `json:"type"`
+	DateWindowDays int      `json:"date_window_days"` // Allowed value date difference; 0 means same day, or any day for ExactReference
+	MaxGroupSize   int      `json:"max_group_size"`   // ManyToOne only: most items combined on the many side
+}
+
+// Validate checks the rule is complete and consistent
+func (r *Rule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("rule has no name")
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("rule %s: invalid type: %s", r.Name, r.Type)
+	}
+	if r.DateWindowDays < 0 {
+		return fmt.Errorf("rule %s: date_window_days must not be negative", r.Name)
+	}
+	if r.Type == RuleManyToOne {
+		if r.MaxGroupSize < 2 || r.MaxGroupSize > MaxGroupSizeLimit {
+			return fmt.Errorf("rule %s: max_group_size must be between 2 and %d", r.Name, MaxGroupSizeLimit)
+		}
+	} else if r.MaxGroupSize != 0 {
+		return fmt.Errorf("rule %s: max_group_size only applies to %s rules", r.Name, RuleManyToOne)
+	}
+	return nil
+}
+
+// Rules are applied in order; each sees only what earlier rules left unmatched
+type Rules []Rule
+
+// DefaultRules are used when no configuration file is given
+func DefaultRules() Rules {
+	return Rules{
+		{Name: "reference", Type: RuleExactReference},
+		{Name: "amount-date", Type: RuleAmountDate, DateWindowDays: 2},
+		{Name: "batch", Type: RuleManyToOne, DateWindowDays: 1, MaxGroupSize: 5},
+	}
+}
+
+// Validate checks every rule and that rule names are unique
+func (rs Rules) Validate() error {
+	if len(rs) == 0 {
+		return fmt.Errorf("no reconciliation rules")
+	}
+	names := make(map[string]bool, len(rs))
+	for i := range rs {
+		if err := rs[i].Validate(); err != nil {
+			return err
+		}
+		if names[rs[i].Name] {
+			return fmt.Errorf("duplicate rule name %s", rs[i].Name)
+		}
+		names[rs[i].Name] = true
+	}
+	return nil
+}
+
+// LoadRules reads and validates a reconciliation rules file
+func LoadRules(path string) (Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reconciliation config: %w", err)
+	}
+	return ParseRules(data)
+}
+
+// ParseRules parses and validates a reconciliation rules document
+func ParseRules(data []byte) (Rules, error) {
+	var file struct {
+		Rules Rules `json:"rules"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse reconciliation config: %w", err)
+	}
+	if err := file.Rules.Validate(); err != nil {
+		return nil, err
+	}
+	return file.Rules, nil
+}
@@ -0,0 +1,160 @@
+// Package reconciliation matches the statements correspondent banks send for
+// our nostro and settlement accounts against the postings on the accounts
+// that mirror them in our ledger. It parses SWIFT MT940 and ISO 20022
+// camt.053 statements, applies an ordered list of matching rules, and
+// renders a report of each run. Matching is deterministic: the same lines,
+// postings and rules always produce the same matches.
+//
+// Amounts are signed minor units from our side of the account: a credit on
+// the bank's statement, money arriving in the nostro, is positive and
+// matches a positive posting on the mirror account.
+package reconciliation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/statement"
+)
+
+// Format is an external statement format
+type Format string
+
+const (
+	FormatMT940   Format = "MT940"
+	FormatCAMT053 Format = "CAMT053"
+)
+
+// IsValid checks if the format is supported
+func (f Format) IsValid() bool {
+	return f == FormatMT940 || f == FormatCAMT053
+}
+
+// ErrInvalidStatement is wrapped by every parse and validation error
+var ErrInvalidStatement = errors.New("invalid bank statement")
+
+// Statement is one statement of an account from a correspondent bank
+type Statement struct {
+	Format         Format
+	StatementID    string // MT940 :20: or camt.053 Stmt/Id
+	SequenceNumber string // MT940 :28C: or camt.053 ElctrncSeqNb
+	Account        string // The account as the bank identifies it: IBAN or account number
+	Currency       string
+	OpeningDate    time.Time
+	OpeningBalance int64
+	ClosingDate    time.Time
+	ClosingBalance int64
+	Lines          []Line
+}
+
+// Line is one booked entry on a statement
+type Line struct {
+	ValueDate     time.Time
+	BookingDate   time.Time
+	Amount        int64  // Signed: credits positive, debits negative
+	Reference     string // Our reference as the bank quotes it: the MT940 customer reference or camt.053 EndToEndId
+	BankReference string // The bank's own reference
+	Description   string
+}
+
+// ExternalID identifies a statement among those received for an account
+func (s *Statement) ExternalID() string {
+	if s.SequenceNumber == "" {
+		return s.StatementID
+	}
+	return s.StatementID + "/" + s.SequenceNumber
+}
+
+// Validate checks the lines account for the movement between the opening
+// and closing balances
+func (s *Statement) Validate() error {
+	sum := s.OpeningBalance
+	for _, l := range s.Lines {
+		sum += l.Amount
+	}
+	if sum != s.ClosingBalance {
+		return fmt.Errorf("%w: statement %s: opening balance %s plus entries is %s, closing balance is %s", ErrInvalidStatement, s.ExternalID(),
+			statement.FormatAmount(s.OpeningBalance, s.Currency), statement.FormatAmount(sum, s.Currency), statement.FormatAmount(s.ClosingBalance, s.Currency))
+	}
+	if s.ClosingDate.Before(s.OpeningDate) {
+		return fmt.Errorf("%w: statement %s closes before it opens", ErrInvalidStatement, s.ExternalID())
+	}
+	return nil
+}
+
+// DetectFormat guesses the format of a statement file: XML is camt.053,
+// anything else MT940
+func DetectFormat(data []byte) Format {
+	if bytes.HasPrefix(bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))), []byte("<")) {
+		return FormatCAMT053
+	}
+	return FormatMT940
+}
+
+// Parse reads every statement in a file and validates each one. An empty
+// format is detected from the content.
+func Parse(format Format, data []byte) ([]*Statement, error) {
+	if format == "" {
+		format = DetectFormat(data)
+	}
+
+	var statements []*Statement
+	var err error
+	switch format {
+	case FormatMT940:
+		statements, err = ParseMT940(data)
+	case FormatCAMT053:
+		statements, err = ParseCAMT053(data)
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalidStatement, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("%w: no statements found", ErrInvalidStatement)
+	}
+	for _, s := range statements {
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return statements, nil
+}
+
+// parseAmount converts an unsigned decimal amount, with a point or the
+// comma SWIFT uses, to minor units of the currency
+func parseAmount(s, currency string) (int64, error) {
+	s = strings.TrimSpace(strings.Replace(s, ",", ".", 1))
+	whole, frac, _ := strings.Cut(s, ".")
+	exp := statement.CurrencyExponent(currency)
+	if whole == "" || !isDigits(whole) || (frac != "" && !isDigits(frac)) {
+		return 0, fmt.Errorf("%w: invalid amount %q", ErrInvalidStatement, s)
+	}
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > exp {
+		return 0, fmt.Errorf("%w: amount %q has more decimals than %s allows", ErrInvalidStatement, s, currency)
+	}
+	digits := strings.TrimLeft(whole+frac+strings.Repeat("0", exp-len(frac)), "0")
+	if digits == "" {
+		return 0, nil
+	}
+	amount, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: amount %q out of range", ErrInvalidStatement, s)
+	}
+	return amount, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,196 @@
+package reconciliation
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/core-banking/services/account-service/internal/statement"
+	"github.com/google/uuid"
+)
+
+func mustDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t.Fatalf("bad date %q: %v", s, err)
+	}
+	return d
+}
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestParse_MT940(t *testing.T) {
+	statements, err := Parse("", readTestdata(t, "nostro.mt940"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("got %d statements, want 1", len(statements))
+	}
+	s := statements[0]
+	if s.Format != FormatMT940 || s.ExternalID() != "STMT240401/91/1" || s.Account != "DE89370400440532013000" || s.Currency != "EUR" {
+		t.Errorf("header = %+v", s)
+	}
+	if s.OpeningBalance != 100000 || s.ClosingBalance != 341050 {
+		t.Errorf("balances = %d, %d; want 100000, 341050", s.OpeningBalance, s.ClosingBalance)
+	}
+	if !s.OpeningDate.Equal(mustDate(t, "2024-03-31")) || !s.ClosingDate.Equal(mustDate(t, "2024-04-02")) {
+		t.Errorf("dates = %s, %s", s.OpeningDate, s.ClosingDate)
+	}
+
+	want := []Line{
+		{ValueDate: mustDate(t, "2024-04-01"), BookingDate: mustDate(t, "2024-04-01"), Amount: 250050,
+			Reference: "PAY-0001", BankReference: "BNP1001", Description: "Invoice 4711 settlement"},
+		{ValueDate: mustDate(t, "2024-04-01"), BookingDate: mustDate(t, "2024-04-01"), Amount: -12000,
+			BankReference: "BNP1002", Description: "Account maintenance"},
+		// A reversed debit is money coming back; the entry date is before the value date
+		{ValueDate: mustDate(t, "2024-04-02"), BookingDate: mustDate(t, "2024-04-01"), Amount: 3000,
+			Reference: "PAY-0002", BankReference: "BNP1003"},
+	}
+	if len(s.Lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(s.Lines), len(want))
+	}
+	for i := range want {
+		if s.Lines[i] != want[i] {
+			t.Errorf("line %d = %+v, want %+v", i, s.Lines[i], want[i])
+		}
+	}
+}
+
+func TestParse_MT940_BareMessages(t *testing.T) {
+	data := strings.Join([]string{
+		":20:A", ":25:12345", ":28C:1", ":60F:C240101JPY100,", ":61:240102D40,NMSCREF-1", ":62F:C240102JPY60,", "-",
+		":20:B", ":25:12345", ":28C:2", ":60M:C240102JPY60,", ":62M:C240103JPY60,", "-",
+	}, "\r\n")
+
+	statements, err := Parse(FormatMT940, []byte(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(statements) != 2 {
+		t.Fatalf("got %d statements, want 2", len(statements))
+	}
+	if statements[0].Lines[0].Amount != -40 || statements[0].Lines[0].Reference != "REF-1" {
+		t.Errorf("JPY line = %+v, want -40 with reference REF-1", statements[0].Lines[0])
+	}
+	if statements[1].ExternalID() != "B/2" || len(statements[1].Lines) != 0 {
+		t.Errorf("second statement = %+v", statements[1])
+	}
+}
+
+func TestParse_CAMT053(t *testing.T) {
+	data := readTestdata(t, "nostro.xml")
+	if DetectFormat(data) != FormatCAMT053 {
+		t.Fatalf("DetectFormat() = %s, want %s", DetectFormat(data), FormatCAMT053)
+	}
+	statements, err := Parse("", data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	s := statements[0]
+	if s.ExternalID() != "STMT-240402/92" || s.Account != "00012345" || s.Currency != "USD" {
+		t.Errorf("header = %+v", s)
+	}
+	if s.OpeningBalance != -5000 || s.ClosingBalance != 94975 {
+		t.Errorf("balances = %d, %d; want -5000, 94975", s.OpeningBalance, s.ClosingBalance)
+	}
+
+	// The pending entry is left out
+	want := []Line{
+		{ValueDate: mustDate(t, "2024-04-02"), BookingDate: mustDate(t, "2024-04-02"), Amount: 100000,
+			Reference: "E2E-42", BankReference: "CITI-9001", Description: "Invoice 42"},
+		{ValueDate: mustDate(t, "2024-04-02"), BookingDate: mustDate(t, "2024-04-02"), Amount: -25,
+			BankReference: "CITI-9002", Description: "Reversal of interest credit"},
+	}
+	if len(s.Lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(s.Lines), len(want))
+	}
+	for i := range want {
+		if s.Lines[i] != want[i] {
+			t.Errorf("line %d = %+v, want %+v", i, s.Lines[i], want[i])
+		}
+	}
+}
+
+func TestParse_CAMT053_RenderedStatement(t *testing.T) {
+	account := &models.Account{
+		ID:            uuid.MustParse("7b0f7a55-4d3e-4a8f-9a43-000000000001"),
+		AccountNumber: "ACC0000000001",
+		AccountType:   models.AccountTypeChecking,
+		Currency:      "EUR",
+	}
+	posting := &models.Posting{
+		ID:          uuid.MustParse("7b0f7a55-4d3e-4a8f-9a43-000000000101"),
+		AccountID:   account.ID,
+		PostingType: models.PostingTypeDebit,
+		Amount:      -120000,
+		Currency:    "EUR",
+		Reference:   "RENT-03",
+		ValueDate:   mustDate(t, "2024-03-15"),
+		BookedAt:    mustDate(t, "2024-03-15").Add(12 * time.Hour),
+	}
+	built, err := statement.Build(statement.Params{
+		ID:             uuid.MustParse("7b0f7a55-4d3e-4a8f-9a43-000000000099"),
+		Version:        1,
+		Account:        account,
+		PeriodStart:    mustDate(t, "2024-03-01"),
+		PeriodEnd:      mustDate(t, "2024-03-31"),
+		OpeningBalance: 200000,
+		Postings:       []*models.Posting{posting},
+		GeneratedAt:    time.Date(2024, 4, 1, 2, 30, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := statement.RenderCAMT053(built)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statements, err := Parse(FormatCAMT053, data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	s := statements[0]
+	if s.Account != "ACC0000000001" || s.OpeningBalance != 200000 || s.ClosingBalance != 80000 {
+		t.Errorf("statement = %+v", s)
+	}
+	if len(s.Lines) != 1 || s.Lines[0].Amount != -120000 || s.Lines[0].Reference != "RENT-03" || !s.Lines[0].ValueDate.Equal(posting.ValueDate) {
+		t.Errorf("lines = %+v", s.Lines)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		data   string
+	}{
+		{"unbalanced", FormatMT940, ":20:A\n:25:1\n:60F:C240101EUR1,00\n:61:240101C1,00NTRFX\n:62F:C240101EUR1,00\n"},
+		{"missing closing balance", FormatMT940, ":20:A\n:25:1\n:60F:C240101EUR1,00\n"},
+		{"bad line", FormatMT940, ":20:A\n:25:1\n:60F:C240101EUR1,00\n:61:24010C1,00NTRFX\n:62F:C240101EUR2,00\n"},
+		{"too many decimals", FormatMT940, ":20:A\n:25:1\n:60F:C240101JPY1,5\n:62F:C240101JPY1,5\n"},
+		{"empty", FormatMT940, "\n"},
+		{"wrong namespace", FormatCAMT053, `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"/>`},
+		{"not xml", FormatCAMT053, "<Document>"},
+		{"unsupported format", Format("BAI2"), "01,"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.format, []byte(tt.data)); !errors.Is(err, ErrInvalidStatement) {
+				t.Errorf("Parse() error = %v, want ErrInvalidStatement", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned when a record is not found
+var ErrNotFound = errors.New("record not found")
+
+// ErrDuplicate is returned when a bank statement with the same external ID
+// has already been imported for the account
+var ErrDuplicate = errors.New("duplicate record")
+
+// ErrConflict is returned when a conditional update finds the record no
+// longer in the state it must be in, because a concurrent transaction
+// changed it first
+var ErrConflict = errors.New("record changed concurrently")
+
+// ErrOptimisticLock is returned when a concurrent update is detected
+type ErrOptimisticLock struct {
+	AccountID uuid.UUID
+}
+
+func (e *ErrOptimisticLock) Error() string {
+	return "optimistic lock error"
+}
+
+// AccountRepository defines the interface for account data operations
+type AccountRepository interface {
+	// Account operations
+	CreateAccount(ctx context.Context, account *models.Account) error
+	GetAccountByID(ctx context.Context, id uuid.UUID) (*models.Account, error)
+	GetAccountByNumber(ctx context.Context, accountNumber string) (*models.Account, error)
+	// GetAccountForUpdate loads the account and locks its row until the
+	// surrounding transaction ends. Outside a transaction it behaves like
+	// GetAccountByID.
+	GetAccountForUpdate(ctx context.Context, id uuid.UUID) (*models.Account, error)
+	UpdateAccount(ctx context.Context, account *models.Account) error
+	// ListAccountsByCustomer returns every account on which the customer has
+	// ever held any party role
+	ListAccountsByCustomer(ctx context.Context, customerID uuid.UUID) ([]*models.Account, error)
+	// ListOpenAccountsByType returns every account of the given type that is not closed
+	ListOpenAccountsByType(ctx context.Context, accountType models.AccountType) ([]*models.Account, error)
+	// NextAccountNumberSequence allocates the next account number sequence
+	// value. Allocations are never rolled back, so numbers may have gaps.
+	NextAccountNumberSequence(ctx context.Context) (int64, error)
+
+	// Party operations
+	CreateParty(ctx context.Context, party *models.AccountParty) error
+	GetPartyByID(ctx context.Context, id uuid.UUID) (*models.AccountParty, error)
+	UpdateParty(ctx context.Context, party *models.AccountParty) error
+	// ListParties returns every party, current or past, on an account
+	ListParties(ctx context.Context, accountID uuid.UUID) ([]*models.AccountParty, error)
+	// ListPartiesByCustomer returns every party role, current or past, held by a customer
+	ListPartiesByCustomer(ctx context.Context, customerID uuid.UUID) ([]*models.AccountParty, error)
+
+	// Ledger operations
+	// AddPosting appends a posting and applies its amount to the account's ledger balance
+	AddPosting(ctx context.Context, posting *models.Posting) error
+	ListPostings(ctx context.Context, accountID uuid.UUID, from, to time.Time) ([]*models.Posting, error)
+	// GetBalanceAsOf returns the sum of postings value-dated on or before the given date
+	GetBalanceAsOf(ctx context.Context, accountID uuid.UUID, valueDate time.Time) (int64, error)
+	// SumPostingsBookedBefore returns the sum of postings booked before the given time
+	SumPostingsBookedBefore(ctx context.Context, accountID uuid.UUID, before time.Time) (int64, error)
+	// EarliestValueDateBookedSince returns the earliest value date among postings
+	// booked at or after the given time, or nil if there are none
+	EarliestValueDateBookedSince(ctx context.Context, accountID uuid.UUID, since time.Time) (*time.Time, error)
+	// GetPostings returns the given postings
+	GetPostings(ctx context.Context, ids []uuid.UUID) ([]*models.Posting, error)
+
+	// Transfer key operations
+	// CreateTransferKey records the transfer made under an idempotency key,
+	// returning ErrDuplicate if the key has already been used
+	CreateTransferKey(ctx context.Context, key *models.TransferKey) error
+	GetTransferKey(ctx context.Context, key string) (*models.TransferKey, error)
+
+	// Interest operations
+	// UpsertAccrual creates or replaces the accrual for the account and day
+	UpsertAccrual(ctx context.Context, accrual *models.InterestAccrual) error
+	// GetLatestAccrual returns the accrual with the latest date for the account
+	GetLatestAccrual(ctx context.Context, accountID uuid.UUID) (*models.InterestAccrual, error)
+	ListAccruals(ctx context.Context, accountID uuid.UUID, from, to time.Time) ([]*models.InterestAccrual, error)
+	// SumAccruals returns the total credit and debit interest accrued, in
+	// micro-units, on or before the given date
+	SumAccruals(ctx context.Context, accountID uuid.UUID, through time.Time) (credit, debit int64, err error)
+	CreateCapitalization(ctx context.Context, capitalization *models.InterestCapitalization) error
+	GetCapitalization(ctx context.Context, accountID uuid.UUID, periodEnd time.Time) (*models.InterestCapitalization, error)
+	// SumCapitalizations returns the total credit and debit interest ever
+	// capitalized onto the account
+	SumCapitalizations(ctx context.Context, accountID uuid.UUID) (credit, debit int64, err error)
+
+	// Fee operations
+	CreateFeeRule(ctx context.Context, rule *models.FeeRule) error
+	// GetFeeRule returns the latest rule effective at the given time
+	GetFeeRule(ctx context.Context, accountType models.AccountType, feeType models.FeeType, currency string, at time.Time) (*models.FeeRule, error)
+	// ListFeeRules returns the full rule history for an account type, or for
+	// every account type when accountType is empty
+	ListFeeRules(ctx context.Context, accountType models.AccountType) ([]*models.FeeRule, error)
+	CreateFee(ctx context.Context, fee *models.Fee) error
+	GetFeeByID(ctx context.Context, id uuid.UUID) (*models.Fee, error)
+	GetFeeByReference(ctx context.Context, accountID uuid.UUID, feeType models.FeeType, reference string) (*models.Fee, error)
+	// UpdateFee saves the reversal of a charged fee, returning ErrConflict
+	// when it is no longer charged
+	UpdateFee(ctx context.Context, fee *models.Fee) error
+	ListFees(ctx context.Context, accountID uuid.UUID) ([]*models.Fee, error)
+
+	// Hold operations
+	CreateHold(ctx context.Context, hold *models.Hold) error
+	GetHoldByID(ctx context.Context, id uuid.UUID) (*models.Hold, error)
+	// UpdateHold saves a change to an active hold, returning ErrConflict
+	// when it is no longer active
+	UpdateHold(ctx context.Context, hold *models.Hold) error
+	ListHolds(ctx context.Context, accountID uuid.UUID, activeOnly bool) ([]*models.Hold, error)
+	// SumActiveHolds returns the total remaining amount of all active holds on an account
+	SumActiveHolds(ctx context.Context, accountID uuid.UUID) (int64, error)
+	// ListExpiredHolds returns active holds whose expiry is at or before the
+	// given time, earliest first. Legal holds on frozen accounts are left out:
+	// they are never expired, and would otherwise fill every batch.
+	ListExpiredHolds(ctx context.Context, now time.Time, limit int) ([]*models.Hold, error)
+
+	// Statement operations
+	// CreateStatement stores a statement together with its rendered documents
+	CreateStatement(ctx context.Context, statement *models.Statement, documents []*models.StatementDocument) error
+	GetStatement(ctx context.Context, id uuid.UUID) (*models.Statement, error)
+	// GetLatestStatement returns the highest version of the statement for the account and period
+	GetLatestStatement(ctx context.Context, accountID uuid.UUID, periodStart, periodEnd time.Time) (*models.Statement, error)
+	// ListStatements returns every version of every statement for the account, newest period first
+	ListStatements(ctx context.Context, accountID uuid.UUID) ([]*models.Statement, error)
+	GetStatementDocument(ctx context.Context, statementID uuid.UUID, format models.StatementFormat) (*models.StatementDocument, error)
+	// ListStatementDocuments returns the formats and hashes of a statement's
+	// documents without their content
+	ListStatementDocuments(ctx context.Context, statementID uuid.UUID) ([]*models.StatementDocument, error)
+
+	// Reconciliation operations
+	// CreateBankStatement stores an imported statement with its lines,
+	// returning ErrDuplicate if the account already has one with the same
+	// external ID
+	CreateBankStatement(ctx context.Context, statement *models.BankStatement, lines []*models.BankStatementLine) error
+	// ListBankStatements returns the account's imported statements, oldest closing date first
+	ListBankStatements(ctx context.Context, accountID uuid.UUID) ([]*models.BankStatement, error)
+	// ListBankStatementAccounts returns every account with an imported statement
+	ListBankStatementAccounts(ctx context.Context) ([]uuid.UUID, error)
+	// ListBankStatementLines returns the account's statement lines value-dated
+	// on or before the given date, of any status when status is empty
+	ListBankStatementLines(ctx context.Context, accountID uuid.UUID, status models.ReconciliationStatus, through time.Time) ([]*models.BankStatementLine, error)
+	GetBankStatementLines(ctx context.Context, ids []uuid.UUID) ([]*models.BankStatementLine, error)
+	// ListUnreconciledPostings returns the account's postings value-dated in
+	// [from, through] that belong to no reconciliation match
+	ListUnreconciledPostings(ctx context.Context, accountID uuid.UUID, from, through time.Time) ([]*models.Posting, error)
+	// GetUnreconciledPostings returns those of the given postings that belong
+	// to no reconciliation match
+	GetUnreconciledPostings(ctx context.Context, ids []uuid.UUID) ([]*models.Posting, error)
+	// CreateReconciliationMatch stores a match and marks its lines, returning
+	// models.ErrAlreadyReconciled if any line or posting is already matched
+	CreateReconciliationMatch(ctx context.Context, match *models.ReconciliationMatch) error
+	// DeleteAutoMatches undoes the rule-made matches on the account whose
+	// statement lines are all value-dated, and on statements closing, on or
+	// before through, and returns how many there were
+	DeleteAutoMatches(ctx context.Context, accountID uuid.UUID, through time.Time) (int, error)
+	CreateReconciliationRun(ctx context.Context, run *models.ReconciliationRun) error
+	GetReconciliationRun(ctx context.Context, id uuid.UUID) (*models.ReconciliationRun, error)
+
+	// FX operations
+	// CreateFXRates adds entries to the rate table, skipping any identical to
+	// an existing entry, and returns how many were added
+	CreateFXRates(ctx context.Context, rates []*models.FXRate) (int, error)
+	// GetFXRate returns the latest rate for the pair effective at the given
+	// time, in the direction given
+	GetFXRate(ctx context.Context, baseCurrency, quoteCurrency string, at time.Time) (*models.FXRate, error)
+	// ListFXRates returns the rate history of the pair in the direction given, latest effective first
+	ListFXRates(ctx context.Context, baseCurrency, quoteCurrency string) ([]*models.FXRate, error)
+	// SetFXPositionAccount assigns the account holding the bank's position in
+	// one currency of a pair, replacing any earlier assignment
+	SetFXPositionAccount(ctx context.Context, position *models.FXPositionAccount) error
+	GetFXPositionAccount(ctx context.Context, currencyPair, currency string) (*models.FXPositionAccount, error)
+	// IsFXPositionAccount reports whether the account holds the bank's
+	// position in a currency of any pair
+	IsFXPositionAccount(ctx context.Context, accountID uuid.UUID) (bool, error)
+	CreateFXQuote(ctx context.Context, quote *models.FXQuote) error
+	GetFXQuote(ctx context.Context, id uuid.UUID) (*models.FXQuote, error)
+	GetFXQuoteForUpdate(ctx context.Context, id uuid.UUID) (*models.FXQuote, error)
+	UpdateFXQuote(ctx context.Context, quote *models.FXQuote) error
+
+	// Limit operations
+	CreateLimitRule(ctx context.Context, rule *models.LimitRule) error
+	GetLimitRule(ctx context.Context, id uuid.UUID) (*models.LimitRule, error)
+	// ListLimitRules returns the full history of limit rules
+	ListLimitRules(ctx context.Context) ([]*models.LimitRule, error)
+	// ListEffectiveLimitRules returns, for each scope with rules in the
+	// currency, the latest rule effective at the given time
+	ListEffectiveLimitRules(ctx context.Context, currency string, at time.Time) ([]*models.LimitRule, error)
+	GetCustomerRiskTier(ctx context.Context, customerID uuid.UUID) (*models.CustomerRiskTier, error)
+	SetCustomerRiskTier(ctx context.Context, tier *models.CustomerRiskTier) error
+	CreateLimitIncrease(ctx context.Context, increase *models.LimitIncrease) error
+	GetLimitIncrease(ctx context.Context, id uuid.UUID) (*models.LimitIncrease, error)
+	// ListActiveLimitIncreases returns the customer's unrevoked increases in
+	// force at the given time
+	ListActiveLimitIncreases(ctx context.Context, customerID uuid.UUID, at time.Time) ([]*models.LimitIncrease, error)
+	RevokeLimitIncrease(ctx context.Context, increase *models.LimitIncrease) error
+	// LockCustomerLimits serializes limit checks for a customer until the
+	// end of the transaction, so concurrent transactions from any of the
+	// customer's accounts cannot together exceed a limit
+	LockCustomerLimits(ctx context.Context, customerID uuid.UUID) error
+	// SumLimitUsage returns the total amount and number of the usages the
+	// filter selects with From <= occurred_at < To
+	SumLimitUsage(ctx context.Context, filter models.LimitUsageFilter) (int64, int64, error)
+	RecordLimitUsage(ctx context.Context, usage *models.LimitUsage) error
+
+	// AML monitoring operations
+	// RecordTransactionEvent adds an event and assigns its sequence
+	RecordTransactionEvent(ctx context.Context, event *models.TransactionEvent) error
+	GetTransactionEvents(ctx context.Context, ids []uuid.UUID) ([]*models.TransactionEvent, error)
+	// ListUnmonitoredTransactionEvents returns up to limit events the monitor
+	// has not seen, in sequence order. Within a transaction they are locked,
+	// and events locked by another monitor are skipped.
+	ListUnmonitoredTransactionEvents(ctx context.Context, limit int) ([]*models.TransactionEvent, error)
+	// ListCustomerTransactionEvents returns the customer's events that
+	// occurred at or after from and were posted before the given sequence
+	ListCustomerTransactionEvents(ctx context.Context, customerID uuid.UUID, from time.Time, beforeSequence int64) ([]*models.TransactionEvent, error)
+	// ListTransactionEvents returns all events that occurred in [from, to),
+	// in sequence order
+	ListTransactionEvents(ctx context.Context, from, to time.Time) ([]*models.TransactionEvent, error)
+	MarkTransactionEventMonitored(ctx context.Context, id uuid.UUID, at time.Time) error
+	// CreateAMLAlert adds an alert with its evidence
+	CreateAMLAlert(ctx context.Context, alert *models.AMLAlert) error
+	GetAMLAlert(ctx context.Context, id uuid.UUID) (*models.AMLAlert, error)
+	ListAMLAlerts(ctx context.Context, filter models.AMLAlertFilter) ([]*models.AMLAlert, error)
+	UpdateAMLAlert(ctx context.Context, alert *models.AMLAlert) error
+	// LastAMLAlertTimes returns when each rule last raised an alert for the
+	// customer, keyed by rule ID
+	LastAMLAlertTimes(ctx context.Context, customerID uuid.UUID) (map[string]time.Time, error)
+
+	// Customer event operations
+	// LockCustomerEventOffset returns the sequence number of the last
+	// customer event applied and locks it until the surrounding transaction
+	// ends
+	LockCustomerEventOffset(ctx context.Context) (int64, error)
+	SetCustomerEventOffset(ctx context.Context, sequence int64) error
+
+	// Transaction management
+	BeginTx(ctx context.Context) (Tx, error)
+}
+
+// Tx represents a database transaction
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+	AccountRepository() AccountRepository
+}
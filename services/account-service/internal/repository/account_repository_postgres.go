@@ -0,0 +1,1494 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// DBQuerier is an interface for database operations
+type DBQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// pgAccountRepository implements AccountRepository for PostgreSQL. The same
+// implementation serves both plain connections and transactions since it
+// only depends on DBQuerier.
+type pgAccountRepository struct {
+	db DBQuerier
+}
+
+// NewAccountRepository creates a new PostgreSQL account repository
+func NewAccountRepository(db *sql.DB) AccountRepository {
+	return &pgAccountRepository{db: db}
+}
+
+const accountColumns = `
+	id, account_number, iban, customer_id, account_type, currency,
+	ledger_balance, overdraft_limit, overdraft_rate, segment,
+	status, opened_at, closed_at, created_at, updated_at, version`
+
+func scanAccount(row rowScanner) (*models.Account, error) {
+	account := &models.Account{}
+	var iban sql.NullString
+	var closedAt sql.NullTime
+
+	err := row.Scan(
+		&account.ID,
+		&account.AccountNumber,
+		&iban,
+		&account.CustomerID,
+		&account.AccountType,
+		&account.Currency,
+		&account.LedgerBalance,
+		&account.OverdraftLimit,
+		&account.OverdraftRate,
+		&account.Segment,
+		&account.Status,
+		&account.OpenedAt,
+		&closedAt,
+		&account.CreatedAt,
+		&account.UpdatedAt,
+		&account.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	account.IBAN = iban.String
+	if closedAt.Valid {
+		closedAtTime := closedAt.Time
+		account.ClosedAt = &closedAtTime
+	}
+
+	return account, nil
+}
+
+// Account operations
+
+func (r *pgAccountRepository) CreateAccount(ctx context.Context, account *models.Account) error {
+	if account.ID == uuid.Nil {
+		account.ID = uuid.New()
+	}
+	now := time.Now().UTC()
+	if account.OpenedAt.IsZero() {
+		account.OpenedAt = now
+	}
+	account.CreatedAt = now
+	account.UpdatedAt = now
+	account.Version = 1
+
+	query := `
+		INSERT INTO accounts (` + accountColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		account.ID,
+		account.AccountNumber,
+		sql.NullString{String: account.IBAN, Valid: account.IBAN != ""},
+		account.CustomerID,
+		account.AccountType,
+		account.Currency,
+		account.LedgerBalance,
+		account.OverdraftLimit,
+		account.OverdraftRate,
+		account.Segment,
+		account.Status,
+		account.OpenedAt,
+		account.ClosedAt,
+		account.CreatedAt,
+		account.UpdatedAt,
+		account.Version,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create account: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetAccountByID(ctx context.Context, id uuid.UUID) (*models.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = $1`
+
+	account, err := scanAccount(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return account, nil
+}
+
+func (r *pgAccountRepository) GetAccountByNumber(ctx context.Context, accountNumber string) (*models.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE account_number = $1`
+
+	account, err := scanAccount(r.db.QueryRowContext(ctx, query, accountNumber))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return account, nil
+}
+
+func (r *pgAccountRepository) GetAccountForUpdate(ctx context.Context, id uuid.UUID) (*models.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = $1 FOR UPDATE`
+
+	account, err := scanAccount(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock account: %w", err)
+	}
+
+	return account, nil
+}
+
+func (r *pgAccountRepository) UpdateAccount(ctx context.Context, account *models.Account) error {
+	account.UpdatedAt = time.Now().UTC()
+	account.Version++
+
+	query := `
+		UPDATE accounts SET
+			account_type = $2,
+			status = $3,
+			closed_at = $4,
+			updated_at = $5,
+			version = $6,
+			overdraft_limit = $8,
+			overdraft_rate = $9,
+			segment = $10,
+			customer_id = $11
+		WHERE id = $1 AND version = $7
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		account.ID,
+		account.AccountType,
+		account.Status,
+		account.ClosedAt,
+		account.UpdatedAt,
+		account.Version,
+		account.Version-1,
+		account.OverdraftLimit,
+		account.OverdraftRate,
+		account.Segment,
+		account.CustomerID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return &ErrOptimisticLock{AccountID: account.ID}
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) ListAccountsByCustomer(ctx context.Context, customerID uuid.UUID) ([]*models.Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM accounts
+		WHERE id IN (SELECT account_id FROM account_parties WHERE customer_id = $1)
+		ORDER BY opened_at
+	`
+	return r.queryAccounts(ctx, query, customerID)
+}
+
+func (r *pgAccountRepository) ListOpenAccountsByType(ctx context.Context, accountType models.AccountType) ([]*models.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE account_type = $1 AND status <> 'Closed' ORDER BY id`
+	return r.queryAccounts(ctx, query, accountType)
+}
+
+func (r *pgAccountRepository) NextAccountNumberSequence(ctx context.Context) (int64, error) {
+	var seq int64
+	if err := r.db.QueryRowContext(ctx, `SELECT nextval('account_number_seq')`).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("failed to allocate account number: %w", err)
+	}
+	return seq, nil
+}
+
+func (r *pgAccountRepository) queryAccounts(ctx context.Context, query string, args ...interface{}) ([]*models.Account, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []*models.Account
+	for rows.Next() {
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating accounts: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// Party operations
+
+const partyColumns = `
+	id, account_id, customer_id, role, signing_rule, start_date, end_date,
+	end_reason, created_at, updated_at, created_by`
+
+func scanParty(row rowScanner) (*models.AccountParty, error) {
+	party := &models.AccountParty{}
+	var endDate sql.NullTime
+
+	err := row.Scan(
+		&party.ID,
+		&party.AccountID,
+		&party.CustomerID,
+		&party.Role,
+		&party.SigningRule,
+		&party.StartDate,
+		&endDate,
+		&party.EndReason,
+		&party.CreatedAt,
+		&party.UpdatedAt,
+		&party.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if endDate.Valid {
+		endDateTime := endDate.Time
+		party.EndDate = &endDateTime
+	}
+
+	return party, nil
+}
+
+func (r *pgAccountRepository) CreateParty(ctx context.Context, party *models.AccountParty) error {
+	if party.ID == uuid.Nil {
+		party.ID = uuid.New()
+	}
+	now := time.Now().UTC()
+	party.CreatedAt = now
+	party.UpdatedAt = now
+
+	query := `
+		INSERT INTO account_parties (` + partyColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		party.ID,
+		party.AccountID,
+		party.CustomerID,
+		party.Role,
+		party.SigningRule,
+		party.StartDate,
+		party.EndDate,
+		party.EndReason,
+		party.CreatedAt,
+		party.UpdatedAt,
+		party.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create account party: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetPartyByID(ctx context.Context, id uuid.UUID) (*models.AccountParty, error) {
+	query := `SELECT ` + partyColumns + ` FROM account_parties WHERE id = $1`
+
+	party, err := scanParty(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account party: %w", err)
+	}
+
+	return party, nil
+}
+
+func (r *pgAccountRepository) UpdateParty(ctx context.Context, party *models.AccountParty) error {
+	party.UpdatedAt = time.Now().UTC()
+
+	query := `
+		UPDATE account_parties SET
+			end_date = $2,
+			end_reason = $3,
+			updated_at = $4
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		party.ID,
+		party.EndDate,
+		party.EndReason,
+		party.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update account party: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) ListParties(ctx context.Context, accountID uuid.UUID) ([]*models.AccountParty, error) {
+	query := `SELECT ` + partyColumns + ` FROM account_parties WHERE account_id = $1 ORDER BY start_date, created_at`
+	return r.queryParties(ctx, query, accountID)
+}
+
+func (r *pgAccountRepository) ListPartiesByCustomer(ctx context.Context, customerID uuid.UUID) ([]*models.AccountParty, error) {
+	query := `SELECT ` + partyColumns + ` FROM account_parties WHERE customer_id = $1 ORDER BY start_date, created_at`
+	return r.queryParties(ctx, query, customerID)
+}
+
+func (r *pgAccountRepository) queryParties(ctx context.Context, query string, args ...interface{}) ([]*models.AccountParty, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account parties: %w", err)
+	}
+	defer rows.Close()
+
+	var parties []*models.AccountParty
+	for rows.Next() {
+		party, err := scanParty(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account party: %w", err)
+		}
+		parties = append(parties, party)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating account parties: %w", err)
+	}
+
+	return parties, nil
+}
+
+// Ledger operations
+
+func (r *pgAccountRepository) AddPosting(ctx context.Context, posting *models.Posting) error {
+	if posting.ID == uuid.Nil {
+		posting.ID = uuid.New()
+	}
+	posting.BookedAt = time.Now().UTC()
+	if posting.ValueDate.IsZero() {
+		posting.ValueDate = posting.BookedAt
+	}
+
+	query := `
+		INSERT INTO postings (
+			id, account_id, posting_type, amount, currency,
+			reference, description, hold_id, value_date, booked_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		posting.ID,
+		posting.AccountID,
+		posting.PostingType,
+		posting.Amount,
+		posting.Currency,
+		posting.Reference,
+		posting.Description,
+		posting.HoldID,
+		posting.ValueDate,
+		posting.BookedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add posting: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE accounts SET ledger_balance = ledger_balance + $2 WHERE id = $1`,
+		posting.AccountID, posting.Amount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to apply posting to balance: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) ListPostings(ctx context.Context, accountID uuid.UUID, from, to time.Time) ([]*models.Posting, error) {
+	query := `
+		SELECT id, account_id, posting_type, amount, currency,
+			reference, description, hold_id, value_date, booked_at
+		FROM postings
+		WHERE account_id = $1 AND booked_at >= $2 AND booked_at < $3
+		ORDER BY booked_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, accountID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list postings: %w", err)
+	}
+	defer rows.Close()
+
+	var postings []*models.Posting
+	for rows.Next() {
+		posting := &models.Posting{}
+		var holdID uuid.NullUUID
+
+		err := rows.Scan(
+			&posting.ID,
+			&posting.AccountID,
+			&posting.PostingType,
+			&posting.Amount,
+			&posting.Currency,
+			&posting.Reference,
+			&posting.Description,
+			&holdID,
+			&posting.ValueDate,
+			&posting.BookedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan posting: %w", err)
+		}
+
+		if holdID.Valid {
+			id := holdID.UUID
+			posting.HoldID = &id
+		}
+
+		postings = append(postings, posting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating postings: %w", err)
+	}
+
+	return postings, nil
+}
+
+func (r *pgAccountRepository) GetBalanceAsOf(ctx context.Context, accountID uuid.UUID, valueDate time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM postings
+		WHERE account_id = $1 AND value_date <= $2
+	`
+
+	var balance int64
+	if err := r.db.QueryRowContext(ctx, query, accountID, valueDate).Scan(&balance); err != nil {
+		return 0, fmt.Errorf("failed to get balance as of %s: %w", valueDate.Format("2006-01-02"), err)
+	}
+
+	return balance, nil
+}
+
+func (r *pgAccountRepository) SumPostingsBookedBefore(ctx context.Context, accountID uuid.UUID, before time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM postings
+		WHERE account_id = $1 AND booked_at < $2
+	`
+
+	var balance int64
+	if err := r.db.QueryRowContext(ctx, query, accountID, before).Scan(&balance); err != nil {
+		return 0, fmt.Errorf("failed to sum postings booked before %s: %w", before.Format(time.RFC3339), err)
+	}
+
+	return balance, nil
+}
+
+func (r *pgAccountRepository) EarliestValueDateBookedSince(ctx context.Context, accountID uuid.UUID, since time.Time) (*time.Time, error) {
+	query := `
+		SELECT MIN(value_date)
+		FROM postings
+		WHERE account_id = $1 AND booked_at >= $2
+	`
+
+	var earliest sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query, accountID, since).Scan(&earliest); err != nil {
+		return nil, fmt.Errorf("failed to find earliest value date: %w", err)
+	}
+
+	if !earliest.Valid {
+		return nil, nil
+	}
+	return &earliest.Time, nil
+}
+
+func (r *pgAccountRepository) GetPostings(ctx context.Context, ids []uuid.UUID) ([]*models.Posting, error) {
+	query := `
+		SELECT id, account_id, posting_type, amount, currency,
+			reference, description, hold_id, value_date, booked_at
+		FROM postings
+		WHERE id = ANY($1::uuid[])
+		ORDER BY booked_at, id
+	`
+	return r.queryPostings(ctx, query, uuidArray(ids))
+}
+
+// Interest operations
+
+const accrualColumns = `account_id, accrual_date, balance, rate, amount_micros, debit_rate, debit_micros, calculated_at`
+
+func scanAccrual(row rowScanner) (*models.InterestAccrual, error) {
+	accrual := &models.InterestAccrual{}
+	err := row.Scan(
+		&accrual.AccountID,
+		&accrual.AccrualDate,
+		&accrual.Balance,
+		&accrual.Rate,
+		&accrual.AmountMicros,
+		&accrual.DebitRate,
+		&accrual.DebitMicros,
+		&accrual.CalculatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return accrual, nil
+}
+
+func (r *pgAccountRepository) UpsertAccrual(ctx context.Context, accrual *models.InterestAccrual) error {
+	query := `
+		INSERT INTO interest_accruals (` + accrualColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (account_id, accrual_date) DO UPDATE SET
+			balance = EXCLUDED.balance,
+			rate = EXCLUDED.rate,
+			amount_micros = EXCLUDED.amount_micros,
+			debit_rate = EXCLUDED.debit_rate,
+			debit_micros = EXCLUDED.debit_micros,
+			calculated_at = EXCLUDED.calculated_at
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		accrual.AccountID,
+		accrual.AccrualDate,
+		accrual.Balance,
+		accrual.Rate,
+		accrual.AmountMicros,
+		accrual.DebitRate,
+		accrual.DebitMicros,
+		accrual.CalculatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert accrual: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetLatestAccrual(ctx context.Context, accountID uuid.UUID) (*models.InterestAccrual, error) {
+	query := `
+		SELECT ` + accrualColumns + `
+		FROM interest_accruals
+		WHERE account_id = $1
+		ORDER BY accrual_date DESC
+		LIMIT 1
+	`
+
+	accrual, err := scanAccrual(r.db.QueryRowContext(ctx, query, accountID))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest accrual: %w", err)
+	}
+
+	return accrual, nil
+}
+
+func (r *pgAccountRepository) ListAccruals(ctx context.Context, accountID uuid.UUID, from, to time.Time) ([]*models.InterestAccrual, error) {
+	query := `
+		SELECT ` + accrualColumns + `
+		FROM interest_accruals
+		WHERE account_id = $1 AND accrual_date >= $2 AND accrual_date <= $3
+		ORDER BY accrual_date
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, accountID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accruals: %w", err)
+	}
+	defer rows.Close()
+
+	var accruals []*models.InterestAccrual
+	for rows.Next() {
+		accrual, err := scanAccrual(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan accrual: %w", err)
+		}
+		accruals = append(accruals, accrual)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating accruals: %w", err)
+	}
+
+	return accruals, nil
+}
+
+func (r *pgAccountRepository) SumAccruals(ctx context.Context, accountID uuid.UUID, through time.Time) (int64, int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount_micros), 0), COALESCE(SUM(debit_micros), 0)
+		FROM interest_accruals
+		WHERE account_id = $1 AND accrual_date <= $2
+	`
+
+	var credit, debit int64
+	if err := r.db.QueryRowContext(ctx, query, accountID, through).Scan(&credit, &debit); err != nil {
+		return 0, 0, fmt.Errorf("failed to sum accruals: %w", err)
+	}
+
+	return credit, debit, nil
+}
+
+func (r *pgAccountRepository) CreateCapitalization(ctx context.Context, capitalization *models.InterestCapitalization) error {
+	if capitalization.ID == uuid.Nil {
+		capitalization.ID = uuid.New()
+	}
+	capitalization.CreatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO interest_capitalizations (
+			id, account_id, period_start, period_end, amount, posting_id,
+			debit_amount, debit_posting_id, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		capitalization.ID,
+		capitalization.AccountID,
+		capitalization.PeriodStart,
+		capitalization.PeriodEnd,
+		capitalization.Amount,
+		capitalization.PostingID,
+		capitalization.DebitAmount,
+		capitalization.DebitPostingID,
+		capitalization.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create capitalization: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetCapitalization(ctx context.Context, accountID uuid.UUID, periodEnd time.Time) (*models.InterestCapitalization, error) {
+	query := `
+		SELECT id, account_id, period_start, period_end, amount, posting_id,
+			debit_amount, debit_posting_id, created_at
+		FROM interest_capitalizations
+		WHERE account_id = $1 AND period_end = $2
+	`
+
+	capitalization := &models.InterestCapitalization{}
+	var postingID, debitPostingID uuid.NullUUID
+
+	err := r.db.QueryRowContext(ctx, query, accountID, periodEnd).Scan(
+		&capitalization.ID,
+		&capitalization.AccountID,
+		&capitalization.PeriodStart,
+		&capitalization.PeriodEnd,
+		&capitalization.Amount,
+		&postingID,
+		&capitalization.DebitAmount,
+		&debitPostingID,
+		&capitalization.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get capitalization: %w", err)
+	}
+
+	if postingID.Valid {
+		id := postingID.UUID
+		capitalization.PostingID = &id
+	}
+	if debitPostingID.Valid {
+		id := debitPostingID.UUID
+		capitalization.DebitPostingID = &id
+	}
+
+	return capitalization, nil
+}
+
+func (r *pgAccountRepository) SumCapitalizations(ctx context.Context, accountID uuid.UUID) (int64, int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0), COALESCE(SUM(debit_amount), 0)
+		FROM interest_capitalizations
+		WHERE account_id = $1
+	`
+
+	var credit, debit int64
+	if err := r.db.QueryRowContext(ctx, query, accountID).Scan(&credit, &debit); err != nil {
+		return 0, 0, fmt.Errorf("failed to sum capitalizations: %w", err)
+	}
+
+	return credit, debit, nil
+}
+
+// Hold operations
+
+const holdColumns = `
+	id, account_id, hold_type, amount, remaining_amount, currency,
+	reason, reference, status, expires_at, created_at, updated_at, created_by`
+
+func scanHold(row rowScanner) (*models.Hold, error) {
+	hold := &models.Hold{}
+	var expiresAt sql.NullTime
+
+	err := row.Scan(
+		&hold.ID,
+		&hold.AccountID,
+		&hold.HoldType,
+		&hold.Amount,
+		&hold.RemainingAmount,
+		&hold.Currency,
+		&hold.Reason,
+		&hold.Reference,
+		&hold.Status,
+		&expiresAt,
+		&hold.CreatedAt,
+		&hold.UpdatedAt,
+		&hold.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		expiresAtTime := expiresAt.Time
+		hold.ExpiresAt = &expiresAtTime
+	}
+
+	return hold, nil
+}
+
+func (r *pgAccountRepository) CreateHold(ctx context.Context, hold *models.Hold) error {
+	if hold.ID == uuid.Nil {
+		hold.ID = uuid.New()
+	}
+	hold.CreatedAt = time.Now().UTC()
+	hold.UpdatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO holds (` + holdColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		hold.ID,
+		hold.AccountID,
+		hold.HoldType,
+		hold.Amount,
+		hold.RemainingAmount,
+		hold.Currency,
+		hold.Reason,
+		hold.Reference,
+		hold.Status,
+		hold.ExpiresAt,
+		hold.CreatedAt,
+		hold.UpdatedAt,
+		hold.CreatedBy,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create hold: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetHoldByID(ctx context.Context, id uuid.UUID) (*models.Hold, error) {
+	query := `SELECT ` + holdColumns + ` FROM holds WHERE id = $1`
+
+	hold, err := scanHold(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+
+	return hold, nil
+}
+
+func (r *pgAccountRepository) UpdateHold(ctx context.Context, hold *models.Hold) error {
+	hold.UpdatedAt = time.Now().UTC()
+
+	query := `
+		UPDATE holds SET
+			remaining_amount = $2,
+			status = $3,
+			expires_at = $4,
+			updated_at = $5
+		WHERE id = $1 AND status = 'Active'
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		hold.ID,
+		hold.RemainingAmount,
+		hold.Status,
+		hold.ExpiresAt,
+		hold.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update hold: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	// Only an active hold changes; one released, captured or expired
+	// meanwhile is left alone
+	if rowsAffected == 0 {
+		return ErrConflict
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) ListHolds(ctx context.Context, accountID uuid.UUID, activeOnly bool) ([]*models.Hold, error) {
+	query := `SELECT ` + holdColumns + ` FROM holds WHERE account_id = $1`
+	if activeOnly {
+		query += ` AND status = 'Active'`
+	}
+	query += ` ORDER BY created_at`
+
+	return r.queryHolds(ctx, query, accountID)
+}
+
+func (r *pgAccountRepository) SumActiveHolds(ctx context.Context, accountID uuid.UUID) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(remaining_amount), 0)
+		FROM holds
+		WHERE account_id = $1 AND status = 'Active'
+	`
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, query, accountID).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to sum holds: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *pgAccountRepository) ListExpiredHolds(ctx context.Context, now time.Time, limit int) ([]*models.Hold, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM holds
+		WHERE status = 'Active' AND expires_at IS NOT NULL AND expires_at <= $1
+		  AND NOT (hold_type = 'LegalGarnishment' AND EXISTS (
+			SELECT 1 FROM accounts WHERE accounts.id = holds.account_id AND accounts.status = 'Frozen'))
+		ORDER BY expires_at
+		LIMIT %d
+	`, holdColumns, limit)
+
+	return r.queryHolds(ctx, query, now)
+}
+
+func (r *pgAccountRepository) queryHolds(ctx context.Context, query string, args ...interface{}) ([]*models.Hold, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holds: %w", err)
+	}
+	defer rows.Close()
+
+	var holds []*models.Hold
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan hold: %w", err)
+		}
+		holds = append(holds, hold)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating holds: %w", err)
+	}
+
+	return holds, nil
+}
+
+// Fee operations
+
+const feeRuleColumns = `
+	id, account_type, fee_type, currency, amount,
+	waiver_min_balance, waiver_segments, effective_from, created_at, created_by`
+
+func scanFeeRule(row rowScanner) (*models.FeeRule, error) {
+	rule := &models.FeeRule{}
+	var waiverMinBalance sql.NullInt64
+	var waiverSegments pq.StringArray
+
+	err := row.Scan(
+		&rule.ID,
+		&rule.AccountType,
+		&rule.FeeType,
+		&rule.Currency,
+		&rule.Amount,
+		&waiverMinBalance,
+		&waiverSegments,
+		&rule.EffectiveFrom,
+		&rule.CreatedAt,
+		&rule.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if waiverMinBalance.Valid {
+		minBalance := waiverMinBalance.Int64
+		rule.WaiverMinBalance = &minBalance
+	}
+	rule.WaiverSegments = waiverSegments
+
+	return rule, nil
+}
+
+func (r *pgAccountRepository) CreateFeeRule(ctx context.Context, rule *models.FeeRule) error {
+	if rule.ID == uuid.Nil {
+		rule.ID = uuid.New()
+	}
+	rule.CreatedAt = time.Now().UTC()
+	if rule.EffectiveFrom.IsZero() {
+		rule.EffectiveFrom = rule.CreatedAt
+	}
+
+	query := `
+		INSERT INTO fee_rules (` + feeRuleColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		rule.ID,
+		rule.AccountType,
+		rule.FeeType,
+		rule.Currency,
+		rule.Amount,
+		rule.WaiverMinBalance,
+		pq.Array(rule.WaiverSegments),
+		rule.EffectiveFrom,
+		rule.CreatedAt,
+		rule.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create fee rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetFeeRule(ctx context.Context, accountType models.AccountType, feeType models.FeeType, currency string, at time.Time) (*models.FeeRule, error) {
+	query := `
+		SELECT ` + feeRuleColumns + `
+		FROM fee_rules
+		WHERE account_type = $1 AND fee_type = $2 AND currency = $3 AND effective_from <= $4
+		ORDER BY effective_from DESC, created_at DESC
+		LIMIT 1
+	`
+
+	rule, err := scanFeeRule(r.db.QueryRowContext(ctx, query, accountType, feeType, currency, at))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *pgAccountRepository) ListFeeRules(ctx context.Context, accountType models.AccountType) ([]*models.FeeRule, error) {
+	query := `SELECT ` + feeRuleColumns + ` FROM fee_rules`
+	var args []interface{}
+	if accountType != "" {
+		query += ` WHERE account_type = $1`
+		args = append(args, accountType)
+	}
+	query += ` ORDER BY account_type, fee_type, currency, effective_from`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*models.FeeRule
+	for rows.Next() {
+		rule, err := scanFeeRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fee rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating fee rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+const feeColumns = `
+	id, account_id, fee_rule_id, fee_type, amount, currency, status, reference,
+	waiver_reason, posting_id, reversal_posting_id, reversal_reason, reversed_by,
+	charged_at, reversed_at`
+
+func scanFee(row rowScanner) (*models.Fee, error) {
+	fee := &models.Fee{}
+	var postingID, reversalPostingID uuid.NullUUID
+	var reversedAt sql.NullTime
+
+	err := row.Scan(
+		&fee.ID,
+		&fee.AccountID,
+		&fee.FeeRuleID,
+		&fee.FeeType,
+		&fee.Amount,
+		&fee.Currency,
+		&fee.Status,
+		&fee.Reference,
+		&fee.WaiverReason,
+		&postingID,
+		&reversalPostingID,
+		&fee.ReversalReason,
+		&fee.ReversedBy,
+		&fee.ChargedAt,
+		&reversedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if postingID.Valid {
+		id := postingID.UUID
+		fee.PostingID = &id
+	}
+	if reversalPostingID.Valid {
+		id := reversalPostingID.UUID
+		fee.ReversalPostingID = &id
+	}
+	if reversedAt.Valid {
+		t := reversedAt.Time
+		fee.ReversedAt = &t
+	}
+
+	return fee, nil
+}
+
+func (r *pgAccountRepository) CreateFee(ctx context.Context, fee *models.Fee) error {
+	if fee.ID == uuid.Nil {
+		fee.ID = uuid.New()
+	}
+	if fee.ChargedAt.IsZero() {
+		fee.ChargedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO fees (` + feeColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		fee.ID,
+		fee.AccountID,
+		fee.FeeRuleID,
+		fee.FeeType,
+		fee.Amount,
+		fee.Currency,
+		fee.Status,
+		fee.Reference,
+		fee.WaiverReason,
+		fee.PostingID,
+		fee.ReversalPostingID,
+		fee.ReversalReason,
+		fee.ReversedBy,
+		fee.ChargedAt,
+		fee.ReversedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create fee: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetFeeByID(ctx context.Context, id uuid.UUID) (*models.Fee, error) {
+	query := `SELECT ` + feeColumns + ` FROM fees WHERE id = $1`
+
+	fee, err := scanFee(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee: %w", err)
+	}
+
+	return fee, nil
+}
+
+func (r *pgAccountRepository) GetFeeByReference(ctx context.Context, accountID uuid.UUID, feeType models.FeeType, reference string) (*models.Fee, error) {
+	query := `
+		SELECT ` + feeColumns + `
+		FROM fees
+		WHERE account_id = $1 AND fee_type = $2 AND reference = $3
+		ORDER BY charged_at DESC
+		LIMIT 1
+	`
+
+	fee, err := scanFee(r.db.QueryRowContext(ctx, query, accountID, feeType, reference))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee: %w", err)
+	}
+
+	return fee, nil
+}
+
+func (r *pgAccountRepository) UpdateFee(ctx context.Context, fee *models.Fee) error {
+	query := `
+		UPDATE fees SET
+			status = $2,
+			reversal_posting_id = $3,
+			reversal_reason = $4,
+			reversed_by = $5,
+			reversed_at = $6
+		WHERE id = $1 AND status = 'Charged'
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		fee.ID,
+		fee.Status,
+		fee.ReversalPostingID,
+		fee.ReversalReason,
+		fee.ReversedBy,
+		fee.ReversedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update fee: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	// Only a charged fee is reversed; one reversed meanwhile is left alone
+	if rowsAffected == 0 {
+		return ErrConflict
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) ListFees(ctx context.Context, accountID uuid.UUID) ([]*models.Fee, error) {
+	query := `SELECT ` + feeColumns + ` FROM fees WHERE account_id = $1 ORDER BY charged_at, id`
+
+	rows, err := r.db.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fees: %w", err)
+	}
+	defer rows.Close()
+
+	var fees []*models.Fee
+	for rows.Next() {
+		fee, err := scanFee(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fee: %w", err)
+		}
+		fees = append(fees, fee)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating fees: %w", err)
+	}
+
+	return fees, nil
+}
+
+// Statement operations
+
+const statementColumns = `
+	id, account_id, period_start, period_end, version,
+	opening_balance, closing_balance, generated_at, generated_by`
+
+func scanStatement(row rowScanner) (*models.Statement, error) {
+	statement := &models.Statement{}
+	err := row.Scan(
+		&statement.ID,
+		&statement.AccountID,
+		&statement.PeriodStart,
+		&statement.PeriodEnd,
+		&statement.Version,
+		&statement.OpeningBalance,
+		&statement.ClosingBalance,
+		&statement.GeneratedAt,
+		&statement.GeneratedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return statement, nil
+}
+
+func (r *pgAccountRepository) CreateStatement(ctx context.Context, statement *models.Statement, documents []*models.StatementDocument) error {
+	if statement.ID == uuid.Nil {
+		statement.ID = uuid.New()
+	}
+	if statement.GeneratedAt.IsZero() {
+		statement.GeneratedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO statements (` + statementColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		statement.ID,
+		statement.AccountID,
+		statement.PeriodStart,
+		statement.PeriodEnd,
+		statement.Version,
+		statement.OpeningBalance,
+		statement.ClosingBalance,
+		statement.GeneratedAt,
+		statement.GeneratedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create statement: %w", err)
+	}
+
+	for _, doc := range documents {
+		_, err := r.db.ExecContext(ctx,
+			`INSERT INTO statement_documents (statement_id, format, content, content_hash) VALUES ($1, $2, $3, $4)`,
+			statement.ID, doc.Format, doc.Content, doc.ContentHash,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to store %s statement document: %w", doc.Format, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetStatement(ctx context.Context, id uuid.UUID) (*models.Statement, error) {
+	query := `SELECT ` + statementColumns + ` FROM statements WHERE id = $1`
+
+	statement, err := scanStatement(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statement: %w", err)
+	}
+
+	return statement, nil
+}
+
+func (r *pgAccountRepository) GetLatestStatement(ctx context.Context, accountID uuid.UUID, periodStart, periodEnd time.Time) (*models.Statement, error) {
+	query := `
+		SELECT ` + statementColumns + `
+		FROM statements
+		WHERE account_id = $1 AND period_start = $2 AND period_end = $3
+		ORDER BY version DESC
+		LIMIT 1
+	`
+
+	statement, err := scanStatement(r.db.QueryRowContext(ctx, query, accountID, periodStart, periodEnd))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statement: %w", err)
+	}
+
+	return statement, nil
+}
+
+func (r *pgAccountRepository) ListStatements(ctx context.Context, accountID uuid.UUID) ([]*models.Statement, error) {
+	query := `
+		SELECT ` + statementColumns + `
+		FROM statements
+		WHERE account_id = $1
+		ORDER BY period_end DESC, period_start DESC, version DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statements: %w", err)
+	}
+	defer rows.Close()
+
+	var statements []*models.Statement
+	for rows.Next() {
+		statement, err := scanStatement(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan statement: %w", err)
+		}
+		statements = append(statements, statement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating statements: %w", err)
+	}
+
+	return statements, nil
+}
+
+func (r *pgAccountRepository) GetStatementDocument(ctx context.Context, statementID uuid.UUID, format models.StatementFormat) (*models.StatementDocument, error) {
+	query := `
+		SELECT statement_id, format, content, content_hash
+		FROM statement_documents
+		WHERE statement_id = $1 AND format = $2
+	`
+
+	doc := &models.StatementDocument{}
+	err := r.db.QueryRowContext(ctx, query, statementID, format).Scan(
+		&doc.StatementID,
+		&doc.Format,
+		&doc.Content,
+		&doc.ContentHash,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statement document: %w", err)
+	}
+
+	return doc, nil
+}
+
+func (r *pgAccountRepository) ListStatementDocuments(ctx context.Context, statementID uuid.UUID) ([]*models.StatementDocument, error) {
+	query := `
+		SELECT statement_id, format, content_hash
+		FROM statement_documents
+		WHERE statement_id = $1
+		ORDER BY format
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, statementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statement documents: %w", err)
+	}
+	defer rows.Close()
+
+	var docs []*models.StatementDocument
+	for rows.Next() {
+		doc := &models.StatementDocument{}
+		if err := rows.Scan(&doc.StatementID, &doc.Format, &doc.ContentHash); err != nil {
+			return nil, fmt.Errorf("failed to scan statement document: %w", err)
+		}
+		docs = append(docs, doc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating statement documents: %w", err)
+	}
+
+	return docs, nil
+}
+
+// Transaction management
+
+func (r *pgAccountRepository) BeginTx(ctx context.Context) (Tx, error) {
+	db, ok := r.db.(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("nested transactions not supported")
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return &pgTx{tx: tx}, nil
+}
+
+// pgTx implements Tx for PostgreSQL
+type pgTx struct {
+	tx *sql.Tx
+}
+
+func (t *pgTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (t *pgTx) Rollback(ctx context.Context) error {
+	if err := t.tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+func (t *pgTx) AccountRepository() AccountRepository {
+	return &pgAccountRepository{db: t.tx}
+}
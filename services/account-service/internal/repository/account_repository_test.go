@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrOptimisticLock(t *testing.T) {
+	accountID := uuid.New()
+	err := &ErrOptimisticLock{AccountID: accountID}
+
+	assert.Contains(t, err.Error(), "optimistic lock error")
+	assert.Equal(t, accountID, err.AccountID)
+}
+
+func TestDBQuerier_Interface(t *testing.T) {
+	t.Run("DBQuerier interface compliance", func(t *testing.T) {
+		var _ DBQuerier = (*sql.DB)(nil)
+	})
+
+	t.Run("DBQuerier interface compliance for Tx", func(t *testing.T) {
+		var _ DBQuerier = (*sql.Tx)(nil)
+	})
+}
+
+func TestAccountRepository_Interface(t *testing.T) {
+	var _ AccountRepository = (*pgAccountRepository)(nil)
+	var _ Tx = (*pgTx)(nil)
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	assert.True(t, isUniqueViolation(fmt.Errorf("insert: %w", &pq.Error{Code: "23505"})))
+	assert.False(t, isUniqueViolation(&pq.Error{Code: "23503"}))
+	assert.False(t, isUniqueViolation(errors.New("duplicate key")))
+}
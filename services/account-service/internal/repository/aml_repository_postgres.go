@@ -0,0 +1,368 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// AML monitoring operations
+
+const transactionEventColumns = `
+	id, sequence, customer_id, account_id, direction, amount, currency,
+	counterparty_account_id, counterparty_country, channel, transaction_type,
+	reference, occurred_at, monitored_at`
+
+func scanTransactionEvent(row rowScanner) (*models.TransactionEvent, error) {
+	event := &models.TransactionEvent{}
+	var counterpartyAccountID uuid.NullUUID
+	var counterpartyCountry sql.NullString
+	var monitoredAt sql.NullTime
+
+	err := row.Scan(
+		&event.ID,
+		&event.Sequence,
+		&event.CustomerID,
+		&event.AccountID,
+		&event.Direction,
+		&event.Amount,
+		&event.Currency,
+		&counterpartyAccountID,
+		&counterpartyCountry,
+		&event.Channel,
+		&event.TransactionType,
+		&event.Reference,
+		&event.OccurredAt,
+		&monitoredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if counterpartyAccountID.Valid {
+		event.CounterpartyAccountID = &counterpartyAccountID.UUID
+	}
+	event.CounterpartyCountry = counterpartyCountry.String
+	if monitoredAt.Valid {
+		event.MonitoredAt = &monitoredAt.Time
+	}
+
+	return event, nil
+}
+
+func (r *pgAccountRepository) RecordTransactionEvent(ctx context.Context, event *models.TransactionEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+
+	query := `
+		INSERT INTO transaction_events (
+			id, customer_id, account_id, direction, amount, currency,
+			counterparty_account_id, counterparty_country, channel,
+			transaction_type, reference, occurred_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10, $11, $12
+		)
+		RETURNING sequence
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		event.ID,
+		event.CustomerID,
+		event.AccountID,
+		event.Direction,
+		event.Amount,
+		event.Currency,
+		event.CounterpartyAccountID,
+		event.CounterpartyCountry,
+		event.Channel,
+		event.TransactionType,
+		event.Reference,
+		event.OccurredAt,
+	).Scan(&event.Sequence)
+	if err != nil {
+		return fmt.Errorf("failed to record transaction event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetTransactionEvents(ctx context.Context, ids []uuid.UUID) ([]*models.TransactionEvent, error) {
+	query := `
+		SELECT ` + transactionEventColumns + `
+		FROM transaction_events
+		WHERE id = ANY($1::uuid[])
+		ORDER BY sequence
+	`
+	return r.queryTransactionEvents(ctx, query, uuidArray(ids))
+}
+
+func (r *pgAccountRepository) ListUnmonitoredTransactionEvents(ctx context.Context, limit int) ([]*models.TransactionEvent, error) {
+	query := `
+		SELECT ` + transactionEventColumns + `
+		FROM transaction_events
+		WHERE monitored_at IS NULL
+		ORDER BY sequence
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	return r.queryTransactionEvents(ctx, query, limit)
+}
+
+func (r *pgAccountRepository) ListCustomerTransactionEvents(ctx context.Context, customerID uuid.UUID, from time.Time, beforeSequence int64) ([]*models.TransactionEvent, error) {
+	query := `
+		SELECT ` + transactionEventColumns + `
+		FROM transaction_events
+		WHERE customer_id = $1 AND occurred_at >= $2 AND sequence < $3
+		ORDER BY sequence
+	`
+	return r.queryTransactionEvents(ctx, query, customerID, from, beforeSequence)
+}
+
+func (r *pgAccountRepository) ListTransactionEvents(ctx context.Context, from, to time.Time) ([]*models.TransactionEvent, error) {
+	query := `
+		SELECT ` + transactionEventColumns + `
+		FROM transaction_events
+		WHERE occurred_at >= $1 AND occurred_at < $2
+		ORDER BY sequence
+	`
+	return r.queryTransactionEvents(ctx, query, from, to)
+}
+
+func (r *pgAccountRepository) queryTransactionEvents(ctx context.Context, query string, args ...interface{}) ([]*models.TransactionEvent, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transaction events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*models.TransactionEvent
+	for rows.Next() {
+		event, err := scanTransactionEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan transaction event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating transaction events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *pgAccountRepository) MarkTransactionEventMonitored(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE transaction_events SET monitored_at = $2 WHERE id = $1`, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to mark transaction event monitored: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+const amlAlertColumns = `
+	a.id, a.rule_id, a.rule_type, a.rules_version, a.customer_id,
+	a.trigger_event_id, a.summary, a.status, a.raised_at, a.created_at,
+	a.closed_at, a.closed_by, a.resolution,
+	ARRAY(SELECT e.event_id::text FROM aml_alert_evidence e WHERE e.alert_id = a.id ORDER BY e.position)`
+
+func scanAMLAlert(row rowScanner) (*models.AMLAlert, error) {
+	alert := &models.AMLAlert{}
+	var closedAt sql.NullTime
+	var evidence pq.StringArray
+
+	err := row.Scan(
+		&alert.ID,
+		&alert.RuleID,
+		&alert.RuleType,
+		&alert.RulesVersion,
+		&alert.CustomerID,
+		&alert.TriggerEventID,
+		&alert.Summary,
+		&alert.Status,
+		&alert.RaisedAt,
+		&alert.CreatedAt,
+		&closedAt,
+		&alert.ClosedBy,
+		&alert.Resolution,
+		&evidence,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if closedAt.Valid {
+		alert.ClosedAt = &closedAt.Time
+	}
+	alert.Evidence = make([]uuid.UUID, len(evidence))
+	for i, id := range evidence {
+		if alert.Evidence[i], err = uuid.Parse(id); err != nil {
+			return nil, fmt.Errorf("invalid evidence event id %q: %w", id, err)
+		}
+	}
+
+	return alert, nil
+}
+
+func (r *pgAccountRepository) CreateAMLAlert(ctx context.Context, alert *models.AMLAlert) error {
+	if alert.ID == uuid.Nil {
+		alert.ID = uuid.New()
+	}
+	alert.CreatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO aml_alerts (
+			id, rule_id, rule_type, rules_version, customer_id, trigger_event_id,
+			summary, status, raised_at, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		alert.ID,
+		alert.RuleID,
+		alert.RuleType,
+		alert.RulesVersion,
+		alert.CustomerID,
+		alert.TriggerEventID,
+		alert.Summary,
+		alert.Status,
+		alert.RaisedAt,
+		alert.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create aml alert: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO aml_alert_evidence (alert_id, event_id, position)
+		SELECT $1, evidence.id, evidence.position
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS evidence(id, position)
+	`, alert.ID, uuidArray(alert.Evidence))
+	if err != nil {
+		return fmt.Errorf("failed to create aml alert evidence: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetAMLAlert(ctx context.Context, id uuid.UUID) (*models.AMLAlert, error) {
+	query := `SELECT ` + amlAlertColumns + ` FROM aml_alerts a WHERE a.id = $1`
+
+	alert, err := scanAMLAlert(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get aml alert: %w", err)
+	}
+
+	return alert, nil
+}
+
+func (r *pgAccountRepository) ListAMLAlerts(ctx context.Context, filter models.AMLAlertFilter) ([]*models.AMLAlert, error) {
+	query := `
+		SELECT ` + amlAlertColumns + `
+		FROM aml_alerts a
+		WHERE ($1::uuid IS NULL OR a.customer_id = $1)
+			AND ($2 = '' OR a.status::text = $2)
+			AND ($3 = '' OR a.rule_id = $3)
+		ORDER BY a.created_at DESC, a.id
+		LIMIT NULLIF($4, 0)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, filter.CustomerID, string(filter.Status), filter.RuleID, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list aml alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*models.AMLAlert
+	for rows.Next() {
+		alert, err := scanAMLAlert(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan aml alert: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating aml alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+func (r *pgAccountRepository) UpdateAMLAlert(ctx context.Context, alert *models.AMLAlert) error {
+	query := `
+		UPDATE aml_alerts SET
+			status = $2,
+			closed_at = $3,
+			closed_by = $4,
+			resolution = $5
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, alert.ID, alert.Status, alert.ClosedAt, alert.ClosedBy, alert.Resolution)
+	if err != nil {
+		return fmt.Errorf("failed to update aml alert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) LastAMLAlertTimes(ctx context.Context, customerID uuid.UUID) (map[string]time.Time, error) {
+	query := `
+		SELECT rule_id, MAX(raised_at)
+		FROM aml_alerts
+		WHERE customer_id = $1
+		GROUP BY rule_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list last aml alerts: %w", err)
+	}
+	defer rows.Close()
+
+	last := make(map[string]time.Time)
+	for rows.Next() {
+		var ruleID string
+		var raisedAt time.Time
+		if err := rows.Scan(&ruleID, &raisedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan last aml alert: %w", err)
+		}
+		last[ruleID] = raisedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating last aml alerts: %w", err)
+	}
+
+	return last, nil
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Customer event operations
+
+func (r *pgAccountRepository) LockCustomerEventOffset(ctx context.Context) (int64, error) {
+	var sequence int64
+	err := r.db.QueryRowContext(ctx, `SELECT sequence FROM customer_event_offset FOR UPDATE`).Scan(&sequence)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock customer event offset: %w", err)
+	}
+	return sequence, nil
+}
+
+func (r *pgAccountRepository) SetCustomerEventOffset(ctx context.Context, sequence int64) error {
+	query := `UPDATE customer_event_offset SET sequence = $1, updated_at = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, sequence, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to set customer event offset: %w", err)
+	}
+	return nil
+}
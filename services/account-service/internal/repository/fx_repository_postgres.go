@@ -0,0 +1,363 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// FX operations
+
+const fxRateColumns = `
+	id, base_currency, quote_currency, mid_rate, effective_from,
+	source, created_at, created_by`
+
+func scanFXRate(row rowScanner) (*models.FXRate, error) {
+	rate := &models.FXRate{}
+	err := row.Scan(
+		&rate.ID,
+		&rate.BaseCurrency,
+		&rate.QuoteCurrency,
+		&rate.MidRate,
+		&rate.EffectiveFrom,
+		&rate.Source,
+		&rate.CreatedAt,
+		&rate.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
+
+func (r *pgAccountRepository) CreateFXRates(ctx context.Context, rates []*models.FXRate) (int, error) {
+	// An entry identical to one already in the table is skipped, so loading
+	// the same file twice adds nothing
+	query := `
+		INSERT INTO fx_rates (` + fxRateColumns + `)
+		SELECT $1::uuid, $2::char(3), $3::char(3), $4::bigint, $5::timestamptz, $6::varchar, $7::timestamptz, $8::varchar
+		WHERE NOT EXISTS (
+			SELECT 1 FROM fx_rates
+			WHERE base_currency = $2 AND quote_currency = $3 AND effective_from = $5 AND mid_rate = $4
+		)
+	`
+
+	created := 0
+	for _, rate := range rates {
+		if rate.ID == uuid.Nil {
+			rate.ID = uuid.New()
+		}
+		rate.CreatedAt = time.Now().UTC()
+
+		result, err := r.db.ExecContext(ctx, query,
+			rate.ID,
+			rate.BaseCurrency,
+			rate.QuoteCurrency,
+			rate.MidRate,
+			rate.EffectiveFrom,
+			rate.Source,
+			rate.CreatedAt,
+			rate.CreatedBy,
+		)
+		if err != nil {
+			return created, fmt.Errorf("failed to create fx rate: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return created, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		created += int(rowsAffected)
+	}
+
+	return created, nil
+}
+
+func (r *pgAccountRepository) GetFXRate(ctx context.Context, baseCurrency, quoteCurrency string, at time.Time) (*models.FXRate, error) {
+	query := `
+		SELECT ` + fxRateColumns + `
+		FROM fx_rates
+		WHERE base_currency = $1 AND quote_currency = $2 AND effective_from <= $3
+		ORDER BY effective_from DESC, created_at DESC
+		LIMIT 1
+	`
+
+	rate, err := scanFXRate(r.db.QueryRowContext(ctx, query, baseCurrency, quoteCurrency, at))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fx rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+func (r *pgAccountRepository) ListFXRates(ctx context.Context, baseCurrency, quoteCurrency string) ([]*models.FXRate, error) {
+	query := `
+		SELECT ` + fxRateColumns + `
+		FROM fx_rates
+		WHERE base_currency = $1 AND quote_currency = $2
+		ORDER BY effective_from DESC, created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, baseCurrency, quoteCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fx rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []*models.FXRate
+	for rows.Next() {
+		rate, err := scanFXRate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fx rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating fx rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+func (r *pgAccountRepository) SetFXPositionAccount(ctx context.Context, position *models.FXPositionAccount) error {
+	position.UpdatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO fx_position_accounts (currency_pair, currency, account_id, updated_at, updated_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (currency_pair, currency) DO UPDATE SET
+			account_id = EXCLUDED.account_id,
+			updated_at = EXCLUDED.updated_at,
+			updated_by = EXCLUDED.updated_by
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		position.CurrencyPair,
+		position.Currency,
+		position.AccountID,
+		position.UpdatedAt,
+		position.UpdatedBy,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set fx position account: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetFXPositionAccount(ctx context.Context, currencyPair, currency string) (*models.FXPositionAccount, error) {
+	query := `
+		SELECT currency_pair, currency, account_id, updated_at, updated_by
+		FROM fx_position_accounts
+		WHERE currency_pair = $1 AND currency = $2
+	`
+
+	position := &models.FXPositionAccount{}
+	err := r.db.QueryRowContext(ctx, query, currencyPair, currency).Scan(
+		&position.CurrencyPair,
+		&position.Currency,
+		&position.AccountID,
+		&position.UpdatedAt,
+		&position.UpdatedBy,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fx position account: %w", err)
+	}
+
+	return position, nil
+}
+
+func (r *pgAccountRepository) IsFXPositionAccount(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM fx_position_accounts WHERE account_id = $1)`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, accountID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check fx position account: %w", err)
+	}
+
+	return exists, nil
+}
+
+const fxQuoteColumns = `
+	id, from_account_id, to_account_id, source_currency, target_currency,
+	source_amount, target_amount, fixed_side, rate_id, mid_rate, inverted,
+	segment, spread_bps, customer_rate, exact_amount, status, expires_at,
+	created_at, created_by, executed_at, executed_by, reference,
+	debit_posting_id, credit_posting_id`
+
+func scanFXQuote(row rowScanner) (*models.FXQuote, error) {
+	quote := &models.FXQuote{}
+	var executedAt sql.NullTime
+	var debitPostingID, creditPostingID uuid.NullUUID
+
+	err := row.Scan(
+		&quote.ID,
+		&quote.FromAccountID,
+		&quote.ToAccountID,
+		&quote.SourceCurrency,
+		&quote.TargetCurrency,
+		&quote.SourceAmount,
+		&quote.TargetAmount,
+		&quote.FixedSide,
+		&quote.RateID,
+		&quote.MidRate,
+		&quote.Inverted,
+		&quote.Segment,
+		&quote.SpreadBps,
+		&quote.CustomerRate,
+		&quote.ExactAmount,
+		&quote.Status,
+		&quote.ExpiresAt,
+		&quote.CreatedAt,
+		&quote.CreatedBy,
+		&executedAt,
+		&quote.ExecutedBy,
+		&quote.Reference,
+		&debitPostingID,
+		&creditPostingID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if executedAt.Valid {
+		quote.ExecutedAt = &executedAt.Time
+	}
+	if debitPostingID.Valid {
+		id := debitPostingID.UUID
+		quote.DebitPostingID = &id
+	}
+	if creditPostingID.Valid {
+		id := creditPostingID.UUID
+		quote.CreditPostingID = &id
+	}
+
+	return quote, nil
+}
+
+func (r *pgAccountRepository) CreateFXQuote(ctx context.Context, quote *models.FXQuote) error {
+	if quote.ID == uuid.Nil {
+		quote.ID = uuid.New()
+	}
+	if quote.CreatedAt.IsZero() {
+		quote.CreatedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO fx_quotes (` + fxQuoteColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			$13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		quote.ID,
+		quote.FromAccountID,
+		quote.ToAccountID,
+		quote.SourceCurrency,
+		quote.TargetCurrency,
+		quote.SourceAmount,
+		quote.TargetAmount,
+		quote.FixedSide,
+		quote.RateID,
+		quote.MidRate,
+		quote.Inverted,
+		quote.Segment,
+		quote.SpreadBps,
+		quote.CustomerRate,
+		quote.ExactAmount,
+		quote.Status,
+		quote.ExpiresAt,
+		quote.CreatedAt,
+		quote.CreatedBy,
+		quote.ExecutedAt,
+		quote.ExecutedBy,
+		quote.Reference,
+		quote.DebitPostingID,
+		quote.CreditPostingID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create fx quote: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetFXQuote(ctx context.Context, id uuid.UUID) (*models.FXQuote, error) {
+	query := `SELECT ` + fxQuoteColumns + ` FROM fx_quotes WHERE id = $1`
+
+	quote, err := scanFXQuote(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fx quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+func (r *pgAccountRepository) GetFXQuoteForUpdate(ctx context.Context, id uuid.UUID) (*models.FXQuote, error) {
+	query := `SELECT ` + fxQuoteColumns + ` FROM fx_quotes WHERE id = $1 FOR UPDATE`
+
+	quote, err := scanFXQuote(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock fx quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+func (r *pgAccountRepository) UpdateFXQuote(ctx context.Context, quote *models.FXQuote) error {
+	query := `
+		UPDATE fx_quotes SET
+			status = $2,
+			executed_at = $3,
+			executed_by = $4,
+			reference = $5,
+			debit_posting_id = $6,
+			credit_posting_id = $7
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		quote.ID,
+		quote.Status,
+		quote.ExecutedAt,
+		quote.ExecutedBy,
+		quote.Reference,
+		quote.DebitPostingID,
+		quote.CreditPostingID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update fx quote: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,376 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Limit operations
+
+const limitRuleColumns = `
+	id, kind, channel, transaction_type, risk_tier, currency, value,
+	effective_from, created_at, created_by`
+
+func scanLimitRule(row rowScanner) (*models.LimitRule, error) {
+	rule := &models.LimitRule{}
+	err := row.Scan(
+		&rule.ID,
+		&rule.Kind,
+		&rule.Channel,
+		&rule.TransactionType,
+		&rule.RiskTier,
+		&rule.Currency,
+		&rule.Value,
+		&rule.EffectiveFrom,
+		&rule.CreatedAt,
+		&rule.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *pgAccountRepository) CreateLimitRule(ctx context.Context, rule *models.LimitRule) error {
+	if rule.ID == uuid.Nil {
+		rule.ID = uuid.New()
+	}
+	rule.CreatedAt = time.Now().UTC()
+	if rule.EffectiveFrom.IsZero() {
+		rule.EffectiveFrom = rule.CreatedAt
+	}
+
+	query := `
+		INSERT INTO limit_rules (` + limitRuleColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		rule.ID,
+		rule.Kind,
+		rule.Channel,
+		rule.TransactionType,
+		rule.RiskTier,
+		rule.Currency,
+		rule.Value,
+		rule.EffectiveFrom,
+		rule.CreatedAt,
+		rule.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create limit rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetLimitRule(ctx context.Context, id uuid.UUID) (*models.LimitRule, error) {
+	query := `SELECT ` + limitRuleColumns + ` FROM limit_rules WHERE id = $1`
+
+	rule, err := scanLimitRule(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limit rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *pgAccountRepository) ListLimitRules(ctx context.Context) ([]*models.LimitRule, error) {
+	query := `
+		SELECT ` + limitRuleColumns + `
+		FROM limit_rules
+		ORDER BY currency, kind, channel NULLS FIRST, transaction_type NULLS FIRST, risk_tier NULLS FIRST, effective_from
+	`
+	return r.queryLimitRules(ctx, query)
+}
+
+func (r *pgAccountRepository) ListEffectiveLimitRules(ctx context.Context, currency string, at time.Time) ([]*models.LimitRule, error) {
+	// DISTINCT ON treats NULLs as equal, so a rule for any channel is
+	// superseded by a later rule for any channel
+	query := `
+		SELECT DISTINCT ON (kind, channel, transaction_type, risk_tier) ` + limitRuleColumns + `
+		FROM limit_rules
+		WHERE currency = $1 AND effective_from <= $2
+		ORDER BY kind, channel, transaction_type, risk_tier, effective_from DESC, created_at DESC
+	`
+	return r.queryLimitRules(ctx, query, currency, at)
+}
+
+func (r *pgAccountRepository) queryLimitRules(ctx context.Context, query string, args ...interface{}) ([]*models.LimitRule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*models.LimitRule
+	for rows.Next() {
+		rule, err := scanLimitRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan limit rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating limit rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *pgAccountRepository) GetCustomerRiskTier(ctx context.Context, customerID uuid.UUID) (*models.CustomerRiskTier, error) {
+	query := `
+		SELECT customer_id, risk_tier, updated_at, updated_by
+		FROM customer_risk_tiers
+		WHERE customer_id = $1
+	`
+
+	tier := &models.CustomerRiskTier{}
+	err := r.db.QueryRowContext(ctx, query, customerID).Scan(
+		&tier.CustomerID,
+		&tier.RiskTier,
+		&tier.UpdatedAt,
+		&tier.UpdatedBy,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer risk tier: %w", err)
+	}
+
+	return tier, nil
+}
+
+func (r *pgAccountRepository) SetCustomerRiskTier(ctx context.Context, tier *models.CustomerRiskTier) error {
+	tier.UpdatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO customer_risk_tiers (customer_id, risk_tier, updated_at, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (customer_id) DO UPDATE SET
+			risk_tier = EXCLUDED.risk_tier,
+			updated_at = EXCLUDED.updated_at,
+			updated_by = EXCLUDED.updated_by
+	`
+
+	_, err := r.db.ExecContext(ctx, query, tier.CustomerID, tier.RiskTier, tier.UpdatedAt, tier.UpdatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to set customer risk tier: %w", err)
+	}
+
+	return nil
+}
+
+const limitIncreaseColumns = `
+	id, customer_id, limit_rule_id, value, starts_at, expires_at, reason,
+	created_at, created_by, revoked_at, revoked_by`
+
+func scanLimitIncrease(row rowScanner) (*models.LimitIncrease, error) {
+	increase := &models.LimitIncrease{}
+	var revokedAt sql.NullTime
+
+	err := row.Scan(
+		&increase.ID,
+		&increase.CustomerID,
+		&increase.LimitRuleID,
+		&increase.Value,
+		&increase.StartsAt,
+		&increase.ExpiresAt,
+		&increase.Reason,
+		&increase.CreatedAt,
+		&increase.CreatedBy,
+		&revokedAt,
+		&increase.RevokedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		increase.RevokedAt = &revokedAt.Time
+	}
+
+	return increase, nil
+}
+
+func (r *pgAccountRepository) CreateLimitIncrease(ctx context.Context, increase *models.LimitIncrease) error {
+	if increase.ID == uuid.Nil {
+		increase.ID = uuid.New()
+	}
+	increase.CreatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO limit_increases (` + limitIncreaseColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		increase.ID,
+		increase.CustomerID,
+		increase.LimitRuleID,
+		increase.Value,
+		increase.StartsAt,
+		increase.ExpiresAt,
+		increase.Reason,
+		increase.CreatedAt,
+		increase.CreatedBy,
+		increase.RevokedAt,
+		increase.RevokedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create limit increase: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetLimitIncrease(ctx context.Context, id uuid.UUID) (*models.LimitIncrease, error) {
+	query := `SELECT ` + limitIncreaseColumns + ` FROM limit_increases WHERE id = $1`
+
+	increase, err := scanLimitIncrease(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limit increase: %w", err)
+	}
+
+	return increase, nil
+}
+
+func (r *pgAccountRepository) ListActiveLimitIncreases(ctx context.Context, customerID uuid.UUID, at time.Time) ([]*models.LimitIncrease, error) {
+	query := `
+		SELECT ` + limitIncreaseColumns + `
+		FROM limit_increases
+		WHERE customer_id = $1 AND revoked_at IS NULL AND starts_at <= $2 AND expires_at > $2
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit increases: %w", err)
+	}
+	defer rows.Close()
+
+	var increases []*models.LimitIncrease
+	for rows.Next() {
+		increase, err := scanLimitIncrease(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan limit increase: %w", err)
+		}
+		increases = append(increases, increase)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating limit increases: %w", err)
+	}
+
+	return increases, nil
+}
+
+func (r *pgAccountRepository) RevokeLimitIncrease(ctx context.Context, increase *models.LimitIncrease) error {
+	query := `
+		UPDATE limit_increases SET
+			revoked_at = $2,
+			revoked_by = $3
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, increase.ID, increase.RevokedAt, increase.RevokedBy)
+	if err != nil {
+		return fmt.Errorf("failed to revoke limit increase: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) LockCustomerLimits(ctx context.Context, customerID uuid.UUID) error {
+	// A transaction-scoped advisory lock keyed by the customer: there may be
+	// no row to lock yet for a customer's first transaction of the period
+	key := int64(binary.BigEndian.Uint64(customerID[:8]))
+	if _, err := r.db.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, key); err != nil {
+		return fmt.Errorf("failed to lock customer limits: %w", err)
+	}
+	return nil
+}
+
+func (r *pgAccountRepository) SumLimitUsage(ctx context.Context, filter models.LimitUsageFilter) (int64, int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0), COUNT(*)
+		FROM limit_usages
+		WHERE customer_id = $1 AND currency = $2 AND occurred_at >= $3 AND occurred_at < $4
+			AND ($5::transaction_channel IS NULL OR channel = $5)
+			AND ($6::limit_transaction_type IS NULL OR transaction_type = $6)
+	`
+
+	var amount, count int64
+	err := r.db.QueryRowContext(ctx, query,
+		filter.CustomerID,
+		filter.Currency,
+		filter.From,
+		filter.To,
+		filter.Channel,
+		filter.TransactionType,
+	).Scan(&amount, &count)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to sum limit usage: %w", err)
+	}
+
+	return amount, count, nil
+}
+
+func (r *pgAccountRepository) RecordLimitUsage(ctx context.Context, usage *models.LimitUsage) error {
+	if usage.ID == uuid.Nil {
+		usage.ID = uuid.New()
+	}
+
+	query := `
+		INSERT INTO limit_usages (
+			id, customer_id, account_id, channel, transaction_type, currency,
+			amount, reference, occurred_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		usage.ID,
+		usage.CustomerID,
+		usage.AccountID,
+		usage.Channel,
+		usage.TransactionType,
+		usage.Currency,
+		usage.Amount,
+		usage.Reference,
+		usage.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record limit usage: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,519 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint violation
+const uniqueViolation = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
+
+// uuidArray passes a list of IDs as a PostgreSQL uuid[] parameter
+func uuidArray(ids []uuid.UUID) interface{} {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	return pq.Array(strs)
+}
+
+// Reconciliation operations
+
+const bankStatementColumns = `
+	id, account_id, format, external_id, external_account, currency,
+	opening_date, opening_balance, closing_date, closing_balance,
+	line_count, content_hash, imported_at, imported_by`
+
+func scanBankStatement(row rowScanner) (*models.BankStatement, error) {
+	s := &models.BankStatement{}
+	err := row.Scan(
+		&s.ID,
+		&s.AccountID,
+		&s.Format,
+		&s.ExternalID,
+		&s.ExternalAccount,
+		&s.Currency,
+		&s.OpeningDate,
+		&s.OpeningBalance,
+		&s.ClosingDate,
+		&s.ClosingBalance,
+		&s.LineCount,
+		&s.ContentHash,
+		&s.ImportedAt,
+		&s.ImportedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+const bankStatementLineColumns = `
+	id, statement_id, account_id, line_number, value_date, booking_date,
+	amount, reference, bank_reference, description, status, match_id`
+
+func scanBankStatementLine(row rowScanner) (*models.BankStatementLine, error) {
+	line := &models.BankStatementLine{}
+	var matchID uuid.NullUUID
+	err := row.Scan(
+		&line.ID,
+		&line.StatementID,
+		&line.AccountID,
+		&line.LineNumber,
+		&line.ValueDate,
+		&line.BookingDate,
+		&line.Amount,
+		&line.Reference,
+		&line.BankReference,
+		&line.Description,
+		&line.Status,
+		&matchID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if matchID.Valid {
+		id := matchID.UUID
+		line.MatchID = &id
+	}
+	return line, nil
+}
+
+func (r *pgAccountRepository) CreateBankStatement(ctx context.Context, statement *models.BankStatement, lines []*models.BankStatementLine) error {
+	if statement.ID == uuid.Nil {
+		statement.ID = uuid.New()
+	}
+	if statement.ImportedAt.IsZero() {
+		statement.ImportedAt = time.Now().UTC()
+	}
+	statement.LineCount = len(lines)
+
+	query := `
+		INSERT INTO bank_statements (` + bankStatementColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		statement.ID,
+		statement.AccountID,
+		statement.Format,
+		statement.ExternalID,
+		statement.ExternalAccount,
+		statement.Currency,
+		statement.OpeningDate,
+		statement.OpeningBalance,
+		statement.ClosingDate,
+		statement.ClosingBalance,
+		statement.LineCount,
+		statement.ContentHash,
+		statement.ImportedAt,
+		statement.ImportedBy,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create bank statement: %w", err)
+	}
+
+	for _, line := range lines {
+		if line.ID == uuid.Nil {
+			line.ID = uuid.New()
+		}
+		line.StatementID = statement.ID
+		line.AccountID = statement.AccountID
+		line.Status = models.ReconciliationStatusUnmatched
+		line.MatchID = nil
+
+		_, err := r.db.ExecContext(ctx, `
+			INSERT INTO bank_statement_lines (`+bankStatementLineColumns+`
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULL
+			)`,
+			line.ID,
+			line.StatementID,
+			line.AccountID,
+			line.LineNumber,
+			line.ValueDate,
+			line.BookingDate,
+			line.Amount,
+			line.Reference,
+			line.BankReference,
+			line.Description,
+			line.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create bank statement line %d: %w", line.LineNumber, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) ListBankStatements(ctx context.Context, accountID uuid.UUID) ([]*models.BankStatement, error) {
+	query := `
+		SELECT ` + bankStatementColumns + `
+		FROM bank_statements
+		WHERE account_id = $1
+		ORDER BY closing_date, opening_date, external_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bank statements: %w", err)
+	}
+	defer rows.Close()
+
+	var statements []*models.BankStatement
+	for rows.Next() {
+		statement, err := scanBankStatement(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bank statement: %w", err)
+		}
+		statements = append(statements, statement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating bank statements: %w", err)
+	}
+
+	return statements, nil
+}
+
+func (r *pgAccountRepository) ListBankStatementAccounts(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT DISTINCT account_id FROM bank_statements ORDER BY account_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bank statement accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan account id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating bank statement accounts: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *pgAccountRepository) ListBankStatementLines(ctx context.Context, accountID uuid.UUID, status models.ReconciliationStatus, through time.Time) ([]*models.BankStatementLine, error) {
+	query := `
+		SELECT ` + bankStatementLineColumns + `
+		FROM bank_statement_lines
+		WHERE account_id = $1 AND value_date <= $2 AND ($3 = '' OR status::text = $3)
+		ORDER BY value_date, statement_id, line_number
+	`
+	return r.queryBankStatementLines(ctx, query, accountID, through, string(status))
+}
+
+func (r *pgAccountRepository) GetBankStatementLines(ctx context.Context, ids []uuid.UUID) ([]*models.BankStatementLine, error) {
+	query := `
+		SELECT ` + bankStatementLineColumns + `
+		FROM bank_statement_lines
+		WHERE id = ANY($1::uuid[])
+		ORDER BY value_date, statement_id, line_number
+	`
+	return r.queryBankStatementLines(ctx, query, uuidArray(ids))
+}
+
+func (r *pgAccountRepository) queryBankStatementLines(ctx context.Context, query string, args ...interface{}) ([]*models.BankStatementLine, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bank statement lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []*models.BankStatementLine
+	for rows.Next() {
+		line, err := scanBankStatementLine(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bank statement line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating bank statement lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+func (r *pgAccountRepository) ListUnreconciledPostings(ctx context.Context, accountID uuid.UUID, from, through time.Time) ([]*models.Posting, error) {
+	query := `
+		SELECT p.id, p.account_id, p.posting_type, p.amount, p.currency,
+			p.reference, p.description, p.hold_id, p.value_date, p.booked_at
+		FROM postings p
+		WHERE p.account_id = $1 AND p.value_date >= $2 AND p.value_date <= $3
+			AND NOT EXISTS (SELECT 1 FROM reconciliation_match_postings mp WHERE mp.posting_id = p.id)
+		ORDER BY p.value_date, p.booked_at, p.id
+	`
+	return r.queryPostings(ctx, query, accountID, from, through)
+}
+
+func (r *pgAccountRepository) GetUnreconciledPostings(ctx context.Context, ids []uuid.UUID) ([]*models.Posting, error) {
+	query := `
+		SELECT p.id, p.account_id, p.posting_type, p.amount, p.currency,
+			p.reference, p.description, p.hold_id, p.value_date, p.booked_at
+		FROM postings p
+		WHERE p.id = ANY($1::uuid[])
+			AND NOT EXISTS (SELECT 1 FROM reconciliation_match_postings mp WHERE mp.posting_id = p.id)
+		ORDER BY p.value_date, p.booked_at, p.id
+	`
+	return r.queryPostings(ctx, query, uuidArray(ids))
+}
+
+func (r *pgAccountRepository) queryPostings(ctx context.Context, query string, args ...interface{}) ([]*models.Posting, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list postings: %w", err)
+	}
+	defer rows.Close()
+
+	var postings []*models.Posting
+	for rows.Next() {
+		posting := &models.Posting{}
+		var holdID uuid.NullUUID
+		err := rows.Scan(
+			&posting.ID,
+			&posting.AccountID,
+			&posting.PostingType,
+			&posting.Amount,
+			&posting.Currency,
+			&posting.Reference,
+			&posting.Description,
+			&holdID,
+			&posting.ValueDate,
+			&posting.BookedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan posting: %w", err)
+		}
+		if holdID.Valid {
+			id := holdID.UUID
+			posting.HoldID = &id
+		}
+		postings = append(postings, posting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating postings: %w", err)
+	}
+
+	return postings, nil
+}
+
+func (r *pgAccountRepository) CreateReconciliationMatch(ctx context.Context, match *models.ReconciliationMatch) error {
+	if match.ID == uuid.Nil {
+		match.ID = uuid.New()
+	}
+	if match.CreatedAt.IsZero() {
+		match.CreatedAt = time.Now().UTC()
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO reconciliation_matches (
+			id, account_id, kind, rule, run_id, adjustment_posting_id, note, created_at, created_by
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)`,
+		match.ID,
+		match.AccountID,
+		match.Kind,
+		match.Rule,
+		match.RunID,
+		match.AdjustmentPostingID,
+		match.Note,
+		match.CreatedAt,
+		match.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create reconciliation match: %w", err)
+	}
+
+	for _, postingID := range match.PostingIDs {
+		_, err := r.db.ExecContext(ctx,
+			`INSERT INTO reconciliation_match_postings (match_id, posting_id) VALUES ($1, $2)`,
+			match.ID, postingID,
+		)
+		if isUniqueViolation(err) {
+			return models.ErrAlreadyReconciled
+		}
+		if err != nil {
+			return fmt.Errorf("failed to add posting to reconciliation match: %w", err)
+		}
+	}
+
+	if len(match.LineIDs) > 0 {
+		status := models.ReconciliationStatusMatched
+		if match.Kind == models.MatchKindWriteOff {
+			status = models.ReconciliationStatusWrittenOff
+		}
+		result, err := r.db.ExecContext(ctx, `
+			UPDATE bank_statement_lines
+			SET status = $3, match_id = $2
+			WHERE id = ANY($1::uuid[]) AND account_id = $4 AND status = 'Unmatched'`,
+			uuidArray(match.LineIDs), match.ID, status, match.AccountID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to mark statement lines matched: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rows != int64(len(match.LineIDs)) {
+			return models.ErrAlreadyReconciled
+		}
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) DeleteAutoMatches(ctx context.Context, accountID uuid.UUID, through time.Time) (int, error) {
+	// Matches with a line on a later statement belong to a later period
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.id
+		FROM reconciliation_matches m
+		WHERE m.account_id = $1 AND m.kind = 'Auto'
+			AND NOT EXISTS (
+				SELECT 1
+				FROM bank_statement_lines l
+				JOIN bank_statements s ON s.id = l.statement_id
+				WHERE l.match_id = m.id AND (s.closing_date > $2 OR l.value_date > $2)
+			)`,
+		accountID, through,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list reconciliation matches: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return 0, fmt.Errorf("failed to scan reconciliation match: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating reconciliation matches: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		UPDATE bank_statement_lines
+		SET status = 'Unmatched', match_id = NULL
+		WHERE match_id = ANY($1::uuid[])`,
+		uuidArray(ids),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unmatch statement lines: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`DELETE FROM reconciliation_match_postings WHERE match_id = ANY($1::uuid[])`,
+		uuidArray(ids),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unmatch postings: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM reconciliation_matches WHERE id = ANY($1::uuid[])`, uuidArray(ids)); err != nil {
+		return 0, fmt.Errorf("failed to delete reconciliation matches: %w", err)
+	}
+
+	return len(ids), nil
+}
+
+const reconciliationRunColumns = `
+	id, account_id, as_of, rerun, matches_created, unmatched_lines, unmatched_postings,
+	statement_balance, ledger_balance, report, run_at, run_by`
+
+func (r *pgAccountRepository) CreateReconciliationRun(ctx context.Context, run *models.ReconciliationRun) error {
+	if run.ID == uuid.Nil {
+		run.ID = uuid.New()
+	}
+	if run.RunAt.IsZero() {
+		run.RunAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO reconciliation_runs (` + reconciliationRunColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		run.ID,
+		run.AccountID,
+		run.AsOf,
+		run.Rerun,
+		run.MatchesCreated,
+		run.UnmatchedLines,
+		run.UnmatchedPostings,
+		run.StatementBalance,
+		run.LedgerBalance,
+		run.Report,
+		run.RunAt,
+		run.RunBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create reconciliation run: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetReconciliationRun(ctx context.Context, id uuid.UUID) (*models.ReconciliationRun, error) {
+	query := `SELECT ` + reconciliationRunColumns + ` FROM reconciliation_runs WHERE id = $1`
+
+	run := &models.ReconciliationRun{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&run.ID,
+		&run.AccountID,
+		&run.AsOf,
+		&run.Rerun,
+		&run.MatchesCreated,
+		&run.UnmatchedLines,
+		&run.UnmatchedPostings,
+		&run.StatementBalance,
+		&run.LedgerBalance,
+		&run.Report,
+		&run.RunAt,
+		&run.RunBy,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reconciliation run: %w", err)
+	}
+
+	return run, nil
+}
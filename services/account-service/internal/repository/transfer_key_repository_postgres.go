@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Transfer key operations
+
+func (r *pgAccountRepository) CreateTransferKey(ctx context.Context, key *models.TransferKey) error {
+	if key.CreatedAt.IsZero() {
+		key.CreatedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO transfer_keys (
+			idempotency_key, from_account_id, to_account_id, amount,
+			debit_posting_id, credit_posting_id, fee_id, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		key.Key,
+		key.FromAccountID,
+		key.ToAccountID,
+		key.Amount,
+		key.DebitPostingID,
+		key.CreditPostingID,
+		key.FeeID,
+		key.CreatedAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create transfer key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgAccountRepository) GetTransferKey(ctx context.Context, key string) (*models.TransferKey, error) {
+	query := `
+		SELECT idempotency_key, from_account_id, to_account_id, amount,
+			debit_posting_id, credit_posting_id, fee_id, created_at
+		FROM transfer_keys
+		WHERE idempotency_key = $1
+	`
+
+	transferKey := &models.TransferKey{}
+	var feeID uuid.NullUUID
+	err := r.db.QueryRowContext(ctx, query, key).Scan(
+		&transferKey.Key,
+		&transferKey.FromAccountID,
+		&transferKey.ToAccountID,
+		&transferKey.Amount,
+		&transferKey.DebitPostingID,
+		&transferKey.CreditPostingID,
+		&feeID,
+		&transferKey.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer key: %w", err)
+	}
+
+	if feeID.Valid {
+		id := feeID.UUID
+		transferKey.FeeID = &id
+	}
+
+	return transferKey, nil
+}
@@ -0,0 +1,969 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/core-banking/pkg/bankid"
+	"github.com/core-banking/services/account-service/internal/fx"
+	"github.com/core-banking/services/account-service/internal/interest"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/reconciliation"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/core-banking/services/account-service/internal/validation"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/core-banking/services/account-service/internal/models"
+)
+
+// DefaultCardAuthorizationExpiry is applied to card authorization holds
+// placed without an explicit expiry
+const DefaultCardAuthorizationExpiry = 7 * 24 * time.Hour
+
+// AccountService handles account business logic
+type AccountService struct {
+	accountpb.UnimplementedAccountServiceServer
+	repo      repository.AccountRepository
+	customers CustomerDirectory
+	numbering *bankid.Scheme
+	validator *validation.Validator
+
+	reconciliationRules reconciliation.Rules
+	fxConfig            *fx.Config
+	settlementAccounts  map[uuid.UUID]bool
+}
+
+// NewAccountService creates a new AccountService instance. New accounts are
+// numbered by the scheme; a nil scheme issues internal numbers without IBANs.
+func NewAccountService(repo repository.AccountRepository, customers CustomerDirectory, numbering *bankid.Scheme) *AccountService {
+	return &AccountService{
+		repo:      repo,
+		customers: customers,
+		numbering: numbering,
+		validator: validation.NewValidator(),
+
+		reconciliationRules: reconciliation.DefaultRules(),
+		fxConfig:            fx.DefaultConfig(),
+	}
+}
+
+// OpenAccount opens a new account with the customer as its primary holder.
+// The customer must exist in customer-service and be Active.
+func (s *AccountService) OpenAccount(ctx context.Context, req *accountpb.OpenAccountRequest) (*accountpb.OpenAccountResponse, error) {
+	if errs := s.validator.ValidateOpenAccount(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	account := &models.Account{
+		ID:          uuid.New(),
+		CustomerID:  uuid.MustParse(req.GetCustomerId()),
+		AccountType: models.AccountType(req.GetAccountType()),
+		Currency:    req.GetCurrency(),
+		Segment:     req.GetSegment(),
+		Status:      models.AccountStatusActive,
+		OpenedAt:    time.Now().UTC(),
+	}
+
+	if err := s.checkCustomerActive(ctx, account.CustomerID); err != nil {
+		return nil, err
+	}
+
+	holder := &models.AccountParty{
+		ID:          uuid.New(),
+		AccountID:   account.ID,
+		CustomerID:  account.CustomerID,
+		Role:        models.PartyRolePrimaryHolder,
+		SigningRule: models.SigningRule(req.GetSigningRule()),
+		StartDate:   interest.Date(account.OpenedAt),
+	}
+	if holder.SigningRule == "" {
+		holder.SigningRule = models.SigningRuleAnyOne
+	}
+
+	err := s.withTx(ctx, func(repo repository.AccountRepository) error {
+		if err := s.assignAccountNumber(ctx, repo, account); err != nil {
+			return err
+		}
+		if err := repo.CreateAccount(ctx, account); err != nil {
+			return status.Errorf(codes.Internal, "failed to open account: %v", err)
+		}
+		if err := repo.CreateParty(ctx, holder); err != nil {
+			return status.Errorf(codes.Internal, "failed to add primary holder: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.OpenAccountResponse{
+		Account: accountModelToProto(account),
+	}, nil
+}
+
+// GetAccount retrieves an account by ID, or by account number when no ID is given
+func (s *AccountService) GetAccount(ctx context.Context, req *accountpb.GetAccountRequest) (*accountpb.GetAccountResponse, error) {
+	if req.GetId() == "" && req.GetAccountNumber() != "" {
+		account, err := s.repo.GetAccountByNumber(ctx, req.GetAccountNumber())
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get account: %v", err)
+		}
+		return &accountpb.GetAccountResponse{Account: accountModelToProto(account)}, nil
+	}
+
+	accountID, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.getAccount(ctx, s.repo, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.GetAccountResponse{
+		Account: accountModelToProto(account),
+	}, nil
+}
+
+// FreezeAccount freezes an account. Existing holds, including legal holds,
+// are left untouched.
+func (s *AccountService) FreezeAccount(ctx context.Context, req *accountpb.FreezeAccountRequest) (*accountpb.FreezeAccountResponse, error) {
+	if req.GetReason() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	account, err := s.changeStatus(ctx, req.GetId(), models.AccountStatusFrozen)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.FreezeAccountResponse{
+		Account: accountModelToProto(account),
+	}, nil
+}
+
+// UnfreezeAccount returns a frozen account to Active
+func (s *AccountService) UnfreezeAccount(ctx context.Context, req *accountpb.UnfreezeAccountRequest) (*accountpb.UnfreezeAccountResponse, error) {
+	if req.GetReason() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	account, err := s.changeStatus(ctx, req.GetId(), models.AccountStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.UnfreezeAccountResponse{
+		Account: accountModelToProto(account),
+	}, nil
+}
+
+// GetBalance returns the ledger, held and available balance of an account
+func (s *AccountService) GetBalance(ctx context.Context, req *accountpb.GetBalanceRequest) (*accountpb.GetBalanceResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.getAccount(ctx, s.repo, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.balance(ctx, s.repo, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.GetBalanceResponse{
+		Balance: balanceModelToProto(balance),
+	}, nil
+}
+
+// PlaceHold earmarks funds on an account. Non-legal holds require sufficient
+// available funds and an account that is not frozen; legal holds may be placed
+// on frozen accounts and may exceed the available balance.
+func (s *AccountService) PlaceHold(ctx context.Context, req *accountpb.PlaceHoldRequest) (*accountpb.PlaceHoldResponse, error) {
+	if errs := s.validator.ValidatePlaceHold(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy uuid.UUID
+	if req.GetCreatedBy() != "" {
+		if createdBy, err = uuid.Parse(req.GetCreatedBy()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid created_by UUID: %v", err)
+		}
+	}
+
+	holdType := models.HoldType(req.GetHoldType())
+	hold := &models.Hold{
+		ID:              uuid.New(),
+		AccountID:       accountID,
+		HoldType:        holdType,
+		Amount:          req.GetAmount(),
+		RemainingAmount: req.GetAmount(),
+		Currency:        req.GetCurrency(),
+		Reason:          req.GetReason(),
+		Reference:       req.GetReference(),
+		Status:          models.HoldStatusActive,
+		CreatedBy:       createdBy,
+	}
+
+	if req.GetExpiresAt() != nil {
+		expiresAt := req.GetExpiresAt().AsTime()
+		hold.ExpiresAt = &expiresAt
+	} else if holdType == models.HoldTypeCardAuthorization {
+		expiresAt := time.Now().UTC().Add(DefaultCardAuthorizationExpiry)
+		hold.ExpiresAt = &expiresAt
+	}
+
+	var balance *models.Balance
+	err = s.withTx(ctx, func(repo repository.AccountRepository) error {
+		account, err := s.lockAccount(ctx, repo, accountID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkHoldAllowed(account, holdType); err != nil {
+			return err
+		}
+
+		if account.Currency != hold.Currency {
+			return status.Errorf(codes.InvalidArgument, "hold currency %s does not match account currency %s", hold.Currency, account.Currency)
+		}
+
+		current, err := s.balance(ctx, repo, account)
+		if err != nil {
+			return err
+		}
+
+		if !holdType.IsLegal() && current.Available < hold.Amount {
+			return status.Errorf(codes.FailedPrecondition, "insufficient available funds: available %d, requested %d", current.Available, hold.Amount)
+		}
+
+		if err := repo.CreateHold(ctx, hold); err != nil {
+			return status.Errorf(codes.Internal, "failed to place hold: %v", err)
+		}
+
+		balance = models.NewBalance(account, current.HeldAmount+hold.RemainingAmount, time.Now().UTC())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.PlaceHoldResponse{
+		Hold:    holdModelToProto(hold),
+		Balance: balanceModelToProto(balance),
+	}, nil
+}
+
+// ReleaseHold releases all or part of a hold
+func (s *AccountService) ReleaseHold(ctx context.Context, req *accountpb.ReleaseHoldRequest) (*accountpb.ReleaseHoldResponse, error) {
+	holdID, err := parseID("hold_id", req.GetHoldId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetAmount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must not be negative")
+	}
+
+	var hold *models.Hold
+	var balance *models.Balance
+	err = s.withTx(ctx, func(repo repository.AccountRepository) error {
+		var account *models.Account
+		hold, account, err = s.lockHold(ctx, repo, holdID)
+		if err != nil {
+			return err
+		}
+
+		amount := req.GetAmount()
+		if amount == 0 {
+			amount = hold.RemainingAmount
+		}
+
+		if err := hold.Release(amount); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if err := repo.UpdateHold(ctx, hold); err != nil {
+			if errors.Is(err, repository.ErrConflict) {
+				return status.Errorf(codes.FailedPrecondition, "hold is no longer active")
+			}
+			return status.Errorf(codes.Internal, "failed to release hold: %v", err)
+		}
+
+		balance, err = s.balance(ctx, repo, account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.ReleaseHoldResponse{
+		Hold:    holdModelToProto(hold),
+		Balance: balanceModelToProto(balance),
+	}, nil
+}
+
+// CaptureHold converts all or part of a hold into a debit posting. Any
+// remaining amount is released.
+func (s *AccountService) CaptureHold(ctx context.Context, req *accountpb.CaptureHoldRequest) (*accountpb.CaptureHoldResponse, error) {
+	holdID, err := parseID("hold_id", req.GetHoldId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetAmount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must not be negative")
+	}
+
+	var hold *models.Hold
+	var posting *models.Posting
+	var balance *models.Balance
+	err = s.withTx(ctx, func(repo repository.AccountRepository) error {
+		var account *models.Account
+		hold, account, err = s.lockHold(ctx, repo, holdID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkHoldAllowed(account, hold.HoldType); err != nil {
+			return err
+		}
+
+		amount := req.GetAmount()
+		if amount == 0 {
+			amount = hold.RemainingAmount
+		}
+
+		if err := hold.Capture(amount); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if err := repo.UpdateHold(ctx, hold); err != nil {
+			if errors.Is(err, repository.ErrConflict) {
+				return status.Errorf(codes.FailedPrecondition, "hold is no longer active")
+			}
+			return status.Errorf(codes.Internal, "failed to capture hold: %v", err)
+		}
+
+		description := req.GetDescription()
+		if description == "" {
+			description = hold.Reason
+		}
+
+		posting = &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   account.ID,
+			PostingType: models.PostingTypeHoldCapture,
+			Amount:      -amount,
+			Currency:    hold.Currency,
+			Reference:   hold.Reference,
+			Description: description,
+			HoldID:      &hold.ID,
+		}
+
+		if err := repo.AddPosting(ctx, posting); err != nil {
+			return status.Errorf(codes.Internal, "failed to post capture: %v", err)
+		}
+		account.LedgerBalance += posting.Amount
+
+		balance, err = s.balance(ctx, repo, account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.CaptureHoldResponse{
+		Hold:    holdModelToProto(hold),
+		Posting: postingModelToProto(posting),
+		Balance: balanceModelToProto(balance),
+	}, nil
+}
+
+// ListHolds lists the holds on an account
+func (s *AccountService) ListHolds(ctx context.Context, req *accountpb.ListHoldsRequest) (*accountpb.ListHoldsResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	holds, err := s.repo.ListHolds(ctx, accountID, req.GetActiveOnly())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list holds: %v", err)
+	}
+
+	protoHolds := make([]*accountpb.Hold, len(holds))
+	for i, h := range holds {
+		protoHolds[i] = holdModelToProto(h)
+	}
+
+	return &accountpb.ListHoldsResponse{
+		Holds: protoHolds,
+	}, nil
+}
+
+// SetOverdraftLimit sets the arranged overdraft limit and debit interest rate
+// of an account. Lowering the limit below the current overdrawn amount is
+// allowed; the excess becomes an unarranged overdraft.
+func (s *AccountService) SetOverdraftLimit(ctx context.Context, req *accountpb.SetOverdraftLimitRequest) (*accountpb.SetOverdraftLimitResponse, error) {
+	if errs := s.validator.ValidateSetOverdraftLimit(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	var rate interest.Rate
+	if req.GetDebitRate() != "" {
+		rate, err = interest.ParseRate(req.GetDebitRate())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "debit_rate: %v", err)
+		}
+	}
+
+	account, err := s.getAccount(ctx, s.repo, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Status == models.AccountStatusClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "account is closed")
+	}
+
+	account.OverdraftLimit = req.GetLimit()
+	account.OverdraftRate = int64(rate)
+	if err := s.repo.UpdateAccount(ctx, account); err != nil {
+		if _, ok := err.(*repository.ErrOptimisticLock); ok {
+			return nil, status.Errorf(codes.Aborted, "account was modified by another process")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set overdraft limit: %v", err)
+	}
+
+	balance, err := s.balance(ctx, s.repo, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.SetOverdraftLimitResponse{
+		Account: accountModelToProto(account),
+		Balance: balanceModelToProto(balance),
+	}, nil
+}
+
+// Transfer moves funds between two accounts in the same currency. The source
+// account must be Active, the transfer must be within the customer's
+// transaction limits, and its available funds, including any arranged
+// overdraft, must cover the amount plus any transfer fee. A transfer rejected
+// for insufficient funds is charged the insufficient-funds fee. Both sides of
+// a posted transfer are recorded for transaction monitoring. A transfer
+// repeated with the idempotency key of one already posted returns that
+// transfer, with the source account's balance now, and posts nothing.
+func (s *AccountService) Transfer(ctx context.Context, req *accountpb.TransferRequest) (*accountpb.TransferResponse, error) {
+	if errs := s.validator.ValidateTransfer(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	fromID := uuid.MustParse(req.GetFromAccountId())
+	toID := uuid.MustParse(req.GetToAccountId())
+
+	reference := req.GetReference()
+	if reference == "" {
+		reference = fmt.Sprintf("TRF-%s", uuid.New())
+	}
+
+	var debit, credit *models.Posting
+	var fee *models.Fee
+	var balance *models.Balance
+	var rejected error
+	err := s.withTx(ctx, func(repo repository.AccountRepository) error {
+		from, to, err := s.lockPair(ctx, repo, fromID, toID)
+		if err != nil {
+			return err
+		}
+
+		// Looked up under the locks, so a repeat sent while the first is
+		// still posting waits for it and finds its key
+		if req.GetIdempotencyKey() != "" {
+			key, err := repo.GetTransferKey(ctx, req.GetIdempotencyKey())
+			if err == nil {
+				debit, credit, fee, err = s.replayTransfer(ctx, repo, key, req)
+				if err != nil {
+					return err
+				}
+				balance, err = s.balance(ctx, repo, from)
+				return err
+			}
+			if !errors.Is(err, repository.ErrNotFound) {
+				return status.Errorf(codes.Internal, "failed to get transfer key: %v", err)
+			}
+		}
+
+		if from.Status != models.AccountStatusActive {
+			return status.Errorf(codes.FailedPrecondition, "source account is %s", from.Status)
+		}
+		if to.Status == models.AccountStatusClosed {
+			return status.Errorf(codes.FailedPrecondition, "destination account is closed")
+		}
+		if from.Currency != req.GetCurrency() || to.Currency != req.GetCurrency() {
+			return status.Errorf(codes.InvalidArgument, "transfer currency %s does not match both account currencies", req.GetCurrency())
+		}
+
+		now := time.Now().UTC()
+
+		channel := channelOrDefault(req.GetChannel())
+		usage, err := s.checkLimits(ctx, repo, from, channel, models.TransactionTypeTransfer, req.GetAmount(), reference, now)
+		if err != nil {
+			return err
+		}
+
+		fee, err = resolveFee(ctx, repo, from, models.FeeTypeTransfer, reference, from.LedgerBalance, now)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to price transfer fee: %v", err)
+		}
+
+		required := req.GetAmount()
+		if fee != nil && fee.Status == models.FeeStatusCharged {
+			required += fee.Amount
+		}
+
+		current, err := s.balance(ctx, repo, from)
+		if err != nil {
+			return err
+		}
+
+		if current.Available < required {
+			// The rejection fee is committed even though the transfer is not
+			if _, err := chargeFee(ctx, repo, from, models.FeeTypeInsufficientFunds, reference, from.LedgerBalance, now, now); err != nil {
+				return status.Errorf(codes.Internal, "failed to charge insufficient funds fee: %v", err)
+			}
+			rejected = status.Errorf(codes.FailedPrecondition, "insufficient available funds: available %d, required %d", current.Available, required)
+			return nil
+		}
+
+		description := req.GetDescription()
+		if description == "" {
+			description = fmt.Sprintf("Transfer to %s", to.AccountNumber)
+		}
+
+		debit = &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   from.ID,
+			PostingType: models.PostingTypeDebit,
+			Amount:      -req.GetAmount(),
+			Currency:    from.Currency,
+			Reference:   reference,
+			Description: description,
+		}
+		if err := repo.AddPosting(ctx, debit); err != nil {
+			return status.Errorf(codes.Internal, "failed to post debit: %v", err)
+		}
+		from.LedgerBalance += debit.Amount
+
+		credit = &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   to.ID,
+			PostingType: models.PostingTypeCredit,
+			Amount:      req.GetAmount(),
+			Currency:    to.Currency,
+			Reference:   reference,
+			Description: fmt.Sprintf("Transfer from %s", from.AccountNumber),
+		}
+		if err := repo.AddPosting(ctx, credit); err != nil {
+			return status.Errorf(codes.Internal, "failed to post credit: %v", err)
+		}
+
+		if fee != nil {
+			if err := postFee(ctx, repo, from, fee, now); err != nil {
+				return status.Errorf(codes.Internal, "failed to charge transfer fee: %v", err)
+			}
+		}
+
+		if usage != nil {
+			if err := repo.RecordLimitUsage(ctx, usage); err != nil {
+				return status.Errorf(codes.Internal, "failed to record limit usage: %v", err)
+			}
+		}
+
+		if req.GetIdempotencyKey() != "" {
+			key := &models.TransferKey{
+				Key:             req.GetIdempotencyKey(),
+				FromAccountID:   from.ID,
+				ToAccountID:     to.ID,
+				Amount:          req.GetAmount(),
+				DebitPostingID:  debit.ID,
+				CreditPostingID: credit.ID,
+			}
+			if fee != nil {
+				key.FeeID = &fee.ID
+			}
+			if err := repo.CreateTransferKey(ctx, key); err != nil {
+				if errors.Is(err, repository.ErrDuplicate) {
+					return status.Errorf(codes.Aborted, "a transfer with idempotency key %q was made concurrently", key.Key)
+				}
+				return status.Errorf(codes.Internal, "failed to record transfer key: %v", err)
+			}
+		}
+
+		err = s.recordTransactionEvents(ctx, repo, movement{
+			from:                from,
+			to:                  to,
+			fromAmount:          req.GetAmount(),
+			toAmount:            req.GetAmount(),
+			channel:             channel,
+			transactionType:     models.TransactionTypeTransfer,
+			counterpartyCountry: req.GetCounterpartyCountry(),
+			reference:           reference,
+			occurredAt:          now,
+		})
+		if err != nil {
+			return err
+		}
+
+		balance, err = s.balance(ctx, repo, from)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rejected != nil {
+		return nil, rejected
+	}
+
+	resp := &accountpb.TransferResponse{
+		Debit:   postingModelToProto(debit),
+		Credit:  postingModelToProto(credit),
+		Balance: balanceModelToProto(balance),
+	}
+	if fee != nil {
+		resp.Fee = feeModelToProto(fee)
+	}
+
+	return resp, nil
+}
+
+// replayTransfer loads the transfer first made with an idempotency key. The
+// key must have been used for the same accounts and amount.
+func (s *AccountService) replayTransfer(ctx context.Context, repo repository.AccountRepository, key *models.TransferKey, req *accountpb.TransferRequest) (debit, credit *models.Posting, fee *models.Fee, err error) {
+	if key.FromAccountID.String() != req.GetFromAccountId() || key.ToAccountID.String() != req.GetToAccountId() || key.Amount != req.GetAmount() {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "idempotency key %q was used for a different transfer", key.Key)
+	}
+
+	postings, err := repo.GetPostings(ctx, []uuid.UUID{key.DebitPostingID, key.CreditPostingID})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get transfer postings: %v", err)
+	}
+	for _, p := range postings {
+		switch p.ID {
+		case key.DebitPostingID:
+			debit = p
+		case key.CreditPostingID:
+			credit = p
+		}
+	}
+	if debit == nil || credit == nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "postings of transfer key %q not found", key.Key)
+	}
+
+	if key.FeeID != nil {
+		if fee, err = s.getFee(ctx, repo, *key.FeeID); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return debit, credit, fee, nil
+}
+
+// changeStatus moves an account to a new status after validating the transition
+func (s *AccountService) changeStatus(ctx context.Context, id string, newStatus models.AccountStatus) (*models.Account, error) {
+	accountID, err := parseID("id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.getAccount(ctx, s.repo, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validator.ValidateStatusTransition(account.Status, newStatus); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	account.Status = newStatus
+	if err := s.repo.UpdateAccount(ctx, account); err != nil {
+		if _, ok := err.(*repository.ErrOptimisticLock); ok {
+			return nil, status.Errorf(codes.Aborted, "account was modified by another process")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update account status: %v", err)
+	}
+
+	return account, nil
+}
+
+// withTx runs fn inside a repository transaction, committing on success and
+// rolling back on error
+func (s *AccountService) withTx(ctx context.Context, fn func(repo repository.AccountRepository) error) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	if err := fn(tx.AccountRepository()); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+	return nil
+}
+
+func (s *AccountService) getAccount(ctx context.Context, repo repository.AccountRepository, id uuid.UUID) (*models.Account, error) {
+	account, err := repo.GetAccountByID(ctx, id)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account: %v", err)
+	}
+	return account, nil
+}
+
+func (s *AccountService) lockAccount(ctx context.Context, repo repository.AccountRepository, id uuid.UUID) (*models.Account, error) {
+	account, err := repo.GetAccountForUpdate(ctx, id)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to lock account: %v", err)
+	}
+	return account, nil
+}
+
+// lockPair locks two accounts in a fixed order so that concurrent transfers in
+// opposite directions cannot deadlock
+func (s *AccountService) lockPair(ctx context.Context, repo repository.AccountRepository, fromID, toID uuid.UUID) (*models.Account, *models.Account, error) {
+	first, second := fromID, toID
+	if bytes.Compare(second[:], first[:]) < 0 {
+		first, second = second, first
+	}
+
+	a, err := s.lockAccount(ctx, repo, first)
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := s.lockAccount(ctx, repo, second)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if a.ID == fromID {
+		return a, b, nil
+	}
+	return b, a, nil
+}
+
+// lockAccounts locks several accounts in a fixed order, like lockPair, and
+// returns them by ID. An ID given twice is locked once.
+func (s *AccountService) lockAccounts(ctx context.Context, repo repository.AccountRepository, ids ...uuid.UUID) (map[uuid.UUID]*models.Account, error) {
+	sorted := append([]uuid.UUID(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+
+	accounts := make(map[uuid.UUID]*models.Account, len(sorted))
+	for _, id := range sorted {
+		if _, ok := accounts[id]; ok {
+			continue
+		}
+		account, err := s.lockAccount(ctx, repo, id)
+		if err != nil {
+			return nil, err
+		}
+		accounts[id] = account
+	}
+	return accounts, nil
+}
+
+func (s *AccountService) getHold(ctx context.Context, repo repository.AccountRepository, id uuid.UUID) (*models.Hold, error) {
+	hold, err := repo.GetHoldByID(ctx, id)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "hold not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get hold: %v", err)
+	}
+	return hold, nil
+}
+
+// lockHold locks the account a hold is on and loads the hold under that
+// lock, so a concurrent release or capture of the same hold has either
+// finished and is seen, or waits for this one
+func (s *AccountService) lockHold(ctx context.Context, repo repository.AccountRepository, id uuid.UUID) (*models.Hold, *models.Account, error) {
+	hold, err := s.getHold(ctx, repo, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, err := s.lockAccount(ctx, repo, hold.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hold, err = s.getHold(ctx, repo, id); err != nil {
+		return nil, nil, err
+	}
+	return hold, account, nil
+}
+
+func (s *AccountService) balance(ctx context.Context, repo repository.AccountRepository, account *models.Account) (*models.Balance, error) {
+	held, err := repo.SumActiveHolds(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get held amount: %v", err)
+	}
+	return models.NewBalance(account, held, time.Now().UTC()), nil
+}
+
+// checkHoldAllowed rejects hold operations that the account status forbids.
+// Only legal holds may be placed on or captured from a frozen account.
+func checkHoldAllowed(account *models.Account, holdType models.HoldType) error {
+	switch account.Status {
+	case models.AccountStatusClosed:
+		return status.Errorf(codes.FailedPrecondition, "account is closed")
+	case models.AccountStatusFrozen:
+		if !holdType.IsLegal() {
+			return status.Errorf(codes.FailedPrecondition, "account is frozen")
+		}
+	}
+	return nil
+}
+
+// Helper functions
+
+func parseID(field, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return id, nil
+}
+
+func accountModelToProto(a *models.Account) *accountpb.Account {
+	account := &accountpb.Account{
+		Id:             a.ID.String(),
+		AccountNumber:  a.AccountNumber,
+		Iban:           a.IBAN,
+		CustomerId:     a.CustomerID.String(),
+		AccountType:    string(a.AccountType),
+		Currency:       a.Currency,
+		LedgerBalance:  a.LedgerBalance,
+		Status:         string(a.Status),
+		Version:        int32(a.Version),
+		OverdraftLimit: a.OverdraftLimit,
+		OverdraftRate:  interest.Rate(a.OverdraftRate).Percent(),
+		Segment:        a.Segment,
+	}
+
+	account.OpenedAt = timestamppb.New(a.OpenedAt)
+	account.CreatedAt = timestamppb.New(a.CreatedAt)
+	account.UpdatedAt = timestamppb.New(a.UpdatedAt)
+
+	if a.ClosedAt != nil {
+		account.ClosedAt = timestamppb.New(*a.ClosedAt)
+	}
+
+	return account
+}
+
+func balanceModelToProto(b *models.Balance) *accountpb.Balance {
+	return &accountpb.Balance{
+		AccountId:        b.AccountID.String(),
+		Currency:         b.Currency,
+		LedgerBalance:    b.LedgerBalance,
+		HeldAmount:       b.HeldAmount,
+		OverdraftLimit:   b.OverdraftLimit,
+		AvailableBalance: b.Available,
+		AsOf:             timestamppb.New(b.AsOf),
+	}
+}
+
+func holdModelToProto(h *models.Hold) *accountpb.Hold {
+	hold := &accountpb.Hold{
+		Id:              h.ID.String(),
+		AccountId:       h.AccountID.String(),
+		HoldType:        string(h.HoldType),
+		Amount:          h.Amount,
+		RemainingAmount: h.RemainingAmount,
+		Currency:        h.Currency,
+		Reason:          h.Reason,
+		Reference:       h.Reference,
+		Status:          string(h.Status),
+		CreatedBy:       h.CreatedBy.String(),
+	}
+
+	hold.CreatedAt = timestamppb.New(h.CreatedAt)
+	hold.UpdatedAt = timestamppb.New(h.UpdatedAt)
+
+	if h.ExpiresAt != nil {
+		hold.ExpiresAt = timestamppb.New(*h.ExpiresAt)
+	}
+
+	return hold
+}
+
+func postingModelToProto(p *models.Posting) *accountpb.Posting {
+	posting := &accountpb.Posting{
+		Id:          p.ID.String(),
+		AccountId:   p.AccountID.String(),
+		PostingType: string(p.PostingType),
+		Amount:      p.Amount,
+		Currency:    p.Currency,
+		Reference:   p.Reference,
+		Description: p.Description,
+		ValueDate:   timestamppb.New(p.ValueDate),
+		BookedAt:    timestamppb.New(p.BookedAt),
+	}
+
+	if p.HoldID != nil {
+		posting.HoldId = p.HoldID.String()
+	}
+
+	return posting
+}
+
+// assignAccountNumber gives the account the next number from the sequence,
+// and its IBAN when the bank issues them
+func (s *AccountService) assignAccountNumber(ctx context.Context, repo repository.AccountRepository, account *models.Account) error {
+	seq, err := repo.NextAccountNumberSequence(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to allocate account number: %v", err)
+	}
+	number, iban, err := s.numbering.Assign(seq)
+	if err != nil {
+		return status.Errorf(codes.ResourceExhausted, "failed to assign account number: %v", err)
+	}
+	account.AccountNumber = number
+	account.IBAN = iban
+	return nil
+}
@@ -0,0 +1,1751 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/core-banking/pkg/bankid"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	customerclient "github.com/core-banking/services/customer-service/client"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockRepository is an in-memory implementation of AccountRepository for testing
+type MockRepository struct {
+	accounts  map[uuid.UUID]*models.Account
+	postings  []*models.Posting
+	holds     map[uuid.UUID]*models.Hold
+	accruals  map[uuid.UUID]map[time.Time]*models.InterestAccrual
+	caps      []*models.InterestCapitalization
+	feeRules  []*models.FeeRule
+	fees      map[uuid.UUID]*models.Fee
+	parties   []*models.AccountParty
+	stmts     []*models.Statement
+	docs      map[uuid.UUID][]*models.StatementDocument
+	bankStmts []*models.BankStatement
+	lines     []*models.BankStatementLine
+	matches   []*models.ReconciliationMatch
+	runs      []*models.ReconciliationRun
+	fxRates   []*models.FXRate
+	positions []*models.FXPositionAccount
+	quotes    map[uuid.UUID]*models.FXQuote
+	limits    []*models.LimitRule
+	tiers     map[uuid.UUID]*models.CustomerRiskTier
+	increases []*models.LimitIncrease
+	usages    []*models.LimitUsage
+	locked    []uuid.UUID
+	events    []*models.TransactionEvent
+	alerts    []*models.AMLAlert
+	keys      map[string]*models.TransferKey
+	seq       int64
+	offset    int64
+	nextErr   error
+	// onLock, when set, runs once as the next account lock is taken, to
+	// stand in for a transaction that got the lock first
+	onLock func()
+}
+
+func NewMockRepository() *MockRepository {
+	return &MockRepository{
+		accounts: make(map[uuid.UUID]*models.Account),
+		holds:    make(map[uuid.UUID]*models.Hold),
+		accruals: make(map[uuid.UUID]map[time.Time]*models.InterestAccrual),
+		fees:     make(map[uuid.UUID]*models.Fee),
+		docs:     make(map[uuid.UUID][]*models.StatementDocument),
+		quotes:   make(map[uuid.UUID]*models.FXQuote),
+		tiers:    make(map[uuid.UUID]*models.CustomerRiskTier),
+		keys:     make(map[string]*models.TransferKey),
+	}
+}
+
+func (m *MockRepository) CreateAccount(ctx context.Context, account *models.Account) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	account.CreatedAt = time.Now().UTC()
+	account.UpdatedAt = time.Now().UTC()
+	account.Version = 1
+	m.accounts[account.ID] = account
+	return nil
+}
+
+func (m *MockRepository) GetAccountByID(ctx context.Context, id uuid.UUID) (*models.Account, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	account, exists := m.accounts[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	copied := *account
+	return &copied, nil
+}
+
+func (m *MockRepository) GetAccountByNumber(ctx context.Context, accountNumber string) (*models.Account, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, account := range m.accounts {
+		if account.AccountNumber == accountNumber {
+			copied := *account
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) GetAccountForUpdate(ctx context.Context, id uuid.UUID) (*models.Account, error) {
+	if onLock := m.onLock; onLock != nil {
+		m.onLock = nil
+		onLock()
+	}
+	return m.GetAccountByID(ctx, id)
+}
+
+func (m *MockRepository) UpdateAccount(ctx context.Context, account *models.Account) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	existing, exists := m.accounts[account.ID]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	if existing.Version != account.Version {
+		return &repository.ErrOptimisticLock{AccountID: account.ID}
+	}
+	account.UpdatedAt = time.Now().UTC()
+	account.Version++
+	copied := *account
+	copied.LedgerBalance = existing.LedgerBalance
+	m.accounts[account.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) ListAccountsByCustomer(ctx context.Context, customerID uuid.UUID) ([]*models.Account, error) {
+	var accounts []*models.Account
+	for _, a := range m.accounts {
+		for _, p := range m.parties {
+			if p.AccountID == a.ID && p.CustomerID == customerID {
+				copied := *a
+				accounts = append(accounts, &copied)
+				break
+			}
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].OpenedAt.Before(accounts[j].OpenedAt) })
+	return accounts, nil
+}
+
+func (m *MockRepository) CreateParty(ctx context.Context, party *models.AccountParty) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	party.CreatedAt = time.Now().UTC()
+	party.UpdatedAt = party.CreatedAt
+	copied := *party
+	m.parties = append(m.parties, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetPartyByID(ctx context.Context, id uuid.UUID) (*models.AccountParty, error) {
+	for _, p := range m.parties {
+		if p.ID == id {
+			copied := *p
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) UpdateParty(ctx context.Context, party *models.AccountParty) error {
+	for i, p := range m.parties {
+		if p.ID == party.ID {
+			party.UpdatedAt = time.Now().UTC()
+			copied := *party
+			m.parties[i] = &copied
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) ListParties(ctx context.Context, accountID uuid.UUID) ([]*models.AccountParty, error) {
+	var parties []*models.AccountParty
+	for _, p := range m.parties {
+		if p.AccountID == accountID {
+			copied := *p
+			parties = append(parties, &copied)
+		}
+	}
+	return parties, nil
+}
+
+func (m *MockRepository) ListPartiesByCustomer(ctx context.Context, customerID uuid.UUID) ([]*models.AccountParty, error) {
+	var parties []*models.AccountParty
+	for _, p := range m.parties {
+		if p.CustomerID == customerID {
+			copied := *p
+			parties = append(parties, &copied)
+		}
+	}
+	return parties, nil
+}
+
+func (m *MockRepository) ListOpenAccountsByType(ctx context.Context, accountType models.AccountType) ([]*models.Account, error) {
+	var accounts []*models.Account
+	for _, a := range m.accounts {
+		if a.AccountType == accountType && a.Status != models.AccountStatusClosed {
+			copied := *a
+			accounts = append(accounts, &copied)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID.String() < accounts[j].ID.String() })
+	return accounts, nil
+}
+
+func (m *MockRepository) NextAccountNumberSequence(ctx context.Context) (int64, error) {
+	if m.nextErr != nil {
+		return 0, m.nextErr
+	}
+	m.seq++
+	return m.seq, nil
+}
+
+func (m *MockRepository) AddPosting(ctx context.Context, posting *models.Posting) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	account, exists := m.accounts[posting.AccountID]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	posting.BookedAt = time.Now().UTC()
+	if posting.ValueDate.IsZero() {
+		posting.ValueDate = posting.BookedAt
+	}
+	account.LedgerBalance += posting.Amount
+	m.postings = append(m.postings, posting)
+	return nil
+}
+
+func (m *MockRepository) ListPostings(ctx context.Context, accountID uuid.UUID, from, to time.Time) ([]*models.Posting, error) {
+	var postings []*models.Posting
+	for _, p := range m.postings {
+		if p.AccountID == accountID && !p.BookedAt.Before(from) && p.BookedAt.Before(to) {
+			postings = append(postings, p)
+		}
+	}
+	return postings, nil
+}
+
+func (m *MockRepository) GetBalanceAsOf(ctx context.Context, accountID uuid.UUID, valueDate time.Time) (int64, error) {
+	var balance int64
+	for _, p := range m.postings {
+		if p.AccountID == accountID && !p.ValueDate.After(valueDate) {
+			balance += p.Amount
+		}
+	}
+	return balance, nil
+}
+
+func (m *MockRepository) SumPostingsBookedBefore(ctx context.Context, accountID uuid.UUID, before time.Time) (int64, error) {
+	var balance int64
+	for _, p := range m.postings {
+		if p.AccountID == accountID && p.BookedAt.Before(before) {
+			balance += p.Amount
+		}
+	}
+	return balance, nil
+}
+
+func (m *MockRepository) EarliestValueDateBookedSince(ctx context.Context, accountID uuid.UUID, since time.Time) (*time.Time, error) {
+	var earliest *time.Time
+	for _, p := range m.postings {
+		if p.AccountID == accountID && !p.BookedAt.Before(since) && (earliest == nil || p.ValueDate.Before(*earliest)) {
+			valueDate := p.ValueDate
+			earliest = &valueDate
+		}
+	}
+	return earliest, nil
+}
+
+func (m *MockRepository) GetPostings(ctx context.Context, ids []uuid.UUID) ([]*models.Posting, error) {
+	var postings []*models.Posting
+	for _, p := range m.postings {
+		if slices.Contains(ids, p.ID) {
+			copied := *p
+			postings = append(postings, &copied)
+		}
+	}
+	return postings, nil
+}
+
+func (m *MockRepository) CreateTransferKey(ctx context.Context, key *models.TransferKey) error {
+	if _, exists := m.keys[key.Key]; exists {
+		return repository.ErrDuplicate
+	}
+	copied := *key
+	m.keys[key.Key] = &copied
+	return nil
+}
+
+func (m *MockRepository) GetTransferKey(ctx context.Context, key string) (*models.TransferKey, error) {
+	transferKey, exists := m.keys[key]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	copied := *transferKey
+	return &copied, nil
+}
+
+func (m *MockRepository) UpsertAccrual(ctx context.Context, accrual *models.InterestAccrual) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if m.accruals[accrual.AccountID] == nil {
+		m.accruals[accrual.AccountID] = make(map[time.Time]*models.InterestAccrual)
+	}
+	copied := *accrual
+	m.accruals[accrual.AccountID][accrual.AccrualDate] = &copied
+	return nil
+}
+
+func (m *MockRepository) GetLatestAccrual(ctx context.Context, accountID uuid.UUID) (*models.InterestAccrual, error) {
+	var latest *models.InterestAccrual
+	for _, a := range m.accruals[accountID] {
+		if latest == nil || a.AccrualDate.After(latest.AccrualDate) {
+			latest = a
+		}
+	}
+	if latest == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *latest
+	return &copied, nil
+}
+
+func (m *MockRepository) ListAccruals(ctx context.Context, accountID uuid.UUID, from, to time.Time) ([]*models.InterestAccrual, error) {
+	var accruals []*models.InterestAccrual
+	for _, a := range m.accruals[accountID] {
+		if !a.AccrualDate.Before(from) && !a.AccrualDate.After(to) {
+			copied := *a
+			accruals = append(accruals, &copied)
+		}
+	}
+	sort.Slice(accruals, func(i, j int) bool { return accruals[i].AccrualDate.Before(accruals[j].AccrualDate) })
+	return accruals, nil
+}
+
+func (m *MockRepository) SumAccruals(ctx context.Context, accountID uuid.UUID, through time.Time) (int64, int64, error) {
+	var credit, debit int64
+	for _, a := range m.accruals[accountID] {
+		if !a.AccrualDate.After(through) {
+			credit += a.AmountMicros
+			debit += a.DebitMicros
+		}
+	}
+	return credit, debit, nil
+}
+
+func (m *MockRepository) CreateCapitalization(ctx context.Context, capitalization *models.InterestCapitalization) error {
+	for _, c := range m.caps {
+		if c.AccountID == capitalization.AccountID && c.PeriodEnd.Equal(capitalization.PeriodEnd) {
+			return errors.New("duplicate capitalization")
+		}
+	}
+	capitalization.CreatedAt = time.Now().UTC()
+	copied := *capitalization
+	m.caps = append(m.caps, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetCapitalization(ctx context.Context, accountID uuid.UUID, periodEnd time.Time) (*models.InterestCapitalization, error) {
+	for _, c := range m.caps {
+		if c.AccountID == accountID && c.PeriodEnd.Equal(periodEnd) {
+			copied := *c
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) SumCapitalizations(ctx context.Context, accountID uuid.UUID) (int64, int64, error) {
+	var credit, debit int64
+	for _, c := range m.caps {
+		if c.AccountID == accountID {
+			credit += c.Amount
+			debit += c.DebitAmount
+		}
+	}
+	return credit, debit, nil
+}
+
+func (m *MockRepository) CreateFeeRule(ctx context.Context, rule *models.FeeRule) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	rule.CreatedAt = time.Now().UTC()
+	copied := *rule
+	m.feeRules = append(m.feeRules, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetFeeRule(ctx context.Context, accountType models.AccountType, feeType models.FeeType, currency string, at time.Time) (*models.FeeRule, error) {
+	var current *models.FeeRule
+	for _, r := range m.feeRules {
+		if r.AccountType != accountType || r.FeeType != feeType || r.Currency != currency || r.EffectiveFrom.After(at) {
+			continue
+		}
+		if current == nil || !r.EffectiveFrom.Before(current.EffectiveFrom) {
+			current = r
+		}
+	}
+	if current == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *current
+	return &copied, nil
+}
+
+func (m *MockRepository) ListFeeRules(ctx context.Context, accountType models.AccountType) ([]*models.FeeRule, error) {
+	var rules []*models.FeeRule
+	for _, r := range m.feeRules {
+		if accountType == "" || r.AccountType == accountType {
+			copied := *r
+			rules = append(rules, &copied)
+		}
+	}
+	return rules, nil
+}
+
+func (m *MockRepository) CreateFee(ctx context.Context, fee *models.Fee) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	copied := *fee
+	m.fees[fee.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) GetFeeByID(ctx context.Context, id uuid.UUID) (*models.Fee, error) {
+	fee, exists := m.fees[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	copied := *fee
+	return &copied, nil
+}
+
+func (m *MockRepository) GetFeeByReference(ctx context.Context, accountID uuid.UUID, feeType models.FeeType, reference string) (*models.Fee, error) {
+	for _, f := range m.fees {
+		if f.AccountID == accountID && f.FeeType == feeType && f.Reference == reference {
+			copied := *f
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) UpdateFee(ctx context.Context, fee *models.Fee) error {
+	existing, exists := m.fees[fee.ID]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	if existing.Status != models.FeeStatusCharged {
+		return repository.ErrConflict
+	}
+	copied := *fee
+	m.fees[fee.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) ListFees(ctx context.Context, accountID uuid.UUID) ([]*models.Fee, error) {
+	var fees []*models.Fee
+	for _, f := range m.fees {
+		if f.AccountID == accountID {
+			copied := *f
+			fees = append(fees, &copied)
+		}
+	}
+	sort.Slice(fees, func(i, j int) bool { return fees[i].ChargedAt.Before(fees[j].ChargedAt) })
+	return fees, nil
+}
+
+func (m *MockRepository) CreateHold(ctx context.Context, hold *models.Hold) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	hold.CreatedAt = time.Now().UTC()
+	hold.UpdatedAt = time.Now().UTC()
+	copied := *hold
+	m.holds[hold.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) GetHoldByID(ctx context.Context, id uuid.UUID) (*models.Hold, error) {
+	hold, exists := m.holds[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	copied := *hold
+	return &copied, nil
+}
+
+func (m *MockRepository) UpdateHold(ctx context.Context, hold *models.Hold) error {
+	existing, exists := m.holds[hold.ID]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	if existing.Status != models.HoldStatusActive {
+		return repository.ErrConflict
+	}
+	hold.UpdatedAt = time.Now().UTC()
+	copied := *hold
+	m.holds[hold.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) ListHolds(ctx context.Context, accountID uuid.UUID, activeOnly bool) ([]*models.Hold, error) {
+	var holds []*models.Hold
+	for _, h := range m.holds {
+		if h.AccountID != accountID || (activeOnly && h.Status != models.HoldStatusActive) {
+			continue
+		}
+		copied := *h
+		holds = append(holds, &copied)
+	}
+	sort.Slice(holds, func(i, j int) bool { return holds[i].CreatedAt.Before(holds[j].CreatedAt) })
+	return holds, nil
+}
+
+func (m *MockRepository) SumActiveHolds(ctx context.Context, accountID uuid.UUID) (int64, error) {
+	var total int64
+	for _, h := range m.holds {
+		if h.AccountID == accountID && h.Status == models.HoldStatusActive {
+			total += h.RemainingAmount
+		}
+	}
+	return total, nil
+}
+
+func (m *MockRepository) ListExpiredHolds(ctx context.Context, now time.Time, limit int) ([]*models.Hold, error) {
+	var holds []*models.Hold
+	for _, h := range m.holds {
+		if h.Status != models.HoldStatusActive || !h.IsExpired(now) {
+			continue
+		}
+		if h.HoldType.IsLegal() && m.accounts[h.AccountID].Status == models.AccountStatusFrozen {
+			continue
+		}
+		copied := *h
+		holds = append(holds, &copied)
+	}
+	sort.Slice(holds, func(i, j int) bool { return holds[i].ExpiresAt.Before(*holds[j].ExpiresAt) })
+	if len(holds) > limit {
+		holds = holds[:limit]
+	}
+	return holds, nil
+}
+
+func (m *MockRepository) CreateStatement(ctx context.Context, statement *models.Statement, documents []*models.StatementDocument) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for _, existing := range m.stmts {
+		if existing.AccountID == statement.AccountID && existing.PeriodStart.Equal(statement.PeriodStart) &&
+			existing.PeriodEnd.Equal(statement.PeriodEnd) && existing.Version == statement.Version {
+			return errors.New("duplicate statement version")
+		}
+	}
+	copied := *statement
+	m.stmts = append(m.stmts, &copied)
+	for _, d := range documents {
+		doc := *d
+		doc.Content = append([]byte(nil), d.Content...)
+		m.docs[statement.ID] = append(m.docs[statement.ID], &doc)
+	}
+	return nil
+}
+
+func (m *MockRepository) GetStatement(ctx context.Context, id uuid.UUID) (*models.Statement, error) {
+	for _, s := range m.stmts {
+		if s.ID == id {
+			copied := *s
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) GetLatestStatement(ctx context.Context, accountID uuid.UUID, periodStart, periodEnd time.Time) (*models.Statement, error) {
+	var latest *models.Statement
+	for _, s := range m.stmts {
+		if s.AccountID == accountID && s.PeriodStart.Equal(periodStart) && s.PeriodEnd.Equal(periodEnd) &&
+			(latest == nil || s.Version > latest.Version) {
+			latest = s
+		}
+	}
+	if latest == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *latest
+	return &copied, nil
+}
+
+func (m *MockRepository) ListStatements(ctx context.Context, accountID uuid.UUID) ([]*models.Statement, error) {
+	var statements []*models.Statement
+	for _, s := range m.stmts {
+		if s.AccountID == accountID {
+			copied := *s
+			statements = append(statements, &copied)
+		}
+	}
+	sort.Slice(statements, func(i, j int) bool {
+		if !statements[i].PeriodEnd.Equal(statements[j].PeriodEnd) {
+			return statements[i].PeriodEnd.After(statements[j].PeriodEnd)
+		}
+		return statements[i].Version > statements[j].Version
+	})
+	return statements, nil
+}
+
+func (m *MockRepository) GetStatementDocument(ctx context.Context, statementID uuid.UUID, format models.StatementFormat) (*models.StatementDocument, error) {
+	for _, d := range m.docs[statementID] {
+		if d.Format == format {
+			copied := *d
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListStatementDocuments(ctx context.Context, statementID uuid.UUID) ([]*models.StatementDocument, error) {
+	var docs []*models.StatementDocument
+	for _, d := range m.docs[statementID] {
+		docs = append(docs, &models.StatementDocument{StatementID: d.StatementID, Format: d.Format, ContentHash: d.ContentHash})
+	}
+	return docs, nil
+}
+
+func (m *MockRepository) CreateBankStatement(ctx context.Context, statement *models.BankStatement, lines []*models.BankStatementLine) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for _, existing := range m.bankStmts {
+		if existing.AccountID == statement.AccountID && existing.ExternalID == statement.ExternalID {
+			return repository.ErrDuplicate
+		}
+	}
+	if statement.ID == uuid.Nil {
+		statement.ID = uuid.New()
+	}
+	statement.ImportedAt = time.Now().UTC()
+	statement.LineCount = len(lines)
+	copied := *statement
+	m.bankStmts = append(m.bankStmts, &copied)
+	for _, l := range lines {
+		if l.ID == uuid.Nil {
+			l.ID = uuid.New()
+		}
+		l.StatementID, l.AccountID = statement.ID, statement.AccountID
+		l.Status, l.MatchID = models.ReconciliationStatusUnmatched, nil
+		line := *l
+		m.lines = append(m.lines, &line)
+	}
+	return nil
+}
+
+func (m *MockRepository) ListBankStatements(ctx context.Context, accountID uuid.UUID) ([]*models.BankStatement, error) {
+	var statements []*models.BankStatement
+	for _, s := range m.bankStmts {
+		if s.AccountID == accountID {
+			copied := *s
+			statements = append(statements, &copied)
+		}
+	}
+	sort.SliceStable(statements, func(i, j int) bool { return statements[i].ClosingDate.Before(statements[j].ClosingDate) })
+	return statements, nil
+}
+
+func (m *MockRepository) ListBankStatementAccounts(ctx context.Context) ([]uuid.UUID, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, s := range m.bankStmts {
+		if !seen[s.AccountID] {
+			seen[s.AccountID] = true
+			ids = append(ids, s.AccountID)
+		}
+	}
+	return ids, nil
+}
+
+func (m *MockRepository) ListBankStatementLines(ctx context.Context, accountID uuid.UUID, status models.ReconciliationStatus, through time.Time) ([]*models.BankStatementLine, error) {
+	var lines []*models.BankStatementLine
+	for _, l := range m.lines {
+		if l.AccountID == accountID && !l.ValueDate.After(through) && (status == "" || l.Status == status) {
+			copied := *l
+			lines = append(lines, &copied)
+		}
+	}
+	return lines, nil
+}
+
+func (m *MockRepository) GetBankStatementLines(ctx context.Context, ids []uuid.UUID) ([]*models.BankStatementLine, error) {
+	var lines []*models.BankStatementLine
+	for _, l := range m.lines {
+		for _, id := range ids {
+			if l.ID == id {
+				copied := *l
+				lines = append(lines, &copied)
+			}
+		}
+	}
+	return lines, nil
+}
+
+// reconciled reports whether a posting belongs to a match
+func (m *MockRepository) reconciled(postingID uuid.UUID) bool {
+	for _, match := range m.matches {
+		for _, id := range match.PostingIDs {
+			if id == postingID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *MockRepository) ListUnreconciledPostings(ctx context.Context, accountID uuid.UUID, from, through time.Time) ([]*models.Posting, error) {
+	var postings []*models.Posting
+	for _, p := range m.postings {
+		if p.AccountID == accountID && !p.ValueDate.Before(from) && !p.ValueDate.After(through) && !m.reconciled(p.ID) {
+			postings = append(postings, p)
+		}
+	}
+	return postings, nil
+}
+
+func (m *MockRepository) GetUnreconciledPostings(ctx context.Context, ids []uuid.UUID) ([]*models.Posting, error) {
+	var postings []*models.Posting
+	for _, p := range m.postings {
+		for _, id := range ids {
+			if p.ID == id && !m.reconciled(p.ID) {
+				postings = append(postings, p)
+			}
+		}
+	}
+	return postings, nil
+}
+
+func (m *MockRepository) CreateReconciliationMatch(ctx context.Context, match *models.ReconciliationMatch) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for _, id := range match.PostingIDs {
+		if m.reconciled(id) {
+			return models.ErrAlreadyReconciled
+		}
+	}
+	var lines []*models.BankStatementLine
+	for _, id := range match.LineIDs {
+		for _, l := range m.lines {
+			if l.ID == id {
+				if l.Status != models.ReconciliationStatusUnmatched || l.AccountID != match.AccountID {
+					return models.ErrAlreadyReconciled
+				}
+				lines = append(lines, l)
+			}
+		}
+	}
+	if match.ID == uuid.Nil {
+		match.ID = uuid.New()
+	}
+	match.CreatedAt = time.Now().UTC()
+	for _, l := range lines {
+		l.Status = models.ReconciliationStatusMatched
+		if match.Kind == models.MatchKindWriteOff {
+			l.Status = models.ReconciliationStatusWrittenOff
+		}
+		id := match.ID
+		l.MatchID = &id
+	}
+	copied := *match
+	m.matches = append(m.matches, &copied)
+	return nil
+}
+
+func (m *MockRepository) DeleteAutoMatches(ctx context.Context, accountID uuid.UUID, through time.Time) (int, error) {
+	closing := make(map[uuid.UUID]time.Time)
+	for _, stmt := range m.bankStmts {
+		closing[stmt.ID] = stmt.ClosingDate
+	}
+	later := func(match *models.ReconciliationMatch) bool {
+		for _, l := range m.lines {
+			if l.MatchID != nil && *l.MatchID == match.ID && (closing[l.StatementID].After(through) || l.ValueDate.After(through)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var kept []*models.ReconciliationMatch
+	deleted := 0
+	for _, match := range m.matches {
+		if match.AccountID != accountID || match.Kind != models.MatchKindAuto || later(match) {
+			kept = append(kept, match)
+			continue
+		}
+		deleted++
+		for _, l := range m.lines {
+			if l.MatchID != nil && *l.MatchID == match.ID {
+				l.Status, l.MatchID = models.ReconciliationStatusUnmatched, nil
+			}
+		}
+	}
+	m.matches = kept
+	return deleted, nil
+}
+
+func (m *MockRepository) CreateReconciliationRun(ctx context.Context, run *models.ReconciliationRun) error {
+	if run.ID == uuid.Nil {
+		run.ID = uuid.New()
+	}
+	if run.RunAt.IsZero() {
+		run.RunAt = time.Now().UTC()
+	}
+	copied := *run
+	m.runs = append(m.runs, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetReconciliationRun(ctx context.Context, id uuid.UUID) (*models.ReconciliationRun, error) {
+	for _, r := range m.runs {
+		if r.ID == id {
+			copied := *r
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) CreateFXRates(ctx context.Context, rates []*models.FXRate) (int, error) {
+	if m.nextErr != nil {
+		return 0, m.nextErr
+	}
+	created := 0
+next:
+	for _, rate := range rates {
+		for _, r := range m.fxRates {
+			if r.BaseCurrency == rate.BaseCurrency && r.QuoteCurrency == rate.QuoteCurrency &&
+				r.EffectiveFrom.Equal(rate.EffectiveFrom) && r.MidRate == rate.MidRate {
+				continue next
+			}
+		}
+		rate.CreatedAt = time.Now().UTC()
+		copied := *rate
+		m.fxRates = append(m.fxRates, &copied)
+		created++
+	}
+	return created, nil
+}
+
+func (m *MockRepository) GetFXRate(ctx context.Context, baseCurrency, quoteCurrency string, at time.Time) (*models.FXRate, error) {
+	var current *models.FXRate
+	for _, r := range m.fxRates {
+		if r.BaseCurrency != baseCurrency || r.QuoteCurrency != quoteCurrency || r.EffectiveFrom.After(at) {
+			continue
+		}
+		if current == nil || !r.EffectiveFrom.Before(current.EffectiveFrom) {
+			current = r
+		}
+	}
+	if current == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *current
+	return &copied, nil
+}
+
+func (m *MockRepository) ListFXRates(ctx context.Context, baseCurrency, quoteCurrency string) ([]*models.FXRate, error) {
+	var rates []*models.FXRate
+	for i := len(m.fxRates) - 1; i >= 0; i-- {
+		r := m.fxRates[i]
+		if r.BaseCurrency == baseCurrency && r.QuoteCurrency == quoteCurrency {
+			copied := *r
+			rates = append(rates, &copied)
+		}
+	}
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].EffectiveFrom.After(rates[j].EffectiveFrom)
+	})
+	return rates, nil
+}
+
+func (m *MockRepository) SetFXPositionAccount(ctx context.Context, position *models.FXPositionAccount) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	position.UpdatedAt = time.Now().UTC()
+	for _, p := range m.positions {
+		if p.AccountID == position.AccountID && (p.CurrencyPair != position.CurrencyPair || p.Currency != position.Currency) {
+			return repository.ErrDuplicate
+		}
+	}
+	copied := *position
+	for i, p := range m.positions {
+		if p.CurrencyPair == position.CurrencyPair && p.Currency == position.Currency {
+			m.positions[i] = &copied
+			return nil
+		}
+	}
+	m.positions = append(m.positions, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetFXPositionAccount(ctx context.Context, currencyPair, currency string) (*models.FXPositionAccount, error) {
+	for _, p := range m.positions {
+		if p.CurrencyPair == currencyPair && p.Currency == currency {
+			copied := *p
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) IsFXPositionAccount(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	for _, p := range m.positions {
+		if p.AccountID == accountID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockRepository) CreateFXQuote(ctx context.Context, quote *models.FXQuote) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	copied := *quote
+	m.quotes[quote.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) GetFXQuote(ctx context.Context, id uuid.UUID) (*models.FXQuote, error) {
+	quote, exists := m.quotes[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	copied := *quote
+	return &copied, nil
+}
+
+func (m *MockRepository) GetFXQuoteForUpdate(ctx context.Context, id uuid.UUID) (*models.FXQuote, error) {
+	return m.GetFXQuote(ctx, id)
+}
+
+func (m *MockRepository) UpdateFXQuote(ctx context.Context, quote *models.FXQuote) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if _, exists := m.quotes[quote.ID]; !exists {
+		return repository.ErrNotFound
+	}
+	copied := *quote
+	m.quotes[quote.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) CreateLimitRule(ctx context.Context, rule *models.LimitRule) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	rule.CreatedAt = time.Now().UTC()
+	copied := *rule
+	m.limits = append(m.limits, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetLimitRule(ctx context.Context, id uuid.UUID) (*models.LimitRule, error) {
+	for _, r := range m.limits {
+		if r.ID == id {
+			copied := *r
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListLimitRules(ctx context.Context) ([]*models.LimitRule, error) {
+	rules := make([]*models.LimitRule, len(m.limits))
+	for i, r := range m.limits {
+		copied := *r
+		rules[i] = &copied
+	}
+	return rules, nil
+}
+
+func (m *MockRepository) ListEffectiveLimitRules(ctx context.Context, currency string, at time.Time) ([]*models.LimitRule, error) {
+	type scope struct {
+		kind     models.LimitKind
+		channel  models.Channel
+		txType   models.TransactionType
+		riskTier models.RiskTier
+	}
+	current := make(map[scope]*models.LimitRule)
+	var order []scope
+	for _, r := range m.limits {
+		if r.Currency != currency || r.EffectiveFrom.After(at) {
+			continue
+		}
+		key := scope{r.Kind, r.Channel, r.TransactionType, r.RiskTier}
+		existing, ok := current[key]
+		if !ok {
+			order = append(order, key)
+		}
+		if !ok || !r.EffectiveFrom.Before(existing.EffectiveFrom) {
+			current[key] = r
+		}
+	}
+	rules := make([]*models.LimitRule, len(order))
+	for i, key := range order {
+		copied := *current[key]
+		rules[i] = &copied
+	}
+	return rules, nil
+}
+
+func (m *MockRepository) GetCustomerRiskTier(ctx context.Context, customerID uuid.UUID) (*models.CustomerRiskTier, error) {
+	tier, exists := m.tiers[customerID]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	copied := *tier
+	return &copied, nil
+}
+
+func (m *MockRepository) SetCustomerRiskTier(ctx context.Context, tier *models.CustomerRiskTier) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	tier.UpdatedAt = time.Now().UTC()
+	copied := *tier
+	m.tiers[tier.CustomerID] = &copied
+	return nil
+}
+
+func (m *MockRepository) CreateLimitIncrease(ctx context.Context, increase *models.LimitIncrease) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	increase.CreatedAt = time.Now().UTC()
+	copied := *increase
+	m.increases = append(m.increases, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetLimitIncrease(ctx context.Context, id uuid.UUID) (*models.LimitIncrease, error) {
+	for _, i := range m.increases {
+		if i.ID == id {
+			copied := *i
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListActiveLimitIncreases(ctx context.Context, customerID uuid.UUID, at time.Time) ([]*models.LimitIncrease, error) {
+	var increases []*models.LimitIncrease
+	for _, i := range m.increases {
+		if i.CustomerID == customerID && i.ActiveAt(at) {
+			copied := *i
+			increases = append(increases, &copied)
+		}
+	}
+	return increases, nil
+}
+
+func (m *MockRepository) RevokeLimitIncrease(ctx context.Context, increase *models.LimitIncrease) error {
+	for _, i := range m.increases {
+		if i.ID == increase.ID && i.RevokedAt == nil {
+			i.RevokedAt = increase.RevokedAt
+			i.RevokedBy = increase.RevokedBy
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) LockCustomerLimits(ctx context.Context, customerID uuid.UUID) error {
+	m.locked = append(m.locked, customerID)
+	return nil
+}
+
+func (m *MockRepository) SumLimitUsage(ctx context.Context, filter models.LimitUsageFilter) (int64, int64, error) {
+	var amount, count int64
+	for _, u := range m.usages {
+		if u.CustomerID != filter.CustomerID || u.Currency != filter.Currency ||
+			u.OccurredAt.Before(filter.From) || !u.OccurredAt.Before(filter.To) ||
+			(filter.Channel != "" && u.Channel != filter.Channel) ||
+			(filter.TransactionType != "" && u.TransactionType != filter.TransactionType) {
+			continue
+		}
+		amount += u.Amount
+		count++
+	}
+	return amount, count, nil
+}
+
+func (m *MockRepository) RecordLimitUsage(ctx context.Context, usage *models.LimitUsage) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	copied := *usage
+	m.usages = append(m.usages, &copied)
+	return nil
+}
+
+func (m *MockRepository) RecordTransactionEvent(ctx context.Context, event *models.TransactionEvent) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	event.Sequence = int64(len(m.events) + 1)
+	copied := *event
+	m.events = append(m.events, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetTransactionEvents(ctx context.Context, ids []uuid.UUID) ([]*models.TransactionEvent, error) {
+	var events []*models.TransactionEvent
+	for _, e := range m.events {
+		if slices.Contains(ids, e.ID) {
+			copied := *e
+			events = append(events, &copied)
+		}
+	}
+	return events, nil
+}
+
+func (m *MockRepository) ListUnmonitoredTransactionEvents(ctx context.Context, limit int) ([]*models.TransactionEvent, error) {
+	var events []*models.TransactionEvent
+	for _, e := range m.events {
+		if e.MonitoredAt == nil && len(events) < limit {
+			copied := *e
+			events = append(events, &copied)
+		}
+	}
+	return events, nil
+}
+
+func (m *MockRepository) ListCustomerTransactionEvents(ctx context.Context, customerID uuid.UUID, from time.Time, beforeSequence int64) ([]*models.TransactionEvent, error) {
+	var events []*models.TransactionEvent
+	for _, e := range m.events {
+		if e.CustomerID == customerID && !e.OccurredAt.Before(from) && e.Sequence < beforeSequence {
+			copied := *e
+			events = append(events, &copied)
+		}
+	}
+	return events, nil
+}
+
+func (m *MockRepository) ListTransactionEvents(ctx context.Context, from, to time.Time) ([]*models.TransactionEvent, error) {
+	var events []*models.TransactionEvent
+	for _, e := range m.events {
+		if !e.OccurredAt.Before(from) && e.OccurredAt.Before(to) {
+			copied := *e
+			events = append(events, &copied)
+		}
+	}
+	return events, nil
+}
+
+func (m *MockRepository) MarkTransactionEventMonitored(ctx context.Context, id uuid.UUID, at time.Time) error {
+	for _, e := range m.events {
+		if e.ID == id {
+			e.MonitoredAt = &at
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) CreateAMLAlert(ctx context.Context, alert *models.AMLAlert) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if alert.ID == uuid.Nil {
+		alert.ID = uuid.New()
+	}
+	alert.CreatedAt = time.Now().UTC()
+	copied := *alert
+	m.alerts = append(m.alerts, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetAMLAlert(ctx context.Context, id uuid.UUID) (*models.AMLAlert, error) {
+	for _, a := range m.alerts {
+		if a.ID == id {
+			copied := *a
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListAMLAlerts(ctx context.Context, filter models.AMLAlertFilter) ([]*models.AMLAlert, error) {
+	var alerts []*models.AMLAlert
+	for i := len(m.alerts) - 1; i >= 0; i-- {
+		a := m.alerts[i]
+		if (filter.CustomerID != nil && a.CustomerID != *filter.CustomerID) ||
+			(filter.Status != "" && a.Status != filter.Status) ||
+			(filter.RuleID != "" && a.RuleID != filter.RuleID) {
+			continue
+		}
+		if filter.Limit > 0 && len(alerts) == filter.Limit {
+			break
+		}
+		copied := *a
+		alerts = append(alerts, &copied)
+	}
+	return alerts, nil
+}
+
+func (m *MockRepository) UpdateAMLAlert(ctx context.Context, alert *models.AMLAlert) error {
+	for i, a := range m.alerts {
+		if a.ID == alert.ID {
+			copied := *alert
+			m.alerts[i] = &copied
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) LastAMLAlertTimes(ctx context.Context, customerID uuid.UUID) (map[string]time.Time, error) {
+	last := make(map[string]time.Time)
+	for _, a := range m.alerts {
+		if a.CustomerID == customerID && a.RaisedAt.After(last[a.RuleID]) {
+			last[a.RuleID] = a.RaisedAt
+		}
+	}
+	return last, nil
+}
+
+func (m *MockRepository) LockCustomerEventOffset(ctx context.Context) (int64, error) {
+	if m.nextErr != nil {
+		return 0, m.nextErr
+	}
+	return m.offset, nil
+}
+
+func (m *MockRepository) SetCustomerEventOffset(ctx context.Context, sequence int64) error {
+	m.offset = sequence
+	return nil
+}
+
+func (m *MockRepository) BeginTx(ctx context.Context) (repository.Tx, error) {
+	return &mockTx{repo: m}, nil
+}
+
+// mockTx runs every operation directly against the mock repository
+type mockTx struct {
+	repo *MockRepository
+}
+
+func (t *mockTx) Commit(ctx context.Context) error                { return nil }
+func (t *mockTx) Rollback(ctx context.Context) error              { return nil }
+func (t *mockTx) AccountRepository() repository.AccountRepository { return t.repo }
+
+// mockCustomerDirectory reports every customer as Active unless it is given
+// another status; an empty status means the customer does not exist
+type mockCustomerDirectory map[uuid.UUID]string
+
+func (d mockCustomerDirectory) GetCustomer(ctx context.Context, id uuid.UUID) (*customerclient.Customer, error) {
+	customerStatus, listed := d[id]
+	if !listed {
+		customerStatus = "Active"
+	}
+	if customerStatus == "" {
+		return nil, customerclient.ErrNotFound
+	}
+	return &customerclient.Customer{ID: id, Status: customerStatus}, nil
+}
+
+func (d mockCustomerDirectory) GetCustomerProfile(ctx context.Context, id uuid.UUID) (*customerclient.Customer, error) {
+	customer, err := d.GetCustomer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	customer.CustomerNumber = "CUST-0001"
+	customer.FirstName = "Ada"
+	customer.LastName = "Lovelace"
+	customer.PrimaryAddress = &customerclient.Address{
+		Street1:    "12 St James's Square",
+		City:       "London",
+		PostalCode: "SW1Y 4JH",
+		Country:    "GB",
+	}
+	return customer, nil
+}
+
+// Test helpers
+
+func seedAccount(repo *MockRepository, balance int64, accountStatus models.AccountStatus) *models.Account {
+	account := &models.Account{
+		ID:            uuid.New(),
+		AccountNumber: "ACCT-1",
+		CustomerID:    uuid.New(),
+		AccountType:   models.AccountTypeChecking,
+		Currency:      "USD",
+		LedgerBalance: balance,
+		Status:        accountStatus,
+		OpenedAt:      time.Now().UTC(),
+		Version:       1,
+	}
+	repo.accounts[account.ID] = account
+	repo.parties = append(repo.parties, &models.AccountParty{
+		ID:          uuid.New(),
+		AccountID:   account.ID,
+		CustomerID:  account.CustomerID,
+		Role:        models.PartyRolePrimaryHolder,
+		SigningRule: models.SigningRuleAnyOne,
+		StartDate:   account.OpenedAt,
+	})
+	return account
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if want == codes.OK {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	if err == nil {
+		t.Fatalf("expected error with code %v, got nil", want)
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("error is not a gRPC status error: %v", err)
+	}
+	if st.Code() != want {
+		t.Fatalf("got code %v, want %v (%v)", st.Code(), want, err)
+	}
+}
+
+// Tests for AccountService
+
+func TestAccountService_OpenAccount(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+
+	resp, err := svc.OpenAccount(ctx, &accountpb.OpenAccountRequest{
+		CustomerId:  uuid.New().String(),
+		AccountType: "Savings",
+		Currency:    "EUR",
+	})
+	assertCode(t, err, codes.OK)
+
+	if resp.Account.Status != string(models.AccountStatusActive) {
+		t.Errorf("OpenAccount() status = %s, want Active", resp.Account.Status)
+	}
+	if err := bankid.ValidateInternalAccountNumber(resp.Account.AccountNumber, bankid.DefaultAccountNumberDigits); err != nil {
+		t.Errorf("OpenAccount() account number %q: %v", resp.Account.AccountNumber, err)
+	}
+	if resp.Account.Iban != "" {
+		t.Errorf("OpenAccount() IBAN = %q, want none without an IBAN scheme", resp.Account.Iban)
+	}
+
+	_, err = svc.OpenAccount(ctx, &accountpb.OpenAccountRequest{AccountType: "Savings", Currency: "EUR"})
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+func TestAccountService_OpenAccount_IBAN(t *testing.T) {
+	repo := NewMockRepository()
+	scheme, err := bankid.NewScheme("GB", "CORE400000")
+	if err != nil {
+		t.Fatalf("NewScheme() error = %v", err)
+	}
+	svc := NewAccountService(repo, mockCustomerDirectory{}, scheme)
+	ctx := context.Background()
+
+	seen := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		resp, err := svc.OpenAccount(ctx, &accountpb.OpenAccountRequest{
+			CustomerId:  uuid.New().String(),
+			AccountType: "Checking",
+			Currency:    "GBP",
+		})
+		assertCode(t, err, codes.OK)
+
+		account := resp.Account
+		if err := bankid.ValidateInternalAccountNumber(account.AccountNumber, 8); err != nil {
+			t.Errorf("OpenAccount() account number %q: %v", account.AccountNumber, err)
+		}
+		if err := bankid.ValidateIBAN(account.Iban); err != nil {
+			t.Errorf("OpenAccount() IBAN %q: %v", account.Iban, err)
+		}
+		if account.Iban[4:] != "CORE400000"+account.AccountNumber {
+			t.Errorf("OpenAccount() IBAN %s does not carry account number %s", account.Iban, account.AccountNumber)
+		}
+		if seen[account.Iban] {
+			t.Errorf("OpenAccount() reissued IBAN %s", account.Iban)
+		}
+		seen[account.Iban] = true
+	}
+}
+
+func TestAccountService_GetAccount_ByNumber(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+
+	opened, err := svc.OpenAccount(ctx, &accountpb.OpenAccountRequest{
+		CustomerId:  uuid.New().String(),
+		AccountType: "Checking",
+		Currency:    "USD",
+	})
+	assertCode(t, err, codes.OK)
+
+	resp, err := svc.GetAccount(ctx, &accountpb.GetAccountRequest{AccountNumber: opened.Account.AccountNumber})
+	assertCode(t, err, codes.OK)
+	if resp.Account.Id != opened.Account.Id {
+		t.Errorf("GetAccount() by number = %s, want %s", resp.Account.Id, opened.Account.Id)
+	}
+
+	_, err = svc.GetAccount(ctx, &accountpb.GetAccountRequest{AccountNumber: "0000000000"})
+	assertCode(t, err, codes.NotFound)
+
+	_, err = svc.GetAccount(ctx, &accountpb.GetAccountRequest{})
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+func TestAccountService_PlaceHold(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		balance       int64
+		accountStatus models.AccountStatus
+		holdType      string
+		amount        int64
+		wantErr       codes.Code
+	}{
+		{"card auth within available funds", 10000, models.AccountStatusActive, "CardAuthorization", 4000, codes.OK},
+		{"card auth exceeding available funds", 10000, models.AccountStatusActive, "CardAuthorization", 10001, codes.FailedPrecondition},
+		{"card auth on frozen account", 10000, models.AccountStatusFrozen, "CardAuthorization", 100, codes.FailedPrecondition},
+		{"legal hold on frozen account", 10000, models.AccountStatusFrozen, "LegalGarnishment", 100, codes.OK},
+		{"legal hold exceeding available funds", 10000, models.AccountStatusActive, "LegalGarnishment", 50000, codes.OK},
+		{"hold on closed account", 10000, models.AccountStatusClosed, "LegalGarnishment", 100, codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockRepository()
+			svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+			account := seedAccount(repo, tt.balance, tt.accountStatus)
+
+			resp, err := svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+				AccountId: account.ID.String(),
+				HoldType:  tt.holdType,
+				Amount:    tt.amount,
+				Currency:  "USD",
+				Reason:    "test",
+				Reference: "REF-1",
+			})
+			assertCode(t, err, tt.wantErr)
+			if tt.wantErr != codes.OK {
+				return
+			}
+
+			if resp.Balance.HeldAmount != tt.amount {
+				t.Errorf("PlaceHold() held = %d, want %d", resp.Balance.HeldAmount, tt.amount)
+			}
+			if resp.Balance.AvailableBalance != tt.balance-tt.amount {
+				t.Errorf("PlaceHold() available = %d, want %d", resp.Balance.AvailableBalance, tt.balance-tt.amount)
+			}
+			if tt.holdType == "CardAuthorization" && resp.Hold.ExpiresAt == nil {
+				t.Error("PlaceHold() expected default expiry on card authorization")
+			}
+		})
+	}
+}
+
+func TestAccountService_PlaceHold_CurrencyMismatch(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 10000, models.AccountStatusActive)
+
+	_, err := svc.PlaceHold(context.Background(), &accountpb.PlaceHoldRequest{
+		AccountId: account.ID.String(),
+		HoldType:  "Manual",
+		Amount:    100,
+		Currency:  "EUR",
+		Reason:    "test",
+	})
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+func TestAccountService_ReleaseAndCaptureHold(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	account := seedAccount(repo, 10000, models.AccountStatusActive)
+
+	placed, err := svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+		AccountId: account.ID.String(),
+		HoldType:  "CardAuthorization",
+		Amount:    6000,
+		Currency:  "USD",
+		Reason:    "Hotel pre-authorization",
+		Reference: "AUTH-42",
+	})
+	assertCode(t, err, codes.OK)
+
+	released, err := svc.ReleaseHold(ctx, &accountpb.ReleaseHoldRequest{HoldId: placed.Hold.Id, Amount: 1000})
+	assertCode(t, err, codes.OK)
+	if released.Hold.RemainingAmount != 5000 || released.Balance.AvailableBalance != 5000 {
+		t.Fatalf("ReleaseHold() remaining=%d available=%d, want 5000/5000",
+			released.Hold.RemainingAmount, released.Balance.AvailableBalance)
+	}
+
+	captured, err := svc.CaptureHold(ctx, &accountpb.CaptureHoldRequest{HoldId: placed.Hold.Id, Amount: 4500})
+	assertCode(t, err, codes.OK)
+
+	if captured.Hold.Status != string(models.HoldStatusCaptured) {
+		t.Errorf("CaptureHold() status = %s, want Captured", captured.Hold.Status)
+	}
+	if captured.Posting.Amount != -4500 || captured.Posting.HoldId != placed.Hold.Id {
+		t.Errorf("CaptureHold() posting = %d (hold %s), want -4500 linked to hold", captured.Posting.Amount, captured.Posting.HoldId)
+	}
+	if captured.Balance.LedgerBalance != 5500 || captured.Balance.HeldAmount != 0 || captured.Balance.AvailableBalance != 5500 {
+		t.Errorf("CaptureHold() balance = %+v, want ledger 5500, held 0, available 5500", captured.Balance)
+	}
+
+	_, err = svc.ReleaseHold(ctx, &accountpb.ReleaseHoldRequest{HoldId: placed.Hold.Id})
+	assertCode(t, err, codes.FailedPrecondition)
+}
+
+func TestAccountService_CaptureHold_Concurrent(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	account := seedAccount(repo, 10000, models.AccountStatusActive)
+
+	placed, err := svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+		AccountId: account.ID.String(),
+		HoldType:  "CardAuthorization",
+		Amount:    6000,
+		Currency:  "USD",
+		Reason:    "Hotel pre-authorization",
+	})
+	assertCode(t, err, codes.OK)
+
+	tests := []struct {
+		name  string
+		first func() error
+	}{
+		{"capture while capturing", func() error {
+			_, err := svc.CaptureHold(ctx, &accountpb.CaptureHoldRequest{HoldId: placed.Hold.Id})
+			return err
+		}},
+		{"release while capturing", func() error {
+			_, err := svc.ReleaseHold(ctx, &accountpb.ReleaseHoldRequest{HoldId: placed.Hold.Id})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hold := repo.holds[uuid.MustParse(placed.Hold.Id)]
+			hold.Status, hold.RemainingAmount = models.HoldStatusActive, 6000
+			repo.accounts[account.ID].LedgerBalance = 10000
+
+			// The other request reads the hold, then takes the account
+			// lock only after this one has finished with it
+			var firstErr error
+			repo.onLock = func() { firstErr = tt.first() }
+			_, err := svc.CaptureHold(ctx, &accountpb.CaptureHoldRequest{HoldId: placed.Hold.Id})
+			assertCode(t, firstErr, codes.OK)
+			assertCode(t, err, codes.FailedPrecondition)
+
+			want := int64(10000)
+			if tt.name == "capture while capturing" {
+				want = 4000
+			}
+			if got := repo.accounts[account.ID].LedgerBalance; got != want {
+				t.Errorf("ledger balance = %d, want %d after one of the two requests", got, want)
+			}
+		})
+	}
+
+	// A write that lost the race anyway is refused rather than applied
+	stale, err := repo.GetHoldByID(ctx, uuid.MustParse(placed.Hold.Id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.Status = models.HoldStatusReleased
+	if err := repo.UpdateHold(ctx, stale); !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("UpdateHold() of a hold no longer active error = %v, want ErrConflict", err)
+	}
+}
+
+func TestAccountService_GetBalance(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	account := seedAccount(repo, 25000, models.AccountStatusActive)
+
+	for _, amount := range []int64{3000, 2000} {
+		_, err := svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+			AccountId: account.ID.String(),
+			HoldType:  "Payment",
+			Amount:    amount,
+			Currency:  "USD",
+			Reason:    "Pending transfer",
+		})
+		assertCode(t, err, codes.OK)
+	}
+
+	resp, err := svc.GetBalance(ctx, &accountpb.GetBalanceRequest{AccountId: account.ID.String()})
+	assertCode(t, err, codes.OK)
+
+	if resp.Balance.LedgerBalance != 25000 || resp.Balance.HeldAmount != 5000 || resp.Balance.AvailableBalance != 20000 {
+		t.Errorf("GetBalance() = %+v, want ledger 25000, held 5000, available 20000", resp.Balance)
+	}
+
+	_, err = svc.GetBalance(ctx, &accountpb.GetBalanceRequest{AccountId: uuid.New().String()})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestAccountService_FreezeKeepsLegalHolds(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	account := seedAccount(repo, 10000, models.AccountStatusActive)
+
+	legal, err := svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+		AccountId: account.ID.String(),
+		HoldType:  "LegalGarnishment",
+		Amount:    4000,
+		Currency:  "USD",
+		Reason:    "Garnishment order",
+		Reference: "COURT-2026-001",
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	assertCode(t, err, codes.OK)
+
+	_, err = svc.FreezeAccount(ctx, &accountpb.FreezeAccountRequest{Id: account.ID.String(), Reason: "Fraud investigation"})
+	assertCode(t, err, codes.OK)
+
+	expirer := NewHoldExpirer(repo, time.Minute, 10, zerolog.Nop())
+	expired, err := expirer.ExpireHolds(ctx, time.Now().Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("ExpireHolds() error: %v", err)
+	}
+	if expired != 0 {
+		t.Errorf("ExpireHolds() expired %d holds on frozen account, want 0", expired)
+	}
+
+	_, err = svc.UnfreezeAccount(ctx, &accountpb.UnfreezeAccountRequest{Id: account.ID.String(), Reason: "Cleared"})
+	assertCode(t, err, codes.OK)
+
+	holds, err := svc.ListHolds(ctx, &accountpb.ListHoldsRequest{AccountId: account.ID.String(), ActiveOnly: true})
+	assertCode(t, err, codes.OK)
+	if len(holds.Holds) != 1 || holds.Holds[0].Id != legal.Hold.Id {
+		t.Fatalf("ListHolds() after unfreeze = %d holds, want the legal hold", len(holds.Holds))
+	}
+}
+
+func TestHoldExpirer_ExpireHolds_SkippedLegalHolds(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	frozen := seedAccount(repo, 100000, models.AccountStatusActive)
+	active := seedAccount(repo, 10000, models.AccountStatusActive)
+
+	// More legal holds than a batch expire before the card authorization
+	for i := 0; i < 3; i++ {
+		_, err := svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+			AccountId: frozen.ID.String(),
+			HoldType:  "LegalGarnishment",
+			Amount:    1000,
+			Currency:  "USD",
+			Reason:    "Garnishment order",
+			Reference: fmt.Sprintf("COURT-2026-%03d", i),
+			ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		assertCode(t, err, codes.OK)
+	}
+	_, err := svc.FreezeAccount(ctx, &accountpb.FreezeAccountRequest{Id: frozen.ID.String(), Reason: "Fraud investigation"})
+	assertCode(t, err, codes.OK)
+	_, err = svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+		AccountId: active.ID.String(),
+		HoldType:  "CardAuthorization",
+		Amount:    1000,
+		Currency:  "USD",
+		Reason:    "Authorization",
+		ExpiresAt: timestamppb.New(time.Now().Add(90 * time.Minute)),
+	})
+	assertCode(t, err, codes.OK)
+
+	expirer := NewHoldExpirer(repo, time.Minute, 2, zerolog.Nop())
+	expired, err := expirer.ExpireHolds(ctx, time.Now().Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("ExpireHolds() error: %v", err)
+	}
+	if expired != 1 {
+		t.Errorf("ExpireHolds() expired %d holds, want the card authorization behind the legal holds", expired)
+	}
+}
+
+func TestHoldExpirer_ExpireHolds(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	account := seedAccount(repo, 10000, models.AccountStatusActive)
+
+	for _, expiresIn := range []time.Duration{time.Hour, 48 * time.Hour} {
+		_, err := svc.PlaceHold(ctx, &accountpb.PlaceHoldRequest{
+			AccountId: account.ID.String(),
+			HoldType:  "CardAuthorization",
+			Amount:    1000,
+			Currency:  "USD",
+			Reason:    "Authorization",
+			ExpiresAt: timestamppb.New(time.Now().Add(expiresIn)),
+		})
+		assertCode(t, err, codes.OK)
+	}
+
+	expirer := NewHoldExpirer(repo, time.Minute, 10, zerolog.Nop())
+	expired, err := expirer.ExpireHolds(ctx, time.Now().Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("ExpireHolds() error: %v", err)
+	}
+	if expired != 1 {
+		t.Errorf("ExpireHolds() expired %d holds, want 1", expired)
+	}
+
+	resp, err := svc.GetBalance(ctx, &accountpb.GetBalanceRequest{AccountId: account.ID.String()})
+	assertCode(t, err, codes.OK)
+	if resp.Balance.HeldAmount != 1000 {
+		t.Errorf("GetBalance() held = %d after expiry, want 1000", resp.Balance.HeldAmount)
+	}
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/aml"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultAMLAlertLimit bounds ListAMLAlerts when the request sets no limit
+const defaultAMLAlertLimit = 100
+
+// movement is a posted transaction between two customers' accounts, in the
+// amounts and currencies each side saw
+type movement struct {
+	from, to            *models.Account
+	fromAmount          int64
+	toAmount            int64
+	channel             models.Channel
+	transactionType     models.TransactionType
+	counterpartyCountry string // Of an external counterparty; empty for customers of the bank
+	reference           string
+	occurredAt          time.Time
+}
+
+// recordTransactionEvents adds the Out and In events transaction monitoring
+// sees for a movement. It must run in the transaction that posts it.
+func (s *AccountService) recordTransactionEvents(ctx context.Context, repo repository.AccountRepository, m movement) error {
+	country := m.counterpartyCountry
+	if country == "" && s.numbering != nil {
+		country = s.numbering.Country
+	}
+
+	events := []*models.TransactionEvent{
+		{
+			CustomerID:            m.from.CustomerID,
+			AccountID:             m.from.ID,
+			Direction:             models.EventDirectionOut,
+			Amount:                m.fromAmount,
+			Currency:              m.from.Currency,
+			CounterpartyAccountID: &m.to.ID,
+			CounterpartyCountry:   country,
+		},
+		{
+			CustomerID:            m.to.CustomerID,
+			AccountID:             m.to.ID,
+			Direction:             models.EventDirectionIn,
+			Amount:                m.toAmount,
+			Currency:              m.to.Currency,
+			CounterpartyAccountID: &m.from.ID,
+			CounterpartyCountry:   country,
+		},
+	}
+	for _, event := range events {
+		event.Channel = m.channel
+		event.TransactionType = m.transactionType
+		event.Reference = m.reference
+		event.OccurredAt = m.occurredAt
+		if err := repo.RecordTransactionEvent(ctx, event); err != nil {
+			return status.Errorf(codes.Internal, "failed to record transaction event: %v", err)
+		}
+	}
+	return nil
+}
+
+// ListAMLAlerts lists transaction monitoring alerts, newest first
+func (s *AccountService) ListAMLAlerts(ctx context.Context, req *accountpb.ListAMLAlertsRequest) (*accountpb.ListAMLAlertsResponse, error) {
+	if errs := s.validator.ValidateListAMLAlerts(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	filter := models.AMLAlertFilter{
+		Status: models.AMLAlertStatus(req.GetStatus()),
+		RuleID: req.GetRuleId(),
+		Limit:  int(req.GetLimit()),
+	}
+	if req.GetCustomerId() != "" {
+		customerID := uuid.MustParse(req.GetCustomerId())
+		filter.CustomerID = &customerID
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultAMLAlertLimit
+	}
+
+	alerts, err := s.repo.ListAMLAlerts(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list aml alerts: %v", err)
+	}
+
+	resp := &accountpb.ListAMLAlertsResponse{}
+	for _, alert := range alerts {
+		resp.Alerts = append(resp.Alerts, amlAlertModelToProto(alert))
+	}
+	return resp, nil
+}
+
+// GetAMLAlert retrieves an alert with the transactions it was raised on
+func (s *AccountService) GetAMLAlert(ctx context.Context, req *accountpb.GetAMLAlertRequest) (*accountpb.GetAMLAlertResponse, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := s.getAMLAlert(ctx, s.repo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.GetTransactionEvents(ctx, alert.Evidence)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get alert evidence: %v", err)
+	}
+
+	resp := &accountpb.GetAMLAlertResponse{Alert: amlAlertModelToProto(alert)}
+	for _, event := range events {
+		resp.Evidence = append(resp.Evidence, transactionEventModelToProto(event))
+	}
+	return resp, nil
+}
+
+// CloseAMLAlert records the outcome of investigating an open alert: escalated
+// when reported as suspicious, dismissed when found to be legitimate
+func (s *AccountService) CloseAMLAlert(ctx context.Context, req *accountpb.CloseAMLAlertRequest) (*accountpb.CloseAMLAlertResponse, error) {
+	if errs := s.validator.ValidateCloseAMLAlert(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	id := uuid.MustParse(req.GetId())
+
+	var alert *models.AMLAlert
+	err := s.withTx(ctx, func(repo repository.AccountRepository) error {
+		var err error
+		alert, err = s.getAMLAlert(ctx, repo, id)
+		if err != nil {
+			return err
+		}
+
+		err = alert.Close(models.AMLAlertStatus(req.GetStatus()), req.GetClosedBy(), req.GetResolution(), time.Now().UTC())
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if err := repo.UpdateAMLAlert(ctx, alert); err != nil {
+			return status.Errorf(codes.Internal, "failed to close aml alert: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.CloseAMLAlertResponse{Alert: amlAlertModelToProto(alert)}, nil
+}
+
+func (s *AccountService) getAMLAlert(ctx context.Context, repo repository.AccountRepository, id uuid.UUID) (*models.AMLAlert, error) {
+	alert, err := repo.GetAMLAlert(ctx, id)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "aml alert not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get aml alert: %v", err)
+	}
+	return alert, nil
+}
+
+// LoadAMLEvents returns the transaction events that occurred in [from, to)
+// as the rules engine sees them, for backtesting
+func LoadAMLEvents(ctx context.Context, repo repository.AccountRepository, from, to time.Time) ([]aml.Event, error) {
+	stored, err := repo.ListTransactionEvents(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]aml.Event, len(stored))
+	for i, e := range stored {
+		events[i] = amlEvent(e)
+	}
+	return events, nil
+}
+
+// amlEvent converts a stored event into what the rules engine sees
+func amlEvent(event *models.TransactionEvent) aml.Event {
+	return aml.Event{
+		ID:                  event.ID,
+		Sequence:            event.Sequence,
+		CustomerID:          event.CustomerID,
+		AccountID:           event.AccountID,
+		Direction:           aml.Direction(event.Direction),
+		Amount:              event.Amount,
+		Currency:            event.Currency,
+		CounterpartyCountry: event.CounterpartyCountry,
+		OccurredAt:          event.OccurredAt,
+	}
+}
+
+func transactionEventModelToProto(event *models.TransactionEvent) *accountpb.TransactionEvent {
+	pb := &accountpb.TransactionEvent{
+		Id:                  event.ID.String(),
+		Sequence:            event.Sequence,
+		CustomerId:          event.CustomerID.String(),
+		AccountId:           event.AccountID.String(),
+		Direction:           string(event.Direction),
+		Amount:              event.Amount,
+		Currency:            event.Currency,
+		CounterpartyCountry: event.CounterpartyCountry,
+		Channel:             string(event.Channel),
+		TransactionType:     string(event.TransactionType),
+		Reference:           event.Reference,
+		OccurredAt:          timestamppb.New(event.OccurredAt),
+	}
+	if event.CounterpartyAccountID != nil {
+		pb.CounterpartyAccountId = event.CounterpartyAccountID.String()
+	}
+	return pb
+}
+
+func amlAlertModelToProto(alert *models.AMLAlert) *accountpb.AMLAlert {
+	pb := &accountpb.AMLAlert{
+		Id:             alert.ID.String(),
+		RuleId:         alert.RuleID,
+		RuleType:       alert.RuleType,
+		RulesVersion:   alert.RulesVersion,
+		CustomerId:     alert.CustomerID.String(),
+		TriggerEventId: alert.TriggerEventID.String(),
+		Summary:        alert.Summary,
+		Status:         string(alert.Status),
+		RaisedAt:       timestamppb.New(alert.RaisedAt),
+		CreatedAt:      timestamppb.New(alert.CreatedAt),
+		ClosedBy:       alert.ClosedBy,
+		Resolution:     alert.Resolution,
+	}
+	if alert.ClosedAt != nil {
+		pb.ClosedAt = timestamppb.New(*alert.ClosedAt)
+	}
+	for _, id := range alert.Evidence {
+		pb.EvidenceEventIds = append(pb.EvidenceEventIds, id.String())
+	}
+	return pb
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/aml"
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// AMLMonitor runs the transaction monitoring rules over posted transaction
+// events in the order they were posted and raises an alert for each rule
+// that fires. Every event is evaluated once; several monitors may run side
+// by side since each batch locks the events it takes.
+type AMLMonitor struct {
+	repo      repository.AccountRepository
+	rules     *aml.Config
+	interval  time.Duration
+	batchSize int
+	log       zerolog.Logger
+}
+
+// NewAMLMonitor creates a new AMLMonitor
+func NewAMLMonitor(repo repository.AccountRepository, rules *aml.Config, interval time.Duration, batchSize int, log zerolog.Logger) *AMLMonitor {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &AMLMonitor{
+		repo:      repo,
+		rules:     rules,
+		interval:  interval,
+		batchSize: batchSize,
+		log:       log,
+	}
+}
+
+// Run monitors new events every interval until the context is cancelled,
+// working through any backlog a batch at a time
+func (m *AMLMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil {
+				processed, alerts, err := m.MonitorEvents(ctx, time.Now().UTC())
+				if err != nil {
+					m.log.Error().Err(err).Msg("AML monitoring run failed")
+					break
+				}
+				if alerts > 0 {
+					m.log.Info().Int("events", processed).Int("alerts", alerts).
+						Str("rules_version", m.rules.Version).Msg("Raised AML alerts")
+				}
+				if processed < m.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// MonitorEvents evaluates the next batch of unmonitored events and returns
+// how many events were evaluated and how many alerts were raised. The batch
+// is committed as a whole, so a failure leaves every event in it to be
+// evaluated again.
+func (m *AMLMonitor) MonitorEvents(ctx context.Context, now time.Time) (int, int, error) {
+	tx, err := m.repo.BeginTx(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	repo := tx.AccountRepository()
+
+	events, err := repo.ListUnmonitoredTransactionEvents(ctx, m.batchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	alerts := 0
+	for _, event := range events {
+		raised, err := m.monitorEvent(ctx, repo, event, now)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to monitor transaction event %s: %w", event.ID, err)
+		}
+		alerts += raised
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	return len(events), alerts, nil
+}
+
+// monitorEvent evaluates the rules against one event and the customer's
+// history before it, and raises an alert for each rule that fires
+func (m *AMLMonitor) monitorEvent(ctx context.Context, repo repository.AccountRepository, event *models.TransactionEvent, now time.Time) (int, error) {
+	stored, err := repo.ListCustomerTransactionEvents(ctx, event.CustomerID, event.OccurredAt.Add(-m.rules.Lookback()), event.Sequence)
+	if err != nil {
+		return 0, err
+	}
+	lastHits, err := repo.LastAMLAlertTimes(ctx, event.CustomerID)
+	if err != nil {
+		return 0, err
+	}
+
+	history := aml.History{LastHits: lastHits}
+	for _, e := range stored {
+		history.Events = append(history.Events, amlEvent(e))
+	}
+
+	hits := m.rules.Evaluate(amlEvent(event), history)
+	for _, hit := range hits {
+		alert := &models.AMLAlert{
+			RuleID:         hit.RuleID,
+			RuleType:       string(hit.RuleType),
+			RulesVersion:   m.rules.Version,
+			CustomerID:     event.CustomerID,
+			TriggerEventID: event.ID,
+			Summary:        hit.Summary,
+			Status:         models.AMLAlertStatusOpen,
+			RaisedAt:       event.OccurredAt,
+			Evidence:       hit.Evidence,
+		}
+		if err := repo.CreateAMLAlert(ctx, alert); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := repo.MarkTransactionEventMonitored(ctx, event.ID, now); err != nil {
+		return 0, err
+	}
+
+	return len(hits), nil
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/aml"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+)
+
+func testAMLRules(t *testing.T) *aml.Config {
+	t.Helper()
+	rules, err := aml.ParseConfig([]byte(`{
+		"version": "test.1",
+		"rules": [
+			{"id": "structuring-usd", "type": "Structuring", "currency": "USD", "window_hours": 72, "threshold": 1000000, "margin": 100000, "min_count": 2},
+			{"id": "high-risk-usd", "type": "HighRiskJurisdiction", "currency": "USD", "min_amount": 100000, "countries": ["IR"]}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+	return rules
+}
+
+func TestAccountService_Transfer_RecordsTransactionEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+
+	from := seedAccount(repo, 100000, models.AccountStatusActive)
+	to := seedAccount(repo, 0, models.AccountStatusActive)
+
+	_, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+		FromAccountId:       from.ID.String(),
+		ToAccountId:         to.ID.String(),
+		Amount:              25000,
+		Currency:            "USD",
+		CounterpartyCountry: "GB",
+	})
+	assertCode(t, err, codes.OK)
+
+	if len(repo.events) != 2 {
+		t.Fatalf("got %d transaction events, want 2", len(repo.events))
+	}
+	out, in := repo.events[0], repo.events[1]
+	if out.CustomerID != from.CustomerID || out.Direction != models.EventDirectionOut || *out.CounterpartyAccountID != to.ID {
+		t.Errorf("outgoing event = %+v", out)
+	}
+	if in.CustomerID != to.CustomerID || in.Direction != models.EventDirectionIn || *in.CounterpartyAccountID != from.ID {
+		t.Errorf("incoming event = %+v", in)
+	}
+	for _, e := range repo.events {
+		if e.Amount != 25000 || e.CounterpartyCountry != "GB" || e.TransactionType != models.TransactionTypeTransfer || e.MonitoredAt != nil {
+			t.Errorf("event = %+v", e)
+		}
+	}
+
+	// A refused transfer moves nothing and is not monitored
+	_, err = svc.Transfer(ctx, &accountpb.TransferRequest{
+		FromAccountId: from.ID.String(),
+		ToAccountId:   to.ID.String(),
+		Amount:        500000,
+		Currency:      "USD",
+	})
+	assertCode(t, err, codes.FailedPrecondition)
+	if len(repo.events) != 2 {
+		t.Errorf("got %d transaction events after a refused transfer, want 2", len(repo.events))
+	}
+}
+
+func TestAccountService_ExecuteFXQuote_RecordsTransactionEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	seedFXDesk(t, svc, repo)
+
+	from := seedCurrencyAccount(repo, "USD", 100000)
+	to := seedCurrencyAccount(repo, "EUR", 0)
+
+	quoted, err := svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+		FromAccountId: from.ID.String(),
+		ToAccountId:   to.ID.String(),
+		SourceAmount:  10000,
+		RequestedBy:   "customer",
+	})
+	assertCode(t, err, codes.OK)
+	_, err = svc.ExecuteFXQuote(ctx, &accountpb.ExecuteFXQuoteRequest{QuoteId: quoted.Quote.Id, ExecutedBy: "customer"})
+	assertCode(t, err, codes.OK)
+
+	// Each side sees the conversion in its own currency
+	if len(repo.events) != 2 {
+		t.Fatalf("got %d transaction events, want 2", len(repo.events))
+	}
+	out, in := repo.events[0], repo.events[1]
+	if out.Amount != 10000 || out.Currency != "USD" || in.Amount != 9170 || in.Currency != "EUR" {
+		t.Errorf("events = %d %s out, %d %s in", out.Amount, out.Currency, in.Amount, in.Currency)
+	}
+	if out.TransactionType != models.TransactionTypeFXConversion {
+		t.Errorf("event transaction type = %s", out.TransactionType)
+	}
+}
+
+func TestAMLMonitor_MonitorEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	monitor := NewAMLMonitor(repo, testAMLRules(t), time.Minute, 10, zerolog.Nop())
+
+	from := seedAccount(repo, 5000000, models.AccountStatusActive)
+	to := seedAccount(repo, 0, models.AccountStatusActive)
+	transfer := func(amount int64, country string) {
+		t.Helper()
+		_, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+			FromAccountId:       from.ID.String(),
+			ToAccountId:         to.ID.String(),
+			Amount:              amount,
+			Currency:            "USD",
+			CounterpartyCountry: country,
+		})
+		assertCode(t, err, codes.OK)
+	}
+
+	// Two payments just under the threshold: both sides structure them
+	transfer(950000, "")
+	transfer(960000, "")
+
+	processed, alerts, err := monitor.MonitorEvents(ctx, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("MonitorEvents() error = %v", err)
+	}
+	if processed != 4 || alerts != 2 {
+		t.Errorf("MonitorEvents() = %d events, %d alerts, want 4 and 2", processed, alerts)
+	}
+	for _, e := range repo.events {
+		if e.MonitoredAt == nil {
+			t.Errorf("event %d not marked monitored", e.Sequence)
+		}
+	}
+
+	// A third payment continues the same episode and raises nothing new
+	transfer(970000, "")
+	if _, alerts, _ := monitor.MonitorEvents(ctx, time.Now().UTC()); alerts != 0 {
+		t.Errorf("MonitorEvents() raised %d alerts for a continuing pattern", alerts)
+	}
+	if processed, _, _ := monitor.MonitorEvents(ctx, time.Now().UTC()); processed != 0 {
+		t.Errorf("MonitorEvents() evaluated %d events twice", processed)
+	}
+
+	listed, err := svc.ListAMLAlerts(ctx, &accountpb.ListAMLAlertsRequest{CustomerId: from.CustomerID.String()})
+	assertCode(t, err, codes.OK)
+	if len(listed.Alerts) != 1 {
+		t.Fatalf("ListAMLAlerts() = %d alerts, want 1", len(listed.Alerts))
+	}
+	alert := listed.Alerts[0]
+	if alert.RuleId != "structuring-usd" || alert.RulesVersion != "test.1" || alert.Status != "Open" || alert.TriggerEventId != repo.events[2].ID.String() {
+		t.Errorf("alert = %+v", alert)
+	}
+
+	got, err := svc.GetAMLAlert(ctx, &accountpb.GetAMLAlertRequest{Id: alert.Id})
+	assertCode(t, err, codes.OK)
+	if len(got.Evidence) != 2 || got.Evidence[0].Amount != 950000 || got.Evidence[1].Amount != 960000 || got.Evidence[0].Direction != "Out" {
+		t.Errorf("GetAMLAlert() evidence = %v", got.Evidence)
+	}
+
+	// A high-risk counterparty is caught on its own
+	transfer(150000, "IR")
+	if _, alerts, _ := monitor.MonitorEvents(ctx, time.Now().UTC()); alerts != 2 {
+		t.Errorf("MonitorEvents() = %d alerts for a high-risk counterparty, want 2", alerts)
+	}
+	highRisk, err := svc.ListAMLAlerts(ctx, &accountpb.ListAMLAlertsRequest{RuleId: "high-risk-usd"})
+	assertCode(t, err, codes.OK)
+	if len(highRisk.Alerts) != 2 {
+		t.Errorf("ListAMLAlerts() by rule = %d alerts, want 2", len(highRisk.Alerts))
+	}
+}
+
+func TestAccountService_CloseAMLAlert(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	alert := &models.AMLAlert{
+		RuleID:     "structuring-usd",
+		RuleType:   "Structuring",
+		CustomerID: account.CustomerID,
+		Status:     models.AMLAlertStatusOpen,
+		RaisedAt:   time.Now().UTC(),
+	}
+	if err := repo.CreateAMLAlert(ctx, alert); err != nil {
+		t.Fatal(err)
+	}
+
+	closed, err := svc.CloseAMLAlert(ctx, &accountpb.CloseAMLAlertRequest{
+		Id:         alert.ID.String(),
+		Status:     "Escalated",
+		ClosedBy:   "analyst",
+		Resolution: "Filed a suspicious activity report",
+	})
+	assertCode(t, err, codes.OK)
+	if closed.Alert.Status != "Escalated" || closed.Alert.ClosedBy != "analyst" || closed.Alert.ClosedAt == nil {
+		t.Errorf("CloseAMLAlert() = %+v", closed.Alert)
+	}
+
+	_, err = svc.CloseAMLAlert(ctx, &accountpb.CloseAMLAlertRequest{
+		Id:         alert.ID.String(),
+		Status:     "Dismissed",
+		ClosedBy:   "supervisor",
+		Resolution: "Salary",
+	})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	open, err := svc.ListAMLAlerts(ctx, &accountpb.ListAMLAlertsRequest{Status: "Open"})
+	assertCode(t, err, codes.OK)
+	if len(open.Alerts) != 0 {
+		t.Errorf("ListAMLAlerts(Open) = %d alerts, want 0", len(open.Alerts))
+	}
+
+	_, err = svc.GetAMLAlert(ctx, &accountpb.GetAMLAlertRequest{Id: account.ID.String()})
+	assertCode(t, err, codes.NotFound)
+}
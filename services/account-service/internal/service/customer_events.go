@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/core-banking/services/account-service/internal/repository"
+	customerclient "github.com/core-banking/services/customer-service/client"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// CustomerEventFeed reads customer-service's event feed
+type CustomerEventFeed interface {
+	ListCustomerEvents(ctx context.Context, afterSequence int64, limit int) ([]customerclient.Event, error)
+}
+
+// CustomerEventJob applies customer-service's events to account ownership.
+// When a duplicate customer is merged, each role it holds on an account
+// passes to the customer it was merged into; when the merge is reversed, the
+// roles pass back. Events are applied in order, once each: a batch and the
+// feed position after it are committed together.
+type CustomerEventJob struct {
+	repo      repository.AccountRepository
+	feed      CustomerEventFeed
+	interval  time.Duration
+	batchSize int
+	log       zerolog.Logger
+}
+
+// NewCustomerEventJob creates a new CustomerEventJob
+func NewCustomerEventJob(repo repository.AccountRepository, feed CustomerEventFeed, interval time.Duration, batchSize int, log zerolog.Logger) *CustomerEventJob {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &CustomerEventJob{
+		repo:      repo,
+		feed:      feed,
+		interval:  interval,
+		batchSize: batchSize,
+		log:       log,
+	}
+}
+
+// Run applies new events every interval until the context is cancelled,
+// working through any backlog a batch at a time
+func (j *CustomerEventJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil {
+				applied, err := j.RunOnce(ctx, time.Now().UTC())
+				if err != nil {
+					j.log.Error().Err(err).Msg("Customer event run failed")
+					break
+				}
+				if applied > 0 {
+					j.log.Info().Int("events", applied).Msg("Applied customer events")
+				}
+				if applied < j.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// RunOnce applies the next batch of customer events and returns how many
+// were applied. Roles change from the date of now. A failure leaves the
+// whole batch to be applied again.
+func (j *CustomerEventJob) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	tx, err := j.repo.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	repo := tx.AccountRepository()
+
+	offset, err := repo.LockCustomerEventOffset(ctx)
+	if err != nil {
+		return 0, err
+	}
+	events, err := j.feed.ListCustomerEvents(ctx, offset, j.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	for _, event := range events {
+		if err := applyCustomerEvent(ctx, repo, event, now); err != nil {
+			return 0, fmt.Errorf("failed to apply customer event %d: %w", event.Sequence, err)
+		}
+	}
+
+	if err := repo.SetCustomerEventOffset(ctx, events[len(events)-1].Sequence); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+// applyCustomerEvent applies one event. Events this service has no use for
+// are skipped.
+func applyCustomerEvent(ctx context.Context, repo repository.AccountRepository, event customerclient.Event, now time.Time) error {
+	switch event.Type {
+	case customerclient.EventMerged:
+		return transferParties(ctx, repo, event.CustomerID, event.RelatedCustomerID, event.ReferenceID, now)
+	case customerclient.EventUnmerged:
+		return restoreParties(ctx, repo, event.CustomerID, event.RelatedCustomerID, event.ReferenceID, now)
+	}
+	return nil
+}
+
+// mergeEndReason is recorded on the roles a merged customer lost in a merge
+func mergeEndReason(mergeID uuid.UUID) string {
+	return fmt.Sprintf("Customer merged (merge %s)", mergeID)
+}
+
+// mergeCreatedBy is recorded on the roles a survivor gained in a merge
+func mergeCreatedBy(mergeID uuid.UUID) string {
+	return "merge:" + mergeID.String()
+}
+
+// laterDate returns the later of today's date and a role's start date
+func laterDate(now, startDate time.Time) time.Time {
+	if today := interest.Date(now); !startDate.After(today) {
+		return today
+	}
+	return startDate
+}
+
+// transferParties ends the current and future roles of a merged customer and
+// gives each of them to the survivor on the same terms. The survivor keeps a
+// role it already holds on the account rather than gaining a second.
+func transferParties(ctx context.Context, repo repository.AccountRepository, mergedID, survivorID, mergeID uuid.UUID, now time.Time) error {
+	parties, err := repo.ListPartiesByCustomer(ctx, mergedID)
+	if err != nil {
+		return err
+	}
+
+	for _, party := range parties {
+		if party.EndDate != nil && party.EndDate.Before(interest.Date(now)) {
+			continue
+		}
+		account, err := repo.GetAccountForUpdate(ctx, party.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// Roles not yet started end on their first day
+		endDate := laterDate(now, party.StartDate)
+		originalEnd := party.EndDate
+		if err := party.End(endDate, mergeEndReason(mergeID), now); err != nil {
+			return err
+		}
+		if err := repo.UpdateParty(ctx, party); err != nil {
+			return err
+		}
+
+		if err := grantParty(ctx, repo, account, party, survivorID, endDate, originalEnd, mergeCreatedBy(mergeID), now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreParties reverses transferParties: the survivor's roles gained in
+// the merge end and the merged customer gets back the roles it lost, from
+// the date of now and until they were due to end
+func restoreParties(ctx context.Context, repo repository.AccountRepository, mergedID, survivorID, mergeID uuid.UUID, now time.Time) error {
+	parties, err := repo.ListPartiesByCustomer(ctx, mergedID)
+	if err != nil {
+		return err
+	}
+
+	for _, party := range parties {
+		if party.EndReason != mergeEndReason(mergeID) {
+			continue
+		}
+		account, err := repo.GetAccountForUpdate(ctx, party.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// The survivor's copy of the role still carries its original end
+		var originalEnd *time.Time
+		existing, err := repo.ListParties(ctx, party.AccountID)
+		if err != nil {
+			return err
+		}
+		for _, p := range existing {
+			if p.CustomerID != survivorID || p.Role != party.Role || p.CreatedBy != mergeCreatedBy(mergeID) {
+				continue
+			}
+			originalEnd = p.EndDate
+			if p.EndDate != nil && p.EndDate.Before(interest.Date(now)) {
+				continue
+			}
+			if err := p.End(laterDate(now, p.StartDate), fmt.Sprintf("Customer merge reversed (merge %s)", mergeID), now); err != nil {
+				return err
+			}
+			if err := repo.UpdateParty(ctx, p); err != nil {
+				return err
+			}
+		}
+
+		startDate := laterDate(now, party.StartDate)
+		if account.Status == models.AccountStatusClosed || (originalEnd != nil && originalEnd.Before(startDate)) {
+			continue
+		}
+		if err := grantParty(ctx, repo, account, party, mergedID, startDate, originalEnd, "unmerge:"+mergeID.String(), now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// grantParty gives customerID the role of party on its account from
+// startDate, unless the customer already holds that role then. An account's
+// primary holder follows its primary holder role.
+func grantParty(ctx context.Context, repo repository.AccountRepository, account *models.Account, party *models.AccountParty, customerID uuid.UUID, startDate time.Time, endDate *time.Time, createdBy string, now time.Time) error {
+	granted := &models.AccountParty{
+		ID:          uuid.New(),
+		AccountID:   party.AccountID,
+		CustomerID:  customerID,
+		Role:        party.Role,
+		SigningRule: party.SigningRule,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		CreatedBy:   createdBy,
+	}
+
+	existing, err := repo.ListParties(ctx, party.AccountID)
+	if err != nil {
+		return err
+	}
+	for _, p := range existing {
+		if p.ID != party.ID && p.CustomerID == customerID && p.Role == party.Role && p.Overlaps(granted) {
+			return nil
+		}
+	}
+
+	if err := repo.CreateParty(ctx, granted); err != nil {
+		return err
+	}
+	if party.Role == models.PartyRolePrimaryHolder && account.CustomerID != customerID {
+		account.CustomerID = customerID
+		return repo.UpdateAccount(ctx, account)
+	}
+	return nil
+}
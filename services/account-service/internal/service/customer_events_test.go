@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	customerclient "github.com/core-banking/services/customer-service/client"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+)
+
+// mockEventFeed serves its events in order, as customer-service does
+type mockEventFeed []customerclient.Event
+
+func (f mockEventFeed) ListCustomerEvents(ctx context.Context, afterSequence int64, limit int) ([]customerclient.Event, error) {
+	var events []customerclient.Event
+	for _, e := range f {
+		if e.Sequence > afterSequence && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+// activeParties returns the customers holding each role on the account on
+// the given date
+func activeParties(repo *MockRepository, accountID uuid.UUID, on time.Time) map[models.PartyRole][]uuid.UUID {
+	roles := make(map[models.PartyRole][]uuid.UUID)
+	for _, p := range repo.parties {
+		if p.AccountID == accountID && p.IsActiveOn(on) {
+			roles[p.Role] = append(roles[p.Role], p.CustomerID)
+		}
+	}
+	return roles
+}
+
+func TestCustomerEventJob(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	now := time.Now().UTC()
+
+	// The merged customer is primary holder of one account and joint holder
+	// of another, which the survivor also jointly holds
+	owned := seedAccount(repo, 0, models.AccountStatusActive)
+	merged := owned.CustomerID
+	survivor := uuid.New()
+	shared := seedAccount(repo, 0, models.AccountStatusActive)
+	for _, customerID := range []uuid.UUID{merged, survivor} {
+		repo.parties = append(repo.parties, &models.AccountParty{
+			ID:          uuid.New(),
+			AccountID:   shared.ID,
+			CustomerID:  customerID,
+			Role:        models.PartyRoleJointHolder,
+			SigningRule: models.SigningRuleAnyOne,
+			StartDate:   shared.OpenedAt,
+		})
+	}
+
+	mergeID := uuid.New()
+	feed := mockEventFeed{
+		{Sequence: 1, ID: uuid.New(), Type: customerclient.EventMerged, CustomerID: merged, RelatedCustomerID: survivor, ReferenceID: mergeID},
+	}
+	job := NewCustomerEventJob(repo, feed, time.Minute, 10, zerolog.Nop())
+
+	applied, err := job.RunOnce(ctx, now)
+	if err != nil || applied != 1 || repo.offset != 1 {
+		t.Fatalf("RunOnce() = %d, %v; offset %d", applied, err, repo.offset)
+	}
+
+	tomorrow := now.AddDate(0, 0, 1)
+	if roles := activeParties(repo, owned.ID, tomorrow); len(roles[models.PartyRolePrimaryHolder]) != 1 || roles[models.PartyRolePrimaryHolder][0] != survivor {
+		t.Errorf("owned account roles after merge = %v", roles)
+	}
+	if repo.accounts[owned.ID].CustomerID != survivor {
+		t.Errorf("owned account holder = %s, want survivor", repo.accounts[owned.ID].CustomerID)
+	}
+	if roles := activeParties(repo, shared.ID, tomorrow); len(roles[models.PartyRoleJointHolder]) != 1 || roles[models.PartyRoleJointHolder][0] != survivor {
+		t.Errorf("shared account roles after merge = %v, want the survivor once", roles)
+	}
+
+	// Nothing new to apply
+	if applied, err := job.RunOnce(ctx, now); err != nil || applied != 0 {
+		t.Errorf("RunOnce() again = %d, %v", applied, err)
+	}
+
+	job.feed = append(feed, customerclient.Event{Sequence: 2, ID: uuid.New(), Type: customerclient.EventUnmerged, CustomerID: merged, RelatedCustomerID: survivor, ReferenceID: mergeID})
+	if applied, err := job.RunOnce(ctx, now); err != nil || applied != 1 || repo.offset != 2 {
+		t.Fatalf("RunOnce() unmerge = %d, %v", applied, err)
+	}
+
+	if roles := activeParties(repo, owned.ID, tomorrow); len(roles[models.PartyRolePrimaryHolder]) != 1 || roles[models.PartyRolePrimaryHolder][0] != merged {
+		t.Errorf("owned account roles after unmerge = %v", roles)
+	}
+	if repo.accounts[owned.ID].CustomerID != merged {
+		t.Errorf("owned account holder = %s, want the merged customer back", repo.accounts[owned.ID].CustomerID)
+	}
+	if roles := activeParties(repo, shared.ID, tomorrow); len(roles[models.PartyRoleJointHolder]) != 2 {
+		t.Errorf("shared account roles after unmerge = %v, want both joint holders", roles)
+	}
+}
+
+func TestAccountService_AddAccountParty_MergedCustomer(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	merged := uuid.New()
+	svc := NewAccountService(repo, mergedCustomerDirectory{merged: uuid.New()}, nil)
+
+	_, err := svc.AddAccountParty(ctx, &accountpb.AddAccountPartyRequest{
+		AccountId:  account.ID.String(),
+		CustomerId: merged.String(),
+		Role:       string(models.PartyRoleJointHolder),
+	})
+	assertCode(t, err, codes.FailedPrecondition)
+}
+
+// mergedCustomerDirectory looks up merged customers as the customers they
+// were merged into, as customer-service does
+type mergedCustomerDirectory map[uuid.UUID]uuid.UUID
+
+func (d mergedCustomerDirectory) GetCustomer(ctx context.Context, id uuid.UUID) (*customerclient.Customer, error) {
+	if survivor, merged := d[id]; merged {
+		id = survivor
+	}
+	return &customerclient.Customer{ID: id, Status: "Active"}, nil
+}
+
+func (d mergedCustomerDirectory) GetCustomerProfile(ctx context.Context, id uuid.UUID) (*customerclient.Customer, error) {
+	return d.GetCustomer(ctx, id)
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/rs/zerolog"
+)
+
+// endOfDayFunc processes one business date and returns how many accounts it handled
+type endOfDayFunc func(ctx context.Context, businessDate time.Time) (int, error)
+
+// runEndOfDay closes each business day once it has ended, checking every
+// interval until the context is cancelled. The previous day is closed on
+// startup so that a restart never leaves a day unprocessed; a failed day is
+// retried on the next tick.
+func runEndOfDay(ctx context.Context, interval time.Duration, log zerolog.Logger, job string, run endOfDayFunc) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastClosed time.Time
+	for {
+		businessDate := interest.Date(time.Now()).AddDate(0, 0, -1)
+		if businessDate.After(lastClosed) {
+			accounts, err := run(ctx, businessDate)
+			if err != nil {
+				log.Error().Err(err).
+					Str("job", job).
+					Str("business_date", businessDate.Format(interest.DateLayout)).
+					Msg("End-of-day run failed")
+			} else {
+				lastClosed = businessDate
+				log.Info().
+					Str("job", job).
+					Str("business_date", businessDate.Format(interest.DateLayout)).
+					Int("accounts", accounts).
+					Msg("End-of-day run completed")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// FeeJob is the end-of-day job that charges periodic fees: the unarranged
+// overdraft fee for each day an account closes beyond its arranged limit, and
+// the monthly maintenance fee on the last day of each month.
+//
+// Each fee carries a reference derived from its period, so rerunning a day
+// never charges the same fee twice.
+type FeeJob struct {
+	repo     repository.AccountRepository
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewFeeJob creates a new FeeJob
+func NewFeeJob(repo repository.AccountRepository, interval time.Duration, log zerolog.Logger) *FeeJob {
+	return &FeeJob{
+		repo:     repo,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run closes each business day once it has ended, checking every interval
+// until the context is cancelled
+func (j *FeeJob) Run(ctx context.Context) {
+	runEndOfDay(ctx, j.interval, j.log, "fees", j.RunEndOfDay)
+}
+
+// RunEndOfDay charges the periodic fees due for the given business date on
+// every open account and returns how many accounts were processed. A failure
+// on one account does not stop the others.
+func (j *FeeJob) RunEndOfDay(ctx context.Context, businessDate time.Time) (int, error) {
+	businessDate = interest.Date(businessDate)
+
+	processed := 0
+	var failures []error
+	for _, accountType := range models.AccountTypes {
+		accounts, err := j.repo.ListOpenAccountsByType(ctx, accountType)
+		if err != nil {
+			return processed, err
+		}
+
+		for _, account := range accounts {
+			if err := j.processAccount(ctx, account.ID, businessDate); err != nil {
+				failures = append(failures, fmt.Errorf("account %s: %w", account.ID, err))
+				continue
+			}
+			processed++
+		}
+	}
+
+	if len(failures) > 0 {
+		return processed, fmt.Errorf("fee run for %s failed for %d accounts: %w",
+			businessDate.Format(interest.DateLayout), len(failures), errors.Join(failures...))
+	}
+
+	return processed, nil
+}
+
+func (j *FeeJob) processAccount(ctx context.Context, accountID uuid.UUID, businessDate time.Time) error {
+	tx, err := j.repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	repo := tx.AccountRepository()
+
+	account, err := repo.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	balance, err := repo.GetBalanceAsOf(ctx, account.ID, businessDate)
+	if err != nil {
+		return err
+	}
+
+	if balance < -account.OverdraftLimit {
+		reference := fmt.Sprintf("UNARRANGED-%s", businessDate.Format("20060102"))
+		if err := j.chargeOnce(ctx, repo, account, models.FeeTypeUnarrangedOverdraft, reference, balance, businessDate); err != nil {
+			return err
+		}
+	}
+
+	if interest.FrequencyMonthly.IsPeriodEnd(businessDate) {
+		reference := fmt.Sprintf("MAINTENANCE-%s", businessDate.Format("200601"))
+		if err := j.chargeOnce(ctx, repo, account, models.FeeTypeMonthlyMaintenance, reference, balance, businessDate); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// chargeOnce charges a periodic fee unless one with the same reference has
+// already been charged or waived. The fee is priced under the rule in force
+// at the end of the business date and waivers are judged on that date's
+// balance, so a rerun or back-fill charges what was due then.
+func (j *FeeJob) chargeOnce(ctx context.Context, repo repository.AccountRepository, account *models.Account, feeType models.FeeType, reference string, balance int64, businessDate time.Time) error {
+	if _, err := repo.GetFeeByReference(ctx, account.ID, feeType, reference); err == nil {
+		return nil
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	endOfDay := businessDate.AddDate(0, 0, 1).Add(-time.Microsecond)
+	_, err := chargeFee(ctx, repo, account, feeType, reference, balance, endOfDay, businessDate)
+	return err
+}
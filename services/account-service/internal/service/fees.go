@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var feeDescriptions = map[models.FeeType]string{
+	models.FeeTypeMonthlyMaintenance:  "Monthly maintenance fee",
+	models.FeeTypeUnarrangedOverdraft: "Unarranged overdraft fee",
+	models.FeeTypeInsufficientFunds:   "Insufficient funds fee",
+	models.FeeTypeTransfer:            "Transfer fee",
+}
+
+// SetFeeRule adds an entry to the fee schedule. Existing rules are never
+// modified, so the schedule keeps its full history and a rule takes effect
+// without a deploy as soon as its effective date passes.
+func (s *AccountService) SetFeeRule(ctx context.Context, req *accountpb.SetFeeRuleRequest) (*accountpb.SetFeeRuleResponse, error) {
+	if errs := s.validator.ValidateSetFeeRule(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	rule := &models.FeeRule{
+		ID:             uuid.New(),
+		AccountType:    models.AccountType(req.GetAccountType()),
+		FeeType:        models.FeeType(req.GetFeeType()),
+		Currency:       req.GetCurrency(),
+		Amount:         req.GetAmount(),
+		WaiverSegments: req.GetWaiverSegments(),
+		CreatedBy:      req.GetCreatedBy(),
+	}
+
+	if req.WaiverMinBalance != nil {
+		minBalance := req.GetWaiverMinBalance()
+		rule.WaiverMinBalance = &minBalance
+	}
+
+	if req.GetEffectiveFrom() != nil {
+		rule.EffectiveFrom = req.GetEffectiveFrom().AsTime()
+	} else {
+		rule.EffectiveFrom = time.Now().UTC()
+	}
+
+	if err := s.repo.CreateFeeRule(ctx, rule); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create fee rule: %v", err)
+	}
+
+	return &accountpb.SetFeeRuleResponse{
+		Rule: feeRuleModelToProto(rule),
+	}, nil
+}
+
+// ListFeeRules lists the fee schedule history
+func (s *AccountService) ListFeeRules(ctx context.Context, req *accountpb.ListFeeRulesRequest) (*accountpb.ListFeeRulesResponse, error) {
+	accountType := models.AccountType(req.GetAccountType())
+	if accountType != "" && !accountType.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account_type: %s", accountType)
+	}
+
+	rules, err := s.repo.ListFeeRules(ctx, accountType)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fee rules: %v", err)
+	}
+
+	protoRules := make([]*accountpb.FeeRule, len(rules))
+	for i, r := range rules {
+		protoRules[i] = feeRuleModelToProto(r)
+	}
+
+	return &accountpb.ListFeeRulesResponse{
+		Rules: protoRules,
+	}, nil
+}
+
+// ListFees lists the fees charged to or waived for an account
+func (s *AccountService) ListFees(ctx context.Context, req *accountpb.ListFeesRequest) (*accountpb.ListFeesResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := s.repo.ListFees(ctx, accountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fees: %v", err)
+	}
+
+	protoFees := make([]*accountpb.Fee, len(fees))
+	for i, f := range fees {
+		protoFees[i] = feeModelToProto(f)
+	}
+
+	return &accountpb.ListFeesResponse{
+		Fees: protoFees,
+	}, nil
+}
+
+// ReverseFee refunds a charged fee with a credit posting and keeps the fee
+// record, marked Reversed, for audit
+func (s *AccountService) ReverseFee(ctx context.Context, req *accountpb.ReverseFeeRequest) (*accountpb.ReverseFeeResponse, error) {
+	feeID, err := parseID("fee_id", req.GetFeeId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetReason() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	var fee *models.Fee
+	var reversal *models.Posting
+	var balance *models.Balance
+	err = s.withTx(ctx, func(repo repository.AccountRepository) error {
+		fee, err = s.getFee(ctx, repo, feeID)
+		if err != nil {
+			return err
+		}
+
+		account, err := s.lockAccount(ctx, repo, fee.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// Reload under the account lock; the fee may have been reversed
+		// meanwhile
+		if fee, err = s.getFee(ctx, repo, feeID); err != nil {
+			return err
+		}
+
+		if account.Status == models.AccountStatusClosed {
+			return status.Errorf(codes.FailedPrecondition, "account is closed")
+		}
+		if fee.Status != models.FeeStatusCharged {
+			return status.Errorf(codes.FailedPrecondition, "%v", models.ErrFeeNotReversible)
+		}
+
+		reversal = &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   account.ID,
+			PostingType: models.PostingTypeFeeReversal,
+			Amount:      fee.Amount,
+			Currency:    fee.Currency,
+			Reference:   fee.Reference,
+			Description: "Reversal of " + feeDescriptions[fee.FeeType],
+		}
+		if err := repo.AddPosting(ctx, reversal); err != nil {
+			return status.Errorf(codes.Internal, "failed to post fee reversal: %v", err)
+		}
+		account.LedgerBalance += reversal.Amount
+
+		if err := fee.Reverse(reversal.ID, req.GetReason(), req.GetReversedBy(), time.Now().UTC()); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if err := repo.UpdateFee(ctx, fee); err != nil {
+			if errors.Is(err, repository.ErrConflict) {
+				return status.Errorf(codes.FailedPrecondition, "%v", models.ErrFeeNotReversible)
+			}
+			return status.Errorf(codes.Internal, "failed to reverse fee: %v", err)
+		}
+
+		balance, err = s.balance(ctx, repo, account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.ReverseFeeResponse{
+		Fee:      feeModelToProto(fee),
+		Reversal: postingModelToProto(reversal),
+		Balance:  balanceModelToProto(balance),
+	}, nil
+}
+
+func (s *AccountService) getFee(ctx context.Context, repo repository.AccountRepository, id uuid.UUID) (*models.Fee, error) {
+	fee, err := repo.GetFeeByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "fee not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get fee: %v", err)
+	}
+	return fee, nil
+}
+
+// resolveFee prices a fee for the account from the schedule in force at the
+// given time, applying any waiver against the ledger balance given. It
+// returns nil when no rule applies or the rule's amount is zero. Nothing is
+// written.
+func resolveFee(ctx context.Context, repo repository.AccountRepository, account *models.Account, feeType models.FeeType, reference string, balance int64, at time.Time) (*models.Fee, error) {
+	rule, err := repo.GetFeeRule(ctx, account.AccountType, feeType, account.Currency, at)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rule.Amount == 0 {
+		return nil, nil
+	}
+
+	fee := &models.Fee{
+		ID:        uuid.New(),
+		AccountID: account.ID,
+		FeeRuleID: rule.ID,
+		FeeType:   feeType,
+		Amount:    rule.Amount,
+		Currency:  account.Currency,
+		Status:    models.FeeStatusCharged,
+		Reference: reference,
+		ChargedAt: at,
+	}
+
+	if waived, reason := rule.WaiverFor(account, balance); waived {
+		fee.Status = models.FeeStatusWaived
+		fee.WaiverReason = reason
+	}
+
+	return fee, nil
+}
+
+// postFee records a resolved fee and, unless it was waived, debits the
+// account. Fees are charged regardless of available funds and may take the
+// account into, or further into, overdraft.
+func postFee(ctx context.Context, repo repository.AccountRepository, account *models.Account, fee *models.Fee, valueDate time.Time) error {
+	if fee.Status == models.FeeStatusCharged {
+		posting := &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   account.ID,
+			PostingType: models.PostingTypeFee,
+			Amount:      -fee.Amount,
+			Currency:    fee.Currency,
+			Reference:   fee.Reference,
+			Description: feeDescriptions[fee.FeeType],
+			ValueDate:   valueDate,
+		}
+		if err := repo.AddPosting(ctx, posting); err != nil {
+			return err
+		}
+		account.LedgerBalance += posting.Amount
+		fee.PostingID = &posting.ID
+	}
+
+	return repo.CreateFee(ctx, fee)
+}
+
+// chargeFee resolves and posts a fee in one step, returning nil when no fee applies
+func chargeFee(ctx context.Context, repo repository.AccountRepository, account *models.Account, feeType models.FeeType, reference string, balance int64, at, valueDate time.Time) (*models.Fee, error) {
+	fee, err := resolveFee(ctx, repo, account, feeType, reference, balance, at)
+	if err != nil || fee == nil {
+		return nil, err
+	}
+	if err := postFee(ctx, repo, account, fee, valueDate); err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+func feeRuleModelToProto(r *models.FeeRule) *accountpb.FeeRule {
+	return &accountpb.FeeRule{
+		Id:               r.ID.String(),
+		AccountType:      string(r.AccountType),
+		FeeType:          string(r.FeeType),
+		Currency:         r.Currency,
+		Amount:           r.Amount,
+		WaiverMinBalance: r.WaiverMinBalance,
+		WaiverSegments:   r.WaiverSegments,
+		EffectiveFrom:    timestamppb.New(r.EffectiveFrom),
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+		CreatedBy:        r.CreatedBy,
+	}
+}
+
+func feeModelToProto(f *models.Fee) *accountpb.Fee {
+	fee := &accountpb.Fee{
+		Id:             f.ID.String(),
+		AccountId:      f.AccountID.String(),
+		FeeRuleId:      f.FeeRuleID.String(),
+		FeeType:        string(f.FeeType),
+		Amount:         f.Amount,
+		Currency:       f.Currency,
+		Status:         string(f.Status),
+		Reference:      f.Reference,
+		WaiverReason:   f.WaiverReason,
+		ReversalReason: f.ReversalReason,
+		ReversedBy:     f.ReversedBy,
+		ChargedAt:      timestamppb.New(f.ChargedAt),
+	}
+
+	if f.PostingID != nil {
+		fee.PostingId = f.PostingID.String()
+	}
+	if f.ReversalPostingID != nil {
+		fee.ReversalPostingId = f.ReversalPostingID.String()
+	}
+	if f.ReversedAt != nil {
+		fee.ReversedAt = timestamppb.New(*f.ReversedAt)
+	}
+
+	return fee
+}
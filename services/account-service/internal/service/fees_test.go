@@ -0,0 +1,478 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func seedFeeRule(t *testing.T, svc *AccountService, req *accountpb.SetFeeRuleRequest) {
+	t.Helper()
+	req.AccountType = string(models.AccountTypeChecking)
+	req.Currency = "USD"
+	if req.EffectiveFrom == nil {
+		req.EffectiveFrom = timestamppb.New(time.Now().Add(-time.Hour))
+	}
+	_, err := svc.SetFeeRule(context.Background(), req)
+	assertCode(t, err, codes.OK)
+}
+
+func TestAccountService_Transfer(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		balance        int64
+		overdraftLimit int64
+		sourceStatus   models.AccountStatus
+		currency       string
+		amount         int64
+		wantErr        codes.Code
+		wantLedger     int64
+	}{
+		{"within balance, fee charged", 10000, 0, models.AccountStatusActive, "USD", 5000, codes.OK, 4750},
+		{"into arranged overdraft", 1000, 10000, models.AccountStatusActive, "USD", 5000, codes.OK, -4250},
+		{"fee takes it past available funds", 5000, 0, models.AccountStatusActive, "USD", 5000, codes.FailedPrecondition, 3500},
+		{"beyond arranged overdraft", 1000, 2000, models.AccountStatusActive, "USD", 5000, codes.FailedPrecondition, -500},
+		{"frozen source", 10000, 0, models.AccountStatusFrozen, "USD", 100, codes.FailedPrecondition, 10000},
+		{"currency mismatch", 10000, 0, models.AccountStatusActive, "EUR", 100, codes.InvalidArgument, 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockRepository()
+			svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+			seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeTransfer), Amount: 250})
+			seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeInsufficientFunds), Amount: 1500})
+
+			from := seedAccount(repo, tt.balance, tt.sourceStatus)
+			from.OverdraftLimit = tt.overdraftLimit
+			to := seedAccount(repo, 0, models.AccountStatusActive)
+
+			resp, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+				FromAccountId: from.ID.String(),
+				ToAccountId:   to.ID.String(),
+				Amount:        tt.amount,
+				Currency:      tt.currency,
+			})
+			assertCode(t, err, tt.wantErr)
+
+			if got := repo.accounts[from.ID].LedgerBalance; got != tt.wantLedger {
+				t.Errorf("source ledger = %d, want %d", got, tt.wantLedger)
+			}
+			if tt.wantErr != codes.OK {
+				if got := repo.accounts[to.ID].LedgerBalance; got != 0 {
+					t.Errorf("destination ledger = %d after rejected transfer, want 0", got)
+				}
+				return
+			}
+
+			if resp.Fee == nil || resp.Fee.Amount != 250 || resp.Fee.Status != string(models.FeeStatusCharged) {
+				t.Errorf("Transfer() fee = %+v, want 250 Charged", resp.Fee)
+			}
+			if resp.Credit.Amount != tt.amount || repo.accounts[to.ID].LedgerBalance != tt.amount {
+				t.Errorf("Transfer() credit = %d, want %d", resp.Credit.Amount, tt.amount)
+			}
+			if resp.Balance.AvailableBalance != tt.wantLedger+tt.overdraftLimit {
+				t.Errorf("Transfer() available = %d, want %d", resp.Balance.AvailableBalance, tt.wantLedger+tt.overdraftLimit)
+			}
+		})
+	}
+}
+
+func TestAccountService_Transfer_IdempotencyKey(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeTransfer), Amount: 250})
+
+	from := seedAccount(repo, 10000, models.AccountStatusActive)
+	to := seedAccount(repo, 0, models.AccountStatusActive)
+	req := &accountpb.TransferRequest{
+		FromAccountId:  from.ID.String(),
+		ToAccountId:    to.ID.String(),
+		Amount:         1000,
+		Currency:       "USD",
+		IdempotencyKey: "ACH 076401250000001",
+	}
+
+	first, err := svc.Transfer(ctx, req)
+	assertCode(t, err, codes.OK)
+
+	// The repeat returns the first transfer, even once the account is frozen
+	repo.accounts[from.ID].Status = models.AccountStatusFrozen
+	replay, err := svc.Transfer(ctx, req)
+	assertCode(t, err, codes.OK)
+	if replay.Debit.Id != first.Debit.Id || replay.Credit.Id != first.Credit.Id || replay.Fee.GetId() != first.Fee.GetId() {
+		t.Errorf("Transfer() replay = %+v, want the first transfer %+v", replay, first)
+	}
+	if got := repo.accounts[from.ID].LedgerBalance; got != 8750 {
+		t.Errorf("source ledger = %d, want 8750 with the transfer posted once", got)
+	}
+	if got := repo.accounts[to.ID].LedgerBalance; got != 1000 {
+		t.Errorf("destination ledger = %d, want 1000", got)
+	}
+
+	req.Amount = 2000
+	_, err = svc.Transfer(ctx, req)
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+func TestAccountService_FeeWaivers(t *testing.T) {
+	ctx := context.Background()
+	minBalance := int64(100000)
+
+	tests := []struct {
+		name       string
+		balance    int64
+		segment    string
+		wantStatus models.FeeStatus
+	}{
+		{"charged below minimum balance", 5000, "Retail", models.FeeStatusCharged},
+		{"waived at minimum balance", 100000, "Retail", models.FeeStatusWaived},
+		{"waived for segment", 5000, "Premier", models.FeeStatusWaived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockRepository()
+			svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+			seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{
+				FeeType:          string(models.FeeTypeTransfer),
+				Amount:           300,
+				WaiverMinBalance: &minBalance,
+				WaiverSegments:   []string{"Premier", "Staff"},
+			})
+
+			from := seedAccount(repo, tt.balance, models.AccountStatusActive)
+			from.Segment = tt.segment
+			to := seedAccount(repo, 0, models.AccountStatusActive)
+
+			resp, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+				FromAccountId: from.ID.String(),
+				ToAccountId:   to.ID.String(),
+				Amount:        1000,
+				Currency:      "USD",
+			})
+			assertCode(t, err, codes.OK)
+
+			if resp.Fee.Status != string(tt.wantStatus) {
+				t.Fatalf("Transfer() fee status = %s, want %s", resp.Fee.Status, tt.wantStatus)
+			}
+			wantLedger := tt.balance - 1000
+			if tt.wantStatus == models.FeeStatusCharged {
+				wantLedger -= 300
+			} else if resp.Fee.WaiverReason == "" || resp.Fee.PostingId != "" {
+				t.Errorf("Transfer() waived fee = %+v, want a reason and no posting", resp.Fee)
+			}
+			if resp.Balance.LedgerBalance != wantLedger {
+				t.Errorf("Transfer() ledger = %d, want %d", resp.Balance.LedgerBalance, wantLedger)
+			}
+		})
+	}
+}
+
+func TestAccountService_FeeRuleHistory(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+
+	seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeTransfer), Amount: 250})
+	_, err := svc.SetFeeRule(ctx, &accountpb.SetFeeRuleRequest{
+		AccountType:   string(models.AccountTypeChecking),
+		FeeType:       string(models.FeeTypeTransfer),
+		Currency:      "USD",
+		Amount:        400,
+		EffectiveFrom: timestamppb.New(time.Now().Add(24 * time.Hour)),
+	})
+	assertCode(t, err, codes.OK)
+
+	from := seedAccount(repo, 10000, models.AccountStatusActive)
+	to := seedAccount(repo, 0, models.AccountStatusActive)
+
+	resp, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+		FromAccountId: from.ID.String(),
+		ToAccountId:   to.ID.String(),
+		Amount:        1000,
+		Currency:      "USD",
+	})
+	assertCode(t, err, codes.OK)
+	if resp.Fee.Amount != 250 {
+		t.Errorf("Transfer() fee = %d, want the rule in force (250)", resp.Fee.Amount)
+	}
+
+	rules, err := svc.ListFeeRules(ctx, &accountpb.ListFeeRulesRequest{})
+	assertCode(t, err, codes.OK)
+	if len(rules.Rules) != 2 {
+		t.Errorf("ListFeeRules() returned %d rules, want 2", len(rules.Rules))
+	}
+}
+
+func TestAccountService_ReverseFee(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeTransfer), Amount: 250})
+
+	from := seedAccount(repo, 10000, models.AccountStatusActive)
+	to := seedAccount(repo, 0, models.AccountStatusActive)
+
+	transfer, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+		FromAccountId: from.ID.String(),
+		ToAccountId:   to.ID.String(),
+		Amount:        1000,
+		Currency:      "USD",
+	})
+	assertCode(t, err, codes.OK)
+
+	_, err = svc.ReverseFee(ctx, &accountpb.ReverseFeeRequest{FeeId: transfer.Fee.Id})
+	assertCode(t, err, codes.InvalidArgument)
+
+	reversed, err := svc.ReverseFee(ctx, &accountpb.ReverseFeeRequest{
+		FeeId:      transfer.Fee.Id,
+		Reason:     "Goodwill",
+		ReversedBy: "ops@bank",
+	})
+	assertCode(t, err, codes.OK)
+
+	if reversed.Fee.Status != string(models.FeeStatusReversed) || reversed.Fee.ReversalPostingId != reversed.Reversal.Id {
+		t.Errorf("ReverseFee() fee = %+v, want Reversed and linked to the reversal posting", reversed.Fee)
+	}
+	if reversed.Reversal.Amount != 250 || reversed.Balance.LedgerBalance != 9000 {
+		t.Errorf("ReverseFee() reversal = %d, ledger = %d, want 250 and 9000", reversed.Reversal.Amount, reversed.Balance.LedgerBalance)
+	}
+
+	_, err = svc.ReverseFee(ctx, &accountpb.ReverseFeeRequest{FeeId: transfer.Fee.Id, Reason: "Again"})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	fees, err := svc.ListFees(ctx, &accountpb.ListFeesRequest{AccountId: from.ID.String()})
+	assertCode(t, err, codes.OK)
+	if len(fees.Fees) != 1 {
+		t.Errorf("ListFees() returned %d fees, want 1", len(fees.Fees))
+	}
+}
+
+func TestAccountService_ReverseFee_Concurrent(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeTransfer), Amount: 250})
+
+	from := seedAccount(repo, 10000, models.AccountStatusActive)
+	to := seedAccount(repo, 0, models.AccountStatusActive)
+	transfer, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+		FromAccountId: from.ID.String(),
+		ToAccountId:   to.ID.String(),
+		Amount:        1000,
+		Currency:      "USD",
+	})
+	assertCode(t, err, codes.OK)
+
+	// The first reversal takes the account lock while the second has
+	// already read the fee as charged
+	var firstErr error
+	repo.onLock = func() {
+		_, firstErr = svc.ReverseFee(ctx, &accountpb.ReverseFeeRequest{FeeId: transfer.Fee.Id, Reason: "Goodwill"})
+	}
+	_, err = svc.ReverseFee(ctx, &accountpb.ReverseFeeRequest{FeeId: transfer.Fee.Id, Reason: "Goodwill"})
+	assertCode(t, firstErr, codes.OK)
+	assertCode(t, err, codes.FailedPrecondition)
+
+	if got := repo.accounts[from.ID].LedgerBalance; got != 9000 {
+		t.Errorf("ledger balance = %d, want 9000 with the fee reversed once", got)
+	}
+
+	// A fee saved from a stale read is refused
+	stale := *repo.fees[uuid.MustParse(transfer.Fee.Id)]
+	if err := repo.UpdateFee(ctx, &stale); !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("UpdateFee() of a reversed fee error = %v, want ErrConflict", err)
+	}
+}
+
+func TestAccountService_SetOverdraftLimit(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	account := seedAccount(repo, 2000, models.AccountStatusActive)
+
+	resp, err := svc.SetOverdraftLimit(ctx, &accountpb.SetOverdraftLimitRequest{
+		AccountId: account.ID.String(),
+		Limit:     50000,
+		DebitRate: "19.9",
+	})
+	assertCode(t, err, codes.OK)
+
+	if resp.Account.OverdraftLimit != 50000 || resp.Account.OverdraftRate != "19.9000" {
+		t.Errorf("SetOverdraftLimit() account = %d at %s, want 50000 at 19.9000", resp.Account.OverdraftLimit, resp.Account.OverdraftRate)
+	}
+	if resp.Balance.OverdraftLimit != 50000 || resp.Balance.AvailableBalance != 52000 {
+		t.Errorf("SetOverdraftLimit() balance = %+v, want available 52000", resp.Balance)
+	}
+
+	closed := seedAccount(repo, 0, models.AccountStatusClosed)
+	_, err = svc.SetOverdraftLimit(ctx, &accountpb.SetOverdraftLimitRequest{AccountId: closed.ID.String(), Limit: 100})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	// A rate that does not parse is refused, not saved as zero
+	rate := repo.accounts[account.ID].OverdraftRate
+	for _, input := range []string{"abc", "19.99999"} {
+		_, err = svc.SetOverdraftLimit(ctx, &accountpb.SetOverdraftLimitRequest{AccountId: account.ID.String(), Limit: 50000, DebitRate: input})
+		assertCode(t, err, codes.InvalidArgument)
+	}
+	if got := repo.accounts[account.ID].OverdraftRate; got != rate {
+		t.Errorf("overdraft rate = %d after refused updates, want %d", got, rate)
+	}
+}
+
+func TestFeeJob_RunEndOfDay(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	effective := timestamppb.New(mustDate(t, "2024-01-01"))
+	seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeUnarrangedOverdraft), Amount: 2500, EffectiveFrom: effective})
+	seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{FeeType: string(models.FeeTypeMonthlyMaintenance), Amount: 500, EffectiveFrom: effective})
+
+	overdrawn := seedAccount(repo, 0, models.AccountStatusActive)
+	overdrawn.OverdraftLimit = 10000
+	inCredit := seedAccount(repo, 0, models.AccountStatusActive)
+
+	for id, amount := range map[uuid.UUID]int64{overdrawn.ID: -15000, inCredit.ID: 20000} {
+		err := repo.AddPosting(ctx, &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   id,
+			PostingType: models.PostingTypeDebit,
+			Amount:      amount,
+			Currency:    "USD",
+			ValueDate:   mustDate(t, "2024-01-30"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	job := NewFeeJob(repo, time.Hour, zerolog.Nop())
+	for _, day := range []string{"2024-01-30", "2024-01-31", "2024-01-31"} {
+		if _, err := job.RunEndOfDay(ctx, mustDate(t, day)); err != nil {
+			t.Fatalf("RunEndOfDay(%s) error = %v", day, err)
+		}
+	}
+
+	count := func(accountID uuid.UUID, feeType models.FeeType) int {
+		fees, _ := repo.ListFees(ctx, accountID)
+		n := 0
+		for _, f := range fees {
+			if f.FeeType == feeType {
+				n++
+			}
+		}
+		return n
+	}
+
+	if got := count(overdrawn.ID, models.FeeTypeUnarrangedOverdraft); got != 2 {
+		t.Errorf("overdrawn account charged %d unarranged overdraft fees, want one per day (2)", got)
+	}
+	if got := count(inCredit.ID, models.FeeTypeUnarrangedOverdraft); got != 0 {
+		t.Errorf("account in credit charged %d unarranged overdraft fees, want 0", got)
+	}
+	for _, id := range []uuid.UUID{overdrawn.ID, inCredit.ID} {
+		if got := count(id, models.FeeTypeMonthlyMaintenance); got != 1 {
+			t.Errorf("account charged %d maintenance fees, want 1", got)
+		}
+	}
+
+	if got := repo.accounts[overdrawn.ID].LedgerBalance; got != -15000-2*2500-500 {
+		t.Errorf("overdrawn ledger = %d, want %d", got, -15000-2*2500-500)
+	}
+}
+
+func TestFeeJob_RunEndOfDay_WaiverOnBusinessDateBalance(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	minBalance := int64(10000)
+	seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{
+		FeeType:          string(models.FeeTypeMonthlyMaintenance),
+		Amount:           500,
+		WaiverMinBalance: &minBalance,
+		EffectiveFrom:    timestamppb.New(mustDate(t, "2024-01-01")),
+	})
+
+	// The late payer was below the minimum on the day charged for, though
+	// a deposit since has taken the account above it
+	latePayer := seedAccount(repo, 0, models.AccountStatusActive)
+	aboveMinimum := seedAccount(repo, 0, models.AccountStatusActive)
+	deposits := []struct {
+		account *models.Account
+		amount  int64
+		day     string
+	}{
+		{latePayer, 5000, "2024-01-31"},
+		{latePayer, 50000, "2024-02-02"},
+		{aboveMinimum, 20000, "2024-01-31"},
+	}
+	for _, d := range deposits {
+		err := repo.AddPosting(ctx, &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   d.account.ID,
+			PostingType: models.PostingTypeCredit,
+			Amount:      d.amount,
+			Currency:    "USD",
+			ValueDate:   mustDate(t, d.day),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	job := NewFeeJob(repo, time.Hour, zerolog.Nop())
+	if _, err := job.RunEndOfDay(ctx, mustDate(t, "2024-01-31")); err != nil {
+		t.Fatalf("RunEndOfDay() error = %v", err)
+	}
+
+	for account, want := range map[*models.Account]models.FeeStatus{latePayer: models.FeeStatusCharged, aboveMinimum: models.FeeStatusWaived} {
+		fees, _ := repo.ListFees(ctx, account.ID)
+		if len(fees) != 1 || fees[0].Status != want {
+			t.Errorf("maintenance fees = %+v, want one %s", fees, want)
+		}
+	}
+}
+
+func TestFeeJob_RunEndOfDay_RuleInForceOnBusinessDate(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	ctx := context.Background()
+	for _, r := range []struct {
+		amount int64
+		from   string
+	}{
+		{500, "2024-01-01"},
+		{900, "2024-02-15"},
+	} {
+		seedFeeRule(t, svc, &accountpb.SetFeeRuleRequest{
+			FeeType:       string(models.FeeTypeMonthlyMaintenance),
+			Amount:        r.amount,
+			EffectiveFrom: timestamppb.New(mustDate(t, r.from)),
+		})
+	}
+	account := seedAccount(repo, 100000, models.AccountStatusActive)
+
+	// January is back-filled after the price rose in February
+	job := NewFeeJob(repo, time.Hour, zerolog.Nop())
+	if _, err := job.RunEndOfDay(ctx, mustDate(t, "2024-01-31")); err != nil {
+		t.Fatalf("RunEndOfDay() error = %v", err)
+	}
+
+	fees, _ := repo.ListFees(ctx, account.ID)
+	if len(fees) != 1 || fees[0].Amount != 500 {
+		t.Errorf("maintenance fees = %+v, want one of 500 under January's rule", fees)
+	}
+}
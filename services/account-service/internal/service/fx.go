@@ -0,0 +1,551 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/fx"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/core-banking/services/account-service/internal/statement"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fxRateSourceAPI is the source recorded for rates set over gRPC without a table
+const fxRateSourceAPI = "api"
+
+// SetFXConfig replaces the quote expiry and spreads applied to conversions.
+// The configuration must already be valid.
+func (s *AccountService) SetFXConfig(cfg *fx.Config) {
+	s.fxConfig = cfg
+}
+
+// LoadFXRates adds the rates of a CSV or JSON rate table file to the
+// exchange rate table and returns how many were new. Rates already in the
+// table are skipped, so the same file can be loaded at every start.
+func LoadFXRates(ctx context.Context, repo repository.AccountRepository, path, loadedBy string) (int, error) {
+	entries, err := fx.LoadTable(path)
+	if err != nil {
+		return 0, err
+	}
+	return repo.CreateFXRates(ctx, fxRatesFromEntries(entries, filepath.Base(path), loadedBy))
+}
+
+// SetFXRates adds effective-dated mid rates to the exchange rate table.
+// Existing rates are never modified: a correction is a new rate effective
+// from the time it applies.
+func (s *AccountService) SetFXRates(ctx context.Context, req *accountpb.SetFXRatesRequest) (*accountpb.SetFXRatesResponse, error) {
+	if errs := s.validator.ValidateSetFXRates(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	var entries []fx.Entry
+	source := fxRateSourceAPI
+	if len(req.GetTable()) > 0 {
+		var err error
+		entries, err = fx.ParseTable(fx.Format(req.GetFormat()), req.GetTable())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		source = fmt.Sprintf("table %s", models.HashContent(req.GetTable()))
+	} else {
+		now := time.Now().UTC()
+		for i, r := range req.GetRates() {
+			mid, err := fx.ParseRate(r.GetMidRate())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "rates[%d]: %v", i, err)
+			}
+			entry := fx.Entry{
+				Base:          r.GetBaseCurrency(),
+				Quote:         r.GetQuoteCurrency(),
+				EffectiveFrom: now,
+				Mid:           mid,
+			}
+			if r.GetEffectiveFrom() != nil {
+				entry.EffectiveFrom = r.GetEffectiveFrom().AsTime()
+			}
+			if err := entry.Validate(); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "rates[%d]: %v", i, err)
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	rates := fxRatesFromEntries(entries, source, req.GetCreatedBy())
+	created, err := s.repo.CreateFXRates(ctx, rates)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set fx rates: %v", err)
+	}
+
+	return &accountpb.SetFXRatesResponse{
+		Created: int32(created),
+		Skipped: int32(len(rates) - created),
+	}, nil
+}
+
+// ListFXRates lists the rate history of a currency pair as the table quotes it
+func (s *AccountService) ListFXRates(ctx context.Context, req *accountpb.ListFXRatesRequest) (*accountpb.ListFXRatesResponse, error) {
+	if !fx.IsCurrency(req.GetBaseCurrency()) || !fx.IsCurrency(req.GetQuoteCurrency()) {
+		return nil, status.Errorf(codes.InvalidArgument, "base_currency and quote_currency must be 3-letter ISO 4217 codes")
+	}
+
+	rates, err := s.repo.ListFXRates(ctx, req.GetBaseCurrency(), req.GetQuoteCurrency())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fx rates: %v", err)
+	}
+
+	protoRates := make([]*accountpb.FXRate, len(rates))
+	for i, r := range rates {
+		protoRates[i] = fxRateModelToProto(r)
+	}
+
+	return &accountpb.ListFXRatesResponse{
+		Rates: protoRates,
+	}, nil
+}
+
+// SetFXPositionAccount assigns the account that holds the bank's position in
+// one currency of a pair; the currency is the account's. An account holds
+// one position only.
+func (s *AccountService) SetFXPositionAccount(ctx context.Context, req *accountpb.SetFXPositionAccountRequest) (*accountpb.SetFXPositionAccountResponse, error) {
+	if errs := s.validator.ValidateSetFXPositionAccount(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	account, err := s.getAccount(ctx, s.repo, uuid.MustParse(req.GetAccountId()))
+	if err != nil {
+		return nil, err
+	}
+
+	pair := req.GetCurrencyPair()
+	if account.Currency != pair[:3] && account.Currency != pair[4:] {
+		return nil, status.Errorf(codes.FailedPrecondition, "account is in %s, not a currency of %s", account.Currency, pair)
+	}
+	if account.Status == models.AccountStatusClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "account is closed")
+	}
+
+	position := &models.FXPositionAccount{
+		CurrencyPair: pair,
+		Currency:     account.Currency,
+		AccountID:    account.ID,
+		UpdatedBy:    req.GetUpdatedBy(),
+	}
+	if err := s.repo.SetFXPositionAccount(ctx, position); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, status.Errorf(codes.AlreadyExists, "account already holds another fx position")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set fx position account: %v", err)
+	}
+
+	return &accountpb.SetFXPositionAccountResponse{
+		Position: fxPositionAccountModelToProto(position),
+	}, nil
+}
+
+// CreateFXQuote prices a conversion from one account to another in a
+// different currency at the latest rate effective now, less the spread of
+// the source account's segment. The customer fixes either the amount to
+// convert or the amount to deliver; the other side is rounded in the bank's
+// favour. The quote can be executed until it expires.
+func (s *AccountService) CreateFXQuote(ctx context.Context, req *accountpb.CreateFXQuoteRequest) (*accountpb.CreateFXQuoteResponse, error) {
+	if errs := s.validator.ValidateCreateFXQuote(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	from, err := s.getAccount(ctx, s.repo, uuid.MustParse(req.GetFromAccountId()))
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getAccount(ctx, s.repo, uuid.MustParse(req.GetToAccountId()))
+	if err != nil {
+		return nil, err
+	}
+
+	if from.Currency == to.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "both accounts are in %s; use Transfer", from.Currency)
+	}
+	if from.Status != models.AccountStatusActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "source account is %s", from.Status)
+	}
+	if to.Status == models.AccountStatusClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "destination account is closed")
+	}
+	if _, _, err := fxPositions(ctx, s.repo, from.Currency, to.Currency); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	rate, inverted, err := findFXRate(ctx, s.repo, from.Currency, to.Currency, now)
+	if err != nil {
+		return nil, err
+	}
+
+	spread := s.fxConfig.Spread(from.Segment)
+	customerRate, err := fx.CustomerRate(fx.Rate(rate.MidRate), inverted, spread)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	fixed := models.FXFixedSideSource
+	var conversion fx.Conversion
+	if req.GetSourceAmount() > 0 {
+		conversion, err = fx.ConvertSource(req.GetSourceAmount(), from.Currency, to.Currency, customerRate)
+	} else {
+		fixed = models.FXFixedSideTarget
+		conversion, err = fx.ConvertTarget(req.GetTargetAmount(), from.Currency, to.Currency, customerRate)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	quote := &models.FXQuote{
+		ID:             uuid.New(),
+		FromAccountID:  from.ID,
+		ToAccountID:    to.ID,
+		SourceCurrency: from.Currency,
+		TargetCurrency: to.Currency,
+		SourceAmount:   conversion.SourceAmount,
+		TargetAmount:   conversion.TargetAmount,
+		FixedSide:      fixed,
+		RateID:         rate.ID,
+		MidRate:        rate.MidRate,
+		Inverted:       inverted,
+		Segment:        from.Segment,
+		SpreadBps:      int(spread),
+		CustomerRate:   int64(customerRate),
+		ExactAmount:    conversion.ExactString(),
+		Status:         models.FXQuoteStatusOpen,
+		ExpiresAt:      now.Add(s.fxConfig.QuoteTTL),
+		CreatedAt:      now,
+		CreatedBy:      req.GetRequestedBy(),
+	}
+	if err := s.repo.CreateFXQuote(ctx, quote); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create fx quote: %v", err)
+	}
+
+	return &accountpb.CreateFXQuoteResponse{
+		Quote: fxQuoteModelToProto(quote, now),
+	}, nil
+}
+
+// GetFXQuote retrieves an FX quote
+func (s *AccountService) GetFXQuote(ctx context.Context, req *accountpb.GetFXQuoteRequest) (*accountpb.GetFXQuoteResponse, error) {
+	quoteID, err := parseID("quote_id", req.GetQuoteId())
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.repo.GetFXQuote(ctx, quoteID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "fx quote not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get fx quote: %v", err)
+	}
+
+	return &accountpb.GetFXQuoteResponse{
+		Quote: fxQuoteModelToProto(quote, time.Now()),
+	}, nil
+}
+
+// ExecuteFXQuote books a quoted conversion in four balanced legs: the source
+// amount moves from the customer's account to the pair's position account in
+// that currency, and the target amount from the pair's position account in
+// the other currency to the destination account. The conversion counts
+// against the customer's transaction limits in the source currency and is
+// recorded for transaction monitoring. A quote executes once; a quote past
+// its expiry is marked Expired instead.
+func (s *AccountService) ExecuteFXQuote(ctx context.Context, req *accountpb.ExecuteFXQuoteRequest) (*accountpb.ExecuteFXQuoteResponse, error) {
+	if errs := s.validator.ValidateExecuteFXQuote(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	quoteID := uuid.MustParse(req.GetQuoteId())
+
+	var quote *models.FXQuote
+	var debit, credit *models.Posting
+	var positionPostings []*models.Posting
+	var balance *models.Balance
+	var rejected error
+	err := s.withTx(ctx, func(repo repository.AccountRepository) error {
+		var err error
+		quote, err = repo.GetFXQuoteForUpdate(ctx, quoteID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return status.Errorf(codes.NotFound, "fx quote not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get fx quote: %v", err)
+		}
+
+		now := time.Now().UTC()
+		switch quote.StatusAt(now) {
+		case models.FXQuoteStatusExecuted:
+			return status.Errorf(codes.FailedPrecondition, "fx quote has already been executed")
+		case models.FXQuoteStatusExpired:
+			if quote.Status == models.FXQuoteStatusOpen {
+				// The expiry is committed even though the conversion is not
+				quote.Status = models.FXQuoteStatusExpired
+				if err := repo.UpdateFXQuote(ctx, quote); err != nil {
+					return status.Errorf(codes.Internal, "failed to expire fx quote: %v", err)
+				}
+			}
+			rejected = status.Errorf(codes.FailedPrecondition, "fx quote expired at %s", quote.ExpiresAt.Format(time.RFC3339))
+			return nil
+		}
+
+		sourcePosition, targetPosition, err := fxPositions(ctx, repo, quote.SourceCurrency, quote.TargetCurrency)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := s.lockAccounts(ctx, repo, quote.FromAccountID, quote.ToAccountID, sourcePosition.AccountID, targetPosition.AccountID)
+		if err != nil {
+			return err
+		}
+		from, to := accounts[quote.FromAccountID], accounts[quote.ToAccountID]
+		sourcePositionAccount, targetPositionAccount := accounts[sourcePosition.AccountID], accounts[targetPosition.AccountID]
+
+		if from.Status != models.AccountStatusActive {
+			return status.Errorf(codes.FailedPrecondition, "source account is %s", from.Status)
+		}
+		if to.Status == models.AccountStatusClosed {
+			return status.Errorf(codes.FailedPrecondition, "destination account is closed")
+		}
+		if sourcePositionAccount.Status == models.AccountStatusClosed || targetPositionAccount.Status == models.AccountStatusClosed {
+			return status.Errorf(codes.FailedPrecondition, "fx position account for %s is closed", fx.Pair(quote.SourceCurrency, quote.TargetCurrency))
+		}
+
+		reference := req.GetReference()
+		if reference == "" {
+			reference = fmt.Sprintf("FX-%s", quote.ID)
+		}
+
+		channel := channelOrDefault(req.GetChannel())
+		usage, err := s.checkLimits(ctx, repo, from, channel, models.TransactionTypeFXConversion, quote.SourceAmount, reference, now)
+		if err != nil {
+			return err
+		}
+
+		current, err := s.balance(ctx, repo, from)
+		if err != nil {
+			return err
+		}
+		if current.Available < quote.SourceAmount {
+			return status.Errorf(codes.FailedPrecondition, "insufficient available funds: available %d, required %d", current.Available, quote.SourceAmount)
+		}
+
+		description := req.GetDescription()
+		if description == "" {
+			description = fmt.Sprintf("FX %s %s to %s %s at %s",
+				statement.FormatAmount(quote.SourceAmount, quote.SourceCurrency), quote.SourceCurrency,
+				statement.FormatAmount(quote.TargetAmount, quote.TargetCurrency), quote.TargetCurrency,
+				fx.Rate(quote.CustomerRate))
+		}
+
+		legs := []struct {
+			account *models.Account
+			amount  int64
+		}{
+			{from, -quote.SourceAmount},
+			{sourcePositionAccount, quote.SourceAmount},
+			{targetPositionAccount, -quote.TargetAmount},
+			{to, quote.TargetAmount},
+		}
+		postings := make([]*models.Posting, len(legs))
+		for i, leg := range legs {
+			postings[i] = &models.Posting{
+				ID:          uuid.New(),
+				AccountID:   leg.account.ID,
+				PostingType: postingTypeFor(leg.amount),
+				Amount:      leg.amount,
+				Currency:    leg.account.Currency,
+				Reference:   reference,
+				Description: description,
+			}
+			if err := repo.AddPosting(ctx, postings[i]); err != nil {
+				return status.Errorf(codes.Internal, "failed to post fx leg: %v", err)
+			}
+			leg.account.LedgerBalance += leg.amount
+		}
+		debit, credit = postings[0], postings[3]
+		positionPostings = postings[1:3]
+
+		if usage != nil {
+			if err := repo.RecordLimitUsage(ctx, usage); err != nil {
+				return status.Errorf(codes.Internal, "failed to record limit usage: %v", err)
+			}
+		}
+
+		err = s.recordTransactionEvents(ctx, repo, movement{
+			from:            from,
+			to:              to,
+			fromAmount:      quote.SourceAmount,
+			toAmount:        quote.TargetAmount,
+			channel:         channel,
+			transactionType: models.TransactionTypeFXConversion,
+			reference:       reference,
+			occurredAt:      now,
+		})
+		if err != nil {
+			return err
+		}
+
+		quote.Status = models.FXQuoteStatusExecuted
+		quote.ExecutedAt = &now
+		quote.ExecutedBy = req.GetExecutedBy()
+		quote.Reference = reference
+		quote.DebitPostingID = &debit.ID
+		quote.CreditPostingID = &credit.ID
+		if err := repo.UpdateFXQuote(ctx, quote); err != nil {
+			return status.Errorf(codes.Internal, "failed to update fx quote: %v", err)
+		}
+
+		balance, err = s.balance(ctx, repo, from)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rejected != nil {
+		return nil, rejected
+	}
+
+	protoPositionPostings := make([]*accountpb.Posting, len(positionPostings))
+	for i, p := range positionPostings {
+		protoPositionPostings[i] = postingModelToProto(p)
+	}
+
+	return &accountpb.ExecuteFXQuoteResponse{
+		Quote:            fxQuoteModelToProto(quote, time.Now()),
+		Debit:            postingModelToProto(debit),
+		Credit:           postingModelToProto(credit),
+		PositionPostings: protoPositionPostings,
+		Balance:          balanceModelToProto(balance),
+	}, nil
+}
+
+// findFXRate returns the rate effective at a time for converting from one
+// currency into another. When the table only quotes the pair the other way
+// round the rate is returned with inverted set.
+func findFXRate(ctx context.Context, repo repository.AccountRepository, from, to string, at time.Time) (*models.FXRate, bool, error) {
+	rate, err := repo.GetFXRate(ctx, from, to, at)
+	if err == nil {
+		return rate, false, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return nil, false, status.Errorf(codes.Internal, "failed to get fx rate: %v", err)
+	}
+
+	rate, err = repo.GetFXRate(ctx, to, from, at)
+	if err == nil {
+		return rate, true, nil
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, false, status.Errorf(codes.FailedPrecondition, "no %s rate is effective", fx.Pair(from, to))
+	}
+	return nil, false, status.Errorf(codes.Internal, "failed to get fx rate: %v", err)
+}
+
+// fxPositions returns the position accounts of a conversion's pair in the
+// source and target currencies
+func fxPositions(ctx context.Context, repo repository.AccountRepository, source, target string) (*models.FXPositionAccount, *models.FXPositionAccount, error) {
+	pair := fx.Pair(source, target)
+	positions := make([]*models.FXPositionAccount, 2)
+	for i, currency := range []string{source, target} {
+		position, err := repo.GetFXPositionAccount(ctx, pair, currency)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, nil, status.Errorf(codes.FailedPrecondition, "no %s position account is set for %s", currency, pair)
+			}
+			return nil, nil, status.Errorf(codes.Internal, "failed to get fx position account: %v", err)
+		}
+		positions[i] = position
+	}
+	return positions[0], positions[1], nil
+}
+
+func fxRatesFromEntries(entries []fx.Entry, source, createdBy string) []*models.FXRate {
+	rates := make([]*models.FXRate, len(entries))
+	for i, e := range entries {
+		rates[i] = &models.FXRate{
+			ID:            uuid.New(),
+			BaseCurrency:  e.Base,
+			QuoteCurrency: e.Quote,
+			MidRate:       int64(e.Mid),
+			EffectiveFrom: e.EffectiveFrom,
+			Source:        source,
+			CreatedBy:     createdBy,
+		}
+	}
+	return rates
+}
+
+func fxRateModelToProto(r *models.FXRate) *accountpb.FXRate {
+	return &accountpb.FXRate{
+		Id:            r.ID.String(),
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		MidRate:       fx.Rate(r.MidRate).String(),
+		EffectiveFrom: timestamppb.New(r.EffectiveFrom),
+		Source:        r.Source,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		CreatedBy:     r.CreatedBy,
+	}
+}
+
+func fxPositionAccountModelToProto(p *models.FXPositionAccount) *accountpb.FXPositionAccount {
+	return &accountpb.FXPositionAccount{
+		CurrencyPair: p.CurrencyPair,
+		Currency:     p.Currency,
+		AccountId:    p.AccountID.String(),
+		UpdatedAt:    timestamppb.New(p.UpdatedAt),
+		UpdatedBy:    p.UpdatedBy,
+	}
+}
+
+// fxQuoteModelToProto converts a quote, reporting its status as of now
+func fxQuoteModelToProto(q *models.FXQuote, now time.Time) *accountpb.FXQuote {
+	quote := &accountpb.FXQuote{
+		Id:             q.ID.String(),
+		FromAccountId:  q.FromAccountID.String(),
+		ToAccountId:    q.ToAccountID.String(),
+		SourceCurrency: q.SourceCurrency,
+		TargetCurrency: q.TargetCurrency,
+		SourceAmount:   q.SourceAmount,
+		TargetAmount:   q.TargetAmount,
+		FixedSide:      string(q.FixedSide),
+		RateId:         q.RateID.String(),
+		MidRate:        fx.Rate(q.MidRate).String(),
+		Inverted:       q.Inverted,
+		Segment:        q.Segment,
+		SpreadBps:      int32(q.SpreadBps),
+		CustomerRate:   fx.Rate(q.CustomerRate).String(),
+		ExactAmount:    q.ExactAmount,
+		Status:         string(q.StatusAt(now)),
+		ExpiresAt:      timestamppb.New(q.ExpiresAt),
+		CreatedAt:      timestamppb.New(q.CreatedAt),
+		CreatedBy:      q.CreatedBy,
+		ExecutedBy:     q.ExecutedBy,
+		Reference:      q.Reference,
+	}
+
+	if q.ExecutedAt != nil {
+		quote.ExecutedAt = timestamppb.New(*q.ExecutedAt)
+	}
+	if q.DebitPostingID != nil {
+		quote.DebitPostingId = q.DebitPostingID.String()
+	}
+	if q.CreditPostingID != nil {
+		quote.CreditPostingId = q.CreditPostingID.String()
+	}
+
+	return quote
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/fx"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// seedFXDesk loads an EUR/USD rate of 1.0850 and assigns the pair's two
+// position accounts, returning them by currency
+func seedFXDesk(t *testing.T, svc *AccountService, repo *MockRepository) map[string]*models.Account {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := svc.SetFXRates(ctx, &accountpb.SetFXRatesRequest{
+		Rates: []*accountpb.FXRate{{
+			BaseCurrency:  "EUR",
+			QuoteCurrency: "USD",
+			MidRate:       "1.0850",
+			EffectiveFrom: timestamppb.New(time.Now().Add(-time.Hour)),
+		}},
+		CreatedBy: "treasury",
+	})
+	assertCode(t, err, codes.OK)
+
+	positions := make(map[string]*models.Account)
+	for _, currency := range []string{"EUR", "USD"} {
+		account := seedCurrencyAccount(repo, currency, 0)
+		_, err := svc.SetFXPositionAccount(ctx, &accountpb.SetFXPositionAccountRequest{
+			CurrencyPair: "EUR/USD",
+			AccountId:    account.ID.String(),
+			UpdatedBy:    "treasury",
+		})
+		assertCode(t, err, codes.OK)
+		positions[currency] = account
+	}
+	return positions
+}
+
+func seedCurrencyAccount(repo *MockRepository, currency string, balance int64) *models.Account {
+	account := seedAccount(repo, balance, models.AccountStatusActive)
+	account.Currency = currency
+	return account
+}
+
+func TestAccountService_ExecuteFXQuote(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	positions := seedFXDesk(t, svc, repo)
+
+	from := seedCurrencyAccount(repo, "USD", 100000)
+	to := seedCurrencyAccount(repo, "EUR", 0)
+
+	quoted, err := svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+		FromAccountId: from.ID.String(),
+		ToAccountId:   to.ID.String(),
+		SourceAmount:  10000,
+		RequestedBy:   "customer",
+	})
+	assertCode(t, err, codes.OK)
+
+	// The table quotes EUR/USD, so USD to EUR uses 1/1.085 less the default
+	// 50bps: 100.00 USD is 91.70506912 EUR, rounded down to 91.70
+	q := quoted.Quote
+	if !q.Inverted || q.SpreadBps != int32(fx.DefaultSpread) || q.CustomerRate != "0.9170506912" {
+		t.Errorf("CreateFXQuote() pricing = inverted %v, spread %d, rate %s", q.Inverted, q.SpreadBps, q.CustomerRate)
+	}
+	if q.SourceAmount != 10000 || q.TargetAmount != 9170 || q.ExactAmount != "9170.506912000000" || q.FixedSide != "Source" {
+		t.Errorf("CreateFXQuote() amounts = %d -> %d (%s, %s)", q.SourceAmount, q.TargetAmount, q.ExactAmount, q.FixedSide)
+	}
+	if q.Status != "Open" || !q.ExpiresAt.AsTime().After(time.Now()) {
+		t.Errorf("CreateFXQuote() status = %s, expires %v", q.Status, q.ExpiresAt.AsTime())
+	}
+
+	executed, err := svc.ExecuteFXQuote(ctx, &accountpb.ExecuteFXQuoteRequest{QuoteId: q.Id, ExecutedBy: "customer"})
+	assertCode(t, err, codes.OK)
+
+	if executed.Quote.Status != "Executed" || executed.Quote.DebitPostingId != executed.Debit.Id || executed.Quote.CreditPostingId != executed.Credit.Id {
+		t.Errorf("ExecuteFXQuote() quote = %+v", executed.Quote)
+	}
+	if executed.Debit.Reference != "FX-"+q.Id || executed.Balance.LedgerBalance != 90000 {
+		t.Errorf("ExecuteFXQuote() debit reference %s, ledger %d", executed.Debit.Reference, executed.Balance.LedgerBalance)
+	}
+
+	wantLedgers := map[*models.Account]int64{
+		from:             90000,
+		to:               9170,
+		positions["USD"]: 10000,
+		positions["EUR"]: -9170,
+	}
+	for account, want := range wantLedgers {
+		if got := repo.accounts[account.ID].LedgerBalance; got != want {
+			t.Errorf("%s account ledger = %d, want %d", account.Currency, got, want)
+		}
+	}
+
+	// Each currency's legs balance
+	sums := make(map[string]int64)
+	for _, p := range repo.postings {
+		sums[p.Currency] += p.Amount
+	}
+	if sums["USD"] != 0 || sums["EUR"] != 0 {
+		t.Errorf("postings do not balance per currency: %v", sums)
+	}
+
+	_, err = svc.ExecuteFXQuote(ctx, &accountpb.ExecuteFXQuoteRequest{QuoteId: q.Id})
+	assertCode(t, err, codes.FailedPrecondition)
+	if len(repo.postings) != 4 {
+		t.Errorf("got %d postings after executing twice, want 4", len(repo.postings))
+	}
+}
+
+func TestAccountService_CreateFXQuote(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	svc.SetFXConfig(&fx.Config{
+		QuoteTTL:       time.Minute,
+		DefaultSpread:  fx.DefaultSpread,
+		SegmentSpreads: map[string]fx.Spread{"Private": 0},
+	})
+	seedFXDesk(t, svc, repo)
+
+	usd := seedCurrencyAccount(repo, "USD", 0)
+	eur := seedCurrencyAccount(repo, "EUR", 0)
+	private := seedCurrencyAccount(repo, "EUR", 0)
+	private.Segment = "Private"
+	gbp := seedCurrencyAccount(repo, "GBP", 0)
+
+	// Delivering 100.00 EUR at 0.9170506912 takes 109.0451... USD, rounded up
+	resp, err := svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+		FromAccountId: usd.ID.String(),
+		ToAccountId:   eur.ID.String(),
+		TargetAmount:  10000,
+	})
+	assertCode(t, err, codes.OK)
+	if resp.Quote.SourceAmount != 10905 || resp.Quote.TargetAmount != 10000 || resp.Quote.FixedSide != "Target" {
+		t.Errorf("CreateFXQuote(target) = %d -> %d (%s)", resp.Quote.SourceAmount, resp.Quote.TargetAmount, resp.Quote.FixedSide)
+	}
+
+	// The Private segment pays no spread on the direct rate
+	resp, err = svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+		FromAccountId: private.ID.String(),
+		ToAccountId:   usd.ID.String(),
+		SourceAmount:  10000,
+	})
+	assertCode(t, err, codes.OK)
+	if resp.Quote.Inverted || resp.Quote.SpreadBps != 0 || resp.Quote.Segment != "Private" || resp.Quote.TargetAmount != 10850 {
+		t.Errorf("CreateFXQuote(Private) = %+v", resp.Quote)
+	}
+	if got := resp.Quote.ExpiresAt.AsTime().Sub(resp.Quote.CreatedAt.AsTime()); got != time.Minute {
+		t.Errorf("CreateFXQuote() expires after %v, want 1m", got)
+	}
+
+	tests := []struct {
+		name    string
+		from    *models.Account
+		to      *models.Account
+		source  int64
+		target  int64
+		wantErr codes.Code
+	}{
+		{"same currency", eur, private, 100, 0, codes.InvalidArgument},
+		{"both amounts", usd, eur, 100, 100, codes.InvalidArgument},
+		{"no position accounts", usd, gbp, 100, 0, codes.FailedPrecondition},
+		{"rounds to nothing", usd, eur, 1, 0, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+				FromAccountId: tt.from.ID.String(),
+				ToAccountId:   tt.to.ID.String(),
+				SourceAmount:  tt.source,
+				TargetAmount:  tt.target,
+			})
+			assertCode(t, err, tt.wantErr)
+		})
+	}
+
+	// Without a rate in either direction nothing can be quoted
+	repo.fxRates = nil
+	_, err = svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+		FromAccountId: usd.ID.String(),
+		ToAccountId:   eur.ID.String(),
+		SourceAmount:  100,
+	})
+	assertCode(t, err, codes.FailedPrecondition)
+}
+
+func TestAccountService_ExecuteFXQuote_Rejected(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	seedFXDesk(t, svc, repo)
+
+	from := seedCurrencyAccount(repo, "EUR", 5000)
+	to := seedCurrencyAccount(repo, "USD", 0)
+
+	quote := func(amount int64) string {
+		resp, err := svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+			FromAccountId: from.ID.String(),
+			ToAccountId:   to.ID.String(),
+			SourceAmount:  amount,
+		})
+		assertCode(t, err, codes.OK)
+		return resp.Quote.Id
+	}
+
+	_, err := svc.ExecuteFXQuote(ctx, &accountpb.ExecuteFXQuoteRequest{QuoteId: quote(6000)})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	// An expired quote is marked Expired even though nothing is booked
+	expiredID := quote(1000)
+	for _, q := range repo.quotes {
+		if q.ID.String() == expiredID {
+			q.ExpiresAt = time.Now().Add(-time.Second)
+		}
+	}
+	_, err = svc.ExecuteFXQuote(ctx, &accountpb.ExecuteFXQuoteRequest{QuoteId: expiredID})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	got, err := svc.GetFXQuote(ctx, &accountpb.GetFXQuoteRequest{QuoteId: expiredID})
+	assertCode(t, err, codes.OK)
+	if got.Quote.Status != "Expired" {
+		t.Errorf("GetFXQuote() status = %s, want Expired", got.Quote.Status)
+	}
+	for _, q := range repo.quotes {
+		if q.ID.String() == expiredID && q.Status != models.FXQuoteStatusExpired {
+			t.Errorf("stored quote status = %s, want Expired", q.Status)
+		}
+	}
+
+	if len(repo.postings) != 0 || repo.accounts[from.ID].LedgerBalance != 5000 {
+		t.Errorf("rejected executions booked %d postings", len(repo.postings))
+	}
+}
+
+func TestAccountService_FXRatesAndPositions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+
+	table := []byte("base,quote,effective_from,mid_rate\nEUR,USD,2024-01-01,1.0950\nEUR,USD,2024-02-01,1.0800\n")
+	resp, err := svc.SetFXRates(ctx, &accountpb.SetFXRatesRequest{Table: table, CreatedBy: "treasury"})
+	assertCode(t, err, codes.OK)
+	if resp.Created != 2 || resp.Skipped != 0 {
+		t.Errorf("SetFXRates() = %d created, %d skipped", resp.Created, resp.Skipped)
+	}
+
+	// Loading the same table again adds nothing
+	resp, err = svc.SetFXRates(ctx, &accountpb.SetFXRatesRequest{Table: table, CreatedBy: "treasury"})
+	assertCode(t, err, codes.OK)
+	if resp.Created != 0 || resp.Skipped != 2 {
+		t.Errorf("SetFXRates() again = %d created, %d skipped", resp.Created, resp.Skipped)
+	}
+
+	list, err := svc.ListFXRates(ctx, &accountpb.ListFXRatesRequest{BaseCurrency: "EUR", QuoteCurrency: "USD"})
+	assertCode(t, err, codes.OK)
+	if len(list.Rates) != 2 || list.Rates[0].MidRate != "1.0800000000" || list.Rates[0].EffectiveFrom.AsTime() != mustDate(t, "2024-02-01") {
+		t.Errorf("ListFXRates() = %v", list.Rates)
+	}
+
+	for name, req := range map[string]*accountpb.SetFXRatesRequest{
+		"no rates":       {CreatedBy: "treasury"},
+		"bad table":      {Table: []byte("base,quote\nEUR,USD\n"), CreatedBy: "treasury"},
+		"bad rate":       {Rates: []*accountpb.FXRate{{BaseCurrency: "EUR", QuoteCurrency: "USD", MidRate: "-1"}}, CreatedBy: "treasury"},
+		"same currency":  {Rates: []*accountpb.FXRate{{BaseCurrency: "EUR", QuoteCurrency: "EUR", MidRate: "1"}}, CreatedBy: "treasury"},
+		"no created_by":  {Rates: []*accountpb.FXRate{{BaseCurrency: "EUR", QuoteCurrency: "USD", MidRate: "1"}}},
+		"unknown format": {Table: table, Format: "XML", CreatedBy: "treasury"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.SetFXRates(ctx, req)
+			assertCode(t, err, codes.InvalidArgument)
+		})
+	}
+
+	eur := seedCurrencyAccount(repo, "EUR", 0)
+	gbp := seedCurrencyAccount(repo, "GBP", 0)
+	position := func(pair string, account *models.Account) error {
+		_, err := svc.SetFXPositionAccount(ctx, &accountpb.SetFXPositionAccountRequest{
+			CurrencyPair: pair,
+			AccountId:    account.ID.String(),
+			UpdatedBy:    "treasury",
+		})
+		return err
+	}
+	assertCode(t, position("EUR/USD", eur), codes.OK)
+	assertCode(t, position("EUR/USD", gbp), codes.FailedPrecondition)
+	assertCode(t, position("EUR/GBP", eur), codes.AlreadyExists)
+	assertCode(t, position("USD/EUR", eur), codes.InvalidArgument)
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// HoldExpirer periodically releases active holds that have passed their expiry
+type HoldExpirer struct {
+	repo      repository.AccountRepository
+	interval  time.Duration
+	batchSize int
+	log       zerolog.Logger
+}
+
+// NewHoldExpirer creates a new HoldExpirer
+func NewHoldExpirer(repo repository.AccountRepository, interval time.Duration, batchSize int, log zerolog.Logger) *HoldExpirer {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &HoldExpirer{
+		repo:      repo,
+		interval:  interval,
+		batchSize: batchSize,
+		log:       log,
+	}
+}
+
+// Run expires holds every interval until the context is cancelled
+func (e *HoldExpirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := e.ExpireHolds(ctx, time.Now().UTC())
+			if err != nil {
+				e.log.Error().Err(err).Msg("Hold expiry run failed")
+				continue
+			}
+			if expired > 0 {
+				e.log.Info().Int("expired", expired).Msg("Expired stale holds")
+			}
+		}
+	}
+}
+
+// ExpireHolds expires every active hold whose expiry is at or before now and
+// returns how many were expired. Legal holds on frozen accounts are skipped
+// so that a freeze never causes a court-ordered hold to lapse.
+func (e *HoldExpirer) ExpireHolds(ctx context.Context, now time.Time) (int, error) {
+	holds, err := e.repo.ListExpiredHolds(ctx, now, e.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, h := range holds {
+		ok, err := e.expireHold(ctx, h, now)
+		if err != nil {
+			return expired, fmt.Errorf("failed to expire hold %s: %w", h.ID, err)
+		}
+		if ok {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+func (e *HoldExpirer) expireHold(ctx context.Context, candidate *models.Hold, now time.Time) (bool, error) {
+	tx, err := e.repo.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	repo := tx.AccountRepository()
+
+	account, err := repo.GetAccountForUpdate(ctx, candidate.AccountID)
+	if err != nil {
+		return false, err
+	}
+
+	// Reload under the account lock; the hold may have been released or captured meanwhile
+	hold, err := repo.GetHoldByID(ctx, candidate.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if hold.Status != models.HoldStatusActive || !hold.IsExpired(now) {
+		return false, nil
+	}
+
+	if hold.HoldType.IsLegal() && account.Status == models.AccountStatusFrozen {
+		return false, nil
+	}
+
+	if err := hold.Expire(); err != nil {
+		return false, err
+	}
+
+	if err := repo.UpdateHold(ctx, hold); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// InterestJob is the end-of-day job that accrues daily credit interest on
+// every interest-bearing account, and overdraft interest on every account with
+// a debit rate, and capitalizes both at the end of each period.
+//
+// The job is idempotent: accruals are keyed by account and day and replaced
+// on rerun, and each period is capitalized at most once. Running it for a
+// date also fills any days missed since the last run and recalculates from
+// the value date of any posting booked since then that lands in the past.
+type InterestJob struct {
+	repo     repository.AccountRepository
+	config   *interest.Config
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewInterestJob creates a new InterestJob
+func NewInterestJob(repo repository.AccountRepository, config *interest.Config, interval time.Duration, log zerolog.Logger) *InterestJob {
+	return &InterestJob{
+		repo:     repo,
+		config:   config,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run closes each business day once it has ended, checking every interval
+// until the context is cancelled
+func (j *InterestJob) Run(ctx context.Context) {
+	runEndOfDay(ctx, j.interval, j.log, "interest", j.RunEndOfDay)
+}
+
+// RunEndOfDay accrues interest for the given business date on every open
+// account that has an interest product or an overdraft rate and returns how
+// many accounts were processed. A failure on one account does not stop the
+// others.
+func (j *InterestJob) RunEndOfDay(ctx context.Context, businessDate time.Time) (int, error) {
+	businessDate = interest.Date(businessDate)
+
+	processed := 0
+	var failures []error
+	for _, accountType := range models.AccountTypes {
+		product, _ := j.config.Product(accountType)
+
+		accounts, err := j.repo.ListOpenAccountsByType(ctx, accountType)
+		if err != nil {
+			return processed, err
+		}
+
+		for _, account := range accounts {
+			if product == nil && account.OverdraftRate == 0 {
+				continue
+			}
+			if err := j.processAccount(ctx, product, account.ID, businessDate); err != nil {
+				failures = append(failures, fmt.Errorf("account %s: %w", account.ID, err))
+				continue
+			}
+			processed++
+		}
+	}
+
+	if len(failures) > 0 {
+		return processed, fmt.Errorf("interest run for %s failed for %d accounts: %w",
+			businessDate.Format(interest.DateLayout), len(failures), errors.Join(failures...))
+	}
+
+	return processed, nil
+}
+
+func (j *InterestJob) processAccount(ctx context.Context, product *interest.Product, accountID uuid.UUID, businessDate time.Time) error {
+	// Anything booked from here on is picked up as a back-value by the next run
+	calculatedAt := time.Now().UTC()
+
+	tx, err := j.repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	repo := tx.AccountRepository()
+
+	account, err := repo.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	start, err := j.recalculateFrom(ctx, repo, account)
+	if err != nil {
+		return err
+	}
+
+	frequency := interest.DefaultOverdraftCapitalization
+	if product != nil {
+		frequency = product.Capitalization
+	}
+
+	for day := start; !day.After(businessDate); day = day.AddDate(0, 0, 1) {
+		if err := j.accrue(ctx, repo, product, account, day, calculatedAt); err != nil {
+			return err
+		}
+		if frequency.IsPeriodEnd(day) {
+			if err := j.capitalize(ctx, repo, frequency, account, day); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// recalculateFrom returns the first day whose accrual must be (re)computed:
+// the day after the last accrual, or earlier when a posting booked since
+// that accrual was calculated is value-dated on or before it.
+func (j *InterestJob) recalculateFrom(ctx context.Context, repo repository.AccountRepository, account *models.Account) (time.Time, error) {
+	opened := interest.Date(account.OpenedAt)
+
+	latest, err := repo.GetLatestAccrual(ctx, account.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return opened, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	start := interest.Date(latest.AccrualDate).AddDate(0, 0, 1)
+
+	earliest, err := repo.EarliestValueDateBookedSince(ctx, account.ID, latest.CalculatedAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if earliest != nil {
+		if backValue := interest.Date(*earliest); backValue.Before(start) {
+			start = backValue
+		}
+	}
+
+	if start.Before(opened) {
+		start = opened
+	}
+	return start, nil
+}
+
+// accrue computes the day's credit interest under the product, if any, and
+// overdraft interest at the account's debit rate
+func (j *InterestJob) accrue(ctx context.Context, repo repository.AccountRepository, product *interest.Product, account *models.Account, day, calculatedAt time.Time) error {
+	balance, err := repo.GetBalanceAsOf(ctx, account.ID, day)
+	if err != nil {
+		return err
+	}
+
+	accrual := &models.InterestAccrual{
+		AccountID:    account.ID,
+		AccrualDate:  day,
+		Balance:      balance,
+		DebitRate:    account.OverdraftRate,
+		CalculatedAt: calculatedAt,
+	}
+
+	dayCount := interest.DefaultOverdraftDayCount
+	if product != nil {
+		dayCount = product.DayCount
+
+		amount, rate, err := product.Accrue(day, balance)
+		if err != nil {
+			return err
+		}
+		accrual.AmountMicros = amount
+		accrual.Rate = int64(rate)
+	}
+
+	accrual.DebitMicros, err = interest.AccrueDebit(dayCount, interest.Rate(account.OverdraftRate), day, balance)
+	if err != nil {
+		return err
+	}
+
+	return repo.UpsertAccrual(ctx, accrual)
+}
+
+// capitalize posts the credit and overdraft interest for the period ending on
+// periodEnd, value dated the following day so that it counts towards the
+// balance of the next period
+func (j *InterestJob) capitalize(ctx context.Context, repo repository.AccountRepository, frequency interest.Frequency, account *models.Account, periodEnd time.Time) error {
+	if _, err := repo.GetCapitalization(ctx, account.ID, periodEnd); err == nil {
+		return nil
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	accruedCredit, accruedDebit, err := repo.SumAccruals(ctx, account.ID, periodEnd)
+	if err != nil {
+		return err
+	}
+
+	capitalizedCredit, capitalizedDebit, err := repo.SumCapitalizations(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	capitalization := &models.InterestCapitalization{
+		ID:          uuid.New(),
+		AccountID:   account.ID,
+		PeriodStart: frequency.PeriodStart(periodEnd),
+		PeriodEnd:   periodEnd,
+		Amount:      interest.CapitalizationAmount(accruedCredit, capitalizedCredit),
+		DebitAmount: interest.CapitalizationAmount(accruedDebit, capitalizedDebit),
+	}
+
+	period := fmt.Sprintf("%s to %s", capitalization.PeriodStart.Format(interest.DateLayout), periodEnd.Format(interest.DateLayout))
+
+	if capitalization.Amount > 0 {
+		posting, err := postInterest(ctx, repo, account, capitalization.Amount,
+			fmt.Sprintf("INT-%s", periodEnd.Format("20060102")), "Interest "+period, periodEnd)
+		if err != nil {
+			return err
+		}
+		capitalization.PostingID = &posting.ID
+	}
+
+	if capitalization.DebitAmount > 0 {
+		posting, err := postInterest(ctx, repo, account, -capitalization.DebitAmount,
+			fmt.Sprintf("ODI-%s", periodEnd.Format("20060102")), "Overdraft interest "+period, periodEnd)
+		if err != nil {
+			return err
+		}
+		capitalization.DebitPostingID = &posting.ID
+	}
+
+	return repo.CreateCapitalization(ctx, capitalization)
+}
+
+func postInterest(ctx context.Context, repo repository.AccountRepository, account *models.Account, amount int64, reference, description string, periodEnd time.Time) (*models.Posting, error) {
+	posting := &models.Posting{
+		ID:          uuid.New(),
+		AccountID:   account.ID,
+		PostingType: models.PostingTypeInterest,
+		Amount:      amount,
+		Currency:    account.Currency,
+		Reference:   reference,
+		Description: description,
+		ValueDate:   periodEnd.AddDate(0, 0, 1),
+	}
+	if err := repo.AddPosting(ctx, posting); err != nil {
+		return nil, err
+	}
+	return posting, nil
+}
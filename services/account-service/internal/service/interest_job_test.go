@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// interestScenario is a golden scenario from testdata/interest. Expected
+// values were computed independently with exact rational arithmetic by
+// recalculating every accrual from the account opening on each run.
+type interestScenario struct {
+	Description    string             `json:"description"`
+	Config         json.RawMessage    `json:"config"`
+	AccountType    models.AccountType `json:"account_type"`
+	OverdraftLimit int64              `json:"overdraft_limit"`
+	OverdraftRate  string             `json:"overdraft_rate"`
+	Opened         string             `json:"opened"`
+	RunFrom        string             `json:"run_from"`
+	RunTo          string             `json:"run_to"`
+	SkipRuns       []string           `json:"skip_runs"`
+	Postings       []struct {
+		Booked    string `json:"booked"`
+		ValueDate string `json:"value_date"`
+		Amount    int64  `json:"amount"`
+	} `json:"postings"`
+	Expected struct {
+		Capitalizations []struct {
+			PeriodEnd   string `json:"period_end"`
+			Amount      int64  `json:"amount"`
+			DebitAmount int64  `json:"debit_amount"`
+		} `json:"capitalizations"`
+		AccruedMicros int64 `json:"accrued_micros"`
+		DebitMicros   int64 `json:"debit_micros"`
+		LedgerBalance int64 `json:"ledger_balance"`
+	} `json:"expected"`
+}
+
+func mustDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse(interest.DateLayout, s)
+	if err != nil {
+		t.Fatalf("invalid date %q: %v", s, err)
+	}
+	return d
+}
+
+func TestInterestJob_GoldenScenarios(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "interest", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no golden scenarios found")
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var sc interestScenario
+			if err := json.Unmarshal(data, &sc); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := interest.ParseConfig(sc.Config)
+			if err != nil {
+				t.Fatalf("ParseConfig() error = %v", err)
+			}
+
+			ctx := context.Background()
+			repo := NewMockRepository()
+			account := seedAccount(repo, 0, models.AccountStatusActive)
+			account.AccountType = models.AccountTypeSavings
+			if sc.AccountType != "" {
+				account.AccountType = sc.AccountType
+			}
+			account.OpenedAt = mustDate(t, sc.Opened)
+			account.OverdraftLimit = sc.OverdraftLimit
+			if sc.OverdraftRate != "" {
+				rate, err := interest.ParseRate(sc.OverdraftRate)
+				if err != nil {
+					t.Fatal(err)
+				}
+				account.OverdraftRate = int64(rate)
+			}
+
+			skip := make(map[string]bool)
+			for _, d := range sc.SkipRuns {
+				skip[d] = true
+			}
+
+			job := NewInterestJob(repo, cfg, time.Hour, zerolog.Nop())
+			end := mustDate(t, sc.RunTo)
+			for day := mustDate(t, sc.RunFrom); !day.After(end); day = day.AddDate(0, 0, 1) {
+				for _, p := range sc.Postings {
+					if mustDate(t, p.Booked).Equal(day) {
+						err := repo.AddPosting(ctx, &models.Posting{
+							ID:          uuid.New(),
+							AccountID:   account.ID,
+							PostingType: models.PostingTypeCredit,
+							Amount:      p.Amount,
+							Currency:    account.Currency,
+							ValueDate:   mustDate(t, p.ValueDate),
+						})
+						if err != nil {
+							t.Fatal(err)
+						}
+					}
+				}
+				if skip[day.Format(interest.DateLayout)] {
+					continue
+				}
+				if _, err := job.RunEndOfDay(ctx, day); err != nil {
+					t.Fatalf("RunEndOfDay(%s) error = %v", day.Format(interest.DateLayout), err)
+				}
+			}
+
+			// Rerunning the last day must change nothing
+			if _, err := job.RunEndOfDay(ctx, end); err != nil {
+				t.Fatalf("RunEndOfDay() rerun error = %v", err)
+			}
+
+			if len(repo.caps) != len(sc.Expected.Capitalizations) {
+				t.Fatalf("got %d capitalizations, want %d", len(repo.caps), len(sc.Expected.Capitalizations))
+			}
+			for i, want := range sc.Expected.Capitalizations {
+				got := repo.caps[i]
+				if got.PeriodEnd.Format(interest.DateLayout) != want.PeriodEnd || got.Amount != want.Amount || got.DebitAmount != want.DebitAmount {
+					t.Errorf("capitalization %d = %s %d/%d, want %s %d/%d", i,
+						got.PeriodEnd.Format(interest.DateLayout), got.Amount, got.DebitAmount,
+						want.PeriodEnd, want.Amount, want.DebitAmount)
+				}
+			}
+
+			accrued, debit, _ := repo.SumAccruals(ctx, account.ID, end)
+			if accrued != sc.Expected.AccruedMicros {
+				t.Errorf("accrued micros = %d, want %d", accrued, sc.Expected.AccruedMicros)
+			}
+			if debit != sc.Expected.DebitMicros {
+				t.Errorf("debit micros = %d, want %d", debit, sc.Expected.DebitMicros)
+			}
+
+			stored, _ := repo.GetAccountByID(ctx, account.ID)
+			if stored.LedgerBalance != sc.Expected.LedgerBalance {
+				t.Errorf("ledger balance = %d, want %d", stored.LedgerBalance, sc.Expected.LedgerBalance)
+			}
+		})
+	}
+}
+
+func TestInterestJob_SkipsAccountsWithoutProduct(t *testing.T) {
+	cfg, err := interest.ParseConfig([]byte(`{"products": [{
+		"account_type": "Savings", "day_count": "ACT/365", "capitalization": "Monthly",
+		"rates": [{"effective_from": "2024-01-01", "method": "Tiered", "tiers": [{"min_balance": 0, "rate": "1"}]}]
+	}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	repo := NewMockRepository()
+	checking := seedAccount(repo, 0, models.AccountStatusActive)
+	checking.OpenedAt = mustDate(t, "2024-01-01")
+
+	processed, err := NewInterestJob(repo, cfg, time.Hour, zerolog.Nop()).RunEndOfDay(ctx, mustDate(t, "2024-01-05"))
+	if err != nil {
+		t.Fatalf("RunEndOfDay() error = %v", err)
+	}
+	if processed != 0 || len(repo.accruals[checking.ID]) != 0 {
+		t.Errorf("checking account should not accrue interest, processed = %d", processed)
+	}
+}
@@ -0,0 +1,461 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetLimitRule adds an entry to the transaction limits. Existing rules are
+// never modified; a rule for the same kind, channel, transaction type, risk
+// tier and currency replaces the earlier one from its effective date.
+func (s *AccountService) SetLimitRule(ctx context.Context, req *accountpb.SetLimitRuleRequest) (*accountpb.SetLimitRuleResponse, error) {
+	if errs := s.validator.ValidateSetLimitRule(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	rule := &models.LimitRule{
+		ID:              uuid.New(),
+		Kind:            models.LimitKind(req.GetKind()),
+		Channel:         models.Channel(req.GetChannel()),
+		TransactionType: models.TransactionType(req.GetTransactionType()),
+		RiskTier:        models.RiskTier(req.GetRiskTier()),
+		Currency:        req.GetCurrency(),
+		Value:           req.GetValue(),
+		CreatedBy:       req.GetCreatedBy(),
+	}
+
+	if req.GetEffectiveFrom() != nil {
+		rule.EffectiveFrom = req.GetEffectiveFrom().AsTime()
+	} else {
+		rule.EffectiveFrom = time.Now().UTC()
+	}
+
+	if err := s.repo.CreateLimitRule(ctx, rule); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create limit rule: %v", err)
+	}
+
+	return &accountpb.SetLimitRuleResponse{
+		Rule: limitRuleModelToProto(rule),
+	}, nil
+}
+
+// ListLimitRules lists the transaction limit history
+func (s *AccountService) ListLimitRules(ctx context.Context, req *accountpb.ListLimitRulesRequest) (*accountpb.ListLimitRulesResponse, error) {
+	rules, err := s.repo.ListLimitRules(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list limit rules: %v", err)
+	}
+
+	var protoRules []*accountpb.LimitRule
+	for _, r := range rules {
+		if req.GetCurrency() == "" || r.Currency == req.GetCurrency() {
+			protoRules = append(protoRules, limitRuleModelToProto(r))
+		}
+	}
+
+	return &accountpb.ListLimitRulesResponse{
+		Rules: protoRules,
+	}, nil
+}
+
+// SetCustomerRiskTier sets the risk tier that chooses which limits apply to
+// a customer. Customers without one are in the Medium tier.
+func (s *AccountService) SetCustomerRiskTier(ctx context.Context, req *accountpb.SetCustomerRiskTierRequest) (*accountpb.SetCustomerRiskTierResponse, error) {
+	customerID, err := parseID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	if !models.RiskTier(req.GetRiskTier()).IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "risk_tier must be Low, Medium or High")
+	}
+	if req.GetUpdatedBy() == "" || len(req.GetUpdatedBy()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "updated_by is required and must not exceed 255 characters")
+	}
+
+	tier := &models.CustomerRiskTier{
+		CustomerID: customerID,
+		RiskTier:   models.RiskTier(req.GetRiskTier()),
+		UpdatedBy:  req.GetUpdatedBy(),
+	}
+	if err := s.repo.SetCustomerRiskTier(ctx, tier); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set customer risk tier: %v", err)
+	}
+
+	return &accountpb.SetCustomerRiskTierResponse{
+		CustomerId: tier.CustomerID.String(),
+		RiskTier:   string(tier.RiskTier),
+		UpdatedAt:  timestamppb.New(tier.UpdatedAt),
+	}, nil
+}
+
+// GrantLimitIncrease raises one limit rule for one customer until the
+// increase expires. The increase must be above the rule's own value.
+func (s *AccountService) GrantLimitIncrease(ctx context.Context, req *accountpb.GrantLimitIncreaseRequest) (*accountpb.GrantLimitIncreaseResponse, error) {
+	if errs := s.validator.ValidateGrantLimitIncrease(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	rule, err := s.repo.GetLimitRule(ctx, uuid.MustParse(req.GetLimitRuleId()))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "limit rule not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get limit rule: %v", err)
+	}
+	if req.GetValue() <= rule.Value {
+		return nil, status.Errorf(codes.InvalidArgument, "value %d does not exceed the limit of %d", req.GetValue(), rule.Value)
+	}
+
+	increase := &models.LimitIncrease{
+		ID:          uuid.New(),
+		CustomerID:  uuid.MustParse(req.GetCustomerId()),
+		LimitRuleID: rule.ID,
+		Value:       req.GetValue(),
+		StartsAt:    time.Now().UTC(),
+		ExpiresAt:   req.GetExpiresAt().AsTime(),
+		Reason:      req.GetReason(),
+		CreatedBy:   req.GetGrantedBy(),
+	}
+	if req.GetStartsAt() != nil {
+		increase.StartsAt = req.GetStartsAt().AsTime()
+	}
+
+	if err := s.repo.CreateLimitIncrease(ctx, increase); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create limit increase: %v", err)
+	}
+
+	return &accountpb.GrantLimitIncreaseResponse{
+		Increase: limitIncreaseModelToProto(increase),
+	}, nil
+}
+
+// RevokeLimitIncrease ends a temporary limit increase before it expires.
+// The increase is kept, marked revoked, for audit.
+func (s *AccountService) RevokeLimitIncrease(ctx context.Context, req *accountpb.RevokeLimitIncreaseRequest) (*accountpb.RevokeLimitIncreaseResponse, error) {
+	increaseID, err := parseID("increase_id", req.GetIncreaseId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetRevokedBy() == "" || len(req.GetRevokedBy()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "revoked_by is required and must not exceed 255 characters")
+	}
+
+	increase, err := s.repo.GetLimitIncrease(ctx, increaseID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "limit increase not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get limit increase: %v", err)
+	}
+
+	now := time.Now().UTC()
+	if increase.RevokedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "limit increase is already revoked")
+	}
+	if !now.Before(increase.ExpiresAt) {
+		return nil, status.Errorf(codes.FailedPrecondition, "limit increase has already expired")
+	}
+
+	increase.RevokedAt = &now
+	increase.RevokedBy = req.GetRevokedBy()
+	if err := s.repo.RevokeLimitIncrease(ctx, increase); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "limit increase is already revoked")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke limit increase: %v", err)
+	}
+
+	return &accountpb.RevokeLimitIncreaseResponse{
+		Increase: limitIncreaseModelToProto(increase),
+	}, nil
+}
+
+// GetRemainingLimits reports, for each limit on transactions from the
+// account, how much its customer has left in the current period. Usage
+// counts transactions from all the customer's accounts in the currency.
+func (s *AccountService) GetRemainingLimits(ctx context.Context, req *accountpb.GetRemainingLimitsRequest) (*accountpb.GetRemainingLimitsResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	channel := models.Channel(req.GetChannel())
+	if channel != "" && !channel.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "channel must be Branch, Online, Mobile, API or Batch")
+	}
+	txType := models.TransactionType(req.GetTransactionType())
+	if txType != "" && !txType.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_type must be Transfer or FXConversion")
+	}
+
+	account, err := s.getAccount(ctx, s.repo, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	tier, err := customerRiskTier(ctx, s.repo, account.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := remainingLimits(ctx, s.repo, account.CustomerID, tier, account.Currency, channel, txType, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	protoLimits := make([]*accountpb.RemainingLimit, len(limits))
+	for i, l := range limits {
+		protoLimits[i] = remainingLimitToProto(l)
+	}
+
+	return &accountpb.GetRemainingLimitsResponse{
+		CustomerId: account.CustomerID.String(),
+		RiskTier:   string(tier),
+		Currency:   account.Currency,
+		Limits:     protoLimits,
+	}, nil
+}
+
+// limitState is how much of one limit rule a customer has used and has
+// left in the period containing a point in time
+type limitState struct {
+	rule      *models.LimitRule
+	increase  *models.LimitIncrease
+	limit     int64
+	used      int64
+	remaining int64
+	resetsAt  time.Time
+}
+
+// SetSettlementAccounts names the accounts the bank settles payment schemes
+// through, such as the ACH settlement account. Like FX position accounts
+// they move the bank's own money, so transactions from them are not held to
+// the limits of the customer that owns them.
+func (s *AccountService) SetSettlementAccounts(ids []uuid.UUID) {
+	s.settlementAccounts = make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		s.settlementAccounts[id] = true
+	}
+}
+
+// checkLimits rejects a transaction from the account that would exceed any
+// limit on its customer, and otherwise returns the usage to record with the
+// transaction's postings. It must run in the transaction that books them:
+// the customer's limits stay locked until it ends, so concurrent
+// transactions are checked one after the other. Settlement and FX position
+// accounts are exempt: nothing is checked and the usage is nil.
+func (s *AccountService) checkLimits(ctx context.Context, repo repository.AccountRepository, account *models.Account, channel models.Channel, txType models.TransactionType, amount int64, reference string, now time.Time) (*models.LimitUsage, error) {
+	if s.settlementAccounts[account.ID] {
+		return nil, nil
+	}
+	position, err := repo.IsFXPositionAccount(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	if position {
+		return nil, nil
+	}
+
+	if err := repo.LockCustomerLimits(ctx, account.CustomerID); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	tier, err := customerRiskTier(ctx, repo, account.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := remainingLimits(ctx, repo, account.CustomerID, tier, account.Currency, channel, txType, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range limits {
+		requested := amount
+		if l.rule.Kind.IsCount() {
+			requested = 1
+		}
+		if requested > l.remaining {
+			return nil, limitBreachError(l, requested)
+		}
+	}
+
+	return &models.LimitUsage{
+		ID:              uuid.New(),
+		CustomerID:      account.CustomerID,
+		AccountID:       account.ID,
+		Channel:         channel,
+		TransactionType: txType,
+		Currency:        account.Currency,
+		Amount:          amount,
+		Reference:       reference,
+		OccurredAt:      now,
+	}, nil
+}
+
+// remainingLimits evaluates the limit rules in force for a customer in the
+// risk tier. An empty channel or transaction type includes the rules for
+// every one.
+func remainingLimits(ctx context.Context, repo repository.AccountRepository, customerID uuid.UUID, tier models.RiskTier, currency string, channel models.Channel, txType models.TransactionType, now time.Time) ([]limitState, error) {
+	rules, err := repo.ListEffectiveLimitRules(ctx, currency, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list limit rules: %v", err)
+	}
+	increases, err := repo.ListActiveLimitIncreases(ctx, customerID, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list limit increases: %v", err)
+	}
+	// The latest increase granted for a rule applies
+	byRule := make(map[uuid.UUID]*models.LimitIncrease, len(increases))
+	for _, inc := range increases {
+		byRule[inc.LimitRuleID] = inc
+	}
+
+	var limits []limitState
+	for _, rule := range rules {
+		if !rule.Applies(tier, channel, txType) {
+			continue
+		}
+
+		l := limitState{rule: rule, limit: rule.Value, increase: byRule[rule.ID]}
+		if l.increase != nil {
+			l.limit = l.increase.Value
+		}
+
+		if rule.Kind != models.LimitKindPerTransaction {
+			from, to := rule.Kind.Window(now)
+			amount, count, err := repo.SumLimitUsage(ctx, models.LimitUsageFilter{
+				CustomerID:      customerID,
+				Channel:         rule.Channel,
+				TransactionType: rule.TransactionType,
+				Currency:        currency,
+				From:            from,
+				To:              to,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to sum limit usage: %v", err)
+			}
+			l.used = amount
+			if rule.Kind.IsCount() {
+				l.used = count
+			}
+			l.resetsAt = to
+		}
+
+		l.remaining = l.limit - l.used
+		if l.remaining < 0 {
+			l.remaining = 0
+		}
+		limits = append(limits, l)
+	}
+	return limits, nil
+}
+
+func customerRiskTier(ctx context.Context, repo repository.AccountRepository, customerID uuid.UUID) (models.RiskTier, error) {
+	tier, err := repo.GetCustomerRiskTier(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return models.DefaultRiskTier, nil
+		}
+		return "", status.Errorf(codes.Internal, "failed to get customer risk tier: %v", err)
+	}
+	return tier.RiskTier, nil
+}
+
+// limitBreachError is a FailedPrecondition status carrying a LimitBreach
+// detail, so callers can show the customer the limit and headroom
+func limitBreachError(l limitState, requested int64) error {
+	breach := &accountpb.LimitBreach{
+		LimitId:   l.rule.ID.String(),
+		Kind:      string(l.rule.Kind),
+		Currency:  l.rule.Currency,
+		Limit:     l.limit,
+		Used:      l.used,
+		Remaining: l.remaining,
+		Requested: requested,
+	}
+	if !l.resetsAt.IsZero() {
+		breach.ResetsAt = timestamppb.New(l.resetsAt)
+	}
+	if l.increase != nil {
+		breach.IncreaseId = l.increase.ID.String()
+	}
+
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("%s limit %s exceeded: remaining %d, requested %d",
+		l.rule.Kind, l.rule.ID, l.remaining, requested))
+	detailed, err := st.WithDetails(breach)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// channelOrDefault returns the channel a request names, or API when it
+// names none
+func channelOrDefault(channel string) models.Channel {
+	if channel == "" {
+		return models.ChannelAPI
+	}
+	return models.Channel(channel)
+}
+
+func limitRuleModelToProto(r *models.LimitRule) *accountpb.LimitRule {
+	return &accountpb.LimitRule{
+		Id:              r.ID.String(),
+		Kind:            string(r.Kind),
+		Channel:         string(r.Channel),
+		TransactionType: string(r.TransactionType),
+		RiskTier:        string(r.RiskTier),
+		Currency:        r.Currency,
+		Value:           r.Value,
+		EffectiveFrom:   timestamppb.New(r.EffectiveFrom),
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+		CreatedBy:       r.CreatedBy,
+	}
+}
+
+func limitIncreaseModelToProto(i *models.LimitIncrease) *accountpb.LimitIncrease {
+	increase := &accountpb.LimitIncrease{
+		Id:          i.ID.String(),
+		CustomerId:  i.CustomerID.String(),
+		LimitRuleId: i.LimitRuleID.String(),
+		Value:       i.Value,
+		StartsAt:    timestamppb.New(i.StartsAt),
+		ExpiresAt:   timestamppb.New(i.ExpiresAt),
+		Reason:      i.Reason,
+		CreatedAt:   timestamppb.New(i.CreatedAt),
+		CreatedBy:   i.CreatedBy,
+		RevokedBy:   i.RevokedBy,
+	}
+
+	if i.RevokedAt != nil {
+		increase.RevokedAt = timestamppb.New(*i.RevokedAt)
+	}
+
+	return increase
+}
+
+func remainingLimitToProto(l limitState) *accountpb.RemainingLimit {
+	limit := &accountpb.RemainingLimit{
+		Rule:      limitRuleModelToProto(l.rule),
+		Limit:     l.limit,
+		Used:      l.used,
+		Remaining: l.remaining,
+	}
+
+	if !l.resetsAt.IsZero() {
+		limit.ResetsAt = timestamppb.New(l.resetsAt)
+	}
+	if l.increase != nil {
+		limit.Increase = limitIncreaseModelToProto(l.increase)
+	}
+
+	return limit
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func seedLimitRule(t *testing.T, svc *AccountService, req *accountpb.SetLimitRuleRequest) *accountpb.LimitRule {
+	t.Helper()
+	if req.Currency == "" {
+		req.Currency = "USD"
+	}
+	req.CreatedBy = "risk"
+	req.EffectiveFrom = timestamppb.New(time.Now().Add(-time.Hour))
+	resp, err := svc.SetLimitRule(context.Background(), req)
+	assertCode(t, err, codes.OK)
+	return resp.Rule
+}
+
+// limitBreach returns the LimitBreach detail of a rejected transaction
+func limitBreach(t *testing.T, err error) *accountpb.LimitBreach {
+	t.Helper()
+	assertCode(t, err, codes.FailedPrecondition)
+	for _, detail := range status.Convert(err).Details() {
+		if breach, ok := detail.(*accountpb.LimitBreach); ok {
+			return breach
+		}
+	}
+	t.Fatalf("error %v carries no LimitBreach", err)
+	return nil
+}
+
+func TestAccountService_Transfer_Limits(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+
+	perTransaction := seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "PerTransaction", Value: 50000})
+	daily := seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "DailyAmount", Value: 80000})
+	mobileCount := seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "DailyCount", Channel: "Mobile", Value: 2})
+	// Other currencies, tiers and transaction types do not apply
+	seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "PerTransaction", Currency: "EUR", Value: 1})
+	seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "PerTransaction", RiskTier: "High", Value: 1})
+	seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "PerTransaction", TransactionType: "FXConversion", Value: 1})
+
+	// Two accounts of the same customer share its limits
+	checking := seedAccount(repo, 1000000, models.AccountStatusActive)
+	savings := seedAccount(repo, 1000000, models.AccountStatusActive)
+	savings.CustomerID = checking.CustomerID
+	payee := seedAccount(repo, 0, models.AccountStatusActive)
+
+	transfer := func(from *models.Account, amount int64, channel string) error {
+		_, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+			FromAccountId: from.ID.String(),
+			ToAccountId:   payee.ID.String(),
+			Amount:        amount,
+			Currency:      "USD",
+			Channel:       channel,
+		})
+		return err
+	}
+
+	breach := limitBreach(t, transfer(checking, 60000, ""))
+	if breach.LimitId != perTransaction.Id || breach.Remaining != 50000 || breach.Requested != 60000 || breach.ResetsAt != nil {
+		t.Errorf("per-transaction breach = %+v", breach)
+	}
+
+	assertCode(t, transfer(checking, 50000, "Mobile"), codes.OK)
+	assertCode(t, transfer(savings, 20000, "Mobile"), codes.OK)
+
+	// The third mobile transfer of the day is refused whatever its amount
+	breach = limitBreach(t, transfer(savings, 100, "Mobile"))
+	if breach.LimitId != mobileCount.Id || breach.Used != 2 || breach.Remaining != 0 || breach.Requested != 1 {
+		t.Errorf("count breach = %+v", breach)
+	}
+
+	// Online is not counted against the mobile limit, but the daily amount is
+	// shared: 70000 of 80000 is used
+	breach = limitBreach(t, transfer(checking, 10001, "Online"))
+	if breach.LimitId != daily.Id || breach.Used != 70000 || breach.Remaining != 10000 {
+		t.Errorf("daily breach = %+v", breach)
+	}
+	if breach.ResetsAt.AsTime() != time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1) {
+		t.Errorf("daily breach resets at %v", breach.ResetsAt.AsTime())
+	}
+	assertCode(t, transfer(checking, 10000, "Online"), codes.OK)
+
+	if len(repo.usages) != 3 {
+		t.Errorf("got %d usages, want 3: rejected transfers must not count", len(repo.usages))
+	}
+	if got := repo.accounts[payee.ID].LedgerBalance; got != 80000 {
+		t.Errorf("payee ledger = %d, want 80000", got)
+	}
+	if len(repo.locked) == 0 || repo.locked[0] != checking.CustomerID {
+		t.Errorf("customer limits were not locked: %v", repo.locked)
+	}
+
+	// High tier customers fall under the High tier rule as well
+	_, err := svc.SetCustomerRiskTier(ctx, &accountpb.SetCustomerRiskTierRequest{
+		CustomerId: payee.CustomerID.String(),
+		RiskTier:   "High",
+		UpdatedBy:  "risk",
+	})
+	assertCode(t, err, codes.OK)
+	_, err = svc.Transfer(ctx, &accountpb.TransferRequest{
+		FromAccountId: payee.ID.String(),
+		ToAccountId:   checking.ID.String(),
+		Amount:        2,
+		Currency:      "USD",
+	})
+	limitBreach(t, err)
+}
+
+func TestAccountService_Transfer_LimitsExemptBankAccounts(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "PerTransaction", Value: 50000})
+
+	// The bank's settlement and position accounts belong to one customer,
+	// which is also subject to the limits
+	settlement := seedAccount(repo, 1000000, models.AccountStatusActive)
+	position := seedAccount(repo, 1000000, models.AccountStatusActive)
+	position.CustomerID = settlement.CustomerID
+	operating := seedAccount(repo, 1000000, models.AccountStatusActive)
+	operating.CustomerID = settlement.CustomerID
+	payee := seedAccount(repo, 0, models.AccountStatusActive)
+	svc.SetSettlementAccounts([]uuid.UUID{settlement.ID})
+	if err := repo.SetFXPositionAccount(ctx, &models.FXPositionAccount{CurrencyPair: "EUR/USD", Currency: "USD", AccountID: position.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	transfer := func(from *models.Account) error {
+		_, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+			FromAccountId: from.ID.String(),
+			ToAccountId:   payee.ID.String(),
+			Amount:        60000,
+			Currency:      "USD",
+		})
+		return err
+	}
+
+	assertCode(t, transfer(settlement), codes.OK)
+	assertCode(t, transfer(position), codes.OK)
+	limitBreach(t, transfer(operating))
+
+	if len(repo.usages) != 0 {
+		t.Errorf("got %d usages, want 0: the bank's own accounts must not count", len(repo.usages))
+	}
+}
+
+func TestAccountService_LimitIncrease(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	rule := seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "DailyAmount", Value: 10000})
+
+	from := seedAccount(repo, 1000000, models.AccountStatusActive)
+	to := seedAccount(repo, 0, models.AccountStatusActive)
+	transfer := func(amount int64) error {
+		_, err := svc.Transfer(ctx, &accountpb.TransferRequest{
+			FromAccountId: from.ID.String(),
+			ToAccountId:   to.ID.String(),
+			Amount:        amount,
+			Currency:      "USD",
+		})
+		return err
+	}
+
+	limitBreach(t, transfer(25000))
+
+	granted, err := svc.GrantLimitIncrease(ctx, &accountpb.GrantLimitIncreaseRequest{
+		CustomerId:  from.CustomerID.String(),
+		LimitRuleId: rule.Id,
+		Value:       30000,
+		ExpiresAt:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Reason:      "House deposit",
+		GrantedBy:   "branch",
+	})
+	assertCode(t, err, codes.OK)
+
+	assertCode(t, transfer(25000), codes.OK)
+
+	limits, err := svc.GetRemainingLimits(ctx, &accountpb.GetRemainingLimitsRequest{AccountId: from.ID.String()})
+	assertCode(t, err, codes.OK)
+	if limits.RiskTier != "Medium" || len(limits.Limits) != 1 {
+		t.Fatalf("GetRemainingLimits() = %+v", limits)
+	}
+	l := limits.Limits[0]
+	if l.Limit != 30000 || l.Used != 25000 || l.Remaining != 5000 || l.Increase.GetId() != granted.Increase.Id || l.ResetsAt == nil {
+		t.Errorf("GetRemainingLimits() limit = %+v", l)
+	}
+
+	breach := limitBreach(t, transfer(6000))
+	if breach.IncreaseId != granted.Increase.Id || breach.Limit != 30000 {
+		t.Errorf("breach under increase = %+v", breach)
+	}
+
+	_, err = svc.RevokeLimitIncrease(ctx, &accountpb.RevokeLimitIncreaseRequest{IncreaseId: granted.Increase.Id, RevokedBy: "branch"})
+	assertCode(t, err, codes.OK)
+	_, err = svc.RevokeLimitIncrease(ctx, &accountpb.RevokeLimitIncreaseRequest{IncreaseId: granted.Increase.Id, RevokedBy: "branch"})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	limits, err = svc.GetRemainingLimits(ctx, &accountpb.GetRemainingLimitsRequest{AccountId: from.ID.String()})
+	assertCode(t, err, codes.OK)
+	if l := limits.Limits[0]; l.Limit != 10000 || l.Remaining != 0 || l.Increase != nil {
+		t.Errorf("GetRemainingLimits() after revoke = %+v", l)
+	}
+
+	for name, req := range map[string]*accountpb.GrantLimitIncreaseRequest{
+		"not above the rule": {CustomerId: from.CustomerID.String(), LimitRuleId: rule.Id, Value: 10000, ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)), Reason: "x", GrantedBy: "branch"},
+		"already expired":    {CustomerId: from.CustomerID.String(), LimitRuleId: rule.Id, Value: 20000, StartsAt: timestamppb.New(time.Now().Add(-2 * time.Hour)), ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)), Reason: "x", GrantedBy: "branch"},
+		"too long":           {CustomerId: from.CustomerID.String(), LimitRuleId: rule.Id, Value: 20000, ExpiresAt: timestamppb.New(time.Now().AddDate(0, 6, 0)), Reason: "x", GrantedBy: "branch"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.GrantLimitIncrease(ctx, req)
+			assertCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+func TestAccountService_ExecuteFXQuote_Limits(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	seedFXDesk(t, svc, repo)
+	rule := seedLimitRule(t, svc, &accountpb.SetLimitRuleRequest{Kind: "PerTransaction", TransactionType: "FXConversion", Value: 5000})
+
+	from := seedCurrencyAccount(repo, "USD", 100000)
+	to := seedCurrencyAccount(repo, "EUR", 0)
+	quote, err := svc.CreateFXQuote(ctx, &accountpb.CreateFXQuoteRequest{
+		FromAccountId: from.ID.String(),
+		ToAccountId:   to.ID.String(),
+		SourceAmount:  10000,
+	})
+	assertCode(t, err, codes.OK)
+
+	_, err = svc.ExecuteFXQuote(ctx, &accountpb.ExecuteFXQuoteRequest{QuoteId: quote.Quote.Id})
+	if breach := limitBreach(t, err); breach.LimitId != rule.Id || breach.Requested != 10000 {
+		t.Errorf("fx breach = %+v", breach)
+	}
+	if len(repo.postings) != 0 {
+		t.Errorf("rejected conversion booked %d postings", len(repo.postings))
+	}
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	customerclient "github.com/core-banking/services/customer-service/client"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// customerStatusActive is the customer-service status a customer must be in
+// to become a party to an account
+const customerStatusActive = "Active"
+
+// CustomerDirectory looks up customers held by customer-service
+type CustomerDirectory interface {
+	GetCustomer(ctx context.Context, id uuid.UUID) (*customerclient.Customer, error)
+	// GetCustomerProfile also returns the customer's current primary address
+	GetCustomerProfile(ctx context.Context, id uuid.UUID) (*customerclient.Customer, error)
+}
+
+// AddAccountParty adds a customer to an account in a role. The customer must
+// exist in customer-service and be Active, and may not hold the same role on
+// the account for an overlapping period.
+func (s *AccountService) AddAccountParty(ctx context.Context, req *accountpb.AddAccountPartyRequest) (*accountpb.AddAccountPartyResponse, error) {
+	if errs := s.validator.ValidateAddAccountParty(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	party := &models.AccountParty{
+		ID:          uuid.New(),
+		AccountID:   uuid.MustParse(req.GetAccountId()),
+		CustomerID:  uuid.MustParse(req.GetCustomerId()),
+		Role:        models.PartyRole(req.GetRole()),
+		SigningRule: models.SigningRule(req.GetSigningRule()),
+		StartDate:   interest.Date(time.Now()),
+		CreatedBy:   req.GetCreatedBy(),
+	}
+	if party.SigningRule == "" {
+		party.SigningRule = defaultSigningRule(party.Role)
+	}
+	if req.GetStartDate() != nil {
+		party.StartDate = interest.Date(req.GetStartDate().AsTime())
+	}
+	if req.GetEndDate() != nil {
+		endDate := interest.Date(req.GetEndDate().AsTime())
+		party.EndDate = &endDate
+	}
+
+	if err := s.checkCustomerActive(ctx, party.CustomerID); err != nil {
+		return nil, err
+	}
+
+	err := s.withTx(ctx, func(repo repository.AccountRepository) error {
+		account, err := s.lockAccount(ctx, repo, party.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Status == models.AccountStatusClosed {
+			return status.Errorf(codes.FailedPrecondition, "account is closed")
+		}
+
+		existing, err := repo.ListParties(ctx, account.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list account parties: %v", err)
+		}
+		for _, p := range existing {
+			if !p.Overlaps(party) {
+				continue
+			}
+			if party.Role == models.PartyRolePrimaryHolder && p.Role == models.PartyRolePrimaryHolder {
+				return status.Errorf(codes.FailedPrecondition, "account already has a primary holder")
+			}
+			if p.CustomerID == party.CustomerID && p.Role == party.Role {
+				return status.Errorf(codes.AlreadyExists, "customer already holds the %s role on this account", party.Role)
+			}
+		}
+
+		if err := repo.CreateParty(ctx, party); err != nil {
+			return status.Errorf(codes.Internal, "failed to add account party: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.AddAccountPartyResponse{
+		Party: partyModelToProto(party),
+	}, nil
+}
+
+// EndAccountParty sets the last day of a party's role. The primary holder
+// cannot be removed from an account.
+func (s *AccountService) EndAccountParty(ctx context.Context, req *accountpb.EndAccountPartyRequest) (*accountpb.EndAccountPartyResponse, error) {
+	partyID, err := parseID("party_id", req.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetReason()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must not exceed 255 characters")
+	}
+
+	now := time.Now().UTC()
+	endDate := now
+	if req.GetEndDate() != nil {
+		endDate = req.GetEndDate().AsTime()
+	}
+
+	var party *models.AccountParty
+	err = s.withTx(ctx, func(repo repository.AccountRepository) error {
+		party, err = repo.GetPartyByID(ctx, partyID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return status.Errorf(codes.NotFound, "account party not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get account party: %v", err)
+		}
+
+		if _, err := s.lockAccount(ctx, repo, party.AccountID); err != nil {
+			return err
+		}
+
+		if party.Role == models.PartyRolePrimaryHolder {
+			return status.Errorf(codes.FailedPrecondition, "the primary holder cannot be removed from an account")
+		}
+		if err := party.End(endDate, req.GetReason(), now); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if err := repo.UpdateParty(ctx, party); err != nil {
+			return status.Errorf(codes.Internal, "failed to end account party: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.EndAccountPartyResponse{
+		Party: partyModelToProto(party),
+	}, nil
+}
+
+// ListAccountParties lists the parties to an account, optionally only those
+// whose role applies today
+func (s *AccountService) ListAccountParties(ctx context.Context, req *accountpb.ListAccountPartiesRequest) (*accountpb.ListAccountPartiesResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getAccount(ctx, s.repo, accountID); err != nil {
+		return nil, err
+	}
+
+	parties, err := s.repo.ListParties(ctx, accountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account parties: %v", err)
+	}
+
+	now := time.Now()
+	protoParties := make([]*accountpb.AccountParty, 0, len(parties))
+	for _, p := range parties {
+		if req.GetActiveOnly() && !p.IsActiveOn(now) {
+			continue
+		}
+		protoParties = append(protoParties, partyModelToProto(p))
+	}
+
+	return &accountpb.ListAccountPartiesResponse{
+		Parties: protoParties,
+	}, nil
+}
+
+// ListAccountsByCustomer lists every account on which the customer holds a
+// role, once per role, optionally only roles that apply today
+func (s *AccountService) ListAccountsByCustomer(ctx context.Context, req *accountpb.ListAccountsByCustomerRequest) (*accountpb.ListAccountsByCustomerResponse, error) {
+	customerID, err := parseID("customer_id", req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.repo.ListAccountsByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %v", err)
+	}
+
+	parties, err := s.repo.ListPartiesByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account parties: %v", err)
+	}
+
+	rolesByAccount := make(map[uuid.UUID][]*models.AccountParty)
+	now := time.Now()
+	for _, p := range parties {
+		if req.GetActiveOnly() && !p.IsActiveOn(now) {
+			continue
+		}
+		rolesByAccount[p.AccountID] = append(rolesByAccount[p.AccountID], p)
+	}
+
+	var customerAccounts []*accountpb.CustomerAccount
+	for _, account := range accounts {
+		for _, p := range rolesByAccount[account.ID] {
+			customerAccounts = append(customerAccounts, &accountpb.CustomerAccount{
+				Account: accountModelToProto(account),
+				Party:   partyModelToProto(p),
+			})
+		}
+	}
+
+	return &accountpb.ListAccountsByCustomerResponse{
+		Accounts: customerAccounts,
+	}, nil
+}
+
+// CheckSigningAuthority reports whether the given customers together may
+// operate the account today under its parties' signing rules
+func (s *AccountService) CheckSigningAuthority(ctx context.Context, req *accountpb.CheckSigningAuthorityRequest) (*accountpb.CheckSigningAuthorityResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetCustomerIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "customer_ids is required")
+	}
+
+	signers := make([]uuid.UUID, len(req.GetCustomerIds()))
+	for i, id := range req.GetCustomerIds() {
+		if signers[i], err = parseID("customer_ids", id); err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := s.getAccount(ctx, s.repo, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	parties, err := s.repo.ListParties(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account parties: %v", err)
+	}
+
+	return &accountpb.CheckSigningAuthorityResponse{
+		Authorized: account.Status != models.AccountStatusClosed && models.SignersAuthorized(parties, signers, time.Now()),
+	}, nil
+}
+
+// checkCustomerActive confirms with customer-service that the customer
+// exists and is Active. A customer merged into another is refused; roles
+// go to the customer it was merged into.
+func (s *AccountService) checkCustomerActive(ctx context.Context, customerID uuid.UUID) error {
+	customer, err := s.customers.GetCustomer(ctx, customerID)
+	if errors.Is(err, customerclient.ErrNotFound) {
+		return status.Errorf(codes.FailedPrecondition, "customer %s not found", customerID)
+	}
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to verify customer %s: %v", customerID, err)
+	}
+	if customer.ID != customerID {
+		return status.Errorf(codes.FailedPrecondition, "customer %s was merged into %s", customerID, customer.ID)
+	}
+	if customer.Status != customerStatusActive {
+		return status.Errorf(codes.FailedPrecondition, "customer %s is %s", customerID, customer.Status)
+	}
+	return nil
+}
+
+// defaultSigningRule is the signing rule given to a party when none is requested
+func defaultSigningRule(role models.PartyRole) models.SigningRule {
+	if !role.CanSign() {
+		return models.SigningRuleNone
+	}
+	return models.SigningRuleAnyOne
+}
+
+func partyModelToProto(p *models.AccountParty) *accountpb.AccountParty {
+	party := &accountpb.AccountParty{
+		Id:          p.ID.String(),
+		AccountId:   p.AccountID.String(),
+		CustomerId:  p.CustomerID.String(),
+		Role:        string(p.Role),
+		SigningRule: string(p.SigningRule),
+		StartDate:   timestamppb.New(p.StartDate),
+		EndReason:   p.EndReason,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		CreatedBy:   p.CreatedBy,
+	}
+
+	if p.EndDate != nil {
+		party.EndDate = timestamppb.New(*p.EndDate)
+	}
+
+	return party
+}
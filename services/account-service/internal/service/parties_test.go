@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAccountService_OpenAccount_ChecksCustomer(t *testing.T) {
+	ctx := context.Background()
+	pending, missing := uuid.New(), uuid.New()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{pending: "Pending", missing: ""}, nil)
+
+	for _, customerID := range []uuid.UUID{pending, missing} {
+		_, err := svc.OpenAccount(ctx, &accountpb.OpenAccountRequest{
+			CustomerId:  customerID.String(),
+			AccountType: "Checking",
+			Currency:    "USD",
+		})
+		assertCode(t, err, codes.FailedPrecondition)
+	}
+	if len(repo.accounts) != 0 {
+		t.Fatalf("OpenAccount() created %d accounts for ineligible customers", len(repo.accounts))
+	}
+
+	customerID := uuid.New()
+	resp, err := svc.OpenAccount(ctx, &accountpb.OpenAccountRequest{
+		CustomerId:  customerID.String(),
+		AccountType: "Checking",
+		Currency:    "USD",
+		SigningRule: "TwoOf",
+	})
+	assertCode(t, err, codes.OK)
+
+	parties, err := svc.ListAccountParties(ctx, &accountpb.ListAccountPartiesRequest{AccountId: resp.Account.Id})
+	assertCode(t, err, codes.OK)
+	if len(parties.Parties) != 1 || parties.Parties[0].CustomerId != customerID.String() ||
+		parties.Parties[0].Role != string(models.PartyRolePrimaryHolder) || parties.Parties[0].SigningRule != "TwoOf" {
+		t.Errorf("OpenAccount() parties = %+v, want the customer as TwoOf primary holder", parties.Parties)
+	}
+}
+
+func TestAccountService_AddAccountParty(t *testing.T) {
+	ctx := context.Background()
+	suspended := uuid.New()
+
+	tests := []struct {
+		name        string
+		customerID  uuid.UUID
+		role        string
+		signingRule string
+		closed      bool
+		wantErr     codes.Code
+		wantRule    string
+	}{
+		{"joint holder", uuid.New(), "JointHolder", "All", false, codes.OK, "All"},
+		{"beneficiary defaults to None", uuid.New(), "Beneficiary", "", false, codes.OK, "None"},
+		{"signatory defaults to AnyOne", uuid.New(), "AuthorizedSignatory", "", false, codes.OK, "AnyOne"},
+		{"beneficiary cannot sign", uuid.New(), "Beneficiary", "AnyOne", false, codes.InvalidArgument, ""},
+		{"second primary holder", uuid.New(), "PrimaryHolder", "", false, codes.FailedPrecondition, ""},
+		{"suspended customer", suspended, "JointHolder", "", false, codes.FailedPrecondition, ""},
+		{"closed account", uuid.New(), "JointHolder", "", true, codes.FailedPrecondition, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockRepository()
+			svc := NewAccountService(repo, mockCustomerDirectory{suspended: "Suspended"}, nil)
+			accountStatus := models.AccountStatusActive
+			if tt.closed {
+				accountStatus = models.AccountStatusClosed
+			}
+			account := seedAccount(repo, 0, accountStatus)
+
+			resp, err := svc.AddAccountParty(ctx, &accountpb.AddAccountPartyRequest{
+				AccountId:   account.ID.String(),
+				CustomerId:  tt.customerID.String(),
+				Role:        tt.role,
+				SigningRule: tt.signingRule,
+			})
+			assertCode(t, err, tt.wantErr)
+			if tt.wantErr != codes.OK {
+				return
+			}
+
+			if resp.Party.SigningRule != tt.wantRule || resp.Party.Role != tt.role || resp.Party.EndDate != nil {
+				t.Errorf("AddAccountParty() party = %+v, want open-ended %s with %s", resp.Party, tt.role, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestAccountService_AddAccountParty_Overlap(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	customerID := uuid.New()
+
+	add := func(start, end time.Time) error {
+		req := &accountpb.AddAccountPartyRequest{
+			AccountId:  account.ID.String(),
+			CustomerId: customerID.String(),
+			Role:       "PowerOfAttorney",
+			StartDate:  timestamppb.New(start),
+		}
+		if !end.IsZero() {
+			req.EndDate = timestamppb.New(end)
+		}
+		_, err := svc.AddAccountParty(ctx, req)
+		return err
+	}
+
+	assertCode(t, add(mustDate(t, "2024-01-01"), mustDate(t, "2024-06-30")), codes.OK)
+	assertCode(t, add(mustDate(t, "2024-06-30"), time.Time{}), codes.AlreadyExists)
+	assertCode(t, add(mustDate(t, "2024-07-01"), time.Time{}), codes.OK)
+}
+
+func TestAccountService_EndAccountParty(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+
+	joint, err := svc.AddAccountParty(ctx, &accountpb.AddAccountPartyRequest{
+		AccountId:  account.ID.String(),
+		CustomerId: uuid.New().String(),
+		Role:       "JointHolder",
+		StartDate:  timestamppb.New(time.Now().AddDate(0, -1, 0)),
+	})
+	assertCode(t, err, codes.OK)
+
+	yesterday := time.Now().AddDate(0, 0, -1)
+	ended, err := svc.EndAccountParty(ctx, &accountpb.EndAccountPartyRequest{
+		PartyId: joint.Party.Id,
+		EndDate: timestamppb.New(yesterday),
+		Reason:  "Divorce",
+	})
+	assertCode(t, err, codes.OK)
+	if ended.Party.EndDate == nil || ended.Party.EndReason != "Divorce" {
+		t.Errorf("EndAccountParty() party = %+v, want end date and reason", ended.Party)
+	}
+
+	_, err = svc.EndAccountParty(ctx, &accountpb.EndAccountPartyRequest{PartyId: joint.Party.Id})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	active, err := svc.ListAccountParties(ctx, &accountpb.ListAccountPartiesRequest{AccountId: account.ID.String(), ActiveOnly: true})
+	assertCode(t, err, codes.OK)
+	if len(active.Parties) != 1 || active.Parties[0].Role != string(models.PartyRolePrimaryHolder) {
+		t.Fatalf("ListAccountParties() active = %+v, want only the primary holder", active.Parties)
+	}
+
+	_, err = svc.EndAccountParty(ctx, &accountpb.EndAccountPartyRequest{PartyId: active.Parties[0].Id})
+	assertCode(t, err, codes.FailedPrecondition)
+}
+
+func TestAccountService_ListAccountsByCustomer(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+
+	own := seedAccount(repo, 0, models.AccountStatusActive)
+	customerID := own.CustomerID
+	other := seedAccount(repo, 0, models.AccountStatusActive)
+	unrelated := seedAccount(repo, 0, models.AccountStatusActive)
+
+	for _, role := range []string{"JointHolder", "Beneficiary"} {
+		_, err := svc.AddAccountParty(ctx, &accountpb.AddAccountPartyRequest{
+			AccountId:  other.ID.String(),
+			CustomerId: customerID.String(),
+			Role:       role,
+		})
+		assertCode(t, err, codes.OK)
+	}
+
+	resp, err := svc.ListAccountsByCustomer(ctx, &accountpb.ListAccountsByCustomerRequest{CustomerId: customerID.String()})
+	assertCode(t, err, codes.OK)
+
+	roles := make(map[string][]string)
+	for _, ca := range resp.Accounts {
+		roles[ca.Account.Id] = append(roles[ca.Account.Id], ca.Party.Role)
+	}
+	if len(resp.Accounts) != 3 || len(roles[own.ID.String()]) != 1 || len(roles[other.ID.String()]) != 2 {
+		t.Errorf("ListAccountsByCustomer() roles = %v, want primary on one account and two roles on the other", roles)
+	}
+	if _, found := roles[unrelated.ID.String()]; found {
+		t.Error("ListAccountsByCustomer() returned an account the customer has no role on")
+	}
+}
+
+func TestAccountService_CheckSigningAuthority(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	repo.parties[0].SigningRule = models.SigningRuleAll
+	primary := account.CustomerID
+	joint := uuid.New()
+
+	_, err := svc.AddAccountParty(ctx, &accountpb.AddAccountPartyRequest{
+		AccountId:   account.ID.String(),
+		CustomerId:  joint.String(),
+		Role:        "JointHolder",
+		SigningRule: "All",
+	})
+	assertCode(t, err, codes.OK)
+
+	check := func(signers ...uuid.UUID) bool {
+		ids := make([]string, len(signers))
+		for i, id := range signers {
+			ids[i] = id.String()
+		}
+		resp, err := svc.CheckSigningAuthority(ctx, &accountpb.CheckSigningAuthorityRequest{AccountId: account.ID.String(), CustomerIds: ids})
+		assertCode(t, err, codes.OK)
+		return resp.Authorized
+	}
+
+	if check(primary) {
+		t.Error("CheckSigningAuthority() authorized one signer on an all-to-sign account")
+	}
+	if !check(primary, joint) {
+		t.Error("CheckSigningAuthority() rejected both holders on an all-to-sign account")
+	}
+}
@@ -0,0 +1,742 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/reconciliation"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetReconciliationRules replaces the rules automatic matching applies. The
+// rules must already be valid.
+func (s *AccountService) SetReconciliationRules(rules reconciliation.Rules) {
+	s.reconciliationRules = rules
+}
+
+// ImportBankStatement stores every statement in a file received from the
+// correspondent bank that holds the account. Statements must be in the
+// account's currency and all come from the same external account; a file
+// containing a statement that was already imported is rejected whole.
+func (s *AccountService) ImportBankStatement(ctx context.Context, req *accountpb.ImportBankStatementRequest) (*accountpb.ImportBankStatementResponse, error) {
+	if errs := s.validator.ValidateImportBankStatement(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	accountID := uuid.MustParse(req.GetAccountId())
+	parsed, err := reconciliation.Parse(reconciliation.Format(req.GetFormat()), req.GetContent())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	contentHash := models.HashContent(req.GetContent())
+	now := time.Now().UTC()
+
+	var statements []*models.BankStatement
+	err = s.withTx(ctx, func(repo repository.AccountRepository) error {
+		account, err := s.lockAccount(ctx, repo, accountID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := repo.ListBankStatements(ctx, accountID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list bank statements: %v", err)
+		}
+		externalAccount := parsed[0].Account
+		if len(existing) > 0 {
+			externalAccount = existing[0].ExternalAccount
+		}
+
+		for _, p := range parsed {
+			if p.Currency != account.Currency {
+				return status.Errorf(codes.FailedPrecondition, "statement %s is in %s, account is in %s", p.ExternalID(), p.Currency, account.Currency)
+			}
+			if p.Account != externalAccount {
+				return status.Errorf(codes.FailedPrecondition, "statement %s is for external account %s, account is reconciled against %s", p.ExternalID(), p.Account, externalAccount)
+			}
+
+			stmt := &models.BankStatement{
+				ID:              uuid.New(),
+				AccountID:       accountID,
+				Format:          models.BankStatementFormat(p.Format),
+				ExternalID:      p.ExternalID(),
+				ExternalAccount: p.Account,
+				Currency:        p.Currency,
+				OpeningDate:     p.OpeningDate,
+				OpeningBalance:  p.OpeningBalance,
+				ClosingDate:     p.ClosingDate,
+				ClosingBalance:  p.ClosingBalance,
+				LineCount:       len(p.Lines),
+				ContentHash:     contentHash,
+				ImportedAt:      now,
+				ImportedBy:      req.GetImportedBy(),
+			}
+			lines := make([]*models.BankStatementLine, len(p.Lines))
+			for i, l := range p.Lines {
+				lines[i] = &models.BankStatementLine{
+					ID:            uuid.New(),
+					StatementID:   stmt.ID,
+					AccountID:     accountID,
+					LineNumber:    i + 1,
+					ValueDate:     l.ValueDate,
+					BookingDate:   l.BookingDate,
+					Amount:        l.Amount,
+					Reference:     l.Reference,
+					BankReference: l.BankReference,
+					Description:   l.Description,
+					Status:        models.ReconciliationStatusUnmatched,
+				}
+			}
+
+			if err := repo.CreateBankStatement(ctx, stmt, lines); err != nil {
+				if errors.Is(err, repository.ErrDuplicate) {
+					return status.Errorf(codes.AlreadyExists, "statement %s has already been imported", stmt.ExternalID)
+				}
+				return status.Errorf(codes.Internal, "failed to store bank statement: %v", err)
+			}
+			statements = append(statements, stmt)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	protoStatements := make([]*accountpb.BankStatement, len(statements))
+	for i, stmt := range statements {
+		protoStatements[i] = bankStatementModelToProto(stmt)
+	}
+	return &accountpb.ImportBankStatementResponse{
+		Statements: protoStatements,
+	}, nil
+}
+
+// RunReconciliation matches the account's statement lines against its
+// postings up to a date and stores the run with its report. A rerun first
+// undoes the earlier automatic matches of the period it covers, so it
+// reaches the same result as the first run given the same data; matches of
+// later periods, manual matches and write-offs are kept.
+func (s *AccountService) RunReconciliation(ctx context.Context, req *accountpb.RunReconciliationRequest) (*accountpb.RunReconciliationResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetRunBy()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "run_by must not exceed 255 characters")
+	}
+
+	asOf := interest.Date(time.Now())
+	if req.GetAsOf() != nil {
+		asOf = interest.Date(req.GetAsOf().AsTime())
+	}
+
+	run, matches, err := reconcile(ctx, s.repo, s.reconciliationRules, accountID, asOf, req.GetRerun(), req.GetRunBy(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	protoMatches := make([]*accountpb.ReconciliationMatch, len(matches))
+	for i, m := range matches {
+		protoMatches[i] = reconciliationMatchModelToProto(m)
+	}
+	return &accountpb.RunReconciliationResponse{
+		Run:     reconciliationRunModelToProto(run),
+		Matches: protoMatches,
+		Report:  run.Report,
+	}, nil
+}
+
+// GetReconciliationReport returns a stored reconciliation run and its report
+func (s *AccountService) GetReconciliationReport(ctx context.Context, req *accountpb.GetReconciliationReportRequest) (*accountpb.GetReconciliationReportResponse, error) {
+	runID, err := parseID("run_id", req.GetRunId())
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.repo.GetReconciliationRun(ctx, runID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "reconciliation run not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get reconciliation run: %v", err)
+	}
+
+	return &accountpb.GetReconciliationReportResponse{
+		Run:    reconciliationRunModelToProto(run),
+		Report: run.Report,
+	}, nil
+}
+
+// ListReconciliationBreaks returns the statement lines and postings value-dated
+// up to a date that are still unmatched. Postings before the first imported
+// statement are not breaks.
+func (s *AccountService) ListReconciliationBreaks(ctx context.Context, req *accountpb.ListReconciliationBreaksRequest) (*accountpb.ListReconciliationBreaksResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := interest.Date(time.Now())
+	if req.GetAsOf() != nil {
+		asOf = interest.Date(req.GetAsOf().AsTime())
+	}
+
+	if _, err := s.getAccount(ctx, s.repo, accountID); err != nil {
+		return nil, err
+	}
+
+	statements, err := s.repo.ListBankStatements(ctx, accountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bank statements: %v", err)
+	}
+	resp := &accountpb.ListReconciliationBreaksResponse{
+		Lines:    []*accountpb.BankStatementLine{},
+		Postings: []*accountpb.Posting{},
+	}
+	if len(statements) == 0 {
+		return resp, nil
+	}
+
+	lines, err := s.repo.ListBankStatementLines(ctx, accountID, models.ReconciliationStatusUnmatched, asOf)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bank statement lines: %v", err)
+	}
+	postings, err := s.repo.ListUnreconciledPostings(ctx, accountID, earliestOpening(statements), asOf)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list unreconciled postings: %v", err)
+	}
+
+	for _, l := range lines {
+		resp.Lines = append(resp.Lines, bankStatementLineModelToProto(l))
+	}
+	for _, p := range postings {
+		resp.Postings = append(resp.Postings, postingModelToProto(p))
+	}
+	return resp, nil
+}
+
+// ManualMatch matches statement lines and postings by hand. The lines and
+// postings must be unmatched and sum to the same amount. A manual match is
+// kept when reconciliation is rerun.
+func (s *AccountService) ManualMatch(ctx context.Context, req *accountpb.ManualMatchRequest) (*accountpb.ManualMatchResponse, error) {
+	if errs := s.validator.ValidateManualMatch(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	accountID := uuid.MustParse(req.GetAccountId())
+	lineIDs := mustParseIDs(req.GetLineIds())
+	postingIDs := mustParseIDs(req.GetPostingIds())
+
+	var match *models.ReconciliationMatch
+	err := s.withTx(ctx, func(repo repository.AccountRepository) error {
+		if _, err := s.lockAccount(ctx, repo, accountID); err != nil {
+			return err
+		}
+
+		lines, err := s.unmatchedLines(ctx, repo, accountID, lineIDs)
+		if err != nil {
+			return err
+		}
+		postings, err := s.unreconciledPostings(ctx, repo, accountID, postingIDs)
+		if err != nil {
+			return err
+		}
+
+		var lineTotal, postingTotal int64
+		for _, l := range lines {
+			lineTotal += l.Amount
+		}
+		for _, p := range postings {
+			postingTotal += p.Amount
+		}
+		if lineTotal != postingTotal {
+			return status.Errorf(codes.FailedPrecondition, "lines total %d but postings total %d", lineTotal, postingTotal)
+		}
+
+		match = &models.ReconciliationMatch{
+			ID:         uuid.New(),
+			AccountID:  accountID,
+			Kind:       models.MatchKindManual,
+			LineIDs:    lineIDs,
+			PostingIDs: postingIDs,
+			Note:       req.GetNote(),
+			CreatedAt:  time.Now().UTC(),
+			CreatedBy:  req.GetMatchedBy(),
+		}
+		return createMatch(ctx, repo, match)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.ManualMatchResponse{
+		Match: reconciliationMatchModelToProto(match),
+	}, nil
+}
+
+// WriteOffBreak clears one unmatched statement line or posting with an
+// adjustment posting on the account, balanced by a posting on the offset
+// account. An unmatched line is brought into the ledger; an unmatched posting
+// is reversed. The adjustment is value-dated with the item it clears.
+func (s *AccountService) WriteOffBreak(ctx context.Context, req *accountpb.WriteOffBreakRequest) (*accountpb.WriteOffBreakResponse, error) {
+	if errs := s.validator.ValidateWriteOffBreak(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	accountID := uuid.MustParse(req.GetAccountId())
+	offsetID := uuid.MustParse(req.GetOffsetAccountId())
+
+	var match *models.ReconciliationMatch
+	var adjustment, offset *models.Posting
+	err := s.withTx(ctx, func(repo repository.AccountRepository) error {
+		account, offsetAccount, err := s.lockPair(ctx, repo, accountID, offsetID)
+		if err != nil {
+			return err
+		}
+		if offsetAccount.Status == models.AccountStatusClosed {
+			return status.Errorf(codes.FailedPrecondition, "offset account is closed")
+		}
+		if offsetAccount.Currency != account.Currency {
+			return status.Errorf(codes.FailedPrecondition, "offset account is in %s, account is in %s", offsetAccount.Currency, account.Currency)
+		}
+
+		match = &models.ReconciliationMatch{
+			ID:        uuid.New(),
+			AccountID: accountID,
+			Kind:      models.MatchKindWriteOff,
+			Note:      req.GetReason(),
+			CreatedAt: time.Now().UTC(),
+			CreatedBy: req.GetWrittenOffBy(),
+		}
+
+		var amount int64
+		var valueDate time.Time
+		if req.GetLineId() != "" {
+			lines, err := s.unmatchedLines(ctx, repo, accountID, []uuid.UUID{uuid.MustParse(req.GetLineId())})
+			if err != nil {
+				return err
+			}
+			amount, valueDate = lines[0].Amount, lines[0].ValueDate
+			match.LineIDs = []uuid.UUID{lines[0].ID}
+		} else {
+			postings, err := s.unreconciledPostings(ctx, repo, accountID, []uuid.UUID{uuid.MustParse(req.GetPostingId())})
+			if err != nil {
+				return err
+			}
+			amount, valueDate = -postings[0].Amount, postings[0].ValueDate
+			match.PostingIDs = []uuid.UUID{postings[0].ID}
+		}
+		if amount == 0 {
+			return status.Errorf(codes.FailedPrecondition, "nothing to write off on a zero amount")
+		}
+
+		reference := fmt.Sprintf("RECON-%s", match.ID)
+		adjustment = &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   account.ID,
+			PostingType: postingTypeFor(amount),
+			Amount:      amount,
+			Currency:    account.Currency,
+			Reference:   reference,
+			Description: fmt.Sprintf("Reconciliation write-off: %s", req.GetReason()),
+			ValueDate:   valueDate,
+		}
+		if err := repo.AddPosting(ctx, adjustment); err != nil {
+			return status.Errorf(codes.Internal, "failed to post adjustment: %v", err)
+		}
+		account.LedgerBalance += adjustment.Amount
+
+		offset = &models.Posting{
+			ID:          uuid.New(),
+			AccountID:   offsetAccount.ID,
+			PostingType: postingTypeFor(-amount),
+			Amount:      -amount,
+			Currency:    offsetAccount.Currency,
+			Reference:   reference,
+			Description: fmt.Sprintf("Reconciliation write-off on %s: %s", account.AccountNumber, req.GetReason()),
+			ValueDate:   valueDate,
+		}
+		if err := repo.AddPosting(ctx, offset); err != nil {
+			return status.Errorf(codes.Internal, "failed to post offset: %v", err)
+		}
+		offsetAccount.LedgerBalance += offset.Amount
+
+		match.PostingIDs = append(match.PostingIDs, adjustment.ID)
+		match.AdjustmentPostingID = &adjustment.ID
+		return createMatch(ctx, repo, match)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.WriteOffBreakResponse{
+		Match:      reconciliationMatchModelToProto(match),
+		Adjustment: postingModelToProto(adjustment),
+		Offset:     postingModelToProto(offset),
+	}, nil
+}
+
+// reconcile runs automatic matching for the account over every statement
+// closing on or before asOf and stores the run with its report. The latest
+// of those statements is compared with the ledger balance on its closing
+// date.
+func reconcile(ctx context.Context, repo repository.AccountRepository, rules reconciliation.Rules, accountID uuid.UUID, asOf time.Time, rerun bool, runBy string, now time.Time) (*models.ReconciliationRun, []*models.ReconciliationMatch, error) {
+	tx, err := repo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	txRepo := tx.AccountRepository()
+
+	// The lock keeps imports, manual matches and other runs out until this
+	// run is stored
+	account, err := txRepo.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to lock account: %v", err)
+	}
+
+	all, err := txRepo.ListBankStatements(ctx, accountID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list bank statements: %v", err)
+	}
+	statements := make(map[uuid.UUID]*models.BankStatement)
+	var latest *models.BankStatement
+	for _, stmt := range all {
+		if stmt.ClosingDate.After(asOf) {
+			continue
+		}
+		statements[stmt.ID] = stmt
+		latest = stmt
+	}
+	if latest == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "no bank statement closes on or before %s", asOf.Format(interest.DateLayout))
+	}
+	through := latest.ClosingDate
+
+	// Only the matches this run makes again are undone; those of later
+	// periods stand
+	if rerun {
+		if _, err := txRepo.DeleteAutoMatches(ctx, accountID, through); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to undo automatic matches: %v", err)
+		}
+	}
+
+	allLines, err := txRepo.ListBankStatementLines(ctx, accountID, "", through)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list bank statement lines: %v", err)
+	}
+	previouslyMatched := 0
+	var lines []*models.BankStatementLine
+	for _, l := range allLines {
+		if statements[l.StatementID] == nil {
+			continue
+		}
+		if l.Status != models.ReconciliationStatusUnmatched {
+			previouslyMatched++
+			continue
+		}
+		lines = append(lines, l)
+	}
+	postings, err := txRepo.ListUnreconciledPostings(ctx, accountID, earliestOpening(all), through)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list unreconciled postings: %v", err)
+	}
+
+	lineItems := make([]reconciliation.Item, len(lines))
+	for i, l := range lines {
+		lineItems[i] = reconciliation.Item{ID: l.ID, ValueDate: l.ValueDate, Amount: l.Amount, References: []string{l.Reference, l.BankReference}}
+	}
+	postingItems := make([]reconciliation.Item, len(postings))
+	for i, p := range postings {
+		postingItems[i] = reconciliation.Item{ID: p.ID, ValueDate: interest.Date(p.ValueDate), Amount: p.Amount, References: []string{p.Reference}}
+	}
+	found := reconciliation.AutoMatch(rules, lineItems, postingItems)
+
+	run := &models.ReconciliationRun{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		AsOf:      asOf,
+		Rerun:     rerun,
+		RunAt:     now.UTC(),
+		RunBy:     runBy,
+	}
+	matched := make(map[uuid.UUID]bool)
+	matches := make([]*models.ReconciliationMatch, len(found))
+	for i, f := range found {
+		matches[i] = &models.ReconciliationMatch{
+			ID:         uuid.New(),
+			AccountID:  accountID,
+			Kind:       models.MatchKindAuto,
+			Rule:       f.Rule,
+			RunID:      &run.ID,
+			LineIDs:    f.Lines,
+			PostingIDs: f.Postings,
+			CreatedAt:  run.RunAt,
+			CreatedBy:  runBy,
+		}
+		if err := createMatch(ctx, txRepo, matches[i]); err != nil {
+			return nil, nil, err
+		}
+		for _, id := range f.Lines {
+			matched[id] = true
+		}
+		for _, id := range f.Postings {
+			matched[id] = true
+		}
+	}
+
+	ledger, err := txRepo.GetBalanceAsOf(ctx, accountID, through)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get ledger balance: %v", err)
+	}
+
+	report := &reconciliation.Report{
+		RunID:             run.ID,
+		AccountID:         accountID,
+		AsOf:              asOf.Format(interest.DateLayout),
+		RunAt:             run.RunAt,
+		RunBy:             runBy,
+		Rerun:             rerun,
+		Currency:          account.Currency,
+		StatementID:       latest.ExternalID,
+		StatementDate:     through.Format(interest.DateLayout),
+		StatementBalance:  latest.ClosingBalance,
+		LedgerBalance:     ledger,
+		Rules:             reconciliation.NewRuleResults(rules, found),
+		PreviouslyMatched: previouslyMatched,
+	}
+	for _, l := range lines {
+		if matched[l.ID] {
+			continue
+		}
+		report.UnmatchedLines = append(report.UnmatchedLines, reconciliation.ReportLine{
+			ID:            l.ID,
+			StatementID:   statements[l.StatementID].ExternalID,
+			ValueDate:     l.ValueDate.Format(interest.DateLayout),
+			Amount:        l.Amount,
+			Reference:     l.Reference,
+			BankReference: l.BankReference,
+			Description:   l.Description,
+		})
+	}
+	for _, p := range postings {
+		if matched[p.ID] {
+			continue
+		}
+		report.UnmatchedPostings = append(report.UnmatchedPostings, reconciliation.ReportPosting{
+			ID:          p.ID,
+			ValueDate:   p.ValueDate.Format(interest.DateLayout),
+			Amount:      p.Amount,
+			Reference:   p.Reference,
+			Description: p.Description,
+		})
+	}
+	report.Totals()
+	content, err := report.Render()
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	run.MatchesCreated = len(matches)
+	run.UnmatchedLines = len(report.UnmatchedLines)
+	run.UnmatchedPostings = len(report.UnmatchedPostings)
+	run.StatementBalance = report.StatementBalance
+	run.LedgerBalance = report.LedgerBalance
+	run.Report = content
+	if err := txRepo.CreateReconciliationRun(ctx, run); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to store reconciliation run: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return run, matches, nil
+}
+
+// unmatchedLines loads the account's statement lines with the given IDs,
+// all of which must still be unmatched
+func (s *AccountService) unmatchedLines(ctx context.Context, repo repository.AccountRepository, accountID uuid.UUID, ids []uuid.UUID) ([]*models.BankStatementLine, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	lines, err := repo.GetBankStatementLines(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get bank statement lines: %v", err)
+	}
+	if len(lines) != len(ids) {
+		return nil, status.Errorf(codes.NotFound, "bank statement line not found")
+	}
+	for _, l := range lines {
+		if l.AccountID != accountID {
+			return nil, status.Errorf(codes.NotFound, "bank statement line not found")
+		}
+		if l.Status != models.ReconciliationStatusUnmatched {
+			return nil, status.Errorf(codes.FailedPrecondition, "bank statement line %s is already %s", l.ID, l.Status)
+		}
+	}
+	return lines, nil
+}
+
+// unreconciledPostings loads the account's postings with the given IDs, none
+// of which may belong to a match
+func (s *AccountService) unreconciledPostings(ctx context.Context, repo repository.AccountRepository, accountID uuid.UUID, ids []uuid.UUID) ([]*models.Posting, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	postings, err := repo.GetUnreconciledPostings(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get postings: %v", err)
+	}
+	if len(postings) != len(ids) {
+		return nil, status.Errorf(codes.FailedPrecondition, "posting not found or already reconciled")
+	}
+	for _, p := range postings {
+		if p.AccountID != accountID {
+			return nil, status.Errorf(codes.NotFound, "posting not found")
+		}
+	}
+	return postings, nil
+}
+
+// createMatch stores a match, reporting items matched concurrently as a
+// failed precondition
+func createMatch(ctx context.Context, repo repository.AccountRepository, match *models.ReconciliationMatch) error {
+	if err := repo.CreateReconciliationMatch(ctx, match); err != nil {
+		if errors.Is(err, models.ErrAlreadyReconciled) {
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return status.Errorf(codes.Internal, "failed to store reconciliation match: %v", err)
+	}
+	return nil
+}
+
+// earliestOpening is the first date any of the statements covers. Postings
+// before it predate reconciliation of the account.
+func earliestOpening(statements []*models.BankStatement) time.Time {
+	earliest := statements[0].OpeningDate
+	for _, stmt := range statements[1:] {
+		if stmt.OpeningDate.Before(earliest) {
+			earliest = stmt.OpeningDate
+		}
+	}
+	return earliest
+}
+
+// postingTypeFor is the posting type of a signed amount
+func postingTypeFor(amount int64) models.PostingType {
+	if amount < 0 {
+		return models.PostingTypeDebit
+	}
+	return models.PostingTypeCredit
+}
+
+// mustParseIDs parses IDs the validator has already checked
+func mustParseIDs(values []string) []uuid.UUID {
+	ids := make([]uuid.UUID, len(values))
+	for i, v := range values {
+		ids[i] = uuid.MustParse(v)
+	}
+	return ids
+}
+
+func bankStatementModelToProto(b *models.BankStatement) *accountpb.BankStatement {
+	return &accountpb.BankStatement{
+		Id:              b.ID.String(),
+		AccountId:       b.AccountID.String(),
+		Format:          string(b.Format),
+		ExternalId:      b.ExternalID,
+		ExternalAccount: b.ExternalAccount,
+		Currency:        b.Currency,
+		OpeningDate:     timestamppb.New(b.OpeningDate),
+		OpeningBalance:  b.OpeningBalance,
+		ClosingDate:     timestamppb.New(b.ClosingDate),
+		ClosingBalance:  b.ClosingBalance,
+		LineCount:       int32(b.LineCount),
+		ContentHash:     b.ContentHash,
+		ImportedAt:      timestamppb.New(b.ImportedAt),
+		ImportedBy:      b.ImportedBy,
+	}
+}
+
+func bankStatementLineModelToProto(l *models.BankStatementLine) *accountpb.BankStatementLine {
+	line := &accountpb.BankStatementLine{
+		Id:            l.ID.String(),
+		StatementId:   l.StatementID.String(),
+		AccountId:     l.AccountID.String(),
+		LineNumber:    int32(l.LineNumber),
+		ValueDate:     timestamppb.New(l.ValueDate),
+		BookingDate:   timestamppb.New(l.BookingDate),
+		Amount:        l.Amount,
+		Reference:     l.Reference,
+		BankReference: l.BankReference,
+		Description:   l.Description,
+		Status:        string(l.Status),
+	}
+
+	if l.MatchID != nil {
+		line.MatchId = l.MatchID.String()
+	}
+
+	return line
+}
+
+func reconciliationMatchModelToProto(m *models.ReconciliationMatch) *accountpb.ReconciliationMatch {
+	match := &accountpb.ReconciliationMatch{
+		Id:         m.ID.String(),
+		AccountId:  m.AccountID.String(),
+		Kind:       string(m.Kind),
+		Rule:       m.Rule,
+		LineIds:    idStrings(m.LineIDs),
+		PostingIds: idStrings(m.PostingIDs),
+		Note:       m.Note,
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+		CreatedBy:  m.CreatedBy,
+	}
+
+	if m.RunID != nil {
+		match.RunId = m.RunID.String()
+	}
+	if m.AdjustmentPostingID != nil {
+		match.AdjustmentPostingId = m.AdjustmentPostingID.String()
+	}
+
+	return match
+}
+
+func reconciliationRunModelToProto(r *models.ReconciliationRun) *accountpb.ReconciliationRun {
+	return &accountpb.ReconciliationRun{
+		Id:                r.ID.String(),
+		AccountId:         r.AccountID.String(),
+		AsOf:              timestamppb.New(r.AsOf),
+		Rerun:             r.Rerun,
+		MatchesCreated:    int32(r.MatchesCreated),
+		UnmatchedLines:    int32(r.UnmatchedLines),
+		UnmatchedPostings: int32(r.UnmatchedPostings),
+		StatementBalance:  r.StatementBalance,
+		LedgerBalance:     r.LedgerBalance,
+		Difference:        r.Difference(),
+		RunAt:             timestamppb.New(r.RunAt),
+		RunBy:             r.RunBy,
+	}
+}
+
+func idStrings(ids []uuid.UUID) []string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values
+}
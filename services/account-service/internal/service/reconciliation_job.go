@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/reconciliation"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reconciliationJobUser is recorded as the runner of reconciliations made by the job
+const reconciliationJobUser = "reconciliation-job"
+
+// ReconciliationJob is the end-of-day job that reconciles every account with
+// imported bank statements. It only adds matches for what earlier runs left
+// open, so rerunning a day is harmless; redoing automatic matches goes
+// through RunReconciliation with rerun set instead.
+type ReconciliationJob struct {
+	repo     repository.AccountRepository
+	rules    reconciliation.Rules
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewReconciliationJob creates a new ReconciliationJob
+func NewReconciliationJob(repo repository.AccountRepository, rules reconciliation.Rules, interval time.Duration, log zerolog.Logger) *ReconciliationJob {
+	return &ReconciliationJob{
+		repo:     repo,
+		rules:    rules,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run closes each business day once it has ended, checking every interval
+// until the context is cancelled
+func (j *ReconciliationJob) Run(ctx context.Context) {
+	runEndOfDay(ctx, j.interval, j.log, "reconciliation", j.RunEndOfDay)
+}
+
+// RunEndOfDay reconciles every account with imported statements as of the
+// given business date and returns how many accounts were reconciled.
+// Accounts whose first statement has not closed yet are skipped. A failure
+// on one account does not stop the others.
+func (j *ReconciliationJob) RunEndOfDay(ctx context.Context, businessDate time.Time) (int, error) {
+	businessDate = interest.Date(businessDate)
+
+	accountIDs, err := j.repo.ListBankStatementAccounts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	var failures []error
+	for _, accountID := range accountIDs {
+		_, _, err := reconcile(ctx, j.repo, j.rules, accountID, businessDate, false, reconciliationJobUser, time.Now())
+		if status.Code(err) == codes.FailedPrecondition {
+			continue
+		}
+		if err != nil {
+			failures = append(failures, fmt.Errorf("account %s: %w", accountID, err))
+			continue
+		}
+		processed++
+	}
+
+	if len(failures) > 0 {
+		return processed, fmt.Errorf("reconciliation run for %s failed for %d accounts: %w",
+			businessDate.Format(interest.DateLayout), len(failures), errors.Join(failures...))
+	}
+
+	return processed, nil
+}
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/reconciliation"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// nostroMT940 is a two-day USD statement: a payment with our reference, a
+// charge the bank references its own way, and a credit we never booked
+func nostroMT940(statementID, currency string) []byte {
+	return []byte(strings.Join([]string{
+		":20:" + statementID,
+		":25:NOSTRO-USD-1",
+		":28C:1",
+		":60F:C240331" + currency + "0,00",
+		":61:240401C100,00NTRFPAY-1//B1",
+		":61:240402D25,00NCHGNONREF//B2",
+		":61:240402C7,50NMSCNONREF//B3",
+		":86:Unknown credit",
+		":62F:C240402" + currency + "82,50",
+		"-",
+	}, "\r\n"))
+}
+
+func TestAccountService_Reconciliation(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	suspense := seedAccount(repo, 0, models.AccountStatusActive)
+
+	seedBookedPosting(repo, account, 10000, mustDate(t, "2024-04-01"), "PAY-1")
+	seedBookedPosting(repo, account, -2500, mustDate(t, "2024-04-01"), "CHG")
+	seedBookedPosting(repo, account, 400, mustDate(t, "2024-04-02"), "ORPHAN")
+	seedBookedPosting(repo, account, 900, mustDate(t, "2024-04-05"), "LATER")
+
+	imported, err := svc.ImportBankStatement(ctx, &accountpb.ImportBankStatementRequest{
+		AccountId:  account.ID.String(),
+		Content:    nostroMT940("S1", "USD"),
+		ImportedBy: "ops",
+	})
+	assertCode(t, err, codes.OK)
+	if len(imported.Statements) != 1 || imported.Statements[0].LineCount != 3 || imported.Statements[0].ExternalId != "S1/1" {
+		t.Fatalf("ImportBankStatement() = %+v", imported.Statements)
+	}
+
+	_, err = svc.ImportBankStatement(ctx, &accountpb.ImportBankStatementRequest{AccountId: account.ID.String(), Content: nostroMT940("S1", "USD")})
+	assertCode(t, err, codes.AlreadyExists)
+	_, err = svc.ImportBankStatement(ctx, &accountpb.ImportBankStatementRequest{AccountId: account.ID.String(), Content: nostroMT940("S2", "EUR")})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	runReq := &accountpb.RunReconciliationRequest{
+		AccountId: account.ID.String(),
+		AsOf:      timestamppb.New(mustDate(t, "2024-04-05")),
+		RunBy:     "ops",
+	}
+	first, err := svc.RunReconciliation(ctx, runReq)
+	assertCode(t, err, codes.OK)
+	run := first.Run
+	if run.MatchesCreated != 2 || run.UnmatchedLines != 1 || run.UnmatchedPostings != 1 {
+		t.Errorf("run = %+v, want 2 matches and one break on each side", run)
+	}
+	// The ledger on the statement date leaves out the posting value-dated later
+	if run.StatementBalance != 8250 || run.LedgerBalance != 7900 || run.Difference != 350 {
+		t.Errorf("run balances = %d, %d, %d; want 8250, 7900, 350", run.StatementBalance, run.LedgerBalance, run.Difference)
+	}
+	var report reconciliation.Report
+	if err := json.Unmarshal(first.Report, &report); err != nil {
+		t.Fatalf("report is not JSON: %v", err)
+	}
+	if report.UnexplainedDifference != 0 || report.Rules[0].Matches != 1 || report.Rules[1].Matches != 1 {
+		t.Errorf("report = %+v, want one match per pair rule and no unexplained difference", report)
+	}
+
+	// A rerun undoes the automatic matches and makes the same ones again
+	runReq.Rerun = true
+	second, err := svc.RunReconciliation(ctx, runReq)
+	assertCode(t, err, codes.OK)
+	if second.Run.Id == run.Id || len(second.Matches) != len(first.Matches) {
+		t.Fatalf("rerun = %+v, want a new run with %d matches", second.Run, len(first.Matches))
+	}
+	for i := range first.Matches {
+		a, b := first.Matches[i], second.Matches[i]
+		if a.Rule != b.Rule || strings.Join(a.LineIds, ",") != strings.Join(b.LineIds, ",") || strings.Join(a.PostingIds, ",") != strings.Join(b.PostingIds, ",") {
+			t.Errorf("rerun match %d = %+v, want %+v", i, b, a)
+		}
+	}
+
+	stored, err := svc.GetReconciliationReport(ctx, &accountpb.GetReconciliationReportRequest{RunId: second.Run.Id})
+	assertCode(t, err, codes.OK)
+	if string(stored.Report) != string(second.Report) {
+		t.Error("GetReconciliationReport() returned a different report")
+	}
+
+	breaks, err := svc.ListReconciliationBreaks(ctx, &accountpb.ListReconciliationBreaksRequest{AccountId: account.ID.String()})
+	assertCode(t, err, codes.OK)
+	if len(breaks.Lines) != 1 || breaks.Lines[0].Amount != 750 || len(breaks.Postings) != 2 {
+		t.Fatalf("ListReconciliationBreaks() = %+v, want the 7.50 line and two postings", breaks)
+	}
+	lineID := breaks.Lines[0].Id
+	orphanID := repo.postings[2].ID.String()
+
+	_, err = svc.ManualMatch(ctx, &accountpb.ManualMatchRequest{
+		AccountId:  account.ID.String(),
+		LineIds:    []string{lineID},
+		PostingIds: []string{orphanID},
+		MatchedBy:  "ops",
+	})
+	assertCode(t, err, codes.FailedPrecondition)
+
+	// The unbooked credit is brought into the ledger from suspense
+	lineOff, err := svc.WriteOffBreak(ctx, &accountpb.WriteOffBreakRequest{
+		AccountId:       account.ID.String(),
+		LineId:          lineID,
+		OffsetAccountId: suspense.ID.String(),
+		Reason:          "unidentified credit",
+		WrittenOffBy:    "ops",
+	})
+	assertCode(t, err, codes.OK)
+	if lineOff.Adjustment.Amount != 750 || lineOff.Offset.Amount != -750 || lineOff.Match.AdjustmentPostingId != lineOff.Adjustment.Id {
+		t.Errorf("line write-off = %+v", lineOff)
+	}
+	if !lineOff.Adjustment.ValueDate.AsTime().Equal(mustDate(t, "2024-04-02")) {
+		t.Errorf("adjustment value date = %s, want the line's", lineOff.Adjustment.ValueDate.AsTime())
+	}
+
+	// The posting the bank never saw is reversed
+	postingOff, err := svc.WriteOffBreak(ctx, &accountpb.WriteOffBreakRequest{
+		AccountId:       account.ID.String(),
+		PostingId:       orphanID,
+		OffsetAccountId: suspense.ID.String(),
+		Reason:          "booked in error",
+		WrittenOffBy:    "ops",
+	})
+	assertCode(t, err, codes.OK)
+	if postingOff.Adjustment.Amount != -400 || len(postingOff.Match.PostingIds) != 2 {
+		t.Errorf("posting write-off = %+v", postingOff)
+	}
+	_, err = svc.WriteOffBreak(ctx, &accountpb.WriteOffBreakRequest{
+		AccountId:       account.ID.String(),
+		PostingId:       orphanID,
+		OffsetAccountId: suspense.ID.String(),
+		Reason:          "again",
+		WrittenOffBy:    "ops",
+	})
+	assertCode(t, err, codes.FailedPrecondition)
+	if suspense.LedgerBalance != -350 {
+		t.Errorf("suspense balance = %d, want -350", suspense.LedgerBalance)
+	}
+
+	// Write-offs survive a rerun and the account now agrees with the bank
+	third, err := svc.RunReconciliation(ctx, runReq)
+	assertCode(t, err, codes.OK)
+	if third.Run.MatchesCreated != 2 || third.Run.UnmatchedLines != 0 || third.Run.UnmatchedPostings != 0 || third.Run.Difference != 0 {
+		t.Errorf("final run = %+v, want everything matched and no difference", third.Run)
+	}
+}
+
+func TestAccountService_Reconciliation_RerunEarlierPeriod(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	seedBookedPosting(repo, account, 10000, mustDate(t, "2024-04-01"), "PAY-1")
+	seedBookedPosting(repo, account, 900, mustDate(t, "2024-04-05"), "LATER")
+
+	later := []byte(strings.Join([]string{
+		":20:S3",
+		":25:NOSTRO-USD-1",
+		":28C:2",
+		":60F:C240402USD82,50",
+		":61:240405C9,00NTRFLATER//B4",
+		":62F:C240405USD91,50",
+		"-",
+	}, "\r\n"))
+	for _, content := range [][]byte{nostroMT940("S1", "USD"), later} {
+		_, err := svc.ImportBankStatement(ctx, &accountpb.ImportBankStatementRequest{AccountId: account.ID.String(), Content: content})
+		assertCode(t, err, codes.OK)
+	}
+
+	first, err := svc.RunReconciliation(ctx, &accountpb.RunReconciliationRequest{AccountId: account.ID.String(), AsOf: timestamppb.New(mustDate(t, "2024-04-05"))})
+	assertCode(t, err, codes.OK)
+	if first.Run.MatchesCreated != 2 {
+		t.Fatalf("run = %+v, want a match in each period", first.Run)
+	}
+
+	// Rerunning the first period leaves the second reconciled
+	rerun, err := svc.RunReconciliation(ctx, &accountpb.RunReconciliationRequest{AccountId: account.ID.String(), AsOf: timestamppb.New(mustDate(t, "2024-04-02")), Rerun: true})
+	assertCode(t, err, codes.OK)
+	if rerun.Run.MatchesCreated != 1 || len(repo.matches) != 2 {
+		t.Errorf("rerun made %d matches leaving %d, want 1 made and both periods matched", rerun.Run.MatchesCreated, len(repo.matches))
+	}
+	for _, l := range repo.lines {
+		if l.Reference == "LATER" && l.Status != models.ReconciliationStatusMatched {
+			t.Errorf("later period line = %s, want it still Matched", l.Status)
+		}
+	}
+}
+
+func TestAccountService_ManualMatch(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	svc.SetReconciliationRules(reconciliation.Rules{{Name: "reference", Type: reconciliation.RuleExactReference}})
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	other := seedAccount(repo, 0, models.AccountStatusActive)
+
+	seedBookedPosting(repo, account, 6000, mustDate(t, "2024-04-01"), "A")
+	seedBookedPosting(repo, account, 4000, mustDate(t, "2024-04-02"), "B")
+	seedBookedPosting(repo, other, 100, mustDate(t, "2024-04-02"), "C")
+
+	_, err := svc.ImportBankStatement(ctx, &accountpb.ImportBankStatementRequest{
+		AccountId: account.ID.String(),
+		Format:    string(models.BankStatementFormatMT940),
+		Content: []byte(strings.Join([]string{
+			":20:M", ":25:NOSTRO", ":28C:1", ":60F:C240331USD0,00",
+			":61:240402C100,00NTRFNONREF", ":62F:C240402USD100,00", "-",
+		}, "\n")),
+	})
+	assertCode(t, err, codes.OK)
+
+	resp, err := svc.RunReconciliation(ctx, &accountpb.RunReconciliationRequest{AccountId: account.ID.String(), AsOf: timestamppb.New(mustDate(t, "2024-04-02"))})
+	assertCode(t, err, codes.OK)
+	if resp.Run.MatchesCreated != 0 {
+		t.Fatalf("reference-only rules made %d matches, want 0", resp.Run.MatchesCreated)
+	}
+
+	lineID := repo.lines[0].ID.String()
+	req := &accountpb.ManualMatchRequest{
+		AccountId:  account.ID.String(),
+		LineIds:    []string{lineID},
+		PostingIds: []string{repo.postings[0].ID.String(), repo.postings[2].ID.String()},
+		MatchedBy:  "ops",
+	}
+	_, err = svc.ManualMatch(ctx, req)
+	assertCode(t, err, codes.NotFound)
+
+	req.PostingIds[1] = repo.postings[1].ID.String()
+	match, err := svc.ManualMatch(ctx, req)
+	assertCode(t, err, codes.OK)
+	if match.Match.Kind != string(models.MatchKindManual) || len(match.Match.PostingIds) != 2 {
+		t.Errorf("ManualMatch() = %+v", match.Match)
+	}
+
+	_, err = svc.ManualMatch(ctx, req)
+	assertCode(t, err, codes.FailedPrecondition)
+
+	rerun, err := svc.RunReconciliation(ctx, &accountpb.RunReconciliationRequest{AccountId: account.ID.String(), AsOf: timestamppb.New(mustDate(t, "2024-04-02")), Rerun: true})
+	assertCode(t, err, codes.OK)
+	if rerun.Run.UnmatchedLines != 0 || rerun.Run.UnmatchedPostings != 0 {
+		t.Errorf("rerun = %+v, want the manual match kept", rerun.Run)
+	}
+}
+
+func TestReconciliationJob_RunEndOfDay(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	seedBookedPosting(repo, account, 10000, mustDate(t, "2024-04-01"), "PAY-1")
+
+	_, err := svc.ImportBankStatement(ctx, &accountpb.ImportBankStatementRequest{AccountId: account.ID.String(), Content: nostroMT940("S1", "USD")})
+	assertCode(t, err, codes.OK)
+
+	job := NewReconciliationJob(repo, reconciliation.DefaultRules(), 0, zerolog.Nop())
+
+	// The statement has not closed on the first of the month
+	processed, err := job.RunEndOfDay(ctx, mustDate(t, "2024-04-01"))
+	if err != nil || processed != 0 {
+		t.Fatalf("RunEndOfDay(2024-04-01) = %d, %v; want 0, nil", processed, err)
+	}
+
+	processed, err = job.RunEndOfDay(ctx, mustDate(t, "2024-04-02"))
+	if err != nil || processed != 1 {
+		t.Fatalf("RunEndOfDay(2024-04-02) = %d, %v; want 1, nil", processed, err)
+	}
+	if len(repo.runs) != 1 || repo.runs[0].MatchesCreated != 1 || repo.runs[0].RunBy != reconciliationJobUser {
+		t.Errorf("runs = %+v, want one job run with the reference match", repo.runs)
+	}
+
+	// Rerunning the day adds nothing
+	if _, err := job.RunEndOfDay(ctx, mustDate(t, "2024-04-02")); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.matches) != 1 || repo.runs[1].MatchesCreated != 0 {
+		t.Errorf("second run made %d matches, want none", repo.runs[1].MatchesCreated)
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// statementJobUser is recorded as the issuer of statements generated by the job
+const statementJobUser = "statement-job"
+
+// StatementJob is the end-of-day job that issues monthly statements. On the
+// last day of each month it generates the month's statement for every open
+// account that does not already have one, so rerunning the day issues no
+// duplicates; on-demand regeneration goes through GenerateStatement instead.
+type StatementJob struct {
+	repo      repository.AccountRepository
+	customers CustomerDirectory
+	interval  time.Duration
+	log       zerolog.Logger
+}
+
+// NewStatementJob creates a new StatementJob
+func NewStatementJob(repo repository.AccountRepository, customers CustomerDirectory, interval time.Duration, log zerolog.Logger) *StatementJob {
+	return &StatementJob{
+		repo:      repo,
+		customers: customers,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// Run closes each business day once it has ended, checking every interval
+// until the context is cancelled
+func (j *StatementJob) Run(ctx context.Context) {
+	runEndOfDay(ctx, j.interval, j.log, "statements", j.RunEndOfDay)
+}
+
+// RunEndOfDay issues the monthly statements due on the given business date
+// and returns how many accounts were processed. Nothing is due except on the
+// last day of a month. A failure on one account does not stop the others.
+func (j *StatementJob) RunEndOfDay(ctx context.Context, businessDate time.Time) (int, error) {
+	businessDate = interest.Date(businessDate)
+	if !interest.FrequencyMonthly.IsPeriodEnd(businessDate) {
+		return 0, nil
+	}
+	periodStart := interest.FrequencyMonthly.PeriodStart(businessDate)
+
+	processed := 0
+	var failures []error
+	for _, accountType := range models.AccountTypes {
+		accounts, err := j.repo.ListOpenAccountsByType(ctx, accountType)
+		if err != nil {
+			return processed, err
+		}
+
+		for _, account := range accounts {
+			// Accounts opened after the month ended have nothing to report
+			if interest.Date(account.OpenedAt).After(businessDate) {
+				continue
+			}
+			if err := j.issue(ctx, account, periodStart, businessDate); err != nil {
+				failures = append(failures, fmt.Errorf("account %s: %w", account.ID, err))
+				continue
+			}
+			processed++
+		}
+	}
+
+	if len(failures) > 0 {
+		return processed, fmt.Errorf("statement run for %s failed for %d accounts: %w",
+			businessDate.Format(interest.DateLayout), len(failures), errors.Join(failures...))
+	}
+
+	return processed, nil
+}
+
+// issue generates the statement for the period unless one already exists
+func (j *StatementJob) issue(ctx context.Context, account *models.Account, periodStart, periodEnd time.Time) error {
+	if _, err := j.repo.GetLatestStatement(ctx, account.ID, periodStart, periodEnd); err == nil {
+		return nil
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	_, _, err := generateStatement(ctx, j.repo, j.customers, account.ID, periodStart, periodEnd, statementJobUser, time.Now())
+	return err
+}
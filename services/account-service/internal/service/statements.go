@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/core-banking/services/account-service/internal/repository"
+	"github.com/core-banking/services/account-service/internal/statement"
+	customerclient "github.com/core-banking/services/customer-service/client"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GenerateStatement issues a new version of the account's statement for the
+// period and stores every rendering of it. Earlier versions are kept.
+func (s *AccountService) GenerateStatement(ctx context.Context, req *accountpb.GenerateStatementRequest) (*accountpb.GenerateStatementResponse, error) {
+	if errs := s.validator.ValidateGenerateStatement(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	accountID := uuid.MustParse(req.GetAccountId())
+	periodStart := interest.Date(req.GetPeriodStart().AsTime())
+	periodEnd := interest.Date(req.GetPeriodEnd().AsTime())
+	if periodEnd.After(interest.Date(time.Now())) {
+		return nil, status.Errorf(codes.InvalidArgument, "period_end must not be in the future")
+	}
+
+	stmt, docs, err := generateStatement(ctx, s.repo, s.customers, accountID, periodStart, periodEnd, req.GetGeneratedBy(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountpb.GenerateStatementResponse{
+		Statement: statementModelToProto(stmt, docs),
+	}, nil
+}
+
+// GetStatement returns one stored rendering of a statement. The content is
+// checked against the hash recorded when it was generated before it is
+// returned.
+func (s *AccountService) GetStatement(ctx context.Context, req *accountpb.GetStatementRequest) (*accountpb.GetStatementResponse, error) {
+	statementID, err := parseID("statement_id", req.GetStatementId())
+	if err != nil {
+		return nil, err
+	}
+
+	format := models.StatementFormatPDF
+	if req.GetFormat() != "" {
+		format = models.StatementFormat(req.GetFormat())
+	}
+	if !format.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "format must be JSON, CSV, CAMT053 or PDF")
+	}
+
+	stmt, err := s.repo.GetStatement(ctx, statementID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "statement not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get statement: %v", err)
+	}
+
+	doc, err := s.repo.GetStatementDocument(ctx, statementID, format)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "statement has no %s rendering", format)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get statement document: %v", err)
+	}
+	if err := doc.Verify(); err != nil {
+		return nil, status.Errorf(codes.DataLoss, "statement %s %s: %v", statementID, format, err)
+	}
+
+	docs, err := s.repo.ListStatementDocuments(ctx, statementID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list statement documents: %v", err)
+	}
+
+	return &accountpb.GetStatementResponse{
+		Statement:   statementModelToProto(stmt, docs),
+		Format:      string(doc.Format),
+		ContentType: doc.Format.ContentType(),
+		Content:     doc.Content,
+		ContentHash: doc.ContentHash,
+	}, nil
+}
+
+// ListStatements lists every statement issued for an account, newest period
+// first and, within a period, newest version first
+func (s *AccountService) ListStatements(ctx context.Context, req *accountpb.ListStatementsRequest) (*accountpb.ListStatementsResponse, error) {
+	accountID, err := parseID("account_id", req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getAccount(ctx, s.repo, accountID); err != nil {
+		return nil, err
+	}
+
+	statements, err := s.repo.ListStatements(ctx, accountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list statements: %v", err)
+	}
+
+	protoStatements := make([]*accountpb.Statement, 0, len(statements))
+	for _, stmt := range statements {
+		docs, err := s.repo.ListStatementDocuments(ctx, stmt.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list statement documents: %v", err)
+		}
+		protoStatements = append(protoStatements, statementModelToProto(stmt, docs))
+	}
+
+	return &accountpb.ListStatementsResponse{
+		Statements: protoStatements,
+	}, nil
+}
+
+// generateStatement builds the statement for the account and inclusive
+// period, renders it in every format and stores it as the next version.
+// The holder's name and address are looked up before the account is locked.
+func generateStatement(ctx context.Context, repo repository.AccountRepository, customers CustomerDirectory, accountID uuid.UUID, periodStart, periodEnd time.Time, generatedBy string, now time.Time) (*models.Statement, []*models.StatementDocument, error) {
+	account, err := repo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to get account: %v", err)
+	}
+
+	holder, err := statementHolder(ctx, customers, account.CustomerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := repo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	txRepo := tx.AccountRepository()
+
+	// The lock serializes version numbering with concurrent regeneration
+	account, err = txRepo.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to lock account: %v", err)
+	}
+
+	version := 1
+	latest, err := txRepo.GetLatestStatement(ctx, accountID, periodStart, periodEnd)
+	if err == nil {
+		version = latest.Version + 1
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get latest statement: %v", err)
+	}
+
+	from, to := statement.PeriodBounds(periodStart, periodEnd)
+	opening, err := txRepo.SumPostingsBookedBefore(ctx, accountID, from)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get opening balance: %v", err)
+	}
+	postings, err := txRepo.ListPostings(ctx, accountID, from, to)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list postings: %v", err)
+	}
+	holds, err := txRepo.ListHolds(ctx, accountID, false)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list holds: %v", err)
+	}
+	accruals, err := txRepo.ListAccruals(ctx, accountID, periodStart, periodEnd)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list accruals: %v", err)
+	}
+
+	content, err := statement.Build(statement.Params{
+		ID:             uuid.New(),
+		Version:        version,
+		Account:        account,
+		Holder:         holder,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		OpeningBalance: opening,
+		Postings:       postings,
+		Holds:          holds,
+		Accruals:       accruals,
+		GeneratedAt:    now,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to build statement: %v", err)
+	}
+
+	docs := make([]*models.StatementDocument, 0, len(models.StatementFormats))
+	for _, format := range models.StatementFormats {
+		rendered, err := statement.Render(content, format)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to render %s statement: %v", format, err)
+		}
+		docs = append(docs, models.NewStatementDocument(content.ID, format, rendered))
+	}
+
+	stmt := &models.Statement{
+		ID:             content.ID,
+		AccountID:      accountID,
+		PeriodStart:    content.PeriodStart,
+		PeriodEnd:      content.PeriodEnd,
+		Version:        version,
+		OpeningBalance: content.OpeningBalance,
+		ClosingBalance: content.ClosingBalance,
+		GeneratedAt:    content.GeneratedAt,
+		GeneratedBy:    generatedBy,
+	}
+	if err := txRepo.CreateStatement(ctx, stmt, docs); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to store statement: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return stmt, docs, nil
+}
+
+// statementHolder looks up the name and primary address a statement is
+// addressed to
+func statementHolder(ctx context.Context, customers CustomerDirectory, customerID uuid.UUID) (statement.Holder, error) {
+	customer, err := customers.GetCustomerProfile(ctx, customerID)
+	if errors.Is(err, customerclient.ErrNotFound) {
+		return statement.Holder{}, status.Errorf(codes.FailedPrecondition, "customer %s not found", customerID)
+	}
+	if err != nil {
+		return statement.Holder{}, status.Errorf(codes.Unavailable, "failed to get customer %s: %v", customerID, err)
+	}
+
+	holder := statement.Holder{
+		CustomerID:     customer.ID,
+		CustomerNumber: customer.CustomerNumber,
+		Name:           customer.FullName(),
+	}
+	if addr := customer.PrimaryAddress; addr != nil {
+		for _, line := range []string{addr.Street1, addr.Street2} {
+			if line != "" {
+				holder.AddressLines = append(holder.AddressLines, line)
+			}
+		}
+		locality := strings.Join(strings.Fields(fmt.Sprintf("%s %s %s", addr.City, addr.State, addr.PostalCode)), " ")
+		if locality != "" {
+			holder.AddressLines = append(holder.AddressLines, locality)
+		}
+		holder.Country = addr.Country
+	}
+	return holder, nil
+}
+
+func statementModelToProto(s *models.Statement, docs []*models.StatementDocument) *accountpb.Statement {
+	stmt := &accountpb.Statement{
+		Id:             s.ID.String(),
+		AccountId:      s.AccountID.String(),
+		PeriodStart:    timestamppb.New(s.PeriodStart),
+		PeriodEnd:      timestamppb.New(s.PeriodEnd),
+		Version:        int32(s.Version),
+		OpeningBalance: s.OpeningBalance,
+		ClosingBalance: s.ClosingBalance,
+		GeneratedAt:    timestamppb.New(s.GeneratedAt),
+		GeneratedBy:    s.GeneratedBy,
+	}
+
+	for _, d := range docs {
+		stmt.Documents = append(stmt.Documents, &accountpb.StatementDocument{
+			Format:      string(d.Format),
+			ContentType: d.Format.ContentType(),
+			ContentHash: d.ContentHash,
+		})
+	}
+
+	return stmt
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// seedBookedPosting records a posting booked at the given time, bypassing
+// AddPosting's booking clock
+func seedBookedPosting(repo *MockRepository, account *models.Account, amount int64, bookedAt time.Time, reference string) {
+	repo.postings = append(repo.postings, &models.Posting{
+		ID:          uuid.New(),
+		AccountID:   account.ID,
+		PostingType: models.PostingTypeCredit,
+		Amount:      amount,
+		Currency:    account.Currency,
+		Reference:   reference,
+		ValueDate:   bookedAt,
+		BookedAt:    bookedAt,
+	})
+	account.LedgerBalance += amount
+}
+
+func TestAccountService_GenerateStatement(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+
+	seedBookedPosting(repo, account, 5000, mustDate(t, "2024-02-20"), "FEB")
+	seedBookedPosting(repo, account, 2500, mustDate(t, "2024-03-05").Add(10*time.Hour), "MAR-1")
+	seedBookedPosting(repo, account, -1000, mustDate(t, "2024-03-31").Add(23*time.Hour), "MAR-2")
+	seedBookedPosting(repo, account, 700, mustDate(t, "2024-04-01"), "APR")
+
+	req := &accountpb.GenerateStatementRequest{
+		AccountId:   account.ID.String(),
+		PeriodStart: timestamppb.New(mustDate(t, "2024-03-01")),
+		PeriodEnd:   timestamppb.New(mustDate(t, "2024-03-31")),
+		GeneratedBy: "auditor",
+	}
+	resp, err := svc.GenerateStatement(ctx, req)
+	assertCode(t, err, codes.OK)
+
+	stmt := resp.Statement
+	if stmt.Version != 1 || stmt.OpeningBalance != 5000 || stmt.ClosingBalance != 6500 || stmt.GeneratedBy != "auditor" {
+		t.Errorf("GenerateStatement() = %+v, want version 1 from 5000 to 6500", stmt)
+	}
+	if len(stmt.Documents) != len(models.StatementFormats) {
+		t.Errorf("GenerateStatement() stored %d documents, want %d", len(stmt.Documents), len(models.StatementFormats))
+	}
+
+	// Regenerating keeps the first version and issues the next
+	again, err := svc.GenerateStatement(ctx, req)
+	assertCode(t, err, codes.OK)
+	if again.Statement.Version != 2 || again.Statement.Id == stmt.Id {
+		t.Errorf("regenerated statement = %+v, want a new version 2", again.Statement)
+	}
+
+	list, err := svc.ListStatements(ctx, &accountpb.ListStatementsRequest{AccountId: account.ID.String()})
+	assertCode(t, err, codes.OK)
+	if len(list.Statements) != 2 || list.Statements[0].Version != 2 || list.Statements[1].Id != stmt.Id {
+		t.Errorf("ListStatements() = %+v, want both versions, newest first", list.Statements)
+	}
+}
+
+func TestAccountService_GenerateStatement_Errors(t *testing.T) {
+	ctx := context.Background()
+	missing := uuid.New()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{missing: ""}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	orphan := seedAccount(repo, 0, models.AccountStatusActive)
+	orphan.CustomerID = missing
+
+	today := time.Now().UTC()
+	tests := []struct {
+		name      string
+		accountID string
+		start     time.Time
+		end       time.Time
+		wantErr   codes.Code
+	}{
+		{"invalid account id", "bad", mustDate(t, "2024-03-01"), mustDate(t, "2024-03-31"), codes.InvalidArgument},
+		{"end before start", account.ID.String(), mustDate(t, "2024-03-31"), mustDate(t, "2024-03-01"), codes.InvalidArgument},
+		{"period not yet ended", account.ID.String(), today, today.AddDate(0, 0, 1), codes.InvalidArgument},
+		{"unknown account", uuid.New().String(), mustDate(t, "2024-03-01"), mustDate(t, "2024-03-31"), codes.NotFound},
+		{"holder missing from customer-service", orphan.ID.String(), mustDate(t, "2024-03-01"), mustDate(t, "2024-03-31"), codes.FailedPrecondition},
+		{"period ending today", account.ID.String(), today.AddDate(0, 0, -7), today, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.GenerateStatement(ctx, &accountpb.GenerateStatementRequest{
+				AccountId:   tt.accountID,
+				PeriodStart: timestamppb.New(tt.start),
+				PeriodEnd:   timestamppb.New(tt.end),
+			})
+			assertCode(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAccountService_GetStatement(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewAccountService(repo, mockCustomerDirectory{}, nil)
+	account := seedAccount(repo, 0, models.AccountStatusActive)
+	seedBookedPosting(repo, account, 123456, mustDate(t, "2024-03-05"), "SALARY")
+
+	generated, err := svc.GenerateStatement(ctx, &accountpb.GenerateStatementRequest{
+		AccountId:   account.ID.String(),
+		PeriodStart: timestamppb.New(mustDate(t, "2024-03-01")),
+		PeriodEnd:   timestamppb.New(mustDate(t, "2024-03-31")),
+	})
+	assertCode(t, err, codes.OK)
+	id := generated.Statement.Id
+
+	pdf, err := svc.GetStatement(ctx, &accountpb.GetStatementRequest{StatementId: id})
+	assertCode(t, err, codes.OK)
+	if pdf.Format != "PDF" || pdf.ContentType != "application/pdf" || !bytes.HasPrefix(pdf.Content, []byte("%PDF-")) {
+		t.Errorf("GetStatement() default = %s %s, want the PDF rendering", pdf.Format, pdf.ContentType)
+	}
+	if pdf.ContentHash != models.HashContent(pdf.Content) {
+		t.Error("GetStatement() hash does not match content")
+	}
+
+	csv, err := svc.GetStatement(ctx, &accountpb.GetStatementRequest{StatementId: id, Format: "CSV"})
+	assertCode(t, err, codes.OK)
+	if !strings.Contains(string(csv.Content), "ENTRY,2024-03-05,2024-03-05,Credit,SALARY,,1234.56,1234.56") {
+		t.Errorf("GetStatement(CSV) content = %s", csv.Content)
+	}
+
+	camt, err := svc.GetStatement(ctx, &accountpb.GetStatementRequest{StatementId: id, Format: "CAMT053"})
+	assertCode(t, err, codes.OK)
+	for _, want := range []string{"<Nm>Ada Lovelace</Nm>", "<AdrLine>12 St James&#39;s Square</AdrLine>", "<AdrLine>London SW1Y 4JH</AdrLine>", "<Ctry>GB</Ctry>"} {
+		if !strings.Contains(string(camt.Content), want) {
+			t.Errorf("GetStatement(CAMT053) missing %s", want)
+		}
+	}
+
+	_, err = svc.GetStatement(ctx, &accountpb.GetStatementRequest{StatementId: id, Format: "XLSX"})
+	assertCode(t, err, codes.InvalidArgument)
+	_, err = svc.GetStatement(ctx, &accountpb.GetStatementRequest{StatementId: uuid.New().String()})
+	assertCode(t, err, codes.NotFound)
+
+	// Stored content that no longer matches its hash is never served
+	repo.docs[uuid.MustParse(id)][0].Content = []byte(`{"closing_balance": 99999999}`)
+	_, err = svc.GetStatement(ctx, &accountpb.GetStatementRequest{StatementId: id, Format: string(repo.docs[uuid.MustParse(id)][0].Format)})
+	assertCode(t, err, codes.DataLoss)
+}
+
+func TestStatementJob_RunEndOfDay(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	customers := mockCustomerDirectory{}
+	svc := NewAccountService(repo, customers, nil)
+
+	opened := seedAccount(repo, 0, models.AccountStatusActive)
+	opened.OpenedAt = mustDate(t, "2024-01-10")
+	seedBookedPosting(repo, opened, 1000, mustDate(t, "2024-01-15"), "JAN")
+	late := seedAccount(repo, 0, models.AccountStatusActive)
+	late.OpenedAt = mustDate(t, "2024-02-01")
+	closed := seedAccount(repo, 0, models.AccountStatusClosed)
+	closed.OpenedAt = mustDate(t, "2024-01-01")
+
+	job := NewStatementJob(repo, customers, time.Hour, zerolog.Nop())
+	for _, day := range []string{"2024-01-30", "2024-01-31", "2024-01-31"} {
+		if _, err := job.RunEndOfDay(ctx, mustDate(t, day)); err != nil {
+			t.Fatalf("RunEndOfDay(%s) error = %v", day, err)
+		}
+	}
+
+	for _, tt := range []struct {
+		account *models.Account
+		want    int
+	}{{opened, 1}, {late, 0}, {closed, 0}} {
+		list, err := svc.ListStatements(ctx, &accountpb.ListStatementsRequest{AccountId: tt.account.ID.String()})
+		assertCode(t, err, codes.OK)
+		if len(list.Statements) != tt.want {
+			t.Errorf("account opened %s (%s) has %d statements, want %d",
+				tt.account.OpenedAt.Format("2006-01-02"), tt.account.Status, len(list.Statements), tt.want)
+		}
+	}
+
+	list, _ := svc.ListStatements(ctx, &accountpb.ListStatementsRequest{AccountId: opened.ID.String()})
+	stmt := list.Statements[0]
+	if !stmt.PeriodStart.AsTime().Equal(mustDate(t, "2024-01-01")) || !stmt.PeriodEnd.AsTime().Equal(mustDate(t, "2024-01-31")) ||
+		stmt.ClosingBalance != 1000 || stmt.GeneratedBy != statementJobUser {
+		t.Errorf("monthly statement = %+v, want January closing at 1000", stmt)
+	}
+}
@@ -0,0 +1,286 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+)
+
+// CAMT053Namespace is the ISO 20022 BankToCustomerStatement version produced
+const CAMT053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+
+// camt.053 balance type and credit/debit codes
+const (
+	balanceOpeningBooked = "OPBD"
+	balanceClosingBooked = "CLBD"
+	credit               = "CRDT"
+	debit                = "DBIT"
+	entryStatusBooked    = "BOOK"
+	notProvided          = "NOTPROVIDED"
+)
+
+// ISO 20022 limits that apply to free-text elements
+const (
+	maxAddressLines     = 7
+	maxText70           = 70
+	maxText140          = 140
+	maxAdditionalInfo   = 500
+	maxEndToEndIDLength = 35
+)
+
+type camtDocument struct {
+	XMLName xml.Name         `xml:"Document"`
+	Xmlns   string           `xml:"xmlns,attr"`
+	Stmt    camtBkToCstmrStm `xml:"BkToCstmrStmt"`
+}
+
+type camtBkToCstmrStm struct {
+	GrpHdr camtGrpHdr   `xml:"GrpHdr"`
+	Stmt   camtStmtBody `xml:"Stmt"`
+}
+
+type camtGrpHdr struct {
+	MsgID   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStmtBody struct {
+	ID           string        `xml:"Id"`
+	ElctrncSeqNb int           `xml:"ElctrncSeqNb"`
+	CreDtTm      string        `xml:"CreDtTm"`
+	FrToDt       camtFrToDt    `xml:"FrToDt"`
+	Acct         camtAcct      `xml:"Acct"`
+	Bal          []camtBal     `xml:"Bal"`
+	TxsSummry    camtTxsSummry `xml:"TxsSummry"`
+	Ntry         []camtNtry    `xml:"Ntry"`
+	AddtlStmtInf string        `xml:"AddtlStmtInf,omitempty"`
+}
+
+type camtFrToDt struct {
+	FrDtTm string `xml:"FrDtTm"`
+	ToDtTm string `xml:"ToDtTm"`
+}
+
+type camtAcct struct {
+	ID   camtAcctID `xml:"Id"`
+	Tp   *camtCode  `xml:"Tp>Prtry,omitempty"`
+	Ccy  string     `xml:"Ccy"`
+	Ownr camtOwnr   `xml:"Ownr"`
+}
+
+// camtAcctID identifies the account by IBAN when it has one, otherwise by
+// its internal number
+type camtAcctID struct {
+	IBAN string    `xml:"IBAN,omitempty"`
+	Othr *camtOthr `xml:"Othr,omitempty"`
+}
+
+type camtOthr struct {
+	ID string `xml:"Id"`
+}
+
+type camtCode struct {
+	Value string `xml:",chardata"`
+}
+
+type camtOwnr struct {
+	Nm      string       `xml:"Nm"`
+	PstlAdr *camtPstlAdr `xml:"PstlAdr,omitempty"`
+}
+
+type camtPstlAdr struct {
+	Ctry    string   `xml:"Ctry,omitempty"`
+	AdrLine []string `xml:"AdrLine"`
+}
+
+type camtBal struct {
+	Tp        string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Dt        string     `xml:"Dt>Dt"`
+}
+
+type camtAmount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camtTxsSummry struct {
+	TtlNtries    camtTotal    `xml:"TtlNtries"`
+	TtlCdtNtries camtNbAndSum `xml:"TtlCdtNtries"`
+	TtlDbtNtries camtNbAndSum `xml:"TtlDbtNtries"`
+}
+
+type camtTotal struct {
+	NbOfNtries int          `xml:"NbOfNtries"`
+	Sum        string       `xml:"Sum"`
+	TtlNetNtry camtNetEntry `xml:"TtlNetNtry"`
+}
+
+type camtNetEntry struct {
+	Amt       string `xml:"Amt"`
+	CdtDbtInd string `xml:"CdtDbtInd"`
+}
+
+type camtNbAndSum struct {
+	NbOfNtries int    `xml:"NbOfNtries"`
+	Sum        string `xml:"Sum"`
+}
+
+type camtNtry struct {
+	NtryRef      string     `xml:"NtryRef"`
+	Amt          camtAmount `xml:"Amt"`
+	CdtDbtInd    string     `xml:"CdtDbtInd"`
+	Sts          string     `xml:"Sts>Cd"`
+	BookgDt      string     `xml:"BookgDt>DtTm"`
+	ValDt        string     `xml:"ValDt>Dt"`
+	AcctSvcrRef  string     `xml:"AcctSvcrRef"`
+	BkTxCd       string     `xml:"BkTxCd>Prtry>Cd"`
+	NtryDtls     camtTxDtls `xml:"NtryDtls>TxDtls"`
+	AddtlNtryInf string     `xml:"AddtlNtryInf,omitempty"`
+}
+
+type camtTxDtls struct {
+	EndToEndID string `xml:"Refs>EndToEndId"`
+}
+
+// RenderCAMT053 renders the statement as an ISO 20022 camt.053.001.08
+// BankToCustomerStatement message
+func RenderCAMT053(s *Statement) ([]byte, error) {
+	ccy := s.Account.Currency
+	created := s.GeneratedAt.Format(time.RFC3339)
+
+	stmt := camtStmtBody{
+		ID:           s.ID.String(),
+		ElctrncSeqNb: s.Version,
+		CreDtTm:      created,
+		FrToDt: camtFrToDt{
+			FrDtTm: s.PeriodStart.Format(time.RFC3339),
+			ToDtTm: s.PeriodEnd.Add(24*time.Hour - time.Second).Format(time.RFC3339),
+		},
+		Acct: camtAcct{
+			ID:  camtAccountID(s.Account),
+			Tp:  &camtCode{Value: string(s.Account.Type)},
+			Ccy: ccy,
+			Ownr: camtOwnr{
+				Nm:      truncate(s.Holder.Name, maxText140),
+				PstlAdr: camtAddress(s.Holder),
+			},
+		},
+		Bal: []camtBal{
+			camtBalance(balanceOpeningBooked, s.OpeningBalance, ccy, s.PeriodStart),
+			camtBalance(balanceClosingBooked, s.ClosingBalance, ccy, s.PeriodEnd),
+		},
+		AddtlStmtInf: truncate(fmt.Sprintf("Accrued credit interest %s; accrued overdraft interest %s; held %s",
+			formatUnsigned(s.Interest.Credit, ccy), formatUnsigned(s.Interest.Debit, ccy),
+			formatUnsigned(s.TotalHeld(), ccy)), maxAdditionalInfo),
+	}
+
+	var credits, debits int
+	for _, e := range s.Entries {
+		indicator := credit
+		if e.Amount < 0 {
+			indicator = debit
+			debits++
+		} else {
+			credits++
+		}
+
+		endToEnd := e.Reference
+		if endToEnd == "" || len(endToEnd) > maxEndToEndIDLength {
+			endToEnd = notProvided
+		}
+
+		stmt.Ntry = append(stmt.Ntry, camtNtry{
+			NtryRef:      e.PostingID.String(),
+			Amt:          camtAmount{Ccy: ccy, Value: formatUnsigned(e.Amount, ccy)},
+			CdtDbtInd:    indicator,
+			Sts:          entryStatusBooked,
+			BookgDt:      e.BookedAt.Format(time.RFC3339),
+			ValDt:        e.ValueDate.Format(interest.DateLayout),
+			AcctSvcrRef:  e.PostingID.String(),
+			BkTxCd:       string(e.Type),
+			NtryDtls:     camtTxDtls{EndToEndID: endToEnd},
+			AddtlNtryInf: truncate(e.Description, maxAdditionalInfo),
+		})
+	}
+
+	net := s.TotalCredits - s.TotalDebits
+	netIndicator := credit
+	if net < 0 {
+		netIndicator = debit
+	}
+	stmt.TxsSummry = camtTxsSummry{
+		TtlNtries: camtTotal{
+			NbOfNtries: len(s.Entries),
+			Sum:        formatUnsigned(s.TotalCredits+s.TotalDebits, ccy),
+			TtlNetNtry: camtNetEntry{Amt: formatUnsigned(net, ccy), CdtDbtInd: netIndicator},
+		},
+		TtlCdtNtries: camtNbAndSum{NbOfNtries: credits, Sum: formatUnsigned(s.TotalCredits, ccy)},
+		TtlDbtNtries: camtNbAndSum{NbOfNtries: debits, Sum: formatUnsigned(s.TotalDebits, ccy)},
+	}
+
+	doc := camtDocument{
+		Xmlns: CAMT053Namespace,
+		Stmt: camtBkToCstmrStm{
+			GrpHdr: camtGrpHdr{MsgID: s.ID.String(), CreDtTm: created},
+			Stmt:   stmt,
+		},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode camt.053: %w", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func camtAccountID(a Account) camtAcctID {
+	if a.IBAN != "" {
+		return camtAcctID{IBAN: a.IBAN}
+	}
+	return camtAcctID{Othr: &camtOthr{ID: a.Number}}
+}
+
+func camtAddress(h Holder) *camtPstlAdr {
+	if len(h.AddressLines) == 0 && h.Country == "" {
+		return nil
+	}
+	addr := &camtPstlAdr{Ctry: h.Country}
+	for i, line := range h.AddressLines {
+		if i == maxAddressLines {
+			break
+		}
+		addr.AdrLine = append(addr.AdrLine, truncate(line, maxText70))
+	}
+	return addr
+}
+
+func camtBalance(code string, amount int64, ccy string, date time.Time) camtBal {
+	indicator := credit
+	if amount < 0 {
+		indicator = debit
+	}
+	return camtBal{
+		Tp:        code,
+		Amt:       camtAmount{Ccy: ccy, Value: formatUnsigned(amount, ccy)},
+		CdtDbtInd: indicator,
+		Dt:        date.Format(interest.DateLayout),
+	}
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
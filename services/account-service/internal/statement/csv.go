@@ -0,0 +1,58 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+)
+
+// CSV record kinds, one per row in the first column
+const (
+	csvOpening  = "OPENING"
+	csvEntry    = "ENTRY"
+	csvHold     = "HOLD"
+	csvInterest = "ACCRUED_INTEREST"
+	csvClosing  = "CLOSING"
+)
+
+var csvHeader = []string{"record", "date", "value_date", "type", "reference", "description", "amount", "balance"}
+
+// RenderCSV renders the statement as CSV with one row per entry, framed by
+// opening and closing balance rows and followed by holds and accrued interest
+func RenderCSV(s *Statement) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	ccy := s.Account.Currency
+
+	rows := [][]string{
+		csvHeader,
+		{csvOpening, s.PeriodStart.Format(interest.DateLayout), "", "", "", "Opening balance", "", FormatAmount(s.OpeningBalance, ccy)},
+	}
+	for _, e := range s.Entries {
+		rows = append(rows, []string{
+			csvEntry,
+			e.BookedAt.Format(interest.DateLayout),
+			e.ValueDate.Format(interest.DateLayout),
+			string(e.Type),
+			e.Reference,
+			e.Description,
+			FormatAmount(e.Amount, ccy),
+			FormatAmount(e.RunningBalance, ccy),
+		})
+	}
+	rows = append(rows, []string{csvClosing, s.PeriodEnd.Format(interest.DateLayout), "", "", "", "Closing balance", "", FormatAmount(s.ClosingBalance, ccy)})
+
+	for _, h := range s.Holds {
+		rows = append(rows, []string{csvHold, h.PlacedAt.Format(interest.DateLayout), "", string(h.Type), h.Reference, h.Reason, FormatAmount(h.Amount, ccy), ""})
+	}
+	rows = append(rows,
+		[]string{csvInterest, s.PeriodEnd.Format(interest.DateLayout), "", "Credit", "", "Credit interest accrued", FormatAmount(s.Interest.Credit, ccy), ""},
+		[]string{csvInterest, s.PeriodEnd.Format(interest.DateLayout), "", "Debit", "", "Overdraft interest accrued", FormatAmount(-s.Interest.Debit, ccy), ""},
+	)
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,12 @@
+package statement
+
+import "encoding/json"
+
+// RenderJSON renders the statement as indented JSON
+func RenderJSON(s *Statement) ([]byte, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
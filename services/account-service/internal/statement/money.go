@@ -0,0 +1,52 @@
+package statement
+
+import (
+	"strconv"
+	"strings"
+)
+
+// currencyExponents lists ISO 4217 currencies whose minor unit is not one
+// hundredth of the major unit
+var currencyExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// CurrencyExponent returns the number of decimal places in the currency's minor unit
+func CurrencyExponent(currency string) int {
+	if exp, ok := currencyExponents[currency]; ok {
+		return exp
+	}
+	return 2
+}
+
+// FormatAmount formats an amount in minor units as a signed decimal in major
+// units, e.g. -123456 USD as "-1234.56"
+func FormatAmount(amount int64, currency string) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+	return sign + formatUnsigned(amount, currency)
+}
+
+// formatUnsigned formats the absolute value of an amount in major units
+func formatUnsigned(amount int64, currency string) string {
+	digits := strconv.FormatUint(absolute(amount), 10)
+	exp := CurrencyExponent(currency)
+	if exp == 0 {
+		return digits
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+func absolute(amount int64) uint64 {
+	if amount < 0 {
+		return uint64(-(amount + 1)) + 1
+	}
+	return uint64(amount)
+}
@@ -0,0 +1,281 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/core-banking/pkg/bankid"
+	"github.com/core-banking/services/account-service/internal/interest"
+)
+
+// A4 page geometry in PDF points
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 50
+	pdfTop          = pdfPageHeight - pdfMargin
+	pdfBottom       = 70
+	pdfFontSize     = 9
+	pdfTitleSize    = 16
+	pdfHeadingSize  = 11
+	pdfLineHeight   = 13
+	pdfDescMaxRunes = 32
+	pdfRefMaxRunes  = 20
+)
+
+// Column positions of the entries table; amounts are right-aligned on theirs
+const (
+	pdfColDate      = 50.0
+	pdfColValueDate = 105.0
+	pdfColDesc      = 160.0
+	pdfColRef       = 330.0
+	pdfColAmount    = 475.0
+	pdfColBalance   = 545.0
+)
+
+// The two standard Type 1 fonts used; they need no embedding
+const (
+	pdfFontRegular = "F1"
+	pdfFontBold    = "F2"
+)
+
+// helveticaWidths holds Helvetica glyph widths in thousandths of the font
+// size for the characters that appear in right-aligned amounts
+var helveticaWidths = map[byte]int{
+	'0': 556, '1': 556, '2': 556, '3': 556, '4': 556,
+	'5': 556, '6': 556, '7': 556, '8': 556, '9': 556,
+	'.': 278, ',': 278, '-': 333, ' ': 278,
+}
+
+// pdfWriter lays text out over as many pages as the statement needs
+type pdfWriter struct {
+	pages  []*bytes.Buffer
+	page   *bytes.Buffer
+	y      float64
+	header func()
+}
+
+func newPDFWriter() *pdfWriter {
+	w := &pdfWriter{}
+	w.newPage()
+	return w
+}
+
+func (w *pdfWriter) newPage() {
+	w.page = &bytes.Buffer{}
+	w.pages = append(w.pages, w.page)
+	w.y = pdfTop
+	if w.header != nil {
+		w.header()
+	}
+}
+
+// line advances to the next line, starting a new page when this one is full
+func (w *pdfWriter) line(height float64) {
+	w.y -= height
+	if w.y < pdfBottom {
+		w.newPage()
+		w.y -= height
+	}
+}
+
+func (w *pdfWriter) text(font string, size int, x float64, s string) {
+	fmt.Fprintf(w.page, "BT /%s %d Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, w.y, pdfEscape(s))
+}
+
+// textRight draws s so that it ends at x
+func (w *pdfWriter) textRight(font string, size int, x float64, s string) {
+	w.text(font, size, x-pdfTextWidth(s, size), s)
+}
+
+func (w *pdfWriter) rule() {
+	fmt.Fprintf(w.page, "0.5 w %d %.2f m %d %.2f l S\n", pdfMargin, w.y-4, pdfPageWidth-pdfMargin, w.y-4)
+}
+
+// pdfTextWidth approximates the width of s in Helvetica at the given size
+func pdfTextWidth(s string, size int) float64 {
+	var units int
+	for i := 0; i < len(s); i++ {
+		if width, ok := helveticaWidths[s[i]]; ok {
+			units += width
+		} else {
+			units += 556
+		}
+	}
+	return float64(units*size) / 1000
+}
+
+// pdfEscape encodes s for a PDF literal string in WinAnsiEncoding. Characters
+// outside Latin-1 are replaced with '?'.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r >= 0x20 && r < 0x7f:
+			b.WriteByte(byte(r))
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// RenderPDF renders the statement as a PDF document using only the standard
+// Helvetica fonts, so no font data has to be embedded
+func RenderPDF(s *Statement) ([]byte, error) {
+	ccy := s.Account.Currency
+	w := newPDFWriter()
+
+	w.text(pdfFontBold, pdfTitleSize, pdfMargin, "Account Statement")
+	w.line(2 * pdfLineHeight)
+
+	w.text(pdfFontBold, pdfFontSize, pdfMargin, s.Holder.Name)
+	for _, l := range s.Holder.AddressLines {
+		w.line(pdfLineHeight)
+		w.text(pdfFontRegular, pdfFontSize, pdfMargin, l)
+	}
+	if s.Holder.Country != "" {
+		w.line(pdfLineHeight)
+		w.text(pdfFontRegular, pdfFontSize, pdfMargin, s.Holder.Country)
+	}
+	w.line(2 * pdfLineHeight)
+
+	details := [][2]string{
+		{"Account number", s.Account.Number},
+		{"IBAN", bankid.FormatIBAN(s.Account.IBAN)},
+		{"Account type", string(s.Account.Type)},
+		{"Currency", ccy},
+		{"Period", s.PeriodStart.Format(interest.DateLayout) + " to " + s.PeriodEnd.Format(interest.DateLayout)},
+		{"Statement", fmt.Sprintf("%s (version %d)", s.ID, s.Version)},
+		{"Generated", s.GeneratedAt.Format("2006-01-02 15:04:05 MST")},
+	}
+	for _, d := range details {
+		if d[1] == "" {
+			continue
+		}
+		w.text(pdfFontBold, pdfFontSize, pdfMargin, d[0])
+		w.text(pdfFontRegular, pdfFontSize, pdfColDesc, d[1])
+		w.line(pdfLineHeight)
+	}
+	w.line(pdfLineHeight)
+
+	w.text(pdfFontBold, pdfHeadingSize, pdfMargin, "Transactions")
+	w.line(pdfLineHeight + 4)
+	tableHeader := func() {
+		w.text(pdfFontBold, pdfFontSize, pdfColDate, "Date")
+		w.text(pdfFontBold, pdfFontSize, pdfColValueDate, "Value date")
+		w.text(pdfFontBold, pdfFontSize, pdfColDesc, "Description")
+		w.text(pdfFontBold, pdfFontSize, pdfColRef, "Reference")
+		w.textRight(pdfFontBold, pdfFontSize, pdfColAmount, "Amount")
+		w.textRight(pdfFontBold, pdfFontSize, pdfColBalance, "Balance")
+		w.rule()
+		w.y -= pdfLineHeight + 2
+	}
+	tableHeader()
+	w.header = tableHeader
+
+	w.text(pdfFontRegular, pdfFontSize, pdfColDate, s.PeriodStart.Format(interest.DateLayout))
+	w.text(pdfFontBold, pdfFontSize, pdfColDesc, "Opening balance")
+	w.textRight(pdfFontBold, pdfFontSize, pdfColBalance, FormatAmount(s.OpeningBalance, ccy))
+	for _, e := range s.Entries {
+		w.line(pdfLineHeight)
+		description := e.Description
+		if description == "" {
+			description = string(e.Type)
+		}
+		w.text(pdfFontRegular, pdfFontSize, pdfColDate, e.BookedAt.Format(interest.DateLayout))
+		w.text(pdfFontRegular, pdfFontSize, pdfColValueDate, e.ValueDate.Format(interest.DateLayout))
+		w.text(pdfFontRegular, pdfFontSize, pdfColDesc, truncate(description, pdfDescMaxRunes))
+		w.text(pdfFontRegular, pdfFontSize, pdfColRef, truncate(e.Reference, pdfRefMaxRunes))
+		w.textRight(pdfFontRegular, pdfFontSize, pdfColAmount, FormatAmount(e.Amount, ccy))
+		w.textRight(pdfFontRegular, pdfFontSize, pdfColBalance, FormatAmount(e.RunningBalance, ccy))
+	}
+	w.line(pdfLineHeight)
+	w.text(pdfFontRegular, pdfFontSize, pdfColDate, s.PeriodEnd.Format(interest.DateLayout))
+	w.text(pdfFontBold, pdfFontSize, pdfColDesc, "Closing balance")
+	w.textRight(pdfFontBold, pdfFontSize, pdfColBalance, FormatAmount(s.ClosingBalance, ccy))
+	w.header = nil
+
+	w.line(2 * pdfLineHeight)
+	summary := [][2]string{
+		{"Total credits", FormatAmount(s.TotalCredits, ccy)},
+		{"Total debits", FormatAmount(-s.TotalDebits, ccy)},
+		{"Credit interest accrued", FormatAmount(s.Interest.Credit, ccy)},
+		{"Overdraft interest accrued", FormatAmount(-s.Interest.Debit, ccy)},
+		{"Funds on hold at period end", FormatAmount(s.TotalHeld(), ccy)},
+	}
+	for _, row := range summary {
+		w.text(pdfFontRegular, pdfFontSize, pdfMargin, row[0])
+		w.textRight(pdfFontRegular, pdfFontSize, pdfColBalance, row[1])
+		w.line(pdfLineHeight)
+	}
+
+	if len(s.Holds) > 0 {
+		w.line(pdfLineHeight)
+		w.text(pdfFontBold, pdfHeadingSize, pdfMargin, "Holds")
+		for _, h := range s.Holds {
+			w.line(pdfLineHeight + 2)
+			w.text(pdfFontRegular, pdfFontSize, pdfColDate, h.PlacedAt.Format(interest.DateLayout))
+			w.text(pdfFontRegular, pdfFontSize, pdfColValueDate, string(h.Type))
+			w.text(pdfFontRegular, pdfFontSize, pdfColDesc, truncate(h.Reason, pdfDescMaxRunes))
+			w.text(pdfFontRegular, pdfFontSize, pdfColRef, truncate(h.Reference, pdfRefMaxRunes))
+			w.textRight(pdfFontRegular, pdfFontSize, pdfColAmount, FormatAmount(h.Amount, ccy))
+		}
+	}
+
+	return w.finish(), nil
+}
+
+// finish numbers the pages and assembles the document. Object 1 is the
+// catalog, 2 the page tree, 3 and 4 the fonts, followed by a page and
+// content stream object per page.
+func (w *pdfWriter) finish() []byte {
+	const firstPageObject = 5
+	total := len(w.pages)
+
+	var objects []string
+	kids := make([]string, total)
+	for i := range w.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObject+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), total),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	)
+	for i, page := range w.pages {
+		footer := fmt.Sprintf("Page %d of %d", i+1, total)
+		fmt.Fprintf(page, "BT /%s %d Tf %.2f %d Td (%s) Tj ET\n",
+			pdfFontRegular, pdfFontSize, pdfColBalance-pdfTextWidth(footer, pdfFontSize), pdfMargin-20, footer)
+
+		contentObject := firstPageObject + 2*i + 1
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, pdfFontRegular, pdfFontBold, contentObject),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()),
+		)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
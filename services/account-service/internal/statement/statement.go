@@ -0,0 +1,222 @@
+// Package statement assembles account statements and renders them as JSON,
+// CSV, ISO 20022 camt.053 XML and PDF. Rendering is deterministic: the same
+// statement always produces byte-identical output, so stored renderings can
+// be verified against their content hash.
+package statement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Statement is the content of an account statement for a period. Entries are
+// the postings booked in the period, in booking order; the opening balance is
+// the sum of everything booked before the period.
+type Statement struct {
+	ID             uuid.UUID `json:"id"`
+	Version        int       `json:"version"`
+	Account        Account   `json:"account"`
+	Holder         Holder    `json:"holder"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance int64     `json:"opening_balance"`
+	TotalCredits   int64     `json:"total_credits"`
+	TotalDebits    int64     `json:"total_debits"`
+	ClosingBalance int64     `json:"closing_balance"`
+	Entries        []Entry   `json:"entries"`
+	Holds          []Hold    `json:"holds"`
+	Interest       Interest  `json:"accrued_interest"`
+	GeneratedAt    time.Time `json:"generated_at"`
+}
+
+// Account identifies the account a statement is for
+type Account struct {
+	ID       uuid.UUID          `json:"id"`
+	Number   string             `json:"number"`
+	IBAN     string             `json:"iban,omitempty"`
+	Type     models.AccountType `json:"type"`
+	Currency string             `json:"currency"`
+}
+
+// Holder is the primary holder the statement is addressed to
+type Holder struct {
+	CustomerID     uuid.UUID `json:"customer_id"`
+	CustomerNumber string    `json:"customer_number"`
+	Name           string    `json:"name"`
+	AddressLines   []string  `json:"address_lines"`
+	Country        string    `json:"country,omitempty"`
+}
+
+// Entry is one posting on the statement
+type Entry struct {
+	PostingID      uuid.UUID          `json:"posting_id"`
+	BookedAt       time.Time          `json:"booked_at"`
+	ValueDate      time.Time          `json:"value_date"`
+	Type           models.PostingType `json:"type"`
+	Reference      string             `json:"reference"`
+	Description    string             `json:"description"`
+	Amount         int64              `json:"amount"`
+	RunningBalance int64              `json:"running_balance"`
+}
+
+// Hold is a hold still earmarking funds at the end of the period
+type Hold struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      models.HoldType `json:"type"`
+	Amount    int64           `json:"amount"`
+	Reason    string          `json:"reason"`
+	Reference string          `json:"reference"`
+	PlacedAt  time.Time       `json:"placed_at"`
+	ExpiresAt *time.Time      `json:"expires_at,omitempty"`
+}
+
+// Interest is the interest accrued on the account during the period. The
+// micro-unit totals are exact; the whole-unit amounts are rounded down.
+type Interest struct {
+	CreditMicros int64 `json:"credit_micros"`
+	DebitMicros  int64 `json:"debit_micros"`
+	Credit       int64 `json:"credit"`
+	Debit        int64 `json:"debit"`
+}
+
+// Params holds everything needed to build a statement
+type Params struct {
+	ID             uuid.UUID
+	Version        int
+	Account        *models.Account
+	Holder         Holder
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	OpeningBalance int64
+	Postings       []*models.Posting
+	Holds          []*models.Hold
+	Accruals       []*models.InterestAccrual
+	GeneratedAt    time.Time
+}
+
+// PeriodBounds returns the half-open booking window [from, to) covering the
+// calendar dates start to end inclusive
+func PeriodBounds(start, end time.Time) (time.Time, time.Time) {
+	return interest.Date(start), interest.Date(end).AddDate(0, 0, 1)
+}
+
+// Build assembles a statement, computing the running and closing balances.
+// Postings must be in booking order. Only holds placed before the end of the
+// period that are still active, or were closed after it, are included.
+func Build(p Params) (*Statement, error) {
+	from, to := PeriodBounds(p.PeriodStart, p.PeriodEnd)
+	if !from.Before(to) {
+		return nil, fmt.Errorf("period end %s is before period start %s",
+			p.PeriodEnd.Format(interest.DateLayout), p.PeriodStart.Format(interest.DateLayout))
+	}
+
+	s := &Statement{
+		ID:      p.ID,
+		Version: p.Version,
+		Account: Account{
+			ID:       p.Account.ID,
+			Number:   p.Account.AccountNumber,
+			IBAN:     p.Account.IBAN,
+			Type:     p.Account.AccountType,
+			Currency: p.Account.Currency,
+		},
+		Holder:         p.Holder,
+		PeriodStart:    from,
+		PeriodEnd:      to.AddDate(0, 0, -1),
+		OpeningBalance: p.OpeningBalance,
+		Entries:        []Entry{},
+		Holds:          []Hold{},
+		GeneratedAt:    p.GeneratedAt.UTC().Truncate(time.Second),
+	}
+	if s.Holder.AddressLines == nil {
+		s.Holder.AddressLines = []string{}
+	}
+
+	balance := p.OpeningBalance
+	for _, posting := range p.Postings {
+		if posting.BookedAt.Before(from) || !posting.BookedAt.Before(to) {
+			return nil, fmt.Errorf("posting %s booked outside the statement period", posting.ID)
+		}
+		balance += posting.Amount
+		if posting.Amount >= 0 {
+			s.TotalCredits += posting.Amount
+		} else {
+			s.TotalDebits -= posting.Amount
+		}
+		s.Entries = append(s.Entries, Entry{
+			PostingID:      posting.ID,
+			BookedAt:       posting.BookedAt.UTC(),
+			ValueDate:      interest.Date(posting.ValueDate),
+			Type:           posting.PostingType,
+			Reference:      posting.Reference,
+			Description:    posting.Description,
+			Amount:         posting.Amount,
+			RunningBalance: balance,
+		})
+	}
+	s.ClosingBalance = balance
+
+	for _, h := range p.Holds {
+		if !h.CreatedAt.Before(to) {
+			continue
+		}
+		if h.Status != models.HoldStatusActive && h.UpdatedAt.Before(to) {
+			continue
+		}
+		amount := h.RemainingAmount
+		if h.Status != models.HoldStatusActive {
+			// Released or captured after the period ended; show what was held
+			amount = h.Amount
+		}
+		s.Holds = append(s.Holds, Hold{
+			ID:        h.ID,
+			Type:      h.HoldType,
+			Amount:    amount,
+			Reason:    h.Reason,
+			Reference: h.Reference,
+			PlacedAt:  h.CreatedAt.UTC(),
+			ExpiresAt: h.ExpiresAt,
+		})
+	}
+
+	for _, a := range p.Accruals {
+		day := interest.Date(a.AccrualDate)
+		if day.Before(from) || !day.Before(to) {
+			continue
+		}
+		s.Interest.CreditMicros += a.AmountMicros
+		s.Interest.DebitMicros += a.DebitMicros
+	}
+	s.Interest.Credit = s.Interest.CreditMicros / interest.MicrosPerUnit
+	s.Interest.Debit = s.Interest.DebitMicros / interest.MicrosPerUnit
+
+	return s, nil
+}
+
+// TotalHeld returns the total amount of the holds on the statement
+func (s *Statement) TotalHeld() int64 {
+	var total int64
+	for _, h := range s.Holds {
+		total += h.Amount
+	}
+	return total
+}
+
+// Render renders the statement in the given format
+func Render(s *Statement, format models.StatementFormat) ([]byte, error) {
+	switch format {
+	case models.StatementFormatJSON:
+		return RenderJSON(s)
+	case models.StatementFormatCSV:
+		return RenderCSV(s)
+	case models.StatementFormatCAMT053:
+		return RenderCAMT053(s)
+	case models.StatementFormatPDF:
+		return RenderPDF(s)
+	}
+	return nil, fmt.Errorf("unsupported statement format: %s", format)
+}
@@ -0,0 +1,303 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	"github.com/google/uuid"
+)
+
+func mustDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t.Fatalf("bad date %q: %v", s, err)
+	}
+	return d
+}
+
+// testStatement builds a March 2024 statement with three postings, a hold
+// still active at month end and a month of accrued interest
+func testStatement(t *testing.T) *Statement {
+	t.Helper()
+	account := &models.Account{
+		ID:            uuid.MustParse("7b0f7a55-4d3e-4a8f-9a43-000000000001"),
+		AccountNumber: "ACC0000000001",
+		AccountType:   models.AccountTypeChecking,
+		Currency:      "EUR",
+	}
+	posting := func(id string, day string, hour int, amount int64, postingType models.PostingType, ref, desc string) *models.Posting {
+		booked := mustDate(t, day).Add(time.Duration(hour) * time.Hour)
+		return &models.Posting{
+			ID:          uuid.MustParse("7b0f7a55-4d3e-4a8f-9a43-0000000001" + id),
+			AccountID:   account.ID,
+			PostingType: postingType,
+			Amount:      amount,
+			Currency:    "EUR",
+			Reference:   ref,
+			Description: desc,
+			ValueDate:   mustDate(t, day),
+			BookedAt:    booked,
+		}
+	}
+	expires := mustDate(t, "2024-04-05")
+
+	s, err := Build(Params{
+		ID:      uuid.MustParse("7b0f7a55-4d3e-4a8f-9a43-000000000099"),
+		Version: 1,
+		Account: account,
+		Holder: Holder{
+			CustomerID:     uuid.MustParse("7b0f7a55-4d3e-4a8f-9a43-000000000050"),
+			CustomerNumber: "CUST-0001",
+			Name:           "Zoë O'Brien (Trading)",
+			AddressLines:   []string{"1 Rue de la Paix", "75002 Paris"},
+			Country:        "FR",
+		},
+		PeriodStart:    mustDate(t, "2024-03-01"),
+		PeriodEnd:      mustDate(t, "2024-03-31"),
+		OpeningBalance: 10000,
+		Postings: []*models.Posting{
+			posting("01", "2024-03-01", 9, 250050, models.PostingTypeCredit, "SALARY-03", "Salary, March"),
+			posting("02", "2024-03-15", 12, -120000, models.PostingTypeDebit, "RENT-03", "Rent"),
+			posting("03", "2024-03-31", 23, -500, models.PostingTypeFee, "MAINTENANCE-202403", "Monthly maintenance fee"),
+		},
+		Holds: []*models.Hold{
+			{ID: uuid.New(), HoldType: models.HoldTypeCardAuthorization, Amount: 4000, RemainingAmount: 2500,
+				Status: models.HoldStatusActive, Reason: "Card", Reference: "AUTH-1", ExpiresAt: &expires,
+				CreatedAt: mustDate(t, "2024-03-30"), UpdatedAt: mustDate(t, "2024-03-30")},
+			{ID: uuid.New(), HoldType: models.HoldTypePayment, Amount: 1000, RemainingAmount: 0,
+				Status: models.HoldStatusReleased, CreatedAt: mustDate(t, "2024-03-02"), UpdatedAt: mustDate(t, "2024-03-03")},
+			{ID: uuid.New(), HoldType: models.HoldTypeManual, Amount: 700, RemainingAmount: 700,
+				Status: models.HoldStatusActive, CreatedAt: mustDate(t, "2024-04-02"), UpdatedAt: mustDate(t, "2024-04-02")},
+		},
+		Accruals: []*models.InterestAccrual{
+			{AccrualDate: mustDate(t, "2024-02-29"), AmountMicros: 9_000_000},
+			{AccrualDate: mustDate(t, "2024-03-01"), AmountMicros: 1_500_000},
+			{AccrualDate: mustDate(t, "2024-03-31"), AmountMicros: 1_700_000, DebitMicros: 250_000},
+		},
+		GeneratedAt: time.Date(2024, 4, 1, 2, 30, 15, 123, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	return s
+}
+
+func TestBuild(t *testing.T) {
+	s := testStatement(t)
+
+	wantRunning := []int64{260050, 140050, 139550}
+	if len(s.Entries) != len(wantRunning) {
+		t.Fatalf("Build() entries = %d, want %d", len(s.Entries), len(wantRunning))
+	}
+	for i, want := range wantRunning {
+		if s.Entries[i].RunningBalance != want {
+			t.Errorf("entry %d running balance = %d, want %d", i, s.Entries[i].RunningBalance, want)
+		}
+	}
+	if s.ClosingBalance != 139550 || s.TotalCredits != 250050 || s.TotalDebits != 120500 {
+		t.Errorf("Build() closing = %d, credits = %d, debits = %d", s.ClosingBalance, s.TotalCredits, s.TotalDebits)
+	}
+
+	// Only the hold placed in the period and still active at its end counts
+	if len(s.Holds) != 1 || s.Holds[0].Amount != 2500 || s.TotalHeld() != 2500 {
+		t.Errorf("Build() holds = %+v, want the card authorization's 2500 remaining", s.Holds)
+	}
+	if s.Interest.CreditMicros != 3_200_000 || s.Interest.Credit != 3 || s.Interest.DebitMicros != 250_000 || s.Interest.Debit != 0 {
+		t.Errorf("Build() interest = %+v", s.Interest)
+	}
+	if !s.GeneratedAt.Equal(time.Date(2024, 4, 1, 2, 30, 15, 0, time.UTC)) {
+		t.Errorf("Build() generated at = %v, want truncated to the second", s.GeneratedAt)
+	}
+}
+
+func TestBuild_RejectsPostingsOutsidePeriod(t *testing.T) {
+	_, err := Build(Params{
+		Account:     &models.Account{Currency: "USD"},
+		PeriodStart: mustDate(t, "2024-03-01"),
+		PeriodEnd:   mustDate(t, "2024-03-31"),
+		Postings:    []*models.Posting{{ID: uuid.New(), Amount: 1, BookedAt: mustDate(t, "2024-04-01")}},
+	})
+	if err == nil {
+		t.Error("Build() error = nil, want posting outside period rejected")
+	}
+
+	_, err = Build(Params{
+		Account:     &models.Account{Currency: "USD"},
+		PeriodStart: mustDate(t, "2024-03-31"),
+		PeriodEnd:   mustDate(t, "2024-03-01"),
+	})
+	if err == nil {
+		t.Error("Build() error = nil, want inverted period rejected")
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{123456, "USD", "1234.56"},
+		{-5, "EUR", "-0.05"},
+		{0, "GBP", "0.00"},
+		{1500, "JPY", "1500"},
+		{-1234, "KWD", "-1.234"},
+		{-9223372036854775808, "USD", "-92233720368547758.08"},
+	}
+	for _, tt := range tests {
+		if got := FormatAmount(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("FormatAmount(%d, %s) = %q, want %q", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	s := testStatement(t)
+	out, err := RenderJSON(s)
+	if err != nil {
+		t.Fatalf("RenderJSON() error = %v", err)
+	}
+
+	var decoded Statement
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("RenderJSON() produced invalid JSON: %v", err)
+	}
+	if decoded.ClosingBalance != s.ClosingBalance || len(decoded.Entries) != len(s.Entries) || decoded.Holder.Name != s.Holder.Name {
+		t.Errorf("RenderJSON() round trip = %+v", decoded)
+	}
+}
+
+func TestRenderCSV(t *testing.T) {
+	out, err := RenderCSV(testStatement(t))
+	if err != nil {
+		t.Fatalf("RenderCSV() error = %v", err)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("RenderCSV() produced invalid CSV: %v", err)
+	}
+	wantKinds := []string{"record", csvOpening, csvEntry, csvEntry, csvEntry, csvClosing, csvHold, csvInterest, csvInterest}
+	if len(rows) != len(wantKinds) {
+		t.Fatalf("RenderCSV() rows = %d, want %d", len(rows), len(wantKinds))
+	}
+	for i, kind := range wantKinds {
+		if rows[i][0] != kind {
+			t.Errorf("row %d kind = %s, want %s", i, rows[i][0], kind)
+		}
+	}
+	if rows[1][7] != "100.00" || rows[2][5] != "Salary, March" || rows[3][6] != "-1200.00" || rows[5][7] != "1395.50" {
+		t.Errorf("RenderCSV() rows = %v", rows)
+	}
+}
+
+func TestRenderCAMT053(t *testing.T) {
+	out, err := RenderCAMT053(testStatement(t))
+	if err != nil {
+		t.Fatalf("RenderCAMT053() error = %v", err)
+	}
+
+	var doc camtDocument
+	if err := xml.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("RenderCAMT053() produced invalid XML: %v", err)
+	}
+	if doc.XMLName.Space != CAMT053Namespace {
+		t.Errorf("namespace = %q, want %q", doc.XMLName.Space, CAMT053Namespace)
+	}
+
+	stmt := doc.Stmt.Stmt
+	if len(stmt.Bal) != 2 || stmt.Bal[0].Tp != "OPBD" || stmt.Bal[0].Amt.Value != "100.00" ||
+		stmt.Bal[1].Tp != "CLBD" || stmt.Bal[1].Amt.Value != "1395.50" || stmt.Bal[1].CdtDbtInd != "CRDT" {
+		t.Errorf("balances = %+v", stmt.Bal)
+	}
+	if len(stmt.Ntry) != 3 || stmt.Ntry[1].CdtDbtInd != "DBIT" || stmt.Ntry[1].Amt.Value != "1200.00" ||
+		stmt.Ntry[1].Amt.Ccy != "EUR" || stmt.Ntry[1].NtryDtls.EndToEndID != "RENT-03" {
+		t.Errorf("entries = %+v", stmt.Ntry)
+	}
+	if stmt.TxsSummry.TtlCdtNtries.NbOfNtries != 1 || stmt.TxsSummry.TtlDbtNtries.Sum != "1205.00" ||
+		stmt.TxsSummry.TtlNtries.TtlNetNtry.Amt != "1295.50" {
+		t.Errorf("summary = %+v", stmt.TxsSummry)
+	}
+	if stmt.Acct.Ownr.PstlAdr == nil || stmt.Acct.Ownr.PstlAdr.Ctry != "FR" || stmt.Acct.Ownr.Nm != "Zoë O'Brien (Trading)" {
+		t.Errorf("owner = %+v", stmt.Acct.Ownr)
+	}
+	if stmt.Acct.ID.IBAN != "" || stmt.Acct.ID.Othr == nil || stmt.Acct.ID.Othr.ID != "ACC0000000001" {
+		t.Errorf("account id = %+v, want the internal number", stmt.Acct.ID)
+	}
+
+	// Accounts with an IBAN are identified by it
+	withIBAN := testStatement(t)
+	withIBAN.Account.IBAN = "FR1420041010050500013M02606"
+	out, err = RenderCAMT053(withIBAN)
+	if err != nil {
+		t.Fatalf("RenderCAMT053() error = %v", err)
+	}
+	doc = camtDocument{}
+	if err := xml.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("RenderCAMT053() produced invalid XML: %v", err)
+	}
+	if id := doc.Stmt.Stmt.Acct.ID; id.IBAN != "FR1420041010050500013M02606" || id.Othr != nil {
+		t.Errorf("account id = %+v, want the IBAN", id)
+	}
+}
+
+func TestRenderPDF(t *testing.T) {
+	s := testStatement(t)
+	out, err := RenderPDF(s)
+	if err != nil {
+		t.Fatalf("RenderPDF() error = %v", err)
+	}
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Error("RenderPDF() output is not framed as a PDF document")
+	}
+	for _, want := range []string{"(Zo\\353 O'Brien \\(Trading\\)) Tj", "(1395.50) Tj", "(Page 1 of 1) Tj", "/BaseFont /Helvetica"} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("RenderPDF() output missing %q", want)
+		}
+	}
+
+	again, _ := RenderPDF(s)
+	if !bytes.Equal(out, again) {
+		t.Error("RenderPDF() is not deterministic")
+	}
+}
+
+func TestRenderPDF_Paginates(t *testing.T) {
+	s := testStatement(t)
+	for i := 0; i < 150; i++ {
+		s.Entries = append(s.Entries, s.Entries[0])
+	}
+
+	out, err := RenderPDF(s)
+	if err != nil {
+		t.Fatalf("RenderPDF() error = %v", err)
+	}
+	if !bytes.Contains(out, []byte("/Count 4")) || !bytes.Contains(out, []byte("(Page 4 of 4) Tj")) {
+		t.Error("RenderPDF() did not spread 153 entries over four pages")
+	}
+
+	// The cross-reference table must point at each object
+	xref := bytes.LastIndex(out, []byte("\nxref\n"))
+	lines := strings.Split(string(out[xref+1:]), "\n")
+	for i, line := range lines[3:] {
+		if strings.HasPrefix(line, "trailer") {
+			break
+		}
+		offset, err := strconv.Atoi(line[:10])
+		if err != nil {
+			t.Fatalf("bad xref line %q", line)
+		}
+		if !bytes.HasPrefix(out[offset:], []byte(strconv.Itoa(i+1)+" 0 obj")) {
+			t.Errorf("xref entry %d points at %q", i+1, out[offset:offset+10])
+		}
+	}
+}
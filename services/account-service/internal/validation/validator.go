@@ -0,0 +1,672 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/interest"
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/google/uuid"
+)
+
+// ValidationError represents a validation error with field details
+type ValidationError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors represents a collection of validation errors
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	if len(e) == 0 {
+		return ""
+	}
+	result := "validation failed: "
+	for i, err := range e {
+		if i > 0 {
+			result += ", "
+		}
+		result += err.Error()
+	}
+	return result
+}
+
+// CurrencyRegex validates ISO 4217 alphabetic currency codes
+var CurrencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// FXPairRegex validates currency pairs written BASE/QUOTE
+var FXPairRegex = regexp.MustCompile(`^[A-Z]{3}/[A-Z]{3}$`)
+
+// CountryRegex validates ISO 3166 alpha-2 country codes
+var CountryRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// MaxAMLAlertListSize bounds how many alerts one ListAMLAlerts call returns
+const MaxAMLAlertListSize = 1000
+
+// Validator provides validation methods for account data
+type Validator struct{}
+
+// NewValidator creates a new Validator instance
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// ValidateOpenAccount validates account opening data
+func (v *Validator) ValidateOpenAccount(req *accountpb.OpenAccountRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetAccountType() == "" {
+		errs = append(errs, ValidationError{Field: "account_type", Message: "is required"})
+	} else if !models.AccountType(req.GetAccountType()).IsValid() {
+		errs = append(errs, ValidationError{Field: "account_type", Message: "must be Checking or Savings"})
+	}
+
+	if !CurrencyRegex.MatchString(req.GetCurrency()) {
+		errs = append(errs, ValidationError{Field: "currency", Message: "must be a 3-letter ISO 4217 code"})
+	}
+
+	if len(req.GetSegment()) > 50 {
+		errs = append(errs, ValidationError{Field: "segment", Message: "must not exceed 50 characters"})
+	}
+
+	if req.GetSigningRule() != "" && !models.SigningRule(req.GetSigningRule()).AllowedFor(models.PartyRolePrimaryHolder) {
+		errs = append(errs, ValidationError{Field: "signing_rule", Message: "must be AnyOne, All or TwoOf"})
+	}
+
+	return errs
+}
+
+// ValidatePlaceHold validates hold placement data
+func (v *Validator) ValidatePlaceHold(req *accountpb.PlaceHoldRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetAccountId() == "" {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "is required"})
+	}
+
+	if req.GetHoldType() == "" {
+		errs = append(errs, ValidationError{Field: "hold_type", Message: "is required"})
+	} else if !models.HoldType(req.GetHoldType()).IsValid() {
+		errs = append(errs, ValidationError{Field: "hold_type", Message: "is invalid hold type"})
+	}
+
+	if req.GetAmount() <= 0 {
+		errs = append(errs, ValidationError{Field: "amount", Message: "must be positive"})
+	}
+
+	if !CurrencyRegex.MatchString(req.GetCurrency()) {
+		errs = append(errs, ValidationError{Field: "currency", Message: "must be a 3-letter ISO 4217 code"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 255 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 255 characters"})
+	}
+
+	if len(req.GetReference()) > 255 {
+		errs = append(errs, ValidationError{Field: "reference", Message: "must not exceed 255 characters"})
+	}
+
+	if models.HoldType(req.GetHoldType()).IsLegal() && req.GetReference() == "" {
+		errs = append(errs, ValidationError{Field: "reference", Message: "is required for legal holds"})
+	}
+
+	if req.GetExpiresAt() != nil && !req.GetExpiresAt().AsTime().After(time.Now()) {
+		errs = append(errs, ValidationError{Field: "expires_at", Message: "must be in the future"})
+	}
+
+	return errs
+}
+
+// ValidateSetOverdraftLimit validates arranged overdraft data
+func (v *Validator) ValidateSetOverdraftLimit(req *accountpb.SetOverdraftLimitRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetAccountId() == "" {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "is required"})
+	}
+
+	if req.GetLimit() < 0 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must not be negative"})
+	}
+
+	if req.GetDebitRate() != "" {
+		rate, err := interest.ParseRate(req.GetDebitRate())
+		if err != nil {
+			errs = append(errs, ValidationError{Field: "debit_rate", Message: "must be a percentage with at most 4 decimal places"})
+		} else if rate < 0 || rate > interest.RateScale {
+			errs = append(errs, ValidationError{Field: "debit_rate", Message: "must be between 0 and 100"})
+		}
+	}
+
+	return errs
+}
+
+// ValidateTransfer validates transfer data
+func (v *Validator) ValidateTransfer(req *accountpb.TransferRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetFromAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "from_account_id", Message: "must be a valid UUID"})
+	}
+
+	if _, err := uuid.Parse(req.GetToAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "to_account_id", Message: "must be a valid UUID"})
+	} else if req.GetToAccountId() == req.GetFromAccountId() {
+		errs = append(errs, ValidationError{Field: "to_account_id", Message: "must differ from from_account_id"})
+	}
+
+	if req.GetAmount() <= 0 {
+		errs = append(errs, ValidationError{Field: "amount", Message: "must be positive"})
+	}
+
+	if !CurrencyRegex.MatchString(req.GetCurrency()) {
+		errs = append(errs, ValidationError{Field: "currency", Message: "must be a 3-letter ISO 4217 code"})
+	}
+
+	if len(req.GetReference()) > 255 {
+		errs = append(errs, ValidationError{Field: "reference", Message: "must not exceed 255 characters"})
+	}
+
+	if len(req.GetDescription()) > 255 {
+		errs = append(errs, ValidationError{Field: "description", Message: "must not exceed 255 characters"})
+	}
+
+	if req.GetChannel() != "" && !models.Channel(req.GetChannel()).IsValid() {
+		errs = append(errs, ValidationError{Field: "channel", Message: "must be Branch, Online, Mobile, API or Batch"})
+	}
+
+	if req.GetCounterpartyCountry() != "" && !CountryRegex.MatchString(req.GetCounterpartyCountry()) {
+		errs = append(errs, ValidationError{Field: "counterparty_country", Message: "must be a 2-letter ISO 3166 code"})
+	}
+
+	if len(req.GetIdempotencyKey()) > 255 {
+		errs = append(errs, ValidationError{Field: "idempotency_key", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// ValidateSetFeeRule validates fee schedule data
+func (v *Validator) ValidateSetFeeRule(req *accountpb.SetFeeRuleRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if !models.AccountType(req.GetAccountType()).IsValid() {
+		errs = append(errs, ValidationError{Field: "account_type", Message: "must be Checking or Savings"})
+	}
+
+	if !models.FeeType(req.GetFeeType()).IsValid() {
+		errs = append(errs, ValidationError{Field: "fee_type", Message: "is invalid fee type"})
+	}
+
+	if !CurrencyRegex.MatchString(req.GetCurrency()) {
+		errs = append(errs, ValidationError{Field: "currency", Message: "must be a 3-letter ISO 4217 code"})
+	}
+
+	if req.GetAmount() < 0 {
+		errs = append(errs, ValidationError{Field: "amount", Message: "must not be negative"})
+	}
+
+	for _, segment := range req.GetWaiverSegments() {
+		if segment == "" || len(segment) > 50 {
+			errs = append(errs, ValidationError{Field: "waiver_segments", Message: "must be non-empty and not exceed 50 characters"})
+			break
+		}
+	}
+
+	return errs
+}
+
+// ValidateAddAccountParty validates account party data
+func (v *Validator) ValidateAddAccountParty(req *accountpb.AddAccountPartyRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "must be a valid UUID"})
+	}
+
+	if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	role := models.PartyRole(req.GetRole())
+	if !role.IsValid() {
+		errs = append(errs, ValidationError{Field: "role", Message: "is invalid party role"})
+	} else if req.GetSigningRule() != "" && !models.SigningRule(req.GetSigningRule()).AllowedFor(role) {
+		if role.CanSign() {
+			errs = append(errs, ValidationError{Field: "signing_rule", Message: "must be AnyOne, All or TwoOf"})
+		} else {
+			errs = append(errs, ValidationError{Field: "signing_rule", Message: "must be None for " + string(role)})
+		}
+	}
+
+	if req.GetStartDate() != nil && req.GetEndDate() != nil &&
+		req.GetEndDate().AsTime().Before(interest.Date(req.GetStartDate().AsTime())) {
+		errs = append(errs, ValidationError{Field: "end_date", Message: "must not be before start_date"})
+	}
+
+	return errs
+}
+
+// ValidateGenerateStatement validates statement generation data
+func (v *Validator) ValidateGenerateStatement(req *accountpb.GenerateStatementRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetPeriodStart() == nil {
+		errs = append(errs, ValidationError{Field: "period_start", Message: "is required"})
+	}
+
+	if req.GetPeriodEnd() == nil {
+		errs = append(errs, ValidationError{Field: "period_end", Message: "is required"})
+	} else if req.GetPeriodStart() != nil &&
+		interest.Date(req.GetPeriodEnd().AsTime()).Before(interest.Date(req.GetPeriodStart().AsTime())) {
+		errs = append(errs, ValidationError{Field: "period_end", Message: "must not be before period_start"})
+	}
+
+	if len(req.GetGeneratedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "generated_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// MaxBankStatementSize bounds the size of an imported bank statement file. It
+// stays under the gRPC server's 4MB message limit.
+const MaxBankStatementSize = 4<<20 - 64<<10
+
+// ValidateImportBankStatement validates bank statement import data
+func (v *Validator) ValidateImportBankStatement(req *accountpb.ImportBankStatementRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetFormat() != "" && !models.BankStatementFormat(req.GetFormat()).IsValid() {
+		errs = append(errs, ValidationError{Field: "format", Message: "must be MT940 or CAMT053"})
+	}
+
+	if len(req.GetContent()) == 0 {
+		errs = append(errs, ValidationError{Field: "content", Message: "is required"})
+	} else if len(req.GetContent()) > MaxBankStatementSize {
+		errs = append(errs, ValidationError{Field: "content", Message: fmt.Sprintf("must not exceed %d bytes", MaxBankStatementSize)})
+	}
+
+	if len(req.GetImportedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "imported_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// ValidateManualMatch validates manual reconciliation match data
+func (v *Validator) ValidateManualMatch(req *accountpb.ManualMatchRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "must be a valid UUID"})
+	}
+
+	if len(req.GetLineIds()) == 0 && len(req.GetPostingIds()) == 0 {
+		errs = append(errs, ValidationError{Field: "line_ids", Message: "at least one line or posting is required"})
+	}
+	errs = append(errs, validateIDList("line_ids", req.GetLineIds())...)
+	errs = append(errs, validateIDList("posting_ids", req.GetPostingIds())...)
+
+	if req.GetMatchedBy() == "" {
+		errs = append(errs, ValidationError{Field: "matched_by", Message: "is required"})
+	} else if len(req.GetMatchedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "matched_by", Message: "must not exceed 255 characters"})
+	}
+
+	if len(req.GetNote()) > 500 {
+		errs = append(errs, ValidationError{Field: "note", Message: "must not exceed 500 characters"})
+	}
+
+	return errs
+}
+
+// ValidateWriteOffBreak validates reconciliation write-off data
+func (v *Validator) ValidateWriteOffBreak(req *accountpb.WriteOffBreakRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	accountID, err := uuid.Parse(req.GetAccountId())
+	if err != nil {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "must be a valid UUID"})
+	}
+
+	switch {
+	case (req.GetLineId() == "") == (req.GetPostingId() == ""):
+		errs = append(errs, ValidationError{Field: "line_id", Message: "exactly one of line_id and posting_id is required"})
+	case req.GetLineId() != "":
+		if _, err := uuid.Parse(req.GetLineId()); err != nil {
+			errs = append(errs, ValidationError{Field: "line_id", Message: "must be a valid UUID"})
+		}
+	default:
+		if _, err := uuid.Parse(req.GetPostingId()); err != nil {
+			errs = append(errs, ValidationError{Field: "posting_id", Message: "must be a valid UUID"})
+		}
+	}
+
+	if offsetID, err := uuid.Parse(req.GetOffsetAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "offset_account_id", Message: "must be a valid UUID"})
+	} else if offsetID == accountID {
+		errs = append(errs, ValidationError{Field: "offset_account_id", Message: "must differ from account_id"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	if req.GetWrittenOffBy() == "" {
+		errs = append(errs, ValidationError{Field: "written_off_by", Message: "is required"})
+	} else if len(req.GetWrittenOffBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "written_off_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// MaxFXRateTableSize bounds the size of a rate table sent over gRPC
+const MaxFXRateTableSize = MaxBankStatementSize
+
+// ValidateSetFXRates validates exchange rate data. The rates themselves are
+// checked when they are parsed.
+func (v *Validator) ValidateSetFXRates(req *accountpb.SetFXRatesRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if (len(req.GetRates()) == 0) == (len(req.GetTable()) == 0) {
+		errs = append(errs, ValidationError{Field: "rates", Message: "exactly one of rates and table is required"})
+	}
+
+	if len(req.GetTable()) > MaxFXRateTableSize {
+		errs = append(errs, ValidationError{Field: "table", Message: fmt.Sprintf("must not exceed %d bytes", MaxFXRateTableSize)})
+	}
+
+	if req.GetFormat() != "" && req.GetFormat() != "CSV" && req.GetFormat() != "JSON" {
+		errs = append(errs, ValidationError{Field: "format", Message: "must be CSV or JSON"})
+	}
+
+	if req.GetCreatedBy() == "" {
+		errs = append(errs, ValidationError{Field: "created_by", Message: "is required"})
+	} else if len(req.GetCreatedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "created_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// ValidateSetFXPositionAccount validates FX position account data
+func (v *Validator) ValidateSetFXPositionAccount(req *accountpb.SetFXPositionAccountRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if !FXPairRegex.MatchString(req.GetCurrencyPair()) {
+		errs = append(errs, ValidationError{Field: "currency_pair", Message: "must be two ISO 4217 codes in alphabetical order, e.g. EUR/USD"})
+	} else if base, quote := req.GetCurrencyPair()[:3], req.GetCurrencyPair()[4:]; base >= quote {
+		errs = append(errs, ValidationError{Field: "currency_pair", Message: "must be two ISO 4217 codes in alphabetical order, e.g. EUR/USD"})
+	}
+
+	if _, err := uuid.Parse(req.GetAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "account_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetUpdatedBy() == "" {
+		errs = append(errs, ValidationError{Field: "updated_by", Message: "is required"})
+	} else if len(req.GetUpdatedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "updated_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// ValidateCreateFXQuote validates FX quote data
+func (v *Validator) ValidateCreateFXQuote(req *accountpb.CreateFXQuoteRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetFromAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "from_account_id", Message: "must be a valid UUID"})
+	}
+
+	if _, err := uuid.Parse(req.GetToAccountId()); err != nil {
+		errs = append(errs, ValidationError{Field: "to_account_id", Message: "must be a valid UUID"})
+	} else if req.GetToAccountId() == req.GetFromAccountId() {
+		errs = append(errs, ValidationError{Field: "to_account_id", Message: "must differ from from_account_id"})
+	}
+
+	switch {
+	case req.GetSourceAmount() < 0:
+		errs = append(errs, ValidationError{Field: "source_amount", Message: "must not be negative"})
+	case req.GetTargetAmount() < 0:
+		errs = append(errs, ValidationError{Field: "target_amount", Message: "must not be negative"})
+	case (req.GetSourceAmount() == 0) == (req.GetTargetAmount() == 0):
+		errs = append(errs, ValidationError{Field: "source_amount", Message: "exactly one of source_amount and target_amount is required"})
+	}
+
+	if len(req.GetRequestedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// ValidateExecuteFXQuote validates FX quote execution data
+func (v *Validator) ValidateExecuteFXQuote(req *accountpb.ExecuteFXQuoteRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetQuoteId()); err != nil {
+		errs = append(errs, ValidationError{Field: "quote_id", Message: "must be a valid UUID"})
+	}
+
+	if len(req.GetReference()) > 255 {
+		errs = append(errs, ValidationError{Field: "reference", Message: "must not exceed 255 characters"})
+	}
+
+	if len(req.GetDescription()) > 255 {
+		errs = append(errs, ValidationError{Field: "description", Message: "must not exceed 255 characters"})
+	}
+
+	if len(req.GetExecutedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "executed_by", Message: "must not exceed 255 characters"})
+	}
+
+	if req.GetChannel() != "" && !models.Channel(req.GetChannel()).IsValid() {
+		errs = append(errs, ValidationError{Field: "channel", Message: "must be Branch, Online, Mobile, API or Batch"})
+	}
+
+	return errs
+}
+
+// MaxLimitIncreaseDuration bounds how long a temporary limit increase can last
+const MaxLimitIncreaseDuration = 90 * 24 * time.Hour
+
+// ValidateSetLimitRule validates transaction limit data
+func (v *Validator) ValidateSetLimitRule(req *accountpb.SetLimitRuleRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if !models.LimitKind(req.GetKind()).IsValid() {
+		errs = append(errs, ValidationError{Field: "kind", Message: "must be PerTransaction, DailyAmount, MonthlyAmount, DailyCount or MonthlyCount"})
+	}
+
+	if req.GetChannel() != "" && !models.Channel(req.GetChannel()).IsValid() {
+		errs = append(errs, ValidationError{Field: "channel", Message: "must be Branch, Online, Mobile, API or Batch"})
+	}
+
+	if req.GetTransactionType() != "" && !models.TransactionType(req.GetTransactionType()).IsValid() {
+		errs = append(errs, ValidationError{Field: "transaction_type", Message: "must be Transfer or FXConversion"})
+	}
+
+	if req.GetRiskTier() != "" && !models.RiskTier(req.GetRiskTier()).IsValid() {
+		errs = append(errs, ValidationError{Field: "risk_tier", Message: "must be Low, Medium or High"})
+	}
+
+	if !CurrencyRegex.MatchString(req.GetCurrency()) {
+		errs = append(errs, ValidationError{Field: "currency", Message: "must be a 3-letter ISO 4217 code"})
+	}
+
+	if req.GetValue() < 0 {
+		errs = append(errs, ValidationError{Field: "value", Message: "must not be negative"})
+	}
+
+	if req.GetCreatedBy() == "" {
+		errs = append(errs, ValidationError{Field: "created_by", Message: "is required"})
+	} else if len(req.GetCreatedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "created_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// ValidateGrantLimitIncrease validates temporary limit increase data
+func (v *Validator) ValidateGrantLimitIncrease(req *accountpb.GrantLimitIncreaseRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	if _, err := uuid.Parse(req.GetLimitRuleId()); err != nil {
+		errs = append(errs, ValidationError{Field: "limit_rule_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetValue() <= 0 {
+		errs = append(errs, ValidationError{Field: "value", Message: "must be positive"})
+	}
+
+	startsAt := time.Now()
+	if req.GetStartsAt() != nil {
+		startsAt = req.GetStartsAt().AsTime()
+	}
+	switch {
+	case req.GetExpiresAt() == nil:
+		errs = append(errs, ValidationError{Field: "expires_at", Message: "is required"})
+	case !req.GetExpiresAt().AsTime().After(startsAt):
+		errs = append(errs, ValidationError{Field: "expires_at", Message: "must be after starts_at"})
+	case !req.GetExpiresAt().AsTime().After(time.Now()):
+		errs = append(errs, ValidationError{Field: "expires_at", Message: "must be in the future"})
+	case req.GetExpiresAt().AsTime().Sub(startsAt) > MaxLimitIncreaseDuration:
+		errs = append(errs, ValidationError{Field: "expires_at", Message: "must be within 90 days of starts_at"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	if req.GetGrantedBy() == "" {
+		errs = append(errs, ValidationError{Field: "granted_by", Message: "is required"})
+	} else if len(req.GetGrantedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "granted_by", Message: "must not exceed 255 characters"})
+	}
+
+	return errs
+}
+
+// ValidateListAMLAlerts validates monitoring alert filters
+func (v *Validator) ValidateListAMLAlerts(req *accountpb.ListAMLAlertsRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() != "" {
+		if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+			errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+		}
+	}
+
+	if req.GetStatus() != "" && !models.AMLAlertStatus(req.GetStatus()).IsValid() {
+		errs = append(errs, ValidationError{Field: "status", Message: "must be Open, Escalated or Dismissed"})
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > MaxAMLAlertListSize {
+		errs = append(errs, ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 0 and %d", MaxAMLAlertListSize)})
+	}
+
+	return errs
+}
+
+// ValidateCloseAMLAlert validates the outcome of an alert investigation
+func (v *Validator) ValidateCloseAMLAlert(req *accountpb.CloseAMLAlertRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		errs = append(errs, ValidationError{Field: "id", Message: "must be a valid UUID"})
+	}
+
+	status := models.AMLAlertStatus(req.GetStatus())
+	if status != models.AMLAlertStatusEscalated && status != models.AMLAlertStatusDismissed {
+		errs = append(errs, ValidationError{Field: "status", Message: "must be Escalated or Dismissed"})
+	}
+
+	if req.GetClosedBy() == "" {
+		errs = append(errs, ValidationError{Field: "closed_by", Message: "is required"})
+	} else if len(req.GetClosedBy()) > 255 {
+		errs = append(errs, ValidationError{Field: "closed_by", Message: "must not exceed 255 characters"})
+	}
+
+	if req.GetResolution() == "" {
+		errs = append(errs, ValidationError{Field: "resolution", Message: "is required"})
+	} else if len(req.GetResolution()) > 2000 {
+		errs = append(errs, ValidationError{Field: "resolution", Message: "must not exceed 2000 characters"})
+	}
+
+	return errs
+}
+
+// validateIDList checks every entry is a UUID and none repeats
+func validateIDList(field string, ids []string) ValidationErrors {
+	var errs ValidationErrors
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, s := range ids {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf("%q is not a valid UUID", s)})
+			continue
+		}
+		if seen[id] {
+			errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf("%s is listed twice", id)})
+		}
+		seen[id] = true
+	}
+	return errs
+}
+
+// ValidateStatusTransition validates account status transition rules
+func (v *Validator) ValidateStatusTransition(currentStatus, newStatus models.AccountStatus) error {
+	validTransitions := map[models.AccountStatus][]models.AccountStatus{
+		models.AccountStatusActive: {models.AccountStatusFrozen, models.AccountStatusClosed},
+		models.AccountStatusFrozen: {models.AccountStatusActive},
+		models.AccountStatusClosed: {}, // No transitions from Closed
+	}
+
+	allowedTransitions, exists := validTransitions[currentStatus]
+	if !exists {
+		return fmt.Errorf("invalid current status: %s", currentStatus)
+	}
+
+	for _, allowed := range allowedTransitions {
+		if allowed == newStatus {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid status transition from %s to %s", currentStatus, newStatus)
+}
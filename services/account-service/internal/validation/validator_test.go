@@ -0,0 +1,570 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/account-service/internal/models"
+	accountpb "github.com/core-banking/services/account-service/internal/proto/accountpb"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidateOpenAccount(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.OpenAccountRequest
+		wantErr bool
+	}{
+		{
+			name: "valid request",
+			req: &accountpb.OpenAccountRequest{
+				CustomerId:  uuid.New().String(),
+				AccountType: "Checking",
+				Currency:    "USD",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid customer id",
+			req: &accountpb.OpenAccountRequest{
+				CustomerId:  "not-a-uuid",
+				AccountType: "Checking",
+				Currency:    "USD",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid account type",
+			req: &accountpb.OpenAccountRequest{
+				CustomerId:  uuid.New().String(),
+				AccountType: "Brokerage",
+				Currency:    "USD",
+			},
+			wantErr: true,
+		},
+		{
+			name: "lowercase currency",
+			req: &accountpb.OpenAccountRequest{
+				CustomerId:  uuid.New().String(),
+				AccountType: "Savings",
+				Currency:    "usd",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateOpenAccount(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateOpenAccount() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateOpenAccount() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidatePlaceHold(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.PlaceHoldRequest
+		wantErr bool
+	}{
+		{
+			name: "valid card authorization",
+			req: &accountpb.PlaceHoldRequest{
+				AccountId: uuid.New().String(),
+				HoldType:  "CardAuthorization",
+				Amount:    2500,
+				Currency:  "USD",
+				Reason:    "POS authorization",
+				Reference: "AUTH123",
+				ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+			},
+			wantErr: false,
+		},
+		{
+			name: "legal hold without reference",
+			req: &accountpb.PlaceHoldRequest{
+				AccountId: uuid.New().String(),
+				HoldType:  "LegalGarnishment",
+				Amount:    2500,
+				Currency:  "USD",
+				Reason:    "Court order",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-positive amount",
+			req: &accountpb.PlaceHoldRequest{
+				AccountId: uuid.New().String(),
+				HoldType:  "Manual",
+				Amount:    0,
+				Currency:  "USD",
+				Reason:    "Review",
+			},
+			wantErr: true,
+		},
+		{
+			name: "expiry in the past",
+			req: &accountpb.PlaceHoldRequest{
+				AccountId: uuid.New().String(),
+				HoldType:  "Manual",
+				Amount:    100,
+				Currency:  "USD",
+				Reason:    "Review",
+				ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidatePlaceHold(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidatePlaceHold() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidatePlaceHold() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateSetOverdraftLimit(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.SetOverdraftLimitRequest
+		wantErr bool
+	}{
+		{"valid limit and rate", &accountpb.SetOverdraftLimitRequest{AccountId: uuid.New().String(), Limit: 50000, DebitRate: "19.9"}, false},
+		{"removing the facility", &accountpb.SetOverdraftLimitRequest{AccountId: uuid.New().String()}, false},
+		{"negative limit", &accountpb.SetOverdraftLimitRequest{AccountId: uuid.New().String(), Limit: -1}, true},
+		{"rate above 100 percent", &accountpb.SetOverdraftLimitRequest{AccountId: uuid.New().String(), Limit: 100, DebitRate: "100.5"}, true},
+		{"too many decimal places", &accountpb.SetOverdraftLimitRequest{AccountId: uuid.New().String(), Limit: 100, DebitRate: "1.23456"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateSetOverdraftLimit(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateSetOverdraftLimit() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateSetOverdraftLimit() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateTransfer(t *testing.T) {
+	validator := NewValidator()
+	from, to := uuid.New().String(), uuid.New().String()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.TransferRequest
+		wantErr bool
+	}{
+		{"valid transfer", &accountpb.TransferRequest{FromAccountId: from, ToAccountId: to, Amount: 1000, Currency: "USD"}, false},
+		{"same account", &accountpb.TransferRequest{FromAccountId: from, ToAccountId: from, Amount: 1000, Currency: "USD"}, true},
+		{"non-positive amount", &accountpb.TransferRequest{FromAccountId: from, ToAccountId: to, Amount: 0, Currency: "USD"}, true},
+		{"invalid currency", &accountpb.TransferRequest{FromAccountId: from, ToAccountId: to, Amount: 1000, Currency: "usd"}, true},
+		{"with counterparty country", &accountpb.TransferRequest{FromAccountId: from, ToAccountId: to, Amount: 1000, Currency: "USD", CounterpartyCountry: "GB"}, false},
+		{"invalid counterparty country", &accountpb.TransferRequest{FromAccountId: from, ToAccountId: to, Amount: 1000, Currency: "USD", CounterpartyCountry: "GBR"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateTransfer(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateTransfer() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateTransfer() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateAddAccountParty(t *testing.T) {
+	validator := NewValidator()
+	accountID, customerID := uuid.New().String(), uuid.New().String()
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     *accountpb.AddAccountPartyRequest
+		wantErr bool
+	}{
+		{"valid joint holder", &accountpb.AddAccountPartyRequest{AccountId: accountID, CustomerId: customerID, Role: "JointHolder", SigningRule: "TwoOf"}, false},
+		{"valid beneficiary", &accountpb.AddAccountPartyRequest{AccountId: accountID, CustomerId: customerID, Role: "Beneficiary", SigningRule: "None"}, false},
+		{"invalid role", &accountpb.AddAccountPartyRequest{AccountId: accountID, CustomerId: customerID, Role: "Owner"}, true},
+		{"signatory without authority", &accountpb.AddAccountPartyRequest{AccountId: accountID, CustomerId: customerID, Role: "AuthorizedSignatory", SigningRule: "None"}, true},
+		{"invalid customer id", &accountpb.AddAccountPartyRequest{AccountId: accountID, CustomerId: "x", Role: "JointHolder"}, true},
+		{"end before start", &accountpb.AddAccountPartyRequest{
+			AccountId: accountID, CustomerId: customerID, Role: "PowerOfAttorney",
+			StartDate: timestamppb.New(start), EndDate: timestamppb.New(start.AddDate(0, 0, -1)),
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateAddAccountParty(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateAddAccountParty() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateAddAccountParty() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateGenerateStatement(t *testing.T) {
+	validator := NewValidator()
+	accountID := uuid.New().String()
+	start := timestamppb.New(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		req     *accountpb.GenerateStatementRequest
+		wantErr bool
+	}{
+		{"valid month", &accountpb.GenerateStatementRequest{AccountId: accountID, PeriodStart: start, PeriodEnd: end}, false},
+		{"single day", &accountpb.GenerateStatementRequest{AccountId: accountID, PeriodStart: end, PeriodEnd: end}, false},
+		{"invalid account id", &accountpb.GenerateStatementRequest{AccountId: "x", PeriodStart: start, PeriodEnd: end}, true},
+		{"missing period start", &accountpb.GenerateStatementRequest{AccountId: accountID, PeriodEnd: end}, true},
+		{"missing period end", &accountpb.GenerateStatementRequest{AccountId: accountID, PeriodStart: start}, true},
+		{"end before start", &accountpb.GenerateStatementRequest{AccountId: accountID, PeriodStart: end, PeriodEnd: start}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateGenerateStatement(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateGenerateStatement() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateGenerateStatement() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateImportBankStatement(t *testing.T) {
+	validator := NewValidator()
+	accountID := uuid.New().String()
+	content := []byte(":20:A\n")
+
+	tests := []struct {
+		name    string
+		req     *accountpb.ImportBankStatementRequest
+		wantErr bool
+	}{
+		{"detected format", &accountpb.ImportBankStatementRequest{AccountId: accountID, Content: content}, false},
+		{"explicit format", &accountpb.ImportBankStatementRequest{AccountId: accountID, Format: "CAMT053", Content: content}, false},
+		{"invalid account id", &accountpb.ImportBankStatementRequest{AccountId: "x", Content: content}, true},
+		{"unknown format", &accountpb.ImportBankStatementRequest{AccountId: accountID, Format: "BAI2", Content: content}, true},
+		{"missing content", &accountpb.ImportBankStatementRequest{AccountId: accountID}, true},
+		{"content too large", &accountpb.ImportBankStatementRequest{AccountId: accountID, Content: make([]byte, MaxBankStatementSize+1)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateImportBankStatement(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateImportBankStatement() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateImportBankStatement() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateManualMatch(t *testing.T) {
+	validator := NewValidator()
+	accountID := uuid.New().String()
+	id := uuid.New().String()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.ManualMatchRequest
+		wantErr bool
+	}{
+		{"line and posting", &accountpb.ManualMatchRequest{AccountId: accountID, LineIds: []string{id}, PostingIds: []string{uuid.New().String()}, MatchedBy: "ops"}, false},
+		{"offsetting postings", &accountpb.ManualMatchRequest{AccountId: accountID, PostingIds: []string{id, uuid.New().String()}, MatchedBy: "ops"}, false},
+		{"nothing to match", &accountpb.ManualMatchRequest{AccountId: accountID, MatchedBy: "ops"}, true},
+		{"invalid line id", &accountpb.ManualMatchRequest{AccountId: accountID, LineIds: []string{"x"}, MatchedBy: "ops"}, true},
+		{"duplicate posting id", &accountpb.ManualMatchRequest{AccountId: accountID, PostingIds: []string{id, id}, MatchedBy: "ops"}, true},
+		{"missing matched by", &accountpb.ManualMatchRequest{AccountId: accountID, LineIds: []string{id}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateManualMatch(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateManualMatch() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateManualMatch() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateWriteOffBreak(t *testing.T) {
+	validator := NewValidator()
+	accountID := uuid.New().String()
+	offsetID := uuid.New().String()
+	id := uuid.New().String()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.WriteOffBreakRequest
+		wantErr bool
+	}{
+		{"line", &accountpb.WriteOffBreakRequest{AccountId: accountID, LineId: id, OffsetAccountId: offsetID, Reason: "r", WrittenOffBy: "ops"}, false},
+		{"posting", &accountpb.WriteOffBreakRequest{AccountId: accountID, PostingId: id, OffsetAccountId: offsetID, Reason: "r", WrittenOffBy: "ops"}, false},
+		{"line and posting", &accountpb.WriteOffBreakRequest{AccountId: accountID, LineId: id, PostingId: id, OffsetAccountId: offsetID, Reason: "r", WrittenOffBy: "ops"}, true},
+		{"neither", &accountpb.WriteOffBreakRequest{AccountId: accountID, OffsetAccountId: offsetID, Reason: "r", WrittenOffBy: "ops"}, true},
+		{"offset is the account", &accountpb.WriteOffBreakRequest{AccountId: accountID, LineId: id, OffsetAccountId: accountID, Reason: "r", WrittenOffBy: "ops"}, true},
+		{"missing reason", &accountpb.WriteOffBreakRequest{AccountId: accountID, LineId: id, OffsetAccountId: offsetID, WrittenOffBy: "ops"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateWriteOffBreak(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateWriteOffBreak() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateWriteOffBreak() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateSetFXPositionAccount(t *testing.T) {
+	validator := NewValidator()
+	accountID := uuid.New().String()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.SetFXPositionAccountRequest
+		wantErr bool
+	}{
+		{"valid", &accountpb.SetFXPositionAccountRequest{CurrencyPair: "EUR/USD", AccountId: accountID, UpdatedBy: "treasury"}, false},
+		{"pair out of order", &accountpb.SetFXPositionAccountRequest{CurrencyPair: "USD/EUR", AccountId: accountID, UpdatedBy: "treasury"}, true},
+		{"same currency twice", &accountpb.SetFXPositionAccountRequest{CurrencyPair: "EUR/EUR", AccountId: accountID, UpdatedBy: "treasury"}, true},
+		{"malformed pair", &accountpb.SetFXPositionAccountRequest{CurrencyPair: "EURUSD", AccountId: accountID, UpdatedBy: "treasury"}, true},
+		{"invalid account id", &accountpb.SetFXPositionAccountRequest{CurrencyPair: "EUR/USD", AccountId: "x", UpdatedBy: "treasury"}, true},
+		{"missing updated_by", &accountpb.SetFXPositionAccountRequest{CurrencyPair: "EUR/USD", AccountId: accountID}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateSetFXPositionAccount(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateSetFXPositionAccount() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateSetFXPositionAccount() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateCreateFXQuote(t *testing.T) {
+	validator := NewValidator()
+	fromID := uuid.New().String()
+	toID := uuid.New().String()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.CreateFXQuoteRequest
+		wantErr bool
+	}{
+		{"source amount", &accountpb.CreateFXQuoteRequest{FromAccountId: fromID, ToAccountId: toID, SourceAmount: 100}, false},
+		{"target amount", &accountpb.CreateFXQuoteRequest{FromAccountId: fromID, ToAccountId: toID, TargetAmount: 100}, false},
+		{"no amount", &accountpb.CreateFXQuoteRequest{FromAccountId: fromID, ToAccountId: toID}, true},
+		{"both amounts", &accountpb.CreateFXQuoteRequest{FromAccountId: fromID, ToAccountId: toID, SourceAmount: 100, TargetAmount: 100}, true},
+		{"negative amount", &accountpb.CreateFXQuoteRequest{FromAccountId: fromID, ToAccountId: toID, SourceAmount: -100}, true},
+		{"same account", &accountpb.CreateFXQuoteRequest{FromAccountId: fromID, ToAccountId: fromID, SourceAmount: 100}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateCreateFXQuote(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateCreateFXQuote() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateCreateFXQuote() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateSetLimitRule(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.SetLimitRuleRequest
+		wantErr bool
+	}{
+		{"any scope", &accountpb.SetLimitRuleRequest{Kind: "DailyAmount", Currency: "USD", Value: 100000, CreatedBy: "risk"}, false},
+		{"full scope", &accountpb.SetLimitRuleRequest{Kind: "DailyCount", Channel: "Mobile", TransactionType: "Transfer", RiskTier: "High", Currency: "USD", Value: 5, CreatedBy: "risk"}, false},
+		{"zero blocks all", &accountpb.SetLimitRuleRequest{Kind: "PerTransaction", Currency: "USD", CreatedBy: "risk"}, false},
+		{"unknown kind", &accountpb.SetLimitRuleRequest{Kind: "Weekly", Currency: "USD", Value: 1, CreatedBy: "risk"}, true},
+		{"unknown channel", &accountpb.SetLimitRuleRequest{Kind: "DailyAmount", Channel: "ATM", Currency: "USD", Value: 1, CreatedBy: "risk"}, true},
+		{"unknown risk tier", &accountpb.SetLimitRuleRequest{Kind: "DailyAmount", RiskTier: "Extreme", Currency: "USD", Value: 1, CreatedBy: "risk"}, true},
+		{"negative value", &accountpb.SetLimitRuleRequest{Kind: "DailyAmount", Currency: "USD", Value: -1, CreatedBy: "risk"}, true},
+		{"missing creator", &accountpb.SetLimitRuleRequest{Kind: "DailyAmount", Currency: "USD", Value: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateSetLimitRule(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateSetLimitRule() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateSetLimitRule() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateGrantLimitIncrease(t *testing.T) {
+	validator := NewValidator()
+	customerID := uuid.New().String()
+	ruleID := uuid.New().String()
+	tomorrow := timestamppb.New(time.Now().Add(24 * time.Hour))
+
+	tests := []struct {
+		name    string
+		req     *accountpb.GrantLimitIncreaseRequest
+		wantErr bool
+	}{
+		{"valid", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: ruleID, Value: 500000, ExpiresAt: tomorrow, Reason: "House deposit", GrantedBy: "branch"}, false},
+		{"starts later", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: ruleID, Value: 500000, StartsAt: timestamppb.New(time.Now().Add(time.Hour)), ExpiresAt: tomorrow, Reason: "House deposit", GrantedBy: "branch"}, false},
+		{"no expiry", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: ruleID, Value: 500000, Reason: "House deposit", GrantedBy: "branch"}, true},
+		{"expires before start", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: ruleID, Value: 500000, StartsAt: timestamppb.New(time.Now().Add(48 * time.Hour)), ExpiresAt: tomorrow, Reason: "House deposit", GrantedBy: "branch"}, true},
+		{"longer than 90 days", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: ruleID, Value: 500000, ExpiresAt: timestamppb.New(time.Now().AddDate(0, 0, 91)), Reason: "House deposit", GrantedBy: "branch"}, true},
+		{"zero value", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: ruleID, ExpiresAt: tomorrow, Reason: "House deposit", GrantedBy: "branch"}, true},
+		{"missing reason", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: ruleID, Value: 500000, ExpiresAt: tomorrow, GrantedBy: "branch"}, true},
+		{"invalid rule", &accountpb.GrantLimitIncreaseRequest{CustomerId: customerID, LimitRuleId: "rule", Value: 500000, ExpiresAt: tomorrow, Reason: "House deposit", GrantedBy: "branch"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateGrantLimitIncrease(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateGrantLimitIncrease() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateGrantLimitIncrease() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateListAMLAlerts(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.ListAMLAlertsRequest
+		wantErr bool
+	}{
+		{"no filter", &accountpb.ListAMLAlertsRequest{}, false},
+		{"full filter", &accountpb.ListAMLAlertsRequest{CustomerId: uuid.New().String(), Status: "Open", RuleId: "structuring-usd", Limit: 50}, false},
+		{"invalid customer", &accountpb.ListAMLAlertsRequest{CustomerId: "customer"}, true},
+		{"unknown status", &accountpb.ListAMLAlertsRequest{Status: "Closed"}, true},
+		{"negative limit", &accountpb.ListAMLAlertsRequest{Limit: -1}, true},
+		{"limit too large", &accountpb.ListAMLAlertsRequest{Limit: MaxAMLAlertListSize + 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateListAMLAlerts(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateListAMLAlerts() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateListAMLAlerts() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateCloseAMLAlert(t *testing.T) {
+	validator := NewValidator()
+	id := uuid.New().String()
+
+	tests := []struct {
+		name    string
+		req     *accountpb.CloseAMLAlertRequest
+		wantErr bool
+	}{
+		{"escalated", &accountpb.CloseAMLAlertRequest{Id: id, Status: "Escalated", ClosedBy: "analyst", Resolution: "Reported"}, false},
+		{"dismissed", &accountpb.CloseAMLAlertRequest{Id: id, Status: "Dismissed", ClosedBy: "analyst", Resolution: "Salary"}, false},
+		{"reopened", &accountpb.CloseAMLAlertRequest{Id: id, Status: "Open", ClosedBy: "analyst", Resolution: "Salary"}, true},
+		{"invalid id", &accountpb.CloseAMLAlertRequest{Id: "alert", Status: "Dismissed", ClosedBy: "analyst", Resolution: "Salary"}, true},
+		{"missing closer", &accountpb.CloseAMLAlertRequest{Id: id, Status: "Dismissed", Resolution: "Salary"}, true},
+		{"missing resolution", &accountpb.CloseAMLAlertRequest{Id: id, Status: "Dismissed", ClosedBy: "analyst"}, true},
+		{"resolution too long", &accountpb.CloseAMLAlertRequest{Id: id, Status: "Dismissed", ClosedBy: "analyst", Resolution: strings.Repeat("a", 2001)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateCloseAMLAlert(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("ValidateCloseAMLAlert() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateCloseAMLAlert() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateStatusTransition(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		from    models.AccountStatus
+		to      models.AccountStatus
+		wantErr bool
+	}{
+		{models.AccountStatusActive, models.AccountStatusFrozen, false},
+		{models.AccountStatusFrozen, models.AccountStatusActive, false},
+		{models.AccountStatusActive, models.AccountStatusClosed, false},
+		{models.AccountStatusFrozen, models.AccountStatusClosed, true},
+		{models.AccountStatusClosed, models.AccountStatusActive, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			err := validator.ValidateStatusTransition(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStatusTransition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Package client is the Go client other services use to look up customers
+// held by customer-service over gRPC.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotFound is returned when customer-service has no such customer
+var ErrNotFound = errors.New("customer not found")
+
+// Customer is the subset of a customer record other services rely on.
+// PrimaryAddress is only populated by GetCustomerProfile.
+type Customer struct {
+	ID             uuid.UUID
+	CustomerNumber string
+	FirstName      string
+	MiddleName     string
+	LastName       string
+	Status         string
+	PrimaryAddress *Address
+}
+
+// FullName returns the customer's name as it appears on correspondence
+func (c *Customer) FullName() string {
+	name := c.FirstName
+	for _, part := range []string{c.MiddleName, c.LastName} {
+		if part != "" {
+			name += " " + part
+		}
+	}
+	return name
+}
+
+// Address is a postal address held for a customer
+type Address struct {
+	Street1    string
+	Street2    string
+	City       string
+	State      string
+	PostalCode string
+	Country    string
+}
+
+// Event types in the customer event feed
+const (
+	// EventMerged means CustomerID was merged into RelatedCustomerID
+	EventMerged = "CustomerMerged"
+	// EventUnmerged means the merge of CustomerID into RelatedCustomerID,
+	// identified by ReferenceID, was reversed
+	EventUnmerged = "CustomerUnmerged"
+)
+
+// Event is an entry in customer-service's event feed. Sequence orders the
+// feed; ReferenceID identifies the merge for merge events.
+type Event struct {
+	Sequence          int64
+	ID                uuid.UUID
+	Type              string
+	CustomerID        uuid.UUID
+	RelatedCustomerID uuid.UUID
+	ReferenceID       uuid.UUID
+	OccurredAt        time.Time
+}
+
+// Client looks up customers in customer-service
+type Client struct {
+	conn   *grpc.ClientConn
+	client customerpb.CustomerServiceClient
+}
+
+// New creates a Client for the customer-service gRPC endpoint at addr. The
+// connection is established lazily on the first call.
+func New(addr string) (*Client, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create customer-service client: %w", err)
+	}
+	return &Client{
+		conn:   conn,
+		client: customerpb.NewCustomerServiceClient(conn),
+	}, nil
+}
+
+// Close closes the underlying connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// GetCustomer retrieves a customer by ID. A customer merged into another is
+// returned as the one it was merged into, whose ID differs from id.
+func (c *Client) GetCustomer(ctx context.Context, id uuid.UUID) (*Customer, error) {
+	resp, err := c.client.GetCustomer(ctx, &customerpb.GetCustomerRequest{Id: id.String()})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	return customerFromProto(resp.GetCustomer())
+}
+
+// GetCustomerProfile retrieves a customer by ID together with the primary
+// address currently in force, if the customer has one. Like GetCustomer, it
+// follows merges.
+func (c *Client) GetCustomerProfile(ctx context.Context, id uuid.UUID) (*Customer, error) {
+	resp, err := c.client.GetCustomerFullProfile(ctx, &customerpb.GetCustomerRequest{Id: id.String()})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer profile: %w", err)
+	}
+
+	customer, err := customerFromProto(resp.GetCustomer())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, a := range resp.GetAddresses() {
+		if !a.GetIsPrimary() {
+			continue
+		}
+		if a.GetValidFrom() != nil && a.GetValidFrom().AsTime().After(now) {
+			continue
+		}
+		if a.GetValidTo() != nil && !a.GetValidTo().AsTime().After(now) {
+			continue
+		}
+		customer.PrimaryAddress = &Address{
+			Street1:    a.GetStreet1(),
+			Street2:    a.GetStreet2(),
+			City:       a.GetCity(),
+			State:      a.GetState(),
+			PostalCode: a.GetPostalCode(),
+			Country:    a.GetCountry(),
+		}
+		break
+	}
+
+	return customer, nil
+}
+
+// ListCustomerEvents reads up to limit events from the customer event feed
+// after the given sequence number, in order
+func (c *Client) ListCustomerEvents(ctx context.Context, afterSequence int64, limit int) ([]Event, error) {
+	resp, err := c.client.ListCustomerEvents(ctx, &customerpb.ListCustomerEventsRequest{
+		AfterSequence: afterSequence,
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customer events: %w", err)
+	}
+
+	events := make([]Event, len(resp.GetEvents()))
+	for i, e := range resp.GetEvents() {
+		events[i] = Event{
+			Sequence:   e.GetSequence(),
+			Type:       e.GetType(),
+			OccurredAt: e.GetOccurredAt().AsTime(),
+		}
+		if events[i].ID, err = uuid.Parse(e.GetId()); err != nil {
+			return nil, fmt.Errorf("invalid event id %q: %w", e.GetId(), err)
+		}
+		if events[i].CustomerID, err = uuid.Parse(e.GetCustomerId()); err != nil {
+			return nil, fmt.Errorf("invalid customer id %q: %w", e.GetCustomerId(), err)
+		}
+		// Related and reference IDs are empty for events that have none
+		events[i].RelatedCustomerID, _ = uuid.Parse(e.GetRelatedCustomerId())
+		events[i].ReferenceID, _ = uuid.Parse(e.GetReferenceId())
+	}
+
+	return events, nil
+}
+
+func customerFromProto(customer *customerpb.Customer) (*Customer, error) {
+	customerID, err := uuid.Parse(customer.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid customer id %q: %w", customer.GetId(), err)
+	}
+
+	return &Customer{
+		ID:             customerID,
+		CustomerNumber: customer.GetCustomerNumber(),
+		FirstName:      customer.GetFirstName(),
+		MiddleName:     customer.GetMiddleName(),
+		LastName:       customer.GetLastName(),
+		Status:         customer.GetStatus(),
+	}, nil
+}
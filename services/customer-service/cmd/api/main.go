@@ -7,10 +7,13 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
 	"github.com/core-banking/pkg/config"
@@ -18,9 +21,21 @@ import (
 	"github.com/core-banking/pkg/logger"
 	"github.com/core-banking/pkg/middleware"
 
+	accountclient "github.com/core-banking/services/account-service/client"
+
 	"github.com/core-banking/services/customer-service/internal/encryption"
+	"github.com/core-banking/services/customer-service/internal/export"
 	customergrpc "github.com/core-banking/services/customer-service/internal/grpc"
+	"github.com/core-banking/services/customer-service/internal/matching"
+	"github.com/core-banking/services/customer-service/internal/merge"
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/phone"
+	"github.com/core-banking/services/customer-service/internal/postal"
 	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/core-banking/services/customer-service/internal/risk"
+	"github.com/core-banking/services/customer-service/internal/screening"
+	"github.com/core-banking/services/customer-service/internal/service"
+	"github.com/core-banking/services/customer-service/internal/storage"
 )
 
 func main() {
@@ -67,6 +82,185 @@ func main() {
 	// Initialize repository
 	repo := repository.NewCustomerRepository(db.DB, encryptor)
 
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+
+	// Sanctions screening runs only with list files. The lists are loaded
+	// before serving so no customer is created unscreened; customers are
+	// re-screened in the background when a list has a new version.
+	var screener *screening.Screener
+	if spec := os.Getenv("SANCTIONS_LISTS"); spec != "" {
+		sources, err := screening.ParseSources(spec)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid SANCTIONS_LISTS")
+		}
+		screeningConfig := screening.DefaultConfig()
+		for name, threshold := range map[string]*float64{
+			"SCREENING_NAME_THRESHOLD": &screeningConfig.NameThreshold,
+			"SCREENING_HIT_THRESHOLD":  &screeningConfig.HitThreshold,
+		} {
+			if value := os.Getenv(name); value != "" {
+				if *threshold, err = strconv.ParseFloat(value, 64); err != nil {
+					log.Fatal().Err(err).Msgf("Invalid %s", name)
+				}
+			}
+		}
+		if err := screeningConfig.Validate(); err != nil {
+			log.Fatal().Err(err).Msg("Invalid screening configuration")
+		}
+
+		screener = screening.NewScreener(screeningConfig)
+		sanctionsJob := service.NewSanctionsListJob(repo, screener, sources, 15*time.Minute, log)
+		if err := sanctionsJob.Load(); err != nil {
+			log.Fatal().Err(err).Msg("Failed to load sanctions lists")
+		}
+		for _, list := range screener.Lists() {
+			log.Info().Str("list", list.Name).Str("version", list.Version).Int("entries", len(list.Entries)).Msg("Loaded sanctions list")
+		}
+		go sanctionsJob.Run(jobsCtx)
+	} else {
+		log.Warn().Msg("SANCTIONS_LISTS not set, sanctions screening disabled")
+	}
+
+	// KYC risk rating uses the built-in model unless one is configured.
+	// Product holdings are scored only when account-service can be reached.
+	riskConfig := risk.DefaultConfig()
+	if path := os.Getenv("RISK_MODEL_FILE"); path != "" {
+		if riskConfig, err = risk.LoadConfig(path); err != nil {
+			log.Fatal().Err(err).Msg("Failed to load risk model")
+		}
+	}
+	var products service.ProductDirectory
+	if accountServiceAddr := os.Getenv("ACCOUNT_SERVICE_ADDR"); accountServiceAddr != "" {
+		accounts, err := accountclient.New(accountServiceAddr)
+		if err != nil {
+			log.Fatal().Err(err).Str("addr", accountServiceAddr).Msg("Failed to create account-service client")
+		}
+		defer accounts.Close()
+		products = accountProducts{accounts}
+	} else {
+		log.Warn().Msg("ACCOUNT_SERVICE_ADDR not set, product holdings left out of risk ratings")
+	}
+	assessor := service.NewRiskAssessor(risk.NewModel(riskConfig), products)
+	log.Info().Str("version", riskConfig.Version).Msg("Loaded risk model")
+	go service.NewReviewScheduler(repo, assessor, 15*time.Minute, log).Run(jobsCtx)
+
+	// Expiring identity documents are checked daily. Customers left without
+	// a valid one are restricted once the grace period runs out.
+	graceDays := 30
+	if value := os.Getenv("DOCUMENT_EXPIRY_GRACE_DAYS"); value != "" {
+		if graceDays, err = strconv.Atoi(value); err != nil || graceDays < 0 {
+			log.Fatal().Str("value", value).Msg("Invalid DOCUMENT_EXPIRY_GRACE_DAYS")
+		}
+	}
+	expiryJob := service.NewDocumentExpiryJob(repo, assessor, logNotifier{log}, time.Duration(graceDays)*24*time.Hour, 24*time.Hour, log)
+	go expiryJob.Run(jobsCtx)
+
+	// Document scans are stored encrypted with the customer data key, and
+	// only when a directory is configured for them
+	var files *service.DocumentFiles
+	if dir := os.Getenv("DOCUMENT_STORE_DIR"); dir != "" {
+		store, err := storage.NewLocalStore(dir)
+		if err != nil {
+			log.Fatal().Err(err).Str("dir", dir).Msg("Failed to open document store")
+		}
+		maxSize := int64(10 << 20)
+		if value := os.Getenv("DOCUMENT_MAX_FILE_BYTES"); value != "" {
+			if maxSize, err = strconv.ParseInt(value, 10, 64); err != nil || maxSize <= 0 {
+				log.Fatal().Str("value", value).Msg("Invalid DOCUMENT_MAX_FILE_BYTES")
+			}
+		}
+		viewerRoles := []string{"compliance", "kyc-officer"}
+		if value := os.Getenv("DOCUMENT_VIEWER_ROLES"); value != "" {
+			viewerRoles = strings.Split(value, ",")
+		}
+		files = service.NewDocumentFiles(store, encryptor, maxSize, viewerRoles)
+	} else {
+		log.Warn().Msg("DOCUMENT_STORE_DIR not set, document file storage disabled")
+	}
+
+	// Closed customers are pseudonymized once their retention period is
+	// over, checked daily. A dry run only logs who would be.
+	retentionYears := 5
+	if value := os.Getenv("DATA_RETENTION_YEARS"); value != "" {
+		if retentionYears, err = strconv.Atoi(value); err != nil || retentionYears < 1 {
+			log.Fatal().Str("value", value).Msg("Invalid DATA_RETENTION_YEARS")
+		}
+	}
+	retentionDryRun := false
+	if value := os.Getenv("DATA_RETENTION_DRY_RUN"); value != "" {
+		if retentionDryRun, err = strconv.ParseBool(value); err != nil {
+			log.Fatal().Str("value", value).Msg("Invalid DATA_RETENTION_DRY_RUN")
+		}
+	}
+	retention := service.NewRetention(retentionYears, files)
+	go service.NewRetentionJob(repo, retention, retentionDryRun, 24*time.Hour, log).Run(jobsCtx)
+
+	// Subject access bundles are signed and kept in the document store, so
+	// exports need both a signing key and document file storage. Bundles
+	// too large to produce while the caller waits are produced every minute.
+	var exports *service.DataExports
+	if seed := os.Getenv("EXPORT_SIGNING_KEY"); seed != "" && files != nil {
+		signer, err := export.NewSigner(seed)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid EXPORT_SIGNING_KEY")
+		}
+		inlineRecords := 1000
+		if value := os.Getenv("EXPORT_INLINE_RECORDS"); value != "" {
+			if inlineRecords, err = strconv.Atoi(value); err != nil || inlineRecords < 0 {
+				log.Fatal().Str("value", value).Msg("Invalid EXPORT_INLINE_RECORDS")
+			}
+		}
+		exports = service.NewDataExports(files, signer, inlineRecords)
+		go service.NewDataExportJob(repo, exports, time.Minute, log).Run(jobsCtx)
+		log.Info().Str("signing_key", signer.PublicKey()).Msg("Data export enabled")
+	} else {
+		log.Warn().Msg("EXPORT_SIGNING_KEY or DOCUMENT_STORE_DIR not set, data export disabled")
+	}
+
+	// Duplicate customers are merged under the built-in survivorship rules
+	// unless others are configured
+	mergeRules := merge.DefaultConfig()
+	if path := os.Getenv("MERGE_RULES_FILE"); path != "" {
+		if mergeRules, err = merge.LoadConfig(path); err != nil {
+			log.Fatal().Err(err).Msg("Failed to load merge rules")
+		}
+	}
+	log.Info().Str("version", mergeRules.Version).Msg("Customer merge rules loaded")
+
+	// New customers are checked for likely duplicates under the built-in
+	// matching model unless one is configured. Customers on file from before
+	// are filed under their blocking keys in the background.
+	matchingModel := matching.DefaultConfig()
+	if path := os.Getenv("MATCHING_MODEL_FILE"); path != "" {
+		if matchingModel, err = matching.LoadConfig(path); err != nil {
+			log.Fatal().Err(err).Msg("Failed to load matching model")
+		}
+	}
+	log.Info().Str("version", matchingModel.Version).Int("block_threshold", matchingModel.BlockThreshold).Msg("Duplicate matching model loaded")
+	go service.NewMatchKeyJob(repo, 24*time.Hour, log).Run(jobsCtx)
+
+	// Addresses are checked and normalized under the generic rules alone
+	// unless per-country rules are configured
+	addressRules := postal.DefaultConfig()
+	if path := os.Getenv("ADDRESS_RULES_FILE"); path != "" {
+		if addressRules, err = postal.LoadConfig(path); err != nil {
+			log.Fatal().Err(err).Msg("Failed to load address rules")
+		}
+	}
+	log.Info().Str("version", addressRules.Version).Int("countries", len(addressRules.Countries)).Msg("Address rules loaded")
+
+	// Phone numbers are taken in international format only, and not typed,
+	// unless numbering plans are configured
+	phonePlans := phone.DefaultConfig()
+	if path := os.Getenv("PHONE_NUMBERING_FILE"); path != "" {
+		if phonePlans, err = phone.LoadConfig(path); err != nil {
+			log.Fatal().Err(err).Msg("Failed to load numbering plans")
+		}
+	}
+	log.Info().Str("version", phonePlans.Version).Int("countries", len(phonePlans.Countries)).Msg("Phone numbering plans loaded")
+
 	// Start gRPC server
 	grpcPort := 50051 // Default gRPC port
 	grpcConfig := customergrpc.Config{
@@ -75,6 +269,15 @@ func main() {
 		MaxSendSize: 100, // 100MB
 		Timeout:     30 * time.Second,
 		EnableAuth:  false,
+		Screener:    screener,
+		Risk:        assessor,
+		Files:       files,
+		Retention:   retention,
+		Exports:     exports,
+		Merger:      service.NewMerger(mergeRules, phonePlans),
+		Matcher:     service.NewMatcher(matchingModel),
+		Addresses:   addressRules,
+		Phones:      phonePlans,
 	}
 
 	grpcServer := customergrpc.NewServer(repo, grpcConfig)
@@ -88,7 +291,7 @@ func main() {
 	}()
 
 	// Create HTTP router
-	router := createRouter(log, db)
+	router := createRouter(log, db, expiryJob.Metrics())
 
 	// Create HTTP server
 	httpServer := &http.Server{
@@ -113,6 +316,7 @@ func main() {
 	<-quit
 
 	log.Info().Msg("Shutting down servers gracefully...")
+	stopJobs()
 
 	// Create shutdown context with timeout
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -130,7 +334,7 @@ func main() {
 }
 
 // createRouter creates the HTTP router with all middleware and routes.
-func createRouter(log zerolog.Logger, db *database.DB) *chi.Mux {
+func createRouter(log zerolog.Logger, db *database.DB, metrics http.Handler) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Add middleware
@@ -144,6 +348,9 @@ func createRouter(log zerolog.Logger, db *database.DB) *chi.Mux {
 	// Health check endpoint (no authentication required)
 	r.Get("/health", healthHandler(log, db))
 
+	// Metrics endpoint for scraping
+	r.Method(http.MethodGet, "/metrics", metrics)
+
 	// API routes
 	r.Route("/api/v1", func(r chi.Router) {
 		// Customer routes
@@ -341,6 +548,46 @@ type updateCustomerRequest struct {
 
 // Helper functions
 
+// accountProducts looks up a customer's holdings in account-service for risk
+// rating
+type accountProducts struct {
+	client *accountclient.Client
+}
+
+func (p accountProducts) CustomerProducts(ctx context.Context, customerID uuid.UUID) ([]risk.Product, error) {
+	holdings, err := p.client.ListCustomerHoldings(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	products := make([]risk.Product, len(holdings))
+	for i, h := range holdings {
+		products[i] = risk.Product{
+			AccountType: h.Account.AccountType,
+			Role:        h.Role,
+			Currency:    h.Account.Currency,
+		}
+	}
+	return products, nil
+}
+
+// logNotifier logs document expiry notices for delivery by the log pipeline
+// until customer messaging is available
+type logNotifier struct {
+	log zerolog.Logger
+}
+
+func (n logNotifier) NotifyDocumentExpiring(ctx context.Context, customer *models.Customer, doc *models.CustomerDocument, daysLeft int) error {
+	n.log.Info().
+		Str("customer_id", customer.ID.String()).
+		Str("email", customer.Email).
+		Str("document_id", doc.ID.String()).
+		Str("document_type", string(doc.DocumentType)).
+		Time("expiry_date", doc.ExpiryDate).
+		Int("days_left", daysLeft).
+		Msg("Identity document expiry notice")
+	return nil
+}
+
 func generateID() string {
 	return fmt.Sprintf("%d", time.Now().UnixNano())
 }
@@ -0,0 +1,165 @@
+// Command sarexport answers a subject access request: it asks customer-service
+// to export everything held on a customer, waits for the bundle if it is
+// produced in the background, downloads the signed JSON bundle and its HTML
+// and PDF renderings, and checks the JSON against its signature.
+//
+//	sarexport -customer 7f1c... -requested-by 3a9e... -out ./sar
+//	sarexport -export 5d2b... -requested-by 3a9e... -out ./sar
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/export"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+func main() {
+	addr := flag.String("addr", envOr("CUSTOMER_SERVICE_ADDR", "localhost:50051"), "customer-service address (defaults to CUSTOMER_SERVICE_ADDR)")
+	customerID := flag.String("customer", "", "customer to export")
+	exportID := flag.String("export", "", "existing export to wait for and download, instead of starting one")
+	requestedBy := flag.String("requested-by", "", "ID of the officer handling the request")
+	roles := flag.String("roles", "compliance", "roles presented to customer-service, comma-separated")
+	outDir := flag.String("out", ".", "directory the bundle is written to")
+	poll := flag.Duration("poll", 5*time.Second, "how often to check on a bundle produced in the background")
+	timeout := flag.Duration("timeout", 30*time.Minute, "how long to wait for the bundle")
+	flag.Parse()
+
+	if err := run(*addr, *customerID, *exportID, *requestedBy, *roles, *outDir, *poll, *timeout); err != nil {
+		fmt.Fprintf(os.Stderr, "sarexport: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func envOr(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func run(addr, customerID, exportID, requestedBy, roles, outDir string, poll, timeout time.Duration) error {
+	if (customerID == "") == (exportID == "") {
+		return errors.New("set exactly one of -customer and -export")
+	}
+	if requestedBy == "" {
+		return errors.New("-requested-by is required")
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+	client := customerpb.NewCustomerServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", requestedBy, "x-user-roles", roles)
+
+	var e *customerpb.DataExport
+	if customerID != "" {
+		resp, err := client.ExportCustomerData(ctx, &customerpb.ExportCustomerDataRequest{
+			CustomerId:  customerID,
+			RequestedBy: requestedBy,
+		})
+		if err != nil {
+			return fmt.Errorf("ExportCustomerData failed: %w", err)
+		}
+		e = resp.GetExport()
+		fmt.Printf("Export %s requested, due by %s\n", e.GetId(), e.GetDueAt().AsTime().Format("2006-01-02"))
+	} else {
+		resp, err := client.GetDataExport(ctx, &customerpb.GetDataExportRequest{ExportId: exportID})
+		if err != nil {
+			return fmt.Errorf("GetDataExport failed: %w", err)
+		}
+		e = resp.GetExport()
+	}
+
+	for e.GetStatus() == "Pending" {
+		fmt.Printf("Waiting for export %s...\n", e.GetId())
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("export %s is still pending: %w", e.GetId(), ctx.Err())
+		case <-time.After(poll):
+		}
+		resp, err := client.GetDataExport(ctx, &customerpb.GetDataExportRequest{ExportId: e.GetId()})
+		if err != nil {
+			return fmt.Errorf("GetDataExport failed: %w", err)
+		}
+		e = resp.GetExport()
+	}
+	if e.GetStatus() != "Completed" {
+		return fmt.Errorf("export %s %s: %s", e.GetId(), e.GetStatus(), e.GetError())
+	}
+
+	if err := os.MkdirAll(outDir, 0o700); err != nil {
+		return err
+	}
+	for _, format := range export.Formats {
+		path := filepath.Join(outDir, fmt.Sprintf("%s.%s", e.GetId(), format))
+		if err := download(ctx, client, e, format, requestedBy, path); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %s\n", path)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outDir, e.GetId()+".json"))
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(data)
+	if hex.EncodeToString(digest[:]) != e.GetSha256() {
+		return errors.New("JSON bundle does not match its digest")
+	}
+	if err := export.Verify(e.GetSigningKey(), e.GetSignature(), data); err != nil {
+		return err
+	}
+	fmt.Printf("Bundle of %d records verified against signing key %s\n", e.GetRecords(), e.GetSigningKey())
+	return nil
+}
+
+// download writes one rendering of the export to path, removing it again if
+// the download fails part way
+func download(ctx context.Context, client customerpb.CustomerServiceClient, e *customerpb.DataExport, format export.Format, requestedBy, path string) error {
+	stream, err := client.DownloadDataExport(ctx, &customerpb.DownloadDataExportRequest{
+		ExportId:    e.GetId(),
+		Format:      string(format),
+		RequestedBy: requestedBy,
+	})
+	if err != nil {
+		return fmt.Errorf("DownloadDataExport failed: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			_, err = f.Write(resp.GetChunk())
+		}
+		if err != nil {
+			f.Close()
+			os.Remove(path)
+			return fmt.Errorf("failed to download %s: %w", format, err)
+		}
+	}
+	return f.Close()
+}
@@ -0,0 +1,129 @@
+package encryption
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ChunkSize is the most plaintext sealed in one chunk by a ChunkWriter
+const ChunkSize = 64 * 1024
+
+// chunkOverhead is what sealing adds to a chunk: the GCM nonce and tag
+const chunkOverhead = 12 + 16
+
+// ErrChunkCorrupt is returned when encrypted chunks cannot be read back
+var ErrChunkCorrupt = errors.New("encrypted chunk is corrupt")
+
+// ChunkWriter encrypts what is written to it in chunks of up to ChunkSize
+// bytes, each sealed with EncryptBytes and preceded by its sealed length as a
+// 4-byte big-endian integer, so large files never have to be held in memory.
+// Chunks are sealed independently: readers must check the content as a
+// whole, since chunks could be dropped from the end or reordered.
+type ChunkWriter struct {
+	w   io.Writer
+	e   *Encryptor
+	buf []byte
+}
+
+// NewChunkWriter returns a ChunkWriter writing to w
+func (e *Encryptor) NewChunkWriter(w io.Writer) *ChunkWriter {
+	return &ChunkWriter{w: w, e: e, buf: make([]byte, 0, ChunkSize)}
+}
+
+// Write encrypts p, writing out each chunk as it fills
+func (c *ChunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), ChunkSize-len(c.buf))
+		c.buf = append(c.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(c.buf) == ChunkSize {
+			if err := c.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Close writes out the last, partly filled chunk. It does not close the
+// underlying writer.
+func (c *ChunkWriter) Close() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	return c.flush()
+}
+
+func (c *ChunkWriter) flush() error {
+	sealed, err := c.e.EncryptBytes(c.buf)
+	if err != nil {
+		return err
+	}
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(sealed)))
+	if _, err := c.w.Write(header[:]); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(sealed); err != nil {
+		return err
+	}
+	c.buf = c.buf[:0]
+	return nil
+}
+
+// ChunkReader decrypts what a ChunkWriter wrote
+type ChunkReader struct {
+	r   io.Reader
+	e   *Encryptor
+	buf []byte
+}
+
+// NewChunkReader returns a ChunkReader reading from r
+func (e *Encryptor) NewChunkReader(r io.Reader) *ChunkReader {
+	return &ChunkReader{r: r, e: e}
+}
+
+// Read reads decrypted content, decrypting a chunk at a time
+func (c *ChunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if err := c.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// next reads and decrypts the next chunk, returning io.EOF after the last
+func (c *ChunkReader) next() error {
+	var header [4]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return fmt.Errorf("%w: truncated length", ErrChunkCorrupt)
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(header[:])
+	if size <= chunkOverhead || size > ChunkSize+chunkOverhead {
+		return fmt.Errorf("%w: length %d out of range", ErrChunkCorrupt, size)
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(c.r, sealed); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return fmt.Errorf("%w: truncated chunk", ErrChunkCorrupt)
+		}
+		return err
+	}
+	plain, err := c.e.DecryptBytes(sealed)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrChunkCorrupt, err)
+	}
+	c.buf = plain
+	return nil
+}
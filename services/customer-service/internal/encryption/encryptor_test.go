@@ -1,6 +1,8 @@
 package encryption
 
 import (
+	"bytes"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -181,3 +183,63 @@ func TestEncryptorWithDifferentKeys(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestChunkWriterReader(t *testing.T) {
+	encryptor, err := NewEncryptor("12345678901234567890123456789012")
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, ChunkSize - 1, ChunkSize, 3*ChunkSize + 17} {
+		plain := make([]byte, size)
+		for i := range plain {
+			plain[i] = byte(i * 7)
+		}
+
+		var sealed bytes.Buffer
+		w := encryptor.NewChunkWriter(&sealed)
+		// Write in odd pieces so chunks fill across writes
+		for rest := plain; len(rest) > 0; {
+			n := min(len(rest), 1000)
+			_, err := w.Write(rest[:n])
+			require.NoError(t, err)
+			rest = rest[n:]
+		}
+		require.NoError(t, w.Close())
+		assert.NotContains(t, sealed.String(), "\x00\x07\x0e\x15\x1c", "size %d written in the clear", size)
+
+		got, err := io.ReadAll(encryptor.NewChunkReader(&sealed))
+		require.NoError(t, err, "size %d", size)
+		assert.Equal(t, len(plain), len(got))
+		assert.True(t, bytes.Equal(plain, got), "size %d round trip", size)
+	}
+}
+
+func TestChunkReader_Corrupt(t *testing.T) {
+	encryptor, err := NewEncryptor("12345678901234567890123456789012")
+	require.NoError(t, err)
+
+	var sealed bytes.Buffer
+	w := encryptor.NewChunkWriter(&sealed)
+	_, err = w.Write(bytes.Repeat([]byte("passport scan "), 10000))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	data := sealed.Bytes()
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := io.ReadAll(encryptor.NewChunkReader(bytes.NewReader(data[:len(data)-10])))
+		assert.ErrorIs(t, err, ErrChunkCorrupt)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := bytes.Clone(data)
+		tampered[100] ^= 0xff
+		_, err := io.ReadAll(encryptor.NewChunkReader(bytes.NewReader(tampered)))
+		assert.ErrorIs(t, err, ErrChunkCorrupt)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		other, err := NewEncryptor("abcdefghijklmnopqrstuvwxyz123456")
+		require.NoError(t, err)
+		_, err = io.ReadAll(other.NewChunkReader(bytes.NewReader(data)))
+		assert.ErrorIs(t, err, ErrChunkCorrupt)
+	})
+}
@@ -0,0 +1,167 @@
+// Package export builds the bundle answering a subject access request:
+// everything held on a customer as machine-readable JSON, signed so it can be
+// shown to be ours and unaltered, and rendered as HTML and PDF for the
+// customer to read.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FormatVersion identifies the layout of the JSON bundle
+const FormatVersion = "2"
+
+// Format is a rendering of a bundle
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatHTML Format = "html"
+	FormatPDF  Format = "pdf"
+)
+
+// Formats lists every rendering produced for a bundle
+var Formats = []Format{FormatJSON, FormatHTML, FormatPDF}
+
+// ContentType returns the media type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSON:
+		return "application/json"
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	case FormatPDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+// ParseFormat parses a format name
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown export format %q", name)
+}
+
+// Bundle is everything held on a customer. Sections are listed oldest first.
+type Bundle struct {
+	FormatVersion string         `json:"format_version"`
+	ExportID      string         `json:"export_id"`
+	GeneratedAt   time.Time      `json:"generated_at"`
+	Customer      Customer       `json:"customer"`
+	Addresses     []Address      `json:"addresses"`
+	Documents     []Document     `json:"documents"`
+	StatusHistory []StatusChange `json:"status_history"`
+	Consents      []Consent      `json:"consents"`
+	AuditEvents   []AuditEvent   `json:"audit_events"`
+}
+
+// Customer is the customer's own details
+type Customer struct {
+	ID             string    `json:"id"`
+	CustomerNumber string    `json:"customer_number"`
+	FirstName      string    `json:"first_name"`
+	MiddleName     string    `json:"middle_name,omitempty"`
+	LastName       string    `json:"last_name"`
+	DateOfBirth    string    `json:"date_of_birth"` // YYYY-MM-DD
+	TaxID          string    `json:"tax_id"`
+	Email          string    `json:"email"`
+	Phone          string    `json:"phone"`       // E.164
+	PhoneInput     string    `json:"phone_input"` // As given
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Address is an address the customer has or had, with when it applied
+type Address struct {
+	ID          string     `json:"id"`
+	AddressType string     `json:"address_type"`
+	Street1     string     `json:"street1"`
+	Street2     string     `json:"street2,omitempty"`
+	City        string     `json:"city"`
+	State       string     `json:"state"`
+	PostalCode  string     `json:"postal_code"`
+	Country     string     `json:"country"`
+	IsPrimary   bool       `json:"is_primary"`
+	ValidFrom   time.Time  `json:"valid_from"`
+	ValidTo     *time.Time `json:"valid_to,omitempty"`
+}
+
+// Document is an identity or supporting document, with the scans stored of
+// it. The scans themselves are not part of the bundle.
+type Document struct {
+	ID                 string         `json:"id"`
+	DocumentType       string         `json:"document_type"`
+	DocumentNumber     string         `json:"document_number"`
+	IssuingAuthority   string         `json:"issuing_authority"`
+	IssuingCountry     string         `json:"issuing_country"`
+	IssueDate          string         `json:"issue_date"`  // YYYY-MM-DD
+	ExpiryDate         string         `json:"expiry_date"` // YYYY-MM-DD
+	VerificationStatus string         `json:"verification_status"`
+	VerifiedAt         *time.Time     `json:"verified_at,omitempty"`
+	Files              []DocumentFile `json:"files,omitempty"`
+}
+
+// DocumentFile describes a stored scan of a document
+type DocumentFile struct {
+	ID          string    `json:"id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+// StatusChange is a change in the customer's status
+type StatusChange struct {
+	PreviousStatus string    `json:"previous_status"`
+	NewStatus      string    `json:"new_status"`
+	Reason         string    `json:"reason"`
+	ChangedBy      string    `json:"changed_by,omitempty"` // Empty when changed by the service itself
+	ChangedAt      time.Time `json:"changed_at"`
+}
+
+// Consent is something the customer agreed to, and when they withdrew it
+type Consent struct {
+	Purpose          string     `json:"purpose"`
+	Channel          string     `json:"channel"`
+	PolicyVersion    string     `json:"policy_version"`
+	Source           string     `json:"source"`
+	GrantedAt        time.Time  `json:"granted_at"`
+	WithdrawnAt      *time.Time `json:"withdrawn_at,omitempty"`
+	WithdrawalSource string     `json:"withdrawal_source,omitempty"`
+}
+
+// AuditEvent is something done with the customer's data, such as viewing a
+// document scan or handling an earlier request about their data
+type AuditEvent struct {
+	Type   string    `json:"type"`
+	Actor  string    `json:"actor"`
+	Detail string    `json:"detail"`
+	At     time.Time `json:"at"`
+}
+
+// Records counts the records in the bundle
+func (b *Bundle) Records() int {
+	n := 1 + len(b.Addresses) + len(b.Documents) + len(b.StatusHistory) + len(b.Consents) + len(b.AuditEvents)
+	for _, doc := range b.Documents {
+		n += len(doc.Files)
+	}
+	return n
+}
+
+// Marshal encodes the bundle as indented JSON. These are the bytes that
+// are signed.
+func Marshal(b *Bundle) ([]byte, error) {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode bundle: %w", err)
+	}
+	return append(data, '\n'), nil
+}
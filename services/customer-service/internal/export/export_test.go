@@ -0,0 +1,167 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSeed = "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"
+
+var generatedAt = time.Date(2026, 6, 1, 9, 30, 0, 0, time.UTC)
+
+func testBundle() *Bundle {
+	movedOut := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	return &Bundle{
+		FormatVersion: FormatVersion,
+		ExportID:      "7f1c7e3a-0000-4000-8000-000000000001",
+		GeneratedAt:   generatedAt,
+		Customer: Customer{
+			ID:             "7f1c7e3a-0000-4000-8000-000000000002",
+			CustomerNumber: "CUST-1",
+			FirstName:      "Zoë",
+			LastName:       "O'Brien <script>",
+			DateOfBirth:    "1985-06-01",
+			TaxID:          "AB12345678",
+			Email:          "zoe@example.com",
+			Phone:          "+441234567890",
+			Status:         "Active",
+		},
+		Addresses: []Address{
+			{AddressType: "Physical", Street1: "1 Old Road", City: "Leeds", Country: "GB", ValidFrom: movedOut.AddDate(-3, 0, 0), ValidTo: &movedOut},
+			{AddressType: "Physical", Street1: "2 New Road (rear)", City: "York", Country: "GB", IsPrimary: true, ValidFrom: movedOut},
+		},
+		Documents: []Document{{
+			DocumentType:   "Passport",
+			DocumentNumber: "P1234567",
+			IssueDate:      "2020-01-01",
+			ExpiryDate:     "2030-01-01",
+			Files:          []DocumentFile{{FileName: "passport.pdf", ContentType: "application/pdf", Size: 2048}},
+		}},
+		StatusHistory: []StatusChange{{PreviousStatus: "Pending", NewStatus: "Active", Reason: "Identity document verified"}},
+	}
+}
+
+func TestBundle_MarshalAndSign(t *testing.T) {
+	b := testBundle()
+	if got := b.Records(); got != 6 {
+		t.Errorf("Records() = %d, want 6", got)
+	}
+
+	data, err := Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("bundle is not JSON: %v", err)
+	}
+	if decoded["customer"].(map[string]any)["tax_id"] != "AB12345678" {
+		t.Errorf("tax id missing from bundle")
+	}
+
+	signer, err := NewSigner(testSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := signer.Sign(data)
+	if err := Verify(signer.PublicKey(), sig, data); err != nil {
+		t.Errorf("Verify() error: %v", err)
+	}
+	tampered := bytes.Replace(data, []byte("AB12345678"), []byte("AB12345679"), 1)
+	if err := Verify(signer.PublicKey(), sig, tampered); !errors.Is(err, ErrBadSignature) {
+		t.Errorf("Verify() tampered = %v, want ErrBadSignature", err)
+	}
+
+	for _, seed := range []string{"", "zz", testSeed[:62]} {
+		if _, err := NewSigner(seed); err == nil {
+			t.Errorf("NewSigner(%q) succeeded", seed)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range Formats {
+		if got, err := ParseFormat(string(f)); err != nil || got != f {
+			t.Errorf("ParseFormat(%q) = %q, %v", f, got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) succeeded")
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderHTML(&buf, testBundle(), Seal{SHA256: "abc123", Signature: "c2ln"}); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+	for _, want := range []string{"Zoë O&#39;Brien &lt;script&gt;", "P1234567", "2021-02-01 to 2024-02-01", "2024-02-01 to present", "Identity document verified", "abc123", "<h2>Use of your data</h2>\n<p>None.</p>"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("HTML does not contain %q", want)
+		}
+	}
+	if strings.Contains(html, "<script>") {
+		t.Error("HTML is not escaped")
+	}
+}
+
+func TestRenderPDF(t *testing.T) {
+	b := testBundle()
+	for i := 0; i < 80; i++ {
+		b.AuditEvents = append(b.AuditEvents, AuditEvent{Type: "document_file_access", Actor: "officer", Detail: fmt.Sprintf("Viewed scan %d", i), At: generatedAt})
+	}
+	var buf bytes.Buffer
+	if err := RenderPDF(&buf, b, Seal{Signature: strings.Repeat("A", 88)}); err != nil {
+		t.Fatal(err)
+	}
+	pdf := buf.String()
+	if !strings.HasPrefix(pdf, "%PDF-1.4\n") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Fatal("not a PDF")
+	}
+	if !strings.Contains(pdf, `(2 New Road \(rear\), York, GB)`) || !strings.Contains(pdf, `(Name) Tj`) || !strings.Contains(pdf, `Zo\353`) {
+		t.Error("PDF text not escaped")
+	}
+	pages := regexp.MustCompile(`/Count (\d+)`).FindStringSubmatch(pdf)
+	if n, _ := strconv.Atoi(pages[1]); n < 3 {
+		t.Errorf("PDF has %s pages, want several", pages[1])
+	}
+
+	// Every object is where the cross-reference table says
+	xref := pdf[strings.LastIndex(pdf, "xref\n"):]
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllStringSubmatch(xref, -1)
+	for i, m := range offsets {
+		off, _ := strconv.Atoi(m[1])
+		if want := fmt.Sprintf("%d 0 obj", i+1); !strings.HasPrefix(pdf[off:], want) {
+			t.Errorf("object %d not at offset %d", i+1, off)
+		}
+	}
+	start := regexp.MustCompile(`startxref\n(\d+)`).FindStringSubmatch(pdf)
+	if off, _ := strconv.Atoi(start[1]); !strings.HasPrefix(pdf[off:], "xref") {
+		t.Error("startxref does not point at the cross-reference table")
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", []string{""}},
+		{"fits ok", []string{"fits ok"}},
+		{"one two three four", []string{"one two", "three", "four"}},
+		{"abcdefghijklmno", []string{"abcdefgh", "ijklmno"}},
+	}
+	for _, tt := range tests {
+		if got := wrap(tt.in, 8); strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("wrap(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page layout in points, set in the standard Helvetica fonts so no font
+// needs embedding
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	margin       = 50
+	valueIndent  = 170
+	fontSize     = 10
+	headingSize  = 13
+	leading      = 14
+	textColumns  = 95 // Characters of body text per line, allowing for Helvetica's widths
+	valueColumns = 70
+)
+
+// pdfDocument lays text out onto pages and writes it as a PDF
+type pdfDocument struct {
+	pages [][]string // Content stream operators for each page
+	y     int
+}
+
+// line moves down to the next line, starting a new page when one is full
+func (d *pdfDocument) line() {
+	if len(d.pages) == 0 || d.y < margin+leading {
+		d.pages = append(d.pages, nil)
+		d.y = pageHeight - margin
+	}
+	d.y -= leading
+}
+
+func (d *pdfDocument) show(font string, size, x int, s string) {
+	page := len(d.pages) - 1
+	d.pages[page] = append(d.pages[page], fmt.Sprintf("BT /%s %d Tf %d %d Td (%s) Tj ET", font, size, x, d.y, pdfString(s)))
+}
+
+func (d *pdfDocument) heading(s string) {
+	d.line()
+	d.y -= headingSize - fontSize
+	d.show("F2", headingSize, margin, s)
+}
+
+func (d *pdfDocument) text(s string) {
+	for _, l := range wrap(s, textColumns) {
+		d.line()
+		d.show("F1", fontSize, margin, l)
+	}
+}
+
+func (d *pdfDocument) field(label, value string) {
+	for i, l := range wrap(value, valueColumns) {
+		d.line()
+		if i == 0 {
+			d.show("F2", fontSize, margin, label)
+		}
+		d.show("F1", fontSize, valueIndent, l)
+	}
+}
+
+func (d *pdfDocument) space() {
+	d.y -= leading / 2
+}
+
+// bytes writes the document: catalog, page tree, fonts, then each page and
+// its content, followed by the cross-reference table
+func (d *pdfDocument) bytes() []byte {
+	if len(d.pages) == 0 {
+		d.line()
+	}
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		footer := fmt.Sprintf("BT /F1 8 Tf %d %d Td (Page %d of %d) Tj ET", margin, margin/2, i+1, len(d.pages))
+		content := strings.Join(append(page, footer), "\n")
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
+
+// pdfString escapes text for a PDF string in WinAnsiEncoding; characters
+// outside Latin-1 are shown as question marks
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// wrap breaks text into lines of at most width characters, at spaces where
+// it can
+func wrap(s string, width int) []string {
+	var lines []string
+	var current []rune
+	for _, word := range strings.Fields(s) {
+		w := []rune(word)
+		if len(current) > 0 && len(current)+1+len(w) > width {
+			lines = append(lines, string(current))
+			current = nil
+		}
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, w...)
+		for len(current) > width {
+			lines = append(lines, string(current[:width]))
+			current = current[width:]
+		}
+	}
+	if len(current) > 0 || len(lines) == 0 {
+		lines = append(lines, string(current))
+	}
+	return lines
+}
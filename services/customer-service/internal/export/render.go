@@ -0,0 +1,211 @@
+package export
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+// Seal is the signature of a bundle's JSON, printed on its renderings so a
+// reader can check them against it
+type Seal struct {
+	SHA256    string
+	Signature string
+	PublicKey string
+}
+
+// section is a titled part of a rendering, made of entries of labelled
+// values
+type section struct {
+	Title   string
+	Entries [][]field
+}
+
+type field struct {
+	Label string
+	Value string
+}
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04 MST"
+)
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeLayout)
+}
+
+// sections lays out the bundle for people to read
+func sections(b *Bundle, seal Seal) []section {
+	c := b.Customer
+	name := strings.Join(strings.Fields(c.FirstName+" "+c.MiddleName+" "+c.LastName), " ")
+	out := []section{{
+		Title: "Your details",
+		Entries: [][]field{{
+			{"Customer number", c.CustomerNumber},
+			{"Name", name},
+			{"Date of birth", c.DateOfBirth},
+			{"Tax ID", c.TaxID},
+			{"Email", c.Email},
+			{"Phone", c.Phone},
+			{"Phone as given", c.PhoneInput},
+			{"Status", c.Status},
+			{"Customer since", formatTime(c.CreatedAt)},
+			{"Last updated", formatTime(c.UpdatedAt)},
+		}},
+	}}
+
+	addresses := section{Title: "Addresses"}
+	for _, a := range b.Addresses {
+		until := "present"
+		if a.ValidTo != nil {
+			until = a.ValidTo.UTC().Format(dateLayout)
+		}
+		lines := []string{a.Street1, a.Street2, a.City, a.State, a.PostalCode, a.Country}
+		var parts []string
+		for _, line := range lines {
+			if line != "" {
+				parts = append(parts, line)
+			}
+		}
+		entry := []field{
+			{"Type", a.AddressType},
+			{"Address", strings.Join(parts, ", ")},
+			{"Valid", a.ValidFrom.UTC().Format(dateLayout) + " to " + until},
+		}
+		if a.IsPrimary {
+			entry = append(entry, field{"Primary", "Yes"})
+		}
+		addresses.Entries = append(addresses.Entries, entry)
+	}
+	out = append(out, addresses)
+
+	documents := section{Title: "Documents"}
+	for _, d := range b.Documents {
+		entry := []field{
+			{"Type", d.DocumentType},
+			{"Number", d.DocumentNumber},
+			{"Issued by", d.IssuingAuthority + ", " + d.IssuingCountry},
+			{"Valid", d.IssueDate + " to " + d.ExpiryDate},
+			{"Verification", d.VerificationStatus},
+		}
+		for _, f := range d.Files {
+			entry = append(entry, field{"Scan", fmt.Sprintf("%s (%s, %d bytes, uploaded %s)", f.FileName, f.ContentType, f.Size, formatTime(f.UploadedAt))})
+		}
+		documents.Entries = append(documents.Entries, entry)
+	}
+	out = append(out, documents)
+
+	history := section{Title: "Status history"}
+	for _, s := range b.StatusHistory {
+		history.Entries = append(history.Entries, []field{
+			{"When", formatTime(s.ChangedAt)},
+			{"Change", s.PreviousStatus + " to " + s.NewStatus},
+			{"Reason", s.Reason},
+		})
+	}
+	out = append(out, history)
+
+	consents := section{Title: "Consents"}
+	for _, c := range b.Consents {
+		withdrawn := "No"
+		if c.WithdrawnAt != nil {
+			withdrawn = formatTime(*c.WithdrawnAt) + " via " + c.WithdrawalSource
+		}
+		consents.Entries = append(consents.Entries, []field{
+			{"Purpose", c.Purpose},
+			{"Channel", c.Channel},
+			{"Policy version", c.PolicyVersion},
+			{"Given", formatTime(c.GrantedAt) + " via " + c.Source},
+			{"Withdrawn", withdrawn},
+		})
+	}
+	out = append(out, consents)
+
+	events := section{Title: "Use of your data"}
+	for _, e := range b.AuditEvents {
+		events.Entries = append(events.Entries, []field{
+			{"When", formatTime(e.At)},
+			{"Event", e.Detail},
+			{"By", e.Actor},
+		})
+	}
+	out = append(out, events)
+
+	return append(out, section{
+		Title: "About this export",
+		Entries: [][]field{{
+			{"Export", b.ExportID},
+			{"Generated", formatTime(b.GeneratedAt)},
+			{"JSON SHA-256", seal.SHA256},
+			{"Ed25519 signature", seal.Signature},
+			{"Public key", seal.PublicKey},
+		}},
+	})
+}
+
+var htmlTemplate = template.Must(template.New("bundle").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Personal data held on {{.Customer}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1em; width: 100%; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { width: 12em; background: #f4f4f4; }
+td { word-break: break-all; }
+</style>
+</head>
+<body>
+<h1>Personal data held on {{.Customer}}</h1>
+<p>Generated {{.Generated}}. The signed JSON bundle delivered with this document is the authoritative copy.</p>
+{{range .Sections}}<h2>{{.Title}}</h2>
+{{range .Entries}}<table>
+{{range .}}<tr><th>{{.Label}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+{{else}}<p>None.</p>
+{{end}}{{end}}</body>
+</html>
+`))
+
+// RenderHTML writes the bundle as an HTML page
+func RenderHTML(w io.Writer, b *Bundle, seal Seal) error {
+	err := htmlTemplate.Execute(w, struct {
+		Customer  string
+		Generated string
+		Sections  []section
+	}{b.Customer.CustomerNumber, formatTime(b.GeneratedAt), sections(b, seal)})
+	if err != nil {
+		return fmt.Errorf("failed to render HTML: %w", err)
+	}
+	return nil
+}
+
+// RenderPDF writes the bundle as a PDF document
+func RenderPDF(w io.Writer, b *Bundle, seal Seal) error {
+	doc := &pdfDocument{}
+	doc.heading("Personal data held on " + b.Customer.CustomerNumber)
+	doc.text("Generated " + formatTime(b.GeneratedAt) + ". The signed JSON bundle delivered with this document is the authoritative copy.")
+	for _, s := range sections(b, seal) {
+		doc.space()
+		doc.heading(s.Title)
+		if len(s.Entries) == 0 {
+			doc.text("None.")
+		}
+		for i, entry := range s.Entries {
+			if i > 0 {
+				doc.space()
+			}
+			for _, f := range entry {
+				doc.field(f.Label, f.Value)
+			}
+		}
+	}
+	if _, err := w.Write(doc.bytes()); err != nil {
+		return fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return nil
+}
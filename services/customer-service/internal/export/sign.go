@@ -0,0 +1,57 @@
+package export
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrBadSignature is returned when a bundle does not match its signature
+var ErrBadSignature = errors.New("bundle signature does not verify")
+
+// Signer signs bundles with an Ed25519 key, so anyone holding the public key
+// can check a bundle came from us unaltered
+type Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewSigner creates a Signer from a hex-encoded 32-byte Ed25519 seed
+func NewSigner(seed string) (*Signer, error) {
+	raw, err := hex.DecodeString(strings.TrimSpace(seed))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing key: %w", err)
+	}
+	if len(raw) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid signing key: must be %d bytes, got %d", ed25519.SeedSize, len(raw))
+	}
+	return &Signer{key: ed25519.NewKeyFromSeed(raw)}, nil
+}
+
+// Sign returns the base64 signature of data
+func (s *Signer) Sign(data []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, data))
+}
+
+// PublicKey returns the base64 public key verifying the signer's signatures
+func (s *Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+// Verify checks a base64 signature of data against a base64 public key
+func Verify(publicKey, signature string, data []byte) error {
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid public key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if !ed25519.Verify(ed25519.PublicKey(key), data, sig) {
+		return ErrBadSignature
+	}
+	return nil
+}
@@ -8,8 +8,11 @@ import (
 	"net"
 	"time"
 
+	"github.com/core-banking/services/customer-service/internal/phone"
+	"github.com/core-banking/services/customer-service/internal/postal"
 	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
 	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/core-banking/services/customer-service/internal/screening"
 	"github.com/core-banking/services/customer-service/internal/service"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -33,12 +36,48 @@ type Config struct {
 	MaxSendSize int
 	Timeout     time.Duration
 	EnableAuth  bool
+	Screener    *screening.Screener    // Nil disables sanctions screening
+	Risk        *service.RiskAssessor  // Nil disables KYC risk rating
+	Files       *service.DocumentFiles // Nil disables document file storage
+	Retention   *service.Retention     // Nil refuses erasure requests
+	Exports     *service.DataExports   // Nil refuses subject access requests
+	Merger      *service.Merger        // Nil refuses customer merges
+	Matcher     *service.Matcher       // Nil disables duplicate checks
+	Addresses   *postal.Config         // Nil checks addresses under the generic rules alone
+	Phones      *phone.Config          // Nil takes phone numbers in international format only
 }
 
 // NewServer creates a new gRPC server
 func NewServer(repo repository.CustomerRepository, cfg Config) *Server {
 	// Create customer service
 	customerService := service.NewCustomerService(repo)
+	if cfg.Screener != nil {
+		customerService.SetScreener(cfg.Screener)
+	}
+	if cfg.Risk != nil {
+		customerService.SetRiskAssessor(cfg.Risk)
+	}
+	if cfg.Files != nil {
+		customerService.SetDocumentFiles(cfg.Files)
+	}
+	if cfg.Retention != nil {
+		customerService.SetRetention(cfg.Retention)
+	}
+	if cfg.Exports != nil {
+		customerService.SetDataExports(cfg.Exports)
+	}
+	if cfg.Merger != nil {
+		customerService.SetMerger(cfg.Merger)
+	}
+	if cfg.Matcher != nil {
+		customerService.SetMatcher(cfg.Matcher)
+	}
+	if cfg.Addresses != nil {
+		customerService.SetAddressRules(cfg.Addresses)
+	}
+	if cfg.Phones != nil {
+		customerService.SetPhoneRules(cfg.Phones)
+	}
 
 	// Create unary interceptors
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
@@ -0,0 +1,106 @@
+// Package matching scores how likely two customer records are to describe
+// the same person, and derives the blocking keys used to find the records
+// worth scoring without comparing against every customer.
+package matching
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Weights is how many of a score's 100 points each field can contribute.
+// A field scores its full weight on an exact match and part of it on a near
+// match.
+type Weights struct {
+	Name        int `json:"name"`
+	DateOfBirth int `json:"date_of_birth"`
+	Email       int `json:"email"`
+	Phone       int `json:"phone"`
+	Address     int `json:"address"`
+}
+
+func (w Weights) total() int {
+	return w.Name + w.DateOfBirth + w.Email + w.Phone + w.Address
+}
+
+// Config is a versioned duplicate-matching model. Every duplicate check
+// records the version of the model that scored it.
+type Config struct {
+	Version string  `json:"version"`
+	Weights Weights `json:"weights"`
+	// Candidates scoring at least ReviewThreshold are reported as potential
+	// duplicates
+	ReviewThreshold int `json:"review_threshold"`
+	// A new customer scoring at least BlockThreshold against an existing
+	// one is not created without an override reason. Zero never blocks.
+	BlockThreshold int `json:"block_threshold"`
+	// MaxCandidates bounds how many customers sharing a blocking key are
+	// scored
+	MaxCandidates int `json:"max_candidates"`
+}
+
+// DefaultConfig returns the model used when none is configured
+func DefaultConfig() *Config {
+	return &Config{
+		Version: "default-1",
+		Weights: Weights{
+			Name:        35,
+			DateOfBirth: 25,
+			Email:       15,
+			Phone:       15,
+			Address:     10,
+		},
+		ReviewThreshold: 50,
+		BlockThreshold:  85,
+		MaxCandidates:   200,
+	}
+}
+
+// Validate checks the model is usable
+func (c *Config) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("matching model has no version")
+	}
+	if len(c.Version) > 64 {
+		return fmt.Errorf("matching model version must not exceed 64 characters")
+	}
+	w := c.Weights
+	if w.Name < 0 || w.DateOfBirth < 0 || w.Email < 0 || w.Phone < 0 || w.Address < 0 {
+		return fmt.Errorf("weights must not be negative")
+	}
+	if w.total() != 100 {
+		return fmt.Errorf("weights must add up to 100, not %d", w.total())
+	}
+	if c.ReviewThreshold < 1 || c.ReviewThreshold > 100 {
+		return fmt.Errorf("review threshold must be between 1 and 100")
+	}
+	if c.BlockThreshold != 0 && (c.BlockThreshold < c.ReviewThreshold || c.BlockThreshold > 100) {
+		return fmt.Errorf("block threshold must be 0 or between the review threshold and 100")
+	}
+	if c.MaxCandidates < 1 || c.MaxCandidates > 1000 {
+		return fmt.Errorf("max candidates must be between 1 and 1000")
+	}
+	return nil
+}
+
+// LoadConfig reads and validates a matching model file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read matching model: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates a matching model document
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse matching model: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
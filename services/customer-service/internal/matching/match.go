@@ -0,0 +1,286 @@
+package matching
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Fields scored when matching
+const (
+	FieldName        = "name"
+	FieldDateOfBirth = "date_of_birth"
+	FieldEmail       = "email"
+	FieldPhone       = "phone"
+	FieldAddress     = "address"
+)
+
+// Person is what matching compares of a customer. Street1, PostalCode and
+// Country are those of the primary address, if any.
+type Person struct {
+	FirstName   string
+	LastName    string
+	DateOfBirth time.Time
+	Email       string
+	Phone       string
+	Street1     string
+	PostalCode  string
+	Country     string
+}
+
+// FieldScore is how similar two records are in one field, from 0 to 1
+type FieldScore struct {
+	Field      string
+	Similarity float64
+}
+
+// Match is how likely two records are to describe the same person, from 0
+// to 100, with the fields that contributed
+type Match struct {
+	Score  int
+	Fields []FieldScore
+}
+
+// Score compares two records under the model's weights
+func (c *Config) Score(a, b Person) Match {
+	fields := []struct {
+		name       string
+		weight     int
+		similarity float64
+	}{
+		{FieldName, c.Weights.Name, nameSimilarity(a, b)},
+		{FieldDateOfBirth, c.Weights.DateOfBirth, dateSimilarity(a.DateOfBirth, b.DateOfBirth)},
+		{FieldEmail, c.Weights.Email, exactSimilarity(normalizeEmail(a.Email), normalizeEmail(b.Email))},
+		{FieldPhone, c.Weights.Phone, exactSimilarity(normalizePhone(a.Phone), normalizePhone(b.Phone))},
+		{FieldAddress, c.Weights.Address, addressSimilarity(a, b)},
+	}
+
+	var match Match
+	var total float64
+	for _, f := range fields {
+		if f.similarity == 0 {
+			continue
+		}
+		total += float64(f.weight) * f.similarity
+		match.Fields = append(match.Fields, FieldScore{Field: f.name, Similarity: f.similarity})
+	}
+	match.Score = int(math.Round(total))
+	return match
+}
+
+// Keys returns the blocking keys of a record. Records sharing no key are
+// never compared, so each key stands for a kind of near match the model
+// should catch: a phonetic name with the same date of birth, a changed last
+// name, a mistyped date of birth, or the same email, phone or postcode.
+func Keys(p Person) []string {
+	first, last := nameCodes(firstToken(p.FirstName)), nameCodes(p.LastName)
+	var keys []string
+	add := func(key string) {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	if !p.DateOfBirth.IsZero() {
+		dob := p.DateOfBirth.Format("20060102")
+		for _, code := range last {
+			add("ld:" + code + ":" + dob)
+		}
+		for _, code := range first {
+			add("fd:" + code + ":" + dob)
+		}
+	}
+	for _, f := range first {
+		for _, l := range last {
+			add("nm:" + f + ":" + l)
+		}
+	}
+	if email := normalizeEmail(p.Email); email != "" {
+		add("em:" + email)
+	}
+	if phone := normalizePhone(p.Phone); phone != "" {
+		add("ph:" + phone)
+	}
+	if postal := normalizePostal(p.PostalCode); postal != "" {
+		for _, code := range last {
+			add("pc:" + postal + ":" + code)
+		}
+	}
+	return keys
+}
+
+// nameTokens upper-cases a name, removes accents and splits it into words
+func nameTokens(name string) []string {
+	name = foldAccents.Replace(strings.ToUpper(name))
+	return strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) })
+}
+
+func firstToken(name string) string {
+	if tokens := nameTokens(name); len(tokens) > 0 {
+		return tokens[0]
+	}
+	return ""
+}
+
+// nameCodes returns the distinct phonetic codes of each word of a name
+func nameCodes(name string) []string {
+	var codes []string
+	for _, token := range nameTokens(name) {
+		primary, alternate := DoubleMetaphone(token)
+		for _, code := range []string{primary, alternate} {
+			if code != "" && !slices.Contains(codes, code) {
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
+
+// wordSimilarity compares two names: 1 when spelled alike, 0.8 when they
+// sound alike or differ by a single typo, 0.5 when one is the other's
+// initial
+func wordSimilarity(a, b string) float64 {
+	ta, tb := strings.Join(nameTokens(a), " "), strings.Join(nameTokens(b), " ")
+	switch {
+	case ta == "" || tb == "":
+		return 0
+	case ta == tb:
+		return 1
+	case slices.ContainsFunc(nameCodes(ta), func(code string) bool { return slices.Contains(nameCodes(tb), code) }):
+		return 0.8
+	case len(ta) >= 4 && len(tb) >= 4 && editDistance(ta, tb) == 1:
+		return 0.8
+	case (len(ta) == 1 && strings.HasPrefix(tb, ta)) || (len(tb) == 1 && strings.HasPrefix(ta, tb)):
+		return 0.5
+	}
+	return 0
+}
+
+// nameSimilarity weighs the last name above the first, and allows for the
+// two having been entered the wrong way round
+func nameSimilarity(a, b Person) float64 {
+	straight := 0.4*wordSimilarity(a.FirstName, b.FirstName) + 0.6*wordSimilarity(a.LastName, b.LastName)
+	swapped := 0.9 * (0.4*wordSimilarity(a.FirstName, b.LastName) + 0.6*wordSimilarity(a.LastName, b.FirstName))
+	return math.Max(straight, swapped)
+}
+
+// dateSimilarity is 1 for the same date, 0.8 for the day and month swapped
+// and 0.5 when any two of year, month and day agree
+func dateSimilarity(a, b time.Time) float64 {
+	if a.IsZero() || b.IsZero() {
+		return 0
+	}
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	switch {
+	case ay == by && am == bm && ad == bd:
+		return 1
+	case ay == by && int(am) == bd && ad == int(bm):
+		return 0.8
+	}
+	agree := 0
+	for _, same := range []bool{ay == by, am == bm, ad == bd} {
+		if same {
+			agree++
+		}
+	}
+	if agree == 2 {
+		return 0.5
+	}
+	return 0
+}
+
+func exactSimilarity(a, b string) float64 {
+	if a != "" && a == b {
+		return 1
+	}
+	return 0
+}
+
+// addressSimilarity is 1 for the same street and postcode, 0.6 for the same
+// postcode alone, within the same country
+func addressSimilarity(a, b Person) float64 {
+	postal := normalizePostal(a.PostalCode)
+	if postal == "" || postal != normalizePostal(b.PostalCode) {
+		return 0
+	}
+	if a.Country != "" && b.Country != "" && !strings.EqualFold(a.Country, b.Country) {
+		return 0
+	}
+	if street := normalizeStreet(a.Street1); street != "" && street == normalizeStreet(b.Street1) {
+		return 1
+	}
+	return 0.6
+}
+
+// normalizeEmail lower-cases an email address and drops any +tag from its
+// local part
+func normalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" || domain == "" {
+		return ""
+	}
+	if tag := strings.IndexByte(local, '+'); tag > 0 {
+		local = local[:tag]
+	}
+	return local + "@" + domain
+}
+
+// normalizePhone keeps the last nine digits of a phone number, enough to
+// tell numbers apart while ignoring how the country and trunk prefixes were
+// written
+func normalizePhone(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) < 7 {
+		return ""
+	}
+	if len(digits) > 9 {
+		digits = digits[len(digits)-9:]
+	}
+	return digits
+}
+
+func normalizePostal(postal string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, postal)
+}
+
+func normalizeStreet(street string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToUpper(street), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// editDistance is the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
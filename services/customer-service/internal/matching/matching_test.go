@@ -0,0 +1,179 @@
+package matching
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDoubleMetaphone(t *testing.T) {
+	tests := []struct {
+		word               string
+		primary, alternate string
+	}{
+		{"Smith", "SM0", "XMT"},
+		{"Schmidt", "XMT", "SMT"},
+		{"Michael", "MKL", "MXL"},
+		{"Jose", "HS", "HS"},
+		{"Xavier", "SF", "SFR"},
+		{"Müller", "MLR", "MLR"},
+	}
+	for _, tt := range tests {
+		primary, alternate := DoubleMetaphone(tt.word)
+		if primary != tt.primary || alternate != tt.alternate {
+			t.Errorf("DoubleMetaphone(%q) = %q, %q; want %q, %q", tt.word, primary, alternate, tt.primary, tt.alternate)
+		}
+	}
+}
+
+func TestConfig_Score(t *testing.T) {
+	jane := Person{
+		FirstName:   "Jane",
+		LastName:    "Smith",
+		DateOfBirth: time.Date(1985, 3, 4, 0, 0, 0, 0, time.UTC),
+		Email:       "jane.smith@example.com",
+		Phone:       "+44 7700 900123",
+		Street1:     "1 High Street",
+		PostalCode:  "SW1A 1AA",
+		Country:     "GB",
+	}
+
+	tests := []struct {
+		name     string
+		other    func(p *Person)
+		min, max int
+	}{
+		{"identical", func(p *Person) {}, 100, 100},
+		{"formatting only", func(p *Person) {
+			p.Email = "Jane.Smith+bank@Example.com"
+			p.Phone = "07700 900123"
+			p.PostalCode = "sw1a1aa"
+		}, 100, 100},
+		{"phonetic spelling", func(p *Person) { p.LastName = "Smyth" }, 85, 99},
+		{"swapped day and month", func(p *Person) { p.DateOfBirth = time.Date(1985, 4, 3, 0, 0, 0, 0, time.UTC) }, 85, 99},
+		{"new contact details", func(p *Person) {
+			p.Email, p.Phone, p.Street1, p.PostalCode = "", "", "", ""
+		}, 60, 60},
+		{"namesake", func(p *Person) {
+			p.DateOfBirth = time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC)
+			p.Email, p.Phone, p.Street1, p.PostalCode = "js@example.org", "+15550100", "9 Elm Road", "90210"
+		}, 35, 35},
+		{"different person", func(p *Person) {
+			*p = Person{FirstName: "Ahmed", LastName: "Khan", DateOfBirth: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)}
+		}, 0, 0},
+	}
+	cfg := DefaultConfig()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := jane
+			tt.other(&other)
+			got := cfg.Score(jane, other)
+			if got.Score < tt.min || got.Score > tt.max {
+				t.Errorf("Score() = %+v, want between %d and %d", got, tt.min, tt.max)
+			}
+			if reversed := cfg.Score(other, jane); reversed.Score != got.Score {
+				t.Errorf("Score() is not symmetric: %d and %d", got.Score, reversed.Score)
+			}
+		})
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b Person
+		want float64
+	}{
+		{Person{FirstName: "José", LastName: "García"}, Person{FirstName: "Jose", LastName: "Garcia"}, 1},
+		{Person{FirstName: "J", LastName: "Smith"}, Person{FirstName: "Jane", LastName: "Smith"}, 0.8},
+		{Person{FirstName: "Jane", LastName: "Smith"}, Person{FirstName: "Smith", LastName: "Jane"}, 0.9},
+		{Person{FirstName: "Jane", LastName: "Smith"}, Person{FirstName: "Jane", LastName: "Jones"}, 0.4},
+	}
+	for _, tt := range tests {
+		if got := nameSimilarity(tt.a, tt.b); got < tt.want-0.001 || got > tt.want+0.001 {
+			t.Errorf("nameSimilarity(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	jane := Person{
+		FirstName:   "Jane",
+		LastName:    "Smith",
+		DateOfBirth: time.Date(1985, 3, 14, 0, 0, 0, 0, time.UTC),
+		Email:       "Jane.Smith+bank@example.com",
+		Phone:       "+44 7700 900123",
+		PostalCode:  "SW1A 1AA",
+	}
+	keys := Keys(jane)
+	for _, want := range []string{
+		"ld:SM0:19850314", "ld:XMT:19850314", "fd:JN:19850314", "nm:JN:SM0",
+		"em:jane.smith@example.com", "ph:700900123", "pc:SW1A1AA:SM0",
+	} {
+		if !slices.Contains(keys, want) {
+			t.Errorf("Keys() = %v, missing %q", keys, want)
+		}
+	}
+
+	// Records the model should catch share at least one key
+	variants := []func(p *Person){
+		func(p *Person) { p.LastName = "Smyth"; p.Email, p.Phone, p.PostalCode = "", "", "" },
+		func(p *Person) { p.LastName = "Jones"; p.Email, p.Phone, p.PostalCode = "", "", "" },
+		func(p *Person) {
+			p.DateOfBirth = p.DateOfBirth.AddDate(1, 0, 0)
+			p.Email, p.Phone, p.PostalCode = "", "", ""
+		},
+		func(p *Person) { *p = Person{FirstName: "J", LastName: "Doe", Email: "jane.smith@example.com"} },
+	}
+	for i, vary := range variants {
+		other := jane
+		vary(&other)
+		if !slices.ContainsFunc(Keys(other), func(k string) bool { return slices.Contains(keys, k) }) {
+			t.Errorf("variant %d shares no key: %v", i, Keys(other))
+		}
+	}
+
+	if got := Keys(Person{}); len(got) != 0 {
+		t.Errorf("Keys() of an empty record = %v", got)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := LoadConfig(filepath.Join("..", "..", "config", "matching.json"))
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if cfg.Version == "" || cfg.BlockThreshold != 85 || cfg.Weights.Name != 35 {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr string
+	}{
+		{"no version", func(c *Config) { c.Version = "" }, "no version"},
+		{"negative weight", func(c *Config) { c.Weights.Email = -15; c.Weights.Name = 65 }, "negative"},
+		{"weights not 100", func(c *Config) { c.Weights.Name = 40 }, "add up to 100"},
+		{"bad review threshold", func(c *Config) { c.ReviewThreshold = 0 }, "review threshold"},
+		{"block below review", func(c *Config) { c.BlockThreshold = 40 }, "block threshold"},
+		{"no candidates", func(c *Config) { c.MaxCandidates = 0 }, "max candidates"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ParseConfig([]byte(`{"version": 1}`)); err == nil {
+		t.Error("ParseConfig() accepted a malformed document")
+	}
+}
@@ -0,0 +1,567 @@
+package matching
+
+import (
+	"strings"
+)
+
+// metaphoneLength is the length phonetic keys are cut to
+const metaphoneLength = 4
+
+// DoubleMetaphone returns the primary and alternate Double Metaphone keys of
+// a word, following Lawrence Philips' algorithm. The alternate key differs
+// from the primary only for words whose pronunciation varies, mostly those
+// of non-English origin. Letters other than A-Z, Ç and Ñ are ignored after
+// accents are removed.
+func DoubleMetaphone(word string) (string, string) {
+	m := newMetaphone(word)
+	if len(m.value) == 0 {
+		return "", ""
+	}
+	m.encode()
+	return m.primary.String(), m.alternate.String()
+}
+
+type metaphone struct {
+	value     []rune
+	last      int
+	slavo     bool
+	primary   strings.Builder
+	alternate strings.Builder
+}
+
+// foldAccents maps accented capitals to the letters they are encoded as.
+// Ç and Ñ are kept, as the algorithm treats them specially.
+var foldAccents = strings.NewReplacer(
+	"À", "A", "Á", "A", "Â", "A", "Ã", "A", "Ä", "A", "Å", "A",
+	"È", "E", "É", "E", "Ê", "E", "Ë", "E",
+	"Ì", "I", "Í", "I", "Î", "I", "Ï", "I",
+	"Ò", "O", "Ó", "O", "Ô", "O", "Õ", "O", "Ö", "O", "Ø", "O",
+	"Ù", "U", "Ú", "U", "Û", "U", "Ü", "U",
+	"Ý", "Y", "ß", "SS",
+)
+
+func newMetaphone(word string) *metaphone {
+	word = foldAccents.Replace(strings.ToUpper(word))
+	var value []rune
+	for _, r := range word {
+		if (r >= 'A' && r <= 'Z') || r == 'Ç' || r == 'Ñ' {
+			value = append(value, r)
+		}
+	}
+	s := string(value)
+	return &metaphone{
+		value: value,
+		last:  len(value) - 1,
+		slavo: strings.Contains(s, "W") || strings.Contains(s, "K") || strings.Contains(s, "CZ") || strings.Contains(s, "WITZ"),
+	}
+}
+
+func (m *metaphone) full() bool {
+	return m.primary.Len() >= metaphoneLength && m.alternate.Len() >= metaphoneLength
+}
+
+// add appends to both keys, or to the primary and alternate separately when
+// an alternate is given
+func (m *metaphone) add(primary string, alternate ...string) {
+	m.addPrimary(primary)
+	if len(alternate) > 0 {
+		m.addAlternate(alternate[0])
+	} else {
+		m.addAlternate(primary)
+	}
+}
+
+func (m *metaphone) addPrimary(s string) {
+	if room := metaphoneLength - m.primary.Len(); room > 0 {
+		m.primary.WriteString(s[:min(room, len(s))])
+	}
+}
+
+func (m *metaphone) addAlternate(s string) {
+	if room := metaphoneLength - m.alternate.Len(); room > 0 {
+		m.alternate.WriteString(s[:min(room, len(s))])
+	}
+}
+
+// at returns the letter at index, or 0 outside the word
+func (m *metaphone) at(index int) rune {
+	if index < 0 || index > m.last {
+		return 0
+	}
+	return m.value[index]
+}
+
+// has reports whether the letters from start spell any of the candidates,
+// all of which must be length letters long
+func (m *metaphone) has(start, length int, candidates ...string) bool {
+	if start < 0 || start+length > len(m.value) {
+		return false
+	}
+	s := string(m.value[start : start+length])
+	for _, c := range candidates {
+		if s == c {
+			return true
+		}
+	}
+	return false
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("AEIOUY", r)
+}
+
+func (m *metaphone) encode() {
+	index := 0
+	if m.has(0, 2, "GN", "KN", "PN", "WR", "PS") {
+		index = 1
+	}
+	if m.at(0) == 'X' {
+		m.add("S")
+		index = 1
+	}
+
+	for !m.full() && index <= m.last {
+		switch c := m.at(index); c {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			if index == 0 {
+				m.add("A")
+			}
+			index++
+		case 'B':
+			m.add("P")
+			index += m.skip(index, 'B')
+		case 'Ç':
+			m.add("S")
+			index++
+		case 'C':
+			index = m.encodeC(index)
+		case 'D':
+			index = m.encodeD(index)
+		case 'F':
+			m.add("F")
+			index += m.skip(index, 'F')
+		case 'G':
+			index = m.encodeG(index)
+		case 'H':
+			if (index == 0 || isVowel(m.at(index-1))) && isVowel(m.at(index+1)) {
+				m.add("H")
+				index += 2
+			} else {
+				index++
+			}
+		case 'J':
+			index = m.encodeJ(index)
+		case 'K':
+			m.add("K")
+			index += m.skip(index, 'K')
+		case 'L':
+			index = m.encodeL(index)
+		case 'M':
+			m.add("M")
+			if m.at(index+1) == 'M' || (m.has(index-1, 3, "UMB") && (index+1 == m.last || m.has(index+2, 2, "ER"))) {
+				index += 2
+			} else {
+				index++
+			}
+		case 'N':
+			m.add("N")
+			index += m.skip(index, 'N')
+		case 'Ñ':
+			m.add("N")
+			index++
+		case 'P':
+			if m.at(index+1) == 'H' {
+				m.add("F")
+				index += 2
+			} else {
+				m.add("P")
+				if m.has(index+1, 1, "P", "B") {
+					index += 2
+				} else {
+					index++
+				}
+			}
+		case 'Q':
+			m.add("K")
+			index += m.skip(index, 'Q')
+		case 'R':
+			if index == m.last && !m.slavo && m.has(index-2, 2, "IE") && !m.has(index-4, 2, "ME", "MA") {
+				m.addAlternate("R")
+			} else {
+				m.add("R")
+			}
+			index += m.skip(index, 'R')
+		case 'S':
+			index = m.encodeS(index)
+		case 'T':
+			index = m.encodeT(index)
+		case 'V':
+			m.add("F")
+			index += m.skip(index, 'V')
+		case 'W':
+			index = m.encodeW(index)
+		case 'X':
+			index = m.encodeX(index)
+		case 'Z':
+			index = m.encodeZ(index)
+		default:
+			index++
+		}
+	}
+}
+
+// skip returns 2 when the letter at index is doubled, else 1
+func (m *metaphone) skip(index int, letter rune) int {
+	if m.at(index+1) == letter {
+		return 2
+	}
+	return 1
+}
+
+func (m *metaphone) encodeC(index int) int {
+	switch {
+	case m.conditionC0(index):
+		m.add("K")
+		return index + 2
+	case index == 0 && m.has(index, 6, "CAESAR"):
+		m.add("S")
+		return index + 2
+	case m.has(index, 2, "CH"):
+		return m.encodeCH(index)
+	case m.has(index, 2, "CZ") && !m.has(index-2, 4, "WICZ"):
+		m.add("S", "X")
+		return index + 2
+	case m.has(index+1, 3, "CIA"):
+		m.add("X")
+		return index + 3
+	case m.has(index, 2, "CC") && !(index == 1 && m.at(0) == 'M'):
+		if m.has(index+2, 1, "I", "E", "H") && !m.has(index+2, 2, "HU") {
+			if (index == 1 && m.at(index-1) == 'A') || m.has(index-1, 5, "UCCEE", "UCCES") {
+				m.add("KS")
+			} else {
+				m.add("X")
+			}
+			return index + 3
+		}
+		m.add("K")
+		return index + 2
+	case m.has(index, 2, "CK", "CG", "CQ"):
+		m.add("K")
+		return index + 2
+	case m.has(index, 2, "CI", "CE", "CY"):
+		if m.has(index, 3, "CIO", "CIE", "CIA") {
+			m.add("S", "X")
+		} else {
+			m.add("S")
+		}
+		return index + 2
+	}
+
+	m.add("K")
+	switch {
+	case m.has(index+1, 1, "C", "K", "Q") && !m.has(index+1, 2, "CE", "CI"):
+		return index + 2
+	default:
+		return index + 1
+	}
+}
+
+func (m *metaphone) conditionC0(index int) bool {
+	if m.has(index, 4, "CHIA") {
+		return true
+	}
+	if index <= 1 || isVowel(m.at(index-2)) || !m.has(index-1, 3, "ACH") {
+		return false
+	}
+	c := m.at(index + 2)
+	return (c != 'I' && c != 'E') || m.has(index-2, 6, "BACHER", "MACHER")
+}
+
+func (m *metaphone) encodeCH(index int) int {
+	switch {
+	case index > 0 && m.has(index, 4, "CHAE"):
+		m.add("K", "X")
+	case m.conditionCH0(index), m.conditionCH1(index):
+		m.add("K")
+	case index > 0:
+		if m.has(0, 2, "MC") {
+			m.add("K")
+		} else {
+			m.add("X", "K")
+		}
+	default:
+		m.add("X")
+	}
+	return index + 2
+}
+
+func (m *metaphone) conditionCH0(index int) bool {
+	if index != 0 {
+		return false
+	}
+	if !m.has(index+1, 5, "HARAC", "HARIS") && !m.has(index+1, 3, "HOR", "HYM", "HIA", "HEM") {
+		return false
+	}
+	return !m.has(0, 5, "CHORE")
+}
+
+func (m *metaphone) conditionCH1(index int) bool {
+	return m.has(0, 3, "SCH") ||
+		m.has(index-2, 6, "ORCHES", "ARCHIT", "ORCHID") ||
+		m.has(index+2, 1, "T", "S") ||
+		((m.has(index-1, 1, "A", "O", "U", "E") || index == 0) &&
+			(m.has(index+2, 1, "L", "R", "N", "M", "B", "H", "F", "V", "W") || index+1 == m.last))
+}
+
+func (m *metaphone) encodeD(index int) int {
+	switch {
+	case m.has(index, 2, "DG"):
+		if m.has(index+2, 1, "I", "E", "Y") {
+			m.add("J")
+			return index + 3
+		}
+		m.add("TK")
+		return index + 2
+	case m.has(index, 2, "DT", "DD"):
+		m.add("T")
+		return index + 2
+	}
+	m.add("T")
+	return index + 1
+}
+
+func (m *metaphone) encodeG(index int) int {
+	next := m.at(index + 1)
+	switch {
+	case next == 'H':
+		return m.encodeGH(index)
+	case next == 'N':
+		switch {
+		case index == 1 && isVowel(m.at(0)) && !m.slavo:
+			m.add("KN", "N")
+		case !m.has(index+2, 2, "EY") && !m.slavo:
+			m.add("N", "KN")
+		default:
+			m.add("KN")
+		}
+		return index + 2
+	case m.has(index+1, 2, "LI") && !m.slavo:
+		m.add("KL", "L")
+		return index + 2
+	case index == 0 && (next == 'Y' || m.has(index+1, 2, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		m.add("K", "J")
+		return index + 2
+	case (m.has(index+1, 2, "ER") || next == 'Y') && !m.has(0, 6, "DANGER", "RANGER", "MANGER") &&
+		!m.has(index-1, 1, "E", "I") && !m.has(index-1, 3, "RGY", "OGY"):
+		m.add("K", "J")
+		return index + 2
+	case m.has(index+1, 1, "E", "I", "Y") || m.has(index-1, 4, "AGGI", "OGGI"):
+		switch {
+		case m.has(0, 3, "SCH") || m.has(index+1, 2, "ET"):
+			m.add("K")
+		case m.has(index+1, 3, "IER"):
+			m.add("J")
+		default:
+			m.add("J", "K")
+		}
+		return index + 2
+	case next == 'G':
+		m.add("K")
+		return index + 2
+	}
+	m.add("K")
+	return index + 1
+}
+
+func (m *metaphone) encodeGH(index int) int {
+	switch {
+	case index > 0 && !isVowel(m.at(index-1)):
+		m.add("K")
+	case index == 0:
+		if m.at(index+2) == 'I' {
+			m.add("J")
+		} else {
+			m.add("K")
+		}
+	case (index > 1 && m.has(index-2, 1, "B", "H", "D")) ||
+		(index > 2 && m.has(index-3, 1, "B", "H", "D")) ||
+		(index > 3 && m.has(index-4, 1, "B", "H")):
+		// Silent, as in "bough" and "broughton"
+	case index > 2 && m.at(index-1) == 'U' && m.has(index-3, 1, "C", "G", "L", "R", "T"):
+		m.add("F")
+	case m.at(index-1) != 'I':
+		m.add("K")
+	}
+	return index + 2
+}
+
+func (m *metaphone) encodeJ(index int) int {
+	if m.has(index, 4, "JOSE") {
+		if len(m.value) == 4 {
+			m.add("H")
+		} else {
+			m.add("J", "H")
+		}
+		return index + 1
+	}
+
+	switch {
+	case index == 0:
+		m.add("J", "A")
+	case isVowel(m.at(index-1)) && !m.slavo && (m.at(index+1) == 'A' || m.at(index+1) == 'O'):
+		m.add("J", "H")
+	case index == m.last:
+		m.add("J", "")
+	case !m.has(index+1, 1, "L", "T", "K", "S", "N", "M", "B", "Z") && !m.has(index-1, 1, "S", "K", "L"):
+		m.add("J")
+	}
+	return index + m.skip(index, 'J')
+}
+
+func (m *metaphone) encodeL(index int) int {
+	if m.at(index+1) != 'L' {
+		m.add("L")
+		return index + 1
+	}
+	if (index == len(m.value)-3 && m.has(index-1, 4, "ILLO", "ILLA", "ALLE")) ||
+		((m.has(m.last-1, 2, "AS", "OS") || m.has(m.last, 1, "A", "O")) && m.has(index-1, 4, "ALLE")) {
+		m.addPrimary("L")
+	} else {
+		m.add("L")
+	}
+	return index + 2
+}
+
+func (m *metaphone) encodeS(index int) int {
+	switch {
+	case m.has(index-1, 3, "ISL", "YSL"):
+		return index + 1
+	case index == 0 && m.has(index, 5, "SUGAR"):
+		m.add("X", "S")
+		return index + 1
+	case m.has(index, 2, "SH"):
+		if m.has(index+1, 4, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			m.add("S")
+		} else {
+			m.add("X")
+		}
+		return index + 2
+	case m.has(index, 3, "SIO", "SIA") || m.has(index, 4, "SIAN"):
+		if m.slavo {
+			m.add("S")
+		} else {
+			m.add("S", "X")
+		}
+		return index + 3
+	case (index == 0 && m.has(index+1, 1, "M", "N", "L", "W")) || m.has(index+1, 1, "Z"):
+		m.add("S", "X")
+		if m.has(index+1, 1, "Z") {
+			return index + 2
+		}
+		return index + 1
+	case m.has(index, 2, "SC"):
+		return m.encodeSC(index)
+	}
+
+	if index == m.last && m.has(index-2, 2, "AI", "OI") {
+		m.addAlternate("S")
+	} else {
+		m.add("S")
+	}
+	if m.has(index+1, 1, "S", "Z") {
+		return index + 2
+	}
+	return index + 1
+}
+
+func (m *metaphone) encodeSC(index int) int {
+	switch {
+	case m.at(index+2) == 'H':
+		switch {
+		case m.has(index+3, 2, "ER", "EN"):
+			m.add("X", "SK")
+		case m.has(index+3, 2, "OO", "UY", "ED", "EM"):
+			m.add("SK")
+		case index == 0 && !isVowel(m.at(3)) && m.at(3) != 'W':
+			m.add("X", "S")
+		default:
+			m.add("X")
+		}
+	case m.has(index+2, 1, "I", "E", "Y"):
+		m.add("S")
+	default:
+		m.add("SK")
+	}
+	return index + 3
+}
+
+func (m *metaphone) encodeT(index int) int {
+	switch {
+	case m.has(index, 4, "TION"), m.has(index, 3, "TIA", "TCH"):
+		m.add("X")
+		return index + 3
+	case m.has(index, 2, "TH") || m.has(index, 3, "TTH"):
+		if m.has(index+2, 2, "OM", "AM") || m.has(0, 3, "SCH") {
+			m.add("T")
+		} else {
+			m.add("0", "T")
+		}
+		return index + 2
+	}
+	m.add("T")
+	if m.has(index+1, 1, "T", "D") {
+		return index + 2
+	}
+	return index + 1
+}
+
+func (m *metaphone) encodeW(index int) int {
+	switch {
+	case m.has(index, 2, "WR"):
+		m.add("R")
+		return index + 2
+	case index == 0 && (isVowel(m.at(index+1)) || m.has(index, 2, "WH")):
+		if isVowel(m.at(index + 1)) {
+			m.add("A", "F")
+		} else {
+			m.add("A")
+		}
+		return index + 1
+	case (index == m.last && isVowel(m.at(index-1))) ||
+		m.has(index-1, 5, "EWSKI", "EWSKY", "OWSKI", "OWSKY") || m.has(0, 3, "SCH"):
+		m.addAlternate("F")
+		return index + 1
+	case m.has(index, 4, "WICZ", "WITZ"):
+		m.add("TS", "FX")
+		return index + 4
+	}
+	return index + 1
+}
+
+func (m *metaphone) encodeX(index int) int {
+	if index == 0 {
+		m.add("S")
+		return index + 1
+	}
+	// Silent at the end of French words such as "breaux"
+	if !(index == m.last && (m.has(index-3, 3, "IAU", "EAU") || m.has(index-2, 2, "AU", "OU"))) {
+		m.add("KS")
+	}
+	if m.has(index+1, 1, "C", "X") {
+		return index + 2
+	}
+	return index + 1
+}
+
+func (m *metaphone) encodeZ(index int) int {
+	if m.at(index+1) == 'H' {
+		m.add("J")
+		return index + 2
+	}
+	if m.has(index+1, 2, "ZO", "ZI", "ZA") || (m.slavo && index > 0 && m.at(index-1) != 'T') {
+		m.add("S", "TS")
+	} else {
+		m.add("S")
+	}
+	return index + m.skip(index, 'Z')
+}
@@ -0,0 +1,161 @@
+// Package merge decides which of two duplicate customer records survives a
+// merge and which value the survivor keeps for each field.
+package merge
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+)
+
+// Field is a customer detail that survivorship rules apply to
+type Field string
+
+const (
+	FieldFirstName   Field = "first_name"
+	FieldMiddleName  Field = "middle_name"
+	FieldLastName    Field = "last_name"
+	FieldDateOfBirth Field = "date_of_birth" // Formatted as 2006-01-02
+	FieldTaxID       Field = "tax_id"
+	FieldEmail       Field = "email"
+	FieldPhone       Field = "phone"
+)
+
+// Fields lists every field in the order they are resolved
+var Fields = []Field{
+	FieldFirstName, FieldMiddleName, FieldLastName, FieldDateOfBirth,
+	FieldTaxID, FieldEmail, FieldPhone,
+}
+
+// Rule says which record a field's value is taken from
+type Rule string
+
+const (
+	// RuleSurvivor always keeps the survivor's value, even when it is empty
+	RuleSurvivor Rule = "survivor"
+	// RuleNonEmpty keeps the survivor's value unless it is empty
+	RuleNonEmpty Rule = "non_empty"
+	// RuleMostRecent takes the value of whichever record was updated last,
+	// falling back to the other record's when it is empty
+	RuleMostRecent Rule = "most_recent"
+	// RuleOldest takes the value of whichever record was created first,
+	// falling back to the other record's when it is empty
+	RuleOldest Rule = "oldest"
+)
+
+// IsValid checks if the rule is valid
+func (r Rule) IsValid() bool {
+	switch r {
+	case RuleSurvivor, RuleNonEmpty, RuleMostRecent, RuleOldest:
+		return true
+	}
+	return false
+}
+
+// Selection says how the survivor is chosen between records of equally
+// preferred status
+type Selection string
+
+const (
+	// SelectOldest keeps the record created first
+	SelectOldest Selection = "oldest"
+	// SelectMostRecent keeps the record updated last
+	SelectMostRecent Selection = "most_recent"
+	// SelectMostComplete keeps the record with the most fields filled in
+	SelectMostComplete Selection = "most_complete"
+)
+
+// IsValid checks if the selection is valid
+func (s Selection) IsValid() bool {
+	switch s {
+	case SelectOldest, SelectMostRecent, SelectMostComplete:
+		return true
+	}
+	return false
+}
+
+// Config is a versioned set of survivorship rules. Every merge records the
+// version of the rules that decided it.
+type Config struct {
+	Version string `json:"version"`
+	// StatusPreference lists customer statuses, most preferred first. A
+	// record whose status is listed earlier survives whatever Survivor
+	// says; unlisted statuses rank last.
+	StatusPreference []string  `json:"status_preference"`
+	Survivor         Selection `json:"survivor"`
+	// Fields left out follow RuleNonEmpty
+	Fields map[Field]Rule `json:"fields"`
+	// UndoDays is how long after a merge it may be reversed
+	UndoDays int `json:"undo_days"`
+}
+
+// DefaultConfig returns the rules used when none are configured
+func DefaultConfig() *Config {
+	return &Config{
+		Version:          "default-1",
+		StatusPreference: []string{"Active", "Restricted", "Suspended", "Inactive", "Pending"},
+		Survivor:         SelectOldest,
+		Fields: map[Field]Rule{
+			FieldFirstName:   RuleSurvivor,
+			FieldMiddleName:  RuleNonEmpty,
+			FieldLastName:    RuleSurvivor,
+			FieldDateOfBirth: RuleSurvivor,
+			FieldTaxID:       RuleNonEmpty,
+			FieldEmail:       RuleMostRecent,
+			FieldPhone:       RuleMostRecent,
+		},
+		UndoDays: 30,
+	}
+}
+
+// Validate checks the rules are usable
+func (c *Config) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("merge rules have no version")
+	}
+	if len(c.Version) > 64 {
+		return fmt.Errorf("merge rules version must not exceed 64 characters")
+	}
+	for i, s := range c.StatusPreference {
+		if slices.Contains(c.StatusPreference[:i], s) {
+			return fmt.Errorf("status_preference: %s is listed twice", s)
+		}
+	}
+	if !c.Survivor.IsValid() {
+		return fmt.Errorf("survivor must be oldest, most_recent or most_complete")
+	}
+	for field, rule := range c.Fields {
+		if !slices.Contains(Fields, field) {
+			return fmt.Errorf("fields: unknown field %q", field)
+		}
+		if !rule.IsValid() {
+			return fmt.Errorf("fields: %s: rule must be survivor, non_empty, most_recent or oldest", field)
+		}
+	}
+	if c.UndoDays < 1 || c.UndoDays > 365 {
+		return fmt.Errorf("undo days must be between 1 and 365")
+	}
+	return nil
+}
+
+// LoadConfig reads and validates a merge rules file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read merge rules: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates a merge rules document
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse merge rules: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,133 @@
+package merge
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	older = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	newer = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestConfig_Survives(t *testing.T) {
+	sparse := Record{Status: "Active", CreatedAt: older, UpdatedAt: older, Values: map[Field]string{FieldFirstName: "Jane"}}
+	full := Record{Status: "Active", CreatedAt: newer, UpdatedAt: newer, Values: map[Field]string{FieldFirstName: "Jane", FieldEmail: "jane@example.com"}}
+	pending := Record{Status: "Pending", CreatedAt: older.AddDate(-1, 0, 0), UpdatedAt: older, Values: full.Values}
+
+	tests := []struct {
+		name     string
+		survivor Selection
+		a, b     Record
+		want     bool
+	}{
+		{"oldest", SelectOldest, sparse, full, true},
+		{"oldest reversed", SelectOldest, full, sparse, false},
+		{"most recent", SelectMostRecent, sparse, full, false},
+		{"most complete", SelectMostComplete, full, sparse, true},
+		{"status beats age", SelectOldest, sparse, pending, true},
+		{"status beats age reversed", SelectOldest, pending, sparse, false},
+		{"tie keeps first", SelectMostComplete, full, full, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Survivor = tt.survivor
+			if got := cfg.Survives(tt.a, tt.b); got != tt.want {
+				t.Errorf("Survives() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Resolve(t *testing.T) {
+	survivor := Record{Status: "Active", CreatedAt: older, UpdatedAt: older, Values: map[Field]string{
+		FieldFirstName: "Jane",
+		FieldLastName:  "Doe",
+		FieldEmail:     "jane@old.example.com",
+		FieldPhone:     "+441632960001",
+	}}
+	merged := Record{Status: "Pending", CreatedAt: newer, UpdatedAt: newer, Values: map[Field]string{
+		FieldFirstName:  "Janet",
+		FieldMiddleName: "Ann",
+		FieldLastName:   "Doe",
+		FieldTaxID:      "AB12345678",
+		FieldEmail:      "jane@new.example.com",
+	}}
+
+	cfg := DefaultConfig()
+	cfg.Fields[FieldFirstName] = RuleOldest
+	delete(cfg.Fields, FieldMiddleName)
+
+	got := make(map[Field]Choice)
+	for _, c := range cfg.Resolve(survivor, merged) {
+		got[c.Field] = c
+	}
+	want := map[Field]string{
+		FieldMiddleName: "Ann",                  // Unlisted fields fill gaps
+		FieldTaxID:      "AB12345678",           // non_empty
+		FieldEmail:      "jane@new.example.com", // most_recent
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Resolve() = %+v, want changes to %v", got, want)
+	}
+	for field, value := range want {
+		if got[field].After != value || got[field].Before != survivor.Values[field] {
+			t.Errorf("Resolve() %s = %+v, want %q", field, got[field], value)
+		}
+	}
+
+	// The older record's value stands under oldest even when it is the
+	// merged one, and a missing value never wins
+	cfg.Fields[FieldFirstName] = RuleOldest
+	choices := cfg.Resolve(merged, survivor)
+	for _, c := range choices {
+		if c.Field == FieldFirstName && c.After != "Jane" {
+			t.Errorf("Resolve() first name = %+v, want Jane", c)
+		}
+		if c.Field == FieldTaxID || c.Field == FieldMiddleName {
+			t.Errorf("Resolve() replaced %s with an empty value", c.Field)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := LoadConfig(filepath.Join("..", "..", "config", "merge_rules.json"))
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if cfg.Version == "" || cfg.Survivor != SelectOldest || cfg.Fields[FieldEmail] != RuleMostRecent || cfg.UndoDays != 30 {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr string
+	}{
+		{"no version", func(c *Config) { c.Version = "" }, "no version"},
+		{"duplicate status", func(c *Config) { c.StatusPreference = []string{"Active", "Active"} }, "listed twice"},
+		{"bad survivor", func(c *Config) { c.Survivor = "newest" }, "survivor"},
+		{"unknown field", func(c *Config) { c.Fields["nickname"] = RuleSurvivor }, "unknown field"},
+		{"bad rule", func(c *Config) { c.Fields[FieldEmail] = "latest" }, "rule must be"},
+		{"no undo window", func(c *Config) { c.UndoDays = 0 }, "undo days"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ParseConfig([]byte(`{"version": 1}`)); err == nil {
+		t.Error("ParseConfig() accepted a malformed document")
+	}
+}
@@ -0,0 +1,91 @@
+package merge
+
+import (
+	"slices"
+	"time"
+)
+
+// Record is what the rules need to know about one of the customers being
+// merged
+type Record struct {
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Values    map[Field]string
+}
+
+// completeness counts the fields the record has a value for
+func (r Record) completeness() int {
+	n := 0
+	for _, field := range Fields {
+		if r.Values[field] != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Choice is a field whose value on the survivor changes in a merge
+type Choice struct {
+	Field  Field
+	Before string // The survivor's value
+	After  string // The merged record's value
+}
+
+// statusRank ranks a status by preference; lower is better
+func (c *Config) statusRank(s string) int {
+	if i := slices.Index(c.StatusPreference, s); i >= 0 {
+		return i
+	}
+	return len(c.StatusPreference)
+}
+
+// Survives reports whether a survives a merge with b. Records of equally
+// preferred status are decided by the Survivor selection and then by age,
+// the older surviving; a survives a complete tie.
+func (c *Config) Survives(a, b Record) bool {
+	if ra, rb := c.statusRank(a.Status), c.statusRank(b.Status); ra != rb {
+		return ra < rb
+	}
+	switch c.Survivor {
+	case SelectMostRecent:
+		if !a.UpdatedAt.Equal(b.UpdatedAt) {
+			return a.UpdatedAt.After(b.UpdatedAt)
+		}
+	case SelectMostComplete:
+		if ca, cb := a.completeness(), b.completeness(); ca != cb {
+			return ca > cb
+		}
+	}
+	return !b.CreatedAt.Before(a.CreatedAt)
+}
+
+// Resolve applies the field rules to a survivor and the record merged into
+// it, returning the fields whose value on the survivor changes
+func (c *Config) Resolve(survivor, merged Record) []Choice {
+	var choices []Choice
+	for _, field := range Fields {
+		current, other := survivor.Values[field], merged.Values[field]
+		if other == "" || other == current {
+			continue
+		}
+
+		rule, ok := c.Fields[field]
+		if !ok {
+			rule = RuleNonEmpty
+		}
+		take := false
+		switch rule {
+		case RuleNonEmpty:
+			take = current == ""
+		case RuleMostRecent:
+			take = current == "" || merged.UpdatedAt.After(survivor.UpdatedAt)
+		case RuleOldest:
+			take = current == "" || merged.CreatedAt.Before(survivor.CreatedAt)
+		}
+		if take {
+			choices = append(choices, Choice{Field: field, Before: current, After: other})
+		}
+	}
+	return choices
+}
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS customer_relationships;
+DROP TYPE IF EXISTS relationship_role;
+ALTER TABLE customers
+    DROP CONSTRAINT IF EXISTS customers_type_details_check,
+    ALTER COLUMN date_of_birth SET NOT NULL,
+    DROP COLUMN IF EXISTS industry_code,
+    DROP COLUMN IF EXISTS incorporation_date,
+    DROP COLUMN IF EXISTS lei,
+    DROP COLUMN IF EXISTS jurisdiction,
+    DROP COLUMN IF EXISTS registration_number,
+    DROP COLUMN IF EXISTS registered_name,
+    DROP COLUMN IF EXISTS customer_type;
+DROP TYPE IF EXISTS customer_type;
@@ -0,0 +1,44 @@
+-- Customers are individuals or businesses. Businesses have no name or date
+-- of birth of their own, only registration details.
+CREATE TYPE customer_type AS ENUM ('Individual', 'Business');
+
+ALTER TABLE customers
+    ADD COLUMN customer_type customer_type NOT NULL DEFAULT 'Individual',
+    ADD COLUMN registered_name VARCHAR(200),
+    ADD COLUMN registration_number VARCHAR(50),
+    ADD COLUMN jurisdiction CHAR(2),
+    ADD COLUMN lei CHAR(20),
+    ADD COLUMN incorporation_date DATE,
+    ADD COLUMN industry_code VARCHAR(10),
+    ALTER COLUMN date_of_birth DROP NOT NULL,
+    ADD CONSTRAINT customers_type_details_check CHECK (
+        (customer_type = 'Individual' AND date_of_birth IS NOT NULL AND registered_name IS NULL)
+        OR (customer_type = 'Business' AND registered_name IS NOT NULL AND registration_number IS NOT NULL
+            AND jurisdiction IS NOT NULL AND incorporation_date IS NOT NULL)
+    );
+
+-- Create customer_relationships table, linking individuals to the
+-- businesses they direct, sign for or own
+CREATE TYPE relationship_role AS ENUM ('Director', 'AuthorisedSignatory', 'BeneficialOwner');
+
+CREATE TABLE customer_relationships (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    business_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    individual_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    role relationship_role NOT NULL,
+    ownership_percentage NUMERIC(5, 2),
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ended_by UUID,
+    ended_at TIMESTAMP WITH TIME ZONE,
+    end_reason TEXT,
+    CHECK (business_id <> individual_id),
+    CHECK ((role = 'BeneficialOwner') = (ownership_percentage IS NOT NULL)),
+    CHECK (ownership_percentage > 0 AND ownership_percentage <= 100)
+);
+
+-- Create indexes for performance. An individual holds each role in a
+-- business at most once at a time.
+CREATE INDEX idx_customers_registration ON customers(jurisdiction, registration_number) WHERE customer_type = 'Business';
+CREATE UNIQUE INDEX idx_customer_relationships_active ON customer_relationships(business_id, individual_id, role) WHERE ended_at IS NULL;
+CREATE INDEX idx_customer_relationships_individual_id ON customer_relationships(individual_id);
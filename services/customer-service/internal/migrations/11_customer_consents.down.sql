@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS customer_consents;
+DROP FUNCTION IF EXISTS protect_customer_consents();
+DROP TYPE IF EXISTS consent_source;
+DROP TYPE IF EXISTS consent_channel;
+DROP TYPE IF EXISTS consent_purpose;
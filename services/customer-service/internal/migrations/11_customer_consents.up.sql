@@ -0,0 +1,50 @@
+-- Create customer_consents table. A consent is evidence of what the customer
+-- agreed to, how and when, so it is never changed once recorded except to be
+-- withdrawn, once, and is never deleted. Agreeing again makes a new consent.
+CREATE TYPE consent_purpose AS ENUM ('Marketing', 'DataSharing', 'ServiceMessages');
+CREATE TYPE consent_channel AS ENUM ('Email', 'SMS', 'Phone', 'Post', 'Push', 'All');
+CREATE TYPE consent_source AS ENUM ('Web', 'MobileApp', 'Branch', 'CallCentre', 'Paper');
+
+CREATE TABLE customer_consents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    purpose consent_purpose NOT NULL,
+    channel consent_channel NOT NULL,
+    policy_version VARCHAR(50) NOT NULL,
+    source consent_source NOT NULL,
+    granted_by UUID NOT NULL,
+    granted_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    withdrawn_by UUID,
+    withdrawn_at TIMESTAMP WITH TIME ZONE,
+    withdrawal_source consent_source,
+    CHECK ((withdrawn_at IS NULL) = (withdrawn_by IS NULL) AND (withdrawn_at IS NULL) = (withdrawal_source IS NULL)),
+    CHECK (withdrawn_at IS NULL OR withdrawn_at >= granted_at)
+);
+
+CREATE OR REPLACE FUNCTION protect_customer_consents()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        RAISE EXCEPTION 'customer consents cannot be deleted';
+    END IF;
+    IF OLD.withdrawn_at IS NOT NULL OR NEW.withdrawn_at IS NULL
+        OR (NEW.id, NEW.customer_id, NEW.purpose, NEW.channel, NEW.policy_version,
+            NEW.source, NEW.granted_by, NEW.granted_at, NEW.recorded_at)
+        IS DISTINCT FROM (OLD.id, OLD.customer_id, OLD.purpose, OLD.channel, OLD.policy_version,
+            OLD.source, OLD.granted_by, OLD.granted_at, OLD.recorded_at) THEN
+        RAISE EXCEPTION 'customer consents can only be withdrawn, once';
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER protect_customer_consents
+    BEFORE UPDATE OR DELETE ON customer_consents
+    FOR EACH ROW
+    EXECUTE FUNCTION protect_customer_consents();
+
+-- Create indexes for performance. A customer holds at most one standing
+-- consent for each purpose and channel.
+CREATE UNIQUE INDEX idx_customer_consents_active ON customer_consents(customer_id, purpose, channel) WHERE withdrawn_at IS NULL;
+CREATE INDEX idx_customer_consents_customer_id ON customer_consents(customer_id, granted_at);
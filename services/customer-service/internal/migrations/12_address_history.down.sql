@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_addresses_customer_valid_from;
+DROP INDEX IF EXISTS idx_addresses_supersedes_id;
+ALTER TABLE addresses DROP COLUMN IF EXISTS supersedes_id;
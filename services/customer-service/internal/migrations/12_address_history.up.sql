@@ -0,0 +1,8 @@
+-- Addresses are effective-dated. A change closes the version in effect and
+-- adds a new one that supersedes it, so each version is superseded at most
+-- once.
+ALTER TABLE addresses
+    ADD COLUMN supersedes_id UUID REFERENCES addresses(id);
+
+CREATE UNIQUE INDEX idx_addresses_supersedes_id ON addresses(supersedes_id) WHERE supersedes_id IS NOT NULL;
+CREATE INDEX idx_addresses_customer_valid_from ON addresses(customer_id, valid_from);
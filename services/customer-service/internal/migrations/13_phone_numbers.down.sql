@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_customers_phone;
+UPDATE customers SET phone = phone_input WHERE phone_input <> '';
+ALTER TABLE customers DROP COLUMN IF EXISTS phone_type;
+ALTER TABLE customers DROP COLUMN IF EXISTS phone_input;
@@ -0,0 +1,12 @@
+-- Phone numbers are stored in E.164 form, next to the number as it was
+-- given and the kind of line it is for. Numbers stored before now were kept
+-- as given: those in international format are reduced to E.164 here, and
+-- the rest stay as they are, of unknown type, until they are next changed.
+ALTER TABLE customers
+    ADD COLUMN phone_input VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN phone_type VARCHAR(20) NOT NULL DEFAULT '';
+
+UPDATE customers SET phone_input = phone, phone_type = 'unknown' WHERE phone <> '';
+UPDATE customers SET phone = '+' || regexp_replace(phone, '[^0-9]', '', 'g') WHERE phone LIKE '+%';
+
+CREATE INDEX idx_customers_phone ON customers(phone) WHERE phone <> '';
@@ -0,0 +1,9 @@
+-- Drop tables
+DROP TABLE IF EXISTS screening_hits;
+DROP TABLE IF EXISTS customer_screenings;
+DROP TABLE IF EXISTS sanctions_list_versions;
+
+-- Drop types
+DROP TYPE IF EXISTS screening_hit_status;
+DROP TYPE IF EXISTS screening_outcome;
+DROP TYPE IF EXISTS screening_trigger;
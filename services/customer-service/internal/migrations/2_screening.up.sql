@@ -0,0 +1,56 @@
+-- Create sanctions_list_versions table
+CREATE TABLE sanctions_list_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    list_name VARCHAR(50) NOT NULL,
+    version VARCHAR(64) NOT NULL,
+    published_at TIMESTAMP WITH TIME ZONE,
+    entry_count INTEGER NOT NULL,
+    source_file TEXT NOT NULL,
+    loaded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    rescreened_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (list_name, version)
+);
+
+-- Create customer_screenings table
+CREATE TYPE screening_trigger AS ENUM ('Onboarding', 'Update', 'Manual', 'ListUpdate');
+CREATE TYPE screening_outcome AS ENUM ('Clear', 'PotentialMatch');
+
+CREATE TABLE customer_screenings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    trigger screening_trigger NOT NULL,
+    outcome screening_outcome NOT NULL,
+    list_versions TEXT[] NOT NULL DEFAULT '{}',
+    screened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create screening_hits table
+CREATE TYPE screening_hit_status AS ENUM ('Pending', 'Confirmed', 'Dismissed');
+
+CREATE TABLE screening_hits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    screening_id UUID NOT NULL REFERENCES customer_screenings(id) ON DELETE CASCADE,
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    list_name VARCHAR(50) NOT NULL,
+    list_version VARCHAR(64) NOT NULL,
+    entry_id VARCHAR(100) NOT NULL,
+    entry_name TEXT NOT NULL,
+    matched_name TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    score NUMERIC(5, 4) NOT NULL,
+    name_score NUMERIC(5, 4) NOT NULL,
+    dob_match VARCHAR(20) NOT NULL,
+    country_match BOOLEAN NOT NULL DEFAULT FALSE,
+    status screening_hit_status NOT NULL DEFAULT 'Pending',
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_customer_screenings_customer_id ON customer_screenings(customer_id, screened_at DESC);
+
+CREATE INDEX idx_screening_hits_customer_id ON screening_hits(customer_id);
+CREATE INDEX idx_screening_hits_screening_id ON screening_hits(screening_id);
+CREATE INDEX idx_screening_hits_pending ON screening_hits(created_at) WHERE status = 'Pending';
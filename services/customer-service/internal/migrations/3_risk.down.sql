@@ -0,0 +1,12 @@
+-- Drop tables
+DROP TABLE IF EXISTS review_tasks;
+DROP TABLE IF EXISTS customer_risk_assessments;
+
+-- Drop types
+DROP TYPE IF EXISTS review_task_status;
+DROP TYPE IF EXISTS risk_assessment_trigger;
+DROP TYPE IF EXISTS risk_rating;
+
+-- Postgres cannot drop an enum value; move restricted customers back to
+-- Active and leave the value unused
+UPDATE customers SET status = 'Active' WHERE status = 'Restricted';
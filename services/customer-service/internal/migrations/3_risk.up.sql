@@ -0,0 +1,44 @@
+-- Customers overdue for a high-risk review are restricted
+ALTER TYPE customer_status ADD VALUE IF NOT EXISTS 'Restricted';
+
+-- Create customer_risk_assessments table
+CREATE TYPE risk_rating AS ENUM ('Low', 'Medium', 'High');
+CREATE TYPE risk_assessment_trigger AS ENUM ('Onboarding', 'Update', 'Screening', 'Scheduled', 'Review', 'Manual');
+
+CREATE TABLE customer_risk_assessments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    rating risk_rating NOT NULL,
+    score INTEGER NOT NULL,
+    factors JSONB NOT NULL DEFAULT '[]',
+    model_version VARCHAR(64) NOT NULL,
+    trigger risk_assessment_trigger NOT NULL,
+    assessed_by UUID,
+    assessed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    next_review_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Create review_tasks table
+CREATE TYPE review_task_status AS ENUM ('Open', 'Completed');
+
+CREATE TABLE review_tasks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    assessment_id UUID NOT NULL REFERENCES customer_risk_assessments(id) ON DELETE CASCADE,
+    rating risk_rating NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status review_task_status NOT NULL DEFAULT 'Open',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    completed_by UUID,
+    notes TEXT
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_customer_risk_assessments_customer_id ON customer_risk_assessments(customer_id, assessed_at DESC);
+CREATE INDEX idx_customer_risk_assessments_next_review ON customer_risk_assessments(next_review_at);
+
+CREATE INDEX idx_review_tasks_customer_id ON review_tasks(customer_id);
+CREATE INDEX idx_review_tasks_open_due ON review_tasks(due_at) WHERE status = 'Open';
+-- A customer has at most one open review at a time
+CREATE UNIQUE INDEX idx_review_tasks_one_open ON review_tasks(customer_id) WHERE status = 'Open';
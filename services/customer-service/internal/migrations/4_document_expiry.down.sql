@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_customer_documents_expiry_date;
+
+-- Drop tables
+DROP TABLE IF EXISTS document_expiry_notices;
@@ -0,0 +1,16 @@
+-- Create document_expiry_notices table
+CREATE TABLE document_expiry_notices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    document_id UUID NOT NULL REFERENCES customer_documents(id) ON DELETE CASCADE,
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    expiry_date DATE NOT NULL,
+    days_before INTEGER NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    -- A customer is told once per notice period, again if the expiry date changes
+    UNIQUE (document_id, expiry_date, days_before)
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_customer_documents_expiry_date ON customer_documents(expiry_date)
+    WHERE verification_status IN ('Pending', 'Verified');
+CREATE INDEX idx_document_expiry_notices_customer_id ON document_expiry_notices(customer_id);
@@ -0,0 +1,3 @@
+-- Drop tables
+DROP TABLE IF EXISTS document_file_access_log;
+DROP TABLE IF EXISTS document_files;
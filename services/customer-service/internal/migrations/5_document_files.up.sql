@@ -0,0 +1,32 @@
+-- Create document_files table
+CREATE TABLE document_files (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    document_id UUID NOT NULL REFERENCES customer_documents(id) ON DELETE CASCADE,
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    uploaded_by UUID NOT NULL,
+    uploaded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create document_file_access_log table. Entries outlive the file so the
+-- trail is kept after a file is removed.
+CREATE TABLE document_file_access_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    file_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    accessed_by UUID NOT NULL,
+    roles TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL,
+    granted BOOLEAN NOT NULL,
+    denial_reason TEXT,
+    accessed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_document_files_document_id ON document_files(document_id);
+CREATE INDEX idx_document_file_access_log_file_id ON document_file_access_log(file_id, accessed_at);
+CREATE INDEX idx_document_file_access_log_accessed_by ON document_file_access_log(accessed_by, accessed_at);
@@ -0,0 +1,25 @@
+-- Drop tables
+DROP TABLE IF EXISTS erasure_requests;
+DROP TABLE IF EXISTS legal_holds;
+DROP TABLE IF EXISTS customer_retention;
+
+-- Drop types
+DROP TYPE IF EXISTS erasure_request_status;
+
+-- Restore cascading deletes
+ALTER TABLE addresses DROP CONSTRAINT addresses_customer_id_fkey,
+    ADD CONSTRAINT addresses_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
+ALTER TABLE customer_documents DROP CONSTRAINT customer_documents_customer_id_fkey,
+    ADD CONSTRAINT customer_documents_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
+ALTER TABLE customer_screenings DROP CONSTRAINT customer_screenings_customer_id_fkey,
+    ADD CONSTRAINT customer_screenings_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
+ALTER TABLE screening_hits DROP CONSTRAINT screening_hits_customer_id_fkey,
+    ADD CONSTRAINT screening_hits_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
+ALTER TABLE customer_risk_assessments DROP CONSTRAINT customer_risk_assessments_customer_id_fkey,
+    ADD CONSTRAINT customer_risk_assessments_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
+ALTER TABLE review_tasks DROP CONSTRAINT review_tasks_customer_id_fkey,
+    ADD CONSTRAINT review_tasks_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
+ALTER TABLE document_expiry_notices DROP CONSTRAINT document_expiry_notices_customer_id_fkey,
+    ADD CONSTRAINT document_expiry_notices_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
+ALTER TABLE document_files DROP CONSTRAINT document_files_customer_id_fkey,
+    ADD CONSTRAINT document_files_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE;
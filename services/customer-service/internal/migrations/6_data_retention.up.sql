@@ -0,0 +1,61 @@
+-- Records linked to a customer are kept for as long as the law requires, so
+-- deleting a customer no longer takes them along; customers are
+-- pseudonymized instead once their retention period is over
+ALTER TABLE addresses DROP CONSTRAINT addresses_customer_id_fkey,
+    ADD CONSTRAINT addresses_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+ALTER TABLE customer_documents DROP CONSTRAINT customer_documents_customer_id_fkey,
+    ADD CONSTRAINT customer_documents_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+ALTER TABLE customer_screenings DROP CONSTRAINT customer_screenings_customer_id_fkey,
+    ADD CONSTRAINT customer_screenings_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+ALTER TABLE screening_hits DROP CONSTRAINT screening_hits_customer_id_fkey,
+    ADD CONSTRAINT screening_hits_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+ALTER TABLE customer_risk_assessments DROP CONSTRAINT customer_risk_assessments_customer_id_fkey,
+    ADD CONSTRAINT customer_risk_assessments_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+ALTER TABLE review_tasks DROP CONSTRAINT review_tasks_customer_id_fkey,
+    ADD CONSTRAINT review_tasks_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+ALTER TABLE document_expiry_notices DROP CONSTRAINT document_expiry_notices_customer_id_fkey,
+    ADD CONSTRAINT document_expiry_notices_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+ALTER TABLE document_files DROP CONSTRAINT document_files_customer_id_fkey,
+    ADD CONSTRAINT document_files_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE RESTRICT;
+
+-- Create customer_retention table
+CREATE TABLE customer_retention (
+    customer_id UUID PRIMARY KEY REFERENCES customers(id) ON DELETE RESTRICT,
+    closed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    pseudonymized_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Customers closed before retention was tracked are taken to have closed
+-- when they were last updated
+INSERT INTO customer_retention (customer_id, closed_at)
+SELECT id, updated_at FROM customers WHERE status = 'Closed';
+
+-- Create legal_holds table
+CREATE TABLE legal_holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    reason TEXT NOT NULL,
+    placed_by UUID NOT NULL,
+    placed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    released_by UUID,
+    released_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create erasure_requests table
+CREATE TYPE erasure_request_status AS ENUM ('Completed', 'Refused');
+
+CREATE TABLE erasure_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    requested_by UUID NOT NULL,
+    reason TEXT NOT NULL,
+    status erasure_request_status NOT NULL,
+    blockers TEXT[] NOT NULL DEFAULT '{}',
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_customer_retention_due ON customer_retention(closed_at) WHERE pseudonymized_at IS NULL;
+CREATE INDEX idx_legal_holds_customer_id ON legal_holds(customer_id, placed_at DESC);
+CREATE INDEX idx_legal_holds_active ON legal_holds(customer_id) WHERE released_at IS NULL;
+CREATE INDEX idx_erasure_requests_customer_id ON erasure_requests(customer_id, requested_at DESC);
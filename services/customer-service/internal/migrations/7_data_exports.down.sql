@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_document_file_access_log_customer_id;
+DROP TABLE IF EXISTS data_exports;
+DROP TYPE IF EXISTS data_export_status;
+DROP TABLE IF EXISTS customer_status_history;
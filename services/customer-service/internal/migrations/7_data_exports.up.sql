@@ -0,0 +1,35 @@
+-- Create customer_status_history table
+CREATE TABLE customer_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    previous_status customer_status NOT NULL,
+    new_status customer_status NOT NULL,
+    reason TEXT NOT NULL,
+    changed_by UUID, -- NULL when changed by the service itself
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create data_exports table. The bundles themselves are kept encrypted in
+-- the document store.
+CREATE TYPE data_export_status AS ENUM ('Pending', 'Completed', 'Failed');
+
+CREATE TABLE data_exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    requested_by UUID NOT NULL,
+    status data_export_status NOT NULL DEFAULT 'Pending',
+    records INTEGER NOT NULL DEFAULT 0,
+    sha256 CHAR(64),
+    signature TEXT,
+    signing_key TEXT,
+    error TEXT,
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_customer_status_history_customer_id ON customer_status_history(customer_id, changed_at);
+CREATE INDEX idx_document_file_access_log_customer_id ON document_file_access_log(customer_id, accessed_at);
+CREATE INDEX idx_data_exports_customer_id ON data_exports(customer_id, requested_at DESC);
+CREATE INDEX idx_data_exports_pending ON data_exports(requested_at) WHERE status = 'Pending';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS customer_events;
+DROP TYPE IF EXISTS customer_event_type;
+DROP TABLE IF EXISTS customer_merges;
@@ -0,0 +1,39 @@
+-- Create customer_merges table. field_changes is encrypted JSON, as it holds
+-- tax IDs.
+CREATE TABLE customer_merges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    survivor_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    merged_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    rules_version VARCHAR(64) NOT NULL,
+    reason TEXT NOT NULL,
+    field_changes TEXT NOT NULL,
+    moved_addresses UUID[] NOT NULL DEFAULT '{}',
+    demoted_addresses UUID[] NOT NULL DEFAULT '{}',
+    moved_documents UUID[] NOT NULL DEFAULT '{}',
+    merged_status customer_status NOT NULL,
+    merged_by UUID NOT NULL,
+    merged_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    undo_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    unmerged_by UUID,
+    unmerged_at TIMESTAMP WITH TIME ZONE,
+    unmerge_reason TEXT,
+    CHECK (survivor_id <> merged_id)
+);
+
+-- Create customer_events table, the feed other services follow
+CREATE TYPE customer_event_type AS ENUM ('CustomerMerged', 'CustomerUnmerged');
+
+CREATE TABLE customer_events (
+    sequence BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    type customer_event_type NOT NULL,
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    related_customer_id UUID REFERENCES customers(id) ON DELETE RESTRICT,
+    reference_id UUID,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for performance. A customer can stand merged into only
+-- one other at a time.
+CREATE UNIQUE INDEX idx_customer_merges_merged_id ON customer_merges(merged_id) WHERE unmerged_at IS NULL;
+CREATE INDEX idx_customer_merges_survivor_id ON customer_merges(survivor_id, merged_at DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS duplicate_overrides;
+DROP TABLE IF EXISTS customer_match_keys;
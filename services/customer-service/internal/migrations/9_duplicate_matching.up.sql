@@ -0,0 +1,25 @@
+-- Create customer_match_keys table. Each customer is filed under the
+-- blocking keys derived from its details, so the customers worth comparing
+-- with a new one are found through the key index rather than a scan.
+CREATE TABLE customer_match_keys (
+    key VARCHAR(320) NOT NULL,
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    PRIMARY KEY (key, customer_id)
+);
+
+-- Create duplicate_overrides table, recording customers created despite
+-- scoring as a likely duplicate
+CREATE TABLE duplicate_overrides (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    candidate_id UUID NOT NULL REFERENCES customers(id) ON DELETE RESTRICT,
+    score INTEGER NOT NULL CHECK (score BETWEEN 0 AND 100),
+    rules_version VARCHAR(64) NOT NULL,
+    reason TEXT NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_customer_match_keys_customer_id ON customer_match_keys(customer_id);
+CREATE INDEX idx_duplicate_overrides_customer_id ON duplicate_overrides(customer_id);
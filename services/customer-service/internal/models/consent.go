@@ -0,0 +1,173 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrConsentWithdrawn is returned when withdrawing a consent that has
+// already been withdrawn
+var ErrConsentWithdrawn = errors.New("consent has already been withdrawn")
+
+// ConsentPurpose is what a customer consents to
+type ConsentPurpose string
+
+const (
+	ConsentPurposeMarketing       ConsentPurpose = "Marketing"
+	ConsentPurposeDataSharing     ConsentPurpose = "DataSharing"
+	ConsentPurposeServiceMessages ConsentPurpose = "ServiceMessages" // The channels the customer prefers for service messages
+)
+
+// IsValid checks if the consent purpose is valid
+func (p ConsentPurpose) IsValid() bool {
+	switch p {
+	case ConsentPurposeMarketing, ConsentPurposeDataSharing, ConsentPurposeServiceMessages:
+		return true
+	}
+	return false
+}
+
+// ConsentChannel is the channel a consent covers
+type ConsentChannel string
+
+const (
+	ConsentChannelEmail ConsentChannel = "Email"
+	ConsentChannelSMS   ConsentChannel = "SMS"
+	ConsentChannelPhone ConsentChannel = "Phone"
+	ConsentChannelPost  ConsentChannel = "Post"
+	ConsentChannelPush  ConsentChannel = "Push"
+	ConsentChannelAll   ConsentChannel = "All" // Every channel, or none in particular
+)
+
+// IsValid checks if the consent channel is valid
+func (c ConsentChannel) IsValid() bool {
+	switch c {
+	case ConsentChannelEmail, ConsentChannelSMS, ConsentChannelPhone,
+		ConsentChannelPost, ConsentChannelPush, ConsentChannelAll:
+		return true
+	}
+	return false
+}
+
+// ConsentSource is how a consent was given or withdrawn
+type ConsentSource string
+
+const (
+	ConsentSourceWeb        ConsentSource = "Web"
+	ConsentSourceMobileApp  ConsentSource = "MobileApp"
+	ConsentSourceBranch     ConsentSource = "Branch"
+	ConsentSourceCallCentre ConsentSource = "CallCentre"
+	ConsentSourcePaper      ConsentSource = "Paper"
+)
+
+// IsValid checks if the consent source is valid
+func (s ConsentSource) IsValid() bool {
+	switch s {
+	case ConsentSourceWeb, ConsentSourceMobileApp, ConsentSourceBranch,
+		ConsentSourceCallCentre, ConsentSourcePaper:
+		return true
+	}
+	return false
+}
+
+// Consent records a customer agreeing to one purpose on one channel under a
+// version of the policy text. Consents are never changed once recorded,
+// except to be withdrawn; agreeing again makes a new consent.
+type Consent struct {
+	ID               uuid.UUID      `json:"id" db:"id"`
+	CustomerID       uuid.UUID      `json:"customer_id" db:"customer_id"`
+	Purpose          ConsentPurpose `json:"purpose" db:"purpose"`
+	Channel          ConsentChannel `json:"channel" db:"channel"`
+	PolicyVersion    string         `json:"policy_version" db:"policy_version"`
+	Source           ConsentSource  `json:"source" db:"source"`
+	GrantedBy        uuid.UUID      `json:"granted_by" db:"granted_by"`
+	GrantedAt        time.Time      `json:"granted_at" db:"granted_at"`   // When the customer agreed
+	RecordedAt       time.Time      `json:"recorded_at" db:"recorded_at"` // When the bank recorded it
+	WithdrawnBy      *uuid.UUID     `json:"withdrawn_by,omitempty" db:"withdrawn_by"`
+	WithdrawnAt      *time.Time     `json:"withdrawn_at,omitempty" db:"withdrawn_at"`
+	WithdrawalSource *ConsentSource `json:"withdrawal_source,omitempty" db:"withdrawal_source"`
+}
+
+// IsActive reports whether the consent has not been withdrawn
+func (c *Consent) IsActive() bool {
+	return c.WithdrawnAt == nil
+}
+
+// ActiveAt reports whether the consent stood at t
+func (c *Consent) ActiveAt(t time.Time) bool {
+	return !c.GrantedAt.After(t) && (c.WithdrawnAt == nil || t.Before(*c.WithdrawnAt))
+}
+
+// Covers reports whether the consent is for purpose on channel. A consent
+// for all channels covers each of them.
+func (c *Consent) Covers(purpose ConsentPurpose, channel ConsentChannel) bool {
+	return c.Purpose == purpose && (c.Channel == channel || c.Channel == ConsentChannelAll)
+}
+
+// Withdraw marks the consent withdrawn
+func (c *Consent) Withdraw(by uuid.UUID, source ConsentSource, at time.Time) error {
+	if !c.IsActive() {
+		return ErrConsentWithdrawn
+	}
+	c.WithdrawnBy = &by
+	c.WithdrawnAt = &at
+	c.WithdrawalSource = &source
+	return nil
+}
+
+// Value implements driver.Valuer for ConsentPurpose
+func (p ConsentPurpose) Value() (driver.Value, error) {
+	return string(p), nil
+}
+
+// Scan implements sql.Scanner for ConsentPurpose
+func (p *ConsentPurpose) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ConsentPurpose")
+	}
+	*p = ConsentPurpose(str)
+	if !p.IsValid() {
+		return errors.New("invalid ConsentPurpose value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for ConsentChannel
+func (c ConsentChannel) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// Scan implements sql.Scanner for ConsentChannel
+func (c *ConsentChannel) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ConsentChannel")
+	}
+	*c = ConsentChannel(str)
+	if !c.IsValid() {
+		return errors.New("invalid ConsentChannel value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for ConsentSource
+func (s ConsentSource) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for ConsentSource
+func (s *ConsentSource) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ConsentSource")
+	}
+	*s = ConsentSource(str)
+	if !s.IsValid() {
+		return errors.New("invalid ConsentSource value")
+	}
+	return nil
+}
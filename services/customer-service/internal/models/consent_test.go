@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsent_Withdraw(t *testing.T) {
+	granted := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	withdrawn := granted.AddDate(0, 6, 0)
+	officer := uuid.New()
+
+	consent := &Consent{Purpose: ConsentPurposeMarketing, Channel: ConsentChannelEmail, GrantedAt: granted}
+	require.True(t, consent.IsActive())
+	require.NoError(t, consent.Withdraw(officer, ConsentSourceWeb, withdrawn))
+	assert.False(t, consent.IsActive())
+	assert.Equal(t, officer, *consent.WithdrawnBy)
+	assert.Equal(t, ConsentSourceWeb, *consent.WithdrawalSource)
+
+	assert.False(t, consent.ActiveAt(granted.Add(-time.Second)), "before granted")
+	assert.True(t, consent.ActiveAt(granted))
+	assert.True(t, consent.ActiveAt(withdrawn.Add(-time.Second)))
+	assert.False(t, consent.ActiveAt(withdrawn), "once withdrawn")
+
+	assert.ErrorIs(t, consent.Withdraw(officer, ConsentSourceWeb, withdrawn), ErrConsentWithdrawn)
+}
+
+func TestConsent_Covers(t *testing.T) {
+	email := &Consent{Purpose: ConsentPurposeMarketing, Channel: ConsentChannelEmail}
+	all := &Consent{Purpose: ConsentPurposeMarketing, Channel: ConsentChannelAll}
+
+	assert.True(t, email.Covers(ConsentPurposeMarketing, ConsentChannelEmail))
+	assert.False(t, email.Covers(ConsentPurposeMarketing, ConsentChannelSMS))
+	assert.False(t, email.Covers(ConsentPurposeDataSharing, ConsentChannelEmail))
+	assert.True(t, all.Covers(ConsentPurposeMarketing, ConsentChannelSMS))
+	assert.False(t, all.Covers(ConsentPurposeServiceMessages, ConsentChannelSMS))
+}
+
+func TestConsentEnums_Scan(t *testing.T) {
+	var purpose ConsentPurpose
+	require.NoError(t, purpose.Scan("DataSharing"))
+	assert.Equal(t, ConsentPurposeDataSharing, purpose)
+	assert.Error(t, purpose.Scan("Profiling"))
+
+	var channel ConsentChannel
+	require.NoError(t, channel.Scan("SMS"))
+	assert.Error(t, channel.Scan(42))
+
+	var source ConsentSource
+	require.NoError(t, source.Scan("CallCentre"))
+	assert.Error(t, source.Scan("Fax"))
+}
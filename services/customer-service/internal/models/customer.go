@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/core-banking/services/customer-service/internal/phone"
 	"github.com/google/uuid"
 )
 
@@ -13,18 +14,36 @@ import (
 type CustomerStatus string
 
 const (
-	CustomerStatusPending   CustomerStatus = "Pending"
-	CustomerStatusActive    CustomerStatus = "Active"
-	CustomerStatusInactive  CustomerStatus = "Inactive"
-	CustomerStatusSuspended CustomerStatus = "Suspended"
-	CustomerStatusClosed    CustomerStatus = "Closed"
+	CustomerStatusPending    CustomerStatus = "Pending"
+	CustomerStatusActive     CustomerStatus = "Active"
+	CustomerStatusInactive   CustomerStatus = "Inactive"
+	CustomerStatusSuspended  CustomerStatus = "Suspended"
+	CustomerStatusClosed     CustomerStatus = "Closed"
+	CustomerStatusRestricted CustomerStatus = "Restricted" // Blocked until KYC is brought up to date
 )
 
 // IsValid checks if the status is valid
 func (s CustomerStatus) IsValid() bool {
 	switch s {
 	case CustomerStatusPending, CustomerStatusActive, CustomerStatusInactive,
-		CustomerStatusSuspended, CustomerStatusClosed:
+		CustomerStatusSuspended, CustomerStatusClosed, CustomerStatusRestricted:
+		return true
+	}
+	return false
+}
+
+// CustomerType distinguishes natural persons from legal entities
+type CustomerType string
+
+const (
+	CustomerTypeIndividual CustomerType = "Individual"
+	CustomerTypeBusiness   CustomerType = "Business"
+)
+
+// IsValid checks if the customer type is valid
+func (t CustomerType) IsValid() bool {
+	switch t {
+	case CustomerTypeIndividual, CustomerTypeBusiness:
 		return true
 	}
 	return false
@@ -91,26 +110,57 @@ func (s VerificationStatus) IsValid() bool {
 	return false
 }
 
-// Customer represents a customer entity in the core banking system
+// Customer represents a customer entity in the core banking system. An
+// individual has a name and date of birth; a business has neither, and its
+// details are in Business instead.
 type Customer struct {
-	ID             uuid.UUID      `json:"id" db:"id"`
-	CustomerNumber string         `json:"customer_number" db:"customer_number"`
-	FirstName      string         `json:"first_name" db:"first_name"`
-	MiddleName     *string        `json:"middle_name,omitempty" db:"middle_name"`
-	LastName       string         `json:"last_name" db:"last_name"`
-	DateOfBirth    time.Time      `json:"date_of_birth" db:"date_of_birth"`
-	TaxID          string         `json:"-" db:"tax_id"` // Encrypted field, not exposed in JSON
-	Email          string         `json:"email" db:"email"`
-	Phone          string         `json:"phone" db:"phone"`
-	Status         CustomerStatus `json:"status" db:"status"`
-	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
-	UpdatedAt      time.Time      `json:"updated_at" db:"updated_at"`
-	CreatedBy      uuid.UUID      `json:"created_by" db:"created_by"`
-	UpdatedBy      *uuid.UUID     `json:"updated_by,omitempty" db:"updated_by"`
-	Version        int            `json:"version" db:"version"` // Optimistic locking
-}
-
-// Address represents a customer's address
+	ID             uuid.UUID        `json:"id" db:"id"`
+	CustomerNumber string           `json:"customer_number" db:"customer_number"`
+	Type           CustomerType     `json:"customer_type" db:"customer_type"`
+	FirstName      string           `json:"first_name" db:"first_name"`
+	MiddleName     *string          `json:"middle_name,omitempty" db:"middle_name"`
+	LastName       string           `json:"last_name" db:"last_name"`
+	DateOfBirth    time.Time        `json:"date_of_birth" db:"date_of_birth"` // Zero for businesses
+	Business       *BusinessDetails `json:"business,omitempty"`               // Set for businesses only
+	TaxID          string           `json:"-" db:"tax_id"`                    // Encrypted field, not exposed in JSON
+	Email          string           `json:"email" db:"email"`
+	Phone          string           `json:"phone" db:"phone"`             // E.164
+	PhoneInput     string           `json:"phone_input" db:"phone_input"` // As given
+	PhoneType      phone.Type       `json:"phone_type" db:"phone_type"`
+	Status         CustomerStatus   `json:"status" db:"status"`
+	CreatedAt      time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at" db:"updated_at"`
+	CreatedBy      uuid.UUID        `json:"created_by" db:"created_by"`
+	UpdatedBy      *uuid.UUID       `json:"updated_by,omitempty" db:"updated_by"`
+	Version        int              `json:"version" db:"version"` // Optimistic locking
+}
+
+// IsBusiness reports whether the customer is a legal entity
+func (c *Customer) IsBusiness() bool {
+	return c.Type == CustomerTypeBusiness
+}
+
+// BusinessDetails are the registration details of a legal-entity customer
+type BusinessDetails struct {
+	RegisteredName     string    `json:"registered_name" db:"registered_name"`
+	RegistrationNumber string    `json:"registration_number" db:"registration_number"`
+	Jurisdiction       string    `json:"jurisdiction" db:"jurisdiction"` // ISO 3166-1 alpha-2
+	LEI                string    `json:"lei,omitempty" db:"lei"`
+	IncorporationDate  time.Time `json:"incorporation_date" db:"incorporation_date"`
+	IndustryCode       string    `json:"industry_code,omitempty" db:"industry_code"`
+}
+
+// ErrAddressEnded is returned when changing or ending an address version
+// that has already ended
+var ErrAddressEnded = errors.New("address has already ended")
+
+// ErrAddressNotYetValid is returned when an address change would take effect
+// before the version it replaces did
+var ErrAddressNotYetValid = errors.New("change must take effect after the address became valid")
+
+// Address represents a customer's address. Addresses are effective-dated:
+// a change closes the version in effect and opens a new one that supersedes
+// it, so the addresses held on any past day can be recovered.
 type Address struct {
 	ID          uuid.UUID   `json:"id" db:"id"`
 	CustomerID  uuid.UUID   `json:"customer_id" db:"customer_id"`
@@ -124,8 +174,41 @@ type Address struct {
 	IsPrimary   bool        `json:"is_primary" db:"is_primary"`
 	ValidFrom   time.Time   `json:"valid_from" db:"valid_from"`
 	ValidTo     *time.Time  `json:"valid_to,omitempty" db:"valid_to"`
-	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+	// SupersedesID is the version this one replaced, if any
+	SupersedesID *uuid.UUID `json:"supersedes_id,omitempty" db:"supersedes_id"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// InEffectAt reports whether the address version was in effect at t
+func (a *Address) InEffectAt(t time.Time) bool {
+	return !a.ValidFrom.After(t) && (a.ValidTo == nil || a.ValidTo.After(t))
+}
+
+// End closes the address version at at
+func (a *Address) End(at time.Time) error {
+	if a.ValidTo != nil && !a.ValidTo.After(at) {
+		return ErrAddressEnded
+	}
+	if !at.After(a.ValidFrom) {
+		return ErrAddressNotYetValid
+	}
+	a.ValidTo = &at
+	return nil
+}
+
+// Supersede closes the address version at at and returns the version that
+// replaces it, a copy valid from at until the old version would have ended.
+// The caller changes the copy as needed before saving both.
+func (a *Address) Supersede(at time.Time) (*Address, error) {
+	next := *a
+	if err := a.End(at); err != nil {
+		return nil, err
+	}
+	next.ID = uuid.New()
+	next.ValidFrom = at
+	next.SupersedesID = &a.ID
+	return &next, nil
 }
 
 // CustomerDocument represents a customer's identification document
@@ -145,12 +228,28 @@ type CustomerDocument struct {
 	UpdatedAt          time.Time          `json:"updated_at" db:"updated_at"`
 }
 
+// IsExpired reports whether the document's expiry date has passed at now
+func (d *CustomerDocument) IsExpired(now time.Time) bool {
+	return d.ExpiryDate.Before(now)
+}
+
+// DocumentExpiryNotice records that a customer was told a document is about
+// to expire
+type DocumentExpiryNotice struct {
+	ID         uuid.UUID `json:"id" db:"id"`
+	DocumentID uuid.UUID `json:"document_id" db:"document_id"`
+	CustomerID uuid.UUID `json:"customer_id" db:"customer_id"`
+	ExpiryDate time.Time `json:"expiry_date" db:"expiry_date"`
+	DaysBefore int       `json:"days_before" db:"days_before"` // The notice period the notice was sent for
+	SentAt     time.Time `json:"sent_at" db:"sent_at"`
+}
+
 // SearchFilters represents the filters for customer search
 type SearchFilters struct {
 	FirstName string         `json:"first_name,omitempty"`
 	LastName  string         `json:"last_name,omitempty"`
 	Email     string         `json:"email,omitempty"`
-	Phone     string         `json:"phone,omitempty"`
+	Phone     string         `json:"phone,omitempty"` // E.164 to match exactly, or digits to find in the number
 	Status    CustomerStatus `json:"status,omitempty"`
 	FromDate  *time.Time     `json:"from_date,omitempty"`
 	ToDate    *time.Time     `json:"to_date,omitempty"`
@@ -191,6 +290,28 @@ func (s *CustomerStatus) Scan(value interface{}) error {
 	return nil
 }
 
+// Value implements driver.Valuer for CustomerType
+func (t CustomerType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for CustomerType
+func (t *CustomerType) Scan(value interface{}) error {
+	if value == nil {
+		*t = CustomerTypeIndividual
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan CustomerType")
+	}
+	*t = CustomerType(str)
+	if !t.IsValid() {
+		return errors.New("invalid CustomerType value")
+	}
+	return nil
+}
+
 // Value implements driver.Valuer for AddressType
 func (t AddressType) Value() (driver.Value, error) {
 	return string(t), nil
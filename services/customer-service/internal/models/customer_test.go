@@ -272,6 +272,52 @@ func TestAddress_MarshalJSON(t *testing.T) {
 	assert.True(t, result.IsPrimary)
 }
 
+func TestAddress_Supersede(t *testing.T) {
+	validFrom := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	address := &Address{
+		ID:        uuid.New(),
+		Street1:   "1 Old Road",
+		IsPrimary: true,
+		ValidFrom: validFrom,
+	}
+
+	_, err := address.Supersede(validFrom)
+	assert.ErrorIs(t, err, ErrAddressNotYetValid)
+	assert.Nil(t, address.ValidTo)
+
+	movedAt := validFrom.AddDate(1, 0, 0)
+	next, err := address.Supersede(movedAt)
+	require.NoError(t, err)
+	require.NotNil(t, address.ValidTo)
+	assert.Equal(t, movedAt, *address.ValidTo)
+	assert.NotEqual(t, address.ID, next.ID)
+	assert.Equal(t, address.ID, *next.SupersedesID)
+	assert.Equal(t, movedAt, next.ValidFrom)
+	assert.Nil(t, next.ValidTo)
+	assert.True(t, next.IsPrimary)
+
+	assert.True(t, address.InEffectAt(movedAt.Add(-time.Second)))
+	assert.False(t, address.InEffectAt(movedAt))
+	assert.False(t, next.InEffectAt(movedAt.Add(-time.Second)))
+	assert.True(t, next.InEffectAt(movedAt))
+
+	_, err = address.Supersede(movedAt.AddDate(0, 1, 0))
+	assert.ErrorIs(t, err, ErrAddressEnded)
+	assert.ErrorIs(t, address.End(movedAt.AddDate(0, 1, 0)), ErrAddressEnded)
+}
+
+func TestAddress_End(t *testing.T) {
+	validFrom := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	plannedEnd := validFrom.AddDate(2, 0, 0)
+	address := &Address{ID: uuid.New(), ValidFrom: validFrom, ValidTo: &plannedEnd}
+
+	// An end still to come can be brought forward
+	endedAt := validFrom.AddDate(1, 0, 0)
+	require.NoError(t, address.End(endedAt))
+	assert.Equal(t, endedAt, *address.ValidTo)
+	assert.ErrorIs(t, address.End(plannedEnd), ErrAddressEnded)
+}
+
 func TestCustomerDocument_MarshalJSON(t *testing.T) {
 	doc := &CustomerDocument{
 		ID:                 uuid.New(),
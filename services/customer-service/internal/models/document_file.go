@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DocumentFile is a stored scan of a customer document. The content is kept
+// encrypted in a blob store under StorageKey.
+type DocumentFile struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	DocumentID  uuid.UUID `json:"document_id" db:"document_id"`
+	CustomerID  uuid.UUID `json:"customer_id" db:"customer_id"`
+	FileName    string    `json:"file_name" db:"file_name"`
+	ContentType string    `json:"content_type" db:"content_type"` // Sniffed from the content
+	Size        int64     `json:"size" db:"size"`
+	SHA256      string    `json:"sha256" db:"sha256"` // Hex digest of the plaintext
+	StorageKey  string    `json:"-" db:"storage_key"`
+	UploadedBy  uuid.UUID `json:"uploaded_by" db:"uploaded_by"`
+	UploadedAt  time.Time `json:"uploaded_at" db:"uploaded_at"`
+}
+
+// DocumentFileAccess records an attempt to download a document file,
+// whether or not it was allowed
+type DocumentFileAccess struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	FileID       uuid.UUID `json:"file_id" db:"file_id"`
+	CustomerID   uuid.UUID `json:"customer_id" db:"customer_id"`
+	AccessedBy   uuid.UUID `json:"accessed_by" db:"accessed_by"`
+	Roles        string    `json:"roles" db:"roles"` // As presented by the caller, comma-separated
+	Reason       string    `json:"reason" db:"reason"`
+	Granted      bool      `json:"granted" db:"granted"`
+	DenialReason *string   `json:"denial_reason,omitempty" db:"denial_reason"`
+	AccessedAt   time.Time `json:"accessed_at" db:"accessed_at"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DuplicateOverride records a customer created even though it scored as a
+// likely duplicate of an existing one, and why
+type DuplicateOverride struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	CustomerID   uuid.UUID `json:"customer_id" db:"customer_id"`
+	CandidateID  uuid.UUID `json:"candidate_id" db:"candidate_id"`
+	Score        int       `json:"score" db:"score"`
+	RulesVersion string    `json:"rules_version" db:"rules_version"`
+	Reason       string    `json:"reason" db:"reason"`
+	CreatedBy    uuid.UUID `json:"created_by" db:"created_by"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// DuplicateCandidate is an existing customer sharing blocking keys with the
+// details being checked, with its primary address, if it has one
+type DuplicateCandidate struct {
+	Customer   *Customer
+	Street1    string
+	PostalCode string
+	Country    string
+	SharedKeys int
+}
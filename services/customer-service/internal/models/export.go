@@ -0,0 +1,107 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExportStatus represents the progress of a subject access export
+type DataExportStatus string
+
+const (
+	DataExportStatusPending   DataExportStatus = "Pending"
+	DataExportStatusCompleted DataExportStatus = "Completed"
+	DataExportStatusFailed    DataExportStatus = "Failed"
+)
+
+// IsValid checks if the data export status is valid
+func (s DataExportStatus) IsValid() bool {
+	switch s {
+	case DataExportStatusPending, DataExportStatusCompleted, DataExportStatusFailed:
+		return true
+	}
+	return false
+}
+
+// DataExportDeadline is how long the law allows for answering a subject
+// access request
+const DataExportDeadline = 30 * 24 * time.Hour
+
+// ErrDataExportFinished is returned when completing or failing an export
+// that is no longer pending
+var ErrDataExportFinished = errors.New("data export has already finished")
+
+// DataExport is a subject access request: a bundle of everything held on a
+// customer, signed and kept in the document store until downloaded
+type DataExport struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	CustomerID  uuid.UUID        `json:"customer_id" db:"customer_id"`
+	RequestedBy uuid.UUID        `json:"requested_by" db:"requested_by"`
+	Status      DataExportStatus `json:"status" db:"status"`
+	Records     int              `json:"records" db:"records"`                   // Records in the bundle
+	SHA256      *string          `json:"sha256,omitempty" db:"sha256"`           // Hex digest of the JSON bundle
+	Signature   *string          `json:"signature,omitempty" db:"signature"`     // Of the JSON bundle
+	SigningKey  *string          `json:"signing_key,omitempty" db:"signing_key"` // Public key verifying the signature
+	Error       *string          `json:"error,omitempty" db:"error"`
+	RequestedAt time.Time        `json:"requested_at" db:"requested_at"`
+	DueAt       time.Time        `json:"due_at" db:"due_at"`
+	CompletedAt *time.Time       `json:"completed_at,omitempty" db:"completed_at"`
+}
+
+// NewDataExport creates a pending export due within the legal deadline
+func NewDataExport(customerID, requestedBy uuid.UUID, at time.Time) *DataExport {
+	return &DataExport{
+		ID:          uuid.New(),
+		CustomerID:  customerID,
+		RequestedBy: requestedBy,
+		Status:      DataExportStatusPending,
+		RequestedAt: at,
+		DueAt:       at.Add(DataExportDeadline),
+	}
+}
+
+// Complete records the signed bundle produced for the export
+func (e *DataExport) Complete(records int, sha256, signature, signingKey string, at time.Time) error {
+	if e.Status != DataExportStatusPending {
+		return ErrDataExportFinished
+	}
+	e.Status = DataExportStatusCompleted
+	e.Records = records
+	e.SHA256 = &sha256
+	e.Signature = &signature
+	e.SigningKey = &signingKey
+	e.CompletedAt = &at
+	return nil
+}
+
+// Fail records why no bundle could be produced for the export
+func (e *DataExport) Fail(reason string, at time.Time) error {
+	if e.Status != DataExportStatusPending {
+		return ErrDataExportFinished
+	}
+	e.Status = DataExportStatusFailed
+	e.Error = &reason
+	e.CompletedAt = &at
+	return nil
+}
+
+// Value implements driver.Valuer for DataExportStatus
+func (s DataExportStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for DataExportStatus
+func (s *DataExportStatus) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan DataExportStatus")
+	}
+	*s = DataExportStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid DataExportStatus value")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataExportStatus_Scan(t *testing.T) {
+	var status DataExportStatus
+	require.NoError(t, status.Scan("Completed"))
+	assert.Equal(t, DataExportStatusCompleted, status)
+	assert.Error(t, status.Scan("Running"))
+	assert.Error(t, status.Scan(nil))
+}
+
+func TestDataExport_Lifecycle(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	export := NewDataExport(uuid.New(), uuid.New(), at)
+	assert.Equal(t, DataExportStatusPending, export.Status)
+	assert.Equal(t, time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC), export.DueAt)
+
+	done := at.Add(time.Minute)
+	require.NoError(t, export.Complete(12, "abc123", "sig", "key", done))
+	assert.Equal(t, DataExportStatusCompleted, export.Status)
+	assert.Equal(t, 12, export.Records)
+	assert.Equal(t, "sig", *export.Signature)
+	assert.Equal(t, done, *export.CompletedAt)
+	assert.ErrorIs(t, export.Fail("too late", done), ErrDataExportFinished)
+
+	failed := NewDataExport(uuid.New(), uuid.New(), at)
+	require.NoError(t, failed.Fail("store unavailable", done))
+	assert.Equal(t, DataExportStatusFailed, failed.Status)
+	assert.Equal(t, "store unavailable", *failed.Error)
+	assert.ErrorIs(t, failed.Complete(1, "", "", "", done), ErrDataExportFinished)
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrMergeReversed is returned when reversing a merge that has already been
+// reversed
+var ErrMergeReversed = errors.New("merge has already been reversed")
+
+// ErrMergeUndoExpired is returned when reversing a merge after its undo
+// window has closed
+var ErrMergeUndoExpired = errors.New("merge can no longer be reversed")
+
+// MergeFieldChange is a detail of the survivor replaced by the merged
+// customer's value. Before is needed to reverse the merge.
+type MergeFieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// CustomerMerge records a duplicate customer merged into the one that
+// survives, with everything needed to reverse it within its undo window.
+// While the merge stands, lookups of the merged customer lead to the
+// survivor.
+type CustomerMerge struct {
+	ID           uuid.UUID          `json:"id" db:"id"`
+	SurvivorID   uuid.UUID          `json:"survivor_id" db:"survivor_id"`
+	MergedID     uuid.UUID          `json:"merged_id" db:"merged_id"`
+	RulesVersion string             `json:"rules_version" db:"rules_version"`
+	Reason       string             `json:"reason" db:"reason"`
+	FieldChanges []MergeFieldChange `json:"-" db:"field_changes"` // Encrypted, as it holds tax IDs
+	// MovedAddresses and MovedDocuments were the merged customer's;
+	// DemotedAddresses are those of them that lost primary status to the
+	// survivor's own
+	MovedAddresses   []uuid.UUID    `json:"moved_addresses" db:"moved_addresses"`
+	DemotedAddresses []uuid.UUID    `json:"demoted_addresses" db:"demoted_addresses"`
+	MovedDocuments   []uuid.UUID    `json:"moved_documents" db:"moved_documents"`
+	MergedStatus     CustomerStatus `json:"merged_status" db:"merged_status"` // The merged customer's status beforehand
+	MergedBy         uuid.UUID      `json:"merged_by" db:"merged_by"`
+	MergedAt         time.Time      `json:"merged_at" db:"merged_at"`
+	UndoUntil        time.Time      `json:"undo_until" db:"undo_until"`
+	UnmergedBy       *uuid.UUID     `json:"unmerged_by,omitempty" db:"unmerged_by"`
+	UnmergedAt       *time.Time     `json:"unmerged_at,omitempty" db:"unmerged_at"`
+	UnmergeReason    *string        `json:"unmerge_reason,omitempty" db:"unmerge_reason"`
+}
+
+// IsReversed reports whether the merge has been undone
+func (m *CustomerMerge) IsReversed() bool {
+	return m.UnmergedAt != nil
+}
+
+// Reverse marks the merge undone, as long as its undo window is still open
+func (m *CustomerMerge) Reverse(by uuid.UUID, reason string, at time.Time) error {
+	if m.IsReversed() {
+		return ErrMergeReversed
+	}
+	if at.After(m.UndoUntil) {
+		return ErrMergeUndoExpired
+	}
+	m.UnmergedBy = &by
+	m.UnmergedAt = &at
+	m.UnmergeReason = &reason
+	return nil
+}
+
+// CustomerEventType identifies what happened to a customer that other
+// services need to act on
+type CustomerEventType string
+
+const (
+	// CustomerEventMerged means CustomerID was merged into RelatedCustomerID
+	CustomerEventMerged CustomerEventType = "CustomerMerged"
+	// CustomerEventUnmerged means the merge of CustomerID into
+	// RelatedCustomerID was reversed
+	CustomerEventUnmerged CustomerEventType = "CustomerUnmerged"
+)
+
+// IsValid checks if the event type is valid
+func (t CustomerEventType) IsValid() bool {
+	switch t {
+	case CustomerEventMerged, CustomerEventUnmerged:
+		return true
+	}
+	return false
+}
+
+// CustomerEvent is an entry in the feed other services follow to keep their
+// own records of customers in step, such as account ownership. Sequence
+// orders the feed.
+type CustomerEvent struct {
+	Sequence          int64             `json:"sequence" db:"sequence"`
+	ID                uuid.UUID         `json:"id" db:"id"`
+	Type              CustomerEventType `json:"type" db:"type"`
+	CustomerID        uuid.UUID         `json:"customer_id" db:"customer_id"`
+	RelatedCustomerID *uuid.UUID        `json:"related_customer_id,omitempty" db:"related_customer_id"`
+	ReferenceID       *uuid.UUID        `json:"reference_id,omitempty" db:"reference_id"` // The merge, for merge events
+	OccurredAt        time.Time         `json:"occurred_at" db:"occurred_at"`
+}
+
+// Value implements driver.Valuer for CustomerEventType
+func (t CustomerEventType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for CustomerEventType
+func (t *CustomerEventType) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan CustomerEventType")
+	}
+	*t = CustomerEventType(str)
+	if !t.IsValid() {
+		return errors.New("invalid CustomerEventType value")
+	}
+	return nil
+}
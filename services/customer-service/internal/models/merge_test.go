@@ -0,0 +1,36 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerMerge_Reverse(t *testing.T) {
+	officer := uuid.New()
+	mergedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	merge := &CustomerMerge{MergedAt: mergedAt, UndoUntil: mergedAt.AddDate(0, 0, 30)}
+	assert.ErrorIs(t, merge.Reverse(officer, "Too late", merge.UndoUntil.Add(time.Second)), ErrMergeUndoExpired)
+	assert.False(t, merge.IsReversed())
+
+	at := merge.UndoUntil
+	require.NoError(t, merge.Reverse(officer, "Different people", at))
+	assert.True(t, merge.IsReversed())
+	assert.Equal(t, officer, *merge.UnmergedBy)
+	assert.Equal(t, at, *merge.UnmergedAt)
+	assert.Equal(t, "Different people", *merge.UnmergeReason)
+
+	assert.ErrorIs(t, merge.Reverse(officer, "Again", at), ErrMergeReversed)
+}
+
+func TestCustomerEventType_Scan(t *testing.T) {
+	var eventType CustomerEventType
+	require.NoError(t, eventType.Scan("CustomerUnmerged"))
+	assert.Equal(t, CustomerEventUnmerged, eventType)
+	assert.Error(t, eventType.Scan("CustomerCreated"))
+	assert.Error(t, eventType.Scan(nil))
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRelationshipEnded is returned when ending a relationship that has
+// already ended
+var ErrRelationshipEnded = errors.New("relationship has already ended")
+
+// UBOThreshold is the share of a business, in percent, from which a
+// beneficial owner counts as an ultimate beneficial owner (UBO) and must be
+// verified before the business is activated
+const UBOThreshold = 25.0
+
+// RelationshipRole is the part an individual customer plays in a business
+// customer
+type RelationshipRole string
+
+const (
+	RelationshipRoleDirector            RelationshipRole = "Director"
+	RelationshipRoleAuthorisedSignatory RelationshipRole = "AuthorisedSignatory"
+	RelationshipRoleBeneficialOwner     RelationshipRole = "BeneficialOwner"
+)
+
+// IsValid checks if the relationship role is valid
+func (r RelationshipRole) IsValid() bool {
+	switch r {
+	case RelationshipRoleDirector, RelationshipRoleAuthorisedSignatory, RelationshipRoleBeneficialOwner:
+		return true
+	}
+	return false
+}
+
+// CustomerRelationship links an individual customer to a business customer
+// in one role. Only beneficial owners hold an ownership percentage.
+type CustomerRelationship struct {
+	ID                  uuid.UUID        `json:"id" db:"id"`
+	BusinessID          uuid.UUID        `json:"business_id" db:"business_id"`
+	IndividualID        uuid.UUID        `json:"individual_id" db:"individual_id"`
+	Role                RelationshipRole `json:"role" db:"role"`
+	OwnershipPercentage float64          `json:"ownership_percentage,omitempty" db:"ownership_percentage"`
+	CreatedBy           uuid.UUID        `json:"created_by" db:"created_by"`
+	CreatedAt           time.Time        `json:"created_at" db:"created_at"`
+	EndedBy             *uuid.UUID       `json:"ended_by,omitempty" db:"ended_by"`
+	EndedAt             *time.Time       `json:"ended_at,omitempty" db:"ended_at"`
+	EndReason           *string          `json:"end_reason,omitempty" db:"end_reason"`
+}
+
+// IsActive reports whether the relationship still stands
+func (r *CustomerRelationship) IsActive() bool {
+	return r.EndedAt == nil
+}
+
+// IsUBO reports whether the relationship makes the individual an ultimate
+// beneficial owner of the business
+func (r *CustomerRelationship) IsUBO() bool {
+	return r.Role == RelationshipRoleBeneficialOwner && r.OwnershipPercentage >= UBOThreshold
+}
+
+// End marks the relationship ended
+func (r *CustomerRelationship) End(by uuid.UUID, reason string, at time.Time) error {
+	if !r.IsActive() {
+		return ErrRelationshipEnded
+	}
+	r.EndedBy = &by
+	r.EndedAt = &at
+	r.EndReason = &reason
+	return nil
+}
+
+// Value implements driver.Valuer for RelationshipRole
+func (r RelationshipRole) Value() (driver.Value, error) {
+	return string(r), nil
+}
+
+// Scan implements sql.Scanner for RelationshipRole
+func (r *RelationshipRole) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan RelationshipRole")
+	}
+	*r = RelationshipRole(str)
+	if !r.IsValid() {
+		return errors.New("invalid RelationshipRole value")
+	}
+	return nil
+}
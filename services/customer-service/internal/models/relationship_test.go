@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerRelationship_End(t *testing.T) {
+	officer := uuid.New()
+	at := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	relationship := &CustomerRelationship{Role: RelationshipRoleDirector}
+	require.True(t, relationship.IsActive())
+	require.NoError(t, relationship.End(officer, "Resigned", at))
+	assert.False(t, relationship.IsActive())
+	assert.Equal(t, officer, *relationship.EndedBy)
+	assert.Equal(t, at, *relationship.EndedAt)
+	assert.Equal(t, "Resigned", *relationship.EndReason)
+
+	assert.ErrorIs(t, relationship.End(officer, "Again", at), ErrRelationshipEnded)
+}
+
+func TestCustomerRelationship_IsUBO(t *testing.T) {
+	tests := []struct {
+		role      RelationshipRole
+		ownership float64
+		want      bool
+	}{
+		{RelationshipRoleBeneficialOwner, 25, true},
+		{RelationshipRoleBeneficialOwner, 24.99, false},
+		{RelationshipRoleDirector, 50, false},
+	}
+	for _, tt := range tests {
+		r := &CustomerRelationship{Role: tt.role, OwnershipPercentage: tt.ownership}
+		assert.Equal(t, tt.want, r.IsUBO(), "%s %.2f%%", tt.role, tt.ownership)
+	}
+}
+
+func TestCustomerTypeAndRelationshipRole_Scan(t *testing.T) {
+	var customerType CustomerType
+	require.NoError(t, customerType.Scan("Business"))
+	assert.Equal(t, CustomerTypeBusiness, customerType)
+	require.NoError(t, customerType.Scan(nil))
+	assert.Equal(t, CustomerTypeIndividual, customerType)
+	assert.Error(t, customerType.Scan("Trust"))
+
+	var role RelationshipRole
+	require.NoError(t, role.Scan("AuthorisedSignatory"))
+	assert.Equal(t, RelationshipRoleAuthorisedSignatory, role)
+	assert.Error(t, role.Scan("Shareholder"))
+	assert.Error(t, role.Scan(nil))
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErasureRequestStatus represents the outcome of a request to erase a
+// customer's personal data
+type ErasureRequestStatus string
+
+const (
+	ErasureRequestStatusCompleted ErasureRequestStatus = "Completed"
+	ErasureRequestStatusRefused   ErasureRequestStatus = "Refused"
+)
+
+// IsValid checks if the erasure request status is valid
+func (s ErasureRequestStatus) IsValid() bool {
+	switch s {
+	case ErasureRequestStatusCompleted, ErasureRequestStatusRefused:
+		return true
+	}
+	return false
+}
+
+// ErrLegalHoldReleased is returned when releasing a legal hold that has
+// already been released
+var ErrLegalHoldReleased = errors.New("legal hold has already been released")
+
+// CustomerRetention tracks how long a closed customer's personal data is kept
+type CustomerRetention struct {
+	CustomerID      uuid.UUID  `json:"customer_id" db:"customer_id"`
+	ClosedAt        time.Time  `json:"closed_at" db:"closed_at"`
+	PseudonymizedAt *time.Time `json:"pseudonymized_at,omitempty" db:"pseudonymized_at"`
+}
+
+// IsPseudonymized reports whether the customer's personal data has been
+// replaced
+func (r *CustomerRetention) IsPseudonymized() bool {
+	return r.PseudonymizedAt != nil
+}
+
+// LegalHold stops a customer's personal data being erased, for example while
+// litigation or an investigation is pending
+type LegalHold struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	CustomerID uuid.UUID  `json:"customer_id" db:"customer_id"`
+	Reason     string     `json:"reason" db:"reason"`
+	PlacedBy   uuid.UUID  `json:"placed_by" db:"placed_by"`
+	PlacedAt   time.Time  `json:"placed_at" db:"placed_at"`
+	ReleasedBy *uuid.UUID `json:"released_by,omitempty" db:"released_by"`
+	ReleasedAt *time.Time `json:"released_at,omitempty" db:"released_at"`
+}
+
+// IsActive reports whether the hold has not been released
+func (h *LegalHold) IsActive() bool {
+	return h.ReleasedAt == nil
+}
+
+// Release lifts the hold
+func (h *LegalHold) Release(by uuid.UUID, at time.Time) error {
+	if !h.IsActive() {
+		return ErrLegalHoldReleased
+	}
+	h.ReleasedBy = &by
+	h.ReleasedAt = &at
+	return nil
+}
+
+// ErasureRequest records a request to erase a customer's personal data and
+// why it was refused, if it was
+type ErasureRequest struct {
+	ID          uuid.UUID            `json:"id" db:"id"`
+	CustomerID  uuid.UUID            `json:"customer_id" db:"customer_id"`
+	RequestedBy uuid.UUID            `json:"requested_by" db:"requested_by"`
+	Reason      string               `json:"reason" db:"reason"`
+	Status      ErasureRequestStatus `json:"status" db:"status"`
+	Blockers    []string             `json:"blockers" db:"blockers"`
+	RequestedAt time.Time            `json:"requested_at" db:"requested_at"`
+}
+
+// Value implements driver.Valuer for ErasureRequestStatus
+func (s ErasureRequestStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for ErasureRequestStatus
+func (s *ErasureRequestStatus) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ErasureRequestStatus")
+	}
+	*s = ErasureRequestStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid ErasureRequestStatus value")
+	}
+	return nil
+}
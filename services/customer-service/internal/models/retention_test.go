@@ -0,0 +1,32 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErasureRequestStatus_Scan(t *testing.T) {
+	var status ErasureRequestStatus
+	require.NoError(t, status.Scan("Refused"))
+	assert.Equal(t, ErasureRequestStatusRefused, status)
+	assert.Error(t, status.Scan("Pending"))
+	assert.Error(t, status.Scan(nil))
+}
+
+func TestLegalHold_Release(t *testing.T) {
+	officer := uuid.New()
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	hold := &LegalHold{Reason: "Litigation pending"}
+	assert.True(t, hold.IsActive())
+	require.NoError(t, hold.Release(officer, at))
+	assert.False(t, hold.IsActive())
+	assert.Equal(t, officer, *hold.ReleasedBy)
+	assert.Equal(t, at, *hold.ReleasedAt)
+
+	assert.ErrorIs(t, hold.Release(officer, at), ErrLegalHoldReleased)
+}
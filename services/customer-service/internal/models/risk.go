@@ -0,0 +1,230 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RiskRating represents a customer's KYC risk classification
+type RiskRating string
+
+const (
+	RiskRatingLow    RiskRating = "Low"
+	RiskRatingMedium RiskRating = "Medium"
+	RiskRatingHigh   RiskRating = "High"
+)
+
+// IsValid checks if the risk rating is valid
+func (r RiskRating) IsValid() bool {
+	switch r {
+	case RiskRatingLow, RiskRatingMedium, RiskRatingHigh:
+		return true
+	}
+	return false
+}
+
+// RiskAssessmentTrigger represents why a customer's risk was assessed
+type RiskAssessmentTrigger string
+
+const (
+	RiskAssessmentTriggerOnboarding RiskAssessmentTrigger = "Onboarding"
+	RiskAssessmentTriggerUpdate     RiskAssessmentTrigger = "Update"
+	RiskAssessmentTriggerScreening  RiskAssessmentTrigger = "Screening"
+	RiskAssessmentTriggerScheduled  RiskAssessmentTrigger = "Scheduled"
+	RiskAssessmentTriggerReview     RiskAssessmentTrigger = "Review"
+	RiskAssessmentTriggerManual     RiskAssessmentTrigger = "Manual"
+)
+
+// IsValid checks if the risk assessment trigger is valid
+func (t RiskAssessmentTrigger) IsValid() bool {
+	switch t {
+	case RiskAssessmentTriggerOnboarding, RiskAssessmentTriggerUpdate, RiskAssessmentTriggerScreening,
+		RiskAssessmentTriggerScheduled, RiskAssessmentTriggerReview, RiskAssessmentTriggerManual:
+		return true
+	}
+	return false
+}
+
+// ReviewTaskStatus represents where a periodic review is
+type ReviewTaskStatus string
+
+const (
+	ReviewTaskStatusOpen      ReviewTaskStatus = "Open"
+	ReviewTaskStatusCompleted ReviewTaskStatus = "Completed"
+)
+
+// IsValid checks if the review task status is valid
+func (s ReviewTaskStatus) IsValid() bool {
+	switch s {
+	case ReviewTaskStatusOpen, ReviewTaskStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// ErrReviewTaskCompleted is returned when completing a review task that is
+// already complete
+var ErrReviewTaskCompleted = errors.New("review task has already been completed")
+
+// RiskFactor is one reason a customer received their rating
+type RiskFactor struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Points      int    `json:"points"`
+}
+
+// RiskFactors is the explanation of an assessment, stored as JSON
+type RiskFactors []RiskFactor
+
+// CustomerRiskAssessment records one rating of a customer. Assessments are
+// never updated; the latest is the customer's current rating.
+type CustomerRiskAssessment struct {
+	ID           uuid.UUID             `json:"id" db:"id"`
+	CustomerID   uuid.UUID             `json:"customer_id" db:"customer_id"`
+	Rating       RiskRating            `json:"rating" db:"rating"`
+	Score        int                   `json:"score" db:"score"`
+	Factors      RiskFactors           `json:"factors" db:"factors"`
+	ModelVersion string                `json:"model_version" db:"model_version"`
+	Trigger      RiskAssessmentTrigger `json:"trigger" db:"trigger"`
+	AssessedBy   *uuid.UUID            `json:"assessed_by,omitempty" db:"assessed_by"` // Nil when assessed automatically
+	AssessedAt   time.Time             `json:"assessed_at" db:"assessed_at"`
+	NextReviewAt time.Time             `json:"next_review_at" db:"next_review_at"`
+}
+
+// ReviewTask represents a periodic KYC review a customer is due
+type ReviewTask struct {
+	ID           uuid.UUID        `json:"id" db:"id"`
+	CustomerID   uuid.UUID        `json:"customer_id" db:"customer_id"`
+	AssessmentID uuid.UUID        `json:"assessment_id" db:"assessment_id"` // The assessment that set the due date
+	Rating       RiskRating       `json:"rating" db:"rating"`
+	DueAt        time.Time        `json:"due_at" db:"due_at"`
+	Status       ReviewTaskStatus `json:"status" db:"status"`
+	CreatedAt    time.Time        `json:"created_at" db:"created_at"`
+	CompletedAt  *time.Time       `json:"completed_at,omitempty" db:"completed_at"`
+	CompletedBy  *uuid.UUID       `json:"completed_by,omitempty" db:"completed_by"`
+	Notes        *string          `json:"notes,omitempty" db:"notes"`
+}
+
+// IsOverdue reports whether an open task is past its due date
+func (t *ReviewTask) IsOverdue(now time.Time) bool {
+	return t.Status == ReviewTaskStatusOpen && now.After(t.DueAt)
+}
+
+// Complete records that the review has been carried out
+func (t *ReviewTask) Complete(by uuid.UUID, notes string, at time.Time) error {
+	if t.Status != ReviewTaskStatusOpen {
+		return ErrReviewTaskCompleted
+	}
+	t.Status = ReviewTaskStatusCompleted
+	t.CompletedBy = &by
+	t.CompletedAt = &at
+	t.Notes = &notes
+	return nil
+}
+
+// ReviewTaskFilter represents the filters for listing review tasks
+type ReviewTaskFilter struct {
+	CustomerID *uuid.UUID       `json:"customer_id,omitempty"`
+	Status     ReviewTaskStatus `json:"status,omitempty"`
+	DueBefore  *time.Time       `json:"due_before,omitempty"`
+	Limit      int              `json:"limit,omitempty"`
+}
+
+// Value implements driver.Valuer for RiskRating
+func (r RiskRating) Value() (driver.Value, error) {
+	return string(r), nil
+}
+
+// Scan implements sql.Scanner for RiskRating
+func (r *RiskRating) Scan(value interface{}) error {
+	if value == nil {
+		*r = RiskRatingLow
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan RiskRating")
+	}
+	*r = RiskRating(str)
+	if !r.IsValid() {
+		return errors.New("invalid RiskRating value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for RiskAssessmentTrigger
+func (t RiskAssessmentTrigger) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for RiskAssessmentTrigger
+func (t *RiskAssessmentTrigger) Scan(value interface{}) error {
+	if value == nil {
+		*t = RiskAssessmentTriggerManual
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan RiskAssessmentTrigger")
+	}
+	*t = RiskAssessmentTrigger(str)
+	if !t.IsValid() {
+		return errors.New("invalid RiskAssessmentTrigger value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for ReviewTaskStatus
+func (s ReviewTaskStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for ReviewTaskStatus
+func (s *ReviewTaskStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = ReviewTaskStatusOpen
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ReviewTaskStatus")
+	}
+	*s = ReviewTaskStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid ReviewTaskStatus value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for RiskFactors
+func (f RiskFactors) Value() (driver.Value, error) {
+	if f == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(f)
+}
+
+// Scan implements sql.Scanner for RiskFactors
+func (f *RiskFactors) Scan(value interface{}) error {
+	if value == nil {
+		*f = nil
+		return nil
+	}
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("failed to scan RiskFactors")
+	}
+	if err := json.Unmarshal(data, f); err != nil {
+		return errors.New("invalid RiskFactors value")
+	}
+	return nil
+}
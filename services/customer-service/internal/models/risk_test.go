@@ -0,0 +1,87 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskRating_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    RiskRating
+		wantErr bool
+	}{
+		{"valid Low", "Low", RiskRatingLow, false},
+		{"valid High", "High", RiskRatingHigh, false},
+		{"nil input", nil, RiskRatingLow, false},
+		{"invalid string", "Severe", RiskRating(""), true},
+		{"wrong type", 3, RiskRating(""), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got RiskRating
+			err := got.Scan(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRiskEnums_Scan(t *testing.T) {
+	var trigger RiskAssessmentTrigger
+	require.NoError(t, trigger.Scan("Scheduled"))
+	assert.Equal(t, RiskAssessmentTriggerScheduled, trigger)
+	assert.Error(t, trigger.Scan("Nightly"))
+
+	var status ReviewTaskStatus
+	require.NoError(t, status.Scan("Completed"))
+	assert.Equal(t, ReviewTaskStatusCompleted, status)
+	assert.Error(t, status.Scan("Cancelled"))
+}
+
+func TestRiskFactors_ValueScan(t *testing.T) {
+	factors := RiskFactors{{Code: "HIGH_RISK_COUNTRY", Description: "Connected to high-risk country IR", Points: 40}}
+	value, err := factors.Value()
+	require.NoError(t, err)
+
+	var got RiskFactors
+	require.NoError(t, got.Scan(value))
+	assert.Equal(t, factors, got)
+
+	empty, err := RiskFactors(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("[]"), empty)
+
+	assert.Error(t, got.Scan(42))
+	assert.Error(t, got.Scan("not json"))
+}
+
+func TestReviewTask_Complete(t *testing.T) {
+	reviewer := uuid.New()
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("complete open task", func(t *testing.T) {
+		task := &ReviewTask{Status: ReviewTaskStatusOpen, DueAt: at.AddDate(0, 0, -1)}
+		assert.True(t, task.IsOverdue(at))
+		require.NoError(t, task.Complete(reviewer, "source of funds confirmed", at))
+		assert.Equal(t, ReviewTaskStatusCompleted, task.Status)
+		assert.Equal(t, reviewer, *task.CompletedBy)
+		assert.Equal(t, at, *task.CompletedAt)
+		assert.False(t, task.IsOverdue(at))
+	})
+
+	t.Run("already completed", func(t *testing.T) {
+		task := &ReviewTask{Status: ReviewTaskStatusCompleted}
+		assert.ErrorIs(t, task.Complete(reviewer, "", at), ErrReviewTaskCompleted)
+	})
+}
@@ -0,0 +1,206 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScreeningTrigger represents why a customer was screened
+type ScreeningTrigger string
+
+const (
+	ScreeningTriggerOnboarding ScreeningTrigger = "Onboarding"
+	ScreeningTriggerUpdate     ScreeningTrigger = "Update"
+	ScreeningTriggerManual     ScreeningTrigger = "Manual"
+	ScreeningTriggerListUpdate ScreeningTrigger = "ListUpdate"
+)
+
+// IsValid checks if the screening trigger is valid
+func (t ScreeningTrigger) IsValid() bool {
+	switch t {
+	case ScreeningTriggerOnboarding, ScreeningTriggerUpdate,
+		ScreeningTriggerManual, ScreeningTriggerListUpdate:
+		return true
+	}
+	return false
+}
+
+// ScreeningOutcome represents the result of screening a customer
+type ScreeningOutcome string
+
+const (
+	ScreeningOutcomeClear          ScreeningOutcome = "Clear"
+	ScreeningOutcomePotentialMatch ScreeningOutcome = "PotentialMatch"
+)
+
+// IsValid checks if the screening outcome is valid
+func (o ScreeningOutcome) IsValid() bool {
+	switch o {
+	case ScreeningOutcomeClear, ScreeningOutcomePotentialMatch:
+		return true
+	}
+	return false
+}
+
+// ScreeningHitStatus represents where a potential match is in review
+type ScreeningHitStatus string
+
+const (
+	ScreeningHitStatusPending   ScreeningHitStatus = "Pending"
+	ScreeningHitStatusConfirmed ScreeningHitStatus = "Confirmed"
+	ScreeningHitStatusDismissed ScreeningHitStatus = "Dismissed"
+)
+
+// IsValid checks if the screening hit status is valid
+func (s ScreeningHitStatus) IsValid() bool {
+	switch s {
+	case ScreeningHitStatusPending, ScreeningHitStatusConfirmed, ScreeningHitStatusDismissed:
+		return true
+	}
+	return false
+}
+
+// BlocksActivation reports whether a hit in this status keeps the customer
+// from being activated
+func (s ScreeningHitStatus) BlocksActivation() bool {
+	return s == ScreeningHitStatusPending || s == ScreeningHitStatusConfirmed
+}
+
+// ErrHitReviewed is returned when reviewing a hit that has already been reviewed
+var ErrHitReviewed = errors.New("screening hit has already been reviewed")
+
+// CustomerScreening records one screening of a customer against the lists
+// loaded at the time
+type CustomerScreening struct {
+	ID           uuid.UUID        `json:"id" db:"id"`
+	CustomerID   uuid.UUID        `json:"customer_id" db:"customer_id"`
+	Trigger      ScreeningTrigger `json:"trigger" db:"trigger"`
+	Outcome      ScreeningOutcome `json:"outcome" db:"outcome"`
+	ListVersions []string         `json:"list_versions" db:"list_versions"` // "NAME:version" of every list screened
+	ScreenedAt   time.Time        `json:"screened_at" db:"screened_at"`
+	Hits         []*ScreeningHit  `json:"hits,omitempty" db:"-"` // Hits raised by this screening
+}
+
+// ScreeningHit represents a potential match between a customer and a list entry
+type ScreeningHit struct {
+	ID           uuid.UUID          `json:"id" db:"id"`
+	ScreeningID  uuid.UUID          `json:"screening_id" db:"screening_id"`
+	CustomerID   uuid.UUID          `json:"customer_id" db:"customer_id"`
+	ListName     string             `json:"list_name" db:"list_name"`
+	ListVersion  string             `json:"list_version" db:"list_version"`
+	EntryID      string             `json:"entry_id" db:"entry_id"`
+	EntryName    string             `json:"entry_name" db:"entry_name"`
+	MatchedName  string             `json:"matched_name" db:"matched_name"`
+	Subject      string             `json:"subject" db:"subject"` // The customer's name and date of birth as screened
+	Score        float64            `json:"score" db:"score"`
+	NameScore    float64            `json:"name_score" db:"name_score"`
+	DOBMatch     string             `json:"dob_match" db:"dob_match"`
+	CountryMatch bool               `json:"country_match" db:"country_match"`
+	Status       ScreeningHitStatus `json:"status" db:"status"`
+	ReviewedBy   *uuid.UUID         `json:"reviewed_by,omitempty" db:"reviewed_by"`
+	ReviewedAt   *time.Time         `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	ReviewNotes  *string            `json:"review_notes,omitempty" db:"review_notes"`
+	CreatedAt    time.Time          `json:"created_at" db:"created_at"`
+}
+
+// Review records the decision on a pending hit
+func (h *ScreeningHit) Review(decision ScreeningHitStatus, by uuid.UUID, notes string, at time.Time) error {
+	if h.Status != ScreeningHitStatusPending {
+		return ErrHitReviewed
+	}
+	if decision != ScreeningHitStatusConfirmed && decision != ScreeningHitStatusDismissed {
+		return errors.New("decision must be Confirmed or Dismissed")
+	}
+	h.Status = decision
+	h.ReviewedBy = &by
+	h.ReviewedAt = &at
+	h.ReviewNotes = &notes
+	return nil
+}
+
+// ScreeningHitFilter represents the filters for listing screening hits
+type ScreeningHitFilter struct {
+	CustomerID *uuid.UUID         `json:"customer_id,omitempty"`
+	Status     ScreeningHitStatus `json:"status,omitempty"`
+	Limit      int                `json:"limit,omitempty"`
+}
+
+// SanctionsList records a version of a sanctions list the service has loaded
+type SanctionsList struct {
+	ListName     string     `json:"list_name" db:"list_name"`
+	Version      string     `json:"version" db:"version"`
+	PublishedAt  *time.Time `json:"published_at,omitempty" db:"published_at"`
+	EntryCount   int        `json:"entry_count" db:"entry_count"`
+	SourceFile   string     `json:"source_file" db:"source_file"`
+	LoadedAt     time.Time  `json:"loaded_at" db:"loaded_at"`
+	RescreenedAt *time.Time `json:"rescreened_at,omitempty" db:"rescreened_at"` // When every customer was screened against it
+}
+
+// Value implements driver.Valuer for ScreeningTrigger
+func (t ScreeningTrigger) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for ScreeningTrigger
+func (t *ScreeningTrigger) Scan(value interface{}) error {
+	if value == nil {
+		*t = ScreeningTriggerManual
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ScreeningTrigger")
+	}
+	*t = ScreeningTrigger(str)
+	if !t.IsValid() {
+		return errors.New("invalid ScreeningTrigger value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for ScreeningOutcome
+func (o ScreeningOutcome) Value() (driver.Value, error) {
+	return string(o), nil
+}
+
+// Scan implements sql.Scanner for ScreeningOutcome
+func (o *ScreeningOutcome) Scan(value interface{}) error {
+	if value == nil {
+		*o = ScreeningOutcomeClear
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ScreeningOutcome")
+	}
+	*o = ScreeningOutcome(str)
+	if !o.IsValid() {
+		return errors.New("invalid ScreeningOutcome value")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer for ScreeningHitStatus
+func (s ScreeningHitStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for ScreeningHitStatus
+func (s *ScreeningHitStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = ScreeningHitStatusPending
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ScreeningHitStatus")
+	}
+	*s = ScreeningHitStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid ScreeningHitStatus value")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreeningHitStatus_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    ScreeningHitStatus
+		wantErr bool
+	}{
+		{"valid Pending", "Pending", ScreeningHitStatusPending, false},
+		{"valid Confirmed", "Confirmed", ScreeningHitStatusConfirmed, false},
+		{"nil input", nil, ScreeningHitStatusPending, false},
+		{"invalid string", "Invalid", ScreeningHitStatus(""), true},
+		{"wrong type", 123, ScreeningHitStatus(""), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ScreeningHitStatus
+			err := got.Scan(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScreeningEnums_Scan(t *testing.T) {
+	var trigger ScreeningTrigger
+	require.NoError(t, trigger.Scan("ListUpdate"))
+	assert.Equal(t, ScreeningTriggerListUpdate, trigger)
+	assert.Error(t, trigger.Scan("Nightly"))
+
+	var outcome ScreeningOutcome
+	require.NoError(t, outcome.Scan("PotentialMatch"))
+	assert.Equal(t, ScreeningOutcomePotentialMatch, outcome)
+	assert.Error(t, outcome.Scan("Blocked"))
+}
+
+func TestScreeningHitStatus_BlocksActivation(t *testing.T) {
+	assert.True(t, ScreeningHitStatusPending.BlocksActivation())
+	assert.True(t, ScreeningHitStatusConfirmed.BlocksActivation())
+	assert.False(t, ScreeningHitStatusDismissed.BlocksActivation())
+}
+
+func TestScreeningHit_Review(t *testing.T) {
+	reviewer := uuid.New()
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("dismiss pending hit", func(t *testing.T) {
+		hit := &ScreeningHit{Status: ScreeningHitStatusPending}
+		require.NoError(t, hit.Review(ScreeningHitStatusDismissed, reviewer, "different date of birth", at))
+		assert.Equal(t, ScreeningHitStatusDismissed, hit.Status)
+		assert.Equal(t, reviewer, *hit.ReviewedBy)
+		assert.Equal(t, at, *hit.ReviewedAt)
+		assert.Equal(t, "different date of birth", *hit.ReviewNotes)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		hit := &ScreeningHit{Status: ScreeningHitStatusConfirmed}
+		assert.ErrorIs(t, hit.Review(ScreeningHitStatusDismissed, reviewer, "", at), ErrHitReviewed)
+	})
+
+	t.Run("decision must be final", func(t *testing.T) {
+		hit := &ScreeningHit{Status: ScreeningHitStatusPending}
+		assert.Error(t, hit.Review(ScreeningHitStatusPending, reviewer, "", at))
+		assert.Equal(t, ScreeningHitStatusPending, hit.Status)
+	})
+}
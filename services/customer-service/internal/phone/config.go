@@ -0,0 +1,206 @@
+// Package phone parses customer phone numbers under the numbering plans of
+// their country, telling whether they are valid and what kind of line they
+// are for, and gives them in E.164 form so the same number is always stored
+// the same way.
+package phone
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+)
+
+// Type is the kind of line a number is for
+type Type string
+
+const (
+	TypeMobile            Type = "mobile"
+	TypeFixedLine         Type = "fixed_line"
+	TypeFixedLineOrMobile Type = "fixed_line_or_mobile" // Where the plan does not tell them apart
+	TypeTollFree          Type = "toll_free"
+	TypeUnknown           Type = "unknown" // Of a country without a numbering plan
+)
+
+// Types lists the types a numbering plan's ranges may have
+var Types = []Type{TypeMobile, TypeFixedLine, TypeFixedLineOrMobile, TypeTollFree}
+
+// MayBeMobile reports whether numbers of the type may be mobiles, and so may
+// be sent text messages
+func (t Type) MayBeMobile() bool {
+	return t == TypeMobile || t == TypeFixedLineOrMobile
+}
+
+// maxDigits is the most digits an E.164 number has, its calling code
+// included, and minDigits the fewest in use
+const (
+	maxDigits = 15
+	minDigits = 7
+)
+
+var (
+	countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+	callingCodeRegex = regexp.MustCompile(`^[1-9][0-9]{0,2}$`)
+	digitsRegex      = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// Range is a block of national numbers of one type
+type Range struct {
+	Type Type `json:"type"`
+	// Prefixes lists what the national significant number, without the
+	// trunk prefix, starts with. Any number is in the range when there are
+	// none.
+	Prefixes []string `json:"prefixes"`
+	// Lengths lists the lengths of the national significant numbers
+	Lengths []int `json:"lengths"`
+}
+
+// Plan is one country's numbering plan
+type Plan struct {
+	Name        string `json:"name"`
+	CallingCode string `json:"calling_code"`
+	// TrunkPrefix is dialled before national numbers within the country,
+	// such as the 0 of 020 7946 0000, and is not part of the E.164 form
+	TrunkPrefix string `json:"trunk_prefix"`
+	// InternationalPrefix is dialled before numbers abroad, in place of +
+	InternationalPrefix string `json:"international_prefix"`
+	// LeadingDigits tells countries sharing a calling code apart: the
+	// national numbers of this country start with one of them. The one
+	// country of a calling code with none takes every other number.
+	LeadingDigits []string `json:"leading_digits"`
+	// Ranges are tried in order, the first with a prefix and length the
+	// number has deciding its type
+	Ranges []Range `json:"ranges"`
+	// Example is a valid number in E.164 form, given in error messages
+	Example string `json:"example"`
+}
+
+// Config is a versioned set of numbering plans
+type Config struct {
+	Version string `json:"version"`
+	// Countries maps ISO 3166-1 alpha-2 codes to their plans. Numbers of
+	// other countries are accepted in international format when they have
+	// as many digits as an E.164 number may.
+	Countries map[string]*Plan `json:"countries"`
+
+	byCallingCode map[string][]string
+}
+
+// DefaultConfig returns the plans used when none are configured: none, so
+// numbers must be given in international format and are not typed
+func DefaultConfig() *Config {
+	return &Config{
+		Version:   "default-1",
+		Countries: map[string]*Plan{},
+	}
+}
+
+// Validate checks the plans are usable and indexes them by calling code.
+// Plans are only applied once validated.
+func (c *Config) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("numbering plans have no version")
+	}
+	byCallingCode := make(map[string][]string)
+	for code, plan := range c.Countries {
+		if !countryCodeRegex.MatchString(code) {
+			return fmt.Errorf("countries: %q is not an ISO 3166-1 alpha-2 code", code)
+		}
+		if plan == nil {
+			return fmt.Errorf("%s: no plan", code)
+		}
+		if plan.Name == "" {
+			return fmt.Errorf("%s: no name", code)
+		}
+		if err := plan.validate(); err != nil {
+			return fmt.Errorf("%s: %w", code, err)
+		}
+		byCallingCode[plan.CallingCode] = append(byCallingCode[plan.CallingCode], code)
+	}
+
+	for callingCode, countries := range byCallingCode {
+		// Sorted so the country taking every other number is found the
+		// same way each time
+		slices.Sort(countries)
+		var rest []string
+		for _, code := range countries {
+			if len(c.Countries[code].LeadingDigits) == 0 {
+				rest = append(rest, code)
+			}
+		}
+		if len(rest) > 1 {
+			return fmt.Errorf("calling code %s: %v all lack leading_digits", callingCode, rest)
+		}
+	}
+	c.byCallingCode = byCallingCode
+
+	for code, plan := range c.Countries {
+		number, err := c.Parse(plan.Example, "")
+		if err != nil || number.Country != code || number.E164 != plan.Example {
+			return fmt.Errorf("%s: example %q is not a %s number in E.164 form", code, plan.Example, plan.Name)
+		}
+	}
+	return nil
+}
+
+func (p *Plan) validate() error {
+	if !callingCodeRegex.MatchString(p.CallingCode) {
+		return fmt.Errorf("calling_code: %q is not a calling code", p.CallingCode)
+	}
+	if p.TrunkPrefix != "" && !digitsRegex.MatchString(p.TrunkPrefix) {
+		return fmt.Errorf("trunk_prefix: %q is not digits", p.TrunkPrefix)
+	}
+	if p.InternationalPrefix != "" && !digitsRegex.MatchString(p.InternationalPrefix) {
+		return fmt.Errorf("international_prefix: %q is not digits", p.InternationalPrefix)
+	}
+	for _, digits := range p.LeadingDigits {
+		if !digitsRegex.MatchString(digits) {
+			return fmt.Errorf("leading_digits: %q is not digits", digits)
+		}
+	}
+
+	if len(p.Ranges) == 0 {
+		return fmt.Errorf("ranges: none")
+	}
+	for i, r := range p.Ranges {
+		if !slices.Contains(Types, r.Type) {
+			return fmt.Errorf("ranges[%d]: unknown type %q", i, r.Type)
+		}
+		for _, prefix := range r.Prefixes {
+			if !digitsRegex.MatchString(prefix) {
+				return fmt.Errorf("ranges[%d]: prefix %q is not digits", i, prefix)
+			}
+		}
+		if len(r.Lengths) == 0 {
+			return fmt.Errorf("ranges[%d]: no lengths", i)
+		}
+		for _, length := range r.Lengths {
+			if length < 1 || len(p.CallingCode)+length > maxDigits {
+				return fmt.Errorf("ranges[%d]: length %d does not fit an E.164 number", i, length)
+			}
+		}
+	}
+	return nil
+}
+
+// LoadConfig reads and validates a numbering plans file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read numbering plans: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates a numbering plans document
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse numbering plans: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,172 @@
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Number is a parsed phone number
+type Number struct {
+	E164    string // Such as +447700900123
+	Country string // ISO 3166-1 alpha-2; empty when the type is unknown
+	Type    Type
+}
+
+// errNotInternational is returned for a number in national format without
+// a default region to read it in
+var errNotInternational = errors.New("must be in international format, such as +447700900123")
+
+// Parse parses a phone number as it was given. A number starting with + or
+// the international prefix of defaultRegion is parsed under the plan of its
+// calling code; any other is taken to be a national number of
+// defaultRegion. Spaces and the punctuation . - / ( ) are ignored, as is a
+// trunk prefix written in either form. The error, when there is one, reads
+// as a validation message.
+func (c *Config) Parse(input, defaultRegion string) (Number, error) {
+	digits, ok := Digits(input)
+	if !ok || digits == "" {
+		return Number{}, errors.New("is not a phone number")
+	}
+	international := strings.HasPrefix(strings.TrimSpace(input), "+")
+
+	region := c.Countries[strings.ToUpper(strings.TrimSpace(defaultRegion))]
+	if !international && region != nil && region.InternationalPrefix != "" && strings.HasPrefix(digits, region.InternationalPrefix) {
+		digits = strings.TrimPrefix(digits, region.InternationalPrefix)
+		international = true
+	}
+	if !international {
+		if region == nil {
+			return Number{}, errNotInternational
+		}
+		return c.parseNational(region.CallingCode, digits)
+	}
+
+	// Calling codes are prefix-free, so the first one found is the one
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		if _, ok := c.byCallingCode[digits[:i]]; ok {
+			return c.parseNational(digits[:i], digits[i:])
+		}
+	}
+	return generic(digits)
+}
+
+// parseNational parses the national number of a calling code, with or
+// without its trunk prefix
+func (c *Config) parseNational(callingCode, national string) (Number, error) {
+	code, plan := c.plan(callingCode, national)
+	if plan == nil {
+		return generic(callingCode + national)
+	}
+	if plan.TrunkPrefix != "" && strings.HasPrefix(national, plan.TrunkPrefix) {
+		trimmed := strings.TrimPrefix(national, plan.TrunkPrefix)
+		if code, plan := c.plan(callingCode, trimmed); plan != nil {
+			if t, ok := plan.numberType(trimmed); ok {
+				return Number{E164: "+" + callingCode + trimmed, Country: code, Type: t}, nil
+			}
+		}
+	}
+	if t, ok := plan.numberType(national); ok {
+		return Number{E164: "+" + callingCode + national, Country: code, Type: t}, nil
+	}
+	return Number{}, fmt.Errorf("is not a valid %s phone number, such as %s", plan.Name, plan.Example)
+}
+
+// plan returns the country a national number of a calling code belongs to,
+// and its plan, or nil when none of the plans of the calling code take it
+func (c *Config) plan(callingCode, national string) (string, *Plan) {
+	rest := ""
+	for _, code := range c.byCallingCode[callingCode] {
+		plan := c.Countries[code]
+		if len(plan.LeadingDigits) == 0 {
+			rest = code
+		} else if hasAnyPrefix(national, plan.LeadingDigits) {
+			return code, plan
+		}
+	}
+	if rest == "" {
+		return "", nil
+	}
+	return rest, c.Countries[rest]
+}
+
+// numberType returns the type of the first range a national significant
+// number is in
+func (p *Plan) numberType(national string) (Type, bool) {
+	for _, r := range p.Ranges {
+		if (len(r.Prefixes) == 0 || hasAnyPrefix(national, r.Prefixes)) && slices.Contains(r.Lengths, len(national)) {
+			return r.Type, true
+		}
+	}
+	return "", false
+}
+
+// generic accepts the digits of an international number there is no plan
+// for when there are as many as an E.164 number may have
+func generic(digits string) (Number, error) {
+	if len(digits) < minDigits || len(digits) > maxDigits || digits[0] == '0' {
+		return Number{}, fmt.Errorf("must have between %d and %d digits after the +", minDigits, maxDigits)
+	}
+	return Number{E164: "+" + digits, Type: TypeUnknown}, nil
+}
+
+// SearchDigits returns the digits of a phone number, or of part of one, as
+// they appear in its E.164 form. A number not in international format loses
+// the trunk prefix of defaultRegion; without a region, it loses the trunk
+// prefix of any plan it is a complete national number of, so 07700 900123
+// finds +447700900123. ok is false when the input is not digits and the
+// punctuation Parse ignores.
+func (c *Config) SearchDigits(input, defaultRegion string) (digits string, ok bool) {
+	digits, ok = Digits(input)
+	if !ok || strings.HasPrefix(strings.TrimSpace(input), "+") {
+		return digits, ok
+	}
+
+	if region := c.Countries[strings.ToUpper(strings.TrimSpace(defaultRegion))]; region != nil {
+		if region.TrunkPrefix != "" {
+			digits = strings.TrimPrefix(digits, region.TrunkPrefix)
+		}
+		return digits, true
+	}
+	// The longest trunk prefix wins when numbers of two plans look alike
+	national := digits
+	for code, plan := range c.Countries {
+		if plan.TrunkPrefix == "" || !strings.HasPrefix(digits, plan.TrunkPrefix) || len(digits)-len(plan.TrunkPrefix) >= len(national) {
+			continue
+		}
+		trimmed := strings.TrimPrefix(digits, plan.TrunkPrefix)
+		if owner, p := c.plan(plan.CallingCode, trimmed); owner == code {
+			if _, ok := p.numberType(trimmed); ok {
+				national = trimmed
+			}
+		}
+	}
+	return national, true
+}
+
+// Digits returns the digits of a phone number, without a leading + or the
+// punctuation Parse ignores, and whether it had nothing else
+func Digits(input string) (string, bool) {
+	s := strings.TrimPrefix(strings.TrimSpace(input), "+")
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case strings.ContainsRune(" .-/()", r):
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package phone
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func loadPlans(t *testing.T) *Config {
+	t.Helper()
+	cfg, err := LoadConfig(filepath.Join("..", "..", "config", "phone_numbering.json"))
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	return cfg
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg := loadPlans(t)
+	if cfg.Version == "" || cfg.Countries["GB"] == nil || len(cfg.byCallingCode["1"]) != 2 {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestConfig_Parse(t *testing.T) {
+	cfg := loadPlans(t)
+
+	tests := []struct {
+		name          string
+		input         string
+		defaultRegion string
+		want          Number
+		wantErr       string
+	}{
+		{name: "UK mobile in international format", input: "+44 7700 900123", want: Number{E164: "+447700900123", Country: "GB", Type: TypeMobile}},
+		{name: "UK mobile in national format", input: "07700 900123", defaultRegion: "GB", want: Number{E164: "+447700900123", Country: "GB", Type: TypeMobile}},
+		{name: "UK trunk prefix written after the calling code", input: "+44 (0)20 7946 0000", want: Number{E164: "+442079460000", Country: "GB", Type: TypeFixedLine}},
+		{name: "UK number dialled from abroad", input: "011 44 20 7946 0000", defaultRegion: "us", want: Number{E164: "+442079460000", Country: "GB", Type: TypeFixedLine}},
+		{name: "UK freephone", input: "0800 123 4567", defaultRegion: "GB", want: Number{E164: "+448001234567", Country: "GB", Type: TypeTollFree}},
+		{name: "UK mobile too short", input: "07700 90012", defaultRegion: "GB", wantErr: "is not a valid United Kingdom phone number, such as +447700900123"},
+		{name: "US number with punctuation", input: "(202) 555-0143", defaultRegion: "US", want: Number{E164: "+12025550143", Country: "US", Type: TypeFixedLineOrMobile}},
+		{name: "US number with trunk prefix", input: "1-800-555-0199", defaultRegion: "US", want: Number{E164: "+18005550199", Country: "US", Type: TypeTollFree}},
+		{name: "Canadian area code", input: "+1 613 555 0143", want: Number{E164: "+16135550143", Country: "CA", Type: TypeFixedLineOrMobile}},
+		{name: "Canadian number given in the US", input: "613.555.0143", defaultRegion: "US", want: Number{E164: "+16135550143", Country: "CA", Type: TypeFixedLineOrMobile}},
+		{name: "NANP number too short", input: "+1234567890", wantErr: "is not a valid United States phone number"},
+		{name: "German mobile", input: "0151 23456789", defaultRegion: "DE", want: Number{E164: "+4915123456789", Country: "DE", Type: TypeMobile}},
+		{name: "Singapore without a trunk prefix", input: "8123 4567", defaultRegion: "SG", want: Number{E164: "+6581234567", Country: "SG", Type: TypeMobile}},
+		{name: "country without a plan", input: "+351 912 345 678", defaultRegion: "GB", want: Number{E164: "+351912345678", Type: TypeUnknown}},
+		{name: "country without a plan too long", input: "+351 912 345 678 901 23", wantErr: "must have between 7 and 15 digits"},
+		{name: "national format without a region", input: "07700 900123", wantErr: "must be in international format"},
+		{name: "national format in a region without a plan", input: "912 345 678", defaultRegion: "PT", wantErr: "must be in international format"},
+		{name: "letters", input: "+44 7700 CALLME", wantErr: "is not a phone number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cfg.Parse(tt.input, tt.defaultRegion)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Parse() = %+v, %v; want error containing %q", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Parse() = %+v, %v; want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_SearchDigits(t *testing.T) {
+	cfg := loadPlans(t)
+
+	tests := []struct {
+		name          string
+		input         string
+		defaultRegion string
+		want          string
+		wantOK        bool
+	}{
+		{name: "international format", input: "+44 7700 900123", want: "447700900123", wantOK: true},
+		{name: "national format with its region", input: "07700 900123", defaultRegion: "GB", want: "7700900123", wantOK: true},
+		{name: "part of a national number with its region", input: "0770", defaultRegion: "gb", want: "770", wantOK: true},
+		{name: "national format without a region", input: "07700 900123", want: "7700900123", wantOK: true},
+		{name: "NANP trunk prefix without a region", input: "1-800-555-0199", want: "8005550199", wantOK: true},
+		{name: "part of a number without a region", input: "123", want: "123", wantOK: true},
+		{name: "letters", input: "ext 5", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := cfg.SearchDigits(tt.input, tt.defaultRegion)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Errorf("SearchDigits() = %q, %v; want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDefaultConfig_Parse(t *testing.T) {
+	cfg := DefaultConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("DefaultConfig().Validate() error: %v", err)
+	}
+	got, err := cfg.Parse("+44 7700 900123", "GB")
+	if err != nil || got != (Number{E164: "+447700900123", Type: TypeUnknown}) {
+		t.Errorf("Parse() = %+v, %v", got, err)
+	}
+	if _, err := cfg.Parse("07700 900123", "GB"); err == nil {
+		t.Error("Parse() accepted a national number without a plan for its region")
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr string
+	}{
+		{"no version", func(c *Config) { c.Version = "" }, "no version"},
+		{"bad country code", func(c *Config) { c.Countries["GBR"] = c.Countries["GB"] }, "alpha-2"},
+		{"bad calling code", func(c *Config) { c.Countries["FR"].CallingCode = "+33" }, "calling_code"},
+		{"unknown type", func(c *Config) { c.Countries["GB"].Ranges[0].Type = TypeUnknown }, "unknown type"},
+		{"too long", func(c *Config) { c.Countries["HK"].Ranges[0].Lengths = []int{13} }, "does not fit"},
+		{"two countries take the rest", func(c *Config) { c.Countries["CA"].LeadingDigits = nil }, "lack leading_digits"},
+		{"example of another country", func(c *Config) { c.Countries["CA"].Example = "+12025550143" }, "example"},
+		{"example not in E.164 form", func(c *Config) { c.Countries["GB"].Example = "+44 7700 900123" }, "example"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadPlans(t)
+			tt.mutate(cfg)
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ParseConfig([]byte(`{"version": 1}`)); err == nil {
+		t.Error("ParseConfig() accepted a malformed document")
+	}
+}
@@ -0,0 +1,187 @@
+// Package postal checks customer addresses against the rules of their
+// country and puts them into the canonical form those rules give, so the
+// same address is always stored the same way.
+package postal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+)
+
+// Field is a part of an address that rules apply to
+type Field string
+
+const (
+	FieldStreet1    Field = "street1"
+	FieldStreet2    Field = "street2"
+	FieldCity       Field = "city"
+	FieldState      Field = "state"
+	FieldPostalCode Field = "postal_code"
+	FieldCountry    Field = "country"
+)
+
+// Fields lists the fields a country's rules may require or forbid
+var Fields = []Field{FieldStreet1, FieldStreet2, FieldCity, FieldState, FieldPostalCode}
+
+var (
+	countryCodeRegex     = regexp.MustCompile(`^[A-Z]{2}$`)
+	subdivisionCodeRegex = regexp.MustCompile(`^[A-Z0-9]{1,3}$`)
+)
+
+// PostalCodeFormat is one accepted form of a country's postal codes
+type PostalCodeFormat struct {
+	// Pattern is matched against the postal code uppercased, with runs of
+	// spaces collapsed to one
+	Pattern string `json:"pattern"`
+	// Canonical is the stored form, expanded from the pattern's groups as
+	// in "$1 $2"
+	Canonical string `json:"canonical"`
+
+	re *regexp.Regexp
+}
+
+// CountryRules is how one country's addresses are written
+type CountryRules struct {
+	Name      string  `json:"name"`
+	Required  []Field `json:"required"`
+	Forbidden []Field `json:"forbidden"`
+	// PostalCodes lists the accepted postal code formats, the first
+	// matching one deciding the canonical form. Any postal code is
+	// accepted when there are none.
+	PostalCodes       []PostalCodeFormat `json:"postal_codes"`
+	PostalCodeExample string             `json:"postal_code_example"`
+	// Subdivisions maps ISO 3166-2 subdivision codes, without the country
+	// prefix, to their names. When there are any, state must be one of them,
+	// given as its code, its full ISO 3166-2 code or its name, and is
+	// stored as its code.
+	Subdivisions map[string]string `json:"subdivisions"`
+	// SubdivisionPostalPrefixes lists the prefixes postal codes in a
+	// subdivision start with, for countries where one tells the other
+	SubdivisionPostalPrefixes map[string][]string `json:"subdivision_postal_prefixes"`
+}
+
+// Config is a versioned set of address rules
+type Config struct {
+	Version string `json:"version"`
+	// Default is the generic rules for countries that have none of their
+	// own. Addresses checked under them are accepted with a warning.
+	Default CountryRules `json:"default"`
+	// Countries maps ISO 3166-1 alpha-2 codes to their rules
+	Countries map[string]*CountryRules `json:"countries"`
+}
+
+// DefaultConfig returns the rules used when none are configured: the
+// generic rules alone
+func DefaultConfig() *Config {
+	return &Config{
+		Version: "default-1",
+		Default: CountryRules{
+			Required: []Field{FieldStreet1, FieldCity},
+		},
+		Countries: map[string]*CountryRules{},
+	}
+}
+
+// Validate checks the rules are usable and compiles their postal code
+// patterns. Rules are only applied once validated.
+func (c *Config) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("address rules have no version")
+	}
+	if err := c.Default.validate(); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+	for code, rules := range c.Countries {
+		if !countryCodeRegex.MatchString(code) {
+			return fmt.Errorf("countries: %q is not an ISO 3166-1 alpha-2 code", code)
+		}
+		if rules == nil {
+			return fmt.Errorf("%s: no rules", code)
+		}
+		if rules.Name == "" {
+			return fmt.Errorf("%s: no name", code)
+		}
+		if err := rules.validate(); err != nil {
+			return fmt.Errorf("%s: %w", code, err)
+		}
+	}
+	return nil
+}
+
+func (r *CountryRules) validate() error {
+	for _, f := range r.Required {
+		if !slices.Contains(Fields, f) {
+			return fmt.Errorf("required: unknown field %q", f)
+		}
+		if slices.Contains(r.Forbidden, f) {
+			return fmt.Errorf("%s is both required and forbidden", f)
+		}
+	}
+	for _, f := range r.Forbidden {
+		if !slices.Contains(Fields, f) {
+			return fmt.Errorf("forbidden: unknown field %q", f)
+		}
+	}
+
+	for i := range r.PostalCodes {
+		format := &r.PostalCodes[i]
+		re, err := regexp.Compile(format.Pattern)
+		if err != nil {
+			return fmt.Errorf("postal_codes: %w", err)
+		}
+		if format.Canonical == "" {
+			return fmt.Errorf("postal_codes: %s has no canonical form", format.Pattern)
+		}
+		format.re = re
+	}
+	if len(r.PostalCodes) > 0 {
+		if r.PostalCodeExample == "" {
+			return fmt.Errorf("postal_codes: no example")
+		}
+		if canonical, ok := r.postalCode(r.PostalCodeExample); !ok || canonical != r.PostalCodeExample {
+			return fmt.Errorf("postal_codes: example %s is not in canonical form", r.PostalCodeExample)
+		}
+	}
+
+	for code, name := range r.Subdivisions {
+		if !subdivisionCodeRegex.MatchString(code) {
+			return fmt.Errorf("subdivisions: %q is not an ISO 3166-2 subdivision code", code)
+		}
+		if name == "" {
+			return fmt.Errorf("subdivisions: %s has no name", code)
+		}
+	}
+	for code, prefixes := range r.SubdivisionPostalPrefixes {
+		if _, ok := r.Subdivisions[code]; !ok {
+			return fmt.Errorf("subdivision_postal_prefixes: unknown subdivision %s", code)
+		}
+		if len(prefixes) == 0 {
+			return fmt.Errorf("subdivision_postal_prefixes: %s has no prefixes", code)
+		}
+	}
+	return nil
+}
+
+// LoadConfig reads and validates an address rules file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read address rules: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates an address rules document
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse address rules: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
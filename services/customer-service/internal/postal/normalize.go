@@ -0,0 +1,148 @@
+package postal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Address is the parts of an address that rules apply to
+type Address struct {
+	Street1    string
+	Street2    string
+	City       string
+	State      string
+	PostalCode string
+	Country    string
+}
+
+func (a *Address) get(f Field) string {
+	switch f {
+	case FieldStreet1:
+		return a.Street1
+	case FieldStreet2:
+		return a.Street2
+	case FieldCity:
+		return a.City
+	case FieldState:
+		return a.State
+	case FieldPostalCode:
+		return a.PostalCode
+	case FieldCountry:
+		return a.Country
+	}
+	return ""
+}
+
+// Problem is something wrong with an address. Warnings are things the rules
+// could not check; any other problem means the address is rejected.
+type Problem struct {
+	Field   Field
+	Message string
+	Warning bool
+}
+
+// Normalize checks an address against its country's rules, or the generic
+// rules when its country has none, and returns it in canonical form: spaces
+// trimmed and collapsed, the country, state and postal code uppercased, the
+// state as its subdivision code and the postal code in its country's
+// format. Fields the rules reject are returned as they were given.
+func (c *Config) Normalize(a Address) (Address, []Problem) {
+	a.Street1 = collapseSpaces(a.Street1)
+	a.Street2 = collapseSpaces(a.Street2)
+	a.City = collapseSpaces(a.City)
+	a.State = collapseSpaces(a.State)
+	a.PostalCode = strings.ToUpper(collapseSpaces(a.PostalCode))
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+
+	var problems []Problem
+	rules, ok := c.Countries[a.Country]
+	name := a.Country
+	if ok {
+		name = rules.Name
+	} else {
+		rules = &c.Default
+		problems = append(problems, Problem{
+			Field:   FieldCountry,
+			Message: fmt.Sprintf("there are no address rules for %s, so only the generic rules were checked", a.Country),
+			Warning: true,
+		})
+	}
+
+	for _, f := range rules.Required {
+		if a.get(f) == "" {
+			problems = append(problems, Problem{Field: f, Message: "is required"})
+		}
+	}
+	for _, f := range rules.Forbidden {
+		if a.get(f) != "" {
+			problems = append(problems, Problem{Field: f, Message: fmt.Sprintf("is not used in %s addresses", name)})
+		}
+	}
+
+	subdivision := ""
+	if len(rules.Subdivisions) > 0 && a.State != "" {
+		if code, ok := rules.subdivision(a.Country, a.State); ok {
+			a.State = code
+			subdivision = code
+		} else {
+			problems = append(problems, Problem{Field: FieldState, Message: fmt.Sprintf("must be an ISO 3166-2 subdivision of %s", name)})
+		}
+	}
+
+	if len(rules.PostalCodes) > 0 && a.PostalCode != "" {
+		canonical, ok := rules.postalCode(a.PostalCode)
+		if !ok {
+			problems = append(problems, Problem{Field: FieldPostalCode, Message: fmt.Sprintf("must be a %s postal code, such as %s", name, rules.PostalCodeExample)})
+			return a, problems
+		}
+		a.PostalCode = canonical
+	}
+	if prefixes := rules.SubdivisionPostalPrefixes[subdivision]; len(prefixes) > 0 && a.PostalCode != "" {
+		if !hasAnyPrefix(a.PostalCode, prefixes) {
+			problems = append(problems, Problem{Field: FieldPostalCode, Message: fmt.Sprintf("is not a postal code in %s", rules.Subdivisions[subdivision])})
+		}
+	}
+
+	return a, problems
+}
+
+// postalCode returns a postal code, already uppercased with its spaces
+// collapsed, in the canonical form of the first format it matches
+func (r *CountryRules) postalCode(code string) (string, bool) {
+	for i := range r.PostalCodes {
+		format := &r.PostalCodes[i]
+		if format.re != nil && format.re.MatchString(code) {
+			return format.re.ReplaceAllString(code, format.Canonical), true
+		}
+	}
+	return "", false
+}
+
+// subdivision returns the code of the subdivision a state names, given as
+// its code, its full ISO 3166-2 code or its name
+func (r *CountryRules) subdivision(country, state string) (string, bool) {
+	code := strings.ToUpper(state)
+	code = strings.TrimPrefix(code, country+"-")
+	if _, ok := r.Subdivisions[code]; ok {
+		return code, true
+	}
+	for code, name := range r.Subdivisions {
+		if strings.EqualFold(name, state) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package postal
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func loadRules(t *testing.T) *Config {
+	t.Helper()
+	cfg, err := LoadConfig(filepath.Join("..", "..", "config", "address_rules.json"))
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	return cfg
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg := loadRules(t)
+	if cfg.Version == "" || cfg.Countries["US"] == nil || len(cfg.Countries["US"].Subdivisions) != 57 || cfg.Countries["CA"].SubdivisionPostalPrefixes["ON"] == nil {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestConfig_Normalize(t *testing.T) {
+	cfg := loadRules(t)
+
+	tests := []struct {
+		name         string
+		address      Address
+		want         Address
+		wantProblems []string // Field: message, warnings prefixed with "warning "
+	}{
+		{
+			name:    "US ZIP+4 and state name",
+			address: Address{Street1: " 1600  Pennsylvania Ave NW ", City: "Washington", State: "district of columbia", PostalCode: "205000003", Country: "us"},
+			want:    Address{Street1: "1600 Pennsylvania Ave NW", City: "Washington", State: "DC", PostalCode: "20500-0003", Country: "US"},
+		},
+		{
+			name:    "US full subdivision code",
+			address: Address{Street1: "350 Fifth Avenue", City: "New York", State: "US-NY", PostalCode: "10118", Country: "US"},
+			want:    Address{Street1: "350 Fifth Avenue", City: "New York", State: "NY", PostalCode: "10118", Country: "US"},
+		},
+		{
+			name:         "US unknown state and short ZIP",
+			address:      Address{Street1: "1 Main St", City: "Springfield", State: "Springfield", PostalCode: "1234", Country: "US"},
+			wantProblems: []string{"state: must be an ISO 3166-2 subdivision of United States", "postal_code: must be a United States postal code, such as 10001"},
+		},
+		{
+			name:         "US missing state",
+			address:      Address{Street1: "1 Main St", City: "Springfield", PostalCode: "62701", Country: "US"},
+			wantProblems: []string{"state: is required"},
+		},
+		{
+			name:    "UK postcode without a space",
+			address: Address{Street1: "10 Downing Street", City: "London", PostalCode: "sw1a2aa", Country: "GB"},
+			want:    Address{Street1: "10 Downing Street", City: "London", PostalCode: "SW1A 2AA", Country: "GB"},
+		},
+		{
+			name:         "UK postcode malformed",
+			address:      Address{Street1: "10 Downing Street", City: "London", PostalCode: "SW1A 2A", Country: "GB"},
+			wantProblems: []string{"postal_code: must be a United Kingdom postal code, such as SW1A 1AA"},
+		},
+		{
+			name:    "Canadian postal code",
+			address: Address{Street1: "111 Wellington St", City: "Ottawa", State: "ontario", PostalCode: "k1a0a9", Country: "CA"},
+			want:    Address{Street1: "111 Wellington St", City: "Ottawa", State: "ON", PostalCode: "K1A 0A9", Country: "CA"},
+		},
+		{
+			name:         "Canadian FSA in another province",
+			address:      Address{Street1: "111 Wellington St", City: "Ottawa", State: "QC", PostalCode: "K1A 0A9", Country: "CA"},
+			wantProblems: []string{"postal_code: is not a postal code in Quebec"},
+		},
+		{
+			name:         "German address with a state",
+			address:      Address{Street1: "Platz der Republik 1", City: "Berlin", State: "Berlin", PostalCode: "11011", Country: "DE"},
+			wantProblems: []string{"state: is not used in Germany addresses"},
+		},
+		{
+			name:         "unknown country",
+			address:      Address{Street1: "Rua Augusta 100", City: "Lisboa", PostalCode: "1100-053", Country: "PT"},
+			want:         Address{Street1: "Rua Augusta 100", City: "Lisboa", PostalCode: "1100-053", Country: "PT"},
+			wantProblems: []string{"warning country: there are no address rules for PT, so only the generic rules were checked"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, problems := cfg.Normalize(tt.address)
+
+			var messages []string
+			for _, p := range problems {
+				message := string(p.Field) + ": " + p.Message
+				if p.Warning {
+					message = "warning " + message
+				}
+				messages = append(messages, message)
+			}
+			if strings.Join(messages, "\n") != strings.Join(tt.wantProblems, "\n") {
+				t.Errorf("Normalize() problems = %q, want %q", messages, tt.wantProblems)
+			}
+			if tt.want != (Address{}) && got != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr string
+	}{
+		{"no version", func(c *Config) { c.Version = "" }, "no version"},
+		{"bad country code", func(c *Config) { c.Countries["USA"] = c.Countries["US"] }, "alpha-2"},
+		{"unknown field", func(c *Config) { c.Countries["GB"].Required = append(c.Countries["GB"].Required, "county") }, "unknown field"},
+		{"required and forbidden", func(c *Config) { c.Countries["DE"].Required = append(c.Countries["DE"].Required, FieldState) }, "both required and forbidden"},
+		{"bad pattern", func(c *Config) { c.Countries["FR"].PostalCodes[0].Pattern = "^([0-9]{5}$" }, "postal_codes"},
+		{"example not canonical", func(c *Config) { c.Countries["GB"].PostalCodeExample = "SW1A1AA" }, "not in canonical form"},
+		{"bad subdivision", func(c *Config) { c.Countries["US"].Subdivisions["new-york"] = "New York" }, "subdivision code"},
+		{"prefixes of unknown subdivision", func(c *Config) { c.Countries["CA"].SubdivisionPostalPrefixes["XX"] = []string{"X"} }, "unknown subdivision"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadRules(t)
+			tt.mutate(cfg)
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ParseConfig([]byte(`{"version": 1}`)); err == nil {
+		t.Error("ParseConfig() accepted a malformed document")
+	}
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Errorf("DefaultConfig().Validate() error: %v", err)
+	}
+}
@@ -32,15 +32,21 @@ type Customer struct {
 	LastName       string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	DateOfBirth    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
 	Email          string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-	Phone          string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	Phone          string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"` // E.164
 	Status         string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy      string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy      string                 `protobuf:"bytes,13,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	Version        int32                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	CustomerType   string                 `protobuf:"bytes,15,opt,name=customer_type,json=customerType,proto3" json:"customer_type,omitempty"` // Individual or Business
+	Business       *BusinessDetails       `protobuf:"bytes,16,opt,name=business,proto3" json:"business,omitempty"`                             // Set for businesses only
+	PhoneInput     string                 `protobuf:"bytes,17,opt,name=phone_input,json=phoneInput,proto3" json:"phone_input,omitempty"`       // The phone number as it was given
+	// mobile, fixed_line, fixed_line_or_mobile where the country does not tell
+	// them apart, toll_free, or unknown for countries without a numbering plan
+	PhoneType     string `protobuf:"bytes,18,opt,name=phone_type,json=phoneType,proto3" json:"phone_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Customer) Reset() {
@@ -171,6 +177,120 @@ func (x *Customer) GetVersion() int32 {
 	return 0
 }
 
+func (x *Customer) GetCustomerType() string {
+	if x != nil {
+		return x.CustomerType
+	}
+	return ""
+}
+
+func (x *Customer) GetBusiness() *BusinessDetails {
+	if x != nil {
+		return x.Business
+	}
+	return nil
+}
+
+func (x *Customer) GetPhoneInput() string {
+	if x != nil {
+		return x.PhoneInput
+	}
+	return ""
+}
+
+func (x *Customer) GetPhoneType() string {
+	if x != nil {
+		return x.PhoneType
+	}
+	return ""
+}
+
+// BusinessDetails are the registration details of a business customer,
+// which has no name or date of birth of its own
+type BusinessDetails struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RegisteredName     string                 `protobuf:"bytes,1,opt,name=registered_name,json=registeredName,proto3" json:"registered_name,omitempty"`
+	RegistrationNumber string                 `protobuf:"bytes,2,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	Jurisdiction       string                 `protobuf:"bytes,3,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"` // ISO 3166-1 alpha-2 country of registration
+	Lei                string                 `protobuf:"bytes,4,opt,name=lei,proto3" json:"lei,omitempty"`                   // Legal Entity Identifier, if any
+	IncorporationDate  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=incorporation_date,json=incorporationDate,proto3" json:"incorporation_date,omitempty"`
+	IndustryCode       string                 `protobuf:"bytes,6,opt,name=industry_code,json=industryCode,proto3" json:"industry_code,omitempty"` // Such as a NACE or SIC code
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BusinessDetails) Reset() {
+	*x = BusinessDetails{}
+	mi := &file_customer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessDetails) ProtoMessage() {}
+
+func (x *BusinessDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessDetails.ProtoReflect.Descriptor instead.
+func (*BusinessDetails) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BusinessDetails) GetRegisteredName() string {
+	if x != nil {
+		return x.RegisteredName
+	}
+	return ""
+}
+
+func (x *BusinessDetails) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *BusinessDetails) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *BusinessDetails) GetLei() string {
+	if x != nil {
+		return x.Lei
+	}
+	return ""
+}
+
+func (x *BusinessDetails) GetIncorporationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IncorporationDate
+	}
+	return nil
+}
+
+func (x *BusinessDetails) GetIndustryCode() string {
+	if x != nil {
+		return x.IndustryCode
+	}
+	return ""
+}
+
 // Address represents a customer's address
 type Address struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -188,13 +308,14 @@ type Address struct {
 	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SupersedesId  string                 `protobuf:"bytes,15,opt,name=supersedes_id,json=supersedesId,proto3" json:"supersedes_id,omitempty"` // The version this one replaced, if any
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Address) Reset() {
 	*x = Address{}
-	mi := &file_customer_proto_msgTypes[1]
+	mi := &file_customer_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +327,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[1]
+	mi := &file_customer_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +340,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{1}
+	return file_customer_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Address) GetId() string {
@@ -320,6 +441,13 @@ func (x *Address) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Address) GetSupersedesId() string {
+	if x != nil {
+		return x.SupersedesId
+	}
+	return ""
+}
+
 // Document represents a customer's identification document
 type Document struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -342,7 +470,7 @@ type Document struct {
 
 func (x *Document) Reset() {
 	*x = Document{}
-	mi := &file_customer_proto_msgTypes[2]
+	mi := &file_customer_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -354,7 +482,7 @@ func (x *Document) String() string {
 func (*Document) ProtoMessage() {}
 
 func (x *Document) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[2]
+	mi := &file_customer_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +495,7 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Document.ProtoReflect.Descriptor instead.
 func (*Document) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{2}
+	return file_customer_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Document) GetId() string {
@@ -461,35 +589,37 @@ func (x *Document) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// StatusChange records a customer status change
-type StatusChange struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CustomerId     string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	PreviousStatus string                 `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
-	NewStatus      string                 `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
-	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	ChangedBy      string                 `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
-	ChangedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// DocumentFile is a stored scan of a customer document
+type DocumentFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // Sniffed from the content
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"` // Hex digest of the content
+	UploadedBy    string                 `protobuf:"bytes,8,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	UploadedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatusChange) Reset() {
-	*x = StatusChange{}
-	mi := &file_customer_proto_msgTypes[3]
+func (x *DocumentFile) Reset() {
+	*x = DocumentFile{}
+	mi := &file_customer_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatusChange) String() string {
+func (x *DocumentFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusChange) ProtoMessage() {}
+func (*DocumentFile) ProtoMessage() {}
 
-func (x *StatusChange) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[3]
+func (x *DocumentFile) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,90 +630,104 @@ func (x *StatusChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
-func (*StatusChange) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DocumentFile.ProtoReflect.Descriptor instead.
+func (*DocumentFile) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *StatusChange) GetId() string {
+func (x *DocumentFile) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *StatusChange) GetCustomerId() string {
+func (x *DocumentFile) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DocumentFile) GetCustomerId() string {
 	if x != nil {
 		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *StatusChange) GetPreviousStatus() string {
+func (x *DocumentFile) GetFileName() string {
 	if x != nil {
-		return x.PreviousStatus
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *StatusChange) GetNewStatus() string {
+func (x *DocumentFile) GetContentType() string {
 	if x != nil {
-		return x.NewStatus
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *StatusChange) GetReason() string {
+func (x *DocumentFile) GetSize() int64 {
 	if x != nil {
-		return x.Reason
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DocumentFile) GetSha256() string {
+	if x != nil {
+		return x.Sha256
 	}
 	return ""
 }
 
-func (x *StatusChange) GetChangedBy() string {
+func (x *DocumentFile) GetUploadedBy() string {
 	if x != nil {
-		return x.ChangedBy
+		return x.UploadedBy
 	}
 	return ""
 }
 
-func (x *StatusChange) GetChangedAt() *timestamppb.Timestamp {
+func (x *DocumentFile) GetUploadedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ChangedAt
+		return x.UploadedAt
 	}
 	return nil
 }
 
-// CreateCustomerRequest is the request for creating a customer
-type CreateCustomerRequest struct {
+// LegalHold stops a customer's personal data being erased while it is active
+type LegalHold struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FirstName     string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	MiddleName    string                 `protobuf:"bytes,2,opt,name=middle_name,json=middleName,proto3" json:"middle_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	DateOfBirth   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
-	TaxId         string                 `protobuf:"bytes,5,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"` // Encrypted in transit
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	PlacedBy      string                 `protobuf:"bytes,4,opt,name=placed_by,json=placedBy,proto3" json:"placed_by,omitempty"`
+	PlacedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	ReleasedBy    string                 `protobuf:"bytes,6,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
+	ReleasedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomerRequest) Reset() {
-	*x = CreateCustomerRequest{}
-	mi := &file_customer_proto_msgTypes[4]
+func (x *LegalHold) Reset() {
+	*x = LegalHold{}
+	mi := &file_customer_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomerRequest) String() string {
+func (x *LegalHold) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomerRequest) ProtoMessage() {}
+func (*LegalHold) ProtoMessage() {}
 
-func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[4]
+func (x *LegalHold) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,90 +738,100 @@ func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
-func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LegalHold.ProtoReflect.Descriptor instead.
+func (*LegalHold) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateCustomerRequest) GetFirstName() string {
+func (x *LegalHold) GetId() string {
 	if x != nil {
-		return x.FirstName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateCustomerRequest) GetMiddleName() string {
+func (x *LegalHold) GetCustomerId() string {
 	if x != nil {
-		return x.MiddleName
+		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *CreateCustomerRequest) GetLastName() string {
+func (x *LegalHold) GetReason() string {
 	if x != nil {
-		return x.LastName
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreateCustomerRequest) GetDateOfBirth() *timestamppb.Timestamp {
+func (x *LegalHold) GetPlacedBy() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.PlacedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateCustomerRequest) GetTaxId() string {
+func (x *LegalHold) GetPlacedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TaxId
+		return x.PlacedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCustomerRequest) GetEmail() string {
+func (x *LegalHold) GetReleasedBy() string {
 	if x != nil {
-		return x.Email
+		return x.ReleasedBy
 	}
 	return ""
 }
 
-func (x *CreateCustomerRequest) GetPhone() string {
+func (x *LegalHold) GetReleasedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Phone
+		return x.ReleasedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCustomerRequest) GetCreatedBy() string {
+func (x *LegalHold) GetActive() bool {
 	if x != nil {
-		return x.CreatedBy
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-// CreateCustomerResponse is the response for creating a customer
-type CreateCustomerResponse struct {
+// ErasureReport describes what erasing a customer does, or would do
+type ErasureReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Eligible      bool                   `protobuf:"varint,2,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Blockers      []string               `protobuf:"bytes,3,rep,name=blockers,proto3" json:"blockers,omitempty"` // Why the customer cannot be erased yet
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	RetainUntil   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retain_until,json=retainUntil,proto3" json:"retain_until,omitempty"`
+	Fields        []string               `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"` // Customer fields pseudonymized
+	Addresses     int32                  `protobuf:"varint,7,opt,name=addresses,proto3" json:"addresses,omitempty"`
+	Documents     int32                  `protobuf:"varint,8,opt,name=documents,proto3" json:"documents,omitempty"`
+	DocumentFiles int32                  `protobuf:"varint,9,opt,name=document_files,json=documentFiles,proto3" json:"document_files,omitempty"` // Scans deleted
+	ScreeningHits int32                  `protobuf:"varint,10,opt,name=screening_hits,json=screeningHits,proto3" json:"screening_hits,omitempty"`
+	DataExports   int32                  `protobuf:"varint,11,opt,name=data_exports,json=dataExports,proto3" json:"data_exports,omitempty"` // Stored subject access bundles deleted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomerResponse) Reset() {
-	*x = CreateCustomerResponse{}
-	mi := &file_customer_proto_msgTypes[5]
+func (x *ErasureReport) Reset() {
+	*x = ErasureReport{}
+	mi := &file_customer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomerResponse) String() string {
+func (x *ErasureReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomerResponse) ProtoMessage() {}
+func (*ErasureReport) ProtoMessage() {}
 
-func (x *CreateCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[5]
+func (x *ErasureReport) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,85 +842,116 @@ func (x *CreateCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomerResponse.ProtoReflect.Descriptor instead.
-func (*CreateCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ErasureReport.ProtoReflect.Descriptor instead.
+func (*ErasureReport) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateCustomerResponse) GetCustomer() *Customer {
+func (x *ErasureReport) GetCustomerId() string {
 	if x != nil {
-		return x.Customer
+		return x.CustomerId
 	}
-	return nil
+	return ""
 }
 
-// GetCustomerRequest is the request for getting a customer
-type GetCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ErasureReport) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
 }
 
-func (x *GetCustomerRequest) Reset() {
-	*x = GetCustomerRequest{}
-	mi := &file_customer_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ErasureReport) GetBlockers() []string {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
 }
 
-func (x *GetCustomerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ErasureReport) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
 }
 
-func (*GetCustomerRequest) ProtoMessage() {}
+func (x *ErasureReport) GetRetainUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetainUntil
+	}
+	return nil
+}
 
-func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[6]
+func (x *ErasureReport) GetFields() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Fields
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{6}
+func (x *ErasureReport) GetAddresses() int32 {
+	if x != nil {
+		return x.Addresses
+	}
+	return 0
 }
 
-func (x *GetCustomerRequest) GetId() string {
+func (x *ErasureReport) GetDocuments() int32 {
 	if x != nil {
-		return x.Id
+		return x.Documents
 	}
-	return ""
+	return 0
 }
 
-// GetCustomerResponse is the response for getting a customer
-type GetCustomerResponse struct {
+func (x *ErasureReport) GetDocumentFiles() int32 {
+	if x != nil {
+		return x.DocumentFiles
+	}
+	return 0
+}
+
+func (x *ErasureReport) GetScreeningHits() int32 {
+	if x != nil {
+		return x.ScreeningHits
+	}
+	return 0
+}
+
+func (x *ErasureReport) GetDataExports() int32 {
+	if x != nil {
+		return x.DataExports
+	}
+	return 0
+}
+
+// ErasureRequest records a request to erase a customer and its outcome
+type ErasureRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // Completed or Refused
+	Blockers      []string               `protobuf:"bytes,6,rep,name=blockers,proto3" json:"blockers,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerResponse) Reset() {
-	*x = GetCustomerResponse{}
+func (x *ErasureRequest) Reset() {
+	*x = ErasureRequest{}
 	mi := &file_customer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerResponse) String() string {
+func (x *ErasureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerResponse) ProtoMessage() {}
+func (*ErasureRequest) ProtoMessage() {}
 
-func (x *GetCustomerResponse) ProtoReflect() protoreflect.Message {
+func (x *ErasureRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,49 +963,93 @@ func (x *GetCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerResponse.ProtoReflect.Descriptor instead.
-func (*GetCustomerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ErasureRequest.ProtoReflect.Descriptor instead.
+func (*ErasureRequest) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCustomerResponse) GetCustomer() *Customer {
+func (x *ErasureRequest) GetId() string {
 	if x != nil {
-		return x.Customer
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ErasureRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ErasureRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ErasureRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ErasureRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ErasureRequest) GetBlockers() []string {
+	if x != nil {
+		return x.Blockers
 	}
 	return nil
 }
 
-// UpdateCustomerRequest is the request for updating a customer
-type UpdateCustomerRequest struct {
+func (x *ErasureRequest) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+// DataExport is a subject access request and the bundle produced for it
+type DataExport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	MiddleName    string                 `protobuf:"bytes,3,opt,name=middle_name,json=middleName,proto3" json:"middle_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	DateOfBirth   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
-	TaxId         string                 `protobuf:"bytes,6,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"` // Encrypted in transit
-	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	Version       int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // Pending, Completed or Failed
+	Records       int32                  `protobuf:"varint,5,opt,name=records,proto3" json:"records,omitempty"`
+	Sha256        string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`                           // Hex digest of the JSON bundle
+	Signature     string                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`                     // Base64 Ed25519 signature of the JSON bundle
+	SigningKey    string                 `protobuf:"bytes,8,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"` // Base64 Ed25519 public key
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerRequest) Reset() {
-	*x = UpdateCustomerRequest{}
+func (x *DataExport) Reset() {
+	*x = DataExport{}
 	mi := &file_customer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerRequest) String() string {
+func (x *DataExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerRequest) ProtoMessage() {}
+func (*DataExport) ProtoMessage() {}
 
-func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
+func (x *DataExport) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -832,103 +1061,120 @@ func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateCustomerRequest) GetId() string {
+func (x *DataExport) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetFirstName() string {
+func (x *DataExport) GetCustomerId() string {
 	if x != nil {
-		return x.FirstName
+		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetMiddleName() string {
+func (x *DataExport) GetRequestedBy() string {
 	if x != nil {
-		return x.MiddleName
+		return x.RequestedBy
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetLastName() string {
+func (x *DataExport) GetStatus() string {
 	if x != nil {
-		return x.LastName
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetDateOfBirth() *timestamppb.Timestamp {
+func (x *DataExport) GetRecords() int32 {
 	if x != nil {
-		return x.DateOfBirth
+		return x.Records
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateCustomerRequest) GetTaxId() string {
+func (x *DataExport) GetSha256() string {
 	if x != nil {
-		return x.TaxId
+		return x.Sha256
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetEmail() string {
+func (x *DataExport) GetSignature() string {
 	if x != nil {
-		return x.Email
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetPhone() string {
+func (x *DataExport) GetSigningKey() string {
 	if x != nil {
-		return x.Phone
+		return x.SigningKey
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetUpdatedBy() string {
+func (x *DataExport) GetError() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Error
 	}
 	return ""
 }
 
-func (x *UpdateCustomerRequest) GetVersion() int32 {
+func (x *DataExport) GetRequestedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Version
+		return x.RequestedAt
 	}
-	return 0
+	return nil
 }
 
-// UpdateCustomerResponse is the response for updating a customer
-type UpdateCustomerResponse struct {
+func (x *DataExport) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// MergeFieldChange is a detail of the survivor replaced in a merge. Tax ID
+// values are never returned.
+type MergeFieldChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerResponse) Reset() {
-	*x = UpdateCustomerResponse{}
+func (x *MergeFieldChange) Reset() {
+	*x = MergeFieldChange{}
 	mi := &file_customer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerResponse) String() string {
+func (x *MergeFieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerResponse) ProtoMessage() {}
+func (*MergeFieldChange) ProtoMessage() {}
 
-func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
+func (x *MergeFieldChange) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -940,48 +1186,68 @@ func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeFieldChange.ProtoReflect.Descriptor instead.
+func (*MergeFieldChange) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateCustomerResponse) GetCustomer() *Customer {
+func (x *MergeFieldChange) GetField() string {
 	if x != nil {
-		return x.Customer
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-// SearchCustomersRequest is the request for searching customers
-type SearchCustomersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FirstName     string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	FromDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
-	ToDate        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
-	Limit         int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MergeFieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
 }
 
-func (x *SearchCustomersRequest) Reset() {
-	*x = SearchCustomersRequest{}
+func (x *MergeFieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// CustomerMerge records a duplicate customer merged into the survivor
+type CustomerMerge struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SurvivorId       string                 `protobuf:"bytes,2,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	MergedId         string                 `protobuf:"bytes,3,opt,name=merged_id,json=mergedId,proto3" json:"merged_id,omitempty"`
+	RulesVersion     string                 `protobuf:"bytes,4,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	Reason           string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	FieldChanges     []*MergeFieldChange    `protobuf:"bytes,6,rep,name=field_changes,json=fieldChanges,proto3" json:"field_changes,omitempty"`
+	MovedAddressIds  []string               `protobuf:"bytes,7,rep,name=moved_address_ids,json=movedAddressIds,proto3" json:"moved_address_ids,omitempty"`
+	MovedDocumentIds []string               `protobuf:"bytes,8,rep,name=moved_document_ids,json=movedDocumentIds,proto3" json:"moved_document_ids,omitempty"`
+	MergedStatus     string                 `protobuf:"bytes,9,opt,name=merged_status,json=mergedStatus,proto3" json:"merged_status,omitempty"` // The merged customer's status beforehand
+	MergedBy         string                 `protobuf:"bytes,10,opt,name=merged_by,json=mergedBy,proto3" json:"merged_by,omitempty"`
+	MergedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	UndoUntil        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=undo_until,json=undoUntil,proto3" json:"undo_until,omitempty"`
+	UnmergedBy       string                 `protobuf:"bytes,13,opt,name=unmerged_by,json=unmergedBy,proto3" json:"unmerged_by,omitempty"`
+	UnmergedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=unmerged_at,json=unmergedAt,proto3" json:"unmerged_at,omitempty"`
+	UnmergeReason    string                 `protobuf:"bytes,15,opt,name=unmerge_reason,json=unmergeReason,proto3" json:"unmerge_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CustomerMerge) Reset() {
+	*x = CustomerMerge{}
 	mi := &file_customer_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchCustomersRequest) String() string {
+func (x *CustomerMerge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchCustomersRequest) ProtoMessage() {}
+func (*CustomerMerge) ProtoMessage() {}
 
-func (x *SearchCustomersRequest) ProtoReflect() protoreflect.Message {
+func (x *CustomerMerge) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -993,97 +1259,144 @@ func (x *SearchCustomersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchCustomersRequest.ProtoReflect.Descriptor instead.
-func (*SearchCustomersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomerMerge.ProtoReflect.Descriptor instead.
+func (*CustomerMerge) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SearchCustomersRequest) GetFirstName() string {
+func (x *CustomerMerge) GetId() string {
 	if x != nil {
-		return x.FirstName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SearchCustomersRequest) GetLastName() string {
+func (x *CustomerMerge) GetSurvivorId() string {
 	if x != nil {
-		return x.LastName
+		return x.SurvivorId
 	}
 	return ""
 }
 
-func (x *SearchCustomersRequest) GetEmail() string {
+func (x *CustomerMerge) GetMergedId() string {
 	if x != nil {
-		return x.Email
+		return x.MergedId
 	}
 	return ""
 }
 
-func (x *SearchCustomersRequest) GetPhone() string {
+func (x *CustomerMerge) GetRulesVersion() string {
 	if x != nil {
-		return x.Phone
+		return x.RulesVersion
 	}
 	return ""
 }
 
-func (x *SearchCustomersRequest) GetStatus() string {
+func (x *CustomerMerge) GetReason() string {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *SearchCustomersRequest) GetFromDate() *timestamppb.Timestamp {
+func (x *CustomerMerge) GetFieldChanges() []*MergeFieldChange {
 	if x != nil {
-		return x.FromDate
+		return x.FieldChanges
 	}
 	return nil
 }
 
-func (x *SearchCustomersRequest) GetToDate() *timestamppb.Timestamp {
+func (x *CustomerMerge) GetMovedAddressIds() []string {
 	if x != nil {
-		return x.ToDate
+		return x.MovedAddressIds
 	}
 	return nil
 }
 
-func (x *SearchCustomersRequest) GetLimit() int32 {
+func (x *CustomerMerge) GetMovedDocumentIds() []string {
 	if x != nil {
-		return x.Limit
+		return x.MovedDocumentIds
 	}
-	return 0
+	return nil
 }
 
-func (x *SearchCustomersRequest) GetOffset() int32 {
+func (x *CustomerMerge) GetMergedStatus() string {
 	if x != nil {
-		return x.Offset
+		return x.MergedStatus
 	}
-	return 0
+	return ""
 }
 
-// SearchCustomersResponse is the response for searching customers
-type SearchCustomersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CustomerMerge) GetMergedBy() string {
+	if x != nil {
+		return x.MergedBy
+	}
+	return ""
 }
 
-func (x *SearchCustomersResponse) Reset() {
-	*x = SearchCustomersResponse{}
+func (x *CustomerMerge) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *CustomerMerge) GetUndoUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UndoUntil
+	}
+	return nil
+}
+
+func (x *CustomerMerge) GetUnmergedBy() string {
+	if x != nil {
+		return x.UnmergedBy
+	}
+	return ""
+}
+
+func (x *CustomerMerge) GetUnmergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnmergedAt
+	}
+	return nil
+}
+
+func (x *CustomerMerge) GetUnmergeReason() string {
+	if x != nil {
+		return x.UnmergeReason
+	}
+	return ""
+}
+
+// CustomerEvent is an entry in the customer event feed
+type CustomerEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sequence          int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Id                string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type              string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // CustomerMerged or CustomerUnmerged
+	CustomerId        string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RelatedCustomerId string                 `protobuf:"bytes,5,opt,name=related_customer_id,json=relatedCustomerId,proto3" json:"related_customer_id,omitempty"` // The survivor, for merge events
+	ReferenceId       string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`                     // The merge, for merge events
+	OccurredAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CustomerEvent) Reset() {
+	*x = CustomerEvent{}
 	mi := &file_customer_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchCustomersResponse) String() string {
+func (x *CustomerEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchCustomersResponse) ProtoMessage() {}
+func (*CustomerEvent) ProtoMessage() {}
 
-func (x *SearchCustomersResponse) ProtoReflect() protoreflect.Message {
+func (x *CustomerEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,57 +1408,95 @@ func (x *SearchCustomersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchCustomersResponse.ProtoReflect.Descriptor instead.
-func (*SearchCustomersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomerEvent.ProtoReflect.Descriptor instead.
+func (*CustomerEvent) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SearchCustomersResponse) GetCustomers() []*Customer {
+func (x *CustomerEvent) GetSequence() int64 {
 	if x != nil {
-		return x.Customers
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (x *SearchCustomersResponse) GetTotal() int32 {
+func (x *CustomerEvent) GetId() string {
 	if x != nil {
-		return x.Total
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-// AddAddressRequest is the request for adding an address
-type AddAddressRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	AddressType   string                 `protobuf:"bytes,2,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
-	Street1       string                 `protobuf:"bytes,3,opt,name=street1,proto3" json:"street1,omitempty"`
-	Street2       string                 `protobuf:"bytes,4,opt,name=street2,proto3" json:"street2,omitempty"`
-	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
-	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	Country       string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,9,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
-	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CustomerEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *AddAddressRequest) Reset() {
-	*x = AddAddressRequest{}
+func (x *CustomerEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetRelatedCustomerId() string {
+	if x != nil {
+		return x.RelatedCustomerId
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Consent records a customer agreeing to one purpose on one channel under a
+// version of the policy text. Consents are never changed, except to be
+// withdrawn.
+type Consent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Purpose          string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"` // Marketing, DataSharing or ServiceMessages
+	Channel          string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"` // Email, SMS, Phone, Post, Push or All
+	PolicyVersion    string                 `protobuf:"bytes,5,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Source           string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"` // Web, MobileApp, Branch, CallCentre or Paper
+	GrantedBy        string                 `protobuf:"bytes,7,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	GrantedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	RecordedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	WithdrawnBy      string                 `protobuf:"bytes,10,opt,name=withdrawn_by,json=withdrawnBy,proto3" json:"withdrawn_by,omitempty"`
+	WithdrawnAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=withdrawn_at,json=withdrawnAt,proto3" json:"withdrawn_at,omitempty"`
+	WithdrawalSource string                 `protobuf:"bytes,12,opt,name=withdrawal_source,json=withdrawalSource,proto3" json:"withdrawal_source,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Consent) Reset() {
+	*x = Consent{}
 	mi := &file_customer_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAddressRequest) String() string {
+func (x *Consent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAddressRequest) ProtoMessage() {}
+func (*Consent) ProtoMessage() {}
 
-func (x *AddAddressRequest) ProtoReflect() protoreflect.Message {
+func (x *Consent) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1157,110 +1508,127 @@ func (x *AddAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAddressRequest.ProtoReflect.Descriptor instead.
-func (*AddAddressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Consent.ProtoReflect.Descriptor instead.
+func (*Consent) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AddAddressRequest) GetCustomerId() string {
+func (x *Consent) GetId() string {
 	if x != nil {
-		return x.CustomerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddAddressRequest) GetAddressType() string {
+func (x *Consent) GetCustomerId() string {
 	if x != nil {
-		return x.AddressType
+		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *AddAddressRequest) GetStreet1() string {
+func (x *Consent) GetPurpose() string {
 	if x != nil {
-		return x.Street1
+		return x.Purpose
 	}
 	return ""
 }
 
-func (x *AddAddressRequest) GetStreet2() string {
+func (x *Consent) GetChannel() string {
 	if x != nil {
-		return x.Street2
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *AddAddressRequest) GetCity() string {
+func (x *Consent) GetPolicyVersion() string {
 	if x != nil {
-		return x.City
+		return x.PolicyVersion
 	}
 	return ""
 }
 
-func (x *AddAddressRequest) GetState() string {
+func (x *Consent) GetSource() string {
 	if x != nil {
-		return x.State
+		return x.Source
 	}
 	return ""
 }
 
-func (x *AddAddressRequest) GetPostalCode() string {
+func (x *Consent) GetGrantedBy() string {
 	if x != nil {
-		return x.PostalCode
+		return x.GrantedBy
 	}
 	return ""
 }
 
-func (x *AddAddressRequest) GetCountry() string {
+func (x *Consent) GetGrantedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Country
+		return x.GrantedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *AddAddressRequest) GetIsPrimary() bool {
+func (x *Consent) GetRecordedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsPrimary
+		return x.RecordedAt
 	}
-	return false
+	return nil
 }
 
-func (x *AddAddressRequest) GetValidFrom() *timestamppb.Timestamp {
+func (x *Consent) GetWithdrawnBy() string {
 	if x != nil {
-		return x.ValidFrom
+		return x.WithdrawnBy
 	}
-	return nil
+	return ""
 }
 
-func (x *AddAddressRequest) GetValidTo() *timestamppb.Timestamp {
+func (x *Consent) GetWithdrawnAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ValidTo
+		return x.WithdrawnAt
 	}
 	return nil
 }
 
-// AddAddressResponse is the response for adding an address
-type AddAddressResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Consent) GetWithdrawalSource() string {
+	if x != nil {
+		return x.WithdrawalSource
+	}
+	return ""
 }
 
-func (x *AddAddressResponse) Reset() {
-	*x = AddAddressResponse{}
+// CustomerRelationship links an individual customer to a business customer
+// in one role
+type CustomerRelationship struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BusinessId          string                 `protobuf:"bytes,2,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	IndividualId        string                 `protobuf:"bytes,3,opt,name=individual_id,json=individualId,proto3" json:"individual_id,omitempty"`
+	Role                string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                                            // Director, AuthorisedSignatory or BeneficialOwner
+	OwnershipPercentage float64                `protobuf:"fixed64,5,opt,name=ownership_percentage,json=ownershipPercentage,proto3" json:"ownership_percentage,omitempty"` // Beneficial owners only
+	CreatedBy           string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EndedBy             string                 `protobuf:"bytes,8,opt,name=ended_by,json=endedBy,proto3" json:"ended_by,omitempty"`
+	EndedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	EndReason           string                 `protobuf:"bytes,10,opt,name=end_reason,json=endReason,proto3" json:"end_reason,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CustomerRelationship) Reset() {
+	*x = CustomerRelationship{}
 	mi := &file_customer_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAddressResponse) String() string {
+func (x *CustomerRelationship) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAddressResponse) ProtoMessage() {}
+func (*CustomerRelationship) ProtoMessage() {}
 
-func (x *AddAddressResponse) ProtoReflect() protoreflect.Message {
+func (x *CustomerRelationship) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1272,47 +1640,5736 @@ func (x *AddAddressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAddressResponse.ProtoReflect.Descriptor instead.
-func (*AddAddressResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomerRelationship.ProtoReflect.Descriptor instead.
+func (*CustomerRelationship) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AddAddressResponse) GetAddress() *Address {
+func (x *CustomerRelationship) GetId() string {
 	if x != nil {
-		return x.Address
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomerRelationship) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *CustomerRelationship) GetIndividualId() string {
+	if x != nil {
+		return x.IndividualId
+	}
+	return ""
+}
+
+func (x *CustomerRelationship) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CustomerRelationship) GetOwnershipPercentage() float64 {
+	if x != nil {
+		return x.OwnershipPercentage
+	}
+	return 0
+}
+
+func (x *CustomerRelationship) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CustomerRelationship) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// AddDocumentRequest is the request for adding a document
-type AddDocumentRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId       string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	DocumentType     string                 `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
-	DocumentNumber   string                 `protobuf:"bytes,3,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"` // Encrypted in transit
-	IssuingAuthority string                 `protobuf:"bytes,4,opt,name=issuing_authority,json=issuingAuthority,proto3" json:"issuing_authority,omitempty"`
-	IssuingCountry   string                 `protobuf:"bytes,5,opt,name=issuing_country,json=issuingCountry,proto3" json:"issuing_country,omitempty"`
-	IssueDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
-	ExpiryDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *CustomerRelationship) GetEndedBy() string {
+	if x != nil {
+		return x.EndedBy
+	}
+	return ""
 }
 
-func (x *AddDocumentRequest) Reset() {
-	*x = AddDocumentRequest{}
+func (x *CustomerRelationship) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *CustomerRelationship) GetEndReason() string {
+	if x != nil {
+		return x.EndReason
+	}
+	return ""
+}
+
+// StatusChange records a customer status change
+type StatusChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId     string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	PreviousStatus string                 `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	NewStatus      string                 `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy      string                 `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_customer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
 	mi := &file_customer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StatusChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusChange) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *StatusChange) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// ScreeningHit is a potential match between a customer and a sanctions list entry
+type ScreeningHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScreeningId   string                 `protobuf:"bytes,2,opt,name=screening_id,json=screeningId,proto3" json:"screening_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ListName      string                 `protobuf:"bytes,4,opt,name=list_name,json=listName,proto3" json:"list_name,omitempty"`
+	ListVersion   string                 `protobuf:"bytes,5,opt,name=list_version,json=listVersion,proto3" json:"list_version,omitempty"`
+	EntryId       string                 `protobuf:"bytes,6,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	EntryName     string                 `protobuf:"bytes,7,opt,name=entry_name,json=entryName,proto3" json:"entry_name,omitempty"`
+	MatchedName   string                 `protobuf:"bytes,8,opt,name=matched_name,json=matchedName,proto3" json:"matched_name,omitempty"`
+	Subject       string                 `protobuf:"bytes,9,opt,name=subject,proto3" json:"subject,omitempty"`
+	Score         float64                `protobuf:"fixed64,10,opt,name=score,proto3" json:"score,omitempty"`
+	NameScore     float64                `protobuf:"fixed64,11,opt,name=name_score,json=nameScore,proto3" json:"name_score,omitempty"`
+	DobMatch      string                 `protobuf:"bytes,12,opt,name=dob_match,json=dobMatch,proto3" json:"dob_match,omitempty"` // Exact, Partial, Mismatch or Unknown
+	CountryMatch  bool                   `protobuf:"varint,13,opt,name=country_match,json=countryMatch,proto3" json:"country_match,omitempty"`
+	Status        string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"` // Pending, Confirmed or Dismissed
+	ReviewedBy    string                 `protobuf:"bytes,15,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewNotes   string                 `protobuf:"bytes,17,opt,name=review_notes,json=reviewNotes,proto3" json:"review_notes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreeningHit) Reset() {
+	*x = ScreeningHit{}
+	mi := &file_customer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreeningHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningHit) ProtoMessage() {}
+
+func (x *ScreeningHit) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningHit.ProtoReflect.Descriptor instead.
+func (*ScreeningHit) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScreeningHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetScreeningId() string {
+	if x != nil {
+		return x.ScreeningId
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetListName() string {
+	if x != nil {
+		return x.ListName
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetListVersion() string {
+	if x != nil {
+		return x.ListVersion
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetEntryName() string {
+	if x != nil {
+		return x.EntryName
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetMatchedName() string {
+	if x != nil {
+		return x.MatchedName
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScreeningHit) GetNameScore() float64 {
+	if x != nil {
+		return x.NameScore
+	}
+	return 0
+}
+
+func (x *ScreeningHit) GetDobMatch() string {
+	if x != nil {
+		return x.DobMatch
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetCountryMatch() bool {
+	if x != nil {
+		return x.CountryMatch
+	}
+	return false
+}
+
+func (x *ScreeningHit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *ScreeningHit) GetReviewNotes() string {
+	if x != nil {
+		return x.ReviewNotes
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CustomerScreening records one screening of a customer
+type CustomerScreening struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Trigger       string                 `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Outcome       string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"` // Clear or PotentialMatch
+	ListVersions  []string               `protobuf:"bytes,5,rep,name=list_versions,json=listVersions,proto3" json:"list_versions,omitempty"`
+	ScreenedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=screened_at,json=screenedAt,proto3" json:"screened_at,omitempty"`
+	Hits          []*ScreeningHit        `protobuf:"bytes,7,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerScreening) Reset() {
+	*x = CustomerScreening{}
+	mi := &file_customer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerScreening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerScreening) ProtoMessage() {}
+
+func (x *CustomerScreening) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerScreening.ProtoReflect.Descriptor instead.
+func (*CustomerScreening) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CustomerScreening) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomerScreening) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CustomerScreening) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *CustomerScreening) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *CustomerScreening) GetListVersions() []string {
+	if x != nil {
+		return x.ListVersions
+	}
+	return nil
+}
+
+func (x *CustomerScreening) GetScreenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScreenedAt
+	}
+	return nil
+}
+
+func (x *CustomerScreening) GetHits() []*ScreeningHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// RiskFactor is one reason a customer received their risk rating
+type RiskFactor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskFactor) Reset() {
+	*x = RiskFactor{}
+	mi := &file_customer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskFactor) ProtoMessage() {}
+
+func (x *RiskFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskFactor.ProtoReflect.Descriptor instead.
+func (*RiskFactor) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RiskFactor) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RiskFactor) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RiskFactor) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// CustomerRiskAssessment records one KYC risk rating of a customer
+type CustomerRiskAssessment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Rating        string                 `protobuf:"bytes,3,opt,name=rating,proto3" json:"rating,omitempty"` // Low, Medium or High
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Factors       []*RiskFactor          `protobuf:"bytes,5,rep,name=factors,proto3" json:"factors,omitempty"`
+	ModelVersion  string                 `protobuf:"bytes,6,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	Trigger       string                 `protobuf:"bytes,7,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	AssessedBy    string                 `protobuf:"bytes,8,opt,name=assessed_by,json=assessedBy,proto3" json:"assessed_by,omitempty"` // Empty when assessed automatically
+	AssessedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=assessed_at,json=assessedAt,proto3" json:"assessed_at,omitempty"`
+	NextReviewAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_review_at,json=nextReviewAt,proto3" json:"next_review_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerRiskAssessment) Reset() {
+	*x = CustomerRiskAssessment{}
+	mi := &file_customer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerRiskAssessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerRiskAssessment) ProtoMessage() {}
+
+func (x *CustomerRiskAssessment) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerRiskAssessment.ProtoReflect.Descriptor instead.
+func (*CustomerRiskAssessment) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CustomerRiskAssessment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomerRiskAssessment) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CustomerRiskAssessment) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *CustomerRiskAssessment) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CustomerRiskAssessment) GetFactors() []*RiskFactor {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+func (x *CustomerRiskAssessment) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+func (x *CustomerRiskAssessment) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *CustomerRiskAssessment) GetAssessedBy() string {
+	if x != nil {
+		return x.AssessedBy
+	}
+	return ""
+}
+
+func (x *CustomerRiskAssessment) GetAssessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssessedAt
+	}
+	return nil
+}
+
+func (x *CustomerRiskAssessment) GetNextReviewAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextReviewAt
+	}
+	return nil
+}
+
+// ReviewTask is a periodic KYC review a customer is due
+type ReviewTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AssessmentId  string                 `protobuf:"bytes,3,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	Rating        string                 `protobuf:"bytes,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // Open or Completed
+	Overdue       bool                   `protobuf:"varint,7,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CompletedBy   string                 `protobuf:"bytes,10,opt,name=completed_by,json=completedBy,proto3" json:"completed_by,omitempty"`
+	Notes         string                 `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewTask) Reset() {
+	*x = ReviewTask{}
+	mi := &file_customer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTask) ProtoMessage() {}
+
+func (x *ReviewTask) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTask.ProtoReflect.Descriptor instead.
+func (*ReviewTask) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReviewTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewTask) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ReviewTask) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *ReviewTask) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *ReviewTask) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *ReviewTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewTask) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *ReviewTask) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReviewTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ReviewTask) GetCompletedBy() string {
+	if x != nil {
+		return x.CompletedBy
+	}
+	return ""
+}
+
+func (x *ReviewTask) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// CreateCustomerRequest is the request for creating a customer
+type CreateCustomerRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FirstName   string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	MiddleName  string                 `protobuf:"bytes,2,opt,name=middle_name,json=middleName,proto3" json:"middle_name,omitempty"`
+	LastName    string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	TaxId       string                 `protobuf:"bytes,5,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"` // Encrypted in transit
+	Email       string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	// In international format, or in national format for a business in its
+	// jurisdiction
+	Phone     string `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
+	CreatedBy string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Creates the customer even though it scores as a likely duplicate
+	DuplicateOverrideReason string `protobuf:"bytes,9,opt,name=duplicate_override_reason,json=duplicateOverrideReason,proto3" json:"duplicate_override_reason,omitempty"`
+	// Individual when unset. Businesses give business in place of a name and
+	// date of birth.
+	CustomerType  string           `protobuf:"bytes,10,opt,name=customer_type,json=customerType,proto3" json:"customer_type,omitempty"`
+	Business      *BusinessDetails `protobuf:"bytes,11,opt,name=business,proto3" json:"business,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomerRequest) Reset() {
+	*x = CreateCustomerRequest{}
+	mi := &file_customer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerRequest) ProtoMessage() {}
+
+func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateCustomerRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetMiddleName() string {
+	if x != nil {
+		return x.MiddleName
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *CreateCustomerRequest) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetDuplicateOverrideReason() string {
+	if x != nil {
+		return x.DuplicateOverrideReason
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetCustomerType() string {
+	if x != nil {
+		return x.CustomerType
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetBusiness() *BusinessDetails {
+	if x != nil {
+		return x.Business
+	}
+	return nil
+}
+
+// CreateCustomerResponse is the response for creating a customer
+type CreateCustomerResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Customer       *Customer               `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Screening      *CustomerScreening      `protobuf:"bytes,2,opt,name=screening,proto3" json:"screening,omitempty"`                                 // Unset when screening is disabled
+	RiskAssessment *CustomerRiskAssessment `protobuf:"bytes,3,opt,name=risk_assessment,json=riskAssessment,proto3" json:"risk_assessment,omitempty"` // Unset when risk rating is disabled
+	// Existing customers scoring at least the review threshold against the
+	// new one; empty when duplicate checks are disabled
+	PotentialDuplicates []*DuplicateCandidate `protobuf:"bytes,4,rep,name=potential_duplicates,json=potentialDuplicates,proto3" json:"potential_duplicates,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateCustomerResponse) Reset() {
+	*x = CreateCustomerResponse{}
+	mi := &file_customer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerResponse) ProtoMessage() {}
+
+func (x *CreateCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *CreateCustomerResponse) GetScreening() *CustomerScreening {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+func (x *CreateCustomerResponse) GetRiskAssessment() *CustomerRiskAssessment {
+	if x != nil {
+		return x.RiskAssessment
+	}
+	return nil
+}
+
+func (x *CreateCustomerResponse) GetPotentialDuplicates() []*DuplicateCandidate {
+	if x != nil {
+		return x.PotentialDuplicates
+	}
+	return nil
+}
+
+// GetCustomerRequest is the request for getting a customer by ID or by
+// customer number
+type GetCustomerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerNumber string                 `protobuf:"bytes,2,opt,name=customer_number,json=customerNumber,proto3" json:"customer_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCustomerRequest) Reset() {
+	*x = GetCustomerRequest{}
+	mi := &file_customer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRequest) ProtoMessage() {}
+
+func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetCustomerRequest) GetCustomerNumber() string {
+	if x != nil {
+		return x.CustomerNumber
+	}
+	return ""
+}
+
+// GetCustomerResponse is the response for getting a customer. A customer
+// merged into another is redirected to the survivor.
+type GetCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	MergedFrom    string                 `protobuf:"bytes,2,opt,name=merged_from,json=mergedFrom,proto3" json:"merged_from,omitempty"` // The merged customer's ID when redirected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerResponse) Reset() {
+	*x = GetCustomerResponse{}
+	mi := &file_customer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerResponse) ProtoMessage() {}
+
+func (x *GetCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *GetCustomerResponse) GetMergedFrom() string {
+	if x != nil {
+		return x.MergedFrom
+	}
+	return ""
+}
+
+// UpdateCustomerRequest is the request for updating a customer
+type UpdateCustomerRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName   string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	MiddleName  string                 `protobuf:"bytes,3,opt,name=middle_name,json=middleName,proto3" json:"middle_name,omitempty"`
+	LastName    string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	TaxId       string                 `protobuf:"bytes,6,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"` // Encrypted in transit
+	Email       string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	// A number in national format is read as one of the country of the
+	// primary address, or for a business with none, its jurisdiction
+	Phone         string           `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	UpdatedBy     string           `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32            `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	Business      *BusinessDetails `protobuf:"bytes,11,opt,name=business,proto3" json:"business,omitempty"` // Businesses only; replaces the details when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerRequest) Reset() {
+	*x = UpdateCustomerRequest{}
+	mi := &file_customer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetMiddleName() string {
+	if x != nil {
+		return x.MiddleName
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *UpdateCustomerRequest) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateCustomerRequest) GetBusiness() *BusinessDetails {
+	if x != nil {
+		return x.Business
+	}
+	return nil
+}
+
+// UpdateCustomerResponse is the response for updating a customer
+type UpdateCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Screening     *CustomerScreening     `protobuf:"bytes,2,opt,name=screening,proto3" json:"screening,omitempty"` // Set when the name or date of birth changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerResponse) Reset() {
+	*x = UpdateCustomerResponse{}
+	mi := &file_customer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerResponse) ProtoMessage() {}
+
+func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *UpdateCustomerResponse) GetScreening() *CustomerScreening {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+// SearchCustomersRequest is the request for searching customers
+type SearchCustomersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FirstName     string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"` // A whole number, or some of its digits
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	FromDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Limit         int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	PhoneCountry  string                 `protobuf:"bytes,10,opt,name=phone_country,json=phoneCountry,proto3" json:"phone_country,omitempty"` // The country of a phone in national format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCustomersRequest) Reset() {
+	*x = SearchCustomersRequest{}
+	mi := &file_customer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCustomersRequest) ProtoMessage() {}
+
+func (x *SearchCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCustomersRequest.ProtoReflect.Descriptor instead.
+func (*SearchCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchCustomersRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *SearchCustomersRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *SearchCustomersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SearchCustomersRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SearchCustomersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchCustomersRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *SearchCustomersRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *SearchCustomersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchCustomersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchCustomersRequest) GetPhoneCountry() string {
+	if x != nil {
+		return x.PhoneCountry
+	}
+	return ""
+}
+
+// SearchCustomersResponse is the response for searching customers
+type SearchCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCustomersResponse) Reset() {
+	*x = SearchCustomersResponse{}
+	mi := &file_customer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCustomersResponse) ProtoMessage() {}
+
+func (x *SearchCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCustomersResponse.ProtoReflect.Descriptor instead.
+func (*SearchCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchCustomersResponse) GetCustomers() []*Customer {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *SearchCustomersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// AddAddressRequest is the request for adding an address
+type AddAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressType   string                 `protobuf:"bytes,2,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	Street1       string                 `protobuf:"bytes,3,opt,name=street1,proto3" json:"street1,omitempty"`
+	Street2       string                 `protobuf:"bytes,4,opt,name=street2,proto3" json:"street2,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,9,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAddressRequest) Reset() {
+	*x = AddAddressRequest{}
+	mi := &file_customer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAddressRequest) ProtoMessage() {}
+
+func (x *AddAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetStreet1() string {
+	if x != nil {
+		return x.Street1
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetStreet2() string {
+	if x != nil {
+		return x.Street2
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *AddAddressRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *AddAddressRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+// AddAddressResponse is the response for adding an address
+type AddAddressResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// What the address rules could not check, such as an address in a
+	// country they do not cover
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAddressResponse) Reset() {
+	*x = AddAddressResponse{}
+	mi := &file_customer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAddressResponse) ProtoMessage() {}
+
+func (x *AddAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAddressResponse.ProtoReflect.Descriptor instead.
+func (*AddAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *AddAddressResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// UpdateAddressRequest is the request for changing an address. The fields
+// replace those of the current version.
+type UpdateAddressRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AddressId   string                 `protobuf:"bytes,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	AddressType string                 `protobuf:"bytes,2,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"` // Unchanged when unset
+	Street1     string                 `protobuf:"bytes,3,opt,name=street1,proto3" json:"street1,omitempty"`
+	Street2     string                 `protobuf:"bytes,4,opt,name=street2,proto3" json:"street2,omitempty"`
+	City        string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State       string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode  string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country     string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
+	// When the change took effect, if earlier than now, as for a late-reported move
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressRequest) Reset() {
+	*x = UpdateAddressRequest{}
+	mi := &file_customer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressRequest) ProtoMessage() {}
+
+func (x *UpdateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetStreet1() string {
+	if x != nil {
+		return x.Street1
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetStreet2() string {
+	if x != nil {
+		return x.Street2
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+// UpdateAddressResponse is the response for changing an address
+type UpdateAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`   // The new version
+	Previous      *Address               `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"` // The version it closed
+	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"` // What the address rules could not check
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressResponse) Reset() {
+	*x = UpdateAddressResponse{}
+	mi := &file_customer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressResponse) ProtoMessage() {}
+
+func (x *UpdateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateAddressResponse) GetPrevious() *Address {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *UpdateAddressResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// EndDateAddressRequest is the request for closing an address
+type EndDateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressId     string                 `protobuf:"bytes,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"` // Now when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndDateAddressRequest) Reset() {
+	*x = EndDateAddressRequest{}
+	mi := &file_customer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndDateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDateAddressRequest) ProtoMessage() {}
+
+func (x *EndDateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDateAddressRequest.ProtoReflect.Descriptor instead.
+func (*EndDateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EndDateAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *EndDateAddressRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+// EndDateAddressResponse is the response for closing an address
+type EndDateAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndDateAddressResponse) Reset() {
+	*x = EndDateAddressResponse{}
+	mi := &file_customer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndDateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDateAddressResponse) ProtoMessage() {}
+
+func (x *EndDateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDateAddressResponse.ProtoReflect.Descriptor instead.
+func (*EndDateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EndDateAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// SetPrimaryAddressRequest is the request for making an address primary
+type SetPrimaryAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressId     string                 `protobuf:"bytes,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // Now when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryAddressRequest) Reset() {
+	*x = SetPrimaryAddressRequest{}
+	mi := &file_customer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryAddressRequest) ProtoMessage() {}
+
+func (x *SetPrimaryAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryAddressRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetPrimaryAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *SetPrimaryAddressRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+// SetPrimaryAddressResponse is the response for making an address primary
+type SetPrimaryAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // The new, primary version
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryAddressResponse) Reset() {
+	*x = SetPrimaryAddressResponse{}
+	mi := &file_customer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryAddressResponse) ProtoMessage() {}
+
+func (x *SetPrimaryAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryAddressResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetPrimaryAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// ListAddressesRequest is the request for listing a customer's addresses
+type ListAddressesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId     string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	IncludeHistory bool                   `protobuf:"varint,2,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"` // Include closed versions
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAddressesRequest) Reset() {
+	*x = ListAddressesRequest{}
+	mi := &file_customer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesRequest) ProtoMessage() {}
+
+func (x *ListAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListAddressesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListAddressesRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+// ListAddressesResponse is the response for listing a customer's addresses
+type ListAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*Address             `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressesResponse) Reset() {
+	*x = ListAddressesResponse{}
+	mi := &file_customer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesResponse) ProtoMessage() {}
+
+func (x *ListAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAddressesResponse) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// GetAddressesAsOfRequest is the request for the addresses a customer had on
+// a day
+type GetAddressesAsOfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // Any time on the day, in UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressesAsOfRequest) Reset() {
+	*x = GetAddressesAsOfRequest{}
+	mi := &file_customer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressesAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressesAsOfRequest) ProtoMessage() {}
+
+func (x *GetAddressesAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressesAsOfRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressesAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAddressesAsOfRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetAddressesAsOfRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+// GetAddressesAsOfResponse lists the addresses in effect at the close of
+// the day
+type GetAddressesAsOfResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*Address             `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressesAsOfResponse) Reset() {
+	*x = GetAddressesAsOfResponse{}
+	mi := &file_customer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressesAsOfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressesAsOfResponse) ProtoMessage() {}
+
+func (x *GetAddressesAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressesAsOfResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressesAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAddressesAsOfResponse) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// AddDocumentRequest is the request for adding a document
+type AddDocumentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId       string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	DocumentType     string                 `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	DocumentNumber   string                 `protobuf:"bytes,3,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"` // Encrypted in transit
+	IssuingAuthority string                 `protobuf:"bytes,4,opt,name=issuing_authority,json=issuingAuthority,proto3" json:"issuing_authority,omitempty"`
+	IssuingCountry   string                 `protobuf:"bytes,5,opt,name=issuing_country,json=issuingCountry,proto3" json:"issuing_country,omitempty"`
+	IssueDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
+	ExpiryDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddDocumentRequest) Reset() {
+	*x = AddDocumentRequest{}
+	mi := &file_customer_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDocumentRequest) ProtoMessage() {}
+
+func (x *AddDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDocumentRequest.ProtoReflect.Descriptor instead.
+func (*AddDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AddDocumentRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddDocumentRequest) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *AddDocumentRequest) GetDocumentNumber() string {
+	if x != nil {
+		return x.DocumentNumber
+	}
+	return ""
+}
+
+func (x *AddDocumentRequest) GetIssuingAuthority() string {
+	if x != nil {
+		return x.IssuingAuthority
+	}
+	return ""
+}
+
+func (x *AddDocumentRequest) GetIssuingCountry() string {
+	if x != nil {
+		return x.IssuingCountry
+	}
+	return ""
+}
+
+func (x *AddDocumentRequest) GetIssueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssueDate
+	}
+	return nil
+}
+
+func (x *AddDocumentRequest) GetExpiryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return nil
+}
+
+// AddDocumentResponse is the response for adding a document
+type AddDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDocumentResponse) Reset() {
+	*x = AddDocumentResponse{}
+	mi := &file_customer_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDocumentResponse) ProtoMessage() {}
+
+func (x *AddDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDocumentResponse.ProtoReflect.Descriptor instead.
+func (*AddDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddDocumentResponse) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+// UpdateCustomerStatusRequest is the request for updating customer status
+type UpdateCustomerStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewStatus     string                 `protobuf:"bytes,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerStatusRequest) Reset() {
+	*x = UpdateCustomerStatusRequest{}
+	mi := &file_customer_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerStatusRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateCustomerStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomerStatusRequest) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *UpdateCustomerStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateCustomerStatusRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+// UpdateCustomerStatusResponse is the response for updating customer status
+type UpdateCustomerStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	StatusChange  *StatusChange          `protobuf:"bytes,2,opt,name=status_change,json=statusChange,proto3" json:"status_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerStatusResponse) Reset() {
+	*x = UpdateCustomerStatusResponse{}
+	mi := &file_customer_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerStatusResponse) ProtoMessage() {}
+
+func (x *UpdateCustomerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateCustomerStatusResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *UpdateCustomerStatusResponse) GetStatusChange() *StatusChange {
+	if x != nil {
+		return x.StatusChange
+	}
+	return nil
+}
+
+// CustomerFullProfileResponse contains the complete customer profile
+type CustomerFullProfileResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Customer      *Customer               `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Addresses     []*Address              `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Documents     []*Document             `protobuf:"bytes,3,rep,name=documents,proto3" json:"documents,omitempty"`
+	StatusHistory []*StatusChange         `protobuf:"bytes,4,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+	MergedFrom    string                  `protobuf:"bytes,5,opt,name=merged_from,json=mergedFrom,proto3" json:"merged_from,omitempty"` // The merged customer's ID when redirected
+	Relationships []*CustomerRelationship `protobuf:"bytes,6,rep,name=relationships,proto3" json:"relationships,omitempty"`             // Active relationships only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerFullProfileResponse) Reset() {
+	*x = CustomerFullProfileResponse{}
+	mi := &file_customer_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerFullProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerFullProfileResponse) ProtoMessage() {}
+
+func (x *CustomerFullProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerFullProfileResponse.ProtoReflect.Descriptor instead.
+func (*CustomerFullProfileResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CustomerFullProfileResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *CustomerFullProfileResponse) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *CustomerFullProfileResponse) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *CustomerFullProfileResponse) GetStatusHistory() []*StatusChange {
+	if x != nil {
+		return x.StatusHistory
+	}
+	return nil
+}
+
+func (x *CustomerFullProfileResponse) GetMergedFrom() string {
+	if x != nil {
+		return x.MergedFrom
+	}
+	return ""
+}
+
+func (x *CustomerFullProfileResponse) GetRelationships() []*CustomerRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+// ScreenCustomerRequest is the request for screening a customer
+type ScreenCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreenCustomerRequest) Reset() {
+	*x = ScreenCustomerRequest{}
+	mi := &file_customer_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenCustomerRequest) ProtoMessage() {}
+
+func (x *ScreenCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenCustomerRequest.ProtoReflect.Descriptor instead.
+func (*ScreenCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ScreenCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+// ScreenCustomerResponse is the response for screening a customer
+type ScreenCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Screening     *CustomerScreening     `protobuf:"bytes,1,opt,name=screening,proto3" json:"screening,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreenCustomerResponse) Reset() {
+	*x = ScreenCustomerResponse{}
+	mi := &file_customer_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenCustomerResponse) ProtoMessage() {}
+
+func (x *ScreenCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenCustomerResponse.ProtoReflect.Descriptor instead.
+func (*ScreenCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ScreenCustomerResponse) GetScreening() *CustomerScreening {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+// GetCustomerScreeningRequest is the request for getting a customer's latest screening
+type GetCustomerScreeningRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerScreeningRequest) Reset() {
+	*x = GetCustomerScreeningRequest{}
+	mi := &file_customer_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerScreeningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerScreeningRequest) ProtoMessage() {}
+
+func (x *GetCustomerScreeningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerScreeningRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerScreeningRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCustomerScreeningRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+// GetCustomerScreeningResponse is the response for getting a customer's latest screening
+type GetCustomerScreeningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Screening     *CustomerScreening     `protobuf:"bytes,1,opt,name=screening,proto3" json:"screening,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerScreeningResponse) Reset() {
+	*x = GetCustomerScreeningResponse{}
+	mi := &file_customer_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerScreeningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerScreeningResponse) ProtoMessage() {}
+
+func (x *GetCustomerScreeningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerScreeningResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerScreeningResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCustomerScreeningResponse) GetScreening() *CustomerScreening {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+// ListScreeningHitsRequest is the request for listing screening hits
+type ListScreeningHitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScreeningHitsRequest) Reset() {
+	*x = ListScreeningHitsRequest{}
+	mi := &file_customer_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScreeningHitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScreeningHitsRequest) ProtoMessage() {}
+
+func (x *ListScreeningHitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScreeningHitsRequest.ProtoReflect.Descriptor instead.
+func (*ListScreeningHitsRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListScreeningHitsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListScreeningHitsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListScreeningHitsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListScreeningHitsResponse is the response for listing screening hits
+type ListScreeningHitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*ScreeningHit        `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScreeningHitsResponse) Reset() {
+	*x = ListScreeningHitsResponse{}
+	mi := &file_customer_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScreeningHitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScreeningHitsResponse) ProtoMessage() {}
+
+func (x *ListScreeningHitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScreeningHitsResponse.ProtoReflect.Descriptor instead.
+func (*ListScreeningHitsResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListScreeningHitsResponse) GetHits() []*ScreeningHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// ReviewScreeningHitRequest is the request for reviewing a screening hit
+type ReviewScreeningHitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HitId         string                 `protobuf:"bytes,1,opt,name=hit_id,json=hitId,proto3" json:"hit_id,omitempty"`
+	Decision      string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"` // Confirmed or Dismissed
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,4,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewScreeningHitRequest) Reset() {
+	*x = ReviewScreeningHitRequest{}
+	mi := &file_customer_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewScreeningHitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewScreeningHitRequest) ProtoMessage() {}
+
+func (x *ReviewScreeningHitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewScreeningHitRequest.ProtoReflect.Descriptor instead.
+func (*ReviewScreeningHitRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReviewScreeningHitRequest) GetHitId() string {
+	if x != nil {
+		return x.HitId
+	}
+	return ""
+}
+
+func (x *ReviewScreeningHitRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ReviewScreeningHitRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ReviewScreeningHitRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+// ReviewScreeningHitResponse is the response for reviewing a screening hit
+type ReviewScreeningHitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hit           *ScreeningHit          `protobuf:"bytes,1,opt,name=hit,proto3" json:"hit,omitempty"`
+	Customer      *Customer              `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewScreeningHitResponse) Reset() {
+	*x = ReviewScreeningHitResponse{}
+	mi := &file_customer_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewScreeningHitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewScreeningHitResponse) ProtoMessage() {}
+
+func (x *ReviewScreeningHitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewScreeningHitResponse.ProtoReflect.Descriptor instead.
+func (*ReviewScreeningHitResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReviewScreeningHitResponse) GetHit() *ScreeningHit {
+	if x != nil {
+		return x.Hit
+	}
+	return nil
+}
+
+func (x *ReviewScreeningHitResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// AssessCustomerRiskRequest is the request for assessing a customer's risk
+type AssessCustomerRiskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AssessedBy    string                 `protobuf:"bytes,2,opt,name=assessed_by,json=assessedBy,proto3" json:"assessed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessCustomerRiskRequest) Reset() {
+	*x = AssessCustomerRiskRequest{}
+	mi := &file_customer_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessCustomerRiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessCustomerRiskRequest) ProtoMessage() {}
+
+func (x *AssessCustomerRiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessCustomerRiskRequest.ProtoReflect.Descriptor instead.
+func (*AssessCustomerRiskRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AssessCustomerRiskRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AssessCustomerRiskRequest) GetAssessedBy() string {
+	if x != nil {
+		return x.AssessedBy
+	}
+	return ""
+}
+
+// AssessCustomerRiskResponse is the response for assessing a customer's risk
+type AssessCustomerRiskResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Assessment    *CustomerRiskAssessment `protobuf:"bytes,1,opt,name=assessment,proto3" json:"assessment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessCustomerRiskResponse) Reset() {
+	*x = AssessCustomerRiskResponse{}
+	mi := &file_customer_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessCustomerRiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessCustomerRiskResponse) ProtoMessage() {}
+
+func (x *AssessCustomerRiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessCustomerRiskResponse.ProtoReflect.Descriptor instead.
+func (*AssessCustomerRiskResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AssessCustomerRiskResponse) GetAssessment() *CustomerRiskAssessment {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+// GetCustomerRiskHistoryRequest is the request for a customer's risk history
+type GetCustomerRiskHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRiskHistoryRequest) Reset() {
+	*x = GetCustomerRiskHistoryRequest{}
+	mi := &file_customer_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRiskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRiskHistoryRequest) ProtoMessage() {}
+
+func (x *GetCustomerRiskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRiskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRiskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetCustomerRiskHistoryRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetCustomerRiskHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetCustomerRiskHistoryResponse is the response for a customer's risk history
+type GetCustomerRiskHistoryResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Assessments   []*CustomerRiskAssessment `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRiskHistoryResponse) Reset() {
+	*x = GetCustomerRiskHistoryResponse{}
+	mi := &file_customer_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRiskHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRiskHistoryResponse) ProtoMessage() {}
+
+func (x *GetCustomerRiskHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRiskHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerRiskHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetCustomerRiskHistoryResponse) GetAssessments() []*CustomerRiskAssessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+// ListReviewTasksRequest is the request for listing review tasks
+type ListReviewTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	OverdueOnly   bool                   `protobuf:"varint,3,opt,name=overdue_only,json=overdueOnly,proto3" json:"overdue_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewTasksRequest) Reset() {
+	*x = ListReviewTasksRequest{}
+	mi := &file_customer_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewTasksRequest) ProtoMessage() {}
+
+func (x *ListReviewTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewTasksRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListReviewTasksRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListReviewTasksRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReviewTasksRequest) GetOverdueOnly() bool {
+	if x != nil {
+		return x.OverdueOnly
+	}
+	return false
+}
+
+func (x *ListReviewTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListReviewTasksResponse is the response for listing review tasks
+type ListReviewTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*ReviewTask          `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewTasksResponse) Reset() {
+	*x = ListReviewTasksResponse{}
+	mi := &file_customer_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewTasksResponse) ProtoMessage() {}
+
+func (x *ListReviewTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewTasksResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListReviewTasksResponse) GetTasks() []*ReviewTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// CompleteReviewTaskRequest is the request for completing a review task
+type CompleteReviewTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CompletedBy   string                 `protobuf:"bytes,2,opt,name=completed_by,json=completedBy,proto3" json:"completed_by,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteReviewTaskRequest) Reset() {
+	*x = CompleteReviewTaskRequest{}
+	mi := &file_customer_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteReviewTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteReviewTaskRequest) ProtoMessage() {}
+
+func (x *CompleteReviewTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteReviewTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteReviewTaskRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CompleteReviewTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CompleteReviewTaskRequest) GetCompletedBy() string {
+	if x != nil {
+		return x.CompletedBy
+	}
+	return ""
+}
+
+func (x *CompleteReviewTaskRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// CompleteReviewTaskResponse is the response for completing a review task
+type CompleteReviewTaskResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Task          *ReviewTask             `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Assessment    *CustomerRiskAssessment `protobuf:"bytes,2,opt,name=assessment,proto3" json:"assessment,omitempty"`
+	Customer      *Customer               `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteReviewTaskResponse) Reset() {
+	*x = CompleteReviewTaskResponse{}
+	mi := &file_customer_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteReviewTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteReviewTaskResponse) ProtoMessage() {}
+
+func (x *CompleteReviewTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteReviewTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteReviewTaskResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CompleteReviewTaskResponse) GetTask() *ReviewTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *CompleteReviewTaskResponse) GetAssessment() *CustomerRiskAssessment {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+func (x *CompleteReviewTaskResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// DocumentFileHeader describes a file being uploaded
+type DocumentFileHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,3,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	Sha256        string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"` // Optional hex digest, checked when the upload completes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentFileHeader) Reset() {
+	*x = DocumentFileHeader{}
+	mi := &file_customer_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentFileHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentFileHeader) ProtoMessage() {}
+
+func (x *DocumentFileHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentFileHeader.ProtoReflect.Descriptor instead.
+func (*DocumentFileHeader) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DocumentFileHeader) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DocumentFileHeader) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DocumentFileHeader) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *DocumentFileHeader) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// UploadDocumentFileRequest is one message of an upload: the header first,
+// then the content in chunks
+type UploadDocumentFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadDocumentFileRequest_Header
+	//	*UploadDocumentFileRequest_Chunk
+	Data          isUploadDocumentFileRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentFileRequest) Reset() {
+	*x = UploadDocumentFileRequest{}
+	mi := &file_customer_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentFileRequest) ProtoMessage() {}
+
+func (x *UploadDocumentFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentFileRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentFileRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UploadDocumentFileRequest) GetData() isUploadDocumentFileRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadDocumentFileRequest) GetHeader() *DocumentFileHeader {
+	if x != nil {
+		if x, ok := x.Data.(*UploadDocumentFileRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadDocumentFileRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadDocumentFileRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadDocumentFileRequest_Data interface {
+	isUploadDocumentFileRequest_Data()
+}
+
+type UploadDocumentFileRequest_Header struct {
+	Header *DocumentFileHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadDocumentFileRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadDocumentFileRequest_Header) isUploadDocumentFileRequest_Data() {}
+
+func (*UploadDocumentFileRequest_Chunk) isUploadDocumentFileRequest_Data() {}
+
+// UploadDocumentFileResponse is the response for uploading a document file
+type UploadDocumentFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          *DocumentFile          `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentFileResponse) Reset() {
+	*x = UploadDocumentFileResponse{}
+	mi := &file_customer_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentFileResponse) ProtoMessage() {}
+
+func (x *UploadDocumentFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentFileResponse.ProtoReflect.Descriptor instead.
+func (*UploadDocumentFileResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UploadDocumentFileResponse) GetFile() *DocumentFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+// DownloadDocumentFileRequest is the request for downloading a document file
+type DownloadDocumentFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // Recorded in the access log
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDocumentFileRequest) Reset() {
+	*x = DownloadDocumentFileRequest{}
+	mi := &file_customer_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDocumentFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDocumentFileRequest) ProtoMessage() {}
+
+func (x *DownloadDocumentFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDocumentFileRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDocumentFileRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DownloadDocumentFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *DownloadDocumentFileRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *DownloadDocumentFileRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DownloadDocumentFileResponse is one message of a download: the file's
+// details first, then the content in chunks
+type DownloadDocumentFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadDocumentFileResponse_File
+	//	*DownloadDocumentFileResponse_Chunk
+	Data          isDownloadDocumentFileResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDocumentFileResponse) Reset() {
+	*x = DownloadDocumentFileResponse{}
+	mi := &file_customer_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDocumentFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDocumentFileResponse) ProtoMessage() {}
+
+func (x *DownloadDocumentFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDocumentFileResponse.ProtoReflect.Descriptor instead.
+func (*DownloadDocumentFileResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DownloadDocumentFileResponse) GetData() isDownloadDocumentFileResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadDocumentFileResponse) GetFile() *DocumentFile {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadDocumentFileResponse_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *DownloadDocumentFileResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadDocumentFileResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadDocumentFileResponse_Data interface {
+	isDownloadDocumentFileResponse_Data()
+}
+
+type DownloadDocumentFileResponse_File struct {
+	File *DocumentFile `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type DownloadDocumentFileResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadDocumentFileResponse_File) isDownloadDocumentFileResponse_Data() {}
+
+func (*DownloadDocumentFileResponse_Chunk) isDownloadDocumentFileResponse_Data() {}
+
+// ListDocumentFilesRequest is the request for listing a document's files
+type ListDocumentFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentFilesRequest) Reset() {
+	*x = ListDocumentFilesRequest{}
+	mi := &file_customer_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentFilesRequest) ProtoMessage() {}
+
+func (x *ListDocumentFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentFilesRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListDocumentFilesRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+// ListDocumentFilesResponse is the response for listing a document's files
+type ListDocumentFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*DocumentFile        `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentFilesResponse) Reset() {
+	*x = ListDocumentFilesResponse{}
+	mi := &file_customer_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentFilesResponse) ProtoMessage() {}
+
+func (x *ListDocumentFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentFilesResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListDocumentFilesResponse) GetFiles() []*DocumentFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// EraseCustomerRequest is the request for erasing a customer
+type EraseCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Report without changing or recording anything
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseCustomerRequest) Reset() {
+	*x = EraseCustomerRequest{}
+	mi := &file_customer_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseCustomerRequest) ProtoMessage() {}
+
+func (x *EraseCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseCustomerRequest.ProtoReflect.Descriptor instead.
+func (*EraseCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *EraseCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *EraseCustomerRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *EraseCustomerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EraseCustomerRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// EraseCustomerResponse is the response for erasing a customer
+type EraseCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ErasureReport         `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Request       *ErasureRequest        `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"` // Unset for a dry run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseCustomerResponse) Reset() {
+	*x = EraseCustomerResponse{}
+	mi := &file_customer_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseCustomerResponse) ProtoMessage() {}
+
+func (x *EraseCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseCustomerResponse.ProtoReflect.Descriptor instead.
+func (*EraseCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *EraseCustomerResponse) GetReport() *ErasureReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *EraseCustomerResponse) GetRequest() *ErasureRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// PlaceLegalHoldRequest is the request for placing a legal hold
+type PlaceLegalHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	PlacedBy      string                 `protobuf:"bytes,2,opt,name=placed_by,json=placedBy,proto3" json:"placed_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceLegalHoldRequest) Reset() {
+	*x = PlaceLegalHoldRequest{}
+	mi := &file_customer_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceLegalHoldRequest) ProtoMessage() {}
+
+func (x *PlaceLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PlaceLegalHoldRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *PlaceLegalHoldRequest) GetPlacedBy() string {
+	if x != nil {
+		return x.PlacedBy
+	}
+	return ""
+}
+
+func (x *PlaceLegalHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// PlaceLegalHoldResponse is the response for placing a legal hold
+type PlaceLegalHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *LegalHold             `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceLegalHoldResponse) Reset() {
+	*x = PlaceLegalHoldResponse{}
+	mi := &file_customer_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceLegalHoldResponse) ProtoMessage() {}
+
+func (x *PlaceLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*PlaceLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PlaceLegalHoldResponse) GetHold() *LegalHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// ReleaseLegalHoldRequest is the request for releasing a legal hold
+type ReleaseLegalHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	ReleasedBy    string                 `protobuf:"bytes,2,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLegalHoldRequest) Reset() {
+	*x = ReleaseLegalHoldRequest{}
+	mi := &file_customer_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLegalHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReleaseLegalHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ReleaseLegalHoldRequest) GetReleasedBy() string {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return ""
+}
+
+// ReleaseLegalHoldResponse is the response for releasing a legal hold
+type ReleaseLegalHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *LegalHold             `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLegalHoldResponse) Reset() {
+	*x = ReleaseLegalHoldResponse{}
+	mi := &file_customer_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLegalHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReleaseLegalHoldResponse) GetHold() *LegalHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// ListLegalHoldsRequest is the request for listing a customer's legal holds
+type ListLegalHoldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLegalHoldsRequest) Reset() {
+	*x = ListLegalHoldsRequest{}
+	mi := &file_customer_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLegalHoldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLegalHoldsRequest) ProtoMessage() {}
+
+func (x *ListLegalHoldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLegalHoldsRequest.ProtoReflect.Descriptor instead.
+func (*ListLegalHoldsRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListLegalHoldsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+// ListLegalHoldsResponse is the response for listing a customer's legal holds
+type ListLegalHoldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holds         []*LegalHold           `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLegalHoldsResponse) Reset() {
+	*x = ListLegalHoldsResponse{}
+	mi := &file_customer_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLegalHoldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLegalHoldsResponse) ProtoMessage() {}
+
+func (x *ListLegalHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLegalHoldsResponse.ProtoReflect.Descriptor instead.
+func (*ListLegalHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListLegalHoldsResponse) GetHolds() []*LegalHold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+// ExportCustomerDataRequest is the request for exporting a customer's data
+type ExportCustomerDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCustomerDataRequest) Reset() {
+	*x = ExportCustomerDataRequest{}
+	mi := &file_customer_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCustomerDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCustomerDataRequest) ProtoMessage() {}
+
+func (x *ExportCustomerDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCustomerDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportCustomerDataRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ExportCustomerDataRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ExportCustomerDataRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+// ExportCustomerDataResponse is the response for exporting a customer's
+// data. Small bundles are produced at once; larger ones are left Pending.
+type ExportCustomerDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCustomerDataResponse) Reset() {
+	*x = ExportCustomerDataResponse{}
+	mi := &file_customer_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCustomerDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCustomerDataResponse) ProtoMessage() {}
+
+func (x *ExportCustomerDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCustomerDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportCustomerDataResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ExportCustomerDataResponse) GetExport() *DataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// GetDataExportRequest is the request for getting a data export
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_customer_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+// GetDataExportResponse is the response for getting a data export
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_customer_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetDataExportResponse) GetExport() *DataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// DownloadDataExportRequest is the request for downloading a data export
+type DownloadDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // json, html or pdf
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportRequest) Reset() {
+	*x = DownloadDataExportRequest{}
+	mi := &file_customer_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportRequest) ProtoMessage() {}
+
+func (x *DownloadDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DownloadDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *DownloadDataExportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DownloadDataExportRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+// DownloadDataExportResponse is one chunk of a data export download
+type DownloadDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportResponse) Reset() {
+	*x = DownloadDataExportResponse{}
+	mi := &file_customer_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportResponse) ProtoMessage() {}
+
+func (x *DownloadDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportResponse.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DownloadDataExportResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// MergeCustomersRequest is the request for merging two customers. The
+// survivorship rules pick the survivor unless survivor_id names one of them.
+type MergeCustomersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	DuplicateId   string                 `protobuf:"bytes,2,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	SurvivorId    string                 `protobuf:"bytes,3,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	MergedBy      string                 `protobuf:"bytes,4,opt,name=merged_by,json=mergedBy,proto3" json:"merged_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Preview without changing or recording anything
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCustomersRequest) Reset() {
+	*x = MergeCustomersRequest{}
+	mi := &file_customer_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCustomersRequest) ProtoMessage() {}
+
+func (x *MergeCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCustomersRequest.ProtoReflect.Descriptor instead.
+func (*MergeCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *MergeCustomersRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *MergeCustomersRequest) GetDuplicateId() string {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return ""
+}
+
+func (x *MergeCustomersRequest) GetSurvivorId() string {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return ""
+}
+
+func (x *MergeCustomersRequest) GetMergedBy() string {
+	if x != nil {
+		return x.MergedBy
+	}
+	return ""
+}
+
+func (x *MergeCustomersRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MergeCustomersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// MergeCustomersResponse is the response for merging two customers
+type MergeCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merge         *CustomerMerge         `protobuf:"bytes,1,opt,name=merge,proto3" json:"merge,omitempty"`
+	Survivor      *Customer              `protobuf:"bytes,2,opt,name=survivor,proto3" json:"survivor,omitempty"` // As it stands after the merge
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCustomersResponse) Reset() {
+	*x = MergeCustomersResponse{}
+	mi := &file_customer_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCustomersResponse) ProtoMessage() {}
+
+func (x *MergeCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCustomersResponse.ProtoReflect.Descriptor instead.
+func (*MergeCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *MergeCustomersResponse) GetMerge() *CustomerMerge {
+	if x != nil {
+		return x.Merge
+	}
+	return nil
+}
+
+func (x *MergeCustomersResponse) GetSurvivor() *Customer {
+	if x != nil {
+		return x.Survivor
+	}
+	return nil
+}
+
+// UnmergeCustomersRequest is the request for reversing a merge
+type UnmergeCustomersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergeId       string                 `protobuf:"bytes,1,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	UnmergedBy    string                 `protobuf:"bytes,2,opt,name=unmerged_by,json=unmergedBy,proto3" json:"unmerged_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmergeCustomersRequest) Reset() {
+	*x = UnmergeCustomersRequest{}
+	mi := &file_customer_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmergeCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeCustomersRequest) ProtoMessage() {}
+
+func (x *UnmergeCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeCustomersRequest.ProtoReflect.Descriptor instead.
+func (*UnmergeCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UnmergeCustomersRequest) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+func (x *UnmergeCustomersRequest) GetUnmergedBy() string {
+	if x != nil {
+		return x.UnmergedBy
+	}
+	return ""
+}
+
+func (x *UnmergeCustomersRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// UnmergeCustomersResponse is the response for reversing a merge. Fields
+// of the survivor changed since the merge keep their new value and are
+// listed in kept_fields.
+type UnmergeCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merge         *CustomerMerge         `protobuf:"bytes,1,opt,name=merge,proto3" json:"merge,omitempty"`
+	Survivor      *Customer              `protobuf:"bytes,2,opt,name=survivor,proto3" json:"survivor,omitempty"`
+	Restored      *Customer              `protobuf:"bytes,3,opt,name=restored,proto3" json:"restored,omitempty"`
+	KeptFields    []string               `protobuf:"bytes,4,rep,name=kept_fields,json=keptFields,proto3" json:"kept_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmergeCustomersResponse) Reset() {
+	*x = UnmergeCustomersResponse{}
+	mi := &file_customer_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmergeCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeCustomersResponse) ProtoMessage() {}
+
+func (x *UnmergeCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeCustomersResponse.ProtoReflect.Descriptor instead.
+func (*UnmergeCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UnmergeCustomersResponse) GetMerge() *CustomerMerge {
+	if x != nil {
+		return x.Merge
+	}
+	return nil
+}
+
+func (x *UnmergeCustomersResponse) GetSurvivor() *Customer {
+	if x != nil {
+		return x.Survivor
+	}
+	return nil
+}
+
+func (x *UnmergeCustomersResponse) GetRestored() *Customer {
+	if x != nil {
+		return x.Restored
+	}
+	return nil
+}
+
+func (x *UnmergeCustomersResponse) GetKeptFields() []string {
+	if x != nil {
+		return x.KeptFields
+	}
+	return nil
+}
+
+// ListCustomerMergesRequest is the request for listing a customer's merges
+type ListCustomerMergesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerMergesRequest) Reset() {
+	*x = ListCustomerMergesRequest{}
+	mi := &file_customer_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerMergesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerMergesRequest) ProtoMessage() {}
+
+func (x *ListCustomerMergesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerMergesRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomerMergesRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListCustomerMergesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+// ListCustomerMergesResponse is the response for listing a customer's merges
+type ListCustomerMergesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merges        []*CustomerMerge       `protobuf:"bytes,1,rep,name=merges,proto3" json:"merges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerMergesResponse) Reset() {
+	*x = ListCustomerMergesResponse{}
+	mi := &file_customer_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerMergesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerMergesResponse) ProtoMessage() {}
+
+func (x *ListCustomerMergesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerMergesResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomerMergesResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListCustomerMergesResponse) GetMerges() []*CustomerMerge {
+	if x != nil {
+		return x.Merges
+	}
+	return nil
+}
+
+// ListCustomerEventsRequest is the request for reading the customer event
+// feed after the last event the caller has seen
+type ListCustomerEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSequence int64                  `protobuf:"varint,1,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // At most 1000; 100 when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerEventsRequest) Reset() {
+	*x = ListCustomerEventsRequest{}
+	mi := &file_customer_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerEventsRequest) ProtoMessage() {}
+
+func (x *ListCustomerEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomerEventsRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListCustomerEventsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *ListCustomerEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListCustomerEventsResponse is the response for reading the customer event feed
+type ListCustomerEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*CustomerEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerEventsResponse) Reset() {
+	*x = ListCustomerEventsResponse{}
+	mi := &file_customer_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerEventsResponse) ProtoMessage() {}
+
+func (x *ListCustomerEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomerEventsResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListCustomerEventsResponse) GetEvents() []*CustomerEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// FindPotentialDuplicatesRequest is the request for finding customers that
+// may be duplicates, either of the details given or, when customer_id is
+// set, of that customer as it is on file
+type FindPotentialDuplicatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	Street1       string                 `protobuf:"bytes,7,opt,name=street1,proto3" json:"street1,omitempty"` // Of the primary address
+	PostalCode    string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
+	Limit         int32                  `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"` // At most 100; 10 when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPotentialDuplicatesRequest) Reset() {
+	*x = FindPotentialDuplicatesRequest{}
+	mi := &file_customer_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPotentialDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPotentialDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindPotentialDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPotentialDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindPotentialDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *FindPotentialDuplicatesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *FindPotentialDuplicatesRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetStreet1() string {
+	if x != nil {
+		return x.Street1
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// FindPotentialDuplicatesResponse is the response for finding potential
+// duplicates. Candidates scoring below the review threshold are left out.
+type FindPotentialDuplicatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*DuplicateCandidate  `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"` // Highest score first
+	RulesVersion  string                 `protobuf:"bytes,2,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	WouldBlock    bool                   `protobuf:"varint,3,opt,name=would_block,json=wouldBlock,proto3" json:"would_block,omitempty"` // Creating a customer with these details needs an override reason
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPotentialDuplicatesResponse) Reset() {
+	*x = FindPotentialDuplicatesResponse{}
+	mi := &file_customer_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPotentialDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPotentialDuplicatesResponse) ProtoMessage() {}
+
+func (x *FindPotentialDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPotentialDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*FindPotentialDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FindPotentialDuplicatesResponse) GetCandidates() []*DuplicateCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *FindPotentialDuplicatesResponse) GetRulesVersion() string {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesResponse) GetWouldBlock() bool {
+	if x != nil {
+		return x.WouldBlock
+	}
+	return false
+}
+
+// DuplicateCandidate is an existing customer scored against other details
+type DuplicateCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`  // 0 to 100
+	Fields        []*FieldSimilarity     `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"` // The fields that matched
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCandidate) Reset() {
+	*x = DuplicateCandidate{}
+	mi := &file_customer_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCandidate) ProtoMessage() {}
+
+func (x *DuplicateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCandidate.ProtoReflect.Descriptor instead.
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DuplicateCandidate) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DuplicateCandidate) GetFields() []*FieldSimilarity {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// FieldSimilarity is how alike two customers are in one field, from 0 to 1
+type FieldSimilarity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Similarity    float64                `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldSimilarity) Reset() {
+	*x = FieldSimilarity{}
+	mi := &file_customer_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldSimilarity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldSimilarity) ProtoMessage() {}
+
+func (x *FieldSimilarity) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldSimilarity.ProtoReflect.Descriptor instead.
+func (*FieldSimilarity) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *FieldSimilarity) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldSimilarity) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+// AddCustomerRelationshipRequest is the request for linking an individual
+// to a business
+type AddCustomerRelationshipRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	BusinessId          string                 `protobuf:"bytes,1,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	IndividualId        string                 `protobuf:"bytes,2,opt,name=individual_id,json=individualId,proto3" json:"individual_id,omitempty"`
+	Role                string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	OwnershipPercentage float64                `protobuf:"fixed64,4,opt,name=ownership_percentage,json=ownershipPercentage,proto3" json:"ownership_percentage,omitempty"` // Required for beneficial owners only
+	CreatedBy           string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddCustomerRelationshipRequest) Reset() {
+	*x = AddCustomerRelationshipRequest{}
+	mi := &file_customer_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCustomerRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCustomerRelationshipRequest) ProtoMessage() {}
+
+func (x *AddCustomerRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomerRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*AddCustomerRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AddCustomerRelationshipRequest) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *AddCustomerRelationshipRequest) GetIndividualId() string {
+	if x != nil {
+		return x.IndividualId
+	}
+	return ""
+}
+
+func (x *AddCustomerRelationshipRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddCustomerRelationshipRequest) GetOwnershipPercentage() float64 {
+	if x != nil {
+		return x.OwnershipPercentage
+	}
+	return 0
+}
+
+func (x *AddCustomerRelationshipRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// AddCustomerRelationshipResponse is the response for linking an
+// individual to a business
+type AddCustomerRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *CustomerRelationship  `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCustomerRelationshipResponse) Reset() {
+	*x = AddCustomerRelationshipResponse{}
+	mi := &file_customer_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCustomerRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCustomerRelationshipResponse) ProtoMessage() {}
+
+func (x *AddCustomerRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomerRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*AddCustomerRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AddCustomerRelationshipResponse) GetRelationship() *CustomerRelationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+// EndCustomerRelationshipRequest is the request for ending an individual's
+// role in a business
+type EndCustomerRelationshipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RelationshipId string                 `protobuf:"bytes,1,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	EndedBy        string                 `protobuf:"bytes,2,opt,name=ended_by,json=endedBy,proto3" json:"ended_by,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EndCustomerRelationshipRequest) Reset() {
+	*x = EndCustomerRelationshipRequest{}
+	mi := &file_customer_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndCustomerRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndCustomerRelationshipRequest) ProtoMessage() {}
+
+func (x *EndCustomerRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndCustomerRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*EndCustomerRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *EndCustomerRelationshipRequest) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *EndCustomerRelationshipRequest) GetEndedBy() string {
+	if x != nil {
+		return x.EndedBy
+	}
+	return ""
+}
+
+func (x *EndCustomerRelationshipRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EndCustomerRelationshipResponse is the response for ending an
+// individual's role in a business
+type EndCustomerRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *CustomerRelationship  `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndCustomerRelationshipResponse) Reset() {
+	*x = EndCustomerRelationshipResponse{}
+	mi := &file_customer_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndCustomerRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndCustomerRelationshipResponse) ProtoMessage() {}
+
+func (x *EndCustomerRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndCustomerRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*EndCustomerRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *EndCustomerRelationshipResponse) GetRelationship() *CustomerRelationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+// ListCustomerRelationshipsRequest is the request for listing a customer's
+// relationships
+type ListCustomerRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	IncludeEnded  bool                   `protobuf:"varint,2,opt,name=include_ended,json=includeEnded,proto3" json:"include_ended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerRelationshipsRequest) Reset() {
+	*x = ListCustomerRelationshipsRequest{}
+	mi := &file_customer_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerRelationshipsRequest) ProtoMessage() {}
+
+func (x *ListCustomerRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomerRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListCustomerRelationshipsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListCustomerRelationshipsRequest) GetIncludeEnded() bool {
+	if x != nil {
+		return x.IncludeEnded
+	}
+	return false
+}
+
+// ListCustomerRelationshipsResponse is the response for listing a
+// customer's relationships
+type ListCustomerRelationshipsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Relationships []*CustomerRelationship `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerRelationshipsResponse) Reset() {
+	*x = ListCustomerRelationshipsResponse{}
+	mi := &file_customer_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerRelationshipsResponse) ProtoMessage() {}
+
+func (x *ListCustomerRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomerRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListCustomerRelationshipsResponse) GetRelationships() []*CustomerRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+// GrantConsentRequest is the request for recording a consent
+type GrantConsentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Purpose       string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	PolicyVersion string                 `protobuf:"bytes,4,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"` // The version of the policy text agreed to
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,6,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	// When the customer agreed, if earlier than now, as on a paper form
+	GrantedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantConsentRequest) Reset() {
+	*x = GrantConsentRequest{}
+	mi := &file_customer_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantConsentRequest) ProtoMessage() {}
+
+func (x *GrantConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantConsentRequest.ProtoReflect.Descriptor instead.
+func (*GrantConsentRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GrantConsentRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GrantConsentRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *GrantConsentRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GrantConsentRequest) GetPolicyVersion() string {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return ""
+}
+
+func (x *GrantConsentRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GrantConsentRequest) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *GrantConsentRequest) GetGrantedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return nil
+}
+
+// GrantConsentResponse is the response for recording a consent
+type GrantConsentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consent       *Consent               `protobuf:"bytes,1,opt,name=consent,proto3" json:"consent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantConsentResponse) Reset() {
+	*x = GrantConsentResponse{}
+	mi := &file_customer_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantConsentResponse) ProtoMessage() {}
+
+func (x *GrantConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantConsentResponse.ProtoReflect.Descriptor instead.
+func (*GrantConsentResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GrantConsentResponse) GetConsent() *Consent {
+	if x != nil {
+		return x.Consent
+	}
+	return nil
+}
+
+// WithdrawConsentRequest is the request for withdrawing a consent
+type WithdrawConsentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsentId     string                 `protobuf:"bytes,1,opt,name=consent_id,json=consentId,proto3" json:"consent_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	WithdrawnBy   string                 `protobuf:"bytes,3,opt,name=withdrawn_by,json=withdrawnBy,proto3" json:"withdrawn_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawConsentRequest) Reset() {
+	*x = WithdrawConsentRequest{}
+	mi := &file_customer_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddDocumentRequest) String() string {
+func (x *WithdrawConsentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDocumentRequest) ProtoMessage() {}
+func (*WithdrawConsentRequest) ProtoMessage() {}
 
-func (x *AddDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[14]
+func (x *WithdrawConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,83 +7380,100 @@ func (x *AddDocumentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDocumentRequest.ProtoReflect.Descriptor instead.
-func (*AddDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use WithdrawConsentRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawConsentRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *AddDocumentRequest) GetCustomerId() string {
+func (x *WithdrawConsentRequest) GetConsentId() string {
 	if x != nil {
-		return x.CustomerId
+		return x.ConsentId
 	}
 	return ""
 }
 
-func (x *AddDocumentRequest) GetDocumentType() string {
+func (x *WithdrawConsentRequest) GetSource() string {
 	if x != nil {
-		return x.DocumentType
+		return x.Source
 	}
 	return ""
 }
 
-func (x *AddDocumentRequest) GetDocumentNumber() string {
+func (x *WithdrawConsentRequest) GetWithdrawnBy() string {
 	if x != nil {
-		return x.DocumentNumber
+		return x.WithdrawnBy
 	}
 	return ""
 }
 
-func (x *AddDocumentRequest) GetIssuingAuthority() string {
-	if x != nil {
-		return x.IssuingAuthority
-	}
-	return ""
+// WithdrawConsentResponse is the response for withdrawing a consent
+type WithdrawConsentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consent       *Consent               `protobuf:"bytes,1,opt,name=consent,proto3" json:"consent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddDocumentRequest) GetIssuingCountry() string {
-	if x != nil {
-		return x.IssuingCountry
-	}
-	return ""
+func (x *WithdrawConsentResponse) Reset() {
+	*x = WithdrawConsentResponse{}
+	mi := &file_customer_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddDocumentRequest) GetIssueDate() *timestamppb.Timestamp {
+func (x *WithdrawConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawConsentResponse) ProtoMessage() {}
+
+func (x *WithdrawConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[103]
 	if x != nil {
-		return x.IssueDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AddDocumentRequest) GetExpiryDate() *timestamppb.Timestamp {
+// Deprecated: Use WithdrawConsentResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawConsentResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *WithdrawConsentResponse) GetConsent() *Consent {
 	if x != nil {
-		return x.ExpiryDate
+		return x.Consent
 	}
 	return nil
 }
 
-// AddDocumentResponse is the response for adding a document
-type AddDocumentResponse struct {
+// ListConsentsRequest is the request for listing a customer's consents
+type ListConsentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddDocumentResponse) Reset() {
-	*x = AddDocumentResponse{}
-	mi := &file_customer_proto_msgTypes[15]
+func (x *ListConsentsRequest) Reset() {
+	*x = ListConsentsRequest{}
+	mi := &file_customer_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddDocumentResponse) String() string {
+func (x *ListConsentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDocumentResponse) ProtoMessage() {}
+func (*ListConsentsRequest) ProtoMessage() {}
 
-func (x *AddDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[15]
+func (x *ListConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,44 +7484,41 @@ func (x *AddDocumentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDocumentResponse.ProtoReflect.Descriptor instead.
-func (*AddDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListConsentsRequest.ProtoReflect.Descriptor instead.
+func (*ListConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *AddDocumentResponse) GetDocument() *Document {
+func (x *ListConsentsRequest) GetCustomerId() string {
 	if x != nil {
-		return x.Document
+		return x.CustomerId
 	}
-	return nil
+	return ""
 }
 
-// UpdateCustomerStatusRequest is the request for updating customer status
-type UpdateCustomerStatusRequest struct {
+// ListConsentsResponse is the response for listing a customer's consents
+type ListConsentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NewStatus     string                 `protobuf:"bytes,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	ChangedBy     string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Consents      []*Consent             `protobuf:"bytes,1,rep,name=consents,proto3" json:"consents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerStatusRequest) Reset() {
-	*x = UpdateCustomerStatusRequest{}
-	mi := &file_customer_proto_msgTypes[16]
+func (x *ListConsentsResponse) Reset() {
+	*x = ListConsentsResponse{}
+	mi := &file_customer_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerStatusRequest) String() string {
+func (x *ListConsentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerStatusRequest) ProtoMessage() {}
+func (*ListConsentsResponse) ProtoMessage() {}
 
-func (x *UpdateCustomerStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[16]
+func (x *ListConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,63 +7529,45 @@ func (x *UpdateCustomerStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerStatusRequest) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *UpdateCustomerStatusRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateCustomerStatusRequest) GetNewStatus() string {
-	if x != nil {
-		return x.NewStatus
-	}
-	return ""
-}
-
-func (x *UpdateCustomerStatusRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+// Deprecated: Use ListConsentsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *UpdateCustomerStatusRequest) GetChangedBy() string {
+func (x *ListConsentsResponse) GetConsents() []*Consent {
 	if x != nil {
-		return x.ChangedBy
+		return x.Consents
 	}
-	return ""
+	return nil
 }
 
-// UpdateCustomerStatusResponse is the response for updating customer status
-type UpdateCustomerStatusResponse struct {
+// CheckConsentRequest asks whether a customer consented to a purpose on a
+// channel, now or at as_of
+type CheckConsentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
-	StatusChange  *StatusChange          `protobuf:"bytes,2,opt,name=status_change,json=statusChange,proto3" json:"status_change,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Purpose       string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // Now when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerStatusResponse) Reset() {
-	*x = UpdateCustomerStatusResponse{}
-	mi := &file_customer_proto_msgTypes[17]
+func (x *CheckConsentRequest) Reset() {
+	*x = CheckConsentRequest{}
+	mi := &file_customer_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerStatusResponse) String() string {
+func (x *CheckConsentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerStatusResponse) ProtoMessage() {}
+func (*CheckConsentRequest) ProtoMessage() {}
 
-func (x *UpdateCustomerStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[17]
+func (x *CheckConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,51 +7578,64 @@ func (x *UpdateCustomerStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerStatusResponse) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CheckConsentRequest.ProtoReflect.Descriptor instead.
+func (*CheckConsentRequest) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *UpdateCustomerStatusResponse) GetCustomer() *Customer {
+func (x *CheckConsentRequest) GetCustomerId() string {
 	if x != nil {
-		return x.Customer
+		return x.CustomerId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateCustomerStatusResponse) GetStatusChange() *StatusChange {
+func (x *CheckConsentRequest) GetPurpose() string {
 	if x != nil {
-		return x.StatusChange
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *CheckConsentRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CheckConsentRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
 	}
 	return nil
 }
 
-// CustomerFullProfileResponse contains the complete customer profile
-type CustomerFullProfileResponse struct {
+// CheckConsentResponse is the answer to a consent check, with the consent
+// that gives it
+type CheckConsentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
-	Addresses     []*Address             `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Documents     []*Document            `protobuf:"bytes,3,rep,name=documents,proto3" json:"documents,omitempty"`
-	StatusHistory []*StatusChange        `protobuf:"bytes,4,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+	Granted       bool                   `protobuf:"varint,1,opt,name=granted,proto3" json:"granted,omitempty"`
+	Consent       *Consent               `protobuf:"bytes,2,opt,name=consent,proto3" json:"consent,omitempty"` // Set when granted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CustomerFullProfileResponse) Reset() {
-	*x = CustomerFullProfileResponse{}
-	mi := &file_customer_proto_msgTypes[18]
+func (x *CheckConsentResponse) Reset() {
+	*x = CheckConsentResponse{}
+	mi := &file_customer_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CustomerFullProfileResponse) String() string {
+func (x *CheckConsentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomerFullProfileResponse) ProtoMessage() {}
+func (*CheckConsentResponse) ProtoMessage() {}
 
-func (x *CustomerFullProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customer_proto_msgTypes[18]
+func (x *CheckConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customer_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,35 +7646,21 @@ func (x *CustomerFullProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomerFullProfileResponse.ProtoReflect.Descriptor instead.
-func (*CustomerFullProfileResponse) Descriptor() ([]byte, []int) {
-	return file_customer_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *CustomerFullProfileResponse) GetCustomer() *Customer {
-	if x != nil {
-		return x.Customer
-	}
-	return nil
-}
-
-func (x *CustomerFullProfileResponse) GetAddresses() []*Address {
-	if x != nil {
-		return x.Addresses
-	}
-	return nil
+// Deprecated: Use CheckConsentResponse.ProtoReflect.Descriptor instead.
+func (*CheckConsentResponse) Descriptor() ([]byte, []int) {
+	return file_customer_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *CustomerFullProfileResponse) GetDocuments() []*Document {
+func (x *CheckConsentResponse) GetGranted() bool {
 	if x != nil {
-		return x.Documents
+		return x.Granted
 	}
-	return nil
+	return false
 }
 
-func (x *CustomerFullProfileResponse) GetStatusHistory() []*StatusChange {
+func (x *CheckConsentResponse) GetConsent() *Consent {
 	if x != nil {
-		return x.StatusHistory
+		return x.Consent
 	}
 	return nil
 }
@@ -1617,7 +7669,7 @@ var File_customer_proto protoreflect.FileDescriptor
 
 const file_customer_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecustomer.proto\x12\vcustomer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x03\n" +
+	"\x0ecustomer.proto\x12\vcustomer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x05\n" +
 	"\bCustomer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0fcustomer_number\x18\x02 \x01(\tR\x0ecustomerNumber\x12\x1d\n" +
@@ -1639,7 +7691,20 @@ const file_customer_proto_rawDesc = "" +
 	"created_by\x18\f \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
 	"updated_by\x18\r \x01(\tR\tupdatedBy\x12\x18\n" +
-	"\aversion\x18\x0e \x01(\x05R\aversion\"\xfd\x03\n" +
+	"\aversion\x18\x0e \x01(\x05R\aversion\x12#\n" +
+	"\rcustomer_type\x18\x0f \x01(\tR\fcustomerType\x128\n" +
+	"\bbusiness\x18\x10 \x01(\v2\x1c.customer.v1.BusinessDetailsR\bbusiness\x12\x1f\n" +
+	"\vphone_input\x18\x11 \x01(\tR\n" +
+	"phoneInput\x12\x1d\n" +
+	"\n" +
+	"phone_type\x18\x12 \x01(\tR\tphoneType\"\x91\x02\n" +
+	"\x0fBusinessDetails\x12'\n" +
+	"\x0fregistered_name\x18\x01 \x01(\tR\x0eregisteredName\x12/\n" +
+	"\x13registration_number\x18\x02 \x01(\tR\x12registrationNumber\x12\"\n" +
+	"\fjurisdiction\x18\x03 \x01(\tR\fjurisdiction\x12\x10\n" +
+	"\x03lei\x18\x04 \x01(\tR\x03lei\x12I\n" +
+	"\x12incorporation_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11incorporationDate\x12#\n" +
+	"\rindustry_code\x18\x06 \x01(\tR\findustryCode\"\xa2\x04\n" +
 	"\aAddress\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\tR\n" +
@@ -1661,7 +7726,8 @@ const file_customer_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xdc\x04\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rsupersedes_id\x18\x0f \x01(\tR\fsupersedesId\"\xdc\x04\n" +
 	"\bDocument\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\tR\n" +
@@ -1683,7 +7749,142 @@ const file_customer_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf9\x01\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaa\x02\n" +
+	"\fDocumentFile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x12\x1f\n" +
+	"\vuploaded_by\x18\b \x01(\tR\n" +
+	"uploadedBy\x12;\n" +
+	"\vuploaded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"uploadedAt\"\xa0\x02\n" +
+	"\tLegalHold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tplaced_by\x18\x04 \x01(\tR\bplacedBy\x127\n" +
+	"\tplaced_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\x12\x1f\n" +
+	"\vreleased_by\x18\x06 \x01(\tR\n" +
+	"releasedBy\x12;\n" +
+	"\vreleased_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\"\xa5\x03\n" +
+	"\rErasureReport\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1a\n" +
+	"\beligible\x18\x02 \x01(\bR\beligible\x12\x1a\n" +
+	"\bblockers\x18\x03 \x03(\tR\bblockers\x127\n" +
+	"\tclosed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12=\n" +
+	"\fretain_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vretainUntil\x12\x16\n" +
+	"\x06fields\x18\x06 \x03(\tR\x06fields\x12\x1c\n" +
+	"\taddresses\x18\a \x01(\x05R\taddresses\x12\x1c\n" +
+	"\tdocuments\x18\b \x01(\x05R\tdocuments\x12%\n" +
+	"\x0edocument_files\x18\t \x01(\x05R\rdocumentFiles\x12%\n" +
+	"\x0escreening_hits\x18\n" +
+	" \x01(\x05R\rscreeningHits\x12!\n" +
+	"\fdata_exports\x18\v \x01(\x05R\vdataExports\"\xef\x01\n" +
+	"\x0eErasureRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\bblockers\x18\x06 \x03(\tR\bblockers\x12=\n" +
+	"\frequested_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xb0\x03\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\arecords\x18\x05 \x01(\x05R\arecords\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\tR\tsignature\x12\x1f\n" +
+	"\vsigning_key\x18\b \x01(\tR\n" +
+	"signingKey\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12=\n" +
+	"\frequested_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x121\n" +
+	"\x06due_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12=\n" +
+	"\fcompleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"V\n" +
+	"\x10MergeFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xf3\x04\n" +
+	"\rCustomerMerge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vsurvivor_id\x18\x02 \x01(\tR\n" +
+	"survivorId\x12\x1b\n" +
+	"\tmerged_id\x18\x03 \x01(\tR\bmergedId\x12#\n" +
+	"\rrules_version\x18\x04 \x01(\tR\frulesVersion\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12B\n" +
+	"\rfield_changes\x18\x06 \x03(\v2\x1d.customer.v1.MergeFieldChangeR\ffieldChanges\x12*\n" +
+	"\x11moved_address_ids\x18\a \x03(\tR\x0fmovedAddressIds\x12,\n" +
+	"\x12moved_document_ids\x18\b \x03(\tR\x10movedDocumentIds\x12#\n" +
+	"\rmerged_status\x18\t \x01(\tR\fmergedStatus\x12\x1b\n" +
+	"\tmerged_by\x18\n" +
+	" \x01(\tR\bmergedBy\x127\n" +
+	"\tmerged_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x129\n" +
+	"\n" +
+	"undo_until\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tundoUntil\x12\x1f\n" +
+	"\vunmerged_by\x18\r \x01(\tR\n" +
+	"unmergedBy\x12;\n" +
+	"\vunmerged_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unmergedAt\x12%\n" +
+	"\x0eunmerge_reason\x18\x0f \x01(\tR\runmergeReason\"\x80\x02\n" +
+	"\rCustomerEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1f\n" +
+	"\vcustomer_id\x18\x04 \x01(\tR\n" +
+	"customerId\x12.\n" +
+	"\x13related_customer_id\x18\x05 \x01(\tR\x11relatedCustomerId\x12!\n" +
+	"\freference_id\x18\x06 \x01(\tR\vreferenceId\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xd3\x03\n" +
+	"\aConsent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12%\n" +
+	"\x0epolicy_version\x18\x05 \x01(\tR\rpolicyVersion\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\a \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"granted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tgrantedAt\x12;\n" +
+	"\vrecorded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\x12!\n" +
+	"\fwithdrawn_by\x18\n" +
+	" \x01(\tR\vwithdrawnBy\x12=\n" +
+	"\fwithdrawn_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vwithdrawnAt\x12+\n" +
+	"\x11withdrawal_source\x18\f \x01(\tR\x10withdrawalSource\"\xfe\x02\n" +
+	"\x14CustomerRelationship\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vbusiness_id\x18\x02 \x01(\tR\n" +
+	"businessId\x12#\n" +
+	"\rindividual_id\x18\x03 \x01(\tR\findividualId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x121\n" +
+	"\x14ownership_percentage\x18\x05 \x01(\x01R\x13ownershipPercentage\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
+	"\bended_by\x18\b \x01(\tR\aendedBy\x125\n" +
+	"\bended_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12\x1d\n" +
+	"\n" +
+	"end_reason\x18\n" +
+	" \x01(\tR\tendReason\"\xf9\x01\n" +
 	"\fStatusChange\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\tR\n" +
@@ -1695,7 +7896,79 @@ const file_customer_proto_rawDesc = "" +
 	"\n" +
 	"changed_by\x18\x06 \x01(\tR\tchangedBy\x129\n" +
 	"\n" +
-	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\x96\x02\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\xe4\x04\n" +
+	"\fScreeningHit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fscreening_id\x18\x02 \x01(\tR\vscreeningId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\tlist_name\x18\x04 \x01(\tR\blistName\x12!\n" +
+	"\flist_version\x18\x05 \x01(\tR\vlistVersion\x12\x19\n" +
+	"\bentry_id\x18\x06 \x01(\tR\aentryId\x12\x1d\n" +
+	"\n" +
+	"entry_name\x18\a \x01(\tR\tentryName\x12!\n" +
+	"\fmatched_name\x18\b \x01(\tR\vmatchedName\x12\x18\n" +
+	"\asubject\x18\t \x01(\tR\asubject\x12\x14\n" +
+	"\x05score\x18\n" +
+	" \x01(\x01R\x05score\x12\x1d\n" +
+	"\n" +
+	"name_score\x18\v \x01(\x01R\tnameScore\x12\x1b\n" +
+	"\tdob_match\x18\f \x01(\tR\bdobMatch\x12#\n" +
+	"\rcountry_match\x18\r \x01(\bR\fcountryMatch\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\x0f \x01(\tR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12!\n" +
+	"\freview_notes\x18\x11 \x01(\tR\vreviewNotes\x129\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x89\x02\n" +
+	"\x11CustomerScreening\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12#\n" +
+	"\rlist_versions\x18\x05 \x03(\tR\flistVersions\x12;\n" +
+	"\vscreened_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"screenedAt\x12-\n" +
+	"\x04hits\x18\a \x03(\v2\x19.customer.v1.ScreeningHitR\x04hits\"Z\n" +
+	"\n" +
+	"RiskFactor\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\"\x89\x03\n" +
+	"\x16CustomerRiskAssessment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\tR\x06rating\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x121\n" +
+	"\afactors\x18\x05 \x03(\v2\x17.customer.v1.RiskFactorR\afactors\x12#\n" +
+	"\rmodel_version\x18\x06 \x01(\tR\fmodelVersion\x12\x18\n" +
+	"\atrigger\x18\a \x01(\tR\atrigger\x12\x1f\n" +
+	"\vassessed_by\x18\b \x01(\tR\n" +
+	"assessedBy\x12;\n" +
+	"\vassessed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assessedAt\x12@\n" +
+	"\x0enext_review_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fnextReviewAt\"\x92\x03\n" +
+	"\n" +
+	"ReviewTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12#\n" +
+	"\rassessment_id\x18\x03 \x01(\tR\fassessmentId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\tR\x06rating\x121\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
+	"\aoverdue\x18\a \x01(\bR\aoverdue\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12!\n" +
+	"\fcompleted_by\x18\n" +
+	" \x01(\tR\vcompletedBy\x12\x14\n" +
+	"\x05notes\x18\v \x01(\tR\x05notes\"\xb1\x03\n" +
 	"\x15CreateCustomerRequest\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1f\n" +
@@ -1707,13 +7980,23 @@ const file_customer_proto_rawDesc = "" +
 	"\x05email\x18\x06 \x01(\tR\x05email\x12\x14\n" +
 	"\x05phone\x18\a \x01(\tR\x05phone\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\b \x01(\tR\tcreatedBy\"K\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12:\n" +
+	"\x19duplicate_override_reason\x18\t \x01(\tR\x17duplicateOverrideReason\x12#\n" +
+	"\rcustomer_type\x18\n" +
+	" \x01(\tR\fcustomerType\x128\n" +
+	"\bbusiness\x18\v \x01(\v2\x1c.customer.v1.BusinessDetailsR\bbusiness\"\xab\x02\n" +
 	"\x16CreateCustomerResponse\x121\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\"$\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\x12<\n" +
+	"\tscreening\x18\x02 \x01(\v2\x1e.customer.v1.CustomerScreeningR\tscreening\x12L\n" +
+	"\x0frisk_assessment\x18\x03 \x01(\v2#.customer.v1.CustomerRiskAssessmentR\x0eriskAssessment\x12R\n" +
+	"\x14potential_duplicates\x18\x04 \x03(\v2\x1f.customer.v1.DuplicateCandidateR\x13potentialDuplicates\"M\n" +
 	"\x12GetCustomerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fcustomer_number\x18\x02 \x01(\tR\x0ecustomerNumber\"i\n" +
 	"\x13GetCustomerResponse\x121\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\"\xc0\x02\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\x12\x1f\n" +
+	"\vmerged_from\x18\x02 \x01(\tR\n" +
+	"mergedFrom\"\xfa\x02\n" +
 	"\x15UpdateCustomerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1728,9 +8011,11 @@ const file_customer_proto_rawDesc = "" +
 	"\n" +
 	"updated_by\x18\t \x01(\tR\tupdatedBy\x12\x18\n" +
 	"\aversion\x18\n" +
-	" \x01(\x05R\aversion\"K\n" +
+	" \x01(\x05R\aversion\x128\n" +
+	"\bbusiness\x18\v \x01(\v2\x1c.customer.v1.BusinessDetailsR\bbusiness\"\x89\x01\n" +
 	"\x16UpdateCustomerResponse\x121\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\"\xb4\x02\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\x12<\n" +
+	"\tscreening\x18\x02 \x01(\v2\x1e.customer.v1.CustomerScreeningR\tscreening\"\xd9\x02\n" +
 	"\x16SearchCustomersRequest\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
@@ -1741,7 +8026,9 @@ const file_customer_proto_rawDesc = "" +
 	"\tfrom_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
 	"\ato_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\x12\x14\n" +
 	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\t \x01(\x05R\x06offset\"d\n" +
+	"\x06offset\x18\t \x01(\x05R\x06offset\x12#\n" +
+	"\rphone_country\x18\n" +
+	" \x01(\tR\fphoneCountry\"d\n" +
 	"\x17SearchCustomersResponse\x123\n" +
 	"\tcustomers\x18\x01 \x03(\v2\x15.customer.v1.CustomerR\tcustomers\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"\x81\x03\n" +
@@ -1761,9 +8048,50 @@ const file_customer_proto_rawDesc = "" +
 	"\n" +
 	"valid_from\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
-	"\bvalid_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"D\n" +
+	"\bvalid_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"`\n" +
 	"\x12AddAddressResponse\x12.\n" +
-	"\aaddress\x18\x01 \x01(\v2\x14.customer.v1.AddressR\aaddress\"\xd1\x02\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.customer.v1.AddressR\aaddress\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xb4\x02\n" +
+	"\x14UpdateAddressRequest\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x01 \x01(\tR\taddressId\x12!\n" +
+	"\faddress_type\x18\x02 \x01(\tR\vaddressType\x12\x18\n" +
+	"\astreet1\x18\x03 \x01(\tR\astreet1\x12\x18\n" +
+	"\astreet2\x18\x04 \x01(\tR\astreet2\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\a \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\x12A\n" +
+	"\x0eeffective_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"\x95\x01\n" +
+	"\x15UpdateAddressResponse\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.customer.v1.AddressR\aaddress\x120\n" +
+	"\bprevious\x18\x02 \x01(\v2\x14.customer.v1.AddressR\bprevious\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"m\n" +
+	"\x15EndDateAddressRequest\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x01 \x01(\tR\taddressId\x125\n" +
+	"\bvalid_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"H\n" +
+	"\x16EndDateAddressResponse\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.customer.v1.AddressR\aaddress\"|\n" +
+	"\x18SetPrimaryAddressRequest\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x01 \x01(\tR\taddressId\x12A\n" +
+	"\x0eeffective_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"K\n" +
+	"\x19SetPrimaryAddressResponse\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.customer.v1.AddressR\aaddress\"`\n" +
+	"\x14ListAddressesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12'\n" +
+	"\x0finclude_history\x18\x02 \x01(\bR\x0eincludeHistory\"K\n" +
+	"\x15ListAddressesResponse\x122\n" +
+	"\taddresses\x18\x01 \x03(\v2\x14.customer.v1.AddressR\taddresses\"j\n" +
+	"\x17GetAddressesAsOfRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"N\n" +
+	"\x18GetAddressesAsOfResponse\x122\n" +
+	"\taddresses\x18\x01 \x03(\v2\x14.customer.v1.AddressR\taddresses\"\xd1\x02\n" +
 	"\x12AddDocumentRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12#\n" +
@@ -1786,22 +8114,308 @@ const file_customer_proto_rawDesc = "" +
 	"changed_by\x18\x04 \x01(\tR\tchangedBy\"\x91\x01\n" +
 	"\x1cUpdateCustomerStatusResponse\x121\n" +
 	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\x12>\n" +
-	"\rstatus_change\x18\x02 \x01(\v2\x19.customer.v1.StatusChangeR\fstatusChange\"\xfb\x01\n" +
+	"\rstatus_change\x18\x02 \x01(\v2\x19.customer.v1.StatusChangeR\fstatusChange\"\xe5\x02\n" +
 	"\x1bCustomerFullProfileResponse\x121\n" +
 	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\x122\n" +
 	"\taddresses\x18\x02 \x03(\v2\x14.customer.v1.AddressR\taddresses\x123\n" +
 	"\tdocuments\x18\x03 \x03(\v2\x15.customer.v1.DocumentR\tdocuments\x12@\n" +
-	"\x0estatus_history\x18\x04 \x03(\v2\x19.customer.v1.StatusChangeR\rstatusHistory2\xea\x05\n" +
+	"\x0estatus_history\x18\x04 \x03(\v2\x19.customer.v1.StatusChangeR\rstatusHistory\x12\x1f\n" +
+	"\vmerged_from\x18\x05 \x01(\tR\n" +
+	"mergedFrom\x12G\n" +
+	"\rrelationships\x18\x06 \x03(\v2!.customer.v1.CustomerRelationshipR\rrelationships\"8\n" +
+	"\x15ScreenCustomerRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"V\n" +
+	"\x16ScreenCustomerResponse\x12<\n" +
+	"\tscreening\x18\x01 \x01(\v2\x1e.customer.v1.CustomerScreeningR\tscreening\">\n" +
+	"\x1bGetCustomerScreeningRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"\\\n" +
+	"\x1cGetCustomerScreeningResponse\x12<\n" +
+	"\tscreening\x18\x01 \x01(\v2\x1e.customer.v1.CustomerScreeningR\tscreening\"i\n" +
+	"\x18ListScreeningHitsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n" +
+	"\x19ListScreeningHitsResponse\x12-\n" +
+	"\x04hits\x18\x01 \x03(\v2\x19.customer.v1.ScreeningHitR\x04hits\"\x85\x01\n" +
+	"\x19ReviewScreeningHitRequest\x12\x15\n" +
+	"\x06hit_id\x18\x01 \x01(\tR\x05hitId\x12\x1a\n" +
+	"\bdecision\x18\x02 \x01(\tR\bdecision\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x1f\n" +
+	"\vreviewed_by\x18\x04 \x01(\tR\n" +
+	"reviewedBy\"|\n" +
+	"\x1aReviewScreeningHitResponse\x12+\n" +
+	"\x03hit\x18\x01 \x01(\v2\x19.customer.v1.ScreeningHitR\x03hit\x121\n" +
+	"\bcustomer\x18\x02 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\"]\n" +
+	"\x19AssessCustomerRiskRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1f\n" +
+	"\vassessed_by\x18\x02 \x01(\tR\n" +
+	"assessedBy\"a\n" +
+	"\x1aAssessCustomerRiskResponse\x12C\n" +
+	"\n" +
+	"assessment\x18\x01 \x01(\v2#.customer.v1.CustomerRiskAssessmentR\n" +
+	"assessment\"V\n" +
+	"\x1dGetCustomerRiskHistoryRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"g\n" +
+	"\x1eGetCustomerRiskHistoryResponse\x12E\n" +
+	"\vassessments\x18\x01 \x03(\v2#.customer.v1.CustomerRiskAssessmentR\vassessments\"\x8a\x01\n" +
+	"\x16ListReviewTasksRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12!\n" +
+	"\foverdue_only\x18\x03 \x01(\bR\voverdueOnly\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"H\n" +
+	"\x17ListReviewTasksResponse\x12-\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x17.customer.v1.ReviewTaskR\x05tasks\"m\n" +
+	"\x19CompleteReviewTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12!\n" +
+	"\fcompleted_by\x18\x02 \x01(\tR\vcompletedBy\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"\xc1\x01\n" +
+	"\x1aCompleteReviewTaskResponse\x12+\n" +
+	"\x04task\x18\x01 \x01(\v2\x17.customer.v1.ReviewTaskR\x04task\x12C\n" +
+	"\n" +
+	"assessment\x18\x02 \x01(\v2#.customer.v1.CustomerRiskAssessmentR\n" +
+	"assessment\x121\n" +
+	"\bcustomer\x18\x03 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\"\x8b\x01\n" +
+	"\x12DocumentFileHeader\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1f\n" +
+	"\vuploaded_by\x18\x03 \x01(\tR\n" +
+	"uploadedBy\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\"v\n" +
+	"\x19UploadDocumentFileRequest\x129\n" +
+	"\x06header\x18\x01 \x01(\v2\x1f.customer.v1.DocumentFileHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"K\n" +
+	"\x1aUploadDocumentFileResponse\x12-\n" +
+	"\x04file\x18\x01 \x01(\v2\x19.customer.v1.DocumentFileR\x04file\"q\n" +
+	"\x1bDownloadDocumentFileRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"o\n" +
+	"\x1cDownloadDocumentFileResponse\x12/\n" +
+	"\x04file\x18\x01 \x01(\v2\x19.customer.v1.DocumentFileH\x00R\x04file\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\";\n" +
+	"\x18ListDocumentFilesRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\"L\n" +
+	"\x19ListDocumentFilesResponse\x12/\n" +
+	"\x05files\x18\x01 \x03(\v2\x19.customer.v1.DocumentFileR\x05files\"\x8b\x01\n" +
+	"\x14EraseCustomerRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\x82\x01\n" +
+	"\x15EraseCustomerResponse\x122\n" +
+	"\x06report\x18\x01 \x01(\v2\x1a.customer.v1.ErasureReportR\x06report\x125\n" +
+	"\arequest\x18\x02 \x01(\v2\x1b.customer.v1.ErasureRequestR\arequest\"m\n" +
+	"\x15PlaceLegalHoldRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\tplaced_by\x18\x02 \x01(\tR\bplacedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"D\n" +
+	"\x16PlaceLegalHoldResponse\x12*\n" +
+	"\x04hold\x18\x01 \x01(\v2\x16.customer.v1.LegalHoldR\x04hold\"S\n" +
+	"\x17ReleaseLegalHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x1f\n" +
+	"\vreleased_by\x18\x02 \x01(\tR\n" +
+	"releasedBy\"F\n" +
+	"\x18ReleaseLegalHoldResponse\x12*\n" +
+	"\x04hold\x18\x01 \x01(\v2\x16.customer.v1.LegalHoldR\x04hold\"8\n" +
+	"\x15ListLegalHoldsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"F\n" +
+	"\x16ListLegalHoldsResponse\x12,\n" +
+	"\x05holds\x18\x01 \x03(\v2\x16.customer.v1.LegalHoldR\x05holds\"_\n" +
+	"\x19ExportCustomerDataRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"M\n" +
+	"\x1aExportCustomerDataResponse\x12/\n" +
+	"\x06export\x18\x01 \x01(\v2\x17.customer.v1.DataExportR\x06export\"3\n" +
+	"\x14GetDataExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\"H\n" +
+	"\x15GetDataExportResponse\x12/\n" +
+	"\x06export\x18\x01 \x01(\v2\x17.customer.v1.DataExportR\x06export\"s\n" +
+	"\x19DownloadDataExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\"2\n" +
+	"\x1aDownloadDataExportResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xca\x01\n" +
+	"\x15MergeCustomersRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12!\n" +
+	"\fduplicate_id\x18\x02 \x01(\tR\vduplicateId\x12\x1f\n" +
+	"\vsurvivor_id\x18\x03 \x01(\tR\n" +
+	"survivorId\x12\x1b\n" +
+	"\tmerged_by\x18\x04 \x01(\tR\bmergedBy\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"}\n" +
+	"\x16MergeCustomersResponse\x120\n" +
+	"\x05merge\x18\x01 \x01(\v2\x1a.customer.v1.CustomerMergeR\x05merge\x121\n" +
+	"\bsurvivor\x18\x02 \x01(\v2\x15.customer.v1.CustomerR\bsurvivor\"m\n" +
+	"\x17UnmergeCustomersRequest\x12\x19\n" +
+	"\bmerge_id\x18\x01 \x01(\tR\amergeId\x12\x1f\n" +
+	"\vunmerged_by\x18\x02 \x01(\tR\n" +
+	"unmergedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xd3\x01\n" +
+	"\x18UnmergeCustomersResponse\x120\n" +
+	"\x05merge\x18\x01 \x01(\v2\x1a.customer.v1.CustomerMergeR\x05merge\x121\n" +
+	"\bsurvivor\x18\x02 \x01(\v2\x15.customer.v1.CustomerR\bsurvivor\x121\n" +
+	"\brestored\x18\x03 \x01(\v2\x15.customer.v1.CustomerR\brestored\x12\x1f\n" +
+	"\vkept_fields\x18\x04 \x03(\tR\n" +
+	"keptFields\"<\n" +
+	"\x19ListCustomerMergesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"P\n" +
+	"\x1aListCustomerMergesResponse\x122\n" +
+	"\x06merges\x18\x01 \x03(\v2\x1a.customer.v1.CustomerMergeR\x06merges\"X\n" +
+	"\x19ListCustomerEventsRequest\x12%\n" +
+	"\x0eafter_sequence\x18\x01 \x01(\x03R\rafterSequence\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"P\n" +
+	"\x1aListCustomerEventsResponse\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.customer.v1.CustomerEventR\x06events\"\xd4\x02\n" +
+	"\x1eFindPotentialDuplicatesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12>\n" +
+	"\rdate_of_birth\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdateOfBirth\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12\x18\n" +
+	"\astreet1\x18\a \x01(\tR\astreet1\x12\x1f\n" +
+	"\vpostal_code\x18\b \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\t \x01(\tR\acountry\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\"\xa8\x01\n" +
+	"\x1fFindPotentialDuplicatesResponse\x12?\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x1f.customer.v1.DuplicateCandidateR\n" +
+	"candidates\x12#\n" +
+	"\rrules_version\x18\x02 \x01(\tR\frulesVersion\x12\x1f\n" +
+	"\vwould_block\x18\x03 \x01(\bR\n" +
+	"wouldBlock\"\x93\x01\n" +
+	"\x12DuplicateCandidate\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.customer.v1.CustomerR\bcustomer\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x124\n" +
+	"\x06fields\x18\x03 \x03(\v2\x1c.customer.v1.FieldSimilarityR\x06fields\"G\n" +
+	"\x0fFieldSimilarity\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\"\xcc\x01\n" +
+	"\x1eAddCustomerRelationshipRequest\x12\x1f\n" +
+	"\vbusiness_id\x18\x01 \x01(\tR\n" +
+	"businessId\x12#\n" +
+	"\rindividual_id\x18\x02 \x01(\tR\findividualId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x121\n" +
+	"\x14ownership_percentage\x18\x04 \x01(\x01R\x13ownershipPercentage\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"h\n" +
+	"\x1fAddCustomerRelationshipResponse\x12E\n" +
+	"\frelationship\x18\x01 \x01(\v2!.customer.v1.CustomerRelationshipR\frelationship\"|\n" +
+	"\x1eEndCustomerRelationshipRequest\x12'\n" +
+	"\x0frelationship_id\x18\x01 \x01(\tR\x0erelationshipId\x12\x19\n" +
+	"\bended_by\x18\x02 \x01(\tR\aendedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"h\n" +
+	"\x1fEndCustomerRelationshipResponse\x12E\n" +
+	"\frelationship\x18\x01 \x01(\v2!.customer.v1.CustomerRelationshipR\frelationship\"h\n" +
+	" ListCustomerRelationshipsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12#\n" +
+	"\rinclude_ended\x18\x02 \x01(\bR\fincludeEnded\"l\n" +
+	"!ListCustomerRelationshipsResponse\x12G\n" +
+	"\rrelationships\x18\x01 \x03(\v2!.customer.v1.CustomerRelationshipR\rrelationships\"\x83\x02\n" +
+	"\x13GrantConsentRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12%\n" +
+	"\x0epolicy_version\x18\x04 \x01(\tR\rpolicyVersion\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x06 \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"granted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tgrantedAt\"F\n" +
+	"\x14GrantConsentResponse\x12.\n" +
+	"\aconsent\x18\x01 \x01(\v2\x14.customer.v1.ConsentR\aconsent\"r\n" +
+	"\x16WithdrawConsentRequest\x12\x1d\n" +
+	"\n" +
+	"consent_id\x18\x01 \x01(\tR\tconsentId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12!\n" +
+	"\fwithdrawn_by\x18\x03 \x01(\tR\vwithdrawnBy\"I\n" +
+	"\x17WithdrawConsentResponse\x12.\n" +
+	"\aconsent\x18\x01 \x01(\v2\x14.customer.v1.ConsentR\aconsent\"6\n" +
+	"\x13ListConsentsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"H\n" +
+	"\x14ListConsentsResponse\x120\n" +
+	"\bconsents\x18\x01 \x03(\v2\x14.customer.v1.ConsentR\bconsents\"\x9b\x01\n" +
+	"\x13CheckConsentRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12/\n" +
+	"\x05as_of\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"`\n" +
+	"\x14CheckConsentResponse\x12\x18\n" +
+	"\agranted\x18\x01 \x01(\bR\agranted\x12.\n" +
+	"\aconsent\x18\x02 \x01(\v2\x14.customer.v1.ConsentR\aconsent2\x84!\n" +
 	"\x0fCustomerService\x12Y\n" +
 	"\x0eCreateCustomer\x12\".customer.v1.CreateCustomerRequest\x1a#.customer.v1.CreateCustomerResponse\x12P\n" +
 	"\vGetCustomer\x12\x1f.customer.v1.GetCustomerRequest\x1a .customer.v1.GetCustomerResponse\x12Y\n" +
 	"\x0eUpdateCustomer\x12\".customer.v1.UpdateCustomerRequest\x1a#.customer.v1.UpdateCustomerResponse\x12\\\n" +
 	"\x0fSearchCustomers\x12#.customer.v1.SearchCustomersRequest\x1a$.customer.v1.SearchCustomersResponse\x12M\n" +
 	"\n" +
-	"AddAddress\x12\x1e.customer.v1.AddAddressRequest\x1a\x1f.customer.v1.AddAddressResponse\x12P\n" +
+	"AddAddress\x12\x1e.customer.v1.AddAddressRequest\x1a\x1f.customer.v1.AddAddressResponse\x12V\n" +
+	"\rUpdateAddress\x12!.customer.v1.UpdateAddressRequest\x1a\".customer.v1.UpdateAddressResponse\x12Y\n" +
+	"\x0eEndDateAddress\x12\".customer.v1.EndDateAddressRequest\x1a#.customer.v1.EndDateAddressResponse\x12b\n" +
+	"\x11SetPrimaryAddress\x12%.customer.v1.SetPrimaryAddressRequest\x1a&.customer.v1.SetPrimaryAddressResponse\x12V\n" +
+	"\rListAddresses\x12!.customer.v1.ListAddressesRequest\x1a\".customer.v1.ListAddressesResponse\x12_\n" +
+	"\x10GetAddressesAsOf\x12$.customer.v1.GetAddressesAsOfRequest\x1a%.customer.v1.GetAddressesAsOfResponse\x12P\n" +
 	"\vAddDocument\x12\x1f.customer.v1.AddDocumentRequest\x1a .customer.v1.AddDocumentResponse\x12k\n" +
 	"\x14UpdateCustomerStatus\x12(.customer.v1.UpdateCustomerStatusRequest\x1a).customer.v1.UpdateCustomerStatusResponse\x12c\n" +
-	"\x16GetCustomerFullProfile\x12\x1f.customer.v1.GetCustomerRequest\x1a(.customer.v1.CustomerFullProfileResponseBMZKgithub.com/core-banking/services/customer-service/internal/proto/customerpbb\x06proto3"
+	"\x16GetCustomerFullProfile\x12\x1f.customer.v1.GetCustomerRequest\x1a(.customer.v1.CustomerFullProfileResponse\x12Y\n" +
+	"\x0eScreenCustomer\x12\".customer.v1.ScreenCustomerRequest\x1a#.customer.v1.ScreenCustomerResponse\x12k\n" +
+	"\x14GetCustomerScreening\x12(.customer.v1.GetCustomerScreeningRequest\x1a).customer.v1.GetCustomerScreeningResponse\x12b\n" +
+	"\x11ListScreeningHits\x12%.customer.v1.ListScreeningHitsRequest\x1a&.customer.v1.ListScreeningHitsResponse\x12e\n" +
+	"\x12ReviewScreeningHit\x12&.customer.v1.ReviewScreeningHitRequest\x1a'.customer.v1.ReviewScreeningHitResponse\x12e\n" +
+	"\x12AssessCustomerRisk\x12&.customer.v1.AssessCustomerRiskRequest\x1a'.customer.v1.AssessCustomerRiskResponse\x12q\n" +
+	"\x16GetCustomerRiskHistory\x12*.customer.v1.GetCustomerRiskHistoryRequest\x1a+.customer.v1.GetCustomerRiskHistoryResponse\x12\\\n" +
+	"\x0fListReviewTasks\x12#.customer.v1.ListReviewTasksRequest\x1a$.customer.v1.ListReviewTasksResponse\x12e\n" +
+	"\x12CompleteReviewTask\x12&.customer.v1.CompleteReviewTaskRequest\x1a'.customer.v1.CompleteReviewTaskResponse\x12g\n" +
+	"\x12UploadDocumentFile\x12&.customer.v1.UploadDocumentFileRequest\x1a'.customer.v1.UploadDocumentFileResponse(\x01\x12m\n" +
+	"\x14DownloadDocumentFile\x12(.customer.v1.DownloadDocumentFileRequest\x1a).customer.v1.DownloadDocumentFileResponse0\x01\x12b\n" +
+	"\x11ListDocumentFiles\x12%.customer.v1.ListDocumentFilesRequest\x1a&.customer.v1.ListDocumentFilesResponse\x12V\n" +
+	"\rEraseCustomer\x12!.customer.v1.EraseCustomerRequest\x1a\".customer.v1.EraseCustomerResponse\x12Y\n" +
+	"\x0ePlaceLegalHold\x12\".customer.v1.PlaceLegalHoldRequest\x1a#.customer.v1.PlaceLegalHoldResponse\x12_\n" +
+	"\x10ReleaseLegalHold\x12$.customer.v1.ReleaseLegalHoldRequest\x1a%.customer.v1.ReleaseLegalHoldResponse\x12Y\n" +
+	"\x0eListLegalHolds\x12\".customer.v1.ListLegalHoldsRequest\x1a#.customer.v1.ListLegalHoldsResponse\x12e\n" +
+	"\x12ExportCustomerData\x12&.customer.v1.ExportCustomerDataRequest\x1a'.customer.v1.ExportCustomerDataResponse\x12V\n" +
+	"\rGetDataExport\x12!.customer.v1.GetDataExportRequest\x1a\".customer.v1.GetDataExportResponse\x12g\n" +
+	"\x12DownloadDataExport\x12&.customer.v1.DownloadDataExportRequest\x1a'.customer.v1.DownloadDataExportResponse0\x01\x12Y\n" +
+	"\x0eMergeCustomers\x12\".customer.v1.MergeCustomersRequest\x1a#.customer.v1.MergeCustomersResponse\x12_\n" +
+	"\x10UnmergeCustomers\x12$.customer.v1.UnmergeCustomersRequest\x1a%.customer.v1.UnmergeCustomersResponse\x12e\n" +
+	"\x12ListCustomerMerges\x12&.customer.v1.ListCustomerMergesRequest\x1a'.customer.v1.ListCustomerMergesResponse\x12e\n" +
+	"\x12ListCustomerEvents\x12&.customer.v1.ListCustomerEventsRequest\x1a'.customer.v1.ListCustomerEventsResponse\x12t\n" +
+	"\x17FindPotentialDuplicates\x12+.customer.v1.FindPotentialDuplicatesRequest\x1a,.customer.v1.FindPotentialDuplicatesResponse\x12t\n" +
+	"\x17AddCustomerRelationship\x12+.customer.v1.AddCustomerRelationshipRequest\x1a,.customer.v1.AddCustomerRelationshipResponse\x12t\n" +
+	"\x17EndCustomerRelationship\x12+.customer.v1.EndCustomerRelationshipRequest\x1a,.customer.v1.EndCustomerRelationshipResponse\x12z\n" +
+	"\x19ListCustomerRelationships\x12-.customer.v1.ListCustomerRelationshipsRequest\x1a..customer.v1.ListCustomerRelationshipsResponse\x12S\n" +
+	"\fGrantConsent\x12 .customer.v1.GrantConsentRequest\x1a!.customer.v1.GrantConsentResponse\x12\\\n" +
+	"\x0fWithdrawConsent\x12#.customer.v1.WithdrawConsentRequest\x1a$.customer.v1.WithdrawConsentResponse\x12S\n" +
+	"\fListConsents\x12 .customer.v1.ListConsentsRequest\x1a!.customer.v1.ListConsentsResponse\x12S\n" +
+	"\fCheckConsent\x12 .customer.v1.CheckConsentRequest\x1a!.customer.v1.CheckConsentResponseBMZKgithub.com/core-banking/services/customer-service/internal/proto/customerpbb\x06proto3"
 
 var (
 	file_customer_proto_rawDescOnce sync.Once
@@ -1815,84 +8429,333 @@ func file_customer_proto_rawDescGZIP() []byte {
 	return file_customer_proto_rawDescData
 }
 
-var file_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 108)
 var file_customer_proto_goTypes = []any{
-	(*Customer)(nil),                     // 0: customer.v1.Customer
-	(*Address)(nil),                      // 1: customer.v1.Address
-	(*Document)(nil),                     // 2: customer.v1.Document
-	(*StatusChange)(nil),                 // 3: customer.v1.StatusChange
-	(*CreateCustomerRequest)(nil),        // 4: customer.v1.CreateCustomerRequest
-	(*CreateCustomerResponse)(nil),       // 5: customer.v1.CreateCustomerResponse
-	(*GetCustomerRequest)(nil),           // 6: customer.v1.GetCustomerRequest
-	(*GetCustomerResponse)(nil),          // 7: customer.v1.GetCustomerResponse
-	(*UpdateCustomerRequest)(nil),        // 8: customer.v1.UpdateCustomerRequest
-	(*UpdateCustomerResponse)(nil),       // 9: customer.v1.UpdateCustomerResponse
-	(*SearchCustomersRequest)(nil),       // 10: customer.v1.SearchCustomersRequest
-	(*SearchCustomersResponse)(nil),      // 11: customer.v1.SearchCustomersResponse
-	(*AddAddressRequest)(nil),            // 12: customer.v1.AddAddressRequest
-	(*AddAddressResponse)(nil),           // 13: customer.v1.AddAddressResponse
-	(*AddDocumentRequest)(nil),           // 14: customer.v1.AddDocumentRequest
-	(*AddDocumentResponse)(nil),          // 15: customer.v1.AddDocumentResponse
-	(*UpdateCustomerStatusRequest)(nil),  // 16: customer.v1.UpdateCustomerStatusRequest
-	(*UpdateCustomerStatusResponse)(nil), // 17: customer.v1.UpdateCustomerStatusResponse
-	(*CustomerFullProfileResponse)(nil),  // 18: customer.v1.CustomerFullProfileResponse
-	(*timestamppb.Timestamp)(nil),        // 19: google.protobuf.Timestamp
+	(*Customer)(nil),                          // 0: customer.v1.Customer
+	(*BusinessDetails)(nil),                   // 1: customer.v1.BusinessDetails
+	(*Address)(nil),                           // 2: customer.v1.Address
+	(*Document)(nil),                          // 3: customer.v1.Document
+	(*DocumentFile)(nil),                      // 4: customer.v1.DocumentFile
+	(*LegalHold)(nil),                         // 5: customer.v1.LegalHold
+	(*ErasureReport)(nil),                     // 6: customer.v1.ErasureReport
+	(*ErasureRequest)(nil),                    // 7: customer.v1.ErasureRequest
+	(*DataExport)(nil),                        // 8: customer.v1.DataExport
+	(*MergeFieldChange)(nil),                  // 9: customer.v1.MergeFieldChange
+	(*CustomerMerge)(nil),                     // 10: customer.v1.CustomerMerge
+	(*CustomerEvent)(nil),                     // 11: customer.v1.CustomerEvent
+	(*Consent)(nil),                           // 12: customer.v1.Consent
+	(*CustomerRelationship)(nil),              // 13: customer.v1.CustomerRelationship
+	(*StatusChange)(nil),                      // 14: customer.v1.StatusChange
+	(*ScreeningHit)(nil),                      // 15: customer.v1.ScreeningHit
+	(*CustomerScreening)(nil),                 // 16: customer.v1.CustomerScreening
+	(*RiskFactor)(nil),                        // 17: customer.v1.RiskFactor
+	(*CustomerRiskAssessment)(nil),            // 18: customer.v1.CustomerRiskAssessment
+	(*ReviewTask)(nil),                        // 19: customer.v1.ReviewTask
+	(*CreateCustomerRequest)(nil),             // 20: customer.v1.CreateCustomerRequest
+	(*CreateCustomerResponse)(nil),            // 21: customer.v1.CreateCustomerResponse
+	(*GetCustomerRequest)(nil),                // 22: customer.v1.GetCustomerRequest
+	(*GetCustomerResponse)(nil),               // 23: customer.v1.GetCustomerResponse
+	(*UpdateCustomerRequest)(nil),             // 24: customer.v1.UpdateCustomerRequest
+	(*UpdateCustomerResponse)(nil),            // 25: customer.v1.UpdateCustomerResponse
+	(*SearchCustomersRequest)(nil),            // 26: customer.v1.SearchCustomersRequest
+	(*SearchCustomersResponse)(nil),           // 27: customer.v1.SearchCustomersResponse
+	(*AddAddressRequest)(nil),                 // 28: customer.v1.AddAddressRequest
+	(*AddAddressResponse)(nil),                // 29: customer.v1.AddAddressResponse
+	(*UpdateAddressRequest)(nil),              // 30: customer.v1.UpdateAddressRequest
+	(*UpdateAddressResponse)(nil),             // 31: customer.v1.UpdateAddressResponse
+	(*EndDateAddressRequest)(nil),             // 32: customer.v1.EndDateAddressRequest
+	(*EndDateAddressResponse)(nil),            // 33: customer.v1.EndDateAddressResponse
+	(*SetPrimaryAddressRequest)(nil),          // 34: customer.v1.SetPrimaryAddressRequest
+	(*SetPrimaryAddressResponse)(nil),         // 35: customer.v1.SetPrimaryAddressResponse
+	(*ListAddressesRequest)(nil),              // 36: customer.v1.ListAddressesRequest
+	(*ListAddressesResponse)(nil),             // 37: customer.v1.ListAddressesResponse
+	(*GetAddressesAsOfRequest)(nil),           // 38: customer.v1.GetAddressesAsOfRequest
+	(*GetAddressesAsOfResponse)(nil),          // 39: customer.v1.GetAddressesAsOfResponse
+	(*AddDocumentRequest)(nil),                // 40: customer.v1.AddDocumentRequest
+	(*AddDocumentResponse)(nil),               // 41: customer.v1.AddDocumentResponse
+	(*UpdateCustomerStatusRequest)(nil),       // 42: customer.v1.UpdateCustomerStatusRequest
+	(*UpdateCustomerStatusResponse)(nil),      // 43: customer.v1.UpdateCustomerStatusResponse
+	(*CustomerFullProfileResponse)(nil),       // 44: customer.v1.CustomerFullProfileResponse
+	(*ScreenCustomerRequest)(nil),             // 45: customer.v1.ScreenCustomerRequest
+	(*ScreenCustomerResponse)(nil),            // 46: customer.v1.ScreenCustomerResponse
+	(*GetCustomerScreeningRequest)(nil),       // 47: customer.v1.GetCustomerScreeningRequest
+	(*GetCustomerScreeningResponse)(nil),      // 48: customer.v1.GetCustomerScreeningResponse
+	(*ListScreeningHitsRequest)(nil),          // 49: customer.v1.ListScreeningHitsRequest
+	(*ListScreeningHitsResponse)(nil),         // 50: customer.v1.ListScreeningHitsResponse
+	(*ReviewScreeningHitRequest)(nil),         // 51: customer.v1.ReviewScreeningHitRequest
+	(*ReviewScreeningHitResponse)(nil),        // 52: customer.v1.ReviewScreeningHitResponse
+	(*AssessCustomerRiskRequest)(nil),         // 53: customer.v1.AssessCustomerRiskRequest
+	(*AssessCustomerRiskResponse)(nil),        // 54: customer.v1.AssessCustomerRiskResponse
+	(*GetCustomerRiskHistoryRequest)(nil),     // 55: customer.v1.GetCustomerRiskHistoryRequest
+	(*GetCustomerRiskHistoryResponse)(nil),    // 56: customer.v1.GetCustomerRiskHistoryResponse
+	(*ListReviewTasksRequest)(nil),            // 57: customer.v1.ListReviewTasksRequest
+	(*ListReviewTasksResponse)(nil),           // 58: customer.v1.ListReviewTasksResponse
+	(*CompleteReviewTaskRequest)(nil),         // 59: customer.v1.CompleteReviewTaskRequest
+	(*CompleteReviewTaskResponse)(nil),        // 60: customer.v1.CompleteReviewTaskResponse
+	(*DocumentFileHeader)(nil),                // 61: customer.v1.DocumentFileHeader
+	(*UploadDocumentFileRequest)(nil),         // 62: customer.v1.UploadDocumentFileRequest
+	(*UploadDocumentFileResponse)(nil),        // 63: customer.v1.UploadDocumentFileResponse
+	(*DownloadDocumentFileRequest)(nil),       // 64: customer.v1.DownloadDocumentFileRequest
+	(*DownloadDocumentFileResponse)(nil),      // 65: customer.v1.DownloadDocumentFileResponse
+	(*ListDocumentFilesRequest)(nil),          // 66: customer.v1.ListDocumentFilesRequest
+	(*ListDocumentFilesResponse)(nil),         // 67: customer.v1.ListDocumentFilesResponse
+	(*EraseCustomerRequest)(nil),              // 68: customer.v1.EraseCustomerRequest
+	(*EraseCustomerResponse)(nil),             // 69: customer.v1.EraseCustomerResponse
+	(*PlaceLegalHoldRequest)(nil),             // 70: customer.v1.PlaceLegalHoldRequest
+	(*PlaceLegalHoldResponse)(nil),            // 71: customer.v1.PlaceLegalHoldResponse
+	(*ReleaseLegalHoldRequest)(nil),           // 72: customer.v1.ReleaseLegalHoldRequest
+	(*ReleaseLegalHoldResponse)(nil),          // 73: customer.v1.ReleaseLegalHoldResponse
+	(*ListLegalHoldsRequest)(nil),             // 74: customer.v1.ListLegalHoldsRequest
+	(*ListLegalHoldsResponse)(nil),            // 75: customer.v1.ListLegalHoldsResponse
+	(*ExportCustomerDataRequest)(nil),         // 76: customer.v1.ExportCustomerDataRequest
+	(*ExportCustomerDataResponse)(nil),        // 77: customer.v1.ExportCustomerDataResponse
+	(*GetDataExportRequest)(nil),              // 78: customer.v1.GetDataExportRequest
+	(*GetDataExportResponse)(nil),             // 79: customer.v1.GetDataExportResponse
+	(*DownloadDataExportRequest)(nil),         // 80: customer.v1.DownloadDataExportRequest
+	(*DownloadDataExportResponse)(nil),        // 81: customer.v1.DownloadDataExportResponse
+	(*MergeCustomersRequest)(nil),             // 82: customer.v1.MergeCustomersRequest
+	(*MergeCustomersResponse)(nil),            // 83: customer.v1.MergeCustomersResponse
+	(*UnmergeCustomersRequest)(nil),           // 84: customer.v1.UnmergeCustomersRequest
+	(*UnmergeCustomersResponse)(nil),          // 85: customer.v1.UnmergeCustomersResponse
+	(*ListCustomerMergesRequest)(nil),         // 86: customer.v1.ListCustomerMergesRequest
+	(*ListCustomerMergesResponse)(nil),        // 87: customer.v1.ListCustomerMergesResponse
+	(*ListCustomerEventsRequest)(nil),         // 88: customer.v1.ListCustomerEventsRequest
+	(*ListCustomerEventsResponse)(nil),        // 89: customer.v1.ListCustomerEventsResponse
+	(*FindPotentialDuplicatesRequest)(nil),    // 90: customer.v1.FindPotentialDuplicatesRequest
+	(*FindPotentialDuplicatesResponse)(nil),   // 91: customer.v1.FindPotentialDuplicatesResponse
+	(*DuplicateCandidate)(nil),                // 92: customer.v1.DuplicateCandidate
+	(*FieldSimilarity)(nil),                   // 93: customer.v1.FieldSimilarity
+	(*AddCustomerRelationshipRequest)(nil),    // 94: customer.v1.AddCustomerRelationshipRequest
+	(*AddCustomerRelationshipResponse)(nil),   // 95: customer.v1.AddCustomerRelationshipResponse
+	(*EndCustomerRelationshipRequest)(nil),    // 96: customer.v1.EndCustomerRelationshipRequest
+	(*EndCustomerRelationshipResponse)(nil),   // 97: customer.v1.EndCustomerRelationshipResponse
+	(*ListCustomerRelationshipsRequest)(nil),  // 98: customer.v1.ListCustomerRelationshipsRequest
+	(*ListCustomerRelationshipsResponse)(nil), // 99: customer.v1.ListCustomerRelationshipsResponse
+	(*GrantConsentRequest)(nil),               // 100: customer.v1.GrantConsentRequest
+	(*GrantConsentResponse)(nil),              // 101: customer.v1.GrantConsentResponse
+	(*WithdrawConsentRequest)(nil),            // 102: customer.v1.WithdrawConsentRequest
+	(*WithdrawConsentResponse)(nil),           // 103: customer.v1.WithdrawConsentResponse
+	(*ListConsentsRequest)(nil),               // 104: customer.v1.ListConsentsRequest
+	(*ListConsentsResponse)(nil),              // 105: customer.v1.ListConsentsResponse
+	(*CheckConsentRequest)(nil),               // 106: customer.v1.CheckConsentRequest
+	(*CheckConsentResponse)(nil),              // 107: customer.v1.CheckConsentResponse
+	(*timestamppb.Timestamp)(nil),             // 108: google.protobuf.Timestamp
 }
 var file_customer_proto_depIdxs = []int32{
-	19, // 0: customer.v1.Customer.date_of_birth:type_name -> google.protobuf.Timestamp
-	19, // 1: customer.v1.Customer.created_at:type_name -> google.protobuf.Timestamp
-	19, // 2: customer.v1.Customer.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 3: customer.v1.Address.valid_from:type_name -> google.protobuf.Timestamp
-	19, // 4: customer.v1.Address.valid_to:type_name -> google.protobuf.Timestamp
-	19, // 5: customer.v1.Address.created_at:type_name -> google.protobuf.Timestamp
-	19, // 6: customer.v1.Address.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 7: customer.v1.Document.issue_date:type_name -> google.protobuf.Timestamp
-	19, // 8: customer.v1.Document.expiry_date:type_name -> google.protobuf.Timestamp
-	19, // 9: customer.v1.Document.verified_at:type_name -> google.protobuf.Timestamp
-	19, // 10: customer.v1.Document.created_at:type_name -> google.protobuf.Timestamp
-	19, // 11: customer.v1.Document.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 12: customer.v1.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
-	19, // 13: customer.v1.CreateCustomerRequest.date_of_birth:type_name -> google.protobuf.Timestamp
-	0,  // 14: customer.v1.CreateCustomerResponse.customer:type_name -> customer.v1.Customer
-	0,  // 15: customer.v1.GetCustomerResponse.customer:type_name -> customer.v1.Customer
-	19, // 16: customer.v1.UpdateCustomerRequest.date_of_birth:type_name -> google.protobuf.Timestamp
-	0,  // 17: customer.v1.UpdateCustomerResponse.customer:type_name -> customer.v1.Customer
-	19, // 18: customer.v1.SearchCustomersRequest.from_date:type_name -> google.protobuf.Timestamp
-	19, // 19: customer.v1.SearchCustomersRequest.to_date:type_name -> google.protobuf.Timestamp
-	0,  // 20: customer.v1.SearchCustomersResponse.customers:type_name -> customer.v1.Customer
-	19, // 21: customer.v1.AddAddressRequest.valid_from:type_name -> google.protobuf.Timestamp
-	19, // 22: customer.v1.AddAddressRequest.valid_to:type_name -> google.protobuf.Timestamp
-	1,  // 23: customer.v1.AddAddressResponse.address:type_name -> customer.v1.Address
-	19, // 24: customer.v1.AddDocumentRequest.issue_date:type_name -> google.protobuf.Timestamp
-	19, // 25: customer.v1.AddDocumentRequest.expiry_date:type_name -> google.protobuf.Timestamp
-	2,  // 26: customer.v1.AddDocumentResponse.document:type_name -> customer.v1.Document
-	0,  // 27: customer.v1.UpdateCustomerStatusResponse.customer:type_name -> customer.v1.Customer
-	3,  // 28: customer.v1.UpdateCustomerStatusResponse.status_change:type_name -> customer.v1.StatusChange
-	0,  // 29: customer.v1.CustomerFullProfileResponse.customer:type_name -> customer.v1.Customer
-	1,  // 30: customer.v1.CustomerFullProfileResponse.addresses:type_name -> customer.v1.Address
-	2,  // 31: customer.v1.CustomerFullProfileResponse.documents:type_name -> customer.v1.Document
-	3,  // 32: customer.v1.CustomerFullProfileResponse.status_history:type_name -> customer.v1.StatusChange
-	4,  // 33: customer.v1.CustomerService.CreateCustomer:input_type -> customer.v1.CreateCustomerRequest
-	6,  // 34: customer.v1.CustomerService.GetCustomer:input_type -> customer.v1.GetCustomerRequest
-	8,  // 35: customer.v1.CustomerService.UpdateCustomer:input_type -> customer.v1.UpdateCustomerRequest
-	10, // 36: customer.v1.CustomerService.SearchCustomers:input_type -> customer.v1.SearchCustomersRequest
-	12, // 37: customer.v1.CustomerService.AddAddress:input_type -> customer.v1.AddAddressRequest
-	14, // 38: customer.v1.CustomerService.AddDocument:input_type -> customer.v1.AddDocumentRequest
-	16, // 39: customer.v1.CustomerService.UpdateCustomerStatus:input_type -> customer.v1.UpdateCustomerStatusRequest
-	6,  // 40: customer.v1.CustomerService.GetCustomerFullProfile:input_type -> customer.v1.GetCustomerRequest
-	5,  // 41: customer.v1.CustomerService.CreateCustomer:output_type -> customer.v1.CreateCustomerResponse
-	7,  // 42: customer.v1.CustomerService.GetCustomer:output_type -> customer.v1.GetCustomerResponse
-	9,  // 43: customer.v1.CustomerService.UpdateCustomer:output_type -> customer.v1.UpdateCustomerResponse
-	11, // 44: customer.v1.CustomerService.SearchCustomers:output_type -> customer.v1.SearchCustomersResponse
-	13, // 45: customer.v1.CustomerService.AddAddress:output_type -> customer.v1.AddAddressResponse
-	15, // 46: customer.v1.CustomerService.AddDocument:output_type -> customer.v1.AddDocumentResponse
-	17, // 47: customer.v1.CustomerService.UpdateCustomerStatus:output_type -> customer.v1.UpdateCustomerStatusResponse
-	18, // 48: customer.v1.CustomerService.GetCustomerFullProfile:output_type -> customer.v1.CustomerFullProfileResponse
-	41, // [41:49] is the sub-list for method output_type
-	33, // [33:41] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	108, // 0: customer.v1.Customer.date_of_birth:type_name -> google.protobuf.Timestamp
+	108, // 1: customer.v1.Customer.created_at:type_name -> google.protobuf.Timestamp
+	108, // 2: customer.v1.Customer.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 3: customer.v1.Customer.business:type_name -> customer.v1.BusinessDetails
+	108, // 4: customer.v1.BusinessDetails.incorporation_date:type_name -> google.protobuf.Timestamp
+	108, // 5: customer.v1.Address.valid_from:type_name -> google.protobuf.Timestamp
+	108, // 6: customer.v1.Address.valid_to:type_name -> google.protobuf.Timestamp
+	108, // 7: customer.v1.Address.created_at:type_name -> google.protobuf.Timestamp
+	108, // 8: customer.v1.Address.updated_at:type_name -> google.protobuf.Timestamp
+	108, // 9: customer.v1.Document.issue_date:type_name -> google.protobuf.Timestamp
+	108, // 10: customer.v1.Document.expiry_date:type_name -> google.protobuf.Timestamp
+	108, // 11: customer.v1.Document.verified_at:type_name -> google.protobuf.Timestamp
+	108, // 12: customer.v1.Document.created_at:type_name -> google.protobuf.Timestamp
+	108, // 13: customer.v1.Document.updated_at:type_name -> google.protobuf.Timestamp
+	108, // 14: customer.v1.DocumentFile.uploaded_at:type_name -> google.protobuf.Timestamp
+	108, // 15: customer.v1.LegalHold.placed_at:type_name -> google.protobuf.Timestamp
+	108, // 16: customer.v1.LegalHold.released_at:type_name -> google.protobuf.Timestamp
+	108, // 17: customer.v1.ErasureReport.closed_at:type_name -> google.protobuf.Timestamp
+	108, // 18: customer.v1.ErasureReport.retain_until:type_name -> google.protobuf.Timestamp
+	108, // 19: customer.v1.ErasureRequest.requested_at:type_name -> google.protobuf.Timestamp
+	108, // 20: customer.v1.DataExport.requested_at:type_name -> google.protobuf.Timestamp
+	108, // 21: customer.v1.DataExport.due_at:type_name -> google.protobuf.Timestamp
+	108, // 22: customer.v1.DataExport.completed_at:type_name -> google.protobuf.Timestamp
+	9,   // 23: customer.v1.CustomerMerge.field_changes:type_name -> customer.v1.MergeFieldChange
+	108, // 24: customer.v1.CustomerMerge.merged_at:type_name -> google.protobuf.Timestamp
+	108, // 25: customer.v1.CustomerMerge.undo_until:type_name -> google.protobuf.Timestamp
+	108, // 26: customer.v1.CustomerMerge.unmerged_at:type_name -> google.protobuf.Timestamp
+	108, // 27: customer.v1.CustomerEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	108, // 28: customer.v1.Consent.granted_at:type_name -> google.protobuf.Timestamp
+	108, // 29: customer.v1.Consent.recorded_at:type_name -> google.protobuf.Timestamp
+	108, // 30: customer.v1.Consent.withdrawn_at:type_name -> google.protobuf.Timestamp
+	108, // 31: customer.v1.CustomerRelationship.created_at:type_name -> google.protobuf.Timestamp
+	108, // 32: customer.v1.CustomerRelationship.ended_at:type_name -> google.protobuf.Timestamp
+	108, // 33: customer.v1.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	108, // 34: customer.v1.ScreeningHit.reviewed_at:type_name -> google.protobuf.Timestamp
+	108, // 35: customer.v1.ScreeningHit.created_at:type_name -> google.protobuf.Timestamp
+	108, // 36: customer.v1.CustomerScreening.screened_at:type_name -> google.protobuf.Timestamp
+	15,  // 37: customer.v1.CustomerScreening.hits:type_name -> customer.v1.ScreeningHit
+	17,  // 38: customer.v1.CustomerRiskAssessment.factors:type_name -> customer.v1.RiskFactor
+	108, // 39: customer.v1.CustomerRiskAssessment.assessed_at:type_name -> google.protobuf.Timestamp
+	108, // 40: customer.v1.CustomerRiskAssessment.next_review_at:type_name -> google.protobuf.Timestamp
+	108, // 41: customer.v1.ReviewTask.due_at:type_name -> google.protobuf.Timestamp
+	108, // 42: customer.v1.ReviewTask.created_at:type_name -> google.protobuf.Timestamp
+	108, // 43: customer.v1.ReviewTask.completed_at:type_name -> google.protobuf.Timestamp
+	108, // 44: customer.v1.CreateCustomerRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	1,   // 45: customer.v1.CreateCustomerRequest.business:type_name -> customer.v1.BusinessDetails
+	0,   // 46: customer.v1.CreateCustomerResponse.customer:type_name -> customer.v1.Customer
+	16,  // 47: customer.v1.CreateCustomerResponse.screening:type_name -> customer.v1.CustomerScreening
+	18,  // 48: customer.v1.CreateCustomerResponse.risk_assessment:type_name -> customer.v1.CustomerRiskAssessment
+	92,  // 49: customer.v1.CreateCustomerResponse.potential_duplicates:type_name -> customer.v1.DuplicateCandidate
+	0,   // 50: customer.v1.GetCustomerResponse.customer:type_name -> customer.v1.Customer
+	108, // 51: customer.v1.UpdateCustomerRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	1,   // 52: customer.v1.UpdateCustomerRequest.business:type_name -> customer.v1.BusinessDetails
+	0,   // 53: customer.v1.UpdateCustomerResponse.customer:type_name -> customer.v1.Customer
+	16,  // 54: customer.v1.UpdateCustomerResponse.screening:type_name -> customer.v1.CustomerScreening
+	108, // 55: customer.v1.SearchCustomersRequest.from_date:type_name -> google.protobuf.Timestamp
+	108, // 56: customer.v1.SearchCustomersRequest.to_date:type_name -> google.protobuf.Timestamp
+	0,   // 57: customer.v1.SearchCustomersResponse.customers:type_name -> customer.v1.Customer
+	108, // 58: customer.v1.AddAddressRequest.valid_from:type_name -> google.protobuf.Timestamp
+	108, // 59: customer.v1.AddAddressRequest.valid_to:type_name -> google.protobuf.Timestamp
+	2,   // 60: customer.v1.AddAddressResponse.address:type_name -> customer.v1.Address
+	108, // 61: customer.v1.UpdateAddressRequest.effective_from:type_name -> google.protobuf.Timestamp
+	2,   // 62: customer.v1.UpdateAddressResponse.address:type_name -> customer.v1.Address
+	2,   // 63: customer.v1.UpdateAddressResponse.previous:type_name -> customer.v1.Address
+	108, // 64: customer.v1.EndDateAddressRequest.valid_to:type_name -> google.protobuf.Timestamp
+	2,   // 65: customer.v1.EndDateAddressResponse.address:type_name -> customer.v1.Address
+	108, // 66: customer.v1.SetPrimaryAddressRequest.effective_from:type_name -> google.protobuf.Timestamp
+	2,   // 67: customer.v1.SetPrimaryAddressResponse.address:type_name -> customer.v1.Address
+	2,   // 68: customer.v1.ListAddressesResponse.addresses:type_name -> customer.v1.Address
+	108, // 69: customer.v1.GetAddressesAsOfRequest.date:type_name -> google.protobuf.Timestamp
+	2,   // 70: customer.v1.GetAddressesAsOfResponse.addresses:type_name -> customer.v1.Address
+	108, // 71: customer.v1.AddDocumentRequest.issue_date:type_name -> google.protobuf.Timestamp
+	108, // 72: customer.v1.AddDocumentRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	3,   // 73: customer.v1.AddDocumentResponse.document:type_name -> customer.v1.Document
+	0,   // 74: customer.v1.UpdateCustomerStatusResponse.customer:type_name -> customer.v1.Customer
+	14,  // 75: customer.v1.UpdateCustomerStatusResponse.status_change:type_name -> customer.v1.StatusChange
+	0,   // 76: customer.v1.CustomerFullProfileResponse.customer:type_name -> customer.v1.Customer
+	2,   // 77: customer.v1.CustomerFullProfileResponse.addresses:type_name -> customer.v1.Address
+	3,   // 78: customer.v1.CustomerFullProfileResponse.documents:type_name -> customer.v1.Document
+	14,  // 79: customer.v1.CustomerFullProfileResponse.status_history:type_name -> customer.v1.StatusChange
+	13,  // 80: customer.v1.CustomerFullProfileResponse.relationships:type_name -> customer.v1.CustomerRelationship
+	16,  // 81: customer.v1.ScreenCustomerResponse.screening:type_name -> customer.v1.CustomerScreening
+	16,  // 82: customer.v1.GetCustomerScreeningResponse.screening:type_name -> customer.v1.CustomerScreening
+	15,  // 83: customer.v1.ListScreeningHitsResponse.hits:type_name -> customer.v1.ScreeningHit
+	15,  // 84: customer.v1.ReviewScreeningHitResponse.hit:type_name -> customer.v1.ScreeningHit
+	0,   // 85: customer.v1.ReviewScreeningHitResponse.customer:type_name -> customer.v1.Customer
+	18,  // 86: customer.v1.AssessCustomerRiskResponse.assessment:type_name -> customer.v1.CustomerRiskAssessment
+	18,  // 87: customer.v1.GetCustomerRiskHistoryResponse.assessments:type_name -> customer.v1.CustomerRiskAssessment
+	19,  // 88: customer.v1.ListReviewTasksResponse.tasks:type_name -> customer.v1.ReviewTask
+	19,  // 89: customer.v1.CompleteReviewTaskResponse.task:type_name -> customer.v1.ReviewTask
+	18,  // 90: customer.v1.CompleteReviewTaskResponse.assessment:type_name -> customer.v1.CustomerRiskAssessment
+	0,   // 91: customer.v1.CompleteReviewTaskResponse.customer:type_name -> customer.v1.Customer
+	61,  // 92: customer.v1.UploadDocumentFileRequest.header:type_name -> customer.v1.DocumentFileHeader
+	4,   // 93: customer.v1.UploadDocumentFileResponse.file:type_name -> customer.v1.DocumentFile
+	4,   // 94: customer.v1.DownloadDocumentFileResponse.file:type_name -> customer.v1.DocumentFile
+	4,   // 95: customer.v1.ListDocumentFilesResponse.files:type_name -> customer.v1.DocumentFile
+	6,   // 96: customer.v1.EraseCustomerResponse.report:type_name -> customer.v1.ErasureReport
+	7,   // 97: customer.v1.EraseCustomerResponse.request:type_name -> customer.v1.ErasureRequest
+	5,   // 98: customer.v1.PlaceLegalHoldResponse.hold:type_name -> customer.v1.LegalHold
+	5,   // 99: customer.v1.ReleaseLegalHoldResponse.hold:type_name -> customer.v1.LegalHold
+	5,   // 100: customer.v1.ListLegalHoldsResponse.holds:type_name -> customer.v1.LegalHold
+	8,   // 101: customer.v1.ExportCustomerDataResponse.export:type_name -> customer.v1.DataExport
+	8,   // 102: customer.v1.GetDataExportResponse.export:type_name -> customer.v1.DataExport
+	10,  // 103: customer.v1.MergeCustomersResponse.merge:type_name -> customer.v1.CustomerMerge
+	0,   // 104: customer.v1.MergeCustomersResponse.survivor:type_name -> customer.v1.Customer
+	10,  // 105: customer.v1.UnmergeCustomersResponse.merge:type_name -> customer.v1.CustomerMerge
+	0,   // 106: customer.v1.UnmergeCustomersResponse.survivor:type_name -> customer.v1.Customer
+	0,   // 107: customer.v1.UnmergeCustomersResponse.restored:type_name -> customer.v1.Customer
+	10,  // 108: customer.v1.ListCustomerMergesResponse.merges:type_name -> customer.v1.CustomerMerge
+	11,  // 109: customer.v1.ListCustomerEventsResponse.events:type_name -> customer.v1.CustomerEvent
+	108, // 110: customer.v1.FindPotentialDuplicatesRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	92,  // 111: customer.v1.FindPotentialDuplicatesResponse.candidates:type_name -> customer.v1.DuplicateCandidate
+	0,   // 112: customer.v1.DuplicateCandidate.customer:type_name -> customer.v1.Customer
+	93,  // 113: customer.v1.DuplicateCandidate.fields:type_name -> customer.v1.FieldSimilarity
+	13,  // 114: customer.v1.AddCustomerRelationshipResponse.relationship:type_name -> customer.v1.CustomerRelationship
+	13,  // 115: customer.v1.EndCustomerRelationshipResponse.relationship:type_name -> customer.v1.CustomerRelationship
+	13,  // 116: customer.v1.ListCustomerRelationshipsResponse.relationships:type_name -> customer.v1.CustomerRelationship
+	108, // 117: customer.v1.GrantConsentRequest.granted_at:type_name -> google.protobuf.Timestamp
+	12,  // 118: customer.v1.GrantConsentResponse.consent:type_name -> customer.v1.Consent
+	12,  // 119: customer.v1.WithdrawConsentResponse.consent:type_name -> customer.v1.Consent
+	12,  // 120: customer.v1.ListConsentsResponse.consents:type_name -> customer.v1.Consent
+	108, // 121: customer.v1.CheckConsentRequest.as_of:type_name -> google.protobuf.Timestamp
+	12,  // 122: customer.v1.CheckConsentResponse.consent:type_name -> customer.v1.Consent
+	20,  // 123: customer.v1.CustomerService.CreateCustomer:input_type -> customer.v1.CreateCustomerRequest
+	22,  // 124: customer.v1.CustomerService.GetCustomer:input_type -> customer.v1.GetCustomerRequest
+	24,  // 125: customer.v1.CustomerService.UpdateCustomer:input_type -> customer.v1.UpdateCustomerRequest
+	26,  // 126: customer.v1.CustomerService.SearchCustomers:input_type -> customer.v1.SearchCustomersRequest
+	28,  // 127: customer.v1.CustomerService.AddAddress:input_type -> customer.v1.AddAddressRequest
+	30,  // 128: customer.v1.CustomerService.UpdateAddress:input_type -> customer.v1.UpdateAddressRequest
+	32,  // 129: customer.v1.CustomerService.EndDateAddress:input_type -> customer.v1.EndDateAddressRequest
+	34,  // 130: customer.v1.CustomerService.SetPrimaryAddress:input_type -> customer.v1.SetPrimaryAddressRequest
+	36,  // 131: customer.v1.CustomerService.ListAddresses:input_type -> customer.v1.ListAddressesRequest
+	38,  // 132: customer.v1.CustomerService.GetAddressesAsOf:input_type -> customer.v1.GetAddressesAsOfRequest
+	40,  // 133: customer.v1.CustomerService.AddDocument:input_type -> customer.v1.AddDocumentRequest
+	42,  // 134: customer.v1.CustomerService.UpdateCustomerStatus:input_type -> customer.v1.UpdateCustomerStatusRequest
+	22,  // 135: customer.v1.CustomerService.GetCustomerFullProfile:input_type -> customer.v1.GetCustomerRequest
+	45,  // 136: customer.v1.CustomerService.ScreenCustomer:input_type -> customer.v1.ScreenCustomerRequest
+	47,  // 137: customer.v1.CustomerService.GetCustomerScreening:input_type -> customer.v1.GetCustomerScreeningRequest
+	49,  // 138: customer.v1.CustomerService.ListScreeningHits:input_type -> customer.v1.ListScreeningHitsRequest
+	51,  // 139: customer.v1.CustomerService.ReviewScreeningHit:input_type -> customer.v1.ReviewScreeningHitRequest
+	53,  // 140: customer.v1.CustomerService.AssessCustomerRisk:input_type -> customer.v1.AssessCustomerRiskRequest
+	55,  // 141: customer.v1.CustomerService.GetCustomerRiskHistory:input_type -> customer.v1.GetCustomerRiskHistoryRequest
+	57,  // 142: customer.v1.CustomerService.ListReviewTasks:input_type -> customer.v1.ListReviewTasksRequest
+	59,  // 143: customer.v1.CustomerService.CompleteReviewTask:input_type -> customer.v1.CompleteReviewTaskRequest
+	62,  // 144: customer.v1.CustomerService.UploadDocumentFile:input_type -> customer.v1.UploadDocumentFileRequest
+	64,  // 145: customer.v1.CustomerService.DownloadDocumentFile:input_type -> customer.v1.DownloadDocumentFileRequest
+	66,  // 146: customer.v1.CustomerService.ListDocumentFiles:input_type -> customer.v1.ListDocumentFilesRequest
+	68,  // 147: customer.v1.CustomerService.EraseCustomer:input_type -> customer.v1.EraseCustomerRequest
+	70,  // 148: customer.v1.CustomerService.PlaceLegalHold:input_type -> customer.v1.PlaceLegalHoldRequest
+	72,  // 149: customer.v1.CustomerService.ReleaseLegalHold:input_type -> customer.v1.ReleaseLegalHoldRequest
+	74,  // 150: customer.v1.CustomerService.ListLegalHolds:input_type -> customer.v1.ListLegalHoldsRequest
+	76,  // 151: customer.v1.CustomerService.ExportCustomerData:input_type -> customer.v1.ExportCustomerDataRequest
+	78,  // 152: customer.v1.CustomerService.GetDataExport:input_type -> customer.v1.GetDataExportRequest
+	80,  // 153: customer.v1.CustomerService.DownloadDataExport:input_type -> customer.v1.DownloadDataExportRequest
+	82,  // 154: customer.v1.CustomerService.MergeCustomers:input_type -> customer.v1.MergeCustomersRequest
+	84,  // 155: customer.v1.CustomerService.UnmergeCustomers:input_type -> customer.v1.UnmergeCustomersRequest
+	86,  // 156: customer.v1.CustomerService.ListCustomerMerges:input_type -> customer.v1.ListCustomerMergesRequest
+	88,  // 157: customer.v1.CustomerService.ListCustomerEvents:input_type -> customer.v1.ListCustomerEventsRequest
+	90,  // 158: customer.v1.CustomerService.FindPotentialDuplicates:input_type -> customer.v1.FindPotentialDuplicatesRequest
+	94,  // 159: customer.v1.CustomerService.AddCustomerRelationship:input_type -> customer.v1.AddCustomerRelationshipRequest
+	96,  // 160: customer.v1.CustomerService.EndCustomerRelationship:input_type -> customer.v1.EndCustomerRelationshipRequest
+	98,  // 161: customer.v1.CustomerService.ListCustomerRelationships:input_type -> customer.v1.ListCustomerRelationshipsRequest
+	100, // 162: customer.v1.CustomerService.GrantConsent:input_type -> customer.v1.GrantConsentRequest
+	102, // 163: customer.v1.CustomerService.WithdrawConsent:input_type -> customer.v1.WithdrawConsentRequest
+	104, // 164: customer.v1.CustomerService.ListConsents:input_type -> customer.v1.ListConsentsRequest
+	106, // 165: customer.v1.CustomerService.CheckConsent:input_type -> customer.v1.CheckConsentRequest
+	21,  // 166: customer.v1.CustomerService.CreateCustomer:output_type -> customer.v1.CreateCustomerResponse
+	23,  // 167: customer.v1.CustomerService.GetCustomer:output_type -> customer.v1.GetCustomerResponse
+	25,  // 168: customer.v1.CustomerService.UpdateCustomer:output_type -> customer.v1.UpdateCustomerResponse
+	27,  // 169: customer.v1.CustomerService.SearchCustomers:output_type -> customer.v1.SearchCustomersResponse
+	29,  // 170: customer.v1.CustomerService.AddAddress:output_type -> customer.v1.AddAddressResponse
+	31,  // 171: customer.v1.CustomerService.UpdateAddress:output_type -> customer.v1.UpdateAddressResponse
+	33,  // 172: customer.v1.CustomerService.EndDateAddress:output_type -> customer.v1.EndDateAddressResponse
+	35,  // 173: customer.v1.CustomerService.SetPrimaryAddress:output_type -> customer.v1.SetPrimaryAddressResponse
+	37,  // 174: customer.v1.CustomerService.ListAddresses:output_type -> customer.v1.ListAddressesResponse
+	39,  // 175: customer.v1.CustomerService.GetAddressesAsOf:output_type -> customer.v1.GetAddressesAsOfResponse
+	41,  // 176: customer.v1.CustomerService.AddDocument:output_type -> customer.v1.AddDocumentResponse
+	43,  // 177: customer.v1.CustomerService.UpdateCustomerStatus:output_type -> customer.v1.UpdateCustomerStatusResponse
+	44,  // 178: customer.v1.CustomerService.GetCustomerFullProfile:output_type -> customer.v1.CustomerFullProfileResponse
+	46,  // 179: customer.v1.CustomerService.ScreenCustomer:output_type -> customer.v1.ScreenCustomerResponse
+	48,  // 180: customer.v1.CustomerService.GetCustomerScreening:output_type -> customer.v1.GetCustomerScreeningResponse
+	50,  // 181: customer.v1.CustomerService.ListScreeningHits:output_type -> customer.v1.ListScreeningHitsResponse
+	52,  // 182: customer.v1.CustomerService.ReviewScreeningHit:output_type -> customer.v1.ReviewScreeningHitResponse
+	54,  // 183: customer.v1.CustomerService.AssessCustomerRisk:output_type -> customer.v1.AssessCustomerRiskResponse
+	56,  // 184: customer.v1.CustomerService.GetCustomerRiskHistory:output_type -> customer.v1.GetCustomerRiskHistoryResponse
+	58,  // 185: customer.v1.CustomerService.ListReviewTasks:output_type -> customer.v1.ListReviewTasksResponse
+	60,  // 186: customer.v1.CustomerService.CompleteReviewTask:output_type -> customer.v1.CompleteReviewTaskResponse
+	63,  // 187: customer.v1.CustomerService.UploadDocumentFile:output_type -> customer.v1.UploadDocumentFileResponse
+	65,  // 188: customer.v1.CustomerService.DownloadDocumentFile:output_type -> customer.v1.DownloadDocumentFileResponse
+	67,  // 189: customer.v1.CustomerService.ListDocumentFiles:output_type -> customer.v1.ListDocumentFilesResponse
+	69,  // 190: customer.v1.CustomerService.EraseCustomer:output_type -> customer.v1.EraseCustomerResponse
+	71,  // 191: customer.v1.CustomerService.PlaceLegalHold:output_type -> customer.v1.PlaceLegalHoldResponse
+	73,  // 192: customer.v1.CustomerService.ReleaseLegalHold:output_type -> customer.v1.ReleaseLegalHoldResponse
+	75,  // 193: customer.v1.CustomerService.ListLegalHolds:output_type -> customer.v1.ListLegalHoldsResponse
+	77,  // 194: customer.v1.CustomerService.ExportCustomerData:output_type -> customer.v1.ExportCustomerDataResponse
+	79,  // 195: customer.v1.CustomerService.GetDataExport:output_type -> customer.v1.GetDataExportResponse
+	81,  // 196: customer.v1.CustomerService.DownloadDataExport:output_type -> customer.v1.DownloadDataExportResponse
+	83,  // 197: customer.v1.CustomerService.MergeCustomers:output_type -> customer.v1.MergeCustomersResponse
+	85,  // 198: customer.v1.CustomerService.UnmergeCustomers:output_type -> customer.v1.UnmergeCustomersResponse
+	87,  // 199: customer.v1.CustomerService.ListCustomerMerges:output_type -> customer.v1.ListCustomerMergesResponse
+	89,  // 200: customer.v1.CustomerService.ListCustomerEvents:output_type -> customer.v1.ListCustomerEventsResponse
+	91,  // 201: customer.v1.CustomerService.FindPotentialDuplicates:output_type -> customer.v1.FindPotentialDuplicatesResponse
+	95,  // 202: customer.v1.CustomerService.AddCustomerRelationship:output_type -> customer.v1.AddCustomerRelationshipResponse
+	97,  // 203: customer.v1.CustomerService.EndCustomerRelationship:output_type -> customer.v1.EndCustomerRelationshipResponse
+	99,  // 204: customer.v1.CustomerService.ListCustomerRelationships:output_type -> customer.v1.ListCustomerRelationshipsResponse
+	101, // 205: customer.v1.CustomerService.GrantConsent:output_type -> customer.v1.GrantConsentResponse
+	103, // 206: customer.v1.CustomerService.WithdrawConsent:output_type -> customer.v1.WithdrawConsentResponse
+	105, // 207: customer.v1.CustomerService.ListConsents:output_type -> customer.v1.ListConsentsResponse
+	107, // 208: customer.v1.CustomerService.CheckConsent:output_type -> customer.v1.CheckConsentResponse
+	166, // [166:209] is the sub-list for method output_type
+	123, // [123:166] is the sub-list for method input_type
+	123, // [123:123] is the sub-list for extension type_name
+	123, // [123:123] is the sub-list for extension extendee
+	0,   // [0:123] is the sub-list for field type_name
 }
 
 func init() { file_customer_proto_init() }
@@ -1900,13 +8763,21 @@ func file_customer_proto_init() {
 	if File_customer_proto != nil {
 		return
 	}
+	file_customer_proto_msgTypes[62].OneofWrappers = []any{
+		(*UploadDocumentFileRequest_Header)(nil),
+		(*UploadDocumentFileRequest_Chunk)(nil),
+	}
+	file_customer_proto_msgTypes[65].OneofWrappers = []any{
+		(*DownloadDocumentFileResponse_File)(nil),
+		(*DownloadDocumentFileResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_customer_proto_rawDesc), len(file_customer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   108,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CustomerService_CreateCustomer_FullMethodName         = "/customer.v1.CustomerService/CreateCustomer"
-	CustomerService_GetCustomer_FullMethodName            = "/customer.v1.CustomerService/GetCustomer"
-	CustomerService_UpdateCustomer_FullMethodName         = "/customer.v1.CustomerService/UpdateCustomer"
-	CustomerService_SearchCustomers_FullMethodName        = "/customer.v1.CustomerService/SearchCustomers"
-	CustomerService_AddAddress_FullMethodName             = "/customer.v1.CustomerService/AddAddress"
-	CustomerService_AddDocument_FullMethodName            = "/customer.v1.CustomerService/AddDocument"
-	CustomerService_UpdateCustomerStatus_FullMethodName   = "/customer.v1.CustomerService/UpdateCustomerStatus"
-	CustomerService_GetCustomerFullProfile_FullMethodName = "/customer.v1.CustomerService/GetCustomerFullProfile"
+	CustomerService_CreateCustomer_FullMethodName            = "/customer.v1.CustomerService/CreateCustomer"
+	CustomerService_GetCustomer_FullMethodName               = "/customer.v1.CustomerService/GetCustomer"
+	CustomerService_UpdateCustomer_FullMethodName            = "/customer.v1.CustomerService/UpdateCustomer"
+	CustomerService_SearchCustomers_FullMethodName           = "/customer.v1.CustomerService/SearchCustomers"
+	CustomerService_AddAddress_FullMethodName                = "/customer.v1.CustomerService/AddAddress"
+	CustomerService_UpdateAddress_FullMethodName             = "/customer.v1.CustomerService/UpdateAddress"
+	CustomerService_EndDateAddress_FullMethodName            = "/customer.v1.CustomerService/EndDateAddress"
+	CustomerService_SetPrimaryAddress_FullMethodName         = "/customer.v1.CustomerService/SetPrimaryAddress"
+	CustomerService_ListAddresses_FullMethodName             = "/customer.v1.CustomerService/ListAddresses"
+	CustomerService_GetAddressesAsOf_FullMethodName          = "/customer.v1.CustomerService/GetAddressesAsOf"
+	CustomerService_AddDocument_FullMethodName               = "/customer.v1.CustomerService/AddDocument"
+	CustomerService_UpdateCustomerStatus_FullMethodName      = "/customer.v1.CustomerService/UpdateCustomerStatus"
+	CustomerService_GetCustomerFullProfile_FullMethodName    = "/customer.v1.CustomerService/GetCustomerFullProfile"
+	CustomerService_ScreenCustomer_FullMethodName            = "/customer.v1.CustomerService/ScreenCustomer"
+	CustomerService_GetCustomerScreening_FullMethodName      = "/customer.v1.CustomerService/GetCustomerScreening"
+	CustomerService_ListScreeningHits_FullMethodName         = "/customer.v1.CustomerService/ListScreeningHits"
+	CustomerService_ReviewScreeningHit_FullMethodName        = "/customer.v1.CustomerService/ReviewScreeningHit"
+	CustomerService_AssessCustomerRisk_FullMethodName        = "/customer.v1.CustomerService/AssessCustomerRisk"
+	CustomerService_GetCustomerRiskHistory_FullMethodName    = "/customer.v1.CustomerService/GetCustomerRiskHistory"
+	CustomerService_ListReviewTasks_FullMethodName           = "/customer.v1.CustomerService/ListReviewTasks"
+	CustomerService_CompleteReviewTask_FullMethodName        = "/customer.v1.CustomerService/CompleteReviewTask"
+	CustomerService_UploadDocumentFile_FullMethodName        = "/customer.v1.CustomerService/UploadDocumentFile"
+	CustomerService_DownloadDocumentFile_FullMethodName      = "/customer.v1.CustomerService/DownloadDocumentFile"
+	CustomerService_ListDocumentFiles_FullMethodName         = "/customer.v1.CustomerService/ListDocumentFiles"
+	CustomerService_EraseCustomer_FullMethodName             = "/customer.v1.CustomerService/EraseCustomer"
+	CustomerService_PlaceLegalHold_FullMethodName            = "/customer.v1.CustomerService/PlaceLegalHold"
+	CustomerService_ReleaseLegalHold_FullMethodName          = "/customer.v1.CustomerService/ReleaseLegalHold"
+	CustomerService_ListLegalHolds_FullMethodName            = "/customer.v1.CustomerService/ListLegalHolds"
+	CustomerService_ExportCustomerData_FullMethodName        = "/customer.v1.CustomerService/ExportCustomerData"
+	CustomerService_GetDataExport_FullMethodName             = "/customer.v1.CustomerService/GetDataExport"
+	CustomerService_DownloadDataExport_FullMethodName        = "/customer.v1.CustomerService/DownloadDataExport"
+	CustomerService_MergeCustomers_FullMethodName            = "/customer.v1.CustomerService/MergeCustomers"
+	CustomerService_UnmergeCustomers_FullMethodName          = "/customer.v1.CustomerService/UnmergeCustomers"
+	CustomerService_ListCustomerMerges_FullMethodName        = "/customer.v1.CustomerService/ListCustomerMerges"
+	CustomerService_ListCustomerEvents_FullMethodName        = "/customer.v1.CustomerService/ListCustomerEvents"
+	CustomerService_FindPotentialDuplicates_FullMethodName   = "/customer.v1.CustomerService/FindPotentialDuplicates"
+	CustomerService_AddCustomerRelationship_FullMethodName   = "/customer.v1.CustomerService/AddCustomerRelationship"
+	CustomerService_EndCustomerRelationship_FullMethodName   = "/customer.v1.CustomerService/EndCustomerRelationship"
+	CustomerService_ListCustomerRelationships_FullMethodName = "/customer.v1.CustomerService/ListCustomerRelationships"
+	CustomerService_GrantConsent_FullMethodName              = "/customer.v1.CustomerService/GrantConsent"
+	CustomerService_WithdrawConsent_FullMethodName           = "/customer.v1.CustomerService/WithdrawConsent"
+	CustomerService_ListConsents_FullMethodName              = "/customer.v1.CustomerService/ListConsents"
+	CustomerService_CheckConsent_FullMethodName              = "/customer.v1.CustomerService/CheckConsent"
 )
 
 // CustomerServiceClient is the client API for CustomerService service.
@@ -45,12 +80,82 @@ type CustomerServiceClient interface {
 	SearchCustomers(ctx context.Context, in *SearchCustomersRequest, opts ...grpc.CallOption) (*SearchCustomersResponse, error)
 	// AddAddress adds a new address to a customer
 	AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddAddressResponse, error)
+	// UpdateAddress changes an address by closing its current version and opening a new one
+	UpdateAddress(ctx context.Context, in *UpdateAddressRequest, opts ...grpc.CallOption) (*UpdateAddressResponse, error)
+	// EndDateAddress closes an address, keeping it on record
+	EndDateAddress(ctx context.Context, in *EndDateAddressRequest, opts ...grpc.CallOption) (*EndDateAddressResponse, error)
+	// SetPrimaryAddress makes an address the customer's primary one
+	SetPrimaryAddress(ctx context.Context, in *SetPrimaryAddressRequest, opts ...grpc.CallOption) (*SetPrimaryAddressResponse, error)
+	// ListAddresses lists a customer's current addresses, or every version of them
+	ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error)
+	// GetAddressesAsOf lists the addresses a customer had in effect on a given day
+	GetAddressesAsOf(ctx context.Context, in *GetAddressesAsOfRequest, opts ...grpc.CallOption) (*GetAddressesAsOfResponse, error)
 	// AddDocument adds a new document to a customer
 	AddDocument(ctx context.Context, in *AddDocumentRequest, opts ...grpc.CallOption) (*AddDocumentResponse, error)
 	// UpdateCustomerStatus updates the status of a customer
 	UpdateCustomerStatus(ctx context.Context, in *UpdateCustomerStatusRequest, opts ...grpc.CallOption) (*UpdateCustomerStatusResponse, error)
 	// GetCustomerFullProfile retrieves the complete customer profile including addresses and documents
 	GetCustomerFullProfile(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*CustomerFullProfileResponse, error)
+	// ScreenCustomer screens a customer against the sanctions lists now
+	ScreenCustomer(ctx context.Context, in *ScreenCustomerRequest, opts ...grpc.CallOption) (*ScreenCustomerResponse, error)
+	// GetCustomerScreening retrieves the latest screening of a customer
+	GetCustomerScreening(ctx context.Context, in *GetCustomerScreeningRequest, opts ...grpc.CallOption) (*GetCustomerScreeningResponse, error)
+	// ListScreeningHits lists potential sanctions matches, most recent first
+	ListScreeningHits(ctx context.Context, in *ListScreeningHitsRequest, opts ...grpc.CallOption) (*ListScreeningHitsResponse, error)
+	// ReviewScreeningHit confirms or dismisses a potential sanctions match
+	ReviewScreeningHit(ctx context.Context, in *ReviewScreeningHitRequest, opts ...grpc.CallOption) (*ReviewScreeningHitResponse, error)
+	// AssessCustomerRisk rates a customer's KYC risk now
+	AssessCustomerRisk(ctx context.Context, in *AssessCustomerRiskRequest, opts ...grpc.CallOption) (*AssessCustomerRiskResponse, error)
+	// GetCustomerRiskHistory lists a customer's risk assessments, most recent first
+	GetCustomerRiskHistory(ctx context.Context, in *GetCustomerRiskHistoryRequest, opts ...grpc.CallOption) (*GetCustomerRiskHistoryResponse, error)
+	// ListReviewTasks lists periodic KYC review tasks, soonest due first
+	ListReviewTasks(ctx context.Context, in *ListReviewTasksRequest, opts ...grpc.CallOption) (*ListReviewTasksResponse, error)
+	// CompleteReviewTask records a periodic review and reassesses the customer
+	CompleteReviewTask(ctx context.Context, in *CompleteReviewTaskRequest, opts ...grpc.CallOption) (*CompleteReviewTaskResponse, error)
+	// UploadDocumentFile stores a scan of a document, streamed as a header then chunks
+	UploadDocumentFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentFileRequest, UploadDocumentFileResponse], error)
+	// DownloadDocumentFile streams a stored scan as its details then chunks
+	DownloadDocumentFile(ctx context.Context, in *DownloadDocumentFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentFileResponse], error)
+	// ListDocumentFiles lists the scans stored for a document
+	ListDocumentFiles(ctx context.Context, in *ListDocumentFilesRequest, opts ...grpc.CallOption) (*ListDocumentFilesResponse, error)
+	// EraseCustomer pseudonymizes a closed customer's personal data, or reports what it would do
+	EraseCustomer(ctx context.Context, in *EraseCustomerRequest, opts ...grpc.CallOption) (*EraseCustomerResponse, error)
+	// PlaceLegalHold stops a customer's personal data being erased
+	PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*PlaceLegalHoldResponse, error)
+	// ReleaseLegalHold lifts a legal hold
+	ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*ReleaseLegalHoldResponse, error)
+	// ListLegalHolds lists a customer's legal holds, most recent first
+	ListLegalHolds(ctx context.Context, in *ListLegalHoldsRequest, opts ...grpc.CallOption) (*ListLegalHoldsResponse, error)
+	// ExportCustomerData answers a subject access request with a signed bundle of everything held on a customer
+	ExportCustomerData(ctx context.Context, in *ExportCustomerDataRequest, opts ...grpc.CallOption) (*ExportCustomerDataResponse, error)
+	// GetDataExport gets a data export, to poll until its bundle is ready
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
+	// DownloadDataExport streams one rendering of a completed data export in chunks
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDataExportResponse], error)
+	// MergeCustomers merges a duplicate customer into the one the survivorship rules keep, or previews the merge
+	MergeCustomers(ctx context.Context, in *MergeCustomersRequest, opts ...grpc.CallOption) (*MergeCustomersResponse, error)
+	// UnmergeCustomers reverses a merge within its undo window
+	UnmergeCustomers(ctx context.Context, in *UnmergeCustomersRequest, opts ...grpc.CallOption) (*UnmergeCustomersResponse, error)
+	// ListCustomerMerges lists the merges a customer took part in, most recent first
+	ListCustomerMerges(ctx context.Context, in *ListCustomerMergesRequest, opts ...grpc.CallOption) (*ListCustomerMergesResponse, error)
+	// ListCustomerEvents reads the feed of customer events other services follow
+	ListCustomerEvents(ctx context.Context, in *ListCustomerEventsRequest, opts ...grpc.CallOption) (*ListCustomerEventsResponse, error)
+	// FindPotentialDuplicates scores the existing customers most like the given details or customer
+	FindPotentialDuplicates(ctx context.Context, in *FindPotentialDuplicatesRequest, opts ...grpc.CallOption) (*FindPotentialDuplicatesResponse, error)
+	// AddCustomerRelationship links an individual customer to a business customer as a director, signatory or owner
+	AddCustomerRelationship(ctx context.Context, in *AddCustomerRelationshipRequest, opts ...grpc.CallOption) (*AddCustomerRelationshipResponse, error)
+	// EndCustomerRelationship ends an individual's role in a business
+	EndCustomerRelationship(ctx context.Context, in *EndCustomerRelationshipRequest, opts ...grpc.CallOption) (*EndCustomerRelationshipResponse, error)
+	// ListCustomerRelationships lists the relationships of a business or individual customer, most recent first
+	ListCustomerRelationships(ctx context.Context, in *ListCustomerRelationshipsRequest, opts ...grpc.CallOption) (*ListCustomerRelationshipsResponse, error)
+	// GrantConsent records a customer agreeing to a purpose on a channel
+	GrantConsent(ctx context.Context, in *GrantConsentRequest, opts ...grpc.CallOption) (*GrantConsentResponse, error)
+	// WithdrawConsent records a customer withdrawing a consent
+	WithdrawConsent(ctx context.Context, in *WithdrawConsentRequest, opts ...grpc.CallOption) (*WithdrawConsentResponse, error)
+	// ListConsents lists a customer's consents, withdrawn ones included, most recently granted first
+	ListConsents(ctx context.Context, in *ListConsentsRequest, opts ...grpc.CallOption) (*ListConsentsResponse, error)
+	// CheckConsent tells other services whether a customer may be contacted for a purpose on a channel
+	CheckConsent(ctx context.Context, in *CheckConsentRequest, opts ...grpc.CallOption) (*CheckConsentResponse, error)
 }
 
 type customerServiceClient struct {
@@ -111,6 +216,56 @@ func (c *customerServiceClient) AddAddress(ctx context.Context, in *AddAddressRe
 	return out, nil
 }
 
+func (c *customerServiceClient) UpdateAddress(ctx context.Context, in *UpdateAddressRequest, opts ...grpc.CallOption) (*UpdateAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAddressResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) EndDateAddress(ctx context.Context, in *EndDateAddressRequest, opts ...grpc.CallOption) (*EndDateAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndDateAddressResponse)
+	err := c.cc.Invoke(ctx, CustomerService_EndDateAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) SetPrimaryAddress(ctx context.Context, in *SetPrimaryAddressRequest, opts ...grpc.CallOption) (*SetPrimaryAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryAddressResponse)
+	err := c.cc.Invoke(ctx, CustomerService_SetPrimaryAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAddressesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetAddressesAsOf(ctx context.Context, in *GetAddressesAsOfRequest, opts ...grpc.CallOption) (*GetAddressesAsOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAddressesAsOfResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetAddressesAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *customerServiceClient) AddDocument(ctx context.Context, in *AddDocumentRequest, opts ...grpc.CallOption) (*AddDocumentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddDocumentResponse)
@@ -118,246 +273,1347 @@ func (c *customerServiceClient) AddDocument(ctx context.Context, in *AddDocument
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *customerServiceClient) UpdateCustomerStatus(ctx context.Context, in *UpdateCustomerStatusRequest, opts ...grpc.CallOption) (*UpdateCustomerStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomerStatusResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateCustomerStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerFullProfile(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*CustomerFullProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerFullProfileResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerFullProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ScreenCustomer(ctx context.Context, in *ScreenCustomerRequest, opts ...grpc.CallOption) (*ScreenCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScreenCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ScreenCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerScreening(ctx context.Context, in *GetCustomerScreeningRequest, opts ...grpc.CallOption) (*GetCustomerScreeningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerScreeningResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerScreening_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListScreeningHits(ctx context.Context, in *ListScreeningHitsRequest, opts ...grpc.CallOption) (*ListScreeningHitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScreeningHitsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListScreeningHits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReviewScreeningHit(ctx context.Context, in *ReviewScreeningHitRequest, opts ...grpc.CallOption) (*ReviewScreeningHitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewScreeningHitResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ReviewScreeningHit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) AssessCustomerRisk(ctx context.Context, in *AssessCustomerRiskRequest, opts ...grpc.CallOption) (*AssessCustomerRiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssessCustomerRiskResponse)
+	err := c.cc.Invoke(ctx, CustomerService_AssessCustomerRisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerRiskHistory(ctx context.Context, in *GetCustomerRiskHistoryRequest, opts ...grpc.CallOption) (*GetCustomerRiskHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerRiskHistoryResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerRiskHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListReviewTasks(ctx context.Context, in *ListReviewTasksRequest, opts ...grpc.CallOption) (*ListReviewTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewTasksResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListReviewTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) CompleteReviewTask(ctx context.Context, in *CompleteReviewTaskRequest, opts ...grpc.CallOption) (*CompleteReviewTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteReviewTaskResponse)
+	err := c.cc.Invoke(ctx, CustomerService_CompleteReviewTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UploadDocumentFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentFileRequest, UploadDocumentFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerService_ServiceDesc.Streams[0], CustomerService_UploadDocumentFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadDocumentFileRequest, UploadDocumentFileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_UploadDocumentFileClient = grpc.ClientStreamingClient[UploadDocumentFileRequest, UploadDocumentFileResponse]
+
+func (c *customerServiceClient) DownloadDocumentFile(ctx context.Context, in *DownloadDocumentFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerService_ServiceDesc.Streams[1], CustomerService_DownloadDocumentFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadDocumentFileRequest, DownloadDocumentFileResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_DownloadDocumentFileClient = grpc.ServerStreamingClient[DownloadDocumentFileResponse]
+
+func (c *customerServiceClient) ListDocumentFiles(ctx context.Context, in *ListDocumentFilesRequest, opts ...grpc.CallOption) (*ListDocumentFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentFilesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListDocumentFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) EraseCustomer(ctx context.Context, in *EraseCustomerRequest, opts ...grpc.CallOption) (*EraseCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_EraseCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*PlaceLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceLegalHoldResponse)
+	err := c.cc.Invoke(ctx, CustomerService_PlaceLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*ReleaseLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseLegalHoldResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ReleaseLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListLegalHolds(ctx context.Context, in *ListLegalHoldsRequest, opts ...grpc.CallOption) (*ListLegalHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLegalHoldsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListLegalHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ExportCustomerData(ctx context.Context, in *ExportCustomerDataRequest, opts ...grpc.CallOption) (*ExportCustomerDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCustomerDataResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ExportCustomerData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataExportResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDataExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerService_ServiceDesc.Streams[2], CustomerService_DownloadDataExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadDataExportRequest, DownloadDataExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_DownloadDataExportClient = grpc.ServerStreamingClient[DownloadDataExportResponse]
+
+func (c *customerServiceClient) MergeCustomers(ctx context.Context, in *MergeCustomersRequest, opts ...grpc.CallOption) (*MergeCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_MergeCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UnmergeCustomers(ctx context.Context, in *UnmergeCustomersRequest, opts ...grpc.CallOption) (*UnmergeCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmergeCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UnmergeCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListCustomerMerges(ctx context.Context, in *ListCustomerMergesRequest, opts ...grpc.CallOption) (*ListCustomerMergesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomerMergesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListCustomerMerges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListCustomerEvents(ctx context.Context, in *ListCustomerEventsRequest, opts ...grpc.CallOption) (*ListCustomerEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomerEventsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListCustomerEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) FindPotentialDuplicates(ctx context.Context, in *FindPotentialDuplicatesRequest, opts ...grpc.CallOption) (*FindPotentialDuplicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPotentialDuplicatesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_FindPotentialDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) AddCustomerRelationship(ctx context.Context, in *AddCustomerRelationshipRequest, opts ...grpc.CallOption) (*AddCustomerRelationshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCustomerRelationshipResponse)
+	err := c.cc.Invoke(ctx, CustomerService_AddCustomerRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) EndCustomerRelationship(ctx context.Context, in *EndCustomerRelationshipRequest, opts ...grpc.CallOption) (*EndCustomerRelationshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndCustomerRelationshipResponse)
+	err := c.cc.Invoke(ctx, CustomerService_EndCustomerRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListCustomerRelationships(ctx context.Context, in *ListCustomerRelationshipsRequest, opts ...grpc.CallOption) (*ListCustomerRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomerRelationshipsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListCustomerRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GrantConsent(ctx context.Context, in *GrantConsentRequest, opts ...grpc.CallOption) (*GrantConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantConsentResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GrantConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) WithdrawConsent(ctx context.Context, in *WithdrawConsentRequest, opts ...grpc.CallOption) (*WithdrawConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawConsentResponse)
+	err := c.cc.Invoke(ctx, CustomerService_WithdrawConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListConsents(ctx context.Context, in *ListConsentsRequest, opts ...grpc.CallOption) (*ListConsentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConsentsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListConsents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) CheckConsent(ctx context.Context, in *CheckConsentRequest, opts ...grpc.CallOption) (*CheckConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckConsentResponse)
+	err := c.cc.Invoke(ctx, CustomerService_CheckConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CustomerServiceServer is the server API for CustomerService service.
+// All implementations must embed UnimplementedCustomerServiceServer
+// for forward compatibility.
+//
+// CustomerService provides customer management operations
+type CustomerServiceServer interface {
+	// CreateCustomer creates a new customer
+	CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error)
+	// GetCustomer retrieves a customer by ID
+	GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error)
+	// UpdateCustomer updates an existing customer
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error)
+	// SearchCustomers searches for customers based on filters
+	SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error)
+	// AddAddress adds a new address to a customer
+	AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error)
+	// UpdateAddress changes an address by closing its current version and opening a new one
+	UpdateAddress(context.Context, *UpdateAddressRequest) (*UpdateAddressResponse, error)
+	// EndDateAddress closes an address, keeping it on record
+	EndDateAddress(context.Context, *EndDateAddressRequest) (*EndDateAddressResponse, error)
+	// SetPrimaryAddress makes an address the customer's primary one
+	SetPrimaryAddress(context.Context, *SetPrimaryAddressRequest) (*SetPrimaryAddressResponse, error)
+	// ListAddresses lists a customer's current addresses, or every version of them
+	ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error)
+	// GetAddressesAsOf lists the addresses a customer had in effect on a given day
+	GetAddressesAsOf(context.Context, *GetAddressesAsOfRequest) (*GetAddressesAsOfResponse, error)
+	// AddDocument adds a new document to a customer
+	AddDocument(context.Context, *AddDocumentRequest) (*AddDocumentResponse, error)
+	// UpdateCustomerStatus updates the status of a customer
+	UpdateCustomerStatus(context.Context, *UpdateCustomerStatusRequest) (*UpdateCustomerStatusResponse, error)
+	// GetCustomerFullProfile retrieves the complete customer profile including addresses and documents
+	GetCustomerFullProfile(context.Context, *GetCustomerRequest) (*CustomerFullProfileResponse, error)
+	// ScreenCustomer screens a customer against the sanctions lists now
+	ScreenCustomer(context.Context, *ScreenCustomerRequest) (*ScreenCustomerResponse, error)
+	// GetCustomerScreening retrieves the latest screening of a customer
+	GetCustomerScreening(context.Context, *GetCustomerScreeningRequest) (*GetCustomerScreeningResponse, error)
+	// ListScreeningHits lists potential sanctions matches, most recent first
+	ListScreeningHits(context.Context, *ListScreeningHitsRequest) (*ListScreeningHitsResponse, error)
+	// ReviewScreeningHit confirms or dismisses a potential sanctions match
+	ReviewScreeningHit(context.Context, *ReviewScreeningHitRequest) (*ReviewScreeningHitResponse, error)
+	// AssessCustomerRisk rates a customer's KYC risk now
+	AssessCustomerRisk(context.Context, *AssessCustomerRiskRequest) (*AssessCustomerRiskResponse, error)
+	// GetCustomerRiskHistory lists a customer's risk assessments, most recent first
+	GetCustomerRiskHistory(context.Context, *GetCustomerRiskHistoryRequest) (*GetCustomerRiskHistoryResponse, error)
+	// ListReviewTasks lists periodic KYC review tasks, soonest due first
+	ListReviewTasks(context.Context, *ListReviewTasksRequest) (*ListReviewTasksResponse, error)
+	// CompleteReviewTask records a periodic review and reassesses the customer
+	CompleteReviewTask(context.Context, *CompleteReviewTaskRequest) (*CompleteReviewTaskResponse, error)
+	// UploadDocumentFile stores a scan of a document, streamed as a header then chunks
+	UploadDocumentFile(grpc.ClientStreamingServer[UploadDocumentFileRequest, UploadDocumentFileResponse]) error
+	// DownloadDocumentFile streams a stored scan as its details then chunks
+	DownloadDocumentFile(*DownloadDocumentFileRequest, grpc.ServerStreamingServer[DownloadDocumentFileResponse]) error
+	// ListDocumentFiles lists the scans stored for a document
+	ListDocumentFiles(context.Context, *ListDocumentFilesRequest) (*ListDocumentFilesResponse, error)
+	// EraseCustomer pseudonymizes a closed customer's personal data, or reports what it would do
+	EraseCustomer(context.Context, *EraseCustomerRequest) (*EraseCustomerResponse, error)
+	// PlaceLegalHold stops a customer's personal data being erased
+	PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*PlaceLegalHoldResponse, error)
+	// ReleaseLegalHold lifts a legal hold
+	ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*ReleaseLegalHoldResponse, error)
+	// ListLegalHolds lists a customer's legal holds, most recent first
+	ListLegalHolds(context.Context, *ListLegalHoldsRequest) (*ListLegalHoldsResponse, error)
+	// ExportCustomerData answers a subject access request with a signed bundle of everything held on a customer
+	ExportCustomerData(context.Context, *ExportCustomerDataRequest) (*ExportCustomerDataResponse, error)
+	// GetDataExport gets a data export, to poll until its bundle is ready
+	GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error)
+	// DownloadDataExport streams one rendering of a completed data export in chunks
+	DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DownloadDataExportResponse]) error
+	// MergeCustomers merges a duplicate customer into the one the survivorship rules keep, or previews the merge
+	MergeCustomers(context.Context, *MergeCustomersRequest) (*MergeCustomersResponse, error)
+	// UnmergeCustomers reverses a merge within its undo window
+	UnmergeCustomers(context.Context, *UnmergeCustomersRequest) (*UnmergeCustomersResponse, error)
+	// ListCustomerMerges lists the merges a customer took part in, most recent first
+	ListCustomerMerges(context.Context, *ListCustomerMergesRequest) (*ListCustomerMergesResponse, error)
+	// ListCustomerEvents reads the feed of customer events other services follow
+	ListCustomerEvents(context.Context, *ListCustomerEventsRequest) (*ListCustomerEventsResponse, error)
+	// FindPotentialDuplicates scores the existing customers most like the given details or customer
+	FindPotentialDuplicates(context.Context, *FindPotentialDuplicatesRequest) (*FindPotentialDuplicatesResponse, error)
+	// AddCustomerRelationship links an individual customer to a business customer as a director, signatory or owner
+	AddCustomerRelationship(context.Context, *AddCustomerRelationshipRequest) (*AddCustomerRelationshipResponse, error)
+	// EndCustomerRelationship ends an individual's role in a business
+	EndCustomerRelationship(context.Context, *EndCustomerRelationshipRequest) (*EndCustomerRelationshipResponse, error)
+	// ListCustomerRelationships lists the relationships of a business or individual customer, most recent first
+	ListCustomerRelationships(context.Context, *ListCustomerRelationshipsRequest) (*ListCustomerRelationshipsResponse, error)
+	// GrantConsent records a customer agreeing to a purpose on a channel
+	GrantConsent(context.Context, *GrantConsentRequest) (*GrantConsentResponse, error)
+	// WithdrawConsent records a customer withdrawing a consent
+	WithdrawConsent(context.Context, *WithdrawConsentRequest) (*WithdrawConsentResponse, error)
+	// ListConsents lists a customer's consents, withdrawn ones included, most recently granted first
+	ListConsents(context.Context, *ListConsentsRequest) (*ListConsentsResponse, error)
+	// CheckConsent tells other services whether a customer may be contacted for a purpose on a channel
+	CheckConsent(context.Context, *CheckConsentRequest) (*CheckConsentResponse, error)
+	mustEmbedUnimplementedCustomerServiceServer()
+}
+
+// UnimplementedCustomerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCustomerServiceServer struct{}
+
+func (UnimplementedCustomerServiceServer) CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAddress not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateAddress(context.Context, *UpdateAddressRequest) (*UpdateAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAddress not implemented")
+}
+func (UnimplementedCustomerServiceServer) EndDateAddress(context.Context, *EndDateAddressRequest) (*EndDateAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndDateAddress not implemented")
+}
+func (UnimplementedCustomerServiceServer) SetPrimaryAddress(context.Context, *SetPrimaryAddressRequest) (*SetPrimaryAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPrimaryAddress not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAddresses not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetAddressesAsOf(context.Context, *GetAddressesAsOfRequest) (*GetAddressesAsOfResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAddressesAsOf not implemented")
+}
+func (UnimplementedCustomerServiceServer) AddDocument(context.Context, *AddDocumentRequest) (*AddDocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddDocument not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateCustomerStatus(context.Context, *UpdateCustomerStatusRequest) (*UpdateCustomerStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCustomerStatus not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerFullProfile(context.Context, *GetCustomerRequest) (*CustomerFullProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCustomerFullProfile not implemented")
+}
+func (UnimplementedCustomerServiceServer) ScreenCustomer(context.Context, *ScreenCustomerRequest) (*ScreenCustomerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScreenCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerScreening(context.Context, *GetCustomerScreeningRequest) (*GetCustomerScreeningResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCustomerScreening not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListScreeningHits(context.Context, *ListScreeningHitsRequest) (*ListScreeningHitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScreeningHits not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReviewScreeningHit(context.Context, *ReviewScreeningHitRequest) (*ReviewScreeningHitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewScreeningHit not implemented")
+}
+func (UnimplementedCustomerServiceServer) AssessCustomerRisk(context.Context, *AssessCustomerRiskRequest) (*AssessCustomerRiskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssessCustomerRisk not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerRiskHistory(context.Context, *GetCustomerRiskHistoryRequest) (*GetCustomerRiskHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCustomerRiskHistory not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListReviewTasks(context.Context, *ListReviewTasksRequest) (*ListReviewTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReviewTasks not implemented")
+}
+func (UnimplementedCustomerServiceServer) CompleteReviewTask(context.Context, *CompleteReviewTaskRequest) (*CompleteReviewTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteReviewTask not implemented")
+}
+func (UnimplementedCustomerServiceServer) UploadDocumentFile(grpc.ClientStreamingServer[UploadDocumentFileRequest, UploadDocumentFileResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadDocumentFile not implemented")
+}
+func (UnimplementedCustomerServiceServer) DownloadDocumentFile(*DownloadDocumentFileRequest, grpc.ServerStreamingServer[DownloadDocumentFileResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadDocumentFile not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListDocumentFiles(context.Context, *ListDocumentFilesRequest) (*ListDocumentFilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDocumentFiles not implemented")
+}
+func (UnimplementedCustomerServiceServer) EraseCustomer(context.Context, *EraseCustomerRequest) (*EraseCustomerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*PlaceLegalHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceLegalHold not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*ReleaseLegalHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseLegalHold not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListLegalHolds(context.Context, *ListLegalHoldsRequest) (*ListLegalHoldsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLegalHolds not implemented")
+}
+func (UnimplementedCustomerServiceServer) ExportCustomerData(context.Context, *ExportCustomerDataRequest) (*ExportCustomerDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCustomerData not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedCustomerServiceServer) DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DownloadDataExportResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedCustomerServiceServer) MergeCustomers(context.Context, *MergeCustomersRequest) (*MergeCustomersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) UnmergeCustomers(context.Context, *UnmergeCustomersRequest) (*UnmergeCustomersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnmergeCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListCustomerMerges(context.Context, *ListCustomerMergesRequest) (*ListCustomerMergesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCustomerMerges not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListCustomerEvents(context.Context, *ListCustomerEventsRequest) (*ListCustomerEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCustomerEvents not implemented")
+}
+func (UnimplementedCustomerServiceServer) FindPotentialDuplicates(context.Context, *FindPotentialDuplicatesRequest) (*FindPotentialDuplicatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindPotentialDuplicates not implemented")
+}
+func (UnimplementedCustomerServiceServer) AddCustomerRelationship(context.Context, *AddCustomerRelationshipRequest) (*AddCustomerRelationshipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCustomerRelationship not implemented")
+}
+func (UnimplementedCustomerServiceServer) EndCustomerRelationship(context.Context, *EndCustomerRelationshipRequest) (*EndCustomerRelationshipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndCustomerRelationship not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListCustomerRelationships(context.Context, *ListCustomerRelationshipsRequest) (*ListCustomerRelationshipsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCustomerRelationships not implemented")
+}
+func (UnimplementedCustomerServiceServer) GrantConsent(context.Context, *GrantConsentRequest) (*GrantConsentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantConsent not implemented")
+}
+func (UnimplementedCustomerServiceServer) WithdrawConsent(context.Context, *WithdrawConsentRequest) (*WithdrawConsentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WithdrawConsent not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListConsents(context.Context, *ListConsentsRequest) (*ListConsentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConsents not implemented")
+}
+func (UnimplementedCustomerServiceServer) CheckConsent(context.Context, *CheckConsentRequest) (*CheckConsentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckConsent not implemented")
+}
+func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {}
+func (UnimplementedCustomerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCustomerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CustomerServiceServer will
+// result in compilation errors.
+type UnsafeCustomerServiceServer interface {
+	mustEmbedUnimplementedCustomerServiceServer()
+}
+
+func RegisterCustomerServiceServer(s grpc.ServiceRegistrar, srv CustomerServiceServer) {
+	// If the following call panics, it indicates UnimplementedCustomerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CustomerService_ServiceDesc, srv)
+}
+
+func _CustomerService_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).CreateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_CreateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomer(ctx, req.(*GetCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_SearchCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).SearchCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_SearchCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).SearchCustomers(ctx, req.(*SearchCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).AddAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_AddAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).AddAddress(ctx, req.(*AddAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateAddress(ctx, req.(*UpdateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_EndDateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).EndDateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_EndDateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).EndDateAddress(ctx, req.(*EndDateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_SetPrimaryAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).SetPrimaryAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_SetPrimaryAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).SetPrimaryAddress(ctx, req.(*SetPrimaryAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListAddresses(ctx, req.(*ListAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetAddressesAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressesAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetAddressesAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetAddressesAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetAddressesAsOf(ctx, req.(*GetAddressesAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_AddDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).AddDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_AddDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).AddDocument(ctx, req.(*AddDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateCustomerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateCustomerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateCustomerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateCustomerStatus(ctx, req.(*UpdateCustomerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerFullProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerFullProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerFullProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerFullProfile(ctx, req.(*GetCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ScreenCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ScreenCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ScreenCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ScreenCustomer(ctx, req.(*ScreenCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerScreening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerScreeningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerScreening(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerScreening_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerScreening(ctx, req.(*GetCustomerScreeningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListScreeningHits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScreeningHitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListScreeningHits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListScreeningHits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListScreeningHits(ctx, req.(*ListScreeningHitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ReviewScreeningHit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewScreeningHitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReviewScreeningHit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ReviewScreeningHit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ReviewScreeningHit(ctx, req.(*ReviewScreeningHitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_AssessCustomerRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessCustomerRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).AssessCustomerRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_AssessCustomerRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).AssessCustomerRisk(ctx, req.(*AssessCustomerRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerRiskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRiskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerRiskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerRiskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerRiskHistory(ctx, req.(*GetCustomerRiskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListReviewTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListReviewTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListReviewTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListReviewTasks(ctx, req.(*ListReviewTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_CompleteReviewTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteReviewTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).CompleteReviewTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_CompleteReviewTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).CompleteReviewTask(ctx, req.(*CompleteReviewTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UploadDocumentFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CustomerServiceServer).UploadDocumentFile(&grpc.GenericServerStream[UploadDocumentFileRequest, UploadDocumentFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_UploadDocumentFileServer = grpc.ClientStreamingServer[UploadDocumentFileRequest, UploadDocumentFileResponse]
+
+func _CustomerService_DownloadDocumentFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDocumentFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CustomerServiceServer).DownloadDocumentFile(m, &grpc.GenericServerStream[DownloadDocumentFileRequest, DownloadDocumentFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_DownloadDocumentFileServer = grpc.ServerStreamingServer[DownloadDocumentFileResponse]
+
+func _CustomerService_ListDocumentFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListDocumentFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListDocumentFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListDocumentFiles(ctx, req.(*ListDocumentFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_EraseCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).EraseCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_EraseCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).EraseCustomer(ctx, req.(*EraseCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_PlaceLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).PlaceLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_PlaceLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).PlaceLegalHold(ctx, req.(*PlaceLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ReleaseLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReleaseLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ReleaseLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ReleaseLegalHold(ctx, req.(*ReleaseLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListLegalHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLegalHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListLegalHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListLegalHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListLegalHolds(ctx, req.(*ListLegalHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *customerServiceClient) UpdateCustomerStatus(ctx context.Context, in *UpdateCustomerStatusRequest, opts ...grpc.CallOption) (*UpdateCustomerStatusResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateCustomerStatusResponse)
-	err := c.cc.Invoke(ctx, CustomerService_UpdateCustomerStatus_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CustomerService_ExportCustomerData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCustomerDataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ExportCustomerData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ExportCustomerData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ExportCustomerData(ctx, req.(*ExportCustomerDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *customerServiceClient) GetCustomerFullProfile(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*CustomerFullProfileResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CustomerFullProfileResponse)
-	err := c.cc.Invoke(ctx, CustomerService_GetCustomerFullProfile_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CustomerService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// CustomerServiceServer is the server API for CustomerService service.
-// All implementations must embed UnimplementedCustomerServiceServer
-// for forward compatibility.
-//
-// CustomerService provides customer management operations
-type CustomerServiceServer interface {
-	// CreateCustomer creates a new customer
-	CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error)
-	// GetCustomer retrieves a customer by ID
-	GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error)
-	// UpdateCustomer updates an existing customer
-	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error)
-	// SearchCustomers searches for customers based on filters
-	SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error)
-	// AddAddress adds a new address to a customer
-	AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error)
-	// AddDocument adds a new document to a customer
-	AddDocument(context.Context, *AddDocumentRequest) (*AddDocumentResponse, error)
-	// UpdateCustomerStatus updates the status of a customer
-	UpdateCustomerStatus(context.Context, *UpdateCustomerStatusRequest) (*UpdateCustomerStatusResponse, error)
-	// GetCustomerFullProfile retrieves the complete customer profile including addresses and documents
-	GetCustomerFullProfile(context.Context, *GetCustomerRequest) (*CustomerFullProfileResponse, error)
-	mustEmbedUnimplementedCustomerServiceServer()
+func _CustomerService_DownloadDataExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDataExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CustomerServiceServer).DownloadDataExport(m, &grpc.GenericServerStream[DownloadDataExportRequest, DownloadDataExportResponse]{ServerStream: stream})
 }
 
-// UnimplementedCustomerServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCustomerServiceServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_DownloadDataExportServer = grpc.ServerStreamingServer[DownloadDataExportResponse]
 
-func (UnimplementedCustomerServiceServer) CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateCustomer not implemented")
-}
-func (UnimplementedCustomerServiceServer) GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCustomer not implemented")
-}
-func (UnimplementedCustomerServiceServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateCustomer not implemented")
-}
-func (UnimplementedCustomerServiceServer) SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SearchCustomers not implemented")
-}
-func (UnimplementedCustomerServiceServer) AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddAddress not implemented")
-}
-func (UnimplementedCustomerServiceServer) AddDocument(context.Context, *AddDocumentRequest) (*AddDocumentResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddDocument not implemented")
-}
-func (UnimplementedCustomerServiceServer) UpdateCustomerStatus(context.Context, *UpdateCustomerStatusRequest) (*UpdateCustomerStatusResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateCustomerStatus not implemented")
+func _CustomerService_MergeCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).MergeCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_MergeCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).MergeCustomers(ctx, req.(*MergeCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCustomerServiceServer) GetCustomerFullProfile(context.Context, *GetCustomerRequest) (*CustomerFullProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCustomerFullProfile not implemented")
+
+func _CustomerService_UnmergeCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmergeCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UnmergeCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UnmergeCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UnmergeCustomers(ctx, req.(*UnmergeCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {}
-func (UnimplementedCustomerServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeCustomerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CustomerServiceServer will
-// result in compilation errors.
-type UnsafeCustomerServiceServer interface {
-	mustEmbedUnimplementedCustomerServiceServer()
+func _CustomerService_ListCustomerMerges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomerMergesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListCustomerMerges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListCustomerMerges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListCustomerMerges(ctx, req.(*ListCustomerMergesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCustomerServiceServer(s grpc.ServiceRegistrar, srv CustomerServiceServer) {
-	// If the following call panics, it indicates UnimplementedCustomerServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _CustomerService_ListCustomerEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomerEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CustomerService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListCustomerEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListCustomerEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListCustomerEvents(ctx, req.(*ListCustomerEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCustomerRequest)
+func _CustomerService_FindPotentialDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPotentialDuplicatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).CreateCustomer(ctx, in)
+		return srv.(CustomerServiceServer).FindPotentialDuplicates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_CreateCustomer_FullMethodName,
+		FullMethod: CustomerService_FindPotentialDuplicates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+		return srv.(CustomerServiceServer).FindPotentialDuplicates(ctx, req.(*FindPotentialDuplicatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCustomerRequest)
+func _CustomerService_AddCustomerRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCustomerRelationshipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).GetCustomer(ctx, in)
+		return srv.(CustomerServiceServer).AddCustomerRelationship(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_GetCustomer_FullMethodName,
+		FullMethod: CustomerService_AddCustomerRelationship_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).GetCustomer(ctx, req.(*GetCustomerRequest))
+		return srv.(CustomerServiceServer).AddCustomerRelationship(ctx, req.(*AddCustomerRelationshipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCustomerRequest)
+func _CustomerService_EndCustomerRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndCustomerRelationshipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).UpdateCustomer(ctx, in)
+		return srv.(CustomerServiceServer).EndCustomerRelationship(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_UpdateCustomer_FullMethodName,
+		FullMethod: CustomerService_EndCustomerRelationship_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+		return srv.(CustomerServiceServer).EndCustomerRelationship(ctx, req.(*EndCustomerRelationshipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_SearchCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchCustomersRequest)
+func _CustomerService_ListCustomerRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomerRelationshipsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).SearchCustomers(ctx, in)
+		return srv.(CustomerServiceServer).ListCustomerRelationships(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_SearchCustomers_FullMethodName,
+		FullMethod: CustomerService_ListCustomerRelationships_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).SearchCustomers(ctx, req.(*SearchCustomersRequest))
+		return srv.(CustomerServiceServer).ListCustomerRelationships(ctx, req.(*ListCustomerRelationshipsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAddressRequest)
+func _CustomerService_GrantConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantConsentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).AddAddress(ctx, in)
+		return srv.(CustomerServiceServer).GrantConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_AddAddress_FullMethodName,
+		FullMethod: CustomerService_GrantConsent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).AddAddress(ctx, req.(*AddAddressRequest))
+		return srv.(CustomerServiceServer).GrantConsent(ctx, req.(*GrantConsentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_AddDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDocumentRequest)
+func _CustomerService_WithdrawConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawConsentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).AddDocument(ctx, in)
+		return srv.(CustomerServiceServer).WithdrawConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_AddDocument_FullMethodName,
+		FullMethod: CustomerService_WithdrawConsent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).AddDocument(ctx, req.(*AddDocumentRequest))
+		return srv.(CustomerServiceServer).WithdrawConsent(ctx, req.(*WithdrawConsentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_UpdateCustomerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCustomerStatusRequest)
+func _CustomerService_ListConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).UpdateCustomerStatus(ctx, in)
+		return srv.(CustomerServiceServer).ListConsents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_UpdateCustomerStatus_FullMethodName,
+		FullMethod: CustomerService_ListConsents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).UpdateCustomerStatus(ctx, req.(*UpdateCustomerStatusRequest))
+		return srv.(CustomerServiceServer).ListConsents(ctx, req.(*ListConsentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CustomerService_GetCustomerFullProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCustomerRequest)
+func _CustomerService_CheckConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConsentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServiceServer).GetCustomerFullProfile(ctx, in)
+		return srv.(CustomerServiceServer).CheckConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CustomerService_GetCustomerFullProfile_FullMethodName,
+		FullMethod: CustomerService_CheckConsent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServiceServer).GetCustomerFullProfile(ctx, req.(*GetCustomerRequest))
+		return srv.(CustomerServiceServer).CheckConsent(ctx, req.(*CheckConsentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -389,6 +1645,26 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddAddress",
 			Handler:    _CustomerService_AddAddress_Handler,
 		},
+		{
+			MethodName: "UpdateAddress",
+			Handler:    _CustomerService_UpdateAddress_Handler,
+		},
+		{
+			MethodName: "EndDateAddress",
+			Handler:    _CustomerService_EndDateAddress_Handler,
+		},
+		{
+			MethodName: "SetPrimaryAddress",
+			Handler:    _CustomerService_SetPrimaryAddress_Handler,
+		},
+		{
+			MethodName: "ListAddresses",
+			Handler:    _CustomerService_ListAddresses_Handler,
+		},
+		{
+			MethodName: "GetAddressesAsOf",
+			Handler:    _CustomerService_GetAddressesAsOf_Handler,
+		},
 		{
 			MethodName: "AddDocument",
 			Handler:    _CustomerService_AddDocument_Handler,
@@ -401,7 +1677,131 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCustomerFullProfile",
 			Handler:    _CustomerService_GetCustomerFullProfile_Handler,
 		},
+		{
+			MethodName: "ScreenCustomer",
+			Handler:    _CustomerService_ScreenCustomer_Handler,
+		},
+		{
+			MethodName: "GetCustomerScreening",
+			Handler:    _CustomerService_GetCustomerScreening_Handler,
+		},
+		{
+			MethodName: "ListScreeningHits",
+			Handler:    _CustomerService_ListScreeningHits_Handler,
+		},
+		{
+			MethodName: "ReviewScreeningHit",
+			Handler:    _CustomerService_ReviewScreeningHit_Handler,
+		},
+		{
+			MethodName: "AssessCustomerRisk",
+			Handler:    _CustomerService_AssessCustomerRisk_Handler,
+		},
+		{
+			MethodName: "GetCustomerRiskHistory",
+			Handler:    _CustomerService_GetCustomerRiskHistory_Handler,
+		},
+		{
+			MethodName: "ListReviewTasks",
+			Handler:    _CustomerService_ListReviewTasks_Handler,
+		},
+		{
+			MethodName: "CompleteReviewTask",
+			Handler:    _CustomerService_CompleteReviewTask_Handler,
+		},
+		{
+			MethodName: "ListDocumentFiles",
+			Handler:    _CustomerService_ListDocumentFiles_Handler,
+		},
+		{
+			MethodName: "EraseCustomer",
+			Handler:    _CustomerService_EraseCustomer_Handler,
+		},
+		{
+			MethodName: "PlaceLegalHold",
+			Handler:    _CustomerService_PlaceLegalHold_Handler,
+		},
+		{
+			MethodName: "ReleaseLegalHold",
+			Handler:    _CustomerService_ReleaseLegalHold_Handler,
+		},
+		{
+			MethodName: "ListLegalHolds",
+			Handler:    _CustomerService_ListLegalHolds_Handler,
+		},
+		{
+			MethodName: "ExportCustomerData",
+			Handler:    _CustomerService_ExportCustomerData_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _CustomerService_GetDataExport_Handler,
+		},
+		{
+			MethodName: "MergeCustomers",
+			Handler:    _CustomerService_MergeCustomers_Handler,
+		},
+		{
+			MethodName: "UnmergeCustomers",
+			Handler:    _CustomerService_UnmergeCustomers_Handler,
+		},
+		{
+			MethodName: "ListCustomerMerges",
+			Handler:    _CustomerService_ListCustomerMerges_Handler,
+		},
+		{
+			MethodName: "ListCustomerEvents",
+			Handler:    _CustomerService_ListCustomerEvents_Handler,
+		},
+		{
+			MethodName: "FindPotentialDuplicates",
+			Handler:    _CustomerService_FindPotentialDuplicates_Handler,
+		},
+		{
+			MethodName: "AddCustomerRelationship",
+			Handler:    _CustomerService_AddCustomerRelationship_Handler,
+		},
+		{
+			MethodName: "EndCustomerRelationship",
+			Handler:    _CustomerService_EndCustomerRelationship_Handler,
+		},
+		{
+			MethodName: "ListCustomerRelationships",
+			Handler:    _CustomerService_ListCustomerRelationships_Handler,
+		},
+		{
+			MethodName: "GrantConsent",
+			Handler:    _CustomerService_GrantConsent_Handler,
+		},
+		{
+			MethodName: "WithdrawConsent",
+			Handler:    _CustomerService_WithdrawConsent_Handler,
+		},
+		{
+			MethodName: "ListConsents",
+			Handler:    _CustomerService_ListConsents_Handler,
+		},
+		{
+			MethodName: "CheckConsent",
+			Handler:    _CustomerService_CheckConsent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadDocumentFile",
+			Handler:       _CustomerService_UploadDocumentFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadDocumentFile",
+			Handler:       _CustomerService_DownloadDocumentFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadDataExport",
+			Handler:       _CustomerService_DownloadDataExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "customer.proto",
 }
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Consent operations
+
+func (r *pgCustomerRepository) CreateCustomerConsent(ctx context.Context, consent *models.Consent) error {
+	if consent.ID == uuid.Nil {
+		consent.ID = uuid.New()
+	}
+	if consent.RecordedAt.IsZero() {
+		consent.RecordedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO customer_consents (
+			id, customer_id, purpose, channel, policy_version, source,
+			granted_by, granted_at, recorded_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		consent.ID,
+		consent.CustomerID,
+		consent.Purpose,
+		consent.Channel,
+		consent.PolicyVersion,
+		consent.Source,
+		consent.GrantedBy,
+		consent.GrantedAt,
+		consent.RecordedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create customer consent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetCustomerConsent(ctx context.Context, id uuid.UUID) (*models.Consent, error) {
+	consents, err := r.queryCustomerConsents(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(consents) == 0 {
+		return nil, ErrNotFound
+	}
+	return consents[0], nil
+}
+
+// ListCustomerConsents lists every consent a customer has given, withdrawn
+// ones included, most recently granted first
+func (r *pgCustomerRepository) ListCustomerConsents(ctx context.Context, customerID uuid.UUID) ([]*models.Consent, error) {
+	return r.queryCustomerConsents(ctx, "WHERE customer_id = $1 ORDER BY granted_at DESC, recorded_at DESC", customerID)
+}
+
+// UpdateCustomerConsent records a consent being withdrawn, the only change a
+// consent allows. A consent that has already been withdrawn is not changed
+// and ErrNotFound is returned.
+func (r *pgCustomerRepository) UpdateCustomerConsent(ctx context.Context, consent *models.Consent) error {
+	query := `
+		UPDATE customer_consents SET
+			withdrawn_by = $2,
+			withdrawn_at = $3,
+			withdrawal_source = $4
+		WHERE id = $1 AND withdrawn_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		consent.ID,
+		consent.WithdrawnBy,
+		consent.WithdrawnAt,
+		consent.WithdrawalSource,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update customer consent: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) queryCustomerConsents(ctx context.Context, where string, args ...interface{}) ([]*models.Consent, error) {
+	query := `
+		SELECT id, customer_id, purpose, channel, policy_version, source,
+			granted_by, granted_at, recorded_at, withdrawn_by, withdrawn_at, withdrawal_source
+		FROM customer_consents
+	` + where
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer consents: %w", err)
+	}
+	defer rows.Close()
+
+	var consents []*models.Consent
+	for rows.Next() {
+		consent := &models.Consent{}
+
+		err := rows.Scan(
+			&consent.ID,
+			&consent.CustomerID,
+			&consent.Purpose,
+			&consent.Channel,
+			&consent.PolicyVersion,
+			&consent.Source,
+			&consent.GrantedBy,
+			&consent.GrantedAt,
+			&consent.RecordedAt,
+			&consent.WithdrawnBy,
+			&consent.WithdrawnAt,
+			&consent.WithdrawalSource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan customer consent: %w", err)
+		}
+
+		consents = append(consents, consent)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customer consents: %w", err)
+	}
+
+	return consents, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/core-banking/services/customer-service/internal/models"
 	"github.com/google/uuid"
@@ -34,14 +35,110 @@ type CustomerRepository interface {
 	// Address operations
 	AddAddress(ctx context.Context, address *models.Address) error
 	UpdateAddress(ctx context.Context, address *models.Address) error
+	EndAddress(ctx context.Context, address *models.Address) error
+	GetAddress(ctx context.Context, id uuid.UUID) (*models.Address, error)
 	GetCustomerAddresses(ctx context.Context, customerID uuid.UUID) ([]*models.Address, error)
+	GetCustomerAddressesAsOf(ctx context.Context, customerID uuid.UUID, at time.Time) ([]*models.Address, error)
 	DeleteAddress(ctx context.Context, id uuid.UUID) error
+	ReassignAddresses(ctx context.Context, ids []uuid.UUID, fromCustomerID, toCustomerID uuid.UUID) error
 
 	// Document operations
 	AddDocument(ctx context.Context, doc *models.CustomerDocument) error
 	UpdateDocument(ctx context.Context, doc *models.CustomerDocument) error
 	GetCustomerDocuments(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerDocument, error)
+	GetDocument(ctx context.Context, id uuid.UUID) (*models.CustomerDocument, error)
 	DeleteDocument(ctx context.Context, id uuid.UUID) error
+	// ReassignDocuments also moves the documents' files
+	ReassignDocuments(ctx context.Context, ids []uuid.UUID, fromCustomerID, toCustomerID uuid.UUID) error
+
+	// Screening operations
+	ListCustomersForScreening(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error)
+	CreateCustomerScreening(ctx context.Context, screening *models.CustomerScreening) error
+	GetLatestCustomerScreening(ctx context.Context, customerID uuid.UUID) (*models.CustomerScreening, error)
+	ListScreeningHits(ctx context.Context, filter models.ScreeningHitFilter) ([]*models.ScreeningHit, error)
+	GetScreeningHit(ctx context.Context, id uuid.UUID) (*models.ScreeningHit, error)
+	UpdateScreeningHit(ctx context.Context, hit *models.ScreeningHit) error
+	RecordSanctionsList(ctx context.Context, list *models.SanctionsList) error
+	MarkSanctionsListRescreened(ctx context.Context, listName, version string, at time.Time) error
+
+	// Risk operations
+	ListCustomersDueRiskAssessment(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error)
+	CreateRiskAssessment(ctx context.Context, assessment *models.CustomerRiskAssessment) error
+	GetLatestRiskAssessment(ctx context.Context, customerID uuid.UUID) (*models.CustomerRiskAssessment, error)
+	ListRiskAssessments(ctx context.Context, customerID uuid.UUID, limit int) ([]*models.CustomerRiskAssessment, error)
+	ListRiskAssessmentsDueReview(ctx context.Context, before time.Time, afterCustomerID uuid.UUID, limit int) ([]*models.CustomerRiskAssessment, error)
+	CreateReviewTask(ctx context.Context, task *models.ReviewTask) error
+	GetReviewTask(ctx context.Context, id uuid.UUID) (*models.ReviewTask, error)
+	ListReviewTasks(ctx context.Context, filter models.ReviewTaskFilter) ([]*models.ReviewTask, error)
+	UpdateReviewTask(ctx context.Context, task *models.ReviewTask) error
+
+	// Document expiry operations
+	ListDocumentsExpiringBefore(ctx context.Context, before time.Time, afterID uuid.UUID, limit int) ([]*models.CustomerDocument, error)
+	ListCustomersWithExpiredIdentity(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error)
+	CreateDocumentExpiryNotice(ctx context.Context, notice *models.DocumentExpiryNotice) error
+	ListDocumentExpiryNotices(ctx context.Context, documentID uuid.UUID) ([]*models.DocumentExpiryNotice, error)
+
+	// Document file operations
+	CreateDocumentFile(ctx context.Context, file *models.DocumentFile) error
+	GetDocumentFile(ctx context.Context, id uuid.UUID) (*models.DocumentFile, error)
+	ListDocumentFiles(ctx context.Context, documentID uuid.UUID) ([]*models.DocumentFile, error)
+	RecordDocumentFileAccess(ctx context.Context, access *models.DocumentFileAccess) error
+	ListDocumentFileAccess(ctx context.Context, customerID uuid.UUID) ([]*models.DocumentFileAccess, error)
+	DeleteDocumentFile(ctx context.Context, id uuid.UUID) error
+
+	// Retention operations
+	RecordCustomerClosure(ctx context.Context, customerID uuid.UUID, closedAt time.Time) error
+	ClearCustomerClosure(ctx context.Context, customerID uuid.UUID) error
+	GetCustomerRetention(ctx context.Context, customerID uuid.UUID) (*models.CustomerRetention, error)
+	ListCustomersDueErasure(ctx context.Context, closedBefore time.Time, afterID uuid.UUID, limit int) ([]*models.CustomerRetention, error)
+	MarkCustomerPseudonymized(ctx context.Context, customerID uuid.UUID, at time.Time) error
+	RedactScreeningHits(ctx context.Context, customerID uuid.UUID, subject string) error
+	CreateLegalHold(ctx context.Context, hold *models.LegalHold) error
+	GetLegalHold(ctx context.Context, id uuid.UUID) (*models.LegalHold, error)
+	ListLegalHolds(ctx context.Context, customerID uuid.UUID) ([]*models.LegalHold, error)
+	UpdateLegalHold(ctx context.Context, hold *models.LegalHold) error
+	CreateErasureRequest(ctx context.Context, req *models.ErasureRequest) error
+	ListErasureRequests(ctx context.Context, customerID uuid.UUID) ([]*models.ErasureRequest, error)
+
+	// Status history operations
+	CreateStatusChange(ctx context.Context, change *models.StatusChange) error
+	ListStatusChanges(ctx context.Context, customerID uuid.UUID) ([]*models.StatusChange, error)
+
+	// Data export operations
+	CreateDataExport(ctx context.Context, export *models.DataExport) error
+	GetDataExport(ctx context.Context, id uuid.UUID) (*models.DataExport, error)
+	UpdateDataExport(ctx context.Context, export *models.DataExport) error
+	ListPendingDataExports(ctx context.Context, limit int) ([]*models.DataExport, error)
+	ListDataExports(ctx context.Context, customerID uuid.UUID) ([]*models.DataExport, error)
+
+	// Merge operations
+	CreateCustomerMerge(ctx context.Context, merge *models.CustomerMerge) error
+	GetCustomerMerge(ctx context.Context, id uuid.UUID) (*models.CustomerMerge, error)
+	GetStandingMerge(ctx context.Context, mergedID uuid.UUID) (*models.CustomerMerge, error)
+	ListCustomerMerges(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerMerge, error)
+	UpdateCustomerMerge(ctx context.Context, merge *models.CustomerMerge) error
+
+	// Customer event operations
+	CreateCustomerEvent(ctx context.Context, event *models.CustomerEvent) error
+	ListCustomerEvents(ctx context.Context, afterSequence int64, limit int) ([]*models.CustomerEvent, error)
+
+	// Duplicate matching operations
+	ReplaceCustomerMatchKeys(ctx context.Context, customerID uuid.UUID, keys []string) error
+	FindDuplicateCandidates(ctx context.Context, keys []string, excludeID uuid.UUID, limit int) ([]*models.DuplicateCandidate, error)
+	ListCustomersWithoutMatchKeys(ctx context.Context, afterID uuid.UUID, limit int) ([]uuid.UUID, error)
+	CreateDuplicateOverride(ctx context.Context, override *models.DuplicateOverride) error
+
+	// Relationship operations
+	CreateCustomerRelationship(ctx context.Context, rel *models.CustomerRelationship) error
+	GetCustomerRelationship(ctx context.Context, id uuid.UUID) (*models.CustomerRelationship, error)
+	ListCustomerRelationships(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerRelationship, error)
+	UpdateCustomerRelationship(ctx context.Context, rel *models.CustomerRelationship) error
+
+	// Consent operations
+	CreateCustomerConsent(ctx context.Context, consent *models.Consent) error
+	GetCustomerConsent(ctx context.Context, id uuid.UUID) (*models.Consent, error)
+	ListCustomerConsents(ctx context.Context, customerID uuid.UUID) ([]*models.Consent, error)
+	UpdateCustomerConsent(ctx context.Context, consent *models.Consent) error
 
 	// Transaction management
 	BeginTx(ctx context.Context) (Tx, error)
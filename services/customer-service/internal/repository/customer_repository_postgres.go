@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -34,6 +35,112 @@ func NewCustomerRepository(db *sql.DB, encryptor *encryption.Encryptor) Customer
 	}
 }
 
+// customerColumns are the customer columns scanCustomer reads, for queries
+// that alias customers as c
+const customerColumns = `c.id, c.customer_number, c.customer_type, c.first_name, c.middle_name, c.last_name,
+			c.date_of_birth, c.tax_id, c.email, c.phone, c.phone_input, c.phone_type, c.status,
+			c.registered_name, c.registration_number, c.jurisdiction, c.lei,
+			c.incorporation_date, c.industry_code,
+			c.created_at, c.updated_at, c.created_by, c.updated_by, c.version`
+
+// rowScanner is a *sql.Row or *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanCustomer reads a customer selected with customerColumns, followed by
+// any extra columns into extra
+func (r *pgCustomerRepository) scanCustomer(row rowScanner, extra ...interface{}) (*models.Customer, error) {
+	customer := &models.Customer{}
+	var encryptedTaxID string
+	var dateOfBirth, incorporationDate sql.NullTime
+	var registeredName, registrationNumber, jurisdiction, lei, industryCode sql.NullString
+	var updatedBy sql.NullString
+
+	dest := []interface{}{
+		&customer.ID,
+		&customer.CustomerNumber,
+		&customer.Type,
+		&customer.FirstName,
+		&customer.MiddleName,
+		&customer.LastName,
+		&dateOfBirth,
+		&encryptedTaxID,
+		&customer.Email,
+		&customer.Phone,
+		&customer.PhoneInput,
+		&customer.PhoneType,
+		&customer.Status,
+		&registeredName,
+		&registrationNumber,
+		&jurisdiction,
+		&lei,
+		&incorporationDate,
+		&industryCode,
+		&customer.CreatedAt,
+		&customer.UpdatedAt,
+		&customer.CreatedBy,
+		&updatedBy,
+		&customer.Version,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, fmt.Errorf("failed to scan customer: %w", err)
+	}
+
+	customer.DateOfBirth = dateOfBirth.Time
+	if customer.IsBusiness() {
+		customer.Business = &models.BusinessDetails{
+			RegisteredName:     registeredName.String,
+			RegistrationNumber: registrationNumber.String,
+			Jurisdiction:       jurisdiction.String,
+			LEI:                lei.String,
+			IncorporationDate:  incorporationDate.Time,
+			IndustryCode:       industryCode.String,
+		}
+	}
+
+	if encryptedTaxID != "" {
+		decrypted, err := r.encryptor.Decrypt(encryptedTaxID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt tax id: %w", err)
+		}
+		customer.TaxID = decrypted
+	}
+
+	if updatedBy.Valid {
+		updatedByUUID := uuid.MustParse(updatedBy.String)
+		customer.UpdatedBy = &updatedByUUID
+	}
+
+	return customer, nil
+}
+
+// dateOfBirthColumn is a customer's date of birth as stored, which is NULL
+// for businesses
+func dateOfBirthColumn(c *models.Customer) interface{} {
+	if c.IsBusiness() {
+		return nil
+	}
+	return c.DateOfBirth
+}
+
+// businessColumns are a customer's registered name, registration number,
+// jurisdiction, LEI, incorporation date and industry code as stored, which
+// are NULL for individuals. The LEI and industry code are NULL when not known.
+func businessColumns(c *models.Customer) [6]interface{} {
+	b := c.Business
+	if b == nil {
+		return [6]interface{}{}
+	}
+	optional := func(s string) interface{} {
+		if s == "" {
+			return nil
+		}
+		return s
+	}
+	return [6]interface{}{b.RegisteredName, b.RegistrationNumber, b.Jurisdiction, optional(b.LEI), b.IncorporationDate, optional(b.IndustryCode)}
+}
+
 // Customer operations
 
 func (r *pgCustomerRepository) CreateCustomer(ctx context.Context, customer *models.Customer) error {
@@ -43,6 +150,9 @@ func (r *pgCustomerRepository) CreateCustomer(ctx context.Context, customer *mod
 	customer.CreatedAt = time.Now().UTC()
 	customer.UpdatedAt = time.Now().UTC()
 	customer.Version = 1
+	if customer.Type == "" {
+		customer.Type = models.CustomerTypeIndividual
+	}
 
 	encryptedTaxID, err := r.encryptor.Encrypt(customer.TaxID)
 	if err != nil {
@@ -51,25 +161,38 @@ func (r *pgCustomerRepository) CreateCustomer(ctx context.Context, customer *mod
 
 	query := `
 		INSERT INTO customers (
-			id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
+			id, customer_number, customer_type, first_name, middle_name, last_name,
+			date_of_birth, tax_id, email, phone, phone_input, phone_type, status,
+			registered_name, registration_number, jurisdiction, lei,
+			incorporation_date, industry_code,
 			created_at, updated_at, created_by, version
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15,
+			$16, $17, $18, $19, $20, $21, $22, $23
 		)
 	`
 
+	business := businessColumns(customer)
 	_, err = r.db.ExecContext(ctx, query,
 		customer.ID,
 		customer.CustomerNumber,
+		customer.Type,
 		customer.FirstName,
 		customer.MiddleName,
 		customer.LastName,
-		customer.DateOfBirth,
+		dateOfBirthColumn(customer),
 		encryptedTaxID,
 		customer.Email,
 		customer.Phone,
+		customer.PhoneInput,
+		customer.PhoneType,
 		customer.Status,
+		business[0],
+		business[1],
+		business[2],
+		business[3],
+		business[4],
+		business[5],
 		customer.CreatedAt,
 		customer.UpdatedAt,
 		customer.CreatedBy,
@@ -85,53 +208,17 @@ func (r *pgCustomerRepository) CreateCustomer(ctx context.Context, customer *mod
 
 func (r *pgCustomerRepository) GetCustomerByID(ctx context.Context, id uuid.UUID) (*models.Customer, error) {
 	query := `
-		SELECT id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
-			created_at, updated_at, created_by, updated_by, version
-		FROM customers
+		SELECT ` + customerColumns + `
+		FROM customers c
 		WHERE id = $1
 	`
 
-	customer := &models.Customer{}
-	var encryptedTaxID string
-	var updatedBy sql.NullString
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&customer.ID,
-		&customer.CustomerNumber,
-		&customer.FirstName,
-		&customer.MiddleName,
-		&customer.LastName,
-		&customer.DateOfBirth,
-		&encryptedTaxID,
-		&customer.Email,
-		&customer.Phone,
-		&customer.Status,
-		&customer.CreatedAt,
-		&customer.UpdatedAt,
-		&customer.CreatedBy,
-		&updatedBy,
-		&customer.Version,
-	)
-
-	if err == sql.ErrNoRows {
+	customer, err := r.scanCustomer(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrNotFound
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get customer: %w", err)
-	}
-
-	if encryptedTaxID != "" {
-		decrypted, err := r.encryptor.Decrypt(encryptedTaxID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decrypt tax id: %w", err)
-		}
-		customer.TaxID = decrypted
-	}
-
-	if updatedBy.Valid {
-		updatedByUUID := uuid.MustParse(updatedBy.String)
-		customer.UpdatedBy = &updatedByUUID
+		return nil, err
 	}
 
 	return customer, nil
@@ -139,53 +226,17 @@ func (r *pgCustomerRepository) GetCustomerByID(ctx context.Context, id uuid.UUID
 
 func (r *pgCustomerRepository) GetCustomerByNumber(ctx context.Context, customerNumber string) (*models.Customer, error) {
 	query := `
-		SELECT id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
-			created_at, updated_at, created_by, updated_by, version
-		FROM customers
+		SELECT ` + customerColumns + `
+		FROM customers c
 		WHERE customer_number = $1
 	`
 
-	customer := &models.Customer{}
-	var encryptedTaxID string
-	var updatedBy sql.NullString
-
-	err := r.db.QueryRowContext(ctx, query, customerNumber).Scan(
-		&customer.ID,
-		&customer.CustomerNumber,
-		&customer.FirstName,
-		&customer.MiddleName,
-		&customer.LastName,
-		&customer.DateOfBirth,
-		&encryptedTaxID,
-		&customer.Email,
-		&customer.Phone,
-		&customer.Status,
-		&customer.CreatedAt,
-		&customer.UpdatedAt,
-		&customer.CreatedBy,
-		&updatedBy,
-		&customer.Version,
-	)
-
-	if err == sql.ErrNoRows {
+	customer, err := r.scanCustomer(r.db.QueryRowContext(ctx, query, customerNumber))
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrNotFound
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get customer: %w", err)
-	}
-
-	if encryptedTaxID != "" {
-		decrypted, err := r.encryptor.Decrypt(encryptedTaxID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decrypt tax id: %w", err)
-		}
-		customer.TaxID = decrypted
-	}
-
-	if updatedBy.Valid {
-		updatedByUUID := uuid.MustParse(updatedBy.String)
-		customer.UpdatedBy = &updatedByUUID
+		return nil, err
 	}
 
 	return customer, nil
@@ -210,24 +261,41 @@ func (r *pgCustomerRepository) UpdateCustomer(ctx context.Context, customer *mod
 			tax_id = $7,
 			email = $8,
 			phone = $9,
-			status = $10,
-			updated_at = $11,
-			updated_by = $12,
-			version = $13
-		WHERE id = $1 AND version = $14
+			phone_input = $10,
+			phone_type = $11,
+			status = $12,
+			registered_name = $13,
+			registration_number = $14,
+			jurisdiction = $15,
+			lei = $16,
+			incorporation_date = $17,
+			industry_code = $18,
+			updated_at = $19,
+			updated_by = $20,
+			version = $21
+		WHERE id = $1 AND version = $22
 	`
 
+	business := businessColumns(customer)
 	result, err := r.db.ExecContext(ctx, query,
 		customer.ID,
 		customer.CustomerNumber,
 		customer.FirstName,
 		customer.MiddleName,
 		customer.LastName,
-		customer.DateOfBirth,
+		dateOfBirthColumn(customer),
 		encryptedTaxID,
 		customer.Email,
 		customer.Phone,
+		customer.PhoneInput,
+		customer.PhoneType,
 		customer.Status,
+		business[0],
+		business[1],
+		business[2],
+		business[3],
+		business[4],
+		business[5],
 		customer.UpdatedAt,
 		customer.UpdatedBy,
 		customer.Version,
@@ -252,6 +320,8 @@ func (r *pgCustomerRepository) UpdateCustomer(ctx context.Context, customer *mod
 	return nil
 }
 
+// DeleteCustomer removes a customer with no other records; customers with
+// records are retained and pseudonymized instead
 func (r *pgCustomerRepository) DeleteCustomer(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM customers WHERE id = $1`
 
@@ -295,8 +365,14 @@ func (r *pgCustomerRepository) SearchCustomers(ctx context.Context, filters mode
 		argIdx++
 	}
 
-	if filters.Phone != "" {
-		conditions = append(conditions, fmt.Sprintf("phone ILIKE $%d", argIdx))
+	// Stored numbers are in E.164 form, so a whole number is matched exactly
+	// and a part of one by its digits
+	if strings.HasPrefix(filters.Phone, "+") {
+		conditions = append(conditions, fmt.Sprintf("phone = $%d", argIdx))
+		args = append(args, filters.Phone)
+		argIdx++
+	} else if filters.Phone != "" {
+		conditions = append(conditions, fmt.Sprintf("phone LIKE $%d", argIdx))
 		args = append(args, "%"+filters.Phone+"%")
 		argIdx++
 	}
@@ -334,10 +410,8 @@ func (r *pgCustomerRepository) SearchCustomers(ctx context.Context, filters mode
 	}
 
 	query := fmt.Sprintf(`
-		SELECT id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
-			created_at, updated_at, created_by, updated_by, version
-		FROM customers
+		SELECT `+customerColumns+`
+		FROM customers c
 		%s
 		ORDER BY created_at DESC
 		LIMIT %d OFFSET %d
@@ -351,43 +425,9 @@ func (r *pgCustomerRepository) SearchCustomers(ctx context.Context, filters mode
 
 	var customers []*models.Customer
 	for rows.Next() {
-		customer := &models.Customer{}
-		var encryptedTaxID string
-		var updatedBy sql.NullString
-
-		err := rows.Scan(
-			&customer.ID,
-			&customer.CustomerNumber,
-			&customer.FirstName,
-			&customer.MiddleName,
-			&customer.LastName,
-			&customer.DateOfBirth,
-			&encryptedTaxID,
-			&customer.Email,
-			&customer.Phone,
-			&customer.Status,
-			&customer.CreatedAt,
-			&customer.UpdatedAt,
-			&customer.CreatedBy,
-			&updatedBy,
-			&customer.Version,
-		)
-
+		customer, err := r.scanCustomer(rows)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan customer: %w", err)
-		}
-
-		if encryptedTaxID != "" {
-			decrypted, err := r.encryptor.Decrypt(encryptedTaxID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decrypt tax id: %w", err)
-			}
-			customer.TaxID = decrypted
-		}
-
-		if updatedBy.Valid {
-			updatedByUUID := uuid.MustParse(updatedBy.String)
-			customer.UpdatedBy = &updatedByUUID
+			return nil, err
 		}
 
 		customers = append(customers, customer)
@@ -413,9 +453,9 @@ func (r *pgCustomerRepository) AddAddress(ctx context.Context, address *models.A
 		INSERT INTO addresses (
 			id, customer_id, address_type, street1, street2,
 			city, state, postal_code, country, is_primary,
-			valid_from, valid_to, created_at, updated_at
+			valid_from, valid_to, supersedes_id, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 		)
 	`
 
@@ -432,6 +472,7 @@ func (r *pgCustomerRepository) AddAddress(ctx context.Context, address *models.A
 		address.IsPrimary,
 		address.ValidFrom,
 		address.ValidTo,
+		address.SupersedesID,
 		address.CreatedAt,
 		address.UpdatedAt,
 	)
@@ -484,17 +525,71 @@ func (r *pgCustomerRepository) UpdateAddress(ctx context.Context, address *model
 	return nil
 }
 
+// EndAddress closes an address version at its ValidTo. It returns
+// ErrNotFound if the version had already ended by then.
+func (r *pgCustomerRepository) EndAddress(ctx context.Context, address *models.Address) error {
+	address.UpdatedAt = time.Now().UTC()
+
+	query := `
+		UPDATE addresses SET
+			valid_to = $2,
+			updated_at = $3
+		WHERE id = $1 AND (valid_to IS NULL OR valid_to > $2)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, address.ID, address.ValidTo, address.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to end address: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetAddress(ctx context.Context, id uuid.UUID) (*models.Address, error) {
+	addresses, err := r.queryAddresses(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(addresses) == 0 {
+		return nil, ErrNotFound
+	}
+	return addresses[0], nil
+}
+
+// GetCustomerAddresses lists every version of a customer's addresses,
+// ended ones included
 func (r *pgCustomerRepository) GetCustomerAddresses(ctx context.Context, customerID uuid.UUID) ([]*models.Address, error) {
+	return r.queryAddresses(ctx, "WHERE customer_id = $1 ORDER BY is_primary DESC, created_at DESC", customerID)
+}
+
+// GetCustomerAddressesAsOf lists the versions of a customer's addresses that
+// were in effect at at
+func (r *pgCustomerRepository) GetCustomerAddressesAsOf(ctx context.Context, customerID uuid.UUID, at time.Time) ([]*models.Address, error) {
+	return r.queryAddresses(ctx, `
+		WHERE customer_id = $1 AND valid_from <= $2 AND (valid_to IS NULL OR valid_to > $2)
+		ORDER BY is_primary DESC, valid_from DESC
+	`, customerID, at)
+}
+
+// queryAddresses selects addresses with the given WHERE and ORDER BY clauses
+func (r *pgCustomerRepository) queryAddresses(ctx context.Context, clauses string, args ...interface{}) ([]*models.Address, error) {
 	query := `
 		SELECT id, customer_id, address_type, street1, street2,
 			city, state, postal_code, country, is_primary,
-			valid_from, valid_to, created_at, updated_at
+			valid_from, valid_to, supersedes_id, created_at, updated_at
 		FROM addresses
-		WHERE customer_id = $1
-		ORDER BY is_primary DESC, created_at DESC
-	`
+	` + clauses
 
-	rows, err := r.db.QueryContext(ctx, query, customerID)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get addresses: %w", err)
 	}
@@ -505,6 +600,7 @@ func (r *pgCustomerRepository) GetCustomerAddresses(ctx context.Context, custome
 		address := &models.Address{}
 		var street2 sql.NullString
 		var validTo sql.NullTime
+		var supersedesID sql.NullString
 
 		err := rows.Scan(
 			&address.ID,
@@ -519,6 +615,7 @@ func (r *pgCustomerRepository) GetCustomerAddresses(ctx context.Context, custome
 			&address.IsPrimary,
 			&address.ValidFrom,
 			&validTo,
+			&supersedesID,
 			&address.CreatedAt,
 			&address.UpdatedAt,
 		)
@@ -535,6 +632,10 @@ func (r *pgCustomerRepository) GetCustomerAddresses(ctx context.Context, custome
 			validToTime := validTo.Time
 			address.ValidTo = &validToTime
 		}
+		if supersedesID.Valid {
+			supersedesUUID := uuid.MustParse(supersedesID.String)
+			address.SupersedesID = &supersedesUUID
+		}
 
 		addresses = append(addresses, address)
 	}
@@ -667,7 +768,30 @@ func (r *pgCustomerRepository) GetCustomerDocuments(ctx context.Context, custome
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, customerID)
+	return r.queryDocuments(ctx, query, customerID)
+}
+
+func (r *pgCustomerRepository) GetDocument(ctx context.Context, id uuid.UUID) (*models.CustomerDocument, error) {
+	query := `
+		SELECT id, customer_id, document_type, document_number,
+			issuing_authority, issuing_country, issue_date, expiry_date,
+			verification_status, verified_at, verified_by, created_at, updated_at
+		FROM customer_documents
+		WHERE id = $1
+	`
+
+	documents, err := r.queryDocuments(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(documents) == 0 {
+		return nil, ErrNotFound
+	}
+	return documents[0], nil
+}
+
+func (r *pgCustomerRepository) queryDocuments(ctx context.Context, query string, args ...interface{}) ([]*models.CustomerDocument, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get documents: %w", err)
 	}
@@ -750,7 +874,11 @@ func (r *pgCustomerRepository) DeleteDocument(ctx context.Context, id uuid.UUID)
 // Transaction management
 
 func (r *pgCustomerRepository) BeginTx(ctx context.Context) (Tx, error) {
-	tx, err := r.db.(*sql.DB).BeginTx(ctx, nil)
+	db, ok := r.db.(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("nested transactions not supported")
+	}
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -771,732 +899,16 @@ func (t *pgTx) Commit(ctx context.Context) error {
 }
 
 func (t *pgTx) Rollback(ctx context.Context) error {
-	if err := t.tx.Rollback(); err != nil {
+	if err := t.tx.Rollback(); err != nil && err != sql.ErrTxDone {
 		return fmt.Errorf("failed to rollback transaction: %w", err)
 	}
 	return nil
 }
 
+// CustomerRepository runs every operation in the transaction
 func (t *pgTx) CustomerRepository() CustomerRepository {
-	return &txCustomerRepository{
-		tx:        t.tx,
+	return &pgCustomerRepository{
+		db:        t.tx,
 		encryptor: t.encryptor,
 	}
 }
-
-// txCustomerRepository wraps a transaction for CustomerRepository
-type txCustomerRepository struct {
-	tx        *sql.Tx
-	encryptor *encryption.Encryptor
-}
-
-func (r *txCustomerRepository) CreateCustomer(ctx context.Context, customer *models.Customer) error {
-	if customer.ID == uuid.Nil {
-		customer.ID = uuid.New()
-	}
-	customer.CreatedAt = time.Now().UTC()
-	customer.UpdatedAt = time.Now().UTC()
-	customer.Version = 1
-
-	encryptedTaxID, err := r.encryptor.Encrypt(customer.TaxID)
-	if err != nil {
-		return fmt.Errorf("failed to encrypt tax id: %w", err)
-	}
-
-	query := `
-		INSERT INTO customers (
-			id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
-			created_at, updated_at, created_by, version
-		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
-		)
-	`
-
-	_, err = r.tx.ExecContext(ctx, query,
-		customer.ID,
-		customer.CustomerNumber,
-		customer.FirstName,
-		customer.MiddleName,
-		customer.LastName,
-		customer.DateOfBirth,
-		encryptedTaxID,
-		customer.Email,
-		customer.Phone,
-		customer.Status,
-		customer.CreatedAt,
-		customer.UpdatedAt,
-		customer.CreatedBy,
-		customer.Version,
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to create customer: %w", err)
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) GetCustomerByID(ctx context.Context, id uuid.UUID) (*models.Customer, error) {
-	query := `
-		SELECT id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
-			created_at, updated_at, created_by, updated_by, version
-		FROM customers
-		WHERE id = $1
-	`
-
-	customer := &models.Customer{}
-	var encryptedTaxID string
-	var updatedBy sql.NullString
-
-	err := r.tx.QueryRowContext(ctx, query, id).Scan(
-		&customer.ID,
-		&customer.CustomerNumber,
-		&customer.FirstName,
-		&customer.MiddleName,
-		&customer.LastName,
-		&customer.DateOfBirth,
-		&encryptedTaxID,
-		&customer.Email,
-		&customer.Phone,
-		&customer.Status,
-		&customer.CreatedAt,
-		&customer.UpdatedAt,
-		&customer.CreatedBy,
-		&updatedBy,
-		&customer.Version,
-	)
-
-	if err == sql.ErrNoRows {
-		return nil, ErrNotFound
-	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to get customer: %w", err)
-	}
-
-	if encryptedTaxID != "" {
-		decrypted, err := r.encryptor.Decrypt(encryptedTaxID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decrypt tax id: %w", err)
-		}
-		customer.TaxID = decrypted
-	}
-
-	if updatedBy.Valid {
-		updatedByUUID := uuid.MustParse(updatedBy.String)
-		customer.UpdatedBy = &updatedByUUID
-	}
-
-	return customer, nil
-}
-
-func (r *txCustomerRepository) GetCustomerByNumber(ctx context.Context, customerNumber string) (*models.Customer, error) {
-	query := `
-		SELECT id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
-			created_at, updated_at, created_by, updated_by, version
-		FROM customers
-		WHERE customer_number = $1
-	`
-
-	customer := &models.Customer{}
-	var encryptedTaxID string
-	var updatedBy sql.NullString
-
-	err := r.tx.QueryRowContext(ctx, query, customerNumber).Scan(
-		&customer.ID,
-		&customer.CustomerNumber,
-		&customer.FirstName,
-		&customer.MiddleName,
-		&customer.LastName,
-		&customer.DateOfBirth,
-		&encryptedTaxID,
-		&customer.Email,
-		&customer.Phone,
-		&customer.Status,
-		&customer.CreatedAt,
-		&customer.UpdatedAt,
-		&customer.CreatedBy,
-		&updatedBy,
-		&customer.Version,
-	)
-
-	if err == sql.ErrNoRows {
-		return nil, ErrNotFound
-	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to get customer: %w", err)
-	}
-
-	if encryptedTaxID != "" {
-		decrypted, err := r.encryptor.Decrypt(encryptedTaxID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decrypt tax id: %w", err)
-		}
-		customer.TaxID = decrypted
-	}
-
-	if updatedBy.Valid {
-		updatedByUUID := uuid.MustParse(updatedBy.String)
-		customer.UpdatedBy = &updatedByUUID
-	}
-
-	return customer, nil
-}
-
-func (r *txCustomerRepository) UpdateCustomer(ctx context.Context, customer *models.Customer) error {
-	customer.UpdatedAt = time.Now().UTC()
-	customer.Version++
-
-	encryptedTaxID, err := r.encryptor.Encrypt(customer.TaxID)
-	if err != nil {
-		return fmt.Errorf("failed to encrypt tax id: %w", err)
-	}
-
-	query := `
-		UPDATE customers SET
-			customer_number = $2,
-			first_name = $3,
-			middle_name = $4,
-			last_name = $5,
-			date_of_birth = $6,
-			tax_id = $7,
-			email = $8,
-			phone = $9,
-			status = $10,
-			updated_at = $11,
-			updated_by = $12,
-			version = $13
-		WHERE id = $1 AND version = $14
-	`
-
-	result, err := r.tx.ExecContext(ctx, query,
-		customer.ID,
-		customer.CustomerNumber,
-		customer.FirstName,
-		customer.MiddleName,
-		customer.LastName,
-		customer.DateOfBirth,
-		encryptedTaxID,
-		customer.Email,
-		customer.Phone,
-		customer.Status,
-		customer.UpdatedAt,
-		customer.UpdatedBy,
-		customer.Version,
-		customer.Version-1,
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to update customer: %w", err)
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return &ErrOptimisticLock{
-			CustomerID: customer.ID,
-		}
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) DeleteCustomer(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM customers WHERE id = $1`
-
-	result, err := r.tx.ExecContext(ctx, query, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete customer: %w", err)
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return ErrNotFound
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) SearchCustomers(ctx context.Context, filters models.SearchFilters) ([]*models.Customer, error) {
-	var conditions []string
-	var args []interface{}
-	argIdx := 1
-
-	if filters.FirstName != "" {
-		conditions = append(conditions, fmt.Sprintf("first_name ILIKE $%d", argIdx))
-		args = append(args, "%"+filters.FirstName+"%")
-		argIdx++
-	}
-
-	if filters.LastName != "" {
-		conditions = append(conditions, fmt.Sprintf("last_name ILIKE $%d", argIdx))
-		args = append(args, "%"+filters.LastName+"%")
-		argIdx++
-	}
-
-	if filters.Email != "" {
-		conditions = append(conditions, fmt.Sprintf("email ILIKE $%d", argIdx))
-		args = append(args, "%"+filters.Email+"%")
-		argIdx++
-	}
-
-	if filters.Phone != "" {
-		conditions = append(conditions, fmt.Sprintf("phone ILIKE $%d", argIdx))
-		args = append(args, "%"+filters.Phone+"%")
-		argIdx++
-	}
-
-	if filters.Status != "" {
-		conditions = append(conditions, fmt.Sprintf("status = $%d", argIdx))
-		args = append(args, filters.Status)
-		argIdx++
-	}
-
-	if filters.FromDate != nil {
-		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", argIdx))
-		args = append(args, *filters.FromDate)
-		argIdx++
-	}
-
-	if filters.ToDate != nil {
-		conditions = append(conditions, fmt.Sprintf("created_at <= $%d", argIdx))
-		args = append(args, *filters.ToDate)
-		argIdx++
-	}
-
-	whereClause := ""
-	if len(conditions) > 0 {
-		whereClause = "WHERE " + strings.Join(conditions, " AND ")
-	}
-
-	limit := 50
-	offset := 0
-	if filters.Limit > 0 {
-		limit = filters.Limit
-	}
-	if filters.Offset > 0 {
-		offset = filters.Offset
-	}
-
-	query := fmt.Sprintf(`
-		SELECT id, customer_number, first_name, middle_name, last_name,
-			date_of_birth, tax_id, email, phone, status,
-			created_at, updated_at, created_by, updated_by, version
-		FROM customers
-		%s
-		ORDER BY created_at DESC
-		LIMIT %d OFFSET %d
-	`, whereClause, limit, offset)
-
-	rows, err := r.tx.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search customers: %w", err)
-	}
-	defer rows.Close()
-
-	var customers []*models.Customer
-	for rows.Next() {
-		customer := &models.Customer{}
-		var encryptedTaxID string
-		var updatedBy sql.NullString
-
-		err := rows.Scan(
-			&customer.ID,
-			&customer.CustomerNumber,
-			&customer.FirstName,
-			&customer.MiddleName,
-			&customer.LastName,
-			&customer.DateOfBirth,
-			&encryptedTaxID,
-			&customer.Email,
-			&customer.Phone,
-			&customer.Status,
-			&customer.CreatedAt,
-			&customer.UpdatedAt,
-			&customer.CreatedBy,
-			&updatedBy,
-			&customer.Version,
-		)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan customer: %w", err)
-		}
-
-		if encryptedTaxID != "" {
-			decrypted, err := r.encryptor.Decrypt(encryptedTaxID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decrypt tax id: %w", err)
-			}
-			customer.TaxID = decrypted
-		}
-
-		if updatedBy.Valid {
-			updatedByUUID := uuid.MustParse(updatedBy.String)
-			customer.UpdatedBy = &updatedByUUID
-		}
-
-		customers = append(customers, customer)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating customers: %w", err)
-	}
-
-	return customers, nil
-}
-
-func (r *txCustomerRepository) AddAddress(ctx context.Context, address *models.Address) error {
-	if address.ID == uuid.Nil {
-		address.ID = uuid.New()
-	}
-	address.CreatedAt = time.Now().UTC()
-	address.UpdatedAt = time.Now().UTC()
-
-	query := `
-		INSERT INTO addresses (
-			id, customer_id, address_type, street1, street2,
-			city, state, postal_code, country, is_primary,
-			valid_from, valid_to, created_at, updated_at
-		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
-		)
-	`
-
-	_, err := r.tx.ExecContext(ctx, query,
-		address.ID,
-		address.CustomerID,
-		address.AddressType,
-		address.Street1,
-		address.Street2,
-		address.City,
-		address.State,
-		address.PostalCode,
-		address.Country,
-		address.IsPrimary,
-		address.ValidFrom,
-		address.ValidTo,
-		address.CreatedAt,
-		address.UpdatedAt,
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to add address: %w", err)
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) UpdateAddress(ctx context.Context, address *models.Address) error {
-	address.UpdatedAt = time.Now().UTC()
-
-	query := `
-		UPDATE addresses SET
-			address_type = $2,
-			street1 = $3,
-			street2 = $4,
-			city = $5,
-			state = $6,
-			postal_code = $7,
-			country = $8,
-			is_primary = $9,
-			valid_from = $10,
-			valid_to = $11,
-			updated_at = $12
-		WHERE id = $1
-	`
-
-	_, err := r.tx.ExecContext(ctx, query,
-		address.ID,
-		address.AddressType,
-		address.Street1,
-		address.Street2,
-		address.City,
-		address.State,
-		address.PostalCode,
-		address.Country,
-		address.IsPrimary,
-		address.ValidFrom,
-		address.ValidTo,
-		address.UpdatedAt,
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to update address: %w", err)
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) GetCustomerAddresses(ctx context.Context, customerID uuid.UUID) ([]*models.Address, error) {
-	query := `
-		SELECT id, customer_id, address_type, street1, street2,
-			city, state, postal_code, country, is_primary,
-			valid_from, valid_to, created_at, updated_at
-		FROM addresses
-		WHERE customer_id = $1
-		ORDER BY is_primary DESC, created_at DESC
-	`
-
-	rows, err := r.tx.QueryContext(ctx, query, customerID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get addresses: %w", err)
-	}
-	defer rows.Close()
-
-	var addresses []*models.Address
-	for rows.Next() {
-		address := &models.Address{}
-		var street2 sql.NullString
-		var validTo sql.NullTime
-
-		err := rows.Scan(
-			&address.ID,
-			&address.CustomerID,
-			&address.AddressType,
-			&address.Street1,
-			&street2,
-			&address.City,
-			&address.State,
-			&address.PostalCode,
-			&address.Country,
-			&address.IsPrimary,
-			&address.ValidFrom,
-			&validTo,
-			&address.CreatedAt,
-			&address.UpdatedAt,
-		)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan address: %w", err)
-		}
-
-		if street2.Valid {
-			street2Str := street2.String
-			address.Street2 = &street2Str
-		}
-		if validTo.Valid {
-			validToTime := validTo.Time
-			address.ValidTo = &validToTime
-		}
-
-		addresses = append(addresses, address)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating addresses: %w", err)
-	}
-
-	return addresses, nil
-}
-
-func (r *txCustomerRepository) DeleteAddress(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM addresses WHERE id = $1`
-
-	result, err := r.tx.ExecContext(ctx, query, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete address: %w", err)
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return ErrNotFound
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) AddDocument(ctx context.Context, doc *models.CustomerDocument) error {
-	if doc.ID == uuid.Nil {
-		doc.ID = uuid.New()
-	}
-	doc.CreatedAt = time.Now().UTC()
-	doc.UpdatedAt = time.Now().UTC()
-
-	encryptedDocNumber, err := r.encryptor.Encrypt(doc.DocumentNumber)
-	if err != nil {
-		return fmt.Errorf("failed to encrypt document number: %w", err)
-	}
-
-	query := `
-		INSERT INTO customer_documents (
-			id, customer_id, document_type, document_number,
-			issuing_authority, issuing_country, issue_date, expiry_date,
-			verification_status, verified_at, verified_by, created_at, updated_at
-		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
-		)
-	`
-
-	_, err = r.tx.ExecContext(ctx, query,
-		doc.ID,
-		doc.CustomerID,
-		doc.DocumentType,
-		encryptedDocNumber,
-		doc.IssuingAuthority,
-		doc.IssuingCountry,
-		doc.IssueDate,
-		doc.ExpiryDate,
-		doc.VerificationStatus,
-		doc.VerifiedAt,
-		doc.VerifiedBy,
-		doc.CreatedAt,
-		doc.UpdatedAt,
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to add document: %w", err)
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) UpdateDocument(ctx context.Context, doc *models.CustomerDocument) error {
-	doc.UpdatedAt = time.Now().UTC()
-
-	encryptedDocNumber, err := r.encryptor.Encrypt(doc.DocumentNumber)
-	if err != nil {
-		return fmt.Errorf("failed to encrypt document number: %w", err)
-	}
-
-	query := `
-		UPDATE customer_documents SET
-			document_type = $2,
-			document_number = $3,
-			issuing_authority = $4,
-			issuing_country = $5,
-			issue_date = $6,
-			expiry_date = $7,
-			verification_status = $8,
-			verified_at = $9,
-			verified_by = $10,
-			updated_at = $11
-		WHERE id = $1
-	`
-
-	_, err = r.tx.ExecContext(ctx, query,
-		doc.ID,
-		doc.DocumentType,
-		encryptedDocNumber,
-		doc.IssuingAuthority,
-		doc.IssuingCountry,
-		doc.IssueDate,
-		doc.ExpiryDate,
-		doc.VerificationStatus,
-		doc.VerifiedAt,
-		doc.VerifiedBy,
-		doc.UpdatedAt,
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to update document: %w", err)
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) GetCustomerDocuments(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerDocument, error) {
-	query := `
-		SELECT id, customer_id, document_type, document_number,
-			issuing_authority, issuing_country, issue_date, expiry_date,
-			verification_status, verified_at, verified_by, created_at, updated_at
-		FROM customer_documents
-		WHERE customer_id = $1
-		ORDER BY created_at DESC
-	`
-
-	rows, err := r.tx.QueryContext(ctx, query, customerID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get documents: %w", err)
-	}
-	defer rows.Close()
-
-	var documents []*models.CustomerDocument
-	for rows.Next() {
-		doc := &models.CustomerDocument{}
-		var encryptedDocNumber string
-		var verifiedAt sql.NullTime
-		var verifiedBy sql.NullString
-
-		err := rows.Scan(
-			&doc.ID,
-			&doc.CustomerID,
-			&doc.DocumentType,
-			&encryptedDocNumber,
-			&doc.IssuingAuthority,
-			&doc.IssuingCountry,
-			&doc.IssueDate,
-			&doc.ExpiryDate,
-			&doc.VerificationStatus,
-			&verifiedAt,
-			&verifiedBy,
-			&doc.CreatedAt,
-			&doc.UpdatedAt,
-		)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan document: %w", err)
-		}
-
-		if encryptedDocNumber != "" {
-			decrypted, err := r.encryptor.Decrypt(encryptedDocNumber)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decrypt document number: %w", err)
-			}
-			doc.DocumentNumber = decrypted
-		}
-
-		if verifiedAt.Valid {
-			verifiedAtTime := verifiedAt.Time
-			doc.VerifiedAt = &verifiedAtTime
-		}
-		if verifiedBy.Valid {
-			verifiedByUUID := uuid.MustParse(verifiedBy.String)
-			doc.VerifiedBy = &verifiedByUUID
-		}
-
-		documents = append(documents, doc)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating documents: %w", err)
-	}
-
-	return documents, nil
-}
-
-func (r *txCustomerRepository) DeleteDocument(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM customer_documents WHERE id = $1`
-
-	result, err := r.tx.ExecContext(ctx, query, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete document: %w", err)
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return ErrNotFound
-	}
-
-	return nil
-}
-
-func (r *txCustomerRepository) BeginTx(ctx context.Context) (Tx, error) {
-	return nil, fmt.Errorf("nested transactions not supported")
-}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Status history operations
+
+// CreateStatusChange records a change in a customer's status. A nil
+// ChangedBy is stored as NULL, meaning the service made the change itself.
+func (r *pgCustomerRepository) CreateStatusChange(ctx context.Context, change *models.StatusChange) error {
+	if change.ID == uuid.Nil {
+		change.ID = uuid.New()
+	}
+	if change.ChangedAt.IsZero() {
+		change.ChangedAt = time.Now().UTC()
+	}
+
+	var changedBy *uuid.UUID
+	if change.ChangedBy != uuid.Nil {
+		changedBy = &change.ChangedBy
+	}
+
+	query := `
+		INSERT INTO customer_status_history (
+			id, customer_id, previous_status, new_status, reason, changed_by, changed_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		change.ID,
+		change.CustomerID,
+		change.PreviousStatus,
+		change.NewStatus,
+		change.Reason,
+		changedBy,
+		change.ChangedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create status change: %w", err)
+	}
+
+	return nil
+}
+
+// ListStatusChanges lists a customer's status changes, oldest first
+func (r *pgCustomerRepository) ListStatusChanges(ctx context.Context, customerID uuid.UUID) ([]*models.StatusChange, error) {
+	query := `
+		SELECT id, customer_id, previous_status, new_status, reason, changed_by, changed_at
+		FROM customer_status_history
+		WHERE customer_id = $1
+		ORDER BY changed_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*models.StatusChange
+	for rows.Next() {
+		change := &models.StatusChange{}
+		var changedBy sql.NullString
+
+		err := rows.Scan(
+			&change.ID,
+			&change.CustomerID,
+			&change.PreviousStatus,
+			&change.NewStatus,
+			&change.Reason,
+			&changedBy,
+			&change.ChangedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan status change: %w", err)
+		}
+
+		if changedBy.Valid {
+			change.ChangedBy = uuid.MustParse(changedBy.String)
+		}
+
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating status changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// Data export operations
+
+func (r *pgCustomerRepository) CreateDataExport(ctx context.Context, export *models.DataExport) error {
+	if export.ID == uuid.Nil {
+		export.ID = uuid.New()
+	}
+	if export.RequestedAt.IsZero() {
+		export.RequestedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO data_exports (
+			id, customer_id, requested_by, status, records, sha256,
+			signature, signing_key, error, requested_at, due_at, completed_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		export.ID,
+		export.CustomerID,
+		export.RequestedBy,
+		export.Status,
+		export.Records,
+		export.SHA256,
+		export.Signature,
+		export.SigningKey,
+		export.Error,
+		export.RequestedAt,
+		export.DueAt,
+		export.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetDataExport(ctx context.Context, id uuid.UUID) (*models.DataExport, error) {
+	exports, err := r.queryDataExports(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(exports) == 0 {
+		return nil, ErrNotFound
+	}
+	return exports[0], nil
+}
+
+// UpdateDataExport records the outcome of a pending export. An export that
+// has already finished is not changed and ErrNotFound is returned.
+func (r *pgCustomerRepository) UpdateDataExport(ctx context.Context, export *models.DataExport) error {
+	query := `
+		UPDATE data_exports SET
+			status = $2,
+			records = $3,
+			sha256 = $4,
+			signature = $5,
+			signing_key = $6,
+			error = $7,
+			completed_at = $8
+		WHERE id = $1 AND status = 'Pending'
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		export.ID,
+		export.Status,
+		export.Records,
+		export.SHA256,
+		export.Signature,
+		export.SigningKey,
+		export.Error,
+		export.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update data export: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListPendingDataExports lists exports waiting for their bundle, oldest
+// request first
+func (r *pgCustomerRepository) ListPendingDataExports(ctx context.Context, limit int) ([]*models.DataExport, error) {
+	return r.queryDataExports(ctx, "WHERE status = 'Pending' ORDER BY requested_at, id LIMIT $1", limit)
+}
+
+// ListDataExports lists a customer's data exports, oldest first
+func (r *pgCustomerRepository) ListDataExports(ctx context.Context, customerID uuid.UUID) ([]*models.DataExport, error) {
+	return r.queryDataExports(ctx, "WHERE customer_id = $1 ORDER BY requested_at", customerID)
+}
+
+func (r *pgCustomerRepository) queryDataExports(ctx context.Context, clauses string, args ...interface{}) ([]*models.DataExport, error) {
+	query := `
+		SELECT id, customer_id, requested_by, status, records, sha256,
+			signature, signing_key, error, requested_at, due_at, completed_at
+		FROM data_exports
+	` + clauses
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*models.DataExport
+	for rows.Next() {
+		export := &models.DataExport{}
+		var sha256, signature, signingKey, exportErr sql.NullString
+		var completedAt sql.NullTime
+
+		err := rows.Scan(
+			&export.ID,
+			&export.CustomerID,
+			&export.RequestedBy,
+			&export.Status,
+			&export.Records,
+			&sha256,
+			&signature,
+			&signingKey,
+			&exportErr,
+			&export.RequestedAt,
+			&export.DueAt,
+			&completedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+
+		if sha256.Valid {
+			export.SHA256 = &sha256.String
+		}
+		if signature.Valid {
+			export.Signature = &signature.String
+		}
+		if signingKey.Valid {
+			export.SigningKey = &signingKey.String
+		}
+		if exportErr.Valid {
+			export.Error = &exportErr.String
+		}
+		if completedAt.Valid {
+			completedAtTime := completedAt.Time
+			export.CompletedAt = &completedAtTime
+		}
+
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating data exports: %w", err)
+	}
+
+	return exports, nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Document expiry operations
+
+// ListDocumentsExpiringBefore lists pending and verified documents with an
+// expiry date before before, ordered by id
+func (r *pgCustomerRepository) ListDocumentsExpiringBefore(ctx context.Context, before time.Time, afterID uuid.UUID, limit int) ([]*models.CustomerDocument, error) {
+	query := `
+		SELECT id, customer_id, document_type, document_number,
+			issuing_authority, issuing_country, issue_date, expiry_date,
+			verification_status, verified_at, verified_by, created_at, updated_at
+		FROM customer_documents
+		WHERE verification_status IN ('Pending', 'Verified')
+			AND expiry_date < $1 AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`
+
+	return r.queryDocuments(ctx, query, before, afterID, limit)
+}
+
+// ListCustomersWithExpiredIdentity lists active and inactive customers
+// holding an expired identity document, ordered by id
+func (r *pgCustomerRepository) ListCustomersWithExpiredIdentity(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error) {
+	query := `
+		SELECT ` + customerColumns + `
+		FROM customers c
+		WHERE c.id > $1 AND c.status IN ('Active', 'Inactive')
+			AND EXISTS (
+				SELECT 1 FROM customer_documents d
+				WHERE d.customer_id = c.id
+					AND d.verification_status = 'Expired'
+					AND d.document_type IN ('Passport', 'DriversLicense', 'NationalID', 'SSN')
+			)
+		ORDER BY c.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers with expired identity: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []*models.Customer
+	for rows.Next() {
+		customer, err := r.scanCustomer(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		customers = append(customers, customer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customers: %w", err)
+	}
+
+	return customers, nil
+}
+
+func (r *pgCustomerRepository) CreateDocumentExpiryNotice(ctx context.Context, notice *models.DocumentExpiryNotice) error {
+	if notice.ID == uuid.Nil {
+		notice.ID = uuid.New()
+	}
+	if notice.SentAt.IsZero() {
+		notice.SentAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO document_expiry_notices (
+			id, document_id, customer_id, expiry_date, days_before, sent_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		notice.ID,
+		notice.DocumentID,
+		notice.CustomerID,
+		notice.ExpiryDate,
+		notice.DaysBefore,
+		notice.SentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create document expiry notice: %w", err)
+	}
+
+	return nil
+}
+
+// ListDocumentExpiryNotices lists the notices sent for a document, most
+// recent first
+func (r *pgCustomerRepository) ListDocumentExpiryNotices(ctx context.Context, documentID uuid.UUID) ([]*models.DocumentExpiryNotice, error) {
+	query := `
+		SELECT id, document_id, customer_id, expiry_date, days_before, sent_at
+		FROM document_expiry_notices
+		WHERE document_id = $1
+		ORDER BY sent_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document expiry notices: %w", err)
+	}
+	defer rows.Close()
+
+	var notices []*models.DocumentExpiryNotice
+	for rows.Next() {
+		notice := &models.DocumentExpiryNotice{}
+		err := rows.Scan(
+			&notice.ID,
+			&notice.DocumentID,
+			&notice.CustomerID,
+			&notice.ExpiryDate,
+			&notice.DaysBefore,
+			&notice.SentAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document expiry notice: %w", err)
+		}
+		notices = append(notices, notice)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating document expiry notices: %w", err)
+	}
+
+	return notices, nil
+}
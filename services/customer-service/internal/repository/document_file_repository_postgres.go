@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Document file operations
+
+func (r *pgCustomerRepository) CreateDocumentFile(ctx context.Context, file *models.DocumentFile) error {
+	if file.ID == uuid.Nil {
+		file.ID = uuid.New()
+	}
+	if file.UploadedAt.IsZero() {
+		file.UploadedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO document_files (
+			id, document_id, customer_id, file_name, content_type,
+			size, sha256, storage_key, uploaded_by, uploaded_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		file.ID,
+		file.DocumentID,
+		file.CustomerID,
+		file.FileName,
+		file.ContentType,
+		file.Size,
+		file.SHA256,
+		file.StorageKey,
+		file.UploadedBy,
+		file.UploadedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create document file: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetDocumentFile(ctx context.Context, id uuid.UUID) (*models.DocumentFile, error) {
+	files, err := r.queryDocumentFiles(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, ErrNotFound
+	}
+	return files[0], nil
+}
+
+// ListDocumentFiles lists the files stored for a document in upload order
+func (r *pgCustomerRepository) ListDocumentFiles(ctx context.Context, documentID uuid.UUID) ([]*models.DocumentFile, error) {
+	return r.queryDocumentFiles(ctx, "WHERE document_id = $1 ORDER BY uploaded_at", documentID)
+}
+
+func (r *pgCustomerRepository) queryDocumentFiles(ctx context.Context, clauses string, args ...interface{}) ([]*models.DocumentFile, error) {
+	query := `
+		SELECT id, document_id, customer_id, file_name, content_type,
+			size, sha256, storage_key, uploaded_by, uploaded_at
+		FROM document_files
+	` + clauses
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*models.DocumentFile
+	for rows.Next() {
+		file := &models.DocumentFile{}
+		err := rows.Scan(
+			&file.ID,
+			&file.DocumentID,
+			&file.CustomerID,
+			&file.FileName,
+			&file.ContentType,
+			&file.Size,
+			&file.SHA256,
+			&file.StorageKey,
+			&file.UploadedBy,
+			&file.UploadedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document file: %w", err)
+		}
+		files = append(files, file)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating document files: %w", err)
+	}
+
+	return files, nil
+}
+
+func (r *pgCustomerRepository) RecordDocumentFileAccess(ctx context.Context, access *models.DocumentFileAccess) error {
+	if access.ID == uuid.Nil {
+		access.ID = uuid.New()
+	}
+	if access.AccessedAt.IsZero() {
+		access.AccessedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO document_file_access_log (
+			id, file_id, customer_id, accessed_by, roles, reason,
+			granted, denial_reason, accessed_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		access.ID,
+		access.FileID,
+		access.CustomerID,
+		access.AccessedBy,
+		access.Roles,
+		access.Reason,
+		access.Granted,
+		access.DenialReason,
+		access.AccessedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record document file access: %w", err)
+	}
+
+	return nil
+}
+
+// ListDocumentFileAccess lists every attempt to download a customer's
+// document files, oldest first
+func (r *pgCustomerRepository) ListDocumentFileAccess(ctx context.Context, customerID uuid.UUID) ([]*models.DocumentFileAccess, error) {
+	query := `
+		SELECT id, file_id, customer_id, accessed_by, roles, reason,
+			granted, denial_reason, accessed_at
+		FROM document_file_access_log
+		WHERE customer_id = $1
+		ORDER BY accessed_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document file access: %w", err)
+	}
+	defer rows.Close()
+
+	var accesses []*models.DocumentFileAccess
+	for rows.Next() {
+		access := &models.DocumentFileAccess{}
+		var denialReason sql.NullString
+
+		err := rows.Scan(
+			&access.ID,
+			&access.FileID,
+			&access.CustomerID,
+			&access.AccessedBy,
+			&access.Roles,
+			&access.Reason,
+			&access.Granted,
+			&denialReason,
+			&access.AccessedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document file access: %w", err)
+		}
+
+		if denialReason.Valid {
+			access.DenialReason = &denialReason.String
+		}
+
+		accesses = append(accesses, access)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating document file access: %w", err)
+	}
+
+	return accesses, nil
+}
+
+func (r *pgCustomerRepository) DeleteDocumentFile(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM document_files WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to delete document file: %w", err)
+	}
+
+	return nil
+}
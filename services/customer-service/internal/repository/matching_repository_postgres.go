@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Duplicate matching operations
+
+// ReplaceCustomerMatchKeys files a customer under the given blocking keys in
+// place of any it had
+func (r *pgCustomerRepository) ReplaceCustomerMatchKeys(ctx context.Context, customerID uuid.UUID, keys []string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM customer_match_keys WHERE customer_id = $1`, customerID); err != nil {
+		return fmt.Errorf("failed to delete match keys: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO customer_match_keys (key, customer_id)
+		SELECT DISTINCT unnest($1::text[]), $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(keys), customerID); err != nil {
+		return fmt.Errorf("failed to create match keys: %w", err)
+	}
+
+	return nil
+}
+
+// FindDuplicateCandidates returns up to limit customers, other than
+// excludeID, filed under any of the given blocking keys, those sharing the
+// most keys first. Closed customers are left out.
+func (r *pgCustomerRepository) FindDuplicateCandidates(ctx context.Context, keys []string, excludeID uuid.UUID, limit int) ([]*models.DuplicateCandidate, error) {
+	query := `
+		WITH candidates AS (
+			SELECT k.customer_id, COUNT(*) AS shared_keys
+			FROM customer_match_keys k
+			JOIN customers c ON c.id = k.customer_id
+			WHERE k.key = ANY($1) AND k.customer_id <> $2 AND c.status <> 'Closed'
+			GROUP BY k.customer_id
+			ORDER BY shared_keys DESC, k.customer_id
+			LIMIT $3
+		)
+		SELECT ` + customerColumns + `,
+			a.street1, a.postal_code, a.country, k.shared_keys
+		FROM candidates k
+		JOIN customers c ON c.id = k.customer_id
+		LEFT JOIN addresses a ON a.customer_id = c.id AND a.is_primary
+		ORDER BY k.shared_keys DESC, c.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(keys), excludeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*models.DuplicateCandidate
+	seen := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		candidate := &models.DuplicateCandidate{}
+		var street1, postalCode, country sql.NullString
+
+		customer, err := r.scanCustomer(rows, &street1, &postalCode, &country, &candidate.SharedKeys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate candidate: %w", err)
+		}
+		candidate.Customer = customer
+
+		// A customer with more than one primary address is scored on the
+		// first
+		if seen[customer.ID] {
+			continue
+		}
+		seen[customer.ID] = true
+		candidate.Street1, candidate.PostalCode, candidate.Country = street1.String, postalCode.String, country.String
+
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating duplicate candidates: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// ListCustomersWithoutMatchKeys lists up to limit open customers not yet
+// filed under any blocking key, ordered by ID from after afterID
+func (r *pgCustomerRepository) ListCustomersWithoutMatchKeys(ctx context.Context, afterID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	query := `
+		SELECT c.id FROM customers c
+		WHERE c.id > $1 AND c.status <> 'Closed'
+			AND NOT EXISTS (SELECT 1 FROM customer_match_keys k WHERE k.customer_id = c.id)
+		ORDER BY c.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers without match keys: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan customer id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customer ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *pgCustomerRepository) CreateDuplicateOverride(ctx context.Context, override *models.DuplicateOverride) error {
+	if override.ID == uuid.Nil {
+		override.ID = uuid.New()
+	}
+	if override.CreatedAt.IsZero() {
+		override.CreatedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO duplicate_overrides (
+			id, customer_id, candidate_id, score, rules_version, reason,
+			created_by, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		override.ID,
+		override.CustomerID,
+		override.CandidateID,
+		override.Score,
+		override.RulesVersion,
+		override.Reason,
+		override.CreatedBy,
+		override.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create duplicate override: %w", err)
+	}
+
+	return nil
+}
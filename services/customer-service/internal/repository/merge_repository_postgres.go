@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ReassignAddresses moves the given addresses from one customer to another.
+// Addresses no longer held by the first customer are left alone.
+func (r *pgCustomerRepository) ReassignAddresses(ctx context.Context, ids []uuid.UUID, fromCustomerID, toCustomerID uuid.UUID) error {
+	query := `
+		UPDATE addresses SET customer_id = $3, updated_at = $4
+		WHERE id = ANY($1) AND customer_id = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(ids), fromCustomerID, toCustomerID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to reassign addresses: %w", err)
+	}
+
+	return nil
+}
+
+// ReassignDocuments moves the given documents, with their files, from one
+// customer to another. Documents no longer held by the first customer are
+// left alone.
+func (r *pgCustomerRepository) ReassignDocuments(ctx context.Context, ids []uuid.UUID, fromCustomerID, toCustomerID uuid.UUID) error {
+	query := `
+		UPDATE customer_documents SET customer_id = $3, updated_at = $4
+		WHERE id = ANY($1) AND customer_id = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(ids), fromCustomerID, toCustomerID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to reassign documents: %w", err)
+	}
+
+	query = `
+		UPDATE document_files SET customer_id = $3
+		WHERE document_id = ANY($1) AND customer_id = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(ids), fromCustomerID, toCustomerID); err != nil {
+		return fmt.Errorf("failed to reassign document files: %w", err)
+	}
+
+	return nil
+}
+
+// Merge operations
+
+func (r *pgCustomerRepository) CreateCustomerMerge(ctx context.Context, merge *models.CustomerMerge) error {
+	if merge.ID == uuid.Nil {
+		merge.ID = uuid.New()
+	}
+	if merge.MergedAt.IsZero() {
+		merge.MergedAt = time.Now().UTC()
+	}
+
+	changes, err := r.encryptFieldChanges(merge.FieldChanges)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO customer_merges (
+			id, survivor_id, merged_id, rules_version, reason, field_changes,
+			moved_addresses, demoted_addresses, moved_documents, merged_status,
+			merged_by, merged_at, undo_until
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+		)
+	`
+
+	_, err = r.db.ExecContext(ctx, query,
+		merge.ID,
+		merge.SurvivorID,
+		merge.MergedID,
+		merge.RulesVersion,
+		merge.Reason,
+		changes,
+		pq.Array(merge.MovedAddresses),
+		pq.Array(merge.DemotedAddresses),
+		pq.Array(merge.MovedDocuments),
+		merge.MergedStatus,
+		merge.MergedBy,
+		merge.MergedAt,
+		merge.UndoUntil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create customer merge: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetCustomerMerge(ctx context.Context, id uuid.UUID) (*models.CustomerMerge, error) {
+	merges, err := r.queryCustomerMerges(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(merges) == 0 {
+		return nil, ErrNotFound
+	}
+	return merges[0], nil
+}
+
+// GetStandingMerge returns the unreversed merge of a customer into another,
+// or ErrNotFound if the customer is not merged
+func (r *pgCustomerRepository) GetStandingMerge(ctx context.Context, mergedID uuid.UUID) (*models.CustomerMerge, error) {
+	merges, err := r.queryCustomerMerges(ctx, "WHERE merged_id = $1 AND unmerged_at IS NULL", mergedID)
+	if err != nil {
+		return nil, err
+	}
+	if len(merges) == 0 {
+		return nil, ErrNotFound
+	}
+	return merges[0], nil
+}
+
+// ListCustomerMerges lists the merges a customer took part in, as survivor
+// or as the merged customer, newest first
+func (r *pgCustomerRepository) ListCustomerMerges(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerMerge, error) {
+	return r.queryCustomerMerges(ctx, "WHERE survivor_id = $1 OR merged_id = $1 ORDER BY merged_at DESC", customerID)
+}
+
+// UpdateCustomerMerge records a merge being reversed. A merge that has
+// already been reversed is not changed and ErrNotFound is returned.
+func (r *pgCustomerRepository) UpdateCustomerMerge(ctx context.Context, merge *models.CustomerMerge) error {
+	query := `
+		UPDATE customer_merges SET
+			unmerged_by = $2,
+			unmerged_at = $3,
+			unmerge_reason = $4
+		WHERE id = $1 AND unmerged_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		merge.ID,
+		merge.UnmergedBy,
+		merge.UnmergedAt,
+		merge.UnmergeReason,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update customer merge: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) queryCustomerMerges(ctx context.Context, where string, args ...interface{}) ([]*models.CustomerMerge, error) {
+	query := `
+		SELECT id, survivor_id, merged_id, rules_version, reason, field_changes,
+			moved_addresses, demoted_addresses, moved_documents, merged_status,
+			merged_by, merged_at, undo_until, unmerged_by, unmerged_at, unmerge_reason
+		FROM customer_merges
+	` + where
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer merges: %w", err)
+	}
+	defer rows.Close()
+
+	var merges []*models.CustomerMerge
+	for rows.Next() {
+		merge := &models.CustomerMerge{}
+		var changes string
+
+		err := rows.Scan(
+			&merge.ID,
+			&merge.SurvivorID,
+			&merge.MergedID,
+			&merge.RulesVersion,
+			&merge.Reason,
+			&changes,
+			pq.Array(&merge.MovedAddresses),
+			pq.Array(&merge.DemotedAddresses),
+			pq.Array(&merge.MovedDocuments),
+			&merge.MergedStatus,
+			&merge.MergedBy,
+			&merge.MergedAt,
+			&merge.UndoUntil,
+			&merge.UnmergedBy,
+			&merge.UnmergedAt,
+			&merge.UnmergeReason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan customer merge: %w", err)
+		}
+
+		if merge.FieldChanges, err = r.decryptFieldChanges(changes); err != nil {
+			return nil, err
+		}
+
+		merges = append(merges, merge)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customer merges: %w", err)
+	}
+
+	return merges, nil
+}
+
+func (r *pgCustomerRepository) encryptFieldChanges(changes []models.MergeFieldChange) (string, error) {
+	if changes == nil {
+		changes = []models.MergeFieldChange{}
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode field changes: %w", err)
+	}
+	encrypted, err := r.encryptor.Encrypt(string(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt field changes: %w", err)
+	}
+	return encrypted, nil
+}
+
+func (r *pgCustomerRepository) decryptFieldChanges(encrypted string) ([]models.MergeFieldChange, error) {
+	data, err := r.encryptor.Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt field changes: %w", err)
+	}
+	var changes []models.MergeFieldChange
+	if err := json.Unmarshal([]byte(data), &changes); err != nil {
+		return nil, fmt.Errorf("failed to decode field changes: %w", err)
+	}
+	return changes, nil
+}
+
+// Customer event operations
+
+func (r *pgCustomerRepository) CreateCustomerEvent(ctx context.Context, event *models.CustomerEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO customer_events (
+			id, type, customer_id, related_customer_id, reference_id, occurred_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+		RETURNING sequence
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		event.ID,
+		event.Type,
+		event.CustomerID,
+		event.RelatedCustomerID,
+		event.ReferenceID,
+		event.OccurredAt,
+	).Scan(&event.Sequence)
+	if err != nil {
+		return fmt.Errorf("failed to create customer event: %w", err)
+	}
+
+	return nil
+}
+
+// ListCustomerEvents lists up to limit events after the given sequence
+// number, in order
+func (r *pgCustomerRepository) ListCustomerEvents(ctx context.Context, afterSequence int64, limit int) ([]*models.CustomerEvent, error) {
+	query := `
+		SELECT sequence, id, type, customer_id, related_customer_id, reference_id, occurred_at
+		FROM customer_events
+		WHERE sequence > $1
+		ORDER BY sequence
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, afterSequence, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*models.CustomerEvent
+	for rows.Next() {
+		event := &models.CustomerEvent{}
+
+		err := rows.Scan(
+			&event.Sequence,
+			&event.ID,
+			&event.Type,
+			&event.CustomerID,
+			&event.RelatedCustomerID,
+			&event.ReferenceID,
+			&event.OccurredAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan customer event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customer events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Relationship operations
+
+func (r *pgCustomerRepository) CreateCustomerRelationship(ctx context.Context, rel *models.CustomerRelationship) error {
+	if rel.ID == uuid.Nil {
+		rel.ID = uuid.New()
+	}
+	if rel.CreatedAt.IsZero() {
+		rel.CreatedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO customer_relationships (
+			id, business_id, individual_id, role, ownership_percentage,
+			created_by, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	var ownership interface{}
+	if rel.Role == models.RelationshipRoleBeneficialOwner {
+		ownership = rel.OwnershipPercentage
+	}
+
+	_, err := r.db.ExecContext(ctx, query,
+		rel.ID,
+		rel.BusinessID,
+		rel.IndividualID,
+		rel.Role,
+		ownership,
+		rel.CreatedBy,
+		rel.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create customer relationship: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetCustomerRelationship(ctx context.Context, id uuid.UUID) (*models.CustomerRelationship, error) {
+	rels, err := r.queryCustomerRelationships(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(rels) == 0 {
+		return nil, ErrNotFound
+	}
+	return rels[0], nil
+}
+
+// ListCustomerRelationships lists the relationships of a customer, as the
+// business or as the individual, ended ones included, newest first
+func (r *pgCustomerRepository) ListCustomerRelationships(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerRelationship, error) {
+	return r.queryCustomerRelationships(ctx, "WHERE business_id = $1 OR individual_id = $1 ORDER BY created_at DESC", customerID)
+}
+
+// UpdateCustomerRelationship records a relationship being ended. A
+// relationship that has already ended is not changed and ErrNotFound is
+// returned.
+func (r *pgCustomerRepository) UpdateCustomerRelationship(ctx context.Context, rel *models.CustomerRelationship) error {
+	query := `
+		UPDATE customer_relationships SET
+			ended_by = $2,
+			ended_at = $3,
+			end_reason = $4
+		WHERE id = $1 AND ended_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		rel.ID,
+		rel.EndedBy,
+		rel.EndedAt,
+		rel.EndReason,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update customer relationship: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) queryCustomerRelationships(ctx context.Context, where string, args ...interface{}) ([]*models.CustomerRelationship, error) {
+	query := `
+		SELECT id, business_id, individual_id, role, ownership_percentage,
+			created_by, created_at, ended_by, ended_at, end_reason
+		FROM customer_relationships
+	` + where
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer relationships: %w", err)
+	}
+	defer rows.Close()
+
+	var rels []*models.CustomerRelationship
+	for rows.Next() {
+		rel := &models.CustomerRelationship{}
+		var ownership sql.NullFloat64
+
+		err := rows.Scan(
+			&rel.ID,
+			&rel.BusinessID,
+			&rel.IndividualID,
+			&rel.Role,
+			&ownership,
+			&rel.CreatedBy,
+			&rel.CreatedAt,
+			&rel.EndedBy,
+			&rel.EndedAt,
+			&rel.EndReason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan customer relationship: %w", err)
+		}
+		rel.OwnershipPercentage = ownership.Float64
+
+		rels = append(rels, rel)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customer relationships: %w", err)
+	}
+
+	return rels, nil
+}
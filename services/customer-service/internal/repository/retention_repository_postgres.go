@@ -0,0 +1,335 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Retention operations
+
+// RecordCustomerClosure starts a closed customer's retention period. A
+// customer's first closure is the one kept.
+func (r *pgCustomerRepository) RecordCustomerClosure(ctx context.Context, customerID uuid.UUID, closedAt time.Time) error {
+	query := `
+		INSERT INTO customer_retention (customer_id, closed_at)
+		VALUES ($1, $2)
+		ON CONFLICT (customer_id) DO NOTHING
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, customerID, closedAt); err != nil {
+		return fmt.Errorf("failed to record customer closure: %w", err)
+	}
+
+	return nil
+}
+
+// ClearCustomerClosure forgets a customer's closure, for a customer
+// reopened when the merge that closed them is reversed. A customer already
+// pseudonymized keeps theirs.
+func (r *pgCustomerRepository) ClearCustomerClosure(ctx context.Context, customerID uuid.UUID) error {
+	query := `
+		DELETE FROM customer_retention
+		WHERE customer_id = $1 AND pseudonymized_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, customerID); err != nil {
+		return fmt.Errorf("failed to clear customer closure: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetCustomerRetention(ctx context.Context, customerID uuid.UUID) (*models.CustomerRetention, error) {
+	retentions, err := r.queryRetention(ctx, "WHERE customer_id = $1", customerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(retentions) == 0 {
+		return nil, ErrNotFound
+	}
+	return retentions[0], nil
+}
+
+// ListCustomersDueErasure lists customers closed before closedBefore who
+// have not been pseudonymized and hold no active legal hold, ordered by
+// customer id
+func (r *pgCustomerRepository) ListCustomersDueErasure(ctx context.Context, closedBefore time.Time, afterID uuid.UUID, limit int) ([]*models.CustomerRetention, error) {
+	return r.queryRetention(ctx, `
+		WHERE closed_at < $1 AND pseudonymized_at IS NULL AND customer_id > $2
+			AND NOT EXISTS (
+				SELECT 1 FROM legal_holds h
+				WHERE h.customer_id = customer_retention.customer_id AND h.released_at IS NULL
+			)
+		ORDER BY customer_id
+		LIMIT $3
+	`, closedBefore, afterID, limit)
+}
+
+func (r *pgCustomerRepository) MarkCustomerPseudonymized(ctx context.Context, customerID uuid.UUID, at time.Time) error {
+	query := `UPDATE customer_retention SET pseudonymized_at = $2 WHERE customer_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, customerID, at)
+	if err != nil {
+		return fmt.Errorf("failed to mark customer pseudonymized: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) queryRetention(ctx context.Context, clauses string, args ...interface{}) ([]*models.CustomerRetention, error) {
+	query := `
+		SELECT customer_id, closed_at, pseudonymized_at
+		FROM customer_retention
+	` + clauses
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer retention: %w", err)
+	}
+	defer rows.Close()
+
+	var retentions []*models.CustomerRetention
+	for rows.Next() {
+		retention := &models.CustomerRetention{}
+		var pseudonymizedAt sql.NullTime
+
+		if err := rows.Scan(&retention.CustomerID, &retention.ClosedAt, &pseudonymizedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan customer retention: %w", err)
+		}
+
+		if pseudonymizedAt.Valid {
+			pseudonymizedAtTime := pseudonymizedAt.Time
+			retention.PseudonymizedAt = &pseudonymizedAtTime
+		}
+
+		retentions = append(retentions, retention)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customer retention: %w", err)
+	}
+
+	return retentions, nil
+}
+
+// RedactScreeningHits replaces the customer's name and date of birth as
+// screened on all their screening hits
+func (r *pgCustomerRepository) RedactScreeningHits(ctx context.Context, customerID uuid.UUID, subject string) error {
+	query := `UPDATE screening_hits SET subject = $2 WHERE customer_id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, customerID, subject); err != nil {
+		return fmt.Errorf("failed to redact screening hits: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) CreateLegalHold(ctx context.Context, hold *models.LegalHold) error {
+	if hold.ID == uuid.Nil {
+		hold.ID = uuid.New()
+	}
+	if hold.PlacedAt.IsZero() {
+		hold.PlacedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO legal_holds (
+			id, customer_id, reason, placed_by, placed_at
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		hold.ID,
+		hold.CustomerID,
+		hold.Reason,
+		hold.PlacedBy,
+		hold.PlacedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create legal hold: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetLegalHold(ctx context.Context, id uuid.UUID) (*models.LegalHold, error) {
+	holds, err := r.queryLegalHolds(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(holds) == 0 {
+		return nil, ErrNotFound
+	}
+	return holds[0], nil
+}
+
+// ListLegalHolds lists a customer's legal holds, most recently placed first
+func (r *pgCustomerRepository) ListLegalHolds(ctx context.Context, customerID uuid.UUID) ([]*models.LegalHold, error) {
+	return r.queryLegalHolds(ctx, "WHERE customer_id = $1 ORDER BY placed_at DESC", customerID)
+}
+
+func (r *pgCustomerRepository) UpdateLegalHold(ctx context.Context, hold *models.LegalHold) error {
+	query := `
+		UPDATE legal_holds SET
+			released_by = $2,
+			released_at = $3
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, hold.ID, hold.ReleasedBy, hold.ReleasedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update legal hold: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) queryLegalHolds(ctx context.Context, clauses string, args ...interface{}) ([]*models.LegalHold, error) {
+	query := `
+		SELECT id, customer_id, reason, placed_by, placed_at, released_by, released_at
+		FROM legal_holds
+	` + clauses
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get legal holds: %w", err)
+	}
+	defer rows.Close()
+
+	var holds []*models.LegalHold
+	for rows.Next() {
+		hold := &models.LegalHold{}
+		var releasedBy sql.NullString
+		var releasedAt sql.NullTime
+
+		err := rows.Scan(
+			&hold.ID,
+			&hold.CustomerID,
+			&hold.Reason,
+			&hold.PlacedBy,
+			&hold.PlacedAt,
+			&releasedBy,
+			&releasedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan legal hold: %w", err)
+		}
+
+		if releasedBy.Valid {
+			releasedByUUID := uuid.MustParse(releasedBy.String)
+			hold.ReleasedBy = &releasedByUUID
+		}
+		if releasedAt.Valid {
+			releasedAtTime := releasedAt.Time
+			hold.ReleasedAt = &releasedAtTime
+		}
+
+		holds = append(holds, hold)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating legal holds: %w", err)
+	}
+
+	return holds, nil
+}
+
+func (r *pgCustomerRepository) CreateErasureRequest(ctx context.Context, req *models.ErasureRequest) error {
+	if req.ID == uuid.Nil {
+		req.ID = uuid.New()
+	}
+	if req.RequestedAt.IsZero() {
+		req.RequestedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO erasure_requests (
+			id, customer_id, requested_by, reason, status, blockers, requested_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		req.ID,
+		req.CustomerID,
+		req.RequestedBy,
+		req.Reason,
+		req.Status,
+		pq.Array(req.Blockers),
+		req.RequestedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create erasure request: %w", err)
+	}
+
+	return nil
+}
+
+// ListErasureRequests lists a customer's erasure requests, oldest first
+func (r *pgCustomerRepository) ListErasureRequests(ctx context.Context, customerID uuid.UUID) ([]*models.ErasureRequest, error) {
+	query := `
+		SELECT id, customer_id, requested_by, reason, status, blockers, requested_at
+		FROM erasure_requests
+		WHERE customer_id = $1
+		ORDER BY requested_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get erasure requests: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []*models.ErasureRequest
+	for rows.Next() {
+		req := &models.ErasureRequest{}
+
+		err := rows.Scan(
+			&req.ID,
+			&req.CustomerID,
+			&req.RequestedBy,
+			&req.Reason,
+			&req.Status,
+			pq.Array(&req.Blockers),
+			&req.RequestedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan erasure request: %w", err)
+		}
+
+		requests = append(requests, req)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating erasure requests: %w", err)
+	}
+
+	return requests, nil
+}
@@ -0,0 +1,362 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// Risk operations
+
+// ListCustomersDueRiskAssessment lists customers who are not closed and have
+// either never been assessed or had a screening hit raised or reviewed since
+// their last assessment
+func (r *pgCustomerRepository) ListCustomersDueRiskAssessment(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error) {
+	query := `
+		SELECT ` + customerColumns + `
+		FROM customers c
+		LEFT JOIN LATERAL (
+			SELECT assessed_at FROM customer_risk_assessments
+			WHERE customer_id = c.id
+			ORDER BY assessed_at DESC
+			LIMIT 1
+		) latest ON TRUE
+		WHERE c.id > $1 AND c.status <> 'Closed'
+			AND (latest.assessed_at IS NULL OR EXISTS (
+				SELECT 1 FROM screening_hits h
+				WHERE h.customer_id = c.id
+					AND GREATEST(h.created_at, COALESCE(h.reviewed_at, h.created_at)) > latest.assessed_at
+			))
+		ORDER BY c.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers due risk assessment: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []*models.Customer
+	for rows.Next() {
+		customer, err := r.scanCustomer(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		customers = append(customers, customer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customers: %w", err)
+	}
+
+	return customers, nil
+}
+
+func (r *pgCustomerRepository) CreateRiskAssessment(ctx context.Context, assessment *models.CustomerRiskAssessment) error {
+	if assessment.ID == uuid.Nil {
+		assessment.ID = uuid.New()
+	}
+	if assessment.AssessedAt.IsZero() {
+		assessment.AssessedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO customer_risk_assessments (
+			id, customer_id, rating, score, factors, model_version,
+			trigger, assessed_by, assessed_at, next_review_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		assessment.ID,
+		assessment.CustomerID,
+		assessment.Rating,
+		assessment.Score,
+		assessment.Factors,
+		assessment.ModelVersion,
+		assessment.Trigger,
+		assessment.AssessedBy,
+		assessment.AssessedAt,
+		assessment.NextReviewAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create risk assessment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetLatestRiskAssessment(ctx context.Context, customerID uuid.UUID) (*models.CustomerRiskAssessment, error) {
+	assessments, err := r.ListRiskAssessments(ctx, customerID, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(assessments) == 0 {
+		return nil, ErrNotFound
+	}
+	return assessments[0], nil
+}
+
+// ListRiskAssessments lists a customer's assessments, most recent first. A
+// limit of zero returns all of them.
+func (r *pgCustomerRepository) ListRiskAssessments(ctx context.Context, customerID uuid.UUID, limit int) ([]*models.CustomerRiskAssessment, error) {
+	query := `
+		SELECT id, customer_id, rating, score, factors, model_version,
+			trigger, assessed_by, assessed_at, next_review_at
+		FROM customer_risk_assessments
+		WHERE customer_id = $1
+		ORDER BY assessed_at DESC
+	`
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	return r.queryRiskAssessments(ctx, query, customerID)
+}
+
+// ListRiskAssessmentsDueReview lists the latest assessment of each customer
+// who is not closed, is due for review by before, and has no open review
+// task, ordered by customer
+func (r *pgCustomerRepository) ListRiskAssessmentsDueReview(ctx context.Context, before time.Time, afterCustomerID uuid.UUID, limit int) ([]*models.CustomerRiskAssessment, error) {
+	query := `
+		SELECT a.id, a.customer_id, a.rating, a.score, a.factors, a.model_version,
+			a.trigger, a.assessed_by, a.assessed_at, a.next_review_at
+		FROM customers c
+		JOIN LATERAL (
+			SELECT * FROM customer_risk_assessments
+			WHERE customer_id = c.id
+			ORDER BY assessed_at DESC
+			LIMIT 1
+		) a ON TRUE
+		WHERE c.id > $2 AND c.status <> 'Closed'
+			AND a.next_review_at <= $1
+			AND NOT EXISTS (
+				SELECT 1 FROM review_tasks t
+				WHERE t.customer_id = c.id AND t.status = 'Open'
+			)
+		ORDER BY c.id
+		LIMIT $3
+	`
+
+	return r.queryRiskAssessments(ctx, query, before, afterCustomerID, limit)
+}
+
+func (r *pgCustomerRepository) queryRiskAssessments(ctx context.Context, query string, args ...interface{}) ([]*models.CustomerRiskAssessment, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get risk assessments: %w", err)
+	}
+	defer rows.Close()
+
+	var assessments []*models.CustomerRiskAssessment
+	for rows.Next() {
+		assessment := &models.CustomerRiskAssessment{}
+		var assessedBy sql.NullString
+
+		err := rows.Scan(
+			&assessment.ID,
+			&assessment.CustomerID,
+			&assessment.Rating,
+			&assessment.Score,
+			&assessment.Factors,
+			&assessment.ModelVersion,
+			&assessment.Trigger,
+			&assessedBy,
+			&assessment.AssessedAt,
+			&assessment.NextReviewAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan risk assessment: %w", err)
+		}
+
+		if assessedBy.Valid {
+			assessedByUUID := uuid.MustParse(assessedBy.String)
+			assessment.AssessedBy = &assessedByUUID
+		}
+
+		assessments = append(assessments, assessment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating risk assessments: %w", err)
+	}
+
+	return assessments, nil
+}
+
+func (r *pgCustomerRepository) CreateReviewTask(ctx context.Context, task *models.ReviewTask) error {
+	if task.ID == uuid.Nil {
+		task.ID = uuid.New()
+	}
+	if task.CreatedAt.IsZero() {
+		task.CreatedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO review_tasks (
+			id, customer_id, assessment_id, rating, due_at, status, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		task.ID,
+		task.CustomerID,
+		task.AssessmentID,
+		task.Rating,
+		task.DueAt,
+		task.Status,
+		task.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create review task: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetReviewTask(ctx context.Context, id uuid.UUID) (*models.ReviewTask, error) {
+	tasks, err := r.queryReviewTasks(ctx, "WHERE id = $1", 0, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, ErrNotFound
+	}
+	return tasks[0], nil
+}
+
+func (r *pgCustomerRepository) ListReviewTasks(ctx context.Context, filter models.ReviewTaskFilter) ([]*models.ReviewTask, error) {
+	var conditions []string
+	var args []interface{}
+	argIdx := 1
+
+	if filter.CustomerID != nil {
+		conditions = append(conditions, fmt.Sprintf("customer_id = $%d", argIdx))
+		args = append(args, *filter.CustomerID)
+		argIdx++
+	}
+
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIdx))
+		args = append(args, filter.Status)
+		argIdx++
+	}
+
+	if filter.DueBefore != nil {
+		conditions = append(conditions, fmt.Sprintf("due_at < $%d", argIdx))
+		args = append(args, *filter.DueBefore)
+		argIdx++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return r.queryReviewTasks(ctx, whereClause+" ORDER BY due_at, created_at", filter.Limit, args...)
+}
+
+func (r *pgCustomerRepository) UpdateReviewTask(ctx context.Context, task *models.ReviewTask) error {
+	query := `
+		UPDATE review_tasks SET
+			due_at = $2,
+			status = $3,
+			completed_at = $4,
+			completed_by = $5,
+			notes = $6
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		task.ID,
+		task.DueAt,
+		task.Status,
+		task.CompletedAt,
+		task.CompletedBy,
+		task.Notes,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update review task: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// queryReviewTasks selects review tasks with the given WHERE and ORDER BY
+// clauses. A limit of zero returns every match.
+func (r *pgCustomerRepository) queryReviewTasks(ctx context.Context, clauses string, limit int, args ...interface{}) ([]*models.ReviewTask, error) {
+	query := `
+		SELECT id, customer_id, assessment_id, rating, due_at, status,
+			created_at, completed_at, completed_by, notes
+		FROM review_tasks
+	` + clauses
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*models.ReviewTask
+	for rows.Next() {
+		task := &models.ReviewTask{}
+		var completedAt sql.NullTime
+		var completedBy sql.NullString
+
+		err := rows.Scan(
+			&task.ID,
+			&task.CustomerID,
+			&task.AssessmentID,
+			&task.Rating,
+			&task.DueAt,
+			&task.Status,
+			&task.CreatedAt,
+			&completedAt,
+			&completedBy,
+			&task.Notes,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan review task: %w", err)
+		}
+
+		if completedAt.Valid {
+			completedAtTime := completedAt.Time
+			task.CompletedAt = &completedAtTime
+		}
+		if completedBy.Valid {
+			completedByUUID := uuid.MustParse(completedBy.String)
+			task.CompletedBy = &completedByUUID
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating review tasks: %w", err)
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,354 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Screening operations
+
+func (r *pgCustomerRepository) ListCustomersForScreening(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error) {
+	query := `
+		SELECT ` + customerColumns + `
+		FROM customers c
+		WHERE id > $1 AND status <> 'Closed'
+		ORDER BY id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers for screening: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []*models.Customer
+	for rows.Next() {
+		customer, err := r.scanCustomer(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		customers = append(customers, customer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customers: %w", err)
+	}
+
+	return customers, nil
+}
+
+func (r *pgCustomerRepository) CreateCustomerScreening(ctx context.Context, screening *models.CustomerScreening) error {
+	if screening.ID == uuid.Nil {
+		screening.ID = uuid.New()
+	}
+	if screening.ScreenedAt.IsZero() {
+		screening.ScreenedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO customer_screenings (
+			id, customer_id, trigger, outcome, list_versions, screened_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		screening.ID,
+		screening.CustomerID,
+		screening.Trigger,
+		screening.Outcome,
+		pq.Array(screening.ListVersions),
+		screening.ScreenedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create customer screening: %w", err)
+	}
+
+	hitQuery := `
+		INSERT INTO screening_hits (
+			id, screening_id, customer_id, list_name, list_version,
+			entry_id, entry_name, matched_name, subject, score,
+			name_score, dob_match, country_match, status, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+		)
+	`
+
+	for _, hit := range screening.Hits {
+		if hit.ID == uuid.Nil {
+			hit.ID = uuid.New()
+		}
+		hit.ScreeningID = screening.ID
+		hit.CustomerID = screening.CustomerID
+		hit.CreatedAt = screening.ScreenedAt
+
+		_, err := r.db.ExecContext(ctx, hitQuery,
+			hit.ID,
+			hit.ScreeningID,
+			hit.CustomerID,
+			hit.ListName,
+			hit.ListVersion,
+			hit.EntryID,
+			hit.EntryName,
+			hit.MatchedName,
+			hit.Subject,
+			hit.Score,
+			hit.NameScore,
+			hit.DOBMatch,
+			hit.CountryMatch,
+			hit.Status,
+			hit.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create screening hit: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) GetLatestCustomerScreening(ctx context.Context, customerID uuid.UUID) (*models.CustomerScreening, error) {
+	query := `
+		SELECT id, customer_id, trigger, outcome, list_versions, screened_at
+		FROM customer_screenings
+		WHERE customer_id = $1
+		ORDER BY screened_at DESC
+		LIMIT 1
+	`
+
+	screening := &models.CustomerScreening{}
+	err := r.db.QueryRowContext(ctx, query, customerID).Scan(
+		&screening.ID,
+		&screening.CustomerID,
+		&screening.Trigger,
+		&screening.Outcome,
+		pq.Array(&screening.ListVersions),
+		&screening.ScreenedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer screening: %w", err)
+	}
+
+	hits, err := r.queryScreeningHits(ctx, "WHERE screening_id = $1 ORDER BY score DESC", 0, screening.ID)
+	if err != nil {
+		return nil, err
+	}
+	screening.Hits = hits
+
+	return screening, nil
+}
+
+func (r *pgCustomerRepository) ListScreeningHits(ctx context.Context, filter models.ScreeningHitFilter) ([]*models.ScreeningHit, error) {
+	var conditions []string
+	var args []interface{}
+	argIdx := 1
+
+	if filter.CustomerID != nil {
+		conditions = append(conditions, fmt.Sprintf("customer_id = $%d", argIdx))
+		args = append(args, *filter.CustomerID)
+		argIdx++
+	}
+
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIdx))
+		args = append(args, filter.Status)
+		argIdx++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return r.queryScreeningHits(ctx, whereClause+" ORDER BY created_at DESC, score DESC", filter.Limit, args...)
+}
+
+func (r *pgCustomerRepository) GetScreeningHit(ctx context.Context, id uuid.UUID) (*models.ScreeningHit, error) {
+	hits, err := r.queryScreeningHits(ctx, "WHERE id = $1", 0, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(hits) == 0 {
+		return nil, ErrNotFound
+	}
+	return hits[0], nil
+}
+
+func (r *pgCustomerRepository) UpdateScreeningHit(ctx context.Context, hit *models.ScreeningHit) error {
+	query := `
+		UPDATE screening_hits SET
+			status = $2,
+			reviewed_by = $3,
+			reviewed_at = $4,
+			review_notes = $5
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		hit.ID,
+		hit.Status,
+		hit.ReviewedBy,
+		hit.ReviewedAt,
+		hit.ReviewNotes,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update screening hit: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// queryScreeningHits selects hits with the given WHERE and ORDER BY clauses.
+// A limit of zero returns every match.
+func (r *pgCustomerRepository) queryScreeningHits(ctx context.Context, clauses string, limit int, args ...interface{}) ([]*models.ScreeningHit, error) {
+	query := `
+		SELECT id, screening_id, customer_id, list_name, list_version,
+			entry_id, entry_name, matched_name, subject, score,
+			name_score, dob_match, country_match, status, reviewed_by,
+			reviewed_at, review_notes, created_at
+		FROM screening_hits
+	` + clauses
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get screening hits: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []*models.ScreeningHit
+	for rows.Next() {
+		hit := &models.ScreeningHit{}
+		var reviewedBy sql.NullString
+		var reviewedAt sql.NullTime
+
+		err := rows.Scan(
+			&hit.ID,
+			&hit.ScreeningID,
+			&hit.CustomerID,
+			&hit.ListName,
+			&hit.ListVersion,
+			&hit.EntryID,
+			&hit.EntryName,
+			&hit.MatchedName,
+			&hit.Subject,
+			&hit.Score,
+			&hit.NameScore,
+			&hit.DOBMatch,
+			&hit.CountryMatch,
+			&hit.Status,
+			&reviewedBy,
+			&reviewedAt,
+			&hit.ReviewNotes,
+			&hit.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan screening hit: %w", err)
+		}
+
+		if reviewedBy.Valid {
+			reviewedByUUID := uuid.MustParse(reviewedBy.String)
+			hit.ReviewedBy = &reviewedByUUID
+		}
+		if reviewedAt.Valid {
+			reviewedAtTime := reviewedAt.Time
+			hit.ReviewedAt = &reviewedAtTime
+		}
+
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating screening hits: %w", err)
+	}
+
+	return hits, nil
+}
+
+// RecordSanctionsList stores a list version the first time it is loaded and
+// fills in when it was first loaded and whether customers have been
+// re-screened against it
+func (r *pgCustomerRepository) RecordSanctionsList(ctx context.Context, list *models.SanctionsList) error {
+	if list.LoadedAt.IsZero() {
+		list.LoadedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO sanctions_list_versions (
+			list_name, version, published_at, entry_count, source_file, loaded_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+		ON CONFLICT (list_name, version) DO UPDATE SET list_name = EXCLUDED.list_name
+		RETURNING loaded_at, rescreened_at
+	`
+
+	var rescreenedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query,
+		list.ListName,
+		list.Version,
+		list.PublishedAt,
+		list.EntryCount,
+		list.SourceFile,
+		list.LoadedAt,
+	).Scan(&list.LoadedAt, &rescreenedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record sanctions list: %w", err)
+	}
+
+	list.RescreenedAt = nil
+	if rescreenedAt.Valid {
+		rescreenedAtTime := rescreenedAt.Time
+		list.RescreenedAt = &rescreenedAtTime
+	}
+
+	return nil
+}
+
+func (r *pgCustomerRepository) MarkSanctionsListRescreened(ctx context.Context, listName, version string, at time.Time) error {
+	query := `
+		UPDATE sanctions_list_versions SET rescreened_at = $3
+		WHERE list_name = $1 AND version = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, listName, version, at)
+	if err != nil {
+		return fmt.Errorf("failed to mark sanctions list rescreened: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
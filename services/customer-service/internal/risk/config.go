@@ -0,0 +1,183 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	countryRegex  = regexp.MustCompile(`^[A-Z]{2}$`)
+	currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// Points is what each factor adds to a customer's score
+type Points struct {
+	HighRiskCountry     int `json:"high_risk_country"`
+	ElevatedRiskCountry int `json:"elevated_risk_country"`
+	YoungCustomer       int `json:"young_customer"`
+	ElderlyCustomer     int `json:"elderly_customer"`
+	NoVerifiedIdentity  int `json:"no_verified_identity"`
+	ExpiredDocument     int `json:"expired_document"`
+	RejectedDocument    int `json:"rejected_document"`
+	ForeignCurrency     int `json:"foreign_currency"`
+	PendingScreeningHit int `json:"pending_screening_hit"`
+}
+
+// ReviewMonths is how long after an assessment each rating is due for review
+type ReviewMonths struct {
+	Low    int `json:"low"`
+	Medium int `json:"medium"`
+	High   int `json:"high"`
+}
+
+// Config is a versioned risk-scoring model. Every assessment records the
+// version of the model that produced it.
+type Config struct {
+	Version string `json:"version"`
+	// HighRiskCountries and ElevatedRiskCountries are ISO 3166 alpha-2 codes
+	// matched against address and document issuing countries
+	HighRiskCountries     []string `json:"high_risk_countries"`
+	ElevatedRiskCountries []string `json:"elevated_risk_countries"`
+	// Customers younger than YoungAge or at least ElderlyAge score the age
+	// points. Zero turns the factor off.
+	YoungAge   int `json:"young_age"`
+	ElderlyAge int `json:"elderly_age"`
+	// HomeCurrency is the currency products are expected to be held in
+	HomeCurrency string `json:"home_currency"`
+	Points       Points `json:"points"`
+	// ProductPoints is keyed by account type or by the customer's role on
+	// the account; a holding scores the higher of the two
+	ProductPoints map[string]int `json:"product_points"`
+	// A score of at least MediumThreshold is Medium, at least HighThreshold
+	// is High
+	MediumThreshold int          `json:"medium_threshold"`
+	HighThreshold   int          `json:"high_threshold"`
+	ReviewMonths    ReviewMonths `json:"review_months"`
+	// ReviewNoticeDays is how long before a review is due the task for it
+	// is raised
+	ReviewNoticeDays int `json:"review_notice_days"`
+}
+
+// DefaultConfig returns the model used when none is configured
+func DefaultConfig() *Config {
+	return &Config{
+		Version:               "default-1",
+		HighRiskCountries:     []string{"AF", "BY", "CU", "IR", "KP", "MM", "RU", "SY"},
+		ElevatedRiskCountries: []string{"AE", "HT", "ML", "NG", "PA", "PK", "VE", "YE"},
+		YoungAge:              21,
+		ElderlyAge:            85,
+		HomeCurrency:          "USD",
+		Points: Points{
+			HighRiskCountry:     40,
+			ElevatedRiskCountry: 20,
+			YoungCustomer:       5,
+			ElderlyCustomer:     5,
+			NoVerifiedIdentity:  20,
+			ExpiredDocument:     10,
+			RejectedDocument:    15,
+			ForeignCurrency:     10,
+			PendingScreeningHit: 30,
+		},
+		ProductPoints: map[string]int{
+			"Checking":            5,
+			"PowerOfAttorney":     15,
+			"AuthorizedSignatory": 10,
+		},
+		MediumThreshold:  25,
+		HighThreshold:    50,
+		ReviewMonths:     ReviewMonths{Low: 36, Medium: 24, High: 12},
+		ReviewNoticeDays: 30,
+	}
+}
+
+// Validate checks the model is usable
+func (c *Config) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("risk model has no version")
+	}
+	if len(c.Version) > 64 {
+		return fmt.Errorf("risk model version must not exceed 64 characters")
+	}
+	seen := make(map[string]string)
+	for _, list := range []struct {
+		name      string
+		countries []string
+	}{{"high_risk_countries", c.HighRiskCountries}, {"elevated_risk_countries", c.ElevatedRiskCountries}} {
+		for _, country := range list.countries {
+			if !countryRegex.MatchString(country) {
+				return fmt.Errorf("%s: %q is not an ISO 3166 alpha-2 code", list.name, country)
+			}
+			if other, ok := seen[country]; ok {
+				return fmt.Errorf("%s: %s is already listed in %s", list.name, country, other)
+			}
+			seen[country] = list.name
+		}
+	}
+	if c.YoungAge < 0 || c.ElderlyAge < 0 {
+		return fmt.Errorf("ages must not be negative")
+	}
+	if c.ElderlyAge > 0 && c.ElderlyAge <= c.YoungAge {
+		return fmt.Errorf("elderly age must be above young age")
+	}
+	if c.HomeCurrency != "" && !currencyRegex.MatchString(c.HomeCurrency) {
+		return fmt.Errorf("home currency must be a 3-letter ISO 4217 code")
+	}
+	for _, p := range []int{
+		c.Points.HighRiskCountry, c.Points.ElevatedRiskCountry, c.Points.YoungCustomer,
+		c.Points.ElderlyCustomer, c.Points.NoVerifiedIdentity, c.Points.ExpiredDocument,
+		c.Points.RejectedDocument, c.Points.ForeignCurrency, c.Points.PendingScreeningHit,
+	} {
+		if p < 0 {
+			return fmt.Errorf("points must not be negative")
+		}
+	}
+	for key, p := range c.ProductPoints {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("product points have an empty key")
+		}
+		if p < 0 {
+			return fmt.Errorf("product %s: points must not be negative", key)
+		}
+	}
+	if c.MediumThreshold <= 0 {
+		return fmt.Errorf("medium threshold must be positive")
+	}
+	if c.HighThreshold <= c.MediumThreshold {
+		return fmt.Errorf("high threshold must be above medium threshold")
+	}
+	r := c.ReviewMonths
+	if r.High <= 0 || r.Medium < r.High || r.Low < r.Medium {
+		return fmt.Errorf("review months must be positive and no shorter for lower ratings")
+	}
+	if r.Low > 120 {
+		return fmt.Errorf("review months must not exceed 120")
+	}
+	if c.ReviewNoticeDays < 0 || c.ReviewNoticeDays > 365 {
+		return fmt.Errorf("review notice days must be between 0 and 365")
+	}
+	return nil
+}
+
+// LoadConfig reads and validates a risk model file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read risk model: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates a risk model document
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse risk model: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
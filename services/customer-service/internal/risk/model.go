@@ -0,0 +1,236 @@
+package risk
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Rating is a customer's overall risk classification
+type Rating string
+
+const (
+	RatingLow    Rating = "Low"
+	RatingMedium Rating = "Medium"
+	RatingHigh   Rating = "High"
+)
+
+// Factor codes explaining an assessment
+const (
+	FactorHighRiskCountry       = "HIGH_RISK_COUNTRY"
+	FactorElevatedRiskCountry   = "ELEVATED_RISK_COUNTRY"
+	FactorYoungCustomer         = "YOUNG_CUSTOMER"
+	FactorElderlyCustomer       = "ELDERLY_CUSTOMER"
+	FactorNoVerifiedIdentity    = "NO_VERIFIED_IDENTITY"
+	FactorExpiredDocument       = "EXPIRED_DOCUMENT"
+	FactorRejectedDocument      = "REJECTED_DOCUMENT"
+	FactorProduct               = "PRODUCT"
+	FactorForeignCurrency       = "FOREIGN_CURRENCY"
+	FactorScreeningHitPending   = "SCREENING_HIT_PENDING"
+	FactorScreeningHitConfirmed = "SCREENING_HIT_CONFIRMED"
+)
+
+// Factor is one reason a customer scored what they did
+type Factor struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Points      int    `json:"points"`
+}
+
+// Document is what the model needs to know about an identity document
+type Document struct {
+	Type       string
+	Identity   bool // Proves who the customer is, such as a passport
+	Status     string
+	ExpiryDate time.Time
+	Country    string
+}
+
+// Product is an account the customer holds or acts on
+type Product struct {
+	AccountType string
+	Role        string
+	Currency    string
+}
+
+// Profile is everything stored about a customer that bears on their risk
+type Profile struct {
+	DateOfBirth time.Time // Zero when unknown
+	Countries   []string  // ISO 3166 alpha-2 codes of addresses
+	Documents   []Document
+	// ProductsKnown is false when holdings could not be looked up, so the
+	// product factors are left out rather than scored as none
+	ProductsKnown          bool
+	Products               []Product
+	PendingScreeningHits   int
+	ConfirmedScreeningHits int
+}
+
+// Assessment is the outcome of scoring a profile
+type Assessment struct {
+	Rating  Rating
+	Score   int
+	Factors []Factor
+}
+
+// Model scores customer profiles against a Config
+type Model struct {
+	cfg          *Config
+	highRisk     map[string]bool
+	elevatedRisk map[string]bool
+}
+
+// NewModel creates a Model from a validated config
+func NewModel(cfg *Config) *Model {
+	m := &Model{
+		cfg:          cfg,
+		highRisk:     make(map[string]bool, len(cfg.HighRiskCountries)),
+		elevatedRisk: make(map[string]bool, len(cfg.ElevatedRiskCountries)),
+	}
+	for _, c := range cfg.HighRiskCountries {
+		m.highRisk[c] = true
+	}
+	for _, c := range cfg.ElevatedRiskCountries {
+		m.elevatedRisk[c] = true
+	}
+	return m
+}
+
+// Version is the version of the config the model scores with
+func (m *Model) Version() string {
+	return m.cfg.Version
+}
+
+// Assess scores a profile as at now. A confirmed screening hit makes the
+// customer High whatever their score.
+func (m *Model) Assess(p Profile, now time.Time) Assessment {
+	var a Assessment
+	add := func(code string, points int, format string, args ...any) {
+		if points <= 0 && code != FactorScreeningHitConfirmed {
+			return
+		}
+		a.Factors = append(a.Factors, Factor{Code: code, Description: fmt.Sprintf(format, args...), Points: points})
+		a.Score += points
+	}
+
+	countries := make([]string, 0, len(p.Countries)+len(p.Documents))
+	countries = append(countries, p.Countries...)
+	for _, d := range p.Documents {
+		countries = append(countries, d.Country)
+	}
+	for _, c := range uniqueCountries(countries) {
+		switch {
+		case m.highRisk[c]:
+			add(FactorHighRiskCountry, m.cfg.Points.HighRiskCountry, "Connected to high-risk country %s", c)
+		case m.elevatedRisk[c]:
+			add(FactorElevatedRiskCountry, m.cfg.Points.ElevatedRiskCountry, "Connected to elevated-risk country %s", c)
+		}
+	}
+
+	if !p.DateOfBirth.IsZero() {
+		age := ageAt(p.DateOfBirth, now)
+		if m.cfg.YoungAge > 0 && age < m.cfg.YoungAge {
+			add(FactorYoungCustomer, m.cfg.Points.YoungCustomer, "Aged %d, under %d", age, m.cfg.YoungAge)
+		}
+		if m.cfg.ElderlyAge > 0 && age >= m.cfg.ElderlyAge {
+			add(FactorElderlyCustomer, m.cfg.Points.ElderlyCustomer, "Aged %d, %d or over", age, m.cfg.ElderlyAge)
+		}
+	}
+
+	verifiedIdentity := false
+	for _, d := range p.Documents {
+		expired := d.Status == "Expired" || (!d.ExpiryDate.IsZero() && d.ExpiryDate.Before(now))
+		switch {
+		case d.Status == "Rejected":
+			add(FactorRejectedDocument, m.cfg.Points.RejectedDocument, "%s was rejected", d.Type)
+		case expired:
+			add(FactorExpiredDocument, m.cfg.Points.ExpiredDocument, "%s has expired", d.Type)
+		case d.Identity && d.Status == "Verified":
+			verifiedIdentity = true
+		}
+	}
+	if !verifiedIdentity {
+		add(FactorNoVerifiedIdentity, m.cfg.Points.NoVerifiedIdentity, "No verified, unexpired identity document")
+	}
+
+	if p.ProductsKnown {
+		foreign := make(map[string]bool)
+		for _, prod := range p.Products {
+			points, key := m.productPoints(prod)
+			add(FactorProduct, points, "Holds %s", key)
+			if m.cfg.HomeCurrency != "" && prod.Currency != "" && prod.Currency != m.cfg.HomeCurrency && !foreign[prod.Currency] {
+				foreign[prod.Currency] = true
+				add(FactorForeignCurrency, m.cfg.Points.ForeignCurrency, "Holds an account in %s", prod.Currency)
+			}
+		}
+	}
+
+	if p.PendingScreeningHits > 0 {
+		add(FactorScreeningHitPending, m.cfg.Points.PendingScreeningHit, "%d sanctions screening hits awaiting review", p.PendingScreeningHits)
+	}
+	if p.ConfirmedScreeningHits > 0 {
+		add(FactorScreeningHitConfirmed, 0, "%d confirmed sanctions screening hits", p.ConfirmedScreeningHits)
+	}
+
+	switch {
+	case p.ConfirmedScreeningHits > 0 || a.Score >= m.cfg.HighThreshold:
+		a.Rating = RatingHigh
+	case a.Score >= m.cfg.MediumThreshold:
+		a.Rating = RatingMedium
+	default:
+		a.Rating = RatingLow
+	}
+	return a
+}
+
+// productPoints is the higher of a product's account type and role points,
+// with the key that scored them
+func (m *Model) productPoints(p Product) (int, string) {
+	typePoints := m.cfg.ProductPoints[p.AccountType]
+	rolePoints := m.cfg.ProductPoints[p.Role]
+	if rolePoints > typePoints {
+		return rolePoints, fmt.Sprintf("%s account as %s", p.AccountType, p.Role)
+	}
+	return typePoints, fmt.Sprintf("%s account", p.AccountType)
+}
+
+// NextReview is when a customer rated at from is next due for review
+func (m *Model) NextReview(rating Rating, from time.Time) time.Time {
+	months := m.cfg.ReviewMonths.Low
+	switch rating {
+	case RatingHigh:
+		months = m.cfg.ReviewMonths.High
+	case RatingMedium:
+		months = m.cfg.ReviewMonths.Medium
+	}
+	return from.AddDate(0, months, 0)
+}
+
+// ReviewNotice is how long before a review is due its task is raised
+func (m *Model) ReviewNotice() time.Duration {
+	return time.Duration(m.cfg.ReviewNoticeDays) * 24 * time.Hour
+}
+
+// ageAt is a person's age in whole years on a date
+func ageAt(dob, at time.Time) int {
+	age := at.Year() - dob.Year()
+	if at.Month() < dob.Month() || (at.Month() == dob.Month() && at.Day() < dob.Day()) {
+		age--
+	}
+	return age
+}
+
+// uniqueCountries upper-cases the codes and drops blanks and repeats,
+// keeping them in a stable order
+func uniqueCountries(codes []string) []string {
+	var out []string
+	for _, c := range codes {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if c != "" && !slices.Contains(out, c) {
+			out = append(out, c)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
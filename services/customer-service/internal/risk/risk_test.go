@@ -0,0 +1,210 @@
+package risk
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var assessedAt = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func verifiedPassport(country string) Document {
+	return Document{
+		Type:       "Passport",
+		Identity:   true,
+		Status:     "Verified",
+		ExpiryDate: assessedAt.AddDate(5, 0, 0),
+		Country:    country,
+	}
+}
+
+func factorCodes(a Assessment) []string {
+	codes := make([]string, len(a.Factors))
+	for i, f := range a.Factors {
+		codes[i] = f.Code
+	}
+	return codes
+}
+
+func TestModel_Assess(t *testing.T) {
+	model := NewModel(DefaultConfig())
+
+	tests := []struct {
+		name       string
+		profile    Profile
+		wantRating Rating
+		wantScore  int
+		wantCodes  []string
+	}{
+		{
+			name: "verified domestic customer",
+			profile: Profile{
+				DateOfBirth:   time.Date(1980, 3, 1, 0, 0, 0, 0, time.UTC),
+				Countries:     []string{"US"},
+				Documents:     []Document{verifiedPassport("US")},
+				ProductsKnown: true,
+				Products:      []Product{{AccountType: "Savings", Role: "PrimaryHolder", Currency: "USD"}},
+			},
+			wantRating: RatingLow,
+			wantScore:  0,
+			wantCodes:  []string{},
+		},
+		{
+			name: "no identity and young",
+			profile: Profile{
+				DateOfBirth: time.Date(2006, 7, 1, 0, 0, 0, 0, time.UTC),
+				Countries:   []string{"US"},
+			},
+			wantRating: RatingMedium,
+			wantScore:  25,
+			wantCodes:  []string{FactorYoungCustomer, FactorNoVerifiedIdentity},
+		},
+		{
+			name: "high-risk country and foreign currency",
+			profile: Profile{
+				DateOfBirth:   time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+				Countries:     []string{"us", "ir"},
+				Documents:     []Document{verifiedPassport("US")},
+				ProductsKnown: true,
+				Products: []Product{
+					{AccountType: "Checking", Role: "PowerOfAttorney", Currency: "EUR"},
+					{AccountType: "Savings", Role: "PrimaryHolder", Currency: "EUR"},
+				},
+			},
+			wantRating: RatingHigh,
+			wantScore:  65,
+			wantCodes:  []string{FactorHighRiskCountry, FactorProduct, FactorForeignCurrency},
+		},
+		{
+			name: "expired and rejected documents",
+			profile: Profile{
+				Countries: []string{"US"},
+				Documents: []Document{
+					{Type: "Passport", Identity: true, Status: "Verified", ExpiryDate: assessedAt.AddDate(0, 0, -1), Country: "US"},
+					{Type: "DriversLicense", Identity: true, Status: "Rejected", Country: "NG"},
+				},
+			},
+			wantRating: RatingHigh,
+			wantScore:  65,
+			wantCodes:  []string{FactorElevatedRiskCountry, FactorExpiredDocument, FactorRejectedDocument, FactorNoVerifiedIdentity},
+		},
+		{
+			name: "confirmed screening hit",
+			profile: Profile{
+				Documents:              []Document{verifiedPassport("US")},
+				ConfirmedScreeningHits: 1,
+			},
+			wantRating: RatingHigh,
+			wantScore:  0,
+			wantCodes:  []string{FactorScreeningHitConfirmed},
+		},
+		{
+			name: "products unknown",
+			profile: Profile{
+				Documents:            []Document{verifiedPassport("US")},
+				Products:             []Product{{AccountType: "Checking", Currency: "EUR"}},
+				PendingScreeningHits: 1,
+			},
+			wantRating: RatingMedium,
+			wantScore:  30,
+			wantCodes:  []string{FactorScreeningHitPending},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := model.Assess(tt.profile, assessedAt)
+			if got.Rating != tt.wantRating || got.Score != tt.wantScore {
+				t.Errorf("Assess() = %s %d, want %s %d (factors %v)", got.Rating, got.Score, tt.wantRating, tt.wantScore, got.Factors)
+			}
+			if codes := factorCodes(got); strings.Join(codes, ",") != strings.Join(tt.wantCodes, ",") {
+				t.Errorf("factors = %v, want %v", codes, tt.wantCodes)
+			}
+			for _, f := range got.Factors {
+				if f.Description == "" {
+					t.Errorf("factor %s has no description", f.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestModel_NextReview(t *testing.T) {
+	model := NewModel(DefaultConfig())
+	from := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rating Rating
+		want   time.Time
+	}{
+		{RatingLow, time.Date(2029, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{RatingMedium, time.Date(2028, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{RatingHigh, time.Date(2027, 1, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := model.NextReview(tt.rating, from); !got.Equal(tt.want) {
+			t.Errorf("NextReview(%s) = %v, want %v", tt.rating, got, tt.want)
+		}
+	}
+	if got := model.ReviewNotice(); got != 30*24*time.Hour {
+		t.Errorf("ReviewNotice() = %v", got)
+	}
+}
+
+func TestAgeAt(t *testing.T) {
+	dob := time.Date(2000, 6, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		at   time.Time
+		want int
+	}{
+		{time.Date(2021, 6, 14, 0, 0, 0, 0, time.UTC), 20},
+		{time.Date(2021, 6, 15, 0, 0, 0, 0, time.UTC), 21},
+		{time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), 21},
+	}
+	for _, tt := range tests {
+		if got := ageAt(dob, tt.at); got != tt.want {
+			t.Errorf("ageAt(%v) = %d, want %d", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := LoadConfig(filepath.Join("..", "..", "config", "risk_model.json"))
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if cfg.Version == "" || cfg.HighThreshold != 50 || cfg.ReviewMonths.High != 12 {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr string
+	}{
+		{"no version", func(c *Config) { c.Version = "" }, "no version"},
+		{"bad country", func(c *Config) { c.HighRiskCountries = []string{"Iran"} }, "alpha-2"},
+		{"country in both lists", func(c *Config) { c.ElevatedRiskCountries = append(c.ElevatedRiskCountries, "IR") }, "already listed"},
+		{"thresholds out of order", func(c *Config) { c.HighThreshold = c.MediumThreshold }, "high threshold"},
+		{"review longer for high", func(c *Config) { c.ReviewMonths.High = 48 }, "review months"},
+		{"negative points", func(c *Config) { c.Points.ExpiredDocument = -1 }, "negative"},
+		{"bad currency", func(c *Config) { c.HomeCurrency = "usd" }, "home currency"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ParseConfig([]byte(`{"version": 1}`)); err == nil {
+		t.Error("ParseConfig() accepted a malformed document")
+	}
+}
@@ -0,0 +1,76 @@
+package screening
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// countryNames maps the English country names lists use, including the SDN
+// list's inverted forms, to ISO 3166 alpha-2 codes. Names missing from the
+// table are left out of screening rather than guessed.
+var countryNames = map[string]string{
+	"afghanistan": "AF", "albania": "AL", "algeria": "DZ", "angola": "AO",
+	"argentina": "AR", "armenia": "AM", "australia": "AU", "austria": "AT",
+	"azerbaijan": "AZ", "bahrain": "BH", "bangladesh": "BD", "belarus": "BY",
+	"belgium": "BE", "bolivia": "BO", "bosnia and herzegovina": "BA", "brazil": "BR",
+	"bulgaria": "BG", "burma": "MM", "myanmar": "MM", "burundi": "BI",
+	"cambodia": "KH", "cameroon": "CM", "canada": "CA", "central african republic": "CF",
+	"chad": "TD", "chile": "CL", "china": "CN", "colombia": "CO",
+	"congo, democratic republic of the": "CD", "democratic republic of the congo": "CD",
+	"congo, republic of the": "CG", "republic of the congo": "CG", "croatia": "HR",
+	"cuba": "CU", "cyprus": "CY", "czech republic": "CZ", "czechia": "CZ",
+	"denmark": "DK", "ecuador": "EC", "egypt": "EG", "eritrea": "ER",
+	"estonia": "EE", "ethiopia": "ET", "finland": "FI", "france": "FR",
+	"georgia": "GE", "germany": "DE", "ghana": "GH", "greece": "GR",
+	"guatemala": "GT", "guinea": "GN", "guinea-bissau": "GW", "haiti": "HT",
+	"honduras": "HN", "hong kong": "HK", "hungary": "HU", "india": "IN",
+	"indonesia": "ID", "iran": "IR", "iraq": "IQ", "ireland": "IE",
+	"israel": "IL", "italy": "IT", "japan": "JP", "jordan": "JO",
+	"kazakhstan": "KZ", "kenya": "KE", "korea, north": "KP", "north korea": "KP",
+	"korea, south": "KR", "south korea": "KR", "kosovo": "XK", "kuwait": "KW",
+	"kyrgyzstan": "KG", "laos": "LA", "latvia": "LV", "lebanon": "LB",
+	"liberia": "LR", "libya": "LY", "lithuania": "LT", "luxembourg": "LU",
+	"malaysia": "MY", "mali": "ML", "malta": "MT", "mexico": "MX",
+	"moldova": "MD", "montenegro": "ME", "morocco": "MA", "mozambique": "MZ",
+	"netherlands": "NL", "new zealand": "NZ", "nicaragua": "NI", "niger": "NE",
+	"nigeria": "NG", "north macedonia": "MK", "norway": "NO", "oman": "OM",
+	"pakistan": "PK", "panama": "PA", "paraguay": "PY", "peru": "PE",
+	"philippines": "PH", "poland": "PL", "portugal": "PT", "qatar": "QA",
+	"romania": "RO", "russia": "RU", "rwanda": "RW", "saudi arabia": "SA",
+	"senegal": "SN", "serbia": "RS", "singapore": "SG", "slovakia": "SK",
+	"slovenia": "SI", "somalia": "SO", "south africa": "ZA", "south sudan": "SS",
+	"spain": "ES", "sri lanka": "LK", "sudan": "SD", "sweden": "SE",
+	"switzerland": "CH", "syria": "SY", "taiwan": "TW", "tajikistan": "TJ",
+	"tanzania": "TZ", "thailand": "TH", "tunisia": "TN", "turkey": "TR",
+	"turkiye": "TR", "turkmenistan": "TM", "uganda": "UG", "ukraine": "UA",
+	"united arab emirates": "AE", "united kingdom": "GB", "united states": "US",
+	"uruguay": "UY", "uzbekistan": "UZ", "venezuela": "VE", "vietnam": "VN",
+	"west bank": "PS", "palestinian territories": "PS", "yemen": "YE",
+	"zambia": "ZM", "zimbabwe": "ZW",
+}
+
+var countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// countryCodes converts country codes and names to a sorted set of codes
+func countryCodes(values []string) []string {
+	seen := make(map[string]bool)
+	var codes []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		code := strings.ToUpper(value)
+		if !countryCodeRegex.MatchString(code) {
+			code = countryNames[strings.Join(strings.Fields(strings.ToLower(value)), " ")]
+			if code == "" {
+				// Spellings with accents, such as "Türkiye"
+				code = countryNames[strings.Join(Normalize(value), " ")]
+			}
+		}
+		if code != "" && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
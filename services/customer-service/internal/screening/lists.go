@@ -0,0 +1,381 @@
+package screening
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EntryType is the kind of party a list entry designates
+type EntryType string
+
+const (
+	EntryTypeIndividual EntryType = "Individual"
+	EntryTypeEntity     EntryType = "Entity"
+	EntryTypeVessel     EntryType = "Vessel"
+	EntryTypeAircraft   EntryType = "Aircraft"
+)
+
+// parseEntryType reads the entry types lists use, where the SDN list marks
+// entities with "-0-"
+func parseEntryType(value string) (EntryType, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "individual":
+		return EntryTypeIndividual, nil
+	case "entity", "", "-0-":
+		return EntryTypeEntity, nil
+	case "vessel":
+		return EntryTypeVessel, nil
+	case "aircraft":
+		return EntryTypeAircraft, nil
+	}
+	return "", fmt.Errorf("unknown entry type %q", value)
+}
+
+// Date is a date of birth as precisely as a list records it; Month and Day
+// are zero when unknown
+type Date struct {
+	Year, Month, Day int
+}
+
+// Entry is one designated party on a list
+type Entry struct {
+	ID           string // The publisher's identifier, unique within the list
+	Type         EntryType
+	Name         string
+	Aliases      []string
+	DatesOfBirth []Date
+	Countries    []string // ISO 3166 alpha-2 nationalities, citizenships and addresses
+	Programs     []string
+
+	names []entryName // The name and aliases, prepared for matching
+}
+
+// entryName is a name as written and normalised
+type entryName struct {
+	written string
+	tokens  []string
+}
+
+// List is one version of a sanctions or watch list
+type List struct {
+	Name        string
+	Version     string    // Digest of the file contents
+	PublishedAt time.Time // Zero when the file does not say
+	Entries     []*Entry
+}
+
+// Source is a list file and the name it is screened under
+type Source struct {
+	Name string
+	Path string
+}
+
+// ParseSources reads a comma-separated list of files, each optionally
+// prefixed with the list name, as in "OFAC-SDN=/lists/sdn.xml". A file
+// without a name is screened under its base name in upper case.
+func ParseSources(spec string) ([]Source, error) {
+	var sources []Source
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, path, named := strings.Cut(item, "=")
+		if !named {
+			path = name
+			name = strings.ToUpper(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+		}
+		name, path = strings.TrimSpace(name), strings.TrimSpace(path)
+		if !listNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid list name %q: use letters, digits, '-' and '_'", name)
+		}
+		for _, s := range sources {
+			if s.Name == name {
+				return nil, fmt.Errorf("list %s is configured twice", name)
+			}
+		}
+		sources = append(sources, Source{Name: name, Path: path})
+	}
+	return sources, nil
+}
+
+var listNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,50}$`)
+
+// LoadLists reads every source, failing if any cannot be read
+func LoadLists(sources []Source) ([]*List, error) {
+	lists := make([]*List, 0, len(sources))
+	for _, source := range sources {
+		list, err := LoadList(source)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+	return lists, nil
+}
+
+// LoadList reads a list from a CSV or XML file, chosen by its extension
+func LoadList(source Source) (*List, error) {
+	data, err := os.ReadFile(source.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read list %s: %w", source.Name, err)
+	}
+
+	var list *List
+	switch strings.ToLower(filepath.Ext(source.Path)) {
+	case ".csv":
+		list, err = ParseCSV(source.Name, data)
+	case ".xml":
+		list, err = ParseXML(source.Name, data)
+	default:
+		return nil, fmt.Errorf("list %s: unsupported file type %q", source.Name, filepath.Ext(source.Path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list %s: %w", source.Name, err)
+	}
+	return list, nil
+}
+
+func newList(name string, data []byte) *List {
+	digest := sha256.Sum256(data)
+	return &List{Name: name, Version: hex.EncodeToString(digest[:8])}
+}
+
+// csvColumns are the columns of a list in CSV form. Only uid and name are
+// required; fields holding several values separate them with ';'.
+var csvColumns = []string{"uid", "name", "type", "programs", "aliases", "dates_of_birth", "countries"}
+
+// ParseCSV reads a list in SDN-style CSV with a header row, one entry per
+// row. Dates of birth are ISO dates, possibly only a year and month or a
+// year; countries are ISO codes or English names.
+func ParseCSV(name string, data []byte) (*List, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	index := make(map[string]int)
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))] = i
+	}
+	for _, column := range csvColumns[:2] {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("missing %s column", column)
+		}
+	}
+
+	list := newList(name, data)
+	seen := make(map[string]bool)
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := func(column string) string {
+			if i, ok := index[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		entry := &Entry{
+			ID:       field("uid"),
+			Name:     field("name"),
+			Aliases:  splitValues(field("aliases")),
+			Programs: splitValues(field("programs")),
+		}
+		if entry.Type, err = parseEntryType(field("type")); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		for _, value := range splitValues(field("dates_of_birth")) {
+			dates, err := parseListDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			entry.DatesOfBirth = append(entry.DatesOfBirth, dates...)
+		}
+		entry.Countries = countryCodes(splitValues(field("countries")))
+
+		if err := list.add(entry, seen); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return list, nil
+}
+
+func splitValues(field string) []string {
+	var values []string
+	for _, value := range strings.Split(field, ";") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// sdnDocument is the part of the OFAC SDN XML schema screening reads
+type sdnDocument struct {
+	Publish struct {
+		Date string `xml:"Publish_Date"`
+	} `xml:"publshInformation"`
+	Entries []struct {
+		UID       string   `xml:"uid"`
+		FirstName string   `xml:"firstName"`
+		LastName  string   `xml:"lastName"`
+		Type      string   `xml:"sdnType"`
+		Programs  []string `xml:"programList>program"`
+		Aliases   []struct {
+			Category  string `xml:"category"`
+			FirstName string `xml:"firstName"`
+			LastName  string `xml:"lastName"`
+		} `xml:"akaList>aka"`
+		DatesOfBirth  []string `xml:"dateOfBirthList>dateOfBirthItem>dateOfBirth"`
+		Nationalities []string `xml:"nationalityList>nationality>country"`
+		Citizenships  []string `xml:"citizenshipList>citizenship>country"`
+		Addresses     []string `xml:"addressList>address>country"`
+	} `xml:"sdnEntry"`
+}
+
+// ParseXML reads a list in the OFAC SDN XML format. Weak aliases, which
+// OFAC advises are too broad to screen against, are left out.
+func ParseXML(name string, data []byte) (*List, error) {
+	var doc sdnDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse XML: %w", err)
+	}
+
+	list := newList(name, data)
+	if doc.Publish.Date != "" {
+		published, err := time.Parse("01/02/2006", strings.TrimSpace(doc.Publish.Date))
+		if err != nil {
+			return nil, fmt.Errorf("invalid Publish_Date %q", doc.Publish.Date)
+		}
+		list.PublishedAt = published
+	}
+
+	seen := make(map[string]bool)
+	for _, e := range doc.Entries {
+		entry := &Entry{
+			ID:       strings.TrimSpace(e.UID),
+			Name:     joinName(e.FirstName, e.LastName),
+			Programs: e.Programs,
+		}
+		var err error
+		if entry.Type, err = parseEntryType(e.Type); err != nil {
+			return nil, fmt.Errorf("entry %s: %w", entry.ID, err)
+		}
+		for _, aka := range e.Aliases {
+			if !strings.EqualFold(strings.TrimSpace(aka.Category), "weak") {
+				entry.Aliases = append(entry.Aliases, joinName(aka.FirstName, aka.LastName))
+			}
+		}
+		for _, value := range e.DatesOfBirth {
+			dates, err := parseListDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("entry %s: %w", entry.ID, err)
+			}
+			entry.DatesOfBirth = append(entry.DatesOfBirth, dates...)
+		}
+		countries := append(append(append([]string(nil), e.Nationalities...), e.Citizenships...), e.Addresses...)
+		entry.Countries = countryCodes(countries)
+
+		if err := list.add(entry, seen); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func joinName(first, last string) string {
+	return strings.TrimSpace(strings.TrimSpace(first) + " " + strings.TrimSpace(last))
+}
+
+// add validates an entry and prepares its names for matching
+func (l *List) add(entry *Entry, seen map[string]bool) error {
+	if entry.ID == "" {
+		return errors.New("entry has no uid")
+	}
+	if seen[entry.ID] {
+		return fmt.Errorf("duplicate uid %s", entry.ID)
+	}
+	if entry.Name == "" {
+		return fmt.Errorf("entry %s has no name", entry.ID)
+	}
+	seen[entry.ID] = true
+
+	for _, name := range append([]string{entry.Name}, entry.Aliases...) {
+		if tokens := Normalize(name); len(tokens) > 0 {
+			entry.names = append(entry.names, entryName{written: name, tokens: tokens})
+		}
+	}
+	l.Entries = append(l.Entries, entry)
+	return nil
+}
+
+// maxDateRange bounds how many years a "1960 to 1965" range expands to
+const maxDateRange = 10
+
+// parseListDate reads a date of birth in the forms lists use: ISO dates
+// ("1960-01-12", "1960-01", "1960"), SDN dates ("12 Jan 1960", "Jan
+// 1960"), approximations ("circa 1960") and ranges of years ("1960 to
+// 1962"), which expand to each year
+func parseListDate(value string) ([]Date, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimSpace(strings.TrimPrefix(strings.ToLower(value), "circa"))
+
+	if from, to, isRange := strings.Cut(value, " to "); isRange {
+		first, err1 := strconv.Atoi(strings.TrimSpace(from))
+		last, err2 := strconv.Atoi(strings.TrimSpace(to))
+		if err1 != nil || err2 != nil || last < first || last-first > maxDateRange {
+			return nil, fmt.Errorf("invalid date of birth range %q", value)
+		}
+		var dates []Date
+		for year := first; year <= last; year++ {
+			dates = append(dates, Date{Year: year})
+		}
+		return dates, nil
+	}
+
+	for _, layout := range []struct {
+		layout     string
+		month, day bool
+	}{
+		{"2006-01-02", true, true},
+		{"2 Jan 2006", true, true},
+		{"2006-01", true, false},
+		{"Jan 2006", true, false},
+		{"2006", false, false},
+	} {
+		t, err := time.Parse(layout.layout, value)
+		if err != nil {
+			continue
+		}
+		date := Date{Year: t.Year()}
+		if layout.month {
+			date.Month = int(t.Month())
+		}
+		if layout.day {
+			date.Day = t.Day()
+		}
+		return []Date{date}, nil
+	}
+	return nil, fmt.Errorf("invalid date of birth %q", value)
+}
@@ -0,0 +1,182 @@
+package screening
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// transliterations spells letters outside ASCII the way list publishers
+// romanise them, so "Müller", "Muller" and "Мюллер" compare alike. Cyrillic
+// follows the usual English romanisation.
+var transliterations = func() map[rune]string {
+	table := make(map[rune]string)
+	for ascii, letters := range map[string]string{
+		"a": "àáâãäåāăąа", "ae": "æ", "b": "б", "c": "çćĉċč", "ch": "ч",
+		"d": "ďđðд", "e": "èéêëēĕėęěеёэ", "f": "ф", "g": "ĝğġģгґ", "h": "ĥħ",
+		"i": "ìíîïĩīĭįıиі", "ij": "ĳ", "j": "ĵ", "k": "ķк", "kh": "х",
+		"l": "ĺļľŀłл", "m": "м", "n": "ñńņňŉн", "o": "òóôõöøōŏőо", "oe": "œ",
+		"p": "п", "r": "ŕŗřр", "s": "śŝşšșс", "sh": "ш", "shch": "щ", "ss": "ß",
+		"t": "ţťŧțт", "th": "þ", "ts": "ц", "u": "ùúûüũūŭůűųу", "v": "в",
+		"w": "ŵ", "y": "ýÿŷйы", "ya": "я", "ye": "є", "yi": "ї", "yu": "ю",
+		"z": "źżžз", "zh": "ж", "": "ъь",
+	} {
+		for _, r := range letters {
+			table[r] = ascii
+		}
+	}
+	return table
+}()
+
+// honorifics are dropped from names before comparing them
+var honorifics = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "miss": true, "dr": true, "sir": true,
+	"prof": true, "sheikh": true, "haji": true,
+}
+
+// Normalize transliterates a name to lower-case ASCII where it can and
+// splits it into words, dropping punctuation and honorifics. Apostrophes
+// join the letters around them, so "O'Neil" is one word.
+func Normalize(name string) []string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if ascii, ok := transliterations[r]; ok {
+			b.WriteString(ascii)
+			continue
+		}
+		switch {
+		case r == '\'' || r == '’' || r == '`':
+			// Join the letters around an apostrophe
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			// Scripts without a transliteration still match themselves
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	var tokens []string
+	for _, token := range strings.Fields(b.String()) {
+		if !honorifics[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of two strings, from 0 for
+// nothing in common to 1 for identical strings
+func JaroWinkler(a, b string) float64 {
+	s, t := []rune(a), []rune(b)
+	if len(s) == 0 && len(t) == 0 {
+		return 1
+	}
+	if len(s) == 0 || len(t) == 0 {
+		return 0
+	}
+
+	window := max(len(s), len(t))/2 - 1
+	window = max(window, 0)
+
+	sMatched := make([]bool, len(s))
+	tMatched := make([]bool, len(t))
+	matches := 0
+	for i := range s {
+		lo, hi := max(0, i-window), min(len(t), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !tMatched[j] && s[i] == t[j] {
+				sMatched[i], tMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Half the matched characters that appear in a different order
+	transpositions, j := 0, 0
+	for i := range s {
+		if !sMatched[i] {
+			continue
+		}
+		for !tMatched[j] {
+			j++
+		}
+		if s[i] != t[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s)) + m/float64(len(t)) + (m-float64(transpositions/2))/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(s), len(t)) && s[prefix] == t[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// unmatchedTokenPenalty is taken off a token-by-token score for every word
+// of the longer name left without a partner, such as a middle name one side
+// does not record
+const unmatchedTokenPenalty = 0.05
+
+// loneTokenPenalty is taken off when a one-word name is compared with a
+// longer one, since a single given name or surname says little on its own
+const loneTokenPenalty = 0.1
+
+// NameScore compares two normalised names, returning the best of comparing
+// them as written, with their words sorted, and word by word in any order
+func NameScore(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	score := JaroWinkler(strings.Join(a, " "), strings.Join(b, " "))
+	score = max(score, JaroWinkler(sortedJoin(a), sortedJoin(b)))
+	// The words run together catch names split differently, as in
+	// "Abdul Rahman" and "Abdulrahman"
+	score = max(score, JaroWinkler(strings.Join(a, ""), strings.Join(b, "")))
+	score = max(score, tokenScore(a, b))
+	if min(len(a), len(b)) == 1 && max(len(a), len(b)) > 1 {
+		score -= loneTokenPenalty
+	}
+	return max(score, 0)
+}
+
+func sortedJoin(tokens []string) string {
+	sorted := append([]string(nil), tokens...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, " ")
+}
+
+// tokenScore pairs every word of the shorter name with its closest unused
+// word in the longer one, weighting each pair by length
+func tokenScore(a, b []string) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	used := make([]bool, len(b))
+	var weighted, weights float64
+	for _, token := range a {
+		best, bestIndex := 0.0, -1
+		for j, other := range b {
+			if used[j] {
+				continue
+			}
+			if score := JaroWinkler(token, other); score > best {
+				best, bestIndex = score, j
+			}
+		}
+		if bestIndex >= 0 {
+			used[bestIndex] = true
+		}
+		weight := float64(len(token))
+		weighted += best * weight
+		weights += weight
+	}
+	return weighted/weights - float64(len(b)-len(a))*unmatchedTokenPenalty
+}
@@ -0,0 +1,212 @@
+package screening
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Config sets how close a customer has to be to a list entry to be a
+// potential match
+type Config struct {
+	// NameThreshold is the lowest name similarity, from 0 to 1, at which an
+	// entry is considered at all
+	NameThreshold float64
+	// HitThreshold is the lowest score, the name similarity adjusted for
+	// date of birth and country, reported as a potential match
+	HitThreshold float64
+	// DOBYearTolerance is how many years a recorded date of birth may be
+	// out before it counts against a match
+	DOBYearTolerance int
+}
+
+// DefaultConfig returns the thresholds used when none are configured
+func DefaultConfig() Config {
+	return Config{NameThreshold: 0.85, HitThreshold: 0.88, DOBYearTolerance: 1}
+}
+
+// Validate checks the thresholds are usable
+func (c Config) Validate() error {
+	if c.NameThreshold <= 0 || c.NameThreshold > 1 {
+		return errors.New("name threshold must be above 0 and at most 1")
+	}
+	if c.HitThreshold <= 0 || c.HitThreshold > 1 {
+		return errors.New("hit threshold must be above 0 and at most 1")
+	}
+	if c.DOBYearTolerance < 0 || c.DOBYearTolerance > 5 {
+		return errors.New("date of birth year tolerance must be between 0 and 5")
+	}
+	return nil
+}
+
+// Adjustments to the name similarity for what else is known about the parties
+const (
+	dobExactBoost      = 0.05
+	dobMismatchPenalty = 0.15
+	countryBoost       = 0.03
+)
+
+// DOBMatch is how a customer's date of birth compares with an entry's
+type DOBMatch string
+
+const (
+	// DOBExact is the same full date of birth
+	DOBExact DOBMatch = "Exact"
+	// DOBPartial is a date of birth consistent with the entry's as far as
+	// the list records it, or within the year tolerance
+	DOBPartial DOBMatch = "Partial"
+	// DOBMismatch is a date of birth the entry rules out
+	DOBMismatch DOBMatch = "Mismatch"
+	// DOBUnknown is when either side has no date of birth
+	DOBUnknown DOBMatch = "Unknown"
+)
+
+// Subject is the customer being screened
+type Subject struct {
+	Name        string
+	DateOfBirth time.Time // Zero when unknown
+	Countries   []string  // ISO 3166 alpha-2 codes or English names, such as nationality and addresses
+}
+
+// Match is a list entry a subject may be
+type Match struct {
+	ListName     string
+	ListVersion  string
+	EntryID      string
+	EntryName    string
+	EntryType    EntryType
+	Programs     []string
+	MatchedName  string // The entry's name or alias the subject resembles most
+	NameScore    float64
+	Score        float64
+	DOB          DOBMatch
+	CountryMatch bool
+}
+
+// Screener screens subjects against the lists currently loaded. Lists may
+// be replaced while screening is under way.
+type Screener struct {
+	cfg   Config
+	mu    sync.RWMutex
+	lists []*List
+}
+
+// NewScreener creates a Screener with no lists loaded
+func NewScreener(cfg Config) *Screener {
+	return &Screener{cfg: cfg}
+}
+
+// SetLists replaces the lists screened against
+func (s *Screener) SetLists(lists []*List) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lists = lists
+}
+
+// Lists returns the lists screened against
+func (s *Screener) Lists() []*List {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lists
+}
+
+// Screen returns the potential matches for a subject, best first. Vessels
+// and aircraft are not screened against.
+func (s *Screener) Screen(subject Subject) []Match {
+	name := Normalize(subject.Name)
+	if len(name) == 0 {
+		return nil
+	}
+
+	countries := countryCodes(subject.Countries)
+
+	var matches []Match
+	for _, list := range s.Lists() {
+		for _, entry := range list.Entries {
+			if entry.Type == EntryTypeVessel || entry.Type == EntryTypeAircraft {
+				continue
+			}
+
+			nameScore, matched := 0.0, ""
+			for _, entryName := range entry.names {
+				if score := NameScore(name, entryName.tokens); score > nameScore {
+					nameScore, matched = score, entryName.written
+				}
+			}
+			if nameScore < s.cfg.NameThreshold {
+				continue
+			}
+
+			match := Match{
+				ListName:    list.Name,
+				ListVersion: list.Version,
+				EntryID:     entry.ID,
+				EntryName:   entry.Name,
+				EntryType:   entry.Type,
+				Programs:    entry.Programs,
+				MatchedName: matched,
+				NameScore:   nameScore,
+				Score:       nameScore,
+				DOB:         dobMatch(subject.DateOfBirth, entry.DatesOfBirth, s.cfg.DOBYearTolerance),
+			}
+			switch match.DOB {
+			case DOBExact:
+				match.Score += dobExactBoost
+			case DOBMismatch:
+				match.Score -= dobMismatchPenalty
+			}
+			for _, country := range countries {
+				if slices.Contains(entry.Countries, country) {
+					match.CountryMatch = true
+					match.Score += countryBoost
+					break
+				}
+			}
+			match.Score = min(max(match.Score, 0), 1)
+
+			if match.Score >= s.cfg.HitThreshold {
+				matches = append(matches, match)
+			}
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		if matches[i].ListName != matches[j].ListName {
+			return matches[i].ListName < matches[j].ListName
+		}
+		return matches[i].EntryID < matches[j].EntryID
+	})
+	return matches
+}
+
+func dobMatch(dob time.Time, dates []Date, yearTolerance int) DOBMatch {
+	if dob.IsZero() || len(dates) == 0 {
+		return DOBUnknown
+	}
+	result := DOBMismatch
+	for _, d := range dates {
+		sameYear := d.Year == dob.Year()
+		consistent := sameYear &&
+			(d.Month == 0 || d.Month == int(dob.Month())) &&
+			(d.Day == 0 || d.Day == dob.Day())
+		if consistent && d.Month != 0 && d.Day != 0 {
+			return DOBExact
+		}
+		if consistent || abs(d.Year-dob.Year()) <= yearTolerance {
+			result = DOBPartial
+		}
+	}
+	return result
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
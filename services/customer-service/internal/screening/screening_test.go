@@ -0,0 +1,337 @@
+package screening
+
+import (
+	"math"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"martha", "marhta", 0.961},
+		{"dwayne", "duane", 0.840},
+		{"dixon", "dicksonx", 0.813},
+		{"same", "same", 1},
+		{"abc", "xyz", 0},
+		{"", "", 1},
+		{"abc", "", 0},
+	}
+	for _, tt := range tests {
+		if got := JaroWinkler(tt.a, tt.b); math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("JaroWinkler(%q, %q) = %.3f, want %.3f", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"Dr. José O'Neil-García", []string{"jose", "oneil", "garcia"}},
+		{"ПЕТРОВ Иван", []string{"petrov", "ivan"}},
+		{"MÜLLER, Hans", []string{"muller", "hans"}},
+		{"  ", nil},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.name); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNameScore(t *testing.T) {
+	score := func(a, b string) float64 { return NameScore(Normalize(a), Normalize(b)) }
+
+	if got := score("Ivan Petrov", "PETROV, Ivan"); got != 1 {
+		t.Errorf("reordered name scored %.3f, want 1", got)
+	}
+	if got := score("Abdulrahman Rashid", "Abdul Rahman Rashid"); got < 0.95 {
+		t.Errorf("name split differently scored %.3f, want at least 0.95", got)
+	}
+	if got := score("John Smith", "John Michael Smith"); got < 0.9 || got >= 1 {
+		t.Errorf("missing middle name scored %.3f, want between 0.9 and 1", got)
+	}
+	if got := score("Jane Doe", "Ivan Petrov"); got > 0.7 {
+		t.Errorf("unrelated names scored %.3f, want at most 0.7", got)
+	}
+	if got := score("Ivan", "Ivan Petrov"); got >= DefaultConfig().HitThreshold {
+		t.Errorf("lone first name scored %.3f, want below the hit threshold", got)
+	}
+}
+
+func TestParseListDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []Date
+	}{
+		{"1960-01-12", []Date{{1960, 1, 12}}},
+		{"12 Jan 1960", []Date{{1960, 1, 12}}},
+		{"12 JAN 1960", []Date{{1960, 1, 12}}},
+		{"1960-01", []Date{{1960, 1, 0}}},
+		{"Jan 1960", []Date{{1960, 1, 0}}},
+		{"circa 1962", []Date{{1962, 0, 0}}},
+		{"1965 to 1967", []Date{{1965, 0, 0}, {1966, 0, 0}, {1967, 0, 0}}},
+	}
+	for _, tt := range tests {
+		got, err := parseListDate(tt.value)
+		if err != nil {
+			t.Errorf("parseListDate(%q): %v", tt.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseListDate(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"soon", "1967 to 1965", "1900 to 1950", "13/01/1960"} {
+		if _, err := parseListDate(value); err == nil {
+			t.Errorf("parseListDate(%q) succeeded, want error", value)
+		}
+	}
+}
+
+func TestParseSources(t *testing.T) {
+	sources, err := ParseSources("OFAC-SDN=/lists/sdn.xml, /lists/uk_hmt.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Source{{Name: "OFAC-SDN", Path: "/lists/sdn.xml"}, {Name: "UK_HMT", Path: "/lists/uk_hmt.csv"}}
+	if !reflect.DeepEqual(sources, want) {
+		t.Errorf("got %v, want %v", sources, want)
+	}
+
+	if sources, err := ParseSources(""); err != nil || len(sources) != 0 {
+		t.Errorf("empty spec: got %v, %v", sources, err)
+	}
+	if _, err := ParseSources("A=/x.csv,A=/y.csv"); err == nil {
+		t.Error("duplicate list name accepted")
+	}
+	if _, err := ParseSources("bad name=/x.csv"); err == nil {
+		t.Error("invalid list name accepted")
+	}
+}
+
+func loadTestList(t *testing.T, file string) *List {
+	t.Helper()
+	list, err := LoadList(Source{Name: "TEST", Path: filepath.Join("testdata", file)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return list
+}
+
+func TestParseCSV(t *testing.T) {
+	list := loadTestList(t, "sdn.csv")
+
+	if len(list.Entries) != 5 {
+		t.Fatalf("got %d entries, want 5", len(list.Entries))
+	}
+	if len(list.Version) != 16 {
+		t.Errorf("version %q is not a 16 character digest", list.Version)
+	}
+
+	petrov := list.Entries[0]
+	if petrov.ID != "101" || petrov.Type != EntryTypeIndividual {
+		t.Errorf("first entry is %s %s", petrov.ID, petrov.Type)
+	}
+	if !reflect.DeepEqual(petrov.Aliases, []string{"PETROV, Ivan", "ПЕТРОВ Иван"}) {
+		t.Errorf("aliases = %q", petrov.Aliases)
+	}
+	if !reflect.DeepEqual(petrov.DatesOfBirth, []Date{{1971, 3, 14}}) {
+		t.Errorf("dates of birth = %v", petrov.DatesOfBirth)
+	}
+	if !reflect.DeepEqual(petrov.Countries, []string{"RU"}) {
+		t.Errorf("countries = %v", petrov.Countries)
+	}
+
+	if got := list.Entries[1].Countries; !reflect.DeepEqual(got, []string{"LB", "SY"}) {
+		t.Errorf("codes and names mixed: countries = %v", got)
+	}
+	if got := list.Entries[2].Countries; !reflect.DeepEqual(got, []string{"AE", "IR"}) {
+		t.Errorf("entity countries = %v", got)
+	}
+	if got := list.Entries[3].Type; got != EntryTypeVessel {
+		t.Errorf("vessel type = %s", got)
+	}
+}
+
+func TestParseCSV_Errors(t *testing.T) {
+	tests := map[string]string{
+		"missing name column": "uid,type\n1,Individual\n",
+		"unknown type":        "uid,name,type\n1,A B,Spaceship\n",
+		"duplicate uid":       "uid,name\n1,A B\n1,C D\n",
+		"missing uid":         "uid,name\n,A B\n",
+		"invalid date":        "uid,name,dates_of_birth\n1,A B,someday\n",
+	}
+	for name, data := range tests {
+		if _, err := ParseCSV("TEST", []byte(data)); err == nil {
+			t.Errorf("%s: accepted", name)
+		}
+	}
+}
+
+func TestParseXML(t *testing.T) {
+	list := loadTestList(t, "sdn.xml")
+
+	if want := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC); !list.PublishedAt.Equal(want) {
+		t.Errorf("published at %v, want %v", list.PublishedAt, want)
+	}
+	if len(list.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(list.Entries))
+	}
+
+	kim := list.Entries[0]
+	if kim.Name != "Kim CHOL SU" || kim.Type != EntryTypeIndividual {
+		t.Errorf("first entry is %q %s", kim.Name, kim.Type)
+	}
+	if !reflect.DeepEqual(kim.Aliases, []string{"Chol-su KIM"}) {
+		t.Errorf("aliases = %q, want the weak alias left out", kim.Aliases)
+	}
+	if !reflect.DeepEqual(kim.DatesOfBirth, []Date{{1960, 1, 12}, {1962, 0, 0}}) {
+		t.Errorf("dates of birth = %v", kim.DatesOfBirth)
+	}
+	if !reflect.DeepEqual(kim.Countries, []string{"KP"}) {
+		t.Errorf("countries = %v", kim.Countries)
+	}
+
+	koryo := list.Entries[1]
+	if koryo.Type != EntryTypeEntity || !reflect.DeepEqual(koryo.Countries, []string{"KP"}) {
+		t.Errorf("entity is %s in %v", koryo.Type, koryo.Countries)
+	}
+}
+
+func TestScreener_Screen(t *testing.T) {
+	screener := NewScreener(DefaultConfig())
+	screener.SetLists([]*List{loadTestList(t, "sdn.csv"), loadTestList(t, "sdn.xml")})
+
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	tests := []struct {
+		name        string
+		subject     Subject
+		wantEntry   string // Empty for no match
+		wantDOB     DOBMatch
+		wantCountry bool
+	}{
+		{
+			name:        "reordered name with date of birth and country",
+			subject:     Subject{Name: "Ivan Petrov", DateOfBirth: date("1971-03-14"), Countries: []string{"RU"}},
+			wantEntry:   "101",
+			wantDOB:     DOBExact,
+			wantCountry: true,
+		},
+		{
+			name:      "cyrillic spelling",
+			subject:   Subject{Name: "Иван Петров"},
+			wantEntry: "101",
+			wantDOB:   DOBUnknown,
+		},
+		{
+			name:      "misspelt name",
+			subject:   Subject{Name: "Ivan Petrow", DateOfBirth: date("1971-03-14")},
+			wantEntry: "101",
+			wantDOB:   DOBExact,
+		},
+		{
+			name:    "date of birth rules the entry out",
+			subject: Subject{Name: "Ivan Petrov", DateOfBirth: date("1990-01-01")},
+		},
+		{
+			name:        "alias within a range of years",
+			subject:     Subject{Name: "Abdulrahman Rashid", DateOfBirth: date("1966-05-05"), Countries: []string{"Lebanon"}},
+			wantEntry:   "102",
+			wantDOB:     DOBPartial,
+			wantCountry: true,
+		},
+		{
+			name:      "transliterated name with month of birth",
+			subject:   Subject{Name: "Hans Muller", DateOfBirth: date("1958-01-20")},
+			wantEntry: "105",
+			wantDOB:   DOBPartial,
+		},
+		{
+			name:      "entity without legal form",
+			subject:   Subject{Name: "Golden Sea Trading"},
+			wantEntry: "103",
+			wantDOB:   DOBUnknown,
+		},
+		{
+			name:        "xml list",
+			subject:     Subject{Name: "Kim Chol-su", DateOfBirth: date("1960-01-12"), Countries: []string{"kp"}},
+			wantEntry:   "7001",
+			wantDOB:     DOBExact,
+			wantCountry: true,
+		},
+		{
+			name:    "vessels are not screened",
+			subject: Subject{Name: "Ocean Star"},
+		},
+		{
+			name:    "unrelated name",
+			subject: Subject{Name: "Jane Doe", DateOfBirth: date("1971-03-14")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := screener.Screen(tt.subject)
+			if tt.wantEntry == "" {
+				if len(matches) != 0 {
+					t.Fatalf("got %d matches, first %s %q scoring %.3f", len(matches), matches[0].EntryID, matches[0].MatchedName, matches[0].Score)
+				}
+				return
+			}
+			if len(matches) == 0 {
+				t.Fatal("got no matches")
+			}
+			m := matches[0]
+			if m.EntryID != tt.wantEntry {
+				t.Errorf("best match is %s %q, want %s", m.EntryID, m.MatchedName, tt.wantEntry)
+			}
+			if m.DOB != tt.wantDOB {
+				t.Errorf("DOB = %s, want %s", m.DOB, tt.wantDOB)
+			}
+			if m.CountryMatch != tt.wantCountry {
+				t.Errorf("CountryMatch = %v, want %v", m.CountryMatch, tt.wantCountry)
+			}
+			if m.Score < DefaultConfig().HitThreshold || m.Score > 1 {
+				t.Errorf("score %.3f out of range", m.Score)
+			}
+			if m.ListName != "TEST" || m.ListVersion == "" {
+				t.Errorf("match from list %q version %q", m.ListName, m.ListVersion)
+			}
+		})
+	}
+}
+
+func TestScreener_NoLists(t *testing.T) {
+	if matches := NewScreener(DefaultConfig()).Screen(Subject{Name: "Ivan Petrov"}); len(matches) != 0 {
+		t.Errorf("got %d matches with no lists loaded", len(matches))
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Errorf("default config: %v", err)
+	}
+	for _, cfg := range []Config{
+		{NameThreshold: 0, HitThreshold: 0.9},
+		{NameThreshold: 0.9, HitThreshold: 1.1},
+		{NameThreshold: 0.9, HitThreshold: 0.9, DOBYearTolerance: -1},
+	} {
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("%+v accepted", cfg)
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/postal"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateAddress changes an address. The version in effect is closed when
+// the change takes effect and a new version, keeping its primary flag,
+// replaces it.
+func (s *CustomerService) UpdateAddress(ctx context.Context, req *customerpb.UpdateAddressRequest) (*customerpb.UpdateAddressResponse, error) {
+	if errs := s.validator.ValidateUpdateAddress(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	now := time.Now().UTC()
+	address, customer, err := s.openAddress(ctx, req.GetAddressId(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	at := now
+	if req.GetEffectiveFrom() != nil {
+		at = req.GetEffectiveFrom().AsTime()
+	}
+	next, err := address.Supersede(at)
+	if err != nil {
+		return nil, addressStatus(err, "failed to update address")
+	}
+	if req.GetAddressType() != "" {
+		next.AddressType = models.AddressType(req.GetAddressType())
+	}
+	normalized, warnings := s.validator.NormalizeAddress(postal.Address{
+		Street1:    req.GetStreet1(),
+		Street2:    req.GetStreet2(),
+		City:       req.GetCity(),
+		State:      req.GetState(),
+		PostalCode: req.GetPostalCode(),
+		Country:    req.GetCountry(),
+	})
+	setAddressFields(next, normalized)
+
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		return replaceAddress(ctx, repo, address, next)
+	})
+	if err != nil {
+		return nil, addressStatus(err, "failed to update address")
+	}
+	if err := s.addressesChanged(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.UpdateAddressResponse{
+		Address:  addressModelToProto(next),
+		Previous: addressModelToProto(address),
+		Warnings: warnings,
+	}, nil
+}
+
+// EndDateAddress closes an address, now or at valid_to, keeping it on
+// record. A primary address can only be closed once no other address is
+// left to take over from it.
+func (s *CustomerService) EndDateAddress(ctx context.Context, req *customerpb.EndDateAddressRequest) (*customerpb.EndDateAddressResponse, error) {
+	if errs := s.validator.ValidateEndDateAddress(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	now := time.Now().UTC()
+	address, customer, err := s.openAddress(ctx, req.GetAddressId(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	at := now
+	if req.GetValidTo() != nil {
+		at = req.GetValidTo().AsTime()
+	}
+	if address.IsPrimary {
+		addresses, err := s.repo.GetCustomerAddresses(ctx, customer.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
+		}
+		for _, a := range openAddresses(addresses, at) {
+			if a.ID != address.ID {
+				return nil, status.Errorf(codes.FailedPrecondition, "address is primary; make address %s or another primary first", a.ID)
+			}
+		}
+	}
+
+	if err := address.End(at); err != nil {
+		return nil, addressStatus(err, "failed to end address")
+	}
+	if err := s.repo.EndAddress(ctx, address); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", models.ErrAddressEnded)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to end address: %v", err)
+	}
+	if err := s.addressesChanged(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.EndDateAddressResponse{
+		Address: addressModelToProto(address),
+	}, nil
+}
+
+// SetPrimaryAddress makes an address the customer's primary one, now or
+// from effective_from. The old primary address and the new one each get a
+// new version, so which address was primary on any day is kept.
+func (s *CustomerService) SetPrimaryAddress(ctx context.Context, req *customerpb.SetPrimaryAddressRequest) (*customerpb.SetPrimaryAddressResponse, error) {
+	if errs := s.validator.ValidateSetPrimaryAddress(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	now := time.Now().UTC()
+	address, customer, err := s.openAddress(ctx, req.GetAddressId(), now)
+	if err != nil {
+		return nil, err
+	}
+	if address.IsPrimary {
+		return &customerpb.SetPrimaryAddressResponse{
+			Address: addressModelToProto(address),
+		}, nil
+	}
+
+	at := now
+	if req.GetEffectiveFrom() != nil {
+		at = req.GetEffectiveFrom().AsTime()
+	}
+	addresses, err := s.repo.GetCustomerAddresses(ctx, customer.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
+	}
+	primary := primaryAddress(addresses, now)
+
+	var demoted *models.Address
+	if primary != nil {
+		if demoted, err = primary.Supersede(at); err != nil {
+			return nil, addressStatus(err, "failed to set primary address")
+		}
+		demoted.IsPrimary = false
+	}
+	promoted, err := address.Supersede(at)
+	if err != nil {
+		return nil, addressStatus(err, "failed to set primary address")
+	}
+	promoted.IsPrimary = true
+
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		if primary != nil {
+			if err := replaceAddress(ctx, repo, primary, demoted); err != nil {
+				return err
+			}
+		}
+		return replaceAddress(ctx, repo, address, promoted)
+	})
+	if err != nil {
+		return nil, addressStatus(err, "failed to set primary address")
+	}
+	if err := indexCustomer(ctx, s.repo, customer); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update match keys: %v", err)
+	}
+
+	return &customerpb.SetPrimaryAddressResponse{
+		Address: addressModelToProto(promoted),
+	}, nil
+}
+
+// ListAddresses lists the addresses a customer has not yet closed, or with
+// include_history every version of them, most recently valid first
+func (s *CustomerService) ListAddresses(ctx context.Context, req *customerpb.ListAddressesRequest) (*customerpb.ListAddressesResponse, error) {
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	addresses, err := s.repo.GetCustomerAddresses(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
+	}
+	if !req.GetIncludeHistory() {
+		addresses = openAddresses(addresses, time.Now().UTC())
+	}
+	addresses = slices.Clone(addresses)
+	slices.SortStableFunc(addresses, func(a, b *models.Address) int {
+		return b.ValidFrom.Compare(a.ValidFrom)
+	})
+
+	return &customerpb.ListAddressesResponse{
+		Addresses: addressesToProto(addresses),
+	}, nil
+}
+
+// GetAddressesAsOf lists the addresses a customer had in effect at the close
+// of a day, in UTC, as statements and tax reports for past periods need
+func (s *CustomerService) GetAddressesAsOf(ctx context.Context, req *customerpb.GetAddressesAsOfRequest) (*customerpb.GetAddressesAsOfResponse, error) {
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetDate() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "date is required")
+	}
+	if _, err := s.repo.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	// The last instant of the day the database can hold
+	day := req.GetDate().AsTime().UTC().Truncate(24 * time.Hour)
+	at := day.AddDate(0, 0, 1).Add(-time.Microsecond)
+
+	addresses, err := s.repo.GetCustomerAddressesAsOf(ctx, customerID, at)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
+	}
+
+	return &customerpb.GetAddressesAsOfResponse{
+		Addresses: addressesToProto(addresses),
+	}, nil
+}
+
+// openAddress gets an address version that has not yet ended, and its
+// customer, for changing
+func (s *CustomerService) openAddress(ctx context.Context, id string, now time.Time) (*models.Address, *models.Customer, error) {
+	address, err := s.repo.GetAddress(ctx, uuid.MustParse(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "address not found")
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to get address: %v", err)
+	}
+	if address.ValidTo != nil && !address.ValidTo.After(now) {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "%v", models.ErrAddressEnded)
+	}
+
+	customer, err := s.repo.GetCustomerByID(ctx, address.CustomerID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+	if err := checkNotPseudonymized(ctx, s.repo, customer); err != nil {
+		return nil, nil, err
+	}
+
+	return address, customer, nil
+}
+
+// addressesChanged refreshes what depends on a customer's addresses: their
+// match keys and, as address countries bear on it, their risk rating
+func (s *CustomerService) addressesChanged(ctx context.Context, customer *models.Customer) error {
+	if err := indexCustomer(ctx, s.repo, customer); err != nil {
+		return status.Errorf(codes.Internal, "failed to update match keys: %v", err)
+	}
+	if _, err := s.assessor.assess(ctx, s.repo, customer, models.RiskAssessmentTriggerUpdate, nil); err != nil {
+		return status.Errorf(codes.Internal, "failed to assess customer risk: %v", err)
+	}
+	return nil
+}
+
+// setAddressFields copies a normalized address onto an address version
+func setAddressFields(address *models.Address, a postal.Address) {
+	address.Street1 = a.Street1
+	address.Street2 = stringPtr(a.Street2)
+	address.City = a.City
+	address.State = a.State
+	address.PostalCode = a.PostalCode
+	address.Country = a.Country
+}
+
+// replaceAddress closes an address version and saves the one superseding it
+func replaceAddress(ctx context.Context, repo repository.CustomerRepository, address, next *models.Address) error {
+	if err := repo.EndAddress(ctx, address); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return models.ErrAddressEnded
+		}
+		return err
+	}
+	return repo.AddAddress(ctx, next)
+}
+
+// addressStatus turns an error changing an address into a gRPC status
+func addressStatus(err error, msg string) error {
+	if errors.Is(err, models.ErrAddressEnded) || errors.Is(err, models.ErrAddressNotYetValid) {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// primaryAddress returns the address that is primary at t, if any
+func primaryAddress(addresses []*models.Address, t time.Time) *models.Address {
+	for _, a := range addresses {
+		if a.IsPrimary && a.InEffectAt(t) {
+			return a
+		}
+	}
+	return nil
+}
+
+// openAddresses returns the addresses not yet closed at t, including those
+// only taking effect later
+func openAddresses(addresses []*models.Address, t time.Time) []*models.Address {
+	var open []*models.Address
+	for _, a := range addresses {
+		if a.ValidTo == nil || a.ValidTo.After(t) {
+			open = append(open, a)
+		}
+	}
+	return open
+}
+
+func addressesToProto(addresses []*models.Address) []*customerpb.Address {
+	out := make([]*customerpb.Address, len(addresses))
+	for i, a := range addresses {
+		out[i] = addressModelToProto(a)
+	}
+	return out
+}
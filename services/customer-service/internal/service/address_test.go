@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/postal"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func addressesAsOf(t *testing.T, svc *CustomerService, customerID string, date time.Time) []*customerpb.Address {
+	t.Helper()
+	resp, err := svc.GetAddressesAsOf(context.Background(), &customerpb.GetAddressesAsOfRequest{
+		CustomerId: customerID,
+		Date:       timestamppb.New(date),
+	})
+	if err != nil {
+		t.Fatalf("GetAddressesAsOf() error: %v", err)
+	}
+	return resp.GetAddresses()
+}
+
+func TestCustomerService_AddressHistory(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	customerID := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId()
+
+	added, err := svc.AddAddress(ctx, &customerpb.AddAddressRequest{
+		CustomerId:  customerID,
+		AddressType: "Physical",
+		Street1:     "1 Old Road",
+		City:        "York",
+		State:       "North Yorkshire",
+		PostalCode:  "YO1 7HH",
+		Country:     "GB",
+		ValidFrom:   timestamppb.New(time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)),
+	})
+	if err != nil {
+		t.Fatalf("AddAddress() error: %v", err)
+	}
+	home := added.GetAddress()
+	if !home.GetIsPrimary() {
+		t.Fatal("AddAddress() first address not primary")
+	}
+
+	// The customer moved in March but told us later
+	moved := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	updateReq := &customerpb.UpdateAddressRequest{
+		AddressId:     home.GetId(),
+		Street1:       "2 New Street",
+		City:          "Leeds",
+		State:         "West Yorkshire",
+		PostalCode:    "LS1 4AP",
+		Country:       "GB",
+		EffectiveFrom: timestamppb.New(moved),
+	}
+	updated, err := svc.UpdateAddress(ctx, updateReq)
+	if err != nil {
+		t.Fatalf("UpdateAddress() error: %v", err)
+	}
+	if !updated.GetPrevious().GetValidTo().AsTime().Equal(moved) {
+		t.Errorf("UpdateAddress() previous valid to = %v, want %v", updated.GetPrevious().GetValidTo().AsTime(), moved)
+	}
+	home = updated.GetAddress()
+	if home.GetSupersedesId() != updated.GetPrevious().GetId() || !home.GetIsPrimary() || home.GetAddressType() != "Physical" {
+		t.Errorf("UpdateAddress() = %v, want a primary physical version superseding %s", home, updated.GetPrevious().GetId())
+	}
+	if _, err := svc.UpdateAddress(ctx, updateReq); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateAddress() of a closed version error = %v, want FailedPrecondition", err)
+	}
+
+	added, err = svc.AddAddress(ctx, &customerpb.AddAddressRequest{
+		CustomerId:  customerID,
+		AddressType: "Mailing",
+		Street1:     "PO Box 12",
+		City:        "Leeds",
+		State:       "West Yorkshire",
+		PostalCode:  "LS1 1AA",
+		Country:     "GB",
+		ValidFrom:   timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	if err != nil {
+		t.Fatalf("AddAddress() error: %v", err)
+	}
+	mailing := added.GetAddress()
+	if mailing.GetIsPrimary() {
+		t.Error("AddAddress() second address made primary")
+	}
+
+	primaryFrom := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	primary, err := svc.SetPrimaryAddress(ctx, &customerpb.SetPrimaryAddressRequest{
+		AddressId:     mailing.GetId(),
+		EffectiveFrom: timestamppb.New(primaryFrom),
+	})
+	if err != nil {
+		t.Fatalf("SetPrimaryAddress() error: %v", err)
+	}
+	mailing = primary.GetAddress()
+	if !mailing.GetIsPrimary() || !mailing.GetValidFrom().AsTime().Equal(primaryFrom) {
+		t.Errorf("SetPrimaryAddress() = %v, want primary from %v", mailing, primaryFrom)
+	}
+
+	tests := []struct {
+		name        string
+		date        time.Time
+		wantStreets map[string]bool // Street1 to whether it is primary
+	}{
+		{name: "before the move", date: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC), wantStreets: map[string]bool{"1 Old Road": true}},
+		{name: "day of the move", date: moved.Add(15 * time.Hour), wantStreets: map[string]bool{"2 New Street": true, "PO Box 12": false}},
+		{name: "day before the move", date: moved.Add(-time.Hour), wantStreets: map[string]bool{"1 Old Road": true, "PO Box 12": false}},
+		{name: "after the primary change", date: time.Now(), wantStreets: map[string]bool{"2 New Street": false, "PO Box 12": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addressesAsOf(t, svc, customerID, tt.date)
+			if len(got) != len(tt.wantStreets) {
+				t.Fatalf("GetAddressesAsOf() = %v, want %v", got, tt.wantStreets)
+			}
+			for _, a := range got {
+				if wantPrimary, ok := tt.wantStreets[a.GetStreet1()]; !ok || a.GetIsPrimary() != wantPrimary {
+					t.Errorf("GetAddressesAsOf() address %s primary %v, want %v", a.GetStreet1(), a.GetIsPrimary(), tt.wantStreets)
+				}
+			}
+		})
+	}
+
+	list, err := svc.ListAddresses(ctx, &customerpb.ListAddressesRequest{CustomerId: customerID})
+	if err != nil || len(list.GetAddresses()) != 2 {
+		t.Fatalf("ListAddresses() = %v, %v; want the two open addresses", list, err)
+	}
+	history, err := svc.ListAddresses(ctx, &customerpb.ListAddressesRequest{CustomerId: customerID, IncludeHistory: true})
+	if err != nil || len(history.GetAddresses()) != 5 {
+		t.Fatalf("ListAddresses() history = %v, %v; want five versions", history, err)
+	}
+
+	profile, err := svc.GetCustomerFullProfile(ctx, &customerpb.GetCustomerRequest{Id: customerID})
+	if err != nil || len(profile.GetAddresses()) != 2 {
+		t.Errorf("GetCustomerFullProfile() = %v, %v; want the two open addresses", profile.GetAddresses(), err)
+	}
+
+	if _, err := svc.EndDateAddress(ctx, &customerpb.EndDateAddressRequest{AddressId: mailing.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("EndDateAddress() of the primary address error = %v, want FailedPrecondition", err)
+	}
+	var homeID string
+	for _, a := range list.GetAddresses() {
+		if !a.GetIsPrimary() {
+			homeID = a.GetId()
+		}
+	}
+	ended, err := svc.EndDateAddress(ctx, &customerpb.EndDateAddressRequest{AddressId: homeID})
+	if err != nil || ended.GetAddress().GetValidTo() == nil {
+		t.Fatalf("EndDateAddress() = %v, %v", ended, err)
+	}
+	if _, err := svc.EndDateAddress(ctx, &customerpb.EndDateAddressRequest{AddressId: homeID}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("EndDateAddress() again error = %v, want FailedPrecondition", err)
+	}
+	if _, err := svc.EndDateAddress(ctx, &customerpb.EndDateAddressRequest{AddressId: mailing.GetId()}); err != nil {
+		t.Errorf("EndDateAddress() of the last address error: %v", err)
+	}
+
+	if _, err := svc.SetPrimaryAddress(ctx, &customerpb.SetPrimaryAddressRequest{AddressId: uuid.New().String()}); status.Code(err) != codes.NotFound {
+		t.Errorf("SetPrimaryAddress() unknown address error = %v, want NotFound", err)
+	}
+	if _, err := svc.GetAddressesAsOf(ctx, &customerpb.GetAddressesAsOfRequest{CustomerId: customerID}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetAddressesAsOf() without a date error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestCustomerService_AddAddressNormalized(t *testing.T) {
+	rules, err := postal.LoadConfig(filepath.Join("..", "..", "config", "address_rules.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetAddressRules(rules)
+	customerID := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId()
+
+	resp, err := svc.AddAddress(ctx, &customerpb.AddAddressRequest{
+		CustomerId:  customerID,
+		AddressType: "Physical",
+		Street1:     "350  Fifth Avenue ",
+		City:        "New York",
+		State:       "new york",
+		PostalCode:  "101181234",
+		Country:     "us",
+	})
+	if err != nil {
+		t.Fatalf("AddAddress() error: %v", err)
+	}
+	stored := repo.addresses[uuid.MustParse(customerID)][0]
+	if stored.Street1 != "350 Fifth Avenue" || stored.State != "NY" || stored.PostalCode != "10118-1234" || stored.Country != "US" {
+		t.Errorf("stored address = %+v, want it in canonical form", stored)
+	}
+	if len(resp.GetWarnings()) != 0 {
+		t.Errorf("AddAddress() warnings = %v, want none", resp.GetWarnings())
+	}
+
+	_, err = svc.UpdateAddress(ctx, &customerpb.UpdateAddressRequest{
+		AddressId:  stored.ID.String(),
+		Street1:    "10 Downing Street",
+		City:       "London",
+		PostalCode: "SW1A 2A",
+		Country:    "GB",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateAddress() with a malformed postcode error = %v, want InvalidArgument", err)
+	}
+
+	updated, err := svc.UpdateAddress(ctx, &customerpb.UpdateAddressRequest{
+		AddressId:  stored.ID.String(),
+		Street1:    "Rua Augusta 100",
+		City:       "Lisboa",
+		PostalCode: "1100-053",
+		Country:    "PT",
+	})
+	if err != nil {
+		t.Fatalf("UpdateAddress() to a country without rules error: %v", err)
+	}
+	if len(updated.GetWarnings()) != 1 {
+		t.Errorf("UpdateAddress() warnings = %v, want one about the country", updated.GetWarnings())
+	}
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddCustomerRelationship links an individual customer to a business
+// customer as a director, authorised signatory or beneficial owner. An
+// individual holds each role in a business at most once, and the owners of
+// a business together hold no more than all of it.
+func (s *CustomerService) AddCustomerRelationship(ctx context.Context, req *customerpb.AddCustomerRelationshipRequest) (*customerpb.AddCustomerRelationshipResponse, error) {
+	if errs := s.validator.ValidateAddCustomerRelationship(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	var customers [2]*models.Customer
+	for i, id := range []string{req.GetBusinessId(), req.GetIndividualId()} {
+		customer, err := s.repo.GetCustomerByID(ctx, uuid.MustParse(id))
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "customer %s not found", id)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+		}
+		if customer.Status == models.CustomerStatusClosed {
+			return nil, status.Errorf(codes.FailedPrecondition, "customer %s is closed", id)
+		}
+		customers[i] = customer
+	}
+	business, individual := customers[0], customers[1]
+	if !business.IsBusiness() {
+		return nil, status.Errorf(codes.FailedPrecondition, "customer %s is not a business", business.ID)
+	}
+	if individual.IsBusiness() {
+		return nil, status.Errorf(codes.FailedPrecondition, "customer %s is not an individual", individual.ID)
+	}
+
+	rel := &models.CustomerRelationship{
+		ID:                  uuid.New(),
+		BusinessID:          business.ID,
+		IndividualID:        individual.ID,
+		Role:                models.RelationshipRole(req.GetRole()),
+		OwnershipPercentage: req.GetOwnershipPercentage(),
+		CreatedBy:           uuid.MustParse(req.GetCreatedBy()),
+		CreatedAt:           time.Now().UTC(),
+	}
+
+	existing, err := s.repo.ListCustomerRelationships(ctx, business.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get relationships: %v", err)
+	}
+	owned := rel.OwnershipPercentage
+	for _, e := range existing {
+		if !e.IsActive() || e.BusinessID != business.ID {
+			continue
+		}
+		if e.IndividualID == rel.IndividualID && e.Role == rel.Role {
+			return nil, status.Errorf(codes.AlreadyExists, "customer %s is already a %s of the business", individual.ID, rel.Role)
+		}
+		if e.Role == models.RelationshipRoleBeneficialOwner {
+			owned += e.OwnershipPercentage
+		}
+	}
+	if owned > 100 {
+		return nil, status.Errorf(codes.FailedPrecondition, "beneficial owners would hold %.2f%% of the business", owned)
+	}
+
+	if err := s.repo.CreateCustomerRelationship(ctx, rel); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create relationship: %v", err)
+	}
+
+	return &customerpb.AddCustomerRelationshipResponse{
+		Relationship: relationshipModelToProto(rel),
+	}, nil
+}
+
+// EndCustomerRelationship ends an individual's role in a business. The
+// relationship is kept, with who ended it and why.
+func (s *CustomerService) EndCustomerRelationship(ctx context.Context, req *customerpb.EndCustomerRelationshipRequest) (*customerpb.EndCustomerRelationshipResponse, error) {
+	if errs := s.validator.ValidateEndCustomerRelationship(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	rel, err := s.repo.GetCustomerRelationship(ctx, uuid.MustParse(req.GetRelationshipId()))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "relationship not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get relationship: %v", err)
+	}
+
+	if err := rel.End(uuid.MustParse(req.GetEndedBy()), req.GetReason(), time.Now().UTC()); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err := s.repo.UpdateCustomerRelationship(ctx, rel); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", models.ErrRelationshipEnded)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to end relationship: %v", err)
+	}
+
+	return &customerpb.EndCustomerRelationshipResponse{
+		Relationship: relationshipModelToProto(rel),
+	}, nil
+}
+
+// ListCustomerRelationships lists the people behind a business customer,
+// or the businesses an individual is linked to, most recent first
+func (s *CustomerService) ListCustomerRelationships(ctx context.Context, req *customerpb.ListCustomerRelationshipsRequest) (*customerpb.ListCustomerRelationshipsResponse, error) {
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	relationships, err := s.repo.ListCustomerRelationships(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get relationships: %v", err)
+	}
+
+	return &customerpb.ListCustomerRelationshipsResponse{
+		Relationships: relationshipsToProto(relationships, req.GetIncludeEnded()),
+	}, nil
+}
+
+// kybFailures checks a business against the know-your-business rules for
+// activation and describes each rule it fails. A business needs at least one
+// director and one authorised signatory, and each ultimate beneficial owner
+// must be an active customer with a verified, unexpired identity document.
+func kybFailures(ctx context.Context, repo repository.CustomerRepository, businessID uuid.UUID, now time.Time) ([]string, error) {
+	relationships, err := repo.ListCustomerRelationships(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []string
+	roles := make(map[models.RelationshipRole]bool)
+	for _, rel := range relationships {
+		if !rel.IsActive() || rel.BusinessID != businessID {
+			continue
+		}
+		roles[rel.Role] = true
+		if !rel.IsUBO() {
+			continue
+		}
+
+		owner, err := repo.GetCustomerByID(ctx, rel.IndividualID)
+		if err != nil {
+			return nil, err
+		}
+		if owner.Status != models.CustomerStatusActive {
+			failures = append(failures, fmt.Sprintf("beneficial owner %s is %s, not Active", owner.CustomerNumber, owner.Status))
+			continue
+		}
+		docs, err := repo.GetCustomerDocuments(ctx, owner.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !hasValidIdentity(docs, now) {
+			failures = append(failures, fmt.Sprintf("beneficial owner %s has no verified identity document", owner.CustomerNumber))
+		}
+	}
+
+	if !roles[models.RelationshipRoleDirector] {
+		failures = append(failures, "no director")
+	}
+	if !roles[models.RelationshipRoleAuthorisedSignatory] {
+		failures = append(failures, "no authorised signatory")
+	}
+	return failures, nil
+}
+
+// hasValidIdentity reports whether any of docs is a verified identity
+// document that has not expired
+func hasValidIdentity(docs []*models.CustomerDocument, now time.Time) bool {
+	for _, d := range docs {
+		if isIdentityDocument(d.DocumentType) && d.VerificationStatus == models.VerificationStatusVerified && !d.IsExpired(now) {
+			return true
+		}
+	}
+	return false
+}
+
+func businessDetailsFromProto(b *customerpb.BusinessDetails) *models.BusinessDetails {
+	return &models.BusinessDetails{
+		RegisteredName:     b.GetRegisteredName(),
+		RegistrationNumber: b.GetRegistrationNumber(),
+		Jurisdiction:       b.GetJurisdiction(),
+		LEI:                b.GetLei(),
+		IncorporationDate:  b.GetIncorporationDate().AsTime(),
+		IndustryCode:       b.GetIndustryCode(),
+	}
+}
+
+// relationshipsToProto converts relationships, leaving out ended ones unless
+// includeEnded is set
+func relationshipsToProto(relationships []*models.CustomerRelationship, includeEnded bool) []*customerpb.CustomerRelationship {
+	protos := make([]*customerpb.CustomerRelationship, 0, len(relationships))
+	for _, rel := range relationships {
+		if includeEnded || rel.IsActive() {
+			protos = append(protos, relationshipModelToProto(rel))
+		}
+	}
+	return protos
+}
+
+func relationshipModelToProto(r *models.CustomerRelationship) *customerpb.CustomerRelationship {
+	out := &customerpb.CustomerRelationship{
+		Id:                  r.ID.String(),
+		BusinessId:          r.BusinessID.String(),
+		IndividualId:        r.IndividualID.String(),
+		Role:                string(r.Role),
+		OwnershipPercentage: r.OwnershipPercentage,
+		CreatedBy:           r.CreatedBy.String(),
+		CreatedAt:           timestamppb.New(r.CreatedAt),
+	}
+	if r.EndedBy != nil {
+		out.EndedBy = r.EndedBy.String()
+	}
+	if r.EndedAt != nil {
+		out.EndedAt = timestamppb.New(*r.EndedAt)
+	}
+	if r.EndReason != nil {
+		out.EndReason = *r.EndReason
+	}
+	return out
+}
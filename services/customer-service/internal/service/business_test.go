@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func createBusiness(t *testing.T, svc *CustomerService) *customerpb.Customer {
+	t.Helper()
+	resp, err := svc.CreateCustomer(context.Background(), &customerpb.CreateCustomerRequest{
+		CustomerType: string(models.CustomerTypeBusiness),
+		Email:        "accounts@acme.example.com",
+		Business: &customerpb.BusinessDetails{
+			RegisteredName:     "Acme Widgets Ltd",
+			RegistrationNumber: "01234567",
+			Jurisdiction:       "GB",
+			IncorporationDate:  timestamppb.New(time.Date(2010, 6, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		CreatedBy: uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomer() business error: %v", err)
+	}
+	return resp.GetCustomer()
+}
+
+// addPerson adds an active individual customer, with a verified passport
+// when verified is set
+func addPerson(repo *MockRepository, name string, verified bool) *models.Customer {
+	person := &models.Customer{
+		ID:             uuid.New(),
+		CustomerNumber: "CUST-" + name,
+		Type:           models.CustomerTypeIndividual,
+		FirstName:      name,
+		LastName:       "Smith",
+		DateOfBirth:    time.Date(1975, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:         models.CustomerStatusActive,
+	}
+	repo.customers[person.ID] = person
+	if verified {
+		repo.documents[person.ID] = append(repo.documents[person.ID], &models.CustomerDocument{
+			ID:                 uuid.New(),
+			CustomerID:         person.ID,
+			DocumentType:       models.DocumentTypePassport,
+			ExpiryDate:         time.Now().AddDate(5, 0, 0),
+			VerificationStatus: models.VerificationStatusVerified,
+		})
+	}
+	return person
+}
+
+func link(t *testing.T, svc *CustomerService, businessID string, person *models.Customer, role models.RelationshipRole, ownership float64) (*customerpb.CustomerRelationship, error) {
+	t.Helper()
+	resp, err := svc.AddCustomerRelationship(context.Background(), &customerpb.AddCustomerRelationshipRequest{
+		BusinessId:          businessID,
+		IndividualId:        person.ID.String(),
+		Role:                string(role),
+		OwnershipPercentage: ownership,
+		CreatedBy:           uuid.New().String(),
+	})
+	return resp.GetRelationship(), err
+}
+
+func TestCustomerService_CreateBusinessCustomer(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetScreener(testScreener(t))
+
+	business := createBusiness(t, svc)
+	if business.GetCustomerType() != string(models.CustomerTypeBusiness) || business.GetBusiness().GetRegisteredName() != "Acme Widgets Ltd" {
+		t.Errorf("created customer = %v", business)
+	}
+	if business.GetDateOfBirth() != nil {
+		t.Errorf("business has date of birth %v", business.GetDateOfBirth())
+	}
+	stored := repo.customers[uuid.MustParse(business.GetId())]
+	if !stored.IsBusiness() || stored.Business.Jurisdiction != "GB" || !stored.DateOfBirth.IsZero() {
+		t.Errorf("stored customer = %+v", stored)
+	}
+	if got := fullName(stored); got != "Acme Widgets Ltd" {
+		t.Errorf("screened name = %q, want the registered name", got)
+	}
+
+	// A business is updated through its registration details only
+	ctx := context.Background()
+	if _, err := svc.UpdateCustomer(ctx, &customerpb.UpdateCustomerRequest{Id: business.GetId(), FirstName: "Acme", Version: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateCustomer() business with a name error = %v, want FailedPrecondition", err)
+	}
+	details := business.GetBusiness()
+	details.RegisteredName = "Acme Holdings Ltd"
+	updated, err := svc.UpdateCustomer(ctx, &customerpb.UpdateCustomerRequest{Id: business.GetId(), Business: details, Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateCustomer() business error: %v", err)
+	}
+	if updated.GetCustomer().GetBusiness().GetRegisteredName() != "Acme Holdings Ltd" || updated.GetScreening() == nil {
+		t.Errorf("UpdateCustomer() = %v, want renamed and screened again", updated)
+	}
+
+	individual := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer()
+	if individual.GetCustomerType() != string(models.CustomerTypeIndividual) {
+		t.Errorf("individual customer type = %q", individual.GetCustomerType())
+	}
+	if _, err := svc.UpdateCustomer(ctx, &customerpb.UpdateCustomerRequest{Id: individual.GetId(), Business: details, Version: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateCustomer() individual with business details error = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestCustomerService_AddCustomerRelationship(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	business := createBusiness(t, svc)
+	alice, bob := addPerson(repo, "Alice", true), addPerson(repo, "Bob", true)
+
+	if _, err := link(t, svc, business.GetId(), alice, models.RelationshipRoleBeneficialOwner, 60); err != nil {
+		t.Fatalf("AddCustomerRelationship() error: %v", err)
+	}
+	if _, err := link(t, svc, business.GetId(), alice, models.RelationshipRoleBeneficialOwner, 10); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("AddCustomerRelationship() same role twice error = %v, want AlreadyExists", err)
+	}
+	if _, err := link(t, svc, business.GetId(), bob, models.RelationshipRoleBeneficialOwner, 50); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("AddCustomerRelationship() owning more than all error = %v, want FailedPrecondition", err)
+	}
+	if _, err := link(t, svc, business.GetId(), bob, models.RelationshipRoleBeneficialOwner, 40); err != nil {
+		t.Errorf("AddCustomerRelationship() remaining share error: %v", err)
+	}
+
+	// Only individuals are linked, and only to businesses
+	if _, err := link(t, svc, alice.ID.String(), bob, models.RelationshipRoleDirector, 0); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("AddCustomerRelationship() to an individual error = %v, want FailedPrecondition", err)
+	}
+	other := repo.customers[uuid.MustParse(createBusiness(t, svc).GetId())]
+	if _, err := link(t, svc, business.GetId(), other, models.RelationshipRoleDirector, 0); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("AddCustomerRelationship() of a business error = %v, want FailedPrecondition", err)
+	}
+	bob.Status = models.CustomerStatusClosed
+	if _, err := link(t, svc, business.GetId(), bob, models.RelationshipRoleDirector, 0); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("AddCustomerRelationship() of a closed customer error = %v, want FailedPrecondition", err)
+	}
+
+	// Ending frees the role, and the relationship is kept
+	director, err := link(t, svc, business.GetId(), alice, models.RelationshipRoleDirector, 0)
+	if err != nil {
+		t.Fatalf("AddCustomerRelationship() director error: %v", err)
+	}
+	end := &customerpb.EndCustomerRelationshipRequest{RelationshipId: director.GetId(), EndedBy: uuid.New().String(), Reason: "Resigned"}
+	ended, err := svc.EndCustomerRelationship(ctx, end)
+	if err != nil || ended.GetRelationship().GetEndedAt() == nil {
+		t.Fatalf("EndCustomerRelationship() = %v, %v", ended, err)
+	}
+	if _, err := svc.EndCustomerRelationship(ctx, end); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("EndCustomerRelationship() again error = %v, want FailedPrecondition", err)
+	}
+	if _, err := link(t, svc, business.GetId(), alice, models.RelationshipRoleDirector, 0); err != nil {
+		t.Errorf("AddCustomerRelationship() after ending error: %v", err)
+	}
+
+	list, err := svc.ListCustomerRelationships(ctx, &customerpb.ListCustomerRelationshipsRequest{CustomerId: alice.ID.String()})
+	if err != nil || len(list.GetRelationships()) != 2 {
+		t.Errorf("ListCustomerRelationships() = %v, %v; want 2 active", list, err)
+	}
+	list, err = svc.ListCustomerRelationships(ctx, &customerpb.ListCustomerRelationshipsRequest{CustomerId: alice.ID.String(), IncludeEnded: true})
+	if err != nil || len(list.GetRelationships()) != 3 {
+		t.Errorf("ListCustomerRelationships() with ended = %v, %v; want 3", list, err)
+	}
+	profile, err := svc.GetCustomerFullProfile(ctx, &customerpb.GetCustomerRequest{Id: business.GetId()})
+	if err != nil || len(profile.GetRelationships()) != 3 {
+		t.Errorf("GetCustomerFullProfile() relationships = %v, %v; want 3 active", profile.GetRelationships(), err)
+	}
+}
+
+func TestCustomerService_ActivateBusiness_KYB(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	business := createBusiness(t, svc)
+	activate := &customerpb.UpdateCustomerStatusRequest{
+		Id:        business.GetId(),
+		NewStatus: string(models.CustomerStatusActive),
+		Reason:    "KYB complete",
+	}
+
+	_, err := svc.UpdateCustomerStatus(ctx, activate)
+	if status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "no director") || !strings.Contains(err.Error(), "no authorised signatory") {
+		t.Fatalf("UpdateCustomerStatus() with nobody linked error = %v", err)
+	}
+
+	alice := addPerson(repo, "Alice", true)
+	unverified := addPerson(repo, "Bob", false)
+	minority := addPerson(repo, "Carol", false)
+	for _, l := range []struct {
+		person    *models.Customer
+		role      models.RelationshipRole
+		ownership float64
+	}{
+		{alice, models.RelationshipRoleDirector, 0},
+		{alice, models.RelationshipRoleAuthorisedSignatory, 0},
+		{alice, models.RelationshipRoleBeneficialOwner, 50},
+		{unverified, models.RelationshipRoleBeneficialOwner, 25},
+		// Below the UBO threshold, so not checked
+		{minority, models.RelationshipRoleBeneficialOwner, 24.99},
+	} {
+		if _, err := link(t, svc, business.GetId(), l.person, l.role, l.ownership); err != nil {
+			t.Fatalf("AddCustomerRelationship() error: %v", err)
+		}
+	}
+
+	_, err = svc.UpdateCustomerStatus(ctx, activate)
+	if status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "CUST-Bob has no verified identity document") || strings.Contains(err.Error(), "CUST-Carol") {
+		t.Fatalf("UpdateCustomerStatus() with an unverified UBO error = %v", err)
+	}
+
+	repo.documents[unverified.ID] = append(repo.documents[unverified.ID], &models.CustomerDocument{
+		ID:                 uuid.New(),
+		CustomerID:         unverified.ID,
+		DocumentType:       models.DocumentTypeNationalID,
+		ExpiryDate:         time.Now().AddDate(0, 0, -1),
+		VerificationStatus: models.VerificationStatusVerified,
+	})
+	if _, err := svc.UpdateCustomerStatus(ctx, activate); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("UpdateCustomerStatus() with an expired UBO document error = %v, want FailedPrecondition", err)
+	}
+	repo.documents[unverified.ID][0].ExpiryDate = time.Now().AddDate(3, 0, 0)
+
+	unverified.Status = models.CustomerStatusSuspended
+	if _, err := svc.UpdateCustomerStatus(ctx, activate); status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "is Suspended") {
+		t.Fatalf("UpdateCustomerStatus() with a suspended UBO error = %v", err)
+	}
+	unverified.Status = models.CustomerStatusActive
+
+	resp, err := svc.UpdateCustomerStatus(ctx, activate)
+	if err != nil {
+		t.Fatalf("UpdateCustomerStatus() error: %v", err)
+	}
+	if resp.GetCustomer().GetStatus() != string(models.CustomerStatusActive) {
+		t.Errorf("status = %s, want Active", resp.GetCustomer().GetStatus())
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GrantConsent records a customer agreeing to a purpose on a channel under a
+// version of the policy text. A customer holds one standing consent for each
+// purpose and channel; to agree to a new policy version the old consent is
+// withdrawn first, so both stay on record.
+func (s *CustomerService) GrantConsent(ctx context.Context, req *customerpb.GrantConsentRequest) (*customerpb.GrantConsentResponse, error) {
+	if errs := s.validator.ValidateGrantConsent(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	customer, err := s.repo.GetCustomerByID(ctx, uuid.MustParse(req.GetCustomerId()))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+	if customer.Status == models.CustomerStatusClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "customer is closed")
+	}
+
+	now := time.Now().UTC()
+	consent := &models.Consent{
+		ID:            uuid.New(),
+		CustomerID:    customer.ID,
+		Purpose:       models.ConsentPurpose(req.GetPurpose()),
+		Channel:       models.ConsentChannel(req.GetChannel()),
+		PolicyVersion: req.GetPolicyVersion(),
+		Source:        models.ConsentSource(req.GetSource()),
+		GrantedBy:     uuid.MustParse(req.GetGrantedBy()),
+		GrantedAt:     now,
+		RecordedAt:    now,
+	}
+	if req.GetGrantedAt() != nil {
+		consent.GrantedAt = req.GetGrantedAt().AsTime()
+	}
+
+	existing, err := s.repo.ListCustomerConsents(ctx, customer.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get consents: %v", err)
+	}
+	for _, c := range existing {
+		if c.IsActive() && c.Purpose == consent.Purpose && c.Channel == consent.Channel {
+			return nil, status.Errorf(codes.AlreadyExists, "customer already consents to %s on %s under policy %s; withdraw consent %s first",
+				c.Purpose, c.Channel, c.PolicyVersion, c.ID)
+		}
+	}
+
+	if err := s.repo.CreateCustomerConsent(ctx, consent); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create consent: %v", err)
+	}
+
+	return &customerpb.GrantConsentResponse{
+		Consent: consentModelToProto(consent),
+	}, nil
+}
+
+// WithdrawConsent records a customer withdrawing a consent, from now on.
+// The consent itself is kept.
+func (s *CustomerService) WithdrawConsent(ctx context.Context, req *customerpb.WithdrawConsentRequest) (*customerpb.WithdrawConsentResponse, error) {
+	if errs := s.validator.ValidateWithdrawConsent(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	consent, err := s.repo.GetCustomerConsent(ctx, uuid.MustParse(req.GetConsentId()))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "consent not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get consent: %v", err)
+	}
+
+	if err := consent.Withdraw(uuid.MustParse(req.GetWithdrawnBy()), models.ConsentSource(req.GetSource()), time.Now().UTC()); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err := s.repo.UpdateCustomerConsent(ctx, consent); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", models.ErrConsentWithdrawn)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to withdraw consent: %v", err)
+	}
+
+	return &customerpb.WithdrawConsentResponse{
+		Consent: consentModelToProto(consent),
+	}, nil
+}
+
+// ListConsents lists every consent a customer has given, withdrawn ones
+// included, most recently granted first
+func (s *CustomerService) ListConsents(ctx context.Context, req *customerpb.ListConsentsRequest) (*customerpb.ListConsentsResponse, error) {
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	consents, err := s.repo.ListCustomerConsents(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get consents: %v", err)
+	}
+
+	protos := make([]*customerpb.Consent, len(consents))
+	for i, c := range consents {
+		protos[i] = consentModelToProto(c)
+	}
+	return &customerpb.ListConsentsResponse{Consents: protos}, nil
+}
+
+// CheckConsent tells whether a customer consented to a purpose on a channel,
+// now or at a given time, for services to ask before contacting them. A
+// consent for all channels answers for each of them.
+func (s *CustomerService) CheckConsent(ctx context.Context, req *customerpb.CheckConsentRequest) (*customerpb.CheckConsentResponse, error) {
+	if errs := s.validator.ValidateCheckConsent(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	customerID := uuid.MustParse(req.GetCustomerId())
+	if _, err := s.repo.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	asOf := time.Now().UTC()
+	if req.GetAsOf() != nil {
+		asOf = req.GetAsOf().AsTime()
+	}
+
+	consents, err := s.repo.ListCustomerConsents(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get consents: %v", err)
+	}
+	purpose, channel := models.ConsentPurpose(req.GetPurpose()), models.ConsentChannel(req.GetChannel())
+	for _, c := range consents {
+		if c.Covers(purpose, channel) && c.ActiveAt(asOf) {
+			return &customerpb.CheckConsentResponse{Granted: true, Consent: consentModelToProto(c)}, nil
+		}
+	}
+	return &customerpb.CheckConsentResponse{Granted: false}, nil
+}
+
+func consentModelToProto(c *models.Consent) *customerpb.Consent {
+	out := &customerpb.Consent{
+		Id:            c.ID.String(),
+		CustomerId:    c.CustomerID.String(),
+		Purpose:       string(c.Purpose),
+		Channel:       string(c.Channel),
+		PolicyVersion: c.PolicyVersion,
+		Source:        string(c.Source),
+		GrantedBy:     c.GrantedBy.String(),
+		GrantedAt:     timestamppb.New(c.GrantedAt),
+		RecordedAt:    timestamppb.New(c.RecordedAt),
+	}
+	if c.WithdrawnBy != nil {
+		out.WithdrawnBy = c.WithdrawnBy.String()
+	}
+	if c.WithdrawnAt != nil {
+		out.WithdrawnAt = timestamppb.New(*c.WithdrawnAt)
+	}
+	if c.WithdrawalSource != nil {
+		out.WithdrawalSource = string(*c.WithdrawalSource)
+	}
+	return out
+}
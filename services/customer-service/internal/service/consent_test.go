@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/export"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func grantConsent(t *testing.T, svc *CustomerService, customerID string, purpose models.ConsentPurpose, channel models.ConsentChannel, policy string) *customerpb.Consent {
+	t.Helper()
+	resp, err := svc.GrantConsent(context.Background(), &customerpb.GrantConsentRequest{
+		CustomerId:    customerID,
+		Purpose:       string(purpose),
+		Channel:       string(channel),
+		PolicyVersion: policy,
+		Source:        string(models.ConsentSourceWeb),
+		GrantedBy:     uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("GrantConsent() error: %v", err)
+	}
+	return resp.GetConsent()
+}
+
+func checkConsent(t *testing.T, svc *CustomerService, customerID string, purpose models.ConsentPurpose, channel models.ConsentChannel, asOf *timestamppb.Timestamp) *customerpb.CheckConsentResponse {
+	t.Helper()
+	resp, err := svc.CheckConsent(context.Background(), &customerpb.CheckConsentRequest{
+		CustomerId: customerID,
+		Purpose:    string(purpose),
+		Channel:    string(channel),
+		AsOf:       asOf,
+	})
+	if err != nil {
+		t.Fatalf("CheckConsent() error: %v", err)
+	}
+	return resp
+}
+
+func TestCustomerService_Consents(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	customerID := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId()
+
+	if checkConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelEmail, nil).GetGranted() {
+		t.Fatal("CheckConsent() granted before any consent")
+	}
+
+	email := grantConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelEmail, "marketing-v1")
+	check := checkConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelEmail, nil)
+	if !check.GetGranted() || check.GetConsent().GetId() != email.GetId() || check.GetConsent().GetPolicyVersion() != "marketing-v1" {
+		t.Errorf("CheckConsent() = %v, want granted by the email consent", check)
+	}
+	if checkConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelSMS, nil).GetGranted() {
+		t.Error("CheckConsent() SMS granted by an email consent")
+	}
+
+	// One standing consent for each purpose and channel
+	_, err := svc.GrantConsent(ctx, &customerpb.GrantConsentRequest{
+		CustomerId:    customerID,
+		Purpose:       string(models.ConsentPurposeMarketing),
+		Channel:       string(models.ConsentChannelEmail),
+		PolicyVersion: "marketing-v2",
+		Source:        string(models.ConsentSourceBranch),
+		GrantedBy:     uuid.New().String(),
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("GrantConsent() while consenting error = %v, want AlreadyExists", err)
+	}
+
+	withdraw := &customerpb.WithdrawConsentRequest{ConsentId: email.GetId(), Source: string(models.ConsentSourceCallCentre), WithdrawnBy: uuid.New().String()}
+	withdrawn, err := svc.WithdrawConsent(ctx, withdraw)
+	if err != nil || withdrawn.GetConsent().GetWithdrawnAt() == nil || withdrawn.GetConsent().GetWithdrawalSource() != string(models.ConsentSourceCallCentre) {
+		t.Fatalf("WithdrawConsent() = %v, %v", withdrawn, err)
+	}
+	if _, err := svc.WithdrawConsent(ctx, withdraw); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("WithdrawConsent() again error = %v, want FailedPrecondition", err)
+	}
+	if checkConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelEmail, nil).GetGranted() {
+		t.Error("CheckConsent() granted after withdrawal")
+	}
+
+	// The withdrawn consent still answers for the time it stood
+	during := timestamppb.New(withdrawn.GetConsent().GetWithdrawnAt().AsTime().Add(-time.Nanosecond))
+	if !checkConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelEmail, during).GetGranted() {
+		t.Error("CheckConsent() as of before withdrawal not granted")
+	}
+
+	// Agreeing to a new policy makes a new consent; a consent for all
+	// channels answers for each
+	all := grantConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelAll, "marketing-v2")
+	check = checkConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelSMS, nil)
+	if !check.GetGranted() || check.GetConsent().GetId() != all.GetId() {
+		t.Errorf("CheckConsent() SMS = %v, want granted by the consent for all channels", check)
+	}
+
+	list, err := svc.ListConsents(ctx, &customerpb.ListConsentsRequest{CustomerId: customerID})
+	if err != nil || len(list.GetConsents()) != 2 {
+		t.Fatalf("ListConsents() = %v, %v; want both consents", list, err)
+	}
+	if list.GetConsents()[1].GetId() != email.GetId() || list.GetConsents()[1].GetWithdrawnAt() == nil {
+		t.Errorf("ListConsents() = %v, want the withdrawn consent kept", list.GetConsents())
+	}
+
+	if _, err := svc.CheckConsent(ctx, &customerpb.CheckConsentRequest{CustomerId: uuid.New().String(), Purpose: "Marketing", Channel: "Email"}); status.Code(err) != codes.NotFound {
+		t.Errorf("CheckConsent() unknown customer error = %v, want NotFound", err)
+	}
+	repo.customers[uuid.MustParse(customerID)].Status = models.CustomerStatusClosed
+	if _, err := svc.GrantConsent(ctx, &customerpb.GrantConsentRequest{
+		CustomerId:    customerID,
+		Purpose:       string(models.ConsentPurposeServiceMessages),
+		Channel:       string(models.ConsentChannelPost),
+		PolicyVersion: "service-v1",
+		Source:        string(models.ConsentSourcePaper),
+		GrantedBy:     uuid.New().String(),
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GrantConsent() closed customer error = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestExportCustomerData_Consents(t *testing.T) {
+	svc, _, _ := testDataExports(t, 100)
+	ctx := context.Background()
+
+	customerID := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId()
+	sharing := grantConsent(t, svc, customerID, models.ConsentPurposeDataSharing, models.ConsentChannelAll, "sharing-v3")
+	if _, err := svc.WithdrawConsent(ctx, &customerpb.WithdrawConsentRequest{ConsentId: sharing.GetId(), Source: string(models.ConsentSourceMobileApp), WithdrawnBy: uuid.New().String()}); err != nil {
+		t.Fatal(err)
+	}
+	grantConsent(t, svc, customerID, models.ConsentPurposeMarketing, models.ConsentChannelSMS, "marketing-v1")
+
+	resp, err := svc.ExportCustomerData(ctx, exportRequest(uuid.MustParse(customerID)))
+	if err != nil {
+		t.Fatalf("ExportCustomerData() error: %v", err)
+	}
+	data, err := downloadExport(svc, resp.GetExport().GetId(), export.FormatJSON, "compliance")
+	if err != nil {
+		t.Fatalf("DownloadDataExport() error: %v", err)
+	}
+	var bundle export.Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		t.Fatal(err)
+	}
+	if len(bundle.Consents) != 2 {
+		t.Fatalf("consents = %+v, want both", bundle.Consents)
+	}
+	first := bundle.Consents[0]
+	if first.Purpose != "DataSharing" || first.PolicyVersion != "sharing-v3" || first.WithdrawnAt == nil || first.WithdrawalSource != "MobileApp" {
+		t.Errorf("first consent = %+v, want the withdrawn data sharing consent", first)
+	}
+	if bundle.Consents[1].WithdrawnAt != nil {
+		t.Errorf("second consent = %+v, want standing", bundle.Consents[1])
+	}
+}
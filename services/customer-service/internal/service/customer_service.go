@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
 	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/core-banking/services/customer-service/internal/screening"
 	"github.com/core-banking/services/customer-service/internal/validation"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -14,6 +16,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/phone"
+	"github.com/core-banking/services/customer-service/internal/postal"
 )
 
 // CustomerService handles customer business logic
@@ -21,16 +25,44 @@ type CustomerService struct {
 	customerpb.UnimplementedCustomerServiceServer
 	repo      repository.CustomerRepository
 	validator *validation.Validator
+	screener  *screening.Screener // Nil when sanctions screening is disabled
+	assessor  *RiskAssessor       // Nil when risk rating is disabled
+	files     *DocumentFiles      // Nil when document file storage is disabled
+	retention *Retention          // Nil when customers are never erased
+	exports   *DataExports        // Nil when data export is disabled
+	merger    *Merger             // Nil when customer merge is disabled
+	matcher   *Matcher            // Nil when duplicate checks are disabled
+	addresses *postal.Config
+	phones    *phone.Config
 }
 
-// NewCustomerService creates a new CustomerService instance
+// NewCustomerService creates a new CustomerService instance. Addresses and
+// phone numbers are checked under the built-in rules, and the optional
+// features are disabled until set.
 func NewCustomerService(repo repository.CustomerRepository) *CustomerService {
 	return &CustomerService{
 		repo:      repo,
 		validator: validation.NewValidator(),
+		addresses: postal.DefaultConfig(),
+		phones:    phone.DefaultConfig(),
 	}
 }
 
+// SetAddressRules checks and normalizes addresses under the rules of their
+// country in cfg, replacing the generic rules.
+func (s *CustomerService) SetAddressRules(cfg *postal.Config) {
+	s.addresses = cfg
+	s.validator = validation.NewValidatorWithRules(s.addresses, s.phones)
+}
+
+// SetPhoneRules parses phone numbers under the numbering plans in cfg and
+// stores them in E.164 form. Without it numbers are taken in international
+// format only, without telling what kind of line they are for.
+func (s *CustomerService) SetPhoneRules(cfg *phone.Config) {
+	s.phones = cfg
+	s.validator = validation.NewValidatorWithRules(s.addresses, s.phones)
+}
+
 // CreateCustomer creates a new customer with validation
 func (s *CustomerService) CreateCustomer(ctx context.Context, req *customerpb.CreateCustomerRequest) (*customerpb.CreateCustomerResponse, error) {
 	// Validate request
@@ -55,50 +87,119 @@ func (s *CustomerService) CreateCustomer(ctx context.Context, req *customerpb.Cr
 	customer := &models.Customer{
 		ID:             uuid.New(),
 		CustomerNumber: customerNumber,
+		Type:           models.CustomerTypeIndividual,
 		FirstName:      req.GetFirstName(),
 		MiddleName:     stringPtr(req.GetMiddleName()),
 		LastName:       req.GetLastName(),
-		DateOfBirth:    req.GetDateOfBirth().AsTime(),
 		TaxID:          req.GetTaxId(),
 		Email:          req.GetEmail(),
-		Phone:          req.GetPhone(),
 		Status:         models.CustomerStatusPending,
 		CreatedBy:      createdByUUID,
 	}
+	if req.GetCustomerType() == string(models.CustomerTypeBusiness) {
+		customer.Type = models.CustomerTypeBusiness
+		customer.Business = businessDetailsFromProto(req.GetBusiness())
+	} else {
+		customer.DateOfBirth = req.GetDateOfBirth().AsTime()
+	}
+	if req.GetPhone() != "" {
+		number, verr := s.validator.ParsePhone(req.GetPhone(), req.GetBusiness().GetJurisdiction())
+		if verr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", validation.ValidationErrors{*verr})
+		}
+		setPhone(customer, req.GetPhone(), number)
+	}
 
-	// Save to repository
-	if err := s.repo.CreateCustomer(ctx, customer); err != nil {
+	// Refuse a likely duplicate unless overridden
+	duplicates, overrides, err := s.matcher.checkDuplicates(ctx, s.repo, customer, req.GetDuplicateOverrideReason())
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository, screen against the sanctions lists and rate risk
+	var screeningResult *models.CustomerScreening
+	var riskResult *models.CustomerRiskAssessment
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		if err := repo.CreateCustomer(ctx, customer); err != nil {
+			return err
+		}
+		if err := indexCustomer(ctx, repo, customer); err != nil {
+			return err
+		}
+		for _, override := range overrides {
+			if err := repo.CreateDuplicateOverride(ctx, override); err != nil {
+				return err
+			}
+		}
+		var err error
+		screeningResult, err = screenCustomer(ctx, repo, s.screener, customer, models.ScreeningTriggerOnboarding)
+		if err != nil {
+			return err
+		}
+		riskResult, err = s.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerOnboarding, nil)
+		return err
+	})
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create customer: %v", err)
 	}
 
 	// Return response
 	return &customerpb.CreateCustomerResponse{
-		Customer: modelToProto(customer),
+		Customer:            modelToProto(customer),
+		Screening:           screeningModelToProto(screeningResult),
+		RiskAssessment:      riskAssessmentModelToProto(riskResult),
+		PotentialDuplicates: duplicateCandidatesToProto(duplicates),
 	}, nil
 }
 
-// GetCustomer retrieves a customer by ID
+// GetCustomer retrieves a customer by ID or customer number. A customer
+// merged into another leads to the one it was merged into, with merged_from
+// set to the ID asked for.
 func (s *CustomerService) GetCustomer(ctx context.Context, req *customerpb.GetCustomerRequest) (*customerpb.GetCustomerResponse, error) {
-	if req.GetId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "id is required")
-	}
-
-	customerID, err := uuid.Parse(req.GetId())
+	customer, mergedFrom, err := s.lookupCustomer(ctx, req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid customer id: %v", err)
+		return nil, err
 	}
 
-	customer, err := s.repo.GetCustomerByID(ctx, customerID)
+	return &customerpb.GetCustomerResponse{
+		Customer:   modelToProto(customer),
+		MergedFrom: mergedFrom,
+	}, nil
+}
+
+// lookupCustomer finds the customer a GetCustomerRequest asks for, following
+// any merge of it. The ID of the merged customer is returned when the
+// customer found is not the one asked for.
+func (s *CustomerService) lookupCustomer(ctx context.Context, req *customerpb.GetCustomerRequest) (*models.Customer, string, error) {
+	var customer *models.Customer
+	var err error
+	switch {
+	case req.GetId() != "":
+		customerID, parseErr := uuid.Parse(req.GetId())
+		if parseErr != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid customer id: %v", parseErr)
+		}
+		customer, err = s.repo.GetCustomerByID(ctx, customerID)
+	case req.GetCustomerNumber() != "":
+		customer, err = s.repo.GetCustomerByNumber(ctx, req.GetCustomerNumber())
+	default:
+		return nil, "", status.Errorf(codes.InvalidArgument, "id or customer_number is required")
+	}
 	if err != nil {
 		if err == repository.ErrNotFound {
-			return nil, status.Errorf(codes.NotFound, "customer not found")
+			return nil, "", status.Errorf(codes.NotFound, "customer not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+		return nil, "", status.Errorf(codes.Internal, "failed to get customer: %v", err)
 	}
 
-	return &customerpb.GetCustomerResponse{
-		Customer: modelToProto(customer),
-	}, nil
+	survivor, err := followMerges(ctx, s.repo, customer)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to follow merge: %v", err)
+	}
+	if survivor.ID == customer.ID {
+		return customer, "", nil
+	}
+	return survivor, customer.ID.String(), nil
 }
 
 // UpdateCustomer updates an existing customer
@@ -121,6 +222,22 @@ func (s *CustomerService) UpdateCustomer(ctx context.Context, req *customerpb.Up
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
 	}
+	if err := checkNotPseudonymized(ctx, s.repo, customer); err != nil {
+		return nil, err
+	}
+
+	// A business has registration details in place of a name and date of
+	// birth
+	if customer.IsBusiness() {
+		if req.GetFirstName() != "" || req.GetMiddleName() != "" || req.GetLastName() != "" || req.GetDateOfBirth() != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "business customers have no name or date of birth; update business instead")
+		}
+	} else if req.GetBusiness() != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "business details can only be set on business customers")
+	}
+
+	// Screen again if the name or date of birth changes
+	screenedBefore := screenedDetails(customer)
 
 	// Update fields if provided
 	if req.GetFirstName() != "" {
@@ -141,8 +258,19 @@ func (s *CustomerService) UpdateCustomer(ctx context.Context, req *customerpb.Up
 	if req.GetEmail() != "" {
 		customer.Email = req.GetEmail()
 	}
+	if req.GetBusiness() != nil {
+		customer.Business = businessDetailsFromProto(req.GetBusiness())
+	}
 	if req.GetPhone() != "" {
-		customer.Phone = req.GetPhone()
+		region, err := s.phoneRegion(ctx, customer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
+		}
+		number, verr := s.validator.ParsePhone(req.GetPhone(), region)
+		if verr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", validation.ValidationErrors{*verr})
+		}
+		setPhone(customer, req.GetPhone(), number)
 	}
 
 	// Parse updated_by
@@ -155,7 +283,26 @@ func (s *CustomerService) UpdateCustomer(ctx context.Context, req *customerpb.Up
 	}
 
 	// Update in repository
-	if err := s.repo.UpdateCustomer(ctx, customer); err != nil {
+	var screeningResult *models.CustomerScreening
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		if err := repo.UpdateCustomer(ctx, customer); err != nil {
+			return err
+		}
+		if err := indexCustomer(ctx, repo, customer); err != nil {
+			return err
+		}
+		if screenedDetails(customer) == screenedBefore {
+			return nil
+		}
+		var err error
+		screeningResult, err = screenCustomer(ctx, repo, s.screener, customer, models.ScreeningTriggerUpdate)
+		if err != nil {
+			return err
+		}
+		_, err = s.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerUpdate, nil)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*repository.ErrOptimisticLock); ok {
 			return nil, status.Errorf(codes.Aborted, "customer was modified by another process")
 		}
@@ -163,7 +310,8 @@ func (s *CustomerService) UpdateCustomer(ctx context.Context, req *customerpb.Up
 	}
 
 	return &customerpb.UpdateCustomerResponse{
-		Customer: modelToProto(customer),
+		Customer:  modelToProto(customer),
+		Screening: screeningModelToProto(screeningResult),
 	}, nil
 }
 
@@ -179,12 +327,27 @@ func (s *CustomerService) SearchCustomers(ctx context.Context, req *customerpb.S
 		FirstName: req.GetFirstName(),
 		LastName:  req.GetLastName(),
 		Email:     req.GetEmail(),
-		Phone:     req.GetPhone(),
 		Status:    models.CustomerStatus(req.GetStatus()),
 		Limit:     int(req.GetLimit()),
 		Offset:    int(req.GetOffset()),
 	}
 
+	// Numbers are stored in E.164 form, so one that parses is matched as a
+	// whole and anything else, including a number of a country without a
+	// numbering plan, by its digits as they appear in that form
+	if req.GetPhone() != "" {
+		number, verr := s.validator.ParsePhone(req.GetPhone(), req.GetPhoneCountry())
+		if verr == nil && number.Type != phone.TypeUnknown {
+			filters.Phone = number.E164
+		} else {
+			digits, ok := s.phones.SearchDigits(req.GetPhone(), req.GetPhoneCountry())
+			if !ok || digits == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "%s", validation.ValidationErrors{{Field: "phone", Message: "must be digits of a phone number"}})
+			}
+			filters.Phone = digits
+		}
+	}
+
 	if req.GetFromDate() != nil {
 		fromDate := req.GetFromDate().AsTime()
 		filters.FromDate = &fromDate
@@ -225,13 +388,16 @@ func (s *CustomerService) AddAddress(ctx context.Context, req *customerpb.AddAdd
 	}
 
 	// Verify customer exists
-	_, err = s.repo.GetCustomerByID(ctx, customerID)
+	customer, err := s.repo.GetCustomerByID(ctx, customerID)
 	if err != nil {
 		if err == repository.ErrNotFound {
 			return nil, status.Errorf(codes.NotFound, "customer not found")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
 	}
+	if err := checkNotPseudonymized(ctx, s.repo, customer); err != nil {
+		return nil, err
+	}
 
 	// Handle primary address logic
 	addresses, err := s.repo.GetCustomerAddresses(ctx, customerID)
@@ -239,45 +405,29 @@ func (s *CustomerService) AddAddress(ctx context.Context, req *customerpb.AddAdd
 		return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
 	}
 
-	// If setting as primary, unset other primary addresses
-	if req.GetIsPrimary() {
-		for _, addr := range addresses {
-			if addr.IsPrimary {
-				addr.IsPrimary = false
-				if err := s.repo.UpdateAddress(ctx, addr); err != nil {
-					return nil, status.Errorf(codes.Internal, "failed to update existing primary address: %v", err)
-				}
-			}
-		}
-	} else {
-		// If no primary address exists and not setting one, set this as primary
-		hasPrimary := false
-		for _, addr := range addresses {
-			if addr.IsPrimary {
-				hasPrimary = true
-				break
-			}
-		}
-		if !hasPrimary {
-			// Auto-set as primary if it's the first address
-			req.IsPrimary = true
-		}
+	// The first address becomes primary
+	primary := primaryAddress(addresses, time.Now().UTC())
+	if primary == nil {
+		req.IsPrimary = true
 	}
 
-	// Create address model
+	// Create address model, in the canonical form of its country's rules
+	normalized, warnings := s.validator.NormalizeAddress(postal.Address{
+		Street1:    req.GetStreet1(),
+		Street2:    req.GetStreet2(),
+		City:       req.GetCity(),
+		State:      req.GetState(),
+		PostalCode: req.GetPostalCode(),
+		Country:    req.GetCountry(),
+	})
 	address := &models.Address{
 		ID:          uuid.New(),
 		CustomerID:  customerID,
 		AddressType: models.AddressType(req.GetAddressType()),
-		Street1:     req.GetStreet1(),
-		Street2:     stringPtr(req.GetStreet2()),
-		City:        req.GetCity(),
-		State:       req.GetState(),
-		PostalCode:  req.GetPostalCode(),
-		Country:     req.GetCountry(),
 		IsPrimary:   req.GetIsPrimary(),
 		ValidFrom:   time.Now().UTC(),
 	}
+	setAddressFields(address, normalized)
 
 	if req.GetValidFrom() != nil {
 		address.ValidFrom = req.GetValidFrom().AsTime()
@@ -287,13 +437,35 @@ func (s *CustomerService) AddAddress(ctx context.Context, req *customerpb.AddAdd
 		address.ValidTo = &validTo
 	}
 
+	// A new primary address takes over from the old one when it becomes
+	// valid, and the old one is kept on record as primary until then
+	var demoted *models.Address
+	if address.IsPrimary && primary != nil {
+		if demoted, err = primary.Supersede(address.ValidFrom); err != nil {
+			return nil, addressStatus(err, "failed to add address")
+		}
+		demoted.IsPrimary = false
+	}
+
 	// Save address
-	if err := s.repo.AddAddress(ctx, address); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to add address: %v", err)
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		if demoted != nil {
+			if err := replaceAddress(ctx, repo, primary, demoted); err != nil {
+				return err
+			}
+		}
+		return repo.AddAddress(ctx, address)
+	})
+	if err != nil {
+		return nil, addressStatus(err, "failed to add address")
+	}
+	if err := s.addressesChanged(ctx, customer); err != nil {
+		return nil, err
 	}
 
 	return &customerpb.AddAddressResponse{
-		Address: addressModelToProto(address),
+		Address:  addressModelToProto(address),
+		Warnings: warnings,
 	}, nil
 }
 
@@ -317,6 +489,9 @@ func (s *CustomerService) AddDocument(ctx context.Context, req *customerpb.AddDo
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
 	}
+	if err := checkNotPseudonymized(ctx, s.repo, customer); err != nil {
+		return nil, err
+	}
 
 	// Create document model
 	doc := &models.CustomerDocument{
@@ -351,15 +526,31 @@ func (s *CustomerService) AddDocument(ctx context.Context, req *customerpb.AddDo
 			}
 		}
 
+		// Potential sanctions matches must be reviewed first
+		blocked := false
 		if hasVerifiedIdentity {
+			blocked, err = hasBlockingHits(ctx, s.repo, customerID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get screening hits: %v", err)
+			}
+		}
+
+		if hasVerifiedIdentity && !blocked {
 			// Auto-activate customer
-			customer.Status = models.CustomerStatusActive
-			if err := s.repo.UpdateCustomer(ctx, customer); err != nil {
+			err := withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+				_, err := changeStatus(ctx, repo, customer, models.CustomerStatusActive, "Identity document verified", nil, time.Now().UTC())
+				return err
+			})
+			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update customer status: %v", err)
 			}
 		}
 	}
 
+	if _, err := s.assessor.assess(ctx, s.repo, customer, models.RiskAssessmentTriggerUpdate, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assess customer risk: %v", err)
+	}
+
 	return &customerpb.AddDocumentResponse{
 		Document: documentModelToProto(doc),
 	}, nil
@@ -401,37 +592,75 @@ func (s *CustomerService) UpdateCustomerStatus(ctx context.Context, req *custome
 		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 	}
 
-	// Update status
-	previousStatus := customer.Status
-	customer.Status = newStatus
+	// Potential sanctions matches must be reviewed before activation
+	if newStatus == models.CustomerStatusActive {
+		blocked, err := hasBlockingHits(ctx, s.repo, customerID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get screening hits: %v", err)
+		}
+		if blocked {
+			return nil, status.Errorf(codes.FailedPrecondition, "customer has potential sanctions matches pending review or confirmed")
+		}
+	}
 
-	var changedByUUID uuid.UUID
-	if req.GetChangedBy() != "" {
-		changedByUUID, err = uuid.Parse(req.GetChangedBy())
+	// A business is activated only once it passes the KYB checks on the
+	// people behind it
+	if newStatus == models.CustomerStatusActive && customer.IsBusiness() {
+		failures, err := kybFailures(ctx, s.repo, customerID, time.Now().UTC())
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid changed_by UUID: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to check business: %v", err)
+		}
+		if len(failures) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "business fails KYB checks: %s", strings.Join(failures, "; "))
 		}
-		customer.UpdatedBy = &changedByUUID
 	}
 
-	// Update customer
-	if err := s.repo.UpdateCustomer(ctx, customer); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update customer status: %v", err)
+	// A customer restricted for an overdue review stays restricted until the
+	// review is completed, and one restricted for want of a valid identity
+	// document until they provide one
+	if currentStatus == models.CustomerStatusRestricted && newStatus == models.CustomerStatusActive {
+		overdue, err := hasOverdueReview(ctx, s.repo, customerID, time.Now().UTC())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get review tasks: %v", err)
+		}
+		if overdue {
+			return nil, status.Errorf(codes.FailedPrecondition, "customer has an overdue KYC review; complete the review task instead")
+		}
+		lapsed, err := hasLapsedIdentity(ctx, s.repo, customerID, time.Now().UTC())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get documents: %v", err)
+		}
+		if lapsed {
+			return nil, status.Errorf(codes.FailedPrecondition, "customer has no valid identity document")
+		}
 	}
 
-	// Create status change record
-	statusChange := &models.StatusChange{
-		ID:             uuid.New(),
-		CustomerID:     customerID,
-		PreviousStatus: previousStatus,
-		NewStatus:      newStatus,
-		Reason:         req.GetReason(),
-		ChangedBy:      changedByUUID,
-		ChangedAt:      time.Now().UTC(),
+	var changedBy *uuid.UUID
+	if req.GetChangedBy() != "" {
+		changedByUUID, err := uuid.Parse(req.GetChangedBy())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid changed_by UUID: %v", err)
+		}
+		changedBy = &changedByUUID
 	}
 
-	// Log status change (in a real system, save to database)
-	fmt.Printf("Status change: %s -> %s, Reason: %s\n", previousStatus, newStatus, req.GetReason())
+	// Update status. Closing a customer starts their retention period.
+	var statusChange *models.StatusChange
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		now := time.Now().UTC()
+		change, err := changeStatus(ctx, repo, customer, newStatus, req.GetReason(), changedBy, now)
+		if err != nil {
+			return err
+		}
+		statusChange = change
+		if newStatus == models.CustomerStatusClosed {
+			return repo.RecordCustomerClosure(ctx, customerID, now)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update customer status: %v", err)
+	}
 
 	return &customerpb.UpdateCustomerStatusResponse{
 		Customer:     modelToProto(customer),
@@ -441,29 +670,19 @@ func (s *CustomerService) UpdateCustomerStatus(ctx context.Context, req *custome
 
 // GetCustomerFullProfile retrieves the complete customer profile
 func (s *CustomerService) GetCustomerFullProfile(ctx context.Context, req *customerpb.GetCustomerRequest) (*customerpb.CustomerFullProfileResponse, error) {
-	if req.GetId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "id is required")
-	}
-
-	customerID, err := uuid.Parse(req.GetId())
+	// Get customer, following any merge
+	customer, mergedFrom, err := s.lookupCustomer(ctx, req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid customer id: %v", err)
+		return nil, err
 	}
+	customerID := customer.ID
 
-	// Get customer
-	customer, err := s.repo.GetCustomerByID(ctx, customerID)
-	if err != nil {
-		if err == repository.ErrNotFound {
-			return nil, status.Errorf(codes.NotFound, "customer not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
-	}
-
-	// Get addresses
+	// Get the addresses not yet closed; ListAddresses gives their history
 	addresses, err := s.repo.GetCustomerAddresses(ctx, customerID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
 	}
+	addresses = openAddresses(addresses, time.Now().UTC())
 
 	// Get documents
 	documents, err := s.repo.GetCustomerDocuments(ctx, customerID)
@@ -472,26 +691,54 @@ func (s *CustomerService) GetCustomerFullProfile(ctx context.Context, req *custo
 	}
 
 	// Convert to proto
-	protoAddresses := make([]*customerpb.Address, len(addresses))
-	for i, a := range addresses {
-		protoAddresses[i] = addressModelToProto(a)
-	}
-
 	protoDocuments := make([]*customerpb.Document, len(documents))
 	for i, d := range documents {
 		protoDocuments[i] = documentModelToProto(d)
 	}
 
+	// Get the businesses the customer is linked to, or the people behind a
+	// business
+	relationships, err := s.repo.ListCustomerRelationships(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get relationships: %v", err)
+	}
+
 	return &customerpb.CustomerFullProfileResponse{
 		Customer:      modelToProto(customer),
-		Addresses:     protoAddresses,
+		Addresses:     addressesToProto(addresses),
 		Documents:     protoDocuments,
 		StatusHistory: []*customerpb.StatusChange{},
+		MergedFrom:    mergedFrom,
+		Relationships: relationshipsToProto(relationships, false),
 	}, nil
 }
 
 // Helper functions
 
+// setPhone sets a customer's phone number, parsed from input
+func setPhone(c *models.Customer, input string, number phone.Number) {
+	c.Phone = number.E164
+	c.PhoneInput = input
+	c.PhoneType = number.Type
+}
+
+// phoneRegion returns the country a customer's phone numbers are read in
+// when given in national format: that of their primary address or, for a
+// business without one, its jurisdiction
+func (s *CustomerService) phoneRegion(ctx context.Context, c *models.Customer) (string, error) {
+	addresses, err := s.repo.GetCustomerAddresses(ctx, c.ID)
+	if err != nil {
+		return "", err
+	}
+	if primary := primaryAddress(addresses, time.Now().UTC()); primary != nil {
+		return primary.Country, nil
+	}
+	if c.Business != nil {
+		return c.Business.Jurisdiction, nil
+	}
+	return "", nil
+}
+
 func stringPtr(s string) *string {
 	if s == "" {
 		return nil
@@ -507,8 +754,11 @@ func modelToProto(c *models.Customer) *customerpb.Customer {
 		LastName:       c.LastName,
 		Email:          c.Email,
 		Phone:          c.Phone,
+		PhoneInput:     c.PhoneInput,
+		PhoneType:      string(c.PhoneType),
 		Status:         string(c.Status),
 		Version:        int32(c.Version),
+		CustomerType:   string(c.Type),
 	}
 
 	if c.MiddleName != nil {
@@ -527,6 +777,17 @@ func modelToProto(c *models.Customer) *customerpb.Customer {
 		customer.UpdatedBy = c.UpdatedBy.String()
 	}
 
+	if c.Business != nil {
+		customer.Business = &customerpb.BusinessDetails{
+			RegisteredName:     c.Business.RegisteredName,
+			RegistrationNumber: c.Business.RegistrationNumber,
+			Jurisdiction:       c.Business.Jurisdiction,
+			Lei:                c.Business.LEI,
+			IncorporationDate:  timestamppb.New(c.Business.IncorporationDate),
+			IndustryCode:       c.Business.IndustryCode,
+		}
+	}
+
 	return customer
 }
 
@@ -554,6 +815,9 @@ func addressModelToProto(a *models.Address) *customerpb.Address {
 	if a.ValidTo != nil {
 		addr.ValidTo = timestamppb.New(*a.ValidTo)
 	}
+	if a.SupersedesID != nil {
+		addr.SupersedesId = a.SupersedesID.String()
+	}
 
 	return addr
 }
@@ -584,6 +848,32 @@ func documentModelToProto(d *models.CustomerDocument) *customerpb.Document {
 	return doc
 }
 
+// changeStatus moves a customer to a new status and records the change in
+// their status history. A nil by means the service made the change itself.
+func changeStatus(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer, newStatus models.CustomerStatus, reason string, by *uuid.UUID, now time.Time) (*models.StatusChange, error) {
+	change := &models.StatusChange{
+		ID:             uuid.New(),
+		CustomerID:     customer.ID,
+		PreviousStatus: customer.Status,
+		NewStatus:      newStatus,
+		Reason:         reason,
+		ChangedAt:      now,
+	}
+	customer.Status = newStatus
+	if by != nil {
+		customer.UpdatedBy = by
+		change.ChangedBy = *by
+	}
+
+	if err := repo.UpdateCustomer(ctx, customer); err != nil {
+		return nil, err
+	}
+	if err := repo.CreateStatusChange(ctx, change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
 func statusChangeModelToProto(sc *models.StatusChange) *customerpb.StatusChange {
 	return &customerpb.StatusChange{
 		Id:             sc.ID.String(),
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"slices"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,6 +23,35 @@ type MockRepository struct {
 	addresses map[uuid.UUID][]*models.Address
 	documents map[uuid.UUID][]*models.CustomerDocument
 	nextErr   error
+
+	screenings     []*models.CustomerScreening
+	hits           []*models.ScreeningHit
+	sanctionsLists map[string]*models.SanctionsList
+
+	assessments []*models.CustomerRiskAssessment
+	reviewTasks []*models.ReviewTask
+
+	expiryNotices []*models.DocumentExpiryNotice
+
+	files      []*models.DocumentFile
+	fileAccess []*models.DocumentFileAccess
+
+	retention       map[uuid.UUID]*models.CustomerRetention
+	legalHolds      []*models.LegalHold
+	erasureRequests []*models.ErasureRequest
+
+	statusChanges []*models.StatusChange
+	exports       []*models.DataExport
+
+	merges []*models.CustomerMerge
+	events []*models.CustomerEvent
+
+	matchKeys map[uuid.UUID][]string
+	overrides []*models.DuplicateOverride
+
+	relationships []*models.CustomerRelationship
+
+	consents []*models.Consent
 }
 
 func NewMockRepository() *MockRepository {
@@ -27,6 +59,10 @@ func NewMockRepository() *MockRepository {
 		customers: make(map[uuid.UUID]*models.Customer),
 		addresses: make(map[uuid.UUID][]*models.Address),
 		documents: make(map[uuid.UUID][]*models.CustomerDocument),
+
+		sanctionsLists: make(map[string]*models.SanctionsList),
+		retention:      make(map[uuid.UUID]*models.CustomerRetention),
+		matchKeys:      make(map[uuid.UUID][]string),
 	}
 }
 
@@ -97,6 +133,12 @@ func (m *MockRepository) SearchCustomers(ctx context.Context, filters models.Sea
 		if filters.Email != "" && customer.Email != filters.Email {
 			continue
 		}
+		if strings.HasPrefix(filters.Phone, "+") && customer.Phone != filters.Phone {
+			continue
+		}
+		if filters.Phone != "" && !strings.Contains(customer.Phone, filters.Phone) {
+			continue
+		}
 		if filters.Status != "" && customer.Status != filters.Status {
 			continue
 		}
@@ -123,6 +165,28 @@ func (m *MockRepository) UpdateAddress(ctx context.Context, address *models.Addr
 	return nil
 }
 
+func (m *MockRepository) EndAddress(ctx context.Context, address *models.Address) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	address.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (m *MockRepository) GetAddress(ctx context.Context, id uuid.UUID) (*models.Address, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, addresses := range m.addresses {
+		for _, address := range addresses {
+			if address.ID == id {
+				return address, nil
+			}
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
 func (m *MockRepository) GetCustomerAddresses(ctx context.Context, customerID uuid.UUID) ([]*models.Address, error) {
 	if m.nextErr != nil {
 		return nil, m.nextErr
@@ -130,10 +194,40 @@ func (m *MockRepository) GetCustomerAddresses(ctx context.Context, customerID uu
 	return m.addresses[customerID], nil
 }
 
+func (m *MockRepository) GetCustomerAddressesAsOf(ctx context.Context, customerID uuid.UUID, at time.Time) ([]*models.Address, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var addresses []*models.Address
+	for _, address := range m.addresses[customerID] {
+		if address.InEffectAt(at) {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses, nil
+}
+
 func (m *MockRepository) DeleteAddress(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (m *MockRepository) ReassignAddresses(ctx context.Context, ids []uuid.UUID, fromCustomerID, toCustomerID uuid.UUID) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	var kept []*models.Address
+	for _, address := range m.addresses[fromCustomerID] {
+		if slices.Contains(ids, address.ID) {
+			address.CustomerID = toCustomerID
+			m.addresses[toCustomerID] = append(m.addresses[toCustomerID], address)
+			continue
+		}
+		kept = append(kept, address)
+	}
+	m.addresses[fromCustomerID] = kept
+	return nil
+}
+
 func (m *MockRepository) AddDocument(ctx context.Context, doc *models.CustomerDocument) error {
 	if m.nextErr != nil {
 		return m.nextErr
@@ -159,12 +253,885 @@ func (m *MockRepository) GetCustomerDocuments(ctx context.Context, customerID uu
 	return m.documents[customerID], nil
 }
 
+func (m *MockRepository) GetDocument(ctx context.Context, id uuid.UUID) (*models.CustomerDocument, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, docs := range m.documents {
+		for _, doc := range docs {
+			if doc.ID == id {
+				return doc, nil
+			}
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ReassignDocuments(ctx context.Context, ids []uuid.UUID, fromCustomerID, toCustomerID uuid.UUID) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	var kept []*models.CustomerDocument
+	for _, doc := range m.documents[fromCustomerID] {
+		if slices.Contains(ids, doc.ID) {
+			doc.CustomerID = toCustomerID
+			m.documents[toCustomerID] = append(m.documents[toCustomerID], doc)
+			continue
+		}
+		kept = append(kept, doc)
+	}
+	m.documents[fromCustomerID] = kept
+	for _, file := range m.files {
+		if file.CustomerID == fromCustomerID && slices.Contains(ids, file.DocumentID) {
+			file.CustomerID = toCustomerID
+		}
+	}
+	return nil
+}
+
 func (m *MockRepository) DeleteDocument(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (m *MockRepository) BeginTx(ctx context.Context) (repository.Tx, error) {
-	return nil, nil
+func (m *MockRepository) ListCustomersForScreening(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.Customer
+	for _, customer := range m.customers {
+		if customer.Status != models.CustomerStatusClosed && customer.ID.String() > afterID.String() {
+			results = append(results, customer)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ID.String() < results[j].ID.String() })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateCustomerScreening(ctx context.Context, screening *models.CustomerScreening) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for _, hit := range screening.Hits {
+		hit.ScreeningID = screening.ID
+		hit.CustomerID = screening.CustomerID
+		hit.CreatedAt = screening.ScreenedAt
+		m.hits = append(m.hits, hit)
+	}
+	m.screenings = append(m.screenings, screening)
+	return nil
+}
+
+func (m *MockRepository) GetLatestCustomerScreening(ctx context.Context, customerID uuid.UUID) (*models.CustomerScreening, error) {
+	for i := len(m.screenings) - 1; i >= 0; i-- {
+		if m.screenings[i].CustomerID == customerID {
+			return m.screenings[i], nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListScreeningHits(ctx context.Context, filter models.ScreeningHitFilter) ([]*models.ScreeningHit, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.ScreeningHit
+	for _, hit := range m.hits {
+		if filter.CustomerID != nil && hit.CustomerID != *filter.CustomerID {
+			continue
+		}
+		if filter.Status != "" && hit.Status != filter.Status {
+			continue
+		}
+		results = append(results, hit)
+	}
+	return results, nil
+}
+
+func (m *MockRepository) GetScreeningHit(ctx context.Context, id uuid.UUID) (*models.ScreeningHit, error) {
+	for _, hit := range m.hits {
+		if hit.ID == id {
+			copied := *hit
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) UpdateScreeningHit(ctx context.Context, hit *models.ScreeningHit) error {
+	for i, existing := range m.hits {
+		if existing.ID == hit.ID {
+			m.hits[i] = hit
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) RecordSanctionsList(ctx context.Context, list *models.SanctionsList) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	key := list.ListName + ":" + list.Version
+	if existing, ok := m.sanctionsLists[key]; ok {
+		list.LoadedAt = existing.LoadedAt
+		list.RescreenedAt = existing.RescreenedAt
+		return nil
+	}
+	list.LoadedAt = time.Now().UTC()
+	stored := *list
+	m.sanctionsLists[key] = &stored
+	return nil
+}
+
+func (m *MockRepository) MarkSanctionsListRescreened(ctx context.Context, listName, version string, at time.Time) error {
+	list, ok := m.sanctionsLists[listName+":"+version]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	list.RescreenedAt = &at
+	return nil
+}
+
+func (m *MockRepository) ListCustomersDueRiskAssessment(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.Customer
+	for _, customer := range m.customers {
+		if customer.Status == models.CustomerStatusClosed || customer.ID.String() <= afterID.String() {
+			continue
+		}
+		latest, err := m.GetLatestRiskAssessment(ctx, customer.ID)
+		if err != nil {
+			results = append(results, customer)
+			continue
+		}
+		for _, hit := range m.hits {
+			changed := hit.CreatedAt
+			if hit.ReviewedAt != nil && hit.ReviewedAt.After(changed) {
+				changed = *hit.ReviewedAt
+			}
+			if hit.CustomerID == customer.ID && changed.After(latest.AssessedAt) {
+				results = append(results, customer)
+				break
+			}
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ID.String() < results[j].ID.String() })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateRiskAssessment(ctx context.Context, assessment *models.CustomerRiskAssessment) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.assessments = append(m.assessments, assessment)
+	return nil
+}
+
+func (m *MockRepository) GetLatestRiskAssessment(ctx context.Context, customerID uuid.UUID) (*models.CustomerRiskAssessment, error) {
+	for i := len(m.assessments) - 1; i >= 0; i-- {
+		if m.assessments[i].CustomerID == customerID {
+			return m.assessments[i], nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListRiskAssessments(ctx context.Context, customerID uuid.UUID, limit int) ([]*models.CustomerRiskAssessment, error) {
+	var results []*models.CustomerRiskAssessment
+	for i := len(m.assessments) - 1; i >= 0; i-- {
+		if m.assessments[i].CustomerID == customerID {
+			results = append(results, m.assessments[i])
+		}
+	}
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) ListRiskAssessmentsDueReview(ctx context.Context, before time.Time, afterCustomerID uuid.UUID, limit int) ([]*models.CustomerRiskAssessment, error) {
+	var results []*models.CustomerRiskAssessment
+	for _, customer := range m.customers {
+		if customer.Status == models.CustomerStatusClosed || customer.ID.String() <= afterCustomerID.String() {
+			continue
+		}
+		latest, err := m.GetLatestRiskAssessment(ctx, customer.ID)
+		if err != nil || latest.NextReviewAt.After(before) {
+			continue
+		}
+		open, _ := m.ListReviewTasks(ctx, models.ReviewTaskFilter{CustomerID: &customer.ID, Status: models.ReviewTaskStatusOpen})
+		if len(open) == 0 {
+			results = append(results, latest)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].CustomerID.String() < results[j].CustomerID.String() })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateReviewTask(ctx context.Context, task *models.ReviewTask) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.reviewTasks = append(m.reviewTasks, task)
+	return nil
+}
+
+func (m *MockRepository) GetReviewTask(ctx context.Context, id uuid.UUID) (*models.ReviewTask, error) {
+	for _, task := range m.reviewTasks {
+		if task.ID == id {
+			copied := *task
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListReviewTasks(ctx context.Context, filter models.ReviewTaskFilter) ([]*models.ReviewTask, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.ReviewTask
+	for _, task := range m.reviewTasks {
+		if filter.CustomerID != nil && task.CustomerID != *filter.CustomerID {
+			continue
+		}
+		if filter.Status != "" && task.Status != filter.Status {
+			continue
+		}
+		if filter.DueBefore != nil && !task.DueAt.Before(*filter.DueBefore) {
+			continue
+		}
+		results = append(results, task)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].DueAt.Before(results[j].DueAt) })
+	if filter.Limit > 0 && len(results) > filter.Limit {
+		results = results[:filter.Limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) UpdateReviewTask(ctx context.Context, task *models.ReviewTask) error {
+	for i, existing := range m.reviewTasks {
+		if existing.ID == task.ID {
+			m.reviewTasks[i] = task
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) ListDocumentsExpiringBefore(ctx context.Context, before time.Time, afterID uuid.UUID, limit int) ([]*models.CustomerDocument, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.CustomerDocument
+	for _, docs := range m.documents {
+		for _, doc := range docs {
+			if doc.VerificationStatus != models.VerificationStatusPending && doc.VerificationStatus != models.VerificationStatusVerified {
+				continue
+			}
+			if doc.ExpiryDate.Before(before) && doc.ID.String() > afterID.String() {
+				results = append(results, doc)
+			}
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ID.String() < results[j].ID.String() })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) ListCustomersWithExpiredIdentity(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.Customer, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.Customer
+	for _, customer := range m.customers {
+		if customer.Status != models.CustomerStatusActive && customer.Status != models.CustomerStatusInactive {
+			continue
+		}
+		if customer.ID.String() <= afterID.String() {
+			continue
+		}
+		for _, doc := range m.documents[customer.ID] {
+			if doc.VerificationStatus == models.VerificationStatusExpired && isIdentityDocument(doc.DocumentType) {
+				results = append(results, customer)
+				break
+			}
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ID.String() < results[j].ID.String() })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateDocumentExpiryNotice(ctx context.Context, notice *models.DocumentExpiryNotice) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.expiryNotices = append(m.expiryNotices, notice)
+	return nil
+}
+
+func (m *MockRepository) ListDocumentExpiryNotices(ctx context.Context, documentID uuid.UUID) ([]*models.DocumentExpiryNotice, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.DocumentExpiryNotice
+	for _, notice := range m.expiryNotices {
+		if notice.DocumentID == documentID {
+			results = append(results, notice)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateDocumentFile(ctx context.Context, file *models.DocumentFile) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.files = append(m.files, file)
+	return nil
+}
+
+func (m *MockRepository) GetDocumentFile(ctx context.Context, id uuid.UUID) (*models.DocumentFile, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, file := range m.files {
+		if file.ID == id {
+			return file, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListDocumentFiles(ctx context.Context, documentID uuid.UUID) ([]*models.DocumentFile, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.DocumentFile
+	for _, file := range m.files {
+		if file.DocumentID == documentID {
+			results = append(results, file)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) RecordDocumentFileAccess(ctx context.Context, access *models.DocumentFileAccess) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.fileAccess = append(m.fileAccess, access)
+	return nil
+}
+
+func (m *MockRepository) ListDocumentFileAccess(ctx context.Context, customerID uuid.UUID) ([]*models.DocumentFileAccess, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.DocumentFileAccess
+	for _, access := range m.fileAccess {
+		if access.CustomerID == customerID {
+			results = append(results, access)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) DeleteDocumentFile(ctx context.Context, id uuid.UUID) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for i, file := range m.files {
+		if file.ID == id {
+			m.files = append(m.files[:i], m.files[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (m *MockRepository) RecordCustomerClosure(ctx context.Context, customerID uuid.UUID, closedAt time.Time) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if _, exists := m.retention[customerID]; !exists {
+		m.retention[customerID] = &models.CustomerRetention{CustomerID: customerID, ClosedAt: closedAt}
+	}
+	return nil
+}
+
+func (m *MockRepository) GetCustomerRetention(ctx context.Context, customerID uuid.UUID) (*models.CustomerRetention, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	retention, exists := m.retention[customerID]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	return retention, nil
+}
+
+func (m *MockRepository) ListCustomersDueErasure(ctx context.Context, closedBefore time.Time, afterID uuid.UUID, limit int) ([]*models.CustomerRetention, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	held := make(map[uuid.UUID]bool)
+	for _, hold := range m.legalHolds {
+		if hold.IsActive() {
+			held[hold.CustomerID] = true
+		}
+	}
+	var results []*models.CustomerRetention
+	for _, retention := range m.retention {
+		if retention.ClosedAt.Before(closedBefore) && !retention.IsPseudonymized() &&
+			!held[retention.CustomerID] && retention.CustomerID.String() > afterID.String() {
+			results = append(results, retention)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].CustomerID.String() < results[j].CustomerID.String() })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (m *MockRepository) MarkCustomerPseudonymized(ctx context.Context, customerID uuid.UUID, at time.Time) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	retention, exists := m.retention[customerID]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	retention.PseudonymizedAt = &at
+	return nil
+}
+
+func (m *MockRepository) ClearCustomerClosure(ctx context.Context, customerID uuid.UUID) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if retention, exists := m.retention[customerID]; exists && !retention.IsPseudonymized() {
+		delete(m.retention, customerID)
+	}
+	return nil
+}
+
+func (m *MockRepository) RedactScreeningHits(ctx context.Context, customerID uuid.UUID, subject string) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for _, hit := range m.hits {
+		if hit.CustomerID == customerID {
+			hit.Subject = subject
+		}
+	}
+	return nil
+}
+
+func (m *MockRepository) CreateLegalHold(ctx context.Context, hold *models.LegalHold) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.legalHolds = append(m.legalHolds, hold)
+	return nil
+}
+
+func (m *MockRepository) GetLegalHold(ctx context.Context, id uuid.UUID) (*models.LegalHold, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, hold := range m.legalHolds {
+		if hold.ID == id {
+			return hold, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListLegalHolds(ctx context.Context, customerID uuid.UUID) ([]*models.LegalHold, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.LegalHold
+	for i := len(m.legalHolds) - 1; i >= 0; i-- {
+		if m.legalHolds[i].CustomerID == customerID {
+			results = append(results, m.legalHolds[i])
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) UpdateLegalHold(ctx context.Context, hold *models.LegalHold) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	return nil
+}
+
+func (m *MockRepository) CreateErasureRequest(ctx context.Context, req *models.ErasureRequest) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.erasureRequests = append(m.erasureRequests, req)
+	return nil
+}
+
+func (m *MockRepository) ListErasureRequests(ctx context.Context, customerID uuid.UUID) ([]*models.ErasureRequest, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.ErasureRequest
+	for _, req := range m.erasureRequests {
+		if req.CustomerID == customerID {
+			results = append(results, req)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateStatusChange(ctx context.Context, change *models.StatusChange) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.statusChanges = append(m.statusChanges, change)
+	return nil
+}
+
+func (m *MockRepository) ListStatusChanges(ctx context.Context, customerID uuid.UUID) ([]*models.StatusChange, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.StatusChange
+	for _, change := range m.statusChanges {
+		if change.CustomerID == customerID {
+			results = append(results, change)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateDataExport(ctx context.Context, export *models.DataExport) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.exports = append(m.exports, export)
+	return nil
+}
+
+func (m *MockRepository) GetDataExport(ctx context.Context, id uuid.UUID) (*models.DataExport, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, export := range m.exports {
+		if export.ID == id {
+			return export, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) UpdateDataExport(ctx context.Context, export *models.DataExport) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	return nil
+}
+
+func (m *MockRepository) ListPendingDataExports(ctx context.Context, limit int) ([]*models.DataExport, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.DataExport
+	for _, export := range m.exports {
+		if export.Status == models.DataExportStatusPending && len(results) < limit {
+			results = append(results, export)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) ListDataExports(ctx context.Context, customerID uuid.UUID) ([]*models.DataExport, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.DataExport
+	for _, export := range m.exports {
+		if export.CustomerID == customerID {
+			results = append(results, export)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) CreateCustomerMerge(ctx context.Context, merge *models.CustomerMerge) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.merges = append(m.merges, merge)
+	return nil
+}
+
+func (m *MockRepository) GetCustomerMerge(ctx context.Context, id uuid.UUID) (*models.CustomerMerge, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, merge := range m.merges {
+		if merge.ID == id {
+			return merge, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) GetStandingMerge(ctx context.Context, mergedID uuid.UUID) (*models.CustomerMerge, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, merge := range m.merges {
+		if merge.MergedID == mergedID && !merge.IsReversed() {
+			return merge, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListCustomerMerges(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerMerge, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.CustomerMerge
+	for i := len(m.merges) - 1; i >= 0; i-- {
+		if m.merges[i].SurvivorID == customerID || m.merges[i].MergedID == customerID {
+			results = append(results, m.merges[i])
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) UpdateCustomerMerge(ctx context.Context, merge *models.CustomerMerge) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	return nil
+}
+
+func (m *MockRepository) CreateCustomerEvent(ctx context.Context, event *models.CustomerEvent) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	event.Sequence = int64(len(m.events) + 1)
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *MockRepository) ListCustomerEvents(ctx context.Context, afterSequence int64, limit int) ([]*models.CustomerEvent, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.CustomerEvent
+	for _, event := range m.events {
+		if event.Sequence > afterSequence && len(results) < limit {
+			results = append(results, event)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) ReplaceCustomerMatchKeys(ctx context.Context, customerID uuid.UUID, keys []string) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if len(keys) == 0 {
+		delete(m.matchKeys, customerID)
+		return nil
+	}
+	m.matchKeys[customerID] = keys
+	return nil
+}
+
+func (m *MockRepository) FindDuplicateCandidates(ctx context.Context, keys []string, excludeID uuid.UUID, limit int) ([]*models.DuplicateCandidate, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var candidates []*models.DuplicateCandidate
+	for id, filed := range m.matchKeys {
+		customer := m.customers[id]
+		if id == excludeID || customer == nil || customer.Status == models.CustomerStatusClosed {
+			continue
+		}
+		shared := 0
+		for _, key := range filed {
+			if slices.Contains(keys, key) {
+				shared++
+			}
+		}
+		if shared == 0 {
+			continue
+		}
+		candidate := &models.DuplicateCandidate{Customer: customer, SharedKeys: shared}
+		for _, a := range m.addresses[id] {
+			if a.IsPrimary {
+				candidate.Street1, candidate.PostalCode, candidate.Country = a.Street1, a.PostalCode, a.Country
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	slices.SortFunc(candidates, func(a, b *models.DuplicateCandidate) int {
+		if a.SharedKeys != b.SharedKeys {
+			return b.SharedKeys - a.SharedKeys
+		}
+		return strings.Compare(a.Customer.ID.String(), b.Customer.ID.String())
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+func (m *MockRepository) ListCustomersWithoutMatchKeys(ctx context.Context, afterID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var ids []uuid.UUID
+	for id, c := range m.customers {
+		if _, filed := m.matchKeys[id]; !filed && c.Status != models.CustomerStatusClosed && id.String() > afterID.String() {
+			ids = append(ids, id)
+		}
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
+
+func (m *MockRepository) CreateDuplicateOverride(ctx context.Context, override *models.DuplicateOverride) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	m.overrides = append(m.overrides, override)
+	return nil
+}
+
+func (m *MockRepository) CreateCustomerRelationship(ctx context.Context, rel *models.CustomerRelationship) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if rel.CreatedAt.IsZero() {
+		rel.CreatedAt = time.Now().UTC()
+	}
+	m.relationships = append(m.relationships, rel)
+	return nil
+}
+
+func (m *MockRepository) GetCustomerRelationship(ctx context.Context, id uuid.UUID) (*models.CustomerRelationship, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, rel := range m.relationships {
+		if rel.ID == id {
+			return rel, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListCustomerRelationships(ctx context.Context, customerID uuid.UUID) ([]*models.CustomerRelationship, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.CustomerRelationship
+	for i := len(m.relationships) - 1; i >= 0; i-- {
+		if m.relationships[i].BusinessID == customerID || m.relationships[i].IndividualID == customerID {
+			results = append(results, m.relationships[i])
+		}
+	}
+	return results, nil
+}
+
+func (m *MockRepository) UpdateCustomerRelationship(ctx context.Context, rel *models.CustomerRelationship) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	return nil
+}
+
+func (m *MockRepository) CreateCustomerConsent(ctx context.Context, consent *models.Consent) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	if consent.RecordedAt.IsZero() {
+		consent.RecordedAt = time.Now().UTC()
+	}
+	m.consents = append(m.consents, consent)
+	return nil
+}
+
+func (m *MockRepository) GetCustomerConsent(ctx context.Context, id uuid.UUID) (*models.Consent, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	for _, consent := range m.consents {
+		if consent.ID == id {
+			return consent, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListCustomerConsents(ctx context.Context, customerID uuid.UUID) ([]*models.Consent, error) {
+	if m.nextErr != nil {
+		return nil, m.nextErr
+	}
+	var results []*models.Consent
+	for _, consent := range m.consents {
+		if consent.CustomerID == customerID {
+			results = append(results, consent)
+		}
+	}
+	slices.SortStableFunc(results, func(a, b *models.Consent) int { return b.GrantedAt.Compare(a.GrantedAt) })
+	return results, nil
+}
+
+func (m *MockRepository) UpdateCustomerConsent(ctx context.Context, consent *models.Consent) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	return nil
+}
+
+func (m *MockRepository) BeginTx(ctx context.Context) (repository.Tx, error) {
+	return &mockTx{repo: m}, nil
+}
+
+// mockTx runs operations directly against the mock repository
+type mockTx struct {
+	repo *MockRepository
+}
+
+func (t *mockTx) Commit(ctx context.Context) error   { return nil }
+func (t *mockTx) Rollback(ctx context.Context) error { return nil }
+
+func (t *mockTx) CustomerRepository() repository.CustomerRepository {
+	return t.repo
 }
 
 // Integration tests for CustomerService
@@ -0,0 +1,542 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/encryption"
+	"github.com/core-banking/services/customer-service/internal/export"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportBatchSize is how many pending exports are produced in one run
+const exportBatchSize = 500
+
+// DataExports answers subject access requests. Each bundle is signed and
+// kept with its renderings, encrypted, in the document store, where only
+// document viewers may download it.
+type DataExports struct {
+	files         *DocumentFiles
+	signer        *export.Signer
+	inlineRecords int
+}
+
+// NewDataExports creates a new DataExports keeping bundles in files' store.
+// Bundles of up to inlineRecords records are produced while the request
+// waits; larger ones are left to a DataExportJob.
+func NewDataExports(files *DocumentFiles, signer *export.Signer, inlineRecords int) *DataExports {
+	return &DataExports{
+		files:         files,
+		signer:        signer,
+		inlineRecords: inlineRecords,
+	}
+}
+
+// SetDataExports answers subject access requests with exports. They are
+// refused until it is set.
+func (s *CustomerService) SetDataExports(exports *DataExports) {
+	s.exports = exports
+}
+
+// exportKey is where a rendering of an export is stored
+func exportKey(e *models.DataExport, format export.Format) string {
+	return fmt.Sprintf("exports/%s/%s.%s", e.CustomerID, e.ID, format)
+}
+
+// assemble gathers everything held on the export's customer as at now.
+// Screening and risk records are left out: they are kept for preventing
+// financial crime and are exempt from subject access.
+func assemble(ctx context.Context, repo repository.CustomerRepository, e *models.DataExport, now time.Time) (*export.Bundle, error) {
+	customer, err := repo.GetCustomerByID(ctx, e.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := repo.GetCustomerAddresses(ctx, e.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addresses: %w", err)
+	}
+	documents, err := repo.GetCustomerDocuments(ctx, e.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+	changes, err := repo.ListStatusChanges(ctx, e.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status history: %w", err)
+	}
+	accesses, err := repo.ListDocumentFileAccess(ctx, e.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document file access: %w", err)
+	}
+	erasures, err := repo.ListErasureRequests(ctx, e.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get erasure requests: %w", err)
+	}
+	consents, err := repo.ListCustomerConsents(ctx, e.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consents: %w", err)
+	}
+	exports, err := repo.ListDataExports(ctx, e.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data exports: %w", err)
+	}
+
+	b := &export.Bundle{
+		FormatVersion: export.FormatVersion,
+		ExportID:      e.ID.String(),
+		GeneratedAt:   now,
+		Customer: export.Customer{
+			ID:             customer.ID.String(),
+			CustomerNumber: customer.CustomerNumber,
+			FirstName:      customer.FirstName,
+			LastName:       customer.LastName,
+			DateOfBirth:    customer.DateOfBirth.Format("2006-01-02"),
+			TaxID:          customer.TaxID,
+			Email:          customer.Email,
+			Phone:          customer.Phone,
+			PhoneInput:     customer.PhoneInput,
+			Status:         string(customer.Status),
+			CreatedAt:      customer.CreatedAt,
+			UpdatedAt:      customer.UpdatedAt,
+		},
+		Addresses:     []export.Address{},
+		Documents:     []export.Document{},
+		StatusHistory: []export.StatusChange{},
+		Consents:      []export.Consent{},
+		AuditEvents:   []export.AuditEvent{},
+	}
+	if customer.MiddleName != nil {
+		b.Customer.MiddleName = *customer.MiddleName
+	}
+
+	slices.SortStableFunc(addresses, func(a, b *models.Address) int { return a.ValidFrom.Compare(b.ValidFrom) })
+	for _, a := range addresses {
+		address := export.Address{
+			ID:          a.ID.String(),
+			AddressType: string(a.AddressType),
+			Street1:     a.Street1,
+			City:        a.City,
+			State:       a.State,
+			PostalCode:  a.PostalCode,
+			Country:     a.Country,
+			IsPrimary:   a.IsPrimary,
+			ValidFrom:   a.ValidFrom,
+			ValidTo:     a.ValidTo,
+		}
+		if a.Street2 != nil {
+			address.Street2 = *a.Street2
+		}
+		b.Addresses = append(b.Addresses, address)
+	}
+
+	slices.SortStableFunc(documents, func(a, b *models.CustomerDocument) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	for _, d := range documents {
+		doc := export.Document{
+			ID:                 d.ID.String(),
+			DocumentType:       string(d.DocumentType),
+			DocumentNumber:     d.DocumentNumber,
+			IssuingAuthority:   d.IssuingAuthority,
+			IssuingCountry:     d.IssuingCountry,
+			IssueDate:          d.IssueDate.Format("2006-01-02"),
+			ExpiryDate:         d.ExpiryDate.Format("2006-01-02"),
+			VerificationStatus: string(d.VerificationStatus),
+			VerifiedAt:         d.VerifiedAt,
+		}
+		files, err := repo.ListDocumentFiles(ctx, d.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list document files: %w", err)
+		}
+		for _, f := range files {
+			doc.Files = append(doc.Files, export.DocumentFile{
+				ID:          f.ID.String(),
+				FileName:    f.FileName,
+				ContentType: f.ContentType,
+				Size:        f.Size,
+				SHA256:      f.SHA256,
+				UploadedAt:  f.UploadedAt,
+			})
+		}
+		b.Documents = append(b.Documents, doc)
+	}
+
+	for _, c := range changes {
+		change := export.StatusChange{
+			PreviousStatus: string(c.PreviousStatus),
+			NewStatus:      string(c.NewStatus),
+			Reason:         c.Reason,
+			ChangedAt:      c.ChangedAt,
+		}
+		if c.ChangedBy != uuid.Nil {
+			change.ChangedBy = c.ChangedBy.String()
+		}
+		b.StatusHistory = append(b.StatusHistory, change)
+	}
+
+	slices.SortStableFunc(consents, func(a, b *models.Consent) int { return a.GrantedAt.Compare(b.GrantedAt) })
+	for _, c := range consents {
+		consent := export.Consent{
+			Purpose:       string(c.Purpose),
+			Channel:       string(c.Channel),
+			PolicyVersion: c.PolicyVersion,
+			Source:        string(c.Source),
+			GrantedAt:     c.GrantedAt,
+			WithdrawnAt:   c.WithdrawnAt,
+		}
+		if c.WithdrawalSource != nil {
+			consent.WithdrawalSource = string(*c.WithdrawalSource)
+		}
+		b.Consents = append(b.Consents, consent)
+	}
+
+	for _, a := range accesses {
+		detail := fmt.Sprintf("Viewed document scan %s: %s", a.FileID, a.Reason)
+		if !a.Granted {
+			detail = fmt.Sprintf("Refused a view of document scan %s", a.FileID)
+			if a.DenialReason != nil {
+				detail += ": " + *a.DenialReason
+			}
+		}
+		b.AuditEvents = append(b.AuditEvents, export.AuditEvent{Type: "document_file_access", Actor: a.AccessedBy.String(), Detail: detail, At: a.AccessedAt})
+	}
+	for _, r := range erasures {
+		detail := "Erasure requested and completed"
+		if r.Status == models.ErasureRequestStatusRefused {
+			detail = "Erasure requested and refused: " + strings.Join(r.Blockers, "; ")
+		}
+		b.AuditEvents = append(b.AuditEvents, export.AuditEvent{Type: "erasure_request", Actor: r.RequestedBy.String(), Detail: detail, At: r.RequestedAt})
+	}
+	for _, x := range exports {
+		if x.ID == e.ID {
+			continue
+		}
+		detail := fmt.Sprintf("Copy of data requested (%s)", strings.ToLower(string(x.Status)))
+		b.AuditEvents = append(b.AuditEvents, export.AuditEvent{Type: "data_export", Actor: x.RequestedBy.String(), Detail: detail, At: x.RequestedAt})
+	}
+	slices.SortStableFunc(b.AuditEvents, func(a, b export.AuditEvent) int { return a.At.Compare(b.At) })
+
+	return b, nil
+}
+
+// produce signs the bundle for a pending export, stores it with its
+// renderings and records it complete. Nothing is left stored if it fails.
+func (x *DataExports) produce(ctx context.Context, repo repository.CustomerRepository, e *models.DataExport, b *export.Bundle, now time.Time) error {
+	data, err := export.Marshal(b)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(data)
+	seal := export.Seal{
+		SHA256:    hex.EncodeToString(digest[:]),
+		Signature: x.signer.Sign(data),
+		PublicKey: x.signer.PublicKey(),
+	}
+
+	renderings := map[export.Format][]byte{export.FormatJSON: data}
+	var html, pdf bytes.Buffer
+	if err := export.RenderHTML(&html, b, seal); err != nil {
+		return err
+	}
+	if err := export.RenderPDF(&pdf, b, seal); err != nil {
+		return err
+	}
+	renderings[export.FormatHTML] = html.Bytes()
+	renderings[export.FormatPDF] = pdf.Bytes()
+
+	var stored []string
+	err = func() error {
+		for _, format := range export.Formats {
+			var sealed bytes.Buffer
+			cw := x.files.encryptor.NewChunkWriter(&sealed)
+			if _, err := cw.Write(renderings[format]); err != nil {
+				return err
+			}
+			if err := cw.Close(); err != nil {
+				return err
+			}
+			key := exportKey(e, format)
+			if _, err := x.files.store.Put(ctx, key, &sealed); err != nil {
+				return err
+			}
+			stored = append(stored, key)
+		}
+		if err := e.Complete(b.Records(), seal.SHA256, seal.Signature, seal.PublicKey, now); err != nil {
+			return err
+		}
+		return repo.UpdateDataExport(ctx, e)
+	}()
+	if err != nil {
+		for _, key := range stored {
+			x.files.store.Delete(context.WithoutCancel(ctx), key)
+		}
+		return err
+	}
+	return nil
+}
+
+// fail records why a pending export could not be produced
+func (x *DataExports) fail(ctx context.Context, repo repository.CustomerRepository, e *models.DataExport, cause error, now time.Time) error {
+	if err := e.Fail(cause.Error(), now); err != nil {
+		return err
+	}
+	return repo.UpdateDataExport(ctx, e)
+}
+
+// ExportCustomerData answers a subject access request with a signed bundle
+// of everything held on the customer. The request is recorded with the
+// date the answer is due. Small bundles are produced straight away; larger
+// ones are left Pending for the export job, and the caller polls
+// GetDataExport until they are ready to download.
+func (s *CustomerService) ExportCustomerData(ctx context.Context, req *customerpb.ExportCustomerDataRequest) (*customerpb.ExportCustomerDataResponse, error) {
+	if errs := s.validator.ValidateExportCustomerData(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	if s.exports == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "data export is not configured")
+	}
+
+	now := time.Now().UTC()
+	e := models.NewDataExport(uuid.MustParse(req.GetCustomerId()), uuid.MustParse(req.GetRequestedBy()), now)
+	b, err := assemble(ctx, s.repo, e, now)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to assemble customer data: %v", err)
+	}
+	if err := s.repo.CreateDataExport(ctx, e); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create data export: %v", err)
+	}
+
+	if b.Records() <= s.exports.inlineRecords {
+		if err := s.exports.produce(ctx, s.repo, e, b, now); err != nil {
+			if failErr := s.exports.fail(context.WithoutCancel(ctx), s.repo, e, err, time.Now().UTC()); failErr != nil {
+				err = errors.Join(err, failErr)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to export customer data: %v", err)
+		}
+	}
+
+	return &customerpb.ExportCustomerDataResponse{
+		Export: dataExportModelToProto(e),
+	}, nil
+}
+
+// GetDataExport gets a data export
+func (s *CustomerService) GetDataExport(ctx context.Context, req *customerpb.GetDataExportRequest) (*customerpb.GetDataExportResponse, error) {
+	exportID, err := uuid.Parse(req.GetExportId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid export id: %v", err)
+	}
+
+	e, err := s.repo.GetDataExport(ctx, exportID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "data export not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get data export: %v", err)
+	}
+
+	return &customerpb.GetDataExportResponse{
+		Export: dataExportModelToProto(e),
+	}, nil
+}
+
+// DownloadDataExport streams one rendering of a completed export in chunks.
+// Only callers holding a document viewer role may download exports, as for
+// document scans. The JSON bundle is checked against its digest as it is
+// sent: a mismatch ends the stream with DataLoss.
+func (s *CustomerService) DownloadDataExport(req *customerpb.DownloadDataExportRequest, stream customerpb.CustomerService_DownloadDataExportServer) error {
+	if errs := s.validator.ValidateDownloadDataExport(req); len(errs) > 0 {
+		return status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	if s.exports == nil {
+		return status.Errorf(codes.FailedPrecondition, "data export is not configured")
+	}
+	ctx := stream.Context()
+
+	e, err := s.repo.GetDataExport(ctx, uuid.MustParse(req.GetExportId()))
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return status.Errorf(codes.NotFound, "data export not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get data export: %v", err)
+	}
+	if _, denial := s.exports.files.authorize(ctx, uuid.MustParse(req.GetRequestedBy())); denial != "" {
+		return status.Errorf(codes.PermissionDenied, "%s", denial)
+	}
+	if e.Status != models.DataExportStatusCompleted {
+		return status.Errorf(codes.FailedPrecondition, "data export is %s", strings.ToLower(string(e.Status)))
+	}
+	retention, err := s.repo.GetCustomerRetention(ctx, e.CustomerID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return status.Errorf(codes.Internal, "failed to get customer retention: %v", err)
+	}
+	if err == nil && retention.IsPseudonymized() && !e.CompletedAt.After(*retention.PseudonymizedAt) {
+		return status.Errorf(codes.FailedPrecondition, "data export was deleted when the customer was pseudonymized")
+	}
+
+	format, _ := export.ParseFormat(req.GetFormat())
+	err = s.exports.get(ctx, e, format, func(chunk []byte) error {
+		return stream.Send(&customerpb.DownloadDataExportResponse{Chunk: chunk})
+	})
+	if err != nil {
+		return documentFileStatus(err, "read data export")
+	}
+	return nil
+}
+
+// get decrypts a stored rendering of an export, passing it to send a chunk
+// at a time
+func (x *DataExports) get(ctx context.Context, e *models.DataExport, format export.Format, send func([]byte) error) error {
+	blob, err := x.files.store.Get(ctx, exportKey(e, format))
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	r := x.files.encryptor.NewChunkReader(blob)
+	hash := sha256.New()
+	buf := make([]byte, encryption.ChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			if err := send(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if format == export.FormatJSON && e.SHA256 != nil && hex.EncodeToString(hash.Sum(nil)) != *e.SHA256 {
+		return errFileCorrupt
+	}
+	return nil
+}
+
+// DataExportJob produces the bundles of pending data exports
+type DataExportJob struct {
+	repo     repository.CustomerRepository
+	exports  *DataExports
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewDataExportJob creates a new DataExportJob
+func NewDataExportJob(repo repository.CustomerRepository, exports *DataExports, interval time.Duration, log zerolog.Logger) *DataExportJob {
+	return &DataExportJob{
+		repo:     repo,
+		exports:  exports,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run produces pending exports straight away and then every interval until
+// the context is cancelled
+func (j *DataExportJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		produced, err := j.RunOnce(ctx, time.Now().UTC())
+		if err != nil && ctx.Err() == nil {
+			j.log.Error().Err(err).Msg("Data export run failed")
+		}
+		if produced > 0 {
+			j.log.Info().Int("produced", produced).Msg("Data export run complete")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce produces pending exports as at now and returns how many were
+// completed. An export that cannot be produced is recorded as Failed, and
+// the failures are returned together.
+func (j *DataExportJob) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	pending, err := j.repo.ListPendingDataExports(ctx, exportBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	produced := 0
+	var failures []error
+	for _, e := range pending {
+		if err := ctx.Err(); err != nil {
+			return produced, err
+		}
+		b, err := assemble(ctx, j.repo, e, now)
+		if err == nil {
+			err = j.exports.produce(ctx, j.repo, e, b, now)
+		}
+		if err != nil {
+			if failErr := j.exports.fail(ctx, j.repo, e, err, now); failErr != nil {
+				err = errors.Join(err, failErr)
+			}
+			failures = append(failures, fmt.Errorf("export %s: %w", e.ID, err))
+			continue
+		}
+		produced++
+		j.log.Info().
+			Str("export_id", e.ID.String()).
+			Str("customer_id", e.CustomerID.String()).
+			Int("records", e.Records).
+			Msg("Data export produced")
+	}
+
+	if len(failures) > 0 {
+		return produced, fmt.Errorf("data export failed for %d exports: %w", len(failures), errors.Join(failures...))
+	}
+	return produced, nil
+}
+
+func dataExportModelToProto(e *models.DataExport) *customerpb.DataExport {
+	pb := &customerpb.DataExport{
+		Id:          e.ID.String(),
+		CustomerId:  e.CustomerID.String(),
+		RequestedBy: e.RequestedBy.String(),
+		Status:      string(e.Status),
+		Records:     int32(e.Records),
+		RequestedAt: timestamppb.New(e.RequestedAt),
+		DueAt:       timestamppb.New(e.DueAt),
+	}
+	if e.SHA256 != nil {
+		pb.Sha256 = *e.SHA256
+	}
+	if e.Signature != nil {
+		pb.Signature = *e.Signature
+	}
+	if e.SigningKey != nil {
+		pb.SigningKey = *e.SigningKey
+	}
+	if e.Error != nil {
+		pb.Error = *e.Error
+	}
+	if e.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*e.CompletedAt)
+	}
+	return pb
+}
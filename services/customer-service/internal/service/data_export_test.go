@@ -0,0 +1,252 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/encryption"
+	"github.com/core-banking/services/customer-service/internal/export"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/storage"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportStream collects what an export download sends
+type exportStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	content bytes.Buffer
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(resp *customerpb.DownloadDataExportResponse) error {
+	s.content.Write(resp.GetChunk())
+	return nil
+}
+
+func testDataExports(t *testing.T, inlineRecords int) (*CustomerService, *MockRepository, string) {
+	t.Helper()
+	root := t.TempDir()
+	store, err := storage.NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptor, err := encryption.NewEncryptor("0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := export.NewSigner("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewMockRepository()
+	files := NewDocumentFiles(store, encryptor, 1<<20, []string{"compliance"})
+	exports := NewDataExports(files, signer, inlineRecords)
+	svc := NewCustomerService(repo)
+	svc.SetDocumentFiles(files)
+	svc.SetRetention(NewRetention(5, files))
+	svc.SetDataExports(exports)
+	return svc, repo, root
+}
+
+func downloadExport(svc *CustomerService, exportID string, format export.Format, roles string) ([]byte, error) {
+	officer := uuid.New().String()
+	stream := &exportStream{ctx: viewerContext(officer, roles)}
+	err := svc.DownloadDataExport(&customerpb.DownloadDataExportRequest{
+		ExportId:    exportID,
+		Format:      string(format),
+		RequestedBy: officer,
+	}, stream)
+	return stream.content.Bytes(), err
+}
+
+func exportRequest(customerID uuid.UUID) *customerpb.ExportCustomerDataRequest {
+	return &customerpb.ExportCustomerDataRequest{CustomerId: customerID.String(), RequestedBy: uuid.New().String()}
+}
+
+func TestExportCustomerData(t *testing.T) {
+	svc, repo, root := testDataExports(t, 100)
+	ctx := context.Background()
+
+	customerID := uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())
+	movedOut := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	repo.addresses[customerID] = []*models.Address{
+		{ID: uuid.New(), CustomerID: customerID, AddressType: models.AddressTypePhysical, Street1: "2 New Road", City: "York", Country: "GB", IsPrimary: true, ValidFrom: movedOut},
+		{ID: uuid.New(), CustomerID: customerID, AddressType: models.AddressTypePhysical, Street1: "1 Old Road", City: "Leeds", Country: "GB", ValidFrom: movedOut.AddDate(-3, 0, 0), ValidTo: &movedOut},
+	}
+	doc := addTestDocument(repo, customerID, models.DocumentTypePassport, models.VerificationStatusVerified, time.Now().AddDate(3, 0, 0))
+	doc.DocumentNumber = "P1234567"
+	repo.fileAccess = append(repo.fileAccess, &models.DocumentFileAccess{ID: uuid.New(), FileID: uuid.New(), CustomerID: customerID, AccessedBy: uuid.New(), Reason: "Periodic review", Granted: true, AccessedAt: time.Now().UTC()})
+	_, err := svc.UpdateCustomerStatus(ctx, &customerpb.UpdateCustomerStatusRequest{Id: customerID.String(), NewStatus: "Active", Reason: "Verified in branch"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := svc.ExportCustomerData(ctx, exportRequest(customerID))
+	if err != nil {
+		t.Fatalf("ExportCustomerData() error: %v", err)
+	}
+	e := resp.GetExport()
+	if e.GetStatus() != string(models.DataExportStatusCompleted) || e.GetRecords() != 6 {
+		t.Fatalf("ExportCustomerData() = %v, want Completed with 6 records", e)
+	}
+	if due := e.GetDueAt().AsTime().Sub(e.GetRequestedAt().AsTime()); due != models.DataExportDeadline {
+		t.Errorf("export due after %v, want %v", due, models.DataExportDeadline)
+	}
+
+	data, err := downloadExport(svc, e.GetId(), export.FormatJSON, "compliance")
+	if err != nil {
+		t.Fatalf("DownloadDataExport() error: %v", err)
+	}
+	digest := sha256.Sum256(data)
+	if hex.EncodeToString(digest[:]) != e.GetSha256() {
+		t.Error("JSON bundle does not match its digest")
+	}
+	if err := export.Verify(e.GetSigningKey(), e.GetSignature(), data); err != nil {
+		t.Errorf("Verify() error: %v", err)
+	}
+
+	var bundle export.Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Customer.TaxID != "AB12345678" || bundle.Documents[0].DocumentNumber != "P1234567" {
+		t.Errorf("encrypted fields not exported: %+v", bundle)
+	}
+	if len(bundle.Addresses) != 2 || bundle.Addresses[0].Street1 != "1 Old Road" || bundle.Addresses[0].ValidTo == nil {
+		t.Errorf("addresses = %+v, want both, oldest first", bundle.Addresses)
+	}
+	if len(bundle.StatusHistory) != 1 || bundle.StatusHistory[0].Reason != "Verified in branch" {
+		t.Errorf("status history = %+v", bundle.StatusHistory)
+	}
+	if len(bundle.AuditEvents) != 1 || !strings.Contains(bundle.AuditEvents[0].Detail, "Periodic review") {
+		t.Errorf("audit events = %+v", bundle.AuditEvents)
+	}
+
+	html, err := downloadExport(svc, e.GetId(), export.FormatHTML, "compliance")
+	if err != nil || !bytes.Contains(html, []byte("P1234567")) || !bytes.Contains(html, []byte(e.GetSha256())) {
+		t.Errorf("DownloadDataExport(html) = %d bytes, %v", len(html), err)
+	}
+	pdf, err := downloadExport(svc, e.GetId(), export.FormatPDF, "compliance")
+	if err != nil || !bytes.HasPrefix(pdf, []byte("%PDF-")) {
+		t.Errorf("DownloadDataExport(pdf) = %d bytes, %v", len(pdf), err)
+	}
+
+	// Bundles are only stored encrypted
+	blobs := storedBlobs(t, root)
+	if len(blobs) != 3 {
+		t.Fatalf("stored %d blobs, want 3", len(blobs))
+	}
+	for _, blob := range blobs {
+		content, _ := os.ReadFile(blob)
+		if bytes.Contains(content, []byte("AB12345678")) {
+			t.Errorf("%s stored in plaintext", blob)
+		}
+	}
+
+	if _, err := downloadExport(svc, e.GetId(), export.FormatJSON, "teller"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DownloadDataExport() without viewer role: got %v, want PermissionDenied", err)
+	}
+	if _, err := downloadExport(svc, e.GetId(), "xml", "compliance"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("DownloadDataExport(xml): got %v, want InvalidArgument", err)
+	}
+	if _, err := svc.ExportCustomerData(ctx, exportRequest(uuid.New())); status.Code(err) != codes.NotFound {
+		t.Errorf("ExportCustomerData() unknown customer: got %v, want NotFound", err)
+	}
+	unconfigured := NewCustomerService(repo)
+	if _, err := unconfigured.ExportCustomerData(ctx, exportRequest(customerID)); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ExportCustomerData() without exports: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestExportCustomerData_Background(t *testing.T) {
+	svc, repo, _ := testDataExports(t, 0)
+	ctx := context.Background()
+	customerID := uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())
+
+	resp, err := svc.ExportCustomerData(ctx, exportRequest(customerID))
+	if err != nil {
+		t.Fatalf("ExportCustomerData() error: %v", err)
+	}
+	exportID := resp.GetExport().GetId()
+	if resp.GetExport().GetStatus() != string(models.DataExportStatusPending) {
+		t.Fatalf("ExportCustomerData() status = %s, want Pending", resp.GetExport().GetStatus())
+	}
+	if _, err := downloadExport(svc, exportID, export.FormatJSON, "compliance"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DownloadDataExport() pending: got %v, want FailedPrecondition", err)
+	}
+
+	// An export for a customer who cannot be read fails rather than blocking
+	// the rest
+	broken := models.NewDataExport(uuid.New(), uuid.New(), time.Now().UTC())
+	repo.exports = append(repo.exports, broken)
+
+	job := NewDataExportJob(repo, svc.exports, time.Minute, zerolog.Nop())
+	produced, err := job.RunOnce(ctx, time.Now().UTC())
+	if produced != 1 || err == nil {
+		t.Errorf("RunOnce() = %d, %v; want 1 and the failure", produced, err)
+	}
+	if broken.Status != models.DataExportStatusFailed || broken.Error == nil {
+		t.Errorf("broken export = %+v, want Failed", broken)
+	}
+
+	got, err := svc.GetDataExport(ctx, &customerpb.GetDataExportRequest{ExportId: exportID})
+	if err != nil || got.GetExport().GetStatus() != string(models.DataExportStatusCompleted) {
+		t.Fatalf("GetDataExport() = %v, %v; want Completed", got, err)
+	}
+	data, err := downloadExport(svc, exportID, export.FormatJSON, "compliance")
+	if err != nil {
+		t.Fatalf("DownloadDataExport() error: %v", err)
+	}
+	if err := export.Verify(got.GetExport().GetSigningKey(), got.GetExport().GetSignature(), data); err != nil {
+		t.Errorf("Verify() error: %v", err)
+	}
+
+	// Earlier requests show up in later bundles
+	resp, _ = svc.ExportCustomerData(ctx, exportRequest(customerID))
+	job.RunOnce(ctx, time.Now().UTC())
+	data, _ = downloadExport(svc, resp.GetExport().GetId(), export.FormatJSON, "compliance")
+	var bundle export.Bundle
+	json.Unmarshal(data, &bundle)
+	if len(bundle.AuditEvents) != 1 || bundle.AuditEvents[0].Type != "data_export" {
+		t.Errorf("audit events = %+v, want the earlier export", bundle.AuditEvents)
+	}
+
+	if produced, err := job.RunOnce(ctx, time.Now().UTC()); produced != 0 || err != nil {
+		t.Errorf("RunOnce() with nothing pending = %d, %v", produced, err)
+	}
+}
+
+func TestExportCustomerData_Pseudonymized(t *testing.T) {
+	svc, repo, root := testDataExports(t, 100)
+	ctx := context.Background()
+	customerID := createClosedCustomer(t, svc, repo, time.Now().UTC().AddDate(-6, 0, 0))
+
+	resp, err := svc.ExportCustomerData(ctx, exportRequest(customerID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	erased, err := svc.EraseCustomer(ctx, eraseRequest(customerID, false))
+	if err != nil || erased.GetReport().GetDataExports() != 1 {
+		t.Fatalf("EraseCustomer() = %v, %v", erased, err)
+	}
+	if blobs := storedBlobs(t, root); len(blobs) != 0 {
+		t.Errorf("bundles still stored: %v", blobs)
+	}
+	if _, err := downloadExport(svc, resp.GetExport().GetId(), export.FormatJSON, "compliance"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DownloadDataExport() after erasure: got %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// expiryBatchSize is how many documents or customers are read at a time when
+// checking document expiry
+const expiryBatchSize = 500
+
+// expiryNoticeDays are how many days before an identity document expires the
+// customer is told, longest first
+var expiryNoticeDays = [...]int{60, 30, 7}
+
+// ExpiryNotifier tells customers an identity document is about to expire so
+// they can provide a new one
+type ExpiryNotifier interface {
+	NotifyDocumentExpiring(ctx context.Context, customer *models.Customer, doc *models.CustomerDocument, daysLeft int) error
+}
+
+// ExpiryRun counts what one run of the document expiry job did
+type ExpiryRun struct {
+	Expired     int // Documents marked expired
+	NoticesSent int
+	Lapsed      int // Active or inactive customers without a valid identity document, within the grace period
+	Restricted  int // Customers restricted once the grace period ran out
+}
+
+// ExpiryMetrics counts what the document expiry job has done since the
+// service started
+type ExpiryMetrics struct {
+	runs           atomic.Int64
+	failedRuns     atomic.Int64
+	expired        atomic.Int64
+	notices        [len(expiryNoticeDays)]atomic.Int64 // By notice period, in expiryNoticeDays order
+	noticeFailures atomic.Int64
+	restricted     atomic.Int64
+	lapsed         atomic.Int64 // As at the last run
+	lastRun        atomic.Int64 // Unix seconds
+}
+
+func (m *ExpiryMetrics) record(run ExpiryRun, noticesByPeriod []int, noticeFailures int, err error, at time.Time) {
+	m.runs.Add(1)
+	if err != nil {
+		m.failedRuns.Add(1)
+	}
+	m.expired.Add(int64(run.Expired))
+	for i, n := range noticesByPeriod {
+		m.notices[i].Add(int64(n))
+	}
+	m.noticeFailures.Add(int64(noticeFailures))
+	m.restricted.Add(int64(run.Restricted))
+	m.lapsed.Store(int64(run.Lapsed))
+	m.lastRun.Store(at.Unix())
+}
+
+// WriteTo writes the metrics in the Prometheus text format
+func (m *ExpiryMetrics) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	metric := func(name, kind, help string) {
+		fmt.Fprintf(cw, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+
+	metric("customer_document_expiry_runs_total", "counter", "Runs of the document expiry job.")
+	fmt.Fprintf(cw, "customer_document_expiry_runs_total %d\n", m.runs.Load())
+	metric("customer_document_expiry_failed_runs_total", "counter", "Runs of the document expiry job that reported failures.")
+	fmt.Fprintf(cw, "customer_document_expiry_failed_runs_total %d\n", m.failedRuns.Load())
+	metric("customer_document_expiry_last_run_timestamp_seconds", "gauge", "When the document expiry job last ran.")
+	fmt.Fprintf(cw, "customer_document_expiry_last_run_timestamp_seconds %d\n", m.lastRun.Load())
+	metric("customer_documents_expired_total", "counter", "Documents marked expired.")
+	fmt.Fprintf(cw, "customer_documents_expired_total %d\n", m.expired.Load())
+	metric("customer_document_expiry_notices_total", "counter", "Expiry notices sent to customers, by days before expiry.")
+	for i, days := range expiryNoticeDays {
+		fmt.Fprintf(cw, "customer_document_expiry_notices_total{days_before=\"%d\"} %d\n", days, m.notices[i].Load())
+	}
+	metric("customer_document_expiry_notice_failures_total", "counter", "Expiry notices that could not be sent.")
+	fmt.Fprintf(cw, "customer_document_expiry_notice_failures_total %d\n", m.noticeFailures.Load())
+	metric("customer_identity_lapsed_customers", "gauge", "Active or inactive customers without a valid identity document, within the grace period.")
+	fmt.Fprintf(cw, "customer_identity_lapsed_customers %d\n", m.lapsed.Load())
+	metric("customer_identity_restrictions_total", "counter", "Customers restricted for having no valid identity document.")
+	fmt.Fprintf(cw, "customer_identity_restrictions_total %d\n", m.restricted.Load())
+
+	return cw.n, cw.err
+}
+
+// ServeHTTP serves the metrics for scraping
+func (m *ExpiryMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.WriteTo(w)
+}
+
+// countingWriter counts what is written and keeps the first error
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
+
+// DocumentExpiryJob keeps customers' identity documents in date. Each time
+// it runs it marks documents past their expiry date as expired, tells
+// customers whose identity documents expire within 60, 30 or 7 days, and
+// restricts active or inactive customers who have been without a valid
+// identity document for longer than the grace period.
+type DocumentExpiryJob struct {
+	repo        repository.CustomerRepository
+	assessor    *RiskAssessor
+	notifier    ExpiryNotifier
+	gracePeriod time.Duration
+	interval    time.Duration
+	metrics     *ExpiryMetrics
+	log         zerolog.Logger
+}
+
+// NewDocumentExpiryJob creates a new DocumentExpiryJob. The assessor may be
+// nil, in which case customers are not reassessed when a document expires.
+func NewDocumentExpiryJob(repo repository.CustomerRepository, assessor *RiskAssessor, notifier ExpiryNotifier, gracePeriod, interval time.Duration, log zerolog.Logger) *DocumentExpiryJob {
+	return &DocumentExpiryJob{
+		repo:        repo,
+		assessor:    assessor,
+		notifier:    notifier,
+		gracePeriod: gracePeriod,
+		interval:    interval,
+		metrics:     &ExpiryMetrics{},
+		log:         log,
+	}
+}
+
+// Metrics returns the job's metrics
+func (j *DocumentExpiryJob) Metrics() *ExpiryMetrics {
+	return j.metrics
+}
+
+// Run checks documents straight away and then every interval until the
+// context is cancelled
+func (j *DocumentExpiryJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		run, err := j.RunOnce(ctx, time.Now().UTC())
+		if err != nil && ctx.Err() == nil {
+			j.log.Error().Err(err).Msg("Document expiry check failed")
+		}
+		if run.Expired > 0 || run.NoticesSent > 0 || run.Restricted > 0 {
+			j.log.Info().
+				Int("expired", run.Expired).
+				Int("notices_sent", run.NoticesSent).
+				Int("lapsed", run.Lapsed).
+				Int("restricted", run.Restricted).
+				Msg("Document expiry check complete")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce does one round of checks as at now. Each step carries on past
+// failures on individual documents or customers, which are returned together.
+func (j *DocumentExpiryJob) RunOnce(ctx context.Context, now time.Time) (ExpiryRun, error) {
+	var run ExpiryRun
+	var failures []error
+
+	expired, err := j.expireDocuments(ctx, now)
+	run.Expired = expired
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	noticesByPeriod := make([]int, len(expiryNoticeDays))
+	noticeFailures, err := j.sendNotices(ctx, now, noticesByPeriod)
+	for _, n := range noticesByPeriod {
+		run.NoticesSent += n
+	}
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	lapsed, restricted, err := j.restrictLapsed(ctx, now)
+	run.Lapsed, run.Restricted = lapsed, restricted
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	err = errors.Join(failures...)
+	j.metrics.record(run, noticesByPeriod, noticeFailures, err, now)
+	return run, err
+}
+
+// expireDocuments marks pending and verified documents past their expiry date
+// as expired and reassesses their customers
+func (j *DocumentExpiryJob) expireDocuments(ctx context.Context, now time.Time) (int, error) {
+	expired := 0
+	var failures []error
+	after := uuid.Nil
+	for {
+		docs, err := j.repo.ListDocumentsExpiringBefore(ctx, now, after, expiryBatchSize)
+		if err != nil {
+			return expired, err
+		}
+		if len(docs) == 0 {
+			break
+		}
+
+		for _, doc := range docs {
+			if err := ctx.Err(); err != nil {
+				return expired, err
+			}
+			err := withTx(ctx, j.repo, func(repo repository.CustomerRepository) error {
+				doc.VerificationStatus = models.VerificationStatusExpired
+				if err := repo.UpdateDocument(ctx, doc); err != nil {
+					return err
+				}
+				if j.assessor == nil {
+					return nil
+				}
+				customer, err := repo.GetCustomerByID(ctx, doc.CustomerID)
+				if err != nil {
+					return err
+				}
+				_, err = j.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerScheduled, nil)
+				return err
+			})
+			if err != nil {
+				failures = append(failures, fmt.Errorf("document %s: %w", doc.ID, err))
+				continue
+			}
+			expired++
+		}
+		after = docs[len(docs)-1].ID
+	}
+
+	if len(failures) > 0 {
+		return expired, fmt.Errorf("expiring documents failed for %d documents: %w", len(failures), errors.Join(failures...))
+	}
+	return expired, nil
+}
+
+// sendNotices tells customers about identity documents reaching a notice
+// period, counting notices sent by period. Only the shortest period reached is
+// sent, so a document added 10 days before expiry gets the 30-day notice and
+// not the 60-day one as well. It returns how many notices could not be sent.
+func (j *DocumentExpiryJob) sendNotices(ctx context.Context, now time.Time, sent []int) (int, error) {
+	today := now.Truncate(24 * time.Hour)
+	before := today.AddDate(0, 0, expiryNoticeDays[0]+1)
+
+	var failures []error
+	after := uuid.Nil
+	for {
+		docs, err := j.repo.ListDocumentsExpiringBefore(ctx, before, after, expiryBatchSize)
+		if err != nil {
+			return len(failures), err
+		}
+		if len(docs) == 0 {
+			break
+		}
+
+		for _, doc := range docs {
+			if err := ctx.Err(); err != nil {
+				return len(failures), err
+			}
+			if !isIdentityDocument(doc.DocumentType) || doc.IsExpired(now) {
+				continue
+			}
+			daysLeft := int(doc.ExpiryDate.Sub(today) / (24 * time.Hour))
+			period := -1
+			for i, days := range expiryNoticeDays {
+				if daysLeft <= days {
+					period = i
+				}
+			}
+			if period < 0 {
+				continue
+			}
+
+			notified, err := j.notify(ctx, doc, expiryNoticeDays[period], daysLeft, now)
+			if err != nil {
+				failures = append(failures, fmt.Errorf("document %s: %w", doc.ID, err))
+				continue
+			}
+			if notified {
+				sent[period]++
+			}
+		}
+		after = docs[len(docs)-1].ID
+	}
+
+	if len(failures) > 0 {
+		return len(failures), fmt.Errorf("sending expiry notices failed for %d documents: %w", len(failures), errors.Join(failures...))
+	}
+	return 0, nil
+}
+
+// notify sends the notice for a period unless it, or one for a shorter
+// period, has already been sent for the document's expiry date. Customers
+// who are closed are not notified.
+func (j *DocumentExpiryJob) notify(ctx context.Context, doc *models.CustomerDocument, periodDays, daysLeft int, now time.Time) (bool, error) {
+	notices, err := j.repo.ListDocumentExpiryNotices(ctx, doc.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, n := range notices {
+		if n.ExpiryDate.Equal(doc.ExpiryDate) && n.DaysBefore <= periodDays {
+			return false, nil
+		}
+	}
+
+	customer, err := j.repo.GetCustomerByID(ctx, doc.CustomerID)
+	if err != nil {
+		return false, err
+	}
+	if customer.Status == models.CustomerStatusClosed {
+		return false, nil
+	}
+
+	if err := j.notifier.NotifyDocumentExpiring(ctx, customer, doc, daysLeft); err != nil {
+		return false, err
+	}
+	notice := &models.DocumentExpiryNotice{
+		ID:         uuid.New(),
+		DocumentID: doc.ID,
+		CustomerID: doc.CustomerID,
+		ExpiryDate: doc.ExpiryDate,
+		DaysBefore: periodDays,
+		SentAt:     now,
+	}
+	if err := j.repo.CreateDocumentExpiryNotice(ctx, notice); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// restrictLapsed finds active and inactive customers left without a valid
+// identity document and restricts those whose grace period has run out. It
+// returns how many are still within the grace period and how many were
+// restricted.
+func (j *DocumentExpiryJob) restrictLapsed(ctx context.Context, now time.Time) (int, int, error) {
+	lapsed, restricted := 0, 0
+	var failures []error
+	after := uuid.Nil
+	for {
+		customers, err := j.repo.ListCustomersWithExpiredIdentity(ctx, after, expiryBatchSize)
+		if err != nil {
+			return lapsed, restricted, err
+		}
+		if len(customers) == 0 {
+			break
+		}
+
+		for _, customer := range customers {
+			if err := ctx.Err(); err != nil {
+				return lapsed, restricted, err
+			}
+			var lapsedAt time.Time
+			changed := false
+			err := withTx(ctx, j.repo, func(repo repository.CustomerRepository) error {
+				docs, err := repo.GetCustomerDocuments(ctx, customer.ID)
+				if err != nil {
+					return err
+				}
+				var ok bool
+				if lapsedAt, ok = identityLapse(docs, now); !ok || now.Before(lapsedAt.Add(j.gracePeriod)) {
+					return nil
+				}
+				changed = true
+				_, err = changeStatus(ctx, repo, customer, models.CustomerStatusRestricted, "No valid identity document", nil, now)
+				return err
+			})
+			if err != nil {
+				failures = append(failures, fmt.Errorf("customer %s: %w", customer.ID, err))
+				continue
+			}
+			if !lapsedAt.IsZero() && !changed {
+				lapsed++
+			}
+			if changed {
+				restricted++
+				j.log.Warn().
+					Str("customer_id", customer.ID.String()).
+					Time("lapsed_at", lapsedAt).
+					Msg("Customer restricted for having no valid identity document")
+			}
+		}
+		after = customers[len(customers)-1].ID
+	}
+
+	if len(failures) > 0 {
+		return lapsed, restricted, fmt.Errorf("restricting customers without valid identity failed for %d customers: %w", len(failures), errors.Join(failures...))
+	}
+	return lapsed, restricted, nil
+}
+
+// identityLapse reports when a customer was left without a valid identity
+// document: one that is verified and in date. A customer has lapsed only if
+// they have no valid identity document and one they held has expired; the
+// lapse dates from the latest such expiry.
+func identityLapse(docs []*models.CustomerDocument, now time.Time) (time.Time, bool) {
+	var lapsedAt time.Time
+	for _, d := range docs {
+		if !isIdentityDocument(d.DocumentType) {
+			continue
+		}
+		switch {
+		case d.VerificationStatus == models.VerificationStatusVerified && !d.IsExpired(now):
+			return time.Time{}, false
+		case d.VerificationStatus == models.VerificationStatusExpired,
+			d.VerificationStatus == models.VerificationStatusVerified:
+			if d.ExpiryDate.After(lapsedAt) {
+				lapsedAt = d.ExpiryDate
+			}
+		}
+	}
+	return lapsedAt, !lapsedAt.IsZero()
+}
+
+// hasLapsedIdentity reports whether a customer has been left without a valid
+// identity document
+func hasLapsedIdentity(ctx context.Context, repo repository.CustomerRepository, customerID uuid.UUID, now time.Time) (bool, error) {
+	docs, err := repo.GetCustomerDocuments(ctx, customerID)
+	if err != nil {
+		return false, err
+	}
+	_, lapsed := identityLapse(docs, now)
+	return lapsed, nil
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockNotifier records the expiry notices sent
+type mockNotifier struct {
+	sent []int // Days left of each notice
+	err  error
+}
+
+func (n *mockNotifier) NotifyDocumentExpiring(ctx context.Context, customer *models.Customer, doc *models.CustomerDocument, daysLeft int) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, daysLeft)
+	return nil
+}
+
+func addTestDocument(repo *MockRepository, customerID uuid.UUID, docType models.DocumentType, verification models.VerificationStatus, expiry time.Time) *models.CustomerDocument {
+	doc := &models.CustomerDocument{
+		ID:                 uuid.New(),
+		CustomerID:         customerID,
+		DocumentType:       docType,
+		DocumentNumber:     "X1234567",
+		IssuingCountry:     "US",
+		ExpiryDate:         expiry,
+		VerificationStatus: verification,
+	}
+	repo.documents[customerID] = append(repo.documents[customerID], doc)
+	return doc
+}
+
+func TestDocumentExpiryJob_Notices(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	notifier := &mockNotifier{}
+	job := NewDocumentExpiryJob(repo, nil, notifier, 30*24*time.Hour, time.Hour, zerolog.Nop())
+	ctx := context.Background()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	today := now.Truncate(24 * time.Hour)
+	customerID := uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())
+	passport := addTestDocument(repo, customerID, models.DocumentTypePassport, models.VerificationStatusVerified, today.AddDate(0, 0, 50))
+	addTestDocument(repo, customerID, models.DocumentTypeUtilityBill, models.VerificationStatusVerified, today.AddDate(0, 0, 10))
+	addTestDocument(repo, customerID, models.DocumentTypeDriversLicense, models.VerificationStatusPending, today.AddDate(0, 0, 5))
+	addTestDocument(repo, customerID, models.DocumentTypeNationalID, models.VerificationStatusVerified, today.AddDate(0, 0, 200))
+
+	run, err := job.RunOnce(ctx, now)
+	if err != nil {
+		t.Fatalf("RunOnce() error: %v", err)
+	}
+	if run.NoticesSent != 2 || len(notifier.sent) != 2 {
+		t.Fatalf("notices sent = %d %v, want 2", run.NoticesSent, notifier.sent)
+	}
+	periods := map[int]bool{}
+	for _, n := range repo.expiryNotices {
+		periods[n.DaysBefore] = true
+	}
+	// Only the shortest notice period reached is sent
+	if !periods[60] || !periods[7] || periods[30] {
+		t.Errorf("notice periods = %v, want 60 and 7", periods)
+	}
+
+	if run, _ := job.RunOnce(ctx, now.Add(time.Hour)); run.NoticesSent != 0 {
+		t.Errorf("repeat run sent %d notices, want 0", run.NoticesSent)
+	}
+
+	if run, _ := job.RunOnce(ctx, now.AddDate(0, 0, 25)); run.NoticesSent != 1 || notifier.sent[2] != 25 {
+		t.Errorf("30-day run sent %d notices %v, want one with 25 days left", run.NoticesSent, notifier.sent)
+	}
+
+	// A renewed expiry date starts the notices again
+	passport.ExpiryDate = today.AddDate(0, 0, 55)
+	if run, _ := job.RunOnce(ctx, now.AddDate(0, 0, 25)); run.NoticesSent != 1 {
+		t.Errorf("renewed document run sent %d notices, want 1", run.NoticesSent)
+	}
+
+	// Failures are counted and retried on the next run
+	notifier.err = errors.New("mail server unavailable")
+	if _, err := job.RunOnce(ctx, now.AddDate(0, 0, 49)); err == nil {
+		t.Error("RunOnce() with failing notifier: want error")
+	}
+	notifier.err = nil
+	if run, _ := job.RunOnce(ctx, now.AddDate(0, 0, 49)); run.NoticesSent != 1 {
+		t.Errorf("retry sent %d notices, want 1", run.NoticesSent)
+	}
+
+	var metrics bytes.Buffer
+	if _, err := job.Metrics().WriteTo(&metrics); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`customer_document_expiry_runs_total 6`,
+		`customer_document_expiry_failed_runs_total 1`,
+		`customer_document_expiry_notices_total{days_before="60"} 1`,
+		`customer_document_expiry_notices_total{days_before="30"} 2`,
+		`customer_document_expiry_notices_total{days_before="7"} 2`,
+		`customer_document_expiry_notice_failures_total 1`,
+	} {
+		if !strings.Contains(metrics.String(), line+"\n") {
+			t.Errorf("metrics missing %q:\n%s", line, metrics.String())
+		}
+	}
+}
+
+func TestDocumentExpiryJob_ExpiresAndRestricts(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetRiskAssessor(testAssessor(nil))
+	job := NewDocumentExpiryJob(repo, testAssessor(nil), &mockNotifier{}, 30*24*time.Hour, time.Hour, zerolog.Nop())
+	ctx := context.Background()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	customerID := uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())
+	repo.customers[customerID].Status = models.CustomerStatusActive
+	passport := addTestDocument(repo, customerID, models.DocumentTypePassport, models.VerificationStatusVerified, today.AddDate(0, 0, 3))
+	bill := addTestDocument(repo, customerID, models.DocumentTypeUtilityBill, models.VerificationStatusPending, today.AddDate(0, 0, 1))
+
+	expiredOn := today.AddDate(0, 0, 4).Add(time.Hour)
+	run, err := job.RunOnce(ctx, expiredOn)
+	if err != nil {
+		t.Fatalf("RunOnce() error: %v", err)
+	}
+	if run.Expired != 2 || run.Lapsed != 1 || run.Restricted != 0 {
+		t.Errorf("run = %+v, want 2 expired and 1 lapsed", run)
+	}
+	if passport.VerificationStatus != models.VerificationStatusExpired || bill.VerificationStatus != models.VerificationStatusExpired {
+		t.Errorf("statuses = %s, %s; want Expired", passport.VerificationStatus, bill.VerificationStatus)
+	}
+	// Each expiry reassesses the customer
+	if len(repo.assessments) != 3 {
+		t.Errorf("%d assessments, want 3", len(repo.assessments))
+	}
+
+	run, err = job.RunOnce(ctx, expiredOn.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("RunOnce() error: %v", err)
+	}
+	if run.Restricted != 1 || run.Lapsed != 0 {
+		t.Errorf("run after grace period = %+v, want 1 restricted", run)
+	}
+	if repo.customers[customerID].Status != models.CustomerStatusRestricted {
+		t.Fatalf("status = %s, want Restricted", repo.customers[customerID].Status)
+	}
+
+	activate := &customerpb.UpdateCustomerStatusRequest{
+		Id:        customerID.String(),
+		NewStatus: string(models.CustomerStatusActive),
+		Reason:    "New passport provided",
+	}
+	if _, err := svc.UpdateCustomerStatus(ctx, activate); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("activation without valid identity: got %v, want FailedPrecondition", err)
+	}
+
+	addTestDocument(repo, customerID, models.DocumentTypePassport, models.VerificationStatusVerified, today.AddDate(10, 0, 0))
+	if _, err := svc.UpdateCustomerStatus(ctx, activate); err != nil {
+		t.Fatalf("activation with new passport: %v", err)
+	}
+}
+
+func TestIdentityLapse(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	doc := func(docType models.DocumentType, verification models.VerificationStatus, expiry time.Time) *models.CustomerDocument {
+		return &models.CustomerDocument{DocumentType: docType, VerificationStatus: verification, ExpiryDate: expiry}
+	}
+	lastMonth := now.AddDate(0, -1, 0)
+	lastWeek := now.AddDate(0, 0, -7)
+
+	tests := []struct {
+		name       string
+		docs       []*models.CustomerDocument
+		wantLapsed bool
+		wantAt     time.Time
+	}{
+		{"no documents", nil, false, time.Time{}},
+		{"only pending", []*models.CustomerDocument{doc(models.DocumentTypePassport, models.VerificationStatusPending, now.AddDate(1, 0, 0))}, false, time.Time{}},
+		{"valid passport", []*models.CustomerDocument{
+			doc(models.DocumentTypePassport, models.VerificationStatusExpired, lastMonth),
+			doc(models.DocumentTypeNationalID, models.VerificationStatusVerified, now.AddDate(1, 0, 0)),
+		}, false, time.Time{}},
+		{"expired passport", []*models.CustomerDocument{doc(models.DocumentTypePassport, models.VerificationStatusExpired, lastMonth)}, true, lastMonth},
+		{"verified but out of date", []*models.CustomerDocument{
+			doc(models.DocumentTypePassport, models.VerificationStatusExpired, lastMonth),
+			doc(models.DocumentTypeDriversLicense, models.VerificationStatusVerified, lastWeek),
+		}, true, lastWeek},
+		{"expired utility bill", []*models.CustomerDocument{doc(models.DocumentTypeUtilityBill, models.VerificationStatusExpired, lastMonth)}, false, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, lapsed := identityLapse(tt.docs, now)
+			if lapsed != tt.wantLapsed || !at.Equal(tt.wantAt) {
+				t.Errorf("identityLapse() = %v, %v; want %v, %v", at, lapsed, tt.wantAt, tt.wantLapsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/encryption"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/core-banking/services/customer-service/internal/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sniffLen is how much of a file is read to detect its content type
+const sniffLen = 512
+
+// documentFileTypes are the content types accepted for document scans
+var documentFileTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+}
+
+var (
+	errFileEmpty        = errors.New("file is empty")
+	errFileTooLarge     = errors.New("file is too large")
+	errFileType         = errors.New("file type is not accepted")
+	errHeaderRepeated   = errors.New("file header sent more than once")
+	errChecksumMismatch = errors.New("file content does not match the SHA-256 digest sent")
+	errFileCorrupt      = errors.New("stored file failed its integrity check")
+)
+
+// DocumentFiles keeps scans of customer documents in a blob store, each
+// encrypted in chunks, and decides who may download them
+type DocumentFiles struct {
+	store       storage.BlobStore
+	encryptor   *encryption.Encryptor
+	maxSize     int64
+	viewerRoles map[string]bool
+}
+
+// NewDocumentFiles creates a new DocumentFiles. Files larger than maxSize
+// bytes are refused, and only callers presenting one of viewerRoles may
+// download files.
+func NewDocumentFiles(store storage.BlobStore, encryptor *encryption.Encryptor, maxSize int64, viewerRoles []string) *DocumentFiles {
+	roles := make(map[string]bool, len(viewerRoles))
+	for _, role := range viewerRoles {
+		if role = strings.TrimSpace(role); role != "" {
+			roles[role] = true
+		}
+	}
+	return &DocumentFiles{
+		store:       store,
+		encryptor:   encryptor,
+		maxSize:     maxSize,
+		viewerRoles: roles,
+	}
+}
+
+// SetDocumentFiles keeps scans of customer documents in files. Document
+// file storage is disabled until it is set.
+func (s *CustomerService) SetDocumentFiles(files *DocumentFiles) {
+	s.files = files
+}
+
+// UploadDocumentFile stores a scan of a customer document. The client sends
+// the file's header and then its content in chunks. The content type is
+// sniffed from the content, which must be a PDF or a JPEG, PNG or WebP image
+// no larger than the configured limit; if the header carries a SHA-256 digest
+// the file is refused unless the content matches it.
+func (s *CustomerService) UploadDocumentFile(stream customerpb.CustomerService_UploadDocumentFileServer) error {
+	if s.files == nil {
+		return status.Errorf(codes.FailedPrecondition, "document file storage is not configured")
+	}
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "file header is required")
+	}
+	if err != nil {
+		return documentFileStatus(err, "receive file")
+	}
+	header := first.GetHeader()
+	if header == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message must be the file header")
+	}
+	if errs := s.validator.ValidateDocumentFileHeader(header); len(errs) > 0 {
+		return status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	doc, err := s.repo.GetDocument(ctx, uuid.MustParse(header.GetDocumentId()))
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return status.Errorf(codes.NotFound, "document not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get document: %v", err)
+	}
+	pseudonymized, err := isPseudonymized(ctx, s.repo, doc.CustomerID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get customer retention: %v", err)
+	}
+	if pseudonymized {
+		return status.Errorf(codes.FailedPrecondition, "customer has been pseudonymized")
+	}
+
+	file := &models.DocumentFile{
+		ID:         uuid.New(),
+		DocumentID: doc.ID,
+		CustomerID: doc.CustomerID,
+		FileName:   header.GetFileName(),
+		UploadedBy: uuid.MustParse(header.GetUploadedBy()),
+		UploadedAt: time.Now().UTC(),
+	}
+	file.StorageKey = fmt.Sprintf("documents/%s/%s", file.CustomerID, file.ID)
+
+	next := func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if msg.GetHeader() != nil {
+			return nil, errHeaderRepeated
+		}
+		return msg.GetChunk(), nil
+	}
+	if err := s.files.put(ctx, file, next, header.GetSha256()); err != nil {
+		return documentFileStatus(err, "store file")
+	}
+
+	if err := s.repo.CreateDocumentFile(ctx, file); err != nil {
+		s.files.store.Delete(context.WithoutCancel(ctx), file.StorageKey)
+		return status.Errorf(codes.Internal, "failed to record document file: %v", err)
+	}
+
+	return stream.SendAndClose(&customerpb.UploadDocumentFileResponse{
+		File: documentFileModelToProto(file),
+	})
+}
+
+// DownloadDocumentFile streams a stored document scan to callers holding one
+// of the document viewer roles, presented in the x-user-roles metadata; a
+// caller identifying themselves in x-user-id must be the requester. Every
+// request is recorded in the access log whether or not it is allowed, and
+// none is served if it cannot be recorded. The content is checked against
+// its SHA-256 digest as it is sent: a mismatch ends the stream with DataLoss,
+// and the client must discard what it received.
+func (s *CustomerService) DownloadDocumentFile(req *customerpb.DownloadDocumentFileRequest, stream customerpb.CustomerService_DownloadDocumentFileServer) error {
+	if s.files == nil {
+		return status.Errorf(codes.FailedPrecondition, "document file storage is not configured")
+	}
+	if errs := s.validator.ValidateDownloadDocumentFile(req); len(errs) > 0 {
+		return status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	ctx := stream.Context()
+
+	file, err := s.repo.GetDocumentFile(ctx, uuid.MustParse(req.GetFileId()))
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return status.Errorf(codes.NotFound, "document file not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get document file: %v", err)
+	}
+
+	requestedBy := uuid.MustParse(req.GetRequestedBy())
+	roles, denial := s.files.authorize(ctx, requestedBy)
+	access := &models.DocumentFileAccess{
+		ID:         uuid.New(),
+		FileID:     file.ID,
+		CustomerID: file.CustomerID,
+		AccessedBy: requestedBy,
+		Roles:      strings.Join(roles, ","),
+		Reason:     req.GetReason(),
+		Granted:    denial == "",
+		AccessedAt: time.Now().UTC(),
+	}
+	if denial != "" {
+		access.DenialReason = &denial
+	}
+	if err := s.repo.RecordDocumentFileAccess(ctx, access); err != nil {
+		return status.Errorf(codes.Internal, "failed to record document file access: %v", err)
+	}
+	if denial != "" {
+		return status.Errorf(codes.PermissionDenied, "%s", denial)
+	}
+
+	err = stream.Send(&customerpb.DownloadDocumentFileResponse{
+		Data: &customerpb.DownloadDocumentFileResponse_File{File: documentFileModelToProto(file)},
+	})
+	if err != nil {
+		return err
+	}
+	err = s.files.get(ctx, file, func(chunk []byte) error {
+		return stream.Send(&customerpb.DownloadDocumentFileResponse{
+			Data: &customerpb.DownloadDocumentFileResponse_Chunk{Chunk: chunk},
+		})
+	})
+	if err != nil {
+		return documentFileStatus(err, "read file")
+	}
+	return nil
+}
+
+// ListDocumentFiles lists the scans stored for a document
+func (s *CustomerService) ListDocumentFiles(ctx context.Context, req *customerpb.ListDocumentFilesRequest) (*customerpb.ListDocumentFilesResponse, error) {
+	documentID, err := uuid.Parse(req.GetDocumentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid document id: %v", err)
+	}
+
+	if _, err := s.repo.GetDocument(ctx, documentID); err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "document not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get document: %v", err)
+	}
+
+	files, err := s.repo.ListDocumentFiles(ctx, documentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list document files: %v", err)
+	}
+
+	protoFiles := make([]*customerpb.DocumentFile, len(files))
+	for i, f := range files {
+		protoFiles[i] = documentFileModelToProto(f)
+	}
+
+	return &customerpb.ListDocumentFilesResponse{
+		Files: protoFiles,
+	}, nil
+}
+
+// put encrypts the content returned by next, until it returns io.EOF, into
+// the blob store under the file's key, filling in its size, content type and
+// digest. Nothing is left stored if the content is refused.
+func (f *DocumentFiles) put(ctx context.Context, file *models.DocumentFile, next func() ([]byte, error), wantSHA256 string) error {
+	pr, pw := io.Pipe()
+	stored := make(chan error, 1)
+	go func() {
+		_, err := f.store.Put(ctx, file.StorageKey, pr)
+		pr.CloseWithError(err)
+		stored <- err
+	}()
+
+	err := f.encrypt(pw, file, next)
+	pw.CloseWithError(err)
+	if storeErr := <-stored; err == nil {
+		err = storeErr
+	}
+	if err == nil && wantSHA256 != "" && !strings.EqualFold(wantSHA256, file.SHA256) {
+		err = errChecksumMismatch
+	}
+	if err != nil {
+		f.store.Delete(context.WithoutCancel(ctx), file.StorageKey)
+		return err
+	}
+	return nil
+}
+
+func (f *DocumentFiles) encrypt(w io.Writer, file *models.DocumentFile, next func() ([]byte, error)) error {
+	cw := f.encryptor.NewChunkWriter(w)
+	hash := sha256.New()
+	var head []byte
+	for {
+		chunk, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		file.Size += int64(len(chunk))
+		if file.Size > f.maxSize {
+			return fmt.Errorf("%w: the limit is %d bytes", errFileTooLarge, f.maxSize)
+		}
+		// Refuse the wrong kind of file as soon as enough is seen to tell
+		if file.ContentType == "" {
+			head = append(head, chunk[:min(len(chunk), sniffLen-len(head))]...)
+			if len(head) == sniffLen {
+				if err := sniffContentType(file, head); err != nil {
+					return err
+				}
+			}
+		}
+
+		hash.Write(chunk)
+		if _, err := cw.Write(chunk); err != nil {
+			return err
+		}
+	}
+
+	if file.Size == 0 {
+		return errFileEmpty
+	}
+	if file.ContentType == "" {
+		if err := sniffContentType(file, head); err != nil {
+			return err
+		}
+	}
+	file.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return cw.Close()
+}
+
+func sniffContentType(file *models.DocumentFile, head []byte) error {
+	contentType := http.DetectContentType(head)
+	if !documentFileTypes[contentType] {
+		return fmt.Errorf("%w: %s", errFileType, contentType)
+	}
+	file.ContentType = contentType
+	return nil
+}
+
+// get decrypts a stored file, passing its content to send a chunk at a time,
+// and checks the whole against the file's size and digest
+func (f *DocumentFiles) get(ctx context.Context, file *models.DocumentFile, send func([]byte) error) error {
+	blob, err := f.store.Get(ctx, file.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	r := f.encryptor.NewChunkReader(blob)
+	hash := sha256.New()
+	buf := make([]byte, encryption.ChunkSize)
+	var size int64
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			size += int64(n)
+			if err := send(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if size != file.Size || hex.EncodeToString(hash.Sum(nil)) != file.SHA256 {
+		return errFileCorrupt
+	}
+	return nil
+}
+
+// authorize checks whether the caller may download files as user. It returns
+// the roles the caller presented and, if they are refused, why.
+func (f *DocumentFiles) authorize(ctx context.Context, user uuid.UUID) ([]string, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var roles []string
+	for _, value := range md.Get("x-user-roles") {
+		for _, role := range strings.Split(value, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	if ids := md.Get("x-user-id"); len(ids) > 0 && ids[0] != user.String() {
+		return roles, "requested_by is not the calling user"
+	}
+	for _, role := range roles {
+		if f.viewerRoles[role] {
+			return roles, ""
+		}
+	}
+	return roles, "caller does not hold a document viewer role"
+}
+
+// documentFileStatus converts an error storing or reading a file to a gRPC
+// status
+func documentFileStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, errFileEmpty), errors.Is(err, errFileTooLarge),
+		errors.Is(err, errFileType), errors.Is(err, errHeaderRepeated):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errChecksumMismatch):
+		return status.Errorf(codes.DataLoss, "%v", err)
+	case errors.Is(err, errFileCorrupt), errors.Is(err, encryption.ErrChunkCorrupt), errors.Is(err, storage.ErrNotFound):
+		return status.Errorf(codes.DataLoss, "stored file is missing or corrupt: %v", err)
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+func documentFileModelToProto(f *models.DocumentFile) *customerpb.DocumentFile {
+	return &customerpb.DocumentFile{
+		Id:          f.ID.String(),
+		DocumentId:  f.DocumentID.String(),
+		CustomerId:  f.CustomerID.String(),
+		FileName:    f.FileName,
+		ContentType: f.ContentType,
+		Size:        f.Size,
+		Sha256:      f.SHA256,
+		UploadedBy:  f.UploadedBy.String(),
+		UploadedAt:  timestamppb.New(f.UploadedAt),
+	}
+}
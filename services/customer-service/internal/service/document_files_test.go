@@ -0,0 +1,349 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/encryption"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// uploadStream feeds an upload its messages and keeps the response
+type uploadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs []*customerpb.UploadDocumentFileRequest
+	resp *customerpb.UploadDocumentFileResponse
+}
+
+func (s *uploadStream) Context() context.Context { return s.ctx }
+
+func (s *uploadStream) Recv() (*customerpb.UploadDocumentFileRequest, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *uploadStream) SendAndClose(resp *customerpb.UploadDocumentFileResponse) error {
+	s.resp = resp
+	return nil
+}
+
+// downloadStream collects what a download sends
+type downloadStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	file    *customerpb.DocumentFile
+	content bytes.Buffer
+}
+
+func (s *downloadStream) Context() context.Context { return s.ctx }
+
+func (s *downloadStream) Send(resp *customerpb.DownloadDocumentFileResponse) error {
+	if file := resp.GetFile(); file != nil {
+		s.file = file
+	} else {
+		s.content.Write(resp.GetChunk())
+	}
+	return nil
+}
+
+func testDocumentFiles(t *testing.T, maxSize int64) (*CustomerService, *MockRepository, string, *models.CustomerDocument) {
+	t.Helper()
+	root := t.TempDir()
+	store, err := storage.NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptor, err := encryption.NewEncryptor("0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewMockRepository()
+	doc := addTestDocument(repo, uuid.New(), models.DocumentTypePassport, models.VerificationStatusPending, time.Now().AddDate(5, 0, 0))
+	files := NewDocumentFiles(store, encryptor, maxSize, []string{"compliance", "kyc-officer"})
+	svc := NewCustomerService(repo)
+	svc.SetDocumentFiles(files)
+	return svc, repo, root, doc
+}
+
+func uploadFile(svc *CustomerService, documentID uuid.UUID, content []byte, chunkSize int, digest string) (*customerpb.DocumentFile, error) {
+	stream := &uploadStream{ctx: context.Background()}
+	stream.msgs = append(stream.msgs, &customerpb.UploadDocumentFileRequest{
+		Data: &customerpb.UploadDocumentFileRequest_Header{Header: &customerpb.DocumentFileHeader{
+			DocumentId: documentID.String(),
+			FileName:   "passport.pdf",
+			UploadedBy: uuid.New().String(),
+			Sha256:     digest,
+		}},
+	})
+	for len(content) > 0 {
+		n := min(chunkSize, len(content))
+		stream.msgs = append(stream.msgs, &customerpb.UploadDocumentFileRequest{
+			Data: &customerpb.UploadDocumentFileRequest_Chunk{Chunk: content[:n]},
+		})
+		content = content[n:]
+	}
+	if err := svc.UploadDocumentFile(stream); err != nil {
+		return nil, err
+	}
+	return stream.resp.GetFile(), nil
+}
+
+func viewerContext(userID string, roles string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-user-roles", roles))
+}
+
+// storedBlobs lists the files kept in a local store
+func storedBlobs(t *testing.T, root string) []string {
+	t.Helper()
+	var blobs []string
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			blobs = append(blobs, path)
+		}
+		return err
+	})
+	return blobs
+}
+
+func testPDF(size int) []byte {
+	content := []byte("%PDF-1.7\n")
+	for i := 0; len(content) < size; i++ {
+		content = append(content, byte(i%251))
+	}
+	return content
+}
+
+func TestDocumentFiles_UploadDownload(t *testing.T) {
+	svc, repo, root, doc := testDocumentFiles(t, 1<<20)
+
+	content := testPDF(150 * 1024)
+	digest := sha256.Sum256(content)
+	file, err := uploadFile(svc, doc.ID, content, 40*1024, hex.EncodeToString(digest[:]))
+	if err != nil {
+		t.Fatalf("UploadDocumentFile() error: %v", err)
+	}
+	if file.GetContentType() != "application/pdf" || file.GetSize() != int64(len(content)) || file.GetCustomerId() != doc.CustomerID.String() {
+		t.Errorf("UploadDocumentFile() = %v", file)
+	}
+
+	// The stored blob is encrypted
+	blobs := storedBlobs(t, root)
+	if len(blobs) != 1 {
+		t.Fatalf("stored %v, want one blob", blobs)
+	}
+	sealed, _ := os.ReadFile(blobs[0])
+	if bytes.Contains(sealed, []byte("%PDF")) {
+		t.Error("blob stored in plaintext")
+	}
+
+	listed, err := svc.ListDocumentFiles(context.Background(), &customerpb.ListDocumentFilesRequest{DocumentId: doc.ID.String()})
+	if err != nil || len(listed.GetFiles()) != 1 || listed.GetFiles()[0].GetId() != file.GetId() {
+		t.Fatalf("ListDocumentFiles() = %v, %v", listed, err)
+	}
+
+	viewer := uuid.New().String()
+	stream := &downloadStream{ctx: viewerContext(viewer, "teller, kyc-officer")}
+	err = svc.DownloadDocumentFile(&customerpb.DownloadDocumentFileRequest{
+		FileId:      file.GetId(),
+		RequestedBy: viewer,
+		Reason:      "Periodic KYC review",
+	}, stream)
+	if err != nil {
+		t.Fatalf("DownloadDocumentFile() error: %v", err)
+	}
+	if stream.file.GetId() != file.GetId() || !bytes.Equal(stream.content.Bytes(), content) {
+		t.Errorf("DownloadDocumentFile() sent %d bytes of %v", stream.content.Len(), stream.file)
+	}
+
+	if len(repo.fileAccess) != 1 {
+		t.Fatalf("recorded %d accesses, want 1", len(repo.fileAccess))
+	}
+	access := repo.fileAccess[0]
+	if !access.Granted || access.AccessedBy.String() != viewer || access.Roles != "teller,kyc-officer" || access.Reason != "Periodic KYC review" {
+		t.Errorf("recorded access %+v", access)
+	}
+}
+
+func TestDocumentFiles_UploadRefused(t *testing.T) {
+	svc, repo, root, doc := testDocumentFiles(t, 64*1024)
+	pdf := testPDF(1024)
+
+	tests := []struct {
+		name       string
+		documentID uuid.UUID
+		content    []byte
+		digest     string
+		want       codes.Code
+	}{
+		{"unknown document", uuid.New(), pdf, "", codes.NotFound},
+		{"empty file", doc.ID, nil, "", codes.InvalidArgument},
+		{"not a scan", doc.ID, []byte(strings.Repeat("plain text ", 100)), "", codes.InvalidArgument},
+		{"too large", doc.ID, testPDF(100 * 1024), "", codes.InvalidArgument},
+		{"digest mismatch", doc.ID, pdf, strings.Repeat("0", 64), codes.DataLoss},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uploadFile(svc, tt.documentID, tt.content, 16*1024, tt.digest)
+			if status.Code(err) != tt.want {
+				t.Errorf("UploadDocumentFile() error = %v, want %s", err, tt.want)
+			}
+		})
+	}
+
+	if len(repo.files) != 0 {
+		t.Errorf("recorded %d files, want none", len(repo.files))
+	}
+	if blobs := storedBlobs(t, root); len(blobs) != 0 {
+		t.Errorf("left behind %v", blobs)
+	}
+
+	// The header must come first, and only once
+	header := &customerpb.UploadDocumentFileRequest{
+		Data: &customerpb.UploadDocumentFileRequest_Header{Header: &customerpb.DocumentFileHeader{
+			DocumentId: doc.ID.String(),
+			FileName:   "passport.pdf",
+			UploadedBy: uuid.New().String(),
+		}},
+	}
+	chunk := &customerpb.UploadDocumentFileRequest{Data: &customerpb.UploadDocumentFileRequest_Chunk{Chunk: pdf}}
+	for _, msgs := range [][]*customerpb.UploadDocumentFileRequest{{chunk}, {header, chunk, header}} {
+		err := svc.UploadDocumentFile(&uploadStream{ctx: context.Background(), msgs: msgs})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UploadDocumentFile() error = %v, want InvalidArgument", err)
+		}
+	}
+}
+
+func TestDocumentFiles_DownloadAccess(t *testing.T) {
+	svc, repo, _, doc := testDocumentFiles(t, 1<<20)
+	file, err := uploadFile(svc, doc.ID, testPDF(2048), 1024, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	viewer := uuid.New().String()
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"no metadata", context.Background()},
+		{"no viewer role", viewerContext(viewer, "teller")},
+		{"requested for someone else", viewerContext(uuid.New().String(), "compliance")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(repo.fileAccess)
+			stream := &downloadStream{ctx: tt.ctx}
+			err := svc.DownloadDocumentFile(&customerpb.DownloadDocumentFileRequest{
+				FileId:      file.GetId(),
+				RequestedBy: viewer,
+				Reason:      "Curious",
+			}, stream)
+			if status.Code(err) != codes.PermissionDenied {
+				t.Errorf("DownloadDocumentFile() error = %v, want PermissionDenied", err)
+			}
+			if stream.file != nil || stream.content.Len() != 0 {
+				t.Error("DownloadDocumentFile() sent the file to a refused caller")
+			}
+			if len(repo.fileAccess) != before+1 || repo.fileAccess[before].Granted || repo.fileAccess[before].DenialReason == nil {
+				t.Errorf("refusal not recorded: %+v", repo.fileAccess[before:])
+			}
+		})
+	}
+
+	// Nothing is served when the access cannot be recorded
+	req := &customerpb.DownloadDocumentFileRequest{FileId: file.GetId(), RequestedBy: viewer, Reason: "Periodic KYC review"}
+	stream := &downloadStream{ctx: viewerContext(viewer, "compliance")}
+	unaudited := NewCustomerService(&failingAuditRepository{repo})
+	unaudited.SetDocumentFiles(svc.files)
+	err = unaudited.DownloadDocumentFile(req, stream)
+	if status.Code(err) != codes.Internal || stream.file != nil {
+		t.Errorf("DownloadDocumentFile() with failing audit = %v", err)
+	}
+}
+
+// failingAuditRepository cannot record document file access
+type failingAuditRepository struct {
+	*MockRepository
+}
+
+func (r *failingAuditRepository) RecordDocumentFileAccess(ctx context.Context, access *models.DocumentFileAccess) error {
+	return io.ErrClosedPipe
+}
+
+func TestDocumentFiles_DownloadCorrupt(t *testing.T) {
+	svc, repo, root, doc := testDocumentFiles(t, 1<<20)
+	content := testPDF(100 * 1024)
+	viewer := uuid.New().String()
+	download := func(fileID string) error {
+		return svc.DownloadDocumentFile(&customerpb.DownloadDocumentFileRequest{
+			FileId:      fileID,
+			RequestedBy: viewer,
+			Reason:      "Periodic KYC review",
+		}, &downloadStream{ctx: viewerContext(viewer, "compliance")})
+	}
+
+	// A record that does not match its content
+	file, err := uploadFile(svc, doc.ID, content, 32*1024, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.files[0].SHA256 = strings.Repeat("0", 64)
+	if err := download(file.GetId()); status.Code(err) != codes.DataLoss {
+		t.Errorf("DownloadDocumentFile() with wrong digest = %v, want DataLoss", err)
+	}
+
+	// A blob altered on disk
+	file, err = uploadFile(svc, doc.ID, content, 32*1024, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(root, filepath.FromSlash(repo.files[1].StorageKey))
+	sealed, _ := os.ReadFile(path)
+	sealed[len(sealed)/2] ^= 0xff
+	if err := os.WriteFile(path, sealed, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := download(file.GetId()); status.Code(err) != codes.DataLoss {
+		t.Errorf("DownloadDocumentFile() with altered blob = %v, want DataLoss", err)
+	}
+
+	// A blob gone missing
+	os.Remove(path)
+	if err := download(file.GetId()); status.Code(err) != codes.DataLoss {
+		t.Errorf("DownloadDocumentFile() with missing blob = %v, want DataLoss", err)
+	}
+}
+
+func TestDocumentFiles_NotConfigured(t *testing.T) {
+	svc := NewCustomerService(NewMockRepository())
+	if err := svc.UploadDocumentFile(&uploadStream{ctx: context.Background()}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UploadDocumentFile() error = %v, want FailedPrecondition", err)
+	}
+	err := svc.DownloadDocumentFile(&customerpb.DownloadDocumentFileRequest{}, &downloadStream{ctx: context.Background()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DownloadDocumentFile() error = %v, want FailedPrecondition", err)
+	}
+}
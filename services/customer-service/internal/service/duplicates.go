@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/matching"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// matchKeyBatchSize is how many customers the match key job files at a time
+const matchKeyBatchSize = 500
+
+// defaultDuplicateLimit is how many potential duplicates are returned when
+// the caller does not say
+const defaultDuplicateLimit = 10
+
+// Matcher finds existing customers that are likely duplicates of new ones
+// under a matching model
+type Matcher struct {
+	model *matching.Config
+}
+
+// NewMatcher creates a Matcher with the given model
+func NewMatcher(model *matching.Config) *Matcher {
+	return &Matcher{model: model}
+}
+
+// SetMatcher checks new customers against existing ones for likely
+// duplicates. Duplicate checks are disabled until it is set.
+func (s *CustomerService) SetMatcher(matcher *Matcher) {
+	s.matcher = matcher
+}
+
+// scoredCandidate is an existing customer with how well it matched
+type scoredCandidate struct {
+	customer *models.Customer
+	match    matching.Match
+}
+
+// candidates scores the customers sharing a blocking key with person,
+// other than excludeID, and returns up to limit of those scoring at least
+// the review threshold, highest first
+func (m *Matcher) candidates(ctx context.Context, repo repository.CustomerRepository, person matching.Person, excludeID uuid.UUID, limit int) ([]scoredCandidate, error) {
+	keys := matching.Keys(person)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	found, err := repo.FindDuplicateCandidates(ctx, keys, excludeID, m.model.MaxCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var scored []scoredCandidate
+	for _, c := range found {
+		other := matchPerson(c.Customer, nil)
+		other.Street1, other.PostalCode, other.Country = c.Street1, c.PostalCode, c.Country
+		if match := m.model.Score(person, other); match.Score >= m.model.ReviewThreshold {
+			scored = append(scored, scoredCandidate{customer: c.Customer, match: match})
+		}
+	}
+	slices.SortStableFunc(scored, func(a, b scoredCandidate) int { return b.match.Score - a.match.Score })
+	if len(scored) > limit {
+		scored = scored[:limit]
+	}
+	return scored, nil
+}
+
+// blocks reports whether a candidate scores high enough to stop a customer
+// being created without an override reason
+func (m *Matcher) blocks(c scoredCandidate) bool {
+	return m.model.BlockThreshold > 0 && c.match.Score >= m.model.BlockThreshold
+}
+
+// matchPerson returns what matching compares of a customer and its primary
+// address, if any. A business is compared by its registered name and
+// incorporation date in place of a name and date of birth.
+func matchPerson(c *models.Customer, primary *models.Address) matching.Person {
+	person := matching.Person{
+		FirstName:   c.FirstName,
+		LastName:    c.LastName,
+		DateOfBirth: c.DateOfBirth,
+		Email:       c.Email,
+		Phone:       c.Phone,
+	}
+	if c.IsBusiness() {
+		person.LastName, person.DateOfBirth = c.Business.RegisteredName, c.Business.IncorporationDate
+	}
+	if primary != nil {
+		person.Street1, person.PostalCode, person.Country = primary.Street1, primary.PostalCode, primary.Country
+	}
+	return person
+}
+
+// customerMatchPerson returns what matching compares of a stored customer
+func customerMatchPerson(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer) (matching.Person, error) {
+	addresses, err := repo.GetCustomerAddresses(ctx, customer.ID)
+	if err != nil {
+		return matching.Person{}, err
+	}
+	return matchPerson(customer, primaryAddress(addresses, time.Now().UTC())), nil
+}
+
+// indexCustomer files a customer under the blocking keys of its current
+// details, so later duplicate checks find it. It is called whenever a
+// customer's name, date of birth, contact details or primary address may
+// have changed, whether or not duplicate checks are enabled.
+func indexCustomer(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer) error {
+	person, err := customerMatchPerson(ctx, repo, customer)
+	if err != nil {
+		return err
+	}
+	return repo.ReplaceCustomerMatchKeys(ctx, customer.ID, matching.Keys(person))
+}
+
+// checkDuplicates scores the customer about to be created against existing
+// customers. Without an override reason, a candidate at or above the block
+// threshold refuses the creation; with one, the overrides to record are
+// returned.
+func (m *Matcher) checkDuplicates(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer, overrideReason string) ([]scoredCandidate, []*models.DuplicateOverride, error) {
+	if m == nil {
+		return nil, nil, nil
+	}
+	candidates, err := m.candidates(ctx, repo, matchPerson(customer, nil), customer.ID, defaultDuplicateLimit)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to check for duplicates: %v", err)
+	}
+
+	var overrides []*models.DuplicateOverride
+	for _, c := range candidates {
+		if !m.blocks(c) {
+			continue
+		}
+		if overrideReason == "" {
+			return nil, nil, status.Errorf(codes.FailedPrecondition,
+				"likely duplicate of customer %s (score %d); give duplicate_override_reason to create anyway",
+				c.customer.CustomerNumber, c.match.Score)
+		}
+		overrides = append(overrides, &models.DuplicateOverride{
+			ID:           uuid.New(),
+			CustomerID:   customer.ID,
+			CandidateID:  c.customer.ID,
+			Score:        c.match.Score,
+			RulesVersion: m.model.Version,
+			Reason:       overrideReason,
+			CreatedBy:    customer.CreatedBy,
+		})
+	}
+	return candidates, overrides, nil
+}
+
+// FindPotentialDuplicates scores the existing customers sharing a blocking
+// key with the details given, or with a customer on file, and returns those
+// scoring at least the review threshold
+func (s *CustomerService) FindPotentialDuplicates(ctx context.Context, req *customerpb.FindPotentialDuplicatesRequest) (*customerpb.FindPotentialDuplicatesResponse, error) {
+	if errs := s.validator.ValidateFindPotentialDuplicates(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	if s.matcher == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "duplicate matching is not configured")
+	}
+
+	var person matching.Person
+	excludeID := uuid.Nil
+	if req.GetCustomerId() != "" {
+		customerID, err := parseCustomerID(req.GetCustomerId())
+		if err != nil {
+			return nil, err
+		}
+		customer, err := s.repo.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "customer not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+		}
+		if person, err = customerMatchPerson(ctx, s.repo, customer); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get addresses: %v", err)
+		}
+		excludeID = customer.ID
+	} else {
+		person = matching.Person{
+			FirstName:  req.GetFirstName(),
+			LastName:   req.GetLastName(),
+			Email:      req.GetEmail(),
+			Phone:      req.GetPhone(),
+			Street1:    req.GetStreet1(),
+			PostalCode: req.GetPostalCode(),
+			Country:    req.GetCountry(),
+		}
+		if req.GetDateOfBirth() != nil {
+			person.DateOfBirth = req.GetDateOfBirth().AsTime()
+		}
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultDuplicateLimit
+	}
+	candidates, err := s.matcher.candidates(ctx, s.repo, person, excludeID, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find potential duplicates: %v", err)
+	}
+
+	return &customerpb.FindPotentialDuplicatesResponse{
+		Candidates:   duplicateCandidatesToProto(candidates),
+		RulesVersion: s.matcher.model.Version,
+		WouldBlock:   len(candidates) > 0 && s.matcher.blocks(candidates[0]),
+	}, nil
+}
+
+// MatchKeyJob files customers that have no blocking keys yet, such as those
+// created before duplicate matching, so that duplicate checks can find them
+type MatchKeyJob struct {
+	repo     repository.CustomerRepository
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewMatchKeyJob creates a new MatchKeyJob
+func NewMatchKeyJob(repo repository.CustomerRepository, interval time.Duration, log zerolog.Logger) *MatchKeyJob {
+	return &MatchKeyJob{
+		repo:     repo,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run files unfiled customers straight away and then every interval until
+// the context is cancelled
+func (j *MatchKeyJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		filed, err := j.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			j.log.Error().Err(err).Msg("Match key run failed")
+		}
+		if filed > 0 {
+			j.log.Info().Int("customers", filed).Msg("Filed customers under match keys")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce files every open customer that has no blocking keys and returns
+// how many it filed. It carries on past failures on individual customers,
+// which are returned together.
+func (j *MatchKeyJob) RunOnce(ctx context.Context) (int, error) {
+	filed := 0
+	var failures []error
+	after := uuid.Nil
+	for {
+		ids, err := j.repo.ListCustomersWithoutMatchKeys(ctx, after, matchKeyBatchSize)
+		if err != nil {
+			return filed, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				return filed, err
+			}
+			customer, err := j.repo.GetCustomerByID(ctx, id)
+			if err == nil {
+				err = indexCustomer(ctx, j.repo, customer)
+			}
+			if err != nil {
+				failures = append(failures, fmt.Errorf("customer %s: %w", id, err))
+				continue
+			}
+			filed++
+		}
+		after = ids[len(ids)-1]
+	}
+
+	if len(failures) > 0 {
+		return filed, fmt.Errorf("filing failed for %d customers: %w", len(failures), errors.Join(failures...))
+	}
+	return filed, nil
+}
+
+func duplicateCandidatesToProto(candidates []scoredCandidate) []*customerpb.DuplicateCandidate {
+	protos := make([]*customerpb.DuplicateCandidate, len(candidates))
+	for i, c := range candidates {
+		proto := &customerpb.DuplicateCandidate{
+			Customer: modelToProto(c.customer),
+			Score:    int32(c.match.Score),
+		}
+		for _, f := range c.match.Fields {
+			proto.Fields = append(proto.Fields, &customerpb.FieldSimilarity{
+				Field:      f.Field,
+				Similarity: f.Similarity,
+			})
+		}
+		protos[i] = proto
+	}
+	return protos
+}
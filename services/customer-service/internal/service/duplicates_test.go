@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/matching"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func duplicateRequest(first, last string, born time.Time) *customerpb.CreateCustomerRequest {
+	return &customerpb.CreateCustomerRequest{
+		FirstName:   first,
+		LastName:    last,
+		Email:       "jane.smith@example.com",
+		Phone:       "+447700900123",
+		DateOfBirth: timestamppb.New(born),
+		CreatedBy:   uuid.New().String(),
+	}
+}
+
+func TestCustomerService_CreateCustomer_Duplicates(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetMatcher(NewMatcher(matching.DefaultConfig()))
+	born := time.Date(1985, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	first, err := svc.CreateCustomer(ctx, duplicateRequest("Jane", "Smith", born))
+	if err != nil {
+		t.Fatalf("CreateCustomer() error: %v", err)
+	}
+	if len(first.GetPotentialDuplicates()) != 0 {
+		t.Errorf("first customer has potential duplicates %v", first.GetPotentialDuplicates())
+	}
+
+	// The same person spelled differently is refused without a reason
+	req := duplicateRequest("Jane", "Smyth", born)
+	if _, err := svc.CreateCustomer(ctx, req); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("CreateCustomer() duplicate error = %v, want FailedPrecondition", err)
+	}
+	if len(repo.customers) != 1 {
+		t.Fatalf("duplicate was created: %d customers", len(repo.customers))
+	}
+
+	req.DuplicateOverrideReason = "Twin sister, confirmed in branch"
+	second, err := svc.CreateCustomer(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateCustomer() with override error: %v", err)
+	}
+	duplicates := second.GetPotentialDuplicates()
+	if len(duplicates) != 1 || duplicates[0].GetCustomer().GetId() != first.GetCustomer().GetId() || duplicates[0].GetScore() < 85 {
+		t.Fatalf("potential duplicates = %v", duplicates)
+	}
+	if len(repo.overrides) != 1 || repo.overrides[0].CandidateID.String() != first.GetCustomer().GetId() ||
+		repo.overrides[0].Reason != req.DuplicateOverrideReason || repo.overrides[0].RulesVersion != "default-1" {
+		t.Errorf("overrides = %+v", repo.overrides)
+	}
+
+	// A weaker match is reported but does not block
+	third, err := svc.CreateCustomer(ctx, &customerpb.CreateCustomerRequest{
+		FirstName:   "Jane",
+		LastName:    "Smith",
+		Email:       "j.smith@example.org",
+		DateOfBirth: timestamppb.New(born),
+		CreatedBy:   uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomer() weaker match error: %v", err)
+	}
+	if len(third.GetPotentialDuplicates()) != 2 {
+		t.Errorf("potential duplicates = %v, want both earlier customers", third.GetPotentialDuplicates())
+	}
+	if len(repo.overrides) != 1 {
+		t.Errorf("override recorded for a match below the block threshold")
+	}
+}
+
+func TestCustomerService_FindPotentialDuplicates(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetMatcher(NewMatcher(matching.DefaultConfig()))
+	born := time.Date(1985, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	jane, err := svc.CreateCustomer(ctx, duplicateRequest("Jane", "Smith", born))
+	if err != nil {
+		t.Fatalf("CreateCustomer() error: %v", err)
+	}
+	janeID := uuid.MustParse(jane.GetCustomer().GetId())
+	if _, err := svc.AddAddress(ctx, &customerpb.AddAddressRequest{
+		CustomerId:  janeID.String(),
+		AddressType: string(models.AddressTypePhysical),
+		Street1:     "1 High Street",
+		City:        "London",
+		State:       "London",
+		PostalCode:  "SW1A 1AA",
+		Country:     "GB",
+		IsPrimary:   true,
+	}); err != nil {
+		t.Fatalf("AddAddress() error: %v", err)
+	}
+	if !slices.Contains(repo.matchKeys[janeID], "pc:SW1A1AA:SM0") {
+		t.Errorf("match keys after AddAddress = %v", repo.matchKeys[janeID])
+	}
+	if _, err := svc.CreateCustomer(ctx, duplicateRequest("Ahmed", "Khan", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC))); err != nil {
+		t.Fatalf("CreateCustomer() error: %v", err)
+	}
+
+	// Found by a married name, with the same phone and address written
+	// differently
+	resp, err := svc.FindPotentialDuplicates(ctx, &customerpb.FindPotentialDuplicatesRequest{
+		FirstName:   "Jane",
+		LastName:    "Jones",
+		DateOfBirth: timestamppb.New(born),
+		Phone:       "07700 900123",
+		PostalCode:  "sw1a 1aa",
+		Street1:     "1 High St.",
+	})
+	if err != nil {
+		t.Fatalf("FindPotentialDuplicates() error: %v", err)
+	}
+	if len(resp.GetCandidates()) != 1 || resp.GetCandidates()[0].GetCustomer().GetId() != janeID.String() || resp.GetWouldBlock() || resp.GetRulesVersion() != "default-1" {
+		t.Fatalf("FindPotentialDuplicates() = %v", resp)
+	}
+	fields := make(map[string]float64)
+	for _, f := range resp.GetCandidates()[0].GetFields() {
+		fields[f.GetField()] = f.GetSimilarity()
+	}
+	if fields[matching.FieldDateOfBirth] != 1 || fields[matching.FieldPhone] != 1 || fields[matching.FieldAddress] != 0.6 || fields[matching.FieldEmail] != 0 {
+		t.Errorf("field similarities = %v", fields)
+	}
+
+	// A customer on file is not reported as its own duplicate
+	resp, err = svc.FindPotentialDuplicates(ctx, &customerpb.FindPotentialDuplicatesRequest{CustomerId: janeID.String()})
+	if err != nil || len(resp.GetCandidates()) != 0 {
+		t.Errorf("FindPotentialDuplicates() by customer = %v, %v", resp, err)
+	}
+
+	if _, err := svc.FindPotentialDuplicates(ctx, &customerpb.FindPotentialDuplicatesRequest{CustomerId: uuid.New().String()}); status.Code(err) != codes.NotFound {
+		t.Errorf("FindPotentialDuplicates() unknown customer error = %v, want NotFound", err)
+	}
+	unconfigured := NewCustomerService(repo)
+	if _, err := unconfigured.FindPotentialDuplicates(ctx, &customerpb.FindPotentialDuplicatesRequest{CustomerId: janeID.String()}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("FindPotentialDuplicates() without matcher error = %v, want FailedPrecondition", err)
+	}
+
+	// Closed customers are never candidates
+	repo.customers[janeID].Status = models.CustomerStatusClosed
+	resp, err = svc.FindPotentialDuplicates(ctx, &customerpb.FindPotentialDuplicatesRequest{FirstName: "Jane", LastName: "Smith", DateOfBirth: timestamppb.New(born)})
+	if err != nil || len(resp.GetCandidates()) != 0 {
+		t.Errorf("FindPotentialDuplicates() with the match closed = %v, %v", resp, err)
+	}
+}
+
+func TestMatchKeyJob(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	for _, name := range []string{"Smith", "Jones", "Khan"} {
+		customer := &models.Customer{
+			ID:          uuid.New(),
+			FirstName:   "Jane",
+			LastName:    name,
+			DateOfBirth: time.Date(1985, 3, 4, 0, 0, 0, 0, time.UTC),
+			Status:      models.CustomerStatusActive,
+		}
+		repo.customers[customer.ID] = customer
+	}
+	job := NewMatchKeyJob(repo, time.Hour, zerolog.Nop())
+
+	filed, err := job.RunOnce(ctx)
+	if err != nil || filed != 3 || len(repo.matchKeys) != 3 {
+		t.Fatalf("RunOnce() = %d, %v; %d customers filed", filed, err, len(repo.matchKeys))
+	}
+	if filed, err := job.RunOnce(ctx); err != nil || filed != 0 {
+		t.Errorf("RunOnce() again = %d, %v", filed, err)
+	}
+}
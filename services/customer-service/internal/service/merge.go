@@ -0,0 +1,561 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/merge"
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/phone"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxMergeRedirects bounds how far a lookup follows a chain of merges
+const maxMergeRedirects = 8
+
+// maxCustomerEvents is the most customer events returned at once
+const maxCustomerEvents = 1000
+
+// Merger merges duplicate customers under a set of survivorship rules
+type Merger struct {
+	rules  *merge.Config
+	phones *phone.Config
+}
+
+// NewMerger creates a Merger with the given rules. The phone numbers it
+// moves are typed again under phones; a nil phones leaves them untyped.
+func NewMerger(rules *merge.Config, phones *phone.Config) *Merger {
+	if phones == nil {
+		phones = phone.DefaultConfig()
+	}
+	return &Merger{rules: rules, phones: phones}
+}
+
+// SetMerger merges duplicate customers under the merger's survivorship
+// rules. Merges are refused until it is set.
+func (s *CustomerService) SetMerger(merger *Merger) {
+	s.merger = merger
+}
+
+// mergePlan is what merging one customer into another would change
+type mergePlan struct {
+	record   *models.CustomerMerge
+	survivor *models.Customer // With the merged customer's values applied
+	merged   *models.Customer
+}
+
+// plan works out which customer survives and what moves to it, without
+// changing anything. survivorID picks the survivor when set.
+func (m *Merger) plan(ctx context.Context, repo repository.CustomerRepository, first, second *models.Customer, survivorID string, by uuid.UUID, reason string, now time.Time) (*mergePlan, error) {
+	survivor, merged := first, second
+	if survivorID == second.ID.String() || (survivorID == "" && !m.rules.Survives(mergeRecord(first), mergeRecord(second))) {
+		survivor, merged = second, first
+	}
+
+	record := &models.CustomerMerge{
+		ID:           uuid.New(),
+		SurvivorID:   survivor.ID,
+		MergedID:     merged.ID,
+		RulesVersion: m.rules.Version,
+		Reason:       reason,
+		MergedStatus: merged.Status,
+		MergedBy:     by,
+		MergedAt:     now,
+		UndoUntil:    now.AddDate(0, 0, m.rules.UndoDays),
+	}
+
+	// The survivor is changed on a copy so a dry run leaves it alone
+	updated := *survivor
+	for _, choice := range m.rules.Resolve(mergeRecord(survivor), mergeRecord(merged)) {
+		m.setField(&updated, choice.Field, choice.After)
+		record.FieldChanges = append(record.FieldChanges, models.MergeFieldChange{
+			Field:  string(choice.Field),
+			Before: choice.Before,
+			After:  choice.After,
+		})
+	}
+
+	// The survivor's primary address stays primary
+	survivorAddresses, err := repo.GetCustomerAddresses(ctx, survivor.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addresses: %w", err)
+	}
+	hasPrimary := primaryAddress(survivorAddresses, now) != nil
+	addresses, err := repo.GetCustomerAddresses(ctx, merged.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addresses: %w", err)
+	}
+	mergedPrimary := primaryAddress(addresses, now)
+	for _, a := range addresses {
+		record.MovedAddresses = append(record.MovedAddresses, a.ID)
+		if a == mergedPrimary && hasPrimary {
+			record.DemotedAddresses = append(record.DemotedAddresses, a.ID)
+		}
+	}
+
+	documents, err := repo.GetCustomerDocuments(ctx, merged.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+	for _, d := range documents {
+		record.MovedDocuments = append(record.MovedDocuments, d.ID)
+	}
+
+	return &mergePlan{record: record, survivor: &updated, merged: merged}, nil
+}
+
+// apply carries out a merge plan: the survivor takes the merged customer's
+// chosen details, addresses and documents, the merged customer is closed,
+// and other services are told through the customer event feed so they can
+// move what the merged customer holds with them, such as accounts
+func (m *Merger) apply(ctx context.Context, repo repository.CustomerRepository, plan *mergePlan) error {
+	record := plan.record
+	if err := repo.UpdateCustomer(ctx, plan.survivor); err != nil {
+		return err
+	}
+
+	addresses, err := repo.GetCustomerAddresses(ctx, record.MergedID)
+	if err != nil {
+		return err
+	}
+	for _, a := range addresses {
+		if slices.Contains(record.DemotedAddresses, a.ID) {
+			a.IsPrimary = false
+			if err := repo.UpdateAddress(ctx, a); err != nil {
+				return err
+			}
+		}
+	}
+	if err := repo.ReassignAddresses(ctx, record.MovedAddresses, record.MergedID, record.SurvivorID); err != nil {
+		return err
+	}
+	if err := repo.ReassignDocuments(ctx, record.MovedDocuments, record.MergedID, record.SurvivorID); err != nil {
+		return err
+	}
+
+	reason := fmt.Sprintf("Merged into %s", plan.survivor.CustomerNumber)
+	if _, err := changeStatus(ctx, repo, plan.merged, models.CustomerStatusClosed, reason, &record.MergedBy, record.MergedAt); err != nil {
+		return err
+	}
+	if err := repo.RecordCustomerClosure(ctx, record.MergedID, record.MergedAt); err != nil {
+		return err
+	}
+
+	if err := repo.CreateCustomerMerge(ctx, record); err != nil {
+		return err
+	}
+	if err := indexCustomer(ctx, repo, plan.survivor); err != nil {
+		return err
+	}
+	return repo.CreateCustomerEvent(ctx, &models.CustomerEvent{
+		ID:                uuid.New(),
+		Type:              models.CustomerEventMerged,
+		CustomerID:        record.MergedID,
+		RelatedCustomerID: &record.SurvivorID,
+		ReferenceID:       &record.ID,
+		OccurredAt:        record.MergedAt,
+	})
+}
+
+// reverse undoes a merge as far as it still can. Details of the survivor
+// changed since the merge keep their new value; the names of those fields
+// are returned.
+func (m *Merger) reverse(ctx context.Context, repo repository.CustomerRepository, record *models.CustomerMerge, survivor, merged *models.Customer, reason string) ([]string, error) {
+	var kept []string
+	for _, change := range record.FieldChanges {
+		field := merge.Field(change.Field)
+		if mergeRecord(survivor).Values[field] != change.After {
+			kept = append(kept, change.Field)
+			continue
+		}
+		m.setField(survivor, field, change.Before)
+	}
+	survivor.UpdatedBy = record.UnmergedBy
+	if err := repo.UpdateCustomer(ctx, survivor); err != nil {
+		return nil, err
+	}
+
+	if err := repo.ReassignAddresses(ctx, record.MovedAddresses, record.SurvivorID, record.MergedID); err != nil {
+		return nil, err
+	}
+	addresses, err := repo.GetCustomerAddresses(ctx, record.MergedID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range addresses {
+		if slices.Contains(record.DemotedAddresses, a.ID) && !a.IsPrimary {
+			a.IsPrimary = true
+			if err := repo.UpdateAddress(ctx, a); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := repo.ReassignDocuments(ctx, record.MovedDocuments, record.SurvivorID, record.MergedID); err != nil {
+		return nil, err
+	}
+
+	if _, err := changeStatus(ctx, repo, merged, record.MergedStatus, fmt.Sprintf("Merge reversed: %s", reason), record.UnmergedBy, *record.UnmergedAt); err != nil {
+		return nil, err
+	}
+	if err := repo.ClearCustomerClosure(ctx, record.MergedID); err != nil {
+		return nil, err
+	}
+
+	if err := repo.UpdateCustomerMerge(ctx, record); err != nil {
+		return nil, err
+	}
+	for _, c := range []*models.Customer{survivor, merged} {
+		if err := indexCustomer(ctx, repo, c); err != nil {
+			return nil, err
+		}
+	}
+	return kept, repo.CreateCustomerEvent(ctx, &models.CustomerEvent{
+		ID:                uuid.New(),
+		Type:              models.CustomerEventUnmerged,
+		CustomerID:        record.MergedID,
+		RelatedCustomerID: &record.SurvivorID,
+		ReferenceID:       &record.ID,
+		OccurredAt:        *record.UnmergedAt,
+	})
+}
+
+// MergeCustomers merges a duplicate customer into the other. The
+// survivorship rules decide which of the two survives and which of the
+// merged customer's details it takes, unless the request names the
+// survivor. The merged customer is closed and lookups of it lead to the
+// survivor until the merge is reversed.
+func (s *CustomerService) MergeCustomers(ctx context.Context, req *customerpb.MergeCustomersRequest) (*customerpb.MergeCustomersResponse, error) {
+	if errs := s.validator.ValidateMergeCustomers(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	if s.merger == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "customer merge is not configured")
+	}
+
+	var customers [2]*models.Customer
+	for i, id := range []string{req.GetCustomerId(), req.GetDuplicateId()} {
+		customer, err := s.repo.GetCustomerByID(ctx, uuid.MustParse(id))
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "customer %s not found", id)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+		}
+		if customer.Status == models.CustomerStatusClosed {
+			return nil, status.Errorf(codes.FailedPrecondition, "customer %s is closed", id)
+		}
+		// The survivorship rules cover the details of individuals only
+		if customer.IsBusiness() {
+			return nil, status.Errorf(codes.FailedPrecondition, "customer %s is a business; only individuals can be merged", id)
+		}
+		customers[i] = customer
+	}
+
+	plan, err := s.merger.plan(ctx, s.repo, customers[0], customers[1], req.GetSurvivorId(), uuid.MustParse(req.GetMergedBy()), req.GetReason(), time.Now().UTC())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to plan merge: %v", err)
+	}
+	if req.GetDryRun() {
+		return &customerpb.MergeCustomersResponse{
+			Merge:    customerMergeModelToProto(plan.record),
+			Survivor: modelToProto(plan.survivor),
+		}, nil
+	}
+
+	// The survivor is screened again if it takes on new identifying details,
+	// and its risk rated again as its addresses and documents change
+	plan.survivor.UpdatedBy = &plan.record.MergedBy
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		if err := s.merger.apply(ctx, repo, plan); err != nil {
+			return err
+		}
+		return s.rescreen(ctx, repo, plan.survivor, screenedDetails(customers[0]) != screenedDetails(plan.survivor) || screenedDetails(customers[1]) != screenedDetails(plan.survivor))
+	})
+	if err != nil {
+		var lockErr *repository.ErrOptimisticLock
+		if errors.As(err, &lockErr) {
+			return nil, status.Errorf(codes.Aborted, "customer was modified by another process")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to merge customers: %v", err)
+	}
+
+	return &customerpb.MergeCustomersResponse{
+		Merge:    customerMergeModelToProto(plan.record),
+		Survivor: modelToProto(plan.survivor),
+	}, nil
+}
+
+// UnmergeCustomers reverses a merge within its undo window. The merged
+// customer is reopened in the status it had and takes back its addresses
+// and documents; the survivor's details go back to what they were, except
+// any changed since the merge.
+func (s *CustomerService) UnmergeCustomers(ctx context.Context, req *customerpb.UnmergeCustomersRequest) (*customerpb.UnmergeCustomersResponse, error) {
+	if errs := s.validator.ValidateUnmergeCustomers(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	if s.merger == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "customer merge is not configured")
+	}
+
+	record, err := s.repo.GetCustomerMerge(ctx, uuid.MustParse(req.GetMergeId()))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "merge not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get merge: %v", err)
+	}
+	if err := record.Reverse(uuid.MustParse(req.GetUnmergedBy()), req.GetReason(), time.Now().UTC()); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	survivor, err := s.repo.GetCustomerByID(ctx, record.SurvivorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get survivor: %v", err)
+	}
+	merged, err := s.repo.GetCustomerByID(ctx, record.MergedID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get merged customer: %v", err)
+	}
+	if err := checkNotPseudonymized(ctx, s.repo, merged); err != nil {
+		return nil, err
+	}
+
+	// Merges are reversed newest first
+	if later, err := s.repo.GetStandingMerge(ctx, survivor.ID); err == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "survivor has since been merged into %s; reverse merge %s first", later.SurvivorID, later.ID)
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get merge: %v", err)
+	}
+
+	screenedBefore := screenedDetails(survivor)
+	var kept []string
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		var err error
+		if kept, err = s.merger.reverse(ctx, repo, record, survivor, merged, req.GetReason()); err != nil {
+			return err
+		}
+		if err := s.rescreen(ctx, repo, survivor, screenedDetails(survivor) != screenedBefore); err != nil {
+			return err
+		}
+		return s.rescreen(ctx, repo, merged, true)
+	})
+	if err != nil {
+		var lockErr *repository.ErrOptimisticLock
+		if errors.As(err, &lockErr) {
+			return nil, status.Errorf(codes.Aborted, "customer was modified by another process")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reverse merge: %v", err)
+	}
+
+	return &customerpb.UnmergeCustomersResponse{
+		Merge:      customerMergeModelToProto(record),
+		Survivor:   modelToProto(survivor),
+		Restored:   modelToProto(merged),
+		KeptFields: kept,
+	}, nil
+}
+
+// ListCustomerMerges lists the merges a customer took part in, most recent
+// first
+func (s *CustomerService) ListCustomerMerges(ctx context.Context, req *customerpb.ListCustomerMergesRequest) (*customerpb.ListCustomerMergesResponse, error) {
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	merges, err := s.repo.ListCustomerMerges(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list merges: %v", err)
+	}
+
+	protoMerges := make([]*customerpb.CustomerMerge, len(merges))
+	for i, m := range merges {
+		protoMerges[i] = customerMergeModelToProto(m)
+	}
+
+	return &customerpb.ListCustomerMergesResponse{
+		Merges: protoMerges,
+	}, nil
+}
+
+// ListCustomerEvents reads the customer event feed after the last event the
+// caller has seen
+func (s *CustomerService) ListCustomerEvents(ctx context.Context, req *customerpb.ListCustomerEventsRequest) (*customerpb.ListCustomerEventsResponse, error) {
+	if req.GetAfterSequence() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "after_sequence must not be negative")
+	}
+	limit := int(req.GetLimit())
+	if limit < 0 || limit > maxCustomerEvents {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxCustomerEvents)
+	}
+	if limit == 0 {
+		limit = 100
+	}
+
+	events, err := s.repo.ListCustomerEvents(ctx, req.GetAfterSequence(), limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list customer events: %v", err)
+	}
+
+	protoEvents := make([]*customerpb.CustomerEvent, len(events))
+	for i, e := range events {
+		protoEvents[i] = customerEventModelToProto(e)
+	}
+
+	return &customerpb.ListCustomerEventsResponse{
+		Events: protoEvents,
+	}, nil
+}
+
+// rescreen screens a customer again if their identifying details changed,
+// and rates their risk again
+func (s *CustomerService) rescreen(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer, detailsChanged bool) error {
+	if detailsChanged {
+		if _, err := screenCustomer(ctx, repo, s.screener, customer, models.ScreeningTriggerUpdate); err != nil {
+			return err
+		}
+	}
+	_, err := s.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerUpdate, nil)
+	return err
+}
+
+// followMerges returns the customer a merged customer was merged into,
+// following any later merge of the survivor in turn. Other customers are
+// returned as they are.
+func followMerges(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer) (*models.Customer, error) {
+	for i := 0; i < maxMergeRedirects && customer.Status == models.CustomerStatusClosed; i++ {
+		record, err := repo.GetStandingMerge(ctx, customer.ID)
+		if errors.Is(err, repository.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if customer, err = repo.GetCustomerByID(ctx, record.SurvivorID); err != nil {
+			return nil, err
+		}
+	}
+	return customer, nil
+}
+
+// mergeRecord describes a customer to the survivorship rules
+func mergeRecord(c *models.Customer) merge.Record {
+	values := map[merge.Field]string{
+		merge.FieldFirstName: c.FirstName,
+		merge.FieldLastName:  c.LastName,
+		merge.FieldTaxID:     c.TaxID,
+		merge.FieldEmail:     c.Email,
+		merge.FieldPhone:     c.Phone,
+	}
+	if c.MiddleName != nil {
+		values[merge.FieldMiddleName] = *c.MiddleName
+	}
+	if !c.DateOfBirth.IsZero() {
+		values[merge.FieldDateOfBirth] = c.DateOfBirth.Format(time.DateOnly)
+	}
+	return merge.Record{
+		Status:    string(c.Status),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		Values:    values,
+	}
+}
+
+// setField sets one of a customer's details to a value produced by
+// mergeRecord. Only the E.164 form of a phone number is recorded, so it
+// stands for the number as given too, and its type is worked out again.
+func (m *Merger) setField(c *models.Customer, field merge.Field, value string) {
+	switch field {
+	case merge.FieldFirstName:
+		c.FirstName = value
+	case merge.FieldMiddleName:
+		c.MiddleName = stringPtr(value)
+	case merge.FieldLastName:
+		c.LastName = value
+	case merge.FieldDateOfBirth:
+		c.DateOfBirth = time.Time{}
+		if value != "" {
+			c.DateOfBirth, _ = time.Parse(time.DateOnly, value)
+		}
+	case merge.FieldTaxID:
+		c.TaxID = value
+	case merge.FieldEmail:
+		c.Email = value
+	case merge.FieldPhone:
+		c.Phone, c.PhoneInput, c.PhoneType = value, value, ""
+		if value != "" {
+			c.PhoneType = phone.TypeUnknown
+			if number, err := m.phones.Parse(value, ""); err == nil {
+				c.PhoneType = number.Type
+			}
+		}
+	}
+}
+
+func customerMergeModelToProto(m *models.CustomerMerge) *customerpb.CustomerMerge {
+	out := &customerpb.CustomerMerge{
+		Id:           m.ID.String(),
+		SurvivorId:   m.SurvivorID.String(),
+		MergedId:     m.MergedID.String(),
+		RulesVersion: m.RulesVersion,
+		Reason:       m.Reason,
+		MergedStatus: string(m.MergedStatus),
+		MergedBy:     m.MergedBy.String(),
+		MergedAt:     timestamppb.New(m.MergedAt),
+		UndoUntil:    timestamppb.New(m.UndoUntil),
+	}
+
+	for _, c := range m.FieldChanges {
+		change := &customerpb.MergeFieldChange{Field: c.Field}
+		if c.Field != string(merge.FieldTaxID) {
+			change.Before = c.Before
+			change.After = c.After
+		}
+		out.FieldChanges = append(out.FieldChanges, change)
+	}
+	for _, id := range m.MovedAddresses {
+		out.MovedAddressIds = append(out.MovedAddressIds, id.String())
+	}
+	for _, id := range m.MovedDocuments {
+		out.MovedDocumentIds = append(out.MovedDocumentIds, id.String())
+	}
+
+	if m.UnmergedBy != nil {
+		out.UnmergedBy = m.UnmergedBy.String()
+	}
+	if m.UnmergedAt != nil {
+		out.UnmergedAt = timestamppb.New(*m.UnmergedAt)
+	}
+	if m.UnmergeReason != nil {
+		out.UnmergeReason = *m.UnmergeReason
+	}
+
+	return out
+}
+
+func customerEventModelToProto(e *models.CustomerEvent) *customerpb.CustomerEvent {
+	event := &customerpb.CustomerEvent{
+		Sequence:   e.Sequence,
+		Id:         e.ID.String(),
+		Type:       string(e.Type),
+		CustomerId: e.CustomerID.String(),
+		OccurredAt: timestamppb.New(e.OccurredAt),
+	}
+
+	if e.RelatedCustomerID != nil {
+		event.RelatedCustomerId = e.RelatedCustomerID.String()
+	}
+	if e.ReferenceID != nil {
+		event.ReferenceId = e.ReferenceID.String()
+	}
+
+	return event
+}
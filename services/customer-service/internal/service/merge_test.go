@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/merge"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createDuplicates creates an older customer and a newer duplicate of them,
+// each with a primary address; the duplicate also has a middle name, a newer
+// email and a document
+func createDuplicates(t *testing.T, svc *CustomerService, repo *MockRepository) (original, duplicate *models.Customer) {
+	t.Helper()
+	now := time.Now().UTC()
+	original = repo.customers[uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())]
+	duplicate = repo.customers[uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())]
+	original.CreatedAt, original.UpdatedAt = now.AddDate(-2, 0, 0), now.AddDate(-1, 0, 0)
+	duplicate.CreatedAt, duplicate.UpdatedAt = now.AddDate(0, -1, 0), now.AddDate(0, -1, 0)
+	duplicate.MiddleName = stringPtr("Anne")
+	duplicate.Email = "jane.doe@example.com"
+
+	for _, c := range []*models.Customer{original, duplicate} {
+		repo.addresses[c.ID] = append(repo.addresses[c.ID], &models.Address{
+			ID:          uuid.New(),
+			CustomerID:  c.ID,
+			AddressType: models.AddressTypePhysical,
+			Street1:     "1 High Street",
+			City:        "London",
+			PostalCode:  "SW1A 1AA",
+			Country:     "GB",
+			IsPrimary:   true,
+		})
+	}
+	addTestDocument(repo, duplicate.ID, models.DocumentTypePassport, models.VerificationStatusVerified, now.AddDate(3, 0, 0))
+	return original, duplicate
+}
+
+func mergeRequest(customerID, duplicateID uuid.UUID, dryRun bool) *customerpb.MergeCustomersRequest {
+	return &customerpb.MergeCustomersRequest{
+		CustomerId:  customerID.String(),
+		DuplicateId: duplicateID.String(),
+		MergedBy:    uuid.New().String(),
+		Reason:      "Duplicate created at branch",
+		DryRun:      dryRun,
+	}
+}
+
+func TestMergeCustomers(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetMerger(NewMerger(merge.DefaultConfig(), nil))
+	ctx := context.Background()
+	original, duplicate := createDuplicates(t, svc, repo)
+
+	// The duplicate is named first, but the older record survives. A dry
+	// run changes nothing.
+	resp, err := svc.MergeCustomers(ctx, mergeRequest(duplicate.ID, original.ID, true))
+	if err != nil {
+		t.Fatalf("MergeCustomers() dry run error: %v", err)
+	}
+	if resp.GetMerge().GetSurvivorId() != original.ID.String() || resp.GetSurvivor().GetMiddleName() != "Anne" {
+		t.Errorf("MergeCustomers() dry run = %v", resp)
+	}
+	if repo.customers[original.ID].MiddleName != nil || len(repo.merges) != 0 || len(repo.events) != 0 {
+		t.Error("dry run changed the customers")
+	}
+
+	resp, err = svc.MergeCustomers(ctx, mergeRequest(duplicate.ID, original.ID, false))
+	if err != nil {
+		t.Fatalf("MergeCustomers() error: %v", err)
+	}
+	record := resp.GetMerge()
+	if record.GetMergedId() != duplicate.ID.String() || record.GetRulesVersion() != "default-1" ||
+		len(record.GetMovedAddressIds()) != 1 || len(record.GetMovedDocumentIds()) != 1 {
+		t.Errorf("MergeCustomers() merge = %v", record)
+	}
+
+	survivor := repo.customers[original.ID]
+	if survivor.MiddleName == nil || *survivor.MiddleName != "Anne" || survivor.Email != "jane.doe@example.com" || survivor.FirstName != "Jane" {
+		t.Errorf("survivor details = %+v", survivor)
+	}
+	if len(repo.addresses[original.ID]) != 2 || len(repo.addresses[duplicate.ID]) != 0 {
+		t.Fatalf("addresses not moved: %d kept", len(repo.addresses[duplicate.ID]))
+	}
+	if moved := repo.addresses[original.ID][1]; moved.IsPrimary || moved.CustomerID != original.ID {
+		t.Errorf("moved address = %+v, want demoted", moved)
+	}
+	if len(repo.documents[original.ID]) != 1 || len(repo.documents[duplicate.ID]) != 0 {
+		t.Error("documents not moved")
+	}
+
+	if repo.customers[duplicate.ID].Status != models.CustomerStatusClosed || repo.retention[duplicate.ID] == nil {
+		t.Errorf("merged customer status = %s, want Closed", repo.customers[duplicate.ID].Status)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != models.CustomerEventMerged ||
+		repo.events[0].CustomerID != duplicate.ID || *repo.events[0].RelatedCustomerID != original.ID {
+		t.Errorf("events = %+v", repo.events)
+	}
+
+	// Lookups of the merged customer lead to the survivor
+	got, err := svc.GetCustomer(ctx, &customerpb.GetCustomerRequest{Id: duplicate.ID.String()})
+	if err != nil || got.GetCustomer().GetId() != original.ID.String() || got.GetMergedFrom() != duplicate.ID.String() {
+		t.Errorf("GetCustomer() merged = %v, %v", got, err)
+	}
+	got, err = svc.GetCustomer(ctx, &customerpb.GetCustomerRequest{CustomerNumber: duplicate.CustomerNumber})
+	if err != nil || got.GetCustomer().GetId() != original.ID.String() {
+		t.Errorf("GetCustomer() by number = %v, %v", got, err)
+	}
+	profile, err := svc.GetCustomerFullProfile(ctx, &customerpb.GetCustomerRequest{Id: duplicate.ID.String()})
+	if err != nil || profile.GetMergedFrom() != duplicate.ID.String() || len(profile.GetAddresses()) != 2 {
+		t.Errorf("GetCustomerFullProfile() merged = %v, %v", profile, err)
+	}
+	got, err = svc.GetCustomer(ctx, &customerpb.GetCustomerRequest{Id: original.ID.String()})
+	if err != nil || got.GetMergedFrom() != "" {
+		t.Errorf("GetCustomer() survivor = %v, %v", got, err)
+	}
+
+	merges, err := svc.ListCustomerMerges(ctx, &customerpb.ListCustomerMergesRequest{CustomerId: duplicate.ID.String()})
+	if err != nil || len(merges.GetMerges()) != 1 {
+		t.Errorf("ListCustomerMerges() = %v, %v", merges, err)
+	}
+
+	// The merged customer is closed and cannot be merged again
+	_, err = svc.MergeCustomers(ctx, mergeRequest(original.ID, duplicate.ID, false))
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("MergeCustomers() closed: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestMergeCustomers_ChosenSurvivor(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetMerger(NewMerger(merge.DefaultConfig(), nil))
+	original, duplicate := createDuplicates(t, svc, repo)
+
+	req := mergeRequest(original.ID, duplicate.ID, false)
+	req.SurvivorId = duplicate.ID.String()
+	resp, err := svc.MergeCustomers(context.Background(), req)
+	if err != nil {
+		t.Fatalf("MergeCustomers() error: %v", err)
+	}
+	if resp.GetMerge().GetSurvivorId() != duplicate.ID.String() || repo.customers[original.ID].Status != models.CustomerStatusClosed {
+		t.Errorf("MergeCustomers() = %v", resp)
+	}
+	// The survivor's own primary address stays primary
+	for _, a := range repo.addresses[duplicate.ID] {
+		if a.IsPrimary != (a.ID == repo.addresses[duplicate.ID][0].ID) {
+			t.Errorf("address %s primary = %v", a.ID, a.IsPrimary)
+		}
+	}
+}
+
+func TestUnmergeCustomers(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetMerger(NewMerger(merge.DefaultConfig(), nil))
+	ctx := context.Background()
+	original, duplicate := createDuplicates(t, svc, repo)
+	duplicateStatus := duplicate.Status
+
+	resp, err := svc.MergeCustomers(ctx, mergeRequest(original.ID, duplicate.ID, false))
+	if err != nil {
+		t.Fatalf("MergeCustomers() error: %v", err)
+	}
+	mergeID := resp.GetMerge().GetId()
+
+	// A detail changed since the merge keeps its new value
+	repo.customers[original.ID].Email = "jane@example.org"
+
+	unmerge := &customerpb.UnmergeCustomersRequest{MergeId: mergeID, UnmergedBy: uuid.New().String(), Reason: "Different people"}
+	undone, err := svc.UnmergeCustomers(ctx, unmerge)
+	if err != nil {
+		t.Fatalf("UnmergeCustomers() error: %v", err)
+	}
+	if len(undone.GetKeptFields()) != 1 || undone.GetKeptFields()[0] != string(merge.FieldEmail) || undone.GetMerge().GetUnmergedAt() == nil {
+		t.Errorf("UnmergeCustomers() = %v", undone)
+	}
+
+	survivor := repo.customers[original.ID]
+	if survivor.MiddleName != nil || survivor.Email != "jane@example.org" {
+		t.Errorf("survivor details = %+v", survivor)
+	}
+	restored := repo.customers[duplicate.ID]
+	if restored.Status != duplicateStatus || repo.retention[duplicate.ID] != nil {
+		t.Errorf("restored status = %s, want %s", restored.Status, duplicateStatus)
+	}
+	if len(repo.addresses[duplicate.ID]) != 1 || !repo.addresses[duplicate.ID][0].IsPrimary || len(repo.addresses[original.ID]) != 1 {
+		t.Error("addresses not moved back")
+	}
+	if len(repo.documents[duplicate.ID]) != 1 || len(repo.documents[original.ID]) != 0 {
+		t.Error("documents not moved back")
+	}
+	if len(repo.events) != 2 || repo.events[1].Type != models.CustomerEventUnmerged {
+		t.Errorf("events = %+v", repo.events)
+	}
+
+	got, err := svc.GetCustomer(ctx, &customerpb.GetCustomerRequest{Id: duplicate.ID.String()})
+	if err != nil || got.GetCustomer().GetId() != duplicate.ID.String() || got.GetMergedFrom() != "" {
+		t.Errorf("GetCustomer() unmerged = %v, %v", got, err)
+	}
+
+	_, err = svc.UnmergeCustomers(ctx, unmerge)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UnmergeCustomers() again: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestUnmergeCustomers_Refused(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetMerger(NewMerger(merge.DefaultConfig(), nil))
+	ctx := context.Background()
+	original, duplicate := createDuplicates(t, svc, repo)
+	third := repo.customers[uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())]
+
+	first, err := svc.MergeCustomers(ctx, mergeRequest(original.ID, duplicate.ID, false))
+	if err != nil {
+		t.Fatalf("MergeCustomers() error: %v", err)
+	}
+	req := mergeRequest(original.ID, third.ID, false)
+	req.SurvivorId = third.ID.String()
+	if _, err := svc.MergeCustomers(ctx, req); err != nil {
+		t.Fatalf("MergeCustomers() error: %v", err)
+	}
+
+	// A merge chain redirects to the last survivor
+	got, err := svc.GetCustomer(ctx, &customerpb.GetCustomerRequest{Id: duplicate.ID.String()})
+	if err != nil || got.GetCustomer().GetId() != third.ID.String() || got.GetMergedFrom() != duplicate.ID.String() {
+		t.Errorf("GetCustomer() chain = %v, %v", got, err)
+	}
+
+	// The later merge of the survivor must be reversed first
+	_, err = svc.UnmergeCustomers(ctx, &customerpb.UnmergeCustomersRequest{MergeId: first.GetMerge().GetId(), UnmergedBy: uuid.New().String(), Reason: "Different people"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UnmergeCustomers() survivor merged: got %v, want FailedPrecondition", err)
+	}
+
+	// Nor can a merge be reversed once its undo window closes
+	repo.merges[1].UndoUntil = time.Now().UTC().Add(-time.Minute)
+	_, err = svc.UnmergeCustomers(ctx, &customerpb.UnmergeCustomersRequest{MergeId: repo.merges[1].ID.String(), UnmergedBy: uuid.New().String(), Reason: "Different people"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UnmergeCustomers() expired: got %v, want FailedPrecondition", err)
+	}
+
+	_, err = svc.UnmergeCustomers(ctx, &customerpb.UnmergeCustomersRequest{MergeId: uuid.New().String(), UnmergedBy: uuid.New().String(), Reason: "Different people"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("UnmergeCustomers() unknown: got %v, want NotFound", err)
+	}
+}
+
+func TestMergeCustomers_Invalid(t *testing.T) {
+	repo := NewMockRepository()
+	ctx := context.Background()
+	original, duplicate := createDuplicates(t, NewCustomerService(repo), repo)
+
+	unconfigured := NewCustomerService(repo)
+	if _, err := unconfigured.MergeCustomers(ctx, mergeRequest(original.ID, duplicate.ID, false)); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("MergeCustomers() unconfigured: got %v, want FailedPrecondition", err)
+	}
+
+	svc := NewCustomerService(repo)
+	svc.SetMerger(NewMerger(merge.DefaultConfig(), nil))
+	if _, err := svc.MergeCustomers(ctx, mergeRequest(original.ID, original.ID, false)); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("MergeCustomers() self: got %v, want InvalidArgument", err)
+	}
+	if _, err := svc.MergeCustomers(ctx, mergeRequest(original.ID, uuid.New(), false)); status.Code(err) != codes.NotFound {
+		t.Errorf("MergeCustomers() unknown: got %v, want NotFound", err)
+	}
+	if _, err := svc.GetCustomer(ctx, &customerpb.GetCustomerRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetCustomer() empty: got %v, want InvalidArgument", err)
+	}
+}
+
+func TestListCustomerEvents(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		related := uuid.New()
+		repo.CreateCustomerEvent(ctx, &models.CustomerEvent{ID: uuid.New(), Type: models.CustomerEventMerged, CustomerID: uuid.New(), RelatedCustomerID: &related})
+	}
+
+	resp, err := svc.ListCustomerEvents(ctx, &customerpb.ListCustomerEventsRequest{AfterSequence: 1, Limit: 1})
+	if err != nil || len(resp.GetEvents()) != 1 || resp.GetEvents()[0].GetSequence() != 2 || resp.GetEvents()[0].GetRelatedCustomerId() == "" {
+		t.Errorf("ListCustomerEvents() = %v, %v", resp, err)
+	}
+	if resp, err := svc.ListCustomerEvents(ctx, &customerpb.ListCustomerEventsRequest{}); err != nil || len(resp.GetEvents()) != 3 {
+		t.Errorf("ListCustomerEvents() all = %v, %v", resp, err)
+	}
+	if _, err := svc.ListCustomerEvents(ctx, &customerpb.ListCustomerEventsRequest{Limit: maxCustomerEvents + 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListCustomerEvents() limit: got %v, want InvalidArgument", err)
+	}
+}
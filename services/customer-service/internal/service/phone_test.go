@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/core-banking/services/customer-service/internal/phone"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCustomerService_PhoneNumbers(t *testing.T) {
+	plans, err := phone.LoadConfig(filepath.Join("..", "..", "config", "phone_numbering.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetPhoneRules(plans)
+
+	customer := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer()
+	if customer.GetPhone() != "+441234567890" || customer.GetPhoneInput() != "+441234567890" || customer.GetPhoneType() != string(phone.TypeFixedLine) {
+		t.Errorf("CreateCustomer() phone = %s, %s, %s; want a UK fixed line", customer.GetPhone(), customer.GetPhoneInput(), customer.GetPhoneType())
+	}
+
+	// Without an address there is no country to read a national number in
+	update := &customerpb.UpdateCustomerRequest{Id: customer.GetId(), Phone: "07700 900123", Version: customer.GetVersion()}
+	if _, err := svc.UpdateCustomer(ctx, update); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateCustomer() national number without an address error = %v, want InvalidArgument", err)
+	}
+
+	if _, err := svc.AddAddress(ctx, &customerpb.AddAddressRequest{
+		CustomerId:  customer.GetId(),
+		AddressType: "Physical",
+		Street1:     "10 Downing Street",
+		City:        "London",
+		PostalCode:  "SW1A 2AA",
+		Country:     "GB",
+	}); err != nil {
+		t.Fatalf("AddAddress() error: %v", err)
+	}
+	updated, err := svc.UpdateCustomer(ctx, update)
+	if err != nil {
+		t.Fatalf("UpdateCustomer() error: %v", err)
+	}
+	customer = updated.GetCustomer()
+	if customer.GetPhone() != "+447700900123" || customer.GetPhoneInput() != "07700 900123" || customer.GetPhoneType() != string(phone.TypeMobile) {
+		t.Errorf("UpdateCustomer() phone = %s, %s, %s; want a UK mobile", customer.GetPhone(), customer.GetPhoneInput(), customer.GetPhoneType())
+	}
+
+	update = &customerpb.UpdateCustomerRequest{Id: customer.GetId(), Phone: "07700 90012", Version: customer.GetVersion()}
+	if _, err := svc.UpdateCustomer(ctx, update); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateCustomer() number too short error = %v, want InvalidArgument", err)
+	}
+
+	createScreenedCustomer(t, svc, "John", "Smith", "1970-01-01")
+
+	tests := []struct {
+		name      string
+		req       *customerpb.SearchCustomersRequest
+		wantCount int
+	}{
+		{name: "international format", req: &customerpb.SearchCustomersRequest{Phone: "+44 7700 900 123"}, wantCount: 1},
+		{name: "national format with its country", req: &customerpb.SearchCustomersRequest{Phone: "07700 900123", PhoneCountry: "GB"}, wantCount: 1},
+		{name: "national format without its country", req: &customerpb.SearchCustomersRequest{Phone: "07700 900123"}, wantCount: 1},
+		{name: "part of a national number with its country", req: &customerpb.SearchCustomersRequest{Phone: "07700 900", PhoneCountry: "GB"}, wantCount: 1},
+		{name: "part of a number", req: &customerpb.SearchCustomersRequest{Phone: "900-123"}, wantCount: 1},
+		{name: "digits in both numbers", req: &customerpb.SearchCustomersRequest{Phone: "123"}, wantCount: 2},
+		{name: "the number replaced", req: &customerpb.SearchCustomersRequest{Phone: "+441234567890"}, wantCount: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.SearchCustomers(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("SearchCustomers() error: %v", err)
+			}
+			if len(resp.GetCustomers()) != tt.wantCount {
+				t.Errorf("SearchCustomers() got %d customers, want %d", len(resp.GetCustomers()), tt.wantCount)
+			}
+		})
+	}
+
+	// A phone that is not digits must not turn into a search without it
+	for _, input := range []string{"abc", "ext 5"} {
+		if _, err := svc.SearchCustomers(ctx, &customerpb.SearchCustomersRequest{Phone: input}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("SearchCustomers(%q) error = %v, want InvalidArgument", input, err)
+		}
+	}
+}
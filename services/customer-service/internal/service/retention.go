@@ -0,0 +1,575 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/export"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// retentionBatchSize is how many customers are read at a time when looking
+// for customers due erasure
+const retentionBatchSize = 500
+
+// erasedValue replaces names and address lines when a customer is
+// pseudonymized
+const erasedValue = "Erased"
+
+// pseudonymizedFields are the customer fields replaced when a customer is
+// pseudonymized
+var pseudonymizedFields = []string{"first_name", "middle_name", "last_name", "date_of_birth", "tax_id", "email", "phone"}
+
+// Retention keeps a closed customer's personal data for a number of years
+// after closure and then pseudonymizes it. Pseudonymizing replaces the
+// customer's names, contact details and tax ID and their address lines,
+// keeps only the year of their date of birth, and shreds their encrypted
+// fields: the ciphertext of the tax ID and document numbers is overwritten,
+// as the encryption key is shared, and stored document scans and subject
+// access bundles are deleted.
+// The customer's ID and everything linked to it stay in place, so financial
+// records remain traceable.
+type Retention struct {
+	years int
+	files *DocumentFiles // Nil when document file storage is disabled
+}
+
+// NewRetention creates a new Retention keeping data for years after closure.
+// Scans stored in files are deleted when a customer is pseudonymized.
+func NewRetention(years int, files *DocumentFiles) *Retention {
+	return &Retention{
+		years: years,
+		files: files,
+	}
+}
+
+// SetRetention erases closed customers on request under the retention
+// policy. Erasure requests are refused until it is set.
+func (s *CustomerService) SetRetention(retention *Retention) {
+	s.retention = retention
+}
+
+// retainUntil is when the retention period for a customer closed at closedAt
+// ends
+func (r *Retention) retainUntil(closedAt time.Time) time.Time {
+	return closedAt.AddDate(r.years, 0, 0)
+}
+
+// erasurePlan is what erasing a customer changes, and what stops it
+type erasurePlan struct {
+	customer  *models.Customer
+	retention *models.CustomerRetention // Nil when the customer's closure was not recorded
+	blockers  []string
+	addresses []*models.Address
+	documents []*models.CustomerDocument
+	files     []*models.DocumentFile
+	exports   []*models.DataExport // Completed exports whose bundles are stored
+	hits      int
+}
+
+// plan works out as at now whether a customer can be erased and what erasing
+// them changes
+func (r *Retention) plan(ctx context.Context, repo repository.CustomerRepository, customerID uuid.UUID, now time.Time) (*erasurePlan, error) {
+	customer, err := repo.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	plan := &erasurePlan{customer: customer}
+
+	plan.retention, err = repo.GetCustomerRetention(ctx, customerID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get customer retention: %w", err)
+	}
+	switch {
+	case customer.Status != models.CustomerStatusClosed:
+		plan.blockers = append(plan.blockers, "customer is not closed")
+	case plan.retention == nil:
+		plan.blockers = append(plan.blockers, "customer's closure date is not recorded")
+	case plan.retention.IsPseudonymized():
+		plan.blockers = append(plan.blockers, "customer has already been pseudonymized")
+	case now.Before(r.retainUntil(plan.retention.ClosedAt)):
+		plan.blockers = append(plan.blockers, fmt.Sprintf("records are retained until %s", r.retainUntil(plan.retention.ClosedAt).Format("2006-01-02")))
+	}
+
+	holds, err := repo.ListLegalHolds(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get legal holds: %w", err)
+	}
+	for _, hold := range holds {
+		if hold.IsActive() {
+			plan.blockers = append(plan.blockers, fmt.Sprintf("legal hold %s: %s", hold.ID, hold.Reason))
+		}
+	}
+
+	if plan.addresses, err = repo.GetCustomerAddresses(ctx, customerID); err != nil {
+		return nil, fmt.Errorf("failed to get addresses: %w", err)
+	}
+	if plan.documents, err = repo.GetCustomerDocuments(ctx, customerID); err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+	for _, doc := range plan.documents {
+		files, err := repo.ListDocumentFiles(ctx, doc.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list document files: %w", err)
+		}
+		plan.files = append(plan.files, files...)
+	}
+	if len(plan.files) > 0 && r.files == nil {
+		plan.blockers = append(plan.blockers, "document scans cannot be deleted while document file storage is disabled")
+	}
+
+	exports, err := repo.ListDataExports(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data exports: %w", err)
+	}
+	for _, e := range exports {
+		if e.Status == models.DataExportStatusCompleted {
+			plan.exports = append(plan.exports, e)
+		}
+	}
+	if len(plan.exports) > 0 && r.files == nil {
+		plan.blockers = append(plan.blockers, "data export bundles cannot be deleted while document file storage is disabled")
+	}
+
+	hits, err := repo.ListScreeningHits(ctx, models.ScreeningHitFilter{CustomerID: &customerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get screening hits: %w", err)
+	}
+	plan.hits = len(hits)
+
+	return plan, nil
+}
+
+// erase pseudonymizes the customer in the plan. Stored scans and bundles are
+// deleted last, so if anything fails the erasure can be retried.
+func (r *Retention) erase(ctx context.Context, repo repository.CustomerRepository, plan *erasurePlan, by *uuid.UUID, now time.Time) error {
+	customer := plan.customer
+	customer.FirstName = erasedValue
+	customer.MiddleName = nil
+	customer.LastName = erasedValue
+	customer.DateOfBirth = time.Date(customer.DateOfBirth.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	customer.TaxID = ""
+	customer.Email = ""
+	customer.Phone = ""
+	customer.PhoneInput = ""
+	customer.PhoneType = ""
+	if by != nil {
+		customer.UpdatedBy = by
+	}
+	if err := repo.UpdateCustomer(ctx, customer); err != nil {
+		return fmt.Errorf("failed to pseudonymize customer: %w", err)
+	}
+
+	for _, address := range plan.addresses {
+		address.Street1 = erasedValue
+		address.Street2 = nil
+		address.City = erasedValue
+		address.State = ""
+		address.PostalCode = ""
+		if err := repo.UpdateAddress(ctx, address); err != nil {
+			return fmt.Errorf("failed to pseudonymize address: %w", err)
+		}
+	}
+
+	for _, doc := range plan.documents {
+		doc.DocumentNumber = ""
+		if err := repo.UpdateDocument(ctx, doc); err != nil {
+			return fmt.Errorf("failed to pseudonymize document: %w", err)
+		}
+	}
+
+	if err := repo.RedactScreeningHits(ctx, customer.ID, erasedValue); err != nil {
+		return err
+	}
+	if err := repo.ReplaceCustomerMatchKeys(ctx, customer.ID, nil); err != nil {
+		return err
+	}
+
+	for _, file := range plan.files {
+		if err := repo.DeleteDocumentFile(ctx, file.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.MarkCustomerPseudonymized(ctx, customer.ID, now); err != nil {
+		return err
+	}
+
+	for _, file := range plan.files {
+		if err := r.files.store.Delete(ctx, file.StorageKey); err != nil {
+			return fmt.Errorf("failed to delete document file: %w", err)
+		}
+	}
+	for _, e := range plan.exports {
+		for _, format := range export.Formats {
+			if err := r.files.store.Delete(ctx, exportKey(e, format)); err != nil {
+				return fmt.Errorf("failed to delete data export: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// report describes the plan for the caller
+func (r *Retention) report(plan *erasurePlan) *customerpb.ErasureReport {
+	report := &customerpb.ErasureReport{
+		CustomerId:    plan.customer.ID.String(),
+		Eligible:      len(plan.blockers) == 0,
+		Blockers:      plan.blockers,
+		Fields:        pseudonymizedFields,
+		Addresses:     int32(len(plan.addresses)),
+		Documents:     int32(len(plan.documents)),
+		DocumentFiles: int32(len(plan.files)),
+		ScreeningHits: int32(plan.hits),
+		DataExports:   int32(len(plan.exports)),
+	}
+	if plan.retention != nil {
+		report.ClosedAt = timestamppb.New(plan.retention.ClosedAt)
+		report.RetainUntil = timestamppb.New(r.retainUntil(plan.retention.ClosedAt))
+	}
+	return report
+}
+
+// EraseCustomer pseudonymizes a closed customer whose retention period is
+// over and who holds no active legal hold. A dry run reports what erasing
+// the customer would do, and why it cannot be done yet, without changing or
+// recording anything. Otherwise the request is recorded either way; a
+// refused request is returned with the reasons rather than as an error.
+func (s *CustomerService) EraseCustomer(ctx context.Context, req *customerpb.EraseCustomerRequest) (*customerpb.EraseCustomerResponse, error) {
+	if errs := s.validator.ValidateEraseCustomer(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	if s.retention == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "data retention is not configured")
+	}
+
+	customerID := uuid.MustParse(req.GetCustomerId())
+	requestedBy := uuid.MustParse(req.GetRequestedBy())
+	now := time.Now().UTC()
+
+	if req.GetDryRun() {
+		plan, err := s.retention.plan(ctx, s.repo, customerID, now)
+		if err != nil {
+			return nil, erasureStatus(err)
+		}
+		return &customerpb.EraseCustomerResponse{Report: s.retention.report(plan)}, nil
+	}
+
+	var plan *erasurePlan
+	request := &models.ErasureRequest{
+		ID:          uuid.New(),
+		CustomerID:  customerID,
+		RequestedBy: requestedBy,
+		Reason:      req.GetReason(),
+		RequestedAt: now,
+	}
+	err := withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		var err error
+		if plan, err = s.retention.plan(ctx, repo, customerID, now); err != nil {
+			return err
+		}
+		request.Status = models.ErasureRequestStatusCompleted
+		request.Blockers = plan.blockers
+		if len(plan.blockers) > 0 {
+			request.Status = models.ErasureRequestStatusRefused
+		} else if err := s.retention.erase(ctx, repo, plan, &requestedBy, now); err != nil {
+			return err
+		}
+		return repo.CreateErasureRequest(ctx, request)
+	})
+	if err != nil {
+		return nil, erasureStatus(err)
+	}
+
+	return &customerpb.EraseCustomerResponse{
+		Report:  s.retention.report(plan),
+		Request: erasureRequestModelToProto(request),
+	}, nil
+}
+
+// PlaceLegalHold stops a customer's personal data being erased until the
+// hold is released
+func (s *CustomerService) PlaceLegalHold(ctx context.Context, req *customerpb.PlaceLegalHoldRequest) (*customerpb.PlaceLegalHoldResponse, error) {
+	if errs := s.validator.ValidatePlaceLegalHold(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+	customerID := uuid.MustParse(req.GetCustomerId())
+
+	if _, err := s.repo.GetCustomerByID(ctx, customerID); err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+	pseudonymized, err := isPseudonymized(ctx, s.repo, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get customer retention: %v", err)
+	}
+	if pseudonymized {
+		return nil, status.Errorf(codes.FailedPrecondition, "customer has already been pseudonymized")
+	}
+
+	hold := &models.LegalHold{
+		ID:         uuid.New(),
+		CustomerID: customerID,
+		Reason:     req.GetReason(),
+		PlacedBy:   uuid.MustParse(req.GetPlacedBy()),
+		PlacedAt:   time.Now().UTC(),
+	}
+	if err := s.repo.CreateLegalHold(ctx, hold); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to place legal hold: %v", err)
+	}
+
+	return &customerpb.PlaceLegalHoldResponse{
+		Hold: legalHoldModelToProto(hold),
+	}, nil
+}
+
+// ReleaseLegalHold lifts a legal hold. The customer can be erased once their
+// retention period is over and no other hold is active.
+func (s *CustomerService) ReleaseLegalHold(ctx context.Context, req *customerpb.ReleaseLegalHoldRequest) (*customerpb.ReleaseLegalHoldResponse, error) {
+	if errs := s.validator.ValidateReleaseLegalHold(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	hold, err := s.repo.GetLegalHold(ctx, uuid.MustParse(req.GetHoldId()))
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "legal hold not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get legal hold: %v", err)
+	}
+
+	if err := hold.Release(uuid.MustParse(req.GetReleasedBy()), time.Now().UTC()); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err := s.repo.UpdateLegalHold(ctx, hold); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to release legal hold: %v", err)
+	}
+
+	return &customerpb.ReleaseLegalHoldResponse{
+		Hold: legalHoldModelToProto(hold),
+	}, nil
+}
+
+// ListLegalHolds lists a customer's legal holds, most recently placed first
+func (s *CustomerService) ListLegalHolds(ctx context.Context, req *customerpb.ListLegalHoldsRequest) (*customerpb.ListLegalHoldsResponse, error) {
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetCustomerByID(ctx, customerID); err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	holds, err := s.repo.ListLegalHolds(ctx, customerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list legal holds: %v", err)
+	}
+
+	protoHolds := make([]*customerpb.LegalHold, len(holds))
+	for i, hold := range holds {
+		protoHolds[i] = legalHoldModelToProto(hold)
+	}
+
+	return &customerpb.ListLegalHoldsResponse{
+		Holds: protoHolds,
+	}, nil
+}
+
+// isPseudonymized reports whether a customer's personal data has been
+// replaced. Their details cannot be changed afterwards.
+func isPseudonymized(ctx context.Context, repo repository.CustomerRepository, customerID uuid.UUID) (bool, error) {
+	retention, err := repo.GetCustomerRetention(ctx, customerID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return retention.IsPseudonymized(), nil
+}
+
+// checkNotPseudonymized refuses changes to a closed customer who has been
+// pseudonymized, which would bring back personal data
+func checkNotPseudonymized(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer) error {
+	if customer.Status != models.CustomerStatusClosed {
+		return nil
+	}
+	pseudonymized, err := isPseudonymized(ctx, repo, customer.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get customer retention: %v", err)
+	}
+	if pseudonymized {
+		return status.Errorf(codes.FailedPrecondition, "customer has been pseudonymized")
+	}
+	return nil
+}
+
+func erasureStatus(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "customer not found")
+	}
+	return status.Errorf(codes.Internal, "failed to erase customer: %v", err)
+}
+
+// RetentionJob pseudonymizes closed customers whose retention period is
+// over, passing over any under legal hold. In dry-run mode it only logs what
+// it would do.
+type RetentionJob struct {
+	repo      repository.CustomerRepository
+	retention *Retention
+	dryRun    bool
+	interval  time.Duration
+	log       zerolog.Logger
+}
+
+// NewRetentionJob creates a new RetentionJob
+func NewRetentionJob(repo repository.CustomerRepository, retention *Retention, dryRun bool, interval time.Duration, log zerolog.Logger) *RetentionJob {
+	return &RetentionJob{
+		repo:      repo,
+		retention: retention,
+		dryRun:    dryRun,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// Run pseudonymizes customers due erasure straight away and then every
+// interval until the context is cancelled
+func (j *RetentionJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		erased, err := j.RunOnce(ctx, time.Now().UTC())
+		if err != nil && ctx.Err() == nil {
+			j.log.Error().Err(err).Msg("Data retention run failed")
+		}
+		if erased > 0 {
+			j.log.Info().Int("pseudonymized", erased).Bool("dry_run", j.dryRun).Msg("Data retention run complete")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce pseudonymizes every customer due erasure as at now and returns how
+// many were, or in dry-run mode would have been. It carries on past failures
+// on individual customers, which are returned together.
+func (j *RetentionJob) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	erased := 0
+	var failures []error
+	after := uuid.Nil
+	for {
+		due, err := j.repo.ListCustomersDueErasure(ctx, now.AddDate(-j.retention.years, 0, 0), after, retentionBatchSize)
+		if err != nil {
+			return erased, err
+		}
+		if len(due) == 0 {
+			break
+		}
+
+		for _, retention := range due {
+			if err := ctx.Err(); err != nil {
+				return erased, err
+			}
+			done, err := j.eraseCustomer(ctx, retention.CustomerID, now)
+			if err != nil {
+				failures = append(failures, fmt.Errorf("customer %s: %w", retention.CustomerID, err))
+				continue
+			}
+			if done {
+				erased++
+			}
+		}
+		after = due[len(due)-1].CustomerID
+	}
+
+	if len(failures) > 0 {
+		return erased, fmt.Errorf("pseudonymization failed for %d customers: %w", len(failures), errors.Join(failures...))
+	}
+	return erased, nil
+}
+
+// eraseCustomer pseudonymizes one customer unless something now stops it,
+// reporting whether it did
+func (j *RetentionJob) eraseCustomer(ctx context.Context, customerID uuid.UUID, now time.Time) (bool, error) {
+	if j.dryRun {
+		plan, err := j.retention.plan(ctx, j.repo, customerID, now)
+		if err != nil {
+			return false, err
+		}
+		if len(plan.blockers) > 0 {
+			return false, nil
+		}
+		j.log.Info().
+			Str("customer_id", customerID.String()).
+			Int("addresses", len(plan.addresses)).
+			Int("documents", len(plan.documents)).
+			Int("document_files", len(plan.files)).
+			Int("screening_hits", plan.hits).
+			Msg("Customer would be pseudonymized (dry run)")
+		return true, nil
+	}
+
+	erased := false
+	err := withTx(ctx, j.repo, func(repo repository.CustomerRepository) error {
+		plan, err := j.retention.plan(ctx, repo, customerID, now)
+		if err != nil {
+			return err
+		}
+		if len(plan.blockers) > 0 {
+			return nil
+		}
+		erased = true
+		return j.retention.erase(ctx, repo, plan, nil, now)
+	})
+	return erased && err == nil, err
+}
+
+func legalHoldModelToProto(h *models.LegalHold) *customerpb.LegalHold {
+	pb := &customerpb.LegalHold{
+		Id:         h.ID.String(),
+		CustomerId: h.CustomerID.String(),
+		Reason:     h.Reason,
+		PlacedBy:   h.PlacedBy.String(),
+		PlacedAt:   timestamppb.New(h.PlacedAt),
+		Active:     h.IsActive(),
+	}
+	if h.ReleasedBy != nil {
+		pb.ReleasedBy = h.ReleasedBy.String()
+	}
+	if h.ReleasedAt != nil {
+		pb.ReleasedAt = timestamppb.New(*h.ReleasedAt)
+	}
+	return pb
+}
+
+func erasureRequestModelToProto(r *models.ErasureRequest) *customerpb.ErasureRequest {
+	return &customerpb.ErasureRequest{
+		Id:          r.ID.String(),
+		CustomerId:  r.CustomerID.String(),
+		RequestedBy: r.RequestedBy.String(),
+		Reason:      r.Reason,
+		Status:      string(r.Status),
+		Blockers:    r.Blockers,
+		RequestedAt: timestamppb.New(r.RequestedAt),
+	}
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/encryption"
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/storage"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createClosedCustomer creates a customer with an address, a document and a
+// screening hit, and closes them at closedAt
+func createClosedCustomer(t *testing.T, svc *CustomerService, repo *MockRepository, closedAt time.Time) uuid.UUID {
+	t.Helper()
+	customerID := uuid.MustParse(createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())
+	repo.addresses[customerID] = append(repo.addresses[customerID], &models.Address{
+		ID:          uuid.New(),
+		CustomerID:  customerID,
+		AddressType: models.AddressTypePhysical,
+		Street1:     "1 High Street",
+		City:        "London",
+		PostalCode:  "SW1A 1AA",
+		Country:     "GB",
+	})
+	doc := addTestDocument(repo, customerID, models.DocumentTypePassport, models.VerificationStatusVerified, closedAt.AddDate(3, 0, 0))
+	doc.DocumentNumber = "P1234567"
+	repo.hits = append(repo.hits, &models.ScreeningHit{ID: uuid.New(), CustomerID: customerID, Subject: "Jane Doe 1985-06-01"})
+
+	_, err := svc.UpdateCustomerStatus(context.Background(), &customerpb.UpdateCustomerStatusRequest{
+		Id:        customerID.String(),
+		NewStatus: string(models.CustomerStatusClosed),
+		Reason:    "Customer request",
+	})
+	if err != nil {
+		t.Fatalf("UpdateCustomerStatus() error: %v", err)
+	}
+	repo.retention[customerID].ClosedAt = closedAt
+	return customerID
+}
+
+func eraseRequest(customerID uuid.UUID, dryRun bool) *customerpb.EraseCustomerRequest {
+	return &customerpb.EraseCustomerRequest{
+		CustomerId:  customerID.String(),
+		RequestedBy: uuid.New().String(),
+		Reason:      "Data subject erasure request",
+		DryRun:      dryRun,
+	}
+}
+
+func TestEraseCustomer(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetRetention(NewRetention(5, nil))
+	ctx := context.Background()
+	customerID := createClosedCustomer(t, svc, repo, time.Now().UTC().AddDate(-6, 0, 0))
+
+	// A dry run reports without changing or recording anything
+	resp, err := svc.EraseCustomer(ctx, eraseRequest(customerID, true))
+	if err != nil {
+		t.Fatalf("EraseCustomer() dry run error: %v", err)
+	}
+	report := resp.GetReport()
+	if !report.GetEligible() || report.GetAddresses() != 1 || report.GetDocuments() != 1 || report.GetScreeningHits() != 1 || resp.GetRequest() != nil {
+		t.Errorf("EraseCustomer() dry run = %v", resp)
+	}
+	if repo.customers[customerID].FirstName != "Jane" || len(repo.erasureRequests) != 0 {
+		t.Error("dry run changed the customer")
+	}
+
+	resp, err = svc.EraseCustomer(ctx, eraseRequest(customerID, false))
+	if err != nil {
+		t.Fatalf("EraseCustomer() error: %v", err)
+	}
+	if resp.GetRequest().GetStatus() != string(models.ErasureRequestStatusCompleted) || len(repo.erasureRequests) != 1 {
+		t.Errorf("EraseCustomer() = %v", resp)
+	}
+
+	customer := repo.customers[customerID]
+	if customer.FirstName != erasedValue || customer.LastName != erasedValue || customer.TaxID != "" ||
+		customer.Email != "" || customer.Phone != "" || customer.DateOfBirth != time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("customer not pseudonymized: %+v", customer)
+	}
+	if address := repo.addresses[customerID][0]; address.Street1 != erasedValue || address.PostalCode != "" || address.Country != "GB" {
+		t.Errorf("address not pseudonymized: %+v", address)
+	}
+	if doc := repo.documents[customerID][0]; doc.DocumentNumber != "" {
+		t.Errorf("document number kept: %q", doc.DocumentNumber)
+	}
+	if repo.hits[0].Subject != erasedValue {
+		t.Errorf("screening subject kept: %q", repo.hits[0].Subject)
+	}
+	if !repo.retention[customerID].IsPseudonymized() {
+		t.Error("customer not marked pseudonymized")
+	}
+
+	// Personal data cannot be brought back
+	_, err = svc.UpdateCustomer(ctx, &customerpb.UpdateCustomerRequest{Id: customerID.String(), FirstName: "Jane", Version: int32(customer.Version)})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateCustomer() pseudonymized: got %v, want FailedPrecondition", err)
+	}
+
+	resp, err = svc.EraseCustomer(ctx, eraseRequest(customerID, false))
+	if err != nil || resp.GetRequest().GetStatus() != string(models.ErasureRequestStatusRefused) {
+		t.Errorf("EraseCustomer() again = %v, %v", resp, err)
+	}
+}
+
+func TestEraseCustomer_Refused(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetRetention(NewRetention(5, nil))
+	ctx := context.Background()
+
+	open := uuid.MustParse(createScreenedCustomer(t, svc, "John", "Smith", "1970-01-01").GetCustomer().GetId())
+	resp, err := svc.EraseCustomer(ctx, eraseRequest(open, false))
+	if err != nil {
+		t.Fatalf("EraseCustomer() error: %v", err)
+	}
+	if resp.GetRequest().GetStatus() != string(models.ErasureRequestStatusRefused) || !strings.Contains(strings.Join(resp.GetReport().GetBlockers(), ";"), "not closed") {
+		t.Errorf("EraseCustomer() open customer = %v", resp)
+	}
+	if repo.customers[open].FirstName != "John" {
+		t.Error("open customer was pseudonymized")
+	}
+
+	closedAt := time.Now().UTC().AddDate(-2, 0, 0)
+	recent := createClosedCustomer(t, svc, repo, closedAt)
+	resp, _ = svc.EraseCustomer(ctx, eraseRequest(recent, true))
+	want := "records are retained until " + closedAt.AddDate(5, 0, 0).Format("2006-01-02")
+	if resp.GetReport().GetEligible() || len(resp.GetReport().GetBlockers()) != 1 || resp.GetReport().GetBlockers()[0] != want {
+		t.Errorf("EraseCustomer() within retention = %v", resp.GetReport())
+	}
+
+	// A legal hold blocks erasure until released
+	due := createClosedCustomer(t, svc, repo, time.Now().UTC().AddDate(-6, 0, 0))
+	placed, err := svc.PlaceLegalHold(ctx, &customerpb.PlaceLegalHoldRequest{
+		CustomerId: due.String(),
+		PlacedBy:   uuid.New().String(),
+		Reason:     "Litigation pending",
+	})
+	if err != nil || !placed.GetHold().GetActive() {
+		t.Fatalf("PlaceLegalHold() = %v, %v", placed, err)
+	}
+	resp, _ = svc.EraseCustomer(ctx, eraseRequest(due, false))
+	if resp.GetRequest().GetStatus() != string(models.ErasureRequestStatusRefused) || !strings.Contains(resp.GetReport().GetBlockers()[0], "Litigation pending") {
+		t.Errorf("EraseCustomer() under hold = %v", resp)
+	}
+
+	release := &customerpb.ReleaseLegalHoldRequest{HoldId: placed.GetHold().GetId(), ReleasedBy: uuid.New().String()}
+	if released, err := svc.ReleaseLegalHold(ctx, release); err != nil || released.GetHold().GetActive() {
+		t.Fatalf("ReleaseLegalHold() = %v, %v", released, err)
+	}
+	if _, err := svc.ReleaseLegalHold(ctx, release); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReleaseLegalHold() twice: got %v, want FailedPrecondition", err)
+	}
+	listed, err := svc.ListLegalHolds(ctx, &customerpb.ListLegalHoldsRequest{CustomerId: due.String()})
+	if err != nil || len(listed.GetHolds()) != 1 {
+		t.Errorf("ListLegalHolds() = %v, %v", listed, err)
+	}
+
+	resp, _ = svc.EraseCustomer(ctx, eraseRequest(due, false))
+	if resp.GetRequest().GetStatus() != string(models.ErasureRequestStatusCompleted) {
+		t.Errorf("EraseCustomer() after release = %v", resp)
+	}
+
+	unconfigured := NewCustomerService(repo)
+	if _, err := unconfigured.EraseCustomer(ctx, eraseRequest(due, true)); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("EraseCustomer() without retention: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestEraseCustomer_DocumentFiles(t *testing.T) {
+	store, err := storage.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptor, err := encryption.NewEncryptor("0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := NewDocumentFiles(store, encryptor, 1<<20, []string{"compliance"})
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetDocumentFiles(files)
+	svc.SetRetention(NewRetention(5, files))
+	ctx := context.Background()
+
+	customerID := createClosedCustomer(t, svc, repo, time.Now().UTC().AddDate(-6, 0, 0))
+	repo.retention = make(map[uuid.UUID]*models.CustomerRetention) // Reopen long enough to upload
+	repo.customers[customerID].Status = models.CustomerStatusActive
+	file, err := uploadFile(svc, repo.documents[customerID][0].ID, testPDF(4096), 1024, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.customers[customerID].Status = models.CustomerStatusClosed
+	repo.retention[customerID] = &models.CustomerRetention{CustomerID: customerID, ClosedAt: time.Now().UTC().AddDate(-6, 0, 0)}
+	key := repo.files[0].StorageKey
+
+	// Scans cannot be erased without the store that holds them
+	withoutFiles := NewCustomerService(repo)
+	withoutFiles.SetRetention(NewRetention(5, nil))
+	resp, _ := withoutFiles.EraseCustomer(ctx, eraseRequest(customerID, true))
+	if resp.GetReport().GetEligible() || resp.GetReport().GetDocumentFiles() != 1 {
+		t.Errorf("EraseCustomer() without file storage = %v", resp.GetReport())
+	}
+
+	resp, err = svc.EraseCustomer(ctx, eraseRequest(customerID, false))
+	if err != nil || resp.GetRequest().GetStatus() != string(models.ErasureRequestStatusCompleted) {
+		t.Fatalf("EraseCustomer() = %v, %v", resp, err)
+	}
+	if len(repo.files) != 0 {
+		t.Errorf("file %s still recorded", file.GetId())
+	}
+	if _, err := store.Get(ctx, key); err == nil {
+		t.Error("scan still stored")
+	}
+
+	// No scans can be added afterwards
+	if _, err := uploadFile(svc, repo.documents[customerID][0].ID, testPDF(4096), 1024, ""); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UploadDocumentFile() pseudonymized: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestRetentionJob(t *testing.T) {
+	repo := NewMockRepository()
+	retention := NewRetention(5, nil)
+	svc := NewCustomerService(repo)
+	svc.SetRetention(retention)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	due := createClosedCustomer(t, svc, repo, now.AddDate(-6, 0, 0))
+	recent := createClosedCustomer(t, svc, repo, now.AddDate(-1, 0, 0))
+	held := createClosedCustomer(t, svc, repo, now.AddDate(-8, 0, 0))
+	if _, err := svc.PlaceLegalHold(ctx, &customerpb.PlaceLegalHoldRequest{
+		CustomerId: held.String(),
+		PlacedBy:   uuid.New().String(),
+		Reason:     "Regulator investigation",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	dryRun := NewRetentionJob(repo, retention, true, time.Hour, zerolog.Nop())
+	erased, err := dryRun.RunOnce(ctx, now)
+	if err != nil || erased != 1 {
+		t.Fatalf("RunOnce() dry run = %d, %v; want 1", erased, err)
+	}
+	if repo.retention[due].IsPseudonymized() || repo.customers[due].FirstName != "Jane" {
+		t.Error("dry run pseudonymized the customer")
+	}
+
+	job := NewRetentionJob(repo, retention, false, time.Hour, zerolog.Nop())
+	erased, err = job.RunOnce(ctx, now)
+	if err != nil || erased != 1 {
+		t.Fatalf("RunOnce() = %d, %v; want 1", erased, err)
+	}
+	if !repo.retention[due].IsPseudonymized() || repo.customers[due].FirstName != erasedValue {
+		t.Error("customer due erasure was not pseudonymized")
+	}
+	for _, id := range []uuid.UUID{recent, held} {
+		if repo.retention[id].IsPseudonymized() || repo.customers[id].FirstName != "Jane" {
+			t.Errorf("customer %s pseudonymized early", id)
+		}
+	}
+
+	// Nothing is left to do
+	if erased, err := job.RunOnce(ctx, now); err != nil || erased != 0 {
+		t.Errorf("RunOnce() again = %d, %v; want 0", erased, err)
+	}
+}
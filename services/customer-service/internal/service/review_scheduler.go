@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// reviewBatchSize is how many customers are read at a time when scheduling
+// reviews
+const reviewBatchSize = 500
+
+// ReviewRun counts what one run of the review scheduler did
+type ReviewRun struct {
+	Assessed    int // Customers assessed for the first time or after a screening hit changed
+	TasksRaised int
+	Restricted  int // High-risk customers restricted for an overdue review
+}
+
+// ReviewScheduler keeps periodic KYC reviews on schedule. Each time it runs
+// it assesses customers who have never been assessed or whose screening hits
+// changed since, raises a review task for every customer whose next review
+// falls within the notice period, and restricts active or inactive customers
+// rated High whose review task is overdue.
+type ReviewScheduler struct {
+	repo     repository.CustomerRepository
+	assessor *RiskAssessor
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewReviewScheduler creates a new ReviewScheduler
+func NewReviewScheduler(repo repository.CustomerRepository, assessor *RiskAssessor, interval time.Duration, log zerolog.Logger) *ReviewScheduler {
+	return &ReviewScheduler{
+		repo:     repo,
+		assessor: assessor,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run schedules reviews straight away and then every interval until the
+// context is cancelled
+func (j *ReviewScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		run, err := j.RunOnce(ctx, time.Now().UTC())
+		if err != nil && ctx.Err() == nil {
+			j.log.Error().Err(err).Msg("Review scheduling failed")
+		}
+		if run.Assessed > 0 || run.TasksRaised > 0 || run.Restricted > 0 {
+			j.log.Info().
+				Int("assessed", run.Assessed).
+				Int("tasks_raised", run.TasksRaised).
+				Int("restricted", run.Restricted).
+				Msg("Review scheduling complete")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce does one round of scheduling as at now. Each step carries on past
+// failures on individual customers, which are returned together.
+func (j *ReviewScheduler) RunOnce(ctx context.Context, now time.Time) (ReviewRun, error) {
+	var run ReviewRun
+	var failures []error
+
+	assessed, err := j.assessDue(ctx)
+	run.Assessed = assessed
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	raised, err := j.raiseTasks(ctx, now)
+	run.TasksRaised = raised
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	restricted, err := j.restrictOverdue(ctx, now)
+	run.Restricted = restricted
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	return run, errors.Join(failures...)
+}
+
+// assessDue assesses customers never assessed or with screening hits raised
+// or reviewed since their last assessment
+func (j *ReviewScheduler) assessDue(ctx context.Context) (int, error) {
+	assessed := 0
+	var failures []error
+	after := uuid.Nil
+	for {
+		customers, err := j.repo.ListCustomersDueRiskAssessment(ctx, after, reviewBatchSize)
+		if err != nil {
+			return assessed, err
+		}
+		if len(customers) == 0 {
+			break
+		}
+
+		for _, customer := range customers {
+			if err := ctx.Err(); err != nil {
+				return assessed, err
+			}
+			err := withTx(ctx, j.repo, func(repo repository.CustomerRepository) error {
+				_, err := j.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerScheduled, nil)
+				return err
+			})
+			if err != nil {
+				failures = append(failures, fmt.Errorf("customer %s: %w", customer.ID, err))
+				continue
+			}
+			assessed++
+		}
+		after = customers[len(customers)-1].ID
+	}
+
+	if len(failures) > 0 {
+		return assessed, fmt.Errorf("risk assessment failed for %d customers: %w", len(failures), errors.Join(failures...))
+	}
+	return assessed, nil
+}
+
+// raiseTasks opens a review task for each customer due for review within the
+// notice period who has none open
+func (j *ReviewScheduler) raiseTasks(ctx context.Context, now time.Time) (int, error) {
+	raised := 0
+	var failures []error
+	before := now.Add(j.assessor.model.ReviewNotice())
+	after := uuid.Nil
+	for {
+		due, err := j.repo.ListRiskAssessmentsDueReview(ctx, before, after, reviewBatchSize)
+		if err != nil {
+			return raised, err
+		}
+		if len(due) == 0 {
+			break
+		}
+
+		for _, assessment := range due {
+			task := &models.ReviewTask{
+				ID:           uuid.New(),
+				CustomerID:   assessment.CustomerID,
+				AssessmentID: assessment.ID,
+				Rating:       assessment.Rating,
+				DueAt:        assessment.NextReviewAt,
+				Status:       models.ReviewTaskStatusOpen,
+				CreatedAt:    now,
+			}
+			if err := j.repo.CreateReviewTask(ctx, task); err != nil {
+				failures = append(failures, fmt.Errorf("customer %s: %w", assessment.CustomerID, err))
+				continue
+			}
+			raised++
+		}
+		after = due[len(due)-1].CustomerID
+	}
+
+	if len(failures) > 0 {
+		return raised, fmt.Errorf("raising review tasks failed for %d customers: %w", len(failures), errors.Join(failures...))
+	}
+	return raised, nil
+}
+
+// restrictOverdue restricts active and inactive customers currently rated
+// High whose review task is past due
+func (j *ReviewScheduler) restrictOverdue(ctx context.Context, now time.Time) (int, error) {
+	overdue, err := j.repo.ListReviewTasks(ctx, models.ReviewTaskFilter{
+		Status:    models.ReviewTaskStatusOpen,
+		DueBefore: &now,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	restricted := 0
+	var failures []error
+	for _, task := range overdue {
+		if err := ctx.Err(); err != nil {
+			return restricted, err
+		}
+		changed := false
+		err := withTx(ctx, j.repo, func(repo repository.CustomerRepository) error {
+			latest, err := repo.GetLatestRiskAssessment(ctx, task.CustomerID)
+			if err != nil {
+				return err
+			}
+			if latest.Rating != models.RiskRatingHigh {
+				return nil
+			}
+			customer, err := repo.GetCustomerByID(ctx, task.CustomerID)
+			if err != nil {
+				return err
+			}
+			if customer.Status != models.CustomerStatusActive && customer.Status != models.CustomerStatusInactive {
+				return nil
+			}
+			changed = true
+			_, err = changeStatus(ctx, repo, customer, models.CustomerStatusRestricted, "KYC review overdue", nil, now)
+			return err
+		})
+		if err != nil {
+			failures = append(failures, fmt.Errorf("customer %s: %w", task.CustomerID, err))
+			continue
+		}
+		if changed {
+			restricted++
+			j.log.Warn().
+				Str("customer_id", task.CustomerID.String()).
+				Str("task_id", task.ID.String()).
+				Time("due_at", task.DueAt).
+				Msg("High-risk customer restricted for overdue KYC review")
+		}
+	}
+
+	if len(failures) > 0 {
+		return restricted, fmt.Errorf("restricting overdue customers failed for %d customers: %w", len(failures), errors.Join(failures...))
+	}
+	return restricted, nil
+}
@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/core-banking/services/customer-service/internal/risk"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ProductDirectory looks up the accounts a customer holds, such as in
+// account-service
+type ProductDirectory interface {
+	CustomerProducts(ctx context.Context, customerID uuid.UUID) ([]risk.Product, error)
+}
+
+// RiskAssessor rates customers' KYC risk from what is stored about them
+type RiskAssessor struct {
+	model    *risk.Model
+	products ProductDirectory // Nil when holdings are not looked up
+}
+
+// NewRiskAssessor creates a RiskAssessor. A nil directory, or one that
+// fails, leaves product holdings out of the assessment.
+func NewRiskAssessor(model *risk.Model, products ProductDirectory) *RiskAssessor {
+	return &RiskAssessor{model: model, products: products}
+}
+
+// SetRiskAssessor rates customers' KYC risk with the assessor when they are
+// created and whenever their details, documents or screening hits change.
+// Risk rating is disabled until it is set.
+func (s *CustomerService) SetRiskAssessor(assessor *RiskAssessor) {
+	s.assessor = assessor
+}
+
+// AssessCustomerRisk rates a customer's KYC risk now
+func (s *CustomerService) AssessCustomerRisk(ctx context.Context, req *customerpb.AssessCustomerRiskRequest) (*customerpb.AssessCustomerRiskResponse, error) {
+	if s.assessor == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "risk rating is not configured")
+	}
+
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	var assessedBy *uuid.UUID
+	if req.GetAssessedBy() != "" {
+		by, err := uuid.Parse(req.GetAssessedBy())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid assessed_by UUID: %v", err)
+		}
+		assessedBy = &by
+	}
+
+	var result *models.CustomerRiskAssessment
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		customer, err := repo.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return err
+		}
+		result, err = s.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerManual, assessedBy)
+		return err
+	})
+	if err == repository.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "customer not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assess customer risk: %v", err)
+	}
+
+	return &customerpb.AssessCustomerRiskResponse{
+		Assessment: riskAssessmentModelToProto(result),
+	}, nil
+}
+
+// GetCustomerRiskHistory lists a customer's risk assessments, most recent first
+func (s *CustomerService) GetCustomerRiskHistory(ctx context.Context, req *customerpb.GetCustomerRiskHistoryRequest) (*customerpb.GetCustomerRiskHistoryResponse, error) {
+	if errs := s.validator.ValidateGetCustomerRiskHistory(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = 20
+	}
+	assessments, err := s.repo.ListRiskAssessments(ctx, uuid.MustParse(req.GetCustomerId()), limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get risk history: %v", err)
+	}
+
+	protoAssessments := make([]*customerpb.CustomerRiskAssessment, len(assessments))
+	for i, a := range assessments {
+		protoAssessments[i] = riskAssessmentModelToProto(a)
+	}
+
+	return &customerpb.GetCustomerRiskHistoryResponse{
+		Assessments: protoAssessments,
+	}, nil
+}
+
+// ListReviewTasks lists periodic KYC review tasks, soonest due first
+func (s *CustomerService) ListReviewTasks(ctx context.Context, req *customerpb.ListReviewTasksRequest) (*customerpb.ListReviewTasksResponse, error) {
+	if errs := s.validator.ValidateListReviewTasks(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	now := time.Now().UTC()
+	filter := models.ReviewTaskFilter{
+		Status: models.ReviewTaskStatus(req.GetStatus()),
+		Limit:  int(req.GetLimit()),
+	}
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+	if req.GetCustomerId() != "" {
+		customerID := uuid.MustParse(req.GetCustomerId())
+		filter.CustomerID = &customerID
+	}
+	if req.GetOverdueOnly() {
+		filter.Status = models.ReviewTaskStatusOpen
+		filter.DueBefore = &now
+	}
+
+	tasks, err := s.repo.ListReviewTasks(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list review tasks: %v", err)
+	}
+
+	protoTasks := make([]*customerpb.ReviewTask, len(tasks))
+	for i, t := range tasks {
+		protoTasks[i] = reviewTaskModelToProto(t, now)
+	}
+
+	return &customerpb.ListReviewTasksResponse{
+		Tasks: protoTasks,
+	}, nil
+}
+
+// CompleteReviewTask records that a periodic review has been carried out and
+// reassesses the customer, which sets their next review date. A customer
+// restricted for an overdue review is made active again unless they have
+// potential sanctions matches pending or confirmed or no valid identity
+// document.
+func (s *CustomerService) CompleteReviewTask(ctx context.Context, req *customerpb.CompleteReviewTaskRequest) (*customerpb.CompleteReviewTaskResponse, error) {
+	if s.assessor == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "risk rating is not configured")
+	}
+	if errs := s.validator.ValidateCompleteReviewTask(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	taskID := uuid.MustParse(req.GetTaskId())
+	completedBy := uuid.MustParse(req.GetCompletedBy())
+
+	var task *models.ReviewTask
+	var assessment *models.CustomerRiskAssessment
+	var customer *models.Customer
+	err := withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		var err error
+		if task, err = repo.GetReviewTask(ctx, taskID); err != nil {
+			return err
+		}
+		if err := task.Complete(completedBy, req.GetNotes(), time.Now().UTC()); err != nil {
+			return err
+		}
+		if err := repo.UpdateReviewTask(ctx, task); err != nil {
+			return err
+		}
+
+		if customer, err = repo.GetCustomerByID(ctx, task.CustomerID); err != nil {
+			return err
+		}
+		if assessment, err = s.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerReview, &completedBy); err != nil {
+			return err
+		}
+
+		if customer.Status != models.CustomerStatusRestricted {
+			return nil
+		}
+		blocked, err := hasBlockingHits(ctx, repo, customer.ID)
+		if err != nil || blocked {
+			return err
+		}
+		lapsed, err := hasLapsedIdentity(ctx, repo, customer.ID, time.Now().UTC())
+		if err != nil || lapsed {
+			return err
+		}
+		_, err = changeStatus(ctx, repo, customer, models.CustomerStatusActive, "KYC review completed", &completedBy, time.Now().UTC())
+		return err
+	})
+	switch {
+	case err == repository.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "review task not found")
+	case err == models.ErrReviewTaskCompleted:
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case err != nil:
+		if _, ok := err.(*repository.ErrOptimisticLock); ok {
+			return nil, status.Errorf(codes.Aborted, "customer was modified by another process")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to complete review task: %v", err)
+	}
+
+	return &customerpb.CompleteReviewTaskResponse{
+		Task:       reviewTaskModelToProto(task, time.Now().UTC()),
+		Assessment: riskAssessmentModelToProto(assessment),
+		Customer:   modelToProto(customer),
+	}, nil
+}
+
+// assess rates a customer and records the assessment. A completed review
+// sets the next review from the new rating; any other assessment can only
+// bring the next review forward, and pulls in an open review task due later
+// than it. Returns nil when the assessor is nil.
+func (a *RiskAssessor) assess(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer, trigger models.RiskAssessmentTrigger, by *uuid.UUID) (*models.CustomerRiskAssessment, error) {
+	if a == nil {
+		return nil, nil
+	}
+	now := time.Now().UTC()
+
+	profile, err := a.profile(ctx, repo, customer, now)
+	if err != nil {
+		return nil, err
+	}
+	result := a.model.Assess(profile, now)
+
+	assessment := &models.CustomerRiskAssessment{
+		ID:           uuid.New(),
+		CustomerID:   customer.ID,
+		Rating:       models.RiskRating(result.Rating),
+		Score:        result.Score,
+		ModelVersion: a.model.Version(),
+		Trigger:      trigger,
+		AssessedBy:   by,
+		AssessedAt:   now,
+		NextReviewAt: a.model.NextReview(result.Rating, now),
+	}
+	for _, f := range result.Factors {
+		assessment.Factors = append(assessment.Factors, models.RiskFactor{Code: f.Code, Description: f.Description, Points: f.Points})
+	}
+
+	if trigger != models.RiskAssessmentTriggerReview {
+		previous, err := repo.GetLatestRiskAssessment(ctx, customer.ID)
+		if err != nil && err != repository.ErrNotFound {
+			return nil, err
+		}
+		if previous != nil && previous.NextReviewAt.Before(assessment.NextReviewAt) {
+			assessment.NextReviewAt = previous.NextReviewAt
+		}
+	}
+
+	if err := repo.CreateRiskAssessment(ctx, assessment); err != nil {
+		return nil, err
+	}
+
+	open, err := repo.ListReviewTasks(ctx, models.ReviewTaskFilter{CustomerID: &customer.ID, Status: models.ReviewTaskStatusOpen})
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range open {
+		if task.DueAt.After(assessment.NextReviewAt) {
+			task.DueAt = assessment.NextReviewAt
+			if err := repo.UpdateReviewTask(ctx, task); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return assessment, nil
+}
+
+// profile gathers what the risk model scores for a customer
+func (a *RiskAssessor) profile(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer, now time.Time) (risk.Profile, error) {
+	profile := risk.Profile{DateOfBirth: customer.DateOfBirth}
+	if customer.IsBusiness() {
+		profile.Countries = append(profile.Countries, customer.Business.Jurisdiction)
+	}
+
+	addresses, err := repo.GetCustomerAddresses(ctx, customer.ID)
+	if err != nil {
+		return profile, err
+	}
+	for _, addr := range addresses {
+		if addr.ValidTo == nil || addr.ValidTo.After(now) {
+			profile.Countries = append(profile.Countries, addr.Country)
+		}
+	}
+
+	documents, err := repo.GetCustomerDocuments(ctx, customer.ID)
+	if err != nil {
+		return profile, err
+	}
+	for _, d := range documents {
+		profile.Documents = append(profile.Documents, risk.Document{
+			Type:       string(d.DocumentType),
+			Identity:   isIdentityDocument(d.DocumentType),
+			Status:     string(d.VerificationStatus),
+			ExpiryDate: d.ExpiryDate,
+			Country:    d.IssuingCountry,
+		})
+	}
+
+	hits, err := repo.ListScreeningHits(ctx, models.ScreeningHitFilter{CustomerID: &customer.ID})
+	if err != nil {
+		return profile, err
+	}
+	for _, h := range hits {
+		switch h.Status {
+		case models.ScreeningHitStatusPending:
+			profile.PendingScreeningHits++
+		case models.ScreeningHitStatusConfirmed:
+			profile.ConfirmedScreeningHits++
+		}
+	}
+
+	if a.products != nil {
+		products, err := a.products.CustomerProducts(ctx, customer.ID)
+		if err == nil {
+			profile.ProductsKnown = true
+			profile.Products = products
+		}
+	}
+
+	return profile, nil
+}
+
+// hasOverdueReview reports whether a customer has an open review task past
+// its due date
+func hasOverdueReview(ctx context.Context, repo repository.CustomerRepository, customerID uuid.UUID, now time.Time) (bool, error) {
+	tasks, err := repo.ListReviewTasks(ctx, models.ReviewTaskFilter{
+		CustomerID: &customerID,
+		Status:     models.ReviewTaskStatusOpen,
+		DueBefore:  &now,
+		Limit:      1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(tasks) > 0, nil
+}
+
+func riskAssessmentModelToProto(a *models.CustomerRiskAssessment) *customerpb.CustomerRiskAssessment {
+	if a == nil {
+		return nil
+	}
+	result := &customerpb.CustomerRiskAssessment{
+		Id:           a.ID.String(),
+		CustomerId:   a.CustomerID.String(),
+		Rating:       string(a.Rating),
+		Score:        int32(a.Score),
+		ModelVersion: a.ModelVersion,
+		Trigger:      string(a.Trigger),
+		AssessedAt:   timestamppb.New(a.AssessedAt),
+		NextReviewAt: timestamppb.New(a.NextReviewAt),
+	}
+	if a.AssessedBy != nil {
+		result.AssessedBy = a.AssessedBy.String()
+	}
+	for _, f := range a.Factors {
+		result.Factors = append(result.Factors, &customerpb.RiskFactor{
+			Code:        f.Code,
+			Description: f.Description,
+			Points:      int32(f.Points),
+		})
+	}
+	return result
+}
+
+func reviewTaskModelToProto(t *models.ReviewTask, now time.Time) *customerpb.ReviewTask {
+	result := &customerpb.ReviewTask{
+		Id:           t.ID.String(),
+		CustomerId:   t.CustomerID.String(),
+		AssessmentId: t.AssessmentID.String(),
+		Rating:       string(t.Rating),
+		DueAt:        timestamppb.New(t.DueAt),
+		Status:       string(t.Status),
+		Overdue:      t.IsOverdue(now),
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+	}
+	if t.CompletedAt != nil {
+		result.CompletedAt = timestamppb.New(*t.CompletedAt)
+	}
+	if t.CompletedBy != nil {
+		result.CompletedBy = t.CompletedBy.String()
+	}
+	if t.Notes != nil {
+		result.Notes = *t.Notes
+	}
+	return result
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/risk"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockProducts is a ProductDirectory with fixed holdings
+type mockProducts struct {
+	products map[uuid.UUID][]risk.Product
+	err      error
+}
+
+func (p *mockProducts) CustomerProducts(ctx context.Context, customerID uuid.UUID) ([]risk.Product, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.products[customerID], nil
+}
+
+func testAssessor(products ProductDirectory) *RiskAssessor {
+	return NewRiskAssessor(risk.NewModel(risk.DefaultConfig()), products)
+}
+
+func factorCodes(a *customerpb.CustomerRiskAssessment) []string {
+	var codes []string
+	for _, f := range a.GetFactors() {
+		codes = append(codes, f.GetCode())
+	}
+	return codes
+}
+
+func codesOf(factors models.RiskFactors) []string {
+	var codes []string
+	for _, f := range factors {
+		codes = append(codes, f.Code)
+	}
+	return codes
+}
+
+func TestCustomerService_CreateCustomer_AssessesRisk(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetRiskAssessor(testAssessor(nil))
+
+	resp := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01")
+	assessment := resp.GetRiskAssessment()
+	if assessment.GetRating() != string(models.RiskRatingLow) || assessment.GetScore() != 20 {
+		t.Fatalf("assessment = %v", assessment)
+	}
+	if codes := factorCodes(assessment); len(codes) != 1 || codes[0] != risk.FactorNoVerifiedIdentity {
+		t.Errorf("factors = %v", codes)
+	}
+	if assessment.GetTrigger() != string(models.RiskAssessmentTriggerOnboarding) || assessment.GetModelVersion() == "" {
+		t.Errorf("trigger = %s, model version = %q", assessment.GetTrigger(), assessment.GetModelVersion())
+	}
+	wantReview := time.Now().UTC().AddDate(3, 0, 0)
+	if got := assessment.GetNextReviewAt().AsTime(); got.Sub(wantReview).Abs() > time.Minute {
+		t.Errorf("next review = %v, want about %v", got, wantReview)
+	}
+}
+
+func TestCustomerService_RiskReassessedOnChanges(t *testing.T) {
+	repo := NewMockRepository()
+	products := &mockProducts{products: make(map[uuid.UUID][]risk.Product)}
+	svc := NewCustomerService(repo)
+	svc.SetRiskAssessor(testAssessor(products))
+	ctx := context.Background()
+
+	customer := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01").GetCustomer()
+	customerID := uuid.MustParse(customer.GetId())
+	products.products[customerID] = []risk.Product{{AccountType: "Checking", Role: "PrimaryHolder", Currency: "EUR"}}
+
+	_, err := svc.AddAddress(ctx, &customerpb.AddAddressRequest{
+		CustomerId:  customer.GetId(),
+		AddressType: "Physical",
+		Street1:     "1 Main Street",
+		City:        "Tehran",
+		State:       "Tehran",
+		PostalCode:  "11369",
+		Country:     "IR",
+	})
+	if err != nil {
+		t.Fatalf("AddAddress() error: %v", err)
+	}
+
+	history, err := svc.GetCustomerRiskHistory(ctx, &customerpb.GetCustomerRiskHistoryRequest{CustomerId: customer.GetId()})
+	if err != nil {
+		t.Fatalf("GetCustomerRiskHistory() error: %v", err)
+	}
+	if len(history.GetAssessments()) != 2 {
+		t.Fatalf("history has %d assessments, want 2", len(history.GetAssessments()))
+	}
+	latest, first := history.GetAssessments()[0], history.GetAssessments()[1]
+	if latest.GetRating() != string(models.RiskRatingHigh) || latest.GetScore() != 75 {
+		t.Errorf("latest = %s %d (%v), want High 75", latest.GetRating(), latest.GetScore(), factorCodes(latest))
+	}
+	if latest.GetTrigger() != string(models.RiskAssessmentTriggerUpdate) {
+		t.Errorf("trigger = %s, want Update", latest.GetTrigger())
+	}
+	// A higher rating brings the review forward; a lower one would not push it back
+	if !latest.GetNextReviewAt().AsTime().Before(first.GetNextReviewAt().AsTime()) {
+		t.Errorf("next review %v not brought forward from %v", latest.GetNextReviewAt().AsTime(), first.GetNextReviewAt().AsTime())
+	}
+
+	// Holdings are left out, not scored as none, when they cannot be looked up
+	products.err = errors.New("account-service unavailable")
+	manual, err := svc.AssessCustomerRisk(ctx, &customerpb.AssessCustomerRiskRequest{
+		CustomerId: customer.GetId(),
+		AssessedBy: uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("AssessCustomerRisk() error: %v", err)
+	}
+	if manual.GetAssessment().GetScore() != 60 || manual.GetAssessment().GetAssessedBy() == "" {
+		t.Errorf("manual assessment = %v", manual.GetAssessment())
+	}
+	if !manual.GetAssessment().GetNextReviewAt().AsTime().Equal(latest.GetNextReviewAt().AsTime()) {
+		t.Errorf("manual assessment moved next review to %v", manual.GetAssessment().GetNextReviewAt().AsTime())
+	}
+}
+
+func TestCustomerService_ConfirmedHitRatesHigh(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetScreener(testScreener(t))
+	svc.SetRiskAssessor(testAssessor(nil))
+	ctx := context.Background()
+
+	created := createScreenedCustomer(t, svc, "Ivan", "Petrov", "1971-03-14")
+	if !slices.Contains(factorCodes(created.GetRiskAssessment()), risk.FactorScreeningHitPending) {
+		t.Errorf("factors = %v, want pending screening hit", factorCodes(created.GetRiskAssessment()))
+	}
+
+	_, err := svc.ReviewScreeningHit(ctx, &customerpb.ReviewScreeningHitRequest{
+		HitId:      created.GetScreening().GetHits()[0].GetId(),
+		Decision:   string(models.ScreeningHitStatusConfirmed),
+		Notes:      "Matches passport",
+		ReviewedBy: uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("ReviewScreeningHit() error: %v", err)
+	}
+
+	latest, err := repo.GetLatestRiskAssessment(ctx, uuid.MustParse(created.GetCustomer().GetId()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.Rating != models.RiskRatingHigh || latest.Trigger != models.RiskAssessmentTriggerScreening ||
+		!slices.Contains(codesOf(latest.Factors), risk.FactorScreeningHitConfirmed) {
+		t.Errorf("after confirmation = %s (%s), want High (Screening)", latest.Rating, latest.Trigger)
+	}
+}
+
+func TestCustomerService_RiskDisabled(t *testing.T) {
+	svc := NewCustomerService(NewMockRepository())
+
+	resp := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01")
+	if resp.GetRiskAssessment() != nil {
+		t.Errorf("assessment = %v, want none", resp.GetRiskAssessment())
+	}
+
+	_, err := svc.AssessCustomerRisk(context.Background(), &customerpb.AssessCustomerRiskRequest{CustomerId: resp.GetCustomer().GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestReviewScheduler_RunOnce(t *testing.T) {
+	repo := NewMockRepository()
+	ctx := context.Background()
+	assessor := testAssessor(nil)
+	scheduler := NewReviewScheduler(repo, assessor, time.Hour, zerolog.Nop())
+
+	// Customers onboarded before risk rating was switched on
+	unrated := NewCustomerService(repo)
+	highRisk := uuid.MustParse(createScreenedCustomer(t, unrated, "Ali", "Karimi", "1980-02-02").GetCustomer().GetId())
+	lowRisk := uuid.MustParse(createScreenedCustomer(t, unrated, "Jane", "Doe", "1985-06-01").GetCustomer().GetId())
+	repo.addresses[highRisk] = []*models.Address{{ID: uuid.New(), CustomerID: highRisk, Country: "IR"}}
+	repo.customers[highRisk].Status = models.CustomerStatusActive
+	repo.customers[lowRisk].Status = models.CustomerStatusActive
+
+	now := time.Now().UTC()
+	run, err := scheduler.RunOnce(ctx, now)
+	if err != nil {
+		t.Fatalf("RunOnce() error: %v", err)
+	}
+	if run.Assessed != 2 || run.TasksRaised != 0 || run.Restricted != 0 {
+		t.Errorf("first run = %+v, want 2 assessed only", run)
+	}
+
+	// Thirteen months on the high-risk customer's review is overdue; the
+	// low-risk customer's is not yet within the notice period
+	later := now.AddDate(1, 1, 0)
+	run, err = scheduler.RunOnce(ctx, later)
+	if err != nil {
+		t.Fatalf("RunOnce() error: %v", err)
+	}
+	if run.Assessed != 0 || run.TasksRaised != 1 || run.Restricted != 1 {
+		t.Errorf("second run = %+v, want 1 task raised and 1 restricted", run)
+	}
+	if repo.customers[highRisk].Status != models.CustomerStatusRestricted {
+		t.Errorf("high-risk status = %s, want Restricted", repo.customers[highRisk].Status)
+	}
+	if repo.customers[lowRisk].Status != models.CustomerStatusActive {
+		t.Errorf("low-risk status = %s, want Active", repo.customers[lowRisk].Status)
+	}
+
+	// Nothing further to do while the task is open
+	run, err = scheduler.RunOnce(ctx, later)
+	if err != nil || run != (ReviewRun{}) {
+		t.Errorf("third run = %+v, %v; want nothing done", run, err)
+	}
+	if len(repo.reviewTasks) != 1 || repo.reviewTasks[0].CustomerID != highRisk {
+		t.Fatalf("review tasks = %v", repo.reviewTasks)
+	}
+}
+
+func TestCustomerService_CompleteReviewTask(t *testing.T) {
+	repo := NewMockRepository()
+	ctx := context.Background()
+	svc := NewCustomerService(repo)
+	svc.SetRiskAssessor(testAssessor(nil))
+
+	customerID := uuid.MustParse(createScreenedCustomer(t, svc, "Ali", "Karimi", "1980-02-02").GetCustomer().GetId())
+	repo.customers[customerID].Status = models.CustomerStatusRestricted
+	latest, _ := repo.GetLatestRiskAssessment(ctx, customerID)
+	latest.Rating = models.RiskRatingHigh
+	task := &models.ReviewTask{
+		ID:           uuid.New(),
+		CustomerID:   customerID,
+		AssessmentID: latest.ID,
+		Rating:       models.RiskRatingHigh,
+		DueAt:        time.Now().UTC().AddDate(0, 0, -3),
+		Status:       models.ReviewTaskStatusOpen,
+		CreatedAt:    time.Now().UTC().AddDate(0, -1, 0),
+	}
+	repo.reviewTasks = append(repo.reviewTasks, task)
+
+	// The restriction cannot be lifted by hand while the review is overdue
+	_, err := svc.UpdateCustomerStatus(ctx, &customerpb.UpdateCustomerStatusRequest{
+		Id:        customerID.String(),
+		NewStatus: string(models.CustomerStatusActive),
+		Reason:    "Customer called in",
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("activation with overdue review: got %v, want FailedPrecondition", err)
+	}
+
+	overdue, err := svc.ListReviewTasks(ctx, &customerpb.ListReviewTasksRequest{OverdueOnly: true})
+	if err != nil || len(overdue.GetTasks()) != 1 || !overdue.GetTasks()[0].GetOverdue() {
+		t.Fatalf("ListReviewTasks() = %v, %v", overdue, err)
+	}
+
+	reviewer := uuid.New()
+	resp, err := svc.CompleteReviewTask(ctx, &customerpb.CompleteReviewTaskRequest{
+		TaskId:      task.ID.String(),
+		CompletedBy: reviewer.String(),
+		Notes:       "Source of wealth documented",
+	})
+	if err != nil {
+		t.Fatalf("CompleteReviewTask() error: %v", err)
+	}
+	if resp.GetTask().GetStatus() != string(models.ReviewTaskStatusCompleted) || resp.GetTask().GetCompletedBy() != reviewer.String() {
+		t.Errorf("task = %v", resp.GetTask())
+	}
+	if resp.GetCustomer().GetStatus() != string(models.CustomerStatusActive) {
+		t.Errorf("customer status = %s, want Active", resp.GetCustomer().GetStatus())
+	}
+	assessment := resp.GetAssessment()
+	if assessment.GetTrigger() != string(models.RiskAssessmentTriggerReview) || assessment.GetAssessedBy() != reviewer.String() {
+		t.Errorf("assessment = %v", assessment)
+	}
+	// A completed review sets the next review from the new rating
+	wantReview := time.Now().UTC().AddDate(3, 0, 0)
+	if got := assessment.GetNextReviewAt().AsTime(); got.Sub(wantReview).Abs() > time.Minute {
+		t.Errorf("next review = %v, want about %v", got, wantReview)
+	}
+
+	_, err = svc.CompleteReviewTask(ctx, &customerpb.CompleteReviewTaskRequest{
+		TaskId:      task.ID.String(),
+		CompletedBy: reviewer.String(),
+		Notes:       "Again",
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("second completion: got %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/core-banking/services/customer-service/internal/screening"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetScreener screens customers against the sanctions lists when they are
+// created and when their name or date of birth changes. Screening is
+// disabled until it is set.
+func (s *CustomerService) SetScreener(screener *screening.Screener) {
+	s.screener = screener
+}
+
+// ScreenCustomer screens a customer against the sanctions lists now
+func (s *CustomerService) ScreenCustomer(ctx context.Context, req *customerpb.ScreenCustomerRequest) (*customerpb.ScreenCustomerResponse, error) {
+	if s.screener == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "sanctions screening is not configured")
+	}
+
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	var result *models.CustomerScreening
+	err = withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		customer, err := repo.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return err
+		}
+		result, err = screenCustomer(ctx, repo, s.screener, customer, models.ScreeningTriggerManual)
+		if err != nil || result == nil || len(result.Hits) == 0 {
+			return err
+		}
+		_, err = s.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerScreening, nil)
+		return err
+	})
+	if err == repository.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "customer not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to screen customer: %v", err)
+	}
+
+	return &customerpb.ScreenCustomerResponse{
+		Screening: screeningModelToProto(result),
+	}, nil
+}
+
+// GetCustomerScreening retrieves the latest screening of a customer
+func (s *CustomerService) GetCustomerScreening(ctx context.Context, req *customerpb.GetCustomerScreeningRequest) (*customerpb.GetCustomerScreeningResponse, error) {
+	customerID, err := parseCustomerID(req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.GetLatestCustomerScreening(ctx, customerID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "customer has not been screened")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer screening: %v", err)
+	}
+
+	return &customerpb.GetCustomerScreeningResponse{
+		Screening: screeningModelToProto(result),
+	}, nil
+}
+
+// ListScreeningHits lists potential sanctions matches, most recent first
+func (s *CustomerService) ListScreeningHits(ctx context.Context, req *customerpb.ListScreeningHitsRequest) (*customerpb.ListScreeningHitsResponse, error) {
+	if errs := s.validator.ValidateListScreeningHits(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	filter := models.ScreeningHitFilter{
+		Status: models.ScreeningHitStatus(req.GetStatus()),
+		Limit:  int(req.GetLimit()),
+	}
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+	if req.GetCustomerId() != "" {
+		customerID := uuid.MustParse(req.GetCustomerId())
+		filter.CustomerID = &customerID
+	}
+
+	hits, err := s.repo.ListScreeningHits(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list screening hits: %v", err)
+	}
+
+	protoHits := make([]*customerpb.ScreeningHit, len(hits))
+	for i, h := range hits {
+		protoHits[i] = screeningHitModelToProto(h)
+	}
+
+	return &customerpb.ListScreeningHitsResponse{
+		Hits: protoHits,
+	}, nil
+}
+
+// ReviewScreeningHit confirms or dismisses a potential sanctions match and
+// reassesses the customer's risk. Confirming a match suspends the customer if
+// they are active or restricted.
+func (s *CustomerService) ReviewScreeningHit(ctx context.Context, req *customerpb.ReviewScreeningHitRequest) (*customerpb.ReviewScreeningHitResponse, error) {
+	if errs := s.validator.ValidateReviewScreeningHit(req); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", errs)
+	}
+
+	hitID := uuid.MustParse(req.GetHitId())
+	reviewedBy := uuid.MustParse(req.GetReviewedBy())
+	decision := models.ScreeningHitStatus(req.GetDecision())
+
+	var hit *models.ScreeningHit
+	var customer *models.Customer
+	err := withTx(ctx, s.repo, func(repo repository.CustomerRepository) error {
+		var err error
+		if hit, err = repo.GetScreeningHit(ctx, hitID); err != nil {
+			return err
+		}
+		if err := hit.Review(decision, reviewedBy, req.GetNotes(), time.Now().UTC()); err != nil {
+			return err
+		}
+		if err := repo.UpdateScreeningHit(ctx, hit); err != nil {
+			return err
+		}
+
+		if customer, err = repo.GetCustomerByID(ctx, hit.CustomerID); err != nil {
+			return err
+		}
+		if _, err := s.assessor.assess(ctx, repo, customer, models.RiskAssessmentTriggerScreening, nil); err != nil {
+			return err
+		}
+		if decision == models.ScreeningHitStatusConfirmed &&
+			(customer.Status == models.CustomerStatusActive || customer.Status == models.CustomerStatusInactive ||
+				customer.Status == models.CustomerStatusRestricted) {
+			_, err := changeStatus(ctx, repo, customer, models.CustomerStatusSuspended, "Sanctions match confirmed", &reviewedBy, time.Now().UTC())
+			return err
+		}
+		return nil
+	})
+	switch {
+	case err == repository.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "screening hit not found")
+	case err == models.ErrHitReviewed:
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case err != nil:
+		if _, ok := err.(*repository.ErrOptimisticLock); ok {
+			return nil, status.Errorf(codes.Aborted, "customer was modified by another process")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to review screening hit: %v", err)
+	}
+
+	return &customerpb.ReviewScreeningHitResponse{
+		Hit:      screeningHitModelToProto(hit),
+		Customer: modelToProto(customer),
+	}, nil
+}
+
+// screenCustomer screens a customer against the loaded lists and records the
+// result. A match already raised for the same entry and the same name and
+// date of birth is not raised again, so a dismissed hit stays dismissed until
+// the customer's details change. Returns nil when screening is disabled.
+func screenCustomer(ctx context.Context, repo repository.CustomerRepository, screener *screening.Screener, customer *models.Customer, trigger models.ScreeningTrigger) (*models.CustomerScreening, error) {
+	if screener == nil {
+		return nil, nil
+	}
+	lists := screener.Lists()
+	if len(lists) == 0 {
+		return nil, nil
+	}
+
+	subject, err := screeningSubject(ctx, repo, customer)
+	if err != nil {
+		return nil, err
+	}
+	matches := screener.Screen(subject)
+
+	existing, err := repo.ListScreeningHits(ctx, models.ScreeningHitFilter{CustomerID: &customer.ID})
+	if err != nil {
+		return nil, err
+	}
+	described := describeSubject(subject)
+
+	result := &models.CustomerScreening{
+		ID:         uuid.New(),
+		CustomerID: customer.ID,
+		Trigger:    trigger,
+		Outcome:    models.ScreeningOutcomeClear,
+		ScreenedAt: time.Now().UTC(),
+	}
+	for _, list := range lists {
+		result.ListVersions = append(result.ListVersions, list.Name+":"+list.Version)
+	}
+
+	for _, match := range matches {
+		previous := findHit(existing, match, described)
+		if previous != nil && previous.Status == models.ScreeningHitStatusDismissed {
+			continue
+		}
+		result.Outcome = models.ScreeningOutcomePotentialMatch
+		if previous != nil {
+			// Already awaiting review or confirmed
+			continue
+		}
+		result.Hits = append(result.Hits, &models.ScreeningHit{
+			ID:           uuid.New(),
+			ListName:     match.ListName,
+			ListVersion:  match.ListVersion,
+			EntryID:      match.EntryID,
+			EntryName:    match.EntryName,
+			MatchedName:  match.MatchedName,
+			Subject:      described,
+			Score:        match.Score,
+			NameScore:    match.NameScore,
+			DOBMatch:     string(match.DOB),
+			CountryMatch: match.CountryMatch,
+			Status:       models.ScreeningHitStatusPending,
+		})
+	}
+
+	if err := repo.CreateCustomerScreening(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// screeningSubject gathers what is screened for a customer: their full name,
+// date of birth, and the countries of their addresses and documents, and of
+// registration for a business
+func screeningSubject(ctx context.Context, repo repository.CustomerRepository, customer *models.Customer) (screening.Subject, error) {
+	subject := screening.Subject{
+		Name:        fullName(customer),
+		DateOfBirth: customer.DateOfBirth,
+	}
+	if customer.IsBusiness() {
+		subject.Countries = append(subject.Countries, customer.Business.Jurisdiction)
+	}
+
+	addresses, err := repo.GetCustomerAddresses(ctx, customer.ID)
+	if err != nil {
+		return subject, err
+	}
+	for _, a := range addresses {
+		subject.Countries = append(subject.Countries, a.Country)
+	}
+
+	documents, err := repo.GetCustomerDocuments(ctx, customer.ID)
+	if err != nil {
+		return subject, err
+	}
+	for _, d := range documents {
+		subject.Countries = append(subject.Countries, d.IssuingCountry)
+	}
+
+	return subject, nil
+}
+
+// fullName is an individual's full name or a business's registered name
+func fullName(c *models.Customer) string {
+	if c.IsBusiness() {
+		return c.Business.RegisteredName
+	}
+	parts := []string{c.FirstName}
+	if c.MiddleName != nil && *c.MiddleName != "" {
+		parts = append(parts, *c.MiddleName)
+	}
+	return strings.Join(append(parts, c.LastName), " ")
+}
+
+// screenedDetails describes the customer details screening depends on
+func screenedDetails(c *models.Customer) string {
+	return describeSubject(screening.Subject{Name: fullName(c), DateOfBirth: c.DateOfBirth})
+}
+
+func describeSubject(subject screening.Subject) string {
+	if subject.DateOfBirth.IsZero() {
+		return subject.Name
+	}
+	return fmt.Sprintf("%s, born %s", subject.Name, subject.DateOfBirth.Format("2006-01-02"))
+}
+
+func findHit(hits []*models.ScreeningHit, match screening.Match, subject string) *models.ScreeningHit {
+	for _, h := range hits {
+		if h.ListName == match.ListName && h.EntryID == match.EntryID && h.Subject == subject {
+			return h
+		}
+	}
+	return nil
+}
+
+// hasBlockingHits reports whether a customer has potential matches pending
+// review or confirmed, which keep them from being activated
+func hasBlockingHits(ctx context.Context, repo repository.CustomerRepository, customerID uuid.UUID) (bool, error) {
+	hits, err := repo.ListScreeningHits(ctx, models.ScreeningHitFilter{CustomerID: &customerID})
+	if err != nil {
+		return false, err
+	}
+	for _, h := range hits {
+		if h.Status.BlocksActivation() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// withTx runs fn with a repository bound to a new transaction, committing
+// if fn succeeds
+func withTx(ctx context.Context, repo repository.CustomerRepository, fn func(repository.CustomerRepository) error) error {
+	tx, err := repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx.CustomerRepository()); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func parseCustomerID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "customer_id is required")
+	}
+	customerID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid customer id: %v", err)
+	}
+	return customerID, nil
+}
+
+func screeningModelToProto(sc *models.CustomerScreening) *customerpb.CustomerScreening {
+	if sc == nil {
+		return nil
+	}
+	result := &customerpb.CustomerScreening{
+		Id:           sc.ID.String(),
+		CustomerId:   sc.CustomerID.String(),
+		Trigger:      string(sc.Trigger),
+		Outcome:      string(sc.Outcome),
+		ListVersions: sc.ListVersions,
+		ScreenedAt:   timestamppb.New(sc.ScreenedAt),
+	}
+	for _, h := range sc.Hits {
+		result.Hits = append(result.Hits, screeningHitModelToProto(h))
+	}
+	return result
+}
+
+func screeningHitModelToProto(h *models.ScreeningHit) *customerpb.ScreeningHit {
+	hit := &customerpb.ScreeningHit{
+		Id:           h.ID.String(),
+		ScreeningId:  h.ScreeningID.String(),
+		CustomerId:   h.CustomerID.String(),
+		ListName:     h.ListName,
+		ListVersion:  h.ListVersion,
+		EntryId:      h.EntryID,
+		EntryName:    h.EntryName,
+		MatchedName:  h.MatchedName,
+		Subject:      h.Subject,
+		Score:        h.Score,
+		NameScore:    h.NameScore,
+		DobMatch:     h.DOBMatch,
+		CountryMatch: h.CountryMatch,
+		Status:       string(h.Status),
+		CreatedAt:    timestamppb.New(h.CreatedAt),
+	}
+
+	if h.ReviewedBy != nil {
+		hit.ReviewedBy = h.ReviewedBy.String()
+	}
+	if h.ReviewedAt != nil {
+		hit.ReviewedAt = timestamppb.New(*h.ReviewedAt)
+	}
+	if h.ReviewNotes != nil {
+		hit.ReviewNotes = *h.ReviewNotes
+	}
+
+	return hit
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/repository"
+	"github.com/core-banking/services/customer-service/internal/screening"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// rescreenBatchSize is how many customers are read at a time when
+// re-screening
+const rescreenBatchSize = 500
+
+// SanctionsListJob keeps the screener's lists in step with the list files.
+// Each time it runs it reloads the files; when a file holds a version of a
+// list no customer has yet been screened against, it re-screens every
+// customer who is not closed.
+//
+// A version is marked re-screened only once every customer has been screened
+// against it, so a run that fails part way is repeated in full next time.
+type SanctionsListJob struct {
+	repo     repository.CustomerRepository
+	screener *screening.Screener
+	sources  []screening.Source
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewSanctionsListJob creates a new SanctionsListJob
+func NewSanctionsListJob(repo repository.CustomerRepository, screener *screening.Screener, sources []screening.Source, interval time.Duration, log zerolog.Logger) *SanctionsListJob {
+	return &SanctionsListJob{
+		repo:     repo,
+		screener: screener,
+		sources:  sources,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Load reads the list files into the screener without recording or
+// re-screening, so screening can start before the first refresh
+func (j *SanctionsListJob) Load() error {
+	lists, err := screening.LoadLists(j.sources)
+	if err != nil {
+		return err
+	}
+	j.screener.SetLists(lists)
+	return nil
+}
+
+// Run refreshes the lists straight away and then every interval until the
+// context is cancelled
+func (j *SanctionsListJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.Refresh(ctx); err != nil && ctx.Err() == nil {
+			j.log.Error().Err(err).Msg("Sanctions list refresh failed")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh loads the list files, screening against them from then on, and
+// re-screens every customer if any list has a new version. It returns how
+// many customers were re-screened. Lists that fail to load leave the lists
+// already loaded in place.
+func (j *SanctionsListJob) Refresh(ctx context.Context) (int, error) {
+	lists, err := screening.LoadLists(j.sources)
+	if err != nil {
+		return 0, err
+	}
+
+	var pending []*models.SanctionsList
+	for i, list := range lists {
+		record := &models.SanctionsList{
+			ListName:   list.Name,
+			Version:    list.Version,
+			EntryCount: len(list.Entries),
+			SourceFile: j.sources[i].Path,
+		}
+		if !list.PublishedAt.IsZero() {
+			publishedAt := list.PublishedAt
+			record.PublishedAt = &publishedAt
+		}
+		if err := j.repo.RecordSanctionsList(ctx, record); err != nil {
+			return 0, err
+		}
+		if record.RescreenedAt == nil {
+			pending = append(pending, record)
+		}
+	}
+	j.screener.SetLists(lists)
+
+	if len(pending) == 0 {
+		return 0, nil
+	}
+	for _, record := range pending {
+		j.log.Info().
+			Str("list", record.ListName).
+			Str("version", record.Version).
+			Int("entries", record.EntryCount).
+			Msg("New sanctions list version loaded, re-screening customers")
+	}
+
+	screened, err := j.rescreenAll(ctx)
+	if err != nil {
+		return screened, err
+	}
+
+	now := time.Now().UTC()
+	for _, record := range pending {
+		if err := j.repo.MarkSanctionsListRescreened(ctx, record.ListName, record.Version, now); err != nil {
+			return screened, err
+		}
+	}
+	j.log.Info().Int("customers", screened).Msg("Re-screening against new sanctions lists complete")
+	return screened, nil
+}
+
+// rescreenAll screens every customer who is not closed. A failure on one
+// customer does not stop the others.
+func (j *SanctionsListJob) rescreenAll(ctx context.Context) (int, error) {
+	screened := 0
+	var failures []error
+	after := uuid.Nil
+	for {
+		customers, err := j.repo.ListCustomersForScreening(ctx, after, rescreenBatchSize)
+		if err != nil {
+			return screened, err
+		}
+		if len(customers) == 0 {
+			break
+		}
+
+		for _, customer := range customers {
+			if err := ctx.Err(); err != nil {
+				return screened, err
+			}
+			err := withTx(ctx, j.repo, func(repo repository.CustomerRepository) error {
+				_, err := screenCustomer(ctx, repo, j.screener, customer, models.ScreeningTriggerListUpdate)
+				return err
+			})
+			if err != nil {
+				failures = append(failures, fmt.Errorf("customer %s: %w", customer.ID, err))
+				continue
+			}
+			screened++
+		}
+		after = customers[len(customers)-1].ID
+	}
+
+	if len(failures) > 0 {
+		return screened, fmt.Errorf("re-screening failed for %d customers: %w", len(failures), errors.Join(failures...))
+	}
+	return screened, nil
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/core-banking/services/customer-service/internal/models"
+	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/core-banking/services/customer-service/internal/screening"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testSanctionsSources() []screening.Source {
+	return []screening.Source{{Name: "TEST", Path: filepath.Join("..", "screening", "testdata", "sdn.csv")}}
+}
+
+func testScreener(t *testing.T) *screening.Screener {
+	t.Helper()
+	lists, err := screening.LoadLists(testSanctionsSources())
+	if err != nil {
+		t.Fatal(err)
+	}
+	screener := screening.NewScreener(screening.DefaultConfig())
+	screener.SetLists(lists)
+	return screener
+}
+
+func createScreenedCustomer(t *testing.T, svc *CustomerService, first, last, dob string) *customerpb.CreateCustomerResponse {
+	t.Helper()
+	born, err := time.Parse("2006-01-02", dob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := svc.CreateCustomer(context.Background(), &customerpb.CreateCustomerRequest{
+		FirstName:   first,
+		LastName:    last,
+		Email:       "customer@example.com",
+		Phone:       "+441234567890",
+		DateOfBirth: timestamppb.New(born),
+		TaxId:       "AB12345678",
+		CreatedBy:   uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomer() error: %v", err)
+	}
+	return resp
+}
+
+func TestCustomerService_CreateCustomer_Screening(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetScreener(testScreener(t))
+
+	t.Run("clear", func(t *testing.T) {
+		resp := createScreenedCustomer(t, svc, "Jane", "Doe", "1985-06-01")
+		if resp.GetScreening().GetOutcome() != string(models.ScreeningOutcomeClear) {
+			t.Errorf("outcome = %s, want Clear", resp.GetScreening().GetOutcome())
+		}
+		if resp.GetScreening().GetTrigger() != string(models.ScreeningTriggerOnboarding) {
+			t.Errorf("trigger = %s, want Onboarding", resp.GetScreening().GetTrigger())
+		}
+		if len(resp.GetScreening().GetListVersions()) != 1 {
+			t.Errorf("list versions = %v", resp.GetScreening().GetListVersions())
+		}
+	})
+
+	t.Run("potential match", func(t *testing.T) {
+		resp := createScreenedCustomer(t, svc, "Ivan", "Petrov", "1971-03-14")
+		if resp.GetScreening().GetOutcome() != string(models.ScreeningOutcomePotentialMatch) {
+			t.Fatalf("outcome = %s, want PotentialMatch", resp.GetScreening().GetOutcome())
+		}
+		hits := resp.GetScreening().GetHits()
+		if len(hits) != 1 || hits[0].GetEntryId() != "101" || hits[0].GetStatus() != "Pending" {
+			t.Fatalf("hits = %v", hits)
+		}
+		if hits[0].GetSubject() != "Ivan Petrov, born 1971-03-14" {
+			t.Errorf("subject = %q", hits[0].GetSubject())
+		}
+	})
+}
+
+func TestCustomerService_ScreeningBlocksActivation(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetScreener(testScreener(t))
+	ctx := context.Background()
+
+	customer := createScreenedCustomer(t, svc, "Ivan", "Petrov", "1971-03-14").GetCustomer()
+	activate := &customerpb.UpdateCustomerStatusRequest{
+		Id:        customer.GetId(),
+		NewStatus: string(models.CustomerStatusActive),
+		Reason:    "Identity verified",
+	}
+
+	_, err := svc.UpdateCustomerStatus(ctx, activate)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("activation with pending hit: got %v, want FailedPrecondition", err)
+	}
+
+	hits, err := svc.ListScreeningHits(ctx, &customerpb.ListScreeningHitsRequest{
+		CustomerId: customer.GetId(),
+		Status:     string(models.ScreeningHitStatusPending),
+	})
+	if err != nil || len(hits.GetHits()) != 1 {
+		t.Fatalf("ListScreeningHits() = %v, %v", hits, err)
+	}
+
+	review, err := svc.ReviewScreeningHit(ctx, &customerpb.ReviewScreeningHitRequest{
+		HitId:      hits.GetHits()[0].GetId(),
+		Decision:   string(models.ScreeningHitStatusDismissed),
+		Notes:      "Different person: passport shows other place of birth",
+		ReviewedBy: uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("ReviewScreeningHit() error: %v", err)
+	}
+	if review.GetHit().GetStatus() != string(models.ScreeningHitStatusDismissed) || review.GetHit().GetReviewedBy() == "" {
+		t.Errorf("reviewed hit = %v", review.GetHit())
+	}
+
+	if _, err := svc.UpdateCustomerStatus(ctx, activate); err != nil {
+		t.Fatalf("activation after dismissal: %v", err)
+	}
+
+	// A dismissed hit is not raised again for the same details
+	rescreen, err := svc.ScreenCustomer(ctx, &customerpb.ScreenCustomerRequest{CustomerId: customer.GetId()})
+	if err != nil {
+		t.Fatalf("ScreenCustomer() error: %v", err)
+	}
+	if rescreen.GetScreening().GetOutcome() != string(models.ScreeningOutcomeClear) || len(rescreen.GetScreening().GetHits()) != 0 {
+		t.Errorf("rescreen = %v, want clear", rescreen.GetScreening())
+	}
+
+	// Reviewing again is refused
+	_, err = svc.ReviewScreeningHit(ctx, &customerpb.ReviewScreeningHitRequest{
+		HitId:      hits.GetHits()[0].GetId(),
+		Decision:   string(models.ScreeningHitStatusConfirmed),
+		Notes:      "Second look",
+		ReviewedBy: uuid.New().String(),
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("second review: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestCustomerService_ReviewScreeningHit_ConfirmSuspends(t *testing.T) {
+	repo := NewMockRepository()
+	screener := testScreener(t)
+	ctx := context.Background()
+
+	// Onboarded before the lists were loaded
+	created := createScreenedCustomer(t, NewCustomerService(repo), "Ivan", "Petrov", "1971-03-14")
+	customerID := uuid.MustParse(created.GetCustomer().GetId())
+	repo.customers[customerID].Status = models.CustomerStatusActive
+
+	svc := NewCustomerService(repo)
+	svc.SetScreener(screener)
+	screened, err := svc.ScreenCustomer(ctx, &customerpb.ScreenCustomerRequest{CustomerId: customerID.String()})
+	if err != nil {
+		t.Fatalf("ScreenCustomer() error: %v", err)
+	}
+	if len(screened.GetScreening().GetHits()) != 1 {
+		t.Fatalf("hits = %v", screened.GetScreening().GetHits())
+	}
+
+	review, err := svc.ReviewScreeningHit(ctx, &customerpb.ReviewScreeningHitRequest{
+		HitId:      screened.GetScreening().GetHits()[0].GetId(),
+		Decision:   string(models.ScreeningHitStatusConfirmed),
+		Notes:      "Matches passport and nationality",
+		ReviewedBy: uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("ReviewScreeningHit() error: %v", err)
+	}
+	if review.GetCustomer().GetStatus() != string(models.CustomerStatusSuspended) {
+		t.Errorf("customer status = %s, want Suspended", review.GetCustomer().GetStatus())
+	}
+
+	latest, err := svc.GetCustomerScreening(ctx, &customerpb.GetCustomerScreeningRequest{CustomerId: customerID.String()})
+	if err != nil || latest.GetScreening().GetTrigger() != string(models.ScreeningTriggerManual) {
+		t.Errorf("GetCustomerScreening() = %v, %v", latest, err)
+	}
+}
+
+func TestCustomerService_UpdateCustomer_Rescreens(t *testing.T) {
+	repo := NewMockRepository()
+	svc := NewCustomerService(repo)
+	svc.SetScreener(testScreener(t))
+	ctx := context.Background()
+
+	customer := createScreenedCustomer(t, svc, "Ivan", "Peters", "1971-03-14").GetCustomer()
+
+	resp, err := svc.UpdateCustomer(ctx, &customerpb.UpdateCustomerRequest{Id: customer.GetId(), Email: "ivan@example.com", Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateCustomer() error: %v", err)
+	}
+	if resp.GetScreening() != nil {
+		t.Errorf("email change screened: %v", resp.GetScreening())
+	}
+
+	resp, err = svc.UpdateCustomer(ctx, &customerpb.UpdateCustomerRequest{Id: customer.GetId(), LastName: "Petrov", Version: 2})
+	if err != nil {
+		t.Fatalf("UpdateCustomer() error: %v", err)
+	}
+	if resp.GetScreening().GetTrigger() != string(models.ScreeningTriggerUpdate) ||
+		resp.GetScreening().GetOutcome() != string(models.ScreeningOutcomePotentialMatch) {
+		t.Errorf("name change screening = %v", resp.GetScreening())
+	}
+}
+
+func TestCustomerService_ScreenCustomer_Disabled(t *testing.T) {
+	svc := NewCustomerService(NewMockRepository())
+	_, err := svc.ScreenCustomer(context.Background(), &customerpb.ScreenCustomerRequest{CustomerId: uuid.New().String()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestSanctionsListJob_Refresh(t *testing.T) {
+	repo := NewMockRepository()
+	ctx := context.Background()
+
+	// Customers onboarded before screening was switched on
+	unscreened := NewCustomerService(repo)
+	createScreenedCustomer(t, unscreened, "Ivan", "Petrov", "1971-03-14")
+	createScreenedCustomer(t, unscreened, "Jane", "Doe", "1985-06-01")
+	closed := createScreenedCustomer(t, unscreened, "Hans", "Muller", "1958-01-20")
+	repo.customers[uuid.MustParse(closed.GetCustomer().GetId())].Status = models.CustomerStatusClosed
+
+	screener := screening.NewScreener(screening.DefaultConfig())
+	job := NewSanctionsListJob(repo, screener, testSanctionsSources(), time.Hour, zerolog.Nop())
+
+	screened, err := job.Refresh(ctx)
+	if err != nil {
+		t.Fatalf("Refresh() error: %v", err)
+	}
+	if screened != 2 {
+		t.Errorf("screened %d customers, want 2", screened)
+	}
+	if len(screener.Lists()) != 1 {
+		t.Errorf("screener has %d lists, want 1", len(screener.Lists()))
+	}
+	if len(repo.hits) != 1 || repo.hits[0].EntryID != "101" {
+		t.Errorf("hits = %v", repo.hits)
+	}
+	for _, sc := range repo.screenings {
+		if sc.Trigger != models.ScreeningTriggerListUpdate {
+			t.Errorf("trigger = %s, want ListUpdate", sc.Trigger)
+		}
+	}
+	for key, list := range repo.sanctionsLists {
+		if list.RescreenedAt == nil {
+			t.Errorf("%s not marked re-screened", key)
+		}
+	}
+
+	// The same version is not screened against twice
+	screened, err = job.Refresh(ctx)
+	if err != nil || screened != 0 {
+		t.Errorf("second Refresh() = %d, %v; want 0, nil", screened, err)
+	}
+}
@@ -0,0 +1,147 @@
+// Package storage keeps binary objects such as document scans outside the
+// database. Stores hold what they are given as is; callers encrypt anything
+// sensitive before storing it.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ErrNotFound is returned when no blob is stored under a key
+var ErrNotFound = errors.New("blob not found")
+
+// ErrExists is returned when storing a blob under a key already in use
+var ErrExists = errors.New("blob already exists")
+
+// ErrInvalidKey is returned for keys that are not slash-separated segments
+// of letters, digits, dots, dashes and underscores
+var ErrInvalidKey = errors.New("invalid blob key")
+
+var keySegment = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`)
+
+// BlobStore stores blobs under keys. Blobs are written once: a key cannot be
+// reused until its blob is deleted.
+type BlobStore interface {
+	// Put stores the content read from r under key and returns its size.
+	// Nothing is stored if reading or writing fails part way.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	// Get opens the blob stored under key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob stored under key; a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// LocalStore is a BlobStore keeping each blob in a file under a root
+// directory, with the key as its relative path
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore opens the store at root, creating the directory
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create blob store: %w", err)
+	}
+	return &LocalStore{root: root}, nil
+}
+
+// Put writes the blob under a hidden temporary name and then links it into
+// place, so a blob is never seen half written
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return 0, fmt.Errorf("%w: %s", ErrExists, key)
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return 0, fmt.Errorf("failed to store %s: %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, contextReader{ctx, r})
+	if err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	// Link rather than rename so a blob stored concurrently under the same
+	// key is never replaced
+	if err := os.Link(tmp.Name(), path); err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return 0, fmt.Errorf("%w: %s", ErrExists, key)
+		}
+		return 0, fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	return n, nil
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to open %s: %w", key, err)
+	}
+	return f, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// path maps a key to its file, rejecting keys that could escape the root
+func (s *LocalStore) path(key string) (string, error) {
+	segments := strings.Split(key, "/")
+	for _, segment := range segments {
+		if !keySegment.MatchString(segment) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return filepath.Join(append([]string{s.root}, segments...)...), nil
+}
+
+// contextReader stops reading once the context is cancelled
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
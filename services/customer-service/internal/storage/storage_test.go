@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocalStore(filepath.Join(root, "blobs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	key := "documents/c1/f1"
+
+	n, err := store.Put(ctx, key, strings.NewReader("sealed scan"))
+	if err != nil || n != 11 {
+		t.Fatalf("Put() = %d, %v", n, err)
+	}
+	if _, err := store.Put(ctx, key, strings.NewReader("again")); !errors.Is(err, ErrExists) {
+		t.Errorf("Put() existing key: got %v, want ErrExists", err)
+	}
+
+	r, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "sealed scan" {
+		t.Errorf("Get() = %q", data)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error: %v", err)
+	}
+	if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() deleted: got %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete() missing: %v", err)
+	}
+
+	// No temporary files are left behind
+	entries, _ := os.ReadDir(filepath.Join(root, "blobs", "documents", "c1"))
+	if len(entries) != 0 {
+		t.Errorf("left behind %v", entries)
+	}
+}
+
+func TestLocalStore_FailedPut(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	failing := io.MultiReader(strings.NewReader("partial"), errReader{errors.New("client went away")})
+	if _, err := store.Put(ctx, "documents/c1/f2", failing); err == nil {
+		t.Fatal("Put() with failing reader: want error")
+	}
+	if _, err := store.Get(ctx, "documents/c1/f2"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("partial blob stored: %v", err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := store.Put(cancelled, "documents/c1/f3", strings.NewReader("data")); !errors.Is(err, context.Canceled) {
+		t.Errorf("Put() cancelled: got %v", err)
+	}
+}
+
+func TestLocalStore_InvalidKeys(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"", "../escape", "documents/../../etc/passwd", "/absolute", "documents//f1", ".hidden", "documents/f 1"} {
+		if _, err := store.Put(context.Background(), key, strings.NewReader("x")); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q): got %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
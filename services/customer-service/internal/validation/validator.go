@@ -3,10 +3,15 @@ package validation
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
+	"github.com/core-banking/services/customer-service/internal/export"
 	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/phone"
+	"github.com/core-banking/services/customer-service/internal/postal"
 	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
+	"github.com/google/uuid"
 )
 
 // ValidationError represents a validation error with field details
@@ -22,6 +27,15 @@ func (e *ValidationError) Error() string {
 // ValidationErrors represents a collection of validation errors
 type ValidationErrors []ValidationError
 
+func (e ValidationErrors) hasField(field string) bool {
+	for _, err := range e {
+		if err.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
 func (e ValidationErrors) Error() string {
 	if len(e) == 0 {
 		return ""
@@ -39,9 +53,6 @@ func (e ValidationErrors) Error() string {
 // EmailRegex validates email format
 var EmailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 
-// PhoneRegex validates international phone format
-var PhoneRegex = regexp.MustCompile(`^\+?[1-9]\d{1,14}$`)
-
 // TaxIDRegexes for different countries
 var (
 	USSSNRegex      = regexp.MustCompile(`^\d{3}-\d{2}-\d{4}$`)
@@ -49,12 +60,42 @@ var (
 	EUTaxIDRegex    = regexp.MustCompile(`^[A-Z]{2}\d{8,12}$`)
 )
 
+// CountryCodeRegex validates an ISO 3166-1 alpha-2 country code
+var CountryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// LEIRegex validates the format of a Legal Entity Identifier
+var LEIRegex = regexp.MustCompile(`^[0-9A-Z]{18}[0-9]{2}$`)
+
+// IndustryCodeRegex validates an industry classification code such as NACE
+// or SIC
+var IndustryCodeRegex = regexp.MustCompile(`^[0-9A-Za-z.]{1,10}$`)
+
+// SHA256Regex validates a hex-encoded SHA-256 digest
+var SHA256Regex = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
 // Validator provides validation methods for customer data
-type Validator struct{}
+type Validator struct {
+	addresses *postal.Config
+	phones    *phone.Config
+}
 
-// NewValidator creates a new Validator instance
+// NewValidator creates a new Validator instance, checking addresses under
+// the generic address rules alone and phone numbers without numbering plans
 func NewValidator() *Validator {
-	return &Validator{}
+	return NewValidatorWithRules(nil, nil)
+}
+
+// NewValidatorWithRules creates a Validator that checks addresses under the
+// given per-country rules and phone numbers under the given numbering plans.
+// A nil config leaves the built-in one in place.
+func NewValidatorWithRules(addresses *postal.Config, phones *phone.Config) *Validator {
+	if addresses == nil {
+		addresses = postal.DefaultConfig()
+	}
+	if phones == nil {
+		phones = phone.DefaultConfig()
+	}
+	return &Validator{addresses: addresses, phones: phones}
 }
 
 // ValidateCustomerCreate validates customer creation data
@@ -62,16 +103,63 @@ func (v *Validator) ValidateCustomerCreate(req *customerpb.CreateCustomerRequest
 	var errs ValidationErrors
 
 	// Required fields validation
-	if req.GetFirstName() == "" {
-		errs = append(errs, ValidationError{Field: "first_name", Message: "is required"})
-	} else if len(req.GetFirstName()) < 2 || len(req.GetFirstName()) > 100 {
-		errs = append(errs, ValidationError{Field: "first_name", Message: "must be between 2 and 100 characters"})
+	customerType := models.CustomerTypeIndividual
+	if req.GetCustomerType() != "" {
+		customerType = models.CustomerType(req.GetCustomerType())
 	}
 
-	if req.GetLastName() == "" {
-		errs = append(errs, ValidationError{Field: "last_name", Message: "is required"})
-	} else if len(req.GetLastName()) < 2 || len(req.GetLastName()) > 100 {
-		errs = append(errs, ValidationError{Field: "last_name", Message: "must be between 2 and 100 characters"})
+	switch customerType {
+	case models.CustomerTypeIndividual:
+		if req.GetBusiness() != nil {
+			errs = append(errs, ValidationError{Field: "business", Message: "must not be set for individuals"})
+		}
+
+		if req.GetFirstName() == "" {
+			errs = append(errs, ValidationError{Field: "first_name", Message: "is required"})
+		} else if len(req.GetFirstName()) < 2 || len(req.GetFirstName()) > 100 {
+			errs = append(errs, ValidationError{Field: "first_name", Message: "must be between 2 and 100 characters"})
+		}
+
+		if req.GetLastName() == "" {
+			errs = append(errs, ValidationError{Field: "last_name", Message: "is required"})
+		} else if len(req.GetLastName()) < 2 || len(req.GetLastName()) > 100 {
+			errs = append(errs, ValidationError{Field: "last_name", Message: "must be between 2 and 100 characters"})
+		}
+
+		if req.GetDateOfBirth() == nil {
+			errs = append(errs, ValidationError{Field: "date_of_birth", Message: "is required"})
+		} else {
+			dob := req.GetDateOfBirth().AsTime()
+			if dob.After(time.Now().AddDate(-18, 0, 0)) {
+				errs = append(errs, ValidationError{Field: "date_of_birth", Message: "customer must be at least 18 years old"})
+			}
+			if dob.Before(time.Now().AddDate(-150, 0, 0)) {
+				errs = append(errs, ValidationError{Field: "date_of_birth", Message: "date of birth is too far in the past"})
+			}
+		}
+	case models.CustomerTypeBusiness:
+		// A business is known by its registration details alone
+		personal := []struct {
+			field string
+			set   bool
+		}{
+			{"first_name", req.GetFirstName() != ""},
+			{"middle_name", req.GetMiddleName() != ""},
+			{"last_name", req.GetLastName() != ""},
+			{"date_of_birth", req.GetDateOfBirth() != nil},
+		}
+		for _, p := range personal {
+			if p.set {
+				errs = append(errs, ValidationError{Field: p.field, Message: "must not be set for businesses"})
+			}
+		}
+		if req.GetBusiness() == nil {
+			errs = append(errs, ValidationError{Field: "business", Message: "is required for businesses"})
+		} else {
+			errs = append(errs, v.ValidateBusinessDetails(req.GetBusiness())...)
+		}
+	default:
+		errs = append(errs, ValidationError{Field: "customer_type", Message: "must be Individual or Business"})
 	}
 
 	if req.GetEmail() == "" {
@@ -80,19 +168,10 @@ func (v *Validator) ValidateCustomerCreate(req *customerpb.CreateCustomerRequest
 		errs = append(errs, ValidationError{Field: "email", Message: "is invalid format"})
 	}
 
-	if req.GetPhone() != "" && !PhoneRegex.MatchString(req.GetPhone()) {
-		errs = append(errs, ValidationError{Field: "phone", Message: "is invalid format. Use international format e.g., +1234567890"})
-	}
-
-	if req.GetDateOfBirth() == nil {
-		errs = append(errs, ValidationError{Field: "date_of_birth", Message: "is required"})
-	} else {
-		dob := req.GetDateOfBirth().AsTime()
-		if dob.After(time.Now().AddDate(-18, 0, 0)) {
-			errs = append(errs, ValidationError{Field: "date_of_birth", Message: "customer must be at least 18 years old"})
-		}
-		if dob.Before(time.Now().AddDate(-150, 0, 0)) {
-			errs = append(errs, ValidationError{Field: "date_of_birth", Message: "date of birth is too far in the past"})
+	// Individuals have no address yet to read a national number in
+	if req.GetPhone() != "" {
+		if _, err := v.ParsePhone(req.GetPhone(), req.GetBusiness().GetJurisdiction()); err != nil {
+			errs = append(errs, *err)
 		}
 	}
 
@@ -102,6 +181,10 @@ func (v *Validator) ValidateCustomerCreate(req *customerpb.CreateCustomerRequest
 		}
 	}
 
+	if len(req.GetDuplicateOverrideReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "duplicate_override_reason", Message: "must not exceed 500 characters"})
+	}
+
 	return errs
 }
 
@@ -129,8 +212,11 @@ func (v *Validator) ValidateCustomerUpdate(req *customerpb.UpdateCustomerRequest
 		errs = append(errs, ValidationError{Field: "email", Message: "is invalid format"})
 	}
 
-	if req.GetPhone() != "" && !PhoneRegex.MatchString(req.GetPhone()) {
-		errs = append(errs, ValidationError{Field: "phone", Message: "is invalid format. Use international format e.g., +1234567890"})
+	// The number itself is parsed once the customer's country is known
+	if req.GetPhone() != "" {
+		if digits, ok := phone.Digits(req.GetPhone()); !ok || digits == "" {
+			errs = append(errs, ValidationError{Field: "phone", Message: "is not a phone number"})
+		}
 	}
 
 	if req.GetDateOfBirth() != nil {
@@ -146,9 +232,71 @@ func (v *Validator) ValidateCustomerUpdate(req *customerpb.UpdateCustomerRequest
 		}
 	}
 
+	if req.GetBusiness() != nil {
+		errs = append(errs, v.ValidateBusinessDetails(req.GetBusiness())...)
+	}
+
 	return errs
 }
 
+// ValidateBusinessDetails validates the registration details of a business
+// customer
+func (v *Validator) ValidateBusinessDetails(b *customerpb.BusinessDetails) ValidationErrors {
+	var errs ValidationErrors
+
+	if b.GetRegisteredName() == "" {
+		errs = append(errs, ValidationError{Field: "business.registered_name", Message: "is required"})
+	} else if len(b.GetRegisteredName()) > 200 {
+		errs = append(errs, ValidationError{Field: "business.registered_name", Message: "must not exceed 200 characters"})
+	}
+
+	if b.GetRegistrationNumber() == "" {
+		errs = append(errs, ValidationError{Field: "business.registration_number", Message: "is required"})
+	} else if len(b.GetRegistrationNumber()) > 50 {
+		errs = append(errs, ValidationError{Field: "business.registration_number", Message: "must not exceed 50 characters"})
+	}
+
+	if b.GetJurisdiction() == "" {
+		errs = append(errs, ValidationError{Field: "business.jurisdiction", Message: "is required"})
+	} else if !CountryCodeRegex.MatchString(b.GetJurisdiction()) {
+		errs = append(errs, ValidationError{Field: "business.jurisdiction", Message: "must be a 2-letter ISO country code"})
+	}
+
+	if b.GetLei() != "" && !ValidLEI(b.GetLei()) {
+		errs = append(errs, ValidationError{Field: "business.lei", Message: "must be a valid 20-character LEI"})
+	}
+
+	if b.GetIncorporationDate() == nil {
+		errs = append(errs, ValidationError{Field: "business.incorporation_date", Message: "is required"})
+	} else if b.GetIncorporationDate().AsTime().After(time.Now()) {
+		errs = append(errs, ValidationError{Field: "business.incorporation_date", Message: "must not be in the future"})
+	}
+
+	if b.GetIndustryCode() != "" && !IndustryCodeRegex.MatchString(b.GetIndustryCode()) {
+		errs = append(errs, ValidationError{Field: "business.industry_code", Message: "must be up to 10 letters, digits or dots"})
+	}
+
+	return errs
+}
+
+// ValidLEI reports whether lei is a Legal Entity Identifier with a correct
+// ISO 17442 check: 18 letters or digits followed by two check digits, which
+// make the whole, read with letters as 10 to 35, leave 1 when divided by 97
+func ValidLEI(lei string) bool {
+	if !LEIRegex.MatchString(lei) {
+		return false
+	}
+	remainder := 0
+	for _, r := range lei {
+		if r >= 'A' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder == 1
+}
+
 // ValidateTaxID validates tax ID format based on country
 func (v *Validator) ValidateTaxID(taxID, country string) *ValidationError {
 	// Basic format validation - alphanumeric with possible hyphens
@@ -189,38 +337,102 @@ func (v *Validator) ValidateAddress(req *customerpb.AddAddressRequest) Validatio
 		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
 	}
 
-	if req.GetStreet1() == "" {
-		errs = append(errs, ValidationError{Field: "street1", Message: "is required"})
-	} else if len(req.GetStreet1()) > 200 {
-		errs = append(errs, ValidationError{Field: "street1", Message: "must not exceed 200 characters"})
+	errs = append(errs, v.validateAddressFields(req.GetAddressType(), postal.Address{
+		Street1:    req.GetStreet1(),
+		Street2:    req.GetStreet2(),
+		City:       req.GetCity(),
+		State:      req.GetState(),
+		PostalCode: req.GetPostalCode(),
+		Country:    req.GetCountry(),
+	})...)
+
+	return errs
+}
+
+// ValidateUpdateAddress validates a request to change an address
+func (v *Validator) ValidateUpdateAddress(req *customerpb.UpdateAddressRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	errs = append(errs, validateAddressID(req.GetAddressId())...)
+	errs = append(errs, v.validateAddressFields(req.GetAddressType(), postal.Address{
+		Street1:    req.GetStreet1(),
+		Street2:    req.GetStreet2(),
+		City:       req.GetCity(),
+		State:      req.GetState(),
+		PostalCode: req.GetPostalCode(),
+		Country:    req.GetCountry(),
+	})...)
+
+	if req.GetEffectiveFrom() != nil && req.GetEffectiveFrom().AsTime().After(time.Now()) {
+		errs = append(errs, ValidationError{Field: "effective_from", Message: "must not be in the future"})
 	}
 
-	if req.GetCity() == "" {
-		errs = append(errs, ValidationError{Field: "city", Message: "is required"})
-	} else if len(req.GetCity()) > 100 {
-		errs = append(errs, ValidationError{Field: "city", Message: "must not exceed 100 characters"})
+	return errs
+}
+
+// ValidateSetPrimaryAddress validates a request to make an address primary
+func (v *Validator) ValidateSetPrimaryAddress(req *customerpb.SetPrimaryAddressRequest) ValidationErrors {
+	errs := validateAddressID(req.GetAddressId())
+
+	if req.GetEffectiveFrom() != nil && req.GetEffectiveFrom().AsTime().After(time.Now()) {
+		errs = append(errs, ValidationError{Field: "effective_from", Message: "must not be in the future"})
 	}
 
-	if req.GetState() == "" {
-		errs = append(errs, ValidationError{Field: "state", Message: "is required"})
-	} else if len(req.GetState()) > 100 {
-		errs = append(errs, ValidationError{Field: "state", Message: "must not exceed 100 characters"})
+	return errs
+}
+
+// ValidateEndDateAddress validates a request to close an address
+func (v *Validator) ValidateEndDateAddress(req *customerpb.EndDateAddressRequest) ValidationErrors {
+	return validateAddressID(req.GetAddressId())
+}
+
+func validateAddressID(id string) ValidationErrors {
+	if id == "" {
+		return ValidationErrors{{Field: "address_id", Message: "is required"}}
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return ValidationErrors{{Field: "address_id", Message: "must be a valid UUID"}}
 	}
+	return nil
+}
+
+// validateAddressFields checks the lengths of an address's fields, then the
+// rules of its country for fields that passed
+func (v *Validator) validateAddressFields(addressType string, a postal.Address) ValidationErrors {
+	var errs ValidationErrors
 
-	if req.GetPostalCode() == "" {
-		errs = append(errs, ValidationError{Field: "postal_code", Message: "is required"})
-	} else if len(req.GetPostalCode()) > 20 {
-		errs = append(errs, ValidationError{Field: "postal_code", Message: "must not exceed 20 characters"})
+	limits := []struct {
+		field string
+		value string
+		max   int
+	}{
+		{"street1", a.Street1, 200},
+		{"street2", a.Street2, 200},
+		{"city", a.City, 100},
+		{"state", a.State, 100},
+		{"postal_code", a.PostalCode, 20},
+	}
+	for _, l := range limits {
+		if len(l.value) > l.max {
+			errs = append(errs, ValidationError{Field: l.field, Message: fmt.Sprintf("must not exceed %d characters", l.max)})
+		}
 	}
 
-	if req.GetCountry() == "" {
+	if a.Country == "" {
 		errs = append(errs, ValidationError{Field: "country", Message: "is required"})
-	} else if len(req.GetCountry()) != 2 {
+	} else if !CountryCodeRegex.MatchString(strings.ToUpper(a.Country)) {
 		errs = append(errs, ValidationError{Field: "country", Message: "must be a 2-letter ISO country code"})
+	} else {
+		_, problems := v.addresses.Normalize(a)
+		for _, p := range problems {
+			if !p.Warning && !errs.hasField(string(p.Field)) {
+				errs = append(errs, ValidationError{Field: string(p.Field), Message: p.Message})
+			}
+		}
 	}
 
-	if req.GetAddressType() != "" {
-		addrType := models.AddressType(req.GetAddressType())
+	if addressType != "" {
+		addrType := models.AddressType(addressType)
 		if !addrType.IsValid() {
 			errs = append(errs, ValidationError{Field: "address_type", Message: "must be Physical, Mailing, or Business"})
 		}
@@ -229,6 +441,32 @@ func (v *Validator) ValidateAddress(req *customerpb.AddAddressRequest) Validatio
 	return errs
 }
 
+// NormalizeAddress puts a validated address into the canonical form of its
+// country's rules, with warnings about what those rules could not check
+func (v *Validator) NormalizeAddress(a postal.Address) (postal.Address, []string) {
+	normalized, problems := v.addresses.Normalize(a)
+	var warnings []string
+	for _, p := range problems {
+		if p.Warning {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", p.Field, p.Message))
+		}
+	}
+	return normalized, warnings
+}
+
+// ParsePhone parses a phone number under the numbering plans, reading one in
+// national format as a number of defaultRegion
+func (v *Validator) ParsePhone(input, defaultRegion string) (phone.Number, *ValidationError) {
+	if len(input) > 50 {
+		return phone.Number{}, &ValidationError{Field: "phone", Message: "must not exceed 50 characters"}
+	}
+	number, err := v.phones.Parse(input, defaultRegion)
+	if err != nil {
+		return phone.Number{}, &ValidationError{Field: "phone", Message: err.Error()}
+	}
+	return number, nil
+}
+
 // ValidateDocument validates document data
 func (v *Validator) ValidateDocument(req *customerpb.AddDocumentRequest) ValidationErrors {
 	var errs ValidationErrors
@@ -288,11 +526,12 @@ func (v *Validator) ValidateDocument(req *customerpb.AddDocumentRequest) Validat
 func (v *Validator) ValidateStatusTransition(currentStatus, newStatus models.CustomerStatus) error {
 	// Define valid status transitions
 	validTransitions := map[models.CustomerStatus][]models.CustomerStatus{
-		models.CustomerStatusPending:   {models.CustomerStatusActive, models.CustomerStatusClosed},
-		models.CustomerStatusActive:    {models.CustomerStatusInactive, models.CustomerStatusSuspended, models.CustomerStatusClosed},
-		models.CustomerStatusInactive:  {models.CustomerStatusActive, models.CustomerStatusSuspended, models.CustomerStatusClosed},
-		models.CustomerStatusSuspended: {models.CustomerStatusActive, models.CustomerStatusInactive, models.CustomerStatusClosed},
-		models.CustomerStatusClosed:    {}, // No transitions from Closed
+		models.CustomerStatusPending:    {models.CustomerStatusActive, models.CustomerStatusClosed},
+		models.CustomerStatusActive:     {models.CustomerStatusInactive, models.CustomerStatusSuspended, models.CustomerStatusClosed, models.CustomerStatusRestricted},
+		models.CustomerStatusInactive:   {models.CustomerStatusActive, models.CustomerStatusSuspended, models.CustomerStatusClosed, models.CustomerStatusRestricted},
+		models.CustomerStatusSuspended:  {models.CustomerStatusActive, models.CustomerStatusInactive, models.CustomerStatusClosed},
+		models.CustomerStatusRestricted: {models.CustomerStatusActive, models.CustomerStatusSuspended, models.CustomerStatusClosed},
+		models.CustomerStatusClosed:     {}, // No transitions from Closed
 	}
 
 	allowedTransitions, exists := validTransitions[currentStatus]
@@ -335,8 +574,563 @@ func (v *Validator) ValidateSearchFilters(req *customerpb.SearchCustomersRequest
 		errs = append(errs, ValidationError{Field: "email", Message: "is invalid format"})
 	}
 
-	if req.GetPhone() != "" && !PhoneRegex.MatchString(req.GetPhone()) {
-		errs = append(errs, ValidationError{Field: "phone", Message: "is invalid format"})
+	if req.GetPhone() != "" {
+		if digits, ok := phone.Digits(req.GetPhone()); !ok || len(digits) < 3 {
+			errs = append(errs, ValidationError{Field: "phone", Message: "must be at least 3 digits of a phone number"})
+		}
+	}
+
+	if req.GetPhoneCountry() != "" && !CountryCodeRegex.MatchString(strings.ToUpper(req.GetPhoneCountry())) {
+		errs = append(errs, ValidationError{Field: "phone_country", Message: "must be an ISO 3166-1 alpha-2 code"})
+	}
+
+	return errs
+}
+
+// ValidateListScreeningHits validates screening hit list parameters
+func (v *Validator) ValidateListScreeningHits(req *customerpb.ListScreeningHitsRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() != "" {
+		if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+			errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+		}
+	}
+
+	if req.GetStatus() != "" && !models.ScreeningHitStatus(req.GetStatus()).IsValid() {
+		errs = append(errs, ValidationError{Field: "status", Message: "must be Pending, Confirmed or Dismissed"})
+	}
+
+	if req.GetLimit() < 0 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must be non-negative"})
+	}
+	if req.GetLimit() > 100 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must not exceed 100"})
+	}
+
+	return errs
+}
+
+// ValidateReviewScreeningHit validates a screening hit review
+func (v *Validator) ValidateReviewScreeningHit(req *customerpb.ReviewScreeningHitRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetHitId() == "" {
+		errs = append(errs, ValidationError{Field: "hit_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetHitId()); err != nil {
+		errs = append(errs, ValidationError{Field: "hit_id", Message: "must be a valid UUID"})
+	}
+
+	switch models.ScreeningHitStatus(req.GetDecision()) {
+	case models.ScreeningHitStatusConfirmed, models.ScreeningHitStatusDismissed:
+	case "":
+		errs = append(errs, ValidationError{Field: "decision", Message: "is required"})
+	default:
+		errs = append(errs, ValidationError{Field: "decision", Message: "must be Confirmed or Dismissed"})
+	}
+
+	if req.GetNotes() == "" {
+		errs = append(errs, ValidationError{Field: "notes", Message: "is required"})
+	} else if len(req.GetNotes()) > 2000 {
+		errs = append(errs, ValidationError{Field: "notes", Message: "must not exceed 2000 characters"})
+	}
+
+	if req.GetReviewedBy() == "" {
+		errs = append(errs, ValidationError{Field: "reviewed_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetReviewedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "reviewed_by", Message: "must be a valid UUID"})
+	}
+
+	return errs
+}
+
+// ValidateGetCustomerRiskHistory validates a risk history request
+func (v *Validator) ValidateGetCustomerRiskHistory(req *customerpb.GetCustomerRiskHistoryRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetLimit() < 0 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must be non-negative"})
+	}
+	if req.GetLimit() > 100 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must not exceed 100"})
+	}
+
+	return errs
+}
+
+// ValidateListReviewTasks validates review task list filters
+func (v *Validator) ValidateListReviewTasks(req *customerpb.ListReviewTasksRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() != "" {
+		if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+			errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+		}
+	}
+
+	if req.GetStatus() != "" && !models.ReviewTaskStatus(req.GetStatus()).IsValid() {
+		errs = append(errs, ValidationError{Field: "status", Message: "must be Open or Completed"})
+	}
+	if req.GetOverdueOnly() && models.ReviewTaskStatus(req.GetStatus()) == models.ReviewTaskStatusCompleted {
+		errs = append(errs, ValidationError{Field: "overdue_only", Message: "applies only to open tasks"})
+	}
+
+	if req.GetLimit() < 0 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must be non-negative"})
+	}
+	if req.GetLimit() > 100 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must not exceed 100"})
+	}
+
+	return errs
+}
+
+// ValidateCompleteReviewTask validates a review task completion
+func (v *Validator) ValidateCompleteReviewTask(req *customerpb.CompleteReviewTaskRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetTaskId() == "" {
+		errs = append(errs, ValidationError{Field: "task_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetTaskId()); err != nil {
+		errs = append(errs, ValidationError{Field: "task_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetNotes() == "" {
+		errs = append(errs, ValidationError{Field: "notes", Message: "is required"})
+	} else if len(req.GetNotes()) > 2000 {
+		errs = append(errs, ValidationError{Field: "notes", Message: "must not exceed 2000 characters"})
+	}
+
+	if req.GetCompletedBy() == "" {
+		errs = append(errs, ValidationError{Field: "completed_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCompletedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "completed_by", Message: "must be a valid UUID"})
+	}
+
+	return errs
+}
+
+// ValidateDocumentFileHeader validates the header of a document file upload
+func (v *Validator) ValidateDocumentFileHeader(header *customerpb.DocumentFileHeader) ValidationErrors {
+	var errs ValidationErrors
+
+	if header.GetDocumentId() == "" {
+		errs = append(errs, ValidationError{Field: "document_id", Message: "is required"})
+	} else if _, err := uuid.Parse(header.GetDocumentId()); err != nil {
+		errs = append(errs, ValidationError{Field: "document_id", Message: "must be a valid UUID"})
+	}
+
+	if header.GetFileName() == "" {
+		errs = append(errs, ValidationError{Field: "file_name", Message: "is required"})
+	} else if len(header.GetFileName()) > 255 {
+		errs = append(errs, ValidationError{Field: "file_name", Message: "must not exceed 255 characters"})
+	} else if strings.ContainsAny(header.GetFileName(), "/\\\x00") {
+		errs = append(errs, ValidationError{Field: "file_name", Message: "must not contain path separators"})
+	}
+
+	if header.GetUploadedBy() == "" {
+		errs = append(errs, ValidationError{Field: "uploaded_by", Message: "is required"})
+	} else if _, err := uuid.Parse(header.GetUploadedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "uploaded_by", Message: "must be a valid UUID"})
+	}
+
+	if header.GetSha256() != "" && !SHA256Regex.MatchString(header.GetSha256()) {
+		errs = append(errs, ValidationError{Field: "sha256", Message: "must be a hex-encoded SHA-256 digest"})
+	}
+
+	return errs
+}
+
+// ValidateDownloadDocumentFile validates a request to download a document file
+func (v *Validator) ValidateDownloadDocumentFile(req *customerpb.DownloadDocumentFileRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetFileId() == "" {
+		errs = append(errs, ValidationError{Field: "file_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetFileId()); err != nil {
+		errs = append(errs, ValidationError{Field: "file_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetRequestedBy() == "" {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetRequestedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "must be a valid UUID"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	return errs
+}
+
+// ValidateEraseCustomer validates a request to erase a customer
+func (v *Validator) ValidateEraseCustomer(req *customerpb.EraseCustomerRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetRequestedBy() == "" {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetRequestedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "must be a valid UUID"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	return errs
+}
+
+// ValidatePlaceLegalHold validates a request to place a legal hold
+func (v *Validator) ValidatePlaceLegalHold(req *customerpb.PlaceLegalHoldRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetPlacedBy() == "" {
+		errs = append(errs, ValidationError{Field: "placed_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetPlacedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "placed_by", Message: "must be a valid UUID"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	return errs
+}
+
+// ValidateReleaseLegalHold validates a request to release a legal hold
+func (v *Validator) ValidateReleaseLegalHold(req *customerpb.ReleaseLegalHoldRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetHoldId() == "" {
+		errs = append(errs, ValidationError{Field: "hold_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetHoldId()); err != nil {
+		errs = append(errs, ValidationError{Field: "hold_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetReleasedBy() == "" {
+		errs = append(errs, ValidationError{Field: "released_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetReleasedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "released_by", Message: "must be a valid UUID"})
+	}
+
+	return errs
+}
+
+// ValidateExportCustomerData validates a request to export a customer's data
+func (v *Validator) ValidateExportCustomerData(req *customerpb.ExportCustomerDataRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetRequestedBy() == "" {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetRequestedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "must be a valid UUID"})
+	}
+
+	return errs
+}
+
+// ValidateDownloadDataExport validates a request to download a data export
+func (v *Validator) ValidateDownloadDataExport(req *customerpb.DownloadDataExportRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetExportId() == "" {
+		errs = append(errs, ValidationError{Field: "export_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetExportId()); err != nil {
+		errs = append(errs, ValidationError{Field: "export_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetFormat() == "" {
+		errs = append(errs, ValidationError{Field: "format", Message: "is required"})
+	} else if _, err := export.ParseFormat(req.GetFormat()); err != nil {
+		errs = append(errs, ValidationError{Field: "format", Message: "must be json, html or pdf"})
+	}
+
+	if req.GetRequestedBy() == "" {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetRequestedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "requested_by", Message: "must be a valid UUID"})
+	}
+
+	return errs
+}
+
+// ValidateMergeCustomers validates a request to merge two customers
+func (v *Validator) ValidateMergeCustomers(req *customerpb.MergeCustomersRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetDuplicateId() == "" {
+		errs = append(errs, ValidationError{Field: "duplicate_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetDuplicateId()); err != nil {
+		errs = append(errs, ValidationError{Field: "duplicate_id", Message: "must be a valid UUID"})
+	} else if req.GetDuplicateId() == req.GetCustomerId() {
+		errs = append(errs, ValidationError{Field: "duplicate_id", Message: "must differ from customer_id"})
+	}
+
+	if req.GetSurvivorId() != "" && req.GetSurvivorId() != req.GetCustomerId() && req.GetSurvivorId() != req.GetDuplicateId() {
+		errs = append(errs, ValidationError{Field: "survivor_id", Message: "must be customer_id or duplicate_id"})
+	}
+
+	if req.GetMergedBy() == "" {
+		errs = append(errs, ValidationError{Field: "merged_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetMergedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "merged_by", Message: "must be a valid UUID"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	return errs
+}
+
+// ValidateUnmergeCustomers validates a request to reverse a merge
+func (v *Validator) ValidateUnmergeCustomers(req *customerpb.UnmergeCustomersRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetMergeId() == "" {
+		errs = append(errs, ValidationError{Field: "merge_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetMergeId()); err != nil {
+		errs = append(errs, ValidationError{Field: "merge_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetUnmergedBy() == "" {
+		errs = append(errs, ValidationError{Field: "unmerged_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetUnmergedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "unmerged_by", Message: "must be a valid UUID"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	return errs
+}
+
+// ValidateFindPotentialDuplicates validates a duplicate search. It needs a
+// customer or at least a name to compare with.
+func (v *Validator) ValidateFindPotentialDuplicates(req *customerpb.FindPotentialDuplicatesRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() != "" {
+		if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+			errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+		}
+	} else {
+		if req.GetFirstName() == "" {
+			errs = append(errs, ValidationError{Field: "first_name", Message: "is required without customer_id"})
+		}
+		if req.GetLastName() == "" {
+			errs = append(errs, ValidationError{Field: "last_name", Message: "is required without customer_id"})
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > 100 {
+		errs = append(errs, ValidationError{Field: "limit", Message: "must be between 0 and 100"})
+	}
+
+	return errs
+}
+
+// ValidateAddCustomerRelationship validates a request to link an individual
+// to a business. Only beneficial owners hold a share of the business.
+func (v *Validator) ValidateAddCustomerRelationship(req *customerpb.AddCustomerRelationshipRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetBusinessId() == "" {
+		errs = append(errs, ValidationError{Field: "business_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetBusinessId()); err != nil {
+		errs = append(errs, ValidationError{Field: "business_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetIndividualId() == "" {
+		errs = append(errs, ValidationError{Field: "individual_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetIndividualId()); err != nil {
+		errs = append(errs, ValidationError{Field: "individual_id", Message: "must be a valid UUID"})
+	} else if req.GetIndividualId() == req.GetBusinessId() {
+		errs = append(errs, ValidationError{Field: "individual_id", Message: "must differ from business_id"})
+	}
+
+	role := models.RelationshipRole(req.GetRole())
+	if req.GetRole() == "" {
+		errs = append(errs, ValidationError{Field: "role", Message: "is required"})
+	} else if !role.IsValid() {
+		errs = append(errs, ValidationError{Field: "role", Message: "must be Director, AuthorisedSignatory or BeneficialOwner"})
+	} else if role == models.RelationshipRoleBeneficialOwner {
+		if req.GetOwnershipPercentage() <= 0 || req.GetOwnershipPercentage() > 100 {
+			errs = append(errs, ValidationError{Field: "ownership_percentage", Message: "must be greater than 0 and at most 100"})
+		}
+	} else if req.GetOwnershipPercentage() != 0 {
+		errs = append(errs, ValidationError{Field: "ownership_percentage", Message: "is only held by beneficial owners"})
+	}
+
+	if req.GetCreatedBy() == "" {
+		errs = append(errs, ValidationError{Field: "created_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCreatedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "created_by", Message: "must be a valid UUID"})
+	}
+
+	return errs
+}
+
+// ValidateEndCustomerRelationship validates a request to end an individual's
+// role in a business
+func (v *Validator) ValidateEndCustomerRelationship(req *customerpb.EndCustomerRelationshipRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetRelationshipId() == "" {
+		errs = append(errs, ValidationError{Field: "relationship_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetRelationshipId()); err != nil {
+		errs = append(errs, ValidationError{Field: "relationship_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetEndedBy() == "" {
+		errs = append(errs, ValidationError{Field: "ended_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetEndedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "ended_by", Message: "must be a valid UUID"})
+	}
+
+	if req.GetReason() == "" {
+		errs = append(errs, ValidationError{Field: "reason", Message: "is required"})
+	} else if len(req.GetReason()) > 500 {
+		errs = append(errs, ValidationError{Field: "reason", Message: "must not exceed 500 characters"})
+	}
+
+	return errs
+}
+
+// ValidateGrantConsent validates a request to record a consent. Data sharing
+// is not tied to a channel, so is consented to on All.
+func (v *Validator) ValidateGrantConsent(req *customerpb.GrantConsentRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	errs = append(errs, validateConsentScope(req.GetPurpose(), req.GetChannel())...)
+
+	if req.GetPolicyVersion() == "" {
+		errs = append(errs, ValidationError{Field: "policy_version", Message: "is required"})
+	} else if len(req.GetPolicyVersion()) > 50 {
+		errs = append(errs, ValidationError{Field: "policy_version", Message: "must not exceed 50 characters"})
+	}
+
+	if req.GetSource() == "" {
+		errs = append(errs, ValidationError{Field: "source", Message: "is required"})
+	} else if !models.ConsentSource(req.GetSource()).IsValid() {
+		errs = append(errs, ValidationError{Field: "source", Message: "must be Web, MobileApp, Branch, CallCentre or Paper"})
+	}
+
+	if req.GetGrantedBy() == "" {
+		errs = append(errs, ValidationError{Field: "granted_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetGrantedBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "granted_by", Message: "must be a valid UUID"})
+	}
+
+	if req.GetGrantedAt() != nil && req.GetGrantedAt().AsTime().After(time.Now()) {
+		errs = append(errs, ValidationError{Field: "granted_at", Message: "must not be in the future"})
+	}
+
+	return errs
+}
+
+// ValidateWithdrawConsent validates a request to withdraw a consent
+func (v *Validator) ValidateWithdrawConsent(req *customerpb.WithdrawConsentRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetConsentId() == "" {
+		errs = append(errs, ValidationError{Field: "consent_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetConsentId()); err != nil {
+		errs = append(errs, ValidationError{Field: "consent_id", Message: "must be a valid UUID"})
+	}
+
+	if req.GetSource() == "" {
+		errs = append(errs, ValidationError{Field: "source", Message: "is required"})
+	} else if !models.ConsentSource(req.GetSource()).IsValid() {
+		errs = append(errs, ValidationError{Field: "source", Message: "must be Web, MobileApp, Branch, CallCentre or Paper"})
+	}
+
+	if req.GetWithdrawnBy() == "" {
+		errs = append(errs, ValidationError{Field: "withdrawn_by", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetWithdrawnBy()); err != nil {
+		errs = append(errs, ValidationError{Field: "withdrawn_by", Message: "must be a valid UUID"})
+	}
+
+	return errs
+}
+
+// ValidateCheckConsent validates a consent check
+func (v *Validator) ValidateCheckConsent(req *customerpb.CheckConsentRequest) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.GetCustomerId() == "" {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "is required"})
+	} else if _, err := uuid.Parse(req.GetCustomerId()); err != nil {
+		errs = append(errs, ValidationError{Field: "customer_id", Message: "must be a valid UUID"})
+	}
+
+	return append(errs, validateConsentScope(req.GetPurpose(), req.GetChannel())...)
+}
+
+// validateConsentScope validates the purpose and channel of a consent
+func validateConsentScope(purpose, channel string) ValidationErrors {
+	var errs ValidationErrors
+
+	if purpose == "" {
+		errs = append(errs, ValidationError{Field: "purpose", Message: "is required"})
+	} else if !models.ConsentPurpose(purpose).IsValid() {
+		errs = append(errs, ValidationError{Field: "purpose", Message: "must be Marketing, DataSharing or ServiceMessages"})
+	}
+
+	if channel == "" {
+		errs = append(errs, ValidationError{Field: "channel", Message: "is required"})
+	} else if !models.ConsentChannel(channel).IsValid() {
+		errs = append(errs, ValidationError{Field: "channel", Message: "must be Email, SMS, Phone, Post, Push or All"})
+	} else if models.ConsentPurpose(purpose) == models.ConsentPurposeDataSharing && models.ConsentChannel(channel) != models.ConsentChannelAll {
+		errs = append(errs, ValidationError{Field: "channel", Message: "must be All for data sharing"})
 	}
 
 	return errs
@@ -1,10 +1,14 @@
 package validation
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/core-banking/services/customer-service/internal/models"
+	"github.com/core-banking/services/customer-service/internal/phone"
+	"github.com/core-banking/services/customer-service/internal/postal"
 	customerpb "github.com/core-banking/services/customer-service/internal/proto/customerpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -185,6 +189,108 @@ func TestValidateAddress(t *testing.T) {
 	}
 }
 
+func TestValidateAddress_CountryRules(t *testing.T) {
+	rules, err := postal.LoadConfig(filepath.Join("..", "..", "config", "address_rules.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := NewValidatorWithRules(rules, nil)
+
+	tests := []struct {
+		name       string
+		req        *customerpb.AddAddressRequest
+		wantFields []string
+	}{
+		{
+			name: "valid UK address without a county",
+			req:  &customerpb.AddAddressRequest{CustomerId: "customer-uuid", Street1: "10 Downing Street", City: "London", PostalCode: "sw1a 2aa", Country: "GB"},
+		},
+		{
+			name:       "US address with a bad ZIP and state",
+			req:        &customerpb.AddAddressRequest{CustomerId: "customer-uuid", Street1: "123 Main St", City: "New York", State: "New Yrok", PostalCode: "1001", Country: "US"},
+			wantFields: []string{"state", "postal_code"},
+		},
+		{
+			name:       "overlong postal code reported once",
+			req:        &customerpb.AddAddressRequest{CustomerId: "customer-uuid", Street1: "123 Main St", City: "New York", State: "NY", PostalCode: strings.Repeat("1", 21), Country: "US"},
+			wantFields: []string{"postal_code"},
+		},
+		{
+			name: "unknown country under generic rules",
+			req:  &customerpb.AddAddressRequest{CustomerId: "customer-uuid", Street1: "Rua Augusta 100", City: "Lisboa", Country: "PT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields []string
+			for _, e := range validator.ValidateAddress(tt.req) {
+				fields = append(fields, e.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("ValidateAddress() error fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+
+	normalized, warnings := validator.NormalizeAddress(postal.Address{Street1: "Rua Augusta 100", City: "Lisboa", Country: "pt"})
+	if normalized.Country != "PT" || len(warnings) != 1 || !strings.HasPrefix(warnings[0], "country: ") {
+		t.Errorf("NormalizeAddress() = %+v, %v; want PT with a warning about the country", normalized, warnings)
+	}
+	normalized, warnings = validator.NormalizeAddress(postal.Address{Street1: "10 Downing Street", City: "London", PostalCode: "sw1a2aa", Country: "GB"})
+	if normalized.PostalCode != "SW1A 2AA" || len(warnings) != 0 {
+		t.Errorf("NormalizeAddress() = %+v, %v; want the canonical postcode", normalized, warnings)
+	}
+}
+
+func TestValidateUpdateAddress(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.UpdateAddressRequest {
+		return &customerpb.UpdateAddressRequest{
+			AddressId:     "6f1c2f8e-3b0a-4d7e-9a51-0c2f6b1d9e10",
+			Street1:       "1 New Street",
+			City:          "Leeds",
+			State:         "West Yorkshire",
+			PostalCode:    "LS1 4AP",
+			Country:       "GB",
+			EffectiveFrom: timestamppb.New(time.Now().AddDate(0, 0, -7)),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(*customerpb.UpdateAddressRequest)
+		wantField string
+	}{
+		{name: "valid", modify: func(*customerpb.UpdateAddressRequest) {}},
+		{name: "missing address id", modify: func(r *customerpb.UpdateAddressRequest) { r.AddressId = "" }, wantField: "address_id"},
+		{name: "invalid address id", modify: func(r *customerpb.UpdateAddressRequest) { r.AddressId = "address-1" }, wantField: "address_id"},
+		{name: "missing city", modify: func(r *customerpb.UpdateAddressRequest) { r.City = "" }, wantField: "city"},
+		{name: "invalid address type", modify: func(r *customerpb.UpdateAddressRequest) { r.AddressType = "Holiday" }, wantField: "address_type"},
+		{name: "future effective date", modify: func(r *customerpb.UpdateAddressRequest) {
+			r.EffectiveFrom = timestamppb.New(time.Now().AddDate(0, 1, 0))
+		}, wantField: "effective_from"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateUpdateAddress(req)
+			if tt.wantField == "" {
+				if len(errs) > 0 {
+					t.Errorf("ValidateUpdateAddress() unexpected error: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tt.wantField {
+				t.Errorf("ValidateUpdateAddress() = %v, want one error on %s", errs, tt.wantField)
+			}
+		})
+	}
+}
+
 func TestValidateDocument(t *testing.T) {
 	validator := NewValidator()
 
@@ -314,6 +420,30 @@ func TestValidateStatusTransition(t *testing.T) {
 			newStatus:     models.CustomerStatusPending,
 			wantErr:       true,
 		},
+		{
+			name:          "active to restricted",
+			currentStatus: models.CustomerStatusActive,
+			newStatus:     models.CustomerStatusRestricted,
+			wantErr:       false,
+		},
+		{
+			name:          "restricted to active",
+			currentStatus: models.CustomerStatusRestricted,
+			newStatus:     models.CustomerStatusActive,
+			wantErr:       false,
+		},
+		{
+			name:          "pending to restricted - invalid",
+			currentStatus: models.CustomerStatusPending,
+			newStatus:     models.CustomerStatusRestricted,
+			wantErr:       true,
+		},
+		{
+			name:          "restricted to inactive - invalid",
+			currentStatus: models.CustomerStatusRestricted,
+			newStatus:     models.CustomerStatusInactive,
+			wantErr:       true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -377,6 +507,31 @@ func TestValidateSearchFilters(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:    "part of a phone number",
+			req:     &customerpb.SearchCustomersRequest{Phone: "900 123"},
+			wantErr: false,
+		},
+		{
+			name:    "national phone number with its country",
+			req:     &customerpb.SearchCustomersRequest{Phone: "07700 900123", PhoneCountry: "gb"},
+			wantErr: false,
+		},
+		{
+			name:    "phone number too short to search for",
+			req:     &customerpb.SearchCustomersRequest{Phone: "+1"},
+			wantErr: true,
+		},
+		{
+			name:    "phone number with letters",
+			req:     &customerpb.SearchCustomersRequest{Phone: "call me"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid phone country",
+			req:     &customerpb.SearchCustomersRequest{Phone: "07700 900123", PhoneCountry: "GBR"},
+			wantErr: true,
+		},
 		{
 			name:    "empty filters - valid",
 			req:     &customerpb.SearchCustomersRequest{},
@@ -396,3 +551,690 @@ func TestValidateSearchFilters(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateListScreeningHits(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *customerpb.ListScreeningHitsRequest
+		wantErr bool
+	}{
+		{
+			name: "valid filters",
+			req: &customerpb.ListScreeningHitsRequest{
+				CustomerId: "550e8400-e29b-41d4-a716-446655440000",
+				Status:     "Pending",
+				Limit:      20,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty filters - valid",
+			req:     &customerpb.ListScreeningHitsRequest{},
+			wantErr: false,
+		},
+		{
+			name: "invalid customer id",
+			req: &customerpb.ListScreeningHitsRequest{
+				CustomerId: "not-a-uuid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid status",
+			req: &customerpb.ListScreeningHitsRequest{
+				Status: "Escalated",
+			},
+			wantErr: true,
+		},
+		{
+			name: "limit too high",
+			req: &customerpb.ListScreeningHitsRequest{
+				Limit: 500,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateListScreeningHits(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateListScreeningHits() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateListScreeningHits() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateReviewScreeningHit(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.ReviewScreeningHitRequest {
+		return &customerpb.ReviewScreeningHitRequest{
+			HitId:      "550e8400-e29b-41d4-a716-446655440000",
+			Decision:   "Dismissed",
+			Notes:      "Date of birth differs from the listed entry",
+			ReviewedBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.ReviewScreeningHitRequest)
+		wantErr bool
+	}{
+		{"valid dismissal", func(req *customerpb.ReviewScreeningHitRequest) {}, false},
+		{"valid confirmation", func(req *customerpb.ReviewScreeningHitRequest) { req.Decision = "Confirmed" }, false},
+		{"missing hit id", func(req *customerpb.ReviewScreeningHitRequest) { req.HitId = "" }, true},
+		{"pending is not a decision", func(req *customerpb.ReviewScreeningHitRequest) { req.Decision = "Pending" }, true},
+		{"missing notes", func(req *customerpb.ReviewScreeningHitRequest) { req.Notes = "" }, true},
+		{"invalid reviewer", func(req *customerpb.ReviewScreeningHitRequest) { req.ReviewedBy = "analyst" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateReviewScreeningHit(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateReviewScreeningHit() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateReviewScreeningHit() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateListReviewTasks(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *customerpb.ListReviewTasksRequest
+		wantErr bool
+	}{
+		{
+			name: "valid filters",
+			req: &customerpb.ListReviewTasksRequest{
+				CustomerId:  "550e8400-e29b-41d4-a716-446655440000",
+				Status:      "Open",
+				OverdueOnly: true,
+				Limit:       20,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty filters - valid",
+			req:     &customerpb.ListReviewTasksRequest{},
+			wantErr: false,
+		},
+		{
+			name:    "invalid status",
+			req:     &customerpb.ListReviewTasksRequest{Status: "Cancelled"},
+			wantErr: true,
+		},
+		{
+			name:    "overdue completed tasks",
+			req:     &customerpb.ListReviewTasksRequest{Status: "Completed", OverdueOnly: true},
+			wantErr: true,
+		},
+		{
+			name:    "limit too high",
+			req:     &customerpb.ListReviewTasksRequest{Limit: 500},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateListReviewTasks(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateListReviewTasks() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateListReviewTasks() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateCompleteReviewTask(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.CompleteReviewTaskRequest {
+		return &customerpb.CompleteReviewTaskRequest{
+			TaskId:      "550e8400-e29b-41d4-a716-446655440000",
+			CompletedBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			Notes:       "Source of funds and address re-verified",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.CompleteReviewTaskRequest)
+		wantErr bool
+	}{
+		{"valid completion", func(req *customerpb.CompleteReviewTaskRequest) {}, false},
+		{"missing task id", func(req *customerpb.CompleteReviewTaskRequest) { req.TaskId = "" }, true},
+		{"missing notes", func(req *customerpb.CompleteReviewTaskRequest) { req.Notes = "" }, true},
+		{"invalid reviewer", func(req *customerpb.CompleteReviewTaskRequest) { req.CompletedBy = "analyst" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateCompleteReviewTask(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateCompleteReviewTask() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateCompleteReviewTask() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateDocumentFileHeader(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.DocumentFileHeader {
+		return &customerpb.DocumentFileHeader{
+			DocumentId: "550e8400-e29b-41d4-a716-446655440000",
+			FileName:   "passport.jpg",
+			UploadedBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(header *customerpb.DocumentFileHeader)
+		wantErr bool
+	}{
+		{"valid header", func(header *customerpb.DocumentFileHeader) {}, false},
+		{"valid digest", func(header *customerpb.DocumentFileHeader) {
+			header.Sha256 = "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"
+		}, false},
+		{"missing document id", func(header *customerpb.DocumentFileHeader) { header.DocumentId = "" }, true},
+		{"missing file name", func(header *customerpb.DocumentFileHeader) { header.FileName = "" }, true},
+		{"file name with path", func(header *customerpb.DocumentFileHeader) { header.FileName = "../passport.jpg" }, true},
+		{"file name too long", func(header *customerpb.DocumentFileHeader) { header.FileName = strings.Repeat("a", 256) }, true},
+		{"invalid uploader", func(header *customerpb.DocumentFileHeader) { header.UploadedBy = "clerk" }, true},
+		{"short digest", func(header *customerpb.DocumentFileHeader) { header.Sha256 = "e3b0c442" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := valid()
+			tt.modify(header)
+			errs := validator.ValidateDocumentFileHeader(header)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateDocumentFileHeader() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateDocumentFileHeader() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateDownloadDocumentFile(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.DownloadDocumentFileRequest {
+		return &customerpb.DownloadDocumentFileRequest{
+			FileId:      "550e8400-e29b-41d4-a716-446655440000",
+			RequestedBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			Reason:      "Periodic KYC review",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.DownloadDocumentFileRequest)
+		wantErr bool
+	}{
+		{"valid request", func(req *customerpb.DownloadDocumentFileRequest) {}, false},
+		{"missing file id", func(req *customerpb.DownloadDocumentFileRequest) { req.FileId = "" }, true},
+		{"invalid requester", func(req *customerpb.DownloadDocumentFileRequest) { req.RequestedBy = "analyst" }, true},
+		{"missing reason", func(req *customerpb.DownloadDocumentFileRequest) { req.Reason = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateDownloadDocumentFile(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateDownloadDocumentFile() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateDownloadDocumentFile() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateEraseCustomer(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.EraseCustomerRequest {
+		return &customerpb.EraseCustomerRequest{
+			CustomerId:  "550e8400-e29b-41d4-a716-446655440000",
+			RequestedBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			Reason:      "Data subject erasure request",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.EraseCustomerRequest)
+		wantErr bool
+	}{
+		{"valid request", func(req *customerpb.EraseCustomerRequest) {}, false},
+		{"valid dry run", func(req *customerpb.EraseCustomerRequest) { req.DryRun = true }, false},
+		{"missing customer id", func(req *customerpb.EraseCustomerRequest) { req.CustomerId = "" }, true},
+		{"invalid requester", func(req *customerpb.EraseCustomerRequest) { req.RequestedBy = "dpo" }, true},
+		{"missing reason", func(req *customerpb.EraseCustomerRequest) { req.Reason = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateEraseCustomer(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateEraseCustomer() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateEraseCustomer() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateLegalHold(t *testing.T) {
+	validator := NewValidator()
+
+	place := &customerpb.PlaceLegalHoldRequest{
+		CustomerId: "550e8400-e29b-41d4-a716-446655440000",
+		PlacedBy:   "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		Reason:     "Litigation pending",
+	}
+	if errs := validator.ValidatePlaceLegalHold(place); len(errs) > 0 {
+		t.Errorf("ValidatePlaceLegalHold() unexpected error: %v", errs)
+	}
+	place.Reason = ""
+	if errs := validator.ValidatePlaceLegalHold(place); len(errs) == 0 {
+		t.Errorf("ValidatePlaceLegalHold() without reason expected error, got none")
+	}
+
+	release := &customerpb.ReleaseLegalHoldRequest{
+		HoldId:     "550e8400-e29b-41d4-a716-446655440000",
+		ReleasedBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}
+	if errs := validator.ValidateReleaseLegalHold(release); len(errs) > 0 {
+		t.Errorf("ValidateReleaseLegalHold() unexpected error: %v", errs)
+	}
+	release.HoldId = "hold-1"
+	if errs := validator.ValidateReleaseLegalHold(release); len(errs) == 0 {
+		t.Errorf("ValidateReleaseLegalHold() with invalid hold id expected error, got none")
+	}
+}
+
+func TestValidateMergeCustomers(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.MergeCustomersRequest {
+		return &customerpb.MergeCustomersRequest{
+			CustomerId:  "550e8400-e29b-41d4-a716-446655440000",
+			DuplicateId: "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			MergedBy:    "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			Reason:      "Opened twice in branch",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.MergeCustomersRequest)
+		wantErr bool
+	}{
+		{"valid request", func(req *customerpb.MergeCustomersRequest) {}, false},
+		{"survivor named", func(req *customerpb.MergeCustomersRequest) { req.SurvivorId = req.DuplicateId }, false},
+		{"survivor not in merge", func(req *customerpb.MergeCustomersRequest) { req.SurvivorId = req.MergedBy }, true},
+		{"same customer twice", func(req *customerpb.MergeCustomersRequest) { req.DuplicateId = req.CustomerId }, true},
+		{"invalid duplicate id", func(req *customerpb.MergeCustomersRequest) { req.DuplicateId = "CUST-1" }, true},
+		{"missing merged by", func(req *customerpb.MergeCustomersRequest) { req.MergedBy = "" }, true},
+		{"missing reason", func(req *customerpb.MergeCustomersRequest) { req.Reason = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateMergeCustomers(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateMergeCustomers() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateMergeCustomers() unexpected error: %v", errs)
+			}
+		})
+	}
+
+	unmerge := &customerpb.UnmergeCustomersRequest{
+		MergeId:    "550e8400-e29b-41d4-a716-446655440000",
+		UnmergedBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		Reason:     "Different people",
+	}
+	if errs := validator.ValidateUnmergeCustomers(unmerge); len(errs) > 0 {
+		t.Errorf("ValidateUnmergeCustomers() unexpected error: %v", errs)
+	}
+	unmerge.Reason = ""
+	if errs := validator.ValidateUnmergeCustomers(unmerge); len(errs) == 0 {
+		t.Errorf("ValidateUnmergeCustomers() without reason expected error, got none")
+	}
+}
+
+func TestValidateFindPotentialDuplicates(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *customerpb.FindPotentialDuplicatesRequest
+		wantErr bool
+	}{
+		{"by customer", &customerpb.FindPotentialDuplicatesRequest{CustomerId: "550e8400-e29b-41d4-a716-446655440000"}, false},
+		{"by details", &customerpb.FindPotentialDuplicatesRequest{FirstName: "Jane", LastName: "Smith", Email: "jane@example.com"}, false},
+		{"invalid customer id", &customerpb.FindPotentialDuplicatesRequest{CustomerId: "CUST-1"}, true},
+		{"missing last name", &customerpb.FindPotentialDuplicatesRequest{FirstName: "Jane"}, true},
+		{"limit too high", &customerpb.FindPotentialDuplicatesRequest{FirstName: "Jane", LastName: "Smith", Limit: 101}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateFindPotentialDuplicates(tt.req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateFindPotentialDuplicates() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateFindPotentialDuplicates() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidatePhone_NumberingPlans(t *testing.T) {
+	plans, err := phone.LoadConfig(filepath.Join("..", "..", "config", "phone_numbering.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := NewValidatorWithRules(postal.DefaultConfig(), plans)
+
+	individual := func(number string) *customerpb.CreateCustomerRequest {
+		return &customerpb.CreateCustomerRequest{
+			FirstName:   "John",
+			LastName:    "Doe",
+			Email:       "john@example.com",
+			Phone:       number,
+			DateOfBirth: timestamppb.New(time.Now().AddDate(-30, 0, 0)),
+		}
+	}
+	business := func(number string) *customerpb.CreateCustomerRequest {
+		return &customerpb.CreateCustomerRequest{
+			CustomerType: string(models.CustomerTypeBusiness),
+			Email:        "accounts@acme.example.com",
+			Phone:        number,
+			Business: &customerpb.BusinessDetails{
+				RegisteredName:     "Acme Widgets Ltd",
+				RegistrationNumber: "01234567",
+				Jurisdiction:       "GB",
+				IncorporationDate:  timestamppb.New(time.Date(2010, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     *customerpb.CreateCustomerRequest
+		wantErr string
+	}{
+		{name: "individual in international format", req: individual("+44 7700 900123")},
+		{name: "individual in national format", req: individual("07700 900123"), wantErr: "phone: must be in international format, such as +447700900123"},
+		{name: "individual with a number too short", req: individual("+44 7700 90012"), wantErr: "phone: is not a valid United Kingdom phone number, such as +447700900123"},
+		{name: "business in national format of its jurisdiction", req: business("020 7946 0000")},
+		{name: "business with a number of another country", req: business("+33 6 12 34 56 78")},
+		{name: "business with letters in its number", req: business("0800 FLOWERS"), wantErr: "phone: is not a phone number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateCustomerCreate(tt.req)
+			var got string
+			if len(errs) > 0 {
+				got = errs[0].Error()
+			}
+			if len(errs) > 1 || got != tt.wantErr {
+				t.Errorf("ValidateCustomerCreate() = %v, want %q", errs, tt.wantErr)
+			}
+		})
+	}
+
+	number, verr := validator.ParsePhone("020 7946 0000", "GB")
+	if verr != nil || number.E164 != "+442079460000" || number.Type != phone.TypeFixedLine {
+		t.Errorf("ParsePhone() = %+v, %v; want a UK fixed line", number, verr)
+	}
+	if _, verr := validator.ParsePhone("+44"+strings.Repeat("0", 50), ""); verr == nil {
+		t.Error("ParsePhone() accepted an overlong number")
+	}
+
+	// The country to read a national number in is not known until the
+	// customer is loaded, so only the characters are checked up front
+	update := &customerpb.UpdateCustomerRequest{Id: "customer-uuid", Version: 1, Phone: "020 7946 0000"}
+	if errs := validator.ValidateCustomerUpdate(update); len(errs) > 0 {
+		t.Errorf("ValidateCustomerUpdate() national number error: %v", errs)
+	}
+	update.Phone = "0800 FLOWERS"
+	if errs := validator.ValidateCustomerUpdate(update); !errs.hasField("phone") {
+		t.Errorf("ValidateCustomerUpdate() = %v, want a phone error", errs)
+	}
+}
+
+func TestValidateBusinessCustomerCreate(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.CreateCustomerRequest {
+		return &customerpb.CreateCustomerRequest{
+			CustomerType: string(models.CustomerTypeBusiness),
+			Email:        "accounts@acme.example.com",
+			Business: &customerpb.BusinessDetails{
+				RegisteredName:     "Acme Widgets Ltd",
+				RegistrationNumber: "01234567",
+				Jurisdiction:       "GB",
+				Lei:                "5493001KJTIIGC8Y1R12",
+				IncorporationDate:  timestamppb.New(time.Date(2010, 6, 1, 0, 0, 0, 0, time.UTC)),
+				IndustryCode:       "25.62",
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.CreateCustomerRequest)
+		wantErr bool
+	}{
+		{"valid request", func(req *customerpb.CreateCustomerRequest) {}, false},
+		{"without lei or industry code", func(req *customerpb.CreateCustomerRequest) { req.Business.Lei, req.Business.IndustryCode = "", "" }, false},
+		{"unknown type", func(req *customerpb.CreateCustomerRequest) { req.CustomerType = "Trust" }, true},
+		{"missing business details", func(req *customerpb.CreateCustomerRequest) { req.Business = nil }, true},
+		{"with a date of birth", func(req *customerpb.CreateCustomerRequest) {
+			req.DateOfBirth = timestamppb.New(time.Now().AddDate(-30, 0, 0))
+		}, true},
+		{"with a first name", func(req *customerpb.CreateCustomerRequest) { req.FirstName = "Acme" }, true},
+		{"missing registered name", func(req *customerpb.CreateCustomerRequest) { req.Business.RegisteredName = "" }, true},
+		{"missing registration number", func(req *customerpb.CreateCustomerRequest) { req.Business.RegistrationNumber = "" }, true},
+		{"lowercase jurisdiction", func(req *customerpb.CreateCustomerRequest) { req.Business.Jurisdiction = "gb" }, true},
+		{"lei check digits wrong", func(req *customerpb.CreateCustomerRequest) { req.Business.Lei = "5493001KJTIIGC8Y1R13" }, true},
+		{"lei too short", func(req *customerpb.CreateCustomerRequest) { req.Business.Lei = "5493001KJTIIGC8Y1R1" }, true},
+		{"incorporated in the future", func(req *customerpb.CreateCustomerRequest) {
+			req.Business.IncorporationDate = timestamppb.New(time.Now().AddDate(0, 1, 0))
+		}, true},
+		{"industry code too long", func(req *customerpb.CreateCustomerRequest) { req.Business.IndustryCode = "12345678901" }, true},
+		{"individual with business details", func(req *customerpb.CreateCustomerRequest) {
+			req.CustomerType = string(models.CustomerTypeIndividual)
+			req.FirstName, req.LastName = "John", "Doe"
+			req.DateOfBirth = timestamppb.New(time.Now().AddDate(-30, 0, 0))
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateCustomerCreate(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateCustomerCreate() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateCustomerCreate() unexpected error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateAddCustomerRelationship(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.AddCustomerRelationshipRequest {
+		return &customerpb.AddCustomerRelationshipRequest{
+			BusinessId:          "550e8400-e29b-41d4-a716-446655440000",
+			IndividualId:        "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			Role:                string(models.RelationshipRoleBeneficialOwner),
+			OwnershipPercentage: 40,
+			CreatedBy:           "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.AddCustomerRelationshipRequest)
+		wantErr bool
+	}{
+		{"valid request", func(req *customerpb.AddCustomerRelationshipRequest) {}, false},
+		{"director", func(req *customerpb.AddCustomerRelationshipRequest) {
+			req.Role, req.OwnershipPercentage = string(models.RelationshipRoleDirector), 0
+		}, false},
+		{"director with a share", func(req *customerpb.AddCustomerRelationshipRequest) {
+			req.Role = string(models.RelationshipRoleDirector)
+		}, true},
+		{"owner without a share", func(req *customerpb.AddCustomerRelationshipRequest) { req.OwnershipPercentage = 0 }, true},
+		{"owner of more than all", func(req *customerpb.AddCustomerRelationshipRequest) { req.OwnershipPercentage = 100.5 }, true},
+		{"unknown role", func(req *customerpb.AddCustomerRelationshipRequest) { req.Role = "Secretary" }, true},
+		{"linked to itself", func(req *customerpb.AddCustomerRelationshipRequest) { req.IndividualId = req.BusinessId }, true},
+		{"missing created by", func(req *customerpb.AddCustomerRelationshipRequest) { req.CreatedBy = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateAddCustomerRelationship(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateAddCustomerRelationship() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateAddCustomerRelationship() unexpected error: %v", errs)
+			}
+		})
+	}
+
+	end := &customerpb.EndCustomerRelationshipRequest{
+		RelationshipId: "550e8400-e29b-41d4-a716-446655440000",
+		EndedBy:        "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		Reason:         "Resigned as director",
+	}
+	if errs := validator.ValidateEndCustomerRelationship(end); len(errs) > 0 {
+		t.Errorf("ValidateEndCustomerRelationship() unexpected error: %v", errs)
+	}
+	end.Reason = ""
+	if errs := validator.ValidateEndCustomerRelationship(end); len(errs) == 0 {
+		t.Errorf("ValidateEndCustomerRelationship() without reason expected error, got none")
+	}
+}
+
+func TestValidateGrantConsent(t *testing.T) {
+	validator := NewValidator()
+
+	valid := func() *customerpb.GrantConsentRequest {
+		return &customerpb.GrantConsentRequest{
+			CustomerId:    "550e8400-e29b-41d4-a716-446655440000",
+			Purpose:       string(models.ConsentPurposeMarketing),
+			Channel:       string(models.ConsentChannelEmail),
+			PolicyVersion: "marketing-2026-03",
+			Source:        string(models.ConsentSourceWeb),
+			GrantedBy:     "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *customerpb.GrantConsentRequest)
+		wantErr bool
+	}{
+		{"valid request", func(req *customerpb.GrantConsentRequest) {}, false},
+		{"given earlier on paper", func(req *customerpb.GrantConsentRequest) {
+			req.Source, req.GrantedAt = string(models.ConsentSourcePaper), timestamppb.New(time.Now().AddDate(0, 0, -3))
+		}, false},
+		{"data sharing on all channels", func(req *customerpb.GrantConsentRequest) {
+			req.Purpose, req.Channel = string(models.ConsentPurposeDataSharing), string(models.ConsentChannelAll)
+		}, false},
+		{"data sharing on one channel", func(req *customerpb.GrantConsentRequest) { req.Purpose = string(models.ConsentPurposeDataSharing) }, true},
+		{"unknown purpose", func(req *customerpb.GrantConsentRequest) { req.Purpose = "Profiling" }, true},
+		{"unknown channel", func(req *customerpb.GrantConsentRequest) { req.Channel = "Fax" }, true},
+		{"missing policy version", func(req *customerpb.GrantConsentRequest) { req.PolicyVersion = "" }, true},
+		{"unknown source", func(req *customerpb.GrantConsentRequest) { req.Source = "Carrier pigeon" }, true},
+		{"granted in the future", func(req *customerpb.GrantConsentRequest) { req.GrantedAt = timestamppb.New(time.Now().Add(time.Hour)) }, true},
+		{"missing granted by", func(req *customerpb.GrantConsentRequest) { req.GrantedBy = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			errs := validator.ValidateGrantConsent(req)
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("ValidateGrantConsent() expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("ValidateGrantConsent() unexpected error: %v", errs)
+			}
+		})
+	}
+
+	withdraw := &customerpb.WithdrawConsentRequest{
+		ConsentId:   "550e8400-e29b-41d4-a716-446655440000",
+		Source:      string(models.ConsentSourceCallCentre),
+		WithdrawnBy: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}
+	if errs := validator.ValidateWithdrawConsent(withdraw); len(errs) > 0 {
+		t.Errorf("ValidateWithdrawConsent() unexpected error: %v", errs)
+	}
+	withdraw.Source = ""
+	if errs := validator.ValidateWithdrawConsent(withdraw); len(errs) == 0 {
+		t.Errorf("ValidateWithdrawConsent() without source expected error, got none")
+	}
+
+	check := &customerpb.CheckConsentRequest{CustomerId: "550e8400-e29b-41d4-a716-446655440000", Purpose: "Marketing", Channel: "SMS"}
+	if errs := validator.ValidateCheckConsent(check); len(errs) > 0 {
+		t.Errorf("ValidateCheckConsent() unexpected error: %v", errs)
+	}
+	check.Channel = ""
+	if errs := validator.ValidateCheckConsent(check); len(errs) == 0 {
+		t.Errorf("ValidateCheckConsent() without channel expected error, got none")
+	}
+}
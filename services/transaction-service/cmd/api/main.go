@@ -10,11 +10,19 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
+	"github.com/core-banking/pkg/bankid"
 	"github.com/core-banking/pkg/config"
+	"github.com/core-banking/pkg/database"
 	"github.com/core-banking/pkg/logger"
 	"github.com/core-banking/pkg/middleware"
+	accountclient "github.com/core-banking/services/account-service/client"
+	"github.com/core-banking/services/transaction-service/internal/beneficiary"
+	"github.com/core-banking/services/transaction-service/internal/exchange"
+	"github.com/core-banking/services/transaction-service/internal/repository"
+	"github.com/core-banking/services/transaction-service/internal/service"
 )
 
 func main() {
@@ -34,8 +42,100 @@ func main() {
 		Int("port", cfg.ServerPort).
 		Msg("Starting transaction service (placeholder)")
 
+	// UK beneficiaries are checked against the Vocalink modulus table; the
+	// bundled sample covers only a few sort codes
+	modulus := bankid.DefaultModulusTable()
+	if path := os.Getenv("UK_MODULUS_TABLE_FILE"); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("Failed to open modulus table")
+		}
+		modulus, err = bankid.LoadModulusTable(f)
+		f.Close()
+		if err != nil {
+			log.Fatal().Err(err).Str("path", path).Msg("Failed to load modulus table")
+		}
+	} else {
+		log.Warn().Msg("UK_MODULUS_TABLE_FILE not set, using the bundled sample modulus table")
+	}
+
+	beneficiaries := beneficiary.NewValidator(modulus)
+
+	// Initialize database
+	db, err := database.NewDatabase(ctx, cfg.DatabaseConfig(), &log)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize database")
+	}
+	defer db.Close()
+
+	// Verify database health
+	if err := db.HealthCheck(ctx); err != nil {
+		log.Fatal().Err(err).Msg("Database health check failed")
+	}
+	log.Info().Msg("Database health check passed")
+
+	// Initialize repository
+	repo := repository.NewPaymentRepository(db.DB)
+
+	// ACH is enabled by configuring our routing number. Entries are posted
+	// to customer accounts in account-service against a settlement account.
+	var ach *service.ACHService
+	if routing := os.Getenv("ACH_ROUTING_NUMBER"); routing != "" {
+		settlementAccountID, err := uuid.Parse(os.Getenv("ACH_SETTLEMENT_ACCOUNT_ID"))
+		if err != nil {
+			log.Fatal().Err(err).Msg("ACH_SETTLEMENT_ACCOUNT_ID must be an account ID")
+		}
+
+		accountServiceAddr := os.Getenv("ACCOUNT_SERVICE_ADDR")
+		if accountServiceAddr == "" {
+			accountServiceAddr = "localhost:50052"
+		}
+		accounts, err := accountclient.New(accountServiceAddr)
+		if err != nil {
+			log.Fatal().Err(err).Str("addr", accountServiceAddr).Msg("Failed to create account-service client")
+		}
+		defer accounts.Close()
+
+		ach, err = service.NewACHService(repo, accounts, service.ACHConfig{
+			RoutingNumber:            routing,
+			ImmediateDestination:     os.Getenv("ACH_OPERATOR_ROUTING_NUMBER"),
+			ImmediateDestinationName: os.Getenv("ACH_OPERATOR_NAME"),
+			ImmediateOriginName:      os.Getenv("ACH_BANK_NAME"),
+			CompanyName:              os.Getenv("ACH_COMPANY_NAME"),
+			CompanyID:                os.Getenv("ACH_COMPANY_ID"),
+			SettlementAccountID:      settlementAccountID,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid ACH configuration")
+		}
+	} else {
+		log.Warn().Msg("ACH_ROUTING_NUMBER not set, ACH disabled")
+	}
+
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+
+	// ISO 20022 and NACHA files are exchanged with the clearing system
+	// through a local inbox/outbox directory served by an external transfer agent
+	if root := os.Getenv("PAYMENT_EXCHANGE_DIR"); root != "" {
+		bic := os.Getenv("BANK_BIC")
+		if bic == "" {
+			log.Fatal().Msg("BANK_BIC must be set to exchange payment files")
+		}
+		dir, err := exchange.NewDirectory(root)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", root).Msg("Failed to open payment exchange directory")
+		}
+		payments := service.NewPaymentService(repo, beneficiaries, bic, os.Getenv("CLEARING_BIC"))
+		exchanger := service.NewPaymentExchanger(payments, ach, dir, time.Minute, log)
+		go exchanger.Run(jobsCtx)
+	} else {
+		log.Warn().Msg("PAYMENT_EXCHANGE_DIR not set, payment file exchange disabled")
+	}
+
 	// Create router
-	router := createRouter(log)
+	router := createRouter(log, beneficiaries, ach)
 
 	// Create HTTP server
 	server := &http.Server{
@@ -67,6 +167,9 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Stop background jobs
+	stopJobs()
+
 	// Shutdown server
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.Error().Err(err).Msg("Server forced to shutdown")
@@ -76,7 +179,7 @@ func main() {
 }
 
 // createRouter creates the HTTP router with all middleware and routes.
-func createRouter(log zerolog.Logger) *chi.Mux {
+func createRouter(log zerolog.Logger, beneficiaries *beneficiary.Validator, ach *service.ACHService) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Add middleware
@@ -99,6 +202,14 @@ func createRouter(log zerolog.Logger) *chi.Mux {
 			w.Header().Set("Content-Type", "application/json")
 			w.Write([]byte(`{"data":[],"message":"Transaction service - placeholder endpoint"}`))
 		})
+
+		// Beneficiary account checks for outgoing payments
+		r.Post("/beneficiaries/validate", beneficiary.ValidateHandler(beneficiaries))
+
+		// ACH origination and account corrections
+		if ach != nil {
+			service.RegisterACHRoutes(r, ach)
+		}
 	})
 
 	return r
@@ -0,0 +1,136 @@
+// Package beneficiary validates the account details of outgoing payment
+// beneficiaries before a payment is accepted.
+package beneficiary
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/core-banking/pkg/bankid"
+)
+
+// Scheme identifies how a beneficiary account is addressed
+type Scheme string
+
+const (
+	SchemeIBAN     Scheme = "IBAN"
+	SchemeABA      Scheme = "ABA"      // US routing number and account number
+	SchemeSortCode Scheme = "SORTCODE" // UK sort code and account number
+)
+
+// Account holds the account details of a beneficiary. Exactly one way of
+// addressing the account is given: an IBAN, a routing number with an
+// account number, or a sort code with an account number.
+type Account struct {
+	Name          string `json:"name,omitempty"`
+	IBAN          string `json:"iban,omitempty"`
+	RoutingNumber string `json:"routing_number,omitempty"`
+	SortCode      string `json:"sort_code,omitempty"`
+	AccountNumber string `json:"account_number,omitempty"`
+}
+
+// FieldError describes a problem with one field of a beneficiary
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// FieldErrors is a list of field errors
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fmt.Sprintf("%s: %s", fe.Field, fe.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Result is the outcome of validating a beneficiary
+type Result struct {
+	Valid   bool        `json:"valid"`
+	Scheme  Scheme      `json:"scheme,omitempty"`
+	Account Account     `json:"account"` // Normalized details
+	Errors  FieldErrors `json:"errors,omitempty"`
+}
+
+// maxUSAccountDigits is the longest account number carried in a NACHA entry
+const maxUSAccountDigits = 17
+
+// Validator checks beneficiary account details
+type Validator struct {
+	modulus *bankid.ModulusTable
+}
+
+// NewValidator creates a Validator that checks UK accounts against the
+// modulus table
+func NewValidator(modulus *bankid.ModulusTable) *Validator {
+	return &Validator{modulus: modulus}
+}
+
+// Validate normalizes the beneficiary's details and checks them against the
+// rules of the scheme they use
+func (v *Validator) Validate(a Account) Result {
+	a = Account{
+		Name:          strings.TrimSpace(a.Name),
+		IBAN:          bankid.NormalizeIBAN(a.IBAN),
+		RoutingNumber: strings.TrimSpace(a.RoutingNumber),
+		SortCode:      bankid.NormalizeSortCode(strings.TrimSpace(a.SortCode)),
+		AccountNumber: strings.ReplaceAll(strings.TrimSpace(a.AccountNumber), " ", ""),
+	}
+	result := Result{Account: a}
+
+	switch {
+	case a.IBAN != "" && a.RoutingNumber == "" && a.SortCode == "":
+		result.Scheme = SchemeIBAN
+		if a.AccountNumber != "" {
+			result.Errors = append(result.Errors, FieldError{"account_number", "must not be given with an IBAN"})
+		}
+		if err := bankid.ValidateIBAN(a.IBAN); err != nil {
+			result.Errors = append(result.Errors, FieldError{"iban", message(err)})
+		}
+	case a.RoutingNumber != "" && a.IBAN == "" && a.SortCode == "":
+		result.Scheme = SchemeABA
+		if err := bankid.ValidateABA(a.RoutingNumber); err != nil {
+			result.Errors = append(result.Errors, FieldError{"routing_number", message(err)})
+		}
+		if a.AccountNumber == "" {
+			result.Errors = append(result.Errors, FieldError{"account_number", "is required"})
+		} else if len(a.AccountNumber) > maxUSAccountDigits || strings.Trim(a.AccountNumber, "0123456789") != "" {
+			result.Errors = append(result.Errors, FieldError{"account_number", fmt.Sprintf("must be 1 to %d digits", maxUSAccountDigits)})
+		}
+	case a.SortCode != "" && a.IBAN == "" && a.RoutingNumber == "":
+		result.Scheme = SchemeSortCode
+		result.Account.AccountNumber = bankid.NormalizeUKAccountNumber(a.AccountNumber)
+		switch {
+		case len(a.SortCode) != 6 || strings.Trim(a.SortCode, "0123456789") != "":
+			result.Errors = append(result.Errors, FieldError{"sort_code", "must be 6 digits"})
+		case a.AccountNumber == "":
+			result.Errors = append(result.Errors, FieldError{"account_number", "is required"})
+		default:
+			if err := v.modulus.ValidateUKAccount(a.SortCode, a.AccountNumber); err != nil {
+				result.Errors = append(result.Errors, FieldError{"account_number", message(err)})
+			}
+		}
+	case a.IBAN == "" && a.RoutingNumber == "" && a.SortCode == "":
+		result.Errors = append(result.Errors, FieldError{"account", "one of iban, routing_number or sort_code is required"})
+	default:
+		result.Errors = append(result.Errors, FieldError{"account", "only one of iban, routing_number or sort_code may be given"})
+	}
+
+	result.Valid = len(result.Errors) == 0
+	return result
+}
+
+// message turns a bankid error into a field message
+func message(err error) string {
+	switch {
+	case errors.Is(err, bankid.ErrInvalidChecksum):
+		return "check digits do not match"
+	case errors.Is(err, bankid.ErrUnsupportedCountry):
+		return "country does not use IBANs or is not supported"
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,92 @@
+package beneficiary
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/core-banking/pkg/bankid"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	v := NewValidator(bankid.DefaultModulusTable())
+
+	tests := []struct {
+		name       string
+		account    Account
+		wantScheme Scheme
+		wantFields []string
+	}{
+		{"valid IBAN as printed", Account{IBAN: "gb82 west 1234 5698 7654 32"}, SchemeIBAN, nil},
+		{"IBAN with bad check digits", Account{IBAN: "GB83WEST12345698765432"}, SchemeIBAN, []string{"iban"}},
+		{"IBAN with account number", Account{IBAN: "GB82WEST12345698765432", AccountNumber: "1"}, SchemeIBAN, []string{"account_number"}},
+		{"valid routing and account", Account{RoutingNumber: "021000021", AccountNumber: "123456789"}, SchemeABA, nil},
+		{"bad routing number", Account{RoutingNumber: "021000022", AccountNumber: "123456789"}, SchemeABA, []string{"routing_number"}},
+		{"routing without account", Account{RoutingNumber: "021000021"}, SchemeABA, []string{"account_number"}},
+		{"US account too long", Account{RoutingNumber: "021000021", AccountNumber: "123456789012345678"}, SchemeABA, []string{"account_number"}},
+		{"valid sort code and account", Account{SortCode: "08-99-99", AccountNumber: "66374958"}, SchemeSortCode, nil},
+		{"account failing modulus check", Account{SortCode: "089999", AccountNumber: "66374959"}, SchemeSortCode, []string{"account_number"}},
+		{"malformed sort code", Account{SortCode: "08-99", AccountNumber: "66374958"}, SchemeSortCode, []string{"sort_code"}},
+		{"no account details", Account{Name: "Ada"}, "", []string{"account"}},
+		{"two schemes", Account{IBAN: "GB82WEST12345698765432", SortCode: "089999"}, "", []string{"account"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := v.Validate(tt.account)
+			if result.Scheme != tt.wantScheme {
+				t.Errorf("Validate() scheme = %q, want %q", result.Scheme, tt.wantScheme)
+			}
+			if result.Valid != (len(tt.wantFields) == 0) {
+				t.Errorf("Validate() valid = %v, errors %v", result.Valid, result.Errors)
+			}
+			if len(result.Errors) != len(tt.wantFields) {
+				t.Fatalf("Validate() errors = %v, want fields %v", result.Errors, tt.wantFields)
+			}
+			for i, field := range tt.wantFields {
+				if result.Errors[i].Field != field {
+					t.Errorf("Validate() error %d field = %q, want %q", i, result.Errors[i].Field, field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidator_Validate_Normalizes(t *testing.T) {
+	v := NewValidator(bankid.DefaultModulusTable())
+
+	result := v.Validate(Account{IBAN: " gb82 west 1234 5698 7654 32 "})
+	if result.Account.IBAN != "GB82WEST12345698765432" {
+		t.Errorf("normalized IBAN = %q", result.Account.IBAN)
+	}
+
+	result = v.Validate(Account{SortCode: "40 00 00", AccountNumber: "345678"})
+	if !result.Valid || result.Account.SortCode != "400000" || result.Account.AccountNumber != "00345678" {
+		t.Errorf("normalized UK account = %+v", result)
+	}
+}
+
+func TestValidateHandler(t *testing.T) {
+	handler := ValidateHandler(NewValidator(bankid.DefaultModulusTable()))
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/beneficiaries/validate", strings.NewReader(`{"iban":"GB83WEST12345698765432"}`)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	var result Result
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("response is not a Result: %v", err)
+	}
+	if result.Valid || result.Scheme != SchemeIBAN || len(result.Errors) != 1 || result.Errors[0].Field != "iban" {
+		t.Errorf("result = %+v, want an invalid IBAN", result)
+	}
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/beneficiaries/validate", strings.NewReader(`{"bic":"WESTGB22"}`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status for unknown field = %d, want 400", rec.Code)
+	}
+}
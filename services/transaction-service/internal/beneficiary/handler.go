@@ -0,0 +1,35 @@
+package beneficiary
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apperrors "github.com/core-banking/pkg/errors"
+)
+
+// maxRequestBytes bounds the size of a validation request body
+const maxRequestBytes = 64 << 10
+
+// ValidateHandler serves POST /beneficiaries/validate. The body is an
+// Account; the response is the Result, with status 200 whether or not the
+// details are valid.
+func ValidateHandler(v *Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var account Account
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&account); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]any{
+				"error": apperrors.NewBadRequestError("invalid beneficiary request body", err.Error()),
+			})
+			return
+		}
+		writeJSON(w, http.StatusOK, v.Validate(account))
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,153 @@
+// Package exchange moves ISO 20022 and NACHA files between the service and
+// the clearing system through a local directory. An external transfer agent
+// drops files into the inbox and collects them from the outbox, which keeps
+// the service free of network protocols and lets tests run without one.
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Subdirectories of the exchange root
+const (
+	InboxDir     = "inbox"
+	ProcessedDir = "inbox/processed"
+	FailedDir    = "inbox/failed"
+	OutboxDir    = "outbox"
+)
+
+// Extensions of message files; anything else is ignored
+const (
+	XMLExt = ".xml" // ISO 20022 messages
+	ACHExt = ".ach" // NACHA files
+)
+
+// ErrInvalidName is returned for file names that are not a plain file in the directory
+var ErrInvalidName = errors.New("invalid file name")
+
+// Directory is an inbox/outbox exchange rooted at a local directory.
+// Processed inbox files are moved to inbox/processed, and files that could
+// not be processed to inbox/failed alongside a .error file giving the reason.
+type Directory struct {
+	root string
+}
+
+// NewDirectory opens the exchange at root, creating its subdirectories
+func NewDirectory(root string) (*Directory, error) {
+	for _, dir := range []string{InboxDir, ProcessedDir, FailedDir, OutboxDir} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create exchange directory: %w", err)
+		}
+	}
+	return &Directory{root: root}, nil
+}
+
+// Pending returns the names of the message files waiting in the inbox, in name order
+func (d *Directory) Pending() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(d.root, InboxDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbox: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.Type().IsRegular() && (strings.HasSuffix(e.Name(), XMLExt) || strings.HasSuffix(e.Name(), ACHExt)) && !strings.HasPrefix(e.Name(), ".") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Read returns the content of an inbox file
+func (d *Directory) Read(name string) ([]byte, error) {
+	if err := checkName(name); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(d.root, InboxDir, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return data, nil
+}
+
+// MarkProcessed moves an inbox file to inbox/processed
+func (d *Directory) MarkProcessed(name string) error {
+	_, err := d.move(name, ProcessedDir)
+	return err
+}
+
+// MarkFailed moves an inbox file to inbox/failed and records the cause
+// next to it in a file of the same name with .error appended
+func (d *Directory) MarkFailed(name string, cause error) error {
+	target, err := d.move(name, FailedDir)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(target+".error", []byte(cause.Error()+"\n"), 0o640); err != nil {
+		return fmt.Errorf("failed to record failure of %s: %w", name, err)
+	}
+	return nil
+}
+
+// Write places a file in the outbox. The file is written under a hidden
+// temporary name and renamed, so a collector never sees it half written;
+// writing a name that already exists replaces it.
+func (d *Directory) Write(name string, data []byte) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	outbox := filepath.Join(d.root, OutboxDir)
+	tmp, err := os.CreateTemp(outbox, "."+name+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(outbox, name)); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// move moves an inbox file into dir and returns its new path. A file of the
+// same name already there, from an earlier delivery, is kept and the new
+// one gets a numbered suffix.
+func (d *Directory) move(name, dir string) (string, error) {
+	if err := checkName(name); err != nil {
+		return "", err
+	}
+	target := filepath.Join(d.root, dir, name)
+	for n := 1; ; n++ {
+		if _, err := os.Stat(target); errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		target = filepath.Join(d.root, dir, fmt.Sprintf("%s.%d", name, n))
+	}
+	if err := os.Rename(filepath.Join(d.root, InboxDir, name), target); err != nil {
+		return "", fmt.Errorf("failed to move %s: %w", name, err)
+	}
+	return target, nil
+}
+
+func checkName(name string) error {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package exchange
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDirectory_Inbox(t *testing.T) {
+	root := t.TempDir()
+	dir, err := NewDirectory(root)
+	if err != nil {
+		t.Fatalf("NewDirectory: %v", err)
+	}
+
+	for _, name := range []string{"b.xml", "a.xml", "c.ach", "notes.txt", ".c.xml.tmp"} {
+		if err := os.WriteFile(filepath.Join(root, InboxDir, name), []byte(name), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pending, err := dir.Pending()
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if want := []string{"a.xml", "b.xml", "c.ach"}; !reflect.DeepEqual(pending, want) {
+		t.Fatalf("Pending = %v, want %v", pending, want)
+	}
+
+	data, err := dir.Read("a.xml")
+	if err != nil || string(data) != "a.xml" {
+		t.Fatalf("Read = %q, %v", data, err)
+	}
+
+	if err := dir.MarkProcessed("a.xml"); err != nil {
+		t.Fatalf("MarkProcessed: %v", err)
+	}
+	if err := dir.MarkFailed("b.xml", errors.New("schema violation")); err != nil {
+		t.Fatalf("MarkFailed: %v", err)
+	}
+
+	pending, _ = dir.Pending()
+	if want := []string{"c.ach"}; !reflect.DeepEqual(pending, want) {
+		t.Errorf("Pending after processing = %v, want %v", pending, want)
+	}
+	if _, err := os.Stat(filepath.Join(root, ProcessedDir, "a.xml")); err != nil {
+		t.Errorf("processed file missing: %v", err)
+	}
+	reason, err := os.ReadFile(filepath.Join(root, FailedDir, "b.xml.error"))
+	if err != nil || string(reason) != "schema violation\n" {
+		t.Errorf("failure reason = %q, %v", reason, err)
+	}
+}
+
+func TestDirectory_MarkProcessed_KeepsEarlierDelivery(t *testing.T) {
+	root := t.TempDir()
+	dir, err := NewDirectory(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, content := range []string{"first", "second"} {
+		if err := os.WriteFile(filepath.Join(root, InboxDir, "pain.xml"), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := dir.MarkProcessed("pain.xml"); err != nil {
+			t.Fatalf("MarkProcessed: %v", err)
+		}
+	}
+
+	first, _ := os.ReadFile(filepath.Join(root, ProcessedDir, "pain.xml"))
+	second, _ := os.ReadFile(filepath.Join(root, ProcessedDir, "pain.xml.1"))
+	if string(first) != "first" || string(second) != "second" {
+		t.Errorf("processed = %q, %q; want first, second", first, second)
+	}
+}
+
+func TestDirectory_Write(t *testing.T) {
+	root := t.TempDir()
+	dir, err := NewDirectory(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, content := range []string{"<v1/>", "<v2/>"} {
+		if err := dir.Write("pacs008.xml", []byte(content)); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+
+	entries, _ := os.ReadDir(filepath.Join(root, OutboxDir))
+	if len(entries) != 1 || entries[0].Name() != "pacs008.xml" {
+		t.Fatalf("outbox holds %v, want only pacs008.xml", entries)
+	}
+	data, _ := os.ReadFile(filepath.Join(root, OutboxDir, "pacs008.xml"))
+	if string(data) != "<v2/>" {
+		t.Errorf("outbox file = %q, want <v2/>", data)
+	}
+}
+
+func TestDirectory_RejectsPaths(t *testing.T) {
+	dir, err := NewDirectory(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"", "../escape.xml", "sub/file.xml", ".hidden.xml"} {
+		if err := dir.Write(name, nil); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Write(%q) = %v, want ErrInvalidName", name, err)
+		}
+		if _, err := dir.Read(name); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Read(%q) = %v, want ErrInvalidName", name, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package iso20022
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout is the layout of ISODate elements
+const dateLayout = "2006-01-02"
+
+// currencyExponents lists ISO 4217 currencies whose minor unit is not one
+// hundredth of the major unit
+var currencyExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// CurrencyExponent returns the number of decimal places in the currency's minor unit
+func CurrencyExponent(currency string) int {
+	if exp, ok := currencyExponents[currency]; ok {
+		return exp
+	}
+	return 2
+}
+
+// ParseAmount converts a message amount in major units to minor units of
+// the currency. Amounts with more decimal places than the currency has
+// cannot be settled and are rejected, as are zero amounts.
+func ParseAmount(value, currency string) (int64, error) {
+	integer, fraction, _ := strings.Cut(value, ".")
+	exp := CurrencyExponent(currency)
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > exp {
+		return 0, fmt.Errorf("amount %s has more than %d decimal places for %s", value, exp, currency)
+	}
+	fraction += strings.Repeat("0", exp-len(fraction))
+	minor, err := strconv.ParseInt(integer+fraction, 10, 64)
+	if err != nil || minor < 0 {
+		return 0, fmt.Errorf("invalid amount %s", value)
+	}
+	if minor == 0 {
+		return 0, fmt.Errorf("amount must be greater than zero")
+	}
+	return minor, nil
+}
+
+// FormatAmount formats an amount in minor units as a message amount in
+// major units, e.g. 123456 EUR as "1234.56"
+func FormatAmount(amount int64, currency string) string {
+	digits := strconv.FormatInt(amount, 10)
+	exp := CurrencyExponent(currency)
+	if exp == 0 {
+		return digits
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+// parseDateTime parses an ISODateTime; times without a zone are taken as UTC
+func parseDateTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid ISODateTime %q", value)
+}
+
+// formatDateTime renders an ISODateTime in UTC to the second
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
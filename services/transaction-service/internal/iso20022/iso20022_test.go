@@ -0,0 +1,291 @@
+package iso20022
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// parse parses a corpus document of any supported type
+func parse(data []byte) (any, error) {
+	ns, err := Namespace(data)
+	if err != nil {
+		return nil, err
+	}
+	switch ns {
+	case Pain001Namespace:
+		return ParsePain001(data)
+	case Pacs008Namespace:
+		return ParsePacs008(data)
+	case Pacs002Namespace:
+		return ParsePacs002(data)
+	}
+	return nil, ErrUnknownMessage
+}
+
+func TestCorpus_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.xml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no corpus files: %v", err)
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			doc, err := parse(data)
+			if err != nil {
+				t.Fatalf("parse error = %v", err)
+			}
+			out, err := Marshal(doc)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if !bytes.Equal(out, data) {
+				t.Errorf("round trip changed the document:\n%s", out)
+			}
+		})
+	}
+}
+
+func TestCorpus_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"pain001_control_sum.xml":              "CstmrCdtTrfInitn/GrpHdr/CtrlSum",
+		"pain001_end_to_end_too_long.xml":      "CstmrCdtTrfInitn/PmtInf[0]/CdtTrfTxInf[0]/PmtId/EndToEndId",
+		"pain001_bad_bic.xml":                  "CstmrCdtTrfInitn/PmtInf[0]/DbtrAgt/FinInstnId/BICFI",
+		"pain001_missing_creditor_account.xml": "CstmrCdtTrfInitn/PmtInf[0]/CdtTrfTxInf[0]/CdtrAcct",
+		"pain001_amount_decimals.xml":          "CstmrCdtTrfInitn/PmtInf[0]/CdtTrfTxInf[0]/Amt/InstdAmt",
+		"pacs008_total_mismatch.xml":           "FIToFICstmrCdtTrf/GrpHdr/TtlIntrBkSttlmAmt",
+		"pacs008_settlement_method.xml":        "FIToFICstmrCdtTrf/GrpHdr/SttlmInf/SttlmMtd",
+		"pacs002_unreferenced_transaction.xml": "FIToFIPmtStsRpt/TxInfAndSts[1]",
+		"pacs002_bad_timestamp.xml":            "FIToFIPmtStsRpt/GrpHdr/CreDtTm",
+	}
+
+	files, _ := filepath.Glob("testdata/invalid/*.xml")
+	for _, file := range files {
+		name := filepath.Base(file)
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = parse(data)
+			if err == nil {
+				t.Fatal("parse succeeded, want an error")
+			}
+
+			wantPath, ok := tests[name]
+			if !ok {
+				if !errors.Is(err, ErrUnknownMessage) {
+					t.Errorf("error = %v, want ErrUnknownMessage", err)
+				}
+				return
+			}
+			var verrs ValidationErrors
+			if !errors.As(err, &verrs) {
+				t.Fatalf("error = %v, want ValidationErrors", err)
+			}
+			for _, ve := range verrs {
+				if ve.Path == wantPath {
+					return
+				}
+			}
+			t.Errorf("errors = %v, want one at %s", verrs, wantPath)
+		})
+	}
+}
+
+func TestPain001_Instructions(t *testing.T) {
+	data, _ := os.ReadFile("testdata/pain001_batches.xml")
+	doc, err := ParsePain001(data)
+	if err != nil {
+		t.Fatalf("ParsePain001() error = %v", err)
+	}
+
+	instructions, err := doc.Instructions()
+	if err != nil {
+		t.Fatalf("Instructions() error = %v", err)
+	}
+	if len(instructions) != 3 {
+		t.Fatalf("Instructions() = %d, want 3", len(instructions))
+	}
+
+	first := instructions[0]
+	if first.MessageID != "PAYROLL-2024-03" || first.PaymentInfoID != "PAYROLL-EUR" || first.EndToEndID != "SAL-0001" ||
+		first.Amount != 240000 || first.Currency != "EUR" || first.CreditorIBAN != "FR1420041010050500013M02606" ||
+		first.DebtorIBAN != "NL91ABNA0417164300" || first.DebtorAgentBIC != "" || first.RemittanceInfo != "Salary March 2024" {
+		t.Errorf("first instruction = %+v", first)
+	}
+	if !first.RequestedExecutionDate.Equal(time.Date(2024, 3, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("execution date from DtTm = %v", first.RequestedExecutionDate)
+	}
+	if instructions[1].Amount != 240050 || instructions[1].CreditorIBAN != "" {
+		t.Errorf("second instruction = %+v, want 2400.50 to a non-IBAN account", instructions[1])
+	}
+	if yen := instructions[2]; yen.Amount != 150000 || yen.Currency != "JPY" || yen.InstructionID != "JP-01" || yen.DebtorAgentBIC != "WESTGB2L" {
+		t.Errorf("JPY instruction = %+v", yen)
+	}
+}
+
+func TestPain001_Instructions_RejectsUnsettleableAmounts(t *testing.T) {
+	data, _ := os.ReadFile("testdata/pain001_single.xml")
+	for _, amount := range []string{"0.00", "1250.755"} {
+		doc, err := ParsePain001(bytes.ReplaceAll(data, []byte("1250.75"), []byte(amount)))
+		if err != nil {
+			t.Fatalf("ParsePain001(%s) error = %v", amount, err)
+		}
+		if _, err := doc.Instructions(); err == nil {
+			t.Errorf("Instructions() accepted an amount of %s EUR", amount)
+		}
+	}
+}
+
+func TestNewPacs008(t *testing.T) {
+	hdr := Pacs008Header{
+		MessageID:           "2f1c7e0a9b3d4c55a1e26f8d0b4c3a21",
+		CreatedAt:           time.Date(2024, 3, 6, 7, 0, 0, 0, time.UTC),
+		SettlementDate:      time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC),
+		InstructingAgentBIC: "COREGB2L",
+		InstructedAgentBIC:  "EBAPFRPP",
+	}
+	transfers := []Transfer{
+		{
+			InstructionID: "INV-1042", EndToEndID: "E2E-INV-1042", TransactionID: "8d3f0c1e5a7b4e2f9c6d1a0b3e5f7c9d",
+			DebtorName: "Acme Widgets Ltd", DebtorIBAN: "GB82WEST12345698765432", DebtorAgentBIC: "COREGB2L",
+			CreditorName: "Müller Maschinenbau GmbH", CreditorIBAN: "DE89370400440532013000", CreditorAgentBIC: "COBADEFFXXX",
+			Amount: 125075, Currency: "EUR", RemittanceInfo: "Invoice 1042 & delivery",
+		},
+		{
+			EndToEndID: NotProvided, TransactionID: "0a4b6c8d1e3f5a7b9c2d4e6f8a0b1c3d",
+			DebtorName: "Ada Lovelace", DebtorIBAN: "GB33BUKB20201555555555", DebtorAgentBIC: "COREGB2L",
+			CreditorName: "Jean Dupont", CreditorIBAN: "FR1420041010050500013M02606",
+			Amount: 10000, Currency: "EUR",
+		},
+	}
+
+	doc, err := NewPacs008(hdr, transfers)
+	if err != nil {
+		t.Fatalf("NewPacs008() error = %v", err)
+	}
+	out, err := Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := os.ReadFile("testdata/pacs008_clearing.xml")
+	if !bytes.Equal(out, want) {
+		t.Errorf("NewPacs008() =\n%s\nwant the corpus document", out)
+	}
+
+	transfers[1].Currency = "GBP"
+	if _, err := NewPacs008(hdr, transfers); err == nil {
+		t.Error("NewPacs008() accepted mixed currencies")
+	}
+	if _, err := NewPacs008(hdr, nil); err == nil {
+		t.Error("NewPacs008() accepted no transfers")
+	}
+}
+
+func TestPacs002_Statuses(t *testing.T) {
+	data, _ := os.ReadFile("testdata/pacs002_transactions.xml")
+	doc, err := ParsePacs002(data)
+	if err != nil {
+		t.Fatalf("ParsePacs002() error = %v", err)
+	}
+	statuses := doc.Statuses()
+	if len(statuses) != 2 {
+		t.Fatalf("Statuses() = %+v, want the two transactions and not the PART group status", statuses)
+	}
+	if s := statuses[0]; s.OriginalMessageID != "2f1c7e0a9b3d4c55a1e26f8d0b4c3a21" || s.OriginalTxID != "8d3f0c1e5a7b4e2f9c6d1a0b3e5f7c9d" || s.Status != StatusAcceptedSettlementCompleted {
+		t.Errorf("first status = %+v", s)
+	}
+	if s := statuses[1]; s.Status != StatusRejected || s.ReasonCode != "AC04" || s.AdditionalInfo != "Account closed" {
+		t.Errorf("second status = %+v", s)
+	}
+
+	data, _ = os.ReadFile("testdata/pacs002_group_reject.xml")
+	doc, err = ParsePacs002(data)
+	if err != nil {
+		t.Fatalf("ParsePacs002() error = %v", err)
+	}
+	statuses = doc.Statuses()
+	if len(statuses) != 1 || statuses[0].OriginalTxID != "" || statuses[0].Status != StatusRejected ||
+		statuses[0].ReasonCode != "DUPLICATE-FILE" || statuses[0].AdditionalInfo != "Message already received on 2024-03-05" {
+		t.Errorf("group statuses = %+v", statuses)
+	}
+}
+
+func TestNewPacs002_RoundTrip(t *testing.T) {
+	doc, err := NewPacs002(Pacs002Header{
+		MessageID:   "STS-1",
+		CreatedAt:   time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC),
+		Original:    "MSG-1",
+		OriginalNm:  Pacs008MessageName,
+		GroupStatus: StatusAcceptedTechnicalValidation,
+	}, []TransactionStatus{
+		{OriginalTxID: "TX-1", Status: StatusRejected, ReasonCode: "AC01", AdditionalInfo: "Unknown account"},
+	})
+	if err != nil {
+		t.Fatalf("NewPacs002() error = %v", err)
+	}
+	out, _ := Marshal(doc)
+	parsed, err := ParsePacs002(out)
+	if err != nil {
+		t.Fatalf("ParsePacs002() error = %v", err)
+	}
+	statuses := parsed.Statuses()
+	if len(statuses) != 1 || statuses[0].OriginalMessageID != "MSG-1" || statuses[0].ReasonCode != "AC01" {
+		t.Errorf("Statuses() = %+v", statuses)
+	}
+}
+
+func TestAmounts(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		want     int64
+		wantErr  bool
+	}{
+		{"1234.56", "EUR", 123456, false},
+		{"1234.5", "EUR", 123450, false},
+		{"1234", "EUR", 123400, false},
+		{"1234.50000", "EUR", 123450, false},
+		{"0.01", "USD", 1, false},
+		{"150000", "JPY", 150000, false},
+		{"1.5", "JPY", 0, true},
+		{"12.345", "KWD", 12345, false},
+		{"12.345", "EUR", 0, true},
+		{"0", "EUR", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.value, tt.currency)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseAmount(%s %s) = %d, %v; want %d", tt.value, tt.currency, got, err, tt.want)
+		}
+		if tt.wantErr {
+			continue
+		}
+		if back, err := ParseAmount(FormatAmount(got, tt.currency), tt.currency); err != nil || back != got {
+			t.Errorf("FormatAmount(%d %s) = %s does not round trip", got, tt.currency, FormatAmount(got, tt.currency))
+		}
+	}
+	if got := FormatAmount(5, "EUR"); got != "0.05" {
+		t.Errorf("FormatAmount(5 EUR) = %s, want 0.05", got)
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	if ns, err := Namespace([]byte(`<?xml version="1.0"?><Document xmlns="` + Pacs002Namespace + `"/>`)); err != nil || ns != Pacs002Namespace {
+		t.Errorf("Namespace() = %q, %v", ns, err)
+	}
+	if _, err := Namespace([]byte(`<Envelope/>`)); !errors.Is(err, ErrUnknownMessage) {
+		t.Errorf("Namespace(<Envelope>) error = %v, want ErrUnknownMessage", err)
+	}
+	if _, err := Namespace([]byte(`not xml`)); err == nil {
+		t.Error("Namespace(not xml) succeeded")
+	}
+}
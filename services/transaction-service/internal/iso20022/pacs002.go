@@ -0,0 +1,241 @@
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Pacs002Document is an FIToFIPaymentStatusReport: the clearing system's or
+// creditor bank's verdict on earlier messages and their transactions
+type Pacs002Document struct {
+	XMLName         xml.Name                  `xml:"Document"`
+	Xmlns           string                    `xml:"xmlns,attr"`
+	FIToFIPmtStsRpt FIToFIPaymentStatusReport `xml:"FIToFIPmtStsRpt"`
+}
+
+// FIToFIPaymentStatusReport holds group and transaction statuses
+type FIToFIPaymentStatusReport struct {
+	GrpHdr            Pacs002GroupHeader                  `xml:"GrpHdr"`
+	OrgnlGrpInfAndSts []OriginalGroupInformationAndStatus `xml:"OrgnlGrpInfAndSts,omitempty"`
+	TxInfAndSts       []PaymentTransactionStatus          `xml:"TxInfAndSts,omitempty"`
+}
+
+// Pacs002GroupHeader identifies the report
+type Pacs002GroupHeader struct {
+	MsgID    string                `xml:"MsgId"`
+	CreDtTm  string                `xml:"CreDtTm"`
+	InstgAgt *FinancialInstitution `xml:"InstgAgt,omitempty"`
+	InstdAgt *FinancialInstitution `xml:"InstdAgt,omitempty"`
+}
+
+// OriginalGroupInformationAndStatus reports on an original message as a whole
+type OriginalGroupInformationAndStatus struct {
+	OrgnlMsgID   string                    `xml:"OrgnlMsgId"`
+	OrgnlMsgNmID string                    `xml:"OrgnlMsgNmId"`
+	GrpSts       string                    `xml:"GrpSts,omitempty"`
+	StsRsnInf    []StatusReasonInformation `xml:"StsRsnInf,omitempty"`
+}
+
+// OriginalGroupInformation references the message a transaction came in
+type OriginalGroupInformation struct {
+	OrgnlMsgID   string `xml:"OrgnlMsgId"`
+	OrgnlMsgNmID string `xml:"OrgnlMsgNmId"`
+}
+
+// PaymentTransactionStatus reports on one original transaction
+type PaymentTransactionStatus struct {
+	StsID           string                    `xml:"StsId,omitempty"`
+	OrgnlGrpInf     *OriginalGroupInformation `xml:"OrgnlGrpInf,omitempty"`
+	OrgnlInstrID    string                    `xml:"OrgnlInstrId,omitempty"`
+	OrgnlEndToEndID string                    `xml:"OrgnlEndToEndId,omitempty"`
+	OrgnlTxID       string                    `xml:"OrgnlTxId,omitempty"`
+	TxSts           string                    `xml:"TxSts,omitempty"`
+	StsRsnInf       []StatusReasonInformation `xml:"StsRsnInf,omitempty"`
+	AccptncDtTm     string                    `xml:"AccptncDtTm,omitempty"`
+}
+
+// Transaction and group status codes
+const (
+	StatusAcceptedTechnicalValidation = "ACTC"
+	StatusAcceptedCustomerProfile     = "ACCP"
+	StatusAcceptedSettlementInProcess = "ACSP"
+	StatusAcceptedWithChange          = "ACWC"
+	StatusAcceptedSettlementCompleted = "ACSC"
+	StatusPending                     = "PDNG"
+	StatusRejected                    = "RJCT"
+)
+
+// TransactionStatus is a status reported for an original transaction, or
+// for every transaction of an original message when EndToEndID and TxID
+// are both empty
+type TransactionStatus struct {
+	OriginalMessageID  string
+	OriginalEndToEndID string
+	OriginalTxID       string
+	Status             string
+	ReasonCode         string
+	AdditionalInfo     string
+}
+
+// Pacs002Header describes a pacs.002 message
+type Pacs002Header struct {
+	MessageID   string
+	CreatedAt   time.Time
+	Original    string // MsgId of the message reported on
+	OriginalNm  string // Message name of the message reported on, e.g. pacs.008.001.08
+	GroupStatus string
+}
+
+// NewPacs002 builds a status report on the transactions of one original message
+func NewPacs002(hdr Pacs002Header, statuses []TransactionStatus) (*Pacs002Document, error) {
+	doc := &Pacs002Document{
+		Xmlns: Pacs002Namespace,
+		FIToFIPmtStsRpt: FIToFIPaymentStatusReport{
+			GrpHdr: Pacs002GroupHeader{MsgID: hdr.MessageID, CreDtTm: formatDateTime(hdr.CreatedAt)},
+			OrgnlGrpInfAndSts: []OriginalGroupInformationAndStatus{{
+				OrgnlMsgID:   hdr.Original,
+				OrgnlMsgNmID: hdr.OriginalNm,
+				GrpSts:       hdr.GroupStatus,
+			}},
+		},
+	}
+	for _, s := range statuses {
+		tx := PaymentTransactionStatus{
+			OrgnlEndToEndID: s.OriginalEndToEndID,
+			OrgnlTxID:       s.OriginalTxID,
+			TxSts:           s.Status,
+		}
+		if s.ReasonCode != "" || s.AdditionalInfo != "" {
+			reason := StatusReasonInformation{}
+			if s.ReasonCode != "" {
+				reason.Rsn = &StatusReason{Cd: s.ReasonCode}
+			}
+			if s.AdditionalInfo != "" {
+				reason.AddtlInf = []string{s.AdditionalInfo}
+			}
+			tx.StsRsnInf = []StatusReasonInformation{reason}
+		}
+		doc.FIToFIPmtStsRpt.TxInfAndSts = append(doc.FIToFIPmtStsRpt.TxInfAndSts, tx)
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ParsePacs002 parses and validates a pacs.002 message
+func ParsePacs002(data []byte) (*Pacs002Document, error) {
+	doc := &Pacs002Document{}
+	if err := unmarshal(data, Pacs002Namespace, doc); err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Validate checks the document against the pacs.002.001.10 schema facets.
+// Transactions that do not name their original message are taken to belong
+// to the only original group reported, so there must be exactly one.
+func (d *Pacs002Document) Validate() error {
+	v := &validator{}
+	rpt := d.FIToFIPmtStsRpt
+	hdr := "FIToFIPmtStsRpt/GrpHdr"
+	v.text(hdr+"/MsgId", rpt.GrpHdr.MsgID, max35Text, true)
+	v.dateTime(hdr+"/CreDtTm", rpt.GrpHdr.CreDtTm)
+	v.agent(hdr+"/InstgAgt", rpt.GrpHdr.InstgAgt, false)
+	v.agent(hdr+"/InstdAgt", rpt.GrpHdr.InstdAgt, false)
+
+	for i, grp := range rpt.OrgnlGrpInfAndSts {
+		path := fmt.Sprintf("FIToFIPmtStsRpt/OrgnlGrpInfAndSts[%d]", i)
+		v.text(path+"/OrgnlMsgId", grp.OrgnlMsgID, max35Text, true)
+		v.text(path+"/OrgnlMsgNmId", grp.OrgnlMsgNmID, max35Text, true)
+		v.text(path+"/GrpSts", grp.GrpSts, max4Text, false)
+		v.reasons(path+"/StsRsnInf", grp.StsRsnInf)
+	}
+	if len(rpt.OrgnlGrpInfAndSts) == 0 && len(rpt.TxInfAndSts) == 0 {
+		v.add("FIToFIPmtStsRpt", "must report on an original group or transaction")
+	}
+
+	for i, tx := range rpt.TxInfAndSts {
+		path := fmt.Sprintf("FIToFIPmtStsRpt/TxInfAndSts[%d]", i)
+		v.text(path+"/StsId", tx.StsID, max35Text, false)
+		if tx.OrgnlGrpInf != nil {
+			v.text(path+"/OrgnlGrpInf/OrgnlMsgId", tx.OrgnlGrpInf.OrgnlMsgID, max35Text, true)
+			v.text(path+"/OrgnlGrpInf/OrgnlMsgNmId", tx.OrgnlGrpInf.OrgnlMsgNmID, max35Text, true)
+		} else if len(rpt.OrgnlGrpInfAndSts) != 1 {
+			v.add(path+"/OrgnlGrpInf", "is required when the report covers %d original groups", len(rpt.OrgnlGrpInfAndSts))
+		}
+		v.text(path+"/OrgnlInstrId", tx.OrgnlInstrID, max35Text, false)
+		v.text(path+"/OrgnlEndToEndId", tx.OrgnlEndToEndID, max35Text, false)
+		v.text(path+"/OrgnlTxId", tx.OrgnlTxID, max35Text, false)
+		if tx.OrgnlEndToEndID == "" && tx.OrgnlTxID == "" {
+			v.add(path, "must reference OrgnlEndToEndId or OrgnlTxId")
+		}
+		v.text(path+"/TxSts", tx.TxSts, max4Text, true)
+		v.reasons(path+"/StsRsnInf", tx.StsRsnInf)
+		if tx.AccptncDtTm != "" {
+			v.dateTime(path+"/AccptncDtTm", tx.AccptncDtTm)
+		}
+	}
+	return v.result()
+}
+
+// Statuses lists the statuses in a validated report. A group status is
+// reported for the whole original message only when the report has no
+// transaction statuses for that message.
+func (d *Pacs002Document) Statuses() []TransactionStatus {
+	rpt := d.FIToFIPmtStsRpt
+	covered := make(map[string]bool)
+	var statuses []TransactionStatus
+	for _, tx := range rpt.TxInfAndSts {
+		original := ""
+		if tx.OrgnlGrpInf != nil {
+			original = tx.OrgnlGrpInf.OrgnlMsgID
+		} else if len(rpt.OrgnlGrpInfAndSts) == 1 {
+			original = rpt.OrgnlGrpInfAndSts[0].OrgnlMsgID
+		}
+		covered[original] = true
+		code, info := reason(tx.StsRsnInf)
+		statuses = append(statuses, TransactionStatus{
+			OriginalMessageID:  original,
+			OriginalEndToEndID: tx.OrgnlEndToEndID,
+			OriginalTxID:       tx.OrgnlTxID,
+			Status:             tx.TxSts,
+			ReasonCode:         code,
+			AdditionalInfo:     info,
+		})
+	}
+	for _, grp := range rpt.OrgnlGrpInfAndSts {
+		if grp.GrpSts == "" || covered[grp.OrgnlMsgID] {
+			continue
+		}
+		code, info := reason(grp.StsRsnInf)
+		statuses = append(statuses, TransactionStatus{
+			OriginalMessageID: grp.OrgnlMsgID,
+			Status:            grp.GrpSts,
+			ReasonCode:        code,
+			AdditionalInfo:    info,
+		})
+	}
+	return statuses
+}
+
+// reason returns the first reason code and the joined additional information
+func reason(reasons []StatusReasonInformation) (string, string) {
+	var code string
+	var info []string
+	for _, r := range reasons {
+		if code == "" && r.Rsn != nil {
+			code = r.Rsn.Cd
+			if code == "" {
+				code = r.Rsn.Prtry
+			}
+		}
+		info = append(info, r.AddtlInf...)
+	}
+	return code, strings.Join(info, " ")
+}
@@ -0,0 +1,215 @@
+package iso20022
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Settlement methods
+const (
+	SettlementMethodClearing = "CLRG"
+)
+
+var settlementMethods = map[string]bool{"INDA": true, "INGA": true, "COVE": true, SettlementMethodClearing: true}
+
+// Pacs008Document is an FIToFICustomerCreditTransfer: credit transfers
+// passed from the debtor's bank to the clearing system or creditor's bank
+type Pacs008Document struct {
+	XMLName           xml.Name                     `xml:"Document"`
+	Xmlns             string                       `xml:"xmlns,attr"`
+	FIToFICstmrCdtTrf FIToFICustomerCreditTransfer `xml:"FIToFICstmrCdtTrf"`
+}
+
+// FIToFICustomerCreditTransfer groups the interbank transfers
+type FIToFICustomerCreditTransfer struct {
+	GrpHdr      Pacs008GroupHeader        `xml:"GrpHdr"`
+	CdtTrfTxInf []InterbankCreditTransfer `xml:"CdtTrfTxInf"`
+}
+
+// Pacs008GroupHeader identifies the message and how it settles
+type Pacs008GroupHeader struct {
+	MsgID             string                   `xml:"MsgId"`
+	CreDtTm           string                   `xml:"CreDtTm"`
+	NbOfTxs           string                   `xml:"NbOfTxs"`
+	TtlIntrBkSttlmAmt *ActiveCurrencyAndAmount `xml:"TtlIntrBkSttlmAmt,omitempty"`
+	IntrBkSttlmDt     string                   `xml:"IntrBkSttlmDt,omitempty"`
+	SttlmInf          SettlementInstruction    `xml:"SttlmInf"`
+	InstgAgt          *FinancialInstitution    `xml:"InstgAgt,omitempty"`
+	InstdAgt          *FinancialInstitution    `xml:"InstdAgt,omitempty"`
+}
+
+// SettlementInstruction gives the settlement method
+type SettlementInstruction struct {
+	SttlmMtd string `xml:"SttlmMtd"`
+}
+
+// InterbankCreditTransfer is one customer credit transfer between banks
+type InterbankCreditTransfer struct {
+	PmtID          PaymentIdentification   `xml:"PmtId"`
+	IntrBkSttlmAmt ActiveCurrencyAndAmount `xml:"IntrBkSttlmAmt"`
+	ChrgBr         string                  `xml:"ChrgBr"`
+	Dbtr           PartyIdentification     `xml:"Dbtr"`
+	DbtrAcct       *CashAccount            `xml:"DbtrAcct,omitempty"`
+	DbtrAgt        *FinancialInstitution   `xml:"DbtrAgt"`
+	CdtrAgt        *FinancialInstitution   `xml:"CdtrAgt"`
+	Cdtr           PartyIdentification     `xml:"Cdtr"`
+	CdtrAcct       *CashAccount            `xml:"CdtrAcct,omitempty"`
+	RmtInf         *RemittanceInformation  `xml:"RmtInf,omitempty"`
+}
+
+// Pacs008Header describes an outgoing pacs.008 message
+type Pacs008Header struct {
+	MessageID           string
+	CreatedAt           time.Time
+	SettlementDate      time.Time
+	InstructingAgentBIC string // Our own BIC
+	InstructedAgentBIC  string // The clearing system's BIC, if it has one
+}
+
+// Transfer is an outgoing credit transfer to be cleared
+type Transfer struct {
+	InstructionID    string
+	EndToEndID       string
+	TransactionID    string
+	DebtorName       string
+	DebtorIBAN       string
+	DebtorAgentBIC   string
+	CreditorName     string
+	CreditorIBAN     string
+	CreditorAgentBIC string // Empty when unknown; sent as NOTPROVIDED
+	Amount           int64  // Minor units
+	Currency         string
+	RemittanceInfo   string
+}
+
+// NewPacs008 builds a pacs.008 for transfers in a single currency that
+// settle on the same date. The result is validated before it is returned.
+func NewPacs008(hdr Pacs008Header, transfers []Transfer) (*Pacs008Document, error) {
+	if len(transfers) == 0 {
+		return nil, errors.New("pacs.008 needs at least one transfer")
+	}
+	currency := transfers[0].Currency
+	var total int64
+	txs := make([]InterbankCreditTransfer, len(transfers))
+	for i, t := range transfers {
+		if t.Currency != currency {
+			return nil, fmt.Errorf("pacs.008 transfers must share one currency, got %s and %s", currency, t.Currency)
+		}
+		total += t.Amount
+		debtorAgent, creditorAgent := agent(t.DebtorAgentBIC), agent(t.CreditorAgentBIC)
+		txs[i] = InterbankCreditTransfer{
+			PmtID: PaymentIdentification{
+				InstrID:    t.InstructionID,
+				EndToEndID: t.EndToEndID,
+				TxID:       t.TransactionID,
+			},
+			IntrBkSttlmAmt: ActiveCurrencyAndAmount{Ccy: t.Currency, Value: FormatAmount(t.Amount, t.Currency)},
+			ChrgBr:         ChargeBearerShared,
+			Dbtr:           PartyIdentification{Nm: t.DebtorName},
+			DbtrAcct:       &CashAccount{ID: AccountIdentification{IBAN: t.DebtorIBAN}},
+			DbtrAgt:        &debtorAgent,
+			CdtrAgt:        &creditorAgent,
+			Cdtr:           PartyIdentification{Nm: t.CreditorName},
+			CdtrAcct:       &CashAccount{ID: AccountIdentification{IBAN: t.CreditorIBAN}},
+			RmtInf:         remittance(t.RemittanceInfo),
+		}
+	}
+
+	instructing := agent(hdr.InstructingAgentBIC)
+	doc := &Pacs008Document{
+		Xmlns: Pacs008Namespace,
+		FIToFICstmrCdtTrf: FIToFICustomerCreditTransfer{
+			GrpHdr: Pacs008GroupHeader{
+				MsgID:             hdr.MessageID,
+				CreDtTm:           formatDateTime(hdr.CreatedAt),
+				NbOfTxs:           fmt.Sprint(len(txs)),
+				TtlIntrBkSttlmAmt: &ActiveCurrencyAndAmount{Ccy: currency, Value: FormatAmount(total, currency)},
+				IntrBkSttlmDt:     hdr.SettlementDate.Format(dateLayout),
+				SttlmInf:          SettlementInstruction{SttlmMtd: SettlementMethodClearing},
+				InstgAgt:          &instructing,
+			},
+			CdtTrfTxInf: txs,
+		},
+	}
+	if hdr.InstructedAgentBIC != "" {
+		instructed := agent(hdr.InstructedAgentBIC)
+		doc.FIToFICstmrCdtTrf.GrpHdr.InstdAgt = &instructed
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ParsePacs008 parses and validates a pacs.008 message
+func ParsePacs008(data []byte) (*Pacs008Document, error) {
+	doc := &Pacs008Document{}
+	if err := unmarshal(data, Pacs008Namespace, doc); err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Validate checks the document against the pacs.008.001.08 schema facets
+// and that its transaction count and total add up
+func (d *Pacs008Document) Validate() error {
+	v := &validator{}
+	msg := d.FIToFICstmrCdtTrf
+	hdr := "FIToFICstmrCdtTrf/GrpHdr"
+	v.text(hdr+"/MsgId", msg.GrpHdr.MsgID, max35Text, true)
+	v.dateTime(hdr+"/CreDtTm", msg.GrpHdr.CreDtTm)
+	v.count(hdr+"/NbOfTxs", msg.GrpHdr.NbOfTxs, true)
+	var declared *big.Rat
+	if msg.GrpHdr.TtlIntrBkSttlmAmt != nil {
+		declared = v.amount(hdr+"/TtlIntrBkSttlmAmt", *msg.GrpHdr.TtlIntrBkSttlmAmt)
+	}
+	if msg.GrpHdr.IntrBkSttlmDt != "" {
+		v.date(hdr+"/IntrBkSttlmDt", msg.GrpHdr.IntrBkSttlmDt)
+	}
+	if v.required(hdr+"/SttlmInf/SttlmMtd", msg.GrpHdr.SttlmInf.SttlmMtd) && !settlementMethods[msg.GrpHdr.SttlmInf.SttlmMtd] {
+		v.add(hdr+"/SttlmInf/SttlmMtd", "%q is not a SettlementMethod1Code", msg.GrpHdr.SttlmInf.SttlmMtd)
+	}
+	v.agent(hdr+"/InstgAgt", msg.GrpHdr.InstgAgt, false)
+	v.agent(hdr+"/InstdAgt", msg.GrpHdr.InstdAgt, false)
+
+	if len(msg.CdtTrfTxInf) == 0 {
+		v.add("FIToFICstmrCdtTrf/CdtTrfTxInf", "is required")
+	}
+	sum := new(big.Rat)
+	for i, tx := range msg.CdtTrfTxInf {
+		path := fmt.Sprintf("FIToFICstmrCdtTrf/CdtTrfTxInf[%d]", i)
+		v.text(path+"/PmtId/InstrId", tx.PmtID.InstrID, max35Text, false)
+		v.text(path+"/PmtId/EndToEndId", tx.PmtID.EndToEndID, max35Text, true)
+		v.text(path+"/PmtId/TxId", tx.PmtID.TxID, max35Text, false)
+		if amt := v.amount(path+"/IntrBkSttlmAmt", tx.IntrBkSttlmAmt); amt != nil {
+			sum.Add(sum, amt)
+		}
+		if ttl := msg.GrpHdr.TtlIntrBkSttlmAmt; ttl != nil && tx.IntrBkSttlmAmt.Ccy != ttl.Ccy {
+			v.add(path+"/IntrBkSttlmAmt/@Ccy", "%s differs from the total's currency %s", tx.IntrBkSttlmAmt.Ccy, ttl.Ccy)
+		}
+		v.text(path+"/ChrgBr", tx.ChrgBr, max4Text, true)
+		v.party(path+"/Dbtr", tx.Dbtr, false)
+		if tx.DbtrAcct != nil {
+			v.account(path+"/DbtrAcct", tx.DbtrAcct)
+		}
+		v.agent(path+"/DbtrAgt", tx.DbtrAgt, true)
+		v.agent(path+"/CdtrAgt", tx.CdtrAgt, true)
+		v.party(path+"/Cdtr", tx.Cdtr, false)
+		if tx.CdtrAcct != nil {
+			v.account(path+"/CdtrAcct", tx.CdtrAcct)
+		}
+		v.remittance(path+"/RmtInf", tx.RmtInf)
+	}
+	v.controlSum(hdr, msg.GrpHdr.NbOfTxs, "", len(msg.CdtTrfTxInf), sum)
+	if declared != nil && declared.Cmp(sum) != 0 {
+		v.add(hdr+"/TtlIntrBkSttlmAmt", "declares %s, transactions total %s",
+			msg.GrpHdr.TtlIntrBkSttlmAmt.Value, sum.FloatString(amountFractionDigits))
+	}
+	return v.result()
+}
@@ -0,0 +1,229 @@
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Payment method and charge bearer codes
+const (
+	PaymentMethodTransfer = "TRF"
+	ChargeBearerShared    = "SLEV"
+)
+
+// Pain001Document is a CustomerCreditTransferInitiation: a customer's
+// instruction to its bank to make one or more credit transfers
+type Pain001Document struct {
+	XMLName          xml.Name                         `xml:"Document"`
+	Xmlns            string                           `xml:"xmlns,attr"`
+	CstmrCdtTrfInitn CustomerCreditTransferInitiation `xml:"CstmrCdtTrfInitn"`
+}
+
+// CustomerCreditTransferInitiation groups the payment information blocks
+type CustomerCreditTransferInitiation struct {
+	GrpHdr Pain001GroupHeader   `xml:"GrpHdr"`
+	PmtInf []PaymentInformation `xml:"PmtInf"`
+}
+
+// Pain001GroupHeader identifies the message and summarizes its transactions
+type Pain001GroupHeader struct {
+	MsgID    string              `xml:"MsgId"`
+	CreDtTm  string              `xml:"CreDtTm"`
+	NbOfTxs  string              `xml:"NbOfTxs"`
+	CtrlSum  string              `xml:"CtrlSum,omitempty"`
+	InitgPty PartyIdentification `xml:"InitgPty"`
+}
+
+// PaymentInformation is a batch of transfers from one debtor account on one date
+type PaymentInformation struct {
+	PmtInfID    string                      `xml:"PmtInfId"`
+	PmtMtd      string                      `xml:"PmtMtd"`
+	NbOfTxs     string                      `xml:"NbOfTxs,omitempty"`
+	CtrlSum     string                      `xml:"CtrlSum,omitempty"`
+	ReqdExctnDt DateAndDateTimeChoice       `xml:"ReqdExctnDt"`
+	Dbtr        PartyIdentification         `xml:"Dbtr"`
+	DbtrAcct    *CashAccount                `xml:"DbtrAcct"`
+	DbtrAgt     *FinancialInstitution       `xml:"DbtrAgt"`
+	ChrgBr      string                      `xml:"ChrgBr,omitempty"`
+	CdtTrfTxInf []CreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// DateAndDateTimeChoice is a date or a date and time
+type DateAndDateTimeChoice struct {
+	Dt   string `xml:"Dt,omitempty"`
+	DtTm string `xml:"DtTm,omitempty"`
+}
+
+// CreditTransferTransaction is one transfer in a payment information block
+type CreditTransferTransaction struct {
+	PmtID    PaymentIdentification  `xml:"PmtId"`
+	Amt      AmountChoice           `xml:"Amt"`
+	ChrgBr   string                 `xml:"ChrgBr,omitempty"`
+	CdtrAgt  *FinancialInstitution  `xml:"CdtrAgt,omitempty"`
+	Cdtr     PartyIdentification    `xml:"Cdtr"`
+	CdtrAcct *CashAccount           `xml:"CdtrAcct"`
+	RmtInf   *RemittanceInformation `xml:"RmtInf,omitempty"`
+}
+
+// PaymentIdentification carries the references of a transfer. TxId is set
+// by the instructing agent in interbank messages only.
+type PaymentIdentification struct {
+	InstrID    string `xml:"InstrId,omitempty"`
+	EndToEndID string `xml:"EndToEndId"`
+	TxID       string `xml:"TxId,omitempty"`
+}
+
+// AmountChoice holds the instructed amount
+type AmountChoice struct {
+	InstdAmt ActiveCurrencyAndAmount `xml:"InstdAmt"`
+}
+
+// Instruction is a single credit transfer taken from a pain.001, with the
+// batch-level details of its payment information block filled in
+type Instruction struct {
+	MessageID              string
+	PaymentInfoID          string
+	InstructionID          string
+	EndToEndID             string
+	RequestedExecutionDate time.Time
+	DebtorName             string
+	DebtorIBAN             string
+	DebtorAgentBIC         string
+	CreditorName           string
+	CreditorIBAN           string // Empty when the creditor account is not identified by IBAN
+	CreditorAgentBIC       string
+	Amount                 int64 // Minor units
+	Currency               string
+	RemittanceInfo         string
+}
+
+// ParsePain001 parses and validates a pain.001 message
+func ParsePain001(data []byte) (*Pain001Document, error) {
+	doc := &Pain001Document{}
+	if err := unmarshal(data, Pain001Namespace, doc); err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Validate checks the document against the pain.001.001.09 schema facets
+// and that its transaction counts and control sums add up
+func (d *Pain001Document) Validate() error {
+	v := &validator{}
+	msg := d.CstmrCdtTrfInitn
+	hdr := "CstmrCdtTrfInitn/GrpHdr"
+	v.text(hdr+"/MsgId", msg.GrpHdr.MsgID, max35Text, true)
+	v.dateTime(hdr+"/CreDtTm", msg.GrpHdr.CreDtTm)
+	v.count(hdr+"/NbOfTxs", msg.GrpHdr.NbOfTxs, true)
+	v.decimal(hdr+"/CtrlSum", msg.GrpHdr.CtrlSum)
+	v.party(hdr+"/InitgPty", msg.GrpHdr.InitgPty, false)
+
+	if len(msg.PmtInf) == 0 {
+		v.add("CstmrCdtTrfInitn/PmtInf", "is required")
+	}
+	total, count := new(big.Rat), 0
+	for i, pmt := range msg.PmtInf {
+		path := fmt.Sprintf("CstmrCdtTrfInitn/PmtInf[%d]", i)
+		v.text(path+"/PmtInfId", pmt.PmtInfID, max35Text, true)
+		if v.required(path+"/PmtMtd", pmt.PmtMtd) && pmt.PmtMtd != PaymentMethodTransfer {
+			v.add(path+"/PmtMtd", "%q is not a credit transfer payment method", pmt.PmtMtd)
+		}
+		v.count(path+"/NbOfTxs", pmt.NbOfTxs, false)
+		v.decimal(path+"/CtrlSum", pmt.CtrlSum)
+		switch {
+		case pmt.ReqdExctnDt.Dt != "" && pmt.ReqdExctnDt.DtTm != "":
+			v.add(path+"/ReqdExctnDt", "must contain either Dt or DtTm")
+		case pmt.ReqdExctnDt.DtTm != "":
+			v.dateTime(path+"/ReqdExctnDt/DtTm", pmt.ReqdExctnDt.DtTm)
+		default:
+			v.date(path+"/ReqdExctnDt/Dt", pmt.ReqdExctnDt.Dt)
+		}
+		v.party(path+"/Dbtr", pmt.Dbtr, false)
+		v.account(path+"/DbtrAcct", pmt.DbtrAcct)
+		v.agent(path+"/DbtrAgt", pmt.DbtrAgt, true)
+		v.text(path+"/ChrgBr", pmt.ChrgBr, max4Text, false)
+
+		if len(pmt.CdtTrfTxInf) == 0 {
+			v.add(path+"/CdtTrfTxInf", "is required")
+		}
+		sum := new(big.Rat)
+		for j, tx := range pmt.CdtTrfTxInf {
+			txPath := fmt.Sprintf("%s/CdtTrfTxInf[%d]", path, j)
+			v.text(txPath+"/PmtId/InstrId", tx.PmtID.InstrID, max35Text, false)
+			v.text(txPath+"/PmtId/EndToEndId", tx.PmtID.EndToEndID, max35Text, true)
+			if tx.PmtID.TxID != "" {
+				v.add(txPath+"/PmtId/TxId", "is not allowed in pain.001")
+			}
+			if amt := v.amount(txPath+"/Amt/InstdAmt", tx.Amt.InstdAmt); amt != nil {
+				sum.Add(sum, amt)
+			}
+			v.text(txPath+"/ChrgBr", tx.ChrgBr, max4Text, false)
+			v.agent(txPath+"/CdtrAgt", tx.CdtrAgt, false)
+			v.party(txPath+"/Cdtr", tx.Cdtr, true)
+			v.account(txPath+"/CdtrAcct", tx.CdtrAcct)
+			v.remittance(txPath+"/RmtInf", tx.RmtInf)
+		}
+		v.controlSum(path, pmt.NbOfTxs, pmt.CtrlSum, len(pmt.CdtTrfTxInf), sum)
+		total.Add(total, sum)
+		count += len(pmt.CdtTrfTxInf)
+	}
+	v.controlSum(hdr, msg.GrpHdr.NbOfTxs, msg.GrpHdr.CtrlSum, count, total)
+	return v.result()
+}
+
+// Instructions flattens a validated document into individual credit
+// transfers. An amount that cannot be expressed in minor units of its
+// currency fails the whole message.
+func (d *Pain001Document) Instructions() ([]Instruction, error) {
+	msg := d.CstmrCdtTrfInitn
+	var instructions []Instruction
+	for i, pmt := range msg.PmtInf {
+		executionDate, err := pmt.ReqdExctnDt.date()
+		if err != nil {
+			return nil, fmt.Errorf("PmtInf[%d]: %w", i, err)
+		}
+		for j, tx := range pmt.CdtTrfTxInf {
+			amount, err := ParseAmount(tx.Amt.InstdAmt.Value, tx.Amt.InstdAmt.Ccy)
+			if err != nil {
+				return nil, ValidationErrors{{
+					Path:    fmt.Sprintf("CstmrCdtTrfInitn/PmtInf[%d]/CdtTrfTxInf[%d]/Amt/InstdAmt", i, j),
+					Message: err.Error(),
+				}}
+			}
+			instructions = append(instructions, Instruction{
+				MessageID:              msg.GrpHdr.MsgID,
+				PaymentInfoID:          pmt.PmtInfID,
+				InstructionID:          tx.PmtID.InstrID,
+				EndToEndID:             tx.PmtID.EndToEndID,
+				RequestedExecutionDate: executionDate,
+				DebtorName:             pmt.Dbtr.Nm,
+				DebtorIBAN:             pmt.DbtrAcct.ID.IBAN,
+				DebtorAgentBIC:         pmt.DbtrAgt.BIC(),
+				CreditorName:           tx.Cdtr.Nm,
+				CreditorIBAN:           tx.CdtrAcct.ID.IBAN,
+				CreditorAgentBIC:       tx.CdtrAgt.BIC(),
+				Amount:                 amount,
+				Currency:               tx.Amt.InstdAmt.Ccy,
+				RemittanceInfo:         tx.RmtInf.Text(),
+			})
+		}
+	}
+	return instructions, nil
+}
+
+// date returns the requested date; a date and time is truncated to its UTC date
+func (c DateAndDateTimeChoice) date() (time.Time, error) {
+	if c.DtTm != "" {
+		t, err := parseDateTime(c.DtTm)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+	return time.Parse(dateLayout, c.Dt)
+}
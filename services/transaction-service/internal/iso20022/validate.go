@@ -0,0 +1,259 @@
+package iso20022
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidationError is a schema violation at an element path such as
+// CstmrCdtTrfInitn/PmtInf[0]/CdtTrfTxInf[1]/Amt/InstdAmt
+type ValidationError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors lists every violation found in a message
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, ve := range e {
+		msgs[i] = fmt.Sprintf("%s: %s", ve.Path, ve.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Schema patterns of the simple types used by the supported messages
+var (
+	bicPattern      = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	ibanPattern     = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[a-zA-Z0-9]{1,30}$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryPattern  = regexp.MustCompile(`^[A-Z]{2}$`)
+	countPattern    = regexp.MustCompile(`^[0-9]{1,15}$`)
+	decimalPattern  = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+	dateTimePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})?$`)
+)
+
+// Lengths of the ISO text types
+const (
+	max4Text   = 4
+	max35Text  = 35
+	max70Text  = 70
+	max105Text = 105
+	max140Text = 140
+	max16Text  = 16
+)
+
+// Facets of ActiveCurrencyAndAmount and DecimalNumber
+const (
+	amountFractionDigits  = 5
+	amountTotalDigits     = 18
+	decimalFractionDigits = 17
+)
+
+// validator accumulates violations while walking a document
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) add(path, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) result() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// required checks a mandatory element is present
+func (v *validator) required(path, value string) bool {
+	if value == "" {
+		v.add(path, "is required")
+		return false
+	}
+	return true
+}
+
+// text checks a MaxNText element; empty optional elements are skipped
+func (v *validator) text(path, value string, maxLen int, mandatory bool) {
+	if value == "" {
+		if mandatory {
+			v.add(path, "is required")
+		}
+		return
+	}
+	if n := utf8.RuneCountInString(value); n > maxLen {
+		v.add(path, "must be at most %d characters, got %d", maxLen, n)
+	}
+}
+
+func (v *validator) pattern(path, value string, re *regexp.Regexp, what string, mandatory bool) {
+	if value == "" {
+		if mandatory {
+			v.add(path, "is required")
+		}
+		return
+	}
+	if !re.MatchString(value) {
+		v.add(path, "%q is not a valid %s", value, what)
+	}
+}
+
+func (v *validator) dateTime(path, value string) {
+	if !v.required(path, value) {
+		return
+	}
+	if !dateTimePattern.MatchString(value) {
+		v.add(path, "%q is not an ISODateTime", value)
+		return
+	}
+	if _, err := parseDateTime(value); err != nil {
+		v.add(path, "%q is not an ISODateTime", value)
+	}
+}
+
+func (v *validator) date(path, value string) {
+	if !v.required(path, value) {
+		return
+	}
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		v.add(path, "%q is not an ISODate", value)
+	}
+}
+
+// count checks a Max15NumericText transaction count
+func (v *validator) count(path, value string, mandatory bool) {
+	v.pattern(path, value, countPattern, "Max15NumericText", mandatory)
+}
+
+// decimal checks a DecimalNumber control sum
+func (v *validator) decimal(path, value string) {
+	if value == "" {
+		return
+	}
+	if !decimalPattern.MatchString(value) {
+		v.add(path, "%q is not a DecimalNumber", value)
+		return
+	}
+	integer, fraction, _ := strings.Cut(value, ".")
+	if len(fraction) > decimalFractionDigits || len(strings.TrimLeft(integer, "0"))+len(fraction) > amountTotalDigits {
+		v.add(path, "%q exceeds %d digits or %d decimal places", value, amountTotalDigits, decimalFractionDigits)
+	}
+}
+
+// amount checks an ActiveCurrencyAndAmount and returns its value
+func (v *validator) amount(path string, amt ActiveCurrencyAndAmount) *big.Rat {
+	v.pattern(path+"/@Ccy", amt.Ccy, currencyPattern, "ActiveCurrencyCode", true)
+	if !v.required(path, amt.Value) {
+		return nil
+	}
+	if !decimalPattern.MatchString(amt.Value) {
+		v.add(path, "%q is not a non-negative decimal amount", amt.Value)
+		return nil
+	}
+	integer, fraction, _ := strings.Cut(amt.Value, ".")
+	if len(fraction) > amountFractionDigits || len(strings.TrimLeft(integer, "0"))+len(fraction) > amountTotalDigits {
+		v.add(path, "%q exceeds %d digits or %d decimal places", amt.Value, amountTotalDigits, amountFractionDigits)
+		return nil
+	}
+	r, _ := new(big.Rat).SetString(amt.Value)
+	return r
+}
+
+// controlSum checks a declared transaction count and control sum against
+// the transactions they summarize
+func (v *validator) controlSum(path, nbOfTxs, ctrlSum string, count int, sum *big.Rat) {
+	if nbOfTxs != "" && countPattern.MatchString(nbOfTxs) && nbOfTxs != fmt.Sprint(count) {
+		v.add(path+"/NbOfTxs", "declares %s transactions, message has %d", nbOfTxs, count)
+	}
+	if ctrlSum == "" || sum == nil || !decimalPattern.MatchString(ctrlSum) {
+		return
+	}
+	declared, _ := new(big.Rat).SetString(ctrlSum)
+	if declared.Cmp(sum) != 0 {
+		v.add(path+"/CtrlSum", "declares %s, transactions total %s", ctrlSum, sum.FloatString(amountFractionDigits))
+	}
+}
+
+func (v *validator) party(path string, p PartyIdentification, nameRequired bool) {
+	v.text(path+"/Nm", p.Nm, max140Text, nameRequired)
+	if a := p.PstlAdr; a != nil {
+		v.text(path+"/PstlAdr/StrtNm", a.StrtNm, max70Text, false)
+		v.text(path+"/PstlAdr/BldgNb", a.BldgNb, max16Text, false)
+		v.text(path+"/PstlAdr/PstCd", a.PstCd, max16Text, false)
+		v.text(path+"/PstlAdr/TwnNm", a.TwnNm, max35Text, false)
+		v.pattern(path+"/PstlAdr/Ctry", a.Ctry, countryPattern, "CountryCode", false)
+		if len(a.AdrLine) > 7 {
+			v.add(path+"/PstlAdr/AdrLine", "must occur at most 7 times")
+		}
+		for i, line := range a.AdrLine {
+			v.text(fmt.Sprintf("%s/PstlAdr/AdrLine[%d]", path, i), line, max70Text, true)
+		}
+	}
+}
+
+func (v *validator) account(path string, a *CashAccount) {
+	if a == nil {
+		v.add(path, "is required")
+		return
+	}
+	switch {
+	case a.ID.IBAN != "" && a.ID.Othr != nil:
+		v.add(path+"/Id", "must contain either IBAN or Othr")
+	case a.ID.IBAN != "":
+		v.pattern(path+"/Id/IBAN", a.ID.IBAN, ibanPattern, "IBAN2007Identifier", true)
+	case a.ID.Othr != nil:
+		v.text(path+"/Id/Othr/Id", a.ID.Othr.ID, 34, true)
+	default:
+		v.add(path+"/Id", "must contain IBAN or Othr")
+	}
+	v.pattern(path+"/Ccy", a.Ccy, currencyPattern, "ActiveOrHistoricCurrencyCode", false)
+}
+
+func (v *validator) agent(path string, a *FinancialInstitution, mandatory bool) {
+	if a == nil {
+		if mandatory {
+			v.add(path, "is required")
+		}
+		return
+	}
+	id := a.FinInstnID
+	if id.BICFI == "" && id.Othr == nil {
+		v.add(path+"/FinInstnId", "must contain BICFI or Othr")
+	}
+	v.pattern(path+"/FinInstnId/BICFI", id.BICFI, bicPattern, "BICFI", false)
+	if id.Othr != nil {
+		v.text(path+"/FinInstnId/Othr/Id", id.Othr.ID, max35Text, true)
+	}
+}
+
+func (v *validator) remittance(path string, r *RemittanceInformation) {
+	if r == nil {
+		return
+	}
+	for i, line := range r.Ustrd {
+		v.text(fmt.Sprintf("%s/Ustrd[%d]", path, i), line, max140Text, true)
+	}
+}
+
+func (v *validator) reasons(path string, reasons []StatusReasonInformation) {
+	for i, r := range reasons {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		if r.Rsn != nil {
+			if (r.Rsn.Cd == "") == (r.Rsn.Prtry == "") {
+				v.add(p+"/Rsn", "must contain either Cd or Prtry")
+			}
+			v.text(p+"/Rsn/Cd", r.Rsn.Cd, max4Text, false)
+			v.text(p+"/Rsn/Prtry", r.Rsn.Prtry, max35Text, false)
+		}
+		for j, line := range r.AddtlInf {
+			v.text(fmt.Sprintf("%s/AddtlInf[%d]", p, j), line, max105Text, true)
+		}
+	}
+}
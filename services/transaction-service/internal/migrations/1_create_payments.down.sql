@@ -0,0 +1,7 @@
+-- Drop tables
+DROP TABLE IF EXISTS iso_messages;
+DROP TABLE IF EXISTS payments;
+
+-- Drop types
+DROP TYPE IF EXISTS message_direction;
+DROP TYPE IF EXISTS payment_status;
@@ -0,0 +1,51 @@
+-- Create payments table. Each row is one outgoing credit transfer taken from
+-- a customer's pain.001 and tracked through clearing.
+CREATE TYPE payment_status AS ENUM ('Received', 'Submitted', 'Accepted', 'Pending', 'Settled', 'Rejected');
+
+CREATE TABLE payments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    message_id VARCHAR(35) NOT NULL, -- pain.001 MsgId
+    payment_info_id VARCHAR(35) NOT NULL,
+    instruction_id VARCHAR(35) NOT NULL DEFAULT '',
+    end_to_end_id VARCHAR(35) NOT NULL,
+    debtor_name VARCHAR(140) NOT NULL,
+    debtor_iban VARCHAR(34) NOT NULL,
+    creditor_name VARCHAR(140) NOT NULL,
+    creditor_iban VARCHAR(34) NOT NULL DEFAULT '',
+    creditor_agent_bic VARCHAR(11) NOT NULL DEFAULT '',
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Minor units
+    currency CHAR(3) NOT NULL,
+    requested_execution_date DATE NOT NULL,
+    remittance_info VARCHAR(140) NOT NULL DEFAULT '',
+    status payment_status NOT NULL DEFAULT 'Received',
+    status_reason VARCHAR(35) NOT NULL DEFAULT '', -- ISO 20022 reason code
+    status_info VARCHAR(255) NOT NULL DEFAULT '',
+    clearing_message_id VARCHAR(35) NOT NULL DEFAULT '', -- pacs.008 MsgId
+    transaction_id VARCHAR(35) NOT NULL UNIQUE, -- pacs.008 TxId
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_payments_status_execution_date ON payments(status, requested_execution_date);
+CREATE INDEX idx_payments_clearing_message_id ON payments(clearing_message_id);
+
+-- Create ISO 20022 message log. Inbound messages are unique per type and
+-- MsgId so a file delivered twice is processed once; outbound messages wait
+-- here until written to the outbox.
+CREATE TYPE message_direction AS ENUM ('Inbound', 'Outbound');
+
+CREATE TABLE iso_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    direction message_direction NOT NULL,
+    message_type VARCHAR(35) NOT NULL, -- e.g. pacs.008.001.08
+    message_id VARCHAR(35) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (direction, message_type, message_id)
+);
+
+CREATE INDEX idx_iso_messages_undelivered ON iso_messages(created_at)
+    WHERE direction = 'Outbound' AND delivered_at IS NULL;
@@ -0,0 +1,13 @@
+-- Drop tables
+DROP TABLE IF EXISTS ach_corrections;
+DROP TABLE IF EXISTS ach_entries;
+DROP TABLE IF EXISTS ach_files;
+
+-- Drop sequences
+DROP SEQUENCE IF EXISTS ach_trace_seq;
+
+-- Drop types
+DROP TYPE IF EXISTS ach_correction_status;
+DROP TYPE IF EXISTS ach_entry_status;
+DROP TYPE IF EXISTS ach_account_type;
+DROP TYPE IF EXISTS ach_entry_type;
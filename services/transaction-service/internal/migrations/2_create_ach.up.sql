@@ -0,0 +1,95 @@
+-- Create ACH entries. Outbound entries are originated for our customers and
+-- batched into NACHA files; inbound entries are received in files from the
+-- ACH operator and posted to our customers' accounts.
+CREATE TYPE ach_entry_type AS ENUM ('Credit', 'Debit');
+CREATE TYPE ach_account_type AS ENUM ('Checking', 'Savings');
+CREATE TYPE ach_entry_status AS ENUM ('Pending', 'Sent', 'Rejected', 'Returned', 'Posted', 'Unposted');
+
+-- Create ACH files. Inbound files are unique on the fields NACHA uses to
+-- identify a duplicate file; outbound files wait here until written to the
+-- outbox.
+CREATE TABLE ach_files (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    direction message_direction NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    immediate_origin VARCHAR(10) NOT NULL,
+    file_created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    id_modifier CHAR(1) NOT NULL,
+    entry_count INTEGER NOT NULL DEFAULT 0,
+    total_debit BIGINT NOT NULL DEFAULT 0, -- Cents
+    total_credit BIGINT NOT NULL DEFAULT 0, -- Cents
+    content BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (direction, immediate_origin, file_created_at, id_modifier)
+);
+
+CREATE INDEX idx_ach_files_undelivered ON ach_files(created_at)
+    WHERE direction = 'Outbound' AND delivered_at IS NULL;
+
+CREATE TABLE ach_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    direction message_direction NOT NULL,
+    account_id UUID, -- Our customer's account; NULL for inbound entries we could not match
+    sec_code CHAR(3) NOT NULL,
+    entry_type ach_entry_type NOT NULL,
+    account_type ach_account_type NOT NULL DEFAULT 'Checking',
+    routing_number CHAR(9) NOT NULL,
+    account_number VARCHAR(17) NOT NULL,
+    name VARCHAR(22) NOT NULL,
+    individual_id VARCHAR(15) NOT NULL DEFAULT '',
+    company_name VARCHAR(16) NOT NULL,
+    company_id VARCHAR(10) NOT NULL,
+    company_entry_description VARCHAR(10) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount >= 0), -- Cents; zero for prenotes
+    effective_date DATE NOT NULL,
+    payment_info VARCHAR(80) NOT NULL DEFAULT '',
+    trace_number VARCHAR(15) NOT NULL DEFAULT '',
+    file_id UUID REFERENCES ach_files(id),
+    status ach_entry_status NOT NULL DEFAULT 'Pending',
+    return_code VARCHAR(3) NOT NULL DEFAULT '',
+    status_reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_ach_entries_pending ON ach_entries(effective_date, created_at)
+    WHERE status = 'Pending';
+CREATE UNIQUE INDEX idx_ach_entries_outbound_trace ON ach_entries(trace_number)
+    WHERE direction = 'Outbound' AND trace_number <> '';
+CREATE INDEX idx_ach_entries_account_id ON ach_entries(account_id);
+CREATE INDEX idx_ach_entries_file_id ON ach_entries(file_id);
+
+-- Trace numbers of outbound entries are the ODFI identification followed by
+-- seven digits from this sequence
+CREATE SEQUENCE ach_trace_seq MAXVALUE 9999999 CYCLE;
+
+-- Create ACH corrections. A notification of change, or a return showing the
+-- receiver's details are wrong, flags the details until someone corrects
+-- them.
+CREATE TYPE ach_correction_status AS ENUM ('Open', 'Resolved');
+
+CREATE TABLE ach_corrections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_id UUID NOT NULL REFERENCES ach_entries(id),
+    account_id UUID,
+    code VARCHAR(3) NOT NULL, -- Change code or return reason code
+    description VARCHAR(255) NOT NULL,
+    routing_number CHAR(9) NOT NULL,
+    account_number VARCHAR(17) NOT NULL,
+    corrected_routing_number VARCHAR(9) NOT NULL DEFAULT '',
+    corrected_account_number VARCHAR(17) NOT NULL DEFAULT '',
+    corrected_account_type ach_account_type,
+    corrected_name VARCHAR(22) NOT NULL DEFAULT '',
+    corrected_individual_id VARCHAR(15) NOT NULL DEFAULT '',
+    corrected_data VARCHAR(29) NOT NULL DEFAULT '',
+    status ach_correction_status NOT NULL DEFAULT 'Open',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    resolved_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_ach_corrections_open ON ach_corrections(created_at)
+    WHERE status = 'Open';
+CREATE INDEX idx_ach_corrections_account_id ON ach_corrections(account_id);
@@ -0,0 +1,233 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ACHEntryType tells whether an entry credits or debits the receiver's account
+type ACHEntryType string
+
+const (
+	ACHEntryTypeCredit ACHEntryType = "Credit"
+	ACHEntryTypeDebit  ACHEntryType = "Debit"
+)
+
+// IsValid checks if the entry type is valid
+func (t ACHEntryType) IsValid() bool {
+	return t == ACHEntryTypeCredit || t == ACHEntryTypeDebit
+}
+
+// Value implements driver.Valuer for ACHEntryType
+func (t ACHEntryType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for ACHEntryType
+func (t *ACHEntryType) Scan(value interface{}) error {
+	if value == nil {
+		*t = ACHEntryTypeCredit
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ACHEntryType")
+	}
+	*t = ACHEntryType(str)
+	if !t.IsValid() {
+		return errors.New("invalid ACHEntryType value")
+	}
+	return nil
+}
+
+// ACHAccountType is the type of account an ACH entry is addressed to
+type ACHAccountType string
+
+const (
+	ACHAccountTypeChecking ACHAccountType = "Checking"
+	ACHAccountTypeSavings  ACHAccountType = "Savings"
+)
+
+// IsValid checks if the account type is valid
+func (t ACHAccountType) IsValid() bool {
+	return t == ACHAccountTypeChecking || t == ACHAccountTypeSavings
+}
+
+// Value implements driver.Valuer for ACHAccountType
+func (t ACHAccountType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// Scan implements sql.Scanner for ACHAccountType
+func (t *ACHAccountType) Scan(value interface{}) error {
+	if value == nil {
+		*t = ACHAccountTypeChecking
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ACHAccountType")
+	}
+	*t = ACHAccountType(str)
+	if !t.IsValid() {
+		return errors.New("invalid ACHAccountType value")
+	}
+	return nil
+}
+
+// ACHEntryStatus tracks an ACH entry
+type ACHEntryStatus string
+
+const (
+	ACHEntryStatusPending  ACHEntryStatus = "Pending"  // Originated, waiting for the next file
+	ACHEntryStatusSent     ACHEntryStatus = "Sent"     // Posted and included in a file
+	ACHEntryStatusRejected ACHEntryStatus = "Rejected" // Could not be posted when the file was built
+	ACHEntryStatusReturned ACHEntryStatus = "Returned" // Returned by the receiving bank and reversed
+	ACHEntryStatusPosted   ACHEntryStatus = "Posted"   // Received and posted to the customer's account
+	ACHEntryStatusUnposted ACHEntryStatus = "Unposted" // Received but could not be posted; needs a manual return
+)
+
+// IsValid checks if the status is valid
+func (s ACHEntryStatus) IsValid() bool {
+	switch s {
+	case ACHEntryStatusPending, ACHEntryStatusSent, ACHEntryStatusRejected,
+		ACHEntryStatusReturned, ACHEntryStatusPosted, ACHEntryStatusUnposted:
+		return true
+	}
+	return false
+}
+
+// Value implements driver.Valuer for ACHEntryStatus
+func (s ACHEntryStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for ACHEntryStatus
+func (s *ACHEntryStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = ACHEntryStatusPending
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ACHEntryStatus")
+	}
+	*s = ACHEntryStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid ACHEntryStatus value")
+	}
+	return nil
+}
+
+// ACHEntry is an ACH entry sent for one of our customers or received for
+// one. For outbound entries the routing and account number are the
+// receiver's at the other bank; for inbound entries the account number is
+// our customer's and the routing number identifies the originating bank.
+// AccountID is our customer's account, nil for an inbound entry addressed
+// to an account we do not hold. Amount is in cents.
+type ACHEntry struct {
+	ID                      uuid.UUID        `json:"id" db:"id"`
+	Direction               MessageDirection `json:"direction" db:"direction"`
+	AccountID               *uuid.UUID       `json:"account_id,omitempty" db:"account_id"`
+	SECCode                 string           `json:"sec_code" db:"sec_code"`
+	EntryType               ACHEntryType     `json:"entry_type" db:"entry_type"`
+	AccountType             ACHAccountType   `json:"account_type" db:"account_type"`
+	RoutingNumber           string           `json:"routing_number" db:"routing_number"`
+	AccountNumber           string           `json:"account_number" db:"account_number"`
+	Name                    string           `json:"name" db:"name"`
+	IndividualID            string           `json:"individual_id,omitempty" db:"individual_id"`
+	CompanyName             string           `json:"company_name" db:"company_name"`
+	CompanyID               string           `json:"company_id" db:"company_id"`
+	CompanyEntryDescription string           `json:"company_entry_description" db:"company_entry_description"`
+	Amount                  int64            `json:"amount" db:"amount"`
+	EffectiveDate           time.Time        `json:"effective_date" db:"effective_date"`
+	PaymentInfo             string           `json:"payment_info,omitempty" db:"payment_info"`
+	TraceNumber             string           `json:"trace_number,omitempty" db:"trace_number"`
+	FileID                  *uuid.UUID       `json:"file_id,omitempty" db:"file_id"`
+	Status                  ACHEntryStatus   `json:"status" db:"status"`
+	ReturnCode              string           `json:"return_code,omitempty" db:"return_code"`
+	StatusReason            string           `json:"status_reason,omitempty" db:"status_reason"`
+	CreatedAt               time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt               time.Time        `json:"updated_at" db:"updated_at"`
+	Version                 int              `json:"version" db:"version"` // Optimistic locking
+}
+
+// ACHFile is an ACH file built for or received from the ACH operator.
+// Inbound files are unique on their origin, creation time and file ID
+// modifier, which is how NACHA identifies a duplicate file.
+type ACHFile struct {
+	ID              uuid.UUID        `json:"id" db:"id"`
+	Direction       MessageDirection `json:"direction" db:"direction"`
+	FileName        string           `json:"file_name" db:"file_name"`
+	ImmediateOrigin string           `json:"immediate_origin" db:"immediate_origin"`
+	FileCreatedAt   time.Time        `json:"file_created_at" db:"file_created_at"`
+	IDModifier      string           `json:"id_modifier" db:"id_modifier"`
+	EntryCount      int              `json:"entry_count" db:"entry_count"`
+	TotalDebit      int64            `json:"total_debit" db:"total_debit"`
+	TotalCredit     int64            `json:"total_credit" db:"total_credit"`
+	Content         []byte           `json:"-" db:"content"`
+	CreatedAt       time.Time        `json:"created_at" db:"created_at"`
+	DeliveredAt     *time.Time       `json:"delivered_at,omitempty" db:"delivered_at"` // Outbound only
+}
+
+// ACHCorrectionStatus tracks a flagged account correction
+type ACHCorrectionStatus string
+
+const (
+	ACHCorrectionStatusOpen     ACHCorrectionStatus = "Open"
+	ACHCorrectionStatusResolved ACHCorrectionStatus = "Resolved"
+)
+
+// IsValid checks if the status is valid
+func (s ACHCorrectionStatus) IsValid() bool {
+	return s == ACHCorrectionStatusOpen || s == ACHCorrectionStatusResolved
+}
+
+// Value implements driver.Valuer for ACHCorrectionStatus
+func (s ACHCorrectionStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for ACHCorrectionStatus
+func (s *ACHCorrectionStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = ACHCorrectionStatusOpen
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan ACHCorrectionStatus")
+	}
+	*s = ACHCorrectionStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid ACHCorrectionStatus value")
+	}
+	return nil
+}
+
+// ACHCorrection flags a receiver's account details as needing correction
+// before they are used again. It is raised by a notification of change,
+// which carries the corrected details, or by a return whose reason shows
+// the details are wrong, which does not.
+type ACHCorrection struct {
+	ID                     uuid.UUID           `json:"id" db:"id"`
+	EntryID                uuid.UUID           `json:"entry_id" db:"entry_id"`
+	AccountID              *uuid.UUID          `json:"account_id,omitempty" db:"account_id"`
+	Code                   string              `json:"code" db:"code"` // C01-C14 or the return reason code
+	Description            string              `json:"description" db:"description"`
+	RoutingNumber          string              `json:"routing_number" db:"routing_number"`
+	AccountNumber          string              `json:"account_number" db:"account_number"`
+	CorrectedRoutingNumber string              `json:"corrected_routing_number,omitempty" db:"corrected_routing_number"`
+	CorrectedAccountNumber string              `json:"corrected_account_number,omitempty" db:"corrected_account_number"`
+	CorrectedAccountType   ACHAccountType      `json:"corrected_account_type,omitempty" db:"corrected_account_type"`
+	CorrectedName          string              `json:"corrected_name,omitempty" db:"corrected_name"`
+	CorrectedIndividualID  string              `json:"corrected_individual_id,omitempty" db:"corrected_individual_id"`
+	CorrectedData          string              `json:"corrected_data,omitempty" db:"corrected_data"` // As received
+	Status                 ACHCorrectionStatus `json:"status" db:"status"`
+	CreatedAt              time.Time           `json:"created_at" db:"created_at"`
+	ResolvedAt             *time.Time          `json:"resolved_at,omitempty" db:"resolved_at"`
+	ResolvedBy             string              `json:"resolved_by,omitempty" db:"resolved_by"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACHEnums_Scan(t *testing.T) {
+	var status ACHEntryStatus
+	assert.NoError(t, status.Scan("Unposted"))
+	assert.Equal(t, ACHEntryStatusUnposted, status)
+	assert.Error(t, status.Scan("Settled"))
+	assert.Error(t, status.Scan(42))
+	assert.NoError(t, status.Scan(nil))
+	assert.Equal(t, ACHEntryStatusPending, status)
+
+	var entryType ACHEntryType
+	assert.NoError(t, entryType.Scan("Debit"))
+	assert.Equal(t, ACHEntryTypeDebit, entryType)
+	assert.Error(t, entryType.Scan("Refund"))
+
+	var accountType ACHAccountType
+	assert.NoError(t, accountType.Scan("Savings"))
+	assert.Equal(t, ACHAccountTypeSavings, accountType)
+	assert.Error(t, accountType.Scan("Loan"))
+
+	var correction ACHCorrectionStatus
+	assert.NoError(t, correction.Scan("Resolved"))
+	assert.Equal(t, ACHCorrectionStatusResolved, correction)
+	assert.Error(t, correction.Scan("Closed"))
+}
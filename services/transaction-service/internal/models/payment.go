@@ -0,0 +1,142 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentStatus tracks an outgoing payment from initiation to settlement
+type PaymentStatus string
+
+const (
+	PaymentStatusReceived  PaymentStatus = "Received"  // Imported, waiting to be sent for clearing
+	PaymentStatusSubmitted PaymentStatus = "Submitted" // Included in a pacs.008
+	PaymentStatusAccepted  PaymentStatus = "Accepted"  // Accepted by the clearing system
+	PaymentStatusPending   PaymentStatus = "Pending"   // Held by the clearing system
+	PaymentStatusSettled   PaymentStatus = "Settled"
+	PaymentStatusRejected  PaymentStatus = "Rejected"
+)
+
+// IsValid checks if the status is valid
+func (s PaymentStatus) IsValid() bool {
+	switch s {
+	case PaymentStatusReceived, PaymentStatusSubmitted, PaymentStatusAccepted,
+		PaymentStatusPending, PaymentStatusSettled, PaymentStatusRejected:
+		return true
+	}
+	return false
+}
+
+// IsFinal reports whether the payment can change status no further
+func (s PaymentStatus) IsFinal() bool {
+	return s == PaymentStatusSettled || s == PaymentStatusRejected
+}
+
+// paymentTransitions lists the statuses each status may move to
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusReceived:  {PaymentStatusSubmitted, PaymentStatusRejected},
+	PaymentStatusSubmitted: {PaymentStatusAccepted, PaymentStatusPending, PaymentStatusSettled, PaymentStatusRejected},
+	PaymentStatusAccepted:  {PaymentStatusPending, PaymentStatusSettled, PaymentStatusRejected},
+	PaymentStatusPending:   {PaymentStatusAccepted, PaymentStatusSettled, PaymentStatusRejected},
+}
+
+// CanTransitionTo reports whether a payment may move from s to next
+func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	for _, allowed := range paymentTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Value implements driver.Valuer for PaymentStatus
+func (s PaymentStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner for PaymentStatus
+func (s *PaymentStatus) Scan(value interface{}) error {
+	if value == nil {
+		*s = PaymentStatusReceived
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return errors.New("failed to scan PaymentStatus")
+	}
+	*s = PaymentStatus(str)
+	if !s.IsValid() {
+		return errors.New("invalid PaymentStatus value")
+	}
+	return nil
+}
+
+// ErrInvalidTransition is returned when a payment cannot move to a status
+var ErrInvalidTransition = errors.New("invalid payment status transition")
+
+// Payment is an outgoing customer credit transfer. It is created from a
+// pain.001 instruction, sent for clearing in a pacs.008 and updated from
+// the pacs.002 status reports that follow. Amount is in minor units.
+type Payment struct {
+	ID                     uuid.UUID     `json:"id" db:"id"`
+	MessageID              string        `json:"message_id" db:"message_id"` // pain.001 MsgId
+	PaymentInfoID          string        `json:"payment_info_id" db:"payment_info_id"`
+	InstructionID          string        `json:"instruction_id,omitempty" db:"instruction_id"`
+	EndToEndID             string        `json:"end_to_end_id" db:"end_to_end_id"`
+	DebtorName             string        `json:"debtor_name" db:"debtor_name"`
+	DebtorIBAN             string        `json:"debtor_iban" db:"debtor_iban"`
+	CreditorName           string        `json:"creditor_name" db:"creditor_name"`
+	CreditorIBAN           string        `json:"creditor_iban" db:"creditor_iban"`
+	CreditorAgentBIC       string        `json:"creditor_agent_bic,omitempty" db:"creditor_agent_bic"`
+	Amount                 int64         `json:"amount" db:"amount"`
+	Currency               string        `json:"currency" db:"currency"`
+	RequestedExecutionDate time.Time     `json:"requested_execution_date" db:"requested_execution_date"`
+	RemittanceInfo         string        `json:"remittance_info,omitempty" db:"remittance_info"`
+	Status                 PaymentStatus `json:"status" db:"status"`
+	StatusReason           string        `json:"status_reason,omitempty" db:"status_reason"` // ISO reason code
+	StatusInfo             string        `json:"status_info,omitempty" db:"status_info"`
+	ClearingMessageID      string        `json:"clearing_message_id,omitempty" db:"clearing_message_id"` // pacs.008 MsgId
+	TransactionID          string        `json:"transaction_id" db:"transaction_id"`                     // pacs.008 TxId
+	CreatedAt              time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt              time.Time     `json:"updated_at" db:"updated_at"`
+	Version                int           `json:"version" db:"version"` // Optimistic locking
+}
+
+// TransitionTo moves the payment to a new status with an optional reason
+func (p *Payment) TransitionTo(next PaymentStatus, reason, info string) error {
+	if !p.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, p.Status, next)
+	}
+	p.Status = next
+	p.StatusReason = reason
+	p.StatusInfo = info
+	return nil
+}
+
+// MessageDirection tells whether a message was received or sent
+type MessageDirection string
+
+const (
+	MessageDirectionInbound  MessageDirection = "Inbound"
+	MessageDirectionOutbound MessageDirection = "Outbound"
+)
+
+// Message is an ISO 20022 file exchanged with customers or the clearing
+// system. Inbound messages are recorded so a file delivered twice is only
+// processed once; outbound messages are stored in the same transaction as
+// the payments they carry and delivered afterwards.
+type Message struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	Direction   MessageDirection `json:"direction" db:"direction"`
+	MessageType string           `json:"message_type" db:"message_type"` // e.g. pacs.008.001.08
+	MessageID   string           `json:"message_id" db:"message_id"`     // GrpHdr/MsgId
+	FileName    string           `json:"file_name" db:"file_name"`
+	Content     []byte           `json:"-" db:"content"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	DeliveredAt *time.Time       `json:"delivered_at,omitempty" db:"delivered_at"` // Outbound only
+}
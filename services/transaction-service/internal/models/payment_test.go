@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from PaymentStatus
+		to   PaymentStatus
+		want bool
+	}{
+		{PaymentStatusReceived, PaymentStatusSubmitted, true},
+		{PaymentStatusReceived, PaymentStatusRejected, true},
+		{PaymentStatusReceived, PaymentStatusSettled, false},
+		{PaymentStatusSubmitted, PaymentStatusSettled, true},
+		{PaymentStatusPending, PaymentStatusAccepted, true},
+		{PaymentStatusAccepted, PaymentStatusSubmitted, false},
+		{PaymentStatusSettled, PaymentStatusRejected, false},
+		{PaymentStatusRejected, PaymentStatusSettled, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to), "%s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestPayment_TransitionTo(t *testing.T) {
+	p := &Payment{Status: PaymentStatusSubmitted}
+	assert.NoError(t, p.TransitionTo(PaymentStatusRejected, "AC04", "Account closed"))
+	assert.Equal(t, PaymentStatusRejected, p.Status)
+	assert.Equal(t, "AC04", p.StatusReason)
+	assert.True(t, p.Status.IsFinal())
+
+	err := p.TransitionTo(PaymentStatusSettled, "", "")
+	assert.True(t, errors.Is(err, ErrInvalidTransition))
+	assert.Equal(t, PaymentStatusRejected, p.Status)
+}
+
+func TestPaymentStatus_Scan(t *testing.T) {
+	var s PaymentStatus
+	assert.NoError(t, s.Scan("Settled"))
+	assert.Equal(t, PaymentStatusSettled, s)
+	assert.NoError(t, s.Scan(nil))
+	assert.Equal(t, PaymentStatusReceived, s)
+	assert.Error(t, s.Scan("Bounced"))
+	assert.Error(t, s.Scan(42))
+}
@@ -0,0 +1,189 @@
+package nacha
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// returnReasons describes the return reason codes R01 to R85. Numbers not
+// listed are unassigned.
+var returnReasons = map[string]string{
+	"R01": "Insufficient funds",
+	"R02": "Account closed",
+	"R03": "No account/unable to locate account",
+	"R04": "Invalid account number structure",
+	"R05": "Unauthorized debit to consumer account using corporate SEC code",
+	"R06": "Returned per ODFI's request",
+	"R07": "Authorization revoked by customer",
+	"R08": "Payment stopped",
+	"R09": "Uncollected funds",
+	"R10": "Customer advises originator is not known to receiver or not authorized",
+	"R11": "Customer advises entry not in accordance with the terms of the authorization",
+	"R12": "Account sold to another DFI",
+	"R13": "Invalid ACH routing number",
+	"R14": "Representative payee deceased or unable to continue in that capacity",
+	"R15": "Beneficiary or account holder deceased",
+	"R16": "Account frozen/entry returned per OFAC instruction",
+	"R17": "File record edit criteria/entry with invalid account number initiated under questionable circumstances",
+	"R18": "Improper effective entry date",
+	"R19": "Amount field error",
+	"R20": "Non-transaction account",
+	"R21": "Invalid company identification",
+	"R22": "Invalid individual ID number",
+	"R23": "Credit entry refused by receiver",
+	"R24": "Duplicate entry",
+	"R25": "Addenda error",
+	"R26": "Mandatory field error",
+	"R27": "Trace number error",
+	"R28": "Routing number check digit error",
+	"R29": "Corporate customer advises not authorized",
+	"R30": "RDFI not participant in check truncation program",
+	"R31": "Permissible return entry (CCD and CTX only)",
+	"R32": "RDFI non-settlement",
+	"R33": "Return of XCK entry",
+	"R34": "Limited participation DFI",
+	"R35": "Return of improper debit entry",
+	"R36": "Return of improper credit entry",
+	"R37": "Source document presented for payment",
+	"R38": "Stop payment on source document",
+	"R39": "Improper source document/source document presented for payment",
+	"R40": "Return of ENR entry by federal government agency",
+	"R41": "Invalid transaction code (ENR)",
+	"R42": "Routing number/check digit error (ENR)",
+	"R43": "Invalid DFI account number (ENR)",
+	"R44": "Invalid individual ID number/identification number (ENR)",
+	"R45": "Invalid individual name/company name (ENR)",
+	"R46": "Invalid representative payee indicator (ENR)",
+	"R47": "Duplicate enrollment (ENR)",
+	"R50": "State law affecting RCK acceptance",
+	"R51": "Item related to RCK entry is ineligible or RCK entry is improper",
+	"R52": "Stop payment on item related to RCK entry",
+	"R53": "Item and RCK entry presented for payment",
+	"R61": "Misrouted return",
+	"R62": "Return of erroneous or reversing debit",
+	"R67": "Duplicate return",
+	"R68": "Untimely return",
+	"R69": "Field error(s)",
+	"R70": "Permissible return entry not accepted/return not requested by ODFI",
+	"R71": "Misrouted dishonored return",
+	"R72": "Untimely dishonored return",
+	"R73": "Timely original return",
+	"R74": "Corrected return",
+	"R75": "Return not a duplicate",
+	"R76": "No errors found",
+	"R77": "Non-acceptance of R62 dishonored return",
+	"R80": "IAT entry coding error",
+	"R81": "Non-participant in IAT program",
+	"R82": "Invalid foreign receiving DFI identification",
+	"R83": "Foreign receiving DFI unable to settle",
+	"R84": "Entry not processed by gateway",
+	"R85": "Incorrectly coded outbound international payment",
+}
+
+// accountDetailReturns are the return reasons that mean the receiver's
+// account details on file are wrong and must be corrected before reuse
+var accountDetailReturns = map[string]bool{
+	"R02": true,
+	"R03": true,
+	"R04": true,
+	"R12": true,
+	"R13": true,
+	"R20": true,
+	"R28": true,
+}
+
+// changeCodes describes the notification of change codes
+var changeCodes = map[string]string{
+	"C01": "Incorrect DFI account number",
+	"C02": "Incorrect routing number",
+	"C03": "Incorrect routing number and incorrect DFI account number",
+	"C04": "Incorrect individual name/receiving company name",
+	"C05": "Incorrect transaction code",
+	"C06": "Incorrect DFI account number and incorrect transaction code",
+	"C07": "Incorrect routing number, incorrect DFI account number, and incorrect transaction code",
+	"C08": "Incorrect receiving DFI identification (IAT only)",
+	"C09": "Incorrect individual identification number",
+	"C13": "Addenda format error",
+	"C14": "Incorrect SEC code for outbound international payment",
+}
+
+// ReturnReason returns the description of a return reason code, or false
+// if the code is not assigned
+func ReturnReason(code string) (string, bool) {
+	description, ok := returnReasons[code]
+	return description, ok
+}
+
+// RequiresAccountCorrection reports whether a return reason means the
+// receiver's account details are wrong
+func RequiresAccountCorrection(returnCode string) bool {
+	return accountDetailReturns[returnCode]
+}
+
+// ChangeReason returns the description of a change code, or false if the
+// code is not assigned
+func ChangeReason(code string) (string, bool) {
+	description, ok := changeCodes[code]
+	return description, ok
+}
+
+// Correction is the corrected data of a notification of change, split into
+// the fields the change code corrects
+type Correction struct {
+	RoutingNumber   string
+	AccountNumber   string
+	TransactionCode int
+	Name            string
+	IndividualID    string
+	Other           string // Corrected data of codes with no structured form
+}
+
+// Correction parses the corrected data according to the change code
+func (c *ChangeAddenda) Correction() (Correction, error) {
+	data := c.CorrectedData
+	field := func(start, end int) string {
+		if start >= len(data) {
+			return ""
+		}
+		return strings.TrimSpace(data[start:min(end, len(data))])
+	}
+	txCode := func(s string) (int, error) {
+		code, err := strconv.Atoi(s)
+		if err != nil || !validTransactionCode(code) {
+			return 0, fmt.Errorf("%w: %s corrected transaction code %q", ErrInvalidFile, c.ChangeCode, s)
+		}
+		return code, nil
+	}
+
+	var corr Correction
+	var err error
+	switch c.ChangeCode {
+	case "C01":
+		corr.AccountNumber = field(0, 17)
+	case "C02":
+		corr.RoutingNumber = field(0, 9)
+	case "C03":
+		corr.RoutingNumber = field(0, 9)
+		corr.AccountNumber = field(12, 29)
+	case "C04":
+		corr.Name = field(0, 22)
+	case "C05":
+		corr.TransactionCode, err = txCode(field(0, 2))
+	case "C06":
+		corr.AccountNumber = field(0, 17)
+		corr.TransactionCode, err = txCode(field(20, 22))
+	case "C07":
+		corr.RoutingNumber = field(0, 9)
+		corr.AccountNumber = field(9, 26)
+		corr.TransactionCode, err = txCode(field(26, 28))
+	case "C09":
+		corr.IndividualID = field(0, 22)
+	default:
+		corr.Other = strings.TrimSpace(data)
+	}
+	if err != nil {
+		return Correction{}, err
+	}
+	return corr, nil
+}
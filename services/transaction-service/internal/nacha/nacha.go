@@ -0,0 +1,248 @@
+// Package nacha reads and writes ACH files in the NACHA format: fixed-width
+// 94-character records grouped into a file header, batches of entries with
+// their addenda, control records carrying counts, totals and an entry hash,
+// and padding to a multiple of ten records.
+package nacha
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Layout constants of a NACHA file
+const (
+	RecordLength   = 94
+	BlockingFactor = 10
+)
+
+// Standard Entry Class codes
+const (
+	SECPPD = "PPD" // Prearranged payment and deposit, consumer accounts
+	SECCCD = "CCD" // Corporate credit or debit
+	SECWEB = "WEB" // Internet-initiated consumer entry
+	SECCOR = "COR" // Notification of change
+)
+
+// Service class codes of a batch
+const (
+	ServiceClassMixed   = 200
+	ServiceClassCredits = 220
+	ServiceClassDebits  = 225
+)
+
+// Transaction codes. Codes ending in 0-4 are credits and 5-9 debits; the
+// return and notification of change codes reuse the account type's tens digit.
+const (
+	CheckingReturnCredit  = 21 // Return or NOC for a credit to a checking account
+	CheckingCredit        = 22
+	CheckingCreditPrenote = 23
+	CheckingReturnDebit   = 26 // Return or NOC for a debit to a checking account
+	CheckingDebit         = 27
+	CheckingDebitPrenote  = 28
+	SavingsReturnCredit   = 31
+	SavingsCredit         = 32
+	SavingsCreditPrenote  = 33
+	SavingsReturnDebit    = 36
+	SavingsDebit          = 37
+	SavingsDebitPrenote   = 38
+)
+
+// Addenda type codes
+const (
+	AddendaPaymentRelated = "05"
+	AddendaChange         = "98"
+	AddendaReturn         = "99"
+)
+
+// ErrInvalidFile is wrapped by every parse and validation error
+var ErrInvalidFile = errors.New("invalid NACHA file")
+
+// File is an ACH file
+type File struct {
+	Header  FileHeader
+	Batches []*Batch
+}
+
+// FileHeader is the file header record
+type FileHeader struct {
+	ImmediateDestination     string // Routing number of the receiving point
+	ImmediateOrigin          string // Routing number or company identification of the sender
+	CreatedAt                time.Time
+	IDModifier               string // A-Z or 0-9, distinguishes files created the same day
+	ImmediateDestinationName string
+	ImmediateOriginName      string
+	ReferenceCode            string
+}
+
+// Batch is a group of entries from one originator with one SEC code,
+// description and effective date
+type Batch struct {
+	Header  BatchHeader
+	Entries []*Entry
+}
+
+// BatchHeader is the company/batch header record
+type BatchHeader struct {
+	ServiceClassCode         int // Derived from the entries when zero
+	CompanyName              string
+	CompanyDiscretionaryData string
+	CompanyID                string
+	SECCode                  string
+	CompanyEntryDescription  string
+	CompanyDescriptiveDate   string
+	EffectiveEntryDate       time.Time
+	SettlementDate           string // Julian day, filled in by the ACH operator
+	OriginatorStatusCode     string
+	ODFIIdentification       string // First eight digits of the originating bank's routing number
+	BatchNumber              int
+}
+
+// Entry is an entry detail record with its addenda. At most one of
+// Addenda, Return and Change is set.
+type Entry struct {
+	TransactionCode   int
+	RDFIRouting       string // Nine-digit routing number of the receiving bank
+	DFIAccountNumber  string
+	Amount            int64 // Cents
+	IndividualID      string
+	IndividualName    string // Receiving company name for CCD
+	DiscretionaryData string // Payment type code for WEB
+	TraceNumber       string
+
+	Addenda *PaymentAddenda
+	Return  *ReturnAddenda
+	Change  *ChangeAddenda
+}
+
+// PaymentAddenda carries payment-related information (addenda type 05)
+type PaymentAddenda struct {
+	PaymentInfo    string
+	SequenceNumber int
+}
+
+// ReturnAddenda explains why an entry was returned (addenda type 99)
+type ReturnAddenda struct {
+	ReasonCode          string // R01-R85
+	OriginalTraceNumber string
+	DateOfDeath         string // YYMMDD, for R14 and R15
+	OriginalRDFI        string // First eight digits of the original receiving bank's routing number
+	Information         string
+	TraceNumber         string
+}
+
+// ChangeAddenda is a notification of change (addenda type 98)
+type ChangeAddenda struct {
+	ChangeCode          string // C01-C14
+	OriginalTraceNumber string
+	OriginalRDFI        string
+	CorrectedData       string
+	TraceNumber         string
+}
+
+// IsCredit reports whether a transaction code credits the receiver's account
+func IsCredit(transactionCode int) bool {
+	return transactionCode%10 <= 4
+}
+
+// IsPrenote reports whether a transaction code is a zero-dollar prenotification
+func IsPrenote(transactionCode int) bool {
+	return transactionCode%10 == 3 || transactionCode%10 == 8
+}
+
+// IsSavings reports whether a transaction code is for a savings account
+func IsSavings(transactionCode int) bool {
+	return transactionCode/10 == 3
+}
+
+func validTransactionCode(code int) bool {
+	tens, units := code/10, code%10
+	return (tens == 2 || tens == 3) && units >= 1 && units <= 9 && units != 5
+}
+
+// HasAddenda reports whether the entry carries an addenda record
+func (e *Entry) HasAddenda() bool {
+	return e.Addenda != nil || e.Return != nil || e.Change != nil
+}
+
+// RDFIIdentification returns the first eight digits of the receiving bank's routing number
+func (e *Entry) RDFIIdentification() string {
+	if len(e.RDFIRouting) < 8 {
+		return e.RDFIRouting
+	}
+	return e.RDFIRouting[:8]
+}
+
+// ServiceClass returns the service class code the batch's entries call for
+func (b *Batch) ServiceClass() int {
+	var credits, debits bool
+	for _, e := range b.Entries {
+		if IsCredit(e.TransactionCode) {
+			credits = true
+		} else {
+			debits = true
+		}
+	}
+	switch {
+	case credits && !debits:
+		return ServiceClassCredits
+	case debits && !credits:
+		return ServiceClassDebits
+	}
+	return ServiceClassMixed
+}
+
+// Totals are the control totals of a batch or file
+type Totals struct {
+	EntryAddendaCount int
+	EntryHash         int64 // Sum of receiving bank identifications, last ten digits
+	TotalDebit        int64
+	TotalCredit       int64
+}
+
+// entryHashModulus keeps the ten low-order digits of the entry hash
+const entryHashModulus = 10_000_000_000
+
+func (t *Totals) add(o Totals) {
+	t.EntryAddendaCount += o.EntryAddendaCount
+	t.EntryHash = (t.EntryHash + o.EntryHash) % entryHashModulus
+	t.TotalDebit += o.TotalDebit
+	t.TotalCredit += o.TotalCredit
+}
+
+// Totals computes the batch's control totals
+func (b *Batch) Totals() Totals {
+	var t Totals
+	for _, e := range b.Entries {
+		t.EntryAddendaCount++
+		if e.HasAddenda() {
+			t.EntryAddendaCount++
+		}
+		rdfi, _ := strconv.ParseInt(e.RDFIIdentification(), 10, 64)
+		t.EntryHash = (t.EntryHash + rdfi) % entryHashModulus
+		if IsCredit(e.TransactionCode) {
+			t.TotalCredit += e.Amount
+		} else {
+			t.TotalDebit += e.Amount
+		}
+	}
+	return t
+}
+
+// Totals computes the file's control totals
+func (f *File) Totals() Totals {
+	var t Totals
+	for _, b := range f.Batches {
+		t.add(b.Totals())
+	}
+	return t
+}
+
+// Entries returns every entry in the file, in file order
+func (f *File) Entries() []*Entry {
+	var entries []*Entry
+	for _, b := range f.Batches {
+		entries = append(entries, b.Entries...)
+	}
+	return entries
+}
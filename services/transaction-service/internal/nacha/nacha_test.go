@@ -0,0 +1,414 @@
+package nacha
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testODFI = "07640125"
+
+// outgoingFile is an originated file with one batch for each supported SEC code
+func outgoingFile() *File {
+	effective := time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)
+	return &File{
+		Header: FileHeader{
+			ImmediateDestination:     "011000015",
+			ImmediateOrigin:          "076401251",
+			CreatedAt:                time.Date(2024, 3, 6, 16, 30, 0, 0, time.UTC),
+			IDModifier:               "A",
+			ImmediateDestinationName: "FEDERAL RESERVE BANK",
+			ImmediateOriginName:      "CORE BANK NA",
+		},
+		Batches: []*Batch{
+			{
+				Header: BatchHeader{
+					CompanyName:             "ACME PAYROLL",
+					CompanyID:               "1234567890",
+					SECCode:                 SECPPD,
+					CompanyEntryDescription: "PAYROLL",
+					EffectiveEntryDate:      effective,
+					ODFIIdentification:      testODFI,
+					BatchNumber:             1,
+				},
+				Entries: []*Entry{
+					{
+						TransactionCode:  CheckingCredit,
+						RDFIRouting:      "021000021",
+						DFIAccountNumber: "123456789",
+						Amount:           250000,
+						IndividualID:     "EMP-001",
+						IndividualName:   "JANE DOE",
+						TraceNumber:      TraceNumber(testODFI, 1),
+						Addenda:          &PaymentAddenda{PaymentInfo: "MARCH SALARY"},
+					},
+					{
+						TransactionCode:  SavingsCredit,
+						RDFIRouting:      "026009593",
+						DFIAccountNumber: "987654321012",
+						Amount:           125050,
+						IndividualID:     "EMP-002",
+						IndividualName:   "JOHN ROE",
+						TraceNumber:      TraceNumber(testODFI, 2),
+					},
+				},
+			},
+			{
+				Header: BatchHeader{
+					CompanyName:             "ACME SUPPLIES",
+					CompanyID:               "1234567890",
+					SECCode:                 SECCCD,
+					CompanyEntryDescription: "INVOICE",
+					EffectiveEntryDate:      effective,
+					ODFIIdentification:      testODFI,
+					BatchNumber:             2,
+				},
+				Entries: []*Entry{
+					{
+						TransactionCode:  CheckingDebit,
+						RDFIRouting:      "121000358",
+						DFIAccountNumber: "55501234",
+						Amount:           980000,
+						IndividualID:     "INV-7781",
+						IndividualName:   "WIDGET WHOLESALE INC",
+						TraceNumber:      TraceNumber(testODFI, 3),
+					},
+				},
+			},
+			{
+				Header: BatchHeader{
+					CompanyName:             "ACME ONLINE",
+					CompanyID:               "1234567890",
+					SECCode:                 SECWEB,
+					CompanyEntryDescription: "SUBSCRIBE",
+					EffectiveEntryDate:      effective,
+					ODFIIdentification:      testODFI,
+					BatchNumber:             3,
+				},
+				Entries: []*Entry{
+					{
+						TransactionCode:   CheckingDebit,
+						RDFIRouting:       "091000019",
+						DFIAccountNumber:  "4400112233",
+						Amount:            1999,
+						IndividualID:      "CUST-42",
+						IndividualName:    "MARY MAJOR",
+						DiscretionaryData: "R",
+						TraceNumber:       TraceNumber(testODFI, 4),
+					},
+				},
+			},
+		},
+	}
+}
+
+// returnsFile is a file received from the ACH operator holding two returns
+// of entries in outgoingFile and a notification of change for a third
+func returnsFile() *File {
+	return &File{
+		Header: FileHeader{
+			ImmediateDestination:     "076401251",
+			ImmediateOrigin:          "011000015",
+			CreatedAt:                time.Date(2024, 3, 8, 6, 5, 0, 0, time.UTC),
+			IDModifier:               "B",
+			ImmediateDestinationName: "CORE BANK NA",
+			ImmediateOriginName:      "FEDERAL RESERVE BANK",
+		},
+		Batches: []*Batch{
+			{
+				Header: BatchHeader{
+					CompanyName:             "ACME PAYROLL",
+					CompanyID:               "1234567890",
+					SECCode:                 SECPPD,
+					CompanyEntryDescription: "PAYROLL",
+					EffectiveEntryDate:      time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC),
+					SettlementDate:          "068",
+					OriginatorStatusCode:    "1",
+					ODFIIdentification:      "02100002",
+					BatchNumber:             1,
+				},
+				Entries: []*Entry{
+					{
+						TransactionCode:  CheckingReturnCredit,
+						RDFIRouting:      "076401251",
+						DFIAccountNumber: "123456789",
+						Amount:           250000,
+						IndividualID:     "EMP-001",
+						IndividualName:   "JANE DOE",
+						TraceNumber:      "021000020000017",
+						Return: &ReturnAddenda{
+							ReasonCode:          "R03",
+							OriginalTraceNumber: TraceNumber(testODFI, 1),
+							OriginalRDFI:        "02100002",
+							TraceNumber:         "021000020000017",
+						},
+					},
+				},
+			},
+			{
+				Header: BatchHeader{
+					CompanyName:             "ACME SUPPLIES",
+					CompanyID:               "1234567890",
+					SECCode:                 SECCCD,
+					CompanyEntryDescription: "INVOICE",
+					EffectiveEntryDate:      time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC),
+					SettlementDate:          "068",
+					OriginatorStatusCode:    "1",
+					ODFIIdentification:      "12100035",
+					BatchNumber:             2,
+				},
+				Entries: []*Entry{
+					{
+						TransactionCode:  CheckingReturnDebit,
+						RDFIRouting:      "076401251",
+						DFIAccountNumber: "55501234",
+						Amount:           980000,
+						IndividualID:     "INV-7781",
+						IndividualName:   "WIDGET WHOLESALE INC",
+						TraceNumber:      "121000350000203",
+						Return: &ReturnAddenda{
+							ReasonCode:          "R01",
+							OriginalTraceNumber: TraceNumber(testODFI, 3),
+							OriginalRDFI:        "12100035",
+							Information:         "NSF",
+							TraceNumber:         "121000350000203",
+						},
+					},
+				},
+			},
+			{
+				Header: BatchHeader{
+					ServiceClassCode:        ServiceClassCredits,
+					CompanyName:             "ACME PAYROLL",
+					CompanyID:               "1234567890",
+					SECCode:                 SECCOR,
+					CompanyEntryDescription: "PAYROLL",
+					EffectiveEntryDate:      time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC),
+					SettlementDate:          "068",
+					OriginatorStatusCode:    "1",
+					ODFIIdentification:      "02600959",
+					BatchNumber:             3,
+				},
+				Entries: []*Entry{
+					{
+						TransactionCode:  SavingsReturnCredit,
+						RDFIRouting:      "076401251",
+						DFIAccountNumber: "987654321012",
+						IndividualID:     "EMP-002",
+						IndividualName:   "JOHN ROE",
+						TraceNumber:      "026009590000088",
+						Change: &ChangeAddenda{
+							ChangeCode:          "C03",
+							OriginalTraceNumber: TraceNumber(testODFI, 2),
+							OriginalRDFI:        "02600959",
+							CorrectedData:       "021000021   987654321013",
+							TraceNumber:         "026009590000088",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readGolden(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestMarshal_Golden(t *testing.T) {
+	tests := []struct {
+		golden string
+		file   *File
+	}{
+		{"outgoing.ach", outgoingFile()},
+		{"returns.ach", returnsFile()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			data, err := tt.file.Marshal()
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if want := readGolden(t, tt.golden); !bytes.Equal(data, want) {
+				t.Errorf("Marshal output differs from %s:\n%s", tt.golden, data)
+			}
+		})
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	for _, name := range []string{"outgoing.ach", "returns.ach"} {
+		t.Run(name, func(t *testing.T) {
+			data := readGolden(t, name)
+			f, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			again, err := f.Marshal()
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if !bytes.Equal(again, data) {
+				t.Errorf("round trip differs:\n%s", again)
+			}
+
+			// Records run together without line breaks parse the same
+			joined, err := Parse(bytes.ReplaceAll(data, []byte("\n"), nil))
+			if err != nil {
+				t.Fatalf("Parse without line breaks: %v", err)
+			}
+			if !reflect.DeepEqual(joined, f) {
+				t.Error("file without line breaks parsed differently")
+			}
+		})
+	}
+}
+
+func TestMarshal_Controls(t *testing.T) {
+	f := outgoingFile()
+	data, err := f.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines)%BlockingFactor != 0 {
+		t.Fatalf("%d records, want a multiple of %d", len(lines), BlockingFactor)
+	}
+
+	// Batch 1 has two credits and one addenda; batch 2 and 3 a debit each
+	if got := f.Batches[0].ServiceClass(); got != ServiceClassCredits {
+		t.Errorf("batch 1 service class = %d, want 220", got)
+	}
+	if got := f.Batches[1].ServiceClass(); got != ServiceClassDebits {
+		t.Errorf("batch 2 service class = %d, want 225", got)
+	}
+	totals := f.Totals()
+	want := Totals{
+		EntryAddendaCount: 5,
+		EntryHash:         2100002 + 2600959 + 12100035 + 9100001,
+		TotalDebit:        981999,
+		TotalCredit:       375050,
+	}
+	if totals != want {
+		t.Errorf("totals = %+v, want %+v", totals, want)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	valid := string(readGolden(t, "outgoing.ach"))
+	lines := strings.Split(strings.TrimSuffix(valid, "\n"), "\n")
+	replace := func(line int, start int, s string) string {
+		l := append([]string(nil), lines...)
+		l[line] = l[line][:start] + s + l[line][start+len(s):]
+		return strings.Join(l, "\n") + "\n"
+	}
+
+	tests := map[string]string{
+		"short record":      strings.Replace(valid, "PAYROLL", "PAYROL", 1),
+		"no file header":    strings.Join(lines[1:], "\n"),
+		"wrong batch hash":  replace(5, 10, "0000000001"),
+		"wrong batch total": replace(5, 32, "000000000001"),
+		"wrong file count":  replace(12, 1, "000009"),
+		"bad transaction":   replace(2, 1, "99"),
+		"unknown addenda":   replace(3, 1, "02"),
+		"missing control":   strings.Join(append(append([]string(nil), lines[:5]...), lines[6:]...), "\n"),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(data)); !errors.Is(err, ErrInvalidFile) {
+				t.Errorf("Parse error = %v, want ErrInvalidFile", err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]func(f *File){
+		"bad routing":     func(f *File) { f.Batches[0].Entries[0].RDFIRouting = "021000022" },
+		"zero amount":     func(f *File) { f.Batches[0].Entries[0].Amount = 0 },
+		"long name":       func(f *File) { f.Batches[0].Entries[0].IndividualName = strings.Repeat("X", 23) },
+		"non-ASCII name":  func(f *File) { f.Batches[0].Entries[0].IndividualName = "JOSÉ" },
+		"no batches":      func(f *File) { f.Batches = nil },
+		"bad SEC code":    func(f *File) { f.Batches[1].Header.SECCode = "ccd" },
+		"bad ID modifier": func(f *File) { f.Header.IDModifier = "a" },
+		"two addenda":     func(f *File) { f.Batches[0].Entries[0].Return = &ReturnAddenda{ReasonCode: "R01"} },
+		"unknown return": func(f *File) {
+			f.Batches[0].Entries[0].Addenda, f.Batches[0].Entries[0].Return = nil, &ReturnAddenda{ReasonCode: "R48", OriginalTraceNumber: TraceNumber(testODFI, 9), OriginalRDFI: testODFI}
+		},
+		"amount too large": func(f *File) { f.Batches[0].Entries[0].Amount = 10_000_000_000 },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := outgoingFile()
+			mutate(f)
+			if _, err := f.Marshal(); !errors.Is(err, ErrInvalidFile) {
+				t.Errorf("Marshal error = %v, want ErrInvalidFile", err)
+			}
+		})
+	}
+
+	// Prenotes carry no amount
+	f := outgoingFile()
+	f.Batches[0].Entries[1].TransactionCode = SavingsCreditPrenote
+	f.Batches[0].Entries[1].Amount = 0
+	if err := f.Validate(); err != nil {
+		t.Errorf("prenote rejected: %v", err)
+	}
+}
+
+func TestReturnReasons(t *testing.T) {
+	for _, code := range []string{"R01", "R02", "R29", "R62", "R85"} {
+		if _, ok := ReturnReason(code); !ok {
+			t.Errorf("ReturnReason(%s) not found", code)
+		}
+	}
+	for _, code := range []string{"R00", "R48", "R86", "C01"} {
+		if _, ok := ReturnReason(code); ok {
+			t.Errorf("ReturnReason(%s) should not be assigned", code)
+		}
+	}
+	if !RequiresAccountCorrection("R03") || RequiresAccountCorrection("R01") {
+		t.Error("R03 requires account correction and R01 does not")
+	}
+}
+
+func TestChangeAddenda_Correction(t *testing.T) {
+	tests := []struct {
+		code string
+		data string
+		want Correction
+	}{
+		{"C01", "987654321013", Correction{AccountNumber: "987654321013"}},
+		{"C02", "021000021", Correction{RoutingNumber: "021000021"}},
+		{"C03", "021000021   987654321013", Correction{RoutingNumber: "021000021", AccountNumber: "987654321013"}},
+		{"C05", "32", Correction{TransactionCode: SavingsCredit}},
+		{"C06", "987654321013        32", Correction{AccountNumber: "987654321013", TransactionCode: SavingsCredit}},
+		{"C07", "021000021987654321013     32", Correction{RoutingNumber: "021000021", AccountNumber: "987654321013", TransactionCode: SavingsCredit}},
+		{"C09", "EMP-0002", Correction{IndividualID: "EMP-0002"}},
+		{"C13", "ADDENDA", Correction{Other: "ADDENDA"}},
+	}
+	for _, tt := range tests {
+		got, err := (&ChangeAddenda{ChangeCode: tt.code, CorrectedData: tt.data}).Correction()
+		if err != nil {
+			t.Errorf("%s: %v", tt.code, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s = %+v, want %+v", tt.code, got, tt.want)
+		}
+	}
+
+	if _, err := (&ChangeAddenda{ChangeCode: "C05", CorrectedData: "99"}).Correction(); !errors.Is(err, ErrInvalidFile) {
+		t.Errorf("invalid corrected transaction code error = %v", err)
+	}
+}
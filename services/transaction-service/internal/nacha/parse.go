@@ -0,0 +1,277 @@
+package nacha
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parse reads an ACH file. Records may be separated by line breaks or run
+// together. The counts, totals and entry hashes in the control records are
+// checked against the entries; padding records after the file control are
+// ignored.
+func Parse(data []byte) (*File, error) {
+	records, err := splitRecords(data)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{records: records}
+	f, err := p.file()
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// splitRecords breaks the data into 94-character records
+func splitRecords(data []byte) ([]string, error) {
+	data = bytes.TrimRight(data, "\r\n")
+	if !bytes.ContainsAny(data, "\r\n") {
+		if len(data) == 0 || len(data)%RecordLength != 0 {
+			return nil, fmt.Errorf("%w: length %d is not a multiple of %d", ErrInvalidFile, len(data), RecordLength)
+		}
+		records := make([]string, 0, len(data)/RecordLength)
+		for i := 0; i < len(data); i += RecordLength {
+			records = append(records, string(data[i:i+RecordLength]))
+		}
+		return records, nil
+	}
+
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if len(line) != RecordLength {
+			return nil, fmt.Errorf("%w: line %d is %d characters, want %d", ErrInvalidFile, i+1, len(line), RecordLength)
+		}
+	}
+	return lines, nil
+}
+
+// parser walks the records of a file
+type parser struct {
+	records []string
+	pos     int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", ErrInvalidFile, p.pos+1, fmt.Sprintf(format, args...))
+}
+
+// peek returns the type of the current record, or 0 at the end
+func (p *parser) peek() byte {
+	if p.pos >= len(p.records) {
+		return 0
+	}
+	return p.records[p.pos][0]
+}
+
+func (p *parser) file() (*File, error) {
+	if p.peek() != '1' {
+		return nil, p.errorf("expected file header record")
+	}
+	r := p.records[p.pos]
+	created, err := time.Parse(dateLayout+timeLayout, r[23:33])
+	if err != nil {
+		return nil, p.errorf("invalid file creation date %q", r[23:33])
+	}
+	f := &File{Header: FileHeader{
+		ImmediateDestination:     strings.TrimSpace(r[3:13]),
+		ImmediateOrigin:          strings.TrimSpace(r[13:23]),
+		CreatedAt:                created,
+		IDModifier:               r[33:34],
+		ImmediateDestinationName: strings.TrimSpace(r[40:63]),
+		ImmediateOriginName:      strings.TrimSpace(r[63:86]),
+		ReferenceCode:            strings.TrimSpace(r[86:94]),
+	}}
+	if r[34:37] != "094" || r[37:39] != "10" {
+		return nil, p.errorf("record size must be 094 and blocking factor 10")
+	}
+	p.pos++
+
+	for p.peek() == '5' {
+		b, err := p.batch()
+		if err != nil {
+			return nil, err
+		}
+		f.Batches = append(f.Batches, b)
+	}
+
+	if p.peek() != '9' {
+		return nil, p.errorf("expected batch header or file control record")
+	}
+	r = p.records[p.pos]
+	if err := p.checkControl("file", r, []controlField{
+		{"batch count", 1, 7, int64(len(f.Batches))},
+		{"block count", 7, 13, int64((p.pos + BlockingFactor) / BlockingFactor)},
+	}, f.Totals(), 13, 21); err != nil {
+		return nil, err
+	}
+	p.pos++
+
+	for ; p.pos < len(p.records); p.pos++ {
+		if p.records[p.pos] != strings.Repeat("9", RecordLength) {
+			return nil, p.errorf("unexpected record after file control")
+		}
+	}
+	return f, nil
+}
+
+func (p *parser) batch() (*Batch, error) {
+	r := p.records[p.pos]
+	serviceClass, err := strconv.Atoi(r[1:4])
+	if err != nil {
+		return nil, p.errorf("invalid service class code %q", r[1:4])
+	}
+	effective := time.Time{}
+	if strings.TrimSpace(r[69:75]) != "" {
+		effective, err = time.Parse(dateLayout, r[69:75])
+		if err != nil {
+			return nil, p.errorf("invalid effective entry date %q", r[69:75])
+		}
+	}
+	batchNumber, err := strconv.Atoi(r[87:94])
+	if err != nil {
+		return nil, p.errorf("invalid batch number %q", r[87:94])
+	}
+	b := &Batch{Header: BatchHeader{
+		ServiceClassCode:         serviceClass,
+		CompanyName:              strings.TrimSpace(r[4:20]),
+		CompanyDiscretionaryData: strings.TrimSpace(r[20:40]),
+		CompanyID:                strings.TrimSpace(r[40:50]),
+		SECCode:                  r[50:53],
+		CompanyEntryDescription:  strings.TrimSpace(r[53:63]),
+		CompanyDescriptiveDate:   strings.TrimSpace(r[63:69]),
+		EffectiveEntryDate:       effective,
+		SettlementDate:           strings.TrimSpace(r[75:78]),
+		OriginatorStatusCode:     r[78:79],
+		ODFIIdentification:       r[79:87],
+		BatchNumber:              batchNumber,
+	}}
+	p.pos++
+
+	for p.peek() == '6' {
+		e, err := p.entry()
+		if err != nil {
+			return nil, err
+		}
+		b.Entries = append(b.Entries, e)
+	}
+
+	if p.peek() != '8' {
+		return nil, p.errorf("expected entry detail or batch control record")
+	}
+	r = p.records[p.pos]
+	if err := p.checkControl("batch", r, []controlField{
+		{"service class code", 1, 4, int64(serviceClass)},
+		{"batch number", 87, 94, int64(batchNumber)},
+	}, b.Totals(), 4, 10); err != nil {
+		return nil, err
+	}
+	p.pos++
+	return b, nil
+}
+
+func (p *parser) entry() (*Entry, error) {
+	r := p.records[p.pos]
+	code, err := strconv.Atoi(r[1:3])
+	if err != nil || !validTransactionCode(code) {
+		return nil, p.errorf("invalid transaction code %q", r[1:3])
+	}
+	amount, err := strconv.ParseInt(r[29:39], 10, 64)
+	if err != nil {
+		return nil, p.errorf("invalid amount %q", r[29:39])
+	}
+	e := &Entry{
+		TransactionCode:   code,
+		RDFIRouting:       r[3:12],
+		DFIAccountNumber:  strings.TrimSpace(r[12:29]),
+		Amount:            amount,
+		IndividualID:      strings.TrimSpace(r[39:54]),
+		IndividualName:    strings.TrimSpace(r[54:76]),
+		DiscretionaryData: strings.TrimSpace(r[76:78]),
+		TraceNumber:       r[79:94],
+	}
+	indicator := r[78]
+	p.pos++
+
+	if indicator == '0' {
+		return e, nil
+	}
+	if indicator != '1' {
+		return nil, p.errorf("invalid addenda record indicator %q", indicator)
+	}
+	if p.peek() != '7' {
+		return nil, p.errorf("expected addenda record")
+	}
+	r = p.records[p.pos]
+	switch r[1:3] {
+	case AddendaPaymentRelated:
+		seq, err := strconv.Atoi(r[83:87])
+		if err != nil {
+			return nil, p.errorf("invalid addenda sequence number %q", r[83:87])
+		}
+		e.Addenda = &PaymentAddenda{PaymentInfo: strings.TrimSpace(r[3:83]), SequenceNumber: seq}
+	case AddendaReturn:
+		e.Return = &ReturnAddenda{
+			ReasonCode:          r[3:6],
+			OriginalTraceNumber: r[6:21],
+			DateOfDeath:         strings.TrimSpace(r[21:27]),
+			OriginalRDFI:        r[27:35],
+			Information:         strings.TrimSpace(r[35:79]),
+			TraceNumber:         r[79:94],
+		}
+		if _, ok := ReturnReason(e.Return.ReasonCode); !ok {
+			return nil, p.errorf("unknown return reason code %q", e.Return.ReasonCode)
+		}
+	case AddendaChange:
+		e.Change = &ChangeAddenda{
+			ChangeCode:          r[3:6],
+			OriginalTraceNumber: r[6:21],
+			OriginalRDFI:        r[27:35],
+			CorrectedData:       strings.TrimRight(r[35:64], " "),
+			TraceNumber:         r[79:94],
+		}
+		if _, ok := ChangeReason(e.Change.ChangeCode); !ok {
+			return nil, p.errorf("unknown change code %q", e.Change.ChangeCode)
+		}
+	default:
+		return nil, p.errorf("unsupported addenda type %q", r[1:3])
+	}
+	p.pos++
+
+	if p.peek() == '7' {
+		return nil, p.errorf("only one addenda record per entry is supported")
+	}
+	return e, nil
+}
+
+// controlField is a numeric field of a control record and its expected value
+type controlField struct {
+	name       string
+	start, end int
+	want       int64
+}
+
+// checkControl compares a control record with the computed values. The
+// count field starts at countStart, and the hash and the two totals follow it.
+func (p *parser) checkControl(kind, r string, fields []controlField, t Totals, countStart, countEnd int) error {
+	hashEnd := countEnd + 10
+	fields = append(fields,
+		controlField{"entry/addenda count", countStart, countEnd, int64(t.EntryAddendaCount)},
+		controlField{"entry hash", countEnd, hashEnd, t.EntryHash},
+		controlField{"total debit amount", hashEnd, hashEnd + 12, t.TotalDebit},
+		controlField{"total credit amount", hashEnd + 12, hashEnd + 24, t.TotalCredit},
+	)
+	for _, f := range fields {
+		got, err := strconv.ParseInt(r[f.start:f.end], 10, 64)
+		if err != nil {
+			return p.errorf("invalid %s control %s %q", kind, f.name, r[f.start:f.end])
+		}
+		if got != f.want {
+			return p.errorf("%s control %s is %d, entries give %d", kind, f.name, got, f.want)
+		}
+	}
+	return nil
+}
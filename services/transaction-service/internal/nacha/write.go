@@ -0,0 +1,392 @@
+package nacha
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/core-banking/pkg/bankid"
+)
+
+// Field limits
+const (
+	maxAmount = 9_999_999_999   // Ten digits in an entry
+	maxTotal  = 999_999_999_999 // Twelve digits in a control record
+	maxBatch  = 9_999_999       // Seven digits
+)
+
+const (
+	dateLayout = "060102"
+	timeLayout = "1504"
+)
+
+// Validate checks that the file can be written: every field fits its
+// width, codes are well formed and routing numbers pass their check digit
+func (f *File) Validate() error {
+	var errs []string
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+
+	h := f.Header
+	if err := bankid.ValidateABA(h.ImmediateDestination); err != nil {
+		add("file header: immediate destination: %v", err)
+	}
+	if origin := strings.TrimSpace(h.ImmediateOrigin); origin == "" || len(origin) > 10 || !isAlphanumeric(origin) {
+		add("file header: immediate origin must be 1 to 10 letters or digits")
+	}
+	if len(h.IDModifier) != 1 || !isAlphanumeric(h.IDModifier) || strings.ToUpper(h.IDModifier) != h.IDModifier {
+		add("file header: file ID modifier must be A-Z or 0-9")
+	}
+	if h.CreatedAt.IsZero() {
+		add("file header: creation time is required")
+	}
+	checkText(add, "file header: immediate destination name", h.ImmediateDestinationName, 23, false)
+	checkText(add, "file header: immediate origin name", h.ImmediateOriginName, 23, false)
+	checkText(add, "file header: reference code", h.ReferenceCode, 8, false)
+
+	if len(f.Batches) == 0 {
+		add("file has no batches")
+	}
+	var totals Totals
+	for i, b := range f.Batches {
+		validateBatch(add, fmt.Sprintf("batch %d", i+1), b)
+		totals.add(b.Totals())
+	}
+	if totals.TotalDebit > maxTotal || totals.TotalCredit > maxTotal {
+		add("file totals exceed 12 digits")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidFile, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func validateBatch(add func(string, ...any), path string, b *Batch) {
+	h := b.Header
+	if h.ServiceClassCode != 0 && h.ServiceClassCode != ServiceClassMixed && h.ServiceClassCode != ServiceClassCredits && h.ServiceClassCode != ServiceClassDebits {
+		add("%s: invalid service class code %d", path, h.ServiceClassCode)
+	}
+	checkText(add, path+": company name", h.CompanyName, 16, true)
+	checkText(add, path+": company discretionary data", h.CompanyDiscretionaryData, 20, false)
+	checkText(add, path+": company identification", h.CompanyID, 10, true)
+	if len(h.SECCode) != 3 || !isUpper(h.SECCode) {
+		add("%s: SEC code must be 3 letters", path)
+	}
+	checkText(add, path+": company entry description", h.CompanyEntryDescription, 10, true)
+	checkText(add, path+": company descriptive date", h.CompanyDescriptiveDate, 6, false)
+	if h.EffectiveEntryDate.IsZero() {
+		add("%s: effective entry date is required", path)
+	}
+	if h.OriginatorStatusCode != "" && !isDigits(h.OriginatorStatusCode, 1) {
+		add("%s: originator status code must be a digit", path)
+	}
+	if !isDigits(h.ODFIIdentification, 8) {
+		add("%s: ODFI identification must be 8 digits", path)
+	}
+	if h.BatchNumber <= 0 || h.BatchNumber > maxBatch {
+		add("%s: batch number must be 1 to %d", path, maxBatch)
+	}
+	if len(b.Entries) == 0 {
+		add("%s: batch has no entries", path)
+	}
+
+	for i, e := range b.Entries {
+		validateEntry(add, fmt.Sprintf("%s entry %d", path, i+1), e)
+	}
+	if t := b.Totals(); t.TotalDebit > maxTotal || t.TotalCredit > maxTotal {
+		add("%s: totals exceed 12 digits", path)
+	}
+}
+
+func validateEntry(add func(string, ...any), path string, e *Entry) {
+	if !validTransactionCode(e.TransactionCode) {
+		add("%s: invalid transaction code %d", path, e.TransactionCode)
+	}
+	if err := bankid.ValidateABA(e.RDFIRouting); err != nil {
+		add("%s: RDFI routing number: %v", path, err)
+	}
+	checkText(add, path+": DFI account number", e.DFIAccountNumber, 17, true)
+	if e.Amount < 0 || e.Amount > maxAmount {
+		add("%s: amount must be 0 to %d cents", path, int64(maxAmount))
+	}
+	if e.Amount == 0 && !IsPrenote(e.TransactionCode) && e.Change == nil {
+		add("%s: amount must be positive", path)
+	}
+	checkText(add, path+": individual ID", e.IndividualID, 15, false)
+	checkText(add, path+": individual name", e.IndividualName, 22, true)
+	checkText(add, path+": discretionary data", e.DiscretionaryData, 2, false)
+	if !isDigits(e.TraceNumber, 15) {
+		add("%s: trace number must be 15 digits", path)
+	}
+
+	set := 0
+	if e.Addenda != nil {
+		set++
+		checkText(add, path+": payment related information", e.Addenda.PaymentInfo, 80, false)
+	}
+	if r := e.Return; r != nil {
+		set++
+		if _, ok := ReturnReason(r.ReasonCode); !ok {
+			add("%s: unknown return reason code %q", path, r.ReasonCode)
+		}
+		if !isDigits(r.OriginalTraceNumber, 15) {
+			add("%s: original trace number must be 15 digits", path)
+		}
+		if !isDigits(r.OriginalRDFI, 8) {
+			add("%s: original RDFI identification must be 8 digits", path)
+		}
+		checkText(add, path+": return information", r.Information, 44, false)
+	}
+	if c := e.Change; c != nil {
+		set++
+		if _, ok := ChangeReason(c.ChangeCode); !ok {
+			add("%s: unknown change code %q", path, c.ChangeCode)
+		}
+		if !isDigits(c.OriginalTraceNumber, 15) {
+			add("%s: original trace number must be 15 digits", path)
+		}
+		if !isDigits(c.OriginalRDFI, 8) {
+			add("%s: original RDFI identification must be 8 digits", path)
+		}
+		checkText(add, path+": corrected data", c.CorrectedData, 29, true)
+	}
+	if set > 1 {
+		add("%s: an entry carries at most one addenda", path)
+	}
+}
+
+// Marshal validates the file and renders it, computing the control records
+// and padding the last block with records of nines
+func (f *File) Marshal() ([]byte, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	var records []string
+	h := f.Header
+	records = append(records, "1"+"01"+
+		alpha(" "+h.ImmediateDestination, 10)+
+		rightAlpha(h.ImmediateOrigin, 10)+
+		h.CreatedAt.Format(dateLayout)+
+		h.CreatedAt.Format(timeLayout)+
+		h.IDModifier+
+		"094"+
+		strconv.Itoa(BlockingFactor)+
+		"1"+
+		alpha(h.ImmediateDestinationName, 23)+
+		alpha(h.ImmediateOriginName, 23)+
+		alpha(h.ReferenceCode, 8))
+
+	for _, b := range f.Batches {
+		records = append(records, b.records()...)
+	}
+
+	t := f.Totals()
+	lines := len(records) + 1
+	blocks := (lines + BlockingFactor - 1) / BlockingFactor
+	records = append(records, "9"+
+		numeric(int64(len(f.Batches)), 6)+
+		numeric(int64(blocks), 6)+
+		numeric(int64(t.EntryAddendaCount), 8)+
+		numeric(t.EntryHash, 10)+
+		numeric(t.TotalDebit, 12)+
+		numeric(t.TotalCredit, 12)+
+		strings.Repeat(" ", 39))
+	for len(records)%BlockingFactor != 0 {
+		records = append(records, strings.Repeat("9", RecordLength))
+	}
+
+	var buf bytes.Buffer
+	for _, r := range records {
+		if len(r) != RecordLength {
+			return nil, fmt.Errorf("nacha: rendered a %d-character record", len(r))
+		}
+		buf.WriteString(r)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func (b *Batch) records() []string {
+	h := b.Header
+	serviceClass := h.ServiceClassCode
+	if serviceClass == 0 {
+		serviceClass = b.ServiceClass()
+	}
+	status := h.OriginatorStatusCode
+	if status == "" {
+		status = "1"
+	}
+
+	records := []string{"5" +
+		numeric(int64(serviceClass), 3) +
+		alpha(h.CompanyName, 16) +
+		alpha(h.CompanyDiscretionaryData, 20) +
+		alpha(h.CompanyID, 10) +
+		h.SECCode +
+		alpha(h.CompanyEntryDescription, 10) +
+		alpha(h.CompanyDescriptiveDate, 6) +
+		h.EffectiveEntryDate.Format(dateLayout) +
+		alpha(h.SettlementDate, 3) +
+		status +
+		h.ODFIIdentification +
+		numeric(int64(h.BatchNumber), 7)}
+
+	for _, e := range b.Entries {
+		records = append(records, e.records()...)
+	}
+
+	t := b.Totals()
+	records = append(records, "8"+
+		numeric(int64(serviceClass), 3)+
+		numeric(int64(t.EntryAddendaCount), 6)+
+		numeric(t.EntryHash, 10)+
+		numeric(t.TotalDebit, 12)+
+		numeric(t.TotalCredit, 12)+
+		alpha(h.CompanyID, 10)+
+		strings.Repeat(" ", 19)+
+		strings.Repeat(" ", 6)+
+		h.ODFIIdentification+
+		numeric(int64(h.BatchNumber), 7))
+	return records
+}
+
+func (e *Entry) records() []string {
+	indicator := "0"
+	if e.HasAddenda() {
+		indicator = "1"
+	}
+	records := []string{"6" +
+		numeric(int64(e.TransactionCode), 2) +
+		e.RDFIRouting +
+		alpha(e.DFIAccountNumber, 17) +
+		numeric(e.Amount, 10) +
+		alpha(e.IndividualID, 15) +
+		alpha(e.IndividualName, 22) +
+		alpha(e.DiscretionaryData, 2) +
+		indicator +
+		e.TraceNumber}
+
+	switch {
+	case e.Addenda != nil:
+		seq := e.Addenda.SequenceNumber
+		if seq == 0 {
+			seq = 1
+		}
+		records = append(records, "7"+AddendaPaymentRelated+
+			alpha(e.Addenda.PaymentInfo, 80)+
+			numeric(int64(seq), 4)+
+			e.TraceNumber[8:])
+	case e.Return != nil:
+		r := e.Return
+		records = append(records, "7"+AddendaReturn+
+			r.ReasonCode+
+			r.OriginalTraceNumber+
+			alpha(r.DateOfDeath, 6)+
+			r.OriginalRDFI+
+			alpha(r.Information, 44)+
+			e.TraceNumber)
+	case e.Change != nil:
+		c := e.Change
+		records = append(records, "7"+AddendaChange+
+			c.ChangeCode+
+			c.OriginalTraceNumber+
+			strings.Repeat(" ", 6)+
+			c.OriginalRDFI+
+			alpha(c.CorrectedData, 29)+
+			strings.Repeat(" ", 15)+
+			e.TraceNumber)
+	}
+	return records
+}
+
+// TraceNumber builds a trace number from the originating bank's eight-digit
+// identification and a sequence number
+func TraceNumber(odfi string, seq int) string {
+	return odfi + numeric(int64(seq), 7)
+}
+
+// FormatDate renders a date as the YYMMDD used in NACHA records
+func FormatDate(t time.Time) string {
+	return t.Format(dateLayout)
+}
+
+// alpha left-justifies s in a field of width n
+func alpha(s string, n int) string {
+	if len(s) >= n {
+		return s[:n]
+	}
+	return s + strings.Repeat(" ", n-len(s))
+}
+
+// rightAlpha right-justifies s in a field of width n
+func rightAlpha(s string, n int) string {
+	if len(s) >= n {
+		return s[:n]
+	}
+	return strings.Repeat(" ", n-len(s)) + s
+}
+
+// numeric zero-pads v to width n
+func numeric(v int64, n int) string {
+	s := strconv.FormatInt(v, 10)
+	if len(s) >= n {
+		return s[len(s)-n:]
+	}
+	return strings.Repeat("0", n-len(s)) + s
+}
+
+func checkText(add func(string, ...any), field, s string, n int, required bool) {
+	switch {
+	case required && strings.TrimSpace(s) == "":
+		add("%s is required", field)
+	case len(s) > n:
+		add("%s must be at most %d characters", field, n)
+	case !isPrintable(s):
+		add("%s must be printable ASCII", field)
+	}
+}
+
+func isDigits(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isUpper(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isPrintable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,519 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// ACH entry operations
+
+const achEntryColumns = `
+	id, direction, account_id, sec_code, entry_type, account_type,
+	routing_number, account_number, name, individual_id,
+	company_name, company_id, company_entry_description,
+	amount, effective_date, payment_info, trace_number, file_id,
+	status, return_code, status_reason, created_at, updated_at, version`
+
+func scanACHEntry(row rowScanner) (*models.ACHEntry, error) {
+	entry := &models.ACHEntry{}
+	var accountID, fileID uuid.NullUUID
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.Direction,
+		&accountID,
+		&entry.SECCode,
+		&entry.EntryType,
+		&entry.AccountType,
+		&entry.RoutingNumber,
+		&entry.AccountNumber,
+		&entry.Name,
+		&entry.IndividualID,
+		&entry.CompanyName,
+		&entry.CompanyID,
+		&entry.CompanyEntryDescription,
+		&entry.Amount,
+		&entry.EffectiveDate,
+		&entry.PaymentInfo,
+		&entry.TraceNumber,
+		&fileID,
+		&entry.Status,
+		&entry.ReturnCode,
+		&entry.StatusReason,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+		&entry.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountID.Valid {
+		entry.AccountID = &accountID.UUID
+	}
+	if fileID.Valid {
+		entry.FileID = &fileID.UUID
+	}
+
+	return entry, nil
+}
+
+func (r *pgPaymentRepository) CreateACHEntry(ctx context.Context, entry *models.ACHEntry) error {
+	if entry.ID == uuid.Nil {
+		entry.ID = uuid.New()
+	}
+	now := time.Now().UTC()
+	entry.CreatedAt = now
+	entry.UpdatedAt = now
+	entry.Version = 1
+
+	query := `
+		INSERT INTO ach_entries (` + achEntryColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			$13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		entry.ID,
+		entry.Direction,
+		entry.AccountID,
+		entry.SECCode,
+		entry.EntryType,
+		entry.AccountType,
+		entry.RoutingNumber,
+		entry.AccountNumber,
+		entry.Name,
+		entry.IndividualID,
+		entry.CompanyName,
+		entry.CompanyID,
+		entry.CompanyEntryDescription,
+		entry.Amount,
+		entry.EffectiveDate,
+		entry.PaymentInfo,
+		entry.TraceNumber,
+		entry.FileID,
+		entry.Status,
+		entry.ReturnCode,
+		entry.StatusReason,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+		entry.Version,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create ACH entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgPaymentRepository) GetACHEntryByID(ctx context.Context, id uuid.UUID) (*models.ACHEntry, error) {
+	query := `SELECT ` + achEntryColumns + ` FROM ach_entries WHERE id = $1`
+	return r.getACHEntry(ctx, query, id)
+}
+
+func (r *pgPaymentRepository) GetOutboundACHEntryByTrace(ctx context.Context, traceNumber string) (*models.ACHEntry, error) {
+	query := `SELECT ` + achEntryColumns + ` FROM ach_entries WHERE direction = 'Outbound' AND trace_number = $1`
+	return r.getACHEntry(ctx, query, traceNumber)
+}
+
+func (r *pgPaymentRepository) getACHEntry(ctx context.Context, query string, args ...interface{}) (*models.ACHEntry, error) {
+	entry, err := scanACHEntry(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ACH entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r *pgPaymentRepository) UpdateACHEntry(ctx context.Context, entry *models.ACHEntry) error {
+	entry.UpdatedAt = time.Now().UTC()
+	entry.Version++
+
+	query := `
+		UPDATE ach_entries SET
+			account_id = $2,
+			trace_number = $3,
+			file_id = $4,
+			status = $5,
+			return_code = $6,
+			status_reason = $7,
+			updated_at = $8,
+			version = $9
+		WHERE id = $1 AND version = $10
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entry.ID,
+		entry.AccountID,
+		entry.TraceNumber,
+		entry.FileID,
+		entry.Status,
+		entry.ReturnCode,
+		entry.StatusReason,
+		entry.UpdatedAt,
+		entry.Version,
+		entry.Version-1,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update ACH entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return &ErrOptimisticLock{ACHEntryID: entry.ID}
+	}
+
+	return nil
+}
+
+func (r *pgPaymentRepository) ListPendingACHEntries(ctx context.Context, through time.Time, limit int) ([]*models.ACHEntry, error) {
+	query := `
+		SELECT ` + achEntryColumns + `
+		FROM ach_entries
+		WHERE status = 'Pending' AND effective_date <= $1
+		ORDER BY created_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, through, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACH entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.ACHEntry
+	for rows.Next() {
+		entry, err := scanACHEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ACH entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ACH entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *pgPaymentRepository) NextACHTraceSequence(ctx context.Context) (int, error) {
+	var seq int
+	if err := r.db.QueryRowContext(ctx, `SELECT nextval('ach_trace_seq')`).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("failed to get ACH trace sequence: %w", err)
+	}
+	return seq, nil
+}
+
+// ACH file operations
+
+const achFileColumns = `
+	id, direction, file_name, immediate_origin, file_created_at, id_modifier,
+	entry_count, total_debit, total_credit, content, created_at, delivered_at`
+
+func scanACHFile(row rowScanner) (*models.ACHFile, error) {
+	file := &models.ACHFile{}
+	var deliveredAt sql.NullTime
+
+	err := row.Scan(
+		&file.ID,
+		&file.Direction,
+		&file.FileName,
+		&file.ImmediateOrigin,
+		&file.FileCreatedAt,
+		&file.IDModifier,
+		&file.EntryCount,
+		&file.TotalDebit,
+		&file.TotalCredit,
+		&file.Content,
+		&file.CreatedAt,
+		&deliveredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if deliveredAt.Valid {
+		deliveredAtTime := deliveredAt.Time
+		file.DeliveredAt = &deliveredAtTime
+	}
+
+	return file, nil
+}
+
+func (r *pgPaymentRepository) CreateACHFile(ctx context.Context, file *models.ACHFile) error {
+	if file.ID == uuid.Nil {
+		file.ID = uuid.New()
+	}
+	file.CreatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO ach_files (` + achFileColumns + `
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		file.ID,
+		file.Direction,
+		file.FileName,
+		file.ImmediateOrigin,
+		file.FileCreatedAt,
+		file.IDModifier,
+		file.EntryCount,
+		file.TotalDebit,
+		file.TotalCredit,
+		file.Content,
+		file.CreatedAt,
+		file.DeliveredAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create ACH file: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgPaymentRepository) CountACHFiles(ctx context.Context, direction models.MessageDirection, from, to time.Time) (int, error) {
+	query := `SELECT COUNT(*) FROM ach_files WHERE direction = $1 AND file_created_at >= $2 AND file_created_at < $3`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, direction, from, to).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count ACH files: %w", err)
+	}
+	return count, nil
+}
+
+func (r *pgPaymentRepository) ListUndeliveredACHFiles(ctx context.Context, limit int) ([]*models.ACHFile, error) {
+	query := `
+		SELECT ` + achFileColumns + `
+		FROM ach_files
+		WHERE direction = 'Outbound' AND delivered_at IS NULL
+		ORDER BY created_at, id
+		LIMIT $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACH files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*models.ACHFile
+	for rows.Next() {
+		file, err := scanACHFile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ACH file: %w", err)
+		}
+		files = append(files, file)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ACH files: %w", err)
+	}
+
+	return files, nil
+}
+
+func (r *pgPaymentRepository) MarkACHFileDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE ach_files SET delivered_at = $2 WHERE id = $1`, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to mark ACH file delivered: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ACH correction operations
+
+const achCorrectionColumns = `
+	id, entry_id, account_id, code, description, routing_number, account_number,
+	corrected_routing_number, corrected_account_number, corrected_account_type,
+	corrected_name, corrected_individual_id, corrected_data,
+	status, created_at, resolved_at, resolved_by`
+
+func scanACHCorrection(row rowScanner) (*models.ACHCorrection, error) {
+	correction := &models.ACHCorrection{}
+	var accountID uuid.NullUUID
+	var accountType sql.NullString
+	var resolvedAt sql.NullTime
+
+	err := row.Scan(
+		&correction.ID,
+		&correction.EntryID,
+		&accountID,
+		&correction.Code,
+		&correction.Description,
+		&correction.RoutingNumber,
+		&correction.AccountNumber,
+		&correction.CorrectedRoutingNumber,
+		&correction.CorrectedAccountNumber,
+		&accountType,
+		&correction.CorrectedName,
+		&correction.CorrectedIndividualID,
+		&correction.CorrectedData,
+		&correction.Status,
+		&correction.CreatedAt,
+		&resolvedAt,
+		&correction.ResolvedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountID.Valid {
+		correction.AccountID = &accountID.UUID
+	}
+	if accountType.Valid {
+		correction.CorrectedAccountType = models.ACHAccountType(accountType.String)
+	}
+	if resolvedAt.Valid {
+		resolvedAtTime := resolvedAt.Time
+		correction.ResolvedAt = &resolvedAtTime
+	}
+
+	return correction, nil
+}
+
+func (r *pgPaymentRepository) CreateACHCorrection(ctx context.Context, correction *models.ACHCorrection) error {
+	if correction.ID == uuid.Nil {
+		correction.ID = uuid.New()
+	}
+	correction.CreatedAt = time.Now().UTC()
+
+	// The corrected account type is only set by change codes that correct it
+	var accountType sql.NullString
+	if correction.CorrectedAccountType != "" {
+		accountType = sql.NullString{String: string(correction.CorrectedAccountType), Valid: true}
+	}
+
+	query := `
+		INSERT INTO ach_corrections (` + achCorrectionColumns + `
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		correction.ID,
+		correction.EntryID,
+		correction.AccountID,
+		correction.Code,
+		correction.Description,
+		correction.RoutingNumber,
+		correction.AccountNumber,
+		correction.CorrectedRoutingNumber,
+		correction.CorrectedAccountNumber,
+		accountType,
+		correction.CorrectedName,
+		correction.CorrectedIndividualID,
+		correction.CorrectedData,
+		correction.Status,
+		correction.CreatedAt,
+		correction.ResolvedAt,
+		correction.ResolvedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create ACH correction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgPaymentRepository) GetACHCorrectionByID(ctx context.Context, id uuid.UUID) (*models.ACHCorrection, error) {
+	query := `SELECT ` + achCorrectionColumns + ` FROM ach_corrections WHERE id = $1`
+
+	correction, err := scanACHCorrection(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ACH correction: %w", err)
+	}
+
+	return correction, nil
+}
+
+func (r *pgPaymentRepository) ListACHCorrections(ctx context.Context, status models.ACHCorrectionStatus, limit int) ([]*models.ACHCorrection, error) {
+	query := `
+		SELECT ` + achCorrectionColumns + `
+		FROM ach_corrections
+		WHERE status = $1
+		ORDER BY created_at, id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACH corrections: %w", err)
+	}
+	defer rows.Close()
+
+	var corrections []*models.ACHCorrection
+	for rows.Next() {
+		correction, err := scanACHCorrection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ACH correction: %w", err)
+		}
+		corrections = append(corrections, correction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ACH corrections: %w", err)
+	}
+
+	return corrections, nil
+}
+
+func (r *pgPaymentRepository) ResolveACHCorrection(ctx context.Context, id uuid.UUID, by string, at time.Time) error {
+	query := `
+		UPDATE ach_corrections SET status = 'Resolved', resolved_at = $2, resolved_by = $3
+		WHERE id = $1 AND status = 'Open'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, at, by)
+	if err != nil {
+		return fmt.Errorf("failed to resolve ACH correction: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
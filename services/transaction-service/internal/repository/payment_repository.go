@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned when a record is not found
+var ErrNotFound = errors.New("record not found")
+
+// ErrDuplicate is returned when a message with the same direction, type and
+// message ID has already been recorded
+var ErrDuplicate = errors.New("duplicate record")
+
+// ErrOptimisticLock is returned when a concurrent update is detected
+type ErrOptimisticLock struct {
+	PaymentID  uuid.UUID
+	ACHEntryID uuid.UUID
+}
+
+func (e *ErrOptimisticLock) Error() string {
+	return "optimistic lock error"
+}
+
+// PaymentRepository defines the interface for payment data operations
+type PaymentRepository interface {
+	// Payment operations
+	CreatePayment(ctx context.Context, payment *models.Payment) error
+	GetPaymentByID(ctx context.Context, id uuid.UUID) (*models.Payment, error)
+	UpdatePayment(ctx context.Context, payment *models.Payment) error
+	// ListPaymentsForSubmission returns received payments due for execution
+	// on or before the given date, oldest first. Inside a transaction the
+	// rows are locked, and rows locked by another submitter are skipped.
+	ListPaymentsForSubmission(ctx context.Context, through time.Time, limit int) ([]*models.Payment, error)
+	// ListPaymentsByClearingMessage returns the payments sent in a pacs.008
+	ListPaymentsByClearingMessage(ctx context.Context, clearingMessageID string) ([]*models.Payment, error)
+
+	// Message operations
+	// CreateMessage records an ISO 20022 message, returning ErrDuplicate if
+	// one with the same direction, type and message ID exists
+	CreateMessage(ctx context.Context, message *models.Message) error
+	// ListUndeliveredMessages returns outbound messages not yet delivered, oldest first
+	ListUndeliveredMessages(ctx context.Context, limit int) ([]*models.Message, error)
+	MarkMessageDelivered(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// ACH entry operations
+	CreateACHEntry(ctx context.Context, entry *models.ACHEntry) error
+	GetACHEntryByID(ctx context.Context, id uuid.UUID) (*models.ACHEntry, error)
+	// GetOutboundACHEntryByTrace finds the entry we sent with a trace number,
+	// which is how returns and notifications of change refer to it
+	GetOutboundACHEntryByTrace(ctx context.Context, traceNumber string) (*models.ACHEntry, error)
+	UpdateACHEntry(ctx context.Context, entry *models.ACHEntry) error
+	// ListPendingACHEntries returns outbound entries waiting for a file with
+	// an effective date on or before the given date, oldest first. Inside a
+	// transaction the rows are locked, and rows locked by another
+	// generator are skipped.
+	ListPendingACHEntries(ctx context.Context, through time.Time, limit int) ([]*models.ACHEntry, error)
+	// NextACHTraceSequence returns the next seven-digit sequence for an
+	// outbound trace number
+	NextACHTraceSequence(ctx context.Context) (int, error)
+
+	// ACH file operations
+	// CreateACHFile records an ACH file, returning ErrDuplicate if one with
+	// the same direction, origin, creation time and ID modifier exists
+	CreateACHFile(ctx context.Context, file *models.ACHFile) error
+	// CountACHFiles returns how many files in a direction were created in
+	// [from, to), which picks the ID modifier of the next outbound file
+	CountACHFiles(ctx context.Context, direction models.MessageDirection, from, to time.Time) (int, error)
+	// ListUndeliveredACHFiles returns outbound files not yet delivered, oldest first
+	ListUndeliveredACHFiles(ctx context.Context, limit int) ([]*models.ACHFile, error)
+	MarkACHFileDelivered(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// ACH correction operations
+	CreateACHCorrection(ctx context.Context, correction *models.ACHCorrection) error
+	GetACHCorrectionByID(ctx context.Context, id uuid.UUID) (*models.ACHCorrection, error)
+	// ListACHCorrections returns corrections with a status, oldest first
+	ListACHCorrections(ctx context.Context, status models.ACHCorrectionStatus, limit int) ([]*models.ACHCorrection, error)
+	// ResolveACHCorrection marks an open correction resolved, returning
+	// ErrNotFound if there is no open correction with the ID
+	ResolveACHCorrection(ctx context.Context, id uuid.UUID, by string, at time.Time) error
+
+	// Transaction management
+	BeginTx(ctx context.Context) (Tx, error)
+}
+
+// Tx represents a database transaction
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+	PaymentRepository() PaymentRepository
+}
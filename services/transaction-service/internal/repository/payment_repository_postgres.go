@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// DBQuerier is an interface for database operations
+type DBQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// pgPaymentRepository implements PaymentRepository for PostgreSQL. The same
+// implementation serves both plain connections and transactions since it
+// only depends on DBQuerier.
+type pgPaymentRepository struct {
+	db DBQuerier
+}
+
+// NewPaymentRepository creates a new PostgreSQL payment repository
+func NewPaymentRepository(db *sql.DB) PaymentRepository {
+	return &pgPaymentRepository{db: db}
+}
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint violation
+const uniqueViolation = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
+
+// Payment operations
+
+const paymentColumns = `
+	id, message_id, payment_info_id, instruction_id, end_to_end_id,
+	debtor_name, debtor_iban, creditor_name, creditor_iban, creditor_agent_bic,
+	amount, currency, requested_execution_date, remittance_info,
+	status, status_reason, status_info, clearing_message_id, transaction_id,
+	created_at, updated_at, version`
+
+func scanPayment(row rowScanner) (*models.Payment, error) {
+	payment := &models.Payment{}
+	err := row.Scan(
+		&payment.ID,
+		&payment.MessageID,
+		&payment.PaymentInfoID,
+		&payment.InstructionID,
+		&payment.EndToEndID,
+		&payment.DebtorName,
+		&payment.DebtorIBAN,
+		&payment.CreditorName,
+		&payment.CreditorIBAN,
+		&payment.CreditorAgentBIC,
+		&payment.Amount,
+		&payment.Currency,
+		&payment.RequestedExecutionDate,
+		&payment.RemittanceInfo,
+		&payment.Status,
+		&payment.StatusReason,
+		&payment.StatusInfo,
+		&payment.ClearingMessageID,
+		&payment.TransactionID,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+		&payment.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+func (r *pgPaymentRepository) CreatePayment(ctx context.Context, payment *models.Payment) error {
+	if payment.ID == uuid.Nil {
+		payment.ID = uuid.New()
+	}
+	now := time.Now().UTC()
+	payment.CreatedAt = now
+	payment.UpdatedAt = now
+	payment.Version = 1
+
+	query := `
+		INSERT INTO payments (` + paymentColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+			$12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		payment.ID,
+		payment.MessageID,
+		payment.PaymentInfoID,
+		payment.InstructionID,
+		payment.EndToEndID,
+		payment.DebtorName,
+		payment.DebtorIBAN,
+		payment.CreditorName,
+		payment.CreditorIBAN,
+		payment.CreditorAgentBIC,
+		payment.Amount,
+		payment.Currency,
+		payment.RequestedExecutionDate,
+		payment.RemittanceInfo,
+		payment.Status,
+		payment.StatusReason,
+		payment.StatusInfo,
+		payment.ClearingMessageID,
+		payment.TransactionID,
+		payment.CreatedAt,
+		payment.UpdatedAt,
+		payment.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgPaymentRepository) GetPaymentByID(ctx context.Context, id uuid.UUID) (*models.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE id = $1`
+
+	payment, err := scanPayment(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+func (r *pgPaymentRepository) UpdatePayment(ctx context.Context, payment *models.Payment) error {
+	payment.UpdatedAt = time.Now().UTC()
+	payment.Version++
+
+	query := `
+		UPDATE payments SET
+			status = $2,
+			status_reason = $3,
+			status_info = $4,
+			clearing_message_id = $5,
+			updated_at = $6,
+			version = $7
+		WHERE id = $1 AND version = $8
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		payment.ID,
+		payment.Status,
+		payment.StatusReason,
+		payment.StatusInfo,
+		payment.ClearingMessageID,
+		payment.UpdatedAt,
+		payment.Version,
+		payment.Version-1,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update payment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return &ErrOptimisticLock{PaymentID: payment.ID}
+	}
+
+	return nil
+}
+
+func (r *pgPaymentRepository) ListPaymentsForSubmission(ctx context.Context, through time.Time, limit int) ([]*models.Payment, error) {
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payments
+		WHERE status = 'Received' AND requested_execution_date <= $1
+		ORDER BY created_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+	return r.queryPayments(ctx, query, through, limit)
+}
+
+func (r *pgPaymentRepository) ListPaymentsByClearingMessage(ctx context.Context, clearingMessageID string) ([]*models.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE clearing_message_id = $1 ORDER BY transaction_id`
+	return r.queryPayments(ctx, query, clearingMessageID)
+}
+
+func (r *pgPaymentRepository) queryPayments(ctx context.Context, query string, args ...interface{}) ([]*models.Payment, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*models.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating payments: %w", err)
+	}
+
+	return payments, nil
+}
+
+// Message operations
+
+const messageColumns = `
+	id, direction, message_type, message_id, file_name, content,
+	created_at, delivered_at`
+
+func scanMessage(row rowScanner) (*models.Message, error) {
+	message := &models.Message{}
+	var deliveredAt sql.NullTime
+
+	err := row.Scan(
+		&message.ID,
+		&message.Direction,
+		&message.MessageType,
+		&message.MessageID,
+		&message.FileName,
+		&message.Content,
+		&message.CreatedAt,
+		&deliveredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if deliveredAt.Valid {
+		deliveredAtTime := deliveredAt.Time
+		message.DeliveredAt = &deliveredAtTime
+	}
+
+	return message, nil
+}
+
+func (r *pgPaymentRepository) CreateMessage(ctx context.Context, message *models.Message) error {
+	if message.ID == uuid.Nil {
+		message.ID = uuid.New()
+	}
+	message.CreatedAt = time.Now().UTC()
+
+	query := `
+		INSERT INTO iso_messages (` + messageColumns + `
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		message.ID,
+		message.Direction,
+		message.MessageType,
+		message.MessageID,
+		message.FileName,
+		message.Content,
+		message.CreatedAt,
+		message.DeliveredAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create message: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgPaymentRepository) ListUndeliveredMessages(ctx context.Context, limit int) ([]*models.Message, error) {
+	query := `
+		SELECT ` + messageColumns + `
+		FROM iso_messages
+		WHERE direction = 'Outbound' AND delivered_at IS NULL
+		ORDER BY created_at, id
+		LIMIT $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*models.Message
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *pgPaymentRepository) MarkMessageDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE iso_messages SET delivered_at = $2 WHERE id = $1`, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to mark message delivered: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Transaction management
+
+func (r *pgPaymentRepository) BeginTx(ctx context.Context) (Tx, error) {
+	db, ok := r.db.(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("nested transactions not supported")
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return &pgTx{tx: tx}, nil
+}
+
+// pgTx implements Tx for PostgreSQL
+type pgTx struct {
+	tx *sql.Tx
+}
+
+func (t *pgTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (t *pgTx) Rollback(ctx context.Context) error {
+	if err := t.tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+func (t *pgTx) PaymentRepository() PaymentRepository {
+	return &pgPaymentRepository{db: t.tx}
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrOptimisticLock(t *testing.T) {
+	paymentID := uuid.New()
+	err := &ErrOptimisticLock{PaymentID: paymentID}
+
+	assert.Contains(t, err.Error(), "optimistic lock error")
+	assert.Equal(t, paymentID, err.PaymentID)
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	assert.True(t, isUniqueViolation(&pq.Error{Code: uniqueViolation}))
+	assert.False(t, isUniqueViolation(&pq.Error{Code: "23503"}))
+	assert.False(t, isUniqueViolation(sql.ErrNoRows))
+	assert.False(t, isUniqueViolation(nil))
+}
+
+func TestPaymentRepository_Interface(t *testing.T) {
+	var _ DBQuerier = (*sql.DB)(nil)
+	var _ DBQuerier = (*sql.Tx)(nil)
+	var _ PaymentRepository = (*pgPaymentRepository)(nil)
+	var _ Tx = (*pgTx)(nil)
+}
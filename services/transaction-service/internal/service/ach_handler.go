@@ -0,0 +1,111 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	apperrors "github.com/core-banking/pkg/errors"
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// maxACHRequestBytes bounds the size of an ACH request body
+const maxACHRequestBytes = 64 << 10
+
+// RegisterACHRoutes serves the ACH endpoints:
+//
+//	POST /ach/entries                    originate an entry (ACHOriginationRequest)
+//	GET  /ach/corrections?status=Open    list flagged account details
+//	POST /ach/corrections/{id}/resolve   mark a correction handled ({"resolved_by": "..."})
+func RegisterACHRoutes(r chi.Router, s *ACHService) {
+	r.Post("/ach/entries", func(w http.ResponseWriter, r *http.Request) {
+		var req ACHOriginationRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+		entry, err := s.OriginateEntry(r.Context(), req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, entry)
+	})
+
+	r.Get("/ach/corrections", func(w http.ResponseWriter, r *http.Request) {
+		status := models.ACHCorrectionStatusOpen
+		if v := r.URL.Query().Get("status"); v != "" {
+			status = models.ACHCorrectionStatus(v)
+		}
+		if !status.IsValid() {
+			writeError(w, apperrors.NewBadRequestError("status must be Open or Resolved", nil))
+			return
+		}
+		corrections, err := s.ListCorrections(r.Context(), status)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if corrections == nil {
+			corrections = []*models.ACHCorrection{}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"data": corrections})
+	})
+
+	r.Post("/ach/corrections/{id}/resolve", func(w http.ResponseWriter, r *http.Request) {
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			writeError(w, apperrors.NewBadRequestError("invalid correction id", err.Error()))
+			return
+		}
+		var req struct {
+			ResolvedBy string `json:"resolved_by"`
+		}
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+		if req.ResolvedBy == "" {
+			writeError(w, apperrors.NewValidationError("resolved_by is required", nil))
+			return
+		}
+		correction, err := s.ResolveCorrection(r.Context(), id, req.ResolvedBy, time.Now().UTC())
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, correction)
+	})
+}
+
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxACHRequestBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeError(w, apperrors.NewBadRequestError("invalid request body", err.Error()))
+		return false
+	}
+	return true
+}
+
+// writeError maps service errors to HTTP responses
+func writeError(w http.ResponseWriter, err error) {
+	var appErr *apperrors.AppError
+	switch {
+	case errors.As(err, &appErr):
+	case errors.Is(err, ErrInvalidACHEntry):
+		appErr = apperrors.NewValidationError(err.Error(), nil)
+	case errors.Is(err, ErrCorrectionNotOpen):
+		appErr = apperrors.NewNotFoundError(err.Error())
+	default:
+		appErr = apperrors.NewInternalServerError("internal error", err)
+	}
+	writeJSON(w, appErr.HTTPStatus, map[string]any{"error": appErr})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,827 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/core-banking/pkg/bankid"
+	accountclient "github.com/core-banking/services/account-service/client"
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/core-banking/services/transaction-service/internal/nacha"
+	"github.com/core-banking/services/transaction-service/internal/repository"
+	"github.com/google/uuid"
+)
+
+// ACH errors. ErrInvalidACHEntry wraps the reasons an entry was refused.
+var (
+	ErrInvalidACHEntry   = errors.New("invalid ACH entry")
+	ErrTooManyACHFiles   = errors.New("all 36 file ID modifiers used today")
+	ErrCorrectionNotOpen = errors.New("correction not found or already resolved")
+)
+
+// ACHCurrency is the only currency ACH entries are posted in
+const ACHCurrency = "USD"
+
+// ACHChannel is the channel ACH postings count against transaction limits
+// under
+const ACHChannel = "Batch"
+
+// ACHCountry is where the other party to every ACH entry is
+const ACHCountry = "US"
+
+// fileIDModifiers distinguish the files we create on one day, in order
+const fileIDModifiers = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// Return reason codes recorded on inbound entries we could not post, for
+// the return an operator sends back
+const (
+	ReturnInsufficientFunds = "R01"
+	ReturnAccountClosed     = "R02"
+	ReturnNoAccount         = "R03"
+	ReturnAccountFrozen     = "R16"
+	ReturnNonTransaction    = "R20"
+)
+
+// Ledger looks up and posts to customer accounts. The account-service
+// client satisfies it.
+type Ledger interface {
+	GetAccount(ctx context.Context, id uuid.UUID) (*accountclient.Account, error)
+	GetAccountByNumber(ctx context.Context, accountNumber string) (*accountclient.Account, error)
+	Transfer(ctx context.Context, req accountclient.TransferRequest) error
+}
+
+// ACHConfig identifies the bank in the ACH files it creates
+type ACHConfig struct {
+	RoutingNumber            string // Our routing number, the file's immediate origin
+	ImmediateDestination     string // Routing number of the ACH operator
+	ImmediateDestinationName string
+	ImmediateOriginName      string
+	// CompanyName and CompanyID name the originator of entries that do not
+	// give their own
+	CompanyName string
+	CompanyID   string
+	// SettlementAccountID is the account ACH entries settle against:
+	// outgoing credits and incoming debits are transferred into it, and
+	// outgoing debits and incoming credits are paid out of it
+	SettlementAccountID uuid.UUID
+}
+
+// ACHOriginationRequest asks to send an ACH credit or debit for one of our
+// customers. The routing and account number are the receiver's.
+type ACHOriginationRequest struct {
+	AccountID     uuid.UUID             `json:"account_id"`
+	SECCode       string                `json:"sec_code"`
+	EntryType     models.ACHEntryType   `json:"entry_type"`
+	AccountType   models.ACHAccountType `json:"account_type"`
+	RoutingNumber string                `json:"routing_number"`
+	AccountNumber string                `json:"account_number"`
+	Name          string                `json:"name"`
+	IndividualID  string                `json:"individual_id"`
+	CompanyName   string                `json:"company_name"`
+	CompanyID     string                `json:"company_id"`
+	Description   string                `json:"description"`
+	Amount        int64                 `json:"amount"` // Cents
+	EffectiveDate time.Time             `json:"effective_date"`
+	PaymentInfo   string                `json:"payment_info"`
+}
+
+// ACHFileResult summarizes a processed inbound ACH file
+type ACHFileResult struct {
+	FileID      uuid.UUID
+	Posted      int // Entries posted to our customers' accounts
+	Unposted    int // Entries waiting for an operator to return them
+	Returned    int // Entries we sent that were returned and reversed
+	Corrections int // Account details flagged for correction
+	// Unmatched lists the original trace numbers of returns and
+	// notifications of change that match no entry we sent
+	Unmatched []string
+	// ReversalsFailed lists the trace numbers of returned entries whose
+	// reversal could not be posted and must be settled by hand
+	ReversalsFailed []string
+	// LimitBreaches lists the trace numbers of unposted entries that would
+	// exceed the customer's transaction limits. They carry no return code:
+	// an operator either raises the limit and posts them or chooses the
+	// return with the customer.
+	LimitBreaches []string
+}
+
+// ACHService originates ACH entries into NACHA files and processes the
+// files the ACH operator sends back
+type ACHService struct {
+	repo      repository.PaymentRepository
+	ledger    Ledger
+	cfg       ACHConfig
+	batchSize int
+}
+
+// NewACHService creates a new ACHService
+func NewACHService(repo repository.PaymentRepository, ledger Ledger, cfg ACHConfig) (*ACHService, error) {
+	if err := bankid.ValidateABA(cfg.RoutingNumber); err != nil {
+		return nil, fmt.Errorf("invalid ACH routing number: %w", err)
+	}
+	if err := bankid.ValidateABA(cfg.ImmediateDestination); err != nil {
+		return nil, fmt.Errorf("invalid ACH immediate destination: %w", err)
+	}
+	if cfg.SettlementAccountID == uuid.Nil {
+		return nil, errors.New("ACH settlement account is required")
+	}
+	return &ACHService{
+		repo:      repo,
+		ledger:    ledger,
+		cfg:       cfg,
+		batchSize: defaultSubmissionBatchSize,
+	}, nil
+}
+
+// OriginateEntry validates and stores an entry to be sent in the next file
+// on or after its effective date. The customer's account must be an active
+// USD account; funds move when the file is generated.
+func (s *ACHService) OriginateEntry(ctx context.Context, req ACHOriginationRequest) (*models.ACHEntry, error) {
+	entry := &models.ACHEntry{
+		ID:                      uuid.New(),
+		Direction:               models.MessageDirectionOutbound,
+		AccountID:               &req.AccountID,
+		SECCode:                 strings.ToUpper(req.SECCode),
+		EntryType:               req.EntryType,
+		AccountType:             req.AccountType,
+		RoutingNumber:           req.RoutingNumber,
+		AccountNumber:           strings.TrimSpace(req.AccountNumber),
+		Name:                    strings.ToUpper(strings.TrimSpace(req.Name)),
+		IndividualID:            strings.TrimSpace(req.IndividualID),
+		CompanyName:             strings.ToUpper(strings.TrimSpace(req.CompanyName)),
+		CompanyID:               strings.TrimSpace(req.CompanyID),
+		CompanyEntryDescription: strings.ToUpper(strings.TrimSpace(req.Description)),
+		Amount:                  req.Amount,
+		EffectiveDate:           date(req.EffectiveDate),
+		PaymentInfo:             strings.TrimSpace(req.PaymentInfo),
+		Status:                  models.ACHEntryStatusPending,
+	}
+	if entry.AccountType == "" {
+		entry.AccountType = models.ACHAccountTypeChecking
+	}
+	if entry.CompanyName == "" {
+		entry.CompanyName = s.cfg.CompanyName
+	}
+	if entry.CompanyID == "" {
+		entry.CompanyID = s.cfg.CompanyID
+	}
+	if req.EffectiveDate.IsZero() {
+		entry.EffectiveDate = date(time.Now())
+	}
+
+	if err := s.validateEntry(entry); err != nil {
+		return nil, err
+	}
+
+	account, err := s.ledger.GetAccount(ctx, req.AccountID)
+	if errors.Is(err, accountclient.ErrNotFound) {
+		return nil, fmt.Errorf("%w: account %s not found", ErrInvalidACHEntry, req.AccountID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if account.Currency != ACHCurrency {
+		return nil, fmt.Errorf("%w: account %s is in %s, ACH settles in %s", ErrInvalidACHEntry, account.ID, account.Currency, ACHCurrency)
+	}
+	if account.Status != "Active" {
+		return nil, fmt.Errorf("%w: account %s is %s", ErrInvalidACHEntry, account.ID, account.Status)
+	}
+
+	if err := s.repo.CreateACHEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// validateEntry checks an entry against the rules we originate under, then
+// renders it in a one-entry file so the writer's field checks apply too
+func (s *ACHService) validateEntry(entry *models.ACHEntry) error {
+	var problems []string
+	switch entry.SECCode {
+	case nacha.SECPPD, nacha.SECCCD:
+	case nacha.SECWEB:
+		// WEB credits are only for person-to-person payments, which we do not offer
+		if entry.EntryType != models.ACHEntryTypeDebit {
+			problems = append(problems, "WEB entries must be debits")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("SEC code %q is not one of PPD, CCD or WEB", entry.SECCode))
+	}
+	if !entry.EntryType.IsValid() {
+		problems = append(problems, fmt.Sprintf("entry type %q must be Credit or Debit", entry.EntryType))
+	}
+	if !entry.AccountType.IsValid() {
+		problems = append(problems, fmt.Sprintf("account type %q must be Checking or Savings", entry.AccountType))
+	}
+	if entry.Amount <= 0 {
+		problems = append(problems, "amount must be positive")
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidACHEntry, strings.Join(problems, "; "))
+	}
+
+	file := s.newFile(time.Now(), "A")
+	batch := s.newBatch(entry, 1)
+	batch.Entries = append(batch.Entries, s.nachaEntry(entry, nacha.TraceNumber(s.odfi(), 1)))
+	file.Batches = append(file.Batches, batch)
+	if err := file.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidACHEntry, err)
+	}
+	return nil
+}
+
+// GenerateFile builds a NACHA file from the pending entries due by now and
+// stores it for delivery. Each entry is posted as it is added: a credit
+// moves the amount from the customer's account to the settlement account,
+// a debit the other way. Entries that cannot be posted are rejected and
+// left out. It returns nil when there is nothing to send.
+//
+// Transfers cannot be rolled back with the database transaction, so when
+// a transfer fails for a reason other than a rejection the entries posted
+// so far are still filed and committed, the rest stay pending for the next
+// run, and the error is returned with the file. Should the run fail after
+// posting and roll back, its entries are posted again by the next run
+// under the same trace numbers, which account-service takes as idempotency
+// keys and does not move the funds twice for.
+func (s *ACHService) GenerateFile(ctx context.Context, now time.Time) (*models.ACHFile, error) {
+	if err := s.numberPendingEntries(ctx, now); err != nil {
+		return nil, err
+	}
+
+	var stored *models.ACHFile
+	var postErr error
+	err := s.withTx(ctx, func(repo repository.PaymentRepository) error {
+		entries, err := repo.ListPendingACHEntries(ctx, date(now), s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		var posted []*models.ACHEntry
+		for _, entry := range entries {
+			if entry.TraceNumber == "" {
+				// Originated since the entries were numbered; left for the next run
+				continue
+			}
+
+			err = s.postOutbound(ctx, entry)
+			if errors.Is(err, accountclient.ErrRejected) || errors.Is(err, accountclient.ErrNotFound) {
+				entry.Status = models.ACHEntryStatusRejected
+				entry.StatusReason = err.Error()
+				if err := repo.UpdateACHEntry(ctx, entry); err != nil {
+					return err
+				}
+				continue
+			}
+			if err != nil {
+				postErr = fmt.Errorf("failed to post ACH entry %s: %w", entry.ID, err)
+				break
+			}
+			posted = append(posted, entry)
+		}
+		if len(posted) == 0 {
+			return nil
+		}
+
+		stored, err = s.storeFile(ctx, repo, posted, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stored, postErr
+}
+
+// numberPendingEntries checks another file may be sent today and gives the
+// pending entries due their trace numbers, committing both before anything
+// is posted. No money moves for a file that could not be stored, and an
+// entry keeps its trace number if the run posting it fails.
+func (s *ACHService) numberPendingEntries(ctx context.Context, now time.Time) error {
+	return s.withTx(ctx, func(repo repository.PaymentRepository) error {
+		if _, err := s.nextFileIDModifier(ctx, repo, now); err != nil {
+			return err
+		}
+
+		entries, err := repo.ListPendingACHEntries(ctx, date(now), s.batchSize)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if entry.TraceNumber != "" {
+				continue
+			}
+			seq, err := repo.NextACHTraceSequence(ctx)
+			if err != nil {
+				return err
+			}
+			entry.TraceNumber = nacha.TraceNumber(s.odfi(), seq)
+			if err := repo.UpdateACHEntry(ctx, entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// nextFileIDModifier returns the ID modifier of the next file sent today, or
+// ErrTooManyACHFiles when every modifier has been used
+func (s *ACHService) nextFileIDModifier(ctx context.Context, repo repository.PaymentRepository, now time.Time) (string, error) {
+	day := date(now)
+	count, err := repo.CountACHFiles(ctx, models.MessageDirectionOutbound, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return "", err
+	}
+	if count >= len(fileIDModifiers) {
+		return "", ErrTooManyACHFiles
+	}
+	return fileIDModifiers[count : count+1], nil
+}
+
+// postOutbound moves the funds of an entry we send. The trace number keys
+// the transfer, so posting the entry again does not move the funds twice.
+func (s *ACHService) postOutbound(ctx context.Context, entry *models.ACHEntry) error {
+	req := accountclient.TransferRequest{
+		Amount:              entry.Amount,
+		Currency:            ACHCurrency,
+		Channel:             ACHChannel,
+		CounterpartyCountry: ACHCountry,
+		Reference:           "ACH " + entry.TraceNumber,
+		Description:         entry.CompanyName + " " + entry.CompanyEntryDescription,
+		IdempotencyKey:      "ACH " + entry.TraceNumber,
+	}
+	if entry.EntryType == models.ACHEntryTypeCredit {
+		req.FromAccountID, req.ToAccountID = *entry.AccountID, s.cfg.SettlementAccountID
+	} else {
+		req.FromAccountID, req.ToAccountID = s.cfg.SettlementAccountID, *entry.AccountID
+	}
+	return s.ledger.Transfer(ctx, req)
+}
+
+// storeFile renders the posted entries as a file, one batch per
+// originator, SEC code, description and effective date in the order the
+// entries came, and marks them sent
+func (s *ACHService) storeFile(ctx context.Context, repo repository.PaymentRepository, entries []*models.ACHEntry, now time.Time) (*models.ACHFile, error) {
+	idModifier, err := s.nextFileIDModifier(ctx, repo, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// NACHA times are to the minute
+	file := s.newFile(now.UTC().Truncate(time.Minute), idModifier)
+	batches := make(map[string]*nacha.Batch)
+	for _, entry := range entries {
+		key := strings.Join([]string{entry.CompanyName, entry.CompanyID, entry.SECCode, entry.CompanyEntryDescription, entry.EffectiveDate.Format(time.DateOnly)}, "|")
+		batch, ok := batches[key]
+		if !ok {
+			batch = s.newBatch(entry, len(file.Batches)+1)
+			batches[key] = batch
+			file.Batches = append(file.Batches, batch)
+		}
+		batch.Entries = append(batch.Entries, s.nachaEntry(entry, entry.TraceNumber))
+	}
+
+	content, err := file.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ACH file: %w", err)
+	}
+	totals := file.Totals()
+	stored := &models.ACHFile{
+		ID:              uuid.New(),
+		Direction:       models.MessageDirectionOutbound,
+		FileName:        fmt.Sprintf("ach_%s_%s.ach", file.Header.CreatedAt.Format("20060102"), file.Header.IDModifier),
+		ImmediateOrigin: file.Header.ImmediateOrigin,
+		FileCreatedAt:   file.Header.CreatedAt,
+		IDModifier:      file.Header.IDModifier,
+		EntryCount:      len(entries),
+		TotalDebit:      totals.TotalDebit,
+		TotalCredit:     totals.TotalCredit,
+		Content:         content,
+	}
+	if err := repo.CreateACHFile(ctx, stored); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.Status = models.ACHEntryStatusSent
+		entry.FileID = &stored.ID
+		if err := repo.UpdateACHEntry(ctx, entry); err != nil {
+			return nil, err
+		}
+	}
+	return stored, nil
+}
+
+func (s *ACHService) newFile(createdAt time.Time, idModifier string) *nacha.File {
+	return &nacha.File{
+		Header: nacha.FileHeader{
+			ImmediateDestination:     s.cfg.ImmediateDestination,
+			ImmediateOrigin:          s.cfg.RoutingNumber,
+			CreatedAt:                createdAt,
+			IDModifier:               idModifier,
+			ImmediateDestinationName: s.cfg.ImmediateDestinationName,
+			ImmediateOriginName:      s.cfg.ImmediateOriginName,
+		},
+	}
+}
+
+func (s *ACHService) newBatch(entry *models.ACHEntry, number int) *nacha.Batch {
+	return &nacha.Batch{
+		Header: nacha.BatchHeader{
+			CompanyName:             entry.CompanyName,
+			CompanyID:               entry.CompanyID,
+			SECCode:                 entry.SECCode,
+			CompanyEntryDescription: entry.CompanyEntryDescription,
+			EffectiveEntryDate:      entry.EffectiveDate,
+			ODFIIdentification:      s.odfi(),
+			BatchNumber:             number,
+		},
+	}
+}
+
+func (s *ACHService) nachaEntry(entry *models.ACHEntry, traceNumber string) *nacha.Entry {
+	e := &nacha.Entry{
+		TransactionCode:  transactionCode(entry.EntryType, entry.AccountType),
+		RDFIRouting:      entry.RoutingNumber,
+		DFIAccountNumber: entry.AccountNumber,
+		Amount:           entry.Amount,
+		IndividualID:     entry.IndividualID,
+		IndividualName:   entry.Name,
+		TraceNumber:      traceNumber,
+	}
+	if entry.SECCode == nacha.SECWEB {
+		e.DiscretionaryData = "S" // Single entry payment type
+	}
+	if entry.PaymentInfo != "" {
+		e.Addenda = &nacha.PaymentAddenda{PaymentInfo: entry.PaymentInfo}
+	}
+	return e
+}
+
+func transactionCode(entryType models.ACHEntryType, accountType models.ACHAccountType) int {
+	switch {
+	case accountType == models.ACHAccountTypeSavings && entryType == models.ACHEntryTypeDebit:
+		return nacha.SavingsDebit
+	case accountType == models.ACHAccountTypeSavings:
+		return nacha.SavingsCredit
+	case entryType == models.ACHEntryTypeDebit:
+		return nacha.CheckingDebit
+	}
+	return nacha.CheckingCredit
+}
+
+// odfi is the eight-digit identification that starts our trace numbers
+func (s *ACHService) odfi() string {
+	return s.cfg.RoutingNumber[:8]
+}
+
+// ProcessIncomingFile applies an ACH file from the operator. A file is
+// processed once: a second file with the same origin, creation time and ID
+// modifier returns ErrDuplicateMessage.
+//
+//   - Returns of entries we sent reverse the posting and mark the entry
+//     returned. When the reason shows the receiver's account details are
+//     wrong, such as R03 no account, the details are flagged for correction.
+//   - Notifications of change flag the receiver's details with the
+//     corrected data.
+//   - Other entries are posted to our customer's account: credits are paid
+//     from the settlement account, debits into it. Entries that cannot be
+//     posted are kept unposted with the reason code an operator returns
+//     them with.
+//
+// As with GenerateFile, transfers already made are not undone if a later
+// step fails, but they are keyed by the file and entry so that processing
+// the file again once the fault is fixed does not post them twice.
+func (s *ACHService) ProcessIncomingFile(ctx context.Context, fileName string, data []byte) (*ACHFileResult, error) {
+	file, err := nacha.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+	if file.Header.ImmediateDestination != s.cfg.RoutingNumber {
+		return nil, fmt.Errorf("%w: file is addressed to %s", ErrInvalidMessage, file.Header.ImmediateDestination)
+	}
+
+	totals := file.Totals()
+	stored := &models.ACHFile{
+		ID:              uuid.New(),
+		Direction:       models.MessageDirectionInbound,
+		FileName:        fileName,
+		ImmediateOrigin: file.Header.ImmediateOrigin,
+		FileCreatedAt:   file.Header.CreatedAt,
+		IDModifier:      file.Header.IDModifier,
+		EntryCount:      len(file.Entries()),
+		TotalDebit:      totals.TotalDebit,
+		TotalCredit:     totals.TotalCredit,
+		Content:         data,
+	}
+	result := &ACHFileResult{FileID: stored.ID}
+	err = s.withTx(ctx, func(repo repository.PaymentRepository) error {
+		err := repo.CreateACHFile(ctx, stored)
+		if errors.Is(err, repository.ErrDuplicate) {
+			return fmt.Errorf("%w: ACH file %s %s %s", ErrDuplicateMessage, stored.ImmediateOrigin, nacha.FormatDate(stored.FileCreatedAt), stored.IDModifier)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, batch := range file.Batches {
+			for _, e := range batch.Entries {
+				switch {
+				case e.Return != nil:
+					err = s.applyReturn(ctx, repo, e, result)
+				case e.Change != nil:
+					err = s.applyChange(ctx, repo, e, result)
+				default:
+					err = s.postInbound(ctx, repo, stored, batch, e, result)
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// applyReturn reverses an entry we sent that came back
+func (s *ACHService) applyReturn(ctx context.Context, repo repository.PaymentRepository, e *nacha.Entry, result *ACHFileResult) error {
+	original, err := repo.GetOutboundACHEntryByTrace(ctx, e.Return.OriginalTraceNumber)
+	if errors.Is(err, repository.ErrNotFound) {
+		result.Unmatched = append(result.Unmatched, e.Return.OriginalTraceNumber)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if original.Status != models.ACHEntryStatusSent {
+		// Already returned by an earlier file
+		result.Unmatched = append(result.Unmatched, e.Return.OriginalTraceNumber)
+		return nil
+	}
+
+	code := e.Return.ReasonCode
+	description, _ := nacha.ReturnReason(code)
+	original.Status = models.ACHEntryStatusReturned
+	original.ReturnCode = code
+	original.StatusReason = description
+
+	// Reverse the original posting
+	req := accountclient.TransferRequest{
+		Amount:              original.Amount,
+		Currency:            ACHCurrency,
+		Channel:             ACHChannel,
+		CounterpartyCountry: ACHCountry,
+		Reference:           "ACH RETURN " + original.TraceNumber,
+		Description:         code + " " + description,
+		IdempotencyKey:      "ACH RETURN " + original.TraceNumber,
+	}
+	if original.EntryType == models.ACHEntryTypeCredit {
+		req.FromAccountID, req.ToAccountID = s.cfg.SettlementAccountID, *original.AccountID
+	} else {
+		req.FromAccountID, req.ToAccountID = *original.AccountID, s.cfg.SettlementAccountID
+	}
+	err = s.ledger.Transfer(ctx, req)
+	switch {
+	case errors.Is(err, accountclient.ErrRejected), errors.Is(err, accountclient.ErrNotFound):
+		original.StatusReason = fmt.Sprintf("%s; reversal not posted: %v", description, err)
+		result.ReversalsFailed = append(result.ReversalsFailed, original.TraceNumber)
+	case err != nil:
+		return fmt.Errorf("failed to reverse ACH entry %s: %w", original.ID, err)
+	}
+	if err := repo.UpdateACHEntry(ctx, original); err != nil {
+		return err
+	}
+	result.Returned++
+
+	if nacha.RequiresAccountCorrection(code) {
+		if err := repo.CreateACHCorrection(ctx, &models.ACHCorrection{
+			ID:            uuid.New(),
+			EntryID:       original.ID,
+			AccountID:     original.AccountID,
+			Code:          code,
+			Description:   description,
+			RoutingNumber: original.RoutingNumber,
+			AccountNumber: original.AccountNumber,
+			Status:        models.ACHCorrectionStatusOpen,
+		}); err != nil {
+			return err
+		}
+		result.Corrections++
+	}
+	return nil
+}
+
+// applyChange flags the receiver's details of an entry we sent with the
+// corrections of a notification of change
+func (s *ACHService) applyChange(ctx context.Context, repo repository.PaymentRepository, e *nacha.Entry, result *ACHFileResult) error {
+	original, err := repo.GetOutboundACHEntryByTrace(ctx, e.Change.OriginalTraceNumber)
+	if errors.Is(err, repository.ErrNotFound) {
+		result.Unmatched = append(result.Unmatched, e.Change.OriginalTraceNumber)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	corr, err := e.Change.Correction()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+	description, _ := nacha.ChangeReason(e.Change.ChangeCode)
+	correction := &models.ACHCorrection{
+		ID:                     uuid.New(),
+		EntryID:                original.ID,
+		AccountID:              original.AccountID,
+		Code:                   e.Change.ChangeCode,
+		Description:            description,
+		RoutingNumber:          original.RoutingNumber,
+		AccountNumber:          original.AccountNumber,
+		CorrectedRoutingNumber: corr.RoutingNumber,
+		CorrectedAccountNumber: corr.AccountNumber,
+		CorrectedName:          corr.Name,
+		CorrectedIndividualID:  corr.IndividualID,
+		CorrectedData:          strings.TrimSpace(e.Change.CorrectedData),
+		Status:                 models.ACHCorrectionStatusOpen,
+	}
+	if corr.TransactionCode != 0 {
+		correction.CorrectedAccountType = models.ACHAccountTypeChecking
+		if nacha.IsSavings(corr.TransactionCode) {
+			correction.CorrectedAccountType = models.ACHAccountTypeSavings
+		}
+	}
+	if err := repo.CreateACHCorrection(ctx, correction); err != nil {
+		return err
+	}
+	result.Corrections++
+	return nil
+}
+
+// postInbound records an entry sent to one of our customers and posts it
+func (s *ACHService) postInbound(ctx context.Context, repo repository.PaymentRepository, file *models.ACHFile, batch *nacha.Batch, e *nacha.Entry, result *ACHFileResult) error {
+	entry := &models.ACHEntry{
+		ID:                      uuid.New(),
+		Direction:               models.MessageDirectionInbound,
+		SECCode:                 batch.Header.SECCode,
+		EntryType:               models.ACHEntryTypeDebit,
+		AccountType:             models.ACHAccountTypeChecking,
+		RoutingNumber:           e.RDFIRouting,
+		AccountNumber:           e.DFIAccountNumber,
+		Name:                    e.IndividualName,
+		IndividualID:            e.IndividualID,
+		CompanyName:             batch.Header.CompanyName,
+		CompanyID:               batch.Header.CompanyID,
+		CompanyEntryDescription: batch.Header.CompanyEntryDescription,
+		Amount:                  e.Amount,
+		EffectiveDate:           batch.Header.EffectiveEntryDate,
+		TraceNumber:             e.TraceNumber,
+		FileID:                  &file.ID,
+		Status:                  models.ACHEntryStatusPosted,
+	}
+	if nacha.IsCredit(e.TransactionCode) {
+		entry.EntryType = models.ACHEntryTypeCredit
+	}
+	if nacha.IsSavings(e.TransactionCode) {
+		entry.AccountType = models.ACHAccountTypeSavings
+	}
+	if e.Addenda != nil {
+		entry.PaymentInfo = e.Addenda.PaymentInfo
+	}
+
+	// Trace numbers are only unique within the originator's files
+	key := fmt.Sprintf("ACH %s %s %s %s", file.ImmediateOrigin, file.FileCreatedAt.Format("0601021504"), file.IDModifier, e.TraceNumber)
+	code, reason, err := s.postToCustomer(ctx, entry, key, nacha.IsPrenote(e.TransactionCode))
+	var breach *accountclient.LimitBreachError
+	if errors.As(err, &breach) {
+		entry.Status = models.ACHEntryStatusUnposted
+		entry.StatusReason = breach.Message
+		result.Unposted++
+		result.LimitBreaches = append(result.LimitBreaches, entry.TraceNumber)
+		return repo.CreateACHEntry(ctx, entry)
+	}
+	if err != nil {
+		return err
+	}
+	if code != "" {
+		entry.Status = models.ACHEntryStatusUnposted
+		entry.ReturnCode = code
+		entry.StatusReason = reason
+		result.Unposted++
+	} else {
+		result.Posted++
+	}
+	return repo.CreateACHEntry(ctx, entry)
+}
+
+// postToCustomer finds the customer's account and, unless the entry is a
+// prenote, moves the funds under the idempotency key given. When the entry
+// cannot be posted it returns the return reason code and why; an entry over
+// the customer's limits is not the customer's refusal, so the
+// *accountclient.LimitBreachError is returned for the operator instead.
+func (s *ACHService) postToCustomer(ctx context.Context, entry *models.ACHEntry, key string, prenote bool) (code, reason string, err error) {
+	account, err := s.ledger.GetAccountByNumber(ctx, entry.AccountNumber)
+	if errors.Is(err, accountclient.ErrNotFound) {
+		return ReturnNoAccount, "no account with this number", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	entry.AccountID = &account.ID
+
+	switch {
+	case account.Status == "Closed":
+		return ReturnAccountClosed, "account is closed", nil
+	case account.Currency != ACHCurrency:
+		return ReturnNonTransaction, fmt.Sprintf("account is in %s", account.Currency), nil
+	case prenote:
+		return "", "", nil
+	}
+
+	req := accountclient.TransferRequest{
+		Amount:              entry.Amount,
+		Currency:            ACHCurrency,
+		Channel:             ACHChannel,
+		CounterpartyCountry: ACHCountry,
+		Reference:           "ACH " + entry.TraceNumber,
+		Description:         entry.CompanyName + " " + entry.CompanyEntryDescription,
+		IdempotencyKey:      key,
+	}
+	if entry.EntryType == models.ACHEntryTypeCredit {
+		req.FromAccountID, req.ToAccountID = s.cfg.SettlementAccountID, account.ID
+	} else {
+		req.FromAccountID, req.ToAccountID = account.ID, s.cfg.SettlementAccountID
+	}
+	err = s.ledger.Transfer(ctx, req)
+	var breach *accountclient.LimitBreachError
+	if errors.As(err, &breach) {
+		return "", "", err
+	}
+	if errors.Is(err, accountclient.ErrRejected) {
+		if account.Status == "Frozen" {
+			return ReturnAccountFrozen, err.Error(), nil
+		}
+		return ReturnInsufficientFunds, err.Error(), nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to post ACH entry %s: %w", entry.TraceNumber, err)
+	}
+	return "", "", nil
+}
+
+// ListCorrections returns the account corrections with a status, oldest first
+func (s *ACHService) ListCorrections(ctx context.Context, status models.ACHCorrectionStatus) ([]*models.ACHCorrection, error) {
+	return s.repo.ListACHCorrections(ctx, status, s.batchSize)
+}
+
+// ResolveCorrection marks a correction handled once the details have been
+// corrected with the customer
+func (s *ACHService) ResolveCorrection(ctx context.Context, id uuid.UUID, by string, now time.Time) (*models.ACHCorrection, error) {
+	err := s.repo.ResolveACHCorrection(ctx, id, by, now)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrCorrectionNotOpen
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetACHCorrectionByID(ctx, id)
+}
+
+// DeliverFiles writes outbound ACH files not yet delivered to the outbox
+// and returns how many were delivered. Like DeliverMessages, a file may be
+// written twice.
+func (s *ACHService) DeliverFiles(ctx context.Context, outbox Outbox, now time.Time) (int, error) {
+	files, err := s.repo.ListUndeliveredACHFiles(ctx, s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, f := range files {
+		if err := outbox.Write(f.FileName, f.Content); err != nil {
+			return delivered, fmt.Errorf("failed to deliver %s: %w", f.FileName, err)
+		}
+		if err := s.repo.MarkACHFileDelivered(ctx, f.ID, now); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+func (s *ACHService) withTx(ctx context.Context, fn func(repo repository.PaymentRepository) error) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx.PaymentRepository()); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
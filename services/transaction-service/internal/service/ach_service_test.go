@@ -0,0 +1,852 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	accountclient "github.com/core-banking/services/account-service/client"
+	"github.com/core-banking/services/transaction-service/internal/exchange"
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/core-banking/services/transaction-service/internal/nacha"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// mockLedger keeps account balances in memory. The settlement account may
+// go negative; customer accounts may not. Like account-service, a transfer
+// repeated with an idempotency key already used moves nothing.
+type mockLedger struct {
+	accounts   map[uuid.UUID]*accountclient.Account
+	balances   map[uuid.UUID]int64
+	settlement uuid.UUID
+	transfers  []accountclient.TransferRequest
+	keys       map[string]bool
+	limits     map[uuid.UUID]int64 // Per-transfer limit on the source account
+	nextErr    error
+}
+
+func newMockLedger() *mockLedger {
+	l := &mockLedger{
+		accounts: make(map[uuid.UUID]*accountclient.Account),
+		balances: make(map[uuid.UUID]int64),
+		keys:     make(map[string]bool),
+	}
+	l.settlement = l.add("9000000001", "USD", "Active", 0).ID
+	return l
+}
+
+func (l *mockLedger) add(number, currency, status string, balance int64) *accountclient.Account {
+	account := &accountclient.Account{
+		ID:            uuid.New(),
+		AccountNumber: number,
+		CustomerID:    uuid.New(),
+		AccountType:   "Checking",
+		Currency:      currency,
+		Status:        status,
+	}
+	l.accounts[account.ID] = account
+	l.balances[account.ID] = balance
+	return account
+}
+
+func (l *mockLedger) GetAccount(ctx context.Context, id uuid.UUID) (*accountclient.Account, error) {
+	account, ok := l.accounts[id]
+	if !ok {
+		return nil, accountclient.ErrNotFound
+	}
+	return account, nil
+}
+
+func (l *mockLedger) GetAccountByNumber(ctx context.Context, accountNumber string) (*accountclient.Account, error) {
+	for _, account := range l.accounts {
+		if account.AccountNumber == accountNumber {
+			return account, nil
+		}
+	}
+	return nil, accountclient.ErrNotFound
+}
+
+func (l *mockLedger) Transfer(ctx context.Context, req accountclient.TransferRequest) error {
+	if l.nextErr != nil {
+		return l.nextErr
+	}
+	if req.IdempotencyKey != "" && l.keys[req.IdempotencyKey] {
+		return nil
+	}
+	from, ok := l.accounts[req.FromAccountID]
+	if !ok {
+		return accountclient.ErrNotFound
+	}
+	if _, ok := l.accounts[req.ToAccountID]; !ok {
+		return accountclient.ErrNotFound
+	}
+	if from.Status != "Active" {
+		return fmt.Errorf("%w: source account is %s", accountclient.ErrRejected, from.Status)
+	}
+	if limit, ok := l.limits[from.ID]; ok && req.Amount > limit {
+		return &accountclient.LimitBreachError{Kind: "PerTransaction", Limit: limit, Remaining: limit, Message: "transfer exceeds the per-transaction limit"}
+	}
+	if from.ID != l.settlement && l.balances[from.ID] < req.Amount {
+		return fmt.Errorf("%w: insufficient available funds", accountclient.ErrRejected)
+	}
+	l.balances[from.ID] -= req.Amount
+	l.balances[req.ToAccountID] += req.Amount
+	l.transfers = append(l.transfers, req)
+	if req.IdempotencyKey != "" {
+		l.keys[req.IdempotencyKey] = true
+	}
+	return nil
+}
+
+const (
+	testACHRouting     = "076401251"
+	testACHDestination = "011000015"
+)
+
+func newTestACHService(t *testing.T, repo *MockRepository, ledger *mockLedger) *ACHService {
+	t.Helper()
+	svc, err := NewACHService(repo, ledger, ACHConfig{
+		RoutingNumber:            testACHRouting,
+		ImmediateDestination:     testACHDestination,
+		ImmediateDestinationName: "FEDERAL RESERVE BANK",
+		ImmediateOriginName:      "CORE BANK NA",
+		CompanyName:              "CORE BANK",
+		CompanyID:                "9876543210",
+		SettlementAccountID:      ledger.settlement,
+	})
+	if err != nil {
+		t.Fatalf("NewACHService: %v", err)
+	}
+	return svc
+}
+
+var testEffectiveDate = time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)
+
+func payrollCredit(accountID uuid.UUID, receiverAccount string, amount int64) ACHOriginationRequest {
+	return ACHOriginationRequest{
+		AccountID:     accountID,
+		SECCode:       nacha.SECPPD,
+		EntryType:     models.ACHEntryTypeCredit,
+		RoutingNumber: "021000021",
+		AccountNumber: receiverAccount,
+		Name:          "Jane Doe",
+		CompanyName:   "Acme Payroll",
+		CompanyID:     "1234567890",
+		Description:   "Payroll",
+		Amount:        amount,
+		EffectiveDate: testEffectiveDate,
+	}
+}
+
+func TestNewACHService_Config(t *testing.T) {
+	repo := NewMockRepository()
+	if _, err := NewACHService(repo, newMockLedger(), ACHConfig{RoutingNumber: "076401252", ImmediateDestination: testACHDestination, SettlementAccountID: uuid.New()}); err == nil {
+		t.Error("expected an error for a routing number failing its check digit")
+	}
+	if _, err := NewACHService(repo, newMockLedger(), ACHConfig{RoutingNumber: testACHRouting, ImmediateDestination: testACHDestination}); err == nil {
+		t.Error("expected an error without a settlement account")
+	}
+}
+
+func TestACHService_OriginateEntry(t *testing.T) {
+	ledger := newMockLedger()
+	customer := ledger.add("1000000011", "USD", "Active", 100000)
+	euro := ledger.add("1000000029", "EUR", "Active", 100000)
+	closed := ledger.add("1000000037", "USD", "Closed", 0)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	entry, err := svc.OriginateEntry(ctx, payrollCredit(customer.ID, "123456789", 2500))
+	if err != nil {
+		t.Fatalf("OriginateEntry: %v", err)
+	}
+	if entry.Status != models.ACHEntryStatusPending || entry.AccountType != models.ACHAccountTypeChecking {
+		t.Errorf("entry = %s %s, want Pending Checking", entry.Status, entry.AccountType)
+	}
+	if entry.Name != "JANE DOE" || entry.CompanyEntryDescription != "PAYROLL" {
+		t.Errorf("entry name %q, description %q; want upper case", entry.Name, entry.CompanyEntryDescription)
+	}
+
+	defaults := payrollCredit(customer.ID, "123456789", 2500)
+	defaults.CompanyName, defaults.CompanyID = "", ""
+	entry, err = svc.OriginateEntry(ctx, defaults)
+	if err != nil || entry.CompanyName != "CORE BANK" || entry.CompanyID != "9876543210" {
+		t.Errorf("OriginateEntry without originator = %+v, %v; want the bank's", entry, err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*ACHOriginationRequest)
+	}{
+		{"SEC code", func(r *ACHOriginationRequest) { r.SECCode = "TEL" }},
+		{"WEB credit", func(r *ACHOriginationRequest) { r.SECCode = nacha.SECWEB }},
+		{"entry type", func(r *ACHOriginationRequest) { r.EntryType = "Transfer" }},
+		{"routing check digit", func(r *ACHOriginationRequest) { r.RoutingNumber = "021000022" }},
+		{"amount", func(r *ACHOriginationRequest) { r.Amount = 0 }},
+		{"missing name", func(r *ACHOriginationRequest) { r.Name = "" }},
+		{"long account number", func(r *ACHOriginationRequest) { r.AccountNumber = "123456789012345678" }},
+		{"unknown account", func(r *ACHOriginationRequest) { r.AccountID = uuid.New() }},
+		{"currency", func(r *ACHOriginationRequest) { r.AccountID = euro.ID }},
+		{"closed account", func(r *ACHOriginationRequest) { r.AccountID = closed.ID }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := payrollCredit(customer.ID, "123456789", 2500)
+			tt.modify(&req)
+			if _, err := svc.OriginateEntry(ctx, req); !errors.Is(err, ErrInvalidACHEntry) {
+				t.Errorf("OriginateEntry = %v, want ErrInvalidACHEntry", err)
+			}
+		})
+	}
+	if len(repo.achEntries) != 2 {
+		t.Errorf("stored %d entries, want 2", len(repo.achEntries))
+	}
+}
+
+func TestACHService_GenerateFile(t *testing.T) {
+	ledger := newMockLedger()
+	employer := ledger.add("1000000011", "USD", "Active", 500000)
+	supplier := ledger.add("1000000029", "USD", "Active", 0)
+	poor := ledger.add("1000000037", "USD", "Active", 100)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+	now := time.Date(2024, 3, 6, 16, 30, 0, 0, time.UTC)
+
+	originate := func(req ACHOriginationRequest) *models.ACHEntry {
+		t.Helper()
+		entry, err := svc.OriginateEntry(ctx, req)
+		if err != nil {
+			t.Fatalf("OriginateEntry: %v", err)
+		}
+		return entry
+	}
+	originate(payrollCredit(employer.ID, "123456789", 250000))
+	savings := payrollCredit(employer.ID, "987654321012", 125050)
+	savings.AccountType = models.ACHAccountTypeSavings
+	savings.RoutingNumber = "026009593"
+	savings.PaymentInfo = "MARCH SALARY"
+	originate(savings)
+	originate(ACHOriginationRequest{
+		AccountID:     supplier.ID,
+		SECCode:       nacha.SECCCD,
+		EntryType:     models.ACHEntryTypeDebit,
+		RoutingNumber: "121000358",
+		AccountNumber: "55501234",
+		Name:          "Widget Wholesale Inc",
+		CompanyName:   "Acme Supplies",
+		CompanyID:     "1234567890",
+		Description:   "Invoice",
+		Amount:        980000,
+		EffectiveDate: testEffectiveDate,
+	})
+	rejected := originate(payrollCredit(poor.ID, "123456789", 5000))
+	later := payrollCredit(employer.ID, "123456789", 1000)
+	later.EffectiveDate = testEffectiveDate.AddDate(0, 0, 7)
+	originate(later)
+
+	// Effective dates up to today are due, so the later entry waits
+	file, err := svc.GenerateFile(ctx, testEffectiveDate)
+	if err != nil {
+		t.Fatalf("GenerateFile: %v", err)
+	}
+	if file == nil || file.EntryCount != 3 || file.TotalCredit != 375050 || file.TotalDebit != 980000 {
+		t.Fatalf("file = %+v, want 3 entries, credits 375050 and debits 980000", file)
+	}
+	if file.IDModifier != "A" || file.FileName != "ach_20240307_A.ach" {
+		t.Errorf("file %s modifier %s, want ach_20240307_A.ach with A", file.FileName, file.IDModifier)
+	}
+
+	parsed, err := nacha.Parse(file.Content)
+	if err != nil {
+		t.Fatalf("generated file does not parse: %v", err)
+	}
+	if len(parsed.Batches) != 2 {
+		t.Fatalf("file has %d batches, want payroll and supplies", len(parsed.Batches))
+	}
+	if b := parsed.Batches[0]; b.Header.CompanyName != "ACME PAYROLL" || b.Header.SECCode != nacha.SECPPD || len(b.Entries) != 2 {
+		t.Errorf("batch 1 = %s %s with %d entries", b.Header.CompanyName, b.Header.SECCode, len(b.Entries))
+	}
+	if e := parsed.Batches[0].Entries[1]; e.TransactionCode != nacha.SavingsCredit || e.Addenda == nil || e.Addenda.PaymentInfo != "MARCH SALARY" {
+		t.Errorf("savings entry = %+v, want a savings credit with addenda", e)
+	}
+	if e := parsed.Batches[1].Entries[0]; e.TransactionCode != nacha.CheckingDebit || e.TraceNumber != "076401250000003" {
+		t.Errorf("debit entry = %d %s, want 27 with the third trace number", e.TransactionCode, e.TraceNumber)
+	}
+
+	// Credits left the employer, the debit was paid to the supplier, and
+	// the settlement account carries the difference
+	if ledger.balances[employer.ID] != 500000-375050 || ledger.balances[supplier.ID] != 980000 {
+		t.Errorf("balances employer %d supplier %d", ledger.balances[employer.ID], ledger.balances[supplier.ID])
+	}
+	if ledger.balances[ledger.settlement] != 375050-980000 {
+		t.Errorf("settlement balance = %d", ledger.balances[ledger.settlement])
+	}
+
+	got, _ := repo.GetACHEntryByID(ctx, rejected.ID)
+	if got.Status != models.ACHEntryStatusRejected || got.StatusReason == "" {
+		t.Errorf("underfunded entry = %s %q, want Rejected with a reason", got.Status, got.StatusReason)
+	}
+	sent := 0
+	for _, e := range repo.achEntries {
+		if e.Status == models.ACHEntryStatusSent {
+			sent++
+			if e.FileID == nil || *e.FileID != file.ID || e.TraceNumber == "" {
+				t.Errorf("sent entry %s has file %v trace %q", e.ID, e.FileID, e.TraceNumber)
+			}
+		}
+	}
+	if sent != 3 {
+		t.Errorf("%d entries sent, want 3", sent)
+	}
+
+	// The later entry waits for its effective date
+	file, err = svc.GenerateFile(ctx, later.EffectiveDate.Add(-7*24*time.Hour+time.Hour))
+	if err != nil || file != nil {
+		t.Fatalf("GenerateFile with nothing due = %v, %v; want nil", file, err)
+	}
+	file, err = svc.GenerateFile(ctx, now.AddDate(0, 0, 8))
+	if err != nil || file == nil || file.EntryCount != 1 {
+		t.Fatalf("GenerateFile for the later entry = %+v, %v", file, err)
+	}
+
+	outbox := memoryOutbox{}
+	delivered, err := svc.DeliverFiles(ctx, outbox, now)
+	if err != nil || delivered != 2 {
+		t.Fatalf("DeliverFiles = %d, %v; want 2", delivered, err)
+	}
+	if _, ok := outbox["ach_20240307_A.ach"]; !ok {
+		t.Errorf("outbox holds %v", outbox)
+	}
+	if delivered, _ := svc.DeliverFiles(ctx, outbox, now); delivered != 0 {
+		t.Errorf("second DeliverFiles delivered %d, want 0", delivered)
+	}
+}
+
+func TestACHService_GenerateFile_IDModifier(t *testing.T) {
+	ledger := newMockLedger()
+	customer := ledger.add("1000000011", "USD", "Active", 100000)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	for i, want := range []string{"A", "B", "C"} {
+		if _, err := svc.OriginateEntry(ctx, payrollCredit(customer.ID, "123456789", 100)); err != nil {
+			t.Fatal(err)
+		}
+		file, err := svc.GenerateFile(ctx, testEffectiveDate.Add(time.Duration(9+i)*time.Hour))
+		if err != nil || file == nil || file.IDModifier != want {
+			t.Fatalf("file %d = %+v, %v; want modifier %s", i, file, err, want)
+		}
+	}
+}
+
+func TestACHService_GenerateFile_LedgerDown(t *testing.T) {
+	ledger := newMockLedger()
+	customer := ledger.add("1000000011", "USD", "Active", 100000)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	if _, err := svc.OriginateEntry(ctx, payrollCredit(customer.ID, "123456789", 100)); err != nil {
+		t.Fatal(err)
+	}
+	ledger.nextErr = errors.New("connection refused")
+	file, err := svc.GenerateFile(ctx, testEffectiveDate)
+	if err == nil || file != nil {
+		t.Fatalf("GenerateFile = %v, %v; want an error and no file", file, err)
+	}
+	if repo.achEntries[0].Status != models.ACHEntryStatusPending {
+		t.Errorf("entry = %s, want it left Pending for the next run", repo.achEntries[0].Status)
+	}
+}
+
+func TestACHService_GenerateFile_Rerun(t *testing.T) {
+	ledger := newMockLedger()
+	employer := ledger.add("1000000011", "USD", "Active", 100000)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	if _, err := svc.OriginateEntry(ctx, payrollCredit(employer.ID, "123456789", 100)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.GenerateFile(ctx, testEffectiveDate); err != nil {
+		t.Fatal(err)
+	}
+
+	// A run that fails after posting rolls back the file and the entry's
+	// status, but not its trace number, which was committed first
+	trace := repo.achEntries[0].TraceNumber
+	repo.achFiles = nil
+	repo.achEntries[0].Status, repo.achEntries[0].FileID = models.ACHEntryStatusPending, nil
+
+	file, err := svc.GenerateFile(ctx, testEffectiveDate.Add(time.Hour))
+	if err != nil || file == nil || file.EntryCount != 1 {
+		t.Fatalf("GenerateFile rerun = %+v, %v; want the entry filed", file, err)
+	}
+	if repo.achEntries[0].TraceNumber != trace {
+		t.Errorf("trace number = %s, want %s kept from the first run", repo.achEntries[0].TraceNumber, trace)
+	}
+	if len(ledger.transfers) != 1 || ledger.balances[employer.ID] != 100000-100 {
+		t.Errorf("%d transfers, employer balance %d; want the entry posted once", len(ledger.transfers), ledger.balances[employer.ID])
+	}
+}
+
+func TestACHService_GenerateFile_TooManyFiles(t *testing.T) {
+	ledger := newMockLedger()
+	employer := ledger.add("1000000011", "USD", "Active", 100000)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	for range len(fileIDModifiers) {
+		repo.achFiles = append(repo.achFiles, &models.ACHFile{Direction: models.MessageDirectionOutbound, FileCreatedAt: testEffectiveDate.Add(time.Hour)})
+	}
+	if _, err := svc.OriginateEntry(ctx, payrollCredit(employer.ID, "123456789", 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := svc.GenerateFile(ctx, testEffectiveDate.Add(2*time.Hour)); !errors.Is(err, ErrTooManyACHFiles) {
+		t.Fatalf("GenerateFile = %v, want ErrTooManyACHFiles", err)
+	}
+	if len(ledger.transfers) != 0 || repo.achEntries[0].Status != models.ACHEntryStatusPending {
+		t.Errorf("%d transfers, entry %s; want nothing posted and the entry left Pending", len(ledger.transfers), repo.achEntries[0].Status)
+	}
+}
+
+// incomingFile builds a file from the ACH operator carrying a return, a
+// notification of change and forward entries for our customers
+func incomingFile(t *testing.T, returnTrace, changeTrace string) []byte {
+	t.Helper()
+	effective := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+	file := &nacha.File{
+		Header: nacha.FileHeader{
+			ImmediateDestination:     testACHRouting,
+			ImmediateOrigin:          testACHDestination,
+			CreatedAt:                time.Date(2024, 3, 8, 6, 5, 0, 0, time.UTC),
+			IDModifier:               "A",
+			ImmediateDestinationName: "CORE BANK NA",
+			ImmediateOriginName:      "FEDERAL RESERVE BANK",
+		},
+		Batches: []*nacha.Batch{
+			{
+				Header: nacha.BatchHeader{
+					CompanyName:             "ACME PAYROLL",
+					CompanyID:               "1234567890",
+					SECCode:                 nacha.SECPPD,
+					CompanyEntryDescription: "PAYROLL",
+					EffectiveEntryDate:      effective,
+					ODFIIdentification:      "02100002",
+					BatchNumber:             1,
+				},
+				Entries: []*nacha.Entry{
+					{
+						TransactionCode:  nacha.CheckingReturnCredit,
+						RDFIRouting:      testACHRouting,
+						DFIAccountNumber: "123456789",
+						Amount:           250000,
+						IndividualName:   "JANE DOE",
+						TraceNumber:      "021000020000017",
+						Return: &nacha.ReturnAddenda{
+							ReasonCode:          "R03",
+							OriginalTraceNumber: returnTrace,
+							OriginalRDFI:        "02100002",
+							TraceNumber:         "021000020000017",
+						},
+					},
+					{
+						TransactionCode:  nacha.CheckingReturnCredit,
+						RDFIRouting:      testACHRouting,
+						DFIAccountNumber: "123456789",
+						Amount:           100,
+						IndividualName:   "JANE DOE",
+						TraceNumber:      "021000020000018",
+						Return: &nacha.ReturnAddenda{
+							ReasonCode:          "R01",
+							OriginalTraceNumber: "076401259999999",
+							OriginalRDFI:        "02100002",
+							TraceNumber:         "021000020000018",
+						},
+					},
+				},
+			},
+			{
+				Header: nacha.BatchHeader{
+					ServiceClassCode:        nacha.ServiceClassCredits,
+					CompanyName:             "ACME PAYROLL",
+					CompanyID:               "1234567890",
+					SECCode:                 nacha.SECCOR,
+					CompanyEntryDescription: "PAYROLL",
+					EffectiveEntryDate:      effective,
+					ODFIIdentification:      "02600959",
+					BatchNumber:             2,
+				},
+				Entries: []*nacha.Entry{
+					{
+						TransactionCode:  nacha.SavingsReturnCredit,
+						RDFIRouting:      testACHRouting,
+						DFIAccountNumber: "987654321012",
+						IndividualName:   "JOHN ROE",
+						TraceNumber:      "026009590000001",
+						Change: &nacha.ChangeAddenda{
+							ChangeCode:          "C01",
+							OriginalTraceNumber: changeTrace,
+							OriginalRDFI:        "02600959",
+							CorrectedData:       "987654321013",
+							TraceNumber:         "026009590000001",
+						},
+					},
+				},
+			},
+			{
+				Header: nacha.BatchHeader{
+					CompanyName:             "GLOBEX CORP",
+					CompanyID:               "1555555555",
+					SECCode:                 nacha.SECPPD,
+					CompanyEntryDescription: "SALARY",
+					EffectiveEntryDate:      effective,
+					ODFIIdentification:      "12100035",
+					BatchNumber:             3,
+				},
+				Entries: []*nacha.Entry{
+					{
+						TransactionCode:  nacha.CheckingCredit,
+						RDFIRouting:      testACHRouting,
+						DFIAccountNumber: "1000000045",
+						Amount:           320000,
+						IndividualName:   "MARY MAJOR",
+						TraceNumber:      "121000350000001",
+					},
+					{
+						TransactionCode:  nacha.CheckingDebit,
+						RDFIRouting:      testACHRouting,
+						DFIAccountNumber: "1000000045",
+						Amount:           900000,
+						IndividualName:   "MARY MAJOR",
+						TraceNumber:      "121000350000002",
+					},
+					{
+						TransactionCode:  nacha.CheckingCredit,
+						RDFIRouting:      testACHRouting,
+						DFIAccountNumber: "1999999999",
+						Amount:           5000,
+						IndividualName:   "NOBODY",
+						TraceNumber:      "121000350000003",
+					},
+				},
+			},
+		},
+	}
+	data, err := file.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	return data
+}
+
+func TestACHService_ProcessIncomingFile(t *testing.T) {
+	ledger := newMockLedger()
+	employer := ledger.add("1000000011", "USD", "Active", 500000)
+	receiver := ledger.add("1000000045", "USD", "Active", 0)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	credit, _ := svc.OriginateEntry(ctx, payrollCredit(employer.ID, "123456789", 250000))
+	savings := payrollCredit(employer.ID, "987654321012", 125050)
+	savings.AccountType = models.ACHAccountTypeSavings
+	changed, _ := svc.OriginateEntry(ctx, savings)
+	if _, err := svc.GenerateFile(ctx, testEffectiveDate); err != nil {
+		t.Fatal(err)
+	}
+	credit, _ = repo.GetACHEntryByID(ctx, credit.ID)
+	changed, _ = repo.GetACHEntryByID(ctx, changed.ID)
+
+	data := incomingFile(t, credit.TraceNumber, changed.TraceNumber)
+	result, err := svc.ProcessIncomingFile(ctx, "returns.ach", data)
+	if err != nil {
+		t.Fatalf("ProcessIncomingFile: %v", err)
+	}
+	if result.Returned != 1 || result.Corrections != 2 || result.Posted != 1 || result.Unposted != 2 {
+		t.Errorf("result = %+v, want 1 returned, 2 corrections, 1 posted, 2 unposted", result)
+	}
+	if len(result.Unmatched) != 1 || result.Unmatched[0] != "076401259999999" {
+		t.Errorf("unmatched = %v, want the unknown trace", result.Unmatched)
+	}
+
+	// The returned credit is reversed back to the employer
+	got, _ := repo.GetACHEntryByID(ctx, credit.ID)
+	if got.Status != models.ACHEntryStatusReturned || got.ReturnCode != "R03" {
+		t.Errorf("returned entry = %s %s, want Returned R03", got.Status, got.ReturnCode)
+	}
+	if ledger.balances[employer.ID] != 500000-125050 {
+		t.Errorf("employer balance = %d, want the returned credit back", ledger.balances[employer.ID])
+	}
+
+	// R03 means the account details are wrong; the NOC carries the fix
+	corrections := map[string]*models.ACHCorrection{}
+	for _, c := range repo.achCorrections {
+		corrections[c.Code] = c
+	}
+	if c := corrections["R03"]; c == nil || c.EntryID != credit.ID || c.AccountNumber != "123456789" || c.Status != models.ACHCorrectionStatusOpen {
+		t.Errorf("R03 correction = %+v", c)
+	}
+	if c := corrections["C01"]; c == nil || c.EntryID != changed.ID || c.CorrectedAccountNumber != "987654321013" || c.Description == "" {
+		t.Errorf("C01 correction = %+v", c)
+	}
+
+	// The forward credit is posted; the debit it cannot cover and the
+	// credit to an unknown account wait to be returned
+	if ledger.balances[receiver.ID] != 320000 {
+		t.Errorf("receiver balance = %d, want 320000", ledger.balances[receiver.ID])
+	}
+	inbound := map[string]*models.ACHEntry{}
+	for _, e := range repo.achEntries {
+		if e.Direction == models.MessageDirectionInbound {
+			inbound[e.TraceNumber] = e
+		}
+	}
+	if e := inbound["121000350000001"]; e == nil || e.Status != models.ACHEntryStatusPosted || e.AccountID == nil || *e.AccountID != receiver.ID {
+		t.Errorf("posted credit = %+v", e)
+	}
+	if e := inbound["121000350000002"]; e == nil || e.Status != models.ACHEntryStatusUnposted || e.ReturnCode != ReturnInsufficientFunds {
+		t.Errorf("uncovered debit = %+v, want Unposted R01", e)
+	}
+	if e := inbound["121000350000003"]; e == nil || e.Status != models.ACHEntryStatusUnposted || e.ReturnCode != ReturnNoAccount || e.AccountID != nil {
+		t.Errorf("unknown account credit = %+v, want Unposted R03", e)
+	}
+
+	if _, err := svc.ProcessIncomingFile(ctx, "returns-again.ach", data); !errors.Is(err, ErrDuplicateMessage) {
+		t.Errorf("second ProcessIncomingFile = %v, want ErrDuplicateMessage", err)
+	}
+}
+
+func TestACHService_ProcessIncomingFile_Rerun(t *testing.T) {
+	ledger := newMockLedger()
+	employer := ledger.add("1000000011", "USD", "Active", 500000)
+	receiver := ledger.add("1000000045", "USD", "Active", 0)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	credit, _ := svc.OriginateEntry(ctx, payrollCredit(employer.ID, "123456789", 250000))
+	if _, err := svc.GenerateFile(ctx, testEffectiveDate); err != nil {
+		t.Fatal(err)
+	}
+	credit, _ = repo.GetACHEntryByID(ctx, credit.ID)
+	data := incomingFile(t, credit.TraceNumber, credit.TraceNumber)
+	if _, err := svc.ProcessIncomingFile(ctx, "returns.ach", data); err != nil {
+		t.Fatal(err)
+	}
+	transfers, employerBalance := len(ledger.transfers), ledger.balances[employer.ID]
+
+	// Rolling back the file's transaction undoes everything it recorded,
+	// but not the transfers
+	var outbound []*models.ACHEntry
+	for _, e := range repo.achEntries {
+		if e.Direction == models.MessageDirectionOutbound {
+			e.Status = models.ACHEntryStatusSent
+			outbound = append(outbound, e)
+		}
+	}
+	repo.achEntries, repo.achCorrections = outbound, nil
+	repo.achFiles = repo.achFiles[:1]
+
+	result, err := svc.ProcessIncomingFile(ctx, "returns.ach", data)
+	if err != nil {
+		t.Fatalf("ProcessIncomingFile again: %v", err)
+	}
+	if result.Returned != 1 || result.Posted != 1 {
+		t.Errorf("result = %+v, want the return and the credit applied again", result)
+	}
+	if len(ledger.transfers) != transfers || ledger.balances[employer.ID] != employerBalance || ledger.balances[receiver.ID] != 320000 {
+		t.Errorf("%d transfers, employer %d, receiver %d; want nothing posted twice",
+			len(ledger.transfers), ledger.balances[employer.ID], ledger.balances[receiver.ID])
+	}
+}
+
+func TestACHService_ProcessIncomingFile_LimitBreach(t *testing.T) {
+	ledger := newMockLedger()
+	receiver := ledger.add("1000000045", "USD", "Active", 1000000)
+	ledger.limits = map[uuid.UUID]int64{receiver.ID: 1000}
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	ctx := context.Background()
+
+	result, err := svc.ProcessIncomingFile(ctx, "returns.ach", incomingFile(t, "076401250000001", "076401250000002"))
+	if err != nil {
+		t.Fatalf("ProcessIncomingFile: %v", err)
+	}
+
+	// The funds are there, so the debit over the limit is not returned as
+	// insufficient funds; it is left for an operator without a return code
+	if len(result.LimitBreaches) != 1 || result.LimitBreaches[0] != "121000350000002" {
+		t.Errorf("limit breaches = %v, want the debit", result.LimitBreaches)
+	}
+	for _, e := range repo.achEntries {
+		if e.TraceNumber != "121000350000002" {
+			continue
+		}
+		if e.Status != models.ACHEntryStatusUnposted || e.ReturnCode != "" || e.StatusReason == "" {
+			t.Errorf("debit over the limit = %+v, want Unposted without a return code", e)
+		}
+	}
+}
+
+func TestACHService_ProcessIncomingFile_Invalid(t *testing.T) {
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, newMockLedger())
+	ctx := context.Background()
+
+	if _, err := svc.ProcessIncomingFile(ctx, "garbage.ach", []byte("not an ACH file")); !errors.Is(err, ErrInvalidMessage) {
+		t.Errorf("garbage = %v, want ErrInvalidMessage", err)
+	}
+
+	other, err := NewACHService(repo, newMockLedger(), ACHConfig{
+		RoutingNumber:        "021000021",
+		ImmediateDestination: testACHDestination,
+		SettlementAccountID:  uuid.New(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.ProcessIncomingFile(ctx, "misrouted.ach", incomingFile(t, "076401250000001", "076401250000002")); !errors.Is(err, ErrInvalidMessage) {
+		t.Errorf("file for another bank = %v, want ErrInvalidMessage", err)
+	}
+	if len(repo.achFiles) != 0 {
+		t.Errorf("stored %d files, want none", len(repo.achFiles))
+	}
+}
+
+func TestACHService_ResolveCorrection(t *testing.T) {
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, newMockLedger())
+	ctx := context.Background()
+	correction := &models.ACHCorrection{ID: uuid.New(), EntryID: uuid.New(), Code: "C02", Status: models.ACHCorrectionStatusOpen}
+	if err := repo.CreateACHCorrection(ctx, correction); err != nil {
+		t.Fatal(err)
+	}
+
+	open, _ := svc.ListCorrections(ctx, models.ACHCorrectionStatusOpen)
+	if len(open) != 1 {
+		t.Fatalf("open corrections = %d, want 1", len(open))
+	}
+	resolved, err := svc.ResolveCorrection(ctx, correction.ID, "ops@example.com", time.Now())
+	if err != nil || resolved.Status != models.ACHCorrectionStatusResolved || resolved.ResolvedBy != "ops@example.com" {
+		t.Fatalf("ResolveCorrection = %+v, %v", resolved, err)
+	}
+	if _, err := svc.ResolveCorrection(ctx, correction.ID, "ops@example.com", time.Now()); !errors.Is(err, ErrCorrectionNotOpen) {
+		t.Errorf("second ResolveCorrection = %v, want ErrCorrectionNotOpen", err)
+	}
+	open, _ = svc.ListCorrections(ctx, models.ACHCorrectionStatusOpen)
+	if len(open) != 0 {
+		t.Errorf("open corrections after resolving = %d, want 0", len(open))
+	}
+}
+
+func TestRegisterACHRoutes(t *testing.T) {
+	ledger := newMockLedger()
+	customer := ledger.add("1000000011", "USD", "Active", 100000)
+	repo := NewMockRepository()
+	svc := newTestACHService(t, repo, ledger)
+	router := chi.NewRouter()
+	RegisterACHRoutes(router, svc)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return rec
+	}
+
+	body := fmt.Sprintf(`{"account_id":%q,"sec_code":"PPD","entry_type":"Credit","routing_number":"021000021","account_number":"123456789","name":"Jane Doe","description":"Payroll","amount":2500}`, customer.ID)
+	if rec := serve(http.MethodPost, "/ach/entries", body); rec.Code != http.StatusCreated {
+		t.Errorf("POST /ach/entries = %d %s, want 201", rec.Code, rec.Body)
+	}
+	body = strings.Replace(body, "021000021", "021000022", 1)
+	if rec := serve(http.MethodPost, "/ach/entries", body); rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "VALIDATION_ERROR") {
+		t.Errorf("POST /ach/entries with a bad routing number = %d %s, want 400", rec.Code, rec.Body)
+	}
+
+	correction := &models.ACHCorrection{ID: uuid.New(), EntryID: uuid.New(), Code: "C01", Status: models.ACHCorrectionStatusOpen}
+	if err := repo.CreateACHCorrection(context.Background(), correction); err != nil {
+		t.Fatal(err)
+	}
+	if rec := serve(http.MethodGet, "/ach/corrections", ""); rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), correction.ID.String()) {
+		t.Errorf("GET /ach/corrections = %d %s", rec.Code, rec.Body)
+	}
+	if rec := serve(http.MethodGet, "/ach/corrections?status=Done", ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("GET /ach/corrections with a bad status = %d, want 400", rec.Code)
+	}
+	path := "/ach/corrections/" + correction.ID.String() + "/resolve"
+	if rec := serve(http.MethodPost, path, `{"resolved_by":"ops"}`); rec.Code != http.StatusOK {
+		t.Errorf("POST %s = %d %s, want 200", path, rec.Code, rec.Body)
+	}
+	if rec := serve(http.MethodPost, path, `{"resolved_by":"ops"}`); rec.Code != http.StatusNotFound {
+		t.Errorf("resolving twice = %d, want 404", rec.Code)
+	}
+}
+
+func TestPaymentExchanger_ACH(t *testing.T) {
+	root := t.TempDir()
+	dir, err := exchange.NewDirectory(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ledger := newMockLedger()
+	customer := ledger.add("1000000045", "USD", "Active", 1000000)
+	repo := NewMockRepository()
+	ach := newTestACHService(t, repo, ledger)
+	exchanger := NewPaymentExchanger(newTestPaymentService(repo), ach, dir, time.Minute, zerolog.Nop())
+	ctx := context.Background()
+
+	if _, err := ach.OriginateEntry(ctx, payrollCredit(customer.ID, "123456789", 2500)); err != nil {
+		t.Fatal(err)
+	}
+	inbox := filepath.Join(root, exchange.InboxDir)
+	if err := os.WriteFile(filepath.Join(inbox, "incoming.ach"), incomingFile(t, "076401250000001", "076401250000002"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(inbox, "broken.ach"), []byte("101"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := exchanger.Exchange(ctx, testEffectiveDate.Add(9*time.Hour)); err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, exchange.ProcessedDir, "incoming.ach")); err != nil {
+		t.Errorf("ACH file not moved to processed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, exchange.FailedDir, "broken.ach.error")); err != nil {
+		t.Errorf("broken ACH file not moved to failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(root, exchange.OutboxDir, "ach_20240307_A.ach"))
+	if err != nil {
+		t.Fatalf("generated ACH file not in outbox: %v", err)
+	}
+	if _, err := nacha.Parse(data); err != nil {
+		t.Errorf("outbox ACH file is invalid: %v", err)
+	}
+
+	// Without ACH configured, NACHA files are rejected
+	if err := os.WriteFile(filepath.Join(inbox, "late.ach"), []byte("101"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	plain := NewPaymentExchanger(newTestPaymentService(repo), nil, dir, time.Minute, zerolog.Nop())
+	if err := plain.Exchange(ctx, testEffectiveDate); err != nil {
+		t.Fatalf("Exchange without ACH: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, exchange.FailedDir, "late.ach.error")); err != nil {
+		t.Errorf("ACH file not rejected without ACH configured: %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/core-banking/services/transaction-service/internal/exchange"
+	"github.com/core-banking/services/transaction-service/internal/iso20022"
+	"github.com/rs/zerolog"
+)
+
+// PaymentExchanger periodically exchanges ISO 20022 files with the clearing
+// system through an exchange directory: it imports pain.001 and pacs.002
+// files from the inbox, submits due payments, and writes the resulting
+// pacs.008 messages to the outbox. When ACH is configured it also processes
+// incoming NACHA files and sends pending ACH entries.
+type PaymentExchanger struct {
+	payments *PaymentService
+	ach      *ACHService // nil when ACH is not configured
+	dir      *exchange.Directory
+	interval time.Duration
+	log      zerolog.Logger
+}
+
+// NewPaymentExchanger creates a new PaymentExchanger. ach may be nil, in
+// which case NACHA files in the inbox are rejected.
+func NewPaymentExchanger(payments *PaymentService, ach *ACHService, dir *exchange.Directory, interval time.Duration, log zerolog.Logger) *PaymentExchanger {
+	return &PaymentExchanger{
+		payments: payments,
+		ach:      ach,
+		dir:      dir,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run exchanges files every interval until the context is cancelled
+func (e *PaymentExchanger) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.Exchange(ctx, time.Now().UTC()); err != nil {
+				e.log.Error().Err(err).Msg("Payment exchange run failed")
+			}
+		}
+	}
+}
+
+// Exchange runs one exchange cycle. Inbox files that are invalid or of an
+// unsupported type are moved to the failed directory; files that fail for
+// any other reason, such as the database being down, stay in the inbox and
+// are retried on the next cycle.
+func (e *PaymentExchanger) Exchange(ctx context.Context, now time.Time) error {
+	names, err := e.dir.Pending()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := e.processFile(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	submitted, err := e.payments.SubmitPayments(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to submit payments: %w", err)
+	}
+	if submitted > 0 {
+		e.log.Info().Int("payments", submitted).Msg("Submitted payments for clearing")
+	}
+
+	delivered, err := e.payments.DeliverMessages(ctx, e.dir, now)
+	if delivered > 0 {
+		e.log.Info().Int("messages", delivered).Msg("Delivered messages to outbox")
+	}
+	if err != nil || e.ach == nil {
+		return err
+	}
+
+	// A file is stored even when posting stopped part way, so deliver it
+	// before reporting the error
+	file, genErr := e.ach.GenerateFile(ctx, now)
+	if file != nil {
+		e.log.Info().
+			Str("file", file.FileName).
+			Int("entries", file.EntryCount).
+			Msg("Generated ACH file")
+	}
+	delivered, err = e.ach.DeliverFiles(ctx, e.dir, now)
+	if delivered > 0 {
+		e.log.Info().Int("files", delivered).Msg("Delivered ACH files to outbox")
+	}
+	if genErr != nil {
+		return fmt.Errorf("failed to generate ACH file: %w", genErr)
+	}
+	return err
+}
+
+// processFile imports one inbox file. It returns an error only when the
+// file should be retried.
+func (e *PaymentExchanger) processFile(ctx context.Context, name string) error {
+	log := e.log.With().Str("file", name).Logger()
+	data, err := e.dir.Read(name)
+	if err != nil {
+		return err
+	}
+
+	err = e.dispatch(ctx, name, data, log)
+	switch {
+	case err == nil:
+		return e.dir.MarkProcessed(name)
+	case errors.Is(err, ErrDuplicateMessage):
+		log.Warn().Err(err).Msg("Skipping duplicate message")
+		return e.dir.MarkProcessed(name)
+	case errors.Is(err, ErrInvalidMessage), errors.Is(err, iso20022.ErrUnknownMessage):
+		log.Error().Err(err).Msg("Rejected inbox file")
+		return e.dir.MarkFailed(name, err)
+	}
+	return fmt.Errorf("failed to process %s: %w", name, err)
+}
+
+func (e *PaymentExchanger) dispatch(ctx context.Context, name string, data []byte, log zerolog.Logger) error {
+	if filepath.Ext(name) == exchange.ACHExt {
+		return e.dispatchACH(ctx, name, data, log)
+	}
+
+	namespace, err := iso20022.Namespace(data)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+
+	switch namespace {
+	case iso20022.Pain001Namespace:
+		result, err := e.payments.ImportPain001(ctx, name, data)
+		if err != nil {
+			return err
+		}
+		log.Info().
+			Str("message_id", result.MessageID).
+			Int("received", result.Received).
+			Int("rejected", result.Rejected).
+			Msg("Imported payment initiation")
+	case iso20022.Pacs002Namespace:
+		result, err := e.payments.ApplyPacs002(ctx, name, data)
+		if err != nil {
+			return err
+		}
+		event := log.Info()
+		if len(result.Unmatched) > 0 {
+			event = log.Warn().Strs("unmatched", result.Unmatched)
+		}
+		event.
+			Str("message_id", result.MessageID).
+			Int("updated", result.Updated).
+			Msg("Applied payment status report")
+	default:
+		return fmt.Errorf("%w: %s", iso20022.ErrUnknownMessage, namespace)
+	}
+	return nil
+}
+
+func (e *PaymentExchanger) dispatchACH(ctx context.Context, name string, data []byte, log zerolog.Logger) error {
+	if e.ach == nil {
+		return fmt.Errorf("%w: ACH is not configured", ErrInvalidMessage)
+	}
+	result, err := e.ach.ProcessIncomingFile(ctx, name, data)
+	if err != nil {
+		return err
+	}
+
+	event := log.Info()
+	if len(result.Unmatched) > 0 || len(result.ReversalsFailed) > 0 || len(result.LimitBreaches) > 0 {
+		event = log.Warn().
+			Strs("unmatched", result.Unmatched).
+			Strs("reversals_failed", result.ReversalsFailed).
+			Strs("limit_breaches", result.LimitBreaches)
+	}
+	event.
+		Int("posted", result.Posted).
+		Int("unposted", result.Unposted).
+		Int("returned", result.Returned).
+		Int("corrections", result.Corrections).
+		Msg("Processed ACH file")
+	return nil
+}
@@ -0,0 +1,423 @@
+// Package service implements payments: importing customer credit transfer
+// initiations, sending them for clearing and tracking their status, and
+// exchanging ACH entries in NACHA files.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/core-banking/pkg/bankid"
+	"github.com/core-banking/services/transaction-service/internal/beneficiary"
+	"github.com/core-banking/services/transaction-service/internal/iso20022"
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/core-banking/services/transaction-service/internal/repository"
+	"github.com/google/uuid"
+)
+
+// Payment errors. ErrInvalidMessage wraps the parse or validation error.
+var (
+	ErrInvalidMessage   = errors.New("invalid message")
+	ErrDuplicateMessage = errors.New("message already processed")
+)
+
+// ISO 20022 reason codes recorded on payments rejected at import
+const (
+	ReasonIncorrectAccountNumber = "AC01" // Creditor account cannot be used
+	ReasonInvalidDebtorAccount   = "AC02"
+)
+
+// defaultSubmissionBatchSize caps the payments sent in one submission run
+const defaultSubmissionBatchSize = 1000
+
+// Outbox receives outbound messages for delivery to the clearing system
+type Outbox interface {
+	Write(name string, data []byte) error
+}
+
+// ImportResult summarizes an imported pain.001
+type ImportResult struct {
+	MessageID string
+	Received  int // Payments waiting to be sent for clearing
+	Rejected  int // Payments rejected at import
+}
+
+// StatusResult summarizes an applied pacs.002
+type StatusResult struct {
+	MessageID string
+	Updated   int
+	// Unmatched lists the reported transactions, as original MsgId/TxId or
+	// MsgId/EndToEndId, that match no payment
+	Unmatched []string
+}
+
+// PaymentService handles outgoing payment business logic
+type PaymentService struct {
+	repo          repository.PaymentRepository
+	beneficiaries *beneficiary.Validator
+	bic           string // Our BIC, the instructing agent
+	clearingBIC   string // The clearing system's BIC, the instructed agent
+	batchSize     int
+}
+
+// NewPaymentService creates a new PaymentService. Outgoing pacs.008
+// messages name bic as the instructing agent and clearingBIC, which may be
+// empty, as the instructed agent.
+func NewPaymentService(repo repository.PaymentRepository, beneficiaries *beneficiary.Validator, bic, clearingBIC string) *PaymentService {
+	return &PaymentService{
+		repo:          repo,
+		beneficiaries: beneficiaries,
+		bic:           bic,
+		clearingBIC:   clearingBIC,
+		batchSize:     defaultSubmissionBatchSize,
+	}
+}
+
+// ImportPain001 records the payments of a customer credit transfer
+// initiation. A message is imported once: a second message with the same
+// MsgId returns ErrDuplicateMessage. Payments whose accounts fail the
+// beneficiary checks are kept, rejected, so the customer can be told why.
+func (s *PaymentService) ImportPain001(ctx context.Context, fileName string, data []byte) (*ImportResult, error) {
+	doc, err := iso20022.ParsePain001(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+	instructions, err := doc.Instructions()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+
+	result := &ImportResult{MessageID: doc.CstmrCdtTrfInitn.GrpHdr.MsgID}
+	err = s.withTx(ctx, func(repo repository.PaymentRepository) error {
+		if err := s.recordInbound(ctx, repo, iso20022.Pain001MessageName, result.MessageID, fileName, data); err != nil {
+			return err
+		}
+		for _, in := range instructions {
+			payment := s.newPayment(in)
+			if payment.Status == models.PaymentStatusRejected {
+				result.Rejected++
+			} else {
+				result.Received++
+			}
+			if err := repo.CreatePayment(ctx, payment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// newPayment turns an instruction into a payment, rejecting it if either
+// account fails validation
+func (s *PaymentService) newPayment(in iso20022.Instruction) *models.Payment {
+	payment := &models.Payment{
+		ID:                     uuid.New(),
+		MessageID:              in.MessageID,
+		PaymentInfoID:          in.PaymentInfoID,
+		InstructionID:          in.InstructionID,
+		EndToEndID:             in.EndToEndID,
+		DebtorName:             in.DebtorName,
+		DebtorIBAN:             in.DebtorIBAN,
+		CreditorName:           in.CreditorName,
+		CreditorIBAN:           in.CreditorIBAN,
+		CreditorAgentBIC:       in.CreditorAgentBIC,
+		Amount:                 in.Amount,
+		Currency:               in.Currency,
+		RequestedExecutionDate: in.RequestedExecutionDate,
+		RemittanceInfo:         in.RemittanceInfo,
+		Status:                 models.PaymentStatusReceived,
+		TransactionID:          newMessageID(),
+	}
+
+	if err := bankid.ValidateIBAN(in.DebtorIBAN); err != nil {
+		payment.Status = models.PaymentStatusRejected
+		payment.StatusReason = ReasonInvalidDebtorAccount
+		payment.StatusInfo = err.Error()
+		return payment
+	}
+	if in.CreditorIBAN == "" {
+		payment.Status = models.PaymentStatusRejected
+		payment.StatusReason = ReasonIncorrectAccountNumber
+		payment.StatusInfo = "creditor account must be identified by IBAN"
+		return payment
+	}
+	if check := s.beneficiaries.Validate(beneficiary.Account{Name: in.CreditorName, IBAN: in.CreditorIBAN}); !check.Valid {
+		payment.Status = models.PaymentStatusRejected
+		payment.StatusReason = ReasonIncorrectAccountNumber
+		payment.StatusInfo = check.Errors.Error()
+	}
+	return payment
+}
+
+// SubmitPayments sends the received payments due on or before now for
+// clearing, one pacs.008 per currency, and returns how many were sent. The
+// messages are stored in the outbox table in the same transaction that
+// marks the payments submitted, so each payment is sent exactly once.
+func (s *PaymentService) SubmitPayments(ctx context.Context, now time.Time) (int, error) {
+	settlementDate := date(now)
+	submitted := 0
+	err := s.withTx(ctx, func(repo repository.PaymentRepository) error {
+		payments, err := repo.ListPaymentsForSubmission(ctx, settlementDate, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		byCurrency := make(map[string][]*models.Payment)
+		for _, p := range payments {
+			byCurrency[p.Currency] = append(byCurrency[p.Currency], p)
+		}
+		currencies := make([]string, 0, len(byCurrency))
+		for ccy := range byCurrency {
+			currencies = append(currencies, ccy)
+		}
+		sort.Strings(currencies)
+
+		for _, ccy := range currencies {
+			if err := s.submit(ctx, repo, byCurrency[ccy], now, settlementDate); err != nil {
+				return err
+			}
+			submitted += len(byCurrency[ccy])
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return submitted, nil
+}
+
+func (s *PaymentService) submit(ctx context.Context, repo repository.PaymentRepository, payments []*models.Payment, now, settlementDate time.Time) error {
+	hdr := iso20022.Pacs008Header{
+		MessageID:           newMessageID(),
+		CreatedAt:           now,
+		SettlementDate:      settlementDate,
+		InstructingAgentBIC: s.bic,
+		InstructedAgentBIC:  s.clearingBIC,
+	}
+	transfers := make([]iso20022.Transfer, len(payments))
+	for i, p := range payments {
+		transfers[i] = iso20022.Transfer{
+			InstructionID:    p.InstructionID,
+			EndToEndID:       p.EndToEndID,
+			TransactionID:    p.TransactionID,
+			DebtorName:       p.DebtorName,
+			DebtorIBAN:       p.DebtorIBAN,
+			DebtorAgentBIC:   s.bic,
+			CreditorName:     p.CreditorName,
+			CreditorIBAN:     p.CreditorIBAN,
+			CreditorAgentBIC: p.CreditorAgentBIC,
+			Amount:           p.Amount,
+			Currency:         p.Currency,
+			RemittanceInfo:   p.RemittanceInfo,
+		}
+	}
+
+	doc, err := iso20022.NewPacs008(hdr, transfers)
+	if err != nil {
+		return fmt.Errorf("failed to build pacs.008: %w", err)
+	}
+	content, err := iso20022.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pacs.008: %w", err)
+	}
+
+	message := &models.Message{
+		ID:          uuid.New(),
+		Direction:   models.MessageDirectionOutbound,
+		MessageType: iso20022.Pacs008MessageName,
+		MessageID:   hdr.MessageID,
+		FileName:    fmt.Sprintf("pacs008_%s.xml", hdr.MessageID),
+		Content:     content,
+	}
+	if err := repo.CreateMessage(ctx, message); err != nil {
+		return err
+	}
+
+	for _, p := range payments {
+		if err := p.TransitionTo(models.PaymentStatusSubmitted, "", ""); err != nil {
+			return err
+		}
+		p.ClearingMessageID = hdr.MessageID
+		if err := repo.UpdatePayment(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyPacs002 updates payments from a clearing status report. Statuses
+// are matched on the original pacs.008 MsgId and the TxId, or the
+// EndToEndId when no TxId is given; a group status applies to every
+// payment of the original message. Statuses a payment has already passed,
+// such as an acceptance arriving after settlement, are ignored.
+func (s *PaymentService) ApplyPacs002(ctx context.Context, fileName string, data []byte) (*StatusResult, error) {
+	doc, err := iso20022.ParsePacs002(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+
+	result := &StatusResult{MessageID: doc.FIToFIPmtStsRpt.GrpHdr.MsgID}
+	err = s.withTx(ctx, func(repo repository.PaymentRepository) error {
+		if err := s.recordInbound(ctx, repo, iso20022.Pacs002MessageName, result.MessageID, fileName, data); err != nil {
+			return err
+		}
+
+		sent := make(map[string][]*models.Payment)
+		for _, st := range doc.Statuses() {
+			payments, ok := sent[st.OriginalMessageID]
+			if !ok {
+				payments, err = repo.ListPaymentsByClearingMessage(ctx, st.OriginalMessageID)
+				if err != nil {
+					return err
+				}
+				sent[st.OriginalMessageID] = payments
+			}
+
+			matched := matchStatus(payments, st)
+			if len(matched) == 0 {
+				result.Unmatched = append(result.Unmatched, statusKey(st))
+				continue
+			}
+
+			next, ok := PaymentStatusFor(st.Status)
+			if !ok {
+				continue
+			}
+			for _, p := range matched {
+				if p.Status == next || !p.Status.CanTransitionTo(next) {
+					continue
+				}
+				if err := p.TransitionTo(next, st.ReasonCode, st.AdditionalInfo); err != nil {
+					return err
+				}
+				if err := repo.UpdatePayment(ctx, p); err != nil {
+					return err
+				}
+				result.Updated++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PaymentStatusFor maps an ISO 20022 transaction status code to a payment status
+func PaymentStatusFor(code string) (models.PaymentStatus, bool) {
+	switch code {
+	case iso20022.StatusAcceptedTechnicalValidation, iso20022.StatusAcceptedCustomerProfile,
+		iso20022.StatusAcceptedSettlementInProcess, iso20022.StatusAcceptedWithChange:
+		return models.PaymentStatusAccepted, true
+	case iso20022.StatusAcceptedSettlementCompleted:
+		return models.PaymentStatusSettled, true
+	case iso20022.StatusPending:
+		return models.PaymentStatusPending, true
+	case iso20022.StatusRejected:
+		return models.PaymentStatusRejected, true
+	}
+	return "", false
+}
+
+// matchStatus returns the payments a status applies to
+func matchStatus(payments []*models.Payment, st iso20022.TransactionStatus) []*models.Payment {
+	if st.OriginalTxID == "" && st.OriginalEndToEndID == "" {
+		return payments
+	}
+	var matched []*models.Payment
+	for _, p := range payments {
+		if st.OriginalTxID != "" {
+			if p.TransactionID == st.OriginalTxID {
+				matched = append(matched, p)
+			}
+		} else if p.EndToEndID == st.OriginalEndToEndID {
+			matched = append(matched, p)
+		}
+	}
+	return matched
+}
+
+func statusKey(st iso20022.TransactionStatus) string {
+	switch {
+	case st.OriginalTxID != "":
+		return st.OriginalMessageID + "/" + st.OriginalTxID
+	case st.OriginalEndToEndID != "":
+		return st.OriginalMessageID + "/" + st.OriginalEndToEndID
+	}
+	return st.OriginalMessageID
+}
+
+// DeliverMessages writes outbound messages not yet delivered to the outbox
+// and returns how many were delivered. A message written but not marked
+// delivered is written again on the next run, so the outbox must accept
+// the same file twice.
+func (s *PaymentService) DeliverMessages(ctx context.Context, outbox Outbox, now time.Time) (int, error) {
+	messages, err := s.repo.ListUndeliveredMessages(ctx, s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, m := range messages {
+		if err := outbox.Write(m.FileName, m.Content); err != nil {
+			return delivered, fmt.Errorf("failed to deliver %s: %w", m.FileName, err)
+		}
+		if err := s.repo.MarkMessageDelivered(ctx, m.ID, now); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+// recordInbound stores an inbound message, failing with ErrDuplicateMessage
+// if it was seen before
+func (s *PaymentService) recordInbound(ctx context.Context, repo repository.PaymentRepository, messageType, messageID, fileName string, data []byte) error {
+	err := repo.CreateMessage(ctx, &models.Message{
+		ID:          uuid.New(),
+		Direction:   models.MessageDirectionInbound,
+		MessageType: messageType,
+		MessageID:   messageID,
+		FileName:    fileName,
+		Content:     data,
+	})
+	if errors.Is(err, repository.ErrDuplicate) {
+		return fmt.Errorf("%w: %s %s", ErrDuplicateMessage, messageType, messageID)
+	}
+	return err
+}
+
+func (s *PaymentService) withTx(ctx context.Context, fn func(repo repository.PaymentRepository) error) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx.PaymentRepository()); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// newMessageID returns a unique identifier that fits the 35 characters
+// ISO 20022 allows for message and transaction IDs
+func newMessageID() string {
+	return strings.ReplaceAll(uuid.NewString(), "-", "")
+}
+
+// date truncates a time to midnight UTC
+func date(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,639 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/core-banking/pkg/bankid"
+	"github.com/core-banking/services/transaction-service/internal/beneficiary"
+	"github.com/core-banking/services/transaction-service/internal/exchange"
+	"github.com/core-banking/services/transaction-service/internal/iso20022"
+	"github.com/core-banking/services/transaction-service/internal/models"
+	"github.com/core-banking/services/transaction-service/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// MockRepository is an in-memory implementation of PaymentRepository for testing
+type MockRepository struct {
+	payments       map[uuid.UUID]*models.Payment
+	messages       []*models.Message
+	achEntries     []*models.ACHEntry
+	achFiles       []*models.ACHFile
+	achCorrections []*models.ACHCorrection
+	traceSeq       int
+	nextErr        error
+}
+
+func NewMockRepository() *MockRepository {
+	return &MockRepository{payments: make(map[uuid.UUID]*models.Payment)}
+}
+
+func (m *MockRepository) CreatePayment(ctx context.Context, payment *models.Payment) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	payment.CreatedAt = time.Now().UTC()
+	payment.UpdatedAt = payment.CreatedAt
+	payment.Version = 1
+	copied := *payment
+	m.payments[payment.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) GetPaymentByID(ctx context.Context, id uuid.UUID) (*models.Payment, error) {
+	payment, exists := m.payments[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	copied := *payment
+	return &copied, nil
+}
+
+func (m *MockRepository) UpdatePayment(ctx context.Context, payment *models.Payment) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	existing, exists := m.payments[payment.ID]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	if existing.Version != payment.Version {
+		return &repository.ErrOptimisticLock{PaymentID: payment.ID}
+	}
+	payment.Version++
+	payment.UpdatedAt = time.Now().UTC()
+	copied := *payment
+	m.payments[payment.ID] = &copied
+	return nil
+}
+
+func (m *MockRepository) ListPaymentsForSubmission(ctx context.Context, through time.Time, limit int) ([]*models.Payment, error) {
+	var payments []*models.Payment
+	for _, p := range m.sortedPayments() {
+		if p.Status == models.PaymentStatusReceived && !p.RequestedExecutionDate.After(through) && len(payments) < limit {
+			payments = append(payments, p)
+		}
+	}
+	return payments, nil
+}
+
+func (m *MockRepository) ListPaymentsByClearingMessage(ctx context.Context, clearingMessageID string) ([]*models.Payment, error) {
+	var payments []*models.Payment
+	for _, p := range m.sortedPayments() {
+		if p.ClearingMessageID == clearingMessageID {
+			payments = append(payments, p)
+		}
+	}
+	return payments, nil
+}
+
+// sortedPayments returns copies of every payment in a stable order
+func (m *MockRepository) sortedPayments() []*models.Payment {
+	payments := make([]*models.Payment, 0, len(m.payments))
+	for _, p := range m.payments {
+		copied := *p
+		payments = append(payments, &copied)
+	}
+	sort.Slice(payments, func(i, j int) bool { return payments[i].EndToEndID < payments[j].EndToEndID })
+	return payments
+}
+
+func (m *MockRepository) CreateMessage(ctx context.Context, message *models.Message) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for _, existing := range m.messages {
+		if existing.Direction == message.Direction && existing.MessageType == message.MessageType && existing.MessageID == message.MessageID {
+			return repository.ErrDuplicate
+		}
+	}
+	message.CreatedAt = time.Now().UTC()
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+func (m *MockRepository) ListUndeliveredMessages(ctx context.Context, limit int) ([]*models.Message, error) {
+	var messages []*models.Message
+	for _, msg := range m.messages {
+		if msg.Direction == models.MessageDirectionOutbound && msg.DeliveredAt == nil && len(messages) < limit {
+			messages = append(messages, msg)
+		}
+	}
+	return messages, nil
+}
+
+func (m *MockRepository) MarkMessageDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	for _, msg := range m.messages {
+		if msg.ID == id {
+			msg.DeliveredAt = &at
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) CreateACHEntry(ctx context.Context, entry *models.ACHEntry) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	entry.CreatedAt = time.Now().UTC()
+	entry.UpdatedAt = entry.CreatedAt
+	entry.Version = 1
+	copied := *entry
+	m.achEntries = append(m.achEntries, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetACHEntryByID(ctx context.Context, id uuid.UUID) (*models.ACHEntry, error) {
+	for _, e := range m.achEntries {
+		if e.ID == id {
+			copied := *e
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) GetOutboundACHEntryByTrace(ctx context.Context, traceNumber string) (*models.ACHEntry, error) {
+	for _, e := range m.achEntries {
+		if e.Direction == models.MessageDirectionOutbound && e.TraceNumber == traceNumber {
+			copied := *e
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) UpdateACHEntry(ctx context.Context, entry *models.ACHEntry) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for i, e := range m.achEntries {
+		if e.ID != entry.ID {
+			continue
+		}
+		if e.Version != entry.Version {
+			return &repository.ErrOptimisticLock{ACHEntryID: entry.ID}
+		}
+		entry.Version++
+		entry.UpdatedAt = time.Now().UTC()
+		copied := *entry
+		m.achEntries[i] = &copied
+		return nil
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) ListPendingACHEntries(ctx context.Context, through time.Time, limit int) ([]*models.ACHEntry, error) {
+	var entries []*models.ACHEntry
+	for _, e := range m.achEntries {
+		if e.Status == models.ACHEntryStatusPending && !e.EffectiveDate.After(through) && len(entries) < limit {
+			copied := *e
+			entries = append(entries, &copied)
+		}
+	}
+	return entries, nil
+}
+
+func (m *MockRepository) NextACHTraceSequence(ctx context.Context) (int, error) {
+	m.traceSeq++
+	return m.traceSeq, nil
+}
+
+func (m *MockRepository) CreateACHFile(ctx context.Context, file *models.ACHFile) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	for _, existing := range m.achFiles {
+		if existing.Direction == file.Direction && existing.ImmediateOrigin == file.ImmediateOrigin &&
+			existing.FileCreatedAt.Equal(file.FileCreatedAt) && existing.IDModifier == file.IDModifier {
+			return repository.ErrDuplicate
+		}
+	}
+	file.CreatedAt = time.Now().UTC()
+	m.achFiles = append(m.achFiles, file)
+	return nil
+}
+
+func (m *MockRepository) CountACHFiles(ctx context.Context, direction models.MessageDirection, from, to time.Time) (int, error) {
+	count := 0
+	for _, f := range m.achFiles {
+		if f.Direction == direction && !f.FileCreatedAt.Before(from) && f.FileCreatedAt.Before(to) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *MockRepository) ListUndeliveredACHFiles(ctx context.Context, limit int) ([]*models.ACHFile, error) {
+	var files []*models.ACHFile
+	for _, f := range m.achFiles {
+		if f.Direction == models.MessageDirectionOutbound && f.DeliveredAt == nil && len(files) < limit {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+func (m *MockRepository) MarkACHFileDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	for _, f := range m.achFiles {
+		if f.ID == id {
+			f.DeliveredAt = &at
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) CreateACHCorrection(ctx context.Context, correction *models.ACHCorrection) error {
+	if m.nextErr != nil {
+		return m.nextErr
+	}
+	correction.CreatedAt = time.Now().UTC()
+	copied := *correction
+	m.achCorrections = append(m.achCorrections, &copied)
+	return nil
+}
+
+func (m *MockRepository) GetACHCorrectionByID(ctx context.Context, id uuid.UUID) (*models.ACHCorrection, error) {
+	for _, c := range m.achCorrections {
+		if c.ID == id {
+			copied := *c
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *MockRepository) ListACHCorrections(ctx context.Context, status models.ACHCorrectionStatus, limit int) ([]*models.ACHCorrection, error) {
+	var corrections []*models.ACHCorrection
+	for _, c := range m.achCorrections {
+		if c.Status == status && len(corrections) < limit {
+			copied := *c
+			corrections = append(corrections, &copied)
+		}
+	}
+	return corrections, nil
+}
+
+func (m *MockRepository) ResolveACHCorrection(ctx context.Context, id uuid.UUID, by string, at time.Time) error {
+	for _, c := range m.achCorrections {
+		if c.ID == id && c.Status == models.ACHCorrectionStatusOpen {
+			c.Status = models.ACHCorrectionStatusResolved
+			c.ResolvedBy = by
+			c.ResolvedAt = &at
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (m *MockRepository) BeginTx(ctx context.Context) (repository.Tx, error) {
+	return &mockTx{repo: m}, nil
+}
+
+// mockTx runs every operation directly against the mock repository
+type mockTx struct {
+	repo *MockRepository
+}
+
+func (t *mockTx) Commit(ctx context.Context) error                { return nil }
+func (t *mockTx) Rollback(ctx context.Context) error              { return nil }
+func (t *mockTx) PaymentRepository() repository.PaymentRepository { return t.repo }
+
+// memoryOutbox collects delivered files
+type memoryOutbox map[string][]byte
+
+func (o memoryOutbox) Write(name string, data []byte) error {
+	o[name] = data
+	return nil
+}
+
+const (
+	testBIC         = "COREGB2L"
+	testClearingBIC = "EBAPFRPP"
+)
+
+func newTestPaymentService(repo *MockRepository) *PaymentService {
+	return NewPaymentService(repo, beneficiary.NewValidator(bankid.DefaultModulusTable()), testBIC, testClearingBIC)
+}
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// paymentsByEndToEnd indexes the repository's payments by EndToEndId
+func paymentsByEndToEnd(repo *MockRepository) map[string]*models.Payment {
+	byID := make(map[string]*models.Payment)
+	for _, p := range repo.sortedPayments() {
+		byID[p.EndToEndID] = p
+	}
+	return byID
+}
+
+func TestImportPain001(t *testing.T) {
+	repo := NewMockRepository()
+	svc := newTestPaymentService(repo)
+
+	result, err := svc.ImportPain001(context.Background(), "payments.xml", readTestdata(t, "pain001_payments.xml"))
+	if err != nil {
+		t.Fatalf("ImportPain001: %v", err)
+	}
+	if result.MessageID != "ACME-20240305-0002" || result.Received != 2 || result.Rejected != 2 {
+		t.Errorf("result = %+v, want 2 received and 2 rejected", result)
+	}
+
+	payments := paymentsByEndToEnd(repo)
+	ok := payments["E2E-INV-2001"]
+	if ok == nil || ok.Status != models.PaymentStatusReceived || ok.Amount != 10000 || ok.Currency != "EUR" {
+		t.Fatalf("E2E-INV-2001 = %+v, want received EUR 100.00", ok)
+	}
+	if len(ok.TransactionID) != 32 || ok.CreditorAgentBIC != "COBADEFFXXX" || ok.RemittanceInfo != "Invoice 2001 & delivery" {
+		t.Errorf("E2E-INV-2001 details = %+v", ok)
+	}
+	for _, id := range []string{"E2E-INV-2003", "E2E-INV-2004"} {
+		p := payments[id]
+		if p == nil || p.Status != models.PaymentStatusRejected || p.StatusReason != ReasonIncorrectAccountNumber || p.StatusInfo == "" {
+			t.Errorf("%s = %+v, want rejected with AC01", id, p)
+		}
+	}
+
+	if len(repo.messages) != 1 || repo.messages[0].Direction != models.MessageDirectionInbound {
+		t.Errorf("messages = %d, want the inbound pain.001 recorded", len(repo.messages))
+	}
+}
+
+func TestImportPain001_Duplicate(t *testing.T) {
+	repo := NewMockRepository()
+	svc := newTestPaymentService(repo)
+	data := readTestdata(t, "pain001_payments.xml")
+
+	if _, err := svc.ImportPain001(context.Background(), "payments.xml", data); err != nil {
+		t.Fatalf("ImportPain001: %v", err)
+	}
+	_, err := svc.ImportPain001(context.Background(), "payments-again.xml", data)
+	if !errors.Is(err, ErrDuplicateMessage) {
+		t.Fatalf("second import error = %v, want ErrDuplicateMessage", err)
+	}
+	if len(repo.payments) != 4 {
+		t.Errorf("payments = %d, want 4", len(repo.payments))
+	}
+}
+
+func TestImportPain001_Invalid(t *testing.T) {
+	svc := newTestPaymentService(NewMockRepository())
+	_, err := svc.ImportPain001(context.Background(), "bad.xml", []byte(`<Document xmlns="`+iso20022.Pain001Namespace+`"><CstmrCdtTrfInitn/></Document>`))
+	if !errors.Is(err, ErrInvalidMessage) {
+		t.Fatalf("error = %v, want ErrInvalidMessage", err)
+	}
+	var verrs iso20022.ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) == 0 {
+		t.Errorf("error = %v, want the validation errors wrapped", err)
+	}
+}
+
+func TestSubmitPayments(t *testing.T) {
+	repo := NewMockRepository()
+	svc := newTestPaymentService(repo)
+	ctx := context.Background()
+	if _, err := svc.ImportPain001(ctx, "payments.xml", readTestdata(t, "pain001_payments.xml")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing is due the day before the requested execution date
+	submitted, err := svc.SubmitPayments(ctx, time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC))
+	if err != nil || submitted != 0 {
+		t.Fatalf("SubmitPayments before execution date = %d, %v; want 0", submitted, err)
+	}
+
+	now := time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC)
+	submitted, err = svc.SubmitPayments(ctx, now)
+	if err != nil || submitted != 2 {
+		t.Fatalf("SubmitPayments = %d, %v; want 2", submitted, err)
+	}
+
+	outbox := memoryOutbox{}
+	delivered, err := svc.DeliverMessages(ctx, outbox, now)
+	if err != nil || delivered != 1 {
+		t.Fatalf("DeliverMessages = %d, %v; want 1", delivered, err)
+	}
+
+	var doc *iso20022.Pacs008Document
+	for _, data := range outbox {
+		doc, err = iso20022.ParsePacs008(data)
+		if err != nil {
+			t.Fatalf("delivered pacs.008 is invalid: %v", err)
+		}
+	}
+	hdr := doc.FIToFICstmrCdtTrf.GrpHdr
+	if hdr.NbOfTxs != "2" || hdr.TtlIntrBkSttlmAmt.Value != "350.50" || hdr.IntrBkSttlmDt != "2024-03-06" {
+		t.Errorf("group header = %+v", hdr)
+	}
+	if got := doc.FIToFICstmrCdtTrf.GrpHdr.InstgAgt.BIC(); got != testBIC {
+		t.Errorf("instructing agent = %s, want %s", got, testBIC)
+	}
+
+	for _, p := range paymentsByEndToEnd(repo) {
+		if p.Status == models.PaymentStatusRejected {
+			continue
+		}
+		if p.Status != models.PaymentStatusSubmitted || p.ClearingMessageID != hdr.MsgID {
+			t.Errorf("%s = %s in %q, want submitted in %s", p.EndToEndID, p.Status, p.ClearingMessageID, hdr.MsgID)
+		}
+	}
+
+	// Delivery is not repeated and submitted payments are not sent again
+	if delivered, _ := svc.DeliverMessages(ctx, outbox, now); delivered != 0 {
+		t.Errorf("second delivery = %d, want 0", delivered)
+	}
+	if submitted, _ := svc.SubmitPayments(ctx, now); submitted != 0 {
+		t.Errorf("second submission = %d, want 0", submitted)
+	}
+}
+
+// submitTestPayments imports and submits the test payments and returns the
+// pacs.008 MsgId they were sent in
+func submitTestPayments(t *testing.T, svc *PaymentService) string {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := svc.ImportPain001(ctx, "payments.xml", readTestdata(t, "pain001_payments.xml")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.SubmitPayments(ctx, time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	return svc.repo.(*MockRepository).messages[1].MessageID
+}
+
+func newTestPacs002(t *testing.T, msgID, original, groupStatus string, statuses ...iso20022.TransactionStatus) []byte {
+	t.Helper()
+	doc, err := iso20022.NewPacs002(iso20022.Pacs002Header{
+		MessageID:   msgID,
+		CreatedAt:   time.Date(2024, 3, 6, 15, 0, 0, 0, time.UTC),
+		Original:    original,
+		OriginalNm:  iso20022.Pacs008MessageName,
+		GroupStatus: groupStatus,
+	}, statuses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := iso20022.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestApplyPacs002_TransactionStatuses(t *testing.T) {
+	repo := NewMockRepository()
+	svc := newTestPaymentService(repo)
+	ctx := context.Background()
+	original := submitTestPayments(t, svc)
+	payments := paymentsByEndToEnd(repo)
+
+	report := newTestPacs002(t, "STS-1", original, "",
+		iso20022.TransactionStatus{OriginalTxID: payments["E2E-INV-2001"].TransactionID, Status: iso20022.StatusAcceptedSettlementCompleted},
+		iso20022.TransactionStatus{OriginalEndToEndID: "E2E-INV-2002", Status: iso20022.StatusRejected, ReasonCode: "AC04", AdditionalInfo: "Account closed"},
+		iso20022.TransactionStatus{OriginalTxID: "UNKNOWN-TX", Status: iso20022.StatusAcceptedSettlementCompleted},
+	)
+	result, err := svc.ApplyPacs002(ctx, "status.xml", report)
+	if err != nil {
+		t.Fatalf("ApplyPacs002: %v", err)
+	}
+	if result.Updated != 2 || len(result.Unmatched) != 1 || result.Unmatched[0] != original+"/UNKNOWN-TX" {
+		t.Errorf("result = %+v, want 2 updated and UNKNOWN-TX unmatched", result)
+	}
+
+	payments = paymentsByEndToEnd(repo)
+	if p := payments["E2E-INV-2001"]; p.Status != models.PaymentStatusSettled {
+		t.Errorf("E2E-INV-2001 = %s, want Settled", p.Status)
+	}
+	if p := payments["E2E-INV-2002"]; p.Status != models.PaymentStatusRejected || p.StatusReason != "AC04" || p.StatusInfo != "Account closed" {
+		t.Errorf("E2E-INV-2002 = %s %s %q, want Rejected AC04", p.Status, p.StatusReason, p.StatusInfo)
+	}
+
+	// A late acceptance does not undo settlement, and the report is not applied twice
+	late := newTestPacs002(t, "STS-2", original, iso20022.StatusAcceptedSettlementInProcess)
+	if result, err := svc.ApplyPacs002(ctx, "late.xml", late); err != nil || result.Updated != 0 {
+		t.Errorf("late acceptance = %+v, %v; want nothing updated", result, err)
+	}
+	if _, err := svc.ApplyPacs002(ctx, "status-again.xml", report); !errors.Is(err, ErrDuplicateMessage) {
+		t.Errorf("repeated report error = %v, want ErrDuplicateMessage", err)
+	}
+}
+
+func TestApplyPacs002_GroupReject(t *testing.T) {
+	repo := NewMockRepository()
+	svc := newTestPaymentService(repo)
+	original := submitTestPayments(t, svc)
+
+	report := newTestPacs002(t, "STS-3", original, iso20022.StatusRejected)
+	result, err := svc.ApplyPacs002(context.Background(), "reject.xml", report)
+	if err != nil || result.Updated != 2 {
+		t.Fatalf("ApplyPacs002 = %+v, %v; want 2 updated", result, err)
+	}
+	for _, p := range paymentsByEndToEnd(repo) {
+		if p.Status != models.PaymentStatusRejected {
+			t.Errorf("%s = %s, want Rejected", p.EndToEndID, p.Status)
+		}
+	}
+}
+
+func TestPaymentStatusFor(t *testing.T) {
+	tests := map[string]models.PaymentStatus{
+		iso20022.StatusAcceptedTechnicalValidation: models.PaymentStatusAccepted,
+		iso20022.StatusAcceptedSettlementInProcess: models.PaymentStatusAccepted,
+		iso20022.StatusAcceptedSettlementCompleted: models.PaymentStatusSettled,
+		iso20022.StatusPending:                     models.PaymentStatusPending,
+		iso20022.StatusRejected:                    models.PaymentStatusRejected,
+	}
+	for code, want := range tests {
+		if got, ok := PaymentStatusFor(code); !ok || got != want {
+			t.Errorf("PaymentStatusFor(%s) = %s, %v; want %s", code, got, ok, want)
+		}
+	}
+	if _, ok := PaymentStatusFor("XXXX"); ok {
+		t.Error("PaymentStatusFor(XXXX) should not map")
+	}
+}
+
+func TestPaymentExchanger_Exchange(t *testing.T) {
+	root := t.TempDir()
+	dir, err := exchange.NewDirectory(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewMockRepository()
+	svc := newTestPaymentService(repo)
+	exchanger := NewPaymentExchanger(svc, nil, dir, time.Minute, zerolog.Nop())
+	ctx := context.Background()
+	now := time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC)
+
+	inbox := filepath.Join(root, exchange.InboxDir)
+	drop := func(name string, data []byte) {
+		if err := os.WriteFile(filepath.Join(inbox, name), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	drop("01_payments.xml", readTestdata(t, "pain001_payments.xml"))
+	drop("02_garbage.xml", []byte("<Document xmlns=\"urn:example\"/>"))
+
+	if err := exchanger.Exchange(ctx, now); err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+
+	outbox, _ := os.ReadDir(filepath.Join(root, exchange.OutboxDir))
+	if len(outbox) != 1 {
+		t.Fatalf("outbox holds %d files, want 1 pacs.008", len(outbox))
+	}
+	if _, err := os.Stat(filepath.Join(root, exchange.ProcessedDir, "01_payments.xml")); err != nil {
+		t.Errorf("pain.001 not moved to processed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, exchange.FailedDir, "02_garbage.xml.error")); err != nil {
+		t.Errorf("unknown message not moved to failed: %v", err)
+	}
+
+	pacs008, err := os.ReadFile(filepath.Join(root, exchange.OutboxDir, outbox[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := iso20022.ParsePacs008(pacs008)
+	if err != nil {
+		t.Fatalf("outbox pacs.008 is invalid: %v", err)
+	}
+	drop("03_status.xml", newTestPacs002(t, "STS-4", doc.FIToFICstmrCdtTrf.GrpHdr.MsgID, iso20022.StatusAcceptedSettlementCompleted))
+
+	if err := exchanger.Exchange(ctx, now); err != nil {
+		t.Fatalf("second Exchange: %v", err)
+	}
+	settled := 0
+	for _, p := range paymentsByEndToEnd(repo) {
+		if p.Status == models.PaymentStatusSettled {
+			settled++
+		}
+	}
+	if settled != 2 {
+		t.Errorf("settled payments = %d, want 2", settled)
+	}
+
+	// A database failure leaves the file in the inbox for the next run
+	drop("04_payments.xml", readTestdata(t, "pain001_payments.xml"))
+	repo.nextErr = errors.New("connection refused")
+	if err := exchanger.Exchange(ctx, now); err == nil {
+		t.Error("Exchange should fail while the database is down")
+	}
+	if pending, _ := dir.Pending(); len(pending) != 1 {
+		t.Errorf("pending = %v, want the file kept for retry", pending)
+	}
+}